@@ -7,23 +7,22 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
-	"github.com/go-chi/chi/v5"
-	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/pkordes/rv-logbook/backend/internal/app"
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
 	"github.com/pkordes/rv-logbook/backend/internal/config"
-	"github.com/pkordes/rv-logbook/backend/internal/handler"
-	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/logging"
 	"github.com/pkordes/rv-logbook/backend/internal/middleware"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
-	"github.com/pkordes/rv-logbook/backend/internal/service"
-	"github.com/pkordes/rv-logbook/backend/spec"
 )
 
 func main() {
@@ -38,13 +37,15 @@ func main() {
 	// --- Logger -----------------------------------------------------------
 	// log/slog is the stdlib structured logger introduced in Go 1.21.
 	// JSON handler writes machine-readable output suitable for log aggregators.
+	// NewContextHandler adds request_id and the authenticated actor to every
+	// line logged with a request context, so incidents can be attributed.
 	var logLevel slog.Level
 	if err := logLevel.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
 		logLevel = slog.LevelInfo
 	}
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	logger := slog.New(logging.NewContextHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: logLevel,
-	}))
+	})))
 	slog.SetDefault(logger)
 
 	// --- Database ---------------------------------------------------------
@@ -71,70 +72,126 @@ func main() {
 	}
 	slog.Info("database connection established")
 
-	// --- Router -----------------------------------------------------------
-	// Middleware is applied in order: RequestID → RealIP → Logger → Recoverer.
-	// RequestID generates a unique trace ID per request.
-	// RealIP sets r.RemoteAddr from X-Forwarded-For / X-Real-IP (safe behind a proxy).
-	// SlogLogger writes one structured JSON log line per request.
-	// Recoverer catches panics and returns HTTP 500 instead of crashing.
-	// NewCORSHandler applies CORS headers based on the configured allowed origins.
-	// NewMaxBodySizeHandler rejects bodies exceeding cfg.MaxBodyBytes (default 1 MiB).
-	r := chi.NewRouter()
-	r.Use(chimiddleware.RequestID)
-	r.Use(chimiddleware.RealIP)
-	r.Use(middleware.NewSlogLogger(logger))
-	r.Use(chimiddleware.Recoverer)
-	r.Use(middleware.NewSecurityHeadersHandler())
-	r.Use(middleware.NewCORSHandler(cfg.CORSOrigins))
-	r.Use(middleware.NewMaxBodySizeHandler(cfg.MaxBodyBytes))
-
-	// Wire the dependency chain: pool → repo → service → handler.
-	tripRepo := repo.NewTripRepo(pool)
-	stopRepo := repo.NewStopRepo(pool)
-	tagRepo := repo.NewTagRepo(pool)
-	tripService := service.NewTripService(tripRepo)
-	stopService := service.NewStopService(tripRepo, stopRepo, tagRepo)
-	tagService := service.NewTagService(tagRepo)
-	exportService := service.NewExportService(tripRepo, stopRepo, tagRepo)
-	server := handler.NewServer(tripService, stopService, tagService, exportService)
-	r.Mount("/", gen.Handler(gen.NewStrictHandler(server, nil)))
-
-	// --- Docs routes (dev convenience) -----------------------------------
-	// GET /openapi.yaml  — serves the embedded OpenAPI spec
-	// GET /docs          — serves the Scalar API browser UI (CDN-hosted, zero deps)
-	r.Get("/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/yaml")
-		w.Write(spec.OpenAPI) //nolint:errcheck
-	})
-	r.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		w.Write([]byte(scalarHTML)) //nolint:errcheck
-	})
+	// Stop positions are PostGIS geography points where the server has the
+	// extension (migration 030). Without it the nearby, cluster, and heatmap
+	// queries fall back to haversine SQL, which measures every stop in a
+	// latitude band instead of asking a spatial index; say so.
+	if postgis, err := repo.NewPoolMonitor(pool).PostGISAvailable(pingCtx); err != nil {
+		slog.Warn("could not check the database server for PostGIS", "error", err)
+	} else if !postgis {
+		slog.Warn("database server lacks PostGIS; spatial stop queries fall back to haversine SQL",
+			"hint", "use a PostGIS image such as postgis/postgis:16-3.4 for indexed spatial queries")
+	}
 
-	// --- HTTP Server ------------------------------------------------------
-	// Explicit timeouts prevent slowloris and resource exhaustion attacks.
-	srv := &http.Server{
-		Addr:         ":" + cfg.Port,
-		Handler:      r,
-		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  60 * time.Second,
+	// --- Signing keys -----------------------------------------------------
+	// Share links and login tokens are signed JWTs. Without configured keys,
+	// fall back to a random per-process key so local development works out
+	// of the box — tokens issued that way stop working when the server
+	// restarts, logging everyone out.
+	var signingKeys *auth.KeySet
+	if cfg.JWTActiveKeyID != "" {
+		signingKeys, err = auth.LoadKeySet(cfg.JWTActiveKeyID, cfg.JWTSigningKeys, cfg.JWTKeysDir)
+		if err != nil {
+			slog.Error("invalid JWT signing keys", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("JWT signing keys loaded", "active_key", signingKeys.ActiveID(), "keys", signingKeys.IDs())
+	} else {
+		signingKeys, err = auth.NewEphemeralKeySet()
+		if err != nil {
+			slog.Error("failed to generate ephemeral signing key", "error", err)
+			os.Exit(1)
+		}
+		slog.Warn("JWT_ACTIVE_KEY_ID not set; using an ephemeral signing key — share links and logins will not survive a restart")
+	}
+
+	// --- Application ------------------------------------------------------
+	// app.New wires repo → service → handler and the router around it.
+	// With AUTH_USERS set, the router requires a bearer token signed with
+	// signingKeys on every route but the health probes, share links, and
+	// login. The e2e tests build the server through the same call.
+	application, err := app.New(cfg, pool, signingKeys, domain.SystemClock, logger)
+	if err != nil {
+		slog.Error("failed to build application", "error", err)
+		os.Exit(1)
+	}
+
+	// `api seed [flags]` loads demo data through the same services (and notes
+	// encryption) as the server, then exits without serving HTTP.
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		code := runSeed(context.Background(), application.Trips, application.Stops, os.Args[2:])
+		pool.Close()
+		os.Exit(code)
+	}
+
+	// --- User accounts ----------------------------------------------------
+	// Each AUTH_USERS account is created in the users table, or has its
+	// password reset to the configured one, before any login is served.
+	if len(application.Accounts) > 0 {
+		provisionCtx, provisionCancel := context.WithTimeout(context.Background(), time.Minute)
+		err := application.Auth.ProvisionUsers(provisionCtx, application.Accounts)
+		provisionCancel()
+		if err != nil {
+			slog.Error("failed to provision user accounts", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("user accounts provisioned", "users", len(application.Accounts))
 	}
 
+	// --- Trash purge ------------------------------------------------------
+	// Deleted trips and stops stay in the trash for domain.TrashRetention and
+	// are then purged for good, checked hourly until shutdown. Replicas take
+	// turns through a Postgres advisory lock; see service.JobService.
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go runTrashPurge(purgeCtx, application.Jobs, application.Trash, trashPurgeInterval)
+
+	// --- Startup gate -----------------------------------------------------
+	// The server listens straight away so /livez answers, but every other
+	// route gets 503 until the migrations are verified current and a
+	// warm-up query succeeds; see app.App.Startup.
+	gate := middleware.NewStartupGate(app.IsLivenessRoute)
+	startupCtx, stopStartup := context.WithCancel(context.Background())
+	defer stopStartup()
+	go openWhenReady(startupCtx, application, gate, startupRetryInterval)
+
+	// --- HTTP Server ------------------------------------------------------
+	// Timeouts, header limits, keep-alives, and h2c come from cfg.
+	srv := app.NewHTTPServer(cfg, gate.Handler(application.Handler))
+
 	// Graceful shutdown: wait for OS signal or server error, then give in-flight
 	// requests up to 15 seconds to complete before forcefully closing.
 	stop := make(chan os.Signal, 1)
-	serverErr := make(chan error, 1)
+	// One slot per server goroutine, so neither blocks if both fail.
+	serverErr := make(chan error, 2)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
 	go func() {
-		slog.Info("server starting", "addr", srv.Addr)
+		slog.Info("server starting", "addr", srv.Addr, "h2c", cfg.HTTP2Cleartext)
 		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			slog.Error("server error", "error", err)
 			serverErr <- err
 		}
 	}()
 
+	// --- gRPC Server ------------------------------------------------------
+	// Off unless GRPC_PORT is set. A failure to listen or serve shuts the
+	// whole process down, like an HTTP server error.
+	if cfg.GRPCPort != "" {
+		lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			slog.Error("failed to listen for gRPC", "error", err)
+			os.Exit(1)
+		}
+		go func() {
+			slog.Info("grpc server starting", "addr", lis.Addr().String())
+			if err := application.GRPC.Serve(lis); err != nil {
+				slog.Error("grpc server error", "error", err)
+				serverErr <- err
+			}
+		}()
+	}
+
 	select {
 	case <-stop:
 		slog.Info("shutting down server")
@@ -142,34 +199,31 @@ func main() {
 		slog.Error("shutting down due to server error", "error", err)
 	}
 
+	stopPurge()
+	stopStartup()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
+	// In-flight RPCs share the same 15 seconds; GracefulStop returns at once
+	// when gRPC was never served.
+	grpcStopped := make(chan struct{})
+	go func() {
+		application.GRPC.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		application.GRPC.Stop()
+	}
+
 	if err := srv.Shutdown(ctx); err != nil {
 		slog.Error("shutdown error", "error", err)
 		os.Exit(1)
 	}
+	// Webhook deliveries started by the last requests are each bounded by
+	// service.WebhookTimeout.
+	application.Webhooks.Wait()
 	slog.Info("server stopped")
 }
-
-// scalarHTML is the single-page Scalar API browser UI.
-// It loads the Scalar library from CDN and points it at our /openapi.yaml endpoint.
-// Scalar is a modern alternative to Swagger UI — cleaner design, same functionality.
-// Available at http://localhost:8080/docs when the server is running.
-const scalarHTML = `<!doctype html>
-<html>
-  <head>
-    <title>RV Logbook API Docs</title>
-    <meta charset="utf-8" />
-    <meta name="viewport" content="width=device-width, initial-scale=1" />
-  </head>
-  <body>
-    <script
-      id="api-reference"
-      data-url="/openapi.yaml"></script>
-    <script
-      src="https://cdn.jsdelivr.net/npm/@scalar/api-reference@1.47.0/dist/browser/standalone.min.js"
-      integrity="sha384-6kjDyToQJPkx17DVSAYYppUPzRFTedx6+Ll/HL3EDCQ5/VdQ01X3Bd4XnAGLRsA/"
-      crossorigin="anonymous"></script>
-  </body>
-</html>`
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// trashPurgeInterval is how often the server purges the trash. Items outlive
+// the retention window by at most this long.
+const trashPurgeInterval = time.Hour
+
+// trashPurgeJob names the purge among the scheduled jobs replicas share.
+const trashPurgeJob = "trash_purge"
+
+// runTrashPurge tries to purge the trash once at startup and then every
+// interval until ctx is cancelled. jobs skips the purge when another
+// replica has run it within the interval, so however many replicas there
+// are, the trash is purged about once per interval. A failed purge is
+// logged and retried on the next tick; nothing depends on it having run.
+func runTrashPurge(ctx context.Context, jobs *service.JobService, trash *service.TrashService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := jobs.Run(ctx, trashPurgeJob, interval, trash.Purge); err != nil && ctx.Err() == nil {
+			slog.Error("trash purge failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
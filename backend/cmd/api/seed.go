@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/seed"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// runSeed implements the `api seed` subcommand: it fills the configured
+// database with demo trips, stops, and tags, then returns a process exit code.
+//
+// It refuses to touch a database that already has trips unless -force is
+// given, so running it against a real logbook by mistake is harmless.
+func runSeed(ctx context.Context, trips *service.TripService, stops *service.StopService, args []string) int {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	count := fs.Int("trips", 12, "number of demo trips to create")
+	seedVal := fs.Uint64("seed", 1, "random seed; the same seed produces the same data")
+	force := fs.Bool("force", false, "seed even if the database already contains trips")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	if !*force {
+		_, total, err := trips.ListPaged(ctx, nil, domain.PaginationParams{Page: 1, Limit: 1})
+		if err != nil {
+			slog.Error("seed: failed to check for existing trips", "error", err)
+			return 1
+		}
+		if total > 0 {
+			slog.Error(fmt.Sprintf("seed: database already has %d trip(s); re-run with -force to add demo data anyway", total))
+			return 1
+		}
+	}
+
+	res, err := seed.Run(ctx, trips, stops, seed.Options{Trips: *count, Seed: *seedVal})
+	if err != nil {
+		slog.Error("seed failed", "error", err, "trips_created", res.Trips, "stops_created", res.Stops)
+		return 1
+	}
+	slog.Info("seed complete", "trips", res.Trips, "stops", res.Stops, "tag_uses", res.TagUses)
+	return 0
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/app"
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// startupRetryInterval is how often the server rechecks whether it is ready
+// to take traffic while its startup gate is closed.
+const startupRetryInterval = 2 * time.Second
+
+// openWhenReady opens gate once application.Startup passes, checking every
+// interval until it does or ctx is cancelled. Until then the server answers
+// only /livez, so a deploy that runs ahead of `goose up` waits for the
+// migrations rather than failing requests.
+func openWhenReady(ctx context.Context, application *app.App, gate *middleware.StartupGate, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		err := application.Startup(checkCtx)
+		cancel()
+		if err == nil {
+			gate.Open()
+			slog.Info("startup checks passed; serving traffic")
+			return
+		}
+		if ctx.Err() == nil {
+			slog.Warn("not ready to serve yet", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// runBackup implements `rvctl backup`: a pg_dump of DATABASE_URL in pg_dump's
+// custom format, restorable with pg_restore. It talks to the database rather
+// than the API so it still works while the server is down or being upgraded.
+// pg_dump must be on PATH and at least as new as the server.
+func runBackup(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("backup", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	dbURL := fs.String("db", os.Getenv("DATABASE_URL"), "database to dump (default $DATABASE_URL)")
+	out := fs.String("o", "rvlogbook-"+time.Now().UTC().Format("20060102-150405")+".dump", "file to write the dump to")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *dbURL == "" {
+		fmt.Fprintln(stderr, "backup: set DATABASE_URL or pass -db")
+		return errUsage
+	}
+
+	pgDump, err := exec.LookPath("pg_dump")
+	if err != nil {
+		return errors.New("backup: pg_dump not found on PATH")
+	}
+
+	err = writeFile(*out, func(w io.Writer) error {
+		cmd := exec.CommandContext(ctx, pgDump, "--format=custom", "--no-owner", "--dbname", *dbURL)
+		cmd.Stdout = w
+		cmd.Stderr = stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("backup: pg_dump: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "wrote %s\n", *out)
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkordes/rv-logbook/backend/internal/client"
+)
+
+// runTripsList implements `rvctl trips list`: every trip, newest first, as a
+// table or (with -json) as a JSON array.
+func runTripsList(ctx context.Context, api *client.Client, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("trips list", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "print the trips as a JSON array")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	trips, err := api.AllTrips(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(trips)
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTART\tEND\tNAME")
+	for _, t := range trips {
+		end := "-"
+		if t.EndDate != nil {
+			end = t.EndDate.String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", t.Id, t.StartDate, end, t.Name)
+	}
+	return tw.Flush()
+}
+
+// runExport implements `rvctl export`: the flat export, written to -o or
+// stdout.
+func runExport(ctx context.Context, api *client.Client, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "csv", "export format: csv or json")
+	out := fs.String("o", "", "write to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *format != "csv" && *format != "json" {
+		fmt.Fprintf(stderr, "export: -format must be csv or json, not %q\n", *format)
+		return errUsage
+	}
+
+	if *out == "" {
+		return api.Export(ctx, *format, stdout)
+	}
+	return writeFile(*out, func(w io.Writer) error { return api.Export(ctx, *format, w) })
+}
+
+// runTagsMerge implements `rvctl tags merge FROM INTO`.
+func runTagsMerge(ctx context.Context, api *client.Client, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("tags merge", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprintln(stderr, "usage: rvctl tags merge FROM-SLUG INTO-SLUG") }
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errUsage
+	}
+
+	tag, err := api.MergeTag(ctx, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "merged %s into %s (%s)\n", fs.Arg(0), tag.Slug, tag.Name)
+	return nil
+}
+
+// writeFile creates path, lets write fill it, and removes it again if
+// anything fails, so a broken run never leaves a truncated file behind.
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
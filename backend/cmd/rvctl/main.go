@@ -0,0 +1,111 @@
+// Command rvctl is the administrative CLI for RV Logbook. Most commands talk
+// to a running API server through internal/client; backup goes to the
+// database directly, since it must work when the server is down. When the
+// server requires login, set RVCTL_USERNAME and RVCTL_PASSWORD.
+//
+//	rvctl [-api URL] trips list [-json]
+//	rvctl [-api URL] export [-format csv|json] [-o FILE]
+//	rvctl [-api URL] tags merge FROM INTO
+//	rvctl backup [-o FILE]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/client"
+)
+
+// defaultAPIURL is the server rvctl talks to when neither -api nor
+// RVCTL_API_URL says otherwise; it matches `make backend/run`.
+const defaultAPIURL = "http://localhost:8080"
+
+const usage = `usage: rvctl [-api URL] <command> [flags]
+
+commands:
+  trips list     list every trip
+  export         download the flat trip/stop export
+  tags merge     fold one tag into another
+  backup         dump the database with pg_dump (uses DATABASE_URL)
+
+Set RVCTL_USERNAME and RVCTL_PASSWORD to log in to a server that requires it.
+
+Run "rvctl <command> -h" for a command's flags.
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run parses the global flags, dispatches to a command, and returns the
+// process exit code: 0 on success, 1 when the command fails, 2 on bad usage.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rvctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+	apiURL := fs.String("api", envOr("RVCTL_API_URL", defaultAPIURL), "base URL of the RV Logbook API")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	api := client.New(*apiURL, &http.Client{Timeout: 5 * time.Minute})
+	if user := os.Getenv("RVCTL_USERNAME"); user != "" && args[0] != "backup" {
+		if err := api.Login(ctx, user, os.Getenv("RVCTL_PASSWORD")); err != nil {
+			fmt.Fprintf(stderr, "rvctl: %v\n", err)
+			return 1
+		}
+	}
+
+	var err error
+	switch {
+	case args[0] == "trips" && len(args) > 1 && args[1] == "list":
+		err = runTripsList(ctx, api, args[2:], stdout, stderr)
+	case args[0] == "export":
+		err = runExport(ctx, api, args[1:], stdout, stderr)
+	case args[0] == "tags" && len(args) > 1 && args[1] == "merge":
+		err = runTagsMerge(ctx, api, args[2:], stdout, stderr)
+	case args[0] == "backup":
+		err = runBackup(ctx, args[1:], stdout, stderr)
+	default:
+		fs.Usage()
+		return 2
+	}
+
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "rvctl: %v\n", err)
+		return 1
+	}
+}
+
+// errUsage reports that a command's flags or arguments were wrong. The
+// command has already printed its own usage.
+var errUsage = errors.New("usage")
+
+// envOr returns the environment variable key, or fallback when it is unset.
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
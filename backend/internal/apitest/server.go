@@ -4,53 +4,175 @@
 // (handler → service → repo → Postgres) into an httptest.Server.
 //
 // This is the "real stack" test layer — HTTP requests flow through every layer
-// with no mocking. Use it to verify that the wiring in main.go is correct and
-// that the layers interact as expected.
+// with no mocking. Use it to verify that the layers interact as expected.
+// The router here is deliberately minimal; internal/e2e covers the production
+// wiring (app.New) with its full middleware stack.
 //
 // Contrast with:
 //   - internal/handler tests: handler only, service is a mock
 //   - internal/repo tests:    SQL only, no HTTP
 //   - apitest (this package): full stack, real HTTP + real DB
+//   - internal/e2e:           the shipped router and middleware via app.New
 package apitest
 
 import (
+	"log/slog"
 	"net/http/httptest"
+	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
 // NewServer wires the real repo → service → handler stack against the supplied
 // pool and returns a running *httptest.Server. The server is automatically
 // closed when the test and all its subtests finish.
 //
-// The router contains only the Recoverer middleware — logging is omitted to
-// keep test output clean. All other production middleware (CORS, body size
-// limit) is also omitted because it is tested independently.
+// The router contains only the recoverer and organization middleware, the
+// latter so tests can act for an organization with X-Organization-ID.
+// Request logging is omitted to keep test output clean; panics are still
+// logged, to stderr. All other production
+// middleware (CORS, body size limit) is also omitted because it is tested
+// independently.
+//
+// Every response is checked against openapi.yaml (testutil.ContractHandler),
+// so any full-stack test that exercises an endpoint also fails on spec drift.
 func NewServer(t *testing.T, pool *pgxpool.Pool) *httptest.Server {
 	t.Helper()
 
 	tripRepo := repo.NewTripRepo(pool)
 	stopRepo := repo.NewStopRepo(pool)
+	exportRepo := repo.NewExportRepo(pool)
 	tagRepo := repo.NewTagRepo(pool)
+	shareRepo := repo.NewShareRepo(pool)
+	odometerRepo := repo.NewOdometerRepo(pool)
+	maintenanceRepo := repo.NewMaintenanceRepo(pool)
+	rigRepo := repo.NewRigRepo(pool)
+	rigMaintenanceRepo := repo.NewRigMaintenanceRepo(pool)
+	propaneRepo := repo.NewPropaneRepo(pool)
+	powerRepo := repo.NewPowerRepo(pool)
+	tankRepo := repo.NewTankRepo(pool)
+	checklistRepo := repo.NewChecklistRepo(pool)
+	packingRepo := repo.NewPackingRepo(pool)
+	reservationRepo := repo.NewReservationRepo(pool)
+	expenseRepo := repo.NewExpenseRepo(pool)
+	borderCrossingRepo := repo.NewBorderCrossingRepo(pool)
+	poiRepo := repo.NewPOIRepo(pool)
+	routeLegRepo := repo.NewRouteLegRepo(pool)
+	locationRepo := repo.NewLocationRepo(pool)
+	placeRepo := repo.NewPlaceRepo(pool)
+	changeRepo := repo.NewChangeRepo(pool)
+	summaryRepo := repo.NewSummaryRepo(pool)
+	trashRepo := repo.NewTrashRepo(pool)
+	organizationRepo := repo.NewOrganizationRepo(pool)
+	customFieldRepo := repo.NewCustomFieldRepo(pool)
+	noteTemplateRepo := repo.NewNoteTemplateRepo(pool)
+	journalRepo := repo.NewJournalRepo(pool)
+	webhookRepo := repo.NewWebhookRepo(pool)
+	photoRepo := repo.NewPhotoRepo(pool)
+
+	keys, err := auth.NewEphemeralKeySet()
+	if err != nil {
+		t.Fatalf("apitest.NewServer: %v", err)
+	}
 
-	tripService := service.NewTripService(tripRepo)
-	stopService := service.NewStopService(tripRepo, stopRepo, tagRepo)
+	events := service.NewEventBus()
+	webhookService := service.NewWebhookService(webhookRepo, nil, domain.SystemClock)
+	events.Subscribe(webhookService.Publish)
+	tripService := service.NewTripService(tripRepo, customFieldRepo, rigRepo, events)
+	stopService := service.NewStopService(tripRepo, stopRepo, tagRepo, customFieldRepo, noteTemplateRepo, events, domain.SystemClock)
 	tagService := service.NewTagService(tagRepo)
-	exportService := service.NewExportService(tripRepo, stopRepo, tagRepo)
+	exportService := service.NewExportService(exportRepo)
+	odometerService := service.NewOdometerService(odometerRepo, tripRepo, maintenanceRepo, events)
+	maintenanceService := service.NewMaintenanceService(maintenanceRepo, odometerRepo, domain.SystemClock)
+	rigService := service.NewRigService(rigRepo)
+	rigMaintenanceService := service.NewRigMaintenanceService(rigRepo, rigMaintenanceRepo)
+	propaneService := service.NewPropaneService(propaneRepo, tripRepo, events)
+	powerService := service.NewPowerService(powerRepo, tripRepo, events)
+	tankService := service.NewTankService(tripRepo, stopRepo, tankRepo, events, domain.SystemClock)
+	checklistService := service.NewChecklistService(checklistRepo, tripRepo, stopRepo, events, domain.SystemClock)
+	packingService := service.NewPackingService(packingRepo, tripRepo)
+	reservationService := service.NewReservationService(tripRepo, stopRepo, reservationRepo, events, domain.SystemClock)
+	expenseService := service.NewExpenseService(tripRepo, stopRepo, tagRepo, expenseRepo, events, domain.SystemClock)
+	reportService := service.NewReportService(tripRepo, expenseRepo)
+	photoService := service.NewPhotoService(tripRepo, stopRepo, photoRepo, objectstore.NewMemory(), events)
+	borderCrossingService := service.NewBorderCrossingService(tripRepo, borderCrossingRepo, events)
+	poiService := service.NewPOIService(poiRepo, tripRepo, tagRepo)
+	routeLegService := service.NewRouteLegService(tripRepo, stopRepo, routeLegRepo, objectstore.NewMemory(), nil, nil, events)
+	mapService := service.NewMapService(tripRepo, stopRepo, routeLegRepo, nil)
+	locationService := service.NewLocationService(tripRepo, stopRepo, locationRepo, domain.GeofenceSettings{
+		Dwell: 30 * time.Minute, RadiusMeters: 150,
+	}, domain.SystemClock)
+	placeService := service.NewPlaceService(placeRepo, nil)
+	shareService := service.NewShareService(tripRepo, stopRepo, shareRepo, keys, domain.SystemClock)
+	cacheService := service.NewCacheService(changeRepo)
+	dashboardService := service.NewDashboardService(summaryRepo)
+	trashService := service.NewTrashService(trashRepo, objectstore.NewMemory(), domain.SystemClock)
+	organizationService := service.NewOrganizationService(organizationRepo)
+	customFieldService := service.NewCustomFieldService(customFieldRepo)
+	noteTemplateService := service.NewNoteTemplateService(noteTemplateRepo)
+	importService := service.NewImportService(tripRepo, stopRepo, events)
+	dataFixService := service.NewDataFixService(repo.NewDataFixRepo(pool), tripRepo)
+	journalService := service.NewJournalService(tripRepo, stopRepo, journalRepo, events)
+	membershipService := service.NewMembershipService(repo.NewTripMemberRepo(pool), tripRepo, repo.NewUserRepo(pool))
 
-	srv := handler.NewServer(tripService, stopService, tagService, exportService)
+	srv := handler.NewServer(handler.ServerDeps{
+		Trips:         tripService,
+		Stops:         stopService,
+		Tags:          tagService,
+		Export:        exportService,
+		Shares:        shareService,
+		Odometer:      odometerService,
+		Propane:       propaneService,
+		Power:         powerService,
+		Tanks:         tankService,
+		Checklists:    checklistService,
+		Packing:       packingService,
+		Reservations:  reservationService,
+		Expenses:      expenseService,
+		Crossings:     borderCrossingService,
+		POIs:          poiService,
+		Routes:        routeLegService,
+		Maps:          mapService,
+		Locations:     locationService,
+		Places:        placeService,
+		Cache:         cacheService,
+		Pool:          repo.NewPoolMonitor(pool),
+		Dashboard:     dashboardService,
+		Trash:         trashService,
+		Orgs:          organizationService,
+		CustomFields:  customFieldService,
+		Journal:       journalService,
+		Webhooks:      webhookService,
+		Maintenance:   maintenanceService,
+		Members:       membershipService,
+		Rigs:          rigService,
+		RigLog:        rigMaintenanceService,
+		Reports:       reportService,
+		Photos:        photoService,
+		NoteTemplates: noteTemplateService,
+		Imports:       importService,
+		DataFixes:     dataFixService,
+	})
 
+	var panics atomic.Uint64
 	r := chi.NewRouter()
-	r.Use(chimiddleware.Recoverer)
-	r.Mount("/", gen.Handler(gen.NewStrictHandler(srv, nil)))
+	r.Use(middleware.NewRecoverer(slog.New(slog.NewTextHandler(os.Stderr, nil)), &panics))
+	r.Use(middleware.NewOrganizationHandler(organizationRepo))
+	r.Mount("/", testutil.ContractHandler(t, gen.HandlerFromMux(handler.NewStrictHandler(srv), handler.NewRouter())))
 
 	ts := httptest.NewServer(r)
 	t.Cleanup(ts.Close)
@@ -0,0 +1,482 @@
+// Package app wires the RV Logbook API together: repositories, services,
+// handlers, middleware, and routes. cmd/api calls New with real config and a
+// live pool; the e2e tests call the same New, so the wiring they exercise is
+// exactly the wiring that ships.
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/buildinfo"
+	"github.com/pkordes/rv-logbook/backend/internal/config"
+	"github.com/pkordes/rv-logbook/backend/internal/docs"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/elevation"
+	"github.com/pkordes/rv-logbook/backend/internal/fieldcrypt"
+	"github.com/pkordes/rv-logbook/backend/internal/geocode"
+	"github.com/pkordes/rv-logbook/backend/internal/graphqlapi"
+	"github.com/pkordes/rv-logbook/backend/internal/grpcapi"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/internal/metrics"
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/routing"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/internal/staticmap"
+	"github.com/pkordes/rv-logbook/backend/internal/web"
+	"github.com/pkordes/rv-logbook/backend/spec"
+)
+
+// providerProbeInterval is how often the detailed health check probes each
+// external provider. The public servers rate-limit, and monitoring may poll
+// the check every few seconds.
+const providerProbeInterval = time.Minute
+
+// failedBodyLogBytes is how much of a failed request's body LOG_FAILED_BODIES
+// logs: enough for any JSON body the API takes, short of a bulk import.
+const failedBodyLogBytes = 4 << 10
+
+// App is the fully wired application.
+type App struct {
+	// Handler serves every route: the API, /openapi.yaml, /docs, and the
+	// embedded web UI.
+	Handler http.Handler
+
+	// Trips and Stops are exposed for non-HTTP entry points such as
+	// `api seed`, which must go through the same services (and notes
+	// encryption) as the server.
+	Trips *service.TripService
+	Stops *service.StopService
+
+	// Trash is exposed so the server can run its purge in the background,
+	// through Jobs so that only one replica purges each hour.
+	Trash *service.TrashService
+	Jobs  *service.JobService
+
+	// Webhooks is exposed so the server can let deliveries finish on shutdown.
+	Webhooks *service.WebhookService
+
+	// Auth and Accounts are exposed so the server can provision the
+	// AUTH_USERS accounts, parsed into Accounts, before serving. New does
+	// not, as it does not touch the database.
+	Auth     *service.AuthService
+	Accounts map[string]string
+
+	// GRPC serves the trip, stop, and tag services over gRPC. The caller
+	// decides whether to serve it; cmd/api does only when cfg.GRPCPort is set.
+	GRPC *grpc.Server
+
+	// startupChecks must all pass before the instance takes traffic; see
+	// Startup.
+	startupChecks []func(context.Context) error
+}
+
+// Startup reports whether the instance is ready to take its first request:
+// every embedded migration has been applied and a warm-up query against the
+// database succeeds. cmd/api serves only /livez behind a
+// middleware.StartupGate until it returns nil.
+func (a *App) Startup(ctx context.Context) error {
+	for _, check := range a.startupChecks {
+		if err := check(ctx); err != nil {
+			return fmt.Errorf("app.App.Startup: %w", err)
+		}
+	}
+	return nil
+}
+
+// New builds the dependency chain pool → repo → service → handler and the
+// router around it. It does not touch the database; the caller owns the pool
+// and decides when to ping it.
+//
+// keys signs share links. clock is the source of "now" for services that
+// need one — domain.SystemClock in production.
+func New(cfg config.Config, pool *pgxpool.Pool, keys *auth.KeySet, clock domain.Clock, logger *slog.Logger) (*App, error) {
+	// Every repo but the export, which streams under the stream timeout,
+	// queries through a per-statement deadline.
+	queries := repo.NewTimeoutDB(pool, time.Duration(cfg.DBQueryTimeoutSeconds)*time.Second)
+	tripRepo := repo.NewTripRepo(queries)
+	stopRepo := repo.NewStopRepo(queries)
+	exportRepo := repo.NewExportRepo(pool)
+
+	// Optional application-level encryption of trip and stop notes.
+	// The decorators sit between the Postgres repos and the services, so
+	// nothing above the repo layer knows whether notes are encrypted at rest.
+	if cfg.NotesEncryptionActiveKeyID != "" {
+		noteKeys, err := fieldcrypt.ParseKeyList(cfg.NotesEncryptionKeys)
+		if err != nil {
+			return nil, fmt.Errorf("app.New: notes encryption keys: %w", err)
+		}
+		notesCipher, err := fieldcrypt.NewCipher(cfg.NotesEncryptionActiveKeyID, noteKeys)
+		if err != nil {
+			return nil, fmt.Errorf("app.New: notes encryption keys: %w", err)
+		}
+		tripRepo = repo.NewEncryptedTripRepo(tripRepo, notesCipher)
+		stopRepo = repo.NewEncryptedStopRepo(stopRepo, notesCipher)
+		exportRepo = repo.NewEncryptedExportRepo(exportRepo, notesCipher)
+		logger.Info("notes encryption enabled", "active_key", notesCipher.ActiveID(), "keys", notesCipher.IDs())
+	}
+
+	// Uploaded files go to an S3 bucket or a directory when one is
+	// configured. Without either they are kept in memory — fine for
+	// development, lost on restart.
+	var objects objectstore.Store
+	if cfg.ObjectStorageS3Bucket != "" {
+		bucket, err := objectstore.NewS3(objectstore.S3Config{
+			Bucket:          cfg.ObjectStorageS3Bucket,
+			Region:          cfg.ObjectStorageS3Region,
+			Endpoint:        cfg.ObjectStorageS3Endpoint,
+			AccessKeyID:     cfg.AWSAccessKeyID,
+			SecretAccessKey: cfg.AWSSecretAccessKey,
+			SessionToken:    cfg.AWSSessionToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("app.New: object storage: %w", err)
+		}
+		objects = bucket
+		logger.Info("object storage enabled", "s3_bucket", cfg.ObjectStorageS3Bucket, "region", cfg.ObjectStorageS3Region)
+	} else if cfg.ObjectStorageDir != "" {
+		dir, err := objectstore.NewDir(cfg.ObjectStorageDir)
+		if err != nil {
+			return nil, fmt.Errorf("app.New: object storage: %w", err)
+		}
+		objects = dir
+		logger.Info("object storage enabled", "dir", cfg.ObjectStorageDir)
+	} else {
+		objects = objectstore.NewMemory()
+		logger.Warn("OBJECT_STORAGE_S3_BUCKET and OBJECT_STORAGE_DIR not set; uploaded files are kept in memory and lost on restart")
+	}
+
+	tagRepo := repo.NewTagRepo(queries)
+	shareRepo := repo.NewShareRepo(queries)
+	odometerRepo := repo.NewOdometerRepo(queries)
+	maintenanceRepo := repo.NewMaintenanceRepo(queries)
+	rigRepo := repo.NewRigRepo(queries)
+	rigMaintenanceRepo := repo.NewRigMaintenanceRepo(queries)
+	propaneRepo := repo.NewPropaneRepo(queries)
+	powerRepo := repo.NewPowerRepo(queries)
+	tankRepo := repo.NewTankRepo(queries)
+	checklistRepo := repo.NewChecklistRepo(queries)
+	packingRepo := repo.NewPackingRepo(queries)
+	reservationRepo := repo.NewReservationRepo(queries)
+	expenseRepo := repo.NewExpenseRepo(queries)
+	borderCrossingRepo := repo.NewBorderCrossingRepo(queries)
+	poiRepo := repo.NewPOIRepo(queries)
+	routeLegRepo := repo.NewRouteLegRepo(queries)
+	locationRepo := repo.NewLocationRepo(queries)
+	placeRepo := repo.NewPlaceRepo(queries)
+	geocodeCacheRepo := repo.NewGeocodeCacheRepo(queries)
+	changeRepo := repo.NewChangeRepo(queries)
+	summaryRepo := repo.NewSummaryRepo(queries)
+	trashRepo := repo.NewTrashRepo(queries)
+	organizationRepo := repo.NewOrganizationRepo(queries)
+	userRepo := repo.NewUserRepo(queries)
+	apiKeyRepo := repo.NewAPIKeyRepo(queries)
+	tripMemberRepo := repo.NewTripMemberRepo(queries)
+	dataFixRepo := repo.NewDataFixRepo(queries)
+	customFieldRepo := repo.NewCustomFieldRepo(queries)
+	noteTemplateRepo := repo.NewNoteTemplateRepo(queries)
+	journalRepo := repo.NewJournalRepo(queries)
+	webhookRepo := repo.NewWebhookRepo(queries)
+	photoRepo := repo.NewPhotoRepo(queries)
+	// Services publish their changes to events; the webhook relay POSTs
+	// them to the organization's webhooks in the background.
+	events := service.NewEventBus()
+	webhookService := service.NewWebhookService(webhookRepo, nil, clock)
+	events.Subscribe(webhookService.Publish)
+	tripService := service.NewTripService(tripRepo, customFieldRepo, rigRepo, events)
+	stopService := service.NewStopService(tripRepo, stopRepo, tagRepo, customFieldRepo, noteTemplateRepo, events, clock)
+	tagService := service.NewTagService(tagRepo)
+	exportService := service.NewExportService(exportRepo)
+	odometerService := service.NewOdometerService(odometerRepo, tripRepo, maintenanceRepo, events)
+	maintenanceService := service.NewMaintenanceService(maintenanceRepo, odometerRepo, clock)
+	rigService := service.NewRigService(rigRepo)
+	rigMaintenanceService := service.NewRigMaintenanceService(rigRepo, rigMaintenanceRepo)
+	propaneService := service.NewPropaneService(propaneRepo, tripRepo, events)
+	powerService := service.NewPowerService(powerRepo, tripRepo, events)
+	tankService := service.NewTankService(tripRepo, stopRepo, tankRepo, events, clock)
+	checklistService := service.NewChecklistService(checklistRepo, tripRepo, stopRepo, events, clock)
+	packingService := service.NewPackingService(packingRepo, tripRepo)
+	reservationService := service.NewReservationService(tripRepo, stopRepo, reservationRepo, events, clock)
+	expenseService := service.NewExpenseService(tripRepo, stopRepo, tagRepo, expenseRepo, events, clock)
+	reportService := service.NewReportService(tripRepo, expenseRepo)
+	photoService := service.NewPhotoService(tripRepo, stopRepo, photoRepo, objects, events)
+	borderCrossingService := service.NewBorderCrossingService(tripRepo, borderCrossingRepo, events)
+	poiService := service.NewPOIService(poiRepo, tripRepo, tagRepo)
+	// Route leg elevation profiles are looked up from ELEVATION_API_URL and
+	// stored with the leg. Without it only profiles already stored are served.
+	// Each configured provider is also probed by the detailed health check.
+	var providerChecks []service.HealthCheck
+	var elevations elevation.Source
+	if cfg.ElevationAPIURL != "" {
+		api := elevation.NewHTTP(cfg.ElevationAPIURL, nil)
+		elevations = api
+		providerChecks = append(providerChecks, service.HealthCheck{Name: "elevation", Every: providerProbeInterval, Probe: api.Ping})
+		logger.Info("elevation lookups enabled", "url", cfg.ElevationAPIURL)
+	}
+	// The drive check asks ROUTING_API_URL about legs with no distance or
+	// driving time entered. Without it those legs go unchecked.
+	var routes routing.Router
+	if cfg.RoutingAPIURL != "" {
+		api := routing.NewHTTP(cfg.RoutingAPIURL, nil)
+		routes = api
+		providerChecks = append(providerChecks, service.HealthCheck{Name: "routing", Every: providerProbeInterval, Probe: api.Ping})
+		logger.Info("routing lookups enabled", "url", cfg.RoutingAPIURL)
+	}
+	routeLegService := service.NewRouteLegService(tripRepo, stopRepo, routeLegRepo, objects, elevations, routes, events)
+	// Static trip maps are drawn over tiles from MAP_TILE_URL, each fetched
+	// once and kept in object storage. Without it they get a plain background.
+	var tiles staticmap.Tiles
+	if cfg.MapTileURL != "" {
+		tileServer := staticmap.NewHTTPTiles(cfg.MapTileURL, nil)
+		tiles = staticmap.NewCached(tileServer, objects)
+		providerChecks = append(providerChecks, service.HealthCheck{Name: "map_tiles", Every: providerProbeInterval, Probe: tileServer.Ping})
+		logger.Info("map tiles enabled", "url", cfg.MapTileURL)
+	}
+	mapService := service.NewMapService(tripRepo, stopRepo, routeLegRepo, tiles)
+	locationService := service.NewLocationService(tripRepo, stopRepo, locationRepo, domain.GeofenceSettings{
+		Dwell:           time.Duration(cfg.GeofenceDwellMinutes) * time.Minute,
+		RadiusMeters:    float64(cfg.GeofenceRadiusMeters),
+		AutoCreateStops: cfg.GeofenceAutoCreateStops,
+	}, clock)
+	// Stops are placed in a state or province by reverse geocoding their
+	// coordinates with GEOCODER_URL, through a cache so a campground is only
+	// looked up once. Without it the report counts them as unresolved.
+	var geocoder geocode.Reverser
+	if cfg.GeocoderURL != "" {
+		nominatim := geocode.NewNominatim(cfg.GeocoderURL, nil)
+		geocoder = service.NewCachedGeocoder(nominatim, "nominatim", geocodeCacheRepo, time.Duration(cfg.GeocodeCacheTTLDays)*24*time.Hour, clock)
+		providerChecks = append(providerChecks, service.HealthCheck{Name: "geocoder", Every: providerProbeInterval, Probe: nominatim.Ping})
+		logger.Info("reverse geocoding enabled", "url", cfg.GeocoderURL)
+	}
+	placeService := service.NewPlaceService(placeRepo, geocoder)
+	shareService := service.NewShareService(tripRepo, stopRepo, shareRepo, keys, clock)
+	cacheService := service.NewCacheService(changeRepo)
+	dashboardService := service.NewDashboardService(summaryRepo)
+	trashService := service.NewTrashService(trashRepo, objects, clock)
+	jobService := service.NewJobService(repo.NewJobLocker(pool), clock)
+	organizationService := service.NewOrganizationService(organizationRepo)
+	customFieldService := service.NewCustomFieldService(customFieldRepo)
+	noteTemplateService := service.NewNoteTemplateService(noteTemplateRepo)
+	importService := service.NewImportService(tripRepo, stopRepo, events)
+	dataFixService := service.NewDataFixService(dataFixRepo, tripRepo)
+	journalService := service.NewJournalService(tripRepo, stopRepo, journalRepo, events)
+	accounts, err := auth.ParseAccounts(cfg.AuthUsers)
+	if err != nil {
+		return nil, fmt.Errorf("app.New: %w", err)
+	}
+	authService := service.NewAuthService(userRepo, keys, clock)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	membershipService := service.NewMembershipService(tripMemberRepo, tripRepo, userRepo)
+	poolMonitor := repo.NewPoolMonitor(pool)
+	schemaMonitor, err := repo.NewSchemaMonitor(pool)
+	if err != nil {
+		return nil, fmt.Errorf("app.New: %w", err)
+	}
+	// The critical checks decide /readyz and are probed on every request;
+	// the API cannot serve requests without them. /healthz?detail=true adds
+	// the providers.
+	healthChecks := []service.HealthCheck{
+		{Name: "database", Critical: true, Probe: poolMonitor.Ping},
+		{Name: "object_storage", Critical: true, Probe: func(ctx context.Context) error { return objectstore.Probe(ctx, objects) }},
+		{Name: "migrations", Critical: true, Probe: schemaMonitor.Current},
+	}
+	if cfg.MaintenanceFile != "" {
+		healthChecks = append(healthChecks, service.HealthCheck{Name: "maintenance", Critical: true, Probe: maintenanceProbe(cfg.MaintenanceFile)})
+	}
+	healthService := service.NewHealthService(buildinfo.String(), append(healthChecks, providerChecks...), clock)
+
+	pagination := handler.PaginationLimits{
+		Default:  domain.PaginationLimits{Default: int(cfg.PaginationDefaultLimit), Max: int(cfg.PaginationMaxLimit)},
+		Readings: domain.PaginationLimits{Default: int(cfg.ReadingsPaginationDefaultLimit), Max: int(cfg.ReadingsPaginationMaxLimit)},
+	}
+	server := handler.NewServer(handler.ServerDeps{
+		Trips:         tripService,
+		Stops:         stopService,
+		Tags:          tagService,
+		Export:        exportService,
+		Shares:        shareService,
+		Odometer:      odometerService,
+		Propane:       propaneService,
+		Power:         powerService,
+		Tanks:         tankService,
+		Checklists:    checklistService,
+		Packing:       packingService,
+		Reservations:  reservationService,
+		Expenses:      expenseService,
+		Crossings:     borderCrossingService,
+		POIs:          poiService,
+		Routes:        routeLegService,
+		Maps:          mapService,
+		Locations:     locationService,
+		Places:        placeService,
+		Cache:         cacheService,
+		Pool:          poolMonitor,
+		Dashboard:     dashboardService,
+		Health:        healthService,
+		Trash:         trashService,
+		Orgs:          organizationService,
+		CustomFields:  customFieldService,
+		Journal:       journalService,
+		Webhooks:      webhookService,
+		Auth:          authService,
+		APIKeys:       apiKeyService,
+		Maintenance:   maintenanceService,
+		Members:       membershipService,
+		Rigs:          rigService,
+		RigLog:        rigMaintenanceService,
+		Reports:       reportService,
+		Photos:        photoService,
+		NoteTemplates: noteTemplateService,
+		Imports:       importService,
+		DataFixes:     dataFixService,
+		Pagination:    &pagination,
+	})
+	// handler.NewRouter answers unknown routes (404) and wrong methods (405,
+	// with Allow) in the standard error envelope.
+	var api http.Handler = gen.HandlerFromMux(handler.NewStrictHandler(server), handler.NewRouter())
+
+	// Dev-mode contract checking: reject requests that do not match the spec
+	// and log any response that drifts from it. Off by default — it buffers
+	// every response body in memory.
+	if cfg.OpenAPIValidation {
+		validate, err := middleware.NewOpenAPIValidator(spec.OpenAPI, middleware.OpenAPIValidatorOptions{
+			ValidateRequests:  true,
+			ValidateResponses: true,
+			OnResponseError: func(r *http.Request, status int, err error) {
+				logger.ErrorContext(r.Context(), "response does not match openapi spec",
+					"method", r.Method, "path", r.URL.Path, "status", status, "error", err)
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("app.New: %w", err)
+		}
+		api = validate(api)
+		logger.Warn("OPENAPI_VALIDATION enabled; requests and responses are validated against the spec")
+	}
+
+	// Middleware is applied in order: RequestID → RealIP → Logger → Recoverer.
+	// RequestID generates a unique trace ID per request.
+	// RealIP sets r.RemoteAddr from X-Forwarded-For / X-Real-IP (safe behind a proxy).
+	// SlogLogger writes one structured JSON log line per request, or for
+	// cfg.LogSampleOKPercent of 200s.
+	// NewFailedBodyLogger, with cfg.LogFailedBodies, also logs the first
+	// failedBodyLogBytes of a failed mutation's body, secrets masked.
+	// NewRecoverer catches panics, logs their stack, counts them in panics, and
+	// returns HTTP 500 with the request ID instead of crashing.
+	// NewSecurityHeadersHandler sets defensive headers, letting other sites
+	// frame only isEmbedRoute's paths.
+	// NewCORSHandler applies CORS headers based on the configured allowed
+	// origins, and lets any origin read isEmbedRoute's paths.
+	// NewStreamDeadlineHandler gives the file routes cfg.StreamTimeoutSeconds to
+	// finish instead of the server's 10 seconds; 0 leaves them at 10.
+	// NewVaryHandler marks every response as depending on the Units header,
+	// which switches quantities between imperial and metric, and on the
+	// headers that pick the user and organization a request reads as.
+	// NewMaxBodySizeHandler rejects bodies exceeding cfg.MaxBodyBytes (default 1 MiB).
+	var panics atomic.Uint64
+	r := chi.NewRouter()
+	r.Use(chimiddleware.RequestID)
+	r.Use(chimiddleware.RealIP)
+	r.Use(middleware.NewSlogLogger(logger, int(cfg.LogSampleOKPercent)))
+	if cfg.LogFailedBodies {
+		r.Use(middleware.NewFailedBodyLogger(logger, failedBodyLogBytes))
+		logger.Warn("LOG_FAILED_BODIES enabled; failed request bodies are logged")
+	}
+	r.Use(middleware.NewRecoverer(logger, &panics))
+	r.Use(middleware.NewSecurityHeadersHandler(isEmbedRoute))
+	r.Use(middleware.NewCORSHandler(cfg.CORSOrigins, isEmbedRoute))
+	if cfg.StreamTimeoutSeconds > 0 {
+		r.Use(middleware.NewStreamDeadlineHandler(time.Duration(cfg.StreamTimeoutSeconds)*time.Second, isStreamRoute))
+	}
+	r.Use(middleware.NewVaryHandler("Units", "Authorization", "X-API-Key", "X-Organization-ID"))
+	r.Use(middleware.NewMaxBodySizeHandler(cfg.MaxBodyBytes))
+
+	// --- Authentication -------------------------------------------------
+	// Only the API and GraphQL are wrapped, so the web UI's pages and assets,
+	// the docs, and /metrics load without a token.
+	// NewAuthHandler requires a bearer token from POST /auth/token, or an
+	// X-API-Key from POST /api-keys, on every route but isPublicRoute's; it
+	// is left out while no accounts are configured, so development needs no
+	// login.
+	// NewOrganizationHandler makes each request act for the organization named
+	// by X-Organization-ID, or the default one; the repos scope every query to
+	// it. It runs after authentication to check the user's membership.
+	// NewAdminHandler then lets only ADMIN_USERS reach /admin; with
+	// authentication off there is no user, so /admin stays closed.
+	// Trip roles are enforced by the services, so they hold for every API.
+	organization := middleware.NewOrganizationHandler(organizationRepo)
+	admin := middleware.NewAdminHandler(userRepo, cfg.AdminUsers)
+	protect := func(next http.Handler) http.Handler { return organization(admin(next)) }
+	if len(accounts) > 0 {
+		authenticate := middleware.NewAuthHandler(authService, apiKeyService, isPublicRoute)
+		protect = func(next http.Handler) http.Handler { return authenticate(organization(admin(next))) }
+		logger.Info("authentication enabled", "users", len(accounts))
+	} else {
+		logger.Warn("AUTH_USERS not set; the API is open to anyone who can reach it")
+	}
+	api = protect(api)
+
+	// --- Web UI ---------------------------------------------------------
+	// The frontend calls the API under /api, as the Vite dev server proxies
+	// it. When the build is embedded, GET / and every page of the app are
+	// served from the binary; API clients keep using the unprefixed paths.
+	r.Mount("/api", http.StripPrefix("/api", api))
+	if ui, ok := web.App(); ok {
+		r.Mount("/", web.Handler(ui, api))
+		logger.Info("serving the embedded web UI")
+	} else {
+		r.Mount("/", api)
+	}
+
+	// --- Docs routes ----------------------------------------------------
+	// GET /openapi.yaml  — serves the embedded OpenAPI spec
+	// GET /docs          — serves the Scalar API browser UI from embedded assets
+	//                      under a strict Content-Security-Policy (works offline)
+	docsRoutes := docs.Routes(spec.OpenAPI)
+	r.Handle("/openapi.yaml", docsRoutes)
+	r.Handle("/docs", docsRoutes)
+	r.Handle("/docs/*", docsRoutes)
+
+	// GET /metrics — connection pool gauges and the recovered panic count in
+	// the Prometheus text format. GET /admin/pool serves the pool figures as JSON.
+	r.Handle("/metrics", metrics.Handler(metrics.Pool(poolMonitor.Stats), metrics.Panics(panics.Load)))
+
+	// POST /graphql — read-only queries over trips, stops, tags, and stats,
+	// for clients that want nested trip → stops → tags in one round trip.
+	r.Handle("/graphql", protect(graphqlapi.NewHandler(tripService, stopService, tagService, dashboardService, pagination.Default, logger)))
+
+	// --- gRPC ---------------------------------------------------------------
+	// The same trip, stop, and tag services, for on-board vehicle computers
+	// and sync agents. NewUnaryInterceptor logs each call and recovers panics,
+	// as SlogLogger and NewRecoverer do for HTTP. NewAuthInterceptor then
+	// does what protect does: it takes a bearer token or API key from the
+	// call's metadata, unless no accounts are configured, and applies
+	// x-organization-id.
+	var grpcTokens grpcapi.TokenVerifier
+	if len(accounts) > 0 {
+		grpcTokens = authService
+	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcapi.NewUnaryInterceptor(logger),
+		grpcapi.NewAuthInterceptor(grpcTokens, apiKeyService, organizationRepo),
+	))
+	grpcapi.NewServer(tripService, stopService, tagService, pagination.Default, logger).Register(grpcServer)
+
+	return &App{
+		Handler: r, Trips: tripService, Stops: stopService, Trash: trashService, Jobs: jobService, Webhooks: webhookService, Auth: authService, Accounts: accounts, GRPC: grpcServer,
+		startupChecks: []func(context.Context) error{schemaMonitor.Current, poolMonitor.WarmUp},
+	}, nil
+}
@@ -0,0 +1,248 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/app"
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/config"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// newApp builds the application against a pool that is never connected —
+// pgxpool.New dials lazily — so these tests need no database. They cover the
+// routes and middleware that do not touch Postgres; the e2e package covers
+// the rest against a real one.
+func newApp(t *testing.T, cfg config.Config) (*app.App, error) {
+	t.Helper()
+
+	pool, err := pgxpool.New(context.Background(), "postgres://unused@127.0.0.1:1/unused")
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	keys, err := auth.NewEphemeralKeySet()
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return app.New(cfg, pool, keys, domain.SystemClock, logger)
+}
+
+func TestNew_ServesHealthThroughMiddleware(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, []string{"Origin", "Units", "Authorization", "X-API-Key", "X-Organization-ID"}, rec.Header().Values("Vary"))
+}
+
+// TestNew_LivenessAndReadiness verifies that an instance whose database is
+// unreachable, or which is in maintenance mode, is alive but not ready.
+func TestNew_LivenessAndReadiness(t *testing.T) {
+	maintenance := filepath.Join(t.TempDir(), "maintenance")
+	require.NoError(t, os.WriteFile(maintenance, nil, 0o600))
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20, MaintenanceFile: maintenance})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var body struct {
+		Components []struct{ Name, Status string }
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	statuses := map[string]string{}
+	for _, c := range body.Components {
+		statuses[c.Name] = c.Status
+	}
+	assert.Equal(t, "down", statuses["database"])
+	assert.Equal(t, "ok", statuses["object_storage"])
+	assert.Equal(t, "down", statuses["maintenance"])
+}
+
+// TestNew_UnmatchedRoutesGetJSON verifies that unknown paths and wrong
+// methods get the error envelope through the full middleware stack.
+func TestNew_UnmatchedRoutesGetJSON(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/nope", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"not_found"`)
+
+	rec = httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/livez", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET", rec.Header().Get("Allow"))
+	assert.Contains(t, rec.Body.String(), `"code":"method_not_allowed"`)
+}
+
+// TestNew_ServesAPIUnderPrefix verifies the API also answers under /api,
+// where the web UI calls it.
+func TestNew_ServesAPIUnderPrefix(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/livez", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/nope", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"not_found"`)
+}
+
+func TestNew_ServesSpec(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.yaml", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "openapi:")
+}
+
+func TestNew_ServesPoolStats(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "\nrvlogbook_db_pool_acquired_conns 0\n")
+
+	// Without login there is no administrator, so /admin stays closed.
+	rec = httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/pool", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"forbidden"`)
+}
+
+func TestNew_ServesGraphQL(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ __typename }"}`)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":{"__typename":"Query"}}`, rec.Body.String())
+}
+
+// TestNew_RequiresBearerToken verifies that with accounts configured the API
+// and GraphQL need a bearer token, while the health probes, docs, and
+// metrics stay public.
+func TestNew_RequiresBearerToken(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20, AuthUsers: "alice:s3cret"})
+	require.NoError(t, err)
+	serve := func(method, path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		a.Handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, path := range []string{"/trips", "/api/trips", "/admin/pool"} {
+		rec := serve(http.MethodGet, path, "", "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, path)
+		assert.Contains(t, rec.Body.String(), `"code":"unauthorized"`, path)
+	}
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, "/graphql", "", `{"query":"{ __typename }"}`).Code)
+	for _, path := range []string{"/healthz", "/api/livez", "/openapi.yaml", "/metrics"} {
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, path, "", "").Code, path)
+	}
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/admin/pool", "forged", "").Code)
+	// Logging in reads the users table; the e2e tests cover it.
+}
+
+func TestNew_InvalidAuthUsers(t *testing.T) {
+	_, err := newApp(t, config.Config{AuthUsers: "alice"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "auth.ParseAccounts")
+}
+
+func TestNew_ExposesServices(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	assert.NotNil(t, a.Trips)
+	assert.NotNil(t, a.Stops)
+	assert.NotNil(t, a.Jobs)
+}
+
+func TestNew_InvalidNotesKeys(t *testing.T) {
+	_, err := newApp(t, config.Config{
+		NotesEncryptionActiveKeyID: "k1",
+		NotesEncryptionKeys:        "not-a-key-list",
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notes encryption keys")
+}
+
+func TestNew_ObjectStorageDirCreated(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "objects")
+
+	_, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20, ObjectStorageDir: dir})
+
+	require.NoError(t, err)
+	assert.DirExists(t, dir)
+}
+
+func TestNew_OpenAPIValidationRejectsBadRequests(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20, OpenAPIValidation: true})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/trips", strings.NewReader(`{"name": 42}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	a.Handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid_request")
+}
+
+// TestApp_StartupFailsWithoutDatabase verifies that an instance that cannot
+// check its schema is not ready to take traffic.
+func TestApp_StartupFailsWithoutDatabase(t *testing.T) {
+	a, err := newApp(t, config.Config{MaxBodyBytes: 1 << 20})
+	require.NoError(t, err)
+
+	err = a.Startup(context.Background())
+
+	assert.ErrorContains(t, err, "app.App.Startup")
+}
+
+func TestIsLivenessRoute(t *testing.T) {
+	for path, want := range map[string]bool{"/livez": true, "/api/livez": true, "/readyz": false, "/trips": false} {
+		assert.Equal(t, want, app.IsLivenessRoute(httptest.NewRequest(http.MethodGet, path, nil)), path)
+	}
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// maintenanceProbe fails while a file exists at path, taking the instance
+// out of rotation without restarting it.
+func maintenanceProbe(path string) func(context.Context) error {
+	return func(context.Context) error {
+		_, err := os.Stat(path)
+		switch {
+		case err == nil:
+			return fmt.Errorf("maintenance mode: %s exists", path)
+		case errors.Is(err, fs.ErrNotExist):
+			return nil
+		default:
+			return fmt.Errorf("maintenance mode: %w", err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/config"
+)
+
+// Server-wide timeouts. They are short to shed slow clients; the routes
+// isStreamRoute matches get cfg.StreamTimeoutSeconds instead.
+const (
+	readTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// NewHTTPServer returns the HTTP server for handler, listening on cfg.Port.
+// Explicit timeouts prevent slowloris and resource exhaustion attacks.
+//
+// With cfg.HTTP2Cleartext the server also speaks HTTP/2 without TLS (h2c),
+// for a proxy that terminates TLS in front of it. Never expose h2c to the
+// internet directly: it offers no encryption.
+func NewHTTPServer(cfg config.Config, handler http.Handler) *http.Server {
+	srv := &http.Server{
+		Addr:           ":" + cfg.Port,
+		Handler:        handler,
+		ReadTimeout:    readTimeout,
+		WriteTimeout:   writeTimeout,
+		IdleTimeout:    time.Duration(cfg.IdleTimeoutSeconds) * time.Second,
+		MaxHeaderBytes: int(cfg.MaxHeaderBytes),
+	}
+	if cfg.HTTP2Cleartext {
+		var protocols http.Protocols
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		srv.Protocols = &protocols
+	}
+	srv.SetKeepAlivesEnabled(cfg.KeepAlive)
+	return srv
+}
+
+// streamRoutes are the paths that move whole files and may need longer than
+// readTimeout or writeTimeout: the CSV/JSON export, GPX track and stop photo
+// uploads and downloads, GPX imports, and rendered static maps.
+var streamRoutes = []string{
+	"/export",
+	"/trips/*/legs/*/track",
+	"/trips/*/import/gpx",
+	"/trips/*/stops/*/photos",
+	"/trips/*/stops/*/photos/*",
+	"/trips/*/map.png",
+}
+
+// isStreamRoute reports whether r is for one of streamRoutes, with or
+// without the /api prefix the web UI uses.
+func isStreamRoute(r *http.Request) bool {
+	p := strings.TrimPrefix(r.URL.Path, "/api")
+	for _, pattern := range streamRoutes {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// publicRoutes are the paths served without a bearer token: the health
+// probes, which orchestrators call unauthenticated; share links, which carry
+// their own token; and the endpoints that issue tokens.
+var publicRoutes = []string{
+	"/healthz",
+	"/livez",
+	"/readyz",
+	"/auth/token",
+	"/auth/refresh",
+	"/shared/*",
+	"/shared/*/embed",
+}
+
+// isPublicRoute reports whether r is for one of publicRoutes, with or
+// without the /api prefix the web UI uses.
+func isPublicRoute(r *http.Request) bool {
+	p := strings.TrimPrefix(r.URL.Path, "/api")
+	for _, pattern := range publicRoutes {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// embedRoutes are the paths other sites may frame and fetch from any
+// origin: the trip summary behind a share link, for a personal blog.
+var embedRoutes = []string{
+	"/shared/*/embed",
+}
+
+// isEmbedRoute reports whether r is for one of embedRoutes, with or without
+// the /api prefix the web UI uses.
+func isEmbedRoute(r *http.Request) bool {
+	p := strings.TrimPrefix(r.URL.Path, "/api")
+	for _, pattern := range embedRoutes {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLivenessRoute reports whether r is for GET /livez, with or without the
+// /api prefix: the one route a closed middleware.StartupGate lets through.
+func IsLivenessRoute(r *http.Request) bool {
+	return strings.TrimPrefix(r.URL.Path, "/api") == "/livez"
+}
@@ -0,0 +1,80 @@
+package app_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/app"
+	"github.com/pkordes/rv-logbook/backend/internal/config"
+)
+
+var protoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte(r.Proto))
+})
+
+// serve starts srv on a local port and returns its base URL.
+func serve(t *testing.T, srv *http.Server) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(func() { _ = srv.Shutdown(context.Background()) })
+	return "http://" + ln.Addr().String()
+}
+
+// h2cClient speaks only HTTP/2 without TLS.
+func h2cClient() *http.Client {
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+	return &http.Client{Transport: &http.Transport{Protocols: &protocols}, Timeout: 5 * time.Second}
+}
+
+func TestNewHTTPServer_Settings(t *testing.T) {
+	srv := app.NewHTTPServer(config.Config{
+		Port: "9090", MaxHeaderBytes: 64 << 10, IdleTimeoutSeconds: 620, KeepAlive: true,
+	}, protoHandler)
+
+	assert.Equal(t, ":9090", srv.Addr)
+	assert.Equal(t, 64<<10, srv.MaxHeaderBytes)
+	assert.Equal(t, 620*time.Second, srv.IdleTimeout)
+	assert.Equal(t, 10*time.Second, srv.WriteTimeout)
+	assert.Nil(t, srv.Protocols, "HTTP/1.1 and TLS HTTP/2 only, by default")
+}
+
+func TestNewHTTPServer_H2C(t *testing.T) {
+	url := serve(t, app.NewHTTPServer(config.Config{HTTP2Cleartext: true, KeepAlive: true}, protoHandler))
+
+	resp, err := h2cClient().Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 2, resp.ProtoMajor)
+
+	resp, err = http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 1, resp.ProtoMajor, "HTTP/1.1 still works")
+}
+
+func TestNewHTTPServer_NoH2CByDefault(t *testing.T) {
+	url := serve(t, app.NewHTTPServer(config.Config{KeepAlive: true}, protoHandler))
+
+	_, err := h2cClient().Get(url)
+
+	assert.Error(t, err)
+}
+
+func TestNewHTTPServer_KeepAliveOff(t *testing.T) {
+	url := serve(t, app.NewHTTPServer(config.Config{KeepAlive: false}, protoHandler))
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.True(t, resp.Close, "the server asks to close the connection")
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// ActorType distinguishes how a request was authenticated.
+type ActorType string
+
+const (
+	// ActorUser is a person authenticated with a JWT bearer token.
+	ActorUser ActorType = "user"
+	// ActorAPIKey is an automation client authenticated with an X-API-Key header.
+	ActorAPIKey ActorType = "api_key"
+)
+
+// Actor identifies who is making a request. It is attached to the request
+// context by the authentication middleware and read by loggers, services,
+// and audit code.
+type Actor struct {
+	Type ActorType
+	// ID is the user ID or API key ID — never the credential itself.
+	ID string
+	// UserID is the user the actor acts for: the user themself, or the user
+	// who created the API key.
+	UserID string
+}
+
+// actorSlot is a mutable holder for the request's Actor.
+//
+// The request logger sits outside the auth middleware in the chain, so it
+// only ever sees the context as it was *before* authentication ran. Placing an
+// empty slot in the context first lets the auth middleware fill it in later,
+// and the logger reads the filled slot once the handler returns.
+type actorSlot struct {
+	actor atomic.Pointer[Actor]
+}
+
+type actorKey struct{}
+
+// WithActorSlot returns a child context carrying an empty actor slot.
+// Call it as early in the middleware chain as possible (the request logger
+// does this) so that outer middleware can observe the authenticated actor.
+func WithActorSlot(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(actorKey{}).(*actorSlot); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, actorKey{}, &actorSlot{})
+}
+
+// WithActor records a as the authenticated actor for this request.
+// If an actor slot already exists in ctx it is filled in place, making the
+// actor visible to outer middleware; otherwise a new slot is created.
+func WithActor(ctx context.Context, a Actor) context.Context {
+	ctx = WithActorSlot(ctx)
+	slot := ctx.Value(actorKey{}).(*actorSlot)
+	slot.actor.Store(&a)
+	return ctx
+}
+
+// ActorFromContext returns the authenticated actor, if any.
+// The boolean is false for anonymous requests (e.g. /healthz).
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	slot, ok := ctx.Value(actorKey{}).(*actorSlot)
+	if !ok {
+		return Actor{}, false
+	}
+	a := slot.actor.Load()
+	if a == nil {
+		return Actor{}, false
+	}
+	return *a, true
+}
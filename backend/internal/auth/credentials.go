@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseAccounts parses the AUTH_USERS format: a comma-separated list of
+// username:password pairs, e.g. "alice:s3cret,bob:hunter2", and returns the
+// passwords by username. A password may contain colons but not commas.
+// Whitespace around entries is ignored. An empty string yields an empty map.
+func ParseAccounts(s string) (map[string]string, error) {
+	accounts := make(map[string]string)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		username, password, ok := strings.Cut(entry, ":")
+		username = strings.TrimSpace(username)
+		if !ok || username == "" || password == "" {
+			return nil, fmt.Errorf("auth.ParseAccounts: malformed entry %q (want username:password)", redact(entry))
+		}
+		if _, dup := accounts[username]; dup {
+			return nil, fmt.Errorf("auth.ParseAccounts: duplicate username %q", username)
+		}
+		accounts[username] = password
+	}
+	return accounts, nil
+}
@@ -0,0 +1,40 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+)
+
+func TestParseAccounts(t *testing.T) {
+	accounts, err := auth.ParseAccounts(" alice:s3cret , bob:pass:with:colons ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"alice": "s3cret",
+		"bob":   "pass:with:colons", // only the first colon separates the username
+	}, accounts)
+}
+
+func TestParseAccounts_Empty(t *testing.T) {
+	accounts, err := auth.ParseAccounts("")
+	require.NoError(t, err)
+	assert.Empty(t, accounts)
+}
+
+func TestParseAccounts_Invalid(t *testing.T) {
+	for _, s := range []string{"alice", "alice:", ":s3cret", "alice:a,alice:b"} {
+		_, err := auth.ParseAccounts(s)
+		assert.Error(t, err, s)
+	}
+}
+
+// TestParseAccounts_RedactsPasswords ensures a malformed entry never puts a
+// password into the error, which ends up in the startup log.
+func TestParseAccounts_RedactsPasswords(t *testing.T) {
+	_, err := auth.ParseAccounts("alice:s3cret,bob:hunter2,:topsecret")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "topsecret")
+}
@@ -0,0 +1,226 @@
+// Package auth contains the token primitives shared by the authentication
+// middleware and the auth service: signing keys, token signing, and token
+// verification, plus password hashing and the configured login accounts. It
+// has no knowledge of HTTP or the database.
+package auth
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// MinKeyBytes is the minimum length of an HMAC signing secret.
+// RFC 7518 §3.2 requires HS256 keys to be at least as long as the hash output.
+const MinKeyBytes = 32
+
+// ErrInvalidToken is returned by KeySet.Parse when a token is malformed,
+// expired, signed with an unknown key, or fails signature verification.
+// Callers should map it to HTTP 401 without exposing the underlying reason.
+var ErrInvalidToken = errors.New("invalid token")
+
+// KeySet holds every HMAC key that is currently trusted for verification,
+// indexed by key ID ("kid"), plus the single key used for signing new tokens.
+//
+// Rotation works by adding a new key, switching the active kid to it, and
+// removing the old key only once every token it signed has expired. Tokens
+// carry their kid in the JWT header, so old and new tokens verify side by side.
+type KeySet struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// NewKeySet builds a KeySet from a map of kid → secret.
+// Returns an error if the set is empty, the active kid is not present, or any
+// secret is shorter than MinKeyBytes.
+func NewKeySet(activeID string, keys map[string][]byte) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("auth.NewKeySet: no signing keys configured")
+	}
+	if _, ok := keys[activeID]; !ok {
+		return nil, fmt.Errorf("auth.NewKeySet: active key %q is not in the key set", activeID)
+	}
+
+	copied := make(map[string][]byte, len(keys))
+	for kid, secret := range keys {
+		if strings.TrimSpace(kid) == "" {
+			return nil, errors.New("auth.NewKeySet: key id must not be empty")
+		}
+		if len(secret) < MinKeyBytes {
+			return nil, fmt.Errorf("auth.NewKeySet: key %q is shorter than %d bytes", kid, MinKeyBytes)
+		}
+		copied[kid] = append([]byte(nil), secret...)
+	}
+	return &KeySet{activeID: activeID, keys: copied}, nil
+}
+
+// NewEphemeralKeySet returns a KeySet holding a single random key that exists
+// only for the life of the process. Intended for local development and tests
+// when no keys are configured: every token it signs becomes invalid on restart.
+func NewEphemeralKeySet() (*KeySet, error) {
+	secret := make([]byte, MinKeyBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("auth.NewEphemeralKeySet: %w", err)
+	}
+	return NewKeySet(ephemeralKeyID, map[string][]byte{ephemeralKeyID: secret})
+}
+
+// ephemeralKeyID is the kid of the key generated by NewEphemeralKeySet.
+const ephemeralKeyID = "ephemeral"
+
+// ActiveID returns the kid used to sign new tokens.
+func (k *KeySet) ActiveID() string {
+	return k.activeID
+}
+
+// IDs returns every trusted kid in sorted order. Useful for startup logging;
+// never log the secrets themselves.
+func (k *KeySet) IDs() []string {
+	ids := make([]string, 0, len(k.keys))
+	for kid := range k.keys {
+		ids = append(ids, kid)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Sign serialises claims as an HS256 JWT signed with the active key.
+// The active kid is written to the token header so Parse can select the
+// matching key after a rotation.
+func (k *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = k.activeID
+
+	signed, err := token.SignedString(k.keys[k.activeID])
+	if err != nil {
+		return "", fmt.Errorf("auth.KeySet.Sign: %w", err)
+	}
+	return signed, nil
+}
+
+// Parse verifies tokenString against the key named by its kid header and
+// decodes the payload into claims. Only HS256 is accepted — pinning the
+// algorithm prevents "alg: none" and algorithm-confusion attacks.
+// Returns ErrInvalidToken on any verification failure.
+func (k *KeySet) Parse(tokenString string, claims jwt.Claims) error {
+	_, err := jwt.ParseWithClaims(tokenString, claims, k.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return nil
+}
+
+// keyFunc resolves the verification key from the token's kid header.
+// Tokens without a kid, or with a kid that has been retired, are rejected.
+func (k *KeySet) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("token has no kid header")
+	}
+	secret, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return secret, nil
+}
+
+// ParseKeyList parses the inline key format used by JWT_SIGNING_KEYS:
+// a comma-separated list of kid:secret pairs, e.g. "2025-01:abc…,2025-06:def…".
+// Whitespace around entries is ignored.
+func ParseKeyList(s string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, secret, ok := strings.Cut(entry, ":")
+		if !ok || strings.TrimSpace(kid) == "" || secret == "" {
+			return nil, fmt.Errorf("auth.ParseKeyList: malformed entry %q (want kid:secret)", redact(entry))
+		}
+		kid = strings.TrimSpace(kid)
+		if _, dup := keys[kid]; dup {
+			return nil, fmt.Errorf("auth.ParseKeyList: duplicate key id %q", kid)
+		}
+		keys[kid] = []byte(secret)
+	}
+	return keys, nil
+}
+
+// LoadKeyDir reads one key per regular file in dir. The file name is the kid
+// and the file content (trailing whitespace trimmed) is the secret. This layout
+// matches how Kubernetes and Docker mount secrets as files, so a rotation is
+// just a new file plus a change to JWT_ACTIVE_KEY_ID.
+// Hidden files (names starting with ".") are skipped.
+func LoadKeyDir(dir string) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("auth.LoadKeyDir: %w", err)
+	}
+
+	keys := make(map[string][]byte)
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(dir, e.Name())) // #nosec G304 -- dir is operator-supplied config
+		if err != nil {
+			return nil, fmt.Errorf("auth.LoadKeyDir: %w", err)
+		}
+		keys[e.Name()] = []byte(strings.TrimRight(string(b), " \t\r\n"))
+	}
+	return keys, nil
+}
+
+// MergeKeys combines key maps in order. A kid present in more than one map is
+// an error rather than a silent override, so a typo cannot shadow a key.
+func MergeKeys(sets ...map[string][]byte) (map[string][]byte, error) {
+	out := make(map[string][]byte)
+	for _, set := range sets {
+		for kid, secret := range set {
+			if _, dup := out[kid]; dup {
+				return nil, fmt.Errorf("auth.MergeKeys: key id %q is defined more than once", kid)
+			}
+			out[kid] = secret
+		}
+	}
+	return out, nil
+}
+
+// LoadKeySet builds a KeySet from the two supported key sources: the inline
+// JWT_SIGNING_KEYS list and the JWT_KEYS_DIR directory. Either may be empty.
+// This is the single entry point main.go uses at startup.
+func LoadKeySet(activeID, inline, dir string) (*KeySet, error) {
+	inlineKeys, err := ParseKeyList(inline)
+	if err != nil {
+		return nil, err
+	}
+	var dirKeys map[string][]byte
+	if dir != "" {
+		if dirKeys, err = LoadKeyDir(dir); err != nil {
+			return nil, err
+		}
+	}
+	keys, err := MergeKeys(inlineKeys, dirKeys)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySet(activeID, keys)
+}
+
+// redact keeps the kid of a malformed key entry but hides any secret material.
+func redact(entry string) string {
+	if kid, _, ok := strings.Cut(entry, ":"); ok {
+		return kid + ":***"
+	}
+	return "***"
+}
@@ -0,0 +1,77 @@
+package auth_test
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+)
+
+// FuzzParseKeyList checks JWT_SIGNING_KEYS parsing on arbitrary input:
+//   - it never panics;
+//   - error messages never echo a secret (they end up in startup logs);
+//   - a successful parse round-trips: re-serialising the map and parsing it
+//     again yields the same keys.
+//
+// Run with: go test ./internal/auth -run '^$' -fuzz FuzzParseKeyList
+func FuzzParseKeyList(f *testing.F) {
+	f.Add("2025-01:old-secret,2025-06:new-secret")
+	f.Add(" k1 : spaced secret , ,k2:x:y:z")
+	f.Add("no-colon-supersecret")
+	f.Add("k1:dup-secret-1,k1:dup-secret-2")
+	f.Add(":orphan-secret-value")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, in string) {
+		keys, err := auth.ParseKeyList(in)
+		if err != nil {
+			assertNoSecretInError(t, in, err)
+			return
+		}
+
+		var b strings.Builder
+		for kid, secret := range keys {
+			if kid == "" || kid != strings.TrimSpace(kid) || len(secret) == 0 {
+				t.Fatalf("ParseKeyList(%q) accepted kid %q with %d-byte secret", in, kid, len(secret))
+			}
+			if b.Len() > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(kid + ":" + string(secret))
+		}
+		again, err := auth.ParseKeyList(b.String())
+		if err != nil {
+			t.Fatalf("re-parse of %q failed: %v", b.String(), err)
+		}
+		if !maps.EqualFunc(keys, again, func(a, b []byte) bool { return string(a) == string(b) }) {
+			t.Fatalf("round trip changed keys: %q → %q", in, b.String())
+		}
+	})
+}
+
+// assertNoSecretInError fails t if err's message contains the secret half of
+// any kid:secret entry in in. Short secrets and secrets that also appear in a
+// key ID (which errors may legitimately name) are ignored to avoid false hits.
+func assertNoSecretInError(t *testing.T, in string, err error) {
+	t.Helper()
+	var kids, secrets []string
+	for _, entry := range strings.Split(in, ",") {
+		if kid, secret, ok := strings.Cut(strings.TrimSpace(entry), ":"); ok {
+			kids = append(kids, kid)
+			secrets = append(secrets, secret)
+		} else {
+			secrets = append(secrets, entry)
+		}
+	}
+	msg := err.Error()
+	for _, s := range secrets {
+		s = strings.TrimSpace(s)
+		if len(s) < 8 || strings.Contains(strings.Join(kids, ","), s) {
+			continue
+		}
+		if strings.Contains(msg, s) {
+			t.Fatalf("ParseKeyList(%q) error leaks secret %q: %v", in, s, err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package auth_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+)
+
+// secret returns a deterministic secret of MinKeyBytes length for key kid.
+func secret(kid string) []byte {
+	return []byte(strings.Repeat(kid[:1], auth.MinKeyBytes))
+}
+
+func claims() *jwt.RegisteredClaims {
+	return &jwt.RegisteredClaims{
+		Subject:   "user-1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestKeySet_SignAndParse_RoundTrip(t *testing.T) {
+	ks, err := auth.NewKeySet("a", map[string][]byte{"a": secret("a")})
+	require.NoError(t, err)
+
+	token, err := ks.Sign(claims())
+	require.NoError(t, err)
+
+	var got jwt.RegisteredClaims
+	require.NoError(t, ks.Parse(token, &got))
+	assert.Equal(t, "user-1", got.Subject)
+}
+
+// TestKeySet_Rotation_OldTokensStillVerify is the core rotation guarantee:
+// a token signed before the active key changed keeps verifying as long as the
+// old key stays in the set.
+func TestKeySet_Rotation_OldTokensStillVerify(t *testing.T) {
+	before, err := auth.NewKeySet("a", map[string][]byte{"a": secret("a")})
+	require.NoError(t, err)
+	oldToken, err := before.Sign(claims())
+	require.NoError(t, err)
+
+	after, err := auth.NewKeySet("b", map[string][]byte{"a": secret("a"), "b": secret("b")})
+	require.NoError(t, err)
+	newToken, err := after.Sign(claims())
+	require.NoError(t, err)
+
+	require.NoError(t, after.Parse(oldToken, &jwt.RegisteredClaims{}), "old token must verify after rotation")
+	require.NoError(t, after.Parse(newToken, &jwt.RegisteredClaims{}))
+
+	// The new token carries the new kid.
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &jwt.RegisteredClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "b", parsed.Header["kid"])
+}
+
+func TestKeySet_Parse_RetiredKeyRejected(t *testing.T) {
+	old, err := auth.NewKeySet("a", map[string][]byte{"a": secret("a")})
+	require.NoError(t, err)
+	token, err := old.Sign(claims())
+	require.NoError(t, err)
+
+	retired, err := auth.NewKeySet("b", map[string][]byte{"b": secret("b")})
+	require.NoError(t, err)
+
+	err = retired.Parse(token, &jwt.RegisteredClaims{})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestKeySet_Parse_ExpiredRejected(t *testing.T) {
+	ks, err := auth.NewKeySet("a", map[string][]byte{"a": secret("a")})
+	require.NoError(t, err)
+	token, err := ks.Sign(&jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, ks.Parse(token, &jwt.RegisteredClaims{}), auth.ErrInvalidToken)
+}
+
+func TestKeySet_Parse_NoneAlgorithmRejected(t *testing.T) {
+	ks, err := auth.NewKeySet("a", map[string][]byte{"a": secret("a")})
+	require.NoError(t, err)
+
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodNone, claims())
+	unsigned.Header["kid"] = "a"
+	token, err := unsigned.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, ks.Parse(token, &jwt.RegisteredClaims{}), auth.ErrInvalidToken)
+}
+
+// TestNewEphemeralKeySet_Unique verifies each ephemeral set has its own key,
+// so tokens from a previous process cannot verify after a restart.
+func TestNewEphemeralKeySet_Unique(t *testing.T) {
+	first, err := auth.NewEphemeralKeySet()
+	require.NoError(t, err)
+	second, err := auth.NewEphemeralKeySet()
+	require.NoError(t, err)
+
+	token, err := first.Sign(claims())
+	require.NoError(t, err)
+	require.NoError(t, first.Parse(token, &jwt.RegisteredClaims{}))
+	assert.ErrorIs(t, second.Parse(token, &jwt.RegisteredClaims{}), auth.ErrInvalidToken)
+}
+
+func TestNewKeySet_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		active string
+		keys   map[string][]byte
+	}{
+		{"empty set", "a", map[string][]byte{}},
+		{"active missing", "z", map[string][]byte{"a": secret("a")}},
+		{"short secret", "a", map[string][]byte{"a": []byte("too-short")}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := auth.NewKeySet(tc.active, tc.keys)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseKeyList(t *testing.T) {
+	keys, err := auth.ParseKeyList(" k1:secret-one , k2:secret:with:colons ")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret-one"), keys["k1"])
+	assert.Equal(t, []byte("secret:with:colons"), keys["k2"])
+
+	_, err = auth.ParseKeyList("no-separator")
+	assert.Error(t, err)
+
+	_, err = auth.ParseKeyList("k1:a,k1:b")
+	assert.Error(t, err, "duplicate kid must be rejected")
+}
+
+func TestLoadKeySet_DirAndInline(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file-key"), append(secret("f"), '\n'), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("ignored"), 0o600))
+
+	inline := "inline-key:" + string(secret("i"))
+	ks, err := auth.LoadKeySet("file-key", inline, dir)
+
+	require.NoError(t, err)
+	assert.Equal(t, "file-key", ks.ActiveID())
+	assert.Equal(t, []string{"file-key", "inline-key"}, ks.IDs())
+}
+
+func TestLoadKeySet_DuplicateAcrossSources(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "k"), secret("k"), 0o600))
+
+	_, err := auth.LoadKeySet("k", "k:"+string(secret("k")), dir)
+	assert.Error(t, err)
+}
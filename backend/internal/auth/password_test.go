@@ -0,0 +1,44 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+)
+
+func TestHashPassword_RoundTrip(t *testing.T) {
+	hash, err := auth.HashPassword("s3cret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "pbkdf2-sha256$"), hash)
+	assert.NotContains(t, hash, "s3cret")
+
+	assert.True(t, auth.CheckPassword(hash, "s3cret"))
+	assert.False(t, auth.CheckPassword(hash, "S3cret"))
+	assert.False(t, auth.CheckPassword(hash, ""))
+}
+
+func TestHashPassword_Salted(t *testing.T) {
+	a, err := auth.HashPassword("s3cret")
+	require.NoError(t, err)
+	b, err := auth.HashPassword("s3cret")
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b, "each hash gets its own salt")
+}
+
+func TestCheckPassword_Malformed(t *testing.T) {
+	for _, hash := range []string{
+		"",
+		"s3cret",
+		"bcrypt$10$c2FsdA$a2V5",
+		"pbkdf2-sha256$x$c2FsdA$a2V5",
+		"pbkdf2-sha256$0$c2FsdA$a2V5",
+		"pbkdf2-sha256$1$!!$a2V5",
+		"pbkdf2-sha256$1$c2FsdA$",
+	} {
+		assert.False(t, auth.CheckPassword(hash, "s3cret"), hash)
+	}
+}
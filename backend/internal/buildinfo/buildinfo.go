@@ -0,0 +1,49 @@
+// Package buildinfo reports which build of the API is running.
+package buildinfo
+
+import "runtime/debug"
+
+// Version is set at link time by `make backend/build`:
+//
+//	go build -ldflags "-X github.com/pkordes/rv-logbook/backend/internal/buildinfo.Version=v1.2.0"
+//
+// It is empty for a plain `go build` or `go run`.
+var Version string
+
+// String returns Version when it was set. Otherwise it returns the commit
+// the Go toolchain stamped into the binary, shortened to 12 characters and
+// suffixed "+dirty" when the working tree had uncommitted changes, or "dev"
+// when there is none (go run, go test).
+func String() string {
+	if Version != "" {
+		return Version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	return fromSettings(info.Settings)
+}
+
+func fromSettings(settings []debug.BuildSetting) string {
+	var revision string
+	var dirty bool
+	for _, s := range settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			dirty = s.Value == "true"
+		}
+	}
+	if revision == "" {
+		return "dev"
+	}
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+	if dirty {
+		revision += "+dirty"
+	}
+	return revision
+}
@@ -0,0 +1,26 @@
+package buildinfo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/buildinfo"
+)
+
+func TestString_PrefersLinkedVersion(t *testing.T) {
+	old := buildinfo.Version
+	t.Cleanup(func() { buildinfo.Version = old })
+	buildinfo.Version = "v1.2.0"
+
+	assert.Equal(t, "v1.2.0", buildinfo.String())
+}
+
+func TestString_FallsBack(t *testing.T) {
+	old := buildinfo.Version
+	t.Cleanup(func() { buildinfo.Version = old })
+	buildinfo.Version = ""
+
+	// Test binaries carry no VCS stamp.
+	assert.Equal(t, "dev", buildinfo.String())
+}
@@ -0,0 +1,175 @@
+// Package client is a typed Go client for the RV Logbook API. It speaks the
+// same wire types the server generates from openapi.yaml (handler/gen), so a
+// spec change that breaks a caller fails to compile rather than at runtime.
+//
+// rvctl is built on it; anything else that drives the API from Go should be
+// too, rather than growing its own request plumbing.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// maxPageLimit is the largest page the list endpoints serve.
+const maxPageLimit = 100
+
+// Client talks to one RV Logbook API server.
+type Client struct {
+	baseURL string
+	http    *http.Client
+	token   string
+}
+
+// New returns a Client for the server at baseURL (for example
+// "http://localhost:8080"). A nil httpClient uses http.DefaultClient.
+func New(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{baseURL: strings.TrimRight(baseURL, "/"), http: httpClient}
+}
+
+// Error is a non-2xx answer from the API, carrying the error envelope's code
+// and message when the server sent one.
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("api: %d %s", e.Status, http.StatusText(e.Status))
+	}
+	return fmt.Sprintf("api: %d %s: %s", e.Status, e.Code, e.Message)
+}
+
+// Login exchanges a username and password for a token pair (POST
+// /auth/token) and sends the access token on every later request.
+func (c *Client) Login(ctx context.Context, username, password string) error {
+	var tokens gen.TokenResponse
+	if err := c.do(ctx, http.MethodPost, "/auth/token", gen.CreateTokenRequest{Username: username, Password: password}, &tokens); err != nil {
+		return fmt.Errorf("client.Login: %w", err)
+	}
+	c.token = tokens.AccessToken
+	return nil
+}
+
+// ListTrips returns one page of trips, newest first. page is 1-indexed.
+func (c *Client) ListTrips(ctx context.Context, page, limit int) (gen.TripList, error) {
+	q := url.Values{"page": {strconv.Itoa(page)}, "limit": {strconv.Itoa(limit)}}
+	var list gen.TripList
+	if err := c.do(ctx, http.MethodGet, "/trips?"+q.Encode(), nil, &list); err != nil {
+		return gen.TripList{}, fmt.Errorf("client.ListTrips: %w", err)
+	}
+	return list, nil
+}
+
+// AllTrips pages through every trip, newest first.
+func (c *Client) AllTrips(ctx context.Context) ([]gen.Trip, error) {
+	trips := []gen.Trip{}
+	for page := 1; ; page++ {
+		list, err := c.ListTrips(ctx, page, maxPageLimit)
+		if err != nil {
+			return nil, err
+		}
+		trips = append(trips, list.Data...)
+		if len(list.Data) == 0 || len(trips) >= list.Pagination.Total {
+			return trips, nil
+		}
+	}
+}
+
+// Export streams the flat export (GET /export) to w in the given format,
+// "json" or "csv", without holding it in memory.
+func (c *Client) Export(ctx context.Context, format string, w io.Writer) error {
+	resp, err := c.send(ctx, http.MethodGet, "/export?"+url.Values{"format": {format}}.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("client.Export: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("client.Export: %w", err)
+	}
+	return nil
+}
+
+// MergeTag folds the tag fromSlug into intoSlug and returns the surviving tag.
+func (c *Client) MergeTag(ctx context.Context, fromSlug, intoSlug string) (gen.Tag, error) {
+	var tag gen.Tag
+	path := "/tags/" + url.PathEscape(fromSlug) + "/merge"
+	if err := c.do(ctx, http.MethodPost, path, gen.MergeTagRequest{Into: intoSlug}, &tag); err != nil {
+		return gen.Tag{}, fmt.Errorf("client.MergeTag: %w", err)
+	}
+	return tag, nil
+}
+
+// do sends a request with an optional JSON body and decodes a JSON answer
+// into out.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	resp, err := c.send(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+// send issues the request and returns the response when its status is 2xx.
+// Any other status is returned as an *Error and the body is closed.
+func (c *Client) send(ctx context.Context, method, path string, body any) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s %s: %w", method, path, err)
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	apiErr := &Error{Status: resp.StatusCode}
+	var envelope gen.ErrorResponse
+	if json.NewDecoder(resp.Body).Decode(&envelope) == nil {
+		apiErr.Code = envelope.Error.Code
+		apiErr.Message = envelope.Error.Message
+	}
+	return nil, apiErr
+}
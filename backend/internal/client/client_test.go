@@ -0,0 +1,112 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/client"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+func TestAllTrips_PagesUntilTotal(t *testing.T) {
+	const total = 150
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		n := 100
+		if r.URL.Query().Get("page") == "2" {
+			n = total - 100
+		}
+		list := gen.TripList{Data: make([]gen.Trip, n), Pagination: gen.Pagination{Total: total}}
+		for i := range list.Data {
+			list.Data[i] = gen.Trip{Id: uuid.New(), Name: fmt.Sprintf("trip %d", i)}
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	}))
+	defer srv.Close()
+
+	trips, err := client.New(srv.URL, nil).AllTrips(context.Background())
+
+	require.NoError(t, err)
+	assert.Len(t, trips, total)
+	assert.Equal(t, []string{"1", "2"}, pages)
+}
+
+func TestExport_StreamsBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "csv", r.URL.Query().Get("format"))
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("trip_name,stop_name\nUtah,Moab\n"))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	err := client.New(srv.URL, nil).Export(context.Background(), "csv", &buf)
+
+	require.NoError(t, err)
+	assert.Equal(t, "trip_name,stop_name\nUtah,Moab\n", buf.String())
+}
+
+func TestMergeTag_SendsTarget(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tags/wal-mart/merge", r.URL.Path)
+		var body gen.MergeTagRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "walmart", body.Into)
+		_ = json.NewEncoder(w).Encode(gen.Tag{Id: uuid.New(), Name: "Walmart", Slug: "walmart"})
+	}))
+	defer srv.Close()
+
+	tag, err := client.New(srv.URL, nil).MergeTag(context.Background(), "wal-mart", "walmart")
+
+	require.NoError(t, err)
+	assert.Equal(t, "walmart", tag.Slug)
+}
+
+func TestClient_ErrorEnvelope(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(gen.ErrorResponse{Error: gen.ErrorDetail{Code: "not_found", Message: "tag not found"}})
+	}))
+	defer srv.Close()
+
+	_, err := client.New(srv.URL, nil).MergeTag(context.Background(), "nope", "walmart")
+
+	var apiErr *client.Error
+	require.True(t, errors.As(err, &apiErr), "want *client.Error, got %v", err)
+	assert.Equal(t, http.StatusNotFound, apiErr.Status)
+	assert.Equal(t, "not_found", apiErr.Code)
+	assert.Equal(t, "tag not found", apiErr.Message)
+}
+
+func TestLogin_SendsBearerToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/token" {
+			var body gen.CreateTokenRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, gen.CreateTokenRequest{Username: "alice", Password: "s3cret"}, body)
+			_ = json.NewEncoder(w).Encode(gen.TokenResponse{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", ExpiresIn: 900})
+			return
+		}
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(gen.Tag{Id: uuid.New(), Name: "Walmart", Slug: "walmart"})
+	}))
+	defer srv.Close()
+
+	c := client.New(srv.URL, nil)
+	require.NoError(t, c.Login(context.Background(), "alice", "s3cret"))
+	_, err := c.MergeTag(context.Background(), "wal-mart", "walmart")
+
+	require.NoError(t, err)
+}
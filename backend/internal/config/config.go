@@ -14,13 +14,35 @@ type Config struct {
 	// Port is the TCP port the HTTP server listens on. Defaults to "8080".
 	Port string
 
+	// GRPCPort is the TCP port the gRPC API listens on, alongside the HTTP
+	// server. Empty (the default) leaves gRPC off. Set GRPC_PORT to enable.
+	GRPCPort string
+
 	// DatabaseURL is the Postgres connection string. Required.
 	DatabaseURL string
 
+	// DBQueryTimeoutSeconds is how long a single query may run before it is
+	// cancelled and the request answered with 504, so one runaway query
+	// cannot hold a pooled connection for the whole request. The export,
+	// which streams under StreamTimeoutSeconds, is exempt. Defaults to 5;
+	// 0 disables it. Set DB_QUERY_TIMEOUT_SECONDS to override.
+	DBQueryTimeoutSeconds int64
+
 	// LogLevel controls the minimum log level. Defaults to "info".
 	// Valid values: debug, info, warn, error.
 	LogLevel string
 
+	// LogSampleOKPercent is the percentage of 200 responses that get a
+	// request log line, from 0 to 100; every other status is always logged.
+	// Defaults to 100. Set LOG_SAMPLE_OK_PERCENT to log fewer busy reads.
+	LogSampleOKPercent int64
+
+	// LogFailedBodies logs the request body, with secrets and notes masked,
+	// of every POST, PUT, PATCH, or DELETE that fails with a 4xx or 5xx. A
+	// debugging aid for reproducing client bugs; off by default. Set
+	// LOG_FAILED_BODIES=true to enable.
+	LogFailedBodies bool
+
 	// CORSOrigins is the list of allowed cross-origin request origins.
 	// Defaults to ["http://localhost:5173"] (Vite dev server).
 	// Set CORS_ORIGINS to a comma-separated list to override.
@@ -30,17 +52,227 @@ type Config struct {
 	// Requests with a larger body are rejected with 413 before reaching a handler.
 	// Defaults to 1 MiB. Set MAX_BODY_BYTES to override.
 	MaxBodyBytes int64
+
+	// MaxHeaderBytes caps the size of request headers, including the request
+	// line. Defaults to 1 MiB, net/http's own default. Set MAX_HEADER_BYTES
+	// to override.
+	MaxHeaderBytes int64
+
+	// HTTP2Cleartext accepts HTTP/2 without TLS (h2c) alongside HTTP/1.1,
+	// for running behind a proxy that terminates TLS and speaks HTTP/2 to
+	// the backend. Off by default. Set HTTP2_CLEARTEXT=true to enable.
+	HTTP2Cleartext bool
+
+	// KeepAlive keeps HTTP/1.1 connections open between requests. On by
+	// default; turn it off behind a proxy that does not reuse backend
+	// connections. Set HTTP_KEEP_ALIVE=false to disable.
+	KeepAlive bool
+
+	// IdleTimeoutSeconds is how long a kept-alive connection may sit idle
+	// before it is closed. Keep it above the fronting proxy's own idle
+	// timeout so the proxy never reuses a connection the server is closing.
+	// Defaults to 60. Set HTTP_IDLE_TIMEOUT_SECONDS to override.
+	IdleTimeoutSeconds int64
+
+	// StreamTimeoutSeconds replaces the 10-second read and write timeouts on
+	// the routes that move whole files — the export, GPX track uploads and
+	// downloads, and static maps. Defaults to 300. Set
+	// STREAM_TIMEOUT_SECONDS to override.
+	StreamTimeoutSeconds int64
+
+	// MaintenanceFile is a path whose existence puts the instance in
+	// maintenance mode: /readyz answers 503, so the load balancer stops
+	// sending it traffic, while /livez stays 200 and nothing restarts it.
+	// Create the file to drain an instance and remove it to bring it back.
+	// Leave empty to disable. Set MAINTENANCE_FILE to configure.
+	MaintenanceFile string
+
+	// JWTActiveKeyID is the key ID ("kid") used to sign new tokens.
+	// Must name a key present in JWTSigningKeys or JWTKeysDir.
+	JWTActiveKeyID string
+
+	// JWTSigningKeys is the inline list of HMAC signing keys in the form
+	// "kid:secret,kid:secret". Keep retired keys here until every token they
+	// signed has expired. Set JWT_SIGNING_KEYS to configure.
+	JWTSigningKeys string
+
+	// JWTKeysDir is an optional directory holding one key per file
+	// (file name = kid, content = secret), e.g. a mounted secrets volume.
+	// Set JWT_KEYS_DIR to configure.
+	JWTKeysDir string
+
+	// AuthUsers lists the accounts that can log in, as "username:password"
+	// pairs separated by commas; cmd/api saves each to the users table at
+	// startup. When set, every API request except the health probes, share
+	// links, and login itself needs a bearer token.
+	// Leave empty to leave the API open, for local development.
+	// Set AUTH_USERS to configure.
+	AuthUsers string
+
+	// AdminUsers lists the usernames, from AuthUsers, allowed to use the
+	// /admin routes: the pool statistics and the data fixes. No one may use
+	// them while it is empty, or while AuthUsers is. Set ADMIN_USERS to a
+	// comma-separated list to configure.
+	AdminUsers []string
+
+	// NotesEncryptionActiveKeyID is the key ID used to encrypt trip and stop
+	// notes on write. Leave empty to store notes in plaintext.
+	// Set NOTES_ENCRYPTION_ACTIVE_KEY_ID to enable encryption.
+	NotesEncryptionActiveKeyID string
+
+	// NotesEncryptionKeys is the list of AES-256 keys in the form
+	// "kid:base64key,kid:base64key". Keep retired keys here until every note
+	// they sealed has been rewritten. Set NOTES_ENCRYPTION_KEYS to configure.
+	NotesEncryptionKeys string
+
+	// OpenAPIValidation validates every request and response against the
+	// embedded OpenAPI spec. Invalid requests get 400; responses that drift
+	// from the spec are logged as errors. Buffers responses in memory — meant
+	// for local development. Set OPENAPI_VALIDATION=true to enable.
+	OpenAPIValidation bool
+
+	// ObjectStorageDir is the directory uploaded files (GPX tracks, stop
+	// photos) are kept in — a local disk or a mounted volume. Leave empty,
+	// and ObjectStorageS3Bucket too, to keep uploads in memory, where they
+	// are lost on restart. Set OBJECT_STORAGE_DIR to configure.
+	ObjectStorageDir string
+
+	// ObjectStorageS3Bucket keeps uploaded files in an S3 bucket instead,
+	// taking precedence over ObjectStorageDir. Requests are signed with
+	// AWSAccessKeyID and AWSSecretAccessKey, which are then required.
+	// Set OBJECT_STORAGE_S3_BUCKET to configure.
+	ObjectStorageS3Bucket string
+
+	// ObjectStorageS3Region is the bucket's region. Defaults to us-east-1.
+	// Set OBJECT_STORAGE_S3_REGION to override.
+	ObjectStorageS3Region string
+
+	// ObjectStorageS3Endpoint is the base URL of an S3-compatible service,
+	// such as MinIO, to use instead of AWS. Set OBJECT_STORAGE_S3_ENDPOINT
+	// to configure.
+	ObjectStorageS3Endpoint string
+
+	// AWSAccessKeyID, AWSSecretAccessKey, and AWSSessionToken are the
+	// credentials for the S3 bucket; the session token only accompanies
+	// temporary credentials. Set AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY,
+	// and AWS_SESSION_TOKEN to configure.
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSSessionToken    string
+
+	// MapTileURL is the tile server static trip maps are drawn on, as a URL
+	// template with {z}, {x}, and {y} placeholders. Fetched tiles are cached
+	// in object storage. Leave empty to draw maps on a plain background with
+	// no outbound requests. Set MAP_TILE_URL to configure.
+	MapTileURL string
+
+	// ElevationAPIURL is the elevation API route leg elevation profiles are
+	// looked up from; it must speak the Open-Meteo elevation format, as
+	// https://api.open-meteo.com/v1/elevation does. Leave empty to turn
+	// elevation lookups off. Set ELEVATION_API_URL to configure.
+	ElevationAPIURL string
+
+	// RoutingAPIURL is the routing API the drive check asks for the distance
+	// and driving time of legs that have none entered; it must speak the
+	// OSRM route service, as https://router.project-osrm.org does. Leave
+	// empty to turn routing lookups off. Set ROUTING_API_URL to configure.
+	RoutingAPIURL string
+
+	// GeocoderURL is the Nominatim server stop coordinates are reverse
+	// geocoded with, to place stops in a state or province for the
+	// states-visited report — for example https://nominatim.openstreetmap.org.
+	// Leave empty to turn lookups off. Set GEOCODER_URL to configure.
+	GeocoderURL string
+
+	// GeocodeCacheTTLDays is how long a geocoder's answer for a point is
+	// kept and reused before the point is looked up again. Defaults to 90.
+	// Set GEOCODE_CACHE_TTL_DAYS to override.
+	GeocodeCacheTTLDays int64
+
+	// GeofenceDwellMinutes is how long location reports (POST /locations)
+	// must stay within GeofenceRadiusMeters of one another before the rig
+	// counts as stopped. Defaults to 30. Set GEOFENCE_DWELL_MINUTES to override.
+	GeofenceDwellMinutes int64
+
+	// GeofenceRadiusMeters is how far apart reports may be and still count
+	// as the same place. Defaults to 150. Set GEOFENCE_RADIUS_METERS to override.
+	GeofenceRadiusMeters int64
+
+	// GeofenceAutoCreateStops logs a stop on the trip in progress as soon as
+	// the rig has dwelled somewhere. Off by default, when each dwell is only
+	// suggested. Set GEOFENCE_AUTO_CREATE_STOPS=true to enable.
+	GeofenceAutoCreateStops bool
+
+	// PaginationDefaultLimit and PaginationMaxLimit are the page size used
+	// when a list request gives no ?limit, and the largest one honored, for
+	// trips, stops, tags, and points of interest. Default to 20 and 100. Set
+	// PAGINATION_DEFAULT_LIMIT and PAGINATION_MAX_LIMIT to override.
+	PaginationDefaultLimit int64
+	PaginationMaxLimit     int64
+
+	// ReadingsPaginationDefaultLimit and ReadingsPaginationMaxLimit are the
+	// same for the odometer, propane, and power logs. Default to the values
+	// above. Set READINGS_PAGINATION_DEFAULT_LIMIT and
+	// READINGS_PAGINATION_MAX_LIMIT to override.
+	ReadingsPaginationDefaultLimit int64
+	ReadingsPaginationMaxLimit     int64
 }
 
 // Load reads configuration from environment variables and returns a Config.
 // Returns an error listing any required variables that are not set.
 func Load() (Config, error) {
 	cfg := Config{
-		Port:         getEnv("PORT", "8080"),
-		LogLevel:     getEnv("LOG_LEVEL", "info"),
-		CORSOrigins:  splitCSV(getEnv("CORS_ORIGINS", "http://localhost:5173")),
-		MaxBodyBytes: getEnvInt64("MAX_BODY_BYTES", 1<<20),
+		Port:               getEnv("PORT", "8080"),
+		GRPCPort:           os.Getenv("GRPC_PORT"),
+		LogLevel:           getEnv("LOG_LEVEL", "info"),
+		LogSampleOKPercent: getEnvInt64("LOG_SAMPLE_OK_PERCENT", 100),
+		LogFailedBodies:    getEnvBool("LOG_FAILED_BODIES", false),
+		CORSOrigins:        splitCSV(getEnv("CORS_ORIGINS", "http://localhost:5173")),
+		MaxBodyBytes:       getEnvInt64("MAX_BODY_BYTES", 1<<20),
+
+		MaxHeaderBytes:       getEnvInt64("MAX_HEADER_BYTES", 1<<20),
+		HTTP2Cleartext:       getEnvBool("HTTP2_CLEARTEXT", false),
+		KeepAlive:            getEnvBool("HTTP_KEEP_ALIVE", true),
+		IdleTimeoutSeconds:   getEnvInt64("HTTP_IDLE_TIMEOUT_SECONDS", 60),
+		StreamTimeoutSeconds: getEnvInt64("STREAM_TIMEOUT_SECONDS", 300),
+		MaintenanceFile:      os.Getenv("MAINTENANCE_FILE"),
+
+		JWTActiveKeyID: os.Getenv("JWT_ACTIVE_KEY_ID"),
+		JWTSigningKeys: os.Getenv("JWT_SIGNING_KEYS"),
+		JWTKeysDir:     os.Getenv("JWT_KEYS_DIR"),
+
+		AuthUsers:  os.Getenv("AUTH_USERS"),
+		AdminUsers: splitCSV(os.Getenv("ADMIN_USERS")),
+
+		NotesEncryptionActiveKeyID: os.Getenv("NOTES_ENCRYPTION_ACTIVE_KEY_ID"),
+		NotesEncryptionKeys:        os.Getenv("NOTES_ENCRYPTION_KEYS"),
+
+		OpenAPIValidation: getEnvBool("OPENAPI_VALIDATION", false),
+
+		ObjectStorageDir:        os.Getenv("OBJECT_STORAGE_DIR"),
+		ObjectStorageS3Bucket:   os.Getenv("OBJECT_STORAGE_S3_BUCKET"),
+		ObjectStorageS3Region:   getEnv("OBJECT_STORAGE_S3_REGION", "us-east-1"),
+		ObjectStorageS3Endpoint: os.Getenv("OBJECT_STORAGE_S3_ENDPOINT"),
+		AWSAccessKeyID:          os.Getenv("AWS_ACCESS_KEY_ID"),
+		AWSSecretAccessKey:      os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		AWSSessionToken:         os.Getenv("AWS_SESSION_TOKEN"),
+		MapTileURL:              os.Getenv("MAP_TILE_URL"),
+		ElevationAPIURL:         os.Getenv("ELEVATION_API_URL"),
+		RoutingAPIURL:           os.Getenv("ROUTING_API_URL"),
+		GeocoderURL:             os.Getenv("GEOCODER_URL"),
+		GeocodeCacheTTLDays:     getEnvInt64("GEOCODE_CACHE_TTL_DAYS", 90),
+
+		GeofenceDwellMinutes:    getEnvInt64("GEOFENCE_DWELL_MINUTES", 30),
+		GeofenceRadiusMeters:    getEnvInt64("GEOFENCE_RADIUS_METERS", 150),
+		GeofenceAutoCreateStops: getEnvBool("GEOFENCE_AUTO_CREATE_STOPS", false),
+
+		PaginationDefaultLimit: getEnvInt64("PAGINATION_DEFAULT_LIMIT", 20),
+		PaginationMaxLimit:     getEnvInt64("PAGINATION_MAX_LIMIT", 100),
+
+		DBQueryTimeoutSeconds: getEnvInt64("DB_QUERY_TIMEOUT_SECONDS", 5),
 	}
+	cfg.ReadingsPaginationDefaultLimit = getEnvInt64("READINGS_PAGINATION_DEFAULT_LIMIT", cfg.PaginationDefaultLimit)
+	cfg.ReadingsPaginationMaxLimit = getEnvInt64("READINGS_PAGINATION_MAX_LIMIT", cfg.PaginationMaxLimit)
 
 	var missing []string
 
@@ -49,10 +281,26 @@ func Load() (Config, error) {
 		missing = append(missing, "DATABASE_URL")
 	}
 
+	if cfg.ObjectStorageS3Bucket != "" {
+		if cfg.AWSAccessKeyID == "" {
+			missing = append(missing, "AWS_ACCESS_KEY_ID")
+		}
+		if cfg.AWSSecretAccessKey == "" {
+			missing = append(missing, "AWS_SECRET_ACCESS_KEY")
+		}
+	}
+
 	if len(missing) > 0 {
 		return Config{}, fmt.Errorf("required environment variables not set: %s", strings.Join(missing, ", "))
 	}
 
+	if cfg.PaginationDefaultLimit < 1 || cfg.PaginationDefaultLimit > cfg.PaginationMaxLimit {
+		return Config{}, fmt.Errorf("PAGINATION_DEFAULT_LIMIT must be between 1 and PAGINATION_MAX_LIMIT")
+	}
+	if cfg.ReadingsPaginationDefaultLimit < 1 || cfg.ReadingsPaginationDefaultLimit > cfg.ReadingsPaginationMaxLimit {
+		return Config{}, fmt.Errorf("READINGS_PAGINATION_DEFAULT_LIMIT must be between 1 and READINGS_PAGINATION_MAX_LIMIT")
+	}
+
 	return cfg, nil
 }
 
@@ -89,3 +337,17 @@ func getEnvInt64(key string, fallback int64) int64 {
 	}
 	return n
 }
+
+// getEnvBool returns the env var named by key parsed as a bool ("true", "1",
+// "false", "0", ...), or fallback if the variable is not set or not valid.
+func getEnvBool(key string, fallback bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
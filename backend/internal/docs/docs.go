@@ -0,0 +1,126 @@
+// Package docs serves the interactive API reference at /docs.
+//
+// The Scalar browser bundle is embedded in the binary rather than loaded from
+// a CDN: the UI keeps working with no internet connection (common on the road),
+// and no third-party script runs on our origin. A strict Content-Security-Policy
+// limits the page to assets served by this binary.
+package docs
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ScalarVersion is the pinned @scalar/api-reference release vendored into
+// assets/. Bump it together with ScalarIntegrity in the Makefile target
+// backend/docs/vendor.
+const ScalarVersion = "1.47.0"
+
+// bundleName is the vendored Scalar standalone bundle inside assets/.
+const bundleName = "scalar-standalone.min.js"
+
+// assets holds the vendored Scalar bundle. README.md is always present so the
+// embed pattern matches even before the bundle has been vendored.
+//
+//go:embed assets
+var assets embed.FS
+
+// ContentSecurityPolicy is sent with every /docs response.
+//
+//   - script-src 'self' — only the embedded bundle may execute; no inline or CDN scripts.
+//   - style-src 'unsafe-inline' — Scalar injects component styles at runtime.
+//   - connect-src 'self' — the UI may fetch /openapi.yaml and call this API ("try it").
+//   - frame-ancestors 'none' — the docs page cannot be framed.
+const ContentSecurityPolicy = "default-src 'none'; " +
+	"script-src 'self'; " +
+	"style-src 'self' 'unsafe-inline'; " +
+	"img-src 'self' data:; " +
+	"font-src 'self' data:; " +
+	"connect-src 'self'; " +
+	"base-uri 'none'; " +
+	"form-action 'none'; " +
+	"frame-ancestors 'none'"
+
+// Routes returns a router serving:
+//
+//	GET /openapi.yaml        — the embedded OpenAPI spec
+//	GET /docs                — the Scalar HTML shell
+//	GET /docs/assets/{file}  — the vendored Scalar bundle
+//
+// Mount it at "/" alongside the API router.
+func Routes(openAPISpec []byte) http.Handler {
+	static, _ := fs.Sub(assets, "assets") // cannot fail: "assets" is a literal embedded directory
+
+	r := chi.NewRouter()
+	r.Get("/openapi.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(openAPISpec) //nolint:errcheck
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(withCSP)
+		r.Get("/docs", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			if _, err := fs.Stat(static, bundleName); err != nil {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(missingBundleHTML)) //nolint:errcheck
+				return
+			}
+			w.Write([]byte(scalarHTML)) //nolint:errcheck
+		})
+		r.Handle("/docs/assets/*", http.StripPrefix("/docs/assets/", withCacheHeaders(http.FileServerFS(static))))
+	})
+	return r
+}
+
+// withCSP applies ContentSecurityPolicy to the wrapped handler's responses.
+func withCSP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy", ContentSecurityPolicy)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withCacheHeaders lets browsers cache the bundle for a day. The bundle only
+// changes when the binary is rebuilt, so a long max-age is safe.
+func withCacheHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// scalarHTML is the Scalar API browser shell. The config script tag carries
+// no code — Scalar reads its data attributes — so it is allowed under
+// script-src 'self'. withDefaultFonts is disabled because the default fonts
+// are fetched from Scalar's CDN, which the CSP (correctly) blocks.
+const scalarHTML = `<!doctype html>
+<html>
+  <head>
+    <title>RV Logbook API Docs</title>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1" />
+  </head>
+  <body>
+    <script
+      id="api-reference"
+      data-url="/openapi.yaml"
+      data-configuration='{"withDefaultFonts":false}'></script>
+    <script src="/docs/assets/` + bundleName + `"></script>
+  </body>
+</html>`
+
+// missingBundleHTML is served when the binary was built without the vendored
+// bundle, so the failure is self-explanatory instead of a blank page.
+const missingBundleHTML = `<!doctype html>
+<html>
+  <head><title>RV Logbook API Docs</title><meta charset="utf-8" /></head>
+  <body>
+    <h1>API docs unavailable</h1>
+    <p>The Scalar bundle was not vendored into this build.
+    Run <code>make backend/docs/vendor</code> and rebuild.
+    The raw spec is still available at <a href="/openapi.yaml">/openapi.yaml</a>.</p>
+  </body>
+</html>`
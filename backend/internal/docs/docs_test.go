@@ -0,0 +1,67 @@
+package docs_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/docs"
+)
+
+func serve(t *testing.T, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	docs.Routes([]byte("openapi: 3.0.0\n")).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+// TestDocs_SetsStrictCSP verifies that the docs page is served with the CSP
+// whether or not the bundle has been vendored into this build.
+func TestDocs_SetsStrictCSP(t *testing.T) {
+	rec := serve(t, "/docs")
+
+	assert.Contains(t, []int{http.StatusOK, http.StatusServiceUnavailable}, rec.Code)
+	assert.Equal(t, docs.ContentSecurityPolicy, rec.Header().Get("Content-Security-Policy"))
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+}
+
+// TestDocs_NoExternalOrigins verifies the HTML shell references only
+// same-origin resources — the point of self-hosting.
+func TestDocs_NoExternalOrigins(t *testing.T) {
+	body := serve(t, "/docs").Body.String()
+
+	assert.NotContains(t, body, "https://")
+	assert.NotContains(t, body, "http://")
+}
+
+// TestDocs_CSPForbidsRemoteScripts guards the policy itself against an
+// accidental loosening (e.g. adding a CDN host to script-src).
+func TestDocs_CSPForbidsRemoteScripts(t *testing.T) {
+	for _, directive := range strings.Split(docs.ContentSecurityPolicy, ";") {
+		directive = strings.TrimSpace(directive)
+		if strings.HasPrefix(directive, "script-src") {
+			assert.Equal(t, "script-src 'self'", directive)
+			return
+		}
+	}
+	t.Fatal("CSP has no script-src directive")
+}
+
+func TestDocs_ServesSpec(t *testing.T) {
+	rec := serve(t, "/openapi.yaml")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/yaml", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "openapi: 3.0.0\n", rec.Body.String())
+}
+
+func TestDocs_AssetsAreCacheable(t *testing.T) {
+	rec := serve(t, "/docs/assets/README.md")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=86400", rec.Header().Get("Cache-Control"))
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey lets an automation client, such as a script on the RV's Raspberry
+// Pi, authenticate without logging in. A request made with the key acts as
+// the user who created it.
+type APIKey struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Name   string
+
+	// Prefix is the start of the key, kept so its owner can tell keys apart.
+	Prefix string
+
+	// KeyHash is the hex SHA-256 of the key; the key itself is never stored.
+	KeyHash string
+
+	// Key is the key itself. It is only populated on the APIKey returned
+	// from creation.
+	Key string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// Tokens is the pair issued when a user logs in or refreshes. The access
+// token authenticates API requests for ExpiresIn; the refresh token is only
+// good for getting a new pair, and lives much longer.
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+}
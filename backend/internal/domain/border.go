@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CrossingDirection says whether a border crossing entered or left Country.
+type CrossingDirection string
+
+const (
+	CrossingEntry CrossingDirection = "entry"
+	CrossingExit  CrossingDirection = "exit"
+)
+
+// Valid reports whether d is a known direction.
+func (d CrossingDirection) Valid() bool {
+	return d == CrossingEntry || d == CrossingExit
+}
+
+// BorderCrossing is one international border crossing on a trip.
+// CrossedOn is a calendar date (midnight UTC). Country is the ISO 3166-1
+// alpha-2 code of the country entered or left, and Documents lists what was
+// shown at the port of entry; it is always an initialised (non-nil) slice.
+type BorderCrossing struct {
+	ID          uuid.UUID
+	TripID      uuid.UUID
+	CrossedOn   time.Time
+	PortOfEntry string
+	Country     string
+	Direction   CrossingDirection
+	Documents   []string
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// BorderCrossingReportRow is a crossing in the year-end report, with the
+// name of the trip it was made on.
+type BorderCrossingReportRow struct {
+	Crossing BorderCrossing
+	TripName string
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "github.com/google/uuid"
+
+// BudgetReport compares what a trip has cost with its budget. Amounts are
+// in cents. RemainingCents is BudgetCents less SpentCents, negative once
+// the trip is over budget; both are nil for a trip with no budget.
+type BudgetReport struct {
+	TripID         uuid.UUID
+	BudgetCents    *int64
+	SpentCents     int64
+	RemainingCents *int64
+	Categories     []CategorySpend
+}
+
+// OverBudget reports whether the trip has spent more than its budget.
+func (r BudgetReport) OverBudget() bool {
+	return r.RemainingCents != nil && *r.RemainingCents < 0
+}
+
+// CategorySpend is what a trip spent in one expense category.
+type CategorySpend struct {
+	Category   ExpenseCategory
+	SpentCents int64
+	Count      int
+}
@@ -0,0 +1,15 @@
+package domain
+
+// CachedView names a read endpoint whose responses clients may cache and
+// revalidate with If-Modified-Since. Each view is as fresh as the tables it
+// is built from.
+type CachedView string
+
+const (
+	ViewTags         CachedView = "tags"
+	ViewPropaneStats CachedView = "propane_stats"
+	ViewTripStats    CachedView = "trip_stats"
+	ViewHeatmap      CachedView = "heatmap"
+	ViewSharedTrip   CachedView = "shared_trip"
+	ViewDashboard    CachedView = "dashboard"
+)
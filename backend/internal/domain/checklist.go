@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChecklistKind says when a checklist is run.
+type ChecklistKind string
+
+const (
+	// ChecklistDeparture is run before leaving a stop (antenna down, steps up).
+	ChecklistDeparture ChecklistKind = "departure"
+	// ChecklistArrival is run after arriving (level, chock, hook up).
+	ChecklistArrival ChecklistKind = "arrival"
+)
+
+// Valid reports whether k is a known kind.
+func (k ChecklistKind) Valid() bool {
+	return k == ChecklistDeparture || k == ChecklistArrival
+}
+
+// ChecklistTemplate is a reusable, ordered list of steps.
+type ChecklistTemplate struct {
+	ID        uuid.UUID
+	Name      string
+	Kind      ChecklistKind
+	Items     []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Checklist is one run of a template at a stop. Name, Kind, and the item
+// labels are copied from the template when the run starts; TemplateID is nil
+// once the template has been deleted.
+type Checklist struct {
+	ID         uuid.UUID
+	StopID     uuid.UUID
+	TemplateID *uuid.UUID
+	Name       string
+	Kind       ChecklistKind
+	Items      []ChecklistItem
+	CreatedAt  time.Time
+}
+
+// Complete reports whether every item has been checked off.
+func (c Checklist) Complete() bool {
+	for _, item := range c.Items {
+		if item.CheckedAt == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ChecklistItem is one step of a checklist run. Position is 1-based.
+// CheckedAt is nil until the step is checked off.
+type ChecklistItem struct {
+	ID        uuid.UUID
+	Position  int
+	Label     string
+	CheckedAt *time.Time
+}
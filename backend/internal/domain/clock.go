@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Clock tells services what time it is. Production code uses SystemClock;
+// tests inject a fixed or advancing clock so time-dependent behaviour (link
+// expiry, "current trip", reminders) can be asserted exactly instead of
+// racing the wall clock.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts an ordinary function to the Clock interface, e.g.
+//
+//	domain.ClockFunc(func() time.Time { return fixed })
+type ClockFunc func() time.Time
+
+// Now returns f().
+func (f ClockFunc) Now() time.Time { return f() }
+
+// SystemClock is the real wall clock.
+var SystemClock Clock = ClockFunc(time.Now)
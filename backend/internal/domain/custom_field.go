@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldEntity is the kind of record a custom field is defined for.
+type CustomFieldEntity string
+
+const (
+	CustomFieldTrip CustomFieldEntity = "trip"
+	CustomFieldStop CustomFieldEntity = "stop"
+)
+
+// Valid reports whether e is a known entity.
+func (e CustomFieldEntity) Valid() bool {
+	return e == CustomFieldTrip || e == CustomFieldStop
+}
+
+// CustomFieldType is the kind of value a custom field holds.
+type CustomFieldType string
+
+const (
+	// FieldText holds any string.
+	FieldText CustomFieldType = "text"
+	// FieldNumber holds a JSON number.
+	FieldNumber CustomFieldType = "number"
+	// FieldBoolean holds true or false.
+	FieldBoolean CustomFieldType = "boolean"
+	// FieldDate holds a calendar day as a "2006-01-02" string.
+	FieldDate CustomFieldType = "date"
+)
+
+// Valid reports whether t is a known type.
+func (t CustomFieldType) Valid() bool {
+	switch t {
+	case FieldText, FieldNumber, FieldBoolean, FieldDate:
+		return true
+	}
+	return false
+}
+
+// CustomField is a field an organization defines for its trips or stops
+// beyond the built-in ones, such as a campground's pet policy. Key names the
+// value in a record's Metadata and never changes; Label is what people see.
+type CustomField struct {
+	ID        uuid.UUID
+	Entity    CustomFieldEntity
+	Key       string
+	Label     string
+	Type      CustomFieldType
+	CreatedAt time.Time
+}
+
+// Metadata holds a trip's or stop's custom field values by key, as decoded
+// from JSON: strings, float64s, and bools.
+type Metadata map[string]any
+
+// MetadataFilter matches the records whose metadata holds every key with
+// exactly the given value. An empty filter matches every record.
+type MetadataFilter map[string]any
@@ -0,0 +1,27 @@
+package domain
+
+import "github.com/google/uuid"
+
+// MaxDateShiftDays bounds how far a data fix may move a trip's dates, to
+// catch a year typed as a day count.
+const MaxDateShiftDays = 3650
+
+// DataFix is what an admin data fix changed or, on a dry run, what it would
+// change. A fix is made whole or not at all, and a dry run reports exactly
+// the changes the real run would make at that moment.
+type DataFix struct {
+	DryRun  bool
+	Changes []FieldChange
+}
+
+// FieldChange is one field of one row rewritten by a data fix. Entity names
+// the kind of row ("trip", "stop", "expense", ...). Before and After are
+// the values as text, dates as 2006-01-02 and times in RFC 3339 UTC; nil is
+// a null.
+type FieldChange struct {
+	Entity string
+	ID     uuid.UUID
+	Field  string
+	Before *string
+	After  *string
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// dateLayout is the ISO 8601 calendar date format the API and database use.
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date with no time of day and no location. Trip dates
+// are days picked on a calendar, not instants: holding them as midnight UTC
+// made a trip starting June 30 begin at 5pm on June 29 for anyone in Pacific
+// time. Compare a Date with an instant only through DateOf, which takes the
+// instant's own calendar day.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the given date, normalised the way time.Date normalises
+// out-of-range values (June 31 is July 1).
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the calendar day t falls on in t's own location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a "2006-01-02" date.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: want YYYY-MM-DD", s)
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date as "2006-01-02".
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool { return d == Date{} }
+
+// In returns midnight at the start of d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d; n may be negative.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// DaysSince returns how many days d is after u; negative when it is before.
+func (d Date) DaysSince(u Date) int {
+	return int(d.In(time.UTC).Sub(u.In(time.UTC)).Hours() / 24)
+}
+
+// Compare returns -1, 0, or +1 as d is before, equal to, or after u.
+func (d Date) Compare(u Date) int {
+	if c := cmp.Compare(d.Year, u.Year); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(d.Month, u.Month); c != 0 {
+		return c
+	}
+	return cmp.Compare(d.Day, u.Day)
+}
+
+// Before reports whether d is earlier than u.
+func (d Date) Before(u Date) bool { return d.Compare(u) < 0 }
+
+// After reports whether d is later than u.
+func (d Date) After(u Date) bool { return d.Compare(u) > 0 }
+
+// MarshalText encodes d as "2006-01-02", so it reads as a date in JSON.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes a "2006-01-02" date.
+func (d *Date) UnmarshalText(b []byte) error {
+	parsed, err := ParseDate(string(b))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
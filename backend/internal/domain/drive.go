@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DriveRule names one of the limits in DriveRules.
+type DriveRule string
+
+// The rules a drive day can break.
+const (
+	RuleMaxMiles         DriveRule = "max_miles"
+	RuleMaxDriveHours    DriveRule = "max_drive_hours"
+	RuleArriveBeforeDark DriveRule = "arrive_before_dark"
+)
+
+// DriveRules are the limits each day's drive of a planned itinerary is held
+// to. A zero MaxMiles or MaxDriveTime is not checked.
+//
+// DepartAt is when the first planned drive leaves; each later one leaves at
+// the same time on the following day. A drive from a stop with a departure
+// already logged leaves then instead. Without either, arrival before dark
+// cannot be checked.
+type DriveRules struct {
+	MaxMiles         float64
+	MaxDriveTime     time.Duration
+	ArriveBeforeDark bool
+	DepartAt         *time.Time
+}
+
+// DriveSource says where a drive day's distance and driving time came from.
+type DriveSource string
+
+const (
+	// DriveEntered figures were entered on the route leg or derived from
+	// its uploaded track.
+	DriveEntered DriveSource = "entered"
+	// DriveRouted figures were looked up from the routing API.
+	DriveRouted DriveSource = "routed"
+	// DriveUnknown means neither was available.
+	DriveUnknown DriveSource = "unknown"
+)
+
+// DriveDay is one leg of a planned itinerary — the drive to a planned stop —
+// with the figures it was checked on. Fields are nil where the figure is not
+// known; the rules that needed it are listed in Unchecked rather than
+// passed or failed.
+type DriveDay struct {
+	LegID           uuid.UUID
+	FromStopID      uuid.UUID
+	ToStopID        uuid.UUID
+	DistanceMiles   *float64
+	DurationMinutes *int
+	Source          DriveSource
+	DepartAt        *time.Time
+	ArriveAt        *time.Time
+	// Sunset is at the destination on the day of the drive.
+	Sunset     *time.Time
+	Violations []DriveViolation
+	Unchecked  []DriveRule
+}
+
+// DriveViolation is a rule a drive day breaks, with a message saying by how
+// much.
+type DriveViolation struct {
+	Rule    DriveRule
+	Message string
+}
+
+// OK reports whether the day breaks no rule.
+func (d DriveDay) OK() bool { return len(d.Violations) == 0 }
+
+// Check sets the day's ArriveAt from its departure and driving time, then
+// its Violations and Unchecked from rules. Sunset must already be set for
+// arrival before dark to be checked.
+func (d *DriveDay) Check(rules DriveRules) {
+	d.Violations, d.Unchecked = nil, nil
+	if d.DepartAt != nil && d.DurationMinutes != nil {
+		arrive := d.DepartAt.Add(time.Duration(*d.DurationMinutes) * time.Minute)
+		d.ArriveAt = &arrive
+	}
+
+	if rules.MaxMiles > 0 {
+		switch {
+		case d.DistanceMiles == nil:
+			d.Unchecked = append(d.Unchecked, RuleMaxMiles)
+		case *d.DistanceMiles > rules.MaxMiles:
+			d.Violations = append(d.Violations, DriveViolation{RuleMaxMiles,
+				fmt.Sprintf("%.0f miles is over the %g-mile limit", *d.DistanceMiles, rules.MaxMiles)})
+		}
+	}
+	if rules.MaxDriveTime > 0 {
+		switch {
+		case d.DurationMinutes == nil:
+			d.Unchecked = append(d.Unchecked, RuleMaxDriveHours)
+		case time.Duration(*d.DurationMinutes)*time.Minute > rules.MaxDriveTime:
+			d.Violations = append(d.Violations, DriveViolation{RuleMaxDriveHours,
+				fmt.Sprintf("%s of driving is over the %g-hour limit", hoursMinutes(*d.DurationMinutes), rules.MaxDriveTime.Hours())})
+		}
+	}
+	if rules.ArriveBeforeDark {
+		switch {
+		case d.ArriveAt == nil || d.Sunset == nil:
+			d.Unchecked = append(d.Unchecked, RuleArriveBeforeDark)
+		case d.ArriveAt.After(*d.Sunset):
+			d.Violations = append(d.Violations, DriveViolation{RuleArriveBeforeDark,
+				fmt.Sprintf("arrives %s after sunset", hoursMinutes(int(d.ArriveAt.Sub(*d.Sunset).Round(time.Minute).Minutes())))})
+		}
+	}
+}
+
+// hoursMinutes formats a number of minutes as "5h 10m", or "40m" under an
+// hour.
+func hoursMinutes(minutes int) string {
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
@@ -11,3 +11,18 @@ var ErrNotFound = errors.New("not found")
 // rule validation (e.g. missing required field, end date before start date).
 // Handlers should map this to HTTP 422 Unprocessable Entity.
 var ErrValidation = errors.New("validation error")
+
+// ErrUnauthorized is returned by the auth service when credentials or a
+// token are missing, wrong, or expired. The reason is deliberately not
+// exposed. Handlers should map this to HTTP 401.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden is returned when the request's user can see a resource but
+// their role does not let them change it, like a trip's viewer editing it.
+// Handlers should map this to HTTP 403.
+var ErrForbidden = errors.New("forbidden")
+
+// ErrTimeout is returned by repo functions when a query runs past its own
+// time limit, as opposed to the request being cancelled.
+// Handlers should map this to HTTP 504.
+var ErrTimeout = errors.New("query timed out")
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExpenseCategory classifies what an expense was for.
+type ExpenseCategory string
+
+const (
+	ExpenseToll       ExpenseCategory = "toll"
+	ExpenseParking    ExpenseCategory = "parking"
+	ExpenseFuel       ExpenseCategory = "fuel"
+	ExpenseCampground ExpenseCategory = "campground"
+	ExpenseFood       ExpenseCategory = "food"
+	ExpenseOther      ExpenseCategory = "other"
+)
+
+// Valid reports whether c is one of the known categories.
+func (c ExpenseCategory) Valid() bool {
+	switch c {
+	case ExpenseToll, ExpenseParking, ExpenseFuel, ExpenseCampground, ExpenseFood, ExpenseOther:
+		return true
+	}
+	return false
+}
+
+// Expense is money spent on a trip.
+//
+// StopID and Location record where the traveller was when the expense was
+// logged. Location is copied from the stop so it survives the stop being
+// deleted; both are empty for an expense logged on the road between stops.
+type Expense struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	StopID    *uuid.UUID
+	Category  ExpenseCategory
+	Amount    float64
+	Note      string
+	Location  string
+	Split     ExpenseSplit
+	SpentAt   time.Time
+	CreatedAt time.Time
+}
+
+// FuelStopRadiusMeters is how close a fill-up must be to a stop already
+// logged on the trip to be tied to it rather than given a stop of its own.
+const FuelStopRadiusMeters = 200.0
+
+// FuelStopTag is the tag that marks a stop created for a fill-up, so the
+// route map can tell fuel stops from the rest.
+const FuelStopTag = "fuel"
+
+// FillUp is where a fuel expense was paid, in decimal degrees. A quick-logged
+// fuel expense that carries one is put on the route map; see
+// service.ExpenseService.QuickLog.
+type FillUp struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// ExpenseSplit records which co-traveller paid an expense and whom it is
+// shared between, evenly. An empty SplitAmong means the payer bore it alone.
+// The zero value is an expense no one has claimed; it takes no part in a
+// trip's Settlement.
+type ExpenseSplit struct {
+	PaidBy     string
+	SplitAmong []string
+}
+
+// Balance is where one co-traveller stands across a trip's split expenses.
+// Net is Paid less Owed: positive when the others owe them.
+type Balance struct {
+	Name string
+	Paid float64
+	Owed float64
+	Net  float64
+}
+
+// Transfer is one payment that settles up part of a trip's costs.
+type Transfer struct {
+	From   string
+	To     string
+	Amount float64
+}
+
+// Settlement sums up a trip's split expenses: what each co-traveller paid
+// and owes, and the payments that even everyone out. Total is the sum of the
+// expenses that have a payer.
+type Settlement struct {
+	Total     float64
+	Balances  []Balance
+	Transfers []Transfer
+}
+
+// StopAt returns the stop the traveller was at when at: arrived on or before
+// at and not yet departed (a nil DepartedAt is still there). If several
+// overlap, the one arrived at last wins. It reports false when at falls
+// between stops. Planned stops are never current.
+func StopAt(stops []Stop, at time.Time) (Stop, bool) {
+	var (
+		current Stop
+		found   bool
+	)
+	for _, s := range stops {
+		if s.Planned || s.ArrivedAt.After(at) {
+			continue
+		}
+		if s.DepartedAt != nil && !s.DepartedAt.After(at) {
+			continue
+		}
+		if !found || s.ArrivedAt.After(current.ArrivedAt) {
+			current, found = s, true
+		}
+	}
+	return current, found
+}
+
+// Settle works out who owes whom for expenses. Expenses with no payer are
+// skipped. Each expense is shared evenly in whole cents; the cents left over
+// go to the first people it is split among, so shares always add up to the
+// amount. Names are matched exactly.
+//
+// Balances are ordered by name. Transfers pair the largest debt with the
+// largest credit until every balance is zero, which needs fewer payments
+// than there are people.
+func Settle(expenses []Expense) Settlement {
+	type cents struct{ paid, owed int64 }
+	byName := map[string]*cents{}
+	person := func(name string) *cents {
+		c, ok := byName[name]
+		if !ok {
+			c = &cents{}
+			byName[name] = c
+		}
+		return c
+	}
+
+	var total int64
+	for _, e := range expenses {
+		if e.Split.PaidBy == "" {
+			continue
+		}
+		amount := int64(math.Round(e.Amount * 100))
+		total += amount
+		person(e.Split.PaidBy).paid += amount
+
+		among := e.Split.SplitAmong
+		if len(among) == 0 {
+			among = []string{e.Split.PaidBy}
+		}
+		share, rest := amount/int64(len(among)), amount%int64(len(among))
+		for i, name := range among {
+			owed := share
+			if int64(i) < rest {
+				owed++
+			}
+			person(name).owed += owed
+		}
+	}
+
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	type party struct {
+		name   string
+		amount int64
+	}
+	var debtors, creditors []party
+	settlement := Settlement{Total: fromCents(total), Balances: make([]Balance, 0, len(names)), Transfers: []Transfer{}}
+	for _, name := range names {
+		c := byName[name]
+		net := c.paid - c.owed
+		settlement.Balances = append(settlement.Balances, Balance{
+			Name: name,
+			Paid: fromCents(c.paid),
+			Owed: fromCents(c.owed),
+			Net:  fromCents(net),
+		})
+		switch {
+		case net < 0:
+			debtors = append(debtors, party{name, -net})
+		case net > 0:
+			creditors = append(creditors, party{name, net})
+		}
+	}
+
+	// Largest first; names are already sorted, so ties keep name order.
+	largest := func(p []party) func(i, j int) bool {
+		return func(i, j int) bool { return p[i].amount > p[j].amount }
+	}
+	sort.SliceStable(debtors, largest(debtors))
+	sort.SliceStable(creditors, largest(creditors))
+
+	for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+		amount := min(debtors[d].amount, creditors[c].amount)
+		settlement.Transfers = append(settlement.Transfers, Transfer{
+			From:   debtors[d].name,
+			To:     creditors[c].name,
+			Amount: fromCents(amount),
+		})
+		debtors[d].amount -= amount
+		creditors[c].amount -= amount
+		if debtors[d].amount == 0 {
+			d++
+		}
+		if creditors[c].amount == 0 {
+			c++
+		}
+	}
+	return settlement
+}
+
+// fromCents converts a whole number of cents to an amount.
+func fromCents(c int64) float64 {
+	return float64(c) / 100
+}
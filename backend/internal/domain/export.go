@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // ExportRow is a single row in the full-data export.
 // It is a flat, denormalized view: one row per stop, with trip fields repeated
@@ -13,8 +17,8 @@ type ExportRow struct {
 	// Trip fields — repeated for every stop on the trip.
 	TripID        string
 	TripName      string
-	TripStartDate string // "2006-01-02" formatted date
-	TripEndDate   string // empty string when nil
+	TripStartDate Date
+	TripEndDate   *Date // nil while the trip is in progress
 
 	// Stop fields — zero values when the trip has no stops.
 	StopName     string
@@ -25,4 +29,28 @@ type ExportRow struct {
 
 	// Tags — slugs of all tags attached to this stop.
 	Tags []string
+
+	// Where the stop is: nil coordinates when it has none, and an empty
+	// State until they have been reverse geocoded (see StopPlace).
+	Latitude  *float64
+	Longitude *float64
+	State     string
+
+	// DistanceMiles is the odometer distance from the trip's previous stop;
+	// nil unless both stops have a reading.
+	DistanceMiles *float64
+
+	// Cost totals the expenses logged at the stop, nil when it has none, and
+	// ExpenseCategories lists their categories alphabetically.
+	Cost              *float64
+	ExpenseCategories []string
+}
+
+// Tombstone records a trip or stop purged from the trash, so a client that
+// keeps an earlier export can drop it. Trips and stops in the trash have no
+// tombstone yet: they leave the export but can still be restored.
+type Tombstone struct {
+	Kind      TrashKind
+	ID        uuid.UUID
+	DeletedAt time.Time // when it was purged
 }
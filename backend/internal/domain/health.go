@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// HealthStatus is the state of the API or one of the components it depends on.
+type HealthStatus string
+
+const (
+	HealthOK HealthStatus = "ok"
+	// HealthDegraded means an optional component is failing: the API serves
+	// requests, but the features that use it fall back or fail.
+	HealthDegraded HealthStatus = "degraded"
+	// HealthDown means a component the API cannot work without is failing.
+	HealthDown HealthStatus = "down"
+)
+
+// ComponentHealth is the outcome of checking one component.
+type ComponentHealth struct {
+	Name      string
+	Critical  bool // the API cannot serve requests without it
+	Status    HealthStatus
+	Latency   time.Duration // how long the check took
+	Error     string        // why the check failed; empty when Status is HealthOK
+	CheckedAt time.Time     // when the check ran, which may be before the report
+}
+
+// HealthReport is the state of every component the API checks.
+type HealthReport struct {
+	Version    string
+	Components []ComponentHealth
+}
+
+// Status returns HealthDown if any critical component is failing,
+// HealthDegraded if any other one is, and HealthOK otherwise.
+func (r HealthReport) Status() HealthStatus {
+	status := HealthOK
+	for _, c := range r.Components {
+		if c.Status == HealthOK {
+			continue
+		}
+		if c.Critical {
+			return HealthDown
+		}
+		status = HealthDegraded
+	}
+	return status
+}
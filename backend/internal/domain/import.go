@@ -0,0 +1,19 @@
+package domain
+
+// StopImport is what came of importing stops from an uploaded file: the
+// stops created, and the items of the file that were not, so that one bad
+// entry does not cost the rest.
+type StopImport struct {
+	Created []Stop
+	Failed  []ImportFailure
+}
+
+// ImportFailure is an item of an uploaded file that was not imported.
+// Index is its 1-based position among the file's items, and Err says why:
+// it wraps ErrValidation when the item itself was wrong, and does not when
+// the item was valid but could not be saved.
+type ImportFailure struct {
+	Index int
+	Name  string
+	Err   error
+}
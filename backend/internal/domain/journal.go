@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JournalMood is how a day on the road felt.
+type JournalMood string
+
+const (
+	MoodGreat JournalMood = "great"
+	MoodGood  JournalMood = "good"
+	MoodOkay  JournalMood = "okay"
+	MoodRough JournalMood = "rough"
+	MoodAwful JournalMood = "awful"
+)
+
+// Valid reports whether m is a known mood.
+func (m JournalMood) Valid() bool {
+	switch m {
+	case MoodGreat, MoodGood, MoodOkay, MoodRough, MoodAwful:
+		return true
+	}
+	return false
+}
+
+// JournalWeather is the day's weather, as the traveller saw it.
+type JournalWeather string
+
+const (
+	WeatherSunny        JournalWeather = "sunny"
+	WeatherPartlyCloudy JournalWeather = "partly_cloudy"
+	WeatherCloudy       JournalWeather = "cloudy"
+	WeatherRain         JournalWeather = "rain"
+	WeatherStorm        JournalWeather = "storm"
+	WeatherSnow         JournalWeather = "snow"
+	WeatherFog          JournalWeather = "fog"
+	WeatherWind         JournalWeather = "wind"
+)
+
+// Valid reports whether w is a known kind of weather.
+func (w JournalWeather) Valid() bool {
+	switch w {
+	case WeatherSunny, WeatherPartlyCloudy, WeatherCloudy, WeatherRain, WeatherStorm, WeatherSnow, WeatherFog, WeatherWind:
+		return true
+	}
+	return false
+}
+
+// JournalEntry is a piece of a trip's narrative, written for one day.
+// Body is markdown. Mood and Weather are empty when not recorded, and StopID
+// is nil for an entry not tied to a stop. A day may have several entries.
+type JournalEntry struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	StopID    *uuid.UUID
+	Date      Date
+	Body      string
+	Mood      JournalMood
+	Weather   JournalWeather
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// JournalDay is one day of a trip's journal: its entries in the order they
+// were written.
+type JournalDay struct {
+	Date    Date
+	Entries []JournalEntry
+}
+
+// GroupJournalByDay groups entries, already ordered by date, into days.
+// Days without entries are left out.
+func GroupJournalByDay(entries []JournalEntry) []JournalDay {
+	days := []JournalDay{}
+	for _, e := range entries {
+		if n := len(days); n > 0 && days[n-1].Date == e.Date {
+			days[n-1].Entries = append(days[n-1].Entries, e)
+			continue
+		}
+		days = append(days, JournalDay{Date: e.Date, Entries: []JournalEntry{e}})
+	}
+	return days
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LocationPing is one position report from a tracking app such as OwnTracks
+// or Home Assistant. Device names the tracker that sent it; AccuracyMeters
+// is nil when the app did not report one.
+type LocationPing struct {
+	ID             uuid.UUID
+	Device         string
+	Latitude       float64
+	Longitude      float64
+	AccuracyMeters *float64
+	RecordedAt     time.Time
+	CreatedAt      time.Time
+}
+
+// DwellStatus is where a dwell's suggested stop stands.
+type DwellStatus string
+
+const (
+	// DwellSuggested is waiting for someone to accept or dismiss it.
+	DwellSuggested DwellStatus = "suggested"
+	// DwellAccepted has had its stop created, automatically or by hand.
+	DwellAccepted DwellStatus = "accepted"
+	// DwellDismissed was not a stop worth logging.
+	DwellDismissed DwellStatus = "dismissed"
+)
+
+// Dwell is a place a tracked device stayed put during a trip — a stop the
+// rig made, whether or not anyone logged it.
+//
+// A dwell is open while the device is still there: LastSeenAt moves forward
+// with each report from inside it and DepartedAt is nil. It closes at the
+// first report from elsewhere. StopID is set once the dwell is accepted and
+// becomes nil again if that stop is deleted.
+type Dwell struct {
+	ID         uuid.UUID
+	TripID     uuid.UUID
+	Device     string
+	Latitude   float64
+	Longitude  float64
+	ArrivedAt  time.Time
+	LastSeenAt time.Time
+	DepartedAt *time.Time
+	Status     DwellStatus
+	StopID     *uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Open reports whether the device is still at the dwell.
+func (d Dwell) Open() bool { return d.DepartedAt == nil }
+
+// GeofenceSettings controls how location reports become stops.
+//
+// A device dwells once its reports have stayed within RadiusMeters of one
+// another for at least Dwell. AutoCreateStops logs a stop for every dwell
+// straight away; otherwise each dwell waits as a suggestion.
+type GeofenceSettings struct {
+	Dwell           time.Duration
+	RadiusMeters    float64
+	AutoCreateStops bool
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultDueSoonMiles is how close to its next service an item is due soon,
+// unless the caller asks for another window.
+const DefaultDueSoonMiles = 500
+
+// MaintenanceItem is service a vehicle needs every IntervalMiles, like an oil
+// change every 5,000 miles. Vehicle is the label its odometer readings are
+// logged under (see OdometerReading.Vehicle).
+//
+// LastService is the record with the highest mileage, nil until the item has
+// been serviced; until then the interval counts from 0 miles.
+type MaintenanceItem struct {
+	ID            uuid.UUID
+	Vehicle       string
+	Name          string
+	IntervalMiles float64
+	LastService   *MaintenanceRecord
+	CreatedAt     time.Time
+}
+
+// DueAtMiles returns the odometer reading the item is next due at.
+func (i MaintenanceItem) DueAtMiles() float64 {
+	if i.LastService == nil {
+		return i.IntervalMiles
+	}
+	return i.LastService.Miles + i.IntervalMiles
+}
+
+// MaintenanceRecord is one time an item was serviced, at Miles on the
+// vehicle's odometer.
+type MaintenanceRecord struct {
+	ID         uuid.UUID
+	ItemID     uuid.UUID
+	Miles      float64
+	ServicedOn Date
+	Notes      string
+	CreatedAt  time.Time
+}
+
+// MaintenanceStatus says how close an item is to needing service.
+type MaintenanceStatus string
+
+const (
+	MaintenanceOK      MaintenanceStatus = "ok"
+	MaintenanceDueSoon MaintenanceStatus = "due_soon"
+	MaintenanceOverdue MaintenanceStatus = "overdue"
+)
+
+// MaintenanceDue is an item checked against its vehicle's odometer.
+// MilesRemaining is negative once the item is overdue.
+type MaintenanceDue struct {
+	Item           MaintenanceItem
+	CurrentMiles   float64
+	DueAtMiles     float64
+	MilesRemaining float64
+	Status         MaintenanceStatus
+}
+
+// CheckMaintenance checks item against an odometer reading of currentMiles.
+// It is overdue at or past DueAtMiles and due soon within withinMiles of it.
+func CheckMaintenance(item MaintenanceItem, currentMiles, withinMiles float64) MaintenanceDue {
+	due := MaintenanceDue{
+		Item:           item,
+		CurrentMiles:   currentMiles,
+		DueAtMiles:     item.DueAtMiles(),
+		MilesRemaining: item.DueAtMiles() - currentMiles,
+		Status:         MaintenanceOK,
+	}
+	switch {
+	case due.MilesRemaining <= 0:
+		due.Status = MaintenanceOverdue
+	case due.MilesRemaining <= withinMiles:
+		due.Status = MaintenanceDueSoon
+	}
+	return due
+}
+
+// MaintenanceEventData is the data of a maintenance.due event.
+type MaintenanceEventData struct {
+	ItemID         uuid.UUID         `json:"item_id"`
+	Vehicle        string            `json:"vehicle"`
+	Name           string            `json:"name"`
+	CurrentMiles   float64           `json:"current_miles"`
+	DueAtMiles     float64           `json:"due_at_miles"`
+	MilesRemaining float64           `json:"miles_remaining"`
+	Status         MaintenanceStatus `json:"status"`
+}
+
+// MaintenanceEvent returns the event data for d.
+func MaintenanceEvent(d MaintenanceDue) MaintenanceEventData {
+	return MaintenanceEventData{
+		ItemID:         d.Item.ID,
+		Vehicle:        d.Item.Vehicle,
+		Name:           d.Item.Name,
+		CurrentMiles:   d.CurrentMiles,
+		DueAtMiles:     d.DueAtMiles,
+		MilesRemaining: d.MilesRemaining,
+		Status:         d.Status,
+	}
+}
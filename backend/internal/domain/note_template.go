@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NoteTemplate is a set of questions to answer about a stop when it is
+// logged, such as its site number or how good the cell coverage is. The
+// answers are kept with the stop and written into its notes.
+type NoteTemplate struct {
+	ID        uuid.UUID
+	Name      string
+	Questions []NoteQuestion
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NoteQuestion is one question of a note template. Key names its answer in
+// a stop's NoteAnswers and never changes; Prompt is what people are asked.
+// Choices, when set, are the only answers allowed, as for a noise level of
+// "quiet", "some", or "loud".
+type NoteQuestion struct {
+	Key     string
+	Prompt  string
+	Choices []string
+}
+
+// Question returns the template's question with the given key.
+func (t NoteTemplate) Question(key string) (NoteQuestion, bool) {
+	for _, q := range t.Questions {
+		if q.Key == key {
+			return q, true
+		}
+	}
+	return NoteQuestion{}, false
+}
+
+// Render writes answers as notes: a "Prompt: answer" line for each answered
+// question, in the template's order.
+func (t NoteTemplate) Render(answers NoteAnswers) string {
+	var lines []string
+	for _, q := range t.Questions {
+		if a, ok := answers[q.Key]; ok {
+			lines = append(lines, q.Prompt+": "+a)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// MaxNoteAnswerLength is the most characters an answer may have; longer
+// notes belong in the stop's free text.
+const MaxNoteAnswerLength = 200
+
+// NoteAnswers holds a stop's answers to its note template, by question key.
+type NoteAnswers map[string]string
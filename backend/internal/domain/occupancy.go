@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NightStatus says what accounts for a night.
+type NightStatus string
+
+const (
+	// NightAtStop nights are covered by a logged stop.
+	NightAtStop NightStatus = "stop"
+	// NightGap nights fall within a trip's dates but no stop covers them:
+	// most likely a stop that was never logged.
+	NightGap NightStatus = "gap"
+	// NightOffTrip nights fall outside every trip.
+	NightOffTrip NightStatus = "off_trip"
+)
+
+// NightSpan is a run of consecutive nights with the same status, trip, and
+// stop. A night is named by the date it begins on; First and Last are the
+// first and last nights of the span.
+type NightSpan struct {
+	First  Date
+	Last   Date
+	Status NightStatus
+	// TripID is the trip a stop or gap night belongs to; nil off trip.
+	TripID *uuid.UUID
+	// StopID and StopName identify the stop of a NightAtStop span.
+	StopID   *uuid.UUID
+	StopName string
+}
+
+// Nights returns how many nights the span covers.
+func (s NightSpan) Nights() int {
+	return daysBetween(s.First, s.Last) + 1
+}
+
+// Occupancy is the nights of one year, each accounted for by a stop, a gap
+// in a trip, or time off trip.
+type Occupancy struct {
+	Year  int
+	Spans []NightSpan
+}
+
+// Count returns the number of nights in spans with the given status.
+func (o Occupancy) Count(status NightStatus) int {
+	n := 0
+	for _, s := range o.Spans {
+		if s.Status == status {
+			n += s.Nights()
+		}
+	}
+	return n
+}
+
+// AccountForNights returns the nights from first to last, inclusive, as
+// spans. Nights are counted as they are everywhere else (UTC calendar days):
+// a stop covers the nights from its arrival up to the day it departed, or
+// only the night of its arrival when it has not departed. A trip covers the
+// nights from its start date up to its end date; a trip with no end date
+// covers every night from its start to last. Planned stops cover nothing.
+//
+// Where stops overlap, the one arrived at first wins; where trips overlap,
+// the first in trips does.
+func AccountForNights(first, last Date, trips []Trip, stops []Stop) []NightSpan {
+	spans := []NightSpan{}
+	if last.Before(first) {
+		return spans
+	}
+
+	type night struct {
+		stop *Stop
+		trip *uuid.UUID
+	}
+	nights := make([]night, daysBetween(first, last)+1)
+	index := func(d Date) int { return daysBetween(first, d) }
+
+	for i := range trips {
+		t := &trips[i]
+		end := last
+		if t.EndDate != nil && t.EndDate.AddDays(-1).Before(end) {
+			end = t.EndDate.AddDays(-1)
+		}
+		for d := maxDate(t.StartDate, first); !d.After(end); d = d.AddDays(1) {
+			if n := &nights[index(d)]; n.trip == nil {
+				n.trip = &t.ID
+			}
+		}
+	}
+	for i := range stops {
+		s := &stops[i]
+		if s.Planned {
+			continue
+		}
+		from := DateOf(s.ArrivedAt.UTC())
+		to := from
+		if s.DepartedAt != nil {
+			to = DateOf(s.DepartedAt.UTC()).AddDays(-1)
+		}
+		for d := maxDate(from, first); !d.After(to) && !d.After(last); d = d.AddDays(1) {
+			n := &nights[index(d)]
+			if n.stop == nil || s.ArrivedAt.Before(n.stop.ArrivedAt) {
+				n.stop = s
+			}
+		}
+	}
+
+	for i, n := range nights {
+		span := NightSpan{First: first.AddDays(i), Status: NightOffTrip}
+		span.Last = span.First
+		switch {
+		case n.stop != nil:
+			id, trip := n.stop.ID, n.stop.TripID
+			span.Status, span.StopID, span.StopName, span.TripID = NightAtStop, &id, n.stop.Name, &trip
+		case n.trip != nil:
+			span.Status, span.TripID = NightGap, n.trip
+		}
+		if k := len(spans) - 1; k >= 0 && sameSpan(spans[k], span) {
+			spans[k].Last = span.Last
+			continue
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+// TripGap is a run of nights within a trip that no stop accounts for.
+type TripGap struct {
+	First Date
+	Last  Date
+	// Previous and Next are the stops whose nights come just before and just
+	// after the gap; nil where the gap starts or ends the trip.
+	Previous *Stop
+	Next     *Stop
+}
+
+// Nights returns how many nights the gap covers.
+func (g TripGap) Nights() int {
+	return daysBetween(g.First, g.Last) + 1
+}
+
+// TripGaps returns the gaps in trip's nights, from its start date through
+// the night before its end date or last, whichever comes first. Nights are
+// accounted for as in AccountForNights; stops are the trip's own.
+func TripGaps(trip Trip, last Date, stops []Stop) []TripGap {
+	if trip.EndDate != nil && trip.EndDate.AddDays(-1).Before(last) {
+		last = trip.EndDate.AddDays(-1)
+	}
+	spans := AccountForNights(trip.StartDate, last, []Trip{trip}, stops)
+
+	stopAt := func(i int) *Stop {
+		if i < 0 || i >= len(spans) || spans[i].StopID == nil {
+			return nil
+		}
+		for j := range stops {
+			if stops[j].ID == *spans[i].StopID {
+				return &stops[j]
+			}
+		}
+		return nil
+	}
+	gaps := []TripGap{}
+	for i, sp := range spans {
+		if sp.Status == NightGap {
+			gaps = append(gaps, TripGap{First: sp.First, Last: sp.Last, Previous: stopAt(i - 1), Next: stopAt(i + 1)})
+		}
+	}
+	return gaps
+}
+
+// sameSpan reports whether b continues a: the same status, trip, and stop.
+func sameSpan(a, b NightSpan) bool {
+	return a.Status == b.Status && equalID(a.TripID, b.TripID) && equalID(a.StopID, b.StopID)
+}
+
+func equalID(a, b *uuid.UUID) bool {
+	return (a == nil) == (b == nil) && (a == nil || *a == *b)
+}
+
+// daysBetween returns the number of days from a to b.
+func daysBetween(a, b Date) int {
+	return int(b.In(time.UTC).Sub(a.In(time.UTC)).Hours() / 24)
+}
+
+func maxDate(a, b Date) Date {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OdometerReading is one entry in a vehicle's mileage log: the odometer value
+// at a point in time. Mileage for a trip or a period is derived from these
+// readings rather than stored.
+//
+// Vehicle is a free-text label ("Motorhome", "Tow car"). TripID is nil for
+// readings not taken on a trip, e.g. at a service appointment.
+type OdometerReading struct {
+	ID         uuid.UUID
+	Vehicle    string
+	Miles      float64
+	RecordedAt time.Time
+	TripID     *uuid.UUID
+	Notes      string
+	CreatedAt  time.Time
+}
+
+// OdometerFilter narrows an odometer reading list. Zero values match all.
+type OdometerFilter struct {
+	Vehicle string
+	TripID  *uuid.UUID
+}
+
+// VehicleMileage is the distance one vehicle covered between its first and
+// last reading in a set.
+type VehicleMileage struct {
+	Vehicle    string
+	StartMiles float64
+	EndMiles   float64
+	Miles      float64
+	Readings   int
+}
+
+// TripMileage is the distance covered on a trip, per vehicle and in total.
+type TripMileage struct {
+	TripID     uuid.UUID
+	TotalMiles float64
+	Vehicles   []VehicleMileage
+}
+
+// MileageByVehicle groups readings by vehicle and returns the distance each
+// vehicle covered, from its earliest to its latest reading by RecordedAt.
+// A vehicle with a single reading covered 0 miles. Results are ordered by
+// vehicle name; the input slice is not modified.
+func MileageByVehicle(readings []OdometerReading) []VehicleMileage {
+	byVehicle := map[string][]OdometerReading{}
+	for _, r := range readings {
+		byVehicle[r.Vehicle] = append(byVehicle[r.Vehicle], r)
+	}
+
+	out := make([]VehicleMileage, 0, len(byVehicle))
+	for vehicle, rs := range byVehicle {
+		sort.Slice(rs, func(i, j int) bool { return rs[i].RecordedAt.Before(rs[j].RecordedAt) })
+		first, last := rs[0], rs[len(rs)-1]
+		out = append(out, VehicleMileage{
+			Vehicle:    vehicle,
+			StartMiles: first.Miles,
+			EndMiles:   last.Miles,
+			Miles:      last.Miles - first.Miles,
+			Readings:   len(rs),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Vehicle < out[j].Vehicle })
+	return out
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultOrganizationID identifies the organization created by the
+// migration that introduced organizations. It owns everything logged before
+// then, and it is the organization a request acts for when it names none.
+var DefaultOrganizationID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+// Organization is a household or group sharing one logbook. Every trip,
+// tag, and log entry belongs to exactly one organization, and nothing is
+// visible outside it.
+type Organization struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MemberRole is what a member may do in their organization. Owners manage
+// the organization and its members; members use the logbook.
+type MemberRole string
+
+const (
+	RoleOwner  MemberRole = "owner"
+	RoleMember MemberRole = "member"
+)
+
+// Valid reports whether r is a known role.
+func (r MemberRole) Valid() bool {
+	return r == RoleOwner || r == RoleMember
+}
+
+// Member is a user's membership of an organization. UserID is the ID an
+// authenticated user carries (auth.Actor.ID).
+type Member struct {
+	OrganizationID uuid.UUID
+	UserID         string
+	Role           MemberRole
+	CreatedAt      time.Time
+}
+
+type organizationKey struct{}
+
+// WithOrganization returns a child context acting for organization id.
+// The repositories scope every read and write to it.
+func WithOrganization(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, organizationKey{}, id)
+}
+
+// OrganizationFromContext returns the organization ctx acts for, or
+// DefaultOrganizationID when none was set.
+func OrganizationFromContext(ctx context.Context) uuid.UUID {
+	if id, ok := ctx.Value(organizationKey{}).(uuid.UUID); ok {
+		return id
+	}
+	return DefaultOrganizationID
+}
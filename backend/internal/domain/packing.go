@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PackingList is a list of things to bring. A list attached to a trip has a
+// TripID; a list without one is a template, kept from season to season and
+// copied into new trips.
+type PackingList struct {
+	ID        uuid.UUID
+	TripID    *uuid.UUID
+	Name      string
+	Items     []PackingItem
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsTemplate reports whether l is a template rather than a trip's list.
+func (l PackingList) IsTemplate() bool {
+	return l.TripID == nil
+}
+
+// PackingItem is one entry on a packing list. Position orders the entries
+// and is assigned when the item is added.
+type PackingItem struct {
+	ID        uuid.UUID
+	ListID    uuid.UUID
+	Position  int
+	Name      string
+	Quantity  int
+	Packed    bool
+	CreatedAt time.Time
+}
+
+// PackingListFilter narrows a packing list query. TripID selects one trip's
+// lists; Templates selects only lists with no trip. The zero value matches
+// every list.
+type PackingListFilter struct {
+	TripID    *uuid.UUID
+	Templates bool
+}
@@ -1,7 +1,7 @@
 package domain
 
 // PaginationParams carries page/limit values from the HTTP layer to the repo layer.
-// Page is 1-indexed. Limit is capped at 100 by NewPaginationParams.
+// Page is 1-indexed. Limit is capped by the PaginationLimits that built it.
 type PaginationParams struct {
 	// Page is the current page number, starting at 1.
 	Page int
@@ -9,23 +9,40 @@ type PaginationParams struct {
 	Limit int
 }
 
-// NewPaginationParams builds a PaginationParams from optional HTTP query params.
-// Nil pointers fall back to sane defaults (page=1, limit=20).
-// The limit is capped at 100 to prevent runaway queries.
-func NewPaginationParams(page, limit *int) PaginationParams {
-	p := PaginationParams{Page: 1, Limit: 20}
+// PaginationLimits are the page sizes a list endpoint allows: Default when
+// the client asks for none, and never more than Max. Deployments set their
+// own in config; see DefaultPaginationLimits.
+type PaginationLimits struct {
+	Default int
+	Max     int
+}
+
+// DefaultPaginationLimits are the limits of a deployment that sets none:
+// 20 items a page, and at most 100 to prevent runaway queries.
+var DefaultPaginationLimits = PaginationLimits{Default: 20, Max: 100}
+
+// Params builds a PaginationParams from optional HTTP query params.
+// Nil pointers fall back to page 1 and l.Default items, and the limit is
+// capped at l.Max.
+func (l PaginationLimits) Params(page, limit *int) PaginationParams {
+	p := PaginationParams{Page: 1, Limit: l.Default}
 	if page != nil && *page >= 1 {
 		p.Page = *page
 	}
 	if limit != nil && *limit >= 1 {
 		p.Limit = *limit
-		if p.Limit > 100 {
-			p.Limit = 100
+		if p.Limit > l.Max {
+			p.Limit = l.Max
 		}
 	}
 	return p
 }
 
+// NewPaginationParams builds a PaginationParams within DefaultPaginationLimits.
+func NewPaginationParams(page, limit *int) PaginationParams {
+	return DefaultPaginationLimits.Params(page, limit)
+}
+
 // Offset returns the zero-based row offset for a SQL OFFSET clause.
 func (p PaginationParams) Offset() int {
 	return (p.Page - 1) * p.Limit
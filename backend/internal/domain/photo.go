@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPhotoCaptionLength is the longest caption a photo may carry, in characters.
+const MaxPhotoCaptionLength = 500
+
+// ThumbnailSize names one of the scaled-down previews made of each photo.
+type ThumbnailSize string
+
+const (
+	ThumbnailSmall  ThumbnailSize = "small"
+	ThumbnailMedium ThumbnailSize = "medium"
+)
+
+// ThumbnailSizes lists every size a thumbnail is made in, smallest first.
+var ThumbnailSizes = []ThumbnailSize{ThumbnailSmall, ThumbnailMedium}
+
+// Valid reports whether s is one of the known sizes.
+func (s ThumbnailSize) Valid() bool {
+	switch s {
+	case ThumbnailSmall, ThumbnailMedium:
+		return true
+	}
+	return false
+}
+
+// MaxEdge is the longest side of a thumbnail of size s, in pixels: small
+// suits a list row, medium a gallery tile or a phone screen.
+func (s ThumbnailSize) MaxEdge() int {
+	if s == ThumbnailMedium {
+		return 640
+	}
+	return 160
+}
+
+// Photo is an image attached to a stop. The image itself is kept in object
+// storage under ObjectKey; ContentType and SizeBytes describe it as stored.
+// Filename is the name it was uploaded under and may be empty.
+//
+// Thumbnails holds the object key of each JPEG thumbnail by size. It is
+// empty for images the server cannot decode, such as WebP.
+//
+// TakenAt, Latitude, and Longitude are where and when the photo was taken,
+// as its EXIF metadata records; each is nil when it does not. Latitude and
+// Longitude are set together.
+type Photo struct {
+	ID          uuid.UUID
+	StopID      uuid.UUID
+	ObjectKey   string
+	ContentType string
+	SizeBytes   int64
+	Filename    string
+	Caption     string
+	Thumbnails  map[ThumbnailSize]string
+	TakenAt     *time.Time
+	Latitude    *float64
+	Longitude   *float64
+	CreatedAt   time.Time
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StopPlace is the country and state, province, or territory a stop is in,
+// as looked up from its coordinates. Latitude and Longitude are the
+// coordinates that were looked up; once the stop's own differ, the place is
+// stale. CountryCode is empty when no country was found there.
+type StopPlace struct {
+	StopID      uuid.UUID
+	Latitude    float64
+	Longitude   float64
+	CountryCode string // ISO 3166-1 alpha-2, e.g. "US"
+	RegionCode  string // ISO 3166-2, e.g. "US-CO"; empty when unknown
+	RegionName  string // e.g. "Colorado"
+	ResolvedAt  time.Time
+}
+
+// RegionVisit is one state, province, or territory in the states-visited
+// report: how many stops and trips reached it and when.
+type RegionVisit struct {
+	CountryCode  string
+	RegionCode   string
+	RegionName   string
+	Stops        int
+	Trips        int
+	FirstVisited time.Time
+	LastVisited  time.Time
+}
+
+// StatesVisitedReport lists the regions stops were made in, by country and
+// then region code. Stops without coordinates are not counted anywhere;
+// UnresolvedStops counts those with coordinates whose place is not known yet.
+type StatesVisitedReport struct {
+	Regions         []RegionVisit
+	UnresolvedStops int
+}
+
+// GeocodeCacheEntry is a geocoder's answer for one lookup, kept until
+// ExpiresAt so the same lookup is not sent again. Query is the lookup in
+// the normalized form the cache is keyed by; CountryCode is empty when the
+// provider found no country there.
+type GeocodeCacheEntry struct {
+	Provider    string
+	Query       string
+	CountryCode string
+	RegionCode  string
+	RegionName  string
+	FetchedAt   time.Time
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// POICategory classifies a point of interest.
+type POICategory string
+
+const (
+	POIWildlife   POICategory = "wildlife"
+	POILandmark   POICategory = "landmark"
+	POIBrewery    POICategory = "brewery"
+	POIRestaurant POICategory = "restaurant"
+	POIViewpoint  POICategory = "viewpoint"
+	POIOther      POICategory = "other"
+)
+
+// Valid reports whether c is one of the known categories.
+func (c POICategory) Valid() bool {
+	switch c {
+	case POIWildlife, POILandmark, POIBrewery, POIRestaurant, POIViewpoint, POIOther:
+		return true
+	}
+	return false
+}
+
+// PointOfInterest is a sighting or a place worth remembering, pinned by
+// coordinates rather than tied to a stop.
+//
+// TripID is nil for points not found on a trip. SeenAt is nil for places
+// that are not a timed sighting. Tags is populated when the point is
+// fetched from the repository; it is always an initialised (non-nil) slice.
+type PointOfInterest struct {
+	ID        uuid.UUID
+	TripID    *uuid.UUID
+	Name      string
+	Category  POICategory
+	Latitude  float64
+	Longitude float64
+	SeenAt    *time.Time
+	Notes     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Tags      []Tag
+}
+
+// POIFilter narrows a point-of-interest list. Zero values match all.
+// TagSlug matches points carrying that tag or any tag beneath it.
+type POIFilter struct {
+	TripID   *uuid.UUID
+	Category POICategory
+	TagSlug  string
+}
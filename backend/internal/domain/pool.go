@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// PoolStats is a snapshot of the database connection pool. The connection
+// counts are current; the counts and durations of acquires accumulate from
+// server start.
+type PoolStats struct {
+	AcquiredConns     int // checked out by requests
+	IdleConns         int
+	ConstructingConns int
+	TotalConns        int // acquired + idle + constructing
+	MaxConns          int
+
+	AcquireCount         int64
+	EmptyAcquireCount    int64 // acquires that had to wait for a connection
+	CanceledAcquireCount int64
+	NewConnsCount        int64
+	AcquireDuration      time.Duration
+	EmptyAcquireWait     time.Duration // time the EmptyAcquireCount acquires spent waiting
+}
+
+// AvgAcquire returns the mean time to acquire a connection, or zero before
+// the first acquire.
+func (s PoolStats) AvgAcquire() time.Duration {
+	if s.AcquireCount == 0 {
+		return 0
+	}
+	return s.AcquireDuration / time.Duration(s.AcquireCount)
+}
+
+// AvgEmptyAcquireWait returns the mean wait of the acquires that found no
+// idle connection, or zero if none have.
+func (s PoolStats) AvgEmptyAcquireWait() time.Duration {
+	if s.EmptyAcquireCount == 0 {
+		return 0
+	}
+	return s.EmptyAcquireWait / time.Duration(s.EmptyAcquireCount)
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PowerReading is one entry in the house electrical log. Every measurement is
+// optional, but a valid reading carries at least one.
+//
+// StateOfCharge is the battery bank's charge in percent at RecordedAt.
+// SolarWh and GeneratorHours are amounts since the previous reading — usually
+// one day — so totals over a period are plain sums.
+type PowerReading struct {
+	ID             uuid.UUID
+	RecordedAt     time.Time
+	StateOfCharge  *float64
+	SolarWh        *float64
+	GeneratorHours *float64
+	TripID         *uuid.UUID
+	Notes          string
+	CreatedAt      time.Time
+}
+
+// PowerFilter narrows a power reading list. Zero values match all.
+type PowerFilter struct {
+	TripID *uuid.UUID
+}
+
+// PowerDay is one point on a power chart: the readings for one UTC calendar
+// day combined.
+//
+// MinStateOfCharge is the lowest charge seen that day — the figure that
+// matters for battery health — and is nil if no reading that day had one.
+type PowerDay struct {
+	Date             time.Time
+	MinStateOfCharge *float64
+	SolarWh          float64
+	GeneratorHours   float64
+	Readings         int
+}
+
+// TripPower is the chart data for a trip's power readings.
+type TripPower struct {
+	TripID              uuid.UUID
+	TotalSolarWh        float64
+	TotalGeneratorHours float64
+	MinStateOfCharge    *float64
+	Days                []PowerDay
+}
+
+// PowerByDay combines readings into one PowerDay per UTC calendar day that
+// has readings, ordered by date. Days with no readings are omitted rather
+// than reported as zero. The input slice is not modified.
+func PowerByDay(readings []PowerReading) []PowerDay {
+	byDay := map[time.Time]*PowerDay{}
+	for _, r := range readings {
+		date := r.RecordedAt.UTC().Truncate(24 * time.Hour)
+		d, ok := byDay[date]
+		if !ok {
+			d = &PowerDay{Date: date}
+			byDay[date] = d
+		}
+		d.Readings++
+		if r.SolarWh != nil {
+			d.SolarWh += *r.SolarWh
+		}
+		if r.GeneratorHours != nil {
+			d.GeneratorHours += *r.GeneratorHours
+		}
+		d.MinStateOfCharge = minPtr(d.MinStateOfCharge, r.StateOfCharge)
+	}
+
+	out := make([]PowerDay, 0, len(byDay))
+	for _, d := range byDay {
+		out = append(out, *d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out
+}
+
+// minPtr returns the smaller of two optional values, or whichever is set.
+func minPtr(a, b *float64) *float64 {
+	if b == nil {
+		return a
+	}
+	if a == nil || *b < *a {
+		v := *b
+		return &v
+	}
+	return a
+}
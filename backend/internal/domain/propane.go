@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PropaneUnit is the unit a propane fill was measured in.
+type PropaneUnit string
+
+const (
+	// PropaneGallons is US gallons, as metered at a pump.
+	PropaneGallons PropaneUnit = "gal"
+	// PropanePounds is pounds, as weighed when filling a cylinder.
+	PropanePounds PropaneUnit = "lb"
+)
+
+// PropanePoundsPerGallon converts weighed fills to gallons. Liquid propane
+// weighs about 4.24 lb per US gallon at 60 °F.
+const PropanePoundsPerGallon = 4.24
+
+// Valid reports whether u is a known unit.
+func (u PropaneUnit) Valid() bool {
+	return u == PropaneGallons || u == PropanePounds
+}
+
+// PropaneFill is one propane purchase. Price is the total paid and is nil
+// when the fill was not priced separately (e.g. included in a site fee).
+type PropaneFill struct {
+	ID        uuid.UUID
+	FilledAt  time.Time
+	Quantity  float64
+	Unit      PropaneUnit
+	Price     *float64
+	Location  string
+	TripID    *uuid.UUID
+	Notes     string
+	CreatedAt time.Time
+}
+
+// Gallons returns the fill quantity in US gallons.
+func (f PropaneFill) Gallons() float64 {
+	if f.Unit == PropanePounds {
+		return f.Quantity / PropanePoundsPerGallon
+	}
+	return f.Quantity
+}
+
+// PropaneFilter narrows a propane fill list. Zero values match all.
+type PropaneFilter struct {
+	TripID *uuid.UUID
+}
+
+// PropaneStats summarises a set of propane fills.
+//
+// GallonsPerDay is the consumption rate: the gallons bought after the first
+// fill divided by the days from the first fill to the last. The first fill's
+// gallons are excluded because they replaced propane burned before the
+// period started. It is nil with fewer than two fills on different days.
+//
+// AvgPricePerGallon covers priced fills only and is nil when none are priced.
+type PropaneStats struct {
+	Fills             int
+	TotalGallons      float64
+	TotalCost         float64
+	AvgPricePerGallon *float64
+	GallonsPerDay     *float64
+	FirstFillAt       *time.Time
+	LastFillAt        *time.Time
+}
+
+// ComputePropaneStats summarises fills, which must be ordered by FilledAt
+// ascending.
+func ComputePropaneStats(fills []PropaneFill) PropaneStats {
+	stats := PropaneStats{Fills: len(fills)}
+	if len(fills) == 0 {
+		return stats
+	}
+
+	var pricedGallons float64
+	for _, f := range fills {
+		g := f.Gallons()
+		stats.TotalGallons += g
+		if f.Price != nil {
+			stats.TotalCost += *f.Price
+			pricedGallons += g
+		}
+	}
+	if pricedGallons > 0 {
+		avg := stats.TotalCost / pricedGallons
+		stats.AvgPricePerGallon = &avg
+	}
+
+	first, last := fills[0].FilledAt, fills[len(fills)-1].FilledAt
+	stats.FirstFillAt, stats.LastFillAt = &first, &last
+	if days := last.Sub(first).Hours() / 24; days >= 1 {
+		rate := (stats.TotalGallons - fills[0].Gallons()) / days
+		stats.GallonsPerDay = &rate
+	}
+	return stats
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultReservationReminderDays is how far ahead of a cancellation deadline
+// an upcoming reservation is flagged when the caller does not say.
+const DefaultReservationReminderDays = 7
+
+// Reservation is a campground booking for a stop. CheckIn and CheckOut are
+// calendar dates (midnight UTC); CheckOut is the departure day. Cost is the
+// total booked and is nil when the site is paid on arrival.
+// CancellationDeadline is nil when the booking has no free-cancellation window.
+type Reservation struct {
+	ID                   uuid.UUID
+	StopID               uuid.UUID
+	ConfirmationNumber   string
+	SiteNumber           string
+	CheckIn              time.Time
+	CheckOut             time.Time
+	Cost                 *float64
+	CancellationDeadline *time.Time
+	Notes                string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// Nights returns the number of nights booked.
+func (r Reservation) Nights() int {
+	return int(r.CheckOut.Sub(r.CheckIn).Hours() / 24)
+}
+
+// UpcomingReservation is a reservation that has not ended yet, with the stop
+// it belongs to and the countdowns a trip planner needs.
+//
+// DaysUntilCheckIn is zero or negative once the stay has started.
+// CancellationReminder is true while the cancellation deadline is still ahead
+// but falls within the reminder window — the last chance to cancel for free.
+type UpcomingReservation struct {
+	Reservation          Reservation
+	TripID               uuid.UUID
+	StopName             string
+	DaysUntilCheckIn     int
+	CancellationReminder bool
+}
+
+// NewUpcomingReservation fills in the countdowns for r as of now. The
+// reminder window is remindWithin, measured back from the deadline.
+func NewUpcomingReservation(r Reservation, tripID uuid.UUID, stopName string, now time.Time, remindWithin time.Duration) UpcomingReservation {
+	today := now.UTC().Truncate(24 * time.Hour)
+	u := UpcomingReservation{
+		Reservation:      r,
+		TripID:           tripID,
+		StopName:         stopName,
+		DaysUntilCheckIn: int(r.CheckIn.Sub(today).Hours() / 24),
+	}
+	if d := r.CancellationDeadline; d != nil && d.After(now) && !d.After(now.Add(remindWithin)) {
+		u.CancellationReminder = true
+	}
+	return u
+}
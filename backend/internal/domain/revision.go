@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TripRevision is one version of a trip's editable fields. Revision counts
+// up from 1, the trip as created; every update adds the next one, so the
+// highest revision is the trip as it stands.
+type TripRevision struct {
+	TripID     uuid.UUID
+	Revision   int
+	Name       string
+	StartDate  Date
+	EndDate    *Date
+	Notes      string
+	RecordedAt time.Time
+}
+
+// Apply returns trip with its editable fields set as they were in r.
+func (r TripRevision) Apply(trip Trip) Trip {
+	trip.Name = r.Name
+	trip.StartDate = r.StartDate
+	trip.EndDate = r.EndDate
+	trip.Notes = r.Notes
+	return trip
+}
+
+// StopRevision is one version of a stop's editable fields, numbered as
+// TripRevision is. Tags are not versioned.
+type StopRevision struct {
+	StopID     uuid.UUID
+	Revision   int
+	Name       string
+	Location   string
+	Latitude   *float64
+	Longitude  *float64
+	ArrivedAt  time.Time
+	Planned    bool
+	DepartedAt *time.Time
+	Notes      string
+	RecordedAt time.Time
+}
+
+// Apply returns stop with its editable fields set as they were in r.
+func (r StopRevision) Apply(stop Stop) Stop {
+	stop.Name = r.Name
+	stop.Location = r.Location
+	stop.Latitude = r.Latitude
+	stop.Longitude = r.Longitude
+	stop.ArrivedAt = r.ArrivedAt
+	stop.Planned = r.Planned
+	stop.DepartedAt = r.DepartedAt
+	stop.Notes = r.Notes
+	return stop
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rig is a vehicle the organization travels in, like a motorhome or a
+// travel trailer and its tow truck. A trip records the rig it was taken in
+// (see Trip.RigID).
+//
+// Name is free text; using the label its odometer readings and maintenance
+// items are logged under (see OdometerReading.Vehicle) keeps them easy to
+// match up. Make and Model are empty and LengthFeet and WeightLbs nil when
+// not known.
+type Rig struct {
+	ID         uuid.UUID
+	Name       string
+	Make       string
+	Model      string
+	LengthFeet *float64
+	WeightLbs  *float64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RigMaintenance is one piece of work done on a rig, like an oil change or a
+// generator service. OdometerMiles and EngineHours are the readings when it
+// was done; either is nil when not recorded, since a generator is serviced
+// by its hours and a trailer has no engine at all.
+//
+// This is the rig's service history. Reminders of what is coming due are
+// MaintenanceItems, kept per vehicle label.
+type RigMaintenance struct {
+	ID            uuid.UUID
+	RigID         uuid.UUID
+	Name          string
+	ServicedOn    Date
+	OdometerMiles *float64
+	EngineHours   *float64
+	Notes         string
+	CreatedAt     time.Time
+}
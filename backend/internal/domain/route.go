@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RouteLeg is the drive from one stop of a trip to the next, in arrived_at
+// order. Legs are derived from the stop order — one per pair of consecutive
+// stops — while the distance, duration, and route geometry are entered by
+// hand and are nil (or empty) until someone does.
+//
+// Polyline is the route in Google's encoded polyline format. When a GPX track
+// has been uploaded for the leg, TrackKey names the original file in object
+// storage and Polyline holds a simplified copy of it.
+//
+// Elevation is nil until the leg's elevation profile has been looked up, and
+// is cleared whenever Polyline changes.
+type RouteLeg struct {
+	ID              uuid.UUID
+	TripID          uuid.UUID
+	FromStopID      uuid.UUID
+	ToStopID        uuid.UUID
+	DistanceMiles   *float64
+	DurationMinutes *int
+	Polyline        string
+	TrackKey        string
+	TrackPoints     int
+	TrackUploadedAt *time.Time
+	Elevation       *ElevationProfile
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// HasTrack reports whether a GPX track has been uploaded for the leg.
+func (l RouteLeg) HasTrack() bool { return l.TrackKey != "" }
+
+// ElevationProfile is the height of the ground along a leg's polyline,
+// sampled at evenly spaced points from its start to its end.
+type ElevationProfile struct {
+	// SpacingMeters is the distance along the route between samples.
+	SpacingMeters float64
+	// Meters is the elevation above sea level at each sample, in route order.
+	Meters    []float64
+	FetchedAt time.Time
+}
+
+// Climb returns the total ascent and descent along the profile, in meters.
+func (p ElevationProfile) Climb() (ascent, descent float64) {
+	for i := 1; i < len(p.Meters); i++ {
+		if d := p.Meters[i] - p.Meters[i-1]; d > 0 {
+			ascent += d
+		} else {
+			descent -= d
+		}
+	}
+	return ascent, descent
+}
+
+// Range returns the lowest and highest elevation along the profile, in
+// meters. An empty profile returns zeros.
+func (p ElevationProfile) Range() (lowest, highest float64) {
+	for i, m := range p.Meters {
+		if i == 0 || m < lowest {
+			lowest = m
+		}
+		if i == 0 || m > highest {
+			highest = m
+		}
+	}
+	return lowest, highest
+}
+
+// StopPair is an ordered pair of stops: the leg from From to To.
+type StopPair struct {
+	From uuid.UUID
+	To   uuid.UUID
+}
+
+// ConsecutiveStopPairs returns the pair for each stop and the one after it.
+// stops must already be in trip order (arrived_at ascending); fewer than two
+// stops yield no pairs.
+func ConsecutiveStopPairs(stops []Stop) []StopPair {
+	if len(stops) < 2 {
+		return nil
+	}
+	pairs := make([]StopPair, 0, len(stops)-1)
+	for i := 1; i < len(stops); i++ {
+		pairs = append(pairs, StopPair{From: stops[i-1].ID, To: stops[i].ID})
+	}
+	return pairs
+}
+
+// ValidPolyline reports whether s uses only the characters of the encoded
+// polyline alphabet (ASCII '?' through '~'). It does not decode s.
+func ValidPolyline(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '?' || s[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// TripStats summarises a trip's stops and the route legs between them.
+// Legs without a distance or duration are counted but add nothing to the
+// totals; LegsMissingDistance says how complete TotalDistanceMiles is.
+type TripStats struct {
+	TripID               uuid.UUID
+	Stops                int
+	Legs                 int
+	LegsMissingDistance  int
+	TotalDistanceMiles   float64
+	TotalDurationMinutes int
+}
+
+// SumRouteLegs adds up the distance and duration of legs into a TripStats
+// for a trip with the given number of stops.
+func SumRouteLegs(tripID uuid.UUID, stops int, legs []RouteLeg) TripStats {
+	st := TripStats{TripID: tripID, Stops: stops, Legs: len(legs)}
+	for _, l := range legs {
+		if l.DistanceMiles != nil {
+			st.TotalDistanceMiles += *l.DistanceMiles
+		} else {
+			st.LegsMissingDistance++
+		}
+		if l.DurationMinutes != nil {
+			st.TotalDurationMinutes += *l.DurationMinutes
+		}
+	}
+	return st
+}
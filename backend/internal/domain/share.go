@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Share is a read-only link to a trip that can be handed to someone without
+// an account. The link carries a signed token embedding the share ID and its
+// expiry; the Share record is what lets the owner list and revoke links
+// before they expire.
+type Share struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+
+	// OrganizationID is the organization the shared trip belongs to. A
+	// share is resolved without one, so it tells the reader where to look.
+	OrganizationID uuid.UUID
+
+	// OwnerID is the user who owns the shared trip, nil if no one does. Like
+	// OrganizationID, it lets the reader see the trip as its owner would.
+	OwnerID *uuid.UUID
+
+	// Token is the signed share token. It is only populated on the Share
+	// returned from creation — tokens are never stored.
+	Token string
+}
+
+// Active reports whether the share can still be used at time now.
+func (s Share) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SharedTrip is the read-only view of a trip exposed through a share link.
+type SharedTrip struct {
+	Trip      Trip
+	Stops     []Stop
+	ExpiresAt time.Time
+}
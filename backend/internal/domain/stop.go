@@ -7,18 +7,124 @@ import (
 )
 
 // Stop represents a single location visited during a trip.
+// A planned stop has not been reached yet: Planned is set, ArrivedAt is the
+// zero time, and it is stored with no arrival, so it sorts after the stops
+// already reached and counts toward no nights.
 // DepartedAt is nil when the traveller is still at this stop.
+// Latitude and Longitude are decimal degrees; both are nil when the stop has
+// only a free-text Location.
+// OdometerMiles is the odometer on arrival, if it was noted. Readings rise
+// with ArrivedAt across a trip's stops; a planned stop has none.
+// Metadata holds its custom field values (see CustomField).
+// NoteAnswers holds its answers to the NoteTemplate it was created from, which
+// NoteTemplateID names until the template is deleted; both are set only when
+// the stop is created.
 // Tags is populated when the stop is fetched from the repository;
 // it is always an initialised (non-nil) slice.
+// Daylight is worked out, not stored: it is set when a single stop with
+// coordinates is fetched, for planning an arrival before dark.
 type Stop struct {
-	ID         uuid.UUID
-	TripID     uuid.UUID
-	Name       string
-	Location   string
-	ArrivedAt  time.Time
-	DepartedAt *time.Time
-	Notes      string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	Tags       []Tag
+	ID             uuid.UUID
+	TripID         uuid.UUID
+	Name           string
+	Location       string
+	Latitude       *float64
+	Longitude      *float64
+	ArrivedAt      time.Time
+	Planned        bool
+	DepartedAt     *time.Time
+	Notes          string
+	OdometerMiles  *float64
+	Metadata       Metadata
+	NoteTemplateID *uuid.UUID
+	NoteAnswers    NoteAnswers
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Tags           []Tag
+	Daylight       []SunDay
+}
+
+// SunDay is when the sun rises and sets at a stop on one day of the stay,
+// Date being that day by local solar time at the stop. Sunrise and Sunset
+// are nil on a day the sun does not rise or set, in polar summer and winter.
+type SunDay struct {
+	Date    Date
+	Sunrise *time.Time
+	Sunset  *time.Time
+}
+
+// MaxSunDays is the most days of a stay its Daylight covers, so a season
+// spent at one park lists only its first month.
+const MaxSunDays = 31
+
+// HasCoordinates reports whether the stop has a latitude and longitude.
+func (s Stop) HasCoordinates() bool {
+	return s.Latitude != nil && s.Longitude != nil
+}
+
+// NearbyStop is a stop found by a radius search, with the name of its trip
+// and its distance from the search center along the Earth's surface.
+type NearbyStop struct {
+	Stop       Stop
+	TripName   string
+	DistanceKm float64
+}
+
+// BoundingBox is the area between two longitudes and two latitudes, in
+// decimal degrees. West is greater than East when the box crosses the
+// antimeridian.
+type BoundingBox struct {
+	West  float64
+	South float64
+	East  float64
+	North float64
+}
+
+// CrossesAntimeridian reports whether the box spans longitude ±180.
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.West > b.East
+}
+
+// StopCluster is a group of stops close enough together at some map zoom to
+// be drawn as one marker. Latitude and Longitude are the mean position of its
+// stops and Bounds the box around them.
+type StopCluster struct {
+	Latitude  float64
+	Longitude float64
+	Count     int
+	Bounds    BoundingBox
+	// Stop identifies the only stop in a cluster of one; nil otherwise.
+	Stop *ClusteredStop
+}
+
+// ClusteredStop is what a map marker needs to link to a stop.
+type ClusteredStop struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+	Name   string
+}
+
+// HeatmapCell is one square of a grid laid over the map in degrees, with the
+// nights spent at stops inside it. Latitude and Longitude are its center.
+type HeatmapCell struct {
+	Latitude  float64
+	Longitude float64
+	Nights    int
+}
+
+// Heatmap is where nights were spent, as the cells of a grid CellDegrees
+// wide that have at least one night, most nights first.
+type Heatmap struct {
+	CellDegrees float64
+	Cells       []HeatmapCell
+}
+
+// MaxNights returns the most nights spent in any one cell, or 0 for an
+// empty heatmap.
+func (h Heatmap) MaxNights() int {
+	most := 0
+	for _, c := range h.Cells {
+		most = max(most, c.Nights)
+	}
+	return most
 }
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TripSummary is the running totals for one trip's stops. Nights counts the
+// calendar days (UTC) from each stop's arrival to its departure, or one for
+// a stop not yet departed. FirstArrivedAt and LastArrivedAt are nil for a
+// trip with no stops.
+type TripSummary struct {
+	TripID         uuid.UUID
+	Name           string
+	StartDate      Date
+	EndDate        *Date
+	Stops          int
+	Nights         int
+	FirstArrivedAt *time.Time
+	LastArrivedAt  *time.Time
+}
+
+// TagCount is how many stops carry a tag.
+type TagCount struct {
+	Slug  string
+	Name  string
+	Stops int
+}
+
+// Dashboard is the overview of every trip and the most used tags.
+type Dashboard struct {
+	Trips []TripSummary
+	Tags  []TagCount
+}
+
+// TotalStops returns the stops logged across all trips.
+func (d Dashboard) TotalStops() int {
+	total := 0
+	for _, t := range d.Trips {
+		total += t.Stops
+	}
+	return total
+}
+
+// TotalNights returns the nights spent across all trips.
+func (d Dashboard) TotalNights() int {
+	total := 0
+	for _, t := range d.Trips {
+		total += t.Nights
+	}
+	return total
+}
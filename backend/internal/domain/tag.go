@@ -10,9 +10,23 @@ import (
 // Tags are global — not owned by any trip or stop.
 // Identity is determined by Slug, which is always lowercase and hyphenated.
 // Name preserves the original casing supplied by the first user to create the tag.
+//
+// Parent is the slug of the tag this one sits under, or empty for a top-level
+// tag. Only the tag endpoints load it; the tags embedded in a stop leave it
+// empty.
 type Tag struct {
 	ID        uuid.UUID
 	Name      string
 	Slug      string
+	Parent    string
 	CreatedAt time.Time
 }
+
+// TagStats counts the stops carrying a tag. Stops counts those tagged with it
+// directly; TotalStops also counts those tagged with any tag beneath it, each
+// stop once however many of the tags it carries.
+type TagStats struct {
+	Tag
+	Stops      int
+	TotalStops int
+}
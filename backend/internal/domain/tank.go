@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TankLevel is a reading of the holding tanks taken at a stop. Levels are
+// percentages of capacity, as shown on the monitor panel; a nil level was not
+// read. A valid reading carries at least one level.
+type TankLevel struct {
+	ID         uuid.UUID
+	StopID     uuid.UUID
+	RecordedAt time.Time
+	FreshPct   *int
+	GreyPct    *int
+	BlackPct   *int
+	Notes      string
+	CreatedAt  time.Time
+}
+
+// DumpEvent records emptying the grey and black tanks at a dump station.
+// Location names the station, which is often not the stop itself (e.g. a
+// travel centre on the way out of a park).
+type DumpEvent struct {
+	ID        uuid.UUID
+	StopID    uuid.UUID
+	DumpedAt  time.Time
+	Location  string
+	Notes     string
+	CreatedAt time.Time
+}
+
+// CurrentTrip is the trip in progress together with the tank status that
+// matters on the road.
+//
+// LastDump is the most recent dump on any trip — tanks do not empty between
+// trips — and DaysSinceLastDump counts whole days since it. Both are nil if
+// no dump has been logged.
+type CurrentTrip struct {
+	Trip              Trip
+	LastDump          *DumpEvent
+	DaysSinceLastDump *int
+}
+
+// TripInProgress returns the trip that covers now: started on or before
+// now's date and not ended before it (a nil EndDate is still in progress).
+// If several overlap, the one that started last wins. now's date is the day
+// on its own calendar, so an evening arrival logged with a local offset
+// belongs to that evening's trip, not the next day's.
+func TripInProgress(trips []Trip, now time.Time) (Trip, bool) {
+	today := DateOf(now)
+	var (
+		current Trip
+		found   bool
+	)
+	for _, t := range trips {
+		if t.StartDate.After(today) {
+			continue
+		}
+		if t.EndDate != nil && t.EndDate.Before(today) {
+			continue
+		}
+		if !found || t.StartDate.After(current.StartDate) {
+			current, found = t, true
+		}
+	}
+	return current, found
+}
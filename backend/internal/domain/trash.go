@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashRetention is how long a deleted trip or stop stays in the trash
+// before it is purged for good.
+const TrashRetention = 30 * 24 * time.Hour
+
+// TrashKind says whether a trash item is a trip or a stop.
+type TrashKind string
+
+const (
+	TrashTrip TrashKind = "trip"
+	TrashStop TrashKind = "stop"
+)
+
+// TrashItem is a deleted trip or stop that can still be restored. The stops
+// of a trashed trip are not items of their own: they come back with the
+// trip. TripID and TripName are the stop's trip, or the trip itself.
+type TrashItem struct {
+	ID        uuid.UUID
+	Kind      TrashKind
+	Name      string
+	TripID    uuid.UUID
+	TripName  string
+	DeletedAt time.Time
+}
+
+// PurgeAt returns when the item leaves the trash for good.
+func (i TrashItem) PurgeAt() time.Time {
+	return i.DeletedAt.Add(TrashRetention)
+}
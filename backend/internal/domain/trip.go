@@ -12,11 +12,49 @@ import (
 // Trip represents a single RV trip from start to finish.
 // A trip is the top-level aggregate; stops belong to a trip.
 type Trip struct {
-	ID        uuid.UUID  `json:"id"`
-	Name      string     `json:"name"`
-	StartDate time.Time  `json:"start_date"`
-	EndDate   *time.Time `json:"end_date,omitempty"` // nil when trip is still in progress
-	Notes     string     `json:"notes,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	StartDate Date      `json:"start_date"`
+	EndDate   *Date     `json:"end_date,omitempty"` // nil when trip is still in progress
+	Notes     string    `json:"notes,omitempty"`
+	Metadata  Metadata  `json:"metadata,omitempty"` // custom field values; see CustomField
+	// UserID is the user who logged the trip; nil when no one was logged in.
+	// Only its owner sees an owned trip. It is set on create from the
+	// request's user and never changes.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// RigID is the rig the trip was taken in; nil when none was recorded.
+	RigID *uuid.UUID `json:"rig_id,omitempty"`
+	// BudgetCents is what the trip is expected to cost, in cents; nil when
+	// no budget was set. See BudgetReport.
+	BudgetCents *int64 `json:"budget_cents,omitempty"`
+	// DistanceTraveled is the miles between the lowest and highest odometer
+	// readings on the trip's stops; nil until two stops have one. It is
+	// worked out when the trip is read and ignored on write.
+	DistanceTraveled *float64  `json:"distance_traveled,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// TripClone describes a copy to make of a trip, such as to run last year's
+// trip again. The copy takes the source's dates, notes, metadata, rig, and
+// budget. Name is its name; blank copies the source's with " (copy)" added.
+// StartDate moves the copy to start on that day, with every date and time
+// in it shifted by the same whole number of days so the spacing between
+// them stays as it was; nil keeps the source's dates.
+type TripClone struct {
+	Name      string
+	StartDate *Date
+	Parts     TripCloneParts
+}
+
+// TripCloneParts chooses what a trip's copy takes besides the trip itself.
+// Stops are copied without their odometer readings, photos, or history.
+// Tags and Checklists are those of the stops, so both need Stops; copied
+// checklists start with nothing checked. Expenses keep their stop when it
+// is copied too.
+type TripCloneParts struct {
+	Stops      bool
+	Tags       bool
+	Expenses   bool
+	Checklists bool
 }
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TripRole is what a user may do with a trip. The trip's owner, the user
+// who logged it, may do anything, including delete it and manage its
+// members. An editor may change the trip and everything logged against it;
+// a viewer may only read it.
+type TripRole string
+
+const (
+	TripRoleOwner  TripRole = "owner"
+	TripRoleEditor TripRole = "editor"
+	TripRoleViewer TripRole = "viewer"
+)
+
+// Valid reports whether r is a role a member can be given. Ownership
+// comes with the trip and cannot be given away.
+func (r TripRole) Valid() bool {
+	return r == TripRoleEditor || r == TripRoleViewer
+}
+
+// CanEdit reports whether r may change the trip and what is logged against
+// it.
+func (r TripRole) CanEdit() bool {
+	return r == TripRoleOwner || r == TripRoleEditor
+}
+
+// TripMember is a user the trip's owner has invited to collaborate on it.
+// Members see the trip as if it were their own, within what Role allows.
+type TripMember struct {
+	TripID    uuid.UUID
+	UserID    uuid.UUID
+	Username  string
+	Role      TripRole
+	CreatedAt time.Time
+}
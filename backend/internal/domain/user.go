@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User is an account that can log in. Trips a user logs are theirs alone;
+// see Trip.UserID.
+type User struct {
+	ID       uuid.UUID
+	Username string
+	// PasswordHash is the password as hashed by auth.HashPassword. It never
+	// leaves the server.
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type userKey struct{}
+
+// WithUser returns a child context acting for the user id. The trip and
+// stop repositories show it only unowned trips and its own.
+func WithUser(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, userKey{}, id)
+}
+
+// UserFromContext returns the user ctx acts for. The boolean is false for
+// anonymous requests, which see only unowned trips.
+func UserFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(userKey{}).(uuid.UUID)
+	return id, ok
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEvent names something that happened in the logbook that a webhook
+// can subscribe to.
+type WebhookEvent string
+
+const (
+	EventTripCreated WebhookEvent = "trip.created"
+	EventTripUpdated WebhookEvent = "trip.updated"
+	EventTripDeleted WebhookEvent = "trip.deleted"
+
+	EventStopCreated WebhookEvent = "stop.created"
+	EventStopUpdated WebhookEvent = "stop.updated"
+	EventStopDeleted WebhookEvent = "stop.deleted"
+
+	// EventMaintenanceDue is sent when an odometer reading brings a
+	// maintenance item due soon or overdue.
+	EventMaintenanceDue WebhookEvent = "maintenance.due"
+
+	// The events about the records logged on a trip, its stops, or the rig.
+	// Their data is a RecordEventData. Fuel fill-ups are fuel expenses.
+	EventExpenseCreated         WebhookEvent = "expense.created"
+	EventExpenseUpdated         WebhookEvent = "expense.updated"
+	EventExpenseDeleted         WebhookEvent = "expense.deleted"
+	EventChecklistCreated       WebhookEvent = "checklist.created"
+	EventChecklistUpdated       WebhookEvent = "checklist.updated"
+	EventChecklistDeleted       WebhookEvent = "checklist.deleted"
+	EventJournalEntryCreated    WebhookEvent = "journal_entry.created"
+	EventJournalEntryUpdated    WebhookEvent = "journal_entry.updated"
+	EventJournalEntryDeleted    WebhookEvent = "journal_entry.deleted"
+	EventBorderCrossingCreated  WebhookEvent = "border_crossing.created"
+	EventBorderCrossingUpdated  WebhookEvent = "border_crossing.updated"
+	EventBorderCrossingDeleted  WebhookEvent = "border_crossing.deleted"
+	EventReservationCreated     WebhookEvent = "reservation.created"
+	EventReservationUpdated     WebhookEvent = "reservation.updated"
+	EventReservationDeleted     WebhookEvent = "reservation.deleted"
+	EventPhotoCreated           WebhookEvent = "photo.created"
+	EventPhotoDeleted           WebhookEvent = "photo.deleted"
+	EventTankLevelCreated       WebhookEvent = "tank_level.created"
+	EventTankLevelDeleted       WebhookEvent = "tank_level.deleted"
+	EventDumpCreated            WebhookEvent = "dump.created"
+	EventDumpDeleted            WebhookEvent = "dump.deleted"
+	EventRouteLegUpdated        WebhookEvent = "route_leg.updated"
+	EventPropaneFillCreated     WebhookEvent = "propane_fill.created"
+	EventPropaneFillDeleted     WebhookEvent = "propane_fill.deleted"
+	EventPowerReadingCreated    WebhookEvent = "power_reading.created"
+	EventPowerReadingDeleted    WebhookEvent = "power_reading.deleted"
+	EventOdometerReadingCreated WebhookEvent = "odometer_reading.created"
+	EventOdometerReadingDeleted WebhookEvent = "odometer_reading.deleted"
+
+	// EventPing is sent only by a test delivery; it cannot be subscribed to.
+	EventPing WebhookEvent = "ping"
+)
+
+// Subscribable reports whether e is an event a webhook may subscribe to.
+func (e WebhookEvent) Subscribable() bool {
+	switch e {
+	case EventTripCreated, EventTripUpdated, EventTripDeleted,
+		EventStopCreated, EventStopUpdated, EventStopDeleted, EventMaintenanceDue,
+		EventExpenseCreated, EventExpenseUpdated, EventExpenseDeleted,
+		EventChecklistCreated, EventChecklistUpdated, EventChecklistDeleted,
+		EventJournalEntryCreated, EventJournalEntryUpdated, EventJournalEntryDeleted,
+		EventBorderCrossingCreated, EventBorderCrossingUpdated, EventBorderCrossingDeleted,
+		EventReservationCreated, EventReservationUpdated, EventReservationDeleted,
+		EventPhotoCreated, EventPhotoDeleted,
+		EventTankLevelCreated, EventTankLevelDeleted, EventDumpCreated, EventDumpDeleted,
+		EventRouteLegUpdated,
+		EventPropaneFillCreated, EventPropaneFillDeleted,
+		EventPowerReadingCreated, EventPowerReadingDeleted,
+		EventOdometerReadingCreated, EventOdometerReadingDeleted:
+		return true
+	}
+	return false
+}
+
+// Webhook is a subscription: every event in Events is POSTed to URL while
+// Active, signed with Secret. Secret is only shown when it is created or
+// rotated. UserID is the user who registered it, nil when no one was
+// signed in; events on a trip reach it only if that user can see the trip.
+type Webhook struct {
+	ID          uuid.UUID
+	UserID      *uuid.UUID
+	URL         string
+	Events      []WebhookEvent
+	Description string
+	Active      bool
+	Secret      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Subscribes reports whether w is active and subscribed to e.
+func (w Webhook) Subscribes(e WebhookEvent) bool {
+	if !w.Active {
+		return false
+	}
+	for _, event := range w.Events {
+		if event == e {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is one attempt to deliver an event to a webhook.
+// StatusCode is 0 when no response came back, and Error then says why; a
+// response outside 2xx is also a failure.
+type WebhookDelivery struct {
+	ID          uuid.UUID
+	WebhookID   uuid.UUID
+	Event       WebhookEvent
+	Payload     json.RawMessage
+	StatusCode  int
+	Error       string
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
+
+// Succeeded reports whether the subscriber accepted the delivery.
+func (d WebhookDelivery) Succeeded() bool {
+	return d.Error == "" && d.StatusCode >= 200 && d.StatusCode < 300
+}
+
+// WebhookPayload is the JSON body of a delivery.
+type WebhookPayload struct {
+	ID         uuid.UUID    `json:"id"`
+	Event      WebhookEvent `json:"event"`
+	OccurredAt time.Time    `json:"occurred_at"`
+	Data       any          `json:"data"`
+}
+
+// TripEventData is the data of a trip event. A trip.deleted event carries
+// only the ID.
+type TripEventData struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name,omitempty"`
+	StartDate *Date     `json:"start_date,omitempty"`
+	EndDate   *Date     `json:"end_date,omitempty"`
+}
+
+// TripEvent returns the event data for t.
+func TripEvent(t Trip) TripEventData {
+	start := t.StartDate
+	return TripEventData{ID: t.ID, Name: t.Name, StartDate: &start, EndDate: t.EndDate}
+}
+
+// StopEventData is the data of a stop event. A stop.deleted event carries
+// only the IDs.
+type StopEventData struct {
+	ID         uuid.UUID  `json:"id"`
+	TripID     uuid.UUID  `json:"trip_id"`
+	Name       string     `json:"name,omitempty"`
+	Location   string     `json:"location,omitempty"`
+	Latitude   *float64   `json:"latitude,omitempty"`
+	Longitude  *float64   `json:"longitude,omitempty"`
+	ArrivedAt  *time.Time `json:"arrived_at,omitempty"`
+	Planned    bool       `json:"planned,omitempty"`
+	DepartedAt *time.Time `json:"departed_at,omitempty"`
+}
+
+// StopEvent returns the event data for s. A planned stop has no arrived_at.
+func StopEvent(s Stop) StopEventData {
+	data := StopEventData{
+		ID:         s.ID,
+		TripID:     s.TripID,
+		Name:       s.Name,
+		Location:   s.Location,
+		Latitude:   s.Latitude,
+		Longitude:  s.Longitude,
+		Planned:    s.Planned,
+		DepartedAt: s.DepartedAt,
+	}
+	if !s.Planned {
+		arrived := s.ArrivedAt
+		data.ArrivedAt = &arrived
+	}
+	return data
+}
+
+// RecordEventData is the data of an event about a record logged on a trip,
+// one of its stops, or the rig: an expense, a checklist, a propane fill, and
+// so on. It carries only IDs; a subscriber that needs the record fetches it.
+// TripID is omitted for a reading logged against no trip, and StopID for a
+// record that is not tied to a stop and from expense.deleted and
+// journal_entry.deleted.
+type RecordEventData struct {
+	ID     uuid.UUID  `json:"id"`
+	TripID *uuid.UUID `json:"trip_id,omitempty"`
+	StopID *uuid.UUID `json:"stop_id,omitempty"`
+}
+
+// TripRecordEvent returns the event data for a record on a trip, and on
+// stopID unless it is nil.
+func TripRecordEvent(id, tripID uuid.UUID, stopID *uuid.UUID) RecordEventData {
+	return RecordEventData{ID: id, TripID: &tripID, StopID: stopID}
+}
+
+// EventTripID returns the trip an event's data is about, or nil for an
+// event about no trip, such as maintenance.due or a reading logged against
+// no trip.
+func EventTripID(data any) *uuid.UUID {
+	switch d := data.(type) {
+	case TripEventData:
+		return &d.ID
+	case StopEventData:
+		return &d.TripID
+	case RecordEventData:
+		return d.TripID
+	}
+	return nil
+}
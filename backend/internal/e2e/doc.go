@@ -0,0 +1,13 @@
+// Package e2e holds end-to-end tests for the API. They boot the server
+// exactly as cmd/api does — app.New with the production router, middleware,
+// and real services — against a private Postgres schema, then drive it over
+// real HTTP through multi-step user flows.
+//
+// Contrast with:
+//   - internal/handler tests: handler only, services are mocks
+//   - internal/apitest:       real repos and services, minimal hand-built router
+//   - e2e (this package):     the shipped wiring, end to end
+//
+// The tests are tagged integration and need TEST_DATABASE_URL, or docker or
+// podman for a throwaway container; see CONTRIBUTING.md § Testing Layers.
+package e2e
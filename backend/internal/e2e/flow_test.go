@@ -0,0 +1,359 @@
+//go:build integration
+
+package e2e_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// TestFlow_PlanTagExport walks the core logbook flow: create a trip, log
+// stops on it, tag them, and export everything as JSON and CSV.
+func TestFlow_PlanTagExport(t *testing.T) {
+	t.Parallel()
+	c := startServer(t)
+
+	var trip gen.Trip
+	c.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Utah Parks",
+		"start_date": "2025-04-01",
+		"end_date":   "2025-04-10",
+	}, http.StatusCreated, &trip)
+
+	arrive := time.Date(2025, 4, 1, 17, 0, 0, 0, time.UTC)
+	var zion, arches gen.Stop
+	c.json(http.MethodPost, "/trips/"+trip.Id.String()+"/stops", map[string]any{
+		"name":       "Watchman Campground",
+		"location":   "Springdale, UT",
+		"arrived_at": arrive,
+	}, http.StatusCreated, &zion)
+	c.json(http.MethodPost, "/trips/"+trip.Id.String()+"/stops", map[string]any{
+		"name":       "Devils Garden",
+		"location":   "Moab, UT",
+		"arrived_at": arrive.Add(72 * time.Hour),
+	}, http.StatusCreated, &arches)
+
+	zionTags := "/trips/" + trip.Id.String() + "/stops/" + zion.Id.String() + "/tags"
+	archesTags := "/trips/" + trip.Id.String() + "/stops/" + arches.Id.String() + "/tags"
+	var tag gen.Tag
+	c.json(http.MethodPost, zionTags, map[string]any{"name": "National Park"}, http.StatusCreated, &tag)
+	assert.Equal(t, "national-park", tag.Slug)
+	c.json(http.MethodPost, zionTags, map[string]any{"name": "Hookups"}, http.StatusCreated, nil)
+	// The same name on another stop reuses the tag rather than creating a duplicate.
+	c.json(http.MethodPost, archesTags, map[string]any{"name": "national park"}, http.StatusCreated, &tag)
+	assert.Equal(t, "national-park", tag.Slug)
+
+	var tags gen.TagList
+	c.json(http.MethodGet, "/tags", nil, http.StatusOK, &tags)
+	assert.Equal(t, 2, tags.Pagination.Total)
+
+	var stopTags []gen.Tag
+	c.json(http.MethodGet, zionTags, nil, http.StatusOK, &stopTags)
+	require.Len(t, stopTags, 2)
+	assert.Equal(t, "hookups", stopTags[0].Slug)
+
+	var rows []gen.ExportRow
+	c.json(http.MethodGet, "/export", nil, http.StatusOK, &rows)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "Utah Parks", rows[0].TripName)
+	require.NotNil(t, rows[0].StopName)
+	assert.Equal(t, "Watchman Campground", *rows[0].StopName)
+	assert.Equal(t, []string{"hookups", "national-park"}, rows[0].Tags)
+	assert.Equal(t, []string{"national-park"}, rows[1].Tags)
+
+	resp, body := c.send(http.MethodGet, "/export?format=csv", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/csv")
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3, "header plus one row per stop")
+	assert.Equal(t, "Devils Garden", records[2][4])
+
+	// Deleting the trip cascades to its stops, so the export empties out.
+	c.json(http.MethodDelete, "/trips/"+trip.Id.String(), nil, http.StatusNoContent, nil)
+	c.json(http.MethodGet, "/export", nil, http.StatusOK, &rows)
+	assert.Empty(t, rows)
+}
+
+// TestFlow_ShareLink issues a share link, views the trip through it, and
+// checks that revoking the link cuts off access.
+func TestFlow_ShareLink(t *testing.T) {
+	t.Parallel()
+	c := startServer(t)
+
+	var trip gen.Trip
+	c.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Gulf Coast",
+		"start_date": "2025-02-01",
+	}, http.StatusCreated, &trip)
+	c.json(http.MethodPost, "/trips/"+trip.Id.String()+"/stops", map[string]any{
+		"name":       "Gulf State Park",
+		"arrived_at": time.Date(2025, 2, 1, 20, 0, 0, 0, time.UTC),
+	}, http.StatusCreated, nil)
+
+	var link gen.ShareLink
+	c.json(http.MethodPost, "/trips/"+trip.Id.String()+"/shares", map[string]any{
+		"expires_in_hours": 24,
+	}, http.StatusCreated, &link)
+	require.NotEmpty(t, link.Token)
+
+	var shared gen.SharedTrip
+	c.json(http.MethodGet, "/shared/"+link.Token, nil, http.StatusOK, &shared)
+	assert.Equal(t, "Gulf Coast", shared.Trip.Name)
+	require.Len(t, shared.Stops, 1)
+	assert.Equal(t, "Gulf State Park", shared.Stops[0].Name)
+
+	var shares gen.ShareList
+	c.json(http.MethodGet, "/trips/"+trip.Id.String()+"/shares", nil, http.StatusOK, &shares)
+	require.Len(t, shares.Data, 1)
+
+	c.json(http.MethodDelete, "/trips/"+trip.Id.String()+"/shares/"+link.Id.String(), nil, http.StatusNoContent, nil)
+	c.json(http.MethodGet, "/shared/"+link.Token, nil, http.StatusNotFound, nil)
+}
+
+// TestFlow_ProductionMiddleware checks that requests pass through the same
+// middleware stack and extra routes as production, not a test-only router.
+func TestFlow_ProductionMiddleware(t *testing.T) {
+	t.Parallel()
+	c := startServer(t)
+
+	resp, _ := c.send(http.MethodGet, "/healthz", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+	assert.Equal(t, "DENY", resp.Header.Get("X-Frame-Options"))
+
+	resp, _ = c.send(http.MethodGet, "/openapi.yaml", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// TestFlow_OdometerMileage logs odometer readings over a trip and reads back
+// the mileage derived from them.
+func TestFlow_OdometerMileage(t *testing.T) {
+	t.Parallel()
+	c := startServer(t)
+
+	var trip gen.Trip
+	c.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Black Hills",
+		"start_date": "2025-07-01",
+	}, http.StatusCreated, &trip)
+
+	t0 := time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC)
+	for i, miles := range []float64{52000, 52180.4, 52333} {
+		c.json(http.MethodPost, "/odometer-readings", map[string]any{
+			"vehicle":     "Motorhome",
+			"miles":       miles,
+			"recorded_at": t0.Add(time.Duration(i) * 24 * time.Hour),
+			"trip_id":     trip.Id,
+		}, http.StatusCreated, nil)
+	}
+	// Going backwards is rejected.
+	c.json(http.MethodPost, "/odometer-readings", map[string]any{
+		"vehicle":     "Motorhome",
+		"miles":       51000,
+		"recorded_at": t0.Add(96 * time.Hour),
+	}, http.StatusUnprocessableEntity, nil)
+
+	var m gen.TripMileage
+	c.json(http.MethodGet, "/trips/"+trip.Id.String()+"/mileage", nil, http.StatusOK, &m)
+	require.Len(t, m.Vehicles, 1)
+	assert.InDelta(t, 333, m.TotalMiles, 0.001)
+
+	var list gen.OdometerReadingList
+	c.json(http.MethodGet, "/odometer-readings?trip_id="+trip.Id.String(), nil, http.StatusOK, &list)
+	assert.Equal(t, 3, list.Pagination.Total)
+}
+
+// TestFlow_TripRig records which rig a trip was taken in, and checks that
+// deleting the rig keeps the trip.
+func TestFlow_TripRig(t *testing.T) {
+	t.Parallel()
+	c := startServer(t)
+
+	var rig gen.Rig
+	c.json(http.MethodPost, "/rigs", map[string]any{
+		"name":        "Tow car",
+		"make":        "Jeep",
+		"length_feet": 15.5,
+	}, http.StatusCreated, &rig)
+
+	var trip gen.Trip
+	c.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Moab",
+		"start_date": "2025-09-10",
+		"rig_id":     rig.Id,
+	}, http.StatusCreated, &trip)
+	require.NotNil(t, trip.RigId)
+	assert.Equal(t, rig.Id, *trip.RigId)
+	// A trip cannot name a rig that does not exist.
+	c.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Nowhere",
+		"start_date": "2025-09-10",
+		"rig_id":     uuid.New(),
+	}, http.StatusUnprocessableEntity, nil)
+
+	c.json(http.MethodDelete, "/rigs/"+rig.Id.String(), nil, http.StatusNoContent, nil)
+	var got gen.Trip
+	c.json(http.MethodGet, "/trips/"+trip.Id.String(), nil, http.StatusOK, &got)
+	assert.Nil(t, got.RigId)
+}
+
+// TestFlow_UserOwnedTrips logs in as two users and checks that a trip one of
+// them logs is hidden from the other, while its share link still works.
+func TestFlow_UserOwnedTrips(t *testing.T) {
+	t.Parallel()
+	anon := startServerWithUsers(t, "alice:s3cret,bob:hunter2")
+	anon.json(http.MethodGet, "/trips", nil, http.StatusUnauthorized, nil)
+	anon.json(http.MethodPost, "/auth/token", map[string]any{"username": "alice", "password": "wrong"}, http.StatusUnauthorized, nil)
+	alice := anon.login("alice", "s3cret")
+	bob := anon.login("bob", "hunter2")
+
+	var trip gen.Trip
+	alice.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Outer Banks",
+		"start_date": "2025-09-01",
+	}, http.StatusCreated, &trip)
+	path := "/trips/" + trip.Id.String()
+
+	alice.json(http.MethodGet, path, nil, http.StatusOK, nil)
+	bob.json(http.MethodGet, path, nil, http.StatusNotFound, nil)
+	bob.json(http.MethodDelete, path, nil, http.StatusNotFound, nil)
+	alice.json(http.MethodPost, path+"/stops", map[string]any{
+		"name":       "Cape Point",
+		"arrived_at": time.Date(2025, 9, 1, 18, 0, 0, 0, time.UTC),
+	}, http.StatusCreated, nil)
+	var stops gen.StopList
+	bob.json(http.MethodGet, path+"/stops", nil, http.StatusOK, &stops)
+	assert.Empty(t, stops.Data, "nor its stops")
+
+	var list gen.TripList
+	bob.json(http.MethodGet, "/trips", nil, http.StatusOK, &list)
+	assert.Empty(t, list.Data)
+	alice.json(http.MethodGet, "/trips", nil, http.StatusOK, &list)
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, trip.Id, list.Data[0].Id)
+
+	var link gen.ShareLink
+	alice.json(http.MethodPost, path+"/shares", map[string]any{"expires_in_hours": 24}, http.StatusCreated, &link)
+	var shared gen.SharedTrip
+	anon.json(http.MethodGet, "/shared/"+link.Token, nil, http.StatusOK, &shared)
+	assert.Equal(t, "Outer Banks", shared.Trip.Name)
+
+	// The embed is public too, and other sites may frame it.
+	resp, page := anon.send(http.MethodGet, "/shared/"+link.Token+"/embed?format=html", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("X-Frame-Options"))
+	assert.Contains(t, string(page), "Outer Banks")
+}
+
+// TestFlow_APIKey creates an API key, scripts against the API with it in
+// place of a login, and checks that it stops working once revoked.
+func TestFlow_APIKey(t *testing.T) {
+	t.Parallel()
+	anon := startServerWithUsers(t, "alice:s3cret,bob:hunter2")
+	alice := anon.login("alice", "s3cret")
+	bob := anon.login("bob", "hunter2")
+
+	var key gen.APIKey
+	alice.json(http.MethodPost, "/api-keys", map[string]any{"name": "Pi tank monitor"}, http.StatusCreated, &key)
+	require.NotNil(t, key.Key)
+	pi := anon.withAPIKey(*key.Key)
+
+	var trip gen.Trip
+	pi.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Blue Ridge",
+		"start_date": "2025-10-01",
+	}, http.StatusCreated, &trip)
+	alice.json(http.MethodGet, "/trips/"+trip.Id.String(), nil, http.StatusOK, nil)
+	bob.json(http.MethodGet, "/trips/"+trip.Id.String(), nil, http.StatusNotFound, nil)
+
+	var keys []gen.APIKey
+	alice.json(http.MethodGet, "/api-keys", nil, http.StatusOK, &keys)
+	require.Len(t, keys, 1)
+	assert.Nil(t, keys[0].Key, "the key is shown only once")
+	bob.json(http.MethodGet, "/api-keys", nil, http.StatusOK, &keys)
+	assert.Empty(t, keys)
+	bob.json(http.MethodDelete, "/api-keys/"+key.Id.String(), nil, http.StatusNotFound, nil)
+
+	alice.json(http.MethodDelete, "/api-keys/"+key.Id.String(), nil, http.StatusNoContent, nil)
+	pi.json(http.MethodGet, "/trips", nil, http.StatusUnauthorized, nil)
+}
+
+// TestFlow_TripMembers shares a trip with one user as a viewer and another
+// as an editor, and checks what each may then do with it.
+func TestFlow_TripMembers(t *testing.T) {
+	t.Parallel()
+	anon := startServerWithUsers(t, "alice:s3cret,bob:hunter2,carol:pa55word")
+	alice := anon.login("alice", "s3cret")
+	bob := anon.login("bob", "hunter2")
+	carol := anon.login("carol", "pa55word")
+
+	var trip gen.Trip
+	alice.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Great Smokies",
+		"start_date": "2025-10-10",
+	}, http.StatusCreated, &trip)
+	path := "/trips/" + trip.Id.String()
+	update := map[string]any{"name": "Great Smoky Mountains", "start_date": "2025-10-10"}
+
+	var viewer, editor gen.TripMember
+	alice.json(http.MethodPost, path+"/members", map[string]any{"username": "bob", "role": "viewer"}, http.StatusCreated, &viewer)
+	alice.json(http.MethodPost, path+"/members", map[string]any{"username": "carol", "role": "editor"}, http.StatusCreated, &editor)
+	alice.json(http.MethodPost, path+"/members", map[string]any{"username": "bob", "role": "editor"}, http.StatusUnprocessableEntity, nil)
+
+	bob.json(http.MethodGet, path, nil, http.StatusOK, nil)
+	bob.json(http.MethodPut, path, update, http.StatusForbidden, nil)
+	bob.json(http.MethodDelete, path, nil, http.StatusForbidden, nil)
+	bob.json(http.MethodPost, path+"/stops", map[string]any{"name": "Clingmans Dome"}, http.StatusForbidden, nil)
+
+	carol.json(http.MethodPut, path, update, http.StatusOK, nil)
+	carol.json(http.MethodPost, path+"/stops", map[string]any{
+		"name":       "Clingmans Dome",
+		"arrived_at": time.Date(2025, 10, 10, 15, 0, 0, 0, time.UTC),
+	}, http.StatusCreated, nil)
+	carol.json(http.MethodDelete, path, nil, http.StatusForbidden, nil)
+	carol.json(http.MethodPost, path+"/members", map[string]any{"username": "bob", "role": "editor"}, http.StatusForbidden, nil)
+
+	var stops gen.StopList
+	bob.json(http.MethodGet, path+"/stops", nil, http.StatusOK, &stops)
+	assert.Len(t, stops.Data, 1, "the viewer sees the editor's stop")
+
+	alice.json(http.MethodPatch, path+"/members/"+viewer.UserId.String(), map[string]any{"role": "editor"}, http.StatusOK, nil)
+	bob.json(http.MethodPut, path, update, http.StatusOK, nil)
+
+	alice.json(http.MethodDelete, path+"/members/"+editor.UserId.String(), nil, http.StatusNoContent, nil)
+	carol.json(http.MethodGet, path, nil, http.StatusNotFound, nil)
+	var members []gen.TripMember
+	alice.json(http.MethodGet, path+"/members", nil, http.StatusOK, &members)
+	require.Len(t, members, 1)
+	assert.Equal(t, "bob", members[0].Username)
+}
+
+// TestFlow_AdminOnly checks that the /admin routes answer only to
+// ADMIN_USERS: owning a trip is not enough to run a data fix on it.
+func TestFlow_AdminOnly(t *testing.T) {
+	t.Parallel()
+	anon := startServerWithUsers(t, "alice:s3cret,root:t0psecret", "root")
+	alice := anon.login("alice", "s3cret")
+	root := anon.login("root", "t0psecret")
+
+	var trip gen.Trip
+	alice.json(http.MethodPost, "/trips", map[string]any{
+		"name":       "Lake Powell",
+		"start_date": "2025-11-01",
+	}, http.StatusCreated, &trip)
+	shift := "/admin/trips/" + trip.Id.String() + "/shift-dates"
+
+	alice.json(http.MethodPost, shift, map[string]any{"days": 7}, http.StatusForbidden, nil)
+	alice.json(http.MethodGet, "/admin/pool", nil, http.StatusForbidden, nil)
+	root.json(http.MethodGet, "/admin/pool", nil, http.StatusOK, nil)
+}
@@ -0,0 +1,20 @@
+//go:build integration
+
+package e2e_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// TestMain only provisions the database (or a throwaway container). There is
+// no shared migration step: every test gets its own migrated schema from
+// testutil.NewSchemaPool, so flows can commit freely and run in parallel.
+func TestMain(m *testing.M) {
+	teardown := testutil.MustSetupTestDatabase()
+	code := m.Run()
+	teardown()
+	os.Exit(code)
+}
@@ -0,0 +1,119 @@
+// Package elevation looks up the height of the ground at points on the map
+// from an elevation API.
+package elevation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+// Source returns the ground elevation, in meters above sea level, at each
+// of pts, in the same order.
+type Source interface {
+	Elevations(ctx context.Context, pts []geo.Point) ([]float64, error)
+}
+
+// MaxBatch is how many points HTTP sends in one request; the public
+// Open-Meteo API accepts up to 100.
+const MaxBatch = 100
+
+// maxResponseBytes caps how much of a response is read. A full batch is a
+// couple of kilobytes.
+const maxResponseBytes = 1 << 20
+
+// UserAgent identifies the logbook to elevation APIs.
+const UserAgent = "rv-logbook (+https://github.com/pkordes/rv-logbook)"
+
+// HTTP looks up elevations from an API that speaks the Open-Meteo elevation
+// format: GET {url}?latitude=a,b&longitude=c,d answered with
+// {"elevation": [x, y]}.
+type HTTP struct {
+	url    string
+	client *http.Client
+}
+
+var _ Source = (*HTTP)(nil)
+
+// NewHTTP returns a Source for the API at baseURL — for example
+// "https://api.open-meteo.com/v1/elevation". A nil client uses one with a
+// ten-second timeout.
+func NewHTTP(baseURL string, client *http.Client) *HTTP {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &HTTP{url: baseURL, client: client}
+}
+
+// Elevations looks pts up MaxBatch at a time.
+func (h *HTTP) Elevations(ctx context.Context, pts []geo.Point) ([]float64, error) {
+	out := make([]float64, 0, len(pts))
+	for start := 0; start < len(pts); start += MaxBatch {
+		end := min(start+MaxBatch, len(pts))
+		batch, err := h.batch(ctx, pts[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("elevation.HTTP.Elevations: %w", err)
+		}
+		out = append(out, batch...)
+	}
+	return out, nil
+}
+
+// Ping looks up a single point to check that the API answers.
+func (h *HTTP) Ping(ctx context.Context) error {
+	if _, err := h.batch(ctx, []geo.Point{{}}); err != nil {
+		return fmt.Errorf("elevation.HTTP.Ping: %w", err)
+	}
+	return nil
+}
+
+// batch makes one request for at most MaxBatch points.
+func (h *HTTP) batch(ctx context.Context, pts []geo.Point) ([]float64, error) {
+	lats := make([]string, len(pts))
+	lons := make([]string, len(pts))
+	for i, p := range pts {
+		lats[i] = strconv.FormatFloat(p.Lat, 'f', 5, 64)
+		lons[i] = strconv.FormatFloat(p.Lon, 'f', 5, 64)
+	}
+	q := url.Values{}
+	q.Set("latitude", strings.Join(lats, ","))
+	q.Set("longitude", strings.Join(lons, ","))
+
+	u := h.url + "?" + q.Encode()
+	if strings.Contains(h.url, "?") {
+		u = h.url + "&" + q.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var body struct {
+		Elevation []float64 `json:"elevation"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if len(body.Elevation) != len(pts) {
+		return nil, fmt.Errorf("asked for %d elevations, got %d", len(pts), len(body.Elevation))
+	}
+	return body.Elevation, nil
+}
@@ -0,0 +1,90 @@
+package elevation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/elevation"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+// fakeAPI answers like Open-Meteo, with each point's elevation equal to its
+// latitude times 100, and records the size of every request.
+func fakeAPI(t *testing.T, batches *[]int) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, elevation.UserAgent, r.UserAgent())
+		lats := strings.Split(r.URL.Query().Get("latitude"), ",")
+		lons := strings.Split(r.URL.Query().Get("longitude"), ",")
+		if len(lats) != len(lons) {
+			http.Error(w, `{"error":true}`, http.StatusBadRequest)
+			return
+		}
+		*batches = append(*batches, len(lats))
+		out := make([]float64, len(lats))
+		for i, s := range lats {
+			lat, _ := strconv.ParseFloat(s, 64)
+			out[i] = lat * 100
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"elevation": out})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTP_Elevations(t *testing.T) {
+	var batches []int
+	srv := fakeAPI(t, &batches)
+
+	got, err := elevation.NewHTTP(srv.URL+"/v1/elevation", srv.Client()).Elevations(context.Background(), []geo.Point{
+		{Lat: 39.5, Lon: -105.9},
+		{Lat: 12.25, Lon: -105.8},
+	})
+
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{3950, 1225}, got, 1e-6)
+	assert.Equal(t, []int{2}, batches)
+}
+
+func TestHTTP_Elevations_Batches(t *testing.T) {
+	var batches []int
+	srv := fakeAPI(t, &batches)
+	pts := make([]geo.Point, elevation.MaxBatch*2+5)
+	for i := range pts {
+		pts[i] = geo.Point{Lat: float64(i) / 1000, Lon: 0}
+	}
+
+	got, err := elevation.NewHTTP(srv.URL, srv.Client()).Elevations(context.Background(), pts)
+
+	require.NoError(t, err)
+	require.Len(t, got, len(pts))
+	assert.InDelta(t, pts[len(pts)-1].Lat*100, got[len(got)-1], 1e-6, "order is kept across batches")
+	assert.Equal(t, []int{elevation.MaxBatch, elevation.MaxBatch, 5}, batches)
+}
+
+func TestHTTP_Elevations_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/short":
+			_, _ = w.Write([]byte(`{"elevation":[1]}`))
+		default:
+			http.Error(w, `{"error":true,"reason":"bad"}`, http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	pts := []geo.Point{{Lat: 1, Lon: 1}, {Lat: 2, Lon: 2}}
+
+	_, err := elevation.NewHTTP(srv.URL+"/short", srv.Client()).Elevations(context.Background(), pts)
+	assert.ErrorContains(t, err, "got 1")
+
+	_, err = elevation.NewHTTP(srv.URL+"/fail", srv.Client()).Elevations(context.Background(), pts)
+	assert.ErrorContains(t, err, "400")
+}
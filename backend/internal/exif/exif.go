@@ -0,0 +1,279 @@
+// Package exif reads where and when a photo was taken from the EXIF metadata
+// cameras and phones write into JPEG files.
+//
+// Only the tags that place a photo are read: the GPS latitude and longitude,
+// and the time it was taken. That time is DateTimeOriginal when the camera
+// also recorded its UTC offset (OffsetTimeOriginal); a bare DateTimeOriginal
+// is a wall clock reading in an unknown zone, so the GPS date and time stamps,
+// which are UTC, are used instead when present. Everything else in the block
+// (camera model, exposure, the embedded thumbnail) is skipped.
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned by Read for data that is not a JPEG image or has
+// no EXIF block.
+var ErrNotFound = errors.New("exif: no EXIF metadata")
+
+// ErrMalformed is returned by Read for an EXIF block that cannot be parsed.
+var ErrMalformed = errors.New("exif: malformed EXIF metadata")
+
+// Data is what a photo's EXIF says about where and when it was taken. Each
+// field is nil when the photo does not say; Latitude and Longitude are set
+// together, in decimal degrees.
+type Data struct {
+	Latitude  *float64
+	Longitude *float64
+	TakenAt   *time.Time
+}
+
+// TIFF tags read by Read. The first two point from the main directory to
+// the EXIF and GPS directories.
+const (
+	tagExifIFD            = 0x8769
+	tagGPSIFD             = 0x8825
+	tagDateTimeOriginal   = 0x9003
+	tagOffsetTimeOriginal = 0x9011
+	tagGPSLatitudeRef     = 0x0001
+	tagGPSLatitude        = 0x0002
+	tagGPSLongitudeRef    = 0x0003
+	tagGPSLongitude       = 0x0004
+	tagGPSTimeStamp       = 0x0007
+	tagGPSDateStamp       = 0x001d
+)
+
+// typeSizes is the size in bytes of one value of each TIFF field type.
+var typeSizes = map[uint16]uint64{
+	1:  1, // BYTE
+	2:  1, // ASCII
+	3:  2, // SHORT
+	4:  4, // LONG
+	5:  8, // RATIONAL
+	7:  1, // UNDEFINED
+	9:  4, // SLONG
+	10: 8, // SRATIONAL
+}
+
+// Read returns the location and time recorded in a JPEG image's EXIF block.
+// A block that records neither is not an error; Read returns an empty Data.
+func Read(data []byte) (Data, error) {
+	block, err := jpegEXIF(data)
+	if err != nil {
+		return Data{}, err
+	}
+	return parse(block)
+}
+
+// jpegEXIF returns the TIFF structure inside a JPEG's EXIF (APP1) segment.
+// Metadata segments come before the image data, so the search stops there.
+func jpegEXIF(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, ErrNotFound
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return nil, fmt.Errorf("%w: no segment marker at byte %d", ErrMalformed, i)
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF: // fill byte
+			i++
+			continue
+		case marker == 0x01 || marker >= 0xD0 && marker <= 0xD8: // no length
+			i += 2
+			continue
+		case marker == 0xD9 || marker == 0xDA: // end of image, start of scan
+			return nil, ErrNotFound
+		}
+		n := int(binary.BigEndian.Uint16(data[i+2:]))
+		if n < 2 || i+2+n > len(data) {
+			return nil, fmt.Errorf("%w: segment at byte %d runs past the end", ErrMalformed, i)
+		}
+		segment := data[i+4 : i+2+n]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:], nil
+		}
+		i += 2 + n
+	}
+	return nil, ErrNotFound
+}
+
+// field is one entry of a TIFF directory with its values' raw bytes.
+type field struct {
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+// tiff reads directories and values out of a TIFF structure.
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// parse reads Data out of the TIFF structure of an EXIF block.
+func parse(block []byte) (Data, error) {
+	if len(block) < 8 {
+		return Data{}, fmt.Errorf("%w: header is truncated", ErrMalformed)
+	}
+	t := tiff{data: block}
+	switch string(block[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return Data{}, fmt.Errorf("%w: unknown byte order %q", ErrMalformed, block[:2])
+	}
+	if t.order.Uint16(block[2:]) != 42 {
+		return Data{}, fmt.Errorf("%w: not a TIFF header", ErrMalformed)
+	}
+
+	main, err := t.ifd(t.order.Uint32(block[4:]))
+	if err != nil {
+		return Data{}, err
+	}
+	var exifDir, gpsDir map[uint16]field
+	if f, ok := main[tagExifIFD]; ok {
+		if exifDir, err = t.ifd(t.uint(f)); err != nil {
+			return Data{}, err
+		}
+	}
+	if f, ok := main[tagGPSIFD]; ok {
+		if gpsDir, err = t.ifd(t.uint(f)); err != nil {
+			return Data{}, err
+		}
+	}
+
+	var d Data
+	d.Latitude, d.Longitude = t.coordinates(gpsDir)
+	d.TakenAt = t.takenAt(exifDir, gpsDir)
+	return d, nil
+}
+
+// ifd reads the directory at offset off, keeping only the fields whose
+// values lie inside the block.
+func (t tiff) ifd(off uint32) (map[uint16]field, error) {
+	start := uint64(off)
+	if start+2 > uint64(len(t.data)) {
+		return nil, fmt.Errorf("%w: directory at %d is outside the block", ErrMalformed, off)
+	}
+	n := uint64(t.order.Uint16(t.data[start:]))
+	if start+2+12*n > uint64(len(t.data)) {
+		return nil, fmt.Errorf("%w: directory at %d is truncated", ErrMalformed, off)
+	}
+
+	fields := make(map[uint16]field, n)
+	for i := uint64(0); i < n; i++ {
+		entry := t.data[start+2+12*i : start+14+12*i]
+		f := field{typ: t.order.Uint16(entry[2:]), count: t.order.Uint32(entry[4:])}
+		size, ok := typeSizes[f.typ]
+		if !ok {
+			continue
+		}
+		size *= uint64(f.count)
+		if size <= 4 {
+			f.value = entry[8 : 8+size]
+		} else {
+			at := uint64(t.order.Uint32(entry[8:]))
+			if at+size > uint64(len(t.data)) {
+				continue
+			}
+			f.value = t.data[at : at+size]
+		}
+		fields[t.order.Uint16(entry)] = f
+	}
+	return fields, nil
+}
+
+// uint returns the first value of a SHORT or LONG field, or 0.
+func (t tiff) uint(f field) uint32 {
+	switch {
+	case f.typ == 3 && len(f.value) >= 2:
+		return uint32(t.order.Uint16(f.value))
+	case f.typ == 4 && len(f.value) >= 4:
+		return t.order.Uint32(f.value)
+	}
+	return 0
+}
+
+// ascii returns the text of an ASCII field, without its NUL terminator.
+func ascii(f field) string {
+	if f.typ != 2 {
+		return ""
+	}
+	s, _, _ := strings.Cut(string(f.value), "\x00")
+	return strings.TrimSpace(s)
+}
+
+// rationals returns the values of a RATIONAL field, or nil if the field is
+// of another type or divides by zero.
+func (t tiff) rationals(f field) []float64 {
+	if f.typ != 5 {
+		return nil
+	}
+	out := make([]float64, f.count)
+	for i := range out {
+		num, den := t.order.Uint32(f.value[8*i:]), t.order.Uint32(f.value[8*i+4:])
+		if den == 0 {
+			return nil
+		}
+		out[i] = float64(num) / float64(den)
+	}
+	return out
+}
+
+// coordinates returns the GPS position as decimal degrees, or nils if it
+// is missing or impossible.
+func (t tiff) coordinates(gps map[uint16]field) (*float64, *float64) {
+	lat, latOK := t.degrees(gps[tagGPSLatitude], ascii(gps[tagGPSLatitudeRef]), "S")
+	lon, lonOK := t.degrees(gps[tagGPSLongitude], ascii(gps[tagGPSLongitudeRef]), "W")
+	if !latOK || !lonOK || math.Abs(lat) > 90 || math.Abs(lon) > 180 {
+		return nil, nil
+	}
+	return &lat, &lon
+}
+
+// degrees converts a degrees, minutes, seconds field to decimal degrees,
+// negated when ref is the negative hemisphere.
+func (t tiff) degrees(f field, ref, negative string) (float64, bool) {
+	dms := t.rationals(f)
+	if len(dms) != 3 {
+		return 0, false
+	}
+	deg := dms[0] + dms[1]/60 + dms[2]/3600
+	if ref == negative {
+		deg = -deg
+	}
+	return deg, true
+}
+
+// takenAt returns when the photo was taken, if the time can be placed.
+func (t tiff) takenAt(exifDir, gps map[uint16]field) *time.Time {
+	original, offset := ascii(exifDir[tagDateTimeOriginal]), ascii(exifDir[tagOffsetTimeOriginal])
+	if original != "" && offset != "" {
+		if at, err := time.Parse("2006:01:02 15:04:05-07:00", original+offset); err == nil {
+			return &at
+		}
+	}
+
+	date, err := time.Parse("2006:01:02", ascii(gps[tagGPSDateStamp]))
+	hms := t.rationals(gps[tagGPSTimeStamp])
+	if err != nil || len(hms) != 3 {
+		return nil
+	}
+	seconds := hms[0]*3600 + hms[1]*60 + hms[2]
+	if seconds < 0 || seconds >= 24*3600 {
+		return nil
+	}
+	at := date.Add(time.Duration(seconds * float64(time.Second))).UTC()
+	return &at
+}
@@ -0,0 +1,39 @@
+package exif_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/internal/exif"
+	"github.com/pkordes/rv-logbook/backend/testutil/exiftest"
+)
+
+// FuzzRead feeds arbitrary uploads to Read. Every photo is read before it is
+// stored, so Read must never panic, must fail only with ErrNotFound or
+// ErrMalformed, and must never report an impossible position.
+//
+// Run with: go test ./internal/exif -run '^$' -fuzz FuzzRead
+func FuzzRead(f *testing.F) {
+	f.Add(exiftest.JPEG(f, exiftest.Tags{Latitude: ptr(44.6615), Longitude: ptr(-110.4995), DateTimeOriginal: "2025:07:14 18:32:05", OffsetTimeOriginal: "-06:00"}))
+	f.Add(exiftest.JPEG(f, exiftest.Tags{LittleEndian: true, Latitude: ptr(-33.8568), Longitude: ptr(151.2153)}))
+	f.Add(exiftest.JPEG(f, exiftest.Tags{}))
+	f.Add([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x08, 'E', 'x', 'i', 'f', 0, 0})
+	f.Add([]byte("\x89PNG\r\n\x1a\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		got, err := exif.Read(data)
+		if err != nil {
+			if !errors.Is(err, exif.ErrNotFound) && !errors.Is(err, exif.ErrMalformed) {
+				t.Fatalf("Read error %v is neither ErrNotFound nor ErrMalformed", err)
+			}
+			return
+		}
+		if (got.Latitude == nil) != (got.Longitude == nil) {
+			t.Fatalf("Read returned only one of latitude and longitude: %+v", got)
+		}
+		if got.Latitude != nil && (math.Abs(*got.Latitude) > 90 || math.Abs(*got.Longitude) > 180 || math.IsNaN(*got.Latitude) || math.IsNaN(*got.Longitude)) {
+			t.Fatalf("Read returned an impossible position %v, %v", *got.Latitude, *got.Longitude)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package exif_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/exif"
+	"github.com/pkordes/rv-logbook/backend/testutil/exiftest"
+)
+
+func ptr(f float64) *float64 { return &f }
+
+func TestRead_GPSAndTime(t *testing.T) {
+	for _, little := range []bool{false, true} {
+		data := exiftest.JPEG(t, exiftest.Tags{
+			Latitude:           ptr(44.6615),
+			Longitude:          ptr(-110.4995),
+			DateTimeOriginal:   "2025:07:14 18:32:05",
+			OffsetTimeOriginal: "-06:00",
+			LittleEndian:       little,
+		})
+
+		got, err := exif.Read(data)
+
+		require.NoError(t, err)
+		require.NotNil(t, got.Latitude)
+		require.NotNil(t, got.Longitude)
+		assert.InDelta(t, 44.6615, *got.Latitude, 1e-6)
+		assert.InDelta(t, -110.4995, *got.Longitude, 1e-6)
+		require.NotNil(t, got.TakenAt)
+		assert.True(t, time.Date(2025, 7, 15, 0, 32, 5, 0, time.UTC).Equal(*got.TakenAt), got.TakenAt)
+	}
+}
+
+func TestRead_SouthernHemisphere(t *testing.T) {
+	got, err := exif.Read(exiftest.JPEG(t, exiftest.Tags{Latitude: ptr(-33.8568), Longitude: ptr(151.2153)}))
+
+	require.NoError(t, err)
+	require.NotNil(t, got.Latitude)
+	assert.InDelta(t, -33.8568, *got.Latitude, 1e-6)
+	assert.InDelta(t, 151.2153, *got.Longitude, 1e-6)
+	assert.Nil(t, got.TakenAt)
+}
+
+func TestRead_TimeWithoutOffsetFallsBackToGPS(t *testing.T) {
+	gps := time.Date(2025, 7, 15, 0, 32, 5, 0, time.UTC)
+
+	got, err := exif.Read(exiftest.JPEG(t, exiftest.Tags{DateTimeOriginal: "2025:07:14 18:32:05", GPSTime: gps}))
+
+	require.NoError(t, err)
+	require.NotNil(t, got.TakenAt)
+	assert.True(t, gps.Equal(*got.TakenAt), got.TakenAt)
+}
+
+func TestRead_TimeWithoutOffsetOrGPSIsUnknown(t *testing.T) {
+	got, err := exif.Read(exiftest.JPEG(t, exiftest.Tags{DateTimeOriginal: "2025:07:14 18:32:05"}))
+
+	require.NoError(t, err)
+	assert.Nil(t, got.TakenAt, "a bare wall clock time cannot be placed")
+}
+
+func TestRead_EmptyBlock(t *testing.T) {
+	got, err := exif.Read(exiftest.JPEG(t, exiftest.Tags{}))
+
+	require.NoError(t, err)
+	assert.Equal(t, exif.Data{}, got)
+}
+
+func TestRead_NotFound(t *testing.T) {
+	var pngData bytes.Buffer
+	require.NoError(t, png.Encode(&pngData, image.NewGray(image.Rect(0, 0, 1, 1))))
+	cases := map[string][]byte{
+		"empty":          nil,
+		"png":            pngData.Bytes(),
+		"jpeg, no block": exiftest.JPEG(t, exiftest.Tags{})[:2:2],
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := exif.Read(data)
+
+			assert.ErrorIs(t, err, exif.ErrNotFound)
+		})
+	}
+}
+
+func TestRead_Malformed(t *testing.T) {
+	block := exiftest.TIFF(exiftest.Tags{Latitude: ptr(44.6615), Longitude: ptr(-110.4995)})
+	cases := map[string][]byte{
+		"bad byte order":        append([]byte("XX"), block[2:]...),
+		"directory off the end": append(append([]byte{}, block[:4]...), 0xFF, 0xFF, 0xFF, 0xFF),
+		"truncated header":      block[:6],
+	}
+	for name, tiff := range cases {
+		t.Run(name, func(t *testing.T) {
+			seg := append([]byte("Exif\x00\x00"), tiff...)
+			data := append([]byte{0xFF, 0xD8, 0xFF, 0xE1, byte((len(seg) + 2) >> 8), byte(len(seg) + 2)}, seg...)
+
+			_, err := exif.Read(data)
+
+			assert.ErrorIs(t, err, exif.ErrMalformed)
+		})
+	}
+}
+
+func TestRead_SegmentOverrunsFile(t *testing.T) {
+	_, err := exif.Read([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0x10, 0x00, 'E', 'x'})
+
+	assert.ErrorIs(t, err, exif.ErrMalformed)
+}
@@ -0,0 +1,176 @@
+// Package fieldcrypt provides application-level encryption for individual
+// database columns that may hold sensitive free text — campground gate codes,
+// door PINs, phone numbers — so a leaked backup or a read-only DB credential
+// does not expose them.
+//
+// Values are sealed with AES-256-GCM. Each ciphertext is self-describing:
+//
+//	enc:v1:<kid>:<base64(nonce || ciphertext || tag)>
+//
+// The key ID lets keys rotate without a big-bang re-encryption: new writes use
+// the active key while older rows keep decrypting with retired keys until they
+// are rewritten. Values without the prefix are treated as legacy plaintext and
+// returned unchanged, so encryption can be switched on for an existing database.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// KeyBytes is the required key length: AES-256.
+const KeyBytes = 32
+
+// prefix marks a value produced by Cipher.Encrypt. The version lets the
+// envelope format change later without guessing.
+const prefix = "enc:v1:"
+
+// ErrDecrypt is returned when a sealed value is malformed, names an unknown
+// key, or fails GCM authentication (wrong key or tampered data).
+var ErrDecrypt = errors.New("fieldcrypt: cannot decrypt value")
+
+// Cipher encrypts and decrypts string fields with a rotatable set of AES-GCM
+// keys. It is safe for concurrent use.
+type Cipher struct {
+	activeID string
+	aeads    map[string]cipher.AEAD
+}
+
+// NewCipher builds a Cipher from a map of kid → 32-byte key.
+// Returns an error if the set is empty, the active kid is not present, or any
+// key has the wrong length.
+//
+// Keys may come from config or be data keys unwrapped from a KMS at startup;
+// the Cipher only ever sees raw key bytes.
+func NewCipher(activeID string, keys map[string][]byte) (*Cipher, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("fieldcrypt.NewCipher: no encryption keys configured")
+	}
+	if _, ok := keys[activeID]; !ok {
+		return nil, fmt.Errorf("fieldcrypt.NewCipher: active key %q is not in the key set", activeID)
+	}
+
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for kid, key := range keys {
+		if strings.TrimSpace(kid) == "" || strings.Contains(kid, ":") {
+			return nil, fmt.Errorf("fieldcrypt.NewCipher: invalid key id %q", kid)
+		}
+		if len(key) != KeyBytes {
+			return nil, fmt.Errorf("fieldcrypt.NewCipher: key %q must be exactly %d bytes", kid, KeyBytes)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt.NewCipher: key %q: %w", kid, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt.NewCipher: key %q: %w", kid, err)
+		}
+		aeads[kid] = aead
+	}
+	return &Cipher{activeID: activeID, aeads: aeads}, nil
+}
+
+// ActiveID returns the kid used to encrypt new values.
+func (c *Cipher) ActiveID() string {
+	return c.activeID
+}
+
+// IDs returns every configured kid in sorted order. Useful for startup
+// logging; never log the keys themselves.
+func (c *Cipher) IDs() []string {
+	ids := make([]string, 0, len(c.aeads))
+	for kid := range c.aeads {
+		ids = append(ids, kid)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Encrypt seals plaintext with the active key. The empty string is returned
+// unchanged so "no notes" stays distinguishable without decrypting.
+func (c *Cipher) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	aead := c.aeads[c.activeID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("fieldcrypt.Encrypt: nonce: %w", err)
+	}
+	// The kid is bound as additional data so a ciphertext cannot be relabelled
+	// to a different key.
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(c.activeID))
+	return prefix + c.activeID + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value produced by Encrypt. Values without the envelope
+// prefix are returned unchanged (legacy plaintext written before encryption
+// was enabled). Returns ErrDecrypt for anything that looks sealed but cannot
+// be opened.
+func (c *Cipher) Decrypt(value string) (string, error) {
+	rest, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+	kid, payload, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", fmt.Errorf("%w: malformed envelope", ErrDecrypt)
+	}
+	aead, ok := c.aeads[kid]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown key %q", ErrDecrypt, kid)
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(payload)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("%w: malformed payload", ErrDecrypt)
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(kid))
+	if err != nil {
+		return "", fmt.Errorf("%w: authentication failed", ErrDecrypt)
+	}
+	return string(plaintext), nil
+}
+
+// ParseKeyList parses "kid:base64key,kid:base64key" into a kid → key map.
+// Keys are standard base64 (as produced by `openssl rand -base64 32`).
+// Whitespace around entries is ignored; an empty string yields an empty map.
+func ParseKeyList(s string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, encoded, ok := strings.Cut(entry, ":")
+		kid = strings.TrimSpace(kid)
+		if !ok || kid == "" {
+			return nil, fmt.Errorf("fieldcrypt.ParseKeyList: entry %q is not in kid:base64key form", redact(entry))
+		}
+		if _, dup := keys[kid]; dup {
+			return nil, fmt.Errorf("fieldcrypt.ParseKeyList: duplicate key id %q", kid)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt.ParseKeyList: key %q is not valid base64: %w", kid, err)
+		}
+		keys[kid] = key
+	}
+	return keys, nil
+}
+
+// redact hides the key material in a config entry so it can be quoted in an
+// error (and from there a startup log) without leaking the key.
+func redact(entry string) string {
+	if kid, _, ok := strings.Cut(entry, ":"); ok {
+		return kid + ":***"
+	}
+	return "***"
+}
@@ -0,0 +1,122 @@
+package fieldcrypt_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/internal/fieldcrypt"
+)
+
+// FuzzCipher_Decrypt feeds arbitrary stored values to Decrypt. Notes columns
+// may hold anything a user typed before encryption was enabled, so Decrypt
+// must never panic, must pass legacy plaintext through untouched, and must
+// fail only with ErrDecrypt.
+//
+// Run with: go test ./internal/fieldcrypt -run '^$' -fuzz FuzzCipher_Decrypt
+func FuzzCipher_Decrypt(f *testing.F) {
+	c, err := fieldcrypt.NewCipher("k1", map[string][]byte{"k1": key(1), "k2": key(2)})
+	if err != nil {
+		f.Fatal(err)
+	}
+	sealed, err := c.Encrypt("gate code 4321#")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(sealed)
+	f.Add("plain old note")
+	f.Add("enc:v1:")
+	f.Add("enc:v1:k1:")
+	f.Add("enc:v1:k1:AAAA")
+	f.Add("enc:v1:nope:AAAAAAAAAAAAAAAAAAAA")
+	f.Add("enc:v1:k2:!!!not-base64!!!")
+
+	f.Fuzz(func(t *testing.T, value string) {
+		got, err := c.Decrypt(value)
+		if !strings.HasPrefix(value, "enc:v1:") {
+			if err != nil || got != value {
+				t.Fatalf("Decrypt(%q) = %q, %v; legacy plaintext must pass through", value, got, err)
+			}
+			return
+		}
+		if err != nil && !errors.Is(err, fieldcrypt.ErrDecrypt) {
+			t.Fatalf("Decrypt(%q) error %v is not ErrDecrypt", value, err)
+		}
+	})
+}
+
+// FuzzCipher_RoundTrip checks that every plaintext survives Encrypt → Decrypt.
+//
+// Run with: go test ./internal/fieldcrypt -run '^$' -fuzz FuzzCipher_RoundTrip
+func FuzzCipher_RoundTrip(f *testing.F) {
+	c, err := fieldcrypt.NewCipher("k1", map[string][]byte{"k1": key(1)})
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add("")
+	f.Add("gate code 4321#")
+	f.Add("enc:v1:k1:looks-sealed-but-is-not")
+	f.Add("\xff\x00 binary-ish")
+
+	f.Fuzz(func(t *testing.T, plaintext string) {
+		sealed, err := c.Encrypt(plaintext)
+		if err != nil {
+			t.Fatalf("Encrypt(%q): %v", plaintext, err)
+		}
+		got, err := c.Decrypt(sealed)
+		if err != nil {
+			t.Fatalf("Decrypt(Encrypt(%q)): %v", plaintext, err)
+		}
+		if got != plaintext {
+			t.Fatalf("round trip: got %q, want %q", got, plaintext)
+		}
+	})
+}
+
+// FuzzParseKeyList checks NOTES_ENCRYPTION_KEYS parsing never panics, never
+// echoes key material in its errors, and that every accepted entry has a
+// usable key ID.
+//
+// Run with: go test ./internal/fieldcrypt -run '^$' -fuzz FuzzParseKeyList
+func FuzzParseKeyList(f *testing.F) {
+	f.Add("k1:AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=,k2:AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=")
+	f.Add(" k1 : AAAA ")
+	f.Add("k1")
+	f.Add("AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=")
+	f.Add("k1:AAAA,k1:BBBB")
+	f.Add("k1:not base64")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, in string) {
+		keys, err := fieldcrypt.ParseKeyList(in)
+		if err != nil {
+			// Errors may name a key ID, so ignore key material that is also
+			// part of some ID.
+			var kids []string
+			for _, entry := range strings.Split(in, ",") {
+				if kid, _, ok := strings.Cut(entry, ":"); ok {
+					kids = append(kids, kid)
+				}
+			}
+			for _, entry := range strings.Split(in, ",") {
+				_, secret, ok := strings.Cut(strings.TrimSpace(entry), ":")
+				if !ok {
+					secret = entry // no kid: the whole entry may be a key
+				}
+				secret = strings.TrimSpace(secret)
+				if len(secret) < 8 || strings.Contains(strings.Join(kids, ","), secret) {
+					continue
+				}
+				if strings.Contains(err.Error(), secret) {
+					t.Fatalf("ParseKeyList(%q) error leaks key material %q: %v", in, secret, err)
+				}
+			}
+			return
+		}
+		for kid := range keys {
+			if kid == "" || kid != strings.TrimSpace(kid) || strings.Contains(kid, ",") {
+				t.Fatalf("ParseKeyList(%q) accepted key id %q", in, kid)
+			}
+		}
+	})
+}
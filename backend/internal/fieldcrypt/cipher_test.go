@@ -0,0 +1,149 @@
+package fieldcrypt_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/fieldcrypt"
+)
+
+func key(b byte) []byte {
+	return bytes.Repeat([]byte{b}, fieldcrypt.KeyBytes)
+}
+
+func newCipher(t *testing.T, active string, keys map[string][]byte) *fieldcrypt.Cipher {
+	t.Helper()
+	c, err := fieldcrypt.NewCipher(active, keys)
+	require.NoError(t, err)
+	return c
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	c := newCipher(t, "k1", map[string][]byte{"k1": key(1)})
+
+	sealed, err := c.Encrypt("gate code 4321#")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, "enc:v1:k1:"))
+	assert.NotContains(t, sealed, "4321")
+
+	plain, err := c.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "gate code 4321#", plain)
+}
+
+// TestCipher_NonceIsRandom verifies equal plaintexts produce different
+// ciphertexts, so identical notes are not linkable in the database.
+func TestCipher_NonceIsRandom(t *testing.T) {
+	c := newCipher(t, "k1", map[string][]byte{"k1": key(1)})
+
+	a, err := c.Encrypt("same")
+	require.NoError(t, err)
+	b, err := c.Encrypt("same")
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b)
+}
+
+func TestCipher_EmptyStaysEmpty(t *testing.T) {
+	c := newCipher(t, "k1", map[string][]byte{"k1": key(1)})
+
+	sealed, err := c.Encrypt("")
+	require.NoError(t, err)
+	assert.Equal(t, "", sealed)
+}
+
+// TestCipher_LegacyPlaintextPassesThrough verifies rows written before
+// encryption was enabled still read back.
+func TestCipher_LegacyPlaintextPassesThrough(t *testing.T) {
+	c := newCipher(t, "k1", map[string][]byte{"k1": key(1)})
+
+	plain, err := c.Decrypt("site 42, hookups on left")
+	require.NoError(t, err)
+	assert.Equal(t, "site 42, hookups on left", plain)
+}
+
+// TestCipher_Rotation verifies values sealed under a retired key still open
+// after the active key changes.
+func TestCipher_Rotation(t *testing.T) {
+	old := newCipher(t, "k1", map[string][]byte{"k1": key(1)})
+	sealed, err := old.Encrypt("door PIN 0000")
+	require.NoError(t, err)
+
+	rotated := newCipher(t, "k2", map[string][]byte{"k1": key(1), "k2": key(2)})
+	plain, err := rotated.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "door PIN 0000", plain)
+
+	resealed, err := rotated.Encrypt(plain)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(resealed, "enc:v1:k2:"))
+}
+
+func TestCipher_DecryptFailures(t *testing.T) {
+	c := newCipher(t, "k1", map[string][]byte{"k1": key(1)})
+	sealed, err := c.Encrypt("secret")
+	require.NoError(t, err)
+
+	other := newCipher(t, "k1", map[string][]byte{"k1": key(9)})
+	tampered := sealed[:len(sealed)-2] + "AA"
+
+	tests := []struct {
+		name  string
+		c     *fieldcrypt.Cipher
+		value string
+	}{
+		{"wrong key", other, sealed},
+		{"tampered", c, tampered},
+		{"unknown kid", c, strings.Replace(sealed, ":k1:", ":k7:", 1)},
+		{"relabelled kid", newCipher(t, "k1", map[string][]byte{"k1": key(1), "k2": key(1)}), strings.Replace(sealed, ":k1:", ":k2:", 1)},
+		{"malformed envelope", c, "enc:v1:nokid"},
+		{"bad base64", c, "enc:v1:k1:!!!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.c.Decrypt(tt.value)
+			assert.ErrorIs(t, err, fieldcrypt.ErrDecrypt)
+		})
+	}
+}
+
+func TestNewCipher_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		active string
+		keys   map[string][]byte
+	}{
+		{"no keys", "k1", nil},
+		{"active missing", "k2", map[string][]byte{"k1": key(1)}},
+		{"short key", "k1", map[string][]byte{"k1": []byte("too-short")}},
+		{"colon in kid", "a:b", map[string][]byte{"a:b": key(1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := fieldcrypt.NewCipher(tt.active, tt.keys)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseKeyList(t *testing.T) {
+	k1 := base64.StdEncoding.EncodeToString(key(1))
+	k2 := base64.StdEncoding.EncodeToString(key(2))
+
+	keys, err := fieldcrypt.ParseKeyList(" k1:" + k1 + " , k2:" + k2 + ",")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"k1": key(1), "k2": key(2)}, keys)
+
+	_, err = fieldcrypt.ParseKeyList("k1:" + k1 + ",k1:" + k2)
+	assert.Error(t, err, "duplicate kid")
+
+	_, err = fieldcrypt.ParseKeyList("k1:not base64!")
+	assert.Error(t, err)
+
+	_, err = fieldcrypt.ParseKeyList("missing-colon")
+	assert.Error(t, err)
+}
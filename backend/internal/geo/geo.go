@@ -0,0 +1,52 @@
+// Package geo holds the small amount of geometry the logbook needs: distances
+// on the Earth's surface, line simplification for map rendering, Google's
+// encoded polyline format, and sunset times. Everything works on plain
+// latitude/longitude pairs in degrees (WGS 84) and is accurate enough for
+// trip logging, not surveying.
+package geo
+
+import "math"
+
+// EarthRadiusMiles is the mean radius of the Earth.
+const EarthRadiusMiles = 3958.8
+
+// earthRadiusMeters is EarthRadiusMiles in meters.
+const earthRadiusMeters = 6371008.8
+
+// Point is a position in decimal degrees.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// DistanceMiles returns the great-circle distance between a and b using the
+// haversine formula.
+func DistanceMiles(a, b Point) float64 {
+	return haversine(a, b) * EarthRadiusMiles
+}
+
+// DistanceMeters returns the great-circle distance between a and b in meters.
+func DistanceMeters(a, b Point) float64 {
+	return haversine(a, b) * earthRadiusMeters
+}
+
+// PathMiles returns the length of the path through pts in order.
+// Fewer than two points have length 0.
+func PathMiles(pts []Point) float64 {
+	var total float64
+	for i := 1; i < len(pts); i++ {
+		total += DistanceMiles(pts[i-1], pts[i])
+	}
+	return total
+}
+
+// haversine returns the central angle between a and b in radians.
+func haversine(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLon := radians(b.Lon - a.Lon)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
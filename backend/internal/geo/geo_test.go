@@ -0,0 +1,184 @@
+package geo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+func TestDistanceMiles(t *testing.T) {
+	// Denver to Salt Lake City is about 371 miles as the crow flies.
+	denver := geo.Point{Lat: 39.7392, Lon: -104.9903}
+	slc := geo.Point{Lat: 40.7608, Lon: -111.8910}
+
+	assert.InDelta(t, 371, geo.DistanceMiles(denver, slc), 3)
+	assert.Zero(t, geo.DistanceMiles(denver, denver))
+	assert.InDelta(t, geo.DistanceMiles(denver, slc)*1609.344, geo.DistanceMeters(denver, slc), 10)
+}
+
+func TestPathMiles(t *testing.T) {
+	a := geo.Point{Lat: 0, Lon: 0}
+	b := geo.Point{Lat: 0, Lon: 1}
+	c := geo.Point{Lat: 0, Lon: 2}
+
+	assert.InDelta(t, 2*geo.DistanceMiles(a, b), geo.PathMiles([]geo.Point{a, b, c}), 1e-9)
+	assert.Zero(t, geo.PathMiles([]geo.Point{a}))
+	assert.Zero(t, geo.PathMiles(nil))
+}
+
+func TestSimplify_DropsPointsOnAStraightLine(t *testing.T) {
+	var pts []geo.Point
+	for i := 0; i <= 100; i++ {
+		pts = append(pts, geo.Point{Lat: 45, Lon: -110 + float64(i)*0.001})
+	}
+
+	got := geo.Simplify(pts, 5)
+
+	assert.Equal(t, []geo.Point{pts[0], pts[100]}, got)
+	assert.Len(t, pts, 101, "input must not be modified")
+}
+
+func TestSimplify_KeepsCorners(t *testing.T) {
+	pts := []geo.Point{
+		{Lat: 45.000, Lon: -110.000},
+		{Lat: 45.000, Lon: -109.995},
+		{Lat: 45.000, Lon: -109.990}, // corner
+		{Lat: 45.005, Lon: -109.990},
+		{Lat: 45.010, Lon: -109.990},
+	}
+
+	got := geo.Simplify(pts, 5)
+
+	assert.Equal(t, []geo.Point{pts[0], pts[2], pts[4]}, got)
+}
+
+func TestSimplify_ShortInputUnchanged(t *testing.T) {
+	pts := []geo.Point{{Lat: 1, Lon: 1}, {Lat: 2, Lon: 2}}
+
+	assert.Equal(t, pts, geo.Simplify(pts, 10))
+}
+
+func TestEncodePolyline_ReferenceExample(t *testing.T) {
+	// The worked example from Google's polyline algorithm documentation.
+	pts := []geo.Point{{Lat: 38.5, Lon: -120.2}, {Lat: 40.7, Lon: -120.95}, {Lat: 43.252, Lon: -126.453}}
+
+	assert.Equal(t, "_p~iF~ps|U_ulLnnqC_mqNvxq`@", geo.EncodePolyline(pts))
+}
+
+func TestDecodePolyline_RoundTrip(t *testing.T) {
+	pts := []geo.Point{{Lat: 61.21806, Lon: -149.90028}, {Lat: 60.55444, Lon: -151.25833}, {Lat: -33.86882, Lon: 151.20930}}
+
+	got, err := geo.DecodePolyline(geo.EncodePolyline(pts))
+
+	require.NoError(t, err)
+	require.Len(t, got, len(pts))
+	for i := range pts {
+		assert.InDelta(t, pts[i].Lat, got[i].Lat, 1e-5)
+		assert.InDelta(t, pts[i].Lon, got[i].Lon, 1e-5)
+	}
+}
+
+func TestDecodePolyline_Malformed(t *testing.T) {
+	for _, s := range []string{"_p~iF~ps|", "_p~iF ~ps|U", "\x00"} {
+		_, err := geo.DecodePolyline(s)
+		assert.True(t, errors.Is(err, geo.ErrPolyline), "%q: got %v", s, err)
+	}
+}
+
+func TestResample_EvenSpacing(t *testing.T) {
+	// An L-shaped path: one degree east, then one degree north, along the
+	// equator and a meridian so both legs are the same length.
+	a := geo.Point{Lat: 0, Lon: 0}
+	b := geo.Point{Lat: 0, Lon: 1}
+	c := geo.Point{Lat: 1, Lon: 1}
+
+	pts := geo.Resample([]geo.Point{a, b, c}, 5)
+
+	require.Len(t, pts, 5)
+	assert.Equal(t, a, pts[0])
+	assert.InDelta(t, 0.5, pts[1].Lon, 1e-3)
+	assert.InDelta(t, 0, pts[2].Lat, 1e-3)
+	assert.InDelta(t, 1, pts[2].Lon, 1e-3)
+	assert.InDelta(t, 0.5, pts[3].Lat, 1e-3)
+	assert.Equal(t, c, pts[4])
+	assert.InDelta(t, geo.PathMeters([]geo.Point{a, b, c})/4, geo.DistanceMeters(pts[0], pts[1]), 1)
+}
+
+func TestResample_Degenerate(t *testing.T) {
+	a := geo.Point{Lat: 40, Lon: -105}
+
+	assert.Nil(t, geo.Resample(nil, 5))
+	assert.Nil(t, geo.Resample([]geo.Point{a, a}, 1))
+	assert.Equal(t, []geo.Point{a, a, a}, geo.Resample([]geo.Point{a}, 3))
+	assert.Equal(t, []geo.Point{a, a, a}, geo.Resample([]geo.Point{a, a}, 3))
+}
+
+func TestSunset(t *testing.T) {
+	tests := []struct {
+		name string
+		p    geo.Point
+		at   time.Time
+		want time.Time
+	}{
+		// Published times: Denver 8:32 pm MDT on 2025-06-21, Key West
+		// 5:44 pm EST on 2025-12-21, Sydney 4:54 pm AEST on 2025-06-21.
+		{"Denver, summer", geo.Point{Lat: 39.7392, Lon: -104.9903}, time.Date(2025, 6, 21, 18, 0, 0, 0, time.UTC), time.Date(2025, 6, 22, 2, 32, 0, 0, time.UTC)},
+		{"Denver, after UTC midnight", geo.Point{Lat: 39.7392, Lon: -104.9903}, time.Date(2025, 6, 22, 1, 0, 0, 0, time.UTC), time.Date(2025, 6, 22, 2, 32, 0, 0, time.UTC)},
+		{"Key West, winter", geo.Point{Lat: 24.5551, Lon: -81.78}, time.Date(2025, 12, 21, 15, 0, 0, 0, time.UTC), time.Date(2025, 12, 21, 22, 44, 0, 0, time.UTC)},
+		{"Sydney", geo.Point{Lat: -33.8688, Lon: 151.2093}, time.Date(2025, 6, 21, 2, 0, 0, 0, time.UTC), time.Date(2025, 6, 21, 6, 54, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := geo.Sunset(tt.p, tt.at)
+
+			require.True(t, ok)
+			assert.WithinDuration(t, tt.want, got, 3*time.Minute)
+		})
+	}
+}
+
+func TestSunrise(t *testing.T) {
+	tests := []struct {
+		name string
+		p    geo.Point
+		at   time.Time
+		want time.Time
+	}{
+		// Published times: Denver 5:32 am MDT on 2025-06-21, Key West
+		// 7:07 am EST on 2025-12-21, Sydney 7:00 am AEST on 2025-06-21.
+		{"Denver, summer", geo.Point{Lat: 39.7392, Lon: -104.9903}, time.Date(2025, 6, 21, 18, 0, 0, 0, time.UTC), time.Date(2025, 6, 21, 11, 32, 0, 0, time.UTC)},
+		{"Key West, winter", geo.Point{Lat: 24.5551, Lon: -81.78}, time.Date(2025, 12, 21, 15, 0, 0, 0, time.UTC), time.Date(2025, 12, 21, 12, 7, 0, 0, time.UTC)},
+		{"Sydney, before UTC midnight", geo.Point{Lat: -33.8688, Lon: 151.2093}, time.Date(2025, 6, 21, 2, 0, 0, 0, time.UTC), time.Date(2025, 6, 20, 21, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := geo.Sunrise(tt.p, tt.at)
+
+			require.True(t, ok)
+			assert.WithinDuration(t, tt.want, got, 3*time.Minute)
+		})
+	}
+}
+
+func TestSolarDay(t *testing.T) {
+	denver := geo.Point{Lat: 39.7392, Lon: -104.9903}
+
+	assert.Equal(t, time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC), geo.SolarDay(denver, time.Date(2025, 6, 22, 1, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2025, 6, 22, 0, 0, 0, 0, time.UTC), geo.SolarDay(denver, time.Date(2025, 6, 22, 8, 0, 0, 0, time.UTC)))
+}
+
+func TestSunset_PolarDayAndNight(t *testing.T) {
+	barrow := geo.Point{Lat: 71.29, Lon: -156.79}
+
+	_, ok := geo.Sunset(barrow, time.Date(2025, 6, 21, 20, 0, 0, 0, time.UTC))
+	assert.False(t, ok, "midnight sun")
+	_, ok = geo.Sunset(barrow, time.Date(2025, 12, 21, 20, 0, 0, 0, time.UTC))
+	assert.False(t, ok, "polar night")
+	_, ok = geo.Sunrise(barrow, time.Date(2025, 12, 21, 20, 0, 0, 0, time.UTC))
+	assert.False(t, ok, "polar night")
+}
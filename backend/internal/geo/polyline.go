@@ -0,0 +1,92 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// ErrPolyline is returned by DecodePolyline for malformed input.
+var ErrPolyline = errors.New("geo: malformed encoded polyline")
+
+// polylineFactor is the coordinate precision of the format: five decimal
+// places, about a meter.
+const polylineFactor = 1e5
+
+// EncodePolyline encodes pts in Google's encoded polyline algorithm format,
+// the compact string most map libraries accept directly.
+func EncodePolyline(pts []Point) string {
+	var b strings.Builder
+	var prevLat, prevLon int64
+	for _, p := range pts {
+		lat := int64(math.Round(p.Lat * polylineFactor))
+		lon := int64(math.Round(p.Lon * polylineFactor))
+		encodeValue(&b, lat-prevLat)
+		encodeValue(&b, lon-prevLon)
+		prevLat, prevLon = lat, lon
+	}
+	return b.String()
+}
+
+// encodeValue appends one signed delta in 5-bit chunks, low bits first.
+func encodeValue(b *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		b.WriteByte(byte((0x20 | (u & 0x1f)) + 63))
+		u >>= 5
+	}
+	b.WriteByte(byte(u + 63))
+}
+
+// DecodePolyline is the inverse of EncodePolyline. Coordinates come back
+// rounded to five decimal places.
+func DecodePolyline(s string) ([]Point, error) {
+	var (
+		pts      []Point
+		lat, lon int64
+	)
+	for i := 0; i < len(s); {
+		dLat, n, err := decodeValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		dLon, n, err := decodeValue(s[i:])
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		lat, lon = lat+dLat, lon+dLon
+		pts = append(pts, Point{Lat: float64(lat) / polylineFactor, Lon: float64(lon) / polylineFactor})
+	}
+	return pts, nil
+}
+
+// decodeValue reads one signed delta from the front of s and returns it with
+// the number of bytes consumed.
+func decodeValue(s string) (int64, int, error) {
+	var (
+		u     uint64
+		shift uint
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 63 || c > 126 || shift > 60 {
+			return 0, 0, ErrPolyline
+		}
+		chunk := uint64(c - 63)
+		u |= (chunk & 0x1f) << shift
+		shift += 5
+		if chunk < 0x20 {
+			v := int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, ErrPolyline
+}
@@ -0,0 +1,52 @@
+package geo
+
+// Resample returns n points spaced evenly by distance along the path through
+// pts, starting at its first point and ending at its last. Points between
+// the originals are interpolated linearly in latitude and longitude, which
+// is close enough over the length of one path segment.
+//
+// Returns nil when pts is empty or n < 2. A path with no length yields n
+// copies of its first point. The input slice is not modified.
+func Resample(pts []Point, n int) []Point {
+	if len(pts) == 0 || n < 2 {
+		return nil
+	}
+	cum := make([]float64, len(pts))
+	for i := 1; i < len(pts); i++ {
+		cum[i] = cum[i-1] + DistanceMeters(pts[i-1], pts[i])
+	}
+	total := cum[len(cum)-1]
+
+	out := make([]Point, n)
+	seg := 1
+	for i := range out {
+		want := total * float64(i) / float64(n-1)
+		for seg < len(pts)-1 && cum[seg] < want {
+			seg++
+		}
+		if len(pts) == 1 || total == 0 {
+			out[i] = pts[0]
+			continue
+		}
+		a, b := pts[seg-1], pts[seg]
+		span := cum[seg] - cum[seg-1]
+		if span == 0 {
+			out[i] = b
+			continue
+		}
+		f := (want - cum[seg-1]) / span
+		out[i] = Point{Lat: a.Lat + (b.Lat-a.Lat)*f, Lon: a.Lon + (b.Lon-a.Lon)*f}
+	}
+	out[n-1] = pts[len(pts)-1]
+	return out
+}
+
+// PathMeters returns the length of the path through pts in meters.
+// Fewer than two points have length 0.
+func PathMeters(pts []Point) float64 {
+	var total float64
+	for i := 1; i < len(pts); i++ {
+		total += DistanceMeters(pts[i-1], pts[i])
+	}
+	return total
+}
@@ -0,0 +1,84 @@
+package geo
+
+import "math"
+
+// Simplify reduces pts with the Ramer–Douglas–Peucker algorithm, dropping
+// every point that lies within toleranceMeters of the line between the points
+// kept around it. The first and last points are always kept. A GPS track
+// logged once a second shrinks by one to two orders of magnitude at a
+// tolerance of a few meters without visibly changing its shape on a map.
+//
+// Distances are measured on a flat projection centred on the track, which is
+// accurate to well under a percent over the length of a day's drive.
+// The input slice is not modified.
+func Simplify(pts []Point, toleranceMeters float64) []Point {
+	if len(pts) < 3 || toleranceMeters <= 0 {
+		return append([]Point(nil), pts...)
+	}
+
+	xy := project(pts)
+	keep := make([]bool, len(pts))
+	keep[0], keep[len(pts)-1] = true, true
+
+	// Iterative rather than recursive: a long, nearly straight highway track
+	// would otherwise recurse once per point.
+	type span struct{ first, last int }
+	stack := []span{{0, len(pts) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		farthest, maxDist := -1, toleranceMeters
+		for i := s.first + 1; i < s.last; i++ {
+			if d := segmentDistance(xy[i], xy[s.first], xy[s.last]); d > maxDist {
+				farthest, maxDist = i, d
+			}
+		}
+		if farthest < 0 {
+			continue
+		}
+		keep[farthest] = true
+		stack = append(stack, span{s.first, farthest}, span{farthest, s.last})
+	}
+
+	out := make([]Point, 0, len(pts))
+	for i, k := range keep {
+		if k {
+			out = append(out, pts[i])
+		}
+	}
+	return out
+}
+
+// xy is a projected position in meters.
+type xy struct{ x, y float64 }
+
+// project maps pts onto an equirectangular plane centred on their mean
+// latitude, in meters.
+func project(pts []Point) []xy {
+	var sumLat float64
+	for _, p := range pts {
+		sumLat += p.Lat
+	}
+	cosLat := math.Cos(radians(sumLat / float64(len(pts))))
+
+	out := make([]xy, len(pts))
+	for i, p := range pts {
+		out[i] = xy{
+			x: radians(p.Lon) * cosLat * earthRadiusMeters,
+			y: radians(p.Lat) * earthRadiusMeters,
+		}
+	}
+	return out
+}
+
+// segmentDistance returns the distance from p to the segment a–b.
+func segmentDistance(p, a, b xy) float64 {
+	dx, dy := b.x-a.x, b.y-a.y
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p.x-a.x, p.y-a.y)
+	}
+	t := ((p.x-a.x)*dx + (p.y-a.y)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p.x-(a.x+t*dx), p.y-(a.y+t*dy))
+}
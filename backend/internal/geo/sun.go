@@ -0,0 +1,58 @@
+package geo
+
+import (
+	"math"
+	"time"
+)
+
+// sunsetZenith is the sun's zenith angle at sunset in degrees: the upper
+// limb on the horizon, allowing for atmospheric refraction.
+const sunsetZenith = 90.833
+
+// Sunset returns when the sun sets at p on the day t falls in, counting days
+// by local solar time at p so that no time zone is needed. It uses NOAA's
+// approximate solar position equations, good to a minute or two outside the
+// polar regions.
+//
+// Returns false when the sun does not set that day (polar day) or does not
+// rise (polar night).
+func Sunset(p Point, t time.Time) (time.Time, bool) {
+	return sunEvent(p, SolarDay(p, t), false)
+}
+
+// Sunrise is Sunset for the sun coming up.
+func Sunrise(p Point, t time.Time) (time.Time, bool) {
+	return sunEvent(p, SolarDay(p, t), true)
+}
+
+// SolarDay returns the calendar day t falls in by local solar time at p, as
+// midnight UTC of that date.
+func SolarDay(p Point, t time.Time) time.Time {
+	// Local solar midnight is offset from UTC by four minutes per degree.
+	local := t.UTC().Add(time.Duration(p.Lon / 15 * float64(time.Hour)))
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// sunEvent returns the sunrise or sunset at p on day, a SolarDay.
+func sunEvent(p Point, day time.Time, rising bool) (time.Time, bool) {
+	// Fractional year in radians, at local noon.
+	g := 2 * math.Pi / 365 * float64(day.YearDay()-1)
+	eqTime := 229.18 * (0.000075 + 0.001868*math.Cos(g) - 0.032077*math.Sin(g) -
+		0.014615*math.Cos(2*g) - 0.040849*math.Sin(2*g))
+	decl := 0.006918 - 0.399912*math.Cos(g) + 0.070257*math.Sin(g) -
+		0.006758*math.Cos(2*g) + 0.000907*math.Sin(2*g) -
+		0.002697*math.Cos(3*g) + 0.00148*math.Sin(3*g)
+
+	lat := radians(p.Lat)
+	cosHA := math.Cos(radians(sunsetZenith))/(math.Cos(lat)*math.Cos(decl)) - math.Tan(lat)*math.Tan(decl)
+	if cosHA < -1 || cosHA > 1 {
+		return time.Time{}, false
+	}
+	ha := math.Acos(cosHA) * 180 / math.Pi
+	if rising {
+		ha = -ha
+	}
+
+	minutes := 720 - 4*(p.Lon-ha) - eqTime
+	return day.Add(time.Duration(minutes * float64(time.Minute))).Truncate(time.Second), true
+}
@@ -0,0 +1,175 @@
+// Package geocode looks up which country and state or province a point on
+// the map lies in.
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+// Place is the result of a reverse lookup. Every field is empty when the
+// point is not in any country, such as out at sea.
+type Place struct {
+	CountryCode string // ISO 3166-1 alpha-2, upper case
+	RegionCode  string // ISO 3166-2, e.g. "US-CO"; may be empty
+	RegionName  string
+}
+
+// Reverser finds the place a point lies in.
+type Reverser interface {
+	Reverse(ctx context.Context, p geo.Point) (Place, error)
+}
+
+// maxResponseBytes caps how much of a response is read. A reverse lookup at
+// state level is well under a kilobyte.
+const maxResponseBytes = 1 << 20
+
+// UserAgent identifies the logbook to geocoders; Nominatim's usage policy
+// requires one that names the application.
+const UserAgent = "rv-logbook (+https://github.com/pkordes/rv-logbook)"
+
+// Nominatim looks places up with the reverse endpoint of a Nominatim server.
+// Requests are spaced at least Interval apart, since the public server
+// allows one per second.
+type Nominatim struct {
+	// Interval is the minimum time between requests. Defaults to one second.
+	Interval time.Duration
+
+	url    string
+	client *http.Client
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+var _ Reverser = (*Nominatim)(nil)
+
+// NewNominatim returns a Reverser for the Nominatim server at baseURL — for
+// example "https://nominatim.openstreetmap.org". A nil client uses one with
+// a ten-second timeout.
+func NewNominatim(baseURL string, client *http.Client) *Nominatim {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Nominatim{Interval: time.Second, url: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+// Reverse looks p up at state level.
+func (n *Nominatim) Reverse(ctx context.Context, p geo.Point) (Place, error) {
+	if err := n.wait(ctx); err != nil {
+		return Place{}, fmt.Errorf("geocode.Nominatim.Reverse: %w", err)
+	}
+
+	q := url.Values{}
+	q.Set("format", "jsonv2")
+	q.Set("lat", strconv.FormatFloat(p.Lat, 'f', 6, 64))
+	q.Set("lon", strconv.FormatFloat(p.Lon, 'f', 6, 64))
+	q.Set("zoom", "5") // state level; finer zooms only add detail we drop
+	q.Set("addressdetails", "1")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.url+"/reverse?"+q.Encode(), nil)
+	if err != nil {
+		return Place{}, fmt.Errorf("geocode.Nominatim.Reverse: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return Place{}, fmt.Errorf("geocode.Nominatim.Reverse: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Place{}, fmt.Errorf("geocode.Nominatim.Reverse: unexpected status %s", resp.Status)
+	}
+
+	var body struct {
+		Error   string            `json:"error"`
+		Address map[string]string `json:"address"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		return Place{}, fmt.Errorf("geocode.Nominatim.Reverse: decode response: %w", err)
+	}
+	if body.Error != "" {
+		// "Unable to geocode": nothing there, which is an answer, not a failure.
+		return Place{}, nil
+	}
+	return placeFromAddress(body.Address), nil
+}
+
+// Ping asks the server's status endpoint whether it is up. It waits its
+// turn like a lookup, so probing never breaks the request spacing.
+func (n *Nominatim) Ping(ctx context.Context) error {
+	if err := n.wait(ctx); err != nil {
+		return fmt.Errorf("geocode.Nominatim.Ping: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.url+"/status", nil)
+	if err != nil {
+		return fmt.Errorf("geocode.Nominatim.Ping: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("geocode.Nominatim.Ping: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("geocode.Nominatim.Ping: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// wait blocks until the next request may be sent and reserves its slot.
+func (n *Nominatim) wait(ctx context.Context) error {
+	n.mu.Lock()
+	now := time.Now()
+	at := n.next
+	if at.Before(now) {
+		at = now
+	}
+	n.next = at.Add(n.Interval)
+	n.mu.Unlock()
+
+	if d := time.Until(at); d > 0 {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// placeFromAddress picks the country and first-level subdivision out of a
+// Nominatim address. Countries name that level differently.
+func placeFromAddress(addr map[string]string) Place {
+	p := Place{CountryCode: strings.ToUpper(addr["country_code"])}
+	if p.CountryCode == "" {
+		return Place{}
+	}
+	for _, key := range []string{"state", "province", "territory", "region"} {
+		if v := addr[key]; v != "" {
+			p.RegionName = v
+			break
+		}
+	}
+	for _, key := range []string{"ISO3166-2-lvl4", "ISO3166-2-lvl3"} {
+		if v := addr[key]; v != "" {
+			p.RegionCode = v
+			break
+		}
+	}
+	return p
+}
@@ -0,0 +1,116 @@
+package geocode_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/geocode"
+)
+
+// fakeNominatim answers reverse lookups with canned bodies keyed by the
+// lat query parameter.
+func fakeNominatim(t *testing.T, bodies map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reverse", r.URL.Path)
+		assert.Equal(t, geocode.UserAgent, r.UserAgent())
+		body, ok := bodies[r.URL.Query().Get("lat")]
+		if !ok {
+			http.Error(w, "unexpected", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newNominatim(srv *httptest.Server) *geocode.Nominatim {
+	n := geocode.NewNominatim(srv.URL+"/", srv.Client())
+	n.Interval = 0
+	return n
+}
+
+func TestNominatim_Reverse(t *testing.T) {
+	srv := fakeNominatim(t, map[string]string{
+		"39.739200": `{"address":{"state":"Colorado","ISO3166-2-lvl4":"US-CO","country":"United States","country_code":"us"}}`,
+		"51.178900": `{"address":{"province":"Alberta","ISO3166-2-lvl4":"CA-AB","country":"Canada","country_code":"ca"}}`,
+		"30.000000": `{"error":"Unable to geocode"}`,
+	})
+	n := newNominatim(srv)
+	ctx := context.Background()
+
+	denver, err := n.Reverse(ctx, geo.Point{Lat: 39.7392, Lon: -104.9903})
+	require.NoError(t, err)
+	assert.Equal(t, geocode.Place{CountryCode: "US", RegionCode: "US-CO", RegionName: "Colorado"}, denver)
+
+	banff, err := n.Reverse(ctx, geo.Point{Lat: 51.1789, Lon: -115.5708})
+	require.NoError(t, err)
+	assert.Equal(t, geocode.Place{CountryCode: "CA", RegionCode: "CA-AB", RegionName: "Alberta"}, banff)
+
+	sea, err := n.Reverse(ctx, geo.Point{Lat: 30, Lon: -40})
+	require.NoError(t, err)
+	assert.Zero(t, sea)
+}
+
+func TestNominatim_Reverse_Failure(t *testing.T) {
+	srv := fakeNominatim(t, nil)
+
+	_, err := newNominatim(srv).Reverse(context.Background(), geo.Point{Lat: 1, Lon: 1})
+
+	assert.ErrorContains(t, err, "500")
+}
+
+func TestNominatim_SpacesRequests(t *testing.T) {
+	srv := fakeNominatim(t, map[string]string{"1.000000": `{"address":{"country_code":"us"}}`})
+	n := newNominatim(srv)
+	n.Interval = 50 * time.Millisecond
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		_, err := n.Reverse(ctx, geo.Point{Lat: 1, Lon: 1})
+		require.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestNominatim_WaitHonoursContext(t *testing.T) {
+	srv := fakeNominatim(t, map[string]string{"1.000000": `{"address":{"country_code":"us"}}`})
+	n := newNominatim(srv)
+	n.Interval = time.Hour
+	_, err := n.Reverse(context.Background(), geo.Point{Lat: 1, Lon: 1})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = n.Reverse(ctx, geo.Point{Lat: 1, Lon: 1})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNominatim_Ping(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/status", r.URL.Path)
+		assert.Equal(t, geocode.UserAgent, r.UserAgent())
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("OK"))
+	}))
+	t.Cleanup(srv.Close)
+	n := newNominatim(srv)
+
+	require.NoError(t, n.Ping(context.Background()))
+
+	status = http.StatusServiceUnavailable
+	assert.ErrorContains(t, n.Ping(context.Background()), "503")
+}
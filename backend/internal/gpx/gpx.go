@@ -0,0 +1,194 @@
+// Package gpx reads GPS Exchange Format files (GPX 1.0 and 1.1), the format
+// every GPS unit, phone tracking app, and mapping site exports.
+//
+// Only what the logbook uses is decoded: the points of every track segment,
+// or of the routes when a file has no tracks, with their elevation and time
+// (Parse), and the named waypoints that mark places (ParseWaypoints).
+// Extensions and metadata are ignored.
+package gpx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+// ErrInvalid is returned by Parse when the input is not a usable GPX file.
+var ErrInvalid = errors.New("gpx: invalid file")
+
+// Point is one recorded position. Elevation (meters) and Time are nil when
+// the file does not carry them.
+type Point struct {
+	geo.Point
+	Elevation *float64
+	Time      *time.Time
+}
+
+// Track is the decoded content of a GPX file: every point in file order, with
+// the segments of all tracks joined end to end.
+type Track struct {
+	Name   string
+	Points []Point
+}
+
+// LatLons returns the track's points without elevation and time.
+func (t Track) LatLons() []geo.Point {
+	out := make([]geo.Point, len(t.Points))
+	for i, p := range t.Points {
+		out[i] = p.Point
+	}
+	return out
+}
+
+// Duration returns the time between the first and last timestamped points,
+// and false when fewer than two points carry a time.
+func (t Track) Duration() (time.Duration, bool) {
+	var first, last *time.Time
+	for _, p := range t.Points {
+		if p.Time == nil {
+			continue
+		}
+		if first == nil {
+			first = p.Time
+		}
+		last = p.Time
+	}
+	if first == nil || last == first {
+		return 0, false
+	}
+	return last.Sub(*first), true
+}
+
+// file mirrors the parts of the GPX schema Parse reads. Element names carry
+// no namespace, so both the GPX 1.0 and 1.1 namespaces match.
+type file struct {
+	XMLName   xml.Name   `xml:"gpx"`
+	Waypoints []waypoint `xml:"wpt"`
+	Tracks    []struct {
+		Name     string `xml:"name"`
+		Segments []struct {
+			Points []point `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Name   string  `xml:"name"`
+		Points []point `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+type point struct {
+	Lat  string `xml:"lat,attr"`
+	Lon  string `xml:"lon,attr"`
+	Ele  string `xml:"ele"`
+	Time string `xml:"time"`
+}
+
+type waypoint struct {
+	point
+	Name string `xml:"name"`
+	Desc string `xml:"desc"`
+}
+
+// Waypoint is a marked place: a campground, a dump station, a stop on a
+// planned route. Err is set, and Point left zero, when its coordinates are
+// missing or out of range; ParseWaypoints reports such waypoints rather than
+// rejecting the file, so the rest can still be used.
+type Waypoint struct {
+	Point
+	Name        string
+	Description string
+	Err         error
+}
+
+// ParseWaypoints decodes the waypoints of a GPX document, in file order. It
+// fails with ErrInvalid only if the input is not GPX or has no waypoints.
+func ParseWaypoints(r io.Reader) ([]Waypoint, error) {
+	var f file
+	if err := xml.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if len(f.Waypoints) == 0 {
+		return nil, fmt.Errorf("%w: no waypoints", ErrInvalid)
+	}
+
+	out := make([]Waypoint, len(f.Waypoints))
+	for i, w := range f.Waypoints {
+		out[i] = Waypoint{Name: strings.TrimSpace(w.Name), Description: strings.TrimSpace(w.Desc)}
+		out[i].Point, out[i].Err = w.decode()
+	}
+	return out, nil
+}
+
+// Parse decodes a GPX document. It fails with ErrInvalid if the input is not
+// GPX, has no track or route points, or has a point with a missing or
+// out-of-range coordinate.
+func Parse(r io.Reader) (Track, error) {
+	var f file
+	if err := xml.NewDecoder(r).Decode(&f); err != nil {
+		return Track{}, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	var (
+		t   Track
+		raw []point
+	)
+	for _, trk := range f.Tracks {
+		if t.Name == "" {
+			t.Name = strings.TrimSpace(trk.Name)
+		}
+		for _, seg := range trk.Segments {
+			raw = append(raw, seg.Points...)
+		}
+	}
+	if len(raw) == 0 {
+		for _, rte := range f.Routes {
+			if t.Name == "" {
+				t.Name = strings.TrimSpace(rte.Name)
+			}
+			raw = append(raw, rte.Points...)
+		}
+	}
+	if len(raw) == 0 {
+		return Track{}, fmt.Errorf("%w: no track or route points", ErrInvalid)
+	}
+
+	t.Points = make([]Point, 0, len(raw))
+	for i, rp := range raw {
+		p, err := rp.decode()
+		if err != nil {
+			return Track{}, fmt.Errorf("%w: point %d: %v", ErrInvalid, i+1, err)
+		}
+		t.Points = append(t.Points, p)
+	}
+	return t, nil
+}
+
+// decode validates and converts one point. A malformed elevation or time is
+// dropped rather than rejected — many devices write junk there — but the
+// coordinates must be present and in range.
+func (rp point) decode() (Point, error) {
+	lat, err := strconv.ParseFloat(strings.TrimSpace(rp.Lat), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return Point{}, fmt.Errorf("lat %q is not a latitude", rp.Lat)
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(rp.Lon), 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return Point{}, fmt.Errorf("lon %q is not a longitude", rp.Lon)
+	}
+
+	p := Point{Point: geo.Point{Lat: lat, Lon: lon}}
+	if ele, err := strconv.ParseFloat(strings.TrimSpace(rp.Ele), 64); err == nil {
+		p.Elevation = &ele
+	}
+	if ts, err := time.Parse(time.RFC3339, strings.TrimSpace(rp.Time)); err == nil {
+		ts = ts.UTC()
+		p.Time = &ts
+	}
+	return p, nil
+}
@@ -0,0 +1,133 @@
+package gpx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/gpx"
+)
+
+const track11 = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="44.0" lon="-110.0"><name>ignored</name></wpt>
+  <trk>
+    <name>Cody to Yellowstone</name>
+    <trkseg>
+      <trkpt lat="44.5263" lon="-109.0565"><ele>1527.0</ele><time>2025-07-01T15:00:00Z</time></trkpt>
+      <trkpt lat="44.4990" lon="-109.4470"><ele>1680.5</ele><time>2025-07-01T15:40:00Z</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="44.4605" lon="-110.0000"><time>2025-07-01T16:45:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestParse_Track(t *testing.T) {
+	tr, err := gpx.Parse(strings.NewReader(track11))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Cody to Yellowstone", tr.Name)
+	require.Len(t, tr.Points, 3)
+	assert.InDelta(t, 44.5263, tr.Points[0].Lat, 1e-9)
+	assert.InDelta(t, -109.0565, tr.Points[0].Lon, 1e-9)
+	require.NotNil(t, tr.Points[1].Elevation)
+	assert.InDelta(t, 1680.5, *tr.Points[1].Elevation, 1e-9)
+	assert.Nil(t, tr.Points[2].Elevation)
+
+	d, ok := tr.Duration()
+	require.True(t, ok)
+	assert.Equal(t, 105*time.Minute, d)
+	assert.Len(t, tr.LatLons(), 3)
+}
+
+func TestParse_RouteWhenNoTrack(t *testing.T) {
+	const doc = `<gpx version="1.0" xmlns="http://www.topografix.com/GPX/1/0">
+  <rte><name>Planned</name>
+    <rtept lat="40.0" lon="-105.0"/>
+    <rtept lat="40.5" lon="-105.5"/>
+  </rte>
+</gpx>`
+
+	tr, err := gpx.Parse(strings.NewReader(doc))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Planned", tr.Name)
+	assert.Len(t, tr.Points, 2)
+	_, ok := tr.Duration()
+	assert.False(t, ok)
+}
+
+func TestParse_IgnoresMalformedTimeAndElevation(t *testing.T) {
+	const doc = `<gpx><trk><trkseg>
+  <trkpt lat="40" lon="-105"><ele>n/a</ele><time>yesterday</time></trkpt>
+</trkseg></trk></gpx>`
+
+	tr, err := gpx.Parse(strings.NewReader(doc))
+
+	require.NoError(t, err)
+	require.Len(t, tr.Points, 1)
+	assert.Nil(t, tr.Points[0].Elevation)
+	assert.Nil(t, tr.Points[0].Time)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not xml":          `{}`,
+		"not gpx":          `<kml><Document/></kml>`,
+		"no points":        `<gpx><trk><trkseg/></trk></gpx>`,
+		"missing lat":      `<gpx><trk><trkseg><trkpt lon="-105"/></trkseg></trk></gpx>`,
+		"latitude too big": `<gpx><trk><trkseg><trkpt lat="91" lon="-105"/></trkseg></trk></gpx>`,
+		"bad longitude":    `<gpx><trk><trkseg><trkpt lat="40" lon="west"/></trkseg></trk></gpx>`,
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := gpx.Parse(strings.NewReader(doc))
+			assert.True(t, errors.Is(err, gpx.ErrInvalid), "got %v", err)
+		})
+	}
+}
+
+func TestParseWaypoints(t *testing.T) {
+	const doc = `<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="44.5263" lon="-109.0565"><time>2025-07-01T15:00:00Z</time><name> Cody KOA </name><desc>Site 14</desc></wpt>
+  <wpt lat="95" lon="-110.0"><name>Off the map</name></wpt>
+  <wpt lat="44.4605" lon="-110.0000"><name>Fishing Bridge RV Park</name></wpt>
+  <trk><trkseg><trkpt lat="44.0" lon="-110.0"/></trkseg></trk>
+</gpx>`
+
+	wpts, err := gpx.ParseWaypoints(strings.NewReader(doc))
+
+	require.NoError(t, err)
+	require.Len(t, wpts, 3)
+	assert.Equal(t, "Cody KOA", wpts[0].Name)
+	assert.Equal(t, "Site 14", wpts[0].Description)
+	assert.InDelta(t, 44.5263, wpts[0].Lat, 1e-9)
+	require.NotNil(t, wpts[0].Time)
+	assert.Equal(t, time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC), *wpts[0].Time)
+	assert.NoError(t, wpts[0].Err)
+
+	assert.Equal(t, "Off the map", wpts[1].Name)
+	assert.Error(t, wpts[1].Err, "a bad waypoint is reported, not fatal")
+
+	assert.NoError(t, wpts[2].Err)
+	assert.Nil(t, wpts[2].Time)
+}
+
+func TestParseWaypoints_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not xml":      `{}`,
+		"not gpx":      `<kml><Document/></kml>`,
+		"no waypoints": `<gpx><trk><trkseg><trkpt lat="40" lon="-105"/></trkseg></trk></gpx>`,
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := gpx.ParseWaypoints(strings.NewReader(doc))
+			assert.True(t, errors.Is(err, gpx.ErrInvalid), "got %v", err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// queryError is an error returned from a resolver. graphql-go reports its
+// message and copies Extensions into the response, so clients can branch on
+// extensions.code the way REST clients branch on error.code.
+type queryError struct {
+	code    string
+	message string
+}
+
+func (e *queryError) Error() string { return e.message }
+
+// Extensions implements the graphql-go resolver error interface.
+func (e *queryError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+// resolverError translates a service error into a GraphQL error, the way
+// the REST handlers translate into HTTP statuses:
+//
+//	domain.ErrNotFound   → not_found, with notFound as the message
+//	domain.ErrValidation → validation_error, with the validation detail
+//	domain.ErrTimeout    → query_timeout, the query ran past its limit
+//	anything else        → internal_error, logged and not echoed to the client
+func resolverError(ctx context.Context, logger *slog.Logger, err error, notFound string) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return &queryError{code: "not_found", message: notFound}
+	case errors.Is(err, domain.ErrValidation):
+		return &queryError{code: "validation_error", message: validationMessage(err)}
+	case errors.Is(err, domain.ErrTimeout):
+		return &queryError{code: "query_timeout", message: "query timed out"}
+	}
+	logger.ErrorContext(ctx, "graphql resolver failed", "error", err)
+	return &queryError{code: "internal_error", message: "internal server error"}
+}
+
+// validationMessage extracts the human-readable part of a wrapped
+// domain.ErrValidation, dropping the call-site prefixes in front of it.
+// e.g. "service.DashboardService.Dashboard: validation error: tags must be …" → "tags must be …"
+func validationMessage(err error) string {
+	msg := err.Error()
+	marker := domain.ErrValidation.Error()
+	i := strings.Index(msg, marker)
+	if i < 0 {
+		return msg
+	}
+	if detail, ok := strings.CutPrefix(msg[i+len(marker):], ": "); ok && detail != "" {
+		return detail
+	}
+	return marker
+}
+
+// panicHandler turns a panic in a resolver into an internal error on that
+// field instead of crashing the process, as middleware.NewRecoverer does for REST.
+type panicHandler struct {
+	logger *slog.Logger
+}
+
+func (h panicHandler) MakePanicError(ctx context.Context, value any) *gqlerrors.QueryError {
+	h.logger.ErrorContext(ctx, "graphql resolver panicked", "panic", value)
+	return &gqlerrors.QueryError{Message: "internal server error", Extensions: map[string]any{"code": "internal_error"}}
+}
@@ -0,0 +1,117 @@
+// Package graphqlapi serves a read-only GraphQL view of trips, stops, tags,
+// and the dashboard totals at /graphql, so the frontend can fetch nested
+// trip → stops → tags in one round trip. The schema is schema.graphql,
+// embedded at build time; writes stay on the REST API.
+//
+// Like packages handler and grpcapi, everything here is translation: the
+// same services the REST API uses do the work, and their errors become
+// GraphQL errors. Stops are fetched through a per-request loader so a page
+// of trips costs one stop query, not one per trip.
+//
+// The server is graph-gophers/graphql-go rather than gqlgen. It is schema
+// first all the same: schema.graphql is the contract, and NewHandler checks
+// every resolver method against it at startup, so a resolver that drifts
+// from the schema stops the server instead of failing a query. What it
+// saves is gqlgen's generated executor and the generator in the toolchain,
+// for four read-only queries over hand-written resolvers.
+package graphqlapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	graphql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// maxDepth bounds how deeply a query may nest selections. The schema itself
+// is only four levels deep; anything past this is a mistake or an attack.
+const maxDepth = 10
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// TripServicer defines the trip reads exposed over GraphQL.
+type TripServicer interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error)
+	ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error)
+}
+
+// StopServicer defines the stop reads exposed over GraphQL. ListByTripIDs
+// must return an entry for every requested trip.
+type StopServicer interface {
+	ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]domain.Stop, error)
+}
+
+// TagServicer defines the tag reads exposed over GraphQL.
+type TagServicer interface {
+	List(ctx context.Context, prefix string) ([]domain.Tag, error)
+}
+
+// DashboardServicer defines the totals exposed as the stats query.
+type DashboardServicer interface {
+	Dashboard(ctx context.Context, tagLimit *int) (domain.Dashboard, error)
+}
+
+// Handler executes GraphQL queries posted as JSON.
+type Handler struct {
+	schema *graphql.Schema
+	stops  StopServicer
+}
+
+// NewHandler creates a Handler. limits are the page sizes Query.trips
+// allows, the same as the REST API's. logger receives the unexpected errors
+// that are reported to clients only as "internal server error".
+func NewHandler(trips TripServicer, stops StopServicer, tags TagServicer, dashboard DashboardServicer, limits domain.PaginationLimits, logger *slog.Logger) *Handler {
+	root := &queryResolver{trips: trips, tags: tags, dashboard: dashboard, limits: limits, logger: logger}
+	schema := graphql.MustParseSchema(schemaSDL, root,
+		graphql.UseStringDescriptions(),
+		graphql.MaxDepth(maxDepth),
+		graphql.PanicHandler(panicHandler{logger: logger}),
+	)
+	return &Handler{schema: schema, stops: stops}
+}
+
+// request is the standard GraphQL-over-HTTP POST body.
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// ServeHTTP runs one query. Only POST is accepted. Errors raised while
+// resolving fields are reported in the response's errors list with status
+// 200, as GraphQL clients expect; only an unreadable body is a 400.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeResponse(w, http.StatusMethodNotAllowed, errorResponse("method not allowed"))
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Query == "" {
+		writeResponse(w, http.StatusBadRequest, errorResponse("request body must be a JSON object with a query"))
+		return
+	}
+
+	ctx := withStopLoader(r.Context(), newStopLoader(h.stops))
+	writeResponse(w, http.StatusOK, h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
+
+// errorResponse builds a response carrying a single error and no data.
+func errorResponse(message string) *graphql.Response {
+	return &graphql.Response{Errors: []*gqlerrors.QueryError{{Message: message}}}
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp *graphql.Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,280 @@
+package graphqlapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/graphqlapi"
+)
+
+// ---- mocks -----------------------------------------------------------------
+
+// mockTripServicer is a test double for graphqlapi.TripServicer.
+// Set only the method fields your test needs.
+type mockTripServicer struct {
+	getByID   func(ctx context.Context, id uuid.UUID) (domain.Trip, error)
+	listPaged func(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error)
+}
+
+func (m *mockTripServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockTripServicer) ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+	return m.listPaged(ctx, fields, p)
+}
+
+// mockStopServicer is a test double for graphqlapi.StopServicer.
+type mockStopServicer struct {
+	listByTripIDs func(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]domain.Stop, error)
+}
+
+func (m *mockStopServicer) ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]domain.Stop, error) {
+	return m.listByTripIDs(ctx, tripIDs)
+}
+
+// mockTagServicer is a test double for graphqlapi.TagServicer.
+type mockTagServicer struct {
+	list func(ctx context.Context, prefix string) ([]domain.Tag, error)
+}
+
+func (m *mockTagServicer) List(ctx context.Context, prefix string) ([]domain.Tag, error) {
+	return m.list(ctx, prefix)
+}
+
+// mockDashboardServicer is a test double for graphqlapi.DashboardServicer.
+type mockDashboardServicer struct {
+	dashboard func(ctx context.Context, tagLimit *int) (domain.Dashboard, error)
+}
+
+func (m *mockDashboardServicer) Dashboard(ctx context.Context, tagLimit *int) (domain.Dashboard, error) {
+	return m.dashboard(ctx, tagLimit)
+}
+
+// compile-time checks: the mocks must satisfy the graphqlapi interfaces.
+var (
+	_ graphqlapi.TripServicer      = (*mockTripServicer)(nil)
+	_ graphqlapi.StopServicer      = (*mockStopServicer)(nil)
+	_ graphqlapi.TagServicer       = (*mockTagServicer)(nil)
+	_ graphqlapi.DashboardServicer = (*mockDashboardServicer)(nil)
+)
+
+// ---- helpers ---------------------------------------------------------------
+
+type gqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// query posts a GraphQL query to a Handler built from the given mocks and
+// decodes the response. Nil services are replaced by empty mocks that fail
+// the test if called.
+func query(t *testing.T, trips *mockTripServicer, stops *mockStopServicer, dashboard *mockDashboardServicer, q string) (int, gqlResponse) {
+	t.Helper()
+	if trips == nil {
+		trips = &mockTripServicer{}
+	}
+	if stops == nil {
+		stops = &mockStopServicer{}
+	}
+	if dashboard == nil {
+		dashboard = &mockDashboardServicer{}
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	h := graphqlapi.NewHandler(trips, stops, &mockTagServicer{}, dashboard, domain.DefaultPaginationLimits, logger)
+
+	body, err := json.Marshal(map[string]string{"query": q})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+
+	var resp gqlResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return rec.Code, resp
+}
+
+func tripFixture(name string) domain.Trip {
+	return domain.Trip{
+		ID:        uuid.New(),
+		Name:      name,
+		StartDate: domain.NewDate(2025, 6, 1),
+		CreatedAt: time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// ---- trips -----------------------------------------------------------------
+
+func TestTrips_NestedStopsAndTags_LoadsStopsOnce(t *testing.T) {
+	a, b := tripFixture("A"), tripFixture("B")
+	var calls atomic.Int32
+
+	trips := &mockTripServicer{
+		listPaged: func(_ context.Context, _ []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+			assert.Equal(t, domain.PaginationParams{Page: 2, Limit: 100}, p, "limit is capped like the REST API")
+			return []domain.Trip{a, b}, 7, nil
+		},
+	}
+	stops := &mockStopServicer{
+		listByTripIDs: func(_ context.Context, ids []uuid.UUID) (map[uuid.UUID][]domain.Stop, error) {
+			calls.Add(1)
+			assert.ElementsMatch(t, []uuid.UUID{a.ID, b.ID}, ids)
+			return map[uuid.UUID][]domain.Stop{
+				a.ID: {{ID: uuid.New(), TripID: a.ID, Name: "Moab", Tags: []domain.Tag{{ID: uuid.New(), Name: "Desert", Slug: "desert"}}}},
+				b.ID: {},
+			}, nil
+		},
+	}
+
+	code, resp := query(t, trips, stops, nil, `{ trips(page: 2, limit: 500) { total items { name stops { name tags { slug } } } } }`)
+
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"trips": {"total": 7, "items": [
+		{"name": "A", "stops": [{"name": "Moab", "tags": [{"slug": "desert"}]}]},
+		{"name": "B", "stops": []}
+	]}}`, string(resp.Data))
+	assert.Equal(t, int32(1), calls.Load(), "stops for the whole page come from one call")
+}
+
+func TestTrip_Found(t *testing.T) {
+	trip := tripFixture("Summer Tour")
+	end := domain.NewDate(2025, 6, 15)
+	trip.EndDate = &end
+
+	trips := &mockTripServicer{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			assert.Equal(t, trip.ID, id)
+			return trip, nil
+		},
+	}
+
+	_, resp := query(t, trips, nil, nil, fmt.Sprintf(`{ trip(id: %q) { id name startDate endDate createdAt } }`, trip.ID))
+
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, fmt.Sprintf(`{"trip": {"id": %q, "name": "Summer Tour", "startDate": "2025-06-01", "endDate": "2025-06-15", "createdAt": "2025-05-20T12:00:00Z"}}`, trip.ID), string(resp.Data))
+}
+
+func TestTrip_NotFound_IsNull(t *testing.T) {
+	trips := &mockTripServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) {
+			return domain.Trip{}, domain.ErrNotFound
+		},
+	}
+
+	_, resp := query(t, trips, nil, nil, fmt.Sprintf(`{ trip(id: %q) { name } }`, uuid.New()))
+
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"trip": null}`, string(resp.Data))
+}
+
+func TestTrip_MalformedID(t *testing.T) {
+	_, resp := query(t, nil, nil, nil, `{ trip(id: "nope") { name } }`)
+
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "id must be a UUID", resp.Errors[0].Message)
+	assert.Equal(t, "validation_error", resp.Errors[0].Extensions["code"])
+}
+
+// ---- stats -----------------------------------------------------------------
+
+func TestStats_OK(t *testing.T) {
+	dashboard := &mockDashboardServicer{
+		dashboard: func(_ context.Context, tagLimit *int) (domain.Dashboard, error) {
+			require.NotNil(t, tagLimit)
+			assert.Equal(t, 3, *tagLimit)
+			return domain.Dashboard{
+				Trips: []domain.TripSummary{{TripID: uuid.New(), Name: "A", Stops: 2, Nights: 5}, {TripID: uuid.New(), Name: "B", Stops: 1, Nights: 1}},
+				Tags:  []domain.TagCount{{Slug: "desert", Name: "Desert", Stops: 2}},
+			}, nil
+		},
+	}
+
+	_, resp := query(t, nil, nil, dashboard, `{ stats(tagLimit: 3) { totalStops totalNights tags { slug stops } } }`)
+
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"stats": {"totalStops": 3, "totalNights": 6, "tags": [{"slug": "desert", "stops": 2}]}}`, string(resp.Data))
+}
+
+func TestStats_ValidationError(t *testing.T) {
+	dashboard := &mockDashboardServicer{
+		dashboard: func(_ context.Context, _ *int) (domain.Dashboard, error) {
+			return domain.Dashboard{}, fmt.Errorf("service.DashboardService.Dashboard: %w: tags must be between 1 and 100", domain.ErrValidation)
+		},
+	}
+
+	_, resp := query(t, nil, nil, dashboard, `{ stats(tagLimit: 0) { totalStops } }`)
+
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "tags must be between 1 and 100", resp.Errors[0].Message)
+	assert.Equal(t, "validation_error", resp.Errors[0].Extensions["code"])
+}
+
+func TestStats_QueryTimeout(t *testing.T) {
+	dashboard := &mockDashboardServicer{
+		dashboard: func(_ context.Context, _ *int) (domain.Dashboard, error) {
+			return domain.Dashboard{}, fmt.Errorf("repo.SummaryRepo.Dashboard: %w: canceling statement", domain.ErrTimeout)
+		},
+	}
+
+	_, resp := query(t, nil, nil, dashboard, `{ stats { totalStops } }`)
+
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "query timed out", resp.Errors[0].Message)
+	assert.Equal(t, "query_timeout", resp.Errors[0].Extensions["code"])
+}
+
+func TestStats_InternalErrorNotLeaked(t *testing.T) {
+	dashboard := &mockDashboardServicer{
+		dashboard: func(_ context.Context, _ *int) (domain.Dashboard, error) {
+			return domain.Dashboard{}, errors.New("pq: connection refused")
+		},
+	}
+
+	_, resp := query(t, nil, nil, dashboard, `{ stats { totalStops } }`)
+
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "internal server error", resp.Errors[0].Message)
+	assert.Equal(t, "internal_error", resp.Errors[0].Extensions["code"])
+}
+
+// ---- transport -------------------------------------------------------------
+
+func TestHandler_RejectsGET(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	h := graphqlapi.NewHandler(&mockTripServicer{}, &mockStopServicer{}, &mockTagServicer{}, &mockDashboardServicer{}, domain.DefaultPaginationLimits, logger)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query={stats{totalStops}}", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+}
+
+func TestHandler_MalformedBody(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	h := graphqlapi.NewHandler(&mockTripServicer{}, &mockStopServicer{}, &mockTagServicer{}, &mockDashboardServicer{}, domain.DefaultPaginationLimits, logger)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("{")))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "errors")
+}
@@ -0,0 +1,82 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// stopLoader fetches stops for the trips of one request in batches.
+//
+// Resolvers announce every trip they return with prime; the first
+// Trip.stops field to be resolved then loads the stops of all announced
+// trips in a single ListByTripIDs call and the rest are served from memory.
+// A loader lives for one request only, so it never serves stale data.
+type stopLoader struct {
+	stops StopServicer
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]struct{}
+	loaded  map[uuid.UUID][]domain.Stop
+}
+
+func newStopLoader(stops StopServicer) *stopLoader {
+	return &stopLoader{
+		stops:   stops,
+		pending: map[uuid.UUID]struct{}{},
+		loaded:  map[uuid.UUID][]domain.Stop{},
+	}
+}
+
+// prime queues trips to be included in the next batch.
+func (l *stopLoader) prime(tripIDs ...uuid.UUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, id := range tripIDs {
+		if _, ok := l.loaded[id]; !ok {
+			l.pending[id] = struct{}{}
+		}
+	}
+}
+
+// load returns the stops of tripID, fetching it together with every pending
+// trip if it has not been loaded yet. Concurrent callers wait for the batch
+// in flight rather than issuing their own.
+func (l *stopLoader) load(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if stops, ok := l.loaded[tripID]; ok {
+		return stops, nil
+	}
+
+	l.pending[tripID] = struct{}{}
+	batch := make([]uuid.UUID, 0, len(l.pending))
+	for id := range l.pending {
+		batch = append(batch, id)
+	}
+
+	byTrip, err := l.stops.ListByTripIDs(ctx, batch)
+	if err != nil {
+		return nil, fmt.Errorf("graphqlapi.stopLoader.load: %w", err)
+	}
+	for _, id := range batch {
+		l.loaded[id] = byTrip[id]
+		delete(l.pending, id)
+	}
+	return l.loaded[tripID], nil
+}
+
+type stopLoaderKey struct{}
+
+func withStopLoader(ctx context.Context, l *stopLoader) context.Context {
+	return context.WithValue(ctx, stopLoaderKey{}, l)
+}
+
+func stopLoaderFrom(ctx context.Context) *stopLoader {
+	return ctx.Value(stopLoaderKey{}).(*stopLoader)
+}
@@ -0,0 +1,242 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// queryResolver resolves the fields of the Query type. graphql-go matches
+// schema fields to exported methods by name (trip → Trip).
+type queryResolver struct {
+	trips     TripServicer
+	tags      TagServicer
+	dashboard DashboardServicer
+	limits    domain.PaginationLimits
+	logger    *slog.Logger
+}
+
+// Trip resolves Query.trip. A missing trip is null rather than an error.
+func (q *queryResolver) Trip(ctx context.Context, args struct{ ID graphql.ID }) (*tripResolver, error) {
+	id, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, &queryError{code: "validation_error", message: "id must be a UUID"}
+	}
+
+	trip, err := q.trips.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverError(ctx, q.logger, err, "trip not found")
+	}
+	stopLoaderFrom(ctx).prime(trip.ID)
+	return &tripResolver{trip: trip, logger: q.logger}, nil
+}
+
+// Trips resolves Query.trips. Page and limit default and cap as on the
+// REST API, within q.limits.
+func (q *queryResolver) Trips(ctx context.Context, args struct {
+	Page  *int32
+	Limit *int32
+}) (*tripPageResolver, error) {
+	params := q.limits.Params(intPtr(args.Page), intPtr(args.Limit))
+
+	trips, total, err := q.trips.ListPaged(ctx, nil, params)
+	if err != nil {
+		return nil, resolverError(ctx, q.logger, err, "trip not found")
+	}
+
+	page := &tripPageResolver{items: make([]*tripResolver, len(trips)), total: total}
+	ids := make([]uuid.UUID, len(trips))
+	for i, t := range trips {
+		page.items[i] = &tripResolver{trip: t, logger: q.logger}
+		ids[i] = t.ID
+	}
+	stopLoaderFrom(ctx).prime(ids...)
+	return page, nil
+}
+
+// Tags resolves Query.tags.
+func (q *queryResolver) Tags(ctx context.Context, args struct{ Prefix *string }) ([]*tagResolver, error) {
+	var prefix string
+	if args.Prefix != nil {
+		prefix = *args.Prefix
+	}
+
+	tags, err := q.tags.List(ctx, prefix)
+	if err != nil {
+		return nil, resolverError(ctx, q.logger, err, "tag not found")
+	}
+	return tagResolvers(tags), nil
+}
+
+// Stats resolves Query.stats from the dashboard summary.
+func (q *queryResolver) Stats(ctx context.Context, args struct{ TagLimit *int32 }) (*statsResolver, error) {
+	d, err := q.dashboard.Dashboard(ctx, intPtr(args.TagLimit))
+	if err != nil {
+		return nil, resolverError(ctx, q.logger, err, "stats not found")
+	}
+	return &statsResolver{d: d}, nil
+}
+
+// ---- Trip ------------------------------------------------------------------
+
+type tripPageResolver struct {
+	items []*tripResolver
+	total int64
+}
+
+func (p *tripPageResolver) Items() []*tripResolver { return p.items }
+func (p *tripPageResolver) Total() int32           { return int32(p.total) }
+
+type tripResolver struct {
+	trip   domain.Trip
+	logger *slog.Logger
+}
+
+func (r *tripResolver) ID() graphql.ID          { return graphql.ID(r.trip.ID.String()) }
+func (r *tripResolver) Name() string            { return r.trip.Name }
+func (r *tripResolver) StartDate() string       { return r.trip.StartDate.String() }
+func (r *tripResolver) EndDate() *string        { return datePtr(r.trip.EndDate) }
+func (r *tripResolver) Notes() string           { return r.trip.Notes }
+func (r *tripResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.trip.CreatedAt} }
+func (r *tripResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.trip.UpdatedAt} }
+
+// Stops resolves Trip.stops through the request's stopLoader.
+func (r *tripResolver) Stops(ctx context.Context) ([]*stopResolver, error) {
+	stops, err := stopLoaderFrom(ctx).load(ctx, r.trip.ID)
+	if err != nil {
+		return nil, resolverError(ctx, r.logger, err, "trip not found")
+	}
+	out := make([]*stopResolver, len(stops))
+	for i, s := range stops {
+		out[i] = &stopResolver{stop: s}
+	}
+	return out, nil
+}
+
+// ---- Stop and Tag ----------------------------------------------------------
+
+type stopResolver struct {
+	stop domain.Stop
+}
+
+func (r *stopResolver) ID() graphql.ID            { return graphql.ID(r.stop.ID.String()) }
+func (r *stopResolver) TripID() graphql.ID        { return graphql.ID(r.stop.TripID.String()) }
+func (r *stopResolver) Name() string              { return r.stop.Name }
+func (r *stopResolver) Location() string          { return r.stop.Location }
+func (r *stopResolver) Latitude() *float64        { return r.stop.Latitude }
+func (r *stopResolver) Longitude() *float64       { return r.stop.Longitude }
+func (r *stopResolver) ArrivedAt() *graphql.Time  { return timePtr(arrival(r.stop)) }
+func (r *stopResolver) Planned() bool             { return r.stop.Planned }
+func (r *stopResolver) DepartedAt() *graphql.Time { return timePtr(r.stop.DepartedAt) }
+func (r *stopResolver) Notes() string             { return r.stop.Notes }
+func (r *stopResolver) CreatedAt() graphql.Time   { return graphql.Time{Time: r.stop.CreatedAt} }
+func (r *stopResolver) UpdatedAt() graphql.Time   { return graphql.Time{Time: r.stop.UpdatedAt} }
+
+// Tags resolves Stop.tags. The repository aggregates them into the stop
+// query, so there is nothing further to load.
+func (r *stopResolver) Tags() []*tagResolver { return tagResolvers(r.stop.Tags) }
+
+type tagResolver struct {
+	tag domain.Tag
+}
+
+func (r *tagResolver) ID() graphql.ID          { return graphql.ID(r.tag.ID.String()) }
+func (r *tagResolver) Name() string            { return r.tag.Name }
+func (r *tagResolver) Slug() string            { return r.tag.Slug }
+func (r *tagResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.tag.CreatedAt} }
+
+func tagResolvers(tags []domain.Tag) []*tagResolver {
+	out := make([]*tagResolver, len(tags))
+	for i, t := range tags {
+		out[i] = &tagResolver{tag: t}
+	}
+	return out
+}
+
+// ---- Stats -----------------------------------------------------------------
+
+type statsResolver struct {
+	d domain.Dashboard
+}
+
+func (r *statsResolver) TotalStops() int32  { return int32(r.d.TotalStops()) }
+func (r *statsResolver) TotalNights() int32 { return int32(r.d.TotalNights()) }
+
+func (r *statsResolver) Trips() []*tripSummaryResolver {
+	out := make([]*tripSummaryResolver, len(r.d.Trips))
+	for i, t := range r.d.Trips {
+		out[i] = &tripSummaryResolver{s: t}
+	}
+	return out
+}
+
+func (r *statsResolver) Tags() []*tagCountResolver {
+	out := make([]*tagCountResolver, len(r.d.Tags))
+	for i, t := range r.d.Tags {
+		out[i] = &tagCountResolver{c: t}
+	}
+	return out
+}
+
+type tripSummaryResolver struct {
+	s domain.TripSummary
+}
+
+func (r *tripSummaryResolver) TripID() graphql.ID            { return graphql.ID(r.s.TripID.String()) }
+func (r *tripSummaryResolver) Name() string                  { return r.s.Name }
+func (r *tripSummaryResolver) StartDate() string             { return r.s.StartDate.String() }
+func (r *tripSummaryResolver) EndDate() *string              { return datePtr(r.s.EndDate) }
+func (r *tripSummaryResolver) Stops() int32                  { return int32(r.s.Stops) }
+func (r *tripSummaryResolver) Nights() int32                 { return int32(r.s.Nights) }
+func (r *tripSummaryResolver) FirstArrivedAt() *graphql.Time { return timePtr(r.s.FirstArrivedAt) }
+func (r *tripSummaryResolver) LastArrivedAt() *graphql.Time  { return timePtr(r.s.LastArrivedAt) }
+
+type tagCountResolver struct {
+	c domain.TagCount
+}
+
+func (r *tagCountResolver) Slug() string { return r.c.Slug }
+func (r *tagCountResolver) Name() string { return r.c.Name }
+func (r *tagCountResolver) Stops() int32 { return int32(r.c.Stops) }
+
+// ---- helpers ---------------------------------------------------------------
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func datePtr(d *domain.Date) *string {
+	if d == nil {
+		return nil
+	}
+	s := d.String()
+	return &s
+}
+
+// arrival returns when the stop was reached, or nil while it is planned.
+func arrival(s domain.Stop) *time.Time {
+	if s.Planned {
+		return nil
+	}
+	return &s.ArrivedAt
+}
+
+func timePtr(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
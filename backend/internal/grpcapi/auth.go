@@ -0,0 +1,138 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TokenVerifier is what NewAuthInterceptor needs to check a bearer token.
+// *service.AuthService satisfies it.
+type TokenVerifier interface {
+	Verify(ctx context.Context, accessToken string) (uuid.UUID, error)
+}
+
+// APIKeyVerifier is what NewAuthInterceptor needs to check an API key.
+// *service.APIKeyService satisfies it.
+type APIKeyVerifier interface {
+	Verify(ctx context.Context, key string) (domain.APIKey, error)
+}
+
+// OrganizationLookup is what NewAuthInterceptor needs to check the
+// organization a call names. repo.OrganizationRepo satisfies it.
+type OrganizationLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error)
+	GetMember(ctx context.Context, orgID uuid.UUID, userID string) (domain.Member, error)
+}
+
+// Metadata keys read by NewAuthInterceptor. gRPC lower-cases every key, so
+// these are the REST API's headers as they arrive here.
+const (
+	authorizationKey = "authorization"
+	apiKeyKey        = "x-api-key"
+	organizationKey  = "x-organization-id"
+)
+
+// NewAuthInterceptor returns the gRPC counterpart of the REST API's
+// NewAuthHandler and NewOrganizationHandler. It authenticates each call by
+// an "authorization: Bearer <token>" or "x-api-key" metadata entry and
+// records the actor and the user on the context, then makes the call act for
+// the organization in "x-organization-id", or the default one.
+//
+// A missing, invalid, or expired credential is rejected with
+// codes.Unauthenticated, and an organization the user is not a member of
+// with codes.PermissionDenied. tokens nil turns authentication off, as the
+// REST API does while no accounts are configured; the organization is
+// still applied. keys may be nil, in which case API keys are not accepted.
+func NewAuthInterceptor(tokens TokenVerifier, keys APIKeyVerifier, orgs OrganizationLookup) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if tokens != nil {
+			var err error
+			if ctx, err = authenticate(ctx, md, tokens, keys); err != nil {
+				return nil, err
+			}
+		}
+		ctx, err := withOrganization(ctx, md, orgs)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authenticate checks the call's credentials, preferring an API key as
+// NewAuthHandler does.
+func authenticate(ctx context.Context, md metadata.MD, tokens TokenVerifier, keys APIKeyVerifier) (context.Context, error) {
+	if key := strings.TrimSpace(firstValue(md, apiKeyKey)); key != "" && keys != nil {
+		apiKey, err := keys.Verify(ctx, key)
+		if err != nil {
+			if errors.Is(err, domain.ErrUnauthorized) {
+				return ctx, status.Error(codes.Unauthenticated, "the API key is invalid or revoked")
+			}
+			return ctx, status.Error(codes.Internal, "internal server error")
+		}
+		ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorAPIKey, ID: apiKey.ID.String(), UserID: apiKey.UserID.String()})
+		return domain.WithUser(ctx, apiKey.UserID), nil
+	}
+
+	scheme, token, _ := strings.Cut(firstValue(md, authorizationKey), " ")
+	token = strings.TrimSpace(token)
+	if !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return ctx, status.Error(codes.Unauthenticated, "a bearer token is required")
+	}
+	userID, err := tokens.Verify(ctx, token)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return ctx, status.Error(codes.Unauthenticated, "the bearer token is invalid or expired")
+		}
+		return ctx, status.Error(codes.Internal, "internal server error")
+	}
+	ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorUser, ID: userID.String(), UserID: userID.String()})
+	return domain.WithUser(ctx, userID), nil
+}
+
+// withOrganization makes the call act for the organization it names, after
+// checking the authenticated user belongs to it.
+func withOrganization(ctx context.Context, md metadata.MD, orgs OrganizationLookup) (context.Context, error) {
+	value := firstValue(md, organizationKey)
+	if value == "" {
+		return ctx, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return ctx, status.Error(codes.InvalidArgument, "x-organization-id must be a UUID")
+	}
+	if _, err := orgs.GetByID(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return ctx, status.Error(codes.NotFound, "organization not found")
+		}
+		return ctx, status.Error(codes.Internal, "internal server error")
+	}
+	if actor, ok := auth.ActorFromContext(ctx); ok {
+		if _, err := orgs.GetMember(ctx, id, actor.UserID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return ctx, status.Error(codes.PermissionDenied, "you are not a member of this organization")
+			}
+			return ctx, status.Error(codes.Internal, "internal server error")
+		}
+	}
+	return domain.WithOrganization(ctx, id), nil
+}
+
+// firstValue returns the first value of a metadata key, or "".
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package grpcapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/grpcapi"
+	pb "github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1"
+)
+
+var (
+	alice = uuid.New()
+	bob   = uuid.New()
+	piKey = domain.APIKey{ID: uuid.New(), UserID: bob}
+	org   = uuid.New()
+)
+
+// fakeTokens accepts "good" as alice's token; "broken" is an unexpected
+// error and anything else is unauthorized.
+type fakeTokens struct{}
+
+func (fakeTokens) Verify(_ context.Context, token string) (uuid.UUID, error) {
+	switch token {
+	case "good":
+		return alice, nil
+	case "broken":
+		return uuid.Nil, errors.New("db down")
+	}
+	return uuid.Nil, domain.ErrUnauthorized
+}
+
+// fakeKeys accepts "rvk_good" as a key bob created.
+type fakeKeys struct{}
+
+func (fakeKeys) Verify(_ context.Context, key string) (domain.APIKey, error) {
+	if key == "rvk_good" {
+		return piKey, nil
+	}
+	return domain.APIKey{}, domain.ErrUnauthorized
+}
+
+// fakeOrgs knows one organization, of which only alice is a member.
+type fakeOrgs struct{}
+
+func (fakeOrgs) GetByID(_ context.Context, id uuid.UUID) (domain.Organization, error) {
+	if id != org {
+		return domain.Organization{}, domain.ErrNotFound
+	}
+	return domain.Organization{ID: id}, nil
+}
+
+func (fakeOrgs) GetMember(_ context.Context, orgID uuid.UUID, userID string) (domain.Member, error) {
+	if orgID == org && userID == alice.String() {
+		return domain.Member{}, nil
+	}
+	return domain.Member{}, domain.ErrNotFound
+}
+
+// seenCall is what a handler behind NewAuthInterceptor saw on its context.
+type seenCall struct {
+	reached bool
+	actor   auth.Actor
+	user    uuid.UUID
+	org     uuid.UUID
+}
+
+// callAuthed makes one GetTrip call with md through NewAuthInterceptor and
+// reports what the service saw and the call's error.
+func callAuthed(t *testing.T, tokens grpcapi.TokenVerifier, md metadata.MD) (seenCall, error) {
+	t.Helper()
+	var seen seenCall
+	trips := &mockTripServicer{getByID: func(ctx context.Context, _ uuid.UUID) (domain.Trip, error) {
+		seen.reached = true
+		seen.actor, _ = auth.ActorFromContext(ctx)
+		seen.user, _ = domain.UserFromContext(ctx)
+		seen.org = domain.OrganizationFromContext(ctx)
+		return tripFixture(), nil
+	}}
+	client := pb.NewTripServiceClient(dialWith(t, grpcapi.NewAuthInterceptor(tokens, fakeKeys{}, fakeOrgs{}), trips, nil, nil))
+
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := client.GetTrip(ctx, &pb.GetTripRequest{Id: uuid.NewString()})
+	return seen, err
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	cases := []struct {
+		name     string
+		md       metadata.MD
+		wantCode codes.Code
+		want     seenCall
+	}{
+		{"no credentials", metadata.Pairs(), codes.Unauthenticated, seenCall{}},
+		{"wrong scheme", metadata.Pairs("authorization", "Basic YWxpY2U6czNjcmV0"), codes.Unauthenticated, seenCall{}},
+		{"invalid token", metadata.Pairs("authorization", "Bearer forged"), codes.Unauthenticated, seenCall{}},
+		{"verifier failure", metadata.Pairs("authorization", "Bearer broken"), codes.Internal, seenCall{}},
+		{"unknown key", metadata.Pairs("x-api-key", "rvk_revoked"), codes.Unauthenticated, seenCall{}},
+		{
+			"bearer token", metadata.Pairs("authorization", "Bearer good"), codes.OK,
+			seenCall{reached: true, actor: auth.Actor{Type: auth.ActorUser, ID: alice.String(), UserID: alice.String()}, user: alice, org: domain.DefaultOrganizationID},
+		},
+		{
+			"API key", metadata.Pairs("x-api-key", "rvk_good"), codes.OK,
+			seenCall{reached: true, actor: auth.Actor{Type: auth.ActorAPIKey, ID: piKey.ID.String(), UserID: bob.String()}, user: bob, org: domain.DefaultOrganizationID},
+		},
+		{
+			"member's organization", metadata.Pairs("authorization", "Bearer good", "x-organization-id", org.String()), codes.OK,
+			seenCall{reached: true, actor: auth.Actor{Type: auth.ActorUser, ID: alice.String(), UserID: alice.String()}, user: alice, org: org},
+		},
+		{"key owner not a member", metadata.Pairs("x-api-key", "rvk_good", "x-organization-id", org.String()), codes.PermissionDenied, seenCall{}},
+		{"unknown organization", metadata.Pairs("authorization", "Bearer good", "x-organization-id", uuid.NewString()), codes.NotFound, seenCall{}},
+		{"malformed organization", metadata.Pairs("authorization", "Bearer good", "x-organization-id", "acme"), codes.InvalidArgument, seenCall{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			seen, err := callAuthed(t, fakeTokens{}, tc.md)
+
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.Equal(t, tc.want, seen)
+		})
+	}
+}
+
+// TestAuthInterceptor_Disabled verifies that without a token verifier, as
+// when no accounts are configured, calls need no credentials but still act
+// for the organization they name.
+func TestAuthInterceptor_Disabled(t *testing.T) {
+	seen, err := callAuthed(t, nil, metadata.Pairs("x-organization-id", org.String()))
+
+	require.NoError(t, err)
+	assert.True(t, seen.reached)
+	assert.Equal(t, org, seen.org)
+	assert.Equal(t, uuid.Nil, seen.user, "no user is set")
+}
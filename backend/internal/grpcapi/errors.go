@@ -0,0 +1,66 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// statusError translates a service error into a gRPC status, the way the
+// REST handlers translate into HTTP statuses:
+//
+//	domain.ErrNotFound   → NotFound, with notFound as the message
+//	domain.ErrValidation → InvalidArgument, with the validation detail
+//	domain.ErrForbidden  → PermissionDenied, with the reason
+//	domain.ErrTimeout    → DeadlineExceeded, the query ran past its limit
+//	context errors       → Canceled / DeadlineExceeded
+//	anything else        → Internal, logged and not echoed to the client
+func (s *Server) statusError(ctx context.Context, err error, notFound string) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	case errors.Is(err, domain.ErrValidation):
+		return status.Error(codes.InvalidArgument, detailMessage(err, domain.ErrValidation))
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, detailMessage(err, domain.ErrForbidden))
+	case errors.Is(err, domain.ErrTimeout):
+		return status.Error(codes.DeadlineExceeded, "query timed out")
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	s.logger.ErrorContext(ctx, "grpc request failed", "error", err)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// detailMessage extracts the human-readable part of an error wrapping
+// sentinel, dropping the call-site prefixes in front of it.
+// e.g. "service.TripService.Create: validation error: name is required" → "name is required"
+func detailMessage(err, sentinel error) string {
+	msg := err.Error()
+	marker := sentinel.Error()
+	i := strings.Index(msg, marker)
+	if i < 0 {
+		return msg
+	}
+	if detail, ok := strings.CutPrefix(msg[i+len(marker):], ": "); ok && detail != "" {
+		return detail
+	}
+	return marker
+}
+
+// parseID parses a UUID request field, naming the field in the
+// InvalidArgument status when it is malformed.
+func parseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s must be a UUID", field))
+	}
+	return id, nil
+}
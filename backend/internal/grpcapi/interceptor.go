@@ -0,0 +1,34 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewUnaryInterceptor returns the gRPC counterpart of the REST middleware
+// stack: it logs each call as one structured line (method, status code,
+// duration) and turns a panic in a handler into codes.Internal instead of
+// crashing the process.
+func NewUnaryInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		start := time.Now()
+		defer func() {
+			if p := recover(); p != nil {
+				log.ErrorContext(ctx, "grpc handler panicked", "method", info.FullMethod, "panic", p, "stack", string(debug.Stack()))
+				resp, err = nil, status.Error(codes.Internal, "internal server error")
+			}
+			log.InfoContext(ctx, "grpc request",
+				"method", info.FullMethod,
+				"code", status.Code(err).String(),
+				"duration_ms", time.Since(start).Milliseconds(),
+			)
+		}()
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,8 @@
+// Package logbookv1 contains code generated from proto/rvlogbook/v1/logbook.proto
+// by protoc-gen-go and protoc-gen-go-grpc. Do not edit the generated files
+// (*.pb.go) by hand.
+//
+// To regenerate, run: make backend/generate
+package logbookv1
+
+//go:generate protoc -I ../../../proto --go_out=. --go_opt=module=github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1 --go-grpc_out=. --go-grpc_opt=module=github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1 rvlogbook/v1/logbook.proto
@@ -0,0 +1,74 @@
+// Package grpcapi serves the core trip, stop, and tag operations over gRPC,
+// alongside the REST API, for on-board vehicle computers and sync agents.
+// The wire format is defined in proto/rvlogbook/v1/logbook.proto; the
+// generated code lives in logbookv1.
+//
+// Like package handler, everything here is translation: requests become
+// domain values, the same services the REST API uses do the work, and their
+// errors become gRPC status codes. Methods are split by resource (trip.go,
+// stop.go, tag.go) but share the one Server struct.
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	pb "github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1"
+)
+
+// TripServicer defines the trip operations exposed over gRPC.
+type TripServicer interface {
+	Create(ctx context.Context, trip domain.Trip) (domain.Trip, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error)
+	ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error)
+	Update(ctx context.Context, trip domain.Trip) (domain.Trip, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// StopServicer defines the stop and stop-tag operations exposed over gRPC.
+type StopServicer interface {
+	Create(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error)
+	ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error)
+	Update(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	Delete(ctx context.Context, tripID, stopID uuid.UUID) error
+	AddTag(ctx context.Context, tripID, stopID uuid.UUID, tagName string) (domain.Tag, error)
+	RemoveTagFromStop(ctx context.Context, tripID, stopID uuid.UUID, slug string) error
+}
+
+// TagServicer defines the tag operations exposed over gRPC.
+type TagServicer interface {
+	List(ctx context.Context, prefix string) ([]domain.Tag, error)
+}
+
+// Server implements the TripService, StopService, and TagService gRPC
+// services defined in logbook.proto.
+type Server struct {
+	pb.UnimplementedTripServiceServer
+	pb.UnimplementedStopServiceServer
+	pb.UnimplementedTagServiceServer
+
+	trips  TripServicer
+	stops  StopServicer
+	tags   TagServicer
+	limits domain.PaginationLimits
+	logger *slog.Logger
+}
+
+// NewServer creates a Server. limits are the page sizes ListTrips allows,
+// the same as the REST API's. logger receives the unexpected errors that
+// are reported to clients only as codes.Internal.
+func NewServer(trips TripServicer, stops StopServicer, tags TagServicer, limits domain.PaginationLimits, logger *slog.Logger) *Server {
+	return &Server{trips: trips, stops: stops, tags: tags, limits: limits, logger: logger}
+}
+
+// Register adds all three services to r, usually a *grpc.Server.
+func (s *Server) Register(r grpc.ServiceRegistrar) {
+	pb.RegisterTripServiceServer(r, s)
+	pb.RegisterStopServiceServer(r, s)
+	pb.RegisterTagServiceServer(r, s)
+}
@@ -0,0 +1,405 @@
+package grpcapi_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/grpcapi"
+	pb "github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1"
+)
+
+// ---- mocks -----------------------------------------------------------------
+
+// mockTripServicer is a test double for grpcapi.TripServicer.
+// Set only the method fields your test needs.
+type mockTripServicer struct {
+	create    func(ctx context.Context, trip domain.Trip) (domain.Trip, error)
+	getByID   func(ctx context.Context, id uuid.UUID) (domain.Trip, error)
+	listPaged func(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error)
+	update    func(ctx context.Context, trip domain.Trip) (domain.Trip, error)
+	delete    func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockTripServicer) Create(ctx context.Context, t domain.Trip) (domain.Trip, error) {
+	return m.create(ctx, t)
+}
+func (m *mockTripServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockTripServicer) ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+	return m.listPaged(ctx, fields, p)
+}
+func (m *mockTripServicer) Update(ctx context.Context, t domain.Trip) (domain.Trip, error) {
+	return m.update(ctx, t)
+}
+func (m *mockTripServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+// mockStopServicer is a test double for grpcapi.StopServicer.
+type mockStopServicer struct {
+	create            func(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	getByID           func(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error)
+	listByTripID      func(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error)
+	update            func(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	delete            func(ctx context.Context, tripID, stopID uuid.UUID) error
+	addTag            func(ctx context.Context, stopID uuid.UUID, tagName string) (domain.Tag, error)
+	removeTagFromStop func(ctx context.Context, stopID uuid.UUID, slug string) error
+}
+
+func (m *mockStopServicer) Create(ctx context.Context, s domain.Stop) (domain.Stop, error) {
+	return m.create(ctx, s)
+}
+func (m *mockStopServicer) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+	return m.getByID(ctx, tripID, stopID)
+}
+func (m *mockStopServicer) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
+	return m.listByTripID(ctx, tripID)
+}
+func (m *mockStopServicer) Update(ctx context.Context, s domain.Stop) (domain.Stop, error) {
+	return m.update(ctx, s)
+}
+func (m *mockStopServicer) Delete(ctx context.Context, tripID, stopID uuid.UUID) error {
+	return m.delete(ctx, tripID, stopID)
+}
+func (m *mockStopServicer) AddTag(ctx context.Context, _, stopID uuid.UUID, tagName string) (domain.Tag, error) {
+	return m.addTag(ctx, stopID, tagName)
+}
+func (m *mockStopServicer) RemoveTagFromStop(ctx context.Context, _, stopID uuid.UUID, slug string) error {
+	return m.removeTagFromStop(ctx, stopID, slug)
+}
+
+// mockTagServicer is a test double for grpcapi.TagServicer.
+type mockTagServicer struct {
+	list func(ctx context.Context, prefix string) ([]domain.Tag, error)
+}
+
+func (m *mockTagServicer) List(ctx context.Context, prefix string) ([]domain.Tag, error) {
+	return m.list(ctx, prefix)
+}
+
+// compile-time checks: the mocks must satisfy the grpcapi interfaces.
+var (
+	_ grpcapi.TripServicer = (*mockTripServicer)(nil)
+	_ grpcapi.StopServicer = (*mockStopServicer)(nil)
+	_ grpcapi.TagServicer  = (*mockTagServicer)(nil)
+)
+
+// ---- helpers ---------------------------------------------------------------
+
+// dial serves the given mocks on an in-memory listener, wired the way
+// app.New wires the real services, and returns a client connection to it.
+func dial(t *testing.T, trips grpcapi.TripServicer, stops grpcapi.StopServicer, tags grpcapi.TagServicer) *grpc.ClientConn {
+	t.Helper()
+	return dialWith(t, nil, trips, stops, tags)
+}
+
+// dialWith is dial with another interceptor chained after the logging one,
+// as the app chains NewAuthInterceptor.
+func dialWith(t *testing.T, extra grpc.UnaryServerInterceptor, trips grpcapi.TripServicer, stops grpcapi.StopServicer, tags grpcapi.TagServicer) *grpc.ClientConn {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	lis := bufconn.Listen(1 << 20)
+	interceptors := []grpc.UnaryServerInterceptor{grpcapi.NewUnaryInterceptor(logger)}
+	if extra != nil {
+		interceptors = append(interceptors, extra)
+	}
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	grpcapi.NewServer(trips, stops, tags, domain.DefaultPaginationLimits, logger).Register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func tripFixture() domain.Trip {
+	end := domain.NewDate(2025, 6, 15)
+	return domain.Trip{
+		ID:        uuid.New(),
+		Name:      "Summer Tour",
+		StartDate: domain.NewDate(2025, 6, 1),
+		EndDate:   &end,
+		Notes:     "test notes",
+		CreatedAt: time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// ---- trips -----------------------------------------------------------------
+
+func TestCreateTrip_ParsesDates(t *testing.T) {
+	want := tripFixture()
+	var got domain.Trip
+	svc := &mockTripServicer{create: func(_ context.Context, trip domain.Trip) (domain.Trip, error) {
+		got = trip
+		return want, nil
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	end := "2025-06-15"
+	resp, err := client.CreateTrip(context.Background(), &pb.CreateTripRequest{
+		Name: "Summer Tour", StartDate: "2025-06-01", EndDate: &end, Notes: "test notes",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.NewDate(2025, 6, 1), got.StartDate)
+	require.NotNil(t, got.EndDate)
+	assert.Equal(t, domain.NewDate(2025, 6, 15), *got.EndDate)
+	assert.Equal(t, want.ID.String(), resp.GetId())
+	assert.Equal(t, "2025-06-01", resp.GetStartDate())
+	assert.Equal(t, "2025-06-15", resp.GetEndDate())
+	assert.Equal(t, want.CreatedAt, resp.GetCreatedAt().AsTime())
+}
+
+func TestCreateTrip_BadDate(t *testing.T) {
+	client := pb.NewTripServiceClient(dial(t, &mockTripServicer{}, nil, nil))
+
+	_, err := client.CreateTrip(context.Background(), &pb.CreateTripRequest{Name: "x", StartDate: "06/01/2025"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateTrip_ValidationError(t *testing.T) {
+	svc := &mockTripServicer{create: func(context.Context, domain.Trip) (domain.Trip, error) {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Create: %w: name is required", domain.ErrValidation)
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.CreateTrip(context.Background(), &pb.CreateTripRequest{StartDate: "2025-06-01"})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "name is required", st.Message())
+}
+
+func TestGetTrip_NotFound(t *testing.T) {
+	svc := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) {
+		return domain.Trip{}, fmt.Errorf("repo.TripRepo.GetByID: %w", domain.ErrNotFound)
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.GetTrip(context.Background(), &pb.GetTripRequest{Id: uuid.NewString()})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "trip not found", st.Message())
+}
+
+func TestGetTrip_MalformedID(t *testing.T) {
+	client := pb.NewTripServiceClient(dial(t, &mockTripServicer{}, nil, nil))
+
+	_, err := client.GetTrip(context.Background(), &pb.GetTripRequest{Id: "not-a-uuid"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetTrip_QueryTimeout(t *testing.T) {
+	svc := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) {
+		return domain.Trip{}, fmt.Errorf("repo.TripRepo.GetByID: %w: canceling statement", domain.ErrTimeout)
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.GetTrip(context.Background(), &pb.GetTripRequest{Id: uuid.NewString()})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.DeadlineExceeded, st.Code())
+	assert.Equal(t, "query timed out", st.Message())
+}
+
+func TestGetTrip_InternalErrorIsNotEchoed(t *testing.T) {
+	svc := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) {
+		return domain.Trip{}, fmt.Errorf("repo.TripRepo.GetByID: connection refused")
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.GetTrip(context.Background(), &pb.GetTripRequest{Id: uuid.NewString()})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.NotContains(t, st.Message(), "connection refused")
+}
+
+func TestGetTrip_PanicIsInternal(t *testing.T) {
+	svc := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) {
+		panic("boom")
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.GetTrip(context.Background(), &pb.GetTripRequest{Id: uuid.NewString()})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestListTrips_DefaultsAndTotal(t *testing.T) {
+	var got domain.PaginationParams
+	svc := &mockTripServicer{listPaged: func(_ context.Context, _ []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+		got = p
+		return []domain.Trip{tripFixture()}, 41, nil
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	resp, err := client.ListTrips(context.Background(), &pb.ListTripsRequest{Limit: 500})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.PaginationParams{Page: 1, Limit: 100}, got)
+	assert.Len(t, resp.GetTrips(), 1)
+	assert.Equal(t, int64(41), resp.GetTotal())
+}
+
+func TestDeleteTrip_NotFound(t *testing.T) {
+	svc := &mockTripServicer{delete: func(context.Context, uuid.UUID) error { return domain.ErrNotFound }}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.DeleteTrip(context.Background(), &pb.DeleteTripRequest{Id: uuid.NewString()})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeleteTrip_Forbidden(t *testing.T) {
+	svc := &mockTripServicer{delete: func(context.Context, uuid.UUID) error {
+		return fmt.Errorf("service.TripService.Delete: %w: only the trip's owner may do this", domain.ErrForbidden)
+	}}
+	client := pb.NewTripServiceClient(dial(t, svc, nil, nil))
+
+	_, err := client.DeleteTrip(context.Background(), &pb.DeleteTripRequest{Id: uuid.NewString()})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Equal(t, "only the trip's owner may do this", st.Message())
+}
+
+// ---- stops -----------------------------------------------------------------
+
+func TestCreateStop_MapsFields(t *testing.T) {
+	tripID := uuid.New()
+	arrived := time.Date(2025, 6, 2, 15, 0, 0, 0, time.UTC)
+	lat, lon := 38.57, -109.55
+	var got domain.Stop
+	svc := &mockStopServicer{create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+		got = s
+		s.ID = uuid.New()
+		s.Tags = []domain.Tag{{ID: uuid.New(), Name: "Free Camping", Slug: "free-camping"}}
+		return s, nil
+	}}
+	client := pb.NewStopServiceClient(dial(t, nil, svc, nil))
+
+	resp, err := client.CreateStop(context.Background(), &pb.CreateStopRequest{
+		TripId: tripID.String(),
+		Stop: &pb.StopFields{
+			Name: "Moab", Latitude: &lat, Longitude: &lon, ArrivedAt: timestamppb.New(arrived),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, tripID, got.TripID)
+	assert.Equal(t, arrived, got.ArrivedAt)
+	assert.Nil(t, got.DepartedAt)
+	require.NotNil(t, got.Latitude)
+	assert.InDelta(t, lat, *got.Latitude, 1e-9)
+	assert.Nil(t, resp.GetDepartedAt())
+	require.Len(t, resp.GetTags(), 1)
+	assert.Equal(t, "free-camping", resp.GetTags()[0].GetSlug())
+}
+
+func TestCreateStop_RequiresArrivedAt(t *testing.T) {
+	client := pb.NewStopServiceClient(dial(t, nil, &mockStopServicer{}, nil))
+
+	_, err := client.CreateStop(context.Background(), &pb.CreateStopRequest{
+		TripId: uuid.NewString(),
+		Stop:   &pb.StopFields{Name: "Moab"},
+	})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "arrived_at is required", st.Message())
+}
+
+func TestGetStop_NotFound(t *testing.T) {
+	svc := &mockStopServicer{getByID: func(context.Context, uuid.UUID, uuid.UUID) (domain.Stop, error) {
+		return domain.Stop{}, domain.ErrNotFound
+	}}
+	client := pb.NewStopServiceClient(dial(t, nil, svc, nil))
+
+	_, err := client.GetStop(context.Background(), &pb.GetStopRequest{TripId: uuid.NewString(), StopId: uuid.NewString()})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "stop not found", st.Message())
+}
+
+// ---- tags ------------------------------------------------------------------
+
+func TestListTags_PassesPrefix(t *testing.T) {
+	var got string
+	svc := &mockTagServicer{list: func(_ context.Context, prefix string) ([]domain.Tag, error) {
+		got = prefix
+		return []domain.Tag{{ID: uuid.New(), Name: "Free Camping", Slug: "free-camping"}}, nil
+	}}
+	client := pb.NewTagServiceClient(dial(t, nil, nil, svc))
+
+	resp, err := client.ListTags(context.Background(), &pb.ListTagsRequest{Prefix: "fr"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "fr", got)
+	require.Len(t, resp.GetTags(), 1)
+	assert.Equal(t, "Free Camping", resp.GetTags()[0].GetName())
+}
+
+func TestAddStopTag_StopOnOtherTrip(t *testing.T) {
+	svc := &mockStopServicer{
+		getByID: func(context.Context, uuid.UUID, uuid.UUID) (domain.Stop, error) {
+			return domain.Stop{}, domain.ErrNotFound
+		},
+		addTag: func(context.Context, uuid.UUID, string) (domain.Tag, error) {
+			t.Fatal("AddTag must not be called for a stop on another trip")
+			return domain.Tag{}, nil
+		},
+	}
+	client := pb.NewTagServiceClient(dial(t, nil, svc, nil))
+
+	_, err := client.AddStopTag(context.Background(), &pb.AddStopTagRequest{
+		TripId: uuid.NewString(), StopId: uuid.NewString(), Name: "Free Camping",
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRemoveStopTag_NotLinked(t *testing.T) {
+	svc := &mockStopServicer{
+		getByID: func(context.Context, uuid.UUID, uuid.UUID) (domain.Stop, error) { return domain.Stop{}, nil },
+		removeTagFromStop: func(context.Context, uuid.UUID, string) error {
+			return domain.ErrNotFound
+		},
+	}
+	client := pb.NewTagServiceClient(dial(t, nil, svc, nil))
+
+	_, err := client.RemoveStopTag(context.Background(), &pb.RemoveStopTagRequest{
+		TripId: uuid.NewString(), StopId: uuid.NewString(), Slug: "free-camping",
+	})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "tag not linked to stop", st.Message())
+}
@@ -0,0 +1,161 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	pb "github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1"
+)
+
+// CreateStop implements StopService.CreateStop.
+func (s *Server) CreateStop(ctx context.Context, req *pb.CreateStopRequest) (*pb.Stop, error) {
+	tripID, err := parseID("trip_id", req.GetTripId())
+	if err != nil {
+		return nil, err
+	}
+	stop, err := stopFromProto(req.GetStop())
+	if err != nil {
+		return nil, err
+	}
+	stop.TripID = tripID
+
+	created, err := s.stops.Create(ctx, stop)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+	return stopToProto(created), nil
+}
+
+// GetStop implements StopService.GetStop.
+func (s *Server) GetStop(ctx context.Context, req *pb.GetStopRequest) (*pb.Stop, error) {
+	tripID, stopID, err := parseStopIDs(req.GetTripId(), req.GetStopId())
+	if err != nil {
+		return nil, err
+	}
+
+	stop, err := s.stops.GetByID(ctx, tripID, stopID)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "stop not found")
+	}
+	return stopToProto(stop), nil
+}
+
+// ListStops implements StopService.ListStops. Sync agents want the whole
+// trip in one call, so unlike GET /trips/{tripId}/stops it is not paginated.
+func (s *Server) ListStops(ctx context.Context, req *pb.ListStopsRequest) (*pb.ListStopsResponse, error) {
+	tripID, err := parseID("trip_id", req.GetTripId())
+	if err != nil {
+		return nil, err
+	}
+
+	stops, err := s.stops.ListByTripID(ctx, tripID)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+
+	resp := &pb.ListStopsResponse{Stops: make([]*pb.Stop, len(stops))}
+	for i, st := range stops {
+		resp.Stops[i] = stopToProto(st)
+	}
+	return resp, nil
+}
+
+// UpdateStop implements StopService.UpdateStop. Like PUT, it replaces every
+// editable field.
+func (s *Server) UpdateStop(ctx context.Context, req *pb.UpdateStopRequest) (*pb.Stop, error) {
+	tripID, stopID, err := parseStopIDs(req.GetTripId(), req.GetStopId())
+	if err != nil {
+		return nil, err
+	}
+	stop, err := stopFromProto(req.GetStop())
+	if err != nil {
+		return nil, err
+	}
+	stop.ID, stop.TripID = stopID, tripID
+
+	updated, err := s.stops.Update(ctx, stop)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "stop not found")
+	}
+	return stopToProto(updated), nil
+}
+
+// DeleteStop implements StopService.DeleteStop.
+func (s *Server) DeleteStop(ctx context.Context, req *pb.DeleteStopRequest) (*emptypb.Empty, error) {
+	tripID, stopID, err := parseStopIDs(req.GetTripId(), req.GetStopId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.stops.Delete(ctx, tripID, stopID); err != nil {
+		return nil, s.statusError(ctx, err, "stop not found")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// parseStopIDs parses the trip_id and stop_id fields every stop-scoped
+// request carries.
+func parseStopIDs(tripID, stopID string) (trip, stop uuid.UUID, err error) {
+	if trip, err = parseID("trip_id", tripID); err != nil {
+		return trip, stop, err
+	}
+	stop, err = parseID("stop_id", stopID)
+	return trip, stop, err
+}
+
+// stopFromProto builds a domain.Stop from the editable fields. arrived_at is
+// required: planned stops are created through the REST API. The service
+// checks everything else.
+func stopFromProto(f *pb.StopFields) (domain.Stop, error) {
+	if f == nil {
+		return domain.Stop{}, status.Error(codes.InvalidArgument, "stop is required")
+	}
+	if f.GetArrivedAt() == nil {
+		return domain.Stop{}, status.Error(codes.InvalidArgument, "arrived_at is required")
+	}
+	stop := domain.Stop{
+		Name:      f.GetName(),
+		Location:  f.GetLocation(),
+		Latitude:  f.Latitude,
+		Longitude: f.Longitude,
+		ArrivedAt: f.GetArrivedAt().AsTime(),
+		Notes:     f.GetNotes(),
+	}
+	if f.GetDepartedAt() != nil {
+		departed := f.GetDepartedAt().AsTime()
+		stop.DepartedAt = &departed
+	}
+	return stop, nil
+}
+
+// stopToProto converts a domain.Stop, with its tags, into its protobuf message.
+func stopToProto(st domain.Stop) *pb.Stop {
+	msg := &pb.Stop{
+		Id:        st.ID.String(),
+		TripId:    st.TripID.String(),
+		Name:      st.Name,
+		Location:  st.Location,
+		Latitude:  st.Latitude,
+		Longitude: st.Longitude,
+		Notes:     st.Notes,
+		Tags:      make([]*pb.Tag, len(st.Tags)),
+		CreatedAt: timestamppb.New(st.CreatedAt),
+		UpdatedAt: timestamppb.New(st.UpdatedAt),
+	}
+	if !st.Planned {
+		msg.ArrivedAt = timestamppb.New(st.ArrivedAt)
+	}
+	if st.DepartedAt != nil {
+		msg.DepartedAt = timestamppb.New(*st.DepartedAt)
+	}
+	for i, t := range st.Tags {
+		msg.Tags[i] = tagToProto(t)
+	}
+	return msg
+}
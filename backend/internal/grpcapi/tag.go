@@ -0,0 +1,69 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	pb "github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1"
+)
+
+// ListTags implements TagService.ListTags.
+func (s *Server) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	tags, err := s.tags.List(ctx, req.GetPrefix())
+	if err != nil {
+		return nil, s.statusError(ctx, err, "tag not found")
+	}
+
+	resp := &pb.ListTagsResponse{Tags: make([]*pb.Tag, len(tags))}
+	for i, t := range tags {
+		resp.Tags[i] = tagToProto(t)
+	}
+	return resp, nil
+}
+
+// AddStopTag implements TagService.AddStopTag. The stop is looked up on its
+// trip first, so a stop_id from another trip is NotFound rather than tagged.
+func (s *Server) AddStopTag(ctx context.Context, req *pb.AddStopTagRequest) (*pb.Tag, error) {
+	tripID, stopID, err := parseStopIDs(req.GetTripId(), req.GetStopId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, s.statusError(ctx, err, "stop not found")
+	}
+
+	tag, err := s.stops.AddTag(ctx, tripID, stopID, req.GetName())
+	if err != nil {
+		return nil, s.statusError(ctx, err, "stop not found")
+	}
+	return tagToProto(tag), nil
+}
+
+// RemoveStopTag implements TagService.RemoveStopTag.
+func (s *Server) RemoveStopTag(ctx context.Context, req *pb.RemoveStopTagRequest) (*emptypb.Empty, error) {
+	tripID, stopID, err := parseStopIDs(req.GetTripId(), req.GetStopId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, s.statusError(ctx, err, "stop not found")
+	}
+
+	if err := s.stops.RemoveTagFromStop(ctx, tripID, stopID, req.GetSlug()); err != nil {
+		return nil, s.statusError(ctx, err, "tag not linked to stop")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// tagToProto converts a domain.Tag into its protobuf message.
+func tagToProto(t domain.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		Slug:      t.Slug,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+}
@@ -0,0 +1,128 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	pb "github.com/pkordes/rv-logbook/backend/internal/grpcapi/logbookv1"
+)
+
+// CreateTrip implements TripService.CreateTrip.
+func (s *Server) CreateTrip(ctx context.Context, req *pb.CreateTripRequest) (*pb.Trip, error) {
+	trip, err := tripFromProto(req.GetName(), req.GetStartDate(), req.EndDate, req.GetNotes())
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.trips.Create(ctx, trip)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+	return tripToProto(created), nil
+}
+
+// GetTrip implements TripService.GetTrip.
+func (s *Server) GetTrip(ctx context.Context, req *pb.GetTripRequest) (*pb.Trip, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	trip, err := s.trips.GetByID(ctx, id)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+	return tripToProto(trip), nil
+}
+
+// ListTrips implements TripService.ListTrips. Zero page and limit fall back
+// to the defaults in s.limits, and limit is capped there too, as on the
+// REST API.
+func (s *Server) ListTrips(ctx context.Context, req *pb.ListTripsRequest) (*pb.ListTripsResponse, error) {
+	page, limit := int(req.GetPage()), int(req.GetLimit())
+	params := s.limits.Params(&page, &limit)
+
+	trips, total, err := s.trips.ListPaged(ctx, nil, params)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+
+	resp := &pb.ListTripsResponse{Trips: make([]*pb.Trip, len(trips)), Total: total}
+	for i, t := range trips {
+		resp.Trips[i] = tripToProto(t)
+	}
+	return resp, nil
+}
+
+// UpdateTrip implements TripService.UpdateTrip. Like PUT /trips/{id}, it
+// replaces every editable field.
+func (s *Server) UpdateTrip(ctx context.Context, req *pb.UpdateTripRequest) (*pb.Trip, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	trip, err := tripFromProto(req.GetName(), req.GetStartDate(), req.EndDate, req.GetNotes())
+	if err != nil {
+		return nil, err
+	}
+	trip.ID = id
+
+	updated, err := s.trips.Update(ctx, trip)
+	if err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+	return tripToProto(updated), nil
+}
+
+// DeleteTrip implements TripService.DeleteTrip.
+func (s *Server) DeleteTrip(ctx context.Context, req *pb.DeleteTripRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.trips.Delete(ctx, id); err != nil {
+		return nil, s.statusError(ctx, err, "trip not found")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// tripFromProto builds a domain.Trip from the fields shared by the create
+// and update requests. A malformed date is an InvalidArgument status.
+func tripFromProto(name, startDate string, endDate *string, notes string) (domain.Trip, error) {
+	start, err := domain.ParseDate(startDate)
+	if err != nil {
+		return domain.Trip{}, status.Error(codes.InvalidArgument, "start_date: "+err.Error())
+	}
+	t := domain.Trip{Name: name, StartDate: start, Notes: notes}
+	if endDate != nil {
+		end, err := domain.ParseDate(*endDate)
+		if err != nil {
+			return domain.Trip{}, status.Error(codes.InvalidArgument, "end_date: "+err.Error())
+		}
+		t.EndDate = &end
+	}
+	return t, nil
+}
+
+// tripToProto converts a domain.Trip into its protobuf message.
+func tripToProto(t domain.Trip) *pb.Trip {
+	msg := &pb.Trip{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		StartDate: t.StartDate.String(),
+		Notes:     t.Notes,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+	if t.EndDate != nil {
+		end := t.EndDate.String()
+		msg.EndDate = &end
+	}
+	return msg
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetPoolStats handles GET /admin/pool.
+func (s *Server) GetPoolStats(_ context.Context, _ gen.GetPoolStatsRequestObject) (gen.GetPoolStatsResponseObject, error) {
+	st := s.pool.Stats()
+	return gen.GetPoolStats200JSONResponse{
+		AcquiredConns:           st.AcquiredConns,
+		IdleConns:               st.IdleConns,
+		ConstructingConns:       st.ConstructingConns,
+		TotalConns:              st.TotalConns,
+		MaxConns:                st.MaxConns,
+		AcquireCount:            st.AcquireCount,
+		EmptyAcquireCount:       st.EmptyAcquireCount,
+		CanceledAcquireCount:    st.CanceledAcquireCount,
+		AcquireMsTotal:          millis(st.AcquireDuration),
+		AvgAcquireMs:            millis(st.AvgAcquire()),
+		EmptyAcquireWaitMsTotal: millis(st.EmptyAcquireWait),
+		AvgEmptyAcquireWaitMs:   millis(st.AvgEmptyAcquireWait()),
+	}, nil
+}
+
+// AdminShiftTripDates handles POST /admin/trips/{id}/shift-dates.
+func (s *Server) AdminShiftTripDates(ctx context.Context, req gen.AdminShiftTripDatesRequestObject) (gen.AdminShiftTripDatesResponseObject, error) {
+	if req.Body == nil {
+		return gen.AdminShiftTripDates422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	fix, err := s.dataFixes.ShiftDates(ctx, req.Id, req.Body.Days, dryRun(req.Params.DryRun))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return gen.AdminShiftTripDates404JSONResponse(notFoundBody("trip not found")), nil
+		case errors.Is(err, domain.ErrForbidden):
+			return gen.AdminShiftTripDates403JSONResponse(forbiddenBody(err)), nil
+		case errors.Is(err, domain.ErrValidation):
+			return gen.AdminShiftTripDates422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.AdminShiftTripDates200JSONResponse(dataFixToResponse(fix)), nil
+}
+
+// AdminReassignStops handles POST /admin/trips/{id}/reassign-stops.
+func (s *Server) AdminReassignStops(ctx context.Context, req gen.AdminReassignStopsRequestObject) (gen.AdminReassignStopsResponseObject, error) {
+	if req.Body == nil {
+		return gen.AdminReassignStops422JSONResponse(requestBody("request body is required")), nil
+	}
+	var stopIDs []uuid.UUID
+	if req.Body.StopIds != nil {
+		stopIDs = *req.Body.StopIds
+	}
+
+	fix, err := s.dataFixes.ReassignStops(ctx, req.Id, req.Body.TargetTripId, stopIDs, dryRun(req.Params.DryRun))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return gen.AdminReassignStops404JSONResponse(notFoundBody("trip not found")), nil
+		case errors.Is(err, domain.ErrForbidden):
+			return gen.AdminReassignStops403JSONResponse(forbiddenBody(err)), nil
+		case errors.Is(err, domain.ErrValidation):
+			return gen.AdminReassignStops422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.AdminReassignStops200JSONResponse(dataFixToResponse(fix)), nil
+}
+
+// AdminNormalizeLocations handles POST /admin/trips/{id}/normalize-locations.
+func (s *Server) AdminNormalizeLocations(ctx context.Context, req gen.AdminNormalizeLocationsRequestObject) (gen.AdminNormalizeLocationsResponseObject, error) {
+	fix, err := s.dataFixes.NormalizeLocations(ctx, req.Id, dryRun(req.Params.DryRun))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return gen.AdminNormalizeLocations404JSONResponse(notFoundBody("trip not found")), nil
+		case errors.Is(err, domain.ErrForbidden):
+			return gen.AdminNormalizeLocations403JSONResponse(forbiddenBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.AdminNormalizeLocations200JSONResponse(dataFixToResponse(fix)), nil
+}
+
+// dryRun reads the optional dry_run query parameter.
+func dryRun(p *gen.DryRun) bool {
+	return p != nil && *p
+}
+
+// dataFixToResponse converts a domain.DataFix to its API representation.
+func dataFixToResponse(fix domain.DataFix) gen.DataFixResult {
+	changes := make([]gen.FieldChange, len(fix.Changes))
+	for i, c := range fix.Changes {
+		changes[i] = gen.FieldChange{
+			Entity: gen.FieldChangeEntity(c.Entity),
+			Id:     c.ID,
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		}
+	}
+	return gen.DataFixResult{DryRun: fix.DryRun, Changes: changes}
+}
+
+// millis returns d in milliseconds, to the microsecond.
+func millis(d time.Duration) float64 {
+	return roundTo(float64(d)/float64(time.Millisecond), 1000)
+}
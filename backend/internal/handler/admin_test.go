@@ -0,0 +1,211 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- fake PoolServicer -----------------------------------------------------
+
+type fixedPool domain.PoolStats
+
+func (p fixedPool) Stats() domain.PoolStats { return domain.PoolStats(p) }
+
+// compile-time check: fixedPool must satisfy handler.PoolServicer.
+var _ handler.PoolServicer = fixedPool{}
+
+// ---- GET /admin/pool -------------------------------------------------------
+
+func TestGetPoolStats_200(t *testing.T) {
+	pool := fixedPool{
+		AcquiredConns:        4,
+		IdleConns:            0,
+		ConstructingConns:    1,
+		TotalConns:           5,
+		MaxConns:             5,
+		AcquireCount:         300,
+		EmptyAcquireCount:    20,
+		CanceledAcquireCount: 2,
+		AcquireDuration:      1500 * time.Millisecond,
+		EmptyAcquireWait:     1234567 * time.Microsecond,
+	}
+	srv := handler.NewServer(handler.ServerDeps{Pool: pool})
+	h := testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/pool", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.PoolStats
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 4, resp.AcquiredConns)
+	assert.Equal(t, 5, resp.MaxConns)
+	assert.Equal(t, int64(20), resp.EmptyAcquireCount)
+	assert.Equal(t, 1500.0, resp.AcquireMsTotal)
+	assert.Equal(t, 5.0, resp.AvgAcquireMs)
+	assert.Equal(t, 1234.567, resp.EmptyAcquireWaitMsTotal)
+	assert.Equal(t, 61.728, resp.AvgEmptyAcquireWaitMs)
+}
+
+func TestGetPoolStats_NoAcquiresYet(t *testing.T) {
+	srv := handler.NewServer(handler.ServerDeps{Pool: fixedPool{MaxConns: 4}})
+	h := testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/pool", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.PoolStats
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Zero(t, resp.AvgAcquireMs)
+	assert.Zero(t, resp.AvgEmptyAcquireWaitMs)
+}
+
+// ---- data fixes ------------------------------------------------------------
+
+type mockDataFixServicer struct {
+	shiftDates         func(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) (domain.DataFix, error)
+	reassignStops      func(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) (domain.DataFix, error)
+	normalizeLocations func(ctx context.Context, tripID uuid.UUID, dryRun bool) (domain.DataFix, error)
+}
+
+func (m *mockDataFixServicer) ShiftDates(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) (domain.DataFix, error) {
+	return m.shiftDates(ctx, tripID, days, dryRun)
+}
+func (m *mockDataFixServicer) ReassignStops(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) (domain.DataFix, error) {
+	return m.reassignStops(ctx, tripID, targetID, stopIDs, dryRun)
+}
+func (m *mockDataFixServicer) NormalizeLocations(ctx context.Context, tripID uuid.UUID, dryRun bool) (domain.DataFix, error) {
+	return m.normalizeLocations(ctx, tripID, dryRun)
+}
+
+func serveDataFix(t *testing.T, svc handler.DataFixServicer, path, body string) *httptest.ResponseRecorder {
+	srv := handler.NewServer(handler.ServerDeps{DataFixes: svc})
+	h := testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAdminShiftTripDates_200_DryRun(t *testing.T) {
+	tripID := uuid.New()
+	var gotDays int
+	var gotDryRun bool
+	svc := &mockDataFixServicer{shiftDates: func(_ context.Context, id uuid.UUID, days int, dryRun bool) (domain.DataFix, error) {
+		gotDays, gotDryRun = days, dryRun
+		before, after := "2025-06-01T18:00:00Z", "2025-06-08T18:00:00Z"
+		return domain.DataFix{DryRun: dryRun, Changes: []domain.FieldChange{
+			{Entity: "stop", ID: uuid.New(), Field: "arrived_at", Before: &before, After: &after},
+		}}, nil
+	}}
+
+	rec := serveDataFix(t, svc, "/admin/trips/"+tripID.String()+"/shift-dates?dry_run=true", `{"days": 7}`)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 7, gotDays)
+	assert.True(t, gotDryRun)
+	var resp gen.DataFixResult
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.True(t, resp.DryRun)
+	require.Len(t, resp.Changes, 1)
+	assert.Equal(t, gen.FieldChangeEntity("stop"), resp.Changes[0].Entity)
+	assert.Equal(t, "2025-06-08T18:00:00Z", *resp.Changes[0].After)
+}
+
+func TestAdminShiftTripDates_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"not found", domain.ErrNotFound, http.StatusNotFound},
+		{"not the owner", fmt.Errorf("%w: only the trip's owner may do this", domain.ErrForbidden), http.StatusForbidden},
+		{"zero days", fmt.Errorf("%w: days must be between -3650 and 3650 and not 0", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &mockDataFixServicer{shiftDates: func(context.Context, uuid.UUID, int, bool) (domain.DataFix, error) {
+				return domain.DataFix{}, tc.err
+			}}
+
+			rec := serveDataFix(t, svc, "/admin/trips/"+uuid.NewString()+"/shift-dates", `{"days": 0}`)
+
+			assert.Equal(t, tc.want, rec.Code)
+		})
+	}
+}
+
+func TestAdminShiftTripDates_403_Message(t *testing.T) {
+	svc := &mockDataFixServicer{shiftDates: func(context.Context, uuid.UUID, int, bool) (domain.DataFix, error) {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.ShiftDates: %w: only the trip's owner may do this", domain.ErrForbidden)
+	}}
+
+	rec := serveDataFix(t, svc, "/admin/trips/"+uuid.NewString()+"/shift-dates", `{"days": 3}`)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, gen.ErrorDetail{Code: "forbidden", Message: "only the trip's owner may do this"}, resp.Error)
+}
+
+func TestAdminReassignStops_200(t *testing.T) {
+	tripID, targetID, stopID := uuid.New(), uuid.New(), uuid.New()
+	var gotTarget uuid.UUID
+	var gotStops []uuid.UUID
+	var gotDryRun bool
+	svc := &mockDataFixServicer{reassignStops: func(_ context.Context, _, target uuid.UUID, stopIDs []uuid.UUID, dryRun bool) (domain.DataFix, error) {
+		gotTarget, gotStops, gotDryRun = target, stopIDs, dryRun
+		return domain.DataFix{Changes: []domain.FieldChange{}}, nil
+	}}
+
+	body := fmt.Sprintf(`{"target_trip_id": %q, "stop_ids": [%q]}`, targetID, stopID)
+	rec := serveDataFix(t, svc, "/admin/trips/"+tripID.String()+"/reassign-stops", body)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, targetID, gotTarget)
+	assert.Equal(t, []uuid.UUID{stopID}, gotStops)
+	assert.False(t, gotDryRun)
+}
+
+func TestAdminReassignStops_422_StopNotOnTrip(t *testing.T) {
+	svc := &mockDataFixServicer{reassignStops: func(context.Context, uuid.UUID, uuid.UUID, []uuid.UUID, bool) (domain.DataFix, error) {
+		return domain.DataFix{}, fmt.Errorf("%w: stop 1 is not on this trip", domain.ErrValidation)
+	}}
+
+	rec := serveDataFix(t, svc, "/admin/trips/"+uuid.NewString()+"/reassign-stops", fmt.Sprintf(`{"target_trip_id": %q}`, uuid.New()))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestAdminNormalizeLocations_200(t *testing.T) {
+	before, after := "Moab ,UT ", "Moab, UT"
+	svc := &mockDataFixServicer{normalizeLocations: func(_ context.Context, _ uuid.UUID, dryRun bool) (domain.DataFix, error) {
+		return domain.DataFix{DryRun: dryRun, Changes: []domain.FieldChange{{Entity: "stop", ID: uuid.New(), Field: "location", Before: &before, After: &after}}}, nil
+	}}
+
+	rec := serveDataFix(t, svc, "/admin/trips/"+uuid.NewString()+"/normalize-locations", "")
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.DataFixResult
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.False(t, resp.DryRun)
+	require.Len(t, resp.Changes, 1)
+	assert.Equal(t, "Moab, UT", *resp.Changes[0].After)
+}
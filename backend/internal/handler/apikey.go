@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListAPIKeys handles GET /api-keys.
+func (s *Server) ListAPIKeys(ctx context.Context, _ gen.ListAPIKeysRequestObject) (gen.ListAPIKeysResponseObject, error) {
+	keys, err := s.apiKeys.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.ListAPIKeys200JSONResponse, len(keys))
+	for i, k := range keys {
+		resp[i] = apiKeyToResponse(k)
+	}
+	return resp, nil
+}
+
+// CreateAPIKey handles POST /api-keys. The response is the only one that
+// includes the key.
+func (s *Server) CreateAPIKey(ctx context.Context, req gen.CreateAPIKeyRequestObject) (gen.CreateAPIKeyResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateAPIKey422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.apiKeys.Create(ctx, req.Body.Name)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.CreateAPIKey401JSONResponse(unauthorizedBody("sign in to create an API key")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateAPIKey422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	resp := apiKeyToResponse(created)
+	resp.Key = &created.Key
+	return gen.CreateAPIKey201JSONResponse(resp), nil
+}
+
+// DeleteAPIKey handles DELETE /api-keys/{id}.
+func (s *Server) DeleteAPIKey(ctx context.Context, req gen.DeleteAPIKeyRequestObject) (gen.DeleteAPIKeyResponseObject, error) {
+	if err := s.apiKeys.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteAPIKey404JSONResponse(notFoundBody("API key not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteAPIKey204Response{}, nil
+}
+
+// apiKeyToResponse converts a domain.APIKey to the API response shape,
+// without the key.
+func apiKeyToResponse(k domain.APIKey) gen.APIKey {
+	return gen.APIKey{
+		Id:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		CreatedAt: k.CreatedAt,
+	}
+}
@@ -0,0 +1,155 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock APIKeyServicer ---------------------------------------------------
+
+type mockAPIKeyServicer struct {
+	create func(ctx context.Context, name string) (domain.APIKey, error)
+	list   func(ctx context.Context) ([]domain.APIKey, error)
+	delete func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockAPIKeyServicer) Create(ctx context.Context, name string) (domain.APIKey, error) {
+	return m.create(ctx, name)
+}
+func (m *mockAPIKeyServicer) List(ctx context.Context) ([]domain.APIKey, error) {
+	return m.list(ctx)
+}
+func (m *mockAPIKeyServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+// compile-time check: mockAPIKeyServicer must satisfy handler.APIKeyServicer.
+var _ handler.APIKeyServicer = (*mockAPIKeyServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newAPIKeyHTTPHandler wires a Server with only the API key service mock.
+func newAPIKeyHTTPHandler(t *testing.T, svc handler.APIKeyServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{APIKeys: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+var (
+	testAPIKeyID = uuid.MustParse("6d1c2e4f-8a9b-4c3d-9e0f-1a2b3c4d5e6f")
+	testAPIKey   = domain.APIKey{
+		ID:        testAPIKeyID,
+		Name:      "Pi tank monitor",
+		Prefix:    "rvk_3fA9x",
+		KeyHash:   "not-in-the-response",
+		CreatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+)
+
+// ---- GET /api-keys ---------------------------------------------------------
+
+func TestListAPIKeys(t *testing.T) {
+	svc := &mockAPIKeyServicer{
+		list: func(context.Context) ([]domain.APIKey, error) { return []domain.APIKey{testAPIKey}, nil },
+	}
+
+	rec := httptest.NewRecorder()
+	newAPIKeyHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api-keys", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"id":"`+testAPIKeyID.String()+`","name":"Pi tank monitor","prefix":"rvk_3fA9x","created_at":"2025-06-01T12:00:00Z"}]`, rec.Body.String())
+}
+
+// ---- POST /api-keys --------------------------------------------------------
+
+func TestCreateAPIKey(t *testing.T) {
+	var got string
+	svc := &mockAPIKeyServicer{
+		create: func(_ context.Context, name string) (domain.APIKey, error) {
+			got = name
+			k := testAPIKey
+			k.Key = "rvk_3fA9xsecret"
+			return k, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api-keys", jsonBody(t, map[string]any{"name": "Pi tank monitor"}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newAPIKeyHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "Pi tank monitor", got)
+	assert.JSONEq(t, `{"id":"`+testAPIKeyID.String()+`","name":"Pi tank monitor","prefix":"rvk_3fA9x","key":"rvk_3fA9xsecret","created_at":"2025-06-01T12:00:00Z"}`, rec.Body.String())
+}
+
+func TestCreateAPIKey_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody string
+	}{
+		{"anonymous", fmt.Errorf("service.APIKeyService.Create: %w", domain.ErrUnauthorized), http.StatusUnauthorized,
+			`{"error":{"code":"unauthorized","message":"sign in to create an API key"}}`},
+		{"no name", fmt.Errorf("%w: name is required", domain.ErrValidation), http.StatusUnprocessableEntity,
+			`{"error":{"code":"validation_error","message":"name is required"}}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &mockAPIKeyServicer{
+				create: func(context.Context, string) (domain.APIKey, error) { return domain.APIKey{}, tc.err },
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api-keys", jsonBody(t, map[string]any{"name": ""}))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			newAPIKeyHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+}
+
+// ---- DELETE /api-keys/{id} -------------------------------------------------
+
+func TestDeleteAPIKey(t *testing.T) {
+	var got uuid.UUID
+	svc := &mockAPIKeyServicer{
+		delete: func(_ context.Context, id uuid.UUID) error {
+			got = id
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newAPIKeyHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api-keys/"+testAPIKeyID.String(), nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, testAPIKeyID, got)
+}
+
+func TestDeleteAPIKey_NotFound(t *testing.T) {
+	svc := &mockAPIKeyServicer{
+		delete: func(context.Context, uuid.UUID) error { return fmt.Errorf("repo: %w", domain.ErrNotFound) },
+	}
+
+	rec := httptest.NewRecorder()
+	newAPIKeyHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api-keys/"+testAPIKeyID.String(), nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"error":{"code":"not_found","message":"API key not found"}}`, rec.Body.String())
+}
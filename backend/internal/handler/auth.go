@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// CreateToken handles POST /auth/token.
+func (s *Server) CreateToken(ctx context.Context, req gen.CreateTokenRequestObject) (gen.CreateTokenResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateToken422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	tokens, err := s.auth.Login(ctx, req.Body.Username, req.Body.Password)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.CreateToken401JSONResponse(unauthorizedBody("wrong username or password")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateToken422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateToken200JSONResponse(tokensToResponse(tokens)), nil
+}
+
+// RefreshToken handles POST /auth/refresh.
+func (s *Server) RefreshToken(ctx context.Context, req gen.RefreshTokenRequestObject) (gen.RefreshTokenResponseObject, error) {
+	if req.Body == nil {
+		return gen.RefreshToken422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	tokens, err := s.auth.Refresh(ctx, req.Body.RefreshToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.RefreshToken401JSONResponse(unauthorizedBody("the refresh token is invalid or expired")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.RefreshToken422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.RefreshToken200JSONResponse(tokensToResponse(tokens)), nil
+}
+
+// tokensToResponse converts a domain.Tokens to the API response shape.
+func tokensToResponse(t domain.Tokens) gen.TokenResponse {
+	return gen.TokenResponse{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(t.ExpiresIn.Seconds()),
+	}
+}
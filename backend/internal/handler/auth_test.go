@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock AuthServicer -----------------------------------------------------
+
+type mockAuthServicer struct {
+	login   func(ctx context.Context, username, password string) (domain.Tokens, error)
+	refresh func(ctx context.Context, refreshToken string) (domain.Tokens, error)
+}
+
+func (m *mockAuthServicer) Login(ctx context.Context, username, password string) (domain.Tokens, error) {
+	return m.login(ctx, username, password)
+}
+func (m *mockAuthServicer) Refresh(ctx context.Context, refreshToken string) (domain.Tokens, error) {
+	return m.refresh(ctx, refreshToken)
+}
+
+// compile-time check: mockAuthServicer must satisfy handler.AuthServicer.
+var _ handler.AuthServicer = (*mockAuthServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newAuthHTTPHandler wires a Server with only the auth service mock.
+func newAuthHTTPHandler(t *testing.T, svc handler.AuthServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Auth: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+var testTokens = domain.Tokens{AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 15 * time.Minute}
+
+// ---- POST /auth/token ------------------------------------------------------
+
+func TestCreateToken(t *testing.T) {
+	var gotUser, gotPassword string
+	svc := &mockAuthServicer{
+		login: func(_ context.Context, username, password string) (domain.Tokens, error) {
+			gotUser, gotPassword = username, password
+			return testTokens, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/token", jsonBody(t, map[string]any{"username": "alice", "password": "s3cret"}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newAuthHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "alice", gotUser)
+	assert.Equal(t, "s3cret", gotPassword)
+	assert.JSONEq(t, `{"access_token":"access","refresh_token":"refresh","token_type":"Bearer","expires_in":900}`, rec.Body.String())
+}
+
+func TestCreateToken_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody string
+	}{
+		{"wrong password", fmt.Errorf("service.AuthService.Login: %w", domain.ErrUnauthorized), http.StatusUnauthorized,
+			`{"error":{"code":"unauthorized","message":"wrong username or password"}}`},
+		{"missing password", fmt.Errorf("%w: username and password are required", domain.ErrValidation), http.StatusUnprocessableEntity,
+			`{"error":{"code":"validation_error","message":"username and password are required"}}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &mockAuthServicer{
+				login: func(context.Context, string, string) (domain.Tokens, error) { return domain.Tokens{}, tc.err },
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/token", jsonBody(t, map[string]any{"username": "alice", "password": ""}))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			newAuthHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+}
+
+// ---- POST /auth/refresh ----------------------------------------------------
+
+func TestRefreshToken(t *testing.T) {
+	var got string
+	svc := &mockAuthServicer{
+		refresh: func(_ context.Context, refreshToken string) (domain.Tokens, error) {
+			got = refreshToken
+			return testTokens, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", jsonBody(t, map[string]any{"refresh_token": "old"}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newAuthHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "old", got)
+	assert.JSONEq(t, `{"access_token":"access","refresh_token":"refresh","token_type":"Bearer","expires_in":900}`, rec.Body.String())
+}
+
+func TestRefreshToken_Invalid(t *testing.T) {
+	svc := &mockAuthServicer{
+		refresh: func(context.Context, string) (domain.Tokens, error) {
+			return domain.Tokens{}, fmt.Errorf("service.AuthService.Refresh: %w", domain.ErrUnauthorized)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", jsonBody(t, map[string]any{"refresh_token": "expired"}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newAuthHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.JSONEq(t, `{"error":{"code":"unauthorized","message":"the refresh token is invalid or expired"}}`, rec.Body.String())
+}
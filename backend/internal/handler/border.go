@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// borderCrossingCSVHeaders defines the columns of the CSV crossing report.
+var borderCrossingCSVHeaders = []string{
+	"crossed_on", "trip_id", "trip_name", "country", "direction",
+	"port_of_entry", "documents", "notes",
+}
+
+// GetBorderCrossingReport handles GET /border-crossings.
+// Use ?format=csv to receive CSV; default is JSON.
+func (s *Server) GetBorderCrossingReport(ctx context.Context, req gen.GetBorderCrossingReportRequestObject) (gen.GetBorderCrossingReportResponseObject, error) {
+	report, err := s.crossings.YearReport(ctx, req.Params.Year)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetBorderCrossingReport422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	if req.Params.Format != nil && *req.Params.Format == gen.GetBorderCrossingReportParamsFormatCsv {
+		return borderCrossingCSV(report), nil
+	}
+	resp := make(gen.GetBorderCrossingReport200JSONResponse, len(report))
+	for i, row := range report {
+		resp[i] = gen.BorderCrossingReportRow{
+			Crossing: borderCrossingToResponse(row.Crossing),
+			TripName: row.TripName,
+		}
+	}
+	return resp, nil
+}
+
+// ListTripBorderCrossings handles GET /trips/{tripId}/border-crossings.
+func (s *Server) ListTripBorderCrossings(ctx context.Context, req gen.ListTripBorderCrossingsRequestObject) (gen.ListTripBorderCrossingsResponseObject, error) {
+	crossings, err := s.crossings.ListByTrip(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripBorderCrossings404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTripBorderCrossings200JSONResponse, len(crossings))
+	for i, c := range crossings {
+		resp[i] = borderCrossingToResponse(c)
+	}
+	return resp, nil
+}
+
+// CreateTripBorderCrossing handles POST /trips/{tripId}/border-crossings.
+func (s *Server) CreateTripBorderCrossing(ctx context.Context, req gen.CreateTripBorderCrossingRequestObject) (gen.CreateTripBorderCrossingResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateTripBorderCrossing422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	c := requestToBorderCrossing(*req.Body)
+	c.TripID = req.TripId
+	created, err := s.crossings.Create(ctx, c)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateTripBorderCrossing404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateTripBorderCrossing422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateTripBorderCrossing201JSONResponse(borderCrossingToResponse(created)), nil
+}
+
+// GetTripBorderCrossing handles GET /trips/{tripId}/border-crossings/{crossingId}.
+func (s *Server) GetTripBorderCrossing(ctx context.Context, req gen.GetTripBorderCrossingRequestObject) (gen.GetTripBorderCrossingResponseObject, error) {
+	c, err := s.crossings.GetByID(ctx, req.TripId, req.CrossingId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripBorderCrossing404JSONResponse(notFoundBody("border crossing not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetTripBorderCrossing200JSONResponse(borderCrossingToResponse(c)), nil
+}
+
+// UpdateTripBorderCrossing handles PUT /trips/{tripId}/border-crossings/{crossingId}.
+func (s *Server) UpdateTripBorderCrossing(ctx context.Context, req gen.UpdateTripBorderCrossingRequestObject) (gen.UpdateTripBorderCrossingResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateTripBorderCrossing422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	c := requestToBorderCrossing(*req.Body)
+	c.ID, c.TripID = req.CrossingId, req.TripId
+	updated, err := s.crossings.Update(ctx, c)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateTripBorderCrossing404JSONResponse(notFoundBody("border crossing not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateTripBorderCrossing422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateTripBorderCrossing200JSONResponse(borderCrossingToResponse(updated)), nil
+}
+
+// DeleteTripBorderCrossing handles DELETE /trips/{tripId}/border-crossings/{crossingId}.
+func (s *Server) DeleteTripBorderCrossing(ctx context.Context, req gen.DeleteTripBorderCrossingRequestObject) (gen.DeleteTripBorderCrossingResponseObject, error) {
+	if err := s.crossings.Delete(ctx, req.TripId, req.CrossingId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteTripBorderCrossing404JSONResponse(notFoundBody("border crossing not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteTripBorderCrossing204Response{}, nil
+}
+
+// borderCrossingCSV encodes the report as CSV, one crossing per line.
+// Documents are pipe-separated ("|"), like tags in the trip export.
+func borderCrossingCSV(report []domain.BorderCrossingReportRow) gen.GetBorderCrossingReport200TextcsvResponse {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	//nolint:errcheck — bytes.Buffer.Write never returns an error.
+	w.Write(borderCrossingCSVHeaders)
+	for _, row := range report {
+		c := row.Crossing
+		//nolint:errcheck
+		w.Write([]string{
+			c.CrossedOn.Format("2006-01-02"),
+			c.TripID.String(),
+			row.TripName,
+			c.Country,
+			string(c.Direction),
+			c.PortOfEntry,
+			strings.Join(c.Documents, "|"),
+			c.Notes,
+		})
+	}
+	w.Flush()
+
+	return gen.GetBorderCrossingReport200TextcsvResponse{
+		Body:          &buf,
+		ContentLength: int64(buf.Len()),
+	}
+}
+
+// requestToBorderCrossing converts a request body into a domain.BorderCrossing.
+// The caller sets TripID (and ID on update) from the path.
+func requestToBorderCrossing(body gen.BorderCrossingRequest) domain.BorderCrossing {
+	c := domain.BorderCrossing{
+		CrossedOn:   body.CrossedOn.Time,
+		PortOfEntry: body.PortOfEntry,
+		Country:     body.Country,
+		Direction:   domain.CrossingDirection(body.Direction),
+		Notes:       derefString(body.Notes),
+	}
+	if body.Documents != nil {
+		c.Documents = *body.Documents
+	}
+	return c
+}
+
+// borderCrossingToResponse converts a domain.BorderCrossing into the generated type.
+func borderCrossingToResponse(c domain.BorderCrossing) gen.BorderCrossing {
+	documents := c.Documents
+	if documents == nil {
+		documents = []string{}
+	}
+	return gen.BorderCrossing{
+		Id:          c.ID,
+		TripId:      c.TripID,
+		CrossedOn:   openapi_types.Date{Time: c.CrossedOn},
+		PortOfEntry: c.PortOfEntry,
+		Country:     c.Country,
+		Direction:   gen.CrossingDirection(c.Direction),
+		Documents:   documents,
+		Notes:       nilIfEmpty(c.Notes),
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
@@ -0,0 +1,246 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock BorderCrossingServicer -------------------------------------------
+
+type mockBorderCrossingServicer struct {
+	create     func(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error)
+	getByID    func(ctx context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error)
+	listByTrip func(ctx context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error)
+	update     func(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error)
+	delete     func(ctx context.Context, tripID, id uuid.UUID) error
+	yearReport func(ctx context.Context, year int) ([]domain.BorderCrossingReportRow, error)
+}
+
+func (m *mockBorderCrossingServicer) Create(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	return m.create(ctx, c)
+}
+func (m *mockBorderCrossingServicer) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error) {
+	return m.getByID(ctx, tripID, id)
+}
+func (m *mockBorderCrossingServicer) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error) {
+	return m.listByTrip(ctx, tripID)
+}
+func (m *mockBorderCrossingServicer) Update(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	return m.update(ctx, c)
+}
+func (m *mockBorderCrossingServicer) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	return m.delete(ctx, tripID, id)
+}
+func (m *mockBorderCrossingServicer) YearReport(ctx context.Context, year int) ([]domain.BorderCrossingReportRow, error) {
+	return m.yearReport(ctx, year)
+}
+
+// compile-time check: mockBorderCrossingServicer must satisfy handler.BorderCrossingServicer.
+var _ handler.BorderCrossingServicer = (*mockBorderCrossingServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newBorderCrossingHTTPHandler wires a Server with only the border crossing service mock.
+func newBorderCrossingHTTPHandler(t *testing.T, svc handler.BorderCrossingServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Crossings: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func sampleCrossingReport() []domain.BorderCrossingReportRow {
+	now := time.Now().UTC()
+	return []domain.BorderCrossingReportRow{{
+		TripName: "Alaska 2025",
+		Crossing: domain.BorderCrossing{
+			ID: uuid.New(), TripID: uuid.New(), CrossedOn: time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC),
+			PortOfEntry: "Blaine, WA", Country: "CA", Direction: domain.CrossingEntry,
+			Documents: []string{"passport", "vehicle registration"}, CreatedAt: now, UpdatedAt: now,
+		},
+	}}
+}
+
+// ---- GET /border-crossings -------------------------------------------------
+
+func TestGetBorderCrossingReport_JSON(t *testing.T) {
+	var gotYear int
+	svc := &mockBorderCrossingServicer{
+		yearReport: func(_ context.Context, year int) ([]domain.BorderCrossingReportRow, error) {
+			gotYear = year
+			return sampleCrossingReport(), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/border-crossings?year=2025", nil)
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 2025, gotYear)
+	var resp []gen.BorderCrossingReportRow
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "Alaska 2025", resp[0].TripName)
+	assert.Equal(t, "2025-07-14", resp[0].Crossing.CrossedOn.String())
+	assert.Equal(t, gen.CrossingDirection("entry"), resp[0].Crossing.Direction)
+}
+
+func TestGetBorderCrossingReport_CSV(t *testing.T) {
+	svc := &mockBorderCrossingServicer{
+		yearReport: func(_ context.Context, _ int) ([]domain.BorderCrossingReportRow, error) {
+			return sampleCrossingReport(), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/border-crossings?year=2025&format=csv", nil)
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/csv")
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "crossed_on", records[0][0])
+	assert.Equal(t, "2025-07-14", records[1][0])
+	assert.Equal(t, "Alaska 2025", records[1][2])
+	assert.Equal(t, "passport|vehicle registration", records[1][6])
+}
+
+func TestGetBorderCrossingReport_422(t *testing.T) {
+	svc := &mockBorderCrossingServicer{
+		yearReport: func(_ context.Context, _ int) ([]domain.BorderCrossingReportRow, error) {
+			return nil, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/border-crossings?year=0", nil)
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- /trips/{tripId}/border-crossings --------------------------------------
+
+func TestCreateTripBorderCrossing_201(t *testing.T) {
+	tripID := uuid.New()
+	var got domain.BorderCrossing
+	svc := &mockBorderCrossingServicer{
+		create: func(_ context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+			got = c
+			c.ID, c.CreatedAt, c.UpdatedAt = uuid.New(), time.Now().UTC(), time.Now().UTC()
+			return c, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"crossed_on":    "2025-07-14",
+		"port_of_entry": "Blaine, WA",
+		"country":       "CA",
+		"direction":     "entry",
+		"documents":     []string{"passport"},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/border-crossings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, tripID, got.TripID)
+	assert.Equal(t, domain.CrossingEntry, got.Direction)
+	assert.Equal(t, []string{"passport"}, got.Documents)
+	assert.True(t, got.CrossedOn.Equal(time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestCreateTripBorderCrossing_422(t *testing.T) {
+	svc := &mockBorderCrossingServicer{
+		create: func(_ context.Context, _ domain.BorderCrossing) (domain.BorderCrossing, error) {
+			return domain.BorderCrossing{}, fmt.Errorf("%w: country must be a two-letter ISO 3166-1 code", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"crossed_on": "2025-07-14", "port_of_entry": "Blaine, WA", "country": "CAN", "direction": "entry",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/border-crossings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestUpdateTripBorderCrossing_UsesPathIDs(t *testing.T) {
+	tripID, crossingID := uuid.New(), uuid.New()
+	var got domain.BorderCrossing
+	svc := &mockBorderCrossingServicer{
+		update: func(_ context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+			got = c
+			c.CreatedAt, c.UpdatedAt = time.Now().UTC(), time.Now().UTC()
+			return c, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"crossed_on": "2025-08-02", "port_of_entry": "Sumas, WA", "country": "CA", "direction": "exit",
+	})
+	req := httptest.NewRequest(http.MethodPut, "/trips/"+tripID.String()+"/border-crossings/"+crossingID.String(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, tripID, got.TripID)
+	assert.Equal(t, crossingID, got.ID)
+	var resp gen.BorderCrossing
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, []string{}, resp.Documents)
+}
+
+func TestGetTripBorderCrossing_404(t *testing.T) {
+	svc := &mockBorderCrossingServicer{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.BorderCrossing, error) {
+			return domain.BorderCrossing{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/border-crossings/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeleteTripBorderCrossing_204(t *testing.T) {
+	svc := &mockBorderCrossingServicer{
+		delete: func(_ context.Context, _, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.NewString()+"/border-crossings/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newBorderCrossingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// cacheControl lets the browser keep a copy of a cacheable response but has
+// it ask with If-Modified-Since before every reuse, so edits show up at once.
+//
+// Handlers look up the Last-Modified time before reading the data, so a
+// change that lands in between leaves the date older than the response,
+// never newer.
+const cacheControl = "private, no-cache"
+
+// notModified reports whether a client whose copy is dated ifModifiedSince
+// already has the latest data. A missing or unparsable header always means
+// the full response is sent.
+func notModified(ifModifiedSince *string, modified time.Time) bool {
+	if ifModifiedSince == nil {
+		return false
+	}
+	since, err := http.ParseTime(*ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	return !modified.After(since)
+}
+
+// httpDate formats t for a Last-Modified header.
+func httpDate(t time.Time) string {
+	return t.UTC().Format(http.TimeFormat)
+}
+
+// notModifiedHeaders returns the headers for a 304 response, which repeat
+// those the 200 would have carried.
+func notModifiedHeaders(modified time.Time) gen.NotModifiedResponseHeaders {
+	return gen.NotModifiedResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)}
+}
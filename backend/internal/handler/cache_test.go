@@ -0,0 +1,108 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- fake CacheServicer ----------------------------------------------------
+
+// fixedCache reports the same Last-Modified time for every view.
+type fixedCache time.Time
+
+func (c fixedCache) LastModified(context.Context, domain.CachedView) (time.Time, error) {
+	return time.Time(c), nil
+}
+
+// compile-time check: fixedCache must satisfy handler.CacheServicer.
+var _ handler.CacheServicer = fixedCache{}
+
+// testModified is when fixedCache says every view last changed.
+var testModified = time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+const testModifiedHTTP = "Sun, 01 Mar 2026 09:30:00 GMT"
+
+// newCachedTagHTTPHandler wires a Server with a tag service mock and cache.
+func newCachedTagHTTPHandler(t *testing.T, cache handler.CacheServicer) http.Handler {
+	tags := &mockTagServicer{
+		listPaged: func(_ context.Context, _ string, _ domain.PaginationParams) ([]domain.Tag, int64, error) {
+			return []domain.Tag{tagFixture()}, 1, nil
+		},
+	}
+	srv := handler.NewServer(handler.ServerDeps{Tags: tags, Cache: cache})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func getTags(t *testing.T, h http.Handler, ifModifiedSince string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+	if ifModifiedSince != "" {
+		req.Header.Set("If-Modified-Since", ifModifiedSince)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// ---- conditional GET -------------------------------------------------------
+
+func TestConditionalGet_SendsValidators(t *testing.T) {
+	rec := getTags(t, newCachedTagHTTPHandler(t, fixedCache(testModified)), "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, testModifiedHTTP, rec.Header().Get("Last-Modified"))
+	assert.NotEmpty(t, rec.Body.String())
+}
+
+func TestConditionalGet_NotModified(t *testing.T) {
+	h := newCachedTagHTTPHandler(t, fixedCache(testModified))
+
+	for _, since := range []string{testModifiedHTTP, "Sun, 01 Mar 2026 10:00:00 GMT"} {
+		rec := getTags(t, h, since)
+
+		assert.Equal(t, http.StatusNotModified, rec.Code, since)
+		assert.Empty(t, rec.Body.String(), since)
+		assert.Equal(t, testModifiedHTTP, rec.Header().Get("Last-Modified"), since)
+		assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"), since)
+	}
+}
+
+func TestConditionalGet_Modified(t *testing.T) {
+	h := newCachedTagHTTPHandler(t, fixedCache(testModified))
+
+	for _, since := range []string{"Sun, 01 Mar 2026 09:29:59 GMT", "yesterday"} {
+		rec := getTags(t, h, since)
+
+		assert.Equal(t, http.StatusOK, rec.Code, since)
+		assert.Equal(t, testModifiedHTTP, rec.Header().Get("Last-Modified"), since)
+	}
+}
+
+func TestConditionalGet_ExpiredShareIsNotFound(t *testing.T) {
+	// Nothing changed, but the link expired: the client must not be told its
+	// copy is still good.
+	svc := &mockShareServicer{
+		resolve: func(context.Context, string) (domain.SharedTrip, error) {
+			return domain.SharedTrip{}, domain.ErrNotFound
+		},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/shared/expired", nil)
+	req.Header.Set("If-Modified-Since", testModifiedHTTP)
+	rec := httptest.NewRecorder()
+
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListChecklistTemplates handles GET /checklist-templates.
+func (s *Server) ListChecklistTemplates(ctx context.Context, _ gen.ListChecklistTemplatesRequestObject) (gen.ListChecklistTemplatesResponseObject, error) {
+	templates, err := s.checklists.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.ListChecklistTemplates200JSONResponse, len(templates))
+	for i, t := range templates {
+		resp[i] = checklistTemplateToResponse(t)
+	}
+	return resp, nil
+}
+
+// CreateChecklistTemplate handles POST /checklist-templates.
+func (s *Server) CreateChecklistTemplate(ctx context.Context, req gen.CreateChecklistTemplateRequestObject) (gen.CreateChecklistTemplateResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateChecklistTemplate422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.checklists.CreateTemplate(ctx, checklistTemplateFromRequest(*req.Body))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateChecklistTemplate422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateChecklistTemplate201JSONResponse(checklistTemplateToResponse(created)), nil
+}
+
+// GetChecklistTemplate handles GET /checklist-templates/{id}.
+func (s *Server) GetChecklistTemplate(ctx context.Context, req gen.GetChecklistTemplateRequestObject) (gen.GetChecklistTemplateResponseObject, error) {
+	t, err := s.checklists.GetTemplate(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetChecklistTemplate404JSONResponse(notFoundBody("checklist template not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetChecklistTemplate200JSONResponse(checklistTemplateToResponse(t)), nil
+}
+
+// UpdateChecklistTemplate handles PUT /checklist-templates/{id}.
+func (s *Server) UpdateChecklistTemplate(ctx context.Context, req gen.UpdateChecklistTemplateRequestObject) (gen.UpdateChecklistTemplateResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateChecklistTemplate422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	t := checklistTemplateFromRequest(*req.Body)
+	t.ID = req.Id
+	updated, err := s.checklists.UpdateTemplate(ctx, t)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateChecklistTemplate404JSONResponse(notFoundBody("checklist template not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateChecklistTemplate422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateChecklistTemplate200JSONResponse(checklistTemplateToResponse(updated)), nil
+}
+
+// DeleteChecklistTemplate handles DELETE /checklist-templates/{id}.
+func (s *Server) DeleteChecklistTemplate(ctx context.Context, req gen.DeleteChecklistTemplateRequestObject) (gen.DeleteChecklistTemplateResponseObject, error) {
+	if err := s.checklists.DeleteTemplate(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteChecklistTemplate404JSONResponse(notFoundBody("checklist template not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteChecklistTemplate204Response{}, nil
+}
+
+// ListStopChecklists handles GET /trips/{tripId}/stops/{stopId}/checklists.
+func (s *Server) ListStopChecklists(ctx context.Context, req gen.ListStopChecklistsRequestObject) (gen.ListStopChecklistsResponseObject, error) {
+	checklists, err := s.checklists.ListChecklists(ctx, req.TripId, req.StopId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListStopChecklists404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListStopChecklists200JSONResponse, len(checklists))
+	for i, c := range checklists {
+		resp[i] = checklistToResponse(c)
+	}
+	return resp, nil
+}
+
+// StartStopChecklist handles POST /trips/{tripId}/stops/{stopId}/checklists.
+func (s *Server) StartStopChecklist(ctx context.Context, req gen.StartStopChecklistRequestObject) (gen.StartStopChecklistResponseObject, error) {
+	if req.Body == nil {
+		return gen.StartStopChecklist422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.checklists.StartChecklist(ctx, req.TripId, req.StopId, req.Body.TemplateId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.StartStopChecklist404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.StartStopChecklist422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.StartStopChecklist201JSONResponse(checklistToResponse(created)), nil
+}
+
+// GetStopChecklist handles GET /trips/{tripId}/stops/{stopId}/checklists/{checklistId}.
+func (s *Server) GetStopChecklist(ctx context.Context, req gen.GetStopChecklistRequestObject) (gen.GetStopChecklistResponseObject, error) {
+	c, err := s.checklists.GetChecklist(ctx, req.TripId, req.StopId, req.ChecklistId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetStopChecklist404JSONResponse(notFoundBody("checklist not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetStopChecklist200JSONResponse(checklistToResponse(c)), nil
+}
+
+// DeleteStopChecklist handles DELETE /trips/{tripId}/stops/{stopId}/checklists/{checklistId}.
+func (s *Server) DeleteStopChecklist(ctx context.Context, req gen.DeleteStopChecklistRequestObject) (gen.DeleteStopChecklistResponseObject, error) {
+	if err := s.checklists.DeleteChecklist(ctx, req.TripId, req.StopId, req.ChecklistId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteStopChecklist404JSONResponse(notFoundBody("checklist not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteStopChecklist204Response{}, nil
+}
+
+// CheckStopChecklistItem handles PUT /trips/{tripId}/stops/{stopId}/checklists/{checklistId}/items/{itemId}.
+func (s *Server) CheckStopChecklistItem(ctx context.Context, req gen.CheckStopChecklistItemRequestObject) (gen.CheckStopChecklistItemResponseObject, error) {
+	if req.Body == nil {
+		return gen.CheckStopChecklistItem422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	c, err := s.checklists.SetItemChecked(ctx, req.TripId, req.StopId, req.ChecklistId, req.ItemId, req.Body.Checked)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CheckStopChecklistItem404JSONResponse(notFoundBody("checklist item not found")), nil
+		}
+		return nil, err
+	}
+	return gen.CheckStopChecklistItem200JSONResponse(checklistToResponse(c)), nil
+}
+
+// checklistTemplateFromRequest converts a request body into a domain.ChecklistTemplate.
+func checklistTemplateFromRequest(body gen.ChecklistTemplateRequest) domain.ChecklistTemplate {
+	return domain.ChecklistTemplate{
+		Name:  body.Name,
+		Kind:  domain.ChecklistKind(body.Kind),
+		Items: body.Items,
+	}
+}
+
+// checklistTemplateToResponse converts a domain.ChecklistTemplate into the generated type.
+func checklistTemplateToResponse(t domain.ChecklistTemplate) gen.ChecklistTemplate {
+	return gen.ChecklistTemplate{
+		Id:        t.ID,
+		Name:      t.Name,
+		Kind:      gen.ChecklistTemplateKind(t.Kind),
+		Items:     t.Items,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// checklistToResponse converts a domain.Checklist into the generated type.
+func checklistToResponse(c domain.Checklist) gen.Checklist {
+	items := make([]gen.ChecklistItem, len(c.Items))
+	for i, item := range c.Items {
+		items[i] = gen.ChecklistItem{
+			Id:        item.ID,
+			Position:  item.Position,
+			Label:     item.Label,
+			CheckedAt: item.CheckedAt,
+		}
+	}
+	return gen.Checklist{
+		Id:         c.ID,
+		StopId:     c.StopID,
+		TemplateId: c.TemplateID,
+		Name:       c.Name,
+		Kind:       gen.ChecklistKind(c.Kind),
+		Items:      items,
+		Complete:   c.Complete(),
+		CreatedAt:  c.CreatedAt,
+	}
+}
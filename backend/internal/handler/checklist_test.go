@@ -0,0 +1,287 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock ChecklistServicer ------------------------------------------------
+
+type mockChecklistServicer struct {
+	createTemplate  func(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error)
+	getTemplate     func(ctx context.Context, id uuid.UUID) (domain.ChecklistTemplate, error)
+	listTemplates   func(ctx context.Context) ([]domain.ChecklistTemplate, error)
+	updateTemplate  func(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error)
+	deleteTemplate  func(ctx context.Context, id uuid.UUID) error
+	startChecklist  func(ctx context.Context, tripID, stopID, templateID uuid.UUID) (domain.Checklist, error)
+	listChecklists  func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Checklist, error)
+	getChecklist    func(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Checklist, error)
+	deleteChecklist func(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	setItemChecked  func(ctx context.Context, tripID, stopID, checklistID, itemID uuid.UUID, checked bool) (domain.Checklist, error)
+}
+
+func (m *mockChecklistServicer) CreateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	return m.createTemplate(ctx, t)
+}
+func (m *mockChecklistServicer) GetTemplate(ctx context.Context, id uuid.UUID) (domain.ChecklistTemplate, error) {
+	return m.getTemplate(ctx, id)
+}
+func (m *mockChecklistServicer) ListTemplates(ctx context.Context) ([]domain.ChecklistTemplate, error) {
+	return m.listTemplates(ctx)
+}
+func (m *mockChecklistServicer) UpdateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	return m.updateTemplate(ctx, t)
+}
+func (m *mockChecklistServicer) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	return m.deleteTemplate(ctx, id)
+}
+func (m *mockChecklistServicer) StartChecklist(ctx context.Context, tripID, stopID, templateID uuid.UUID) (domain.Checklist, error) {
+	return m.startChecklist(ctx, tripID, stopID, templateID)
+}
+func (m *mockChecklistServicer) ListChecklists(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Checklist, error) {
+	return m.listChecklists(ctx, tripID, stopID)
+}
+func (m *mockChecklistServicer) GetChecklist(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Checklist, error) {
+	return m.getChecklist(ctx, tripID, stopID, id)
+}
+func (m *mockChecklistServicer) DeleteChecklist(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	return m.deleteChecklist(ctx, tripID, stopID, id)
+}
+func (m *mockChecklistServicer) SetItemChecked(ctx context.Context, tripID, stopID, checklistID, itemID uuid.UUID, checked bool) (domain.Checklist, error) {
+	return m.setItemChecked(ctx, tripID, stopID, checklistID, itemID, checked)
+}
+
+// compile-time check: mockChecklistServicer must satisfy handler.ChecklistServicer.
+var _ handler.ChecklistServicer = (*mockChecklistServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newChecklistHTTPHandler wires a Server with only the checklist service mock.
+func newChecklistHTTPHandler(t *testing.T, svc handler.ChecklistServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Checklists: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- /checklist-templates --------------------------------------------------
+
+func TestCreateChecklistTemplate_201(t *testing.T) {
+	var got domain.ChecklistTemplate
+	svc := &mockChecklistServicer{
+		createTemplate: func(_ context.Context, tmpl domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+			got = tmpl
+			tmpl.ID, tmpl.CreatedAt, tmpl.UpdatedAt = uuid.New(), time.Now().UTC(), time.Now().UTC()
+			return tmpl, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":  "Pull out",
+		"kind":  "departure",
+		"items": []string{"Antenna down", "Steps up", "Hitch locked"},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/checklist-templates", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, domain.ChecklistDeparture, got.Kind)
+	assert.Equal(t, []string{"Antenna down", "Steps up", "Hitch locked"}, got.Items)
+}
+
+func TestCreateChecklistTemplate_422(t *testing.T) {
+	svc := &mockChecklistServicer{
+		createTemplate: func(_ context.Context, _ domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+			return domain.ChecklistTemplate{}, fmt.Errorf("%w: item 2 is blank", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Pull out", "kind": "departure", "items": []string{"Antenna down", " "}})
+	req := httptest.NewRequest(http.MethodPost, "/checklist-templates", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestUpdateChecklistTemplate_404(t *testing.T) {
+	svc := &mockChecklistServicer{
+		updateTemplate: func(_ context.Context, _ domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+			return domain.ChecklistTemplate{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Set up", "kind": "arrival", "items": []string{"Level"}})
+	req := httptest.NewRequest(http.MethodPut, "/checklist-templates/"+uuid.NewString(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestListChecklistTemplates_200(t *testing.T) {
+	svc := &mockChecklistServicer{
+		listTemplates: func(_ context.Context) ([]domain.ChecklistTemplate, error) {
+			return []domain.ChecklistTemplate{
+				{ID: uuid.New(), Name: "Set up", Kind: domain.ChecklistArrival, Items: []string{"Level"}},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/checklist-templates", nil)
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.ChecklistTemplate
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, gen.ChecklistTemplateKindArrival, resp[0].Kind)
+}
+
+// ---- /trips/{tripId}/stops/{stopId}/checklists -----------------------------
+
+func TestStartStopChecklist_201(t *testing.T) {
+	tripID, stopID, templateID := uuid.New(), uuid.New(), uuid.New()
+	var gotTemplate uuid.UUID
+	svc := &mockChecklistServicer{
+		startChecklist: func(_ context.Context, _, sid, tid uuid.UUID) (domain.Checklist, error) {
+			gotTemplate = tid
+			return domain.Checklist{
+				ID: uuid.New(), StopID: sid, TemplateID: &tid, Name: "Pull out", Kind: domain.ChecklistDeparture,
+				Items: []domain.ChecklistItem{{ID: uuid.New(), Position: 1, Label: "Antenna down"}},
+			}, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"template_id": templateID})
+	req := httptest.NewRequest(http.MethodPost, stopPath(tripID, stopID, "/checklists"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, templateID, gotTemplate)
+	var resp gen.Checklist
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.False(t, resp.Complete)
+	require.Len(t, resp.Items, 1)
+	assert.Nil(t, resp.Items[0].CheckedAt)
+}
+
+func TestStartStopChecklist_422UnknownTemplate(t *testing.T) {
+	svc := &mockChecklistServicer{
+		startChecklist: func(_ context.Context, _, _, tid uuid.UUID) (domain.Checklist, error) {
+			return domain.Checklist{}, fmt.Errorf("%w: checklist template %s does not exist", domain.ErrValidation, tid)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"template_id": uuid.New()})
+	req := httptest.NewRequest(http.MethodPost, stopPath(uuid.New(), uuid.New(), "/checklists"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestGetStopChecklist_404(t *testing.T) {
+	svc := &mockChecklistServicer{
+		getChecklist: func(_ context.Context, _, _, _ uuid.UUID) (domain.Checklist, error) {
+			return domain.Checklist{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, stopPath(uuid.New(), uuid.New(), "/checklists/"+uuid.NewString()), nil)
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCheckStopChecklistItem_200(t *testing.T) {
+	checklistID, itemID := uuid.New(), uuid.New()
+	checkedAt := time.Date(2025, 6, 10, 8, 30, 0, 0, time.UTC)
+	var gotItem uuid.UUID
+	var gotChecked bool
+	svc := &mockChecklistServicer{
+		setItemChecked: func(_ context.Context, _, sid, cid, iid uuid.UUID, checked bool) (domain.Checklist, error) {
+			gotItem, gotChecked = iid, checked
+			return domain.Checklist{
+				ID: cid, StopID: sid, Name: "Pull out", Kind: domain.ChecklistDeparture,
+				Items: []domain.ChecklistItem{{ID: iid, Position: 1, Label: "Antenna down", CheckedAt: &checkedAt}},
+			}, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"checked": true})
+	path := stopPath(uuid.New(), uuid.New(), "/checklists/"+checklistID.String()+"/items/"+itemID.String())
+	req := httptest.NewRequest(http.MethodPut, path, body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, itemID, gotItem)
+	assert.True(t, gotChecked)
+	var resp gen.Checklist
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.True(t, resp.Complete)
+	require.NotNil(t, resp.Items[0].CheckedAt)
+	assert.Equal(t, checkedAt, resp.Items[0].CheckedAt.UTC())
+}
+
+func TestCheckStopChecklistItem_404(t *testing.T) {
+	svc := &mockChecklistServicer{
+		setItemChecked: func(_ context.Context, _, _, _, _ uuid.UUID, _ bool) (domain.Checklist, error) {
+			return domain.Checklist{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"checked": false})
+	path := stopPath(uuid.New(), uuid.New(), "/checklists/"+uuid.NewString()+"/items/"+uuid.NewString())
+	req := httptest.NewRequest(http.MethodPut, path, body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeleteStopChecklist_204(t *testing.T) {
+	svc := &mockChecklistServicer{
+		deleteChecklist: func(_ context.Context, _, _, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, stopPath(uuid.New(), uuid.New(), "/checklists/"+uuid.NewString()), nil)
+	rec := httptest.NewRecorder()
+
+	newChecklistHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
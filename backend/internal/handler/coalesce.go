@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// coalesce runs fn once for all concurrent requests passing the same key
+// and gives each of them its result, so a burst of identical reads costs one
+// computation. The result is shared: callers must not modify it. Only
+// requests acting for the same organization and user share a result, since
+// what a read returns depends on both; key need not include them.
+//
+// fn runs without the cancellation of the request that started it, since
+// other requests may be waiting on the result; it keeps the context's values.
+// Waiting requests cannot leave early, so fn should be bounded by the time
+// its queries take.
+func coalesce[T any](ctx context.Context, s *Server, key string, fn func(context.Context) (T, error)) (T, error) {
+	userID, _ := domain.UserFromContext(ctx)
+	key = fmt.Sprintf("%s|%s|%s", domain.OrganizationFromContext(ctx), userID, key)
+	v, err, _ := s.flights.Do(key, func() (any, error) {
+		return fn(context.WithoutCancel(ctx))
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.(T), nil
+}
+
+// flightKey builds a coalescing key from an operation name, the
+// Last-Modified time the request read, and its parameters. A request that
+// read a later time never joins a computation that began before a change,
+// so a response is never dated newer than its data; views that send no
+// Last-Modified pass the zero time. nil parameters are written as "-".
+func flightKey(op string, modified time.Time, params ...any) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s|%d", op, modified.Unix())
+	for _, p := range params {
+		b.WriteByte('|')
+		switch v := p.(type) {
+		case *int:
+			writeOptional(&b, v)
+		case *float64:
+			writeOptional(&b, v)
+		case *uuid.UUID:
+			writeOptional(&b, v)
+		default:
+			fmt.Fprint(&b, v)
+		}
+	}
+	return b.String()
+}
+
+func writeOptional[T any](b *strings.Builder, p *T) {
+	if p == nil {
+		b.WriteByte('-')
+		return
+	}
+	fmt.Fprint(b, *p)
+}
@@ -0,0 +1,169 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// steppingCache reports whatever time it was last set to, and counts lookups
+// so a test can tell when requests have got past them.
+type steppingCache struct {
+	modified atomic.Int64 // Unix seconds
+	lookups  atomic.Int32
+}
+
+func (c *steppingCache) LastModified(context.Context, domain.CachedView) (time.Time, error) {
+	c.lookups.Add(1)
+	return time.Unix(c.modified.Load(), 0).UTC(), nil
+}
+
+// blockingTripStats counts TripStats calls and holds each one until release
+// is closed.
+type blockingTripStats struct {
+	mockRouteLegServicer
+	calls   atomic.Int32
+	entered chan struct{}
+	release chan struct{}
+}
+
+func newBlockingTripStats() *blockingTripStats {
+	b := &blockingTripStats{entered: make(chan struct{}, 10), release: make(chan struct{})}
+	b.tripStats = func(ctx context.Context, tripID uuid.UUID) (domain.TripStats, error) {
+		b.calls.Add(1)
+		b.entered <- struct{}{}
+		<-b.release
+		if err := ctx.Err(); err != nil {
+			return domain.TripStats{}, err
+		}
+		return domain.TripStats{TripID: tripID, Stops: 2, Legs: 1}, nil
+	}
+	return b
+}
+
+func newCoalescingHTTPHandler(t *testing.T, svc handler.RouteLegServicer, cache handler.CacheServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Routes: svc, Cache: cache})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// serveAsync serves req in the background and returns the recorder, ready to
+// read once wg is done.
+func serveAsync(h http.Handler, req *http.Request, wg *sync.WaitGroup) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		h.ServeHTTP(rec, req)
+	}()
+	return rec
+}
+
+func TestCoalesce_IdenticalRequestsShareOneCall(t *testing.T) {
+	svc := newBlockingTripStats()
+	cache := &steppingCache{}
+	cache.modified.Store(testModified.Unix())
+	h := newCoalescingHTTPHandler(t, svc, cache)
+	path := "/trips/" + uuid.NewString() + "/stats"
+
+	var wg sync.WaitGroup
+	recs := []*httptest.ResponseRecorder{serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil), &wg)}
+	<-svc.entered
+	for range 4 {
+		recs = append(recs, serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil), &wg))
+	}
+	require.Eventually(t, func() bool { return cache.lookups.Load() == 5 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond) // let the later requests join the call
+	close(svc.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), svc.calls.Load())
+	for _, rec := range recs {
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
+
+func TestCoalesce_LaterChangeStartsNewCall(t *testing.T) {
+	svc := newBlockingTripStats()
+	cache := &steppingCache{}
+	cache.modified.Store(testModified.Unix())
+	h := newCoalescingHTTPHandler(t, svc, cache)
+	path := "/trips/" + uuid.NewString() + "/stats"
+
+	var wg sync.WaitGroup
+	first := serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil), &wg)
+	<-svc.entered
+	cache.modified.Store(testModified.Add(time.Minute).Unix())
+	second := serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil), &wg)
+	<-svc.entered
+	close(svc.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(2), svc.calls.Load())
+	assert.Equal(t, testModifiedHTTP, first.Header().Get("Last-Modified"))
+	assert.Equal(t, "Sun, 01 Mar 2026 09:31:00 GMT", second.Header().Get("Last-Modified"))
+}
+
+func TestCoalesce_FirstCallerGivingUpDoesNotFailOthers(t *testing.T) {
+	svc := newBlockingTripStats()
+	cache := &steppingCache{}
+	cache.modified.Store(testModified.Unix())
+	h := newCoalescingHTTPHandler(t, svc, cache)
+	path := "/trips/" + uuid.NewString() + "/stats"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx), &wg)
+	<-svc.entered
+	waiter := serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil), &wg)
+	require.Eventually(t, func() bool { return cache.lookups.Load() == 2 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	close(svc.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), svc.calls.Load())
+	assert.Equal(t, http.StatusOK, waiter.Code)
+}
+
+func TestCoalesce_DifferentCallersDoNotShare(t *testing.T) {
+	tests := []struct {
+		name   string
+		second func(context.Context) context.Context
+	}{
+		{"another user", func(ctx context.Context) context.Context { return domain.WithUser(ctx, uuid.New()) }},
+		{"another organization", func(ctx context.Context) context.Context { return domain.WithOrganization(ctx, uuid.New()) }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newBlockingTripStats()
+			cache := &steppingCache{}
+			cache.modified.Store(testModified.Unix())
+			h := newCoalescingHTTPHandler(t, svc, cache)
+			path := "/trips/" + uuid.NewString() + "/stats"
+			ctx := domain.WithUser(context.Background(), uuid.New())
+
+			var wg sync.WaitGroup
+			serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx), &wg)
+			<-svc.entered
+			serveAsync(h, httptest.NewRequest(http.MethodGet, path, nil).WithContext(tc.second(ctx)), &wg)
+			<-svc.entered
+			close(svc.release)
+			wg.Wait()
+
+			assert.Equal(t, int32(2), svc.calls.Load())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListCustomFields handles GET /custom-fields.
+func (s *Server) ListCustomFields(ctx context.Context, req gen.ListCustomFieldsRequestObject) (gen.ListCustomFieldsResponseObject, error) {
+	var entity domain.CustomFieldEntity
+	if req.Params.Entity != nil {
+		entity = domain.CustomFieldEntity(*req.Params.Entity)
+	}
+	fields, err := s.customFields.List(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.ListCustomFields200JSONResponse, len(fields))
+	for i, f := range fields {
+		resp[i] = customFieldToResponse(f)
+	}
+	return resp, nil
+}
+
+// CreateCustomField handles POST /custom-fields.
+func (s *Server) CreateCustomField(ctx context.Context, req gen.CreateCustomFieldRequestObject) (gen.CreateCustomFieldResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateCustomField422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.customFields.Create(ctx, domain.CustomField{
+		Entity: domain.CustomFieldEntity(req.Body.Entity),
+		Key:    req.Body.Key,
+		Label:  req.Body.Label,
+		Type:   domain.CustomFieldType(req.Body.Type),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateCustomField422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateCustomField201JSONResponse(customFieldToResponse(created)), nil
+}
+
+// PatchCustomField handles PATCH /custom-fields/{id}.
+func (s *Server) PatchCustomField(ctx context.Context, req gen.PatchCustomFieldRequestObject) (gen.PatchCustomFieldResponseObject, error) {
+	if req.Body == nil {
+		return gen.PatchCustomField422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	f, err := s.customFields.UpdateLabel(ctx, req.Id, req.Body.Label)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.PatchCustomField404JSONResponse(notFoundBody("custom field not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.PatchCustomField422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.PatchCustomField200JSONResponse(customFieldToResponse(f)), nil
+}
+
+// DeleteCustomField handles DELETE /custom-fields/{id}.
+func (s *Server) DeleteCustomField(ctx context.Context, req gen.DeleteCustomFieldRequestObject) (gen.DeleteCustomFieldResponseObject, error) {
+	if err := s.customFields.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteCustomField404JSONResponse(notFoundBody("custom field not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteCustomField204Response{}, nil
+}
+
+// customFieldToResponse converts a domain.CustomField to the generated API type.
+func customFieldToResponse(f domain.CustomField) gen.CustomField {
+	return gen.CustomField{
+		Id:        f.ID,
+		Entity:    gen.CustomFieldEntity(f.Entity),
+		Key:       f.Key,
+		Label:     f.Label,
+		Type:      gen.CustomFieldType(f.Type),
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+// metadataFromAPI converts request metadata. An omitted object stays nil so
+// an update keeps the current values.
+func metadataFromAPI(m *gen.Metadata) domain.Metadata {
+	if m == nil {
+		return nil
+	}
+	return domain.Metadata(*m)
+}
+
+// metadataToAPI converts metadata for a response, omitting it when empty.
+func metadataToAPI(m domain.Metadata) *gen.Metadata {
+	if len(m) == 0 {
+		return nil
+	}
+	v := gen.Metadata(m)
+	return &v
+}
+
+// fieldFilters returns the ?field= custom field filters, if any.
+func fieldFilters(f *gen.FieldFilter) []string {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
@@ -0,0 +1,209 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock CustomFieldServicer ----------------------------------------------
+
+type mockCustomFieldServicer struct {
+	create      func(ctx context.Context, f domain.CustomField) (domain.CustomField, error)
+	list        func(ctx context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error)
+	updateLabel func(ctx context.Context, id uuid.UUID, label string) (domain.CustomField, error)
+	delete      func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockCustomFieldServicer) Create(ctx context.Context, f domain.CustomField) (domain.CustomField, error) {
+	return m.create(ctx, f)
+}
+
+func (m *mockCustomFieldServicer) List(ctx context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error) {
+	return m.list(ctx, entity)
+}
+
+func (m *mockCustomFieldServicer) UpdateLabel(ctx context.Context, id uuid.UUID, label string) (domain.CustomField, error) {
+	return m.updateLabel(ctx, id, label)
+}
+
+func (m *mockCustomFieldServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+// compile-time check: mockCustomFieldServicer must satisfy handler.CustomFieldServicer.
+var _ handler.CustomFieldServicer = (*mockCustomFieldServicer)(nil)
+
+func newCustomFieldHTTPHandler(t *testing.T, svc handler.CustomFieldServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{CustomFields: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- /custom-fields --------------------------------------------------------
+
+func TestListCustomFields_200(t *testing.T) {
+	svc := &mockCustomFieldServicer{
+		list: func(_ context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error) {
+			assert.Equal(t, domain.CustomFieldStop, entity)
+			return []domain.CustomField{{ID: uuid.New(), Entity: entity, Key: "pets_allowed", Label: "Pets allowed", Type: domain.FieldBoolean, CreatedAt: time.Now()}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/custom-fields?entity=stop", nil)
+	rec := httptest.NewRecorder()
+
+	newCustomFieldHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.CustomField
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "pets_allowed", resp[0].Key)
+	assert.Equal(t, gen.Boolean, resp[0].Type)
+}
+
+func TestCreateCustomField_201(t *testing.T) {
+	svc := &mockCustomFieldServicer{
+		create: func(_ context.Context, f domain.CustomField) (domain.CustomField, error) {
+			assert.Equal(t, domain.CustomFieldTrip, f.Entity)
+			assert.Equal(t, domain.FieldText, f.Type)
+			f.ID = uuid.New()
+			f.CreatedAt = time.Now()
+			return f, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/custom-fields",
+		strings.NewReader(`{"entity":"trip","key":"altitude_notes","label":"Altitude sickness","type":"text"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newCustomFieldHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+func TestCreateCustomField_422(t *testing.T) {
+	svc := &mockCustomFieldServicer{
+		create: func(_ context.Context, _ domain.CustomField) (domain.CustomField, error) {
+			return domain.CustomField{}, fmt.Errorf("repo.CustomFieldRepo.Create: %w: trips already have a field \"pets\"", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/custom-fields",
+		strings.NewReader(`{"entity":"trip","key":"pets","label":"Pets","type":"text"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newCustomFieldHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, `trips already have a field "pets"`, resp.Error.Message)
+}
+
+// ---- /custom-fields/{id} ---------------------------------------------------
+
+func TestPatchCustomField_404(t *testing.T) {
+	svc := &mockCustomFieldServicer{
+		updateLabel: func(_ context.Context, _ uuid.UUID, _ string) (domain.CustomField, error) {
+			return domain.CustomField{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/custom-fields/%s", uuid.New()), strings.NewReader(`{"label":"Pets welcome"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newCustomFieldHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeleteCustomField_204(t *testing.T) {
+	id := uuid.New()
+	svc := &mockCustomFieldServicer{
+		delete: func(_ context.Context, got uuid.UUID) error {
+			assert.Equal(t, id, got)
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/custom-fields/%s", id), nil)
+	rec := httptest.NewRecorder()
+
+	newCustomFieldHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+// ---- metadata on trips -----------------------------------------------------
+
+func TestCreateTrip_Metadata(t *testing.T) {
+	svc := &mockTripServicer{
+		create: func(_ context.Context, trip domain.Trip) (domain.Trip, error) {
+			assert.Equal(t, domain.Metadata{"altitude_ft": 9200.0}, trip.Metadata)
+			trip.ID = uuid.New()
+			return trip, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/trips",
+		strings.NewReader(`{"name":"Rockies","start_date":"2025-06-01","metadata":{"altitude_ft":9200}}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var resp gen.Trip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Metadata)
+	assert.Equal(t, 9200.0, (*resp.Metadata)["altitude_ft"])
+}
+
+func TestListTrips_FieldFilters(t *testing.T) {
+	svc := &mockTripServicer{
+		listPaged: func(_ context.Context, fields []string, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
+			assert.Equal(t, []string{"pets:dogs", "sick:true"}, fields)
+			return nil, 0, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips?field=pets:dogs&field=sick:true", nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func TestListTrips_FieldFilters_422(t *testing.T) {
+	svc := &mockTripServicer{
+		listPaged: func(_ context.Context, _ []string, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
+			return nil, 0, fmt.Errorf("service.TripService.ListPaged: %w: metadata field \"wifi\" is not defined for trips", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips?field=wifi:good", nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
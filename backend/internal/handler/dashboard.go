@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetDashboard handles GET /stats/dashboard.
+func (s *Server) GetDashboard(ctx context.Context, req gen.GetDashboardRequestObject) (gen.GetDashboardResponseObject, error) {
+	modified, err := s.cache.LastModified(ctx, domain.ViewDashboard)
+	if err != nil {
+		return nil, err
+	}
+	dashboard, err := coalesce(ctx, s, flightKey("dashboard", modified, req.Params.Tags), func(ctx context.Context) (domain.Dashboard, error) {
+		return s.dashboard.Dashboard(ctx, req.Params.Tags)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetDashboard422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	if notModified(req.Params.IfModifiedSince, modified) {
+		return gen.GetDashboard304Response{Headers: notModifiedHeaders(modified)}, nil
+	}
+
+	trips := make([]gen.TripSummary, len(dashboard.Trips))
+	for i, t := range dashboard.Trips {
+		trips[i] = tripSummaryToResponse(t)
+	}
+	tags := make([]gen.TagCount, len(dashboard.Tags))
+	for i, c := range dashboard.Tags {
+		tags[i] = gen.TagCount{Slug: c.Slug, Name: c.Name, Stops: c.Stops}
+	}
+	return gen.GetDashboard200JSONResponse{
+		Body: gen.Dashboard{
+			TotalTrips:  len(dashboard.Trips),
+			TotalStops:  dashboard.TotalStops(),
+			TotalNights: dashboard.TotalNights(),
+			Trips:       trips,
+			TopTags:     tags,
+		},
+		Headers: gen.GetDashboard200ResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)},
+	}, nil
+}
+
+// tripSummaryToResponse converts a domain.TripSummary to the generated API type.
+func tripSummaryToResponse(t domain.TripSummary) gen.TripSummary {
+	resp := gen.TripSummary{
+		TripId:         t.TripID,
+		Name:           t.Name,
+		StartDate:      dateToAPI(t.StartDate),
+		EndDate:        dateToAPIPtr(t.EndDate),
+		Stops:          t.Stops,
+		Nights:         t.Nights,
+		FirstArrivedAt: t.FirstArrivedAt,
+		LastArrivedAt:  t.LastArrivedAt,
+	}
+	return resp
+}
@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock DashboardServicer ------------------------------------------------
+
+type mockDashboardServicer struct {
+	dashboard func(ctx context.Context, tagLimit *int) (domain.Dashboard, error)
+}
+
+func (m *mockDashboardServicer) Dashboard(ctx context.Context, tagLimit *int) (domain.Dashboard, error) {
+	return m.dashboard(ctx, tagLimit)
+}
+
+// compile-time check: mockDashboardServicer must satisfy handler.DashboardServicer.
+var _ handler.DashboardServicer = (*mockDashboardServicer)(nil)
+
+func newDashboardHTTPHandler(t *testing.T, svc handler.DashboardServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Cache: fixedCache(testModified), Dashboard: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /stats/dashboard --------------------------------------------------
+
+func TestGetDashboard_200(t *testing.T) {
+	tripID := uuid.New()
+	start := domain.NewDate(2025, 9, 1)
+	first := time.Date(2025, 9, 1, 16, 0, 0, 0, time.UTC)
+	last := time.Date(2025, 9, 6, 15, 0, 0, 0, time.UTC)
+	svc := &mockDashboardServicer{
+		dashboard: func(_ context.Context, tagLimit *int) (domain.Dashboard, error) {
+			require.NotNil(t, tagLimit)
+			assert.Equal(t, 5, *tagLimit)
+			return domain.Dashboard{
+				Trips: []domain.TripSummary{
+					{TripID: tripID, Name: "Fall Colors", StartDate: start, Stops: 4, Nights: 7, FirstArrivedAt: &first, LastArrivedAt: &last},
+					{TripID: uuid.New(), Name: "Planning", StartDate: domain.NewDate(2026, 9, 1)},
+				},
+				Tags: []domain.TagCount{{Slug: "boondocking", Name: "Boondocking", Stops: 3}},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/dashboard?tags=5", nil)
+	rec := httptest.NewRecorder()
+
+	newDashboardHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, testModifiedHTTP, rec.Header().Get("Last-Modified"))
+	var resp gen.Dashboard
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 2, resp.TotalTrips)
+	assert.Equal(t, 4, resp.TotalStops)
+	assert.Equal(t, 7, resp.TotalNights)
+	require.Len(t, resp.Trips, 2)
+	assert.Equal(t, tripID, resp.Trips[0].TripId)
+	assert.Equal(t, "2025-09-01", resp.Trips[0].StartDate.String())
+	assert.Nil(t, resp.Trips[0].EndDate)
+	require.NotNil(t, resp.Trips[0].FirstArrivedAt)
+	assert.True(t, first.Equal(*resp.Trips[0].FirstArrivedAt))
+	assert.Nil(t, resp.Trips[1].FirstArrivedAt, "a trip without stops has no arrivals")
+	assert.Equal(t, []gen.TagCount{{Slug: "boondocking", Name: "Boondocking", Stops: 3}}, resp.TopTags)
+}
+
+func TestGetDashboard_200_Empty(t *testing.T) {
+	svc := &mockDashboardServicer{
+		dashboard: func(_ context.Context, tagLimit *int) (domain.Dashboard, error) {
+			assert.Nil(t, tagLimit)
+			return domain.Dashboard{Trips: []domain.TripSummary{}, Tags: []domain.TagCount{}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/dashboard", nil)
+	rec := httptest.NewRecorder()
+
+	newDashboardHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"total_trips":0,"total_stops":0,"total_nights":0,"trips":[],"top_tags":[]}`, rec.Body.String())
+}
+
+func TestGetDashboard_304(t *testing.T) {
+	svc := &mockDashboardServicer{
+		dashboard: func(_ context.Context, _ *int) (domain.Dashboard, error) {
+			return domain.Dashboard{}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/dashboard", nil)
+	req.Header.Set("If-Modified-Since", testModifiedHTTP)
+	rec := httptest.NewRecorder()
+
+	newDashboardHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestGetDashboard_422(t *testing.T) {
+	svc := &mockDashboardServicer{
+		dashboard: func(_ context.Context, _ *int) (domain.Dashboard, error) {
+			return domain.Dashboard{}, fmt.Errorf("%w: tags must be between 1 and 100", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/dashboard?tags=500", nil)
+	rec := httptest.NewRecorder()
+
+	newDashboardHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
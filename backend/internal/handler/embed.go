@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"html/template"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// The route map on the HTML embed is sized for a blog's content column.
+const (
+	embedMapWidth  = 640
+	embedMapHeight = 360
+)
+
+// GetSharedTripEmbed handles GET /shared/{token}/embed.
+// Use ?format=html for a page to put in an iframe; default is JSON.
+func (s *Server) GetSharedTripEmbed(ctx context.Context, req gen.GetSharedTripEmbedRequestObject) (gen.GetSharedTripEmbedResponseObject, error) {
+	modified, err := s.cache.LastModified(ctx, domain.ViewSharedTrip)
+	if err != nil {
+		return nil, err
+	}
+	// Shares its flights with GET /shared/{token}: both read the same view.
+	shared, err := coalesce(ctx, s, flightKey("shared_trip", modified, req.Token), func(ctx context.Context) (domain.SharedTrip, error) {
+		return s.shares.Resolve(ctx, req.Token)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetSharedTripEmbed404JSONResponse(notFoundBody("share link is invalid or has expired")), nil
+		}
+		return nil, err
+	}
+
+	if req.Params.Format == nil || *req.Params.Format != gen.Html {
+		return gen.GetSharedTripEmbed200JSONResponse(embedToResponse(shared)), nil
+	}
+	page, err := coalesce(ctx, s, flightKey("shared_trip_embed", modified, req.Token), func(ctx context.Context) ([]byte, error) {
+		return s.renderEmbed(ctx, shared)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return embedHTMLResponse(page), nil
+}
+
+// renderEmbed renders the HTML embed for a shared trip. A trip with nothing
+// to put on a map is shown without one.
+func (s *Server) renderEmbed(ctx context.Context, shared domain.SharedTrip) ([]byte, error) {
+	page := embedPage{Name: shared.Trip.Name, Dates: embedDates(shared.Trip)}
+	img, err := s.maps.StopsMapPNG(ctx, shared.Stops, embedMapWidth, embedMapHeight)
+	switch {
+	case err == nil:
+		// The image is encoded here, so it is safe to mark as a trusted URL.
+		page.Map = template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(img))
+	case !errors.Is(err, domain.ErrValidation):
+		return nil, err
+	}
+	for _, st := range shared.Stops {
+		es := embedStop{Name: st.Name, Location: st.Location, Planned: st.Planned}
+		if !st.Planned {
+			es.Arrived = st.ArrivedAt.Format("Jan 2")
+		}
+		page.Stops = append(page.Stops, es)
+	}
+
+	var buf bytes.Buffer
+	if err := embedTemplate.Execute(&buf, page); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// embedDates describes when a trip ran, for the embed's subtitle.
+func embedDates(t domain.Trip) string {
+	const layout = "Jan 2, 2006"
+	start := t.StartDate.In(time.UTC).Format(layout)
+	if t.EndDate == nil {
+		return "Since " + start
+	}
+	return start + " – " + t.EndDate.In(time.UTC).Format(layout)
+}
+
+// embedToResponse converts a shared trip to the JSON embed payload.
+func embedToResponse(shared domain.SharedTrip) gen.SharedTripEmbed {
+	stops := make([]gen.SharedTripEmbedStop, len(shared.Stops))
+	for i, st := range shared.Stops {
+		tags := make([]string, len(st.Tags))
+		for j, tag := range st.Tags {
+			tags[j] = tag.Name
+		}
+		stops[i] = gen.SharedTripEmbedStop{
+			Name:      st.Name,
+			Location:  nilIfEmpty(st.Location),
+			Latitude:  st.Latitude,
+			Longitude: st.Longitude,
+			Planned:   st.Planned,
+			Tags:      tags,
+		}
+		if !st.Planned {
+			arrived := st.ArrivedAt
+			stops[i].ArrivedAt = &arrived
+		}
+	}
+	return gen.SharedTripEmbed{
+		Name:      shared.Trip.Name,
+		StartDate: dateToAPI(shared.Trip.StartDate),
+		EndDate:   dateToAPIPtr(shared.Trip.EndDate),
+		Stops:     stops,
+		ExpiresAt: shared.ExpiresAt,
+	}
+}
+
+// embedHTMLResponse writes the HTML embed. The generated response type sends
+// text/html with no charset, which leaves browsers to guess it.
+type embedHTMLResponse []byte
+
+func (r embedHTMLResponse) VisitGetSharedTripEmbedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err := io.Copy(w, bytes.NewReader(r))
+	return err
+}
+
+// embedPage is what embedTemplate renders.
+type embedPage struct {
+	Name  string
+	Dates string
+	Map   template.URL
+	Stops []embedStop
+}
+
+type embedStop struct {
+	Name     string
+	Location string
+	Arrived  string
+	Planned  bool
+}
+
+// embedTemplate is the HTML embed: a self-contained page with inline styles
+// and the map inlined, so it needs nothing else from the server.
+var embedTemplate = template.Must(template.New("embed").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Name}}</title>
+<style>
+body { margin: 0; padding: 12px; font: 14px/1.4 system-ui, sans-serif; color: #1f2937; }
+h1 { margin: 0; font-size: 18px; }
+.dates { margin: 0 0 8px; color: #6b7280; }
+img { display: block; max-width: 100%; height: auto; border-radius: 4px; }
+ol { margin: 8px 0 0; padding-left: 20px; }
+.meta { color: #6b7280; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<p class="dates">{{.Dates}}</p>
+{{with .Map}}<img src="{{.}}" alt="Route map">
+{{end}}<ol>
+{{range .Stops}}<li>{{.Name}}{{with .Location}} <span class="meta">{{.}}</span>{{end}}{{if .Planned}} <span class="meta">(planned)</span>{{else}} <span class="meta">{{.Arrived}}</span>{{end}}</li>
+{{end}}</ol>
+</body>
+</html>
+`))
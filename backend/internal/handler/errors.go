@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"strings"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
 )
 
@@ -17,6 +20,22 @@ func validationBody(err error) gen.ErrorResponse {
 	return gen.ErrorResponse{Error: gen.ErrorDetail{Code: "validation_error", Message: unwrapMessage(err)}}
 }
 
+// unauthorizedBody returns an ErrorResponse for failed authentication. The
+// message must not say which part of the credentials was wrong.
+func unauthorizedBody(message string) gen.ErrorResponse {
+	return gen.ErrorResponse{Error: gen.ErrorDetail{Code: "unauthorized", Message: message}}
+}
+
+// forbiddenBody returns an ErrorResponse for a change the request's user may
+// not make. The message is the detail wrapped with domain.ErrForbidden.
+func forbiddenBody(err error) gen.ErrorResponse {
+	message := domain.ErrForbidden.Error()
+	if _, detail, ok := strings.Cut(err.Error(), message+": "); ok && detail != "" {
+		message = detail
+	}
+	return gen.ErrorResponse{Error: gen.ErrorDetail{Code: "forbidden", Message: message}}
+}
+
 // requestBody returns an ErrorResponse for a bad request rejected before
 // reaching the service layer (e.g. missing or malformed body).
 func requestBody(message string) gen.ErrorResponse {
@@ -25,22 +44,26 @@ func requestBody(message string) gen.ErrorResponse {
 
 // unwrapMessage extracts the human-readable part from a wrapped sentinel error.
 // e.g. "service.TripService.Create: validation error: name is required" → "name is required"
+//
+// Everything up to and including the first "validation error: " is dropped,
+// whatever wrapped it, so call-site prefixes never leak into API responses.
+// An error with no detail after the sentinel yields just "validation error".
 func unwrapMessage(err error) string {
 	if err == nil {
 		return ""
 	}
 	msg := err.Error()
-	for _, prefix := range []string{
-		"service.TripService.Create: validation error: ",
-		"service.TripService.Update: validation error: ",
-		"service.StopService.Create: validation error: ",
-		"service.StopService.Update: validation error: ",
-		"service.TagService.AddTag: validation error: ",
-		"validation error: ",
-	} {
-		if len(msg) > len(prefix) && msg[:len(prefix)] == prefix {
-			return msg[len(prefix):]
-		}
+	marker := domain.ErrValidation.Error()
+	i := strings.Index(msg, marker)
+	if i < 0 {
+		return msg
+	}
+	rest := msg[i+len(marker):]
+	if detail, ok := strings.CutPrefix(rest, ": "); ok && detail != "" {
+		return detail
+	}
+	if rest == "" || rest == ": " {
+		return marker
 	}
 	return msg
 }
@@ -0,0 +1,69 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// FuzzValidationMessage checks that whatever wraps a domain.ErrValidation on
+// its way out of the service layer, the 422 body carries only the
+// human-readable detail — never a call-site prefix like "service.X.Create:".
+//
+// Run with: go test ./internal/handler -run '^$' -fuzz FuzzValidationMessage
+func FuzzValidationMessage(f *testing.F) {
+	f.Add("service.TripService.Create: ", "name is required")
+	f.Add("service.ShareService.Create: ", "ttl must be positive")
+	f.Add("", "end_date must not be before start_date")
+	f.Add("service.TripService.Create: ", "")
+	f.Add("a: b: c: ", "detail: with: colons")
+	f.Add("", "validation error: nested")
+
+	f.Fuzz(func(t *testing.T, wrapper, detail string) {
+		if !utf8.ValidString(wrapper) || !utf8.ValidString(detail) {
+			t.Skip("JSON encoding rewrites invalid UTF-8; not what this target checks")
+		}
+		if strings.Contains(wrapper, domain.ErrValidation.Error()) {
+			t.Skip("a wrapper that repeats the sentinel text is indistinguishable from the detail")
+		}
+
+		svcErr := fmt.Errorf("%s%w", wrapper, fmt.Errorf("%w: %s", domain.ErrValidation, detail))
+		svc := &mockTripServicer{
+			create: func(_ context.Context, _ domain.Trip) (domain.Trip, error) {
+				return domain.Trip{}, svcErr
+			},
+		}
+		h := gen.Handler(gen.NewStrictHandler(handler.NewServer(handler.ServerDeps{Trips: svc}), nil))
+
+		req := httptest.NewRequest(http.MethodPost, "/trips",
+			strings.NewReader(`{"name":"x","start_date":"2025-06-01"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status = %d, want 422", rec.Code)
+		}
+		var resp gen.ErrorResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+
+		want := detail
+		if want == "" {
+			want = domain.ErrValidation.Error()
+		}
+		if resp.Error.Message != want {
+			t.Fatalf("message = %q, want %q (service error %q)", resp.Error.Message, want, svcErr)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// QuickLogExpense handles POST /trips/{tripId}/quicklog.
+func (s *Server) QuickLogExpense(ctx context.Context, req gen.QuickLogExpenseRequestObject) (gen.QuickLogExpenseResponseObject, error) {
+	if req.Body == nil {
+		return gen.QuickLogExpense422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	category := domain.ExpenseCategory(req.Body.Type)
+	var fillUp *domain.FillUp
+	if fs := req.Body.FuelStop; fs != nil {
+		fillUp = &domain.FillUp{Latitude: fs.Latitude, Longitude: fs.Longitude}
+	}
+	e, err := s.expenses.QuickLog(ctx, req.TripId, category, req.Body.Amount, derefString(req.Body.Note), fillUp)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.QuickLogExpense404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.QuickLogExpense422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.QuickLogExpense201JSONResponse(expenseToResponse(e)), nil
+}
+
+// ListTripExpenses handles GET /trips/{tripId}/expenses.
+func (s *Server) ListTripExpenses(ctx context.Context, req gen.ListTripExpensesRequestObject) (gen.ListTripExpensesResponseObject, error) {
+	expenses, err := s.expenses.ListByTrip(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripExpenses404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTripExpenses200JSONResponse, len(expenses))
+	for i, e := range expenses {
+		resp[i] = expenseToResponse(e)
+	}
+	return resp, nil
+}
+
+// SplitTripExpense handles PUT /trips/{tripId}/expenses/{expenseId}/split.
+func (s *Server) SplitTripExpense(ctx context.Context, req gen.SplitTripExpenseRequestObject) (gen.SplitTripExpenseResponseObject, error) {
+	if req.Body == nil {
+		return gen.SplitTripExpense422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	split := domain.ExpenseSplit{PaidBy: derefString(req.Body.PaidBy)}
+	if req.Body.SplitAmong != nil {
+		split.SplitAmong = *req.Body.SplitAmong
+	}
+	e, err := s.expenses.SetSplit(ctx, req.TripId, req.ExpenseId, split)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.SplitTripExpense404JSONResponse(notFoundBody("expense not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.SplitTripExpense422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.SplitTripExpense200JSONResponse(expenseToResponse(e)), nil
+}
+
+// GetTripSettlement handles GET /trips/{tripId}/settlement.
+func (s *Server) GetTripSettlement(ctx context.Context, req gen.GetTripSettlementRequestObject) (gen.GetTripSettlementResponseObject, error) {
+	settlement, err := s.expenses.Settlement(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripSettlement404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := gen.Settlement{
+		TripId:    req.TripId,
+		Total:     settlement.Total,
+		Balances:  make([]gen.SettlementBalance, len(settlement.Balances)),
+		Transfers: make([]gen.SettlementTransfer, len(settlement.Transfers)),
+	}
+	for i, b := range settlement.Balances {
+		resp.Balances[i] = gen.SettlementBalance{Name: b.Name, Paid: b.Paid, Owed: b.Owed, Net: b.Net}
+	}
+	for i, t := range settlement.Transfers {
+		resp.Transfers[i] = gen.SettlementTransfer{From: t.From, To: t.To, Amount: t.Amount}
+	}
+	return gen.GetTripSettlement200JSONResponse(resp), nil
+}
+
+// DeleteTripExpense handles DELETE /trips/{tripId}/expenses/{expenseId}.
+func (s *Server) DeleteTripExpense(ctx context.Context, req gen.DeleteTripExpenseRequestObject) (gen.DeleteTripExpenseResponseObject, error) {
+	if err := s.expenses.Delete(ctx, req.TripId, req.ExpenseId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteTripExpense404JSONResponse(notFoundBody("expense not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteTripExpense204Response{}, nil
+}
+
+// expenseToResponse converts a domain.Expense into the generated type.
+func expenseToResponse(e domain.Expense) gen.Expense {
+	return gen.Expense{
+		Id:         e.ID,
+		TripId:     e.TripID,
+		StopId:     e.StopID,
+		Category:   gen.ExpenseCategory(e.Category),
+		Amount:     e.Amount,
+		Note:       nilIfEmpty(e.Note),
+		Location:   nilIfEmpty(e.Location),
+		PaidBy:     nilIfEmpty(e.Split.PaidBy),
+		SplitAmong: splitAmongToResponse(e.Split.SplitAmong),
+		SpentAt:    e.SpentAt,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+// splitAmongToResponse returns names, never nil, so split_among is always
+// an array.
+func splitAmongToResponse(names []string) []string {
+	if names == nil {
+		return []string{}
+	}
+	return names
+}
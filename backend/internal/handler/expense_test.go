@@ -0,0 +1,307 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock ExpenseServicer --------------------------------------------------
+
+type mockExpenseServicer struct {
+	quickLog   func(ctx context.Context, tripID uuid.UUID, category domain.ExpenseCategory, amount float64, note string, fillUp *domain.FillUp) (domain.Expense, error)
+	listByTrip func(ctx context.Context, tripID uuid.UUID) ([]domain.Expense, error)
+	setSplit   func(ctx context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error)
+	settlement func(ctx context.Context, tripID uuid.UUID) (domain.Settlement, error)
+	delete     func(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+func (m *mockExpenseServicer) QuickLog(ctx context.Context, tripID uuid.UUID, category domain.ExpenseCategory, amount float64, note string, fillUp *domain.FillUp) (domain.Expense, error) {
+	return m.quickLog(ctx, tripID, category, amount, note, fillUp)
+}
+func (m *mockExpenseServicer) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.Expense, error) {
+	return m.listByTrip(ctx, tripID)
+}
+func (m *mockExpenseServicer) SetSplit(ctx context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error) {
+	return m.setSplit(ctx, tripID, id, split)
+}
+func (m *mockExpenseServicer) Settlement(ctx context.Context, tripID uuid.UUID) (domain.Settlement, error) {
+	return m.settlement(ctx, tripID)
+}
+func (m *mockExpenseServicer) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	return m.delete(ctx, tripID, id)
+}
+
+// compile-time check: mockExpenseServicer must satisfy handler.ExpenseServicer.
+var _ handler.ExpenseServicer = (*mockExpenseServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newExpenseHTTPHandler wires a Server with only the expense service mock.
+func newExpenseHTTPHandler(t *testing.T, svc handler.ExpenseServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Expenses: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- POST /trips/{tripId}/quicklog -----------------------------------------
+
+func TestQuickLogExpense_201(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	var (
+		gotTrip     uuid.UUID
+		gotCategory domain.ExpenseCategory
+		gotAmount   float64
+		gotNote     string
+		gotFillUp   *domain.FillUp
+	)
+	svc := &mockExpenseServicer{
+		quickLog: func(_ context.Context, tid uuid.UUID, category domain.ExpenseCategory, amount float64, note string, fillUp *domain.FillUp) (domain.Expense, error) {
+			gotTrip, gotCategory, gotAmount, gotNote, gotFillUp = tid, category, amount, note, fillUp
+			now := time.Now().UTC()
+			return domain.Expense{
+				ID: uuid.New(), TripID: tid, StopID: &stopID, Category: category, Amount: amount,
+				Note: note, Location: "Sausalito, CA", SpentAt: now, CreatedAt: now,
+			}, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"type": "toll", "amount": 8.75, "note": "Golden Gate Bridge"})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/quicklog", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, tripID, gotTrip)
+	assert.Equal(t, domain.ExpenseToll, gotCategory)
+	assert.InDelta(t, 8.75, gotAmount, 0.001)
+	assert.Equal(t, "Golden Gate Bridge", gotNote)
+	assert.Nil(t, gotFillUp)
+	var resp gen.Expense
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, gen.ExpenseCategory("toll"), resp.Category)
+	require.NotNil(t, resp.StopId)
+	assert.Equal(t, stopID, *resp.StopId)
+	require.NotNil(t, resp.Location)
+	assert.Equal(t, "Sausalito, CA", *resp.Location)
+}
+
+func TestQuickLogExpense_FuelStop(t *testing.T) {
+	var gotFillUp *domain.FillUp
+	svc := &mockExpenseServicer{
+		quickLog: func(_ context.Context, tid uuid.UUID, category domain.ExpenseCategory, amount float64, _ string, fillUp *domain.FillUp) (domain.Expense, error) {
+			gotFillUp = fillUp
+			now := time.Now().UTC()
+			return domain.Expense{ID: uuid.New(), TripID: tid, Category: category, Amount: amount, SpentAt: now, CreatedAt: now}, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"type": "fuel", "amount": 92.40,
+		"fuel_stop": map[string]any{"latitude": 41.7558, "longitude": -124.2026},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/quicklog", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, gotFillUp)
+	assert.Equal(t, domain.FillUp{Latitude: 41.7558, Longitude: -124.2026}, *gotFillUp)
+}
+
+func TestQuickLogExpense_404(t *testing.T) {
+	svc := &mockExpenseServicer{
+		quickLog: func(_ context.Context, _ uuid.UUID, _ domain.ExpenseCategory, _ float64, _ string, _ *domain.FillUp) (domain.Expense, error) {
+			return domain.Expense{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"type": "parking", "amount": 10})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/quicklog", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestQuickLogExpense_422(t *testing.T) {
+	svc := &mockExpenseServicer{
+		quickLog: func(_ context.Context, _ uuid.UUID, _ domain.ExpenseCategory, _ float64, _ string, _ *domain.FillUp) (domain.Expense, error) {
+			return domain.Expense{}, fmt.Errorf("%w: amount must be positive", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"type": "toll", "amount": 0})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/quicklog", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- /trips/{tripId}/expenses ----------------------------------------------
+
+func TestListTripExpenses_200(t *testing.T) {
+	tripID := uuid.New()
+	svc := &mockExpenseServicer{
+		listByTrip: func(_ context.Context, tid uuid.UUID) ([]domain.Expense, error) {
+			now := time.Now().UTC()
+			return []domain.Expense{
+				{ID: uuid.New(), TripID: tid, Category: domain.ExpenseToll, Amount: 6.5, SpentAt: now, CreatedAt: now},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/expenses", nil)
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.Expense
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Nil(t, resp[0].StopId)
+	assert.Equal(t, tripID, resp[0].TripId)
+}
+
+func TestDeleteTripExpense_404(t *testing.T) {
+	svc := &mockExpenseServicer{
+		delete: func(_ context.Context, _, _ uuid.UUID) error {
+			return fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.NewString()+"/expenses/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- PUT /trips/{tripId}/expenses/{expenseId}/split ------------------------
+
+func TestSplitTripExpense_200(t *testing.T) {
+	tripID, expenseID := uuid.New(), uuid.New()
+	var got domain.ExpenseSplit
+	svc := &mockExpenseServicer{
+		setSplit: func(_ context.Context, tid, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error) {
+			got = split
+			now := time.Now().UTC()
+			return domain.Expense{
+				ID: id, TripID: tid, Category: domain.ExpenseFuel, Amount: 90,
+				Split: split, SpentAt: now, CreatedAt: now,
+			}, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"paid_by": "Ana", "split_among": []string{"Ana", "Ben", "Cy"}})
+	req := httptest.NewRequest(http.MethodPut, "/trips/"+tripID.String()+"/expenses/"+expenseID.String()+"/split", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, domain.ExpenseSplit{PaidBy: "Ana", SplitAmong: []string{"Ana", "Ben", "Cy"}}, got)
+	var resp gen.Expense
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.PaidBy)
+	assert.Equal(t, "Ana", *resp.PaidBy)
+	assert.Equal(t, []string{"Ana", "Ben", "Cy"}, resp.SplitAmong)
+}
+
+func TestSplitTripExpense_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{"unknown expense", fmt.Errorf("svc: %w", domain.ErrNotFound), http.StatusNotFound},
+		{"no payer", fmt.Errorf("%w: paid_by is required to split an expense", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &mockExpenseServicer{
+				setSplit: func(context.Context, uuid.UUID, uuid.UUID, domain.ExpenseSplit) (domain.Expense, error) {
+					return domain.Expense{}, tc.err
+				},
+			}
+
+			body := jsonBody(t, map[string]any{"split_among": []string{"Ana"}})
+			req := httptest.NewRequest(http.MethodPut, "/trips/"+uuid.NewString()+"/expenses/"+uuid.NewString()+"/split", body)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			newExpenseHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+		})
+	}
+}
+
+// ---- GET /trips/{tripId}/settlement ----------------------------------------
+
+func TestGetTripSettlement_200(t *testing.T) {
+	tripID := uuid.New()
+	svc := &mockExpenseServicer{
+		settlement: func(context.Context, uuid.UUID) (domain.Settlement, error) {
+			return domain.Settlement{
+				Total: 90,
+				Balances: []domain.Balance{
+					{Name: "Ana", Paid: 90, Owed: 45, Net: 45},
+					{Name: "Ben", Paid: 0, Owed: 45, Net: -45},
+				},
+				Transfers: []domain.Transfer{{From: "Ben", To: "Ana", Amount: 45}},
+			}, nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/settlement", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{
+		"trip_id": "`+tripID.String()+`",
+		"total": 90,
+		"balances": [
+			{"name": "Ana", "paid": 90, "owed": 45, "net": 45},
+			{"name": "Ben", "paid": 0, "owed": 45, "net": -45}
+		],
+		"transfers": [{"from": "Ben", "to": "Ana", "amount": 45}]
+	}`, rec.Body.String())
+}
+
+func TestGetTripSettlement_404(t *testing.T) {
+	svc := &mockExpenseServicer{
+		settlement: func(context.Context, uuid.UUID) (domain.Settlement, error) {
+			return domain.Settlement{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newExpenseHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/settlement", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
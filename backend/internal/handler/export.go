@@ -1,93 +1,437 @@
-// Package handler — export.go implements GET /export.
-// Returns all trips, stops, and tags as a flat table.
-// Supports content negotiation via ?format=csv (CSV) or default (JSON).
+// Package handler — export.go implements GET /export and GET /export/deletions.
+// Returns all trips, stops, and tags as a flat table, in whichever of the
+// registered export formats the client asks for with ?format or Accept, or
+// the stops as GPX waypoints, and the trips and stops since deleted for good.
 package handler
 
 import (
-	"bytes"
+	"bufio"
 	"context"
 	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
 )
 
-// csvHeaders defines the column names written as the first row of any CSV export.
-var csvHeaders = []string{
-	"trip_id", "trip_name", "trip_start_date", "trip_end_date",
-	"stop_name", "stop_location", "arrived_at", "departed_at",
-	"stop_notes", "tags",
+// exportEncoder writes a stream of export rows in one format.
+type exportEncoder interface {
+	// Encode writes one row.
+	Encode(row domain.ExportRow) error
+	// Close writes whatever follows the last row and flushes.
+	Close() error
+}
+
+// exportFormat is an output format GET /export can produce.
+type exportFormat struct {
+	// name is the ?format value that selects it.
+	name gen.GetExportParamsFormat
+	// mediaType is matched against Accept and sent as the Content-Type.
+	mediaType string
+	// newEncoder starts an export written to w.
+	newEncoder func(w io.Writer, opts exportOptions) (exportEncoder, error)
+}
+
+// exportOptions are the request's choices an encoder writes rows with.
+type exportOptions struct {
+	// columns are the CSV columns, in order.
+	columns []exportColumn
+	units   units
+}
+
+// exportFormats lists the formats GET /export produces. The first is the
+// default, and wins when the client likes several equally. A new format is
+// an entry here and a value in the spec's format enum.
+var exportFormats = []exportFormat{
+	{name: gen.GetExportParamsFormatJson, mediaType: "application/json", newEncoder: newJSONExportEncoder},
+	{name: gen.GetExportParamsFormatCsv, mediaType: "text/csv", newEncoder: newCSVExportEncoder},
+	{name: gen.GetExportParamsFormatGpx, mediaType: "application/gpx+xml", newEncoder: newGPXExportEncoder},
 }
 
 // GetExport implements GET /export.
 // It returns a flat table of every trip, stop, and tag combination.
-// Use ?format=csv to receive CSV; default is JSON.
+// ?format picks the format; without it the Accept header does, and JSON is
+// the default. ?columns picks the CSV columns.
 func (s *Server) GetExport(ctx context.Context, req gen.GetExportRequestObject) (gen.GetExportResponseObject, error) {
-	rows, err := s.export.Export(ctx)
+	columns, err := parseExportColumns(derefString(req.Params.Columns))
+	if err != nil {
+		return gen.GetExport422JSONResponse(requestBody(err.Error())), nil
+	}
+	return exportResponse{
+		ctx:    ctx,
+		export: s.export,
+		format: negotiateExportFormat(req.Params.Format, derefString(req.Params.Accept)),
+		opts:   exportOptions{columns: columns, units: unitsFor(req.Params.Units)},
+	}, nil
+}
+
+// ListExportDeletions implements GET /export/deletions.
+// Without ?since it lists every deletion.
+func (s *Server) ListExportDeletions(ctx context.Context, req gen.ListExportDeletionsRequestObject) (gen.ListExportDeletionsResponseObject, error) {
+	var since time.Time
+	if req.Params.Since != nil {
+		since = *req.Params.Since
+	}
+	tombstones, err := s.export.Deletions(ctx, since)
 	if err != nil {
 		return nil, err
 	}
 
-	wantCSV := req.Params.Format != nil && *req.Params.Format == gen.Csv
-	if wantCSV {
-		return buildCSVResponse(rows), nil
+	resp := make(gen.ListExportDeletions200JSONResponse, len(tombstones))
+	for i, t := range tombstones {
+		resp[i] = gen.Tombstone{
+			Kind:      gen.TombstoneKind(t.Kind),
+			Id:        t.ID,
+			DeletedAt: t.DeletedAt,
+		}
+	}
+	return resp, nil
+}
+
+// parseExportColumns resolves a ?columns list of names. An empty list is
+// defaultExportColumns.
+func parseExportColumns(list string) ([]exportColumn, error) {
+	if strings.TrimSpace(list) == "" {
+		return exportColumns[:defaultExportColumns], nil
+	}
+	var columns []exportColumn
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		i := slices.IndexFunc(exportColumns, func(c exportColumn) bool { return c.name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("columns: unknown column %q", name)
+		}
+		columns = append(columns, exportColumns[i])
+	}
+	return columns, nil
+}
+
+// negotiateExportFormat picks the export format for a request. An explicit
+// ?format wins. Otherwise each format is given the quality of the most
+// specific Accept range that matches its media type, and the best wins;
+// a malformed range is ignored.
+func negotiateExportFormat(format *gen.GetExportParamsFormat, accept string) exportFormat {
+	if format != nil {
+		for _, f := range exportFormats {
+			if f.name == *format {
+				return f
+			}
+		}
+	}
+
+	type mediaRange struct {
+		typ, subtype string
+		q            float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		typ, subtype, ok := strings.Cut(mediaType, "/")
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, mediaRange{typ: typ, subtype: subtype, q: q})
+	}
+
+	best, bestQ := exportFormats[0], 0.0
+	for _, f := range exportFormats {
+		typ, subtype, _ := strings.Cut(f.mediaType, "/")
+		// specificity: 3 for type/subtype, 2 for type/*, 1 for */*.
+		q, specificity := 0.0, 0
+		for _, r := range ranges {
+			var s int
+			switch {
+			case r.typ == typ && r.subtype == subtype:
+				s = 3
+			case r.typ == typ && r.subtype == "*":
+				s = 2
+			case r.typ == "*" && r.subtype == "*":
+				s = 1
+			default:
+				continue
+			}
+			if s > specificity {
+				q, specificity = r.q, s
+			}
+		}
+		if q > bestQ {
+			best, bestQ = f, q
+		}
 	}
-	return buildJSONResponse(rows), nil
+	return best
 }
 
-// buildJSONResponse converts domain rows to the typed JSON response.
-func buildJSONResponse(rows []domain.ExportRow) gen.GetExport200JSONResponse {
-	out := make(gen.GetExport200JSONResponse, 0, len(rows))
-	for _, r := range rows {
-		out = append(out, domainRowToGenRow(r))
+// exportResponse streams the export from the database straight to the
+// client, so memory use does not grow with the logbook. The export runs when
+// the response is written, after GetExport has returned, so it keeps the
+// request context to cancel the query with.
+type exportResponse struct {
+	ctx    context.Context
+	export ExportServicer
+	format exportFormat
+	opts   exportOptions
+}
+
+// VisitGetExportResponse writes the export. The encoder's buffer blocks on a
+// slow client, which stops rows being read until it catches up.
+//
+// An error before the first buffer is flushed is returned and becomes a 500.
+// After that the 200 is already on the wire, so the connection is aborted
+// instead, and the client sees a failed download rather than a short file.
+func (r exportResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", r.format.mediaType)
+	w.Header().Add("Vary", "Accept")
+	out := newExportWriter(w)
+
+	enc, err := r.format.newEncoder(out, r.opts)
+	if err == nil {
+		err = r.export.Stream(r.ctx, enc.Encode)
 	}
-	return out
+	if err == nil {
+		err = enc.Close()
+	}
+	if err != nil && out.sent {
+		panic(http.ErrAbortHandler)
+	}
+	return err
+}
+
+// jsonExportEncoder writes the rows as a JSON array of gen.ExportRow.
+type jsonExportEncoder struct {
+	w     *bufio.Writer
+	units units
+	rows  int
 }
 
-// buildCSVResponse encodes domain rows as CSV and wraps in the streaming response type.
-// Tags within a row are pipe-separated ("|") to keep each stop on a single CSV line.
-func buildCSVResponse(rows []domain.ExportRow) gen.GetExport200TextcsvResponse {
-	var buf bytes.Buffer
-	w := csv.NewWriter(&buf)
+func newJSONExportEncoder(w io.Writer, opts exportOptions) (exportEncoder, error) {
+	return &jsonExportEncoder{w: bufio.NewWriter(w), units: opts.units}, nil
+}
 
-	//nolint:errcheck — bytes.Buffer.Write never returns an error.
-	w.Write(csvHeaders)
-	for _, r := range rows {
-		//nolint:errcheck
-		w.Write(domainRowToCSVRecord(r))
+func (e *jsonExportEncoder) Encode(row domain.ExportRow) error {
+	b, err := json.Marshal(domainRowToGenRow(row, e.units))
+	if err != nil {
+		return err
 	}
-	w.Flush()
+	sep := byte(',')
+	if e.rows == 0 {
+		sep = '['
+	}
+	e.rows++
+	if err := e.w.WriteByte(sep); err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
 
-	return gen.GetExport200TextcsvResponse{
-		Body:          &buf,
-		ContentLength: int64(buf.Len()),
+func (e *jsonExportEncoder) Close() error {
+	end := "]\n"
+	if e.rows == 0 {
+		end = "[]\n"
 	}
+	if _, err := e.w.WriteString(end); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+// exportColumn is a CSV column: its header, which ?columns names it by, and
+// how a row's value is written in it.
+type exportColumn struct {
+	name  string
+	value func(r domain.ExportRow, u units) string
 }
 
-// domainRowToGenRow maps a domain.ExportRow to the generated gen.ExportRow type.
-// Fields that are empty strings become nil pointers (omitempty in JSON).
-func domainRowToGenRow(r domain.ExportRow) gen.ExportRow {
+// exportColumns lists every CSV column. The first defaultExportColumns are
+// written when ?columns is not given, as they were before the rest were
+// added. Lists within a row are pipe-separated ("|") to keep each stop on a
+// single CSV line; nil times, dates, and numbers are empty strings.
+var exportColumns = []exportColumn{
+	{"trip_id", func(r domain.ExportRow, _ units) string { return r.TripID }},
+	{"trip_name", func(r domain.ExportRow, _ units) string { return r.TripName }},
+	{"trip_start_date", func(r domain.ExportRow, _ units) string { return r.TripStartDate.String() }},
+	{"trip_end_date", func(r domain.ExportRow, _ units) string { return formatOptionalDate(r.TripEndDate) }},
+	{"stop_name", func(r domain.ExportRow, _ units) string { return r.StopName }},
+	{"stop_location", func(r domain.ExportRow, _ units) string { return r.StopLocation }},
+	{"arrived_at", func(r domain.ExportRow, _ units) string { return formatOptionalTime(r.ArrivedAt) }},
+	{"departed_at", func(r domain.ExportRow, _ units) string { return formatOptionalTime(r.DepartedAt) }},
+	{"stop_notes", func(r domain.ExportRow, _ units) string { return r.StopNotes }},
+	{"tags", func(r domain.ExportRow, _ units) string { return strings.Join(r.Tags, "|") }},
+	{"latitude", func(r domain.ExportRow, _ units) string { return formatOptionalFloat(r.Latitude, -1) }},
+	{"longitude", func(r domain.ExportRow, _ units) string { return formatOptionalFloat(r.Longitude, -1) }},
+	{"state", func(r domain.ExportRow, _ units) string { return r.State }},
+	{"distance", func(r domain.ExportRow, u units) string {
+		return formatOptionalFloat(u.distancePtr(r.DistanceMiles), -1)
+	}},
+	{"cost", func(r domain.ExportRow, _ units) string { return formatOptionalFloat(r.Cost, 2) }},
+	{"categories", func(r domain.ExportRow, _ units) string { return strings.Join(r.ExpenseCategories, "|") }},
+}
+
+// defaultExportColumns is how many of exportColumns a CSV export has when
+// ?columns does not choose.
+const defaultExportColumns = 10
+
+// csvExportEncoder writes the rows as CSV, one column per exportColumn
+// chosen, under a header row of their names.
+type csvExportEncoder struct {
+	w       *csv.Writer
+	columns []exportColumn
+	units   units
+}
+
+func newCSVExportEncoder(w io.Writer, opts exportOptions) (exportEncoder, error) {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(opts.columns))
+	for i, c := range opts.columns {
+		header[i] = c.name
+	}
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+	return csvExportEncoder{w: cw, columns: opts.columns, units: opts.units}, nil
+}
+
+func (e csvExportEncoder) Encode(row domain.ExportRow) error {
+	record := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		record[i] = c.value(row, e.units)
+	}
+	return e.w.Write(record)
+}
+
+func (e csvExportEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// gpxExportEncoder writes the stops that have coordinates as GPX 1.1
+// waypoints, which Garmin devices and apps such as Gaia GPS open as a list
+// of places. Rows without coordinates, including trips with no stops, are
+// left out.
+type gpxExportEncoder struct {
+	w   *bufio.Writer
+	enc *xml.Encoder
+}
+
+// gpxWaypoint is a GPX <wpt>. Its children must come in the schema's order.
+// Coordinates are strings so they are never written in exponent form,
+// which GPX's decimal type does not allow.
+type gpxWaypoint struct {
+	XMLName xml.Name `xml:"wpt"`
+	Lat     string   `xml:"lat,attr"`
+	Lon     string   `xml:"lon,attr"`
+	Time    string   `xml:"time,omitempty"`
+	Name    string   `xml:"name,omitempty"`
+	Comment string   `xml:"cmt,omitempty"`
+	Desc    string   `xml:"desc,omitempty"`
+}
+
+func newGPXExportEncoder(w io.Writer, _ exportOptions) (exportEncoder, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(xml.Header + `<gpx version="1.1" creator="rv-logbook" xmlns="http://www.topografix.com/GPX/1/1">` + "\n"); err != nil {
+		return nil, err
+	}
+	return &gpxExportEncoder{w: bw, enc: xml.NewEncoder(bw)}, nil
+}
+
+// Encode writes the row's stop as a waypoint named after it, timed at its
+// arrival, with the trip as its comment and its location as its
+// description.
+func (e *gpxExportEncoder) Encode(row domain.ExportRow) error {
+	if row.Latitude == nil || row.Longitude == nil {
+		return nil
+	}
+	wpt := gpxWaypoint{
+		Lat:     strconv.FormatFloat(*row.Latitude, 'f', -1, 64),
+		Lon:     strconv.FormatFloat(*row.Longitude, 'f', -1, 64),
+		Name:    row.StopName,
+		Comment: row.TripName,
+		Desc:    row.StopLocation,
+	}
+	if row.ArrivedAt != nil {
+		wpt.Time = row.ArrivedAt.UTC().Format(time.RFC3339)
+	}
+	if err := e.enc.Encode(wpt); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+func (e *gpxExportEncoder) Close() error {
+	if _, err := e.w.WriteString("</gpx>\n"); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+// exportWriteTimeout bounds each write of a streamed export. It stands in for
+// the server's WriteTimeout, which covers the whole response and would cut
+// off a large export part way through.
+const exportWriteTimeout = 30 * time.Second
+
+// exportWriter passes writes through to the client, pushing the write
+// deadline back before each one, and records whether anything was sent.
+type exportWriter struct {
+	w    http.ResponseWriter
+	rc   *http.ResponseController
+	sent bool
+}
+
+func newExportWriter(w http.ResponseWriter) *exportWriter {
+	return &exportWriter{w: w, rc: http.NewResponseController(w)}
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	e.sent = true
+	// Writers without deadline support (httptest's) keep the server's.
+	_ = e.rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+	return e.w.Write(p)
+}
+
+// domainRowToGenRow maps a domain.ExportRow to the generated gen.ExportRow type,
+// giving its distance in u. Fields that are empty strings become nil pointers
+// (omitempty in JSON).
+func domainRowToGenRow(r domain.ExportRow, u units) gen.ExportRow {
 	tripID, _ := uuid.Parse(r.TripID)
 
-	startDate := mustParseDate(r.TripStartDate)
 	row := gen.ExportRow{
 		TripId:        tripID,
 		TripName:      r.TripName,
-		TripStartDate: startDate,
+		TripStartDate: dateToAPI(r.TripStartDate),
+		TripEndDate:   dateToAPIPtr(r.TripEndDate),
 		ArrivedAt:     r.ArrivedAt,
 		DepartedAt:    r.DepartedAt,
 		Tags:          r.Tags,
+		Latitude:      r.Latitude,
+		Longitude:     r.Longitude,
+		State:         nilIfEmpty(r.State),
+		Distance:      u.distancePtr(r.DistanceMiles),
+		Cost:          r.Cost,
 	}
-
-	if r.TripEndDate != "" {
-		d := mustParseDate(r.TripEndDate)
-		row.TripEndDate = &d
+	if len(r.ExpenseCategories) > 0 {
+		row.Categories = &r.ExpenseCategories
 	}
+
 	if r.StopName != "" {
 		row.StopName = &r.StopName
 	}
@@ -100,36 +444,6 @@ func domainRowToGenRow(r domain.ExportRow) gen.ExportRow {
 	return row
 }
 
-// domainRowToCSVRecord encodes a domain.ExportRow as a flat string slice.
-// Nil time pointers are encoded as empty strings.
-// Tags are joined with "|".
-func domainRowToCSVRecord(r domain.ExportRow) []string {
-	arrivedAt := formatOptionalTime(r.ArrivedAt)
-	departedAt := formatOptionalTime(r.DepartedAt)
-	return []string{
-		r.TripID,
-		r.TripName,
-		r.TripStartDate,
-		r.TripEndDate,
-		r.StopName,
-		r.StopLocation,
-		arrivedAt,
-		departedAt,
-		r.StopNotes,
-		strings.Join(r.Tags, "|"),
-	}
-}
-
-// mustParseDate parses an "2006-01-02" string into an openapi_types.Date.
-// Panics on malformed input; callers are expected to pass service-generated dates.
-func mustParseDate(s string) openapi_types.Date {
-	t, err := time.Parse("2006-01-02", s)
-	if err != nil {
-		panic("handler: malformed date from service: " + s)
-	}
-	return openapi_types.Date{Time: t}
-}
-
 // formatOptionalTime returns the RFC3339 representation of t, or "" if t is nil.
 func formatOptionalTime(t *time.Time) string {
 	if t == nil {
@@ -137,3 +451,20 @@ func formatOptionalTime(t *time.Time) string {
 	}
 	return t.UTC().Format(time.RFC3339)
 }
+
+// formatOptionalDate returns d as YYYY-MM-DD, or "" if d is nil.
+func formatOptionalDate(d *domain.Date) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+// formatOptionalFloat returns f with prec decimal places (-1 for as few as
+// needed), or "" if f is nil.
+func formatOptionalFloat(f *float64, prec int) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', prec, 64)
+}
@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/testutil/golden"
+)
+
+// goldenExportRows is a fixed export covering the awkward cases for each
+// format: a trip with no stops, an open-ended trip, a stop still occupied
+// (no departure), and text that needs CSV quoting.
+func goldenExportRows() []domain.ExportRow {
+	at := func(s string) *time.Time {
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			panic(err)
+		}
+		return &ts
+	}
+	pacificEnd := domain.NewDate(2024, 6, 30)
+	return []domain.ExportRow{
+		{
+			TripID: "0b6c1d2e-3f40-4a51-8b62-7c8d9e0f1a2b", TripName: "Pacific Coast Tour",
+			TripStartDate: domain.NewDate(2024, 6, 15), TripEndDate: &pacificEnd,
+			StopName: "Big Sur Campground", StopLocation: "Big Sur, CA",
+			ArrivedAt: at("2024-06-15T17:00:00Z"), DepartedAt: at("2024-06-18T15:30:00Z"),
+			StopNotes: `Site 12, "ocean view", no hookups`, Tags: []string{"ocean", "dry-camping"},
+		},
+		{
+			TripID: "0b6c1d2e-3f40-4a51-8b62-7c8d9e0f1a2b", TripName: "Pacific Coast Tour",
+			TripStartDate: domain.NewDate(2024, 6, 15), TripEndDate: &pacificEnd,
+			StopName: "Harris Beach State Park", StopLocation: "Brookings, OR",
+			ArrivedAt: at("2024-06-18T22:00:00Z"), DepartedAt: at("2024-06-21T16:00:00Z"),
+			StopNotes: "Multi-line note:\nwatch the low branches", Tags: []string{},
+		},
+		{
+			TripID: "5e4d3c2b-1a09-4f8e-9d7c-6b5a4f3e2d1c", TripName: "Desert Southwest",
+			TripStartDate: domain.NewDate(2025, 1, 10),
+			StopName:      "Lone Rock Beach", StopLocation: "Big Water, UT",
+			ArrivedAt: at("2025-01-10T21:15:00Z"), DepartedAt: nil,
+			StopNotes: "", Tags: []string{"boondocking"},
+		},
+		{
+			TripID: "9f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a", TripName: "Planned, not started",
+			TripStartDate: domain.NewDate(2025, 9, 1),
+			Tags:          []string{},
+		},
+	}
+}
+
+// TestGetExport_Golden pins each export format byte-for-byte. After an
+// intended format change run:
+//
+//	go test ./internal/handler -run TestGetExport_Golden -update
+func TestGetExport_Golden(t *testing.T) {
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			return goldenExportRows(), nil
+		},
+	}
+
+	for _, tc := range []struct{ name, query string }{
+		{"export.csv", "?format=csv"},
+		{"export.json", "?format=json"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/export"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			golden.Assert(t, tc.name, rec.Body.Bytes())
+		})
+	}
+}
+
+// TestGetExport_GoldenGPX pins the GPX export of goldenExportRows with
+// coordinates on the stops that have them in life; GPX leaves out the rest.
+func TestGetExport_GoldenGPX(t *testing.T) {
+	rows := goldenExportRows()
+	for i, c := range [][2]float64{{36.2704, -121.8081}, {42.0726, -124.3067}, {37.0131, -111.5428}} {
+		lat, lon := c[0], c[1]
+		rows[i].Latitude, rows[i].Longitude = &lat, &lon
+	}
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) { return rows, nil },
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export?format=gpx", nil)
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	golden.Assert(t, "export.gpx", rec.Body.Bytes())
+}
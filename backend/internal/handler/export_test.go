@@ -17,39 +17,65 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
 // ---- mock ExportServicer ---------------------------------------------------
 
 type mockExportServicer struct {
-	export func(ctx context.Context) ([]domain.ExportRow, error)
+	export    func(ctx context.Context) ([]domain.ExportRow, error)
+	stream    func(ctx context.Context, fn func(domain.ExportRow) error) error
+	deletions func(ctx context.Context, since time.Time) ([]domain.Tombstone, error)
 }
 
 func (m *mockExportServicer) Export(ctx context.Context) ([]domain.ExportRow, error) {
 	return m.export(ctx)
 }
 
+// Stream calls m.stream if set, and otherwise hands the rows from m.export
+// to fn one at a time.
+func (m *mockExportServicer) Stream(ctx context.Context, fn func(domain.ExportRow) error) error {
+	if m.stream != nil {
+		return m.stream(ctx, fn)
+	}
+	rows, err := m.export(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockExportServicer) Deletions(ctx context.Context, since time.Time) ([]domain.Tombstone, error) {
+	return m.deletions(ctx, since)
+}
+
 // compile-time check: mockExportServicer must satisfy handler.ExportServicer.
 var _ handler.ExportServicer = (*mockExportServicer)(nil)
 
 // ---- helpers ---------------------------------------------------------------
 
 // newExportHTTPHandler wires a Server with only the export service mock.
-func newExportHTTPHandler(exportSvc handler.ExportServicer) http.Handler {
-	srv := handler.NewServer(nil, nil, nil, exportSvc)
-	return gen.Handler(gen.NewStrictHandler(srv, nil))
+func newExportHTTPHandler(t *testing.T, exportSvc handler.ExportServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Export: exportSvc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
 }
 
 // exportRowFixture returns a fully-populated domain.ExportRow for testing.
 func exportRowFixture() domain.ExportRow {
 	arrivedAt := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
 	departedAt := time.Date(2024, 6, 18, 10, 0, 0, 0, time.UTC)
+	endDate := domain.NewDate(2024, 6, 30)
 
 	return domain.ExportRow{
 		TripID:        uuid.New().String(),
 		TripName:      "Pacific Coast Tour",
-		TripStartDate: "2024-06-15",
-		TripEndDate:   "2024-06-30",
+		TripStartDate: domain.NewDate(2024, 6, 15),
+		TripEndDate:   &endDate,
 		StopName:      "Big Sur Campground",
 		StopLocation:  "Big Sur, CA",
 		ArrivedAt:     &arrivedAt,
@@ -70,7 +96,7 @@ func TestGetExport_DefaultJSON_EmptyResult(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	assert.Contains(t, rec.Header().Get("Content-Type"), "application/json")
@@ -90,7 +116,7 @@ func TestGetExport_FormatJSON_ExplicitParam(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export?format=json", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	assert.Contains(t, rec.Header().Get("Content-Type"), "application/json")
@@ -105,8 +131,7 @@ func TestGetExport_JSON_TripWithNoStops_EmptyStopFields(t *testing.T) {
 	row := domain.ExportRow{
 		TripID:        uuid.New().String(),
 		TripName:      "No Stop Trip",
-		TripStartDate: "2024-07-01",
-		TripEndDate:   "",
+		TripStartDate: domain.NewDate(2024, 7, 1),
 		Tags:          []string{},
 	}
 	svc := &mockExportServicer{
@@ -117,7 +142,7 @@ func TestGetExport_JSON_TripWithNoStops_EmptyStopFields(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 
@@ -141,7 +166,7 @@ func TestGetExport_CSV_FormatParam_ContentType(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	assert.Contains(t, rec.Header().Get("Content-Type"), "text/csv")
@@ -156,7 +181,7 @@ func TestGetExport_CSV_EmptyResult_HasHeaderRow(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	body := rec.Body.String()
@@ -173,7 +198,7 @@ func TestGetExport_CSV_OneRow_HasHeaderAndDataRow(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
@@ -194,12 +219,157 @@ func TestGetExport_CSV_TagsJoinedWithPipe(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	assert.Contains(t, rec.Body.String(), "beach|hiking")
 }
 
+func TestGetExport_CSV_ChosenColumns(t *testing.T) {
+	row := exportRowFixture()
+	lat, lon, miles, cost := 36.2704, -121.8081, 100.0, 46.5
+	row.Latitude, row.Longitude, row.State = &lat, &lon, "California"
+	row.DistanceMiles, row.Cost = &miles, &cost
+	row.ExpenseCategories = []string{"campground", "fuel"}
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			return []domain.ExportRow{row}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export?format=csv&columns=stop_name,state,latitude,longitude,distance,cost,categories", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "stop_name,state,latitude,longitude,distance,cost,categories", lines[0])
+	assert.Equal(t, "Big Sur Campground,California,36.2704,-121.8081,160.93,46.50,campground|fuel", lines[1])
+}
+
+func TestGetExport_CSV_UnknownColumn_Returns422(t *testing.T) {
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			t.Fatal("export must not run")
+			return nil, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export?format=csv&columns=trip_name,price", nil)
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `\"price\"`)
+}
+
+func TestGetExport_JSON_StopFigures(t *testing.T) {
+	row := exportRowFixture()
+	miles, cost := 100.0, 46.5
+	row.State, row.DistanceMiles, row.Cost = "California", &miles, &cost
+	row.ExpenseCategories = []string{"campground"}
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			return []domain.ExportRow{row}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got []gen.ExportRow
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	require.Len(t, got, 1)
+	require.NotNil(t, got[0].Distance)
+	assert.InDelta(t, 160.93, *got[0].Distance, 0.001)
+	require.NotNil(t, got[0].Cost)
+	assert.InDelta(t, 46.5, *got[0].Cost, 0.001)
+	assert.Equal(t, "California", *got[0].State)
+	assert.Equal(t, []string{"campground"}, *got[0].Categories)
+	assert.Nil(t, got[0].Latitude)
+}
+
+// ---- GET /export — Accept negotiation --------------------------------------
+
+func TestGetExport_AcceptNegotiation(t *testing.T) {
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			return []domain.ExportRow{exportRowFixture()}, nil
+		},
+	}
+
+	for _, tc := range []struct{ name, query, accept, want string }{
+		{"csv", "", "text/csv", "text/csv"},
+		{"type wildcard", "", "text/*", "text/csv"},
+		{"any defaults to json", "", "*/*", "application/json"},
+		{"preferred by quality", "", "application/json;q=0.5, text/csv", "text/csv"},
+		{"most specific range sets quality", "", "text/csv;q=0.2, */*", "application/json"},
+		{"unproduced type falls back to json", "", "application/xml", "application/json"},
+		{"format param wins", "?format=json", "text/csv", "application/json"},
+		{"gpx", "", "application/gpx+xml", "application/gpx+xml"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/export"+tc.query, nil)
+			req.Header.Set("Accept", tc.accept)
+			rec := httptest.NewRecorder()
+			newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tc.want, rec.Header().Get("Content-Type"))
+			assert.Equal(t, "Accept", rec.Header().Get("Vary"))
+		})
+	}
+}
+
+// ---- GET /export — GPX -----------------------------------------------------
+
+func TestGetExport_GPX_WaypointPerStopWithCoordinates(t *testing.T) {
+	located := exportRowFixture()
+	lat, lon := 36.2704, -121.8081
+	located.Latitude, located.Longitude = &lat, &lon
+	located.StopName = "Big Sur <Riverside>"
+	planned := exportRowFixture()
+	lat2, lon2 := 0.00001, 0.5
+	planned.Latitude, planned.Longitude, planned.ArrivedAt = &lat2, &lon2, nil
+	planned.StopName, planned.StopLocation = "Next", ""
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			return []domain.ExportRow{located, exportRowFixture(), planned}, nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export?format=gpx", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/gpx+xml", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="rv-logbook" xmlns="http://www.topografix.com/GPX/1/1">
+<wpt lat="36.2704" lon="-121.8081"><time>2024-06-15T12:00:00Z</time><name>Big Sur &lt;Riverside&gt;</name><cmt>Pacific Coast Tour</cmt><desc>Big Sur, CA</desc></wpt>
+<wpt lat="0.00001" lon="0.5"><name>Next</name><cmt>Pacific Coast Tour</cmt></wpt>
+</gpx>
+`, rec.Body.String())
+}
+
+func TestGetExport_GPX_EmptyResult(t *testing.T) {
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) { return nil, nil },
+	}
+
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export?format=gpx", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.HasSuffix(rec.Body.String(), `xmlns="http://www.topografix.com/GPX/1/1">
+</gpx>
+`), rec.Body.String())
+}
+
 // ---- error handling --------------------------------------------------------
 
 func TestGetExport_ServiceError_Returns500(t *testing.T) {
@@ -211,7 +381,89 @@ func TestGetExport_ServiceError_Returns500(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/export", nil)
 	rec := httptest.NewRecorder()
-	newExportHTTPHandler(svc).ServeHTTP(rec, req)
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 }
+
+func TestGetExport_CSV_ServiceError_Returns500(t *testing.T) {
+	svc := &mockExportServicer{
+		export: func(_ context.Context) ([]domain.ExportRow, error) {
+			return nil, fmt.Errorf("database unavailable")
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestGetExport_CSV_FailureAfterFirstRowsAbortsResponse(t *testing.T) {
+	// Enough rows to flush the CSV writer's buffer, so the 200 is already
+	// sent when the export fails.
+	svc := &mockExportServicer{
+		stream: func(_ context.Context, fn func(domain.ExportRow) error) error {
+			for range 200 {
+				if err := fn(exportRowFixture()); err != nil {
+					return err
+				}
+			}
+			return fmt.Errorf("connection reset")
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export?format=csv", nil)
+	rec := httptest.NewRecorder()
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+	})
+}
+
+// ---- GET /export/deletions -------------------------------------------------
+
+func TestListExportDeletions_Since(t *testing.T) {
+	id := uuid.New()
+	purgedAt := time.Date(2025, 8, 2, 3, 0, 0, 0, time.UTC)
+	var gotSince time.Time
+	svc := &mockExportServicer{
+		deletions: func(_ context.Context, since time.Time) ([]domain.Tombstone, error) {
+			gotSince = since
+			return []domain.Tombstone{{Kind: domain.TrashStop, ID: id, DeletedAt: purgedAt}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export/deletions?since=2025-08-01T00:00:00Z", nil)
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, gotSince.Equal(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)))
+
+	var got []gen.Tombstone
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	require.Len(t, got, 1)
+	assert.Equal(t, gen.TombstoneKindStop, got[0].Kind)
+	assert.Equal(t, id, got[0].Id)
+	assert.True(t, got[0].DeletedAt.Equal(purgedAt))
+}
+
+func TestListExportDeletions_NoSinceListsAll(t *testing.T) {
+	var gotSince time.Time
+	svc := &mockExportServicer{
+		deletions: func(_ context.Context, since time.Time) ([]domain.Tombstone, error) {
+			gotSince = since
+			return []domain.Tombstone{}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/export/deletions", nil)
+	rec := httptest.NewRecorder()
+	newExportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, gotSince.IsZero())
+	assert.JSONEq(t, "[]", rec.Body.String())
+}
@@ -6,8 +6,10 @@ package gen
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
 
@@ -17,1790 +19,25279 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Defines values for GetExportParamsFormat.
 const (
-	Csv  GetExportParamsFormat = "csv"
-	Json GetExportParamsFormat = "json"
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Valid indicates whether the value is a known member of the GetExportParamsFormat enum.
-func (e GetExportParamsFormat) Valid() bool {
+// Defines values for ChecklistKind.
+const (
+	ChecklistKindArrival   ChecklistKind = "arrival"
+	ChecklistKindDeparture ChecklistKind = "departure"
+)
+
+// Valid indicates whether the value is a known member of the ChecklistKind enum.
+func (e ChecklistKind) Valid() bool {
 	switch e {
-	case Csv:
+	case ChecklistKindArrival:
 		return true
-	case Json:
+	case ChecklistKindDeparture:
 		return true
 	default:
 		return false
 	}
 }
 
-// AddTagRequest defines model for AddTagRequest.
-type AddTagRequest struct {
-	Name string `json:"name"`
-}
+// Defines values for ChecklistTemplateKind.
+const (
+	ChecklistTemplateKindArrival   ChecklistTemplateKind = "arrival"
+	ChecklistTemplateKindDeparture ChecklistTemplateKind = "departure"
+)
 
-// CreateStopRequest defines model for CreateStopRequest.
-type CreateStopRequest struct {
-	ArrivedAt  time.Time  `json:"arrived_at"`
-	DepartedAt *time.Time `json:"departed_at,omitempty"`
-	Location   *string    `json:"location,omitempty"`
-	Name       string     `json:"name"`
-	Notes      *string    `json:"notes,omitempty"`
+// Valid indicates whether the value is a known member of the ChecklistTemplateKind enum.
+func (e ChecklistTemplateKind) Valid() bool {
+	switch e {
+	case ChecklistTemplateKindArrival:
+		return true
+	case ChecklistTemplateKindDeparture:
+		return true
+	default:
+		return false
+	}
 }
 
-// CreateTagRequest defines model for CreateTagRequest.
-type CreateTagRequest struct {
-	// Name Display name for the tag. Will be normalised to a lowercase hyphenated slug.
-	Name string `json:"name"`
-}
+// Defines values for ChecklistTemplateRequestKind.
+const (
+	Arrival   ChecklistTemplateRequestKind = "arrival"
+	Departure ChecklistTemplateRequestKind = "departure"
+)
 
-// CreateTripRequest defines model for CreateTripRequest.
-type CreateTripRequest struct {
-	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
-	Name      string              `json:"name"`
-	Notes     *string             `json:"notes,omitempty"`
-	StartDate openapi_types.Date  `json:"start_date"`
+// Valid indicates whether the value is a known member of the ChecklistTemplateRequestKind enum.
+func (e ChecklistTemplateRequestKind) Valid() bool {
+	switch e {
+	case Arrival:
+		return true
+	case Departure:
+		return true
+	default:
+		return false
+	}
 }
 
-// ErrorDetail defines model for ErrorDetail.
-type ErrorDetail struct {
-	// Code Machine-readable error code for client branching.
-	Code string `json:"code"`
+// Defines values for ComponentHealthStatus.
+const (
+	Degraded ComponentHealthStatus = "degraded"
+	Down     ComponentHealthStatus = "down"
+	Ok       ComponentHealthStatus = "ok"
+)
 
-	// Message Human-readable description of the error.
-	Message string `json:"message"`
+// Valid indicates whether the value is a known member of the ComponentHealthStatus enum.
+func (e ComponentHealthStatus) Valid() bool {
+	switch e {
+	case Degraded:
+		return true
+	case Down:
+		return true
+	case Ok:
+		return true
+	default:
+		return false
+	}
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error ErrorDetail `json:"error"`
-}
+// Defines values for CreatePropaneFillRequestUnit.
+const (
+	CreatePropaneFillRequestUnitGal CreatePropaneFillRequestUnit = "gal"
+	CreatePropaneFillRequestUnitLb  CreatePropaneFillRequestUnit = "lb"
+)
 
-// ExportRow defines model for ExportRow.
-type ExportRow struct {
-	ArrivedAt     *time.Time          `json:"arrived_at,omitempty"`
-	DepartedAt    *time.Time          `json:"departed_at,omitempty"`
-	StopLocation  *string             `json:"stop_location,omitempty"`
-	StopName      *string             `json:"stop_name,omitempty"`
-	StopNotes     *string             `json:"stop_notes,omitempty"`
-	Tags          []string            `json:"tags"`
-	TripEndDate   *openapi_types.Date `json:"trip_end_date,omitempty"`
-	TripId        openapi_types.UUID  `json:"trip_id"`
-	TripName      string              `json:"trip_name"`
-	TripStartDate openapi_types.Date  `json:"trip_start_date"`
+// Valid indicates whether the value is a known member of the CreatePropaneFillRequestUnit enum.
+func (e CreatePropaneFillRequestUnit) Valid() bool {
+	switch e {
+	case CreatePropaneFillRequestUnitGal:
+		return true
+	case CreatePropaneFillRequestUnitLb:
+		return true
+	default:
+		return false
+	}
 }
 
-// HealthResponse defines model for HealthResponse.
-type HealthResponse struct {
-	Status string `json:"status"`
+// Defines values for CrossingDirection.
+const (
+	Entry CrossingDirection = "entry"
+	Exit  CrossingDirection = "exit"
+)
+
+// Valid indicates whether the value is a known member of the CrossingDirection enum.
+func (e CrossingDirection) Valid() bool {
+	switch e {
+	case Entry:
+		return true
+	case Exit:
+		return true
+	default:
+		return false
+	}
 }
 
-// Pagination Pagination metadata returned with every list response.
-type Pagination struct {
-	Limit int `json:"limit"`
-	Page  int `json:"page"`
+// Defines values for CustomFieldEntity.
+const (
+	CustomFieldEntityStop CustomFieldEntity = "stop"
+	CustomFieldEntityTrip CustomFieldEntity = "trip"
+)
 
-	// Total Total number of items matching the query (across all pages).
-	Total int `json:"total"`
+// Valid indicates whether the value is a known member of the CustomFieldEntity enum.
+func (e CustomFieldEntity) Valid() bool {
+	switch e {
+	case CustomFieldEntityStop:
+		return true
+	case CustomFieldEntityTrip:
+		return true
+	default:
+		return false
+	}
 }
 
-// PatchTagRequest defines model for PatchTagRequest.
-type PatchTagRequest struct {
-	Name string `json:"name"`
+// Defines values for CustomFieldType.
+const (
+	Boolean CustomFieldType = "boolean"
+	Date    CustomFieldType = "date"
+	Number  CustomFieldType = "number"
+	Text    CustomFieldType = "text"
+)
+
+// Valid indicates whether the value is a known member of the CustomFieldType enum.
+func (e CustomFieldType) Valid() bool {
+	switch e {
+	case Boolean:
+		return true
+	case Date:
+		return true
+	case Number:
+		return true
+	case Text:
+		return true
+	default:
+		return false
+	}
 }
 
-// Stop defines model for Stop.
-type Stop struct {
-	ArrivedAt  time.Time          `json:"arrived_at"`
-	CreatedAt  time.Time          `json:"created_at"`
-	DepartedAt *time.Time         `json:"departed_at,omitempty"`
-	Id         openapi_types.UUID `json:"id"`
-	Location   *string            `json:"location,omitempty"`
-	Name       string             `json:"name"`
-	Notes      *string            `json:"notes,omitempty"`
+// Defines values for DriveDaySource.
+const (
+	Entered DriveDaySource = "entered"
+	Routed  DriveDaySource = "routed"
+	Unknown DriveDaySource = "unknown"
+)
 
-	// Tags Tags linked to this stop, ordered by slug.
-	Tags      *[]Tag             `json:"tags,omitempty"`
-	TripId    openapi_types.UUID `json:"trip_id"`
-	UpdatedAt time.Time          `json:"updated_at"`
+// Valid indicates whether the value is a known member of the DriveDaySource enum.
+func (e DriveDaySource) Valid() bool {
+	switch e {
+	case Entered:
+		return true
+	case Routed:
+		return true
+	case Unknown:
+		return true
+	default:
+		return false
+	}
 }
 
-// StopList defines model for StopList.
-type StopList struct {
-	Data []Stop `json:"data"`
+// Defines values for DriveRule.
+const (
+	ArriveBeforeDark DriveRule = "arrive_before_dark"
+	MaxDriveHours    DriveRule = "max_drive_hours"
+	MaxMiles         DriveRule = "max_miles"
+)
 
-	// Pagination Pagination metadata returned with every list response.
-	Pagination Pagination `json:"pagination"`
+// Valid indicates whether the value is a known member of the DriveRule enum.
+func (e DriveRule) Valid() bool {
+	switch e {
+	case ArriveBeforeDark:
+		return true
+	case MaxDriveHours:
+		return true
+	case MaxMiles:
+		return true
+	default:
+		return false
+	}
 }
 
-// Tag defines model for Tag.
-type Tag struct {
-	CreatedAt time.Time          `json:"created_at"`
-	Id        openapi_types.UUID `json:"id"`
-	Name      string             `json:"name"`
-	Slug      string             `json:"slug"`
+// Defines values for ExpenseCategory.
+const (
+	ExpenseCategoryCampground ExpenseCategory = "campground"
+	ExpenseCategoryFood       ExpenseCategory = "food"
+	ExpenseCategoryFuel       ExpenseCategory = "fuel"
+	ExpenseCategoryOther      ExpenseCategory = "other"
+	ExpenseCategoryParking    ExpenseCategory = "parking"
+	ExpenseCategoryToll       ExpenseCategory = "toll"
+)
+
+// Valid indicates whether the value is a known member of the ExpenseCategory enum.
+func (e ExpenseCategory) Valid() bool {
+	switch e {
+	case ExpenseCategoryCampground:
+		return true
+	case ExpenseCategoryFood:
+		return true
+	case ExpenseCategoryFuel:
+		return true
+	case ExpenseCategoryOther:
+		return true
+	case ExpenseCategoryParking:
+		return true
+	case ExpenseCategoryToll:
+		return true
+	default:
+		return false
+	}
 }
 
-// TagList defines model for TagList.
-type TagList struct {
-	Data []Tag `json:"data"`
+// Defines values for FieldChangeEntity.
+const (
+	FieldChangeEntityBorderCrossing FieldChangeEntity = "border_crossing"
+	FieldChangeEntityExpense        FieldChangeEntity = "expense"
+	FieldChangeEntityJournalEntry   FieldChangeEntity = "journal_entry"
+	FieldChangeEntityReservation    FieldChangeEntity = "reservation"
+	FieldChangeEntityStop           FieldChangeEntity = "stop"
+	FieldChangeEntityTrip           FieldChangeEntity = "trip"
+)
 
-	// Pagination Pagination metadata returned with every list response.
-	Pagination Pagination `json:"pagination"`
+// Valid indicates whether the value is a known member of the FieldChangeEntity enum.
+func (e FieldChangeEntity) Valid() bool {
+	switch e {
+	case FieldChangeEntityBorderCrossing:
+		return true
+	case FieldChangeEntityExpense:
+		return true
+	case FieldChangeEntityJournalEntry:
+		return true
+	case FieldChangeEntityReservation:
+		return true
+	case FieldChangeEntityStop:
+		return true
+	case FieldChangeEntityTrip:
+		return true
+	default:
+		return false
+	}
 }
 
-// Trip defines model for Trip.
-type Trip struct {
-	CreatedAt time.Time           `json:"created_at"`
-	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
-	Id        openapi_types.UUID  `json:"id"`
-	Name      string              `json:"name"`
-	Notes     *string             `json:"notes,omitempty"`
-	StartDate openapi_types.Date  `json:"start_date"`
-	UpdatedAt time.Time           `json:"updated_at"`
+// Defines values for GeoJSONFeatureType.
+const (
+	Feature GeoJSONFeatureType = "Feature"
+)
+
+// Valid indicates whether the value is a known member of the GeoJSONFeatureType enum.
+func (e GeoJSONFeatureType) Valid() bool {
+	switch e {
+	case Feature:
+		return true
+	default:
+		return false
+	}
 }
 
-// TripList defines model for TripList.
-type TripList struct {
-	Data []Trip `json:"data"`
+// Defines values for GeoJSONFeatureCollectionType.
+const (
+	FeatureCollection GeoJSONFeatureCollectionType = "FeatureCollection"
+)
 
-	// Pagination Pagination metadata returned with every list response.
-	Pagination Pagination `json:"pagination"`
+// Valid indicates whether the value is a known member of the GeoJSONFeatureCollectionType enum.
+func (e GeoJSONFeatureCollectionType) Valid() bool {
+	switch e {
+	case FeatureCollection:
+		return true
+	default:
+		return false
+	}
 }
 
-// UpdateStopRequest defines model for UpdateStopRequest.
-type UpdateStopRequest struct {
-	ArrivedAt  time.Time  `json:"arrived_at"`
-	DepartedAt *time.Time `json:"departed_at,omitempty"`
-	Location   *string    `json:"location,omitempty"`
-	Name       string     `json:"name"`
-	Notes      *string    `json:"notes,omitempty"`
-}
+// Defines values for GeoJSONGeometryType.
+const (
+	LineString GeoJSONGeometryType = "LineString"
+	Point      GeoJSONGeometryType = "Point"
+)
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
-	Name      string              `json:"name"`
-	Notes     *string             `json:"notes,omitempty"`
-	StartDate openapi_types.Date  `json:"start_date"`
+// Valid indicates whether the value is a known member of the GeoJSONGeometryType enum.
+func (e GeoJSONGeometryType) Valid() bool {
+	switch e {
+	case LineString:
+		return true
+	case Point:
+		return true
+	default:
+		return false
+	}
 }
 
-// GetExportParams defines parameters for GetExport.
-type GetExportParams struct {
-	// Format Response format. Overrides the Accept header when provided.
-	Format *GetExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
-}
+// Defines values for GeoJSONPropertiesKind.
+const (
+	GeoJSONPropertiesKindRoute GeoJSONPropertiesKind = "route"
+	GeoJSONPropertiesKindStop  GeoJSONPropertiesKind = "stop"
+)
 
-// GetExportParamsFormat defines parameters for GetExport.
-type GetExportParamsFormat string
+// Valid indicates whether the value is a known member of the GeoJSONPropertiesKind enum.
+func (e GeoJSONPropertiesKind) Valid() bool {
+	switch e {
+	case GeoJSONPropertiesKindRoute:
+		return true
+	case GeoJSONPropertiesKindStop:
+		return true
+	default:
+		return false
+	}
+}
 
-// ListTagsParams defines parameters for ListTags.
-type ListTagsParams struct {
-	// Q Filter by slug prefix (case-insensitive).
-	Q *string `form:"q,omitempty" json:"q,omitempty"`
+// Defines values for JournalMood.
+const (
+	Awful JournalMood = "awful"
+	Good  JournalMood = "good"
+	Great JournalMood = "great"
+	Okay  JournalMood = "okay"
+	Rough JournalMood = "rough"
+)
 
-	// Page Page number (1-indexed).
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
-
-	// Limit Number of items per page (max 100).
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// Valid indicates whether the value is a known member of the JournalMood enum.
+func (e JournalMood) Valid() bool {
+	switch e {
+	case Awful:
+		return true
+	case Good:
+		return true
+	case Great:
+		return true
+	case Okay:
+		return true
+	case Rough:
+		return true
+	default:
+		return false
+	}
 }
 
-// ListTripsParams defines parameters for ListTrips.
-type ListTripsParams struct {
-	// Page Page number (1-indexed).
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
+// Defines values for JournalWeather.
+const (
+	Cloudy       JournalWeather = "cloudy"
+	Fog          JournalWeather = "fog"
+	PartlyCloudy JournalWeather = "partly_cloudy"
+	Rain         JournalWeather = "rain"
+	Snow         JournalWeather = "snow"
+	Storm        JournalWeather = "storm"
+	Sunny        JournalWeather = "sunny"
+	Wind         JournalWeather = "wind"
+)
 
-	// Limit Number of items per page (max 100).
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// Valid indicates whether the value is a known member of the JournalWeather enum.
+func (e JournalWeather) Valid() bool {
+	switch e {
+	case Cloudy:
+		return true
+	case Fog:
+		return true
+	case PartlyCloudy:
+		return true
+	case Rain:
+		return true
+	case Snow:
+		return true
+	case Storm:
+		return true
+	case Sunny:
+		return true
+	case Wind:
+		return true
+	default:
+		return false
+	}
 }
 
-// ListStopsParams defines parameters for ListStops.
-type ListStopsParams struct {
-	// Page Page number (1-indexed).
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
+// Defines values for MaintenanceStatus.
+const (
+	DueSoon MaintenanceStatus = "due_soon"
+	Overdue MaintenanceStatus = "overdue"
+)
 
-	// Limit Number of items per page (max 100).
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// Valid indicates whether the value is a known member of the MaintenanceStatus enum.
+func (e MaintenanceStatus) Valid() bool {
+	switch e {
+	case DueSoon:
+		return true
+	case Overdue:
+		return true
+	default:
+		return false
+	}
 }
 
-// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
-type CreateTagJSONRequestBody = CreateTagRequest
+// Defines values for NightSpanStatus.
+const (
+	NightSpanStatusGap     NightSpanStatus = "gap"
+	NightSpanStatusOffTrip NightSpanStatus = "off_trip"
+	NightSpanStatusStop    NightSpanStatus = "stop"
+)
 
-// PatchTagJSONRequestBody defines body for PatchTag for application/json ContentType.
-type PatchTagJSONRequestBody = PatchTagRequest
+// Valid indicates whether the value is a known member of the NightSpanStatus enum.
+func (e NightSpanStatus) Valid() bool {
+	switch e {
+	case NightSpanStatusGap:
+		return true
+	case NightSpanStatusOffTrip:
+		return true
+	case NightSpanStatusStop:
+		return true
+	default:
+		return false
+	}
+}
 
-// CreateTripJSONRequestBody defines body for CreateTrip for application/json ContentType.
-type CreateTripJSONRequestBody = CreateTripRequest
+// Defines values for OrganizationRole.
+const (
+	Member OrganizationRole = "member"
+	Owner  OrganizationRole = "owner"
+)
 
-// UpdateTripJSONRequestBody defines body for UpdateTrip for application/json ContentType.
-type UpdateTripJSONRequestBody = UpdateTripRequest
+// Valid indicates whether the value is a known member of the OrganizationRole enum.
+func (e OrganizationRole) Valid() bool {
+	switch e {
+	case Member:
+		return true
+	case Owner:
+		return true
+	default:
+		return false
+	}
+}
 
-// CreateStopJSONRequestBody defines body for CreateStop for application/json ContentType.
-type CreateStopJSONRequestBody = CreateStopRequest
+// Defines values for POICategory.
+const (
+	POICategoryBrewery    POICategory = "brewery"
+	POICategoryLandmark   POICategory = "landmark"
+	POICategoryOther      POICategory = "other"
+	POICategoryRestaurant POICategory = "restaurant"
+	POICategoryViewpoint  POICategory = "viewpoint"
+	POICategoryWildlife   POICategory = "wildlife"
+)
 
-// UpdateStopJSONRequestBody defines body for UpdateStop for application/json ContentType.
-type UpdateStopJSONRequestBody = UpdateStopRequest
+// Valid indicates whether the value is a known member of the POICategory enum.
+func (e POICategory) Valid() bool {
+	switch e {
+	case POICategoryBrewery:
+		return true
+	case POICategoryLandmark:
+		return true
+	case POICategoryOther:
+		return true
+	case POICategoryRestaurant:
+		return true
+	case POICategoryViewpoint:
+		return true
+	case POICategoryWildlife:
+		return true
+	default:
+		return false
+	}
+}
 
-// AddTagToStopJSONRequestBody defines body for AddTagToStop for application/json ContentType.
-type AddTagToStopJSONRequestBody = AddTagRequest
+// Defines values for PropaneFillUnit.
+const (
+	PropaneFillUnitGal PropaneFillUnit = "gal"
+	PropaneFillUnitLb  PropaneFillUnit = "lb"
+)
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Export all trips, stops, and tags as a flat table
-	// (GET /export)
-	GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams)
-	// Health check
-	// (GET /healthz)
-	GetHealth(w http.ResponseWriter, r *http.Request)
-	// List tags, optionally filtered by name prefix
-	// (GET /tags)
-	ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams)
-	// Create a tag by name
-	// (POST /tags)
-	CreateTag(w http.ResponseWriter, r *http.Request)
-	// Delete a tag
-	// (DELETE /tags/{slug})
-	DeleteTag(w http.ResponseWriter, r *http.Request, slug string)
-	// Update a tag's display name
-	// (PATCH /tags/{slug})
-	PatchTag(w http.ResponseWriter, r *http.Request, slug string)
-	// List all trips
-	// (GET /trips)
-	ListTrips(w http.ResponseWriter, r *http.Request, params ListTripsParams)
-	// Create a trip
-	// (POST /trips)
-	CreateTrip(w http.ResponseWriter, r *http.Request)
-	// Delete a trip
-	// (DELETE /trips/{id})
-	DeleteTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Get a trip by ID
-	// (GET /trips/{id})
-	GetTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Update a trip
-	// (PUT /trips/{id})
-	UpdateTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// List all stops for a trip
-	// (GET /trips/{tripId}/stops)
-	ListStops(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListStopsParams)
-	// Create a stop on a trip
-	// (POST /trips/{tripId}/stops)
-	CreateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
-	// Delete a stop
-	// (DELETE /trips/{tripId}/stops/{stopId})
-	DeleteStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
-	// Get a stop by ID
-	// (GET /trips/{tripId}/stops/{stopId})
-	GetStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
-	// Update a stop
-	// (PUT /trips/{tripId}/stops/{stopId})
-	UpdateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
-	// List tags on a stop
-	// (GET /trips/{tripId}/stops/{stopId}/tags)
-	ListTagsByStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
-	// Add a tag to a stop
-	// (POST /trips/{tripId}/stops/{stopId}/tags)
-	AddTagToStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
-	// Remove a tag from a stop
-	// (DELETE /trips/{tripId}/stops/{stopId}/tags/{slug})
-	RemoveTagFromStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, slug string)
+// Valid indicates whether the value is a known member of the PropaneFillUnit enum.
+func (e PropaneFillUnit) Valid() bool {
+	switch e {
+	case PropaneFillUnitGal:
+		return true
+	case PropaneFillUnitLb:
+		return true
+	default:
+		return false
+	}
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
-
-type Unimplemented struct{}
+// Defines values for ThumbnailSize.
+const (
+	Medium ThumbnailSize = "medium"
+	Small  ThumbnailSize = "small"
+)
 
-// Export all trips, stops, and tags as a flat table
-// (GET /export)
-func (_ Unimplemented) GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Valid indicates whether the value is a known member of the ThumbnailSize enum.
+func (e ThumbnailSize) Valid() bool {
+	switch e {
+	case Medium:
+		return true
+	case Small:
+		return true
+	default:
+		return false
+	}
 }
 
-// Health check
-// (GET /healthz)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Defines values for TombstoneKind.
+const (
+	TombstoneKindStop TombstoneKind = "stop"
+	TombstoneKindTrip TombstoneKind = "trip"
+)
 
-// List tags, optionally filtered by name prefix
-// (GET /tags)
-func (_ Unimplemented) ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Valid indicates whether the value is a known member of the TombstoneKind enum.
+func (e TombstoneKind) Valid() bool {
+	switch e {
+	case TombstoneKindStop:
+		return true
+	case TombstoneKindTrip:
+		return true
+	default:
+		return false
+	}
 }
 
-// Create a tag by name
-// (POST /tags)
-func (_ Unimplemented) CreateTag(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Defines values for TrashItemKind.
+const (
+	TrashItemKindStop TrashItemKind = "stop"
+	TrashItemKindTrip TrashItemKind = "trip"
+)
 
-// Delete a tag
-// (DELETE /tags/{slug})
-func (_ Unimplemented) DeleteTag(w http.ResponseWriter, r *http.Request, slug string) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Valid indicates whether the value is a known member of the TrashItemKind enum.
+func (e TrashItemKind) Valid() bool {
+	switch e {
+	case TrashItemKindStop:
+		return true
+	case TrashItemKindTrip:
+		return true
+	default:
+		return false
+	}
 }
 
-// Update a tag's display name
-// (PATCH /tags/{slug})
-func (_ Unimplemented) PatchTag(w http.ResponseWriter, r *http.Request, slug string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Defines values for TripCloneRequestInclude.
+const (
+	Checklists TripCloneRequestInclude = "checklists"
+	Expenses   TripCloneRequestInclude = "expenses"
+	Stops      TripCloneRequestInclude = "stops"
+	Tags       TripCloneRequestInclude = "tags"
+)
 
-// List all trips
-// (GET /trips)
-func (_ Unimplemented) ListTrips(w http.ResponseWriter, r *http.Request, params ListTripsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Valid indicates whether the value is a known member of the TripCloneRequestInclude enum.
+func (e TripCloneRequestInclude) Valid() bool {
+	switch e {
+	case Checklists:
+		return true
+	case Expenses:
+		return true
+	case Stops:
+		return true
+	case Tags:
+		return true
+	default:
+		return false
+	}
 }
 
-// Create a trip
-// (POST /trips)
-func (_ Unimplemented) CreateTrip(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Defines values for TripMemberRole.
+const (
+	Editor TripMemberRole = "editor"
+	Viewer TripMemberRole = "viewer"
+)
 
-// Delete a trip
-// (DELETE /trips/{id})
-func (_ Unimplemented) DeleteTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Valid indicates whether the value is a known member of the TripMemberRole enum.
+func (e TripMemberRole) Valid() bool {
+	switch e {
+	case Editor:
+		return true
+	case Viewer:
+		return true
+	default:
+		return false
+	}
 }
 
-// Get a trip by ID
-// (GET /trips/{id})
-func (_ Unimplemented) GetTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Defines values for UnitSystem.
+const (
+	Imperial UnitSystem = "imperial"
+	Metric   UnitSystem = "metric"
+)
 
-// Update a trip
-// (PUT /trips/{id})
-func (_ Unimplemented) UpdateTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Valid indicates whether the value is a known member of the UnitSystem enum.
+func (e UnitSystem) Valid() bool {
+	switch e {
+	case Imperial:
+		return true
+	case Metric:
+		return true
+	default:
+		return false
+	}
 }
 
-// List all stops for a trip
-// (GET /trips/{tripId}/stops)
-func (_ Unimplemented) ListStops(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListStopsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Defines values for WebhookEvent.
+const (
+	BorderCrossingCreated  WebhookEvent = "border_crossing.created"
+	BorderCrossingDeleted  WebhookEvent = "border_crossing.deleted"
+	BorderCrossingUpdated  WebhookEvent = "border_crossing.updated"
+	ChecklistCreated       WebhookEvent = "checklist.created"
+	ChecklistDeleted       WebhookEvent = "checklist.deleted"
+	ChecklistUpdated       WebhookEvent = "checklist.updated"
+	DumpCreated            WebhookEvent = "dump.created"
+	DumpDeleted            WebhookEvent = "dump.deleted"
+	ExpenseCreated         WebhookEvent = "expense.created"
+	ExpenseDeleted         WebhookEvent = "expense.deleted"
+	ExpenseUpdated         WebhookEvent = "expense.updated"
+	JournalEntryCreated    WebhookEvent = "journal_entry.created"
+	JournalEntryDeleted    WebhookEvent = "journal_entry.deleted"
+	JournalEntryUpdated    WebhookEvent = "journal_entry.updated"
+	MaintenanceDue         WebhookEvent = "maintenance.due"
+	OdometerReadingCreated WebhookEvent = "odometer_reading.created"
+	OdometerReadingDeleted WebhookEvent = "odometer_reading.deleted"
+	PhotoCreated           WebhookEvent = "photo.created"
+	PhotoDeleted           WebhookEvent = "photo.deleted"
+	PowerReadingCreated    WebhookEvent = "power_reading.created"
+	PowerReadingDeleted    WebhookEvent = "power_reading.deleted"
+	PropaneFillCreated     WebhookEvent = "propane_fill.created"
+	PropaneFillDeleted     WebhookEvent = "propane_fill.deleted"
+	ReservationCreated     WebhookEvent = "reservation.created"
+	ReservationDeleted     WebhookEvent = "reservation.deleted"
+	ReservationUpdated     WebhookEvent = "reservation.updated"
+	RouteLegUpdated        WebhookEvent = "route_leg.updated"
+	StopCreated            WebhookEvent = "stop.created"
+	StopDeleted            WebhookEvent = "stop.deleted"
+	StopUpdated            WebhookEvent = "stop.updated"
+	TankLevelCreated       WebhookEvent = "tank_level.created"
+	TankLevelDeleted       WebhookEvent = "tank_level.deleted"
+	TripCreated            WebhookEvent = "trip.created"
+	TripDeleted            WebhookEvent = "trip.deleted"
+	TripUpdated            WebhookEvent = "trip.updated"
+)
+
+// Valid indicates whether the value is a known member of the WebhookEvent enum.
+func (e WebhookEvent) Valid() bool {
+	switch e {
+	case BorderCrossingCreated:
+		return true
+	case BorderCrossingDeleted:
+		return true
+	case BorderCrossingUpdated:
+		return true
+	case ChecklistCreated:
+		return true
+	case ChecklistDeleted:
+		return true
+	case ChecklistUpdated:
+		return true
+	case DumpCreated:
+		return true
+	case DumpDeleted:
+		return true
+	case ExpenseCreated:
+		return true
+	case ExpenseDeleted:
+		return true
+	case ExpenseUpdated:
+		return true
+	case JournalEntryCreated:
+		return true
+	case JournalEntryDeleted:
+		return true
+	case JournalEntryUpdated:
+		return true
+	case MaintenanceDue:
+		return true
+	case OdometerReadingCreated:
+		return true
+	case OdometerReadingDeleted:
+		return true
+	case PhotoCreated:
+		return true
+	case PhotoDeleted:
+		return true
+	case PowerReadingCreated:
+		return true
+	case PowerReadingDeleted:
+		return true
+	case PropaneFillCreated:
+		return true
+	case PropaneFillDeleted:
+		return true
+	case ReservationCreated:
+		return true
+	case ReservationDeleted:
+		return true
+	case ReservationUpdated:
+		return true
+	case RouteLegUpdated:
+		return true
+	case StopCreated:
+		return true
+	case StopDeleted:
+		return true
+	case StopUpdated:
+		return true
+	case TankLevelCreated:
+		return true
+	case TankLevelDeleted:
+		return true
+	case TripCreated:
+		return true
+	case TripDeleted:
+		return true
+	case TripUpdated:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetBorderCrossingReportParamsFormat.
+const (
+	GetBorderCrossingReportParamsFormatCsv  GetBorderCrossingReportParamsFormat = "csv"
+	GetBorderCrossingReportParamsFormatJson GetBorderCrossingReportParamsFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the GetBorderCrossingReportParamsFormat enum.
+func (e GetBorderCrossingReportParamsFormat) Valid() bool {
+	switch e {
+	case GetBorderCrossingReportParamsFormatCsv:
+		return true
+	case GetBorderCrossingReportParamsFormatJson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetExportParamsFormat.
+const (
+	GetExportParamsFormatCsv  GetExportParamsFormat = "csv"
+	GetExportParamsFormatGpx  GetExportParamsFormat = "gpx"
+	GetExportParamsFormatJson GetExportParamsFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the GetExportParamsFormat enum.
+func (e GetExportParamsFormat) Valid() bool {
+	switch e {
+	case GetExportParamsFormatCsv:
+		return true
+	case GetExportParamsFormatGpx:
+		return true
+	case GetExportParamsFormatJson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetSharedTripEmbedParamsFormat.
+const (
+	Html GetSharedTripEmbedParamsFormat = "html"
+	Json GetSharedTripEmbedParamsFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the GetSharedTripEmbedParamsFormat enum.
+func (e GetSharedTripEmbedParamsFormat) Valid() bool {
+	switch e {
+	case Html:
+		return true
+	case Json:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key The key to send in X-API-Key. Only returned on create.
+	Key  *string `json:"key,omitempty"`
+	Name string  `json:"name"`
+
+	// Prefix The start of the key, to recognize it by.
+	Prefix string `json:"prefix"`
+}
+
+// APIKeyRequest defines model for APIKeyRequest.
+type APIKeyRequest struct {
+	// Name What the key is for, to tell keys apart.
+	Name string `json:"name"`
+}
+
+// AddOrganizationMemberRequest defines model for AddOrganizationMemberRequest.
+type AddOrganizationMemberRequest struct {
+	// Role Owners manage the organization and its members; members use the logbook.
+	Role   OrganizationRole `json:"role"`
+	UserId string           `json:"user_id"`
+}
+
+// AddTagRequest defines model for AddTagRequest.
+type AddTagRequest struct {
+	Name string `json:"name"`
+}
+
+// ArriveAtStopRequest defines model for ArriveAtStopRequest.
+type ArriveAtStopRequest struct {
+	// ArrivedAt When the stop was reached. Defaults to now.
+	ArrivedAt *time.Time `json:"arrived_at,omitempty"`
+}
+
+// BorderCrossing defines model for BorderCrossing.
+type BorderCrossing struct {
+	Country   string             `json:"country"`
+	CreatedAt time.Time          `json:"created_at"`
+	CrossedOn openapi_types.Date `json:"crossed_on"`
+
+	// Direction Whether the crossing entered or left country.
+	Direction   CrossingDirection  `json:"direction"`
+	Documents   []string           `json:"documents"`
+	Id          openapi_types.UUID `json:"id"`
+	Notes       *string            `json:"notes,omitempty"`
+	PortOfEntry string             `json:"port_of_entry"`
+	TripId      openapi_types.UUID `json:"trip_id"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// BorderCrossingReportRow defines model for BorderCrossingReportRow.
+type BorderCrossingReportRow struct {
+	Crossing BorderCrossing `json:"crossing"`
+	TripName string         `json:"trip_name"`
+}
+
+// BorderCrossingRequest defines model for BorderCrossingRequest.
+type BorderCrossingRequest struct {
+	// Country ISO 3166-1 alpha-2 code of the country entered or left.
+	Country   string             `json:"country"`
+	CrossedOn openapi_types.Date `json:"crossed_on"`
+
+	// Direction Whether the crossing entered or left country.
+	Direction   CrossingDirection `json:"direction"`
+	Documents   *[]string         `json:"documents,omitempty"`
+	Notes       *string           `json:"notes,omitempty"`
+	PortOfEntry string            `json:"port_of_entry"`
+}
+
+// BoundingBox The box around a cluster's stops.
+type BoundingBox struct {
+	East  float64 `json:"east"`
+	North float64 `json:"north"`
+	South float64 `json:"south"`
+	West  float64 `json:"west"`
+}
+
+// BudgetCategory defines model for BudgetCategory.
+type BudgetCategory struct {
+	Category     ExpenseCategory `json:"category"`
+	ExpenseCount int             `json:"expense_count"`
+
+	// Share This category's fraction of all spending.
+	Share      float64 `json:"share"`
+	SpentCents int64   `json:"spent_cents"`
+}
+
+// BudgetReport defines model for BudgetReport.
+type BudgetReport struct {
+	// BudgetCents The trip's budget, or null if it has none.
+	BudgetCents *int64 `json:"budget_cents,omitempty"`
+
+	// Categories Spending per expense category that has any, largest first.
+	Categories []BudgetCategory `json:"categories"`
+
+	// OverBudget Whether spending exceeds the budget. False without one.
+	OverBudget bool `json:"over_budget"`
+
+	// RemainingCents Budget less spending; negative once over budget. Null without a budget.
+	RemainingCents *int64 `json:"remaining_cents,omitempty"`
+
+	// SpentCents The sum of the trip's expenses.
+	SpentCents int64              `json:"spent_cents"`
+	TripId     openapi_types.UUID `json:"trip_id"`
+}
+
+// CheckItemRequest defines model for CheckItemRequest.
+type CheckItemRequest struct {
+	Checked bool `json:"checked"`
+}
+
+// Checklist defines model for Checklist.
+type Checklist struct {
+	// Complete True when every item has been checked off.
+	Complete  bool               `json:"complete"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Items     []ChecklistItem    `json:"items"`
+	Kind      ChecklistKind      `json:"kind"`
+	Name      string             `json:"name"`
+	StopId    openapi_types.UUID `json:"stop_id"`
+
+	// TemplateId The template this run was started from; null once it is deleted.
+	TemplateId *openapi_types.UUID `json:"template_id,omitempty"`
+}
+
+// ChecklistKind defines model for Checklist.Kind.
+type ChecklistKind string
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	// CheckedAt When the item was checked off; null while unchecked.
+	CheckedAt *time.Time         `json:"checked_at,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Label     string             `json:"label"`
+
+	// Position 1-based order within the checklist.
+	Position int `json:"position"`
+}
+
+// ChecklistTemplate defines model for ChecklistTemplate.
+type ChecklistTemplate struct {
+	CreatedAt time.Time             `json:"created_at"`
+	Id        openapi_types.UUID    `json:"id"`
+	Items     []string              `json:"items"`
+	Kind      ChecklistTemplateKind `json:"kind"`
+	Name      string                `json:"name"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+// ChecklistTemplateKind defines model for ChecklistTemplate.Kind.
+type ChecklistTemplateKind string
+
+// ChecklistTemplateRequest defines model for ChecklistTemplateRequest.
+type ChecklistTemplateRequest struct {
+	Items []string                     `json:"items"`
+	Kind  ChecklistTemplateRequestKind `json:"kind"`
+	Name  string                       `json:"name"`
+}
+
+// ChecklistTemplateRequestKind defines model for ChecklistTemplateRequest.Kind.
+type ChecklistTemplateRequestKind string
+
+// ClusteredStop The stop a cluster of one stands for.
+type ClusteredStop struct {
+	Id     openapi_types.UUID `json:"id"`
+	Name   string             `json:"name"`
+	TripId openapi_types.UUID `json:"trip_id"`
+}
+
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	// CheckedAt When the check ran. Earlier than the request for results repeated between provider probes.
+	CheckedAt time.Time `json:"checked_at"`
+
+	// Critical Whether the API is down, rather than degraded, while this component fails.
+	Critical bool `json:"critical"`
+
+	// Error Why the check failed. Absent when status is ok.
+	Error *string `json:"error,omitempty"`
+
+	// LatencyMs How long the check took, in milliseconds.
+	LatencyMs float64 `json:"latency_ms"`
+
+	// Name database, object_storage, migrations, maintenance, geocoder, elevation, routing, or map_tiles.
+	Name   string                `json:"name"`
+	Status ComponentHealthStatus `json:"status"`
+}
+
+// ComponentHealthStatus defines model for ComponentHealth.Status.
+type ComponentHealthStatus string
+
+// CreateCustomFieldRequest defines model for CreateCustomFieldRequest.
+type CreateCustomFieldRequest struct {
+	Entity CustomFieldEntity `json:"entity"`
+
+	// Key A lowercase letter, then up to 63 lowercase letters, digits, and underscores.
+	Key   string          `json:"key"`
+	Label string          `json:"label"`
+	Type  CustomFieldType `json:"type"`
+}
+
+// CreateDumpEventRequest defines model for CreateDumpEventRequest.
+type CreateDumpEventRequest struct {
+	DumpedAt time.Time `json:"dumped_at"`
+
+	// Location The dump station, if it is not the stop itself.
+	Location *string `json:"location,omitempty"`
+	Notes    *string `json:"notes,omitempty"`
+}
+
+// CreateOdometerReadingRequest defines model for CreateOdometerReadingRequest.
+type CreateOdometerReadingRequest struct {
+	Miles      float64   `json:"miles"`
+	Notes      *string   `json:"notes,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+
+	// TripId The trip the reading was taken on, if any.
+	TripId *openapi_types.UUID `json:"trip_id,omitempty"`
+
+	// Vehicle Which vehicle the reading is for. Readings are ordered and compared per vehicle.
+	Vehicle string `json:"vehicle"`
+}
+
+// CreateOrganizationRequest defines model for CreateOrganizationRequest.
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// CreatePackingItemRequest defines model for CreatePackingItemRequest.
+type CreatePackingItemRequest struct {
+	Name     string `json:"name"`
+	Packed   *bool  `json:"packed,omitempty"`
+	Quantity *int   `json:"quantity,omitempty"`
+}
+
+// CreatePackingListRequest defines model for CreatePackingListRequest.
+type CreatePackingListRequest struct {
+	// Name Required unless source_list_id is set.
+	Name *string `json:"name,omitempty"`
+
+	// SourceListId A list whose items are copied into the new one, unpacked.
+	SourceListId *openapi_types.UUID `json:"source_list_id,omitempty"`
+
+	// TripId The trip the list belongs to. Omit to create a template.
+	TripId *openapi_types.UUID `json:"trip_id,omitempty"`
+}
+
+// CreatePowerReadingRequest defines model for CreatePowerReadingRequest.
+type CreatePowerReadingRequest struct {
+	// GeneratorHours Generator run time since the previous reading, in hours.
+	GeneratorHours *float64  `json:"generator_hours,omitempty"`
+	Notes          *string   `json:"notes,omitempty"`
+	RecordedAt     time.Time `json:"recorded_at"`
+
+	// SolarWh Solar yield since the previous reading, in watt-hours.
+	SolarWh *float64 `json:"solar_wh,omitempty"`
+
+	// StateOfCharge Battery bank state of charge, in percent.
+	StateOfCharge *float64 `json:"state_of_charge,omitempty"`
+
+	// TripId The trip the reading was taken on, if any.
+	TripId *openapi_types.UUID `json:"trip_id,omitempty"`
+}
+
+// CreatePropaneFillRequest defines model for CreatePropaneFillRequest.
+type CreatePropaneFillRequest struct {
+	FilledAt time.Time `json:"filled_at"`
+	Location *string   `json:"location,omitempty"`
+	Notes    *string   `json:"notes,omitempty"`
+
+	// Price Total paid for the fill. Omit when it was not priced separately.
+	Price *float64 `json:"price,omitempty"`
+
+	// Quantity Amount bought, in unit. Must be greater than zero.
+	Quantity float64 `json:"quantity"`
+
+	// TripId The trip the fill was bought on, if any.
+	TripId *openapi_types.UUID `json:"trip_id,omitempty"`
+
+	// Unit US gallons (metered) or pounds (weighed cylinders).
+	Unit CreatePropaneFillRequestUnit `json:"unit"`
+}
+
+// CreatePropaneFillRequestUnit US gallons (metered) or pounds (weighed cylinders).
+type CreatePropaneFillRequestUnit string
+
+// CreateShareRequest defines model for CreateShareRequest.
+type CreateShareRequest struct {
+	// ExpiresInHours Link lifetime in hours (default 7 days, max 90 days).
+	ExpiresInHours *int `json:"expires_in_hours,omitempty"`
+}
+
+// CreateStopRequest defines model for CreateStopRequest.
+type CreateStopRequest struct {
+	// ArrivedAt Required unless planned is true, and omitted when it is.
+	ArrivedAt  *time.Time `json:"arrived_at,omitempty"`
+	DepartedAt *time.Time `json:"departed_at,omitempty"`
+
+	// Latitude Decimal degrees. Give latitude and longitude together or not at all.
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Location  *string  `json:"location,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Metadata Custom field values by key; see /custom-fields. Each key must be
+	// defined for the entity and each value must match the field's type:
+	// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+	// date. A null value removes the field. On update, omitting metadata
+	// keeps the current values, and the object given replaces them.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Name     string    `json:"name"`
+
+	// NoteAnswers Answers to the stop's note template, by question key. Blank answers
+	// are dropped, and an answer to a question with choices must be one
+	// of them. Set only when the stop is created.
+	NoteAnswers *NoteAnswers `json:"note_answers,omitempty"`
+
+	// NoteTemplateId A note template from /note-templates whose questions note_answers
+	// answers. The answers are written into notes, one "Prompt: answer"
+	// line each in the template's order, ahead of any notes given.
+	NoteTemplateId *openapi_types.UUID `json:"note_template_id,omitempty"`
+	Notes          *string             `json:"notes,omitempty"`
+
+	// OdometerMiles The odometer on arrival, in miles whatever the Units header says.
+	// Readings must rise with arrival time across the trip's stops, and
+	// a planned stop has none.
+	OdometerMiles *float64 `json:"odometer_miles,omitempty"`
+
+	// Planned A stop not reached yet. It has no arrived_at until it is marked
+	// as reached with POST /trips/{tripId}/stops/{stopId}/arrive, and
+	// is listed after the stops already reached.
+	Planned *bool `json:"planned,omitempty"`
+}
+
+// CreateTagRequest defines model for CreateTagRequest.
+type CreateTagRequest struct {
+	// Name Display name for the tag. Will be normalised to a lowercase hyphenated slug.
+	Name string `json:"name"`
+}
+
+// CreateTankLevelRequest defines model for CreateTankLevelRequest.
+type CreateTankLevelRequest struct {
+	// BlackPct Black water tank, percent full.
+	BlackPct *int `json:"black_pct,omitempty"`
+
+	// FreshPct Fresh water tank, percent full.
+	FreshPct *int `json:"fresh_pct,omitempty"`
+
+	// GreyPct Grey water tank, percent full.
+	GreyPct    *int      `json:"grey_pct,omitempty"`
+	Notes      *string   `json:"notes,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// CreateTokenRequest defines model for CreateTokenRequest.
+type CreateTokenRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// CreateTripRequest defines model for CreateTripRequest.
+type CreateTripRequest struct {
+	// BudgetCents What the trip is expected to cost, in cents; see /trips/{tripId}/budget-report.
+	BudgetCents *int64              `json:"budget_cents,omitempty"`
+	EndDate     *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Metadata Custom field values by key; see /custom-fields. Each key must be
+	// defined for the entity and each value must match the field's type:
+	// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+	// date. A null value removes the field. On update, omitting metadata
+	// keeps the current values, and the object given replaces them.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Name     string    `json:"name"`
+	Notes    *string   `json:"notes,omitempty"`
+
+	// RigId The rig the trip is taken in; see /rigs.
+	RigId     *openapi_types.UUID `json:"rig_id,omitempty"`
+	StartDate openapi_types.Date  `json:"start_date"`
+}
+
+// CrossingDirection Whether the crossing entered or left country.
+type CrossingDirection string
+
+// CurrentTrip defines model for CurrentTrip.
+type CurrentTrip struct {
+	// DaysSinceLastDump Whole days since last_dump. Null if no dump has been logged.
+	DaysSinceLastDump *int `json:"days_since_last_dump,omitempty"`
+
+	// LastDump The most recent dump on any trip. Null if none has been logged.
+	LastDump *DumpEvent `json:"last_dump,omitempty"`
+	Trip     Trip       `json:"trip"`
+}
+
+// CustomField defines model for CustomField.
+type CustomField struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Entity    CustomFieldEntity  `json:"entity"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key The key the value is stored under in metadata.
+	Key   string          `json:"key"`
+	Label string          `json:"label"`
+	Type  CustomFieldType `json:"type"`
+}
+
+// CustomFieldEntity defines model for CustomFieldEntity.
+type CustomFieldEntity string
+
+// CustomFieldType defines model for CustomFieldType.
+type CustomFieldType string
+
+// Dashboard defines model for Dashboard.
+type Dashboard struct {
+	// TopTags The most used tags, most stops first. Tags on no stop are left out.
+	TopTags     []TagCount `json:"top_tags"`
+	TotalNights int        `json:"total_nights"`
+	TotalStops  int        `json:"total_stops"`
+	TotalTrips  int        `json:"total_trips"`
+
+	// Trips Every trip, newest start date first.
+	Trips []TripSummary `json:"trips"`
+}
+
+// DataFixResult defines model for DataFixResult.
+type DataFixResult struct {
+	Changes []FieldChange `json:"changes"`
+
+	// DryRun True when nothing was written.
+	DryRun bool `json:"dry_run"`
+}
+
+// DateShiftRequest defines model for DateShiftRequest.
+type DateShiftRequest struct {
+	// Days Days to move every date by; negative moves them earlier.
+	Days int `json:"days"`
+}
+
+// DriveCheck defines model for DriveCheck.
+type DriveCheck struct {
+	Days []DriveDay `json:"days"`
+
+	// Ok True when no drive breaks a rule.
+	Ok     bool               `json:"ok"`
+	TripId openapi_types.UUID `json:"trip_id"`
+}
+
+// DriveDay defines model for DriveDay.
+type DriveDay struct {
+	ArriveAt        *time.Time         `json:"arrive_at,omitempty"`
+	DepartAt        *time.Time         `json:"depart_at,omitempty"`
+	DistanceMiles   *float64           `json:"distance_miles,omitempty"`
+	DurationMinutes *int               `json:"duration_minutes,omitempty"`
+	FromStopId      openapi_types.UUID `json:"from_stop_id"`
+	LegId           openapi_types.UUID `json:"leg_id"`
+	Ok              bool               `json:"ok"`
+
+	// Source Where the distance and driving time came from: entered on the
+	// leg, looked up from the routing API, or neither.
+	Source DriveDaySource `json:"source"`
+
+	// Sunset Sunset at the destination on the day of the drive.
+	Sunset   *time.Time         `json:"sunset,omitempty"`
+	ToStopId openapi_types.UUID `json:"to_stop_id"`
+
+	// Unchecked Rules that could not be checked for lack of a figure.
+	Unchecked  []DriveRule      `json:"unchecked"`
+	Violations []DriveViolation `json:"violations"`
+}
+
+// DriveDaySource Where the distance and driving time came from: entered on the
+// leg, looked up from the routing API, or neither.
+type DriveDaySource string
+
+// DriveRule defines model for DriveRule.
+type DriveRule string
+
+// DriveRulesRequest defines model for DriveRulesRequest.
+type DriveRulesRequest struct {
+	ArriveBeforeDark *bool `json:"arrive_before_dark,omitempty"`
+
+	// DepartAt When the first planned drive leaves, with the traveller's UTC
+	// offset; later drives leave at the same time on the days after.
+	// Needed to check arrival before dark.
+	DepartAt       *time.Time `json:"depart_at,omitempty"`
+	MaxDriveHours  *float64   `json:"max_drive_hours,omitempty"`
+	MaxMilesPerDay *float64   `json:"max_miles_per_day,omitempty"`
+}
+
+// DriveViolation defines model for DriveViolation.
+type DriveViolation struct {
+	Message string    `json:"message"`
+	Rule    DriveRule `json:"rule"`
+}
+
+// DumpEvent defines model for DumpEvent.
+type DumpEvent struct {
+	CreatedAt time.Time          `json:"created_at"`
+	DumpedAt  time.Time          `json:"dumped_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Location  *string            `json:"location,omitempty"`
+	Notes     *string            `json:"notes,omitempty"`
+	StopId    openapi_types.UUID `json:"stop_id"`
+}
+
+// ElevationPoint defines model for ElevationPoint.
+type ElevationPoint struct {
+	// DistanceMiles Distance along the leg from its start.
+	DistanceMiles float64 `json:"distance_miles"`
+
+	// ElevationFeet Height of the ground above sea level.
+	ElevationFeet float64 `json:"elevation_feet"`
+}
+
+// ElevationProfile defines model for ElevationProfile.
+type ElevationProfile struct {
+	AscentFeet  float64 `json:"ascent_feet"`
+	DescentFeet float64 `json:"descent_feet"`
+
+	// DistanceMiles Length of the leg's polyline.
+	DistanceMiles    float64            `json:"distance_miles"`
+	FetchedAt        time.Time          `json:"fetched_at"`
+	LegId            openapi_types.UUID `json:"leg_id"`
+	MaxElevationFeet float64            `json:"max_elevation_feet"`
+	MinElevationFeet float64            `json:"min_elevation_feet"`
+
+	// Points Evenly spaced samples from the leg's start to its end.
+	Points []ElevationPoint `json:"points"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Code Machine-readable error code for client branching.
+	Code string `json:"code"`
+
+	// Message Human-readable description of the error.
+	Message string `json:"message"`
+
+	// RequestId Set on 500 responses from a server fault. Matches the request_id the server logged, so quote it when reporting the problem.
+	RequestId *string `json:"request_id,omitempty"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// Expense defines model for Expense.
+type Expense struct {
+	Amount    float64            `json:"amount"`
+	Category  ExpenseCategory    `json:"category"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Location The stop's location when the expense was logged; kept if the stop is deleted.
+	Location *string `json:"location,omitempty"`
+	Note     *string `json:"note,omitempty"`
+
+	// PaidBy The co-traveller who paid; null until the expense is claimed.
+	PaidBy  *string   `json:"paid_by,omitempty"`
+	SpentAt time.Time `json:"spent_at"`
+
+	// SplitAmong Who shares the expense evenly; empty when the payer bore it alone.
+	SplitAmong []string `json:"split_among"`
+
+	// StopId The stop the traveller was at when the expense was logged.
+	StopId *openapi_types.UUID `json:"stop_id,omitempty"`
+	TripId openapi_types.UUID  `json:"trip_id"`
+}
+
+// ExpenseCategory defines model for ExpenseCategory.
+type ExpenseCategory string
+
+// ExpenseSplitRequest defines model for ExpenseSplitRequest.
+type ExpenseSplitRequest struct {
+	PaidBy     *string   `json:"paid_by,omitempty"`
+	SplitAmong *[]string `json:"split_among,omitempty"`
+}
+
+// ExportRow defines model for ExportRow.
+type ExportRow struct {
+	ArrivedAt *time.Time `json:"arrived_at,omitempty"`
+
+	// Categories The categories of the expenses logged at the stop. Pipe-separated in CSV.
+	Categories *[]string `json:"categories,omitempty"`
+
+	// Cost The total of the expenses logged at the stop, in the amounts as
+	// entered. Null when it has none.
+	Cost       *float64   `json:"cost,omitempty"`
+	DepartedAt *time.Time `json:"departed_at,omitempty"`
+
+	// Distance The odometer distance from the trip's previous stop, in miles, or
+	// kilometers with Units: metric. Null unless both stops have a reading.
+	Distance  *float64 `json:"distance,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// State The state or province the stop is in, once its coordinates have
+	// been looked up; see /stats/states-visited.
+	State         *string             `json:"state,omitempty"`
+	StopLocation  *string             `json:"stop_location,omitempty"`
+	StopName      *string             `json:"stop_name,omitempty"`
+	StopNotes     *string             `json:"stop_notes,omitempty"`
+	Tags          []string            `json:"tags"`
+	TripEndDate   *openapi_types.Date `json:"trip_end_date,omitempty"`
+	TripId        openapi_types.UUID  `json:"trip_id"`
+	TripName      string              `json:"trip_name"`
+	TripStartDate openapi_types.Date  `json:"trip_start_date"`
+}
+
+// FieldChange One field of one record a data fix rewrites. Values are text: dates
+// as YYYY-MM-DD and times as RFC 3339 in UTC.
+type FieldChange struct {
+	After  *string            `json:"after"`
+	Before *string            `json:"before"`
+	Entity FieldChangeEntity  `json:"entity"`
+	Field  string             `json:"field"`
+	Id     openapi_types.UUID `json:"id"`
+}
+
+// FieldChangeEntity defines model for FieldChange.Entity.
+type FieldChangeEntity string
+
+// GeoJSONFeature defines model for GeoJSONFeature.
+type GeoJSONFeature struct {
+	Geometry GeoJSONGeometry `json:"geometry"`
+
+	// Id The stop's ID for stop points; absent on the route.
+	Id         *string            `json:"id,omitempty"`
+	Properties GeoJSONProperties  `json:"properties"`
+	Type       GeoJSONFeatureType `json:"type"`
+}
+
+// GeoJSONFeatureType defines model for GeoJSONFeature.Type.
+type GeoJSONFeatureType string
+
+// GeoJSONFeatureCollection defines model for GeoJSONFeatureCollection.
+type GeoJSONFeatureCollection struct {
+	Features []GeoJSONFeature             `json:"features"`
+	Type     GeoJSONFeatureCollectionType `json:"type"`
+}
+
+// GeoJSONFeatureCollectionType defines model for GeoJSONFeatureCollection.Type.
+type GeoJSONFeatureCollectionType string
+
+// GeoJSONGeometry defines model for GeoJSONGeometry.
+type GeoJSONGeometry struct {
+	// Coordinates A [longitude, latitude] position for a Point; an array of them for a LineString.
+	Coordinates interface{}         `json:"coordinates"`
+	Type        GeoJSONGeometryType `json:"type"`
+}
+
+// GeoJSONGeometryType defines model for GeoJSONGeometry.Type.
+type GeoJSONGeometryType string
+
+// GeoJSONProperties defines model for GeoJSONProperties.
+type GeoJSONProperties struct {
+	// ArrivedAt When the stop was arrived at; absent for a planned stop.
+	ArrivedAt  *time.Time `json:"arrived_at,omitempty"`
+	DepartedAt *time.Time `json:"departed_at,omitempty"`
+
+	// Kind Whether the feature is a stop or the route between them.
+	Kind GeoJSONPropertiesKind `json:"kind"`
+
+	// Name The stop's name.
+	Name *string `json:"name,omitempty"`
+}
+
+// GeoJSONPropertiesKind Whether the feature is a stop or the route between them.
+type GeoJSONPropertiesKind string
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	// Components One entry per checked component. Only with detail=true.
+	Components *[]ComponentHealth `json:"components,omitempty"`
+
+	// Status ok, degraded, or down. Always ok without detail=true.
+	Status string `json:"status"`
+
+	// Version The running build. Only with detail=true.
+	Version *string `json:"version,omitempty"`
+}
+
+// ImportFailure An item of an imported file that was not imported, and why.
+type ImportFailure struct {
+	// Index The item's 1-based position among the file's waypoints.
+	Index int `json:"index"`
+
+	// Name The item's name, if it has one.
+	Name   *string `json:"name,omitempty"`
+	Reason string  `json:"reason"`
+}
+
+// InviteTripMemberRequest defines model for InviteTripMemberRequest.
+type InviteTripMemberRequest struct {
+	// Role Editors may change the trip and everything logged against it; viewers may only read it.
+	Role     TripMemberRole `json:"role"`
+	Username string         `json:"username"`
+}
+
+// JournalDay defines model for JournalDay.
+type JournalDay struct {
+	Date    openapi_types.Date `json:"date"`
+	Entries []JournalEntry     `json:"entries"`
+}
+
+// JournalEntry defines model for JournalEntry.
+type JournalEntry struct {
+	Body      string              `json:"body"`
+	CreatedAt time.Time           `json:"created_at"`
+	Date      openapi_types.Date  `json:"date"`
+	Id        openapi_types.UUID  `json:"id"`
+	Mood      *JournalMood        `json:"mood,omitempty"`
+	StopId    *openapi_types.UUID `json:"stop_id,omitempty"`
+	TripId    openapi_types.UUID  `json:"trip_id"`
+	UpdatedAt time.Time           `json:"updated_at"`
+	Weather   *JournalWeather     `json:"weather,omitempty"`
+}
+
+// JournalEntryRequest defines model for JournalEntryRequest.
+type JournalEntryRequest struct {
+	// Body Markdown.
+	Body string             `json:"body"`
+	Date openapi_types.Date `json:"date"`
+	Mood *JournalMood       `json:"mood,omitempty"`
+
+	// StopId A stop on the same trip that the entry is about.
+	StopId  *openapi_types.UUID `json:"stop_id,omitempty"`
+	Weather *JournalWeather     `json:"weather,omitempty"`
+}
+
+// JournalMood defines model for JournalMood.
+type JournalMood string
+
+// JournalWeather defines model for JournalWeather.
+type JournalWeather string
+
+// LocationReport An OwnTracks message. Only location messages are used; the fields
+// below are the ones read from them, and any others are ignored.
+type LocationReport struct {
+	UnderscoreType string `json:"_type"`
+
+	// Acc Accuracy radius in meters. Reports less accurate than the geofence radius are stored but not used for dwell detection.
+	Acc *float64 `json:"acc,omitempty"`
+	Lat *float64 `json:"lat,omitempty"`
+	Lon *float64 `json:"lon,omitempty"`
+
+	// Tid Tracker ID. Identifies the device when topic is absent.
+	Tid *string `json:"tid,omitempty"`
+
+	// Topic OwnTracks topic (owntracks/user/device). Identifies the device when present.
+	Topic *string `json:"topic,omitempty"`
+
+	// Tst When the position was recorded, in Unix seconds. Defaults to the time of receipt.
+	Tst *int64 `json:"tst,omitempty"`
+}
+
+// MaintenanceDueItem defines model for MaintenanceDueItem.
+type MaintenanceDueItem struct {
+	// CurrentMiles The vehicle's latest odometer reading.
+	CurrentMiles float64         `json:"current_miles"`
+	Item         MaintenanceItem `json:"item"`
+
+	// MilesRemaining Miles until the item is due; negative once it is overdue.
+	MilesRemaining float64           `json:"miles_remaining"`
+	Status         MaintenanceStatus `json:"status"`
+}
+
+// MaintenanceItem defines model for MaintenanceItem.
+type MaintenanceItem struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DueAtMiles The odometer reading the item is next due at.
+	DueAtMiles    float64            `json:"due_at_miles"`
+	Id            openapi_types.UUID `json:"id"`
+	IntervalMiles float64            `json:"interval_miles"`
+
+	// LastService The service with the most miles; null until one is recorded.
+	LastService *MaintenanceRecord `json:"last_service,omitempty"`
+	Name        string             `json:"name"`
+	Vehicle     string             `json:"vehicle"`
+}
+
+// MaintenanceItemRequest defines model for MaintenanceItemRequest.
+type MaintenanceItemRequest struct {
+	IntervalMiles float64 `json:"interval_miles"`
+	Name          string  `json:"name"`
+
+	// Vehicle The vehicle's label, as its odometer readings are logged.
+	Vehicle string `json:"vehicle"`
+}
+
+// MaintenanceRecord defines model for MaintenanceRecord.
+type MaintenanceRecord struct {
+	CreatedAt  time.Time          `json:"created_at"`
+	Id         openapi_types.UUID `json:"id"`
+	ItemId     openapi_types.UUID `json:"item_id"`
+	Miles      float64            `json:"miles"`
+	Notes      *string            `json:"notes,omitempty"`
+	ServicedOn openapi_types.Date `json:"serviced_on"`
+}
+
+// MaintenanceRecordRequest defines model for MaintenanceRecordRequest.
+type MaintenanceRecordRequest struct {
+	// Miles The odometer reading when the service was done.
+	Miles      float64            `json:"miles"`
+	Notes      *string            `json:"notes,omitempty"`
+	ServicedOn openapi_types.Date `json:"serviced_on"`
+}
+
+// MaintenanceStatus defines model for MaintenanceStatus.
+type MaintenanceStatus string
+
+// MergeTagRequest defines model for MergeTagRequest.
+type MergeTagRequest struct {
+	// Into Slug of the tag to keep.
+	Into string `json:"into"`
+}
+
+// Metadata Custom field values by key; see /custom-fields. Each key must be
+// defined for the entity and each value must match the field's type:
+// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+// date. A null value removes the field. On update, omitting metadata
+// keeps the current values, and the object given replaces them.
+type Metadata map[string]interface{}
+
+// NearbyStop defines model for NearbyStop.
+type NearbyStop struct {
+	// DistanceKm Distance from the search point along the Earth's surface.
+	DistanceKm float64 `json:"distance_km"`
+	Stop       Stop    `json:"stop"`
+
+	// TripName Name of the trip the stop belongs to.
+	TripName string `json:"trip_name"`
+}
+
+// NightSpan defines model for NightSpan.
+type NightSpan struct {
+	FirstNight openapi_types.Date  `json:"first_night"`
+	LastNight  openapi_types.Date  `json:"last_night"`
+	Nights     int                 `json:"nights"`
+	Status     NightSpanStatus     `json:"status"`
+	StopId     *openapi_types.UUID `json:"stop_id,omitempty"`
+	StopName   *string             `json:"stop_name,omitempty"`
+
+	// TripId The trip of a stop or gap span.
+	TripId *openapi_types.UUID `json:"trip_id,omitempty"`
+}
+
+// NightSpanStatus defines model for NightSpan.Status.
+type NightSpanStatus string
+
+// NoteAnswers Answers to the stop's note template, by question key. Blank answers
+// are dropped, and an answer to a question with choices must be one
+// of them. Set only when the stop is created.
+type NoteAnswers map[string]string
+
+// NoteQuestion defines model for NoteQuestion.
+type NoteQuestion struct {
+	// Choices The only answers allowed. Omit to allow any.
+	Choices *[]string `json:"choices,omitempty"`
+
+	// Key Names the answer in a stop's note_answers.
+	Key    string `json:"key"`
+	Prompt string `json:"prompt"`
+}
+
+// NoteTemplate defines model for NoteTemplate.
+type NoteTemplate struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	Questions []NoteQuestion     `json:"questions"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// NoteTemplateRequest defines model for NoteTemplateRequest.
+type NoteTemplateRequest struct {
+	Name      string         `json:"name"`
+	Questions []NoteQuestion `json:"questions"`
+}
+
+// OdometerReading defines model for OdometerReading.
+type OdometerReading struct {
+	CreatedAt  time.Time           `json:"created_at"`
+	Id         openapi_types.UUID  `json:"id"`
+	Miles      float64             `json:"miles"`
+	Notes      *string             `json:"notes,omitempty"`
+	RecordedAt time.Time           `json:"recorded_at"`
+	TripId     *openapi_types.UUID `json:"trip_id,omitempty"`
+	Vehicle    string              `json:"vehicle"`
+}
+
+// OdometerReadingList defines model for OdometerReadingList.
+type OdometerReadingList struct {
+	Data []OdometerReading `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	OrganizationId openapi_types.UUID `json:"organization_id"`
+
+	// Role Owners manage the organization and its members; members use the logbook.
+	Role   OrganizationRole `json:"role"`
+	UserId string           `json:"user_id"`
+}
+
+// OrganizationRole Owners manage the organization and its members; members use the logbook.
+type OrganizationRole string
+
+// POICategory defines model for POICategory.
+type POICategory string
+
+// PackingItem defines model for PackingItem.
+type PackingItem struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	ListId    openapi_types.UUID `json:"list_id"`
+	Name      string             `json:"name"`
+	Packed    bool               `json:"packed"`
+
+	// Position 1-based order within the list.
+	Position int `json:"position"`
+	Quantity int `json:"quantity"`
+}
+
+// PackingList defines model for PackingList.
+type PackingList struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// IsTemplate True when the list is not attached to a trip.
+	IsTemplate bool                `json:"is_template"`
+	Items      []PackingItem       `json:"items"`
+	Name       string              `json:"name"`
+	TripId     *openapi_types.UUID `json:"trip_id,omitempty"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// Pagination Pagination metadata returned with every list response.
+type Pagination struct {
+	// DefaultLimit Page size used when the request gives no limit.
+	DefaultLimit int `json:"default_limit"`
+	Limit        int `json:"limit"`
+
+	// MaxLimit Largest limit honored; larger requests are cut to it.
+	MaxLimit int `json:"max_limit"`
+	Page     int `json:"page"`
+
+	// Total Total number of items matching the query (across all pages).
+	Total int `json:"total"`
+}
+
+// PatchCustomFieldRequest defines model for PatchCustomFieldRequest.
+type PatchCustomFieldRequest struct {
+	Label string `json:"label"`
+}
+
+// PatchOrganizationMemberRequest defines model for PatchOrganizationMemberRequest.
+type PatchOrganizationMemberRequest struct {
+	// Role Owners manage the organization and its members; members use the logbook.
+	Role OrganizationRole `json:"role"`
+}
+
+// PatchOrganizationRequest defines model for PatchOrganizationRequest.
+type PatchOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// PatchTagRequest defines model for PatchTagRequest.
+type PatchTagRequest struct {
+	Name string `json:"name"`
+}
+
+// PatchTripMemberRequest defines model for PatchTripMemberRequest.
+type PatchTripMemberRequest struct {
+	// Role Editors may change the trip and everything logged against it; viewers may only read it.
+	Role TripMemberRole `json:"role"`
+}
+
+// Photo defines model for Photo.
+type Photo struct {
+	Caption     *string   `json:"caption,omitempty"`
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Filename The name the file was uploaded under, if it had one.
+	Filename *string            `json:"filename,omitempty"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// Latitude Where the photo was taken, from its EXIF GPS position, in decimal degrees.
+	Latitude  *float64           `json:"latitude,omitempty"`
+	Longitude *float64           `json:"longitude,omitempty"`
+	SizeBytes int64              `json:"size_bytes"`
+	StopId    openapi_types.UUID `json:"stop_id"`
+
+	// TakenAt When the photo was taken, from its EXIF metadata. Null unless the
+	// camera recorded its UTC offset or a GPS time stamp.
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+
+	// ThumbnailSizes The sizes GET /photos/{photoId}/thumbnail can return. Empty for
+	// images the server cannot decode, such as WebP.
+	ThumbnailSizes []ThumbnailSize `json:"thumbnail_sizes"`
+}
+
+// PointOfInterest defines model for PointOfInterest.
+type PointOfInterest struct {
+	Category  POICategory         `json:"category"`
+	CreatedAt time.Time           `json:"created_at"`
+	Id        openapi_types.UUID  `json:"id"`
+	Latitude  float64             `json:"latitude"`
+	Longitude float64             `json:"longitude"`
+	Name      string              `json:"name"`
+	Notes     *string             `json:"notes,omitempty"`
+	SeenAt    *time.Time          `json:"seen_at,omitempty"`
+	Tags      []Tag               `json:"tags"`
+	TripId    *openapi_types.UUID `json:"trip_id,omitempty"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// PointOfInterestList defines model for PointOfInterestList.
+type PointOfInterestList struct {
+	Data []PointOfInterest `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// PointOfInterestRequest defines model for PointOfInterestRequest.
+type PointOfInterestRequest struct {
+	Category  POICategory `json:"category"`
+	Latitude  float64     `json:"latitude"`
+	Longitude float64     `json:"longitude"`
+	Name      string      `json:"name"`
+	Notes     *string     `json:"notes,omitempty"`
+
+	// SeenAt When it was seen, for sightings.
+	SeenAt *time.Time `json:"seen_at,omitempty"`
+
+	// Tags Tag names; each is upserted by slug.
+	Tags *[]string `json:"tags,omitempty"`
+
+	// TripId The trip the point was found on, if any.
+	TripId *openapi_types.UUID `json:"trip_id,omitempty"`
+}
+
+// PoolStats defines model for PoolStats.
+type PoolStats struct {
+	// AcquireCount Connections handed out since start.
+	AcquireCount int64 `json:"acquire_count"`
+
+	// AcquireMsTotal Time spent acquiring connections since start, in milliseconds.
+	AcquireMsTotal float64 `json:"acquire_ms_total"`
+
+	// AcquiredConns Connections currently checked out by requests.
+	AcquiredConns int `json:"acquired_conns"`
+
+	// AvgAcquireMs Mean time to acquire a connection; 0 before the first acquire.
+	AvgAcquireMs float64 `json:"avg_acquire_ms"`
+
+	// AvgEmptyAcquireWaitMs Mean wait of the acquires that had to wait; 0 if none have.
+	AvgEmptyAcquireWaitMs float64 `json:"avg_empty_acquire_wait_ms"`
+
+	// CanceledAcquireCount Acquires abandoned because the request's context ended first.
+	CanceledAcquireCount int64 `json:"canceled_acquire_count"`
+
+	// ConstructingConns Connections being opened.
+	ConstructingConns int `json:"constructing_conns"`
+
+	// EmptyAcquireCount Acquires that found no idle connection and had to wait for one.
+	EmptyAcquireCount int64 `json:"empty_acquire_count"`
+
+	// EmptyAcquireWaitMsTotal Time acquires spent waiting for a connection to free up, in milliseconds.
+	EmptyAcquireWaitMsTotal float64 `json:"empty_acquire_wait_ms_total"`
+
+	// IdleConns Open connections waiting in the pool.
+	IdleConns int `json:"idle_conns"`
+
+	// MaxConns The most connections the pool will open.
+	MaxConns int `json:"max_conns"`
+
+	// TotalConns All open connections, acquired, idle, and constructing.
+	TotalConns int `json:"total_conns"`
+}
+
+// PowerDay defines model for PowerDay.
+type PowerDay struct {
+	Date           openapi_types.Date `json:"date"`
+	GeneratorHours float64            `json:"generator_hours"`
+
+	// MinStateOfCharge Lowest state of charge recorded that day. Null if no reading that day had one.
+	MinStateOfCharge *float64 `json:"min_state_of_charge,omitempty"`
+
+	// Readings Number of readings combined into this point.
+	Readings int     `json:"readings"`
+	SolarWh  float64 `json:"solar_wh"`
+}
+
+// PowerReading defines model for PowerReading.
+type PowerReading struct {
+	CreatedAt      time.Time           `json:"created_at"`
+	GeneratorHours *float64            `json:"generator_hours,omitempty"`
+	Id             openapi_types.UUID  `json:"id"`
+	Notes          *string             `json:"notes,omitempty"`
+	RecordedAt     time.Time           `json:"recorded_at"`
+	SolarWh        *float64            `json:"solar_wh,omitempty"`
+	StateOfCharge  *float64            `json:"state_of_charge,omitempty"`
+	TripId         *openapi_types.UUID `json:"trip_id,omitempty"`
+}
+
+// PowerReadingList defines model for PowerReadingList.
+type PowerReadingList struct {
+	Data []PowerReading `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// PropaneFill defines model for PropaneFill.
+type PropaneFill struct {
+	CreatedAt time.Time `json:"created_at"`
+	FilledAt  time.Time `json:"filled_at"`
+
+	// Gallons quantity converted to US gallons.
+	Gallons  float64             `json:"gallons"`
+	Id       openapi_types.UUID  `json:"id"`
+	Location *string             `json:"location,omitempty"`
+	Notes    *string             `json:"notes,omitempty"`
+	Price    *float64            `json:"price,omitempty"`
+	Quantity float64             `json:"quantity"`
+	TripId   *openapi_types.UUID `json:"trip_id,omitempty"`
+	Unit     PropaneFillUnit     `json:"unit"`
+}
+
+// PropaneFillUnit defines model for PropaneFill.Unit.
+type PropaneFillUnit string
+
+// PropaneFillList defines model for PropaneFillList.
+type PropaneFillList struct {
+	Data []PropaneFill `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// PropaneStats defines model for PropaneStats.
+type PropaneStats struct {
+	// AvgPricePerGallon total_cost divided by the gallons of priced fills. Null when no fill is priced.
+	AvgPricePerGallon *float64 `json:"avg_price_per_gallon,omitempty"`
+
+	// Fills Number of fills summarised.
+	Fills       int        `json:"fills"`
+	FirstFillAt *time.Time `json:"first_fill_at,omitempty"`
+
+	// GallonsPerDay Consumption rate. Null with fewer than two fills a day apart.
+	GallonsPerDay *float64   `json:"gallons_per_day,omitempty"`
+	LastFillAt    *time.Time `json:"last_fill_at,omitempty"`
+
+	// TotalCost Sum of price over priced fills.
+	TotalCost    float64 `json:"total_cost"`
+	TotalGallons float64 `json:"total_gallons"`
+}
+
+// QuickLogFuelStop Where a fuel expense was paid, to put the fill-up on the route map.
+type QuickLogFuelStop struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// QuickLogRequest defines model for QuickLogRequest.
+type QuickLogRequest struct {
+	Amount float64 `json:"amount"`
+
+	// FuelStop Where a fuel expense was paid, to put the fill-up on the route map.
+	FuelStop *QuickLogFuelStop `json:"fuel_stop,omitempty"`
+	Note     *string           `json:"note,omitempty"`
+	Type     ExpenseCategory   `json:"type"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	// RefreshToken The refresh_token of an earlier TokenResponse.
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RegionVisit defines model for RegionVisit.
+type RegionVisit struct {
+	// CountryCode ISO 3166-1 alpha-2 country code.
+	CountryCode string `json:"country_code"`
+
+	// FirstVisited Arrival at the earliest stop there.
+	FirstVisited time.Time `json:"first_visited"`
+
+	// LastVisited Arrival at the latest stop there.
+	LastVisited time.Time `json:"last_visited"`
+
+	// RegionCode ISO 3166-2 code of the state, province, or territory; null when
+	// the geocoder gave none.
+	RegionCode *string `json:"region_code,omitempty"`
+	RegionName *string `json:"region_name,omitempty"`
+	Stops      int     `json:"stops"`
+	Trips      int     `json:"trips"`
+}
+
+// Reservation defines model for Reservation.
+type Reservation struct {
+	CancellationDeadline *time.Time         `json:"cancellation_deadline,omitempty"`
+	CheckIn              openapi_types.Date `json:"check_in"`
+	CheckOut             openapi_types.Date `json:"check_out"`
+	ConfirmationNumber   *string            `json:"confirmation_number,omitempty"`
+	Cost                 *float64           `json:"cost,omitempty"`
+	CreatedAt            time.Time          `json:"created_at"`
+	Id                   openapi_types.UUID `json:"id"`
+	Nights               int                `json:"nights"`
+	Notes                *string            `json:"notes,omitempty"`
+	SiteNumber           *string            `json:"site_number,omitempty"`
+	StopId               openapi_types.UUID `json:"stop_id"`
+	UpdatedAt            time.Time          `json:"updated_at"`
+}
+
+// ReservationRequest defines model for ReservationRequest.
+type ReservationRequest struct {
+	// CancellationDeadline Last moment the booking can be cancelled without penalty.
+	CancellationDeadline *time.Time         `json:"cancellation_deadline,omitempty"`
+	CheckIn              openapi_types.Date `json:"check_in"`
+
+	// CheckOut Departure day; must be after check_in.
+	CheckOut           openapi_types.Date `json:"check_out"`
+	ConfirmationNumber *string            `json:"confirmation_number,omitempty"`
+
+	// Cost Total booked. Omit when the site is paid on arrival.
+	Cost       *float64 `json:"cost,omitempty"`
+	Notes      *string  `json:"notes,omitempty"`
+	SiteNumber *string  `json:"site_number,omitempty"`
+}
+
+// Rig defines model for Rig.
+type Rig struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LengthFeet Length in feet, or meters with Units metric.
+	LengthFeet *float64  `json:"length_feet,omitempty"`
+	Make       *string   `json:"make,omitempty"`
+	Model      *string   `json:"model,omitempty"`
+	Name       string    `json:"name"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// WeightLbs Gross vehicle weight rating in pounds, or kilograms with Units metric.
+	WeightLbs *float64 `json:"weight_lbs,omitempty"`
+}
+
+// RigMaintenance defines model for RigMaintenance.
+type RigMaintenance struct {
+	CreatedAt   time.Time          `json:"created_at"`
+	EngineHours *float64           `json:"engine_hours,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	Name        string             `json:"name"`
+	Notes       *string            `json:"notes,omitempty"`
+
+	// OdometerMiles In miles, or kilometers with Units metric.
+	OdometerMiles *float64           `json:"odometer_miles,omitempty"`
+	RigId         openapi_types.UUID `json:"rig_id"`
+	ServicedOn    openapi_types.Date `json:"serviced_on"`
+}
+
+// RigMaintenanceRequest defines model for RigMaintenanceRequest.
+type RigMaintenanceRequest struct {
+	// EngineHours The engine or generator hour meter when the work was done.
+	EngineHours *float64 `json:"engine_hours,omitempty"`
+	Name        string   `json:"name"`
+	Notes       *string  `json:"notes,omitempty"`
+
+	// OdometerMiles The odometer reading when the work was done.
+	OdometerMiles *float64           `json:"odometer_miles,omitempty"`
+	ServicedOn    openapi_types.Date `json:"serviced_on"`
+}
+
+// RigRequest defines model for RigRequest.
+type RigRequest struct {
+	LengthFeet *float64 `json:"length_feet,omitempty"`
+	Make       *string  `json:"make,omitempty"`
+	Model      *string  `json:"model,omitempty"`
+
+	// Name What the rig is called. Using the vehicle label its odometer
+	// readings and maintenance items are logged under keeps them easy
+	// to match up.
+	Name string `json:"name"`
+
+	// WeightLbs Gross vehicle weight rating.
+	WeightLbs *float64 `json:"weight_lbs,omitempty"`
+}
+
+// RouteLeg defines model for RouteLeg.
+type RouteLeg struct {
+	// AscentFeet Total climb along the leg, from its elevation profile; null until
+	// the profile has been fetched.
+	AscentFeet *float64  `json:"ascent_feet,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// DescentFeet Total descent along the leg; null until the elevation profile has been fetched.
+	DescentFeet     *float64           `json:"descent_feet,omitempty"`
+	DistanceMiles   *float64           `json:"distance_miles,omitempty"`
+	DurationMinutes *int               `json:"duration_minutes,omitempty"`
+	FromStopId      openapi_types.UUID `json:"from_stop_id"`
+	Id              openapi_types.UUID `json:"id"`
+
+	// Polyline The route driven, in Google's encoded polyline format. Simplified
+	// from the uploaded track when there is one.
+	Polyline *string            `json:"polyline,omitempty"`
+	ToStopId openapi_types.UUID `json:"to_stop_id"`
+
+	// TrackPoints Number of points in the uploaded GPX track; null when none has been uploaded.
+	TrackPoints     *int               `json:"track_points,omitempty"`
+	TrackUploadedAt *time.Time         `json:"track_uploaded_at,omitempty"`
+	TripId          openapi_types.UUID `json:"trip_id"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// RouteLegRequest defines model for RouteLegRequest.
+type RouteLegRequest struct {
+	DistanceMiles   *float64 `json:"distance_miles,omitempty"`
+	DurationMinutes *int     `json:"duration_minutes,omitempty"`
+	Polyline        *string  `json:"polyline,omitempty"`
+}
+
+// SetTagParentRequest defines model for SetTagParentRequest.
+type SetTagParentRequest struct {
+	// Parent Slug of the tag to put this one under.
+	Parent string `json:"parent"`
+}
+
+// Settlement defines model for Settlement.
+type Settlement struct {
+	// Balances One entry per co-traveller, by name.
+	Balances []SettlementBalance `json:"balances"`
+
+	// Total The sum of the trip's claimed expenses.
+	Total float64 `json:"total"`
+
+	// Transfers Payments that settle every balance, largest debts first.
+	Transfers []SettlementTransfer `json:"transfers"`
+	TripId    openapi_types.UUID   `json:"trip_id"`
+}
+
+// SettlementBalance defines model for SettlementBalance.
+type SettlementBalance struct {
+	Name string `json:"name"`
+
+	// Net Paid less owed; positive when the others owe them.
+	Net float64 `json:"net"`
+
+	// Owed Their share of the expenses split among them.
+	Owed float64 `json:"owed"`
+	Paid float64 `json:"paid"`
+}
+
+// SettlementTransfer defines model for SettlementTransfer.
+type SettlementTransfer struct {
+	Amount float64 `json:"amount"`
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+}
+
+// Share defines model for Share.
+type Share struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+	TripId    openapi_types.UUID `json:"trip_id"`
+}
+
+// ShareLink defines model for ShareLink.
+type ShareLink struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Token Signed share token. Shown only once.
+	Token  string             `json:"token"`
+	TripId openapi_types.UUID `json:"trip_id"`
+}
+
+// ShareList defines model for ShareList.
+type ShareList struct {
+	Data []Share `json:"data"`
+}
+
+// SharedTrip defines model for SharedTrip.
+type SharedTrip struct {
+	// ExpiresAt When the share link stops working.
+	ExpiresAt time.Time `json:"expires_at"`
+	Stops     []Stop    `json:"stops"`
+	Trip      Trip      `json:"trip"`
+}
+
+// SharedTripEmbed defines model for SharedTripEmbed.
+type SharedTripEmbed struct {
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// ExpiresAt When the share link, and so the embed, stops working.
+	ExpiresAt time.Time          `json:"expires_at"`
+	Name      string             `json:"name"`
+	StartDate openapi_types.Date `json:"start_date"`
+
+	// Stops Stops in arrival order, planned stops last.
+	Stops []SharedTripEmbedStop `json:"stops"`
+}
+
+// SharedTripEmbedStop defines model for SharedTripEmbedStop.
+type SharedTripEmbedStop struct {
+	// ArrivedAt Null for a planned stop.
+	ArrivedAt *time.Time `json:"arrived_at,omitempty"`
+	Latitude  *float64   `json:"latitude,omitempty"`
+	Location  *string    `json:"location,omitempty"`
+	Longitude *float64   `json:"longitude,omitempty"`
+	Name      string     `json:"name"`
+	Planned   bool       `json:"planned"`
+
+	// Tags The stop's tag names.
+	Tags []string `json:"tags"`
+}
+
+// StartChecklistRequest defines model for StartChecklistRequest.
+type StartChecklistRequest struct {
+	TemplateId openapi_types.UUID `json:"template_id"`
+}
+
+// StatesVisitedReport defines model for StatesVisitedReport.
+type StatesVisitedReport struct {
+	Regions []RegionVisit `json:"regions"`
+
+	// UnresolvedStops Stops with coordinates whose region has not been looked up yet.
+	UnresolvedStops int `json:"unresolved_stops"`
+}
+
+// Stop defines model for Stop.
+type Stop struct {
+	// ArrivedAt Null while the stop is planned.
+	ArrivedAt *time.Time `json:"arrived_at"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// Daylight Sunrise and sunset on each day from arrival to departure, or on
+	// the day of arrival while the stop has not been left; at most 31
+	// days. Only on GET /trips/{tripId}/stops/{stopId}, and only for a
+	// stop with coordinates and an arrival.
+	Daylight   *[]SunDay          `json:"daylight,omitempty"`
+	DepartedAt *time.Time         `json:"departed_at,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Latitude Decimal degrees. Give latitude and longitude together or not at all.
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Location  *string  `json:"location,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Metadata Custom field values by key; see /custom-fields. Each key must be
+	// defined for the entity and each value must match the field's type:
+	// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+	// date. A null value removes the field. On update, omitting metadata
+	// keeps the current values, and the object given replaces them.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Name     string    `json:"name"`
+
+	// NoteAnswers Answers to the stop's note template, by question key. Blank answers
+	// are dropped, and an answer to a question with choices must be one
+	// of them. Set only when the stop is created.
+	NoteAnswers *NoteAnswers `json:"note_answers,omitempty"`
+
+	// NoteTemplateId The note template the stop was created from; null if none, or
+	// once the template is deleted.
+	NoteTemplateId *openapi_types.UUID `json:"note_template_id,omitempty"`
+	Notes          *string             `json:"notes,omitempty"`
+
+	// OdometerMiles The odometer on arrival, in miles. Not converted by Units.
+	OdometerMiles *float64 `json:"odometer_miles,omitempty"`
+
+	// Planned The stop has not been reached yet.
+	Planned bool `json:"planned"`
+
+	// Tags Tags linked to this stop, ordered by slug.
+	Tags      *[]Tag             `json:"tags,omitempty"`
+	TripId    openapi_types.UUID `json:"trip_id"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// StopCluster defines model for StopCluster.
+type StopCluster struct {
+	// Bounds The box around a cluster's stops.
+	Bounds BoundingBox `json:"bounds"`
+
+	// Count Number of stops in the cluster.
+	Count int `json:"count"`
+
+	// Latitude Mean latitude of the cluster's stops; where to draw the marker.
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+
+	// Stop The stop a cluster of one stands for.
+	Stop *ClusteredStop `json:"stop,omitempty"`
+}
+
+// StopHeatmap defines model for StopHeatmap.
+type StopHeatmap struct {
+	// CellDegrees Grid cell size the points were summed over.
+	CellDegrees float64 `json:"cell_degrees"`
+
+	// MaxNights The largest weight among the points; 0 when there are none.
+	MaxNights int `json:"max_nights"`
+
+	// Points One [latitude, longitude, nights] triple per cell, most nights
+	// first.
+	Points [][]float64 `json:"points"`
+}
+
+// StopImportResult What came of importing stops from a file.
+type StopImportResult struct {
+	// Created The stops created, in file order.
+	Created []Stop `json:"created"`
+
+	// Failed The items of the file that were not imported, in file order.
+	Failed []ImportFailure `json:"failed"`
+}
+
+// StopList defines model for StopList.
+type StopList struct {
+	Data []Stop `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// StopOccupancy defines model for StopOccupancy.
+type StopOccupancy struct {
+	GapNights     int         `json:"gap_nights"`
+	OffTripNights int         `json:"off_trip_nights"`
+	Spans         []NightSpan `json:"spans"`
+	StopNights    int         `json:"stop_nights"`
+	Year          int         `json:"year"`
+}
+
+// StopReassignRequest defines model for StopReassignRequest.
+type StopReassignRequest struct {
+	// StopIds The stops to move. Omitted or empty moves every stop.
+	StopIds *[]openapi_types.UUID `json:"stop_ids,omitempty"`
+
+	// TargetTripId The trip to move the stops onto.
+	TargetTripId openapi_types.UUID `json:"target_trip_id"`
+}
+
+// StopRevision defines model for StopRevision.
+type StopRevision struct {
+	// ArrivedAt Null while the stop was planned.
+	ArrivedAt  *time.Time `json:"arrived_at"`
+	DepartedAt *time.Time `json:"departed_at,omitempty"`
+	Latitude   *float64   `json:"latitude,omitempty"`
+	Location   *string    `json:"location,omitempty"`
+	Longitude  *float64   `json:"longitude,omitempty"`
+	Name       string     `json:"name"`
+	Notes      *string    `json:"notes,omitempty"`
+	Planned    bool       `json:"planned"`
+
+	// RecordedAt When this version was saved.
+	RecordedAt time.Time          `json:"recorded_at"`
+	Revision   int                `json:"revision"`
+	StopId     openapi_types.UUID `json:"stop_id"`
+}
+
+// StopSuggestion defines model for StopSuggestion.
+type StopSuggestion struct {
+	ArrivedAt time.Time `json:"arrived_at"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// DepartedAt The first report from outside the geofence; null while the rig is still there.
+	DepartedAt *time.Time         `json:"departed_at,omitempty"`
+	Device     string             `json:"device"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// LastSeenAt The latest report from inside the geofence.
+	LastSeenAt time.Time          `json:"last_seen_at"`
+	Latitude   float64            `json:"latitude"`
+	Longitude  float64            `json:"longitude"`
+	TripId     openapi_types.UUID `json:"trip_id"`
+}
+
+// SunDay defines model for SunDay.
+type SunDay struct {
+	// Date The day, by local solar time at the stop.
+	Date openapi_types.Date `json:"date"`
+
+	// Sunrise Null on a day the sun does not rise.
+	Sunrise *time.Time `json:"sunrise,omitempty"`
+
+	// Sunset Null on a day the sun does not set.
+	Sunset *time.Time `json:"sunset,omitempty"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// Parent Slug of the tag this one sits under. Not set on the tags embedded in a stop.
+	Parent *string `json:"parent,omitempty"`
+	Slug   string  `json:"slug"`
+}
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+
+	// Stops Stops carrying the tag.
+	Stops int `json:"stops"`
+}
+
+// TagList defines model for TagList.
+type TagList struct {
+	Data []Tag `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// TagStats defines model for TagStats.
+type TagStats struct {
+	Name string `json:"name"`
+
+	// Parent Slug of the tag this one sits under.
+	Parent *string `json:"parent,omitempty"`
+	Slug   string  `json:"slug"`
+
+	// Stops Stops carrying the tag itself.
+	Stops int `json:"stops"`
+
+	// TotalStops Stops carrying the tag or any tag beneath it.
+	TotalStops int `json:"total_stops"`
+}
+
+// TankLevel defines model for TankLevel.
+type TankLevel struct {
+	BlackPct   *int               `json:"black_pct,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+	FreshPct   *int               `json:"fresh_pct,omitempty"`
+	GreyPct    *int               `json:"grey_pct,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	Notes      *string            `json:"notes,omitempty"`
+	RecordedAt time.Time          `json:"recorded_at"`
+	StopId     openapi_types.UUID `json:"stop_id"`
+}
+
+// ThumbnailSize defines model for ThumbnailSize.
+type ThumbnailSize string
+
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	// AccessToken Send in the Authorization header as Bearer <token>.
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn Seconds until the access token expires.
+	ExpiresIn int `json:"expires_in"`
+
+	// RefreshToken Trade for a new pair at POST /auth/refresh.
+	RefreshToken string `json:"refresh_token"`
+
+	// TokenType Always Bearer.
+	TokenType string `json:"token_type"`
+}
+
+// Tombstone defines model for Tombstone.
+type Tombstone struct {
+	// DeletedAt When the trip or stop was deleted for good.
+	DeletedAt time.Time          `json:"deleted_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Kind      TombstoneKind      `json:"kind"`
+}
+
+// TombstoneKind defines model for Tombstone.Kind.
+type TombstoneKind string
+
+// TrashItem defines model for TrashItem.
+type TrashItem struct {
+	DeletedAt time.Time          `json:"deleted_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Kind      TrashItemKind      `json:"kind"`
+	Name      string             `json:"name"`
+
+	// PurgeAt When the item will be deleted for good.
+	PurgeAt time.Time `json:"purge_at"`
+
+	// TripId The stop's trip, or the trip itself.
+	TripId   openapi_types.UUID `json:"trip_id"`
+	TripName string             `json:"trip_name"`
+}
+
+// TrashItemKind defines model for TrashItem.Kind.
+type TrashItemKind string
+
+// Trip defines model for Trip.
+type Trip struct {
+	// BudgetCents What the trip is expected to cost, in cents, if a budget was set.
+	BudgetCents *int64    `json:"budget_cents,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// DistanceTraveled Miles between the lowest and highest odometer readings on the
+	// trip's stops, or kilometers with Units: metric. Null until two
+	// stops have a reading.
+	DistanceTraveled *float64            `json:"distance_traveled,omitempty"`
+	EndDate          *openapi_types.Date `json:"end_date,omitempty"`
+	Id               openapi_types.UUID  `json:"id"`
+
+	// Metadata Custom field values by key; see /custom-fields. Each key must be
+	// defined for the entity and each value must match the field's type:
+	// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+	// date. A null value removes the field. On update, omitting metadata
+	// keeps the current values, and the object given replaces them.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Name     string    `json:"name"`
+	Notes    *string   `json:"notes,omitempty"`
+
+	// RigId The rig the trip was taken in, if one was recorded.
+	RigId     *openapi_types.UUID `json:"rig_id,omitempty"`
+	StartDate openapi_types.Date  `json:"start_date"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// TripCloneRequest defines model for TripCloneRequest.
+type TripCloneRequest struct {
+	// Include What to copy besides the trip itself. Tags and checklists belong
+	// to stops, so they need stops too. Expenses tied to a stop that is
+	// not copied are kept without one. Defaults to stops and tags.
+	Include *[]TripCloneRequestInclude `json:"include,omitempty"`
+
+	// Name The copy's name. Defaults to the trip's name followed by " (copy)".
+	Name *string `json:"name,omitempty"`
+
+	// StartDate The day the copy starts. Defaults to the trip's own start date.
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// TripCloneRequestInclude defines model for TripCloneRequest.Include.
+type TripCloneRequestInclude string
+
+// TripGap defines model for TripGap.
+type TripGap struct {
+	FirstNight openapi_types.Date `json:"first_night"`
+	LastNight  openapi_types.Date `json:"last_night"`
+
+	// NextStopId The stop just after the gap; null when it ends the trip.
+	NextStopId   *openapi_types.UUID `json:"next_stop_id,omitempty"`
+	NextStopName *string             `json:"next_stop_name,omitempty"`
+	Nights       int                 `json:"nights"`
+
+	// PreviousStopId The stop just before the gap; null when it starts the trip.
+	PreviousStopId   *openapi_types.UUID `json:"previous_stop_id,omitempty"`
+	PreviousStopName *string             `json:"previous_stop_name,omitempty"`
+}
+
+// TripGaps defines model for TripGaps.
+type TripGaps struct {
+	// GapNights Nights across all the gaps.
+	GapNights int                `json:"gap_nights"`
+	Gaps      []TripGap          `json:"gaps"`
+	TripId    openapi_types.UUID `json:"trip_id"`
+}
+
+// TripList defines model for TripList.
+type TripList struct {
+	Data []Trip `json:"data"`
+
+	// Pagination Pagination metadata returned with every list response.
+	Pagination Pagination `json:"pagination"`
+}
+
+// TripMember defines model for TripMember.
+type TripMember struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Role Editors may change the trip and everything logged against it; viewers may only read it.
+	Role     TripMemberRole     `json:"role"`
+	TripId   openapi_types.UUID `json:"trip_id"`
+	UserId   openapi_types.UUID `json:"user_id"`
+	Username string             `json:"username"`
+}
+
+// TripMemberRole Editors may change the trip and everything logged against it; viewers may only read it.
+type TripMemberRole string
+
+// TripMileage defines model for TripMileage.
+type TripMileage struct {
+	// TotalMiles Sum of miles across all vehicles.
+	TotalMiles float64            `json:"total_miles"`
+	TripId     openapi_types.UUID `json:"trip_id"`
+	Vehicles   []VehicleMileage   `json:"vehicles"`
+}
+
+// TripPower defines model for TripPower.
+type TripPower struct {
+	Days []PowerDay `json:"days"`
+
+	// MinStateOfCharge Lowest state of charge recorded on the trip.
+	MinStateOfCharge    *float64           `json:"min_state_of_charge,omitempty"`
+	TotalGeneratorHours float64            `json:"total_generator_hours"`
+	TotalSolarWh        float64            `json:"total_solar_wh"`
+	TripId              openapi_types.UUID `json:"trip_id"`
+}
+
+// TripRevision defines model for TripRevision.
+type TripRevision struct {
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+	Name    string              `json:"name"`
+	Notes   *string             `json:"notes,omitempty"`
+
+	// RecordedAt When this version was saved.
+	RecordedAt time.Time          `json:"recorded_at"`
+	Revision   int                `json:"revision"`
+	StartDate  openapi_types.Date `json:"start_date"`
+	TripId     openapi_types.UUID `json:"trip_id"`
+}
+
+// TripStats defines model for TripStats.
+type TripStats struct {
+	Legs int `json:"legs"`
+
+	// LegsMissingDistance Legs with no distance entered; they add nothing to total_distance_miles.
+	LegsMissingDistance  int                `json:"legs_missing_distance"`
+	Stops                int                `json:"stops"`
+	TotalDistanceMiles   float64            `json:"total_distance_miles"`
+	TotalDurationMinutes int                `json:"total_duration_minutes"`
+	TripId               openapi_types.UUID `json:"trip_id"`
+}
+
+// TripSummary defines model for TripSummary.
+type TripSummary struct {
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// FirstArrivedAt Arrival at the trip's earliest stop; absent when it has none.
+	FirstArrivedAt *time.Time `json:"first_arrived_at,omitempty"`
+
+	// LastArrivedAt Arrival at the trip's latest stop; absent when it has none.
+	LastArrivedAt *time.Time         `json:"last_arrived_at,omitempty"`
+	Name          string             `json:"name"`
+	Nights        int                `json:"nights"`
+	StartDate     openapi_types.Date `json:"start_date"`
+	Stops         int                `json:"stops"`
+	TripId        openapi_types.UUID `json:"trip_id"`
+}
+
+// UnitSystem defines model for UnitSystem.
+type UnitSystem string
+
+// UpcomingReservation defines model for UpcomingReservation.
+type UpcomingReservation struct {
+	// CancellationReminder True when the cancellation deadline is still ahead but within remind_days.
+	CancellationReminder bool `json:"cancellation_reminder"`
+
+	// DaysUntilCheckIn Days from today (UTC) to check-in; zero or negative once the stay has started.
+	DaysUntilCheckIn int                `json:"days_until_check_in"`
+	Reservation      Reservation        `json:"reservation"`
+	StopName         string             `json:"stop_name"`
+	TripId           openapi_types.UUID `json:"trip_id"`
+}
+
+// UpdatePackingItemRequest defines model for UpdatePackingItemRequest.
+type UpdatePackingItemRequest struct {
+	Name     string `json:"name"`
+	Packed   bool   `json:"packed"`
+	Quantity int    `json:"quantity"`
+}
+
+// UpdatePackingListRequest defines model for UpdatePackingListRequest.
+type UpdatePackingListRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateStopRequest defines model for UpdateStopRequest.
+type UpdateStopRequest struct {
+	// ArrivedAt Required unless planned is true, and omitted when it is.
+	ArrivedAt  *time.Time `json:"arrived_at,omitempty"`
+	DepartedAt *time.Time `json:"departed_at,omitempty"`
+
+	// Latitude Decimal degrees. Give latitude and longitude together or not at all.
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Location  *string  `json:"location,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Metadata Custom field values by key; see /custom-fields. Each key must be
+	// defined for the entity and each value must match the field's type:
+	// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+	// date. A null value removes the field. On update, omitting metadata
+	// keeps the current values, and the object given replaces them.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Name     string    `json:"name"`
+	Notes    *string   `json:"notes,omitempty"`
+
+	// OdometerMiles The odometer on arrival, in miles whatever the Units header says.
+	// Readings must rise with arrival time across the trip's stops, and
+	// a planned stop has none.
+	OdometerMiles *float64 `json:"odometer_miles,omitempty"`
+
+	// Planned A stop not reached yet. It has no arrived_at until it is marked
+	// as reached with POST /trips/{tripId}/stops/{stopId}/arrive, and
+	// is listed after the stops already reached.
+	Planned *bool `json:"planned,omitempty"`
+}
+
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	// BudgetCents What the trip is expected to cost, in cents; omit or send null for no budget.
+	BudgetCents *int64              `json:"budget_cents,omitempty"`
+	EndDate     *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Metadata Custom field values by key; see /custom-fields. Each key must be
+	// defined for the entity and each value must match the field's type:
+	// a string for text, a number, a boolean, or a YYYY-MM-DD string for
+	// date. A null value removes the field. On update, omitting metadata
+	// keeps the current values, and the object given replaces them.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Name     string    `json:"name"`
+	Notes    *string   `json:"notes,omitempty"`
+
+	// RigId The rig the trip is taken in; omit or send null for none.
+	RigId     *openapi_types.UUID `json:"rig_id,omitempty"`
+	StartDate openapi_types.Date  `json:"start_date"`
+}
+
+// VehicleMileage defines model for VehicleMileage.
+type VehicleMileage struct {
+	// EndMiles The vehicle's latest reading on the trip.
+	EndMiles float64 `json:"end_miles"`
+
+	// Miles end_miles minus start_miles.
+	Miles float64 `json:"miles"`
+
+	// Readings Number of readings the figure is derived from.
+	Readings int `json:"readings"`
+
+	// StartMiles The vehicle's earliest reading on the trip.
+	StartMiles float64 `json:"start_miles"`
+	Vehicle    string  `json:"vehicle"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active      bool               `json:"active"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Description *string            `json:"description,omitempty"`
+	Events      []WebhookEvent     `json:"events"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// Secret The signing secret. Only returned on create and rotate-secret.
+	Secret    *string   `json:"secret,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Url       string    `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	AttemptedAt time.Time `json:"attempted_at"`
+	DurationMs  int       `json:"duration_ms"`
+
+	// Error Why no response came back.
+	Error *string `json:"error,omitempty"`
+
+	// Event The event delivered, or ping for a test delivery.
+	Event string `json:"event"`
+
+	// Id Also sent as the payload's id and the X-Webhook-Delivery header.
+	Id openapi_types.UUID `json:"id"`
+
+	// Payload The body POSTed to a webhook. For trip events data holds the trip's
+	// id, name, start_date, and end_date; trip.deleted carries only id.
+	// For stop events data holds the stop's id, trip_id, name, location,
+	// latitude, longitude, arrived_at, and departed_at; stop.deleted
+	// carries only id and trip_id.
+	// maintenance.due carries the item_id, vehicle, name, current_miles,
+	// due_at_miles, miles_remaining, and status.
+	// Every other event is about a record logged on a trip or the rig, and
+	// its data holds only the record's id, trip_id, and stop_id; fetch the
+	// record for the rest. trip_id is left out for a propane fill, power
+	// reading, or odometer reading logged against no trip, and stop_id for
+	// a record not tied to a stop and from expense.deleted and
+	// journal_entry.deleted.
+	Payload WebhookPayload `json:"payload"`
+
+	// StatusCode The subscriber's HTTP status; null when no response came back.
+	StatusCode *int `json:"status_code,omitempty"`
+
+	// Succeeded Whether the subscriber answered with a 2xx status.
+	Succeeded bool               `json:"succeeded"`
+	WebhookId openapi_types.UUID `json:"webhook_id"`
+}
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent string
+
+// WebhookPayload The body POSTed to a webhook. For trip events data holds the trip's
+// id, name, start_date, and end_date; trip.deleted carries only id.
+// For stop events data holds the stop's id, trip_id, name, location,
+// latitude, longitude, arrived_at, and departed_at; stop.deleted
+// carries only id and trip_id.
+// maintenance.due carries the item_id, vehicle, name, current_miles,
+// due_at_miles, miles_remaining, and status.
+// Every other event is about a record logged on a trip or the rig, and
+// its data holds only the record's id, trip_id, and stop_id; fetch the
+// record for the rest. trip_id is left out for a propane fill, power
+// reading, or odometer reading logged against no trip, and stop_id for
+// a record not tied to a stop and from expense.deleted and
+// journal_entry.deleted.
+type WebhookPayload struct {
+	Data       map[string]interface{} `json:"data"`
+	Event      string                 `json:"event"`
+	Id         openapi_types.UUID     `json:"id"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	Active      *bool          `json:"active,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Events      []WebhookEvent `json:"events"`
+	Url         string         `json:"url"`
+}
+
+// DryRun defines model for DryRun.
+type DryRun = bool
+
+// FieldFilter defines model for FieldFilter.
+type FieldFilter = []string
+
+// IfModifiedSince defines model for IfModifiedSince.
+type IfModifiedSince = string
+
+// Units defines model for Units.
+type Units = UnitSystem
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// AdminNormalizeLocationsParams defines parameters for AdminNormalizeLocations.
+type AdminNormalizeLocationsParams struct {
+	// DryRun Report what the fix would change without changing anything. The
+	// preview is exactly what a real run would do at that moment.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// AdminReassignStopsParams defines parameters for AdminReassignStops.
+type AdminReassignStopsParams struct {
+	// DryRun Report what the fix would change without changing anything. The
+	// preview is exactly what a real run would do at that moment.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// AdminShiftTripDatesParams defines parameters for AdminShiftTripDates.
+type AdminShiftTripDatesParams struct {
+	// DryRun Report what the fix would change without changing anything. The
+	// preview is exactly what a real run would do at that moment.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetBorderCrossingReportParams defines parameters for GetBorderCrossingReport.
+type GetBorderCrossingReportParams struct {
+	Year int `form:"year" json:"year"`
+
+	// Format Response format. Defaults to JSON.
+	Format *GetBorderCrossingReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBorderCrossingReportParamsFormat defines parameters for GetBorderCrossingReport.
+type GetBorderCrossingReportParamsFormat string
+
+// ListCustomFieldsParams defines parameters for ListCustomFields.
+type ListCustomFieldsParams struct {
+	// Entity Only list the fields defined for this entity.
+	Entity *CustomFieldEntity `form:"entity,omitempty" json:"entity,omitempty"`
+}
+
+// GetExportParams defines parameters for GetExport.
+type GetExportParams struct {
+	// Columns Comma-separated CSV columns, written in the order given. Any of
+	// the ExportRow fields: trip_id, trip_name, trip_start_date,
+	// trip_end_date, stop_name, stop_location, arrived_at, departed_at,
+	// stop_notes, tags, latitude, longitude, state, distance, cost, and
+	// categories. Ignored for JSON, which always has every field.
+	Columns *string `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// Format Response format. Overrides the Accept header when provided.
+	Format *GetExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+
+	// Accept The media types the client takes, as in RFC 9110; the most
+	// preferred one an export format produces wins. JSON when none does.
+	Accept *string `json:"Accept,omitempty"`
+}
+
+// GetExportParamsFormat defines parameters for GetExport.
+type GetExportParamsFormat string
+
+// ListExportDeletionsParams defines parameters for ListExportDeletions.
+type ListExportDeletionsParams struct {
+	// Since Return only the deletions at or after this time.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetHealthParams defines parameters for GetHealth.
+type GetHealthParams struct {
+	// Detail Check every component and include the results.
+	Detail *bool `form:"detail,omitempty" json:"detail,omitempty"`
+}
+
+// ListMaintenanceDueParams defines parameters for ListMaintenanceDue.
+type ListMaintenanceDueParams struct {
+	// WithinMiles How close to its next service an item counts as due soon.
+	WithinMiles *float64 `form:"within_miles,omitempty" json:"within_miles,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListMaintenanceItemsParams defines parameters for ListMaintenanceItems.
+type ListMaintenanceItemsParams struct {
+	// Vehicle Only items for this vehicle.
+	Vehicle *string `form:"vehicle,omitempty" json:"vehicle,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreateMaintenanceItemParams defines parameters for CreateMaintenanceItem.
+type CreateMaintenanceItemParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreateMaintenanceRecordParams defines parameters for CreateMaintenanceRecord.
+type CreateMaintenanceRecordParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListOdometerReadingsParams defines parameters for ListOdometerReadings.
+type ListOdometerReadingsParams struct {
+	// Vehicle Only readings for this vehicle.
+	Vehicle *string `form:"vehicle,omitempty" json:"vehicle,omitempty"`
+
+	// TripId Only readings linked to this trip.
+	TripId *openapi_types.UUID `form:"trip_id,omitempty" json:"trip_id,omitempty"`
+
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreateOdometerReadingParams defines parameters for CreateOdometerReading.
+type CreateOdometerReadingParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetOdometerReadingParams defines parameters for GetOdometerReading.
+type GetOdometerReadingParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListPackingListsParams defines parameters for ListPackingLists.
+type ListPackingListsParams struct {
+	// TripId Only lists attached to this trip.
+	TripId *openapi_types.UUID `form:"trip_id,omitempty" json:"trip_id,omitempty"`
+
+	// Templates Only templates — lists not attached to any trip.
+	Templates *bool `form:"templates,omitempty" json:"templates,omitempty"`
+}
+
+// GetPhotoThumbnailParams defines parameters for GetPhotoThumbnail.
+type GetPhotoThumbnailParams struct {
+	// Size Which thumbnail to return. Defaults to small.
+	Size *ThumbnailSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListPointsOfInterestParams defines parameters for ListPointsOfInterest.
+type ListPointsOfInterestParams struct {
+	// TripId Only points linked to this trip.
+	TripId *openapi_types.UUID `form:"trip_id,omitempty" json:"trip_id,omitempty"`
+
+	// Category Only points in this category.
+	Category *POICategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Tag Only points carrying this tag (name or slug) or a tag beneath it.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListPowerReadingsParams defines parameters for ListPowerReadings.
+type ListPowerReadingsParams struct {
+	// TripId Only readings linked to this trip.
+	TripId *openapi_types.UUID `form:"trip_id,omitempty" json:"trip_id,omitempty"`
+
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListPropaneFillsParams defines parameters for ListPropaneFills.
+type ListPropaneFillsParams struct {
+	// TripId Only fills linked to this trip.
+	TripId *openapi_types.UUID `form:"trip_id,omitempty" json:"trip_id,omitempty"`
+
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreatePropaneFillParams defines parameters for CreatePropaneFill.
+type CreatePropaneFillParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetPropaneFillParams defines parameters for GetPropaneFill.
+type GetPropaneFillParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListUpcomingReservationsParams defines parameters for ListUpcomingReservations.
+type ListUpcomingReservationsParams struct {
+	// RemindDays How many days ahead of a cancellation deadline to flag it.
+	RemindDays *int `form:"remind_days,omitempty" json:"remind_days,omitempty"`
+}
+
+// ListRigsParams defines parameters for ListRigs.
+type ListRigsParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreateRigParams defines parameters for CreateRig.
+type CreateRigParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetRigParams defines parameters for GetRig.
+type GetRigParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// UpdateRigParams defines parameters for UpdateRig.
+type UpdateRigParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListRigMaintenanceParams defines parameters for ListRigMaintenance.
+type ListRigMaintenanceParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreateRigMaintenanceParams defines parameters for CreateRigMaintenance.
+type CreateRigMaintenanceParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetSharedTripParams defines parameters for GetSharedTrip.
+type GetSharedTripParams struct {
+	// IfModifiedSince The Last-Modified value of a copy the client already has. When
+	// nothing it covers has changed since, the response is 304 Not
+	// Modified with no body.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetSharedTripEmbedParams defines parameters for GetSharedTripEmbed.
+type GetSharedTripEmbedParams struct {
+	// Format Response format. Defaults to JSON.
+	Format *GetSharedTripEmbedParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetSharedTripEmbedParamsFormat defines parameters for GetSharedTripEmbed.
+type GetSharedTripEmbedParamsFormat string
+
+// GetDashboardParams defines parameters for GetDashboard.
+type GetDashboardParams struct {
+	// Tags How many of the most used tags to include.
+	Tags *int `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// IfModifiedSince The Last-Modified value of a copy the client already has. When
+	// nothing it covers has changed since, the response is 304 Not
+	// Modified with no body.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetStopHeatmapParams defines parameters for GetStopHeatmap.
+type GetStopHeatmapParams struct {
+	// Year Only stops arrived at in this calendar year (UTC).
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
+
+	// Cell Grid cell size in degrees of latitude and longitude.
+	Cell *float64 `form:"cell,omitempty" json:"cell,omitempty"`
+
+	// IfModifiedSince The Last-Modified value of a copy the client already has. When
+	// nothing it covers has changed since, the response is 304 Not
+	// Modified with no body.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetPropaneStatsParams defines parameters for GetPropaneStats.
+type GetPropaneStatsParams struct {
+	// TripId Only fills linked to this trip.
+	TripId *openapi_types.UUID `form:"trip_id,omitempty" json:"trip_id,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+
+	// IfModifiedSince The Last-Modified value of a copy the client already has. When
+	// nothing it covers has changed since, the response is 304 Not
+	// Modified with no body.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// GetStatesVisitedParams defines parameters for GetStatesVisited.
+type GetStatesVisitedParams struct {
+	// Year Only stops arrived at in this calendar year (UTC).
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// ListStopClustersParams defines parameters for ListStopClusters.
+type ListStopClustersParams struct {
+	// Bbox west,south,east,north in decimal degrees, as Leaflet's
+	// LatLngBounds.toBBoxString() gives them.
+	Bbox []float64 `form:"bbox" json:"bbox"`
+	Zoom int       `form:"zoom" json:"zoom"`
+}
+
+// ListNearbyStopsParams defines parameters for ListNearbyStops.
+type ListNearbyStopsParams struct {
+	Lat float64  `form:"lat" json:"lat"`
+	Lon *float64 `form:"lon,omitempty" json:"lon,omitempty"`
+
+	// Lng An alias of lon.
+	Lng *float64 `form:"lng,omitempty" json:"lng,omitempty"`
+
+	// RadiusKm Search radius. The default is about 50 miles.
+	RadiusKm *float64 `form:"radius_km,omitempty" json:"radius_km,omitempty"`
+}
+
+// GetStopOccupancyParams defines parameters for GetStopOccupancy.
+type GetStopOccupancyParams struct {
+	// Year The calendar year; the current one when omitted.
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// ListTagsParams defines parameters for ListTags.
+type ListTagsParams struct {
+	// Q Filter by slug prefix (case-insensitive).
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IfModifiedSince The Last-Modified value of a copy the client already has. When
+	// nothing it covers has changed since, the response is 304 Not
+	// Modified with no body.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// ListTripsParams defines parameters for ListTrips.
+type ListTripsParams struct {
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Field A custom field filter, key:value, e.g. pets_allowed:true. The value
+	// is read as the field's type. Repeat it to require several fields;
+	// only records whose metadata matches every filter are listed.
+	Field *FieldFilter `form:"field,omitempty" json:"field,omitempty"`
+
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CreateTripParams defines parameters for CreateTrip.
+type CreateTripParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetTripParams defines parameters for GetTrip.
+type GetTripParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// UpdateTripParams defines parameters for UpdateTrip.
+type UpdateTripParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// CloneTripParams defines parameters for CloneTrip.
+type CloneTripParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// RevertTripParams defines parameters for RevertTrip.
+type RevertTripParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetTripMapParams defines parameters for GetTripMap.
+type GetTripMapParams struct {
+	Width  *int `form:"width,omitempty" json:"width,omitempty"`
+	Height *int `form:"height,omitempty" json:"height,omitempty"`
+}
+
+// GetTripMileageParams defines parameters for GetTripMileage.
+type GetTripMileageParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetTripStatsParams defines parameters for GetTripStats.
+type GetTripStatsParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+
+	// IfModifiedSince The Last-Modified value of a copy the client already has. When
+	// nothing it covers has changed since, the response is 304 Not
+	// Modified with no body.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// CheckTripDrivesParams defines parameters for CheckTripDrives.
+type CheckTripDrivesParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListTripJournalParams defines parameters for ListTripJournal.
+type ListTripJournalParams struct {
+	// Date Return only this day's entries.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListTripRouteLegsParams defines parameters for ListTripRouteLegs.
+type ListTripRouteLegsParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// UpdateTripRouteLegParams defines parameters for UpdateTripRouteLeg.
+type UpdateTripRouteLegParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// GetTripRouteLegElevationParams defines parameters for GetTripRouteLegElevation.
+type GetTripRouteLegElevationParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// UploadTripRouteLegTrackParams defines parameters for UploadTripRouteLegTrack.
+type UploadTripRouteLegTrackParams struct {
+	// Units The unit system for distances, heights, weights, and volumes in the
+	// response. They are stored in imperial units — miles, feet, pounds, and
+	// US gallons — and returned that way by default. With metric they are
+	// converted to kilometers, meters, kilograms, and liters, and prices per
+	// gallon to prices per liter, in the same fields: the field names do not
+	// change. Fields
+	// already named in metric units, such as distance_km, are unaffected,
+	// and request bodies are always read as named. Every response carries
+	// Vary: Units.
+	Units *Units `json:"Units,omitempty"`
+}
+
+// ListStopsParams defines parameters for ListStops.
+type ListStopsParams struct {
+	// Page Page number (1-indexed).
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page. Defaults to pagination.default_limit and is capped at pagination.max_limit, which each deployment sets.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Field A custom field filter, key:value, e.g. pets_allowed:true. The value
+	// is read as the field's type. Repeat it to require several fields;
+	// only records whose metadata matches every filter are listed.
+	Field *FieldFilter `form:"field,omitempty" json:"field,omitempty"`
+}
+
+// UploadStopPhotoMultipartBody defines parameters for UploadStopPhoto.
+type UploadStopPhotoMultipartBody struct {
+	Caption *string            `json:"caption,omitempty"`
+	File    openapi_types.File `json:"file"`
+}
+
+// UploadStopPhotoParams defines parameters for UploadStopPhoto.
+type UploadStopPhotoParams struct {
+	// ApplyExif Copy the photo's EXIF position and time onto the stop's latitude,
+	// longitude, and arrived_at. The upload is refused if that time is
+	// after the stop's departed_at.
+	ApplyExif *bool `form:"apply_exif,omitempty" json:"apply_exif,omitempty"`
+}
+
+// AdminReassignStopsJSONRequestBody defines body for AdminReassignStops for application/json ContentType.
+type AdminReassignStopsJSONRequestBody = StopReassignRequest
+
+// AdminShiftTripDatesJSONRequestBody defines body for AdminShiftTripDates for application/json ContentType.
+type AdminShiftTripDatesJSONRequestBody = DateShiftRequest
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = APIKeyRequest
+
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshTokenRequest
+
+// CreateTokenJSONRequestBody defines body for CreateToken for application/json ContentType.
+type CreateTokenJSONRequestBody = CreateTokenRequest
+
+// CreateChecklistTemplateJSONRequestBody defines body for CreateChecklistTemplate for application/json ContentType.
+type CreateChecklistTemplateJSONRequestBody = ChecklistTemplateRequest
+
+// UpdateChecklistTemplateJSONRequestBody defines body for UpdateChecklistTemplate for application/json ContentType.
+type UpdateChecklistTemplateJSONRequestBody = ChecklistTemplateRequest
+
+// CreateCustomFieldJSONRequestBody defines body for CreateCustomField for application/json ContentType.
+type CreateCustomFieldJSONRequestBody = CreateCustomFieldRequest
+
+// PatchCustomFieldJSONRequestBody defines body for PatchCustomField for application/json ContentType.
+type PatchCustomFieldJSONRequestBody = PatchCustomFieldRequest
+
+// IngestLocationJSONRequestBody defines body for IngestLocation for application/json ContentType.
+type IngestLocationJSONRequestBody = LocationReport
+
+// CreateMaintenanceItemJSONRequestBody defines body for CreateMaintenanceItem for application/json ContentType.
+type CreateMaintenanceItemJSONRequestBody = MaintenanceItemRequest
+
+// CreateMaintenanceRecordJSONRequestBody defines body for CreateMaintenanceRecord for application/json ContentType.
+type CreateMaintenanceRecordJSONRequestBody = MaintenanceRecordRequest
+
+// CreateNoteTemplateJSONRequestBody defines body for CreateNoteTemplate for application/json ContentType.
+type CreateNoteTemplateJSONRequestBody = NoteTemplateRequest
+
+// UpdateNoteTemplateJSONRequestBody defines body for UpdateNoteTemplate for application/json ContentType.
+type UpdateNoteTemplateJSONRequestBody = NoteTemplateRequest
+
+// CreateOdometerReadingJSONRequestBody defines body for CreateOdometerReading for application/json ContentType.
+type CreateOdometerReadingJSONRequestBody = CreateOdometerReadingRequest
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = CreateOrganizationRequest
+
+// PatchOrganizationJSONRequestBody defines body for PatchOrganization for application/json ContentType.
+type PatchOrganizationJSONRequestBody = PatchOrganizationRequest
+
+// AddOrganizationMemberJSONRequestBody defines body for AddOrganizationMember for application/json ContentType.
+type AddOrganizationMemberJSONRequestBody = AddOrganizationMemberRequest
+
+// PatchOrganizationMemberJSONRequestBody defines body for PatchOrganizationMember for application/json ContentType.
+type PatchOrganizationMemberJSONRequestBody = PatchOrganizationMemberRequest
+
+// CreatePackingListJSONRequestBody defines body for CreatePackingList for application/json ContentType.
+type CreatePackingListJSONRequestBody = CreatePackingListRequest
+
+// UpdatePackingListJSONRequestBody defines body for UpdatePackingList for application/json ContentType.
+type UpdatePackingListJSONRequestBody = UpdatePackingListRequest
+
+// CreatePackingItemJSONRequestBody defines body for CreatePackingItem for application/json ContentType.
+type CreatePackingItemJSONRequestBody = CreatePackingItemRequest
+
+// UpdatePackingItemJSONRequestBody defines body for UpdatePackingItem for application/json ContentType.
+type UpdatePackingItemJSONRequestBody = UpdatePackingItemRequest
+
+// CreatePointOfInterestJSONRequestBody defines body for CreatePointOfInterest for application/json ContentType.
+type CreatePointOfInterestJSONRequestBody = PointOfInterestRequest
+
+// UpdatePointOfInterestJSONRequestBody defines body for UpdatePointOfInterest for application/json ContentType.
+type UpdatePointOfInterestJSONRequestBody = PointOfInterestRequest
+
+// CreatePowerReadingJSONRequestBody defines body for CreatePowerReading for application/json ContentType.
+type CreatePowerReadingJSONRequestBody = CreatePowerReadingRequest
+
+// CreatePropaneFillJSONRequestBody defines body for CreatePropaneFill for application/json ContentType.
+type CreatePropaneFillJSONRequestBody = CreatePropaneFillRequest
+
+// CreateRigJSONRequestBody defines body for CreateRig for application/json ContentType.
+type CreateRigJSONRequestBody = RigRequest
+
+// UpdateRigJSONRequestBody defines body for UpdateRig for application/json ContentType.
+type UpdateRigJSONRequestBody = RigRequest
+
+// CreateRigMaintenanceJSONRequestBody defines body for CreateRigMaintenance for application/json ContentType.
+type CreateRigMaintenanceJSONRequestBody = RigMaintenanceRequest
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = CreateTagRequest
+
+// PatchTagJSONRequestBody defines body for PatchTag for application/json ContentType.
+type PatchTagJSONRequestBody = PatchTagRequest
+
+// MergeTagJSONRequestBody defines body for MergeTag for application/json ContentType.
+type MergeTagJSONRequestBody = MergeTagRequest
+
+// SetTagParentJSONRequestBody defines body for SetTagParent for application/json ContentType.
+type SetTagParentJSONRequestBody = SetTagParentRequest
+
+// CreateTripJSONRequestBody defines body for CreateTrip for application/json ContentType.
+type CreateTripJSONRequestBody = CreateTripRequest
+
+// UpdateTripJSONRequestBody defines body for UpdateTrip for application/json ContentType.
+type UpdateTripJSONRequestBody = UpdateTripRequest
+
+// CloneTripJSONRequestBody defines body for CloneTrip for application/json ContentType.
+type CloneTripJSONRequestBody = TripCloneRequest
+
+// CreateTripShareJSONRequestBody defines body for CreateTripShare for application/json ContentType.
+type CreateTripShareJSONRequestBody = CreateShareRequest
+
+// CreateTripBorderCrossingJSONRequestBody defines body for CreateTripBorderCrossing for application/json ContentType.
+type CreateTripBorderCrossingJSONRequestBody = BorderCrossingRequest
+
+// UpdateTripBorderCrossingJSONRequestBody defines body for UpdateTripBorderCrossing for application/json ContentType.
+type UpdateTripBorderCrossingJSONRequestBody = BorderCrossingRequest
+
+// CheckTripDrivesJSONRequestBody defines body for CheckTripDrives for application/json ContentType.
+type CheckTripDrivesJSONRequestBody = DriveRulesRequest
+
+// SplitTripExpenseJSONRequestBody defines body for SplitTripExpense for application/json ContentType.
+type SplitTripExpenseJSONRequestBody = ExpenseSplitRequest
+
+// CreateTripJournalEntryJSONRequestBody defines body for CreateTripJournalEntry for application/json ContentType.
+type CreateTripJournalEntryJSONRequestBody = JournalEntryRequest
+
+// UpdateTripJournalEntryJSONRequestBody defines body for UpdateTripJournalEntry for application/json ContentType.
+type UpdateTripJournalEntryJSONRequestBody = JournalEntryRequest
+
+// UpdateTripRouteLegJSONRequestBody defines body for UpdateTripRouteLeg for application/json ContentType.
+type UpdateTripRouteLegJSONRequestBody = RouteLegRequest
+
+// InviteTripMemberJSONRequestBody defines body for InviteTripMember for application/json ContentType.
+type InviteTripMemberJSONRequestBody = InviteTripMemberRequest
+
+// PatchTripMemberJSONRequestBody defines body for PatchTripMember for application/json ContentType.
+type PatchTripMemberJSONRequestBody = PatchTripMemberRequest
+
+// QuickLogExpenseJSONRequestBody defines body for QuickLogExpense for application/json ContentType.
+type QuickLogExpenseJSONRequestBody = QuickLogRequest
+
+// CreateStopJSONRequestBody defines body for CreateStop for application/json ContentType.
+type CreateStopJSONRequestBody = CreateStopRequest
+
+// UpdateStopJSONRequestBody defines body for UpdateStop for application/json ContentType.
+type UpdateStopJSONRequestBody = UpdateStopRequest
+
+// ArriveAtStopJSONRequestBody defines body for ArriveAtStop for application/json ContentType.
+type ArriveAtStopJSONRequestBody = ArriveAtStopRequest
+
+// StartStopChecklistJSONRequestBody defines body for StartStopChecklist for application/json ContentType.
+type StartStopChecklistJSONRequestBody = StartChecklistRequest
+
+// CheckStopChecklistItemJSONRequestBody defines body for CheckStopChecklistItem for application/json ContentType.
+type CheckStopChecklistItemJSONRequestBody = CheckItemRequest
+
+// CreateDumpEventJSONRequestBody defines body for CreateDumpEvent for application/json ContentType.
+type CreateDumpEventJSONRequestBody = CreateDumpEventRequest
+
+// UploadStopPhotoMultipartRequestBody defines body for UploadStopPhoto for multipart/form-data ContentType.
+type UploadStopPhotoMultipartRequestBody UploadStopPhotoMultipartBody
+
+// CreateStopReservationJSONRequestBody defines body for CreateStopReservation for application/json ContentType.
+type CreateStopReservationJSONRequestBody = ReservationRequest
+
+// UpdateStopReservationJSONRequestBody defines body for UpdateStopReservation for application/json ContentType.
+type UpdateStopReservationJSONRequestBody = ReservationRequest
+
+// AddTagToStopJSONRequestBody defines body for AddTagToStop for application/json ContentType.
+type AddTagToStopJSONRequestBody = AddTagRequest
+
+// CreateTankLevelJSONRequestBody defines body for CreateTankLevel for application/json ContentType.
+type CreateTankLevelJSONRequestBody = CreateTankLevelRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Database connection pool statistics
+	// (GET /admin/pool)
+	GetPoolStats(w http.ResponseWriter, r *http.Request)
+	// Tidy the stop locations on a trip
+	// (POST /admin/trips/{id}/normalize-locations)
+	AdminNormalizeLocations(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminNormalizeLocationsParams)
+	// Move stops onto another trip
+	// (POST /admin/trips/{id}/reassign-stops)
+	AdminReassignStops(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminReassignStopsParams)
+	// Move every date on a trip by some days
+	// (POST /admin/trips/{id}/shift-dates)
+	AdminShiftTripDates(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminShiftTripDatesParams)
+	// List your API keys
+	// (GET /api-keys)
+	ListAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Create an API key
+	// (POST /api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Revoke an API key
+	// (DELETE /api-keys/{id})
+	DeleteAPIKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Refresh an access token
+	// (POST /auth/refresh)
+	RefreshToken(w http.ResponseWriter, r *http.Request)
+	// Log in
+	// (POST /auth/token)
+	CreateToken(w http.ResponseWriter, r *http.Request)
+	// Year-end report of border crossings
+	// (GET /border-crossings)
+	GetBorderCrossingReport(w http.ResponseWriter, r *http.Request, params GetBorderCrossingReportParams)
+	// List checklist templates
+	// (GET /checklist-templates)
+	ListChecklistTemplates(w http.ResponseWriter, r *http.Request)
+	// Create a checklist template
+	// (POST /checklist-templates)
+	CreateChecklistTemplate(w http.ResponseWriter, r *http.Request)
+	// Delete a checklist template
+	// (DELETE /checklist-templates/{id})
+	DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a checklist template
+	// (GET /checklist-templates/{id})
+	GetChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a checklist template
+	// (PUT /checklist-templates/{id})
+	UpdateChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List custom field definitions
+	// (GET /custom-fields)
+	ListCustomFields(w http.ResponseWriter, r *http.Request, params ListCustomFieldsParams)
+	// Define a custom field
+	// (POST /custom-fields)
+	CreateCustomField(w http.ResponseWriter, r *http.Request)
+	// Delete a custom field
+	// (DELETE /custom-fields/{id})
+	DeleteCustomField(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Relabel a custom field
+	// (PATCH /custom-fields/{id})
+	PatchCustomField(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Export all trips, stops, and tags as a flat table
+	// (GET /export)
+	GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams)
+	// List the trips and stops deleted for good
+	// (GET /export/deletions)
+	ListExportDeletions(w http.ResponseWriter, r *http.Request, params ListExportDeletionsParams)
+	// Health check
+	// (GET /healthz)
+	GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams)
+	// Liveness probe
+	// (GET /livez)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// Report the rig's location
+	// (POST /locations)
+	IngestLocation(w http.ResponseWriter, r *http.Request)
+	// List maintenance coming due
+	// (GET /maintenance/due)
+	ListMaintenanceDue(w http.ResponseWriter, r *http.Request, params ListMaintenanceDueParams)
+	// List maintenance items
+	// (GET /maintenance/items)
+	ListMaintenanceItems(w http.ResponseWriter, r *http.Request, params ListMaintenanceItemsParams)
+	// Add a maintenance item
+	// (POST /maintenance/items)
+	CreateMaintenanceItem(w http.ResponseWriter, r *http.Request, params CreateMaintenanceItemParams)
+	// Delete a maintenance item and its service history
+	// (DELETE /maintenance/items/{itemId})
+	DeleteMaintenanceItem(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID)
+	// Record that an item was serviced
+	// (POST /maintenance/items/{itemId}/records)
+	CreateMaintenanceRecord(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID, params CreateMaintenanceRecordParams)
+	// List note templates
+	// (GET /note-templates)
+	ListNoteTemplates(w http.ResponseWriter, r *http.Request)
+	// Create a note template
+	// (POST /note-templates)
+	CreateNoteTemplate(w http.ResponseWriter, r *http.Request)
+	// Delete a note template
+	// (DELETE /note-templates/{id})
+	DeleteNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a note template
+	// (GET /note-templates/{id})
+	GetNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a note template
+	// (PUT /note-templates/{id})
+	UpdateNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List odometer readings
+	// (GET /odometer-readings)
+	ListOdometerReadings(w http.ResponseWriter, r *http.Request, params ListOdometerReadingsParams)
+	// Record an odometer reading
+	// (POST /odometer-readings)
+	CreateOdometerReading(w http.ResponseWriter, r *http.Request, params CreateOdometerReadingParams)
+	// Delete an odometer reading
+	// (DELETE /odometer-readings/{id})
+	DeleteOdometerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get an odometer reading by ID
+	// (GET /odometer-readings/{id})
+	GetOdometerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetOdometerReadingParams)
+	// List your organizations
+	// (GET /organizations)
+	ListOrganizations(w http.ResponseWriter, r *http.Request)
+	// Create an organization
+	// (POST /organizations)
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	// Delete an organization
+	// (DELETE /organizations/{orgId})
+	DeleteOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID)
+	// Get an organization
+	// (GET /organizations/{orgId})
+	GetOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID)
+	// Rename an organization
+	// (PATCH /organizations/{orgId})
+	PatchOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID)
+	// List an organization's members
+	// (GET /organizations/{orgId}/members)
+	ListOrganizationMembers(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID)
+	// Add a member to an organization
+	// (POST /organizations/{orgId}/members)
+	AddOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID)
+	// Remove a member from an organization
+	// (DELETE /organizations/{orgId}/members/{userId})
+	RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID, userId string)
+	// Change a member's role
+	// (PATCH /organizations/{orgId}/members/{userId})
+	PatchOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID, userId string)
+	// List packing lists
+	// (GET /packing-lists)
+	ListPackingLists(w http.ResponseWriter, r *http.Request, params ListPackingListsParams)
+	// Create a packing list
+	// (POST /packing-lists)
+	CreatePackingList(w http.ResponseWriter, r *http.Request)
+	// Delete a packing list
+	// (DELETE /packing-lists/{id})
+	DeletePackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a packing list
+	// (GET /packing-lists/{id})
+	GetPackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Rename a packing list
+	// (PUT /packing-lists/{id})
+	UpdatePackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add an item to a packing list
+	// (POST /packing-lists/{id}/items)
+	CreatePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove an item from a packing list
+	// (DELETE /packing-lists/{id}/items/{itemId})
+	DeletePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, itemId openapi_types.UUID)
+	// Update a packing list item
+	// (PUT /packing-lists/{id}/items/{itemId})
+	UpdatePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, itemId openapi_types.UUID)
+	// Download a photo's thumbnail
+	// (GET /photos/{photoId}/thumbnail)
+	GetPhotoThumbnail(w http.ResponseWriter, r *http.Request, photoId openapi_types.UUID, params GetPhotoThumbnailParams)
+	// List points of interest
+	// (GET /points-of-interest)
+	ListPointsOfInterest(w http.ResponseWriter, r *http.Request, params ListPointsOfInterestParams)
+	// Log a sighting or point of interest
+	// (POST /points-of-interest)
+	CreatePointOfInterest(w http.ResponseWriter, r *http.Request)
+	// Delete a point of interest
+	// (DELETE /points-of-interest/{id})
+	DeletePointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a point of interest by ID
+	// (GET /points-of-interest/{id})
+	GetPointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a point of interest
+	// (PUT /points-of-interest/{id})
+	UpdatePointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List power readings
+	// (GET /power-readings)
+	ListPowerReadings(w http.ResponseWriter, r *http.Request, params ListPowerReadingsParams)
+	// Record a power reading
+	// (POST /power-readings)
+	CreatePowerReading(w http.ResponseWriter, r *http.Request)
+	// Delete a power reading
+	// (DELETE /power-readings/{id})
+	DeletePowerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a power reading by ID
+	// (GET /power-readings/{id})
+	GetPowerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List propane fills
+	// (GET /propane-fills)
+	ListPropaneFills(w http.ResponseWriter, r *http.Request, params ListPropaneFillsParams)
+	// Log a propane fill
+	// (POST /propane-fills)
+	CreatePropaneFill(w http.ResponseWriter, r *http.Request, params CreatePropaneFillParams)
+	// Delete a propane fill
+	// (DELETE /propane-fills/{id})
+	DeletePropaneFill(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a propane fill by ID
+	// (GET /propane-fills/{id})
+	GetPropaneFill(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPropaneFillParams)
+	// Readiness probe
+	// (GET /readyz)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// List reservations that have not ended
+	// (GET /reservations/upcoming)
+	ListUpcomingReservations(w http.ResponseWriter, r *http.Request, params ListUpcomingReservationsParams)
+	// List rigs
+	// (GET /rigs)
+	ListRigs(w http.ResponseWriter, r *http.Request, params ListRigsParams)
+	// Add a rig
+	// (POST /rigs)
+	CreateRig(w http.ResponseWriter, r *http.Request, params CreateRigParams)
+	// Delete a rig
+	// (DELETE /rigs/{rigId})
+	DeleteRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID)
+	// Get a rig
+	// (GET /rigs/{rigId})
+	GetRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params GetRigParams)
+	// Replace a rig's details
+	// (PUT /rigs/{rigId})
+	UpdateRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params UpdateRigParams)
+	// List a rig's service history
+	// (GET /rigs/{rigId}/maintenance)
+	ListRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params ListRigMaintenanceParams)
+	// Log work done on a rig
+	// (POST /rigs/{rigId}/maintenance)
+	CreateRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params CreateRigMaintenanceParams)
+	// Delete an entry from a rig's service history
+	// (DELETE /rigs/{rigId}/maintenance/{entryId})
+	DeleteRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, entryId openapi_types.UUID)
+	// View a shared trip
+	// (GET /shared/{token})
+	GetSharedTrip(w http.ResponseWriter, r *http.Request, token string, params GetSharedTripParams)
+	// Embed a shared trip in another site
+	// (GET /shared/{token}/embed)
+	GetSharedTripEmbed(w http.ResponseWriter, r *http.Request, token string, params GetSharedTripEmbedParams)
+	// Totals for every trip and the most used tags
+	// (GET /stats/dashboard)
+	GetDashboard(w http.ResponseWriter, r *http.Request, params GetDashboardParams)
+	// Nights spent, as heatmap points
+	// (GET /stats/heatmap)
+	GetStopHeatmap(w http.ResponseWriter, r *http.Request, params GetStopHeatmapParams)
+	// Propane totals and consumption rate
+	// (GET /stats/propane)
+	GetPropaneStats(w http.ResponseWriter, r *http.Request, params GetPropaneStatsParams)
+	// States and provinces visited
+	// (GET /stats/states-visited)
+	GetStatesVisited(w http.ResponseWriter, r *http.Request, params GetStatesVisitedParams)
+	// Stop counts for every tag, rolled up through the hierarchy
+	// (GET /stats/tags)
+	GetTagStats(w http.ResponseWriter, r *http.Request)
+	// Clustered stop markers for a map view
+	// (GET /stops/clusters)
+	ListStopClusters(w http.ResponseWriter, r *http.Request, params ListStopClustersParams)
+	// Stops near a point
+	// (GET /stops/nearby)
+	ListNearbyStops(w http.ResponseWriter, r *http.Request, params ListNearbyStopsParams)
+	// Which nights of a year are accounted for by stops
+	// (GET /stops/occupancy)
+	GetStopOccupancy(w http.ResponseWriter, r *http.Request, params GetStopOccupancyParams)
+	// List tags, optionally filtered by name prefix
+	// (GET /tags)
+	ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams)
+	// Create a tag by name
+	// (POST /tags)
+	CreateTag(w http.ResponseWriter, r *http.Request)
+	// Delete a tag
+	// (DELETE /tags/{slug})
+	DeleteTag(w http.ResponseWriter, r *http.Request, slug string)
+	// Update a tag's display name
+	// (PATCH /tags/{slug})
+	PatchTag(w http.ResponseWriter, r *http.Request, slug string)
+	// Merge a tag into another
+	// (POST /tags/{slug}/merge)
+	MergeTag(w http.ResponseWriter, r *http.Request, slug string)
+	// Move a tag to the top level
+	// (DELETE /tags/{slug}/parent)
+	ClearTagParent(w http.ResponseWriter, r *http.Request, slug string)
+	// Put a tag under another
+	// (PUT /tags/{slug}/parent)
+	SetTagParent(w http.ResponseWriter, r *http.Request, slug string)
+	// List recently deleted trips and stops
+	// (GET /trash)
+	ListTrash(w http.ResponseWriter, r *http.Request)
+	// Restore a deleted trip or stop
+	// (POST /trash/{id}/restore)
+	RestoreTrashItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List all trips
+	// (GET /trips)
+	ListTrips(w http.ResponseWriter, r *http.Request, params ListTripsParams)
+	// Create a trip
+	// (POST /trips)
+	CreateTrip(w http.ResponseWriter, r *http.Request, params CreateTripParams)
+	// Get the trip in progress and its tank status
+	// (GET /trips/current)
+	GetCurrentTrip(w http.ResponseWriter, r *http.Request)
+	// Delete a trip
+	// (DELETE /trips/{id})
+	DeleteTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a trip by ID
+	// (GET /trips/{id})
+	GetTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripParams)
+	// Update a trip
+	// (PUT /trips/{id})
+	UpdateTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UpdateTripParams)
+	// Copy a trip into a new one
+	// (POST /trips/{id}/clone)
+	CloneTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CloneTripParams)
+	// Find nights within a trip that no stop accounts for
+	// (GET /trips/{id}/gaps)
+	ListTripGaps(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a trip's stops and route as GeoJSON
+	// (GET /trips/{id}/geojson)
+	GetTripGeoJSON(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List every recorded version of a trip
+	// (GET /trips/{id}/history)
+	ListTripHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Put a trip back as it was at a revision
+	// (POST /trips/{id}/history/{revision}/revert)
+	RevertTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, revision int, params RevertTripParams)
+	// Import stops from GPX waypoints
+	// (POST /trips/{id}/import/gpx)
+	ImportTripGPX(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Render a trip as a static map image
+	// (GET /trips/{id}/map.png)
+	GetTripMap(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripMapParams)
+	// Get the distance covered on a trip
+	// (GET /trips/{id}/mileage)
+	GetTripMileage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripMileageParams)
+	// Chart a trip's battery, solar, and generator readings
+	// (GET /trips/{id}/power)
+	GetTripPower(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List active share links for a trip
+	// (GET /trips/{id}/shares)
+	ListTripShares(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Create a share link for a trip
+	// (POST /trips/{id}/shares)
+	CreateTripShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Revoke a share link
+	// (DELETE /trips/{id}/shares/{shareId})
+	RevokeTripShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, shareId openapi_types.UUID)
+	// Get a trip's stop and route totals
+	// (GET /trips/{id}/stats)
+	GetTripStats(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripStatsParams)
+	// List a trip's border crossings
+	// (GET /trips/{tripId}/border-crossings)
+	ListTripBorderCrossings(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Log a border crossing
+	// (POST /trips/{tripId}/border-crossings)
+	CreateTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Delete a border crossing
+	// (DELETE /trips/{tripId}/border-crossings/{crossingId})
+	DeleteTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID)
+	// Get a border crossing
+	// (GET /trips/{tripId}/border-crossings/{crossingId})
+	GetTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID)
+	// Update a border crossing
+	// (PUT /trips/{tripId}/border-crossings/{crossingId})
+	UpdateTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID)
+	// Compare a trip's spending with its budget
+	// (GET /trips/{tripId}/budget-report)
+	GetTripBudgetReport(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Check a trip's planned drives against driving rules
+	// (POST /trips/{tripId}/drive-check)
+	CheckTripDrives(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params CheckTripDrivesParams)
+	// List a trip's expenses
+	// (GET /trips/{tripId}/expenses)
+	ListTripExpenses(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Delete an expense
+	// (DELETE /trips/{tripId}/expenses/{expenseId})
+	DeleteTripExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, expenseId openapi_types.UUID)
+	// Record who paid an expense and who shares it
+	// (PUT /trips/{tripId}/expenses/{expenseId}/split)
+	SplitTripExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, expenseId openapi_types.UUID)
+	// List a trip's journal by day
+	// (GET /trips/{tripId}/journal)
+	ListTripJournal(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListTripJournalParams)
+	// Write a journal entry
+	// (POST /trips/{tripId}/journal)
+	CreateTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Delete a journal entry
+	// (DELETE /trips/{tripId}/journal/{entryId})
+	DeleteTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID)
+	// Get a journal entry
+	// (GET /trips/{tripId}/journal/{entryId})
+	GetTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID)
+	// Update a journal entry
+	// (PUT /trips/{tripId}/journal/{entryId})
+	UpdateTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID)
+	// List the route legs between a trip's stops
+	// (GET /trips/{tripId}/legs)
+	ListTripRouteLegs(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListTripRouteLegsParams)
+	// Set a route leg's distance, duration, and polyline
+	// (PUT /trips/{tripId}/legs/{legId})
+	UpdateTripRouteLeg(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params UpdateTripRouteLegParams)
+	// Get a route leg's elevation profile
+	// (GET /trips/{tripId}/legs/{legId}/elevation)
+	GetTripRouteLegElevation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params GetTripRouteLegElevationParams)
+	// Download a route leg's GPX track
+	// (GET /trips/{tripId}/legs/{legId}/track)
+	GetTripRouteLegTrack(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID)
+	// Upload a GPX track for a route leg
+	// (POST /trips/{tripId}/legs/{legId}/track)
+	UploadTripRouteLegTrack(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params UploadTripRouteLegTrackParams)
+	// List a trip's members
+	// (GET /trips/{tripId}/members)
+	ListTripMembers(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Share a trip with another user
+	// (POST /trips/{tripId}/members)
+	InviteTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Stop sharing a trip with a user
+	// (DELETE /trips/{tripId}/members/{userId})
+	RemoveTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, userId openapi_types.UUID)
+	// Change a member's role
+	// (PATCH /trips/{tripId}/members/{userId})
+	PatchTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, userId openapi_types.UUID)
+	// Log an expense in one tap
+	// (POST /trips/{tripId}/quicklog)
+	QuickLogExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Settle up a group trip's shared costs
+	// (GET /trips/{tripId}/settlement)
+	GetTripSettlement(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// List stops suggested from location reports
+	// (GET /trips/{tripId}/stop-suggestions)
+	ListTripStopSuggestions(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Dismiss a suggested stop
+	// (DELETE /trips/{tripId}/stop-suggestions/{suggestionId})
+	DismissTripStopSuggestion(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, suggestionId openapi_types.UUID)
+	// Log a suggested stop
+	// (POST /trips/{tripId}/stop-suggestions/{suggestionId}/accept)
+	AcceptTripStopSuggestion(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, suggestionId openapi_types.UUID)
+	// List all stops for a trip
+	// (GET /trips/{tripId}/stops)
+	ListStops(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListStopsParams)
+	// Create a stop on a trip
+	// (POST /trips/{tripId}/stops)
+	CreateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID)
+	// Delete a stop
+	// (DELETE /trips/{tripId}/stops/{stopId})
+	DeleteStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Get a stop by ID
+	// (GET /trips/{tripId}/stops/{stopId})
+	GetStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Update a stop
+	// (PUT /trips/{tripId}/stops/{stopId})
+	UpdateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Mark a planned stop as reached
+	// (POST /trips/{tripId}/stops/{stopId}/arrive)
+	ArriveAtStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// List checklists run at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/checklists)
+	ListStopChecklists(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Start a checklist at a stop
+	// (POST /trips/{tripId}/stops/{stopId}/checklists)
+	StartStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Delete a checklist run at a stop
+	// (DELETE /trips/{tripId}/stops/{stopId}/checklists/{checklistId})
+	DeleteStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID)
+	// Get a checklist run at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/checklists/{checklistId})
+	GetStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID)
+	// Check off or uncheck a checklist item
+	// (PUT /trips/{tripId}/stops/{stopId}/checklists/{checklistId}/items/{itemId})
+	CheckStopChecklistItem(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID, itemId openapi_types.UUID)
+	// List dump events at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/dumps)
+	ListDumpEvents(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Log a dump at a stop
+	// (POST /trips/{tripId}/stops/{stopId}/dumps)
+	CreateDumpEvent(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Delete a dump event
+	// (DELETE /trips/{tripId}/stops/{stopId}/dumps/{dumpId})
+	DeleteDumpEvent(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, dumpId openapi_types.UUID)
+	// List every recorded version of a stop
+	// (GET /trips/{tripId}/stops/{stopId}/history)
+	ListStopHistory(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Put a stop back as it was at a revision
+	// (POST /trips/{tripId}/stops/{stopId}/history/{revision}/revert)
+	RevertStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, revision int)
+	// List a stop's photos
+	// (GET /trips/{tripId}/stops/{stopId}/photos)
+	ListStopPhotos(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Attach a photo to a stop
+	// (POST /trips/{tripId}/stops/{stopId}/photos)
+	UploadStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, params UploadStopPhotoParams)
+	// Remove a photo from a stop
+	// (DELETE /trips/{tripId}/stops/{stopId}/photos/{photoId})
+	DeleteStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, photoId openapi_types.UUID)
+	// Download a photo
+	// (GET /trips/{tripId}/stops/{stopId}/photos/{photoId})
+	GetStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, photoId openapi_types.UUID)
+	// List reservations for a stop
+	// (GET /trips/{tripId}/stops/{stopId}/reservations)
+	ListStopReservations(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Add a reservation to a stop
+	// (POST /trips/{tripId}/stops/{stopId}/reservations)
+	CreateStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Delete a reservation
+	// (DELETE /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+	DeleteStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID)
+	// Get a reservation
+	// (GET /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+	GetStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID)
+	// Update a reservation
+	// (PUT /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+	UpdateStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID)
+	// List tags on a stop
+	// (GET /trips/{tripId}/stops/{stopId}/tags)
+	ListTagsByStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Add a tag to a stop
+	// (POST /trips/{tripId}/stops/{stopId}/tags)
+	AddTagToStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Remove a tag from a stop
+	// (DELETE /trips/{tripId}/stops/{stopId}/tags/{slug})
+	RemoveTagFromStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, slug string)
+	// List tank readings at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/tank-levels)
+	ListTankLevels(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Record tank levels at a stop
+	// (POST /trips/{tripId}/stops/{stopId}/tank-levels)
+	CreateTankLevel(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID)
+	// Delete a tank reading
+	// (DELETE /trips/{tripId}/stops/{stopId}/tank-levels/{levelId})
+	DeleteTankLevel(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, levelId openapi_types.UUID)
+	// List webhooks
+	// (GET /webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Subscribe a URL to events
+	// (POST /webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a webhook
+	// (GET /webhooks/{id})
+	GetWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a webhook
+	// (PUT /webhooks/{id})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List a webhook's delivery attempts
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Replace a webhook's secret
+	// (POST /webhooks/{id}/rotate-secret)
+	RotateWebhookSecret(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Send a test delivery
+	// (POST /webhooks/{id}/test)
+	TestWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Database connection pool statistics
+// (GET /admin/pool)
+func (_ Unimplemented) GetPoolStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Tidy the stop locations on a trip
+// (POST /admin/trips/{id}/normalize-locations)
+func (_ Unimplemented) AdminNormalizeLocations(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminNormalizeLocationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move stops onto another trip
+// (POST /admin/trips/{id}/reassign-stops)
+func (_ Unimplemented) AdminReassignStops(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminReassignStopsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move every date on a trip by some days
+// (POST /admin/trips/{id}/shift-dates)
+func (_ Unimplemented) AdminShiftTripDates(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminShiftTripDatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List your API keys
+// (GET /api-keys)
+func (_ Unimplemented) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an API key
+// (POST /api-keys)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an API key
+// (DELETE /api-keys/{id})
+func (_ Unimplemented) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Refresh an access token
+// (POST /auth/refresh)
+func (_ Unimplemented) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log in
+// (POST /auth/token)
+func (_ Unimplemented) CreateToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Year-end report of border crossings
+// (GET /border-crossings)
+func (_ Unimplemented) GetBorderCrossingReport(w http.ResponseWriter, r *http.Request, params GetBorderCrossingReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List checklist templates
+// (GET /checklist-templates)
+func (_ Unimplemented) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a checklist template
+// (POST /checklist-templates)
+func (_ Unimplemented) CreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a checklist template
+// (DELETE /checklist-templates/{id})
+func (_ Unimplemented) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a checklist template
+// (GET /checklist-templates/{id})
+func (_ Unimplemented) GetChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a checklist template
+// (PUT /checklist-templates/{id})
+func (_ Unimplemented) UpdateChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List custom field definitions
+// (GET /custom-fields)
+func (_ Unimplemented) ListCustomFields(w http.ResponseWriter, r *http.Request, params ListCustomFieldsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Define a custom field
+// (POST /custom-fields)
+func (_ Unimplemented) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a custom field
+// (DELETE /custom-fields/{id})
+func (_ Unimplemented) DeleteCustomField(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Relabel a custom field
+// (PATCH /custom-fields/{id})
+func (_ Unimplemented) PatchCustomField(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export all trips, stops, and tags as a flat table
+// (GET /export)
+func (_ Unimplemented) GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the trips and stops deleted for good
+// (GET /export/deletions)
+func (_ Unimplemented) ListExportDeletions(w http.ResponseWriter, r *http.Request, params ListExportDeletionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Health check
+// (GET /healthz)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Liveness probe
+// (GET /livez)
+func (_ Unimplemented) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report the rig's location
+// (POST /locations)
+func (_ Unimplemented) IngestLocation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List maintenance coming due
+// (GET /maintenance/due)
+func (_ Unimplemented) ListMaintenanceDue(w http.ResponseWriter, r *http.Request, params ListMaintenanceDueParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List maintenance items
+// (GET /maintenance/items)
+func (_ Unimplemented) ListMaintenanceItems(w http.ResponseWriter, r *http.Request, params ListMaintenanceItemsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a maintenance item
+// (POST /maintenance/items)
+func (_ Unimplemented) CreateMaintenanceItem(w http.ResponseWriter, r *http.Request, params CreateMaintenanceItemParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a maintenance item and its service history
+// (DELETE /maintenance/items/{itemId})
+func (_ Unimplemented) DeleteMaintenanceItem(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record that an item was serviced
+// (POST /maintenance/items/{itemId}/records)
+func (_ Unimplemented) CreateMaintenanceRecord(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID, params CreateMaintenanceRecordParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List note templates
+// (GET /note-templates)
+func (_ Unimplemented) ListNoteTemplates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a note template
+// (POST /note-templates)
+func (_ Unimplemented) CreateNoteTemplate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a note template
+// (DELETE /note-templates/{id})
+func (_ Unimplemented) DeleteNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a note template
+// (GET /note-templates/{id})
+func (_ Unimplemented) GetNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a note template
+// (PUT /note-templates/{id})
+func (_ Unimplemented) UpdateNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List odometer readings
+// (GET /odometer-readings)
+func (_ Unimplemented) ListOdometerReadings(w http.ResponseWriter, r *http.Request, params ListOdometerReadingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record an odometer reading
+// (POST /odometer-readings)
+func (_ Unimplemented) CreateOdometerReading(w http.ResponseWriter, r *http.Request, params CreateOdometerReadingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an odometer reading
+// (DELETE /odometer-readings/{id})
+func (_ Unimplemented) DeleteOdometerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an odometer reading by ID
+// (GET /odometer-readings/{id})
+func (_ Unimplemented) GetOdometerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetOdometerReadingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List your organizations
+// (GET /organizations)
+func (_ Unimplemented) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an organization
+// (POST /organizations)
+func (_ Unimplemented) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an organization
+// (DELETE /organizations/{orgId})
+func (_ Unimplemented) DeleteOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an organization
+// (GET /organizations/{orgId})
+func (_ Unimplemented) GetOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename an organization
+// (PATCH /organizations/{orgId})
+func (_ Unimplemented) PatchOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List an organization's members
+// (GET /organizations/{orgId}/members)
+func (_ Unimplemented) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a member to an organization
+// (POST /organizations/{orgId}/members)
+func (_ Unimplemented) AddOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a member from an organization
+// (DELETE /organizations/{orgId}/members/{userId})
+func (_ Unimplemented) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change a member's role
+// (PATCH /organizations/{orgId}/members/{userId})
+func (_ Unimplemented) PatchOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List packing lists
+// (GET /packing-lists)
+func (_ Unimplemented) ListPackingLists(w http.ResponseWriter, r *http.Request, params ListPackingListsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a packing list
+// (POST /packing-lists)
+func (_ Unimplemented) CreatePackingList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a packing list
+// (DELETE /packing-lists/{id})
+func (_ Unimplemented) DeletePackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a packing list
+// (GET /packing-lists/{id})
+func (_ Unimplemented) GetPackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename a packing list
+// (PUT /packing-lists/{id})
+func (_ Unimplemented) UpdatePackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add an item to a packing list
+// (POST /packing-lists/{id}/items)
+func (_ Unimplemented) CreatePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove an item from a packing list
+// (DELETE /packing-lists/{id}/items/{itemId})
+func (_ Unimplemented) DeletePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a packing list item
+// (PUT /packing-lists/{id}/items/{itemId})
+func (_ Unimplemented) UpdatePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a photo's thumbnail
+// (GET /photos/{photoId}/thumbnail)
+func (_ Unimplemented) GetPhotoThumbnail(w http.ResponseWriter, r *http.Request, photoId openapi_types.UUID, params GetPhotoThumbnailParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List points of interest
+// (GET /points-of-interest)
+func (_ Unimplemented) ListPointsOfInterest(w http.ResponseWriter, r *http.Request, params ListPointsOfInterestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log a sighting or point of interest
+// (POST /points-of-interest)
+func (_ Unimplemented) CreatePointOfInterest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a point of interest
+// (DELETE /points-of-interest/{id})
+func (_ Unimplemented) DeletePointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a point of interest by ID
+// (GET /points-of-interest/{id})
+func (_ Unimplemented) GetPointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a point of interest
+// (PUT /points-of-interest/{id})
+func (_ Unimplemented) UpdatePointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List power readings
+// (GET /power-readings)
+func (_ Unimplemented) ListPowerReadings(w http.ResponseWriter, r *http.Request, params ListPowerReadingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record a power reading
+// (POST /power-readings)
+func (_ Unimplemented) CreatePowerReading(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a power reading
+// (DELETE /power-readings/{id})
+func (_ Unimplemented) DeletePowerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a power reading by ID
+// (GET /power-readings/{id})
+func (_ Unimplemented) GetPowerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List propane fills
+// (GET /propane-fills)
+func (_ Unimplemented) ListPropaneFills(w http.ResponseWriter, r *http.Request, params ListPropaneFillsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log a propane fill
+// (POST /propane-fills)
+func (_ Unimplemented) CreatePropaneFill(w http.ResponseWriter, r *http.Request, params CreatePropaneFillParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a propane fill
+// (DELETE /propane-fills/{id})
+func (_ Unimplemented) DeletePropaneFill(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a propane fill by ID
+// (GET /propane-fills/{id})
+func (_ Unimplemented) GetPropaneFill(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPropaneFillParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Readiness probe
+// (GET /readyz)
+func (_ Unimplemented) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List reservations that have not ended
+// (GET /reservations/upcoming)
+func (_ Unimplemented) ListUpcomingReservations(w http.ResponseWriter, r *http.Request, params ListUpcomingReservationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List rigs
+// (GET /rigs)
+func (_ Unimplemented) ListRigs(w http.ResponseWriter, r *http.Request, params ListRigsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a rig
+// (POST /rigs)
+func (_ Unimplemented) CreateRig(w http.ResponseWriter, r *http.Request, params CreateRigParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a rig
+// (DELETE /rigs/{rigId})
+func (_ Unimplemented) DeleteRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a rig
+// (GET /rigs/{rigId})
+func (_ Unimplemented) GetRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params GetRigParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a rig's details
+// (PUT /rigs/{rigId})
+func (_ Unimplemented) UpdateRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params UpdateRigParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a rig's service history
+// (GET /rigs/{rigId}/maintenance)
+func (_ Unimplemented) ListRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params ListRigMaintenanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log work done on a rig
+// (POST /rigs/{rigId}/maintenance)
+func (_ Unimplemented) CreateRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params CreateRigMaintenanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an entry from a rig's service history
+// (DELETE /rigs/{rigId}/maintenance/{entryId})
+func (_ Unimplemented) DeleteRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, entryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// View a shared trip
+// (GET /shared/{token})
+func (_ Unimplemented) GetSharedTrip(w http.ResponseWriter, r *http.Request, token string, params GetSharedTripParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Embed a shared trip in another site
+// (GET /shared/{token}/embed)
+func (_ Unimplemented) GetSharedTripEmbed(w http.ResponseWriter, r *http.Request, token string, params GetSharedTripEmbedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Totals for every trip and the most used tags
+// (GET /stats/dashboard)
+func (_ Unimplemented) GetDashboard(w http.ResponseWriter, r *http.Request, params GetDashboardParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Nights spent, as heatmap points
+// (GET /stats/heatmap)
+func (_ Unimplemented) GetStopHeatmap(w http.ResponseWriter, r *http.Request, params GetStopHeatmapParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Propane totals and consumption rate
+// (GET /stats/propane)
+func (_ Unimplemented) GetPropaneStats(w http.ResponseWriter, r *http.Request, params GetPropaneStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// States and provinces visited
+// (GET /stats/states-visited)
+func (_ Unimplemented) GetStatesVisited(w http.ResponseWriter, r *http.Request, params GetStatesVisitedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop counts for every tag, rolled up through the hierarchy
+// (GET /stats/tags)
+func (_ Unimplemented) GetTagStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clustered stop markers for a map view
+// (GET /stops/clusters)
+func (_ Unimplemented) ListStopClusters(w http.ResponseWriter, r *http.Request, params ListStopClustersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stops near a point
+// (GET /stops/nearby)
+func (_ Unimplemented) ListNearbyStops(w http.ResponseWriter, r *http.Request, params ListNearbyStopsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Which nights of a year are accounted for by stops
+// (GET /stops/occupancy)
+func (_ Unimplemented) GetStopOccupancy(w http.ResponseWriter, r *http.Request, params GetStopOccupancyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tags, optionally filtered by name prefix
+// (GET /tags)
+func (_ Unimplemented) ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a tag by name
+// (POST /tags)
+func (_ Unimplemented) CreateTag(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a tag
+// (DELETE /tags/{slug})
+func (_ Unimplemented) DeleteTag(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a tag's display name
+// (PATCH /tags/{slug})
+func (_ Unimplemented) PatchTag(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Merge a tag into another
+// (POST /tags/{slug}/merge)
+func (_ Unimplemented) MergeTag(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a tag to the top level
+// (DELETE /tags/{slug}/parent)
+func (_ Unimplemented) ClearTagParent(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Put a tag under another
+// (PUT /tags/{slug}/parent)
+func (_ Unimplemented) SetTagParent(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List recently deleted trips and stops
+// (GET /trash)
+func (_ Unimplemented) ListTrash(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a deleted trip or stop
+// (POST /trash/{id}/restore)
+func (_ Unimplemented) RestoreTrashItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all trips
+// (GET /trips)
+func (_ Unimplemented) ListTrips(w http.ResponseWriter, r *http.Request, params ListTripsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a trip
+// (POST /trips)
+func (_ Unimplemented) CreateTrip(w http.ResponseWriter, r *http.Request, params CreateTripParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the trip in progress and its tank status
+// (GET /trips/current)
+func (_ Unimplemented) GetCurrentTrip(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a trip
+// (DELETE /trips/{id})
+func (_ Unimplemented) DeleteTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a trip by ID
+// (GET /trips/{id})
+func (_ Unimplemented) GetTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a trip
+// (PUT /trips/{id})
+func (_ Unimplemented) UpdateTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UpdateTripParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Copy a trip into a new one
+// (POST /trips/{id}/clone)
+func (_ Unimplemented) CloneTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CloneTripParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Find nights within a trip that no stop accounts for
+// (GET /trips/{id}/gaps)
+func (_ Unimplemented) ListTripGaps(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a trip's stops and route as GeoJSON
+// (GET /trips/{id}/geojson)
+func (_ Unimplemented) GetTripGeoJSON(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List every recorded version of a trip
+// (GET /trips/{id}/history)
+func (_ Unimplemented) ListTripHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Put a trip back as it was at a revision
+// (POST /trips/{id}/history/{revision}/revert)
+func (_ Unimplemented) RevertTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, revision int, params RevertTripParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import stops from GPX waypoints
+// (POST /trips/{id}/import/gpx)
+func (_ Unimplemented) ImportTripGPX(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Render a trip as a static map image
+// (GET /trips/{id}/map.png)
+func (_ Unimplemented) GetTripMap(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripMapParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the distance covered on a trip
+// (GET /trips/{id}/mileage)
+func (_ Unimplemented) GetTripMileage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripMileageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Chart a trip's battery, solar, and generator readings
+// (GET /trips/{id}/power)
+func (_ Unimplemented) GetTripPower(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List active share links for a trip
+// (GET /trips/{id}/shares)
+func (_ Unimplemented) ListTripShares(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a share link for a trip
+// (POST /trips/{id}/shares)
+func (_ Unimplemented) CreateTripShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a share link
+// (DELETE /trips/{id}/shares/{shareId})
+func (_ Unimplemented) RevokeTripShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, shareId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a trip's stop and route totals
+// (GET /trips/{id}/stats)
+func (_ Unimplemented) GetTripStats(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a trip's border crossings
+// (GET /trips/{tripId}/border-crossings)
+func (_ Unimplemented) ListTripBorderCrossings(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log a border crossing
+// (POST /trips/{tripId}/border-crossings)
+func (_ Unimplemented) CreateTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a border crossing
+// (DELETE /trips/{tripId}/border-crossings/{crossingId})
+func (_ Unimplemented) DeleteTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a border crossing
+// (GET /trips/{tripId}/border-crossings/{crossingId})
+func (_ Unimplemented) GetTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a border crossing
+// (PUT /trips/{tripId}/border-crossings/{crossingId})
+func (_ Unimplemented) UpdateTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Compare a trip's spending with its budget
+// (GET /trips/{tripId}/budget-report)
+func (_ Unimplemented) GetTripBudgetReport(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check a trip's planned drives against driving rules
+// (POST /trips/{tripId}/drive-check)
+func (_ Unimplemented) CheckTripDrives(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params CheckTripDrivesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a trip's expenses
+// (GET /trips/{tripId}/expenses)
+func (_ Unimplemented) ListTripExpenses(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an expense
+// (DELETE /trips/{tripId}/expenses/{expenseId})
+func (_ Unimplemented) DeleteTripExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, expenseId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record who paid an expense and who shares it
+// (PUT /trips/{tripId}/expenses/{expenseId}/split)
+func (_ Unimplemented) SplitTripExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, expenseId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a trip's journal by day
+// (GET /trips/{tripId}/journal)
+func (_ Unimplemented) ListTripJournal(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListTripJournalParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Write a journal entry
+// (POST /trips/{tripId}/journal)
+func (_ Unimplemented) CreateTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a journal entry
+// (DELETE /trips/{tripId}/journal/{entryId})
+func (_ Unimplemented) DeleteTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a journal entry
+// (GET /trips/{tripId}/journal/{entryId})
+func (_ Unimplemented) GetTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a journal entry
+// (PUT /trips/{tripId}/journal/{entryId})
+func (_ Unimplemented) UpdateTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the route legs between a trip's stops
+// (GET /trips/{tripId}/legs)
+func (_ Unimplemented) ListTripRouteLegs(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListTripRouteLegsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set a route leg's distance, duration, and polyline
+// (PUT /trips/{tripId}/legs/{legId})
+func (_ Unimplemented) UpdateTripRouteLeg(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params UpdateTripRouteLegParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a route leg's elevation profile
+// (GET /trips/{tripId}/legs/{legId}/elevation)
+func (_ Unimplemented) GetTripRouteLegElevation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params GetTripRouteLegElevationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a route leg's GPX track
+// (GET /trips/{tripId}/legs/{legId}/track)
+func (_ Unimplemented) GetTripRouteLegTrack(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a GPX track for a route leg
+// (POST /trips/{tripId}/legs/{legId}/track)
+func (_ Unimplemented) UploadTripRouteLegTrack(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params UploadTripRouteLegTrackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a trip's members
+// (GET /trips/{tripId}/members)
+func (_ Unimplemented) ListTripMembers(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Share a trip with another user
+// (POST /trips/{tripId}/members)
+func (_ Unimplemented) InviteTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop sharing a trip with a user
+// (DELETE /trips/{tripId}/members/{userId})
+func (_ Unimplemented) RemoveTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change a member's role
+// (PATCH /trips/{tripId}/members/{userId})
+func (_ Unimplemented) PatchTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log an expense in one tap
+// (POST /trips/{tripId}/quicklog)
+func (_ Unimplemented) QuickLogExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Settle up a group trip's shared costs
+// (GET /trips/{tripId}/settlement)
+func (_ Unimplemented) GetTripSettlement(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List stops suggested from location reports
+// (GET /trips/{tripId}/stop-suggestions)
+func (_ Unimplemented) ListTripStopSuggestions(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dismiss a suggested stop
+// (DELETE /trips/{tripId}/stop-suggestions/{suggestionId})
+func (_ Unimplemented) DismissTripStopSuggestion(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, suggestionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log a suggested stop
+// (POST /trips/{tripId}/stop-suggestions/{suggestionId}/accept)
+func (_ Unimplemented) AcceptTripStopSuggestion(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, suggestionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all stops for a trip
+// (GET /trips/{tripId}/stops)
+func (_ Unimplemented) ListStops(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListStopsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a stop on a trip
+// (POST /trips/{tripId}/stops)
+func (_ Unimplemented) CreateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a stop
+// (DELETE /trips/{tripId}/stops/{stopId})
+func (_ Unimplemented) DeleteStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a stop by ID
+// (GET /trips/{tripId}/stops/{stopId})
+func (_ Unimplemented) GetStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a stop
+// (PUT /trips/{tripId}/stops/{stopId})
+func (_ Unimplemented) UpdateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a planned stop as reached
+// (POST /trips/{tripId}/stops/{stopId}/arrive)
+func (_ Unimplemented) ArriveAtStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List checklists run at a stop
+// (GET /trips/{tripId}/stops/{stopId}/checklists)
+func (_ Unimplemented) ListStopChecklists(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a checklist at a stop
+// (POST /trips/{tripId}/stops/{stopId}/checklists)
+func (_ Unimplemented) StartStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a checklist run at a stop
+// (DELETE /trips/{tripId}/stops/{stopId}/checklists/{checklistId})
+func (_ Unimplemented) DeleteStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a checklist run at a stop
+// (GET /trips/{tripId}/stops/{stopId}/checklists/{checklistId})
+func (_ Unimplemented) GetStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check off or uncheck a checklist item
+// (PUT /trips/{tripId}/stops/{stopId}/checklists/{checklistId}/items/{itemId})
+func (_ Unimplemented) CheckStopChecklistItem(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List dump events at a stop
+// (GET /trips/{tripId}/stops/{stopId}/dumps)
+func (_ Unimplemented) ListDumpEvents(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log a dump at a stop
+// (POST /trips/{tripId}/stops/{stopId}/dumps)
+func (_ Unimplemented) CreateDumpEvent(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a dump event
+// (DELETE /trips/{tripId}/stops/{stopId}/dumps/{dumpId})
+func (_ Unimplemented) DeleteDumpEvent(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, dumpId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List every recorded version of a stop
+// (GET /trips/{tripId}/stops/{stopId}/history)
+func (_ Unimplemented) ListStopHistory(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Put a stop back as it was at a revision
+// (POST /trips/{tripId}/stops/{stopId}/history/{revision}/revert)
+func (_ Unimplemented) RevertStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, revision int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a stop's photos
+// (GET /trips/{tripId}/stops/{stopId}/photos)
+func (_ Unimplemented) ListStopPhotos(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Attach a photo to a stop
+// (POST /trips/{tripId}/stops/{stopId}/photos)
+func (_ Unimplemented) UploadStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, params UploadStopPhotoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a photo from a stop
+// (DELETE /trips/{tripId}/stops/{stopId}/photos/{photoId})
+func (_ Unimplemented) DeleteStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, photoId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a photo
+// (GET /trips/{tripId}/stops/{stopId}/photos/{photoId})
+func (_ Unimplemented) GetStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, photoId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List reservations for a stop
+// (GET /trips/{tripId}/stops/{stopId}/reservations)
+func (_ Unimplemented) ListStopReservations(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a reservation to a stop
+// (POST /trips/{tripId}/stops/{stopId}/reservations)
+func (_ Unimplemented) CreateStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a reservation
+// (DELETE /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+func (_ Unimplemented) DeleteStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a reservation
+// (GET /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+func (_ Unimplemented) GetStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a reservation
+// (PUT /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+func (_ Unimplemented) UpdateStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tags on a stop
+// (GET /trips/{tripId}/stops/{stopId}/tags)
+func (_ Unimplemented) ListTagsByStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a tag to a stop
+// (POST /trips/{tripId}/stops/{stopId}/tags)
+func (_ Unimplemented) AddTagToStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a tag from a stop
+// (DELETE /trips/{tripId}/stops/{stopId}/tags/{slug})
+func (_ Unimplemented) RemoveTagFromStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tank readings at a stop
+// (GET /trips/{tripId}/stops/{stopId}/tank-levels)
+func (_ Unimplemented) ListTankLevels(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record tank levels at a stop
+// (POST /trips/{tripId}/stops/{stopId}/tank-levels)
+func (_ Unimplemented) CreateTankLevel(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a tank reading
+// (DELETE /trips/{tripId}/stops/{stopId}/tank-levels/{levelId})
+func (_ Unimplemented) DeleteTankLevel(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, levelId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhooks
+// (GET /webhooks)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe a URL to events
+// (POST /webhooks)
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook
+// (DELETE /webhooks/{id})
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a webhook
+// (GET /webhooks/{id})
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a webhook
+// (PUT /webhooks/{id})
+func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a webhook's delivery attempts
+// (GET /webhooks/{id}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a webhook's secret
+// (POST /webhooks/{id}/rotate-secret)
+func (_ Unimplemented) RotateWebhookSecret(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a test delivery
+// (POST /webhooks/{id}/test)
+func (_ Unimplemented) TestWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetPoolStats operation middleware
+func (siw *ServerInterfaceWrapper) GetPoolStats(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPoolStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdminNormalizeLocations operation middleware
+func (siw *ServerInterfaceWrapper) AdminNormalizeLocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminNormalizeLocationsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminNormalizeLocations(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdminReassignStops operation middleware
+func (siw *ServerInterfaceWrapper) AdminReassignStops(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminReassignStopsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminReassignStops(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdminShiftTripDates operation middleware
+func (siw *ServerInterfaceWrapper) AdminShiftTripDates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminShiftTripDatesParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminShiftTripDates(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAPIKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIKey(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBorderCrossingReport operation middleware
+func (siw *ServerInterfaceWrapper) GetBorderCrossingReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBorderCrossingReportParams
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "year", r.URL.Query(), &params.Year, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBorderCrossingReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListChecklistTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListChecklistTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateChecklistTemplate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteChecklistTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChecklistTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateChecklistTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCustomFieldsParams
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entity", r.URL.Query(), &params.Entity, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCustomFields(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCustomField operation middleware
+func (siw *ServerInterfaceWrapper) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCustomField(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomField operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomField(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomField(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchCustomField operation middleware
+func (siw *ServerInterfaceWrapper) PatchCustomField(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchCustomField(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExport operation middleware
+func (siw *ServerInterfaceWrapper) GetExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportParams
+
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "columns", r.URL.Query(), &params.Columns, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "columns", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	// ------------- Optional header parameter "Accept" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListExportDeletions operation middleware
+func (siw *ServerInterfaceWrapper) ListExportDeletions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExportDeletionsParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExportDeletions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHealthParams
+
+	// ------------- Optional query parameter "detail" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "detail", r.URL.Query(), &params.Detail, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "detail", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IngestLocation operation middleware
+func (siw *ServerInterfaceWrapper) IngestLocation(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestLocation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMaintenanceDue operation middleware
+func (siw *ServerInterfaceWrapper) ListMaintenanceDue(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaintenanceDueParams
+
+	// ------------- Optional query parameter "within_miles" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "within_miles", r.URL.Query(), &params.WithinMiles, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "within_miles", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaintenanceDue(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMaintenanceItems operation middleware
+func (siw *ServerInterfaceWrapper) ListMaintenanceItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaintenanceItemsParams
+
+	// ------------- Optional query parameter "vehicle" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "vehicle", r.URL.Query(), &params.Vehicle, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicle", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaintenanceItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMaintenanceItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateMaintenanceItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMaintenanceItemParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMaintenanceItem(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMaintenanceItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaintenanceItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMaintenanceItem(w, r, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMaintenanceRecord operation middleware
+func (siw *ServerInterfaceWrapper) CreateMaintenanceRecord(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMaintenanceRecordParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMaintenanceRecord(w, r, itemId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNoteTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListNoteTemplates(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNoteTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateNoteTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateNoteTemplate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateNoteTemplate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteNoteTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNoteTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNoteTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNoteTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetNoteTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNoteTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateNoteTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNoteTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNoteTemplate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOdometerReadings operation middleware
+func (siw *ServerInterfaceWrapper) ListOdometerReadings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOdometerReadingsParams
+
+	// ------------- Optional query parameter "vehicle" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "vehicle", r.URL.Query(), &params.Vehicle, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicle", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "trip_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "trip_id", r.URL.Query(), &params.TripId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trip_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOdometerReadings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOdometerReading operation middleware
+func (siw *ServerInterfaceWrapper) CreateOdometerReading(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateOdometerReadingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOdometerReading(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOdometerReading operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOdometerReading(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOdometerReading(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOdometerReading operation middleware
+func (siw *ServerInterfaceWrapper) GetOdometerReading(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOdometerReadingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOdometerReading(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganization operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganization(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganization(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchOrganization operation middleware
+func (siw *ServerInterfaceWrapper) PatchOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchOrganization(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationMembers(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) AddOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddOrganizationMember(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveOrganizationMember(w, r, orgId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) PatchOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchOrganizationMember(w, r, orgId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPackingLists operation middleware
+func (siw *ServerInterfaceWrapper) ListPackingLists(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPackingListsParams
+
+	// ------------- Optional query parameter "trip_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "trip_id", r.URL.Query(), &params.TripId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trip_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "templates" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "templates", r.URL.Query(), &params.Templates, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templates", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPackingLists(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePackingList operation middleware
+func (siw *ServerInterfaceWrapper) CreatePackingList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePackingList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePackingList operation middleware
+func (siw *ServerInterfaceWrapper) DeletePackingList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePackingList(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPackingList operation middleware
+func (siw *ServerInterfaceWrapper) GetPackingList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackingList(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePackingList operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePackingList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePackingList(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePackingItem operation middleware
+func (siw *ServerInterfaceWrapper) CreatePackingItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePackingItem(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePackingItem operation middleware
+func (siw *ServerInterfaceWrapper) DeletePackingItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePackingItem(w, r, id, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePackingItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePackingItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePackingItem(w, r, id, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPhotoThumbnail operation middleware
+func (siw *ServerInterfaceWrapper) GetPhotoThumbnail(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "photoId" -------------
+	var photoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "photoId", chi.URLParam(r, "photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPhotoThumbnailParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", r.URL.Query(), &params.Size, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPhotoThumbnail(w, r, photoId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPointsOfInterest operation middleware
+func (siw *ServerInterfaceWrapper) ListPointsOfInterest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPointsOfInterestParams
+
+	// ------------- Optional query parameter "trip_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "trip_id", r.URL.Query(), &params.TripId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trip_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "category", r.URL.Query(), &params.Category, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tag", r.URL.Query(), &params.Tag, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPointsOfInterest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePointOfInterest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePointOfInterest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePointOfInterest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePointOfInterest operation middleware
+func (siw *ServerInterfaceWrapper) DeletePointOfInterest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePointOfInterest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPointOfInterest operation middleware
+func (siw *ServerInterfaceWrapper) GetPointOfInterest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPointOfInterest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePointOfInterest operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePointOfInterest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePointOfInterest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPowerReadings operation middleware
+func (siw *ServerInterfaceWrapper) ListPowerReadings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPowerReadingsParams
+
+	// ------------- Optional query parameter "trip_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "trip_id", r.URL.Query(), &params.TripId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trip_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPowerReadings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePowerReading operation middleware
+func (siw *ServerInterfaceWrapper) CreatePowerReading(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePowerReading(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePowerReading operation middleware
+func (siw *ServerInterfaceWrapper) DeletePowerReading(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePowerReading(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPowerReading operation middleware
+func (siw *ServerInterfaceWrapper) GetPowerReading(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPowerReading(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPropaneFills operation middleware
+func (siw *ServerInterfaceWrapper) ListPropaneFills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPropaneFillsParams
+
+	// ------------- Optional query parameter "trip_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "trip_id", r.URL.Query(), &params.TripId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trip_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPropaneFills(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePropaneFill operation middleware
+func (siw *ServerInterfaceWrapper) CreatePropaneFill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePropaneFillParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePropaneFill(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePropaneFill operation middleware
+func (siw *ServerInterfaceWrapper) DeletePropaneFill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePropaneFill(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPropaneFill operation middleware
+func (siw *ServerInterfaceWrapper) GetPropaneFill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPropaneFillParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPropaneFill(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUpcomingReservations operation middleware
+func (siw *ServerInterfaceWrapper) ListUpcomingReservations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUpcomingReservationsParams
+
+	// ------------- Optional query parameter "remind_days" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "remind_days", r.URL.Query(), &params.RemindDays, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "remind_days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUpcomingReservations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRigs operation middleware
+func (siw *ServerInterfaceWrapper) ListRigs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRigsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRigs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRig operation middleware
+func (siw *ServerInterfaceWrapper) CreateRig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRigParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRig(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRig operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "rigId" -------------
+	var rigId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rigId", chi.URLParam(r, "rigId"), &rigId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rigId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRig(w, r, rigId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRig operation middleware
+func (siw *ServerInterfaceWrapper) GetRig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "rigId" -------------
+	var rigId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rigId", chi.URLParam(r, "rigId"), &rigId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rigId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRigParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRig(w, r, rigId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "rigId" -------------
+	var rigId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rigId", chi.URLParam(r, "rigId"), &rigId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rigId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateRigParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRig(w, r, rigId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRigMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) ListRigMaintenance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "rigId" -------------
+	var rigId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rigId", chi.URLParam(r, "rigId"), &rigId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rigId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRigMaintenanceParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRigMaintenance(w, r, rigId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRigMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) CreateRigMaintenance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "rigId" -------------
+	var rigId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rigId", chi.URLParam(r, "rigId"), &rigId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rigId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRigMaintenanceParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRigMaintenance(w, r, rigId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRigMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRigMaintenance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "rigId" -------------
+	var rigId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rigId", chi.URLParam(r, "rigId"), &rigId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rigId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", chi.URLParam(r, "entryId"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRigMaintenance(w, r, rigId, entryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSharedTrip operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharedTripParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedTrip(w, r, token, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSharedTripEmbed operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedTripEmbed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharedTripEmbedParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedTripEmbed(w, r, token, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardParams
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tags", r.URL.Query(), &params.Tags, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDashboard(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStopHeatmap operation middleware
+func (siw *ServerInterfaceWrapper) GetStopHeatmap(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStopHeatmapParams
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "year", r.URL.Query(), &params.Year, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cell" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cell", r.URL.Query(), &params.Cell, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cell", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStopHeatmap(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPropaneStats operation middleware
+func (siw *ServerInterfaceWrapper) GetPropaneStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPropaneStatsParams
+
+	// ------------- Optional query parameter "trip_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "trip_id", r.URL.Query(), &params.TripId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trip_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPropaneStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatesVisited operation middleware
+func (siw *ServerInterfaceWrapper) GetStatesVisited(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatesVisitedParams
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "year", r.URL.Query(), &params.Year, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatesVisited(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTagStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTagStats(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStopClusters operation middleware
+func (siw *ServerInterfaceWrapper) ListStopClusters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStopClustersParams
+
+	// ------------- Required query parameter "bbox" -------------
+
+	if paramValue := r.URL.Query().Get("bbox"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "bbox"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", false, true, "bbox", r.URL.Query(), &params.Bbox, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bbox", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "zoom" -------------
+
+	if paramValue := r.URL.Query().Get("zoom"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "zoom"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "zoom", r.URL.Query(), &params.Zoom, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "zoom", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStopClusters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNearbyStops operation middleware
+func (siw *ServerInterfaceWrapper) ListNearbyStops(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNearbyStopsParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lat"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "lat", r.URL.Query(), &params.Lat, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lon", r.URL.Query(), &params.Lon, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lng" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lng", r.URL.Query(), &params.Lng, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lng", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "radius_km" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "radius_km", r.URL.Query(), &params.RadiusKm, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius_km", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNearbyStops(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStopOccupancy operation middleware
+func (siw *ServerInterfaceWrapper) GetStopOccupancy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStopOccupancyParams
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "year", r.URL.Query(), &params.Year, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStopOccupancy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTag(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTag(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchTag operation middleware
+func (siw *ServerInterfaceWrapper) PatchTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchTag(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergeTag operation middleware
+func (siw *ServerInterfaceWrapper) MergeTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergeTag(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClearTagParent operation middleware
+func (siw *ServerInterfaceWrapper) ClearTagParent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClearTagParent(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTagParent operation middleware
+func (siw *ServerInterfaceWrapper) SetTagParent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTagParent(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTrash operation middleware
+func (siw *ServerInterfaceWrapper) ListTrash(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreTrashItem operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTrashItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreTrashItem(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTrips operation middleware
+func (siw *ServerInterfaceWrapper) ListTrips(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTripsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "field", r.URL.Query(), &params.Field, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrips(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTrip operation middleware
+func (siw *ServerInterfaceWrapper) CreateTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTripParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTrip(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentTrip operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentTrip(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentTrip(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTrip operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTrip(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTrip operation middleware
+func (siw *ServerInterfaceWrapper) GetTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrip(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTrip operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTripParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTrip(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneTrip operation middleware
+func (siw *ServerInterfaceWrapper) CloneTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CloneTripParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneTrip(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripGaps operation middleware
+func (siw *ServerInterfaceWrapper) ListTripGaps(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripGaps(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripGeoJSON operation middleware
+func (siw *ServerInterfaceWrapper) GetTripGeoJSON(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripGeoJSON(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListTripHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripHistory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevertTrip operation middleware
+func (siw *ServerInterfaceWrapper) RevertTrip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevertTripParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevertTrip(w, r, id, revision, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTripGPX operation middleware
+func (siw *ServerInterfaceWrapper) ImportTripGPX(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTripGPX(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripMap operation middleware
+func (siw *ServerInterfaceWrapper) GetTripMap(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripMapParams
+
+	// ------------- Optional query parameter "width" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "width", r.URL.Query(), &params.Width, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "width", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "height" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "height", r.URL.Query(), &params.Height, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "height", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripMap(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripMileage operation middleware
+func (siw *ServerInterfaceWrapper) GetTripMileage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripMileageParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripMileage(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripPower operation middleware
+func (siw *ServerInterfaceWrapper) GetTripPower(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripPower(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripShares operation middleware
+func (siw *ServerInterfaceWrapper) ListTripShares(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripShares(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTripShare operation middleware
+func (siw *ServerInterfaceWrapper) CreateTripShare(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTripShare(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeTripShare operation middleware
+func (siw *ServerInterfaceWrapper) RevokeTripShare(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "shareId" -------------
+	var shareId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shareId", chi.URLParam(r, "shareId"), &shareId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shareId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeTripShare(w, r, id, shareId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTripStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripStatsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripStats(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripBorderCrossings operation middleware
+func (siw *ServerInterfaceWrapper) ListTripBorderCrossings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripBorderCrossings(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTripBorderCrossing operation middleware
+func (siw *ServerInterfaceWrapper) CreateTripBorderCrossing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTripBorderCrossing(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTripBorderCrossing operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripBorderCrossing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "crossingId" -------------
+	var crossingId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "crossingId", chi.URLParam(r, "crossingId"), &crossingId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "crossingId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTripBorderCrossing(w, r, tripId, crossingId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripBorderCrossing operation middleware
+func (siw *ServerInterfaceWrapper) GetTripBorderCrossing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "crossingId" -------------
+	var crossingId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "crossingId", chi.URLParam(r, "crossingId"), &crossingId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "crossingId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripBorderCrossing(w, r, tripId, crossingId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTripBorderCrossing operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTripBorderCrossing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "crossingId" -------------
+	var crossingId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "crossingId", chi.URLParam(r, "crossingId"), &crossingId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "crossingId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTripBorderCrossing(w, r, tripId, crossingId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripBudgetReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTripBudgetReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripBudgetReport(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckTripDrives operation middleware
+func (siw *ServerInterfaceWrapper) CheckTripDrives(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckTripDrivesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckTripDrives(w, r, tripId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripExpenses operation middleware
+func (siw *ServerInterfaceWrapper) ListTripExpenses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripExpenses(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTripExpense operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripExpense(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "expenseId", chi.URLParam(r, "expenseId"), &expenseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expenseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTripExpense(w, r, tripId, expenseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SplitTripExpense operation middleware
+func (siw *ServerInterfaceWrapper) SplitTripExpense(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "expenseId", chi.URLParam(r, "expenseId"), &expenseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expenseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SplitTripExpense(w, r, tripId, expenseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripJournal operation middleware
+func (siw *ServerInterfaceWrapper) ListTripJournal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTripJournalParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "date", r.URL.Query(), &params.Date, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripJournal(w, r, tripId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTripJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTripJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTripJournalEntry(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTripJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", chi.URLParam(r, "entryId"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTripJournalEntry(w, r, tripId, entryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) GetTripJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", chi.URLParam(r, "entryId"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripJournalEntry(w, r, tripId, entryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTripJournalEntry operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTripJournalEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", chi.URLParam(r, "entryId"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTripJournalEntry(w, r, tripId, entryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripRouteLegs operation middleware
+func (siw *ServerInterfaceWrapper) ListTripRouteLegs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTripRouteLegsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripRouteLegs(w, r, tripId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTripRouteLeg operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTripRouteLeg(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "legId" -------------
+	var legId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "legId", chi.URLParam(r, "legId"), &legId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "legId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTripRouteLegParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTripRouteLeg(w, r, tripId, legId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripRouteLegElevation operation middleware
+func (siw *ServerInterfaceWrapper) GetTripRouteLegElevation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "legId" -------------
+	var legId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "legId", chi.URLParam(r, "legId"), &legId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "legId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripRouteLegElevationParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripRouteLegElevation(w, r, tripId, legId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripRouteLegTrack operation middleware
+func (siw *ServerInterfaceWrapper) GetTripRouteLegTrack(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "legId" -------------
+	var legId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "legId", chi.URLParam(r, "legId"), &legId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "legId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripRouteLegTrack(w, r, tripId, legId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadTripRouteLegTrack operation middleware
+func (siw *ServerInterfaceWrapper) UploadTripRouteLegTrack(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "legId" -------------
+	var legId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "legId", chi.URLParam(r, "legId"), &legId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "legId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadTripRouteLegTrackParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Units" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Units")]; found {
+		var Units Units
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Units", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Units", valueList[0], &Units, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Units", Err: err})
+			return
+		}
+
+		params.Units = &Units
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadTripRouteLegTrack(w, r, tripId, legId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListTripMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripMembers(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InviteTripMember operation middleware
+func (siw *ServerInterfaceWrapper) InviteTripMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InviteTripMember(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTripMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTripMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTripMember(w, r, tripId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchTripMember operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchTripMember(w, r, tripId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QuickLogExpense operation middleware
+func (siw *ServerInterfaceWrapper) QuickLogExpense(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuickLogExpense(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTripSettlement operation middleware
+func (siw *ServerInterfaceWrapper) GetTripSettlement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTripSettlement(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTripStopSuggestions operation middleware
+func (siw *ServerInterfaceWrapper) ListTripStopSuggestions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTripStopSuggestions(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DismissTripStopSuggestion operation middleware
+func (siw *ServerInterfaceWrapper) DismissTripStopSuggestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "suggestionId" -------------
+	var suggestionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "suggestionId", chi.URLParam(r, "suggestionId"), &suggestionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suggestionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DismissTripStopSuggestion(w, r, tripId, suggestionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptTripStopSuggestion operation middleware
+func (siw *ServerInterfaceWrapper) AcceptTripStopSuggestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "suggestionId" -------------
+	var suggestionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "suggestionId", chi.URLParam(r, "suggestionId"), &suggestionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suggestionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptTripStopSuggestion(w, r, tripId, suggestionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStops operation middleware
+func (siw *ServerInterfaceWrapper) ListStops(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStopsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "field", r.URL.Query(), &params.Field, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStops(w, r, tripId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateStop operation middleware
+func (siw *ServerInterfaceWrapper) CreateStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateStop(w, r, tripId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStop operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStop(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStop operation middleware
+func (siw *ServerInterfaceWrapper) GetStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStop(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateStop operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateStop(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArriveAtStop operation middleware
+func (siw *ServerInterfaceWrapper) ArriveAtStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArriveAtStop(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStopChecklists operation middleware
+func (siw *ServerInterfaceWrapper) ListStopChecklists(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStopChecklists(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartStopChecklist operation middleware
+func (siw *ServerInterfaceWrapper) StartStopChecklist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartStopChecklist(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStopChecklist operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStopChecklist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", chi.URLParam(r, "checklistId"), &checklistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "checklistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStopChecklist(w, r, tripId, stopId, checklistId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStopChecklist operation middleware
+func (siw *ServerInterfaceWrapper) GetStopChecklist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", chi.URLParam(r, "checklistId"), &checklistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "checklistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStopChecklist(w, r, tripId, stopId, checklistId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckStopChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) CheckStopChecklistItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", chi.URLParam(r, "checklistId"), &checklistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "checklistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckStopChecklistItem(w, r, tripId, stopId, checklistId, itemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDumpEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListDumpEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDumpEvents(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDumpEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateDumpEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDumpEvent(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDumpEvent operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDumpEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "dumpId" -------------
+	var dumpId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dumpId", chi.URLParam(r, "dumpId"), &dumpId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dumpId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDumpEvent(w, r, tripId, stopId, dumpId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStopHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListStopHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStopHistory(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevertStop operation middleware
+func (siw *ServerInterfaceWrapper) RevertStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevertStop(w, r, tripId, stopId, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStopPhotos operation middleware
+func (siw *ServerInterfaceWrapper) ListStopPhotos(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStopPhotos(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadStopPhoto operation middleware
+func (siw *ServerInterfaceWrapper) UploadStopPhoto(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadStopPhotoParams
+
+	// ------------- Optional query parameter "apply_exif" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "apply_exif", r.URL.Query(), &params.ApplyExif, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apply_exif", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadStopPhoto(w, r, tripId, stopId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStopPhoto operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStopPhoto(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "photoId" -------------
+	var photoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "photoId", chi.URLParam(r, "photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStopPhoto(w, r, tripId, stopId, photoId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStopPhoto operation middleware
+func (siw *ServerInterfaceWrapper) GetStopPhoto(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "photoId" -------------
+	var photoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "photoId", chi.URLParam(r, "photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStopPhoto(w, r, tripId, stopId, photoId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStopReservations operation middleware
+func (siw *ServerInterfaceWrapper) ListStopReservations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStopReservations(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateStopReservation operation middleware
+func (siw *ServerInterfaceWrapper) CreateStopReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateStopReservation(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStopReservation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStopReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reservationId" -------------
+	var reservationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationId", chi.URLParam(r, "reservationId"), &reservationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reservationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStopReservation(w, r, tripId, stopId, reservationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStopReservation operation middleware
+func (siw *ServerInterfaceWrapper) GetStopReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reservationId" -------------
+	var reservationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationId", chi.URLParam(r, "reservationId"), &reservationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reservationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStopReservation(w, r, tripId, stopId, reservationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateStopReservation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStopReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reservationId" -------------
+	var reservationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationId", chi.URLParam(r, "reservationId"), &reservationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reservationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateStopReservation(w, r, tripId, stopId, reservationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTagsByStop operation middleware
+func (siw *ServerInterfaceWrapper) ListTagsByStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTagsByStop(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTagToStop operation middleware
+func (siw *ServerInterfaceWrapper) AddTagToStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTagToStop(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTagFromStop operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTagFromStop(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTagFromStop(w, r, tripId, stopId, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTankLevels operation middleware
+func (siw *ServerInterfaceWrapper) ListTankLevels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTankLevels(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTankLevel operation middleware
+func (siw *ServerInterfaceWrapper) CreateTankLevel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTankLevel(w, r, tripId, stopId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTankLevel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTankLevel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tripId" -------------
+	var tripId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "stopId" -------------
+	var stopId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "levelId" -------------
+	var levelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "levelId", chi.URLParam(r, "levelId"), &levelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "levelId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTankLevel(w, r, tripId, stopId, levelId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateWebhookSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateWebhookSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateWebhookSecret(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestWebhook operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/pool", wrapper.GetPoolStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/trips/{id}/normalize-locations", wrapper.AdminNormalizeLocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/trips/{id}/reassign-stops", wrapper.AdminReassignStops)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/trips/{id}/shift-dates", wrapper.AdminShiftTripDates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys", wrapper.ListAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api-keys", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api-keys/{id}", wrapper.DeleteAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.RefreshToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/token", wrapper.CreateToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/border-crossings", wrapper.GetBorderCrossingReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/checklist-templates", wrapper.ListChecklistTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/checklist-templates", wrapper.CreateChecklistTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/checklist-templates/{id}", wrapper.DeleteChecklistTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/checklist-templates/{id}", wrapper.GetChecklistTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/checklist-templates/{id}", wrapper.UpdateChecklistTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/custom-fields", wrapper.ListCustomFields)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/custom-fields", wrapper.CreateCustomField)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/custom-fields/{id}", wrapper.DeleteCustomField)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/custom-fields/{id}", wrapper.PatchCustomField)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export", wrapper.GetExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/deletions", wrapper.ListExportDeletions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/healthz", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/livez", wrapper.GetLiveness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/locations", wrapper.IngestLocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/maintenance/due", wrapper.ListMaintenanceDue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/maintenance/items", wrapper.ListMaintenanceItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/maintenance/items", wrapper.CreateMaintenanceItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/maintenance/items/{itemId}", wrapper.DeleteMaintenanceItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/maintenance/items/{itemId}/records", wrapper.CreateMaintenanceRecord)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/note-templates", wrapper.ListNoteTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/note-templates", wrapper.CreateNoteTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/note-templates/{id}", wrapper.DeleteNoteTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/note-templates/{id}", wrapper.GetNoteTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/note-templates/{id}", wrapper.UpdateNoteTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/odometer-readings", wrapper.ListOdometerReadings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/odometer-readings", wrapper.CreateOdometerReading)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/odometer-readings/{id}", wrapper.DeleteOdometerReading)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/odometer-readings/{id}", wrapper.GetOdometerReading)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations", wrapper.ListOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations", wrapper.CreateOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/organizations/{orgId}", wrapper.DeleteOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{orgId}", wrapper.GetOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/organizations/{orgId}", wrapper.PatchOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{orgId}/members", wrapper.ListOrganizationMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{orgId}/members", wrapper.AddOrganizationMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/organizations/{orgId}/members/{userId}", wrapper.RemoveOrganizationMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/organizations/{orgId}/members/{userId}", wrapper.PatchOrganizationMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/packing-lists", wrapper.ListPackingLists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/packing-lists", wrapper.CreatePackingList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/packing-lists/{id}", wrapper.DeletePackingList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/packing-lists/{id}", wrapper.GetPackingList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/packing-lists/{id}", wrapper.UpdatePackingList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/packing-lists/{id}/items", wrapper.CreatePackingItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/packing-lists/{id}/items/{itemId}", wrapper.DeletePackingItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/packing-lists/{id}/items/{itemId}", wrapper.UpdatePackingItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/photos/{photoId}/thumbnail", wrapper.GetPhotoThumbnail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/points-of-interest", wrapper.ListPointsOfInterest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/points-of-interest", wrapper.CreatePointOfInterest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/points-of-interest/{id}", wrapper.DeletePointOfInterest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/points-of-interest/{id}", wrapper.GetPointOfInterest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/points-of-interest/{id}", wrapper.UpdatePointOfInterest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/power-readings", wrapper.ListPowerReadings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/power-readings", wrapper.CreatePowerReading)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/power-readings/{id}", wrapper.DeletePowerReading)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/power-readings/{id}", wrapper.GetPowerReading)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/propane-fills", wrapper.ListPropaneFills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/propane-fills", wrapper.CreatePropaneFill)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/propane-fills/{id}", wrapper.DeletePropaneFill)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/propane-fills/{id}", wrapper.GetPropaneFill)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/readyz", wrapper.GetReadiness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reservations/upcoming", wrapper.ListUpcomingReservations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rigs", wrapper.ListRigs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/rigs", wrapper.CreateRig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/rigs/{rigId}", wrapper.DeleteRig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rigs/{rigId}", wrapper.GetRig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/rigs/{rigId}", wrapper.UpdateRig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rigs/{rigId}/maintenance", wrapper.ListRigMaintenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/rigs/{rigId}/maintenance", wrapper.CreateRigMaintenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/rigs/{rigId}/maintenance/{entryId}", wrapper.DeleteRigMaintenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/shared/{token}", wrapper.GetSharedTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/shared/{token}/embed", wrapper.GetSharedTripEmbed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/dashboard", wrapper.GetDashboard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/heatmap", wrapper.GetStopHeatmap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/propane", wrapper.GetPropaneStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/states-visited", wrapper.GetStatesVisited)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/tags", wrapper.GetTagStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stops/clusters", wrapper.ListStopClusters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stops/nearby", wrapper.ListNearbyStops)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stops/occupancy", wrapper.GetStopOccupancy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags", wrapper.ListTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tags", wrapper.CreateTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tags/{slug}", wrapper.DeleteTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/tags/{slug}", wrapper.PatchTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tags/{slug}/merge", wrapper.MergeTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tags/{slug}/parent", wrapper.ClearTagParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/tags/{slug}/parent", wrapper.SetTagParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash", wrapper.ListTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trash/{id}/restore", wrapper.RestoreTrashItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips", wrapper.ListTrips)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips", wrapper.CreateTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/current", wrapper.GetCurrentTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{id}", wrapper.DeleteTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}", wrapper.GetTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{id}", wrapper.UpdateTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{id}/clone", wrapper.CloneTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/gaps", wrapper.ListTripGaps)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/geojson", wrapper.GetTripGeoJSON)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/history", wrapper.ListTripHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{id}/history/{revision}/revert", wrapper.RevertTrip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{id}/import/gpx", wrapper.ImportTripGPX)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/map.png", wrapper.GetTripMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/mileage", wrapper.GetTripMileage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/power", wrapper.GetTripPower)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/shares", wrapper.ListTripShares)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{id}/shares", wrapper.CreateTripShare)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{id}/shares/{shareId}", wrapper.RevokeTripShare)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{id}/stats", wrapper.GetTripStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/border-crossings", wrapper.ListTripBorderCrossings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/border-crossings", wrapper.CreateTripBorderCrossing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/border-crossings/{crossingId}", wrapper.DeleteTripBorderCrossing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/border-crossings/{crossingId}", wrapper.GetTripBorderCrossing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/border-crossings/{crossingId}", wrapper.UpdateTripBorderCrossing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/budget-report", wrapper.GetTripBudgetReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/drive-check", wrapper.CheckTripDrives)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/expenses", wrapper.ListTripExpenses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/expenses/{expenseId}", wrapper.DeleteTripExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/expenses/{expenseId}/split", wrapper.SplitTripExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/journal", wrapper.ListTripJournal)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/journal", wrapper.CreateTripJournalEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/journal/{entryId}", wrapper.DeleteTripJournalEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/journal/{entryId}", wrapper.GetTripJournalEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/journal/{entryId}", wrapper.UpdateTripJournalEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/legs", wrapper.ListTripRouteLegs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/legs/{legId}", wrapper.UpdateTripRouteLeg)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/legs/{legId}/elevation", wrapper.GetTripRouteLegElevation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/legs/{legId}/track", wrapper.GetTripRouteLegTrack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/legs/{legId}/track", wrapper.UploadTripRouteLegTrack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/members", wrapper.ListTripMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/members", wrapper.InviteTripMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/members/{userId}", wrapper.RemoveTripMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/trips/{tripId}/members/{userId}", wrapper.PatchTripMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/quicklog", wrapper.QuickLogExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/settlement", wrapper.GetTripSettlement)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stop-suggestions", wrapper.ListTripStopSuggestions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stop-suggestions/{suggestionId}", wrapper.DismissTripStopSuggestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stop-suggestions/{suggestionId}/accept", wrapper.AcceptTripStopSuggestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops", wrapper.ListStops)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops", wrapper.CreateStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}", wrapper.DeleteStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}", wrapper.GetStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/stops/{stopId}", wrapper.UpdateStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/arrive", wrapper.ArriveAtStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/checklists", wrapper.ListStopChecklists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/checklists", wrapper.StartStopChecklist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/checklists/{checklistId}", wrapper.DeleteStopChecklist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/checklists/{checklistId}", wrapper.GetStopChecklist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/stops/{stopId}/checklists/{checklistId}/items/{itemId}", wrapper.CheckStopChecklistItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/dumps", wrapper.ListDumpEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/dumps", wrapper.CreateDumpEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/dumps/{dumpId}", wrapper.DeleteDumpEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/history", wrapper.ListStopHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/history/{revision}/revert", wrapper.RevertStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/photos", wrapper.ListStopPhotos)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/photos", wrapper.UploadStopPhoto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/photos/{photoId}", wrapper.DeleteStopPhoto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/photos/{photoId}", wrapper.GetStopPhoto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/reservations", wrapper.ListStopReservations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/reservations", wrapper.CreateStopReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/reservations/{reservationId}", wrapper.DeleteStopReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/reservations/{reservationId}", wrapper.GetStopReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trips/{tripId}/stops/{stopId}/reservations/{reservationId}", wrapper.UpdateStopReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tags", wrapper.ListTagsByStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tags", wrapper.AddTagToStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tags/{slug}", wrapper.RemoveTagFromStop)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tank-levels", wrapper.ListTankLevels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tank-levels", wrapper.CreateTankLevel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tank-levels/{levelId}", wrapper.DeleteTankLevel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{id}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{id}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhooks/{id}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{id}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{id}/rotate-secret", wrapper.RotateWebhookSecret)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{id}/test", wrapper.TestWebhook)
+	})
+
+	return r
+}
+
+type ForbiddenJSONResponse ErrorResponse
+
+type InternalErrorTextResponse string
+
+type NotModifiedResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
+}
+
+type GetPoolStatsRequestObject struct {
+}
+
+type GetPoolStatsResponseObject interface {
+	VisitGetPoolStatsResponse(w http.ResponseWriter) error
+}
+
+type GetPoolStats200JSONResponse PoolStats
+
+func (response GetPoolStats200JSONResponse) VisitGetPoolStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPoolStats403JSONResponse ErrorResponse
+
+func (response GetPoolStats403JSONResponse) VisitGetPoolStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminNormalizeLocationsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params AdminNormalizeLocationsParams
+}
+
+type AdminNormalizeLocationsResponseObject interface {
+	VisitAdminNormalizeLocationsResponse(w http.ResponseWriter) error
+}
+
+type AdminNormalizeLocations200JSONResponse DataFixResult
+
+func (response AdminNormalizeLocations200JSONResponse) VisitAdminNormalizeLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminNormalizeLocations403JSONResponse ErrorResponse
+
+func (response AdminNormalizeLocations403JSONResponse) VisitAdminNormalizeLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminNormalizeLocations404JSONResponse ErrorResponse
+
+func (response AdminNormalizeLocations404JSONResponse) VisitAdminNormalizeLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminReassignStopsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params AdminReassignStopsParams
+	Body   *AdminReassignStopsJSONRequestBody
+}
+
+type AdminReassignStopsResponseObject interface {
+	VisitAdminReassignStopsResponse(w http.ResponseWriter) error
+}
+
+type AdminReassignStops200JSONResponse DataFixResult
+
+func (response AdminReassignStops200JSONResponse) VisitAdminReassignStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminReassignStops403JSONResponse ErrorResponse
+
+func (response AdminReassignStops403JSONResponse) VisitAdminReassignStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminReassignStops404JSONResponse ErrorResponse
+
+func (response AdminReassignStops404JSONResponse) VisitAdminReassignStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminReassignStops422JSONResponse ErrorResponse
+
+func (response AdminReassignStops422JSONResponse) VisitAdminReassignStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminShiftTripDatesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params AdminShiftTripDatesParams
+	Body   *AdminShiftTripDatesJSONRequestBody
+}
+
+type AdminShiftTripDatesResponseObject interface {
+	VisitAdminShiftTripDatesResponse(w http.ResponseWriter) error
+}
+
+type AdminShiftTripDates200JSONResponse DataFixResult
+
+func (response AdminShiftTripDates200JSONResponse) VisitAdminShiftTripDatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminShiftTripDates403JSONResponse ErrorResponse
+
+func (response AdminShiftTripDates403JSONResponse) VisitAdminShiftTripDatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminShiftTripDates404JSONResponse ErrorResponse
+
+func (response AdminShiftTripDates404JSONResponse) VisitAdminShiftTripDatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminShiftTripDates422JSONResponse ErrorResponse
+
+func (response AdminShiftTripDates422JSONResponse) VisitAdminShiftTripDatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPIKeysRequestObject struct {
+}
+
+type ListAPIKeysResponseObject interface {
+	VisitListAPIKeysResponse(w http.ResponseWriter) error
+}
+
+type ListAPIKeys200JSONResponse []APIKey
+
+func (response ListAPIKeys200JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKeyRequestObject struct {
+	Body *CreateAPIKeyJSONRequestBody
+}
+
+type CreateAPIKeyResponseObject interface {
+	VisitCreateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateAPIKey201JSONResponse APIKey
+
+func (response CreateAPIKey201JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey401JSONResponse ErrorResponse
+
+func (response CreateAPIKey401JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey422JSONResponse ErrorResponse
+
+func (response CreateAPIKey422JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIKeyRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteAPIKeyResponseObject interface {
+	VisitDeleteAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIKey204Response struct {
+}
+
+func (response DeleteAPIKey204Response) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAPIKey404JSONResponse ErrorResponse
+
+func (response DeleteAPIKey404JSONResponse) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokenRequestObject struct {
+	Body *RefreshTokenJSONRequestBody
+}
+
+type RefreshTokenResponseObject interface {
+	VisitRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type RefreshToken200JSONResponse TokenResponse
+
+func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshToken401JSONResponse ErrorResponse
+
+func (response RefreshToken401JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshToken422JSONResponse ErrorResponse
+
+func (response RefreshToken422JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTokenRequestObject struct {
+	Body *CreateTokenJSONRequestBody
+}
+
+type CreateTokenResponseObject interface {
+	VisitCreateTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateToken200JSONResponse TokenResponse
+
+func (response CreateToken200JSONResponse) VisitCreateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateToken401JSONResponse ErrorResponse
+
+func (response CreateToken401JSONResponse) VisitCreateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateToken422JSONResponse ErrorResponse
+
+func (response CreateToken422JSONResponse) VisitCreateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBorderCrossingReportRequestObject struct {
+	Params GetBorderCrossingReportParams
+}
+
+type GetBorderCrossingReportResponseObject interface {
+	VisitGetBorderCrossingReportResponse(w http.ResponseWriter) error
+}
+
+type GetBorderCrossingReport200JSONResponse []BorderCrossingReportRow
+
+func (response GetBorderCrossingReport200JSONResponse) VisitGetBorderCrossingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBorderCrossingReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetBorderCrossingReport200TextcsvResponse) VisitGetBorderCrossingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetBorderCrossingReport422JSONResponse ErrorResponse
+
+func (response GetBorderCrossingReport422JSONResponse) VisitGetBorderCrossingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChecklistTemplatesRequestObject struct {
+}
+
+type ListChecklistTemplatesResponseObject interface {
+	VisitListChecklistTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListChecklistTemplates200JSONResponse []ChecklistTemplate
+
+func (response ListChecklistTemplates200JSONResponse) VisitListChecklistTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplateRequestObject struct {
+	Body *CreateChecklistTemplateJSONRequestBody
+}
+
+type CreateChecklistTemplateResponseObject interface {
+	VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistTemplate201JSONResponse ChecklistTemplate
+
+func (response CreateChecklistTemplate201JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplate422JSONResponse ErrorResponse
+
+func (response CreateChecklistTemplate422JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistTemplateRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteChecklistTemplateResponseObject interface {
+	VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistTemplate204Response struct {
+}
+
+func (response DeleteChecklistTemplate204Response) VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteChecklistTemplate404JSONResponse ErrorResponse
+
+func (response DeleteChecklistTemplate404JSONResponse) VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistTemplateRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetChecklistTemplateResponseObject interface {
+	VisitGetChecklistTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistTemplate200JSONResponse ChecklistTemplate
+
+func (response GetChecklistTemplate200JSONResponse) VisitGetChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistTemplate404JSONResponse ErrorResponse
+
+func (response GetChecklistTemplate404JSONResponse) VisitGetChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistTemplateRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateChecklistTemplateJSONRequestBody
+}
+
+type UpdateChecklistTemplateResponseObject interface {
+	VisitUpdateChecklistTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistTemplate200JSONResponse ChecklistTemplate
+
+func (response UpdateChecklistTemplate200JSONResponse) VisitUpdateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistTemplate404JSONResponse ErrorResponse
+
+func (response UpdateChecklistTemplate404JSONResponse) VisitUpdateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistTemplate422JSONResponse ErrorResponse
+
+func (response UpdateChecklistTemplate422JSONResponse) VisitUpdateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomFieldsRequestObject struct {
+	Params ListCustomFieldsParams
+}
+
+type ListCustomFieldsResponseObject interface {
+	VisitListCustomFieldsResponse(w http.ResponseWriter) error
+}
+
+type ListCustomFields200JSONResponse []CustomField
+
+func (response ListCustomFields200JSONResponse) VisitListCustomFieldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCustomFieldRequestObject struct {
+	Body *CreateCustomFieldJSONRequestBody
+}
+
+type CreateCustomFieldResponseObject interface {
+	VisitCreateCustomFieldResponse(w http.ResponseWriter) error
+}
+
+type CreateCustomField201JSONResponse CustomField
+
+func (response CreateCustomField201JSONResponse) VisitCreateCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCustomField422JSONResponse ErrorResponse
+
+func (response CreateCustomField422JSONResponse) VisitCreateCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomFieldRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteCustomFieldResponseObject interface {
+	VisitDeleteCustomFieldResponse(w http.ResponseWriter) error
+}
+
+type DeleteCustomField204Response struct {
+}
+
+func (response DeleteCustomField204Response) VisitDeleteCustomFieldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCustomField404JSONResponse ErrorResponse
+
+func (response DeleteCustomField404JSONResponse) VisitDeleteCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCustomFieldRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *PatchCustomFieldJSONRequestBody
+}
+
+type PatchCustomFieldResponseObject interface {
+	VisitPatchCustomFieldResponse(w http.ResponseWriter) error
+}
+
+type PatchCustomField200JSONResponse CustomField
+
+func (response PatchCustomField200JSONResponse) VisitPatchCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCustomField404JSONResponse ErrorResponse
+
+func (response PatchCustomField404JSONResponse) VisitPatchCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCustomField422JSONResponse ErrorResponse
+
+func (response PatchCustomField422JSONResponse) VisitPatchCustomFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportRequestObject struct {
+	Params GetExportParams
+}
+
+type GetExportResponseObject interface {
+	VisitGetExportResponse(w http.ResponseWriter) error
+}
+
+type GetExport200ApplicationgpxXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetExport200ApplicationgpxXmlResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gpx+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExport200JSONResponse []ExportRow
+
+func (response GetExport200JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetExport200TextcsvResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExport422JSONResponse ErrorResponse
+
+func (response GetExport422JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExport500TextResponse InternalErrorTextResponse
+
+func (response GetExport500TextResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(500)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type ListExportDeletionsRequestObject struct {
+	Params ListExportDeletionsParams
+}
+
+type ListExportDeletionsResponseObject interface {
+	VisitListExportDeletionsResponse(w http.ResponseWriter) error
+}
+
+type ListExportDeletions200JSONResponse []Tombstone
+
+func (response ListExportDeletions200JSONResponse) VisitListExportDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExportDeletions500TextResponse InternalErrorTextResponse
+
+func (response ListExportDeletions500TextResponse) VisitListExportDeletionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(500)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetHealthRequestObject struct {
+	Params GetHealthParams
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthResponse
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealth503JSONResponse HealthResponse
+
+func (response GetHealth503JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLivenessRequestObject struct {
+}
+
+type GetLivenessResponseObject interface {
+	VisitGetLivenessResponse(w http.ResponseWriter) error
+}
+
+type GetLiveness200JSONResponse HealthResponse
+
+func (response GetLiveness200JSONResponse) VisitGetLivenessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestLocationRequestObject struct {
+	Body *IngestLocationJSONRequestBody
+}
+
+type IngestLocationResponseObject interface {
+	VisitIngestLocationResponse(w http.ResponseWriter) error
+}
+
+type IngestLocation200JSONResponse []map[string]interface{}
+
+func (response IngestLocation200JSONResponse) VisitIngestLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestLocation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response IngestLocation403JSONResponse) VisitIngestLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestLocation422JSONResponse ErrorResponse
+
+func (response IngestLocation422JSONResponse) VisitIngestLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMaintenanceDueRequestObject struct {
+	Params ListMaintenanceDueParams
+}
+
+type ListMaintenanceDueResponseObject interface {
+	VisitListMaintenanceDueResponse(w http.ResponseWriter) error
+}
+
+type ListMaintenanceDue200JSONResponse []MaintenanceDueItem
+
+func (response ListMaintenanceDue200JSONResponse) VisitListMaintenanceDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMaintenanceDue422JSONResponse ErrorResponse
+
+func (response ListMaintenanceDue422JSONResponse) VisitListMaintenanceDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMaintenanceItemsRequestObject struct {
+	Params ListMaintenanceItemsParams
+}
+
+type ListMaintenanceItemsResponseObject interface {
+	VisitListMaintenanceItemsResponse(w http.ResponseWriter) error
+}
+
+type ListMaintenanceItems200JSONResponse []MaintenanceItem
+
+func (response ListMaintenanceItems200JSONResponse) VisitListMaintenanceItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceItemRequestObject struct {
+	Params CreateMaintenanceItemParams
+	Body   *CreateMaintenanceItemJSONRequestBody
+}
+
+type CreateMaintenanceItemResponseObject interface {
+	VisitCreateMaintenanceItemResponse(w http.ResponseWriter) error
+}
+
+type CreateMaintenanceItem201JSONResponse MaintenanceItem
+
+func (response CreateMaintenanceItem201JSONResponse) VisitCreateMaintenanceItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceItem422JSONResponse ErrorResponse
+
+func (response CreateMaintenanceItem422JSONResponse) VisitCreateMaintenanceItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMaintenanceItemRequestObject struct {
+	ItemId openapi_types.UUID `json:"itemId"`
+}
+
+type DeleteMaintenanceItemResponseObject interface {
+	VisitDeleteMaintenanceItemResponse(w http.ResponseWriter) error
+}
+
+type DeleteMaintenanceItem204Response struct {
+}
+
+func (response DeleteMaintenanceItem204Response) VisitDeleteMaintenanceItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteMaintenanceItem404JSONResponse ErrorResponse
+
+func (response DeleteMaintenanceItem404JSONResponse) VisitDeleteMaintenanceItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceRecordRequestObject struct {
+	ItemId openapi_types.UUID `json:"itemId"`
+	Params CreateMaintenanceRecordParams
+	Body   *CreateMaintenanceRecordJSONRequestBody
+}
+
+type CreateMaintenanceRecordResponseObject interface {
+	VisitCreateMaintenanceRecordResponse(w http.ResponseWriter) error
+}
+
+type CreateMaintenanceRecord201JSONResponse MaintenanceRecord
+
+func (response CreateMaintenanceRecord201JSONResponse) VisitCreateMaintenanceRecordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceRecord404JSONResponse ErrorResponse
+
+func (response CreateMaintenanceRecord404JSONResponse) VisitCreateMaintenanceRecordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceRecord422JSONResponse ErrorResponse
+
+func (response CreateMaintenanceRecord422JSONResponse) VisitCreateMaintenanceRecordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNoteTemplatesRequestObject struct {
+}
+
+type ListNoteTemplatesResponseObject interface {
+	VisitListNoteTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListNoteTemplates200JSONResponse []NoteTemplate
+
+func (response ListNoteTemplates200JSONResponse) VisitListNoteTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNoteTemplateRequestObject struct {
+	Body *CreateNoteTemplateJSONRequestBody
+}
+
+type CreateNoteTemplateResponseObject interface {
+	VisitCreateNoteTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateNoteTemplate201JSONResponse NoteTemplate
+
+func (response CreateNoteTemplate201JSONResponse) VisitCreateNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNoteTemplate422JSONResponse ErrorResponse
+
+func (response CreateNoteTemplate422JSONResponse) VisitCreateNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNoteTemplateRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteNoteTemplateResponseObject interface {
+	VisitDeleteNoteTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteNoteTemplate204Response struct {
+}
+
+func (response DeleteNoteTemplate204Response) VisitDeleteNoteTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteNoteTemplate404JSONResponse ErrorResponse
+
+func (response DeleteNoteTemplate404JSONResponse) VisitDeleteNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNoteTemplateRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetNoteTemplateResponseObject interface {
+	VisitGetNoteTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetNoteTemplate200JSONResponse NoteTemplate
+
+func (response GetNoteTemplate200JSONResponse) VisitGetNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNoteTemplate404JSONResponse ErrorResponse
+
+func (response GetNoteTemplate404JSONResponse) VisitGetNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNoteTemplateRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateNoteTemplateJSONRequestBody
+}
+
+type UpdateNoteTemplateResponseObject interface {
+	VisitUpdateNoteTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateNoteTemplate200JSONResponse NoteTemplate
+
+func (response UpdateNoteTemplate200JSONResponse) VisitUpdateNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNoteTemplate404JSONResponse ErrorResponse
+
+func (response UpdateNoteTemplate404JSONResponse) VisitUpdateNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNoteTemplate422JSONResponse ErrorResponse
+
+func (response UpdateNoteTemplate422JSONResponse) VisitUpdateNoteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOdometerReadingsRequestObject struct {
+	Params ListOdometerReadingsParams
+}
+
+type ListOdometerReadingsResponseObject interface {
+	VisitListOdometerReadingsResponse(w http.ResponseWriter) error
+}
+
+type ListOdometerReadings200JSONResponse OdometerReadingList
+
+func (response ListOdometerReadings200JSONResponse) VisitListOdometerReadingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOdometerReadingRequestObject struct {
+	Params CreateOdometerReadingParams
+	Body   *CreateOdometerReadingJSONRequestBody
+}
+
+type CreateOdometerReadingResponseObject interface {
+	VisitCreateOdometerReadingResponse(w http.ResponseWriter) error
+}
+
+type CreateOdometerReading201JSONResponse OdometerReading
+
+func (response CreateOdometerReading201JSONResponse) VisitCreateOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOdometerReading403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateOdometerReading403JSONResponse) VisitCreateOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOdometerReading404JSONResponse ErrorResponse
+
+func (response CreateOdometerReading404JSONResponse) VisitCreateOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOdometerReading422JSONResponse ErrorResponse
+
+func (response CreateOdometerReading422JSONResponse) VisitCreateOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOdometerReadingRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteOdometerReadingResponseObject interface {
+	VisitDeleteOdometerReadingResponse(w http.ResponseWriter) error
+}
+
+type DeleteOdometerReading204Response struct {
+}
+
+func (response DeleteOdometerReading204Response) VisitDeleteOdometerReadingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOdometerReading403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteOdometerReading403JSONResponse) VisitDeleteOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOdometerReading404JSONResponse ErrorResponse
+
+func (response DeleteOdometerReading404JSONResponse) VisitDeleteOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOdometerReadingRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetOdometerReadingParams
+}
+
+type GetOdometerReadingResponseObject interface {
+	VisitGetOdometerReadingResponse(w http.ResponseWriter) error
+}
+
+type GetOdometerReading200JSONResponse OdometerReading
+
+func (response GetOdometerReading200JSONResponse) VisitGetOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOdometerReading404JSONResponse ErrorResponse
+
+func (response GetOdometerReading404JSONResponse) VisitGetOdometerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationsRequestObject struct {
+}
+
+type ListOrganizationsResponseObject interface {
+	VisitListOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizations200JSONResponse []Organization
+
+func (response ListOrganizations200JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizations401JSONResponse ErrorResponse
+
+func (response ListOrganizations401JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationRequestObject struct {
+	Body *CreateOrganizationJSONRequestBody
+}
+
+type CreateOrganizationResponseObject interface {
+	VisitCreateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganization201JSONResponse Organization
+
+func (response CreateOrganization201JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization401JSONResponse ErrorResponse
+
+func (response CreateOrganization401JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization422JSONResponse ErrorResponse
+
+func (response CreateOrganization422JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationRequestObject struct {
+	OrgId openapi_types.UUID `json:"orgId"`
+}
+
+type DeleteOrganizationResponseObject interface {
+	VisitDeleteOrganizationResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrganization204Response struct {
+}
+
+func (response DeleteOrganization204Response) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOrganization401JSONResponse ErrorResponse
+
+func (response DeleteOrganization401JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization403JSONResponse ErrorResponse
+
+func (response DeleteOrganization403JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization404JSONResponse ErrorResponse
+
+func (response DeleteOrganization404JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization422JSONResponse ErrorResponse
+
+func (response DeleteOrganization422JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationRequestObject struct {
+	OrgId openapi_types.UUID `json:"orgId"`
+}
+
+type GetOrganizationResponseObject interface {
+	VisitGetOrganizationResponse(w http.ResponseWriter) error
+}
+
+type GetOrganization200JSONResponse Organization
+
+func (response GetOrganization200JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization401JSONResponse ErrorResponse
+
+func (response GetOrganization401JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization403JSONResponse ErrorResponse
+
+func (response GetOrganization403JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization404JSONResponse ErrorResponse
+
+func (response GetOrganization404JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganizationRequestObject struct {
+	OrgId openapi_types.UUID `json:"orgId"`
+	Body  *PatchOrganizationJSONRequestBody
+}
+
+type PatchOrganizationResponseObject interface {
+	VisitPatchOrganizationResponse(w http.ResponseWriter) error
+}
+
+type PatchOrganization200JSONResponse Organization
+
+func (response PatchOrganization200JSONResponse) VisitPatchOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganization401JSONResponse ErrorResponse
+
+func (response PatchOrganization401JSONResponse) VisitPatchOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganization403JSONResponse ErrorResponse
+
+func (response PatchOrganization403JSONResponse) VisitPatchOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganization404JSONResponse ErrorResponse
+
+func (response PatchOrganization404JSONResponse) VisitPatchOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganization422JSONResponse ErrorResponse
+
+func (response PatchOrganization422JSONResponse) VisitPatchOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembersRequestObject struct {
+	OrgId openapi_types.UUID `json:"orgId"`
+}
+
+type ListOrganizationMembersResponseObject interface {
+	VisitListOrganizationMembersResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizationMembers200JSONResponse []OrganizationMember
+
+func (response ListOrganizationMembers200JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers401JSONResponse ErrorResponse
+
+func (response ListOrganizationMembers401JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers403JSONResponse ErrorResponse
+
+func (response ListOrganizationMembers403JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers404JSONResponse ErrorResponse
+
+func (response ListOrganizationMembers404JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOrganizationMemberRequestObject struct {
+	OrgId openapi_types.UUID `json:"orgId"`
+	Body  *AddOrganizationMemberJSONRequestBody
+}
+
+type AddOrganizationMemberResponseObject interface {
+	VisitAddOrganizationMemberResponse(w http.ResponseWriter) error
+}
+
+type AddOrganizationMember201JSONResponse OrganizationMember
+
+func (response AddOrganizationMember201JSONResponse) VisitAddOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOrganizationMember401JSONResponse ErrorResponse
+
+func (response AddOrganizationMember401JSONResponse) VisitAddOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOrganizationMember403JSONResponse ErrorResponse
+
+func (response AddOrganizationMember403JSONResponse) VisitAddOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOrganizationMember404JSONResponse ErrorResponse
+
+func (response AddOrganizationMember404JSONResponse) VisitAddOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddOrganizationMember422JSONResponse ErrorResponse
+
+func (response AddOrganizationMember422JSONResponse) VisitAddOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMemberRequestObject struct {
+	OrgId  openapi_types.UUID `json:"orgId"`
+	UserId string             `json:"userId"`
+}
+
+type RemoveOrganizationMemberResponseObject interface {
+	VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveOrganizationMember204Response struct {
+}
+
+func (response RemoveOrganizationMember204Response) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveOrganizationMember401JSONResponse ErrorResponse
+
+func (response RemoveOrganizationMember401JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember403JSONResponse ErrorResponse
+
+func (response RemoveOrganizationMember403JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember404JSONResponse ErrorResponse
+
+func (response RemoveOrganizationMember404JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember422JSONResponse ErrorResponse
+
+func (response RemoveOrganizationMember422JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganizationMemberRequestObject struct {
+	OrgId  openapi_types.UUID `json:"orgId"`
+	UserId string             `json:"userId"`
+	Body   *PatchOrganizationMemberJSONRequestBody
+}
+
+type PatchOrganizationMemberResponseObject interface {
+	VisitPatchOrganizationMemberResponse(w http.ResponseWriter) error
+}
+
+type PatchOrganizationMember200JSONResponse OrganizationMember
+
+func (response PatchOrganizationMember200JSONResponse) VisitPatchOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganizationMember401JSONResponse ErrorResponse
+
+func (response PatchOrganizationMember401JSONResponse) VisitPatchOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganizationMember403JSONResponse ErrorResponse
+
+func (response PatchOrganizationMember403JSONResponse) VisitPatchOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganizationMember404JSONResponse ErrorResponse
+
+func (response PatchOrganizationMember404JSONResponse) VisitPatchOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchOrganizationMember422JSONResponse ErrorResponse
+
+func (response PatchOrganizationMember422JSONResponse) VisitPatchOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackingListsRequestObject struct {
+	Params ListPackingListsParams
+}
+
+type ListPackingListsResponseObject interface {
+	VisitListPackingListsResponse(w http.ResponseWriter) error
+}
+
+type ListPackingLists200JSONResponse []PackingList
+
+func (response ListPackingLists200JSONResponse) VisitListPackingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackingLists422JSONResponse ErrorResponse
+
+func (response ListPackingLists422JSONResponse) VisitListPackingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingListRequestObject struct {
+	Body *CreatePackingListJSONRequestBody
+}
+
+type CreatePackingListResponseObject interface {
+	VisitCreatePackingListResponse(w http.ResponseWriter) error
+}
+
+type CreatePackingList201JSONResponse PackingList
+
+func (response CreatePackingList201JSONResponse) VisitCreatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingList403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePackingList403JSONResponse) VisitCreatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingList404JSONResponse ErrorResponse
+
+func (response CreatePackingList404JSONResponse) VisitCreatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingList422JSONResponse ErrorResponse
+
+func (response CreatePackingList422JSONResponse) VisitCreatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackingListRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePackingListResponseObject interface {
+	VisitDeletePackingListResponse(w http.ResponseWriter) error
+}
+
+type DeletePackingList204Response struct {
+}
+
+func (response DeletePackingList204Response) VisitDeletePackingListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePackingList403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePackingList403JSONResponse) VisitDeletePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackingList404JSONResponse ErrorResponse
+
+func (response DeletePackingList404JSONResponse) VisitDeletePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackingListRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPackingListResponseObject interface {
+	VisitGetPackingListResponse(w http.ResponseWriter) error
+}
+
+type GetPackingList200JSONResponse PackingList
+
+func (response GetPackingList200JSONResponse) VisitGetPackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackingList404JSONResponse ErrorResponse
+
+func (response GetPackingList404JSONResponse) VisitGetPackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingListRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdatePackingListJSONRequestBody
+}
+
+type UpdatePackingListResponseObject interface {
+	VisitUpdatePackingListResponse(w http.ResponseWriter) error
+}
+
+type UpdatePackingList200JSONResponse PackingList
+
+func (response UpdatePackingList200JSONResponse) VisitUpdatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingList403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdatePackingList403JSONResponse) VisitUpdatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingList404JSONResponse ErrorResponse
+
+func (response UpdatePackingList404JSONResponse) VisitUpdatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingList422JSONResponse ErrorResponse
+
+func (response UpdatePackingList422JSONResponse) VisitUpdatePackingListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingItemRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreatePackingItemJSONRequestBody
+}
+
+type CreatePackingItemResponseObject interface {
+	VisitCreatePackingItemResponse(w http.ResponseWriter) error
+}
+
+type CreatePackingItem201JSONResponse PackingItem
+
+func (response CreatePackingItem201JSONResponse) VisitCreatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePackingItem403JSONResponse) VisitCreatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingItem404JSONResponse ErrorResponse
+
+func (response CreatePackingItem404JSONResponse) VisitCreatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackingItem422JSONResponse ErrorResponse
+
+func (response CreatePackingItem422JSONResponse) VisitCreatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackingItemRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	ItemId openapi_types.UUID `json:"itemId"`
+}
+
+type DeletePackingItemResponseObject interface {
+	VisitDeletePackingItemResponse(w http.ResponseWriter) error
+}
+
+type DeletePackingItem204Response struct {
+}
+
+func (response DeletePackingItem204Response) VisitDeletePackingItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePackingItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePackingItem403JSONResponse) VisitDeletePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackingItem404JSONResponse ErrorResponse
+
+func (response DeletePackingItem404JSONResponse) VisitDeletePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingItemRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	ItemId openapi_types.UUID `json:"itemId"`
+	Body   *UpdatePackingItemJSONRequestBody
+}
+
+type UpdatePackingItemResponseObject interface {
+	VisitUpdatePackingItemResponse(w http.ResponseWriter) error
+}
+
+type UpdatePackingItem200JSONResponse PackingItem
+
+func (response UpdatePackingItem200JSONResponse) VisitUpdatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdatePackingItem403JSONResponse) VisitUpdatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingItem404JSONResponse ErrorResponse
+
+func (response UpdatePackingItem404JSONResponse) VisitUpdatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePackingItem422JSONResponse ErrorResponse
+
+func (response UpdatePackingItem422JSONResponse) VisitUpdatePackingItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhotoThumbnailRequestObject struct {
+	PhotoId openapi_types.UUID `json:"photoId"`
+	Params  GetPhotoThumbnailParams
+}
+
+type GetPhotoThumbnailResponseObject interface {
+	VisitGetPhotoThumbnailResponse(w http.ResponseWriter) error
+}
+
+type GetPhotoThumbnail200ImagejpegResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPhotoThumbnail200ImagejpegResponse) VisitGetPhotoThumbnailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/jpeg")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPhotoThumbnail404JSONResponse ErrorResponse
+
+func (response GetPhotoThumbnail404JSONResponse) VisitGetPhotoThumbnailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhotoThumbnail422JSONResponse ErrorResponse
+
+func (response GetPhotoThumbnail422JSONResponse) VisitGetPhotoThumbnailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPointsOfInterestRequestObject struct {
+	Params ListPointsOfInterestParams
+}
+
+type ListPointsOfInterestResponseObject interface {
+	VisitListPointsOfInterestResponse(w http.ResponseWriter) error
+}
+
+type ListPointsOfInterest200JSONResponse PointOfInterestList
+
+func (response ListPointsOfInterest200JSONResponse) VisitListPointsOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPointsOfInterest422JSONResponse ErrorResponse
+
+func (response ListPointsOfInterest422JSONResponse) VisitListPointsOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePointOfInterestRequestObject struct {
+	Body *CreatePointOfInterestJSONRequestBody
+}
+
+type CreatePointOfInterestResponseObject interface {
+	VisitCreatePointOfInterestResponse(w http.ResponseWriter) error
+}
+
+type CreatePointOfInterest201JSONResponse PointOfInterest
+
+func (response CreatePointOfInterest201JSONResponse) VisitCreatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePointOfInterest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePointOfInterest403JSONResponse) VisitCreatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePointOfInterest404JSONResponse ErrorResponse
+
+func (response CreatePointOfInterest404JSONResponse) VisitCreatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePointOfInterest422JSONResponse ErrorResponse
+
+func (response CreatePointOfInterest422JSONResponse) VisitCreatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePointOfInterestRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePointOfInterestResponseObject interface {
+	VisitDeletePointOfInterestResponse(w http.ResponseWriter) error
+}
+
+type DeletePointOfInterest204Response struct {
+}
+
+func (response DeletePointOfInterest204Response) VisitDeletePointOfInterestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePointOfInterest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePointOfInterest403JSONResponse) VisitDeletePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePointOfInterest404JSONResponse ErrorResponse
+
+func (response DeletePointOfInterest404JSONResponse) VisitDeletePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPointOfInterestRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPointOfInterestResponseObject interface {
+	VisitGetPointOfInterestResponse(w http.ResponseWriter) error
+}
+
+type GetPointOfInterest200JSONResponse PointOfInterest
+
+func (response GetPointOfInterest200JSONResponse) VisitGetPointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPointOfInterest404JSONResponse ErrorResponse
+
+func (response GetPointOfInterest404JSONResponse) VisitGetPointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePointOfInterestRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdatePointOfInterestJSONRequestBody
+}
+
+type UpdatePointOfInterestResponseObject interface {
+	VisitUpdatePointOfInterestResponse(w http.ResponseWriter) error
+}
+
+type UpdatePointOfInterest200JSONResponse PointOfInterest
+
+func (response UpdatePointOfInterest200JSONResponse) VisitUpdatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePointOfInterest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdatePointOfInterest403JSONResponse) VisitUpdatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePointOfInterest404JSONResponse ErrorResponse
+
+func (response UpdatePointOfInterest404JSONResponse) VisitUpdatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePointOfInterest422JSONResponse ErrorResponse
+
+func (response UpdatePointOfInterest422JSONResponse) VisitUpdatePointOfInterestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPowerReadingsRequestObject struct {
+	Params ListPowerReadingsParams
+}
+
+type ListPowerReadingsResponseObject interface {
+	VisitListPowerReadingsResponse(w http.ResponseWriter) error
+}
+
+type ListPowerReadings200JSONResponse PowerReadingList
+
+func (response ListPowerReadings200JSONResponse) VisitListPowerReadingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePowerReadingRequestObject struct {
+	Body *CreatePowerReadingJSONRequestBody
+}
+
+type CreatePowerReadingResponseObject interface {
+	VisitCreatePowerReadingResponse(w http.ResponseWriter) error
+}
+
+type CreatePowerReading201JSONResponse PowerReading
+
+func (response CreatePowerReading201JSONResponse) VisitCreatePowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePowerReading403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePowerReading403JSONResponse) VisitCreatePowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePowerReading404JSONResponse ErrorResponse
+
+func (response CreatePowerReading404JSONResponse) VisitCreatePowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePowerReading422JSONResponse ErrorResponse
+
+func (response CreatePowerReading422JSONResponse) VisitCreatePowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePowerReadingRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePowerReadingResponseObject interface {
+	VisitDeletePowerReadingResponse(w http.ResponseWriter) error
+}
+
+type DeletePowerReading204Response struct {
+}
+
+func (response DeletePowerReading204Response) VisitDeletePowerReadingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePowerReading403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePowerReading403JSONResponse) VisitDeletePowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePowerReading404JSONResponse ErrorResponse
+
+func (response DeletePowerReading404JSONResponse) VisitDeletePowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPowerReadingRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPowerReadingResponseObject interface {
+	VisitGetPowerReadingResponse(w http.ResponseWriter) error
+}
+
+type GetPowerReading200JSONResponse PowerReading
+
+func (response GetPowerReading200JSONResponse) VisitGetPowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPowerReading404JSONResponse ErrorResponse
+
+func (response GetPowerReading404JSONResponse) VisitGetPowerReadingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPropaneFillsRequestObject struct {
+	Params ListPropaneFillsParams
+}
+
+type ListPropaneFillsResponseObject interface {
+	VisitListPropaneFillsResponse(w http.ResponseWriter) error
+}
+
+type ListPropaneFills200JSONResponse PropaneFillList
+
+func (response ListPropaneFills200JSONResponse) VisitListPropaneFillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePropaneFillRequestObject struct {
+	Params CreatePropaneFillParams
+	Body   *CreatePropaneFillJSONRequestBody
+}
+
+type CreatePropaneFillResponseObject interface {
+	VisitCreatePropaneFillResponse(w http.ResponseWriter) error
+}
+
+type CreatePropaneFill201JSONResponse PropaneFill
+
+func (response CreatePropaneFill201JSONResponse) VisitCreatePropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePropaneFill403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePropaneFill403JSONResponse) VisitCreatePropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePropaneFill404JSONResponse ErrorResponse
+
+func (response CreatePropaneFill404JSONResponse) VisitCreatePropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePropaneFill422JSONResponse ErrorResponse
+
+func (response CreatePropaneFill422JSONResponse) VisitCreatePropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePropaneFillRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePropaneFillResponseObject interface {
+	VisitDeletePropaneFillResponse(w http.ResponseWriter) error
+}
+
+type DeletePropaneFill204Response struct {
+}
+
+func (response DeletePropaneFill204Response) VisitDeletePropaneFillResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePropaneFill403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePropaneFill403JSONResponse) VisitDeletePropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePropaneFill404JSONResponse ErrorResponse
+
+func (response DeletePropaneFill404JSONResponse) VisitDeletePropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPropaneFillRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetPropaneFillParams
+}
+
+type GetPropaneFillResponseObject interface {
+	VisitGetPropaneFillResponse(w http.ResponseWriter) error
+}
+
+type GetPropaneFill200JSONResponse PropaneFill
+
+func (response GetPropaneFill200JSONResponse) VisitGetPropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPropaneFill404JSONResponse ErrorResponse
+
+func (response GetPropaneFill404JSONResponse) VisitGetPropaneFillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadinessRequestObject struct {
+}
+
+type GetReadinessResponseObject interface {
+	VisitGetReadinessResponse(w http.ResponseWriter) error
+}
+
+type GetReadiness200JSONResponse HealthResponse
+
+func (response GetReadiness200JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadiness503JSONResponse HealthResponse
+
+func (response GetReadiness503JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUpcomingReservationsRequestObject struct {
+	Params ListUpcomingReservationsParams
+}
+
+type ListUpcomingReservationsResponseObject interface {
+	VisitListUpcomingReservationsResponse(w http.ResponseWriter) error
+}
+
+type ListUpcomingReservations200JSONResponse []UpcomingReservation
+
+func (response ListUpcomingReservations200JSONResponse) VisitListUpcomingReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUpcomingReservations422JSONResponse ErrorResponse
+
+func (response ListUpcomingReservations422JSONResponse) VisitListUpcomingReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRigsRequestObject struct {
+	Params ListRigsParams
+}
+
+type ListRigsResponseObject interface {
+	VisitListRigsResponse(w http.ResponseWriter) error
+}
+
+type ListRigs200JSONResponse []Rig
+
+func (response ListRigs200JSONResponse) VisitListRigsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRigRequestObject struct {
+	Params CreateRigParams
+	Body   *CreateRigJSONRequestBody
+}
+
+type CreateRigResponseObject interface {
+	VisitCreateRigResponse(w http.ResponseWriter) error
+}
+
+type CreateRig201JSONResponse Rig
+
+func (response CreateRig201JSONResponse) VisitCreateRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRig422JSONResponse ErrorResponse
+
+func (response CreateRig422JSONResponse) VisitCreateRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRigRequestObject struct {
+	RigId openapi_types.UUID `json:"rigId"`
+}
+
+type DeleteRigResponseObject interface {
+	VisitDeleteRigResponse(w http.ResponseWriter) error
+}
+
+type DeleteRig204Response struct {
+}
+
+func (response DeleteRig204Response) VisitDeleteRigResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRig404JSONResponse ErrorResponse
+
+func (response DeleteRig404JSONResponse) VisitDeleteRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRigRequestObject struct {
+	RigId  openapi_types.UUID `json:"rigId"`
+	Params GetRigParams
+}
+
+type GetRigResponseObject interface {
+	VisitGetRigResponse(w http.ResponseWriter) error
+}
+
+type GetRig200JSONResponse Rig
+
+func (response GetRig200JSONResponse) VisitGetRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRig404JSONResponse ErrorResponse
+
+func (response GetRig404JSONResponse) VisitGetRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRigRequestObject struct {
+	RigId  openapi_types.UUID `json:"rigId"`
+	Params UpdateRigParams
+	Body   *UpdateRigJSONRequestBody
+}
+
+type UpdateRigResponseObject interface {
+	VisitUpdateRigResponse(w http.ResponseWriter) error
+}
+
+type UpdateRig200JSONResponse Rig
+
+func (response UpdateRig200JSONResponse) VisitUpdateRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRig404JSONResponse ErrorResponse
+
+func (response UpdateRig404JSONResponse) VisitUpdateRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRig422JSONResponse ErrorResponse
+
+func (response UpdateRig422JSONResponse) VisitUpdateRigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRigMaintenanceRequestObject struct {
+	RigId  openapi_types.UUID `json:"rigId"`
+	Params ListRigMaintenanceParams
+}
+
+type ListRigMaintenanceResponseObject interface {
+	VisitListRigMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type ListRigMaintenance200JSONResponse []RigMaintenance
+
+func (response ListRigMaintenance200JSONResponse) VisitListRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRigMaintenance404JSONResponse ErrorResponse
+
+func (response ListRigMaintenance404JSONResponse) VisitListRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRigMaintenanceRequestObject struct {
+	RigId  openapi_types.UUID `json:"rigId"`
+	Params CreateRigMaintenanceParams
+	Body   *CreateRigMaintenanceJSONRequestBody
+}
+
+type CreateRigMaintenanceResponseObject interface {
+	VisitCreateRigMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type CreateRigMaintenance201JSONResponse RigMaintenance
+
+func (response CreateRigMaintenance201JSONResponse) VisitCreateRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRigMaintenance404JSONResponse ErrorResponse
+
+func (response CreateRigMaintenance404JSONResponse) VisitCreateRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRigMaintenance422JSONResponse ErrorResponse
+
+func (response CreateRigMaintenance422JSONResponse) VisitCreateRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRigMaintenanceRequestObject struct {
+	RigId   openapi_types.UUID `json:"rigId"`
+	EntryId openapi_types.UUID `json:"entryId"`
+}
+
+type DeleteRigMaintenanceResponseObject interface {
+	VisitDeleteRigMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type DeleteRigMaintenance204Response struct {
+}
+
+func (response DeleteRigMaintenance204Response) VisitDeleteRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRigMaintenance404JSONResponse ErrorResponse
+
+func (response DeleteRigMaintenance404JSONResponse) VisitDeleteRigMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTripRequestObject struct {
+	Token  string `json:"token"`
+	Params GetSharedTripParams
+}
+
+type GetSharedTripResponseObject interface {
+	VisitGetSharedTripResponse(w http.ResponseWriter) error
+}
+
+type GetSharedTrip200ResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+
+type GetSharedTrip200JSONResponse struct {
+	Body    SharedTrip
+	Headers GetSharedTrip200ResponseHeaders
+}
+
+func (response GetSharedTrip200JSONResponse) VisitGetSharedTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSharedTrip304Response = NotModifiedResponse
+
+func (response GetSharedTrip304Response) VisitGetSharedTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetSharedTrip404JSONResponse ErrorResponse
+
+func (response GetSharedTrip404JSONResponse) VisitGetSharedTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTripEmbedRequestObject struct {
+	Token  string `json:"token"`
+	Params GetSharedTripEmbedParams
+}
+
+type GetSharedTripEmbedResponseObject interface {
+	VisitGetSharedTripEmbedResponse(w http.ResponseWriter) error
+}
+
+type GetSharedTripEmbed200JSONResponse SharedTripEmbed
+
+func (response GetSharedTripEmbed200JSONResponse) VisitGetSharedTripEmbedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTripEmbed200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetSharedTripEmbed200TexthtmlResponse) VisitGetSharedTripEmbedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSharedTripEmbed404JSONResponse ErrorResponse
+
+func (response GetSharedTripEmbed404JSONResponse) VisitGetSharedTripEmbedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDashboardRequestObject struct {
+	Params GetDashboardParams
+}
+
+type GetDashboardResponseObject interface {
+	VisitGetDashboardResponse(w http.ResponseWriter) error
+}
+
+type GetDashboard200ResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+
+type GetDashboard200JSONResponse struct {
+	Body    Dashboard
+	Headers GetDashboard200ResponseHeaders
+}
+
+func (response GetDashboard200JSONResponse) VisitGetDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDashboard304Response = NotModifiedResponse
+
+func (response GetDashboard304Response) VisitGetDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetDashboard422JSONResponse ErrorResponse
+
+func (response GetDashboard422JSONResponse) VisitGetDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopHeatmapRequestObject struct {
+	Params GetStopHeatmapParams
+}
+
+type GetStopHeatmapResponseObject interface {
+	VisitGetStopHeatmapResponse(w http.ResponseWriter) error
+}
+
+type GetStopHeatmap200ResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+
+type GetStopHeatmap200JSONResponse struct {
+	Body    StopHeatmap
+	Headers GetStopHeatmap200ResponseHeaders
+}
+
+func (response GetStopHeatmap200JSONResponse) VisitGetStopHeatmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetStopHeatmap304Response = NotModifiedResponse
+
+func (response GetStopHeatmap304Response) VisitGetStopHeatmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetStopHeatmap422JSONResponse ErrorResponse
+
+func (response GetStopHeatmap422JSONResponse) VisitGetStopHeatmapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPropaneStatsRequestObject struct {
+	Params GetPropaneStatsParams
+}
+
+type GetPropaneStatsResponseObject interface {
+	VisitGetPropaneStatsResponse(w http.ResponseWriter) error
+}
+
+type GetPropaneStats200ResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+
+type GetPropaneStats200JSONResponse struct {
+	Body    PropaneStats
+	Headers GetPropaneStats200ResponseHeaders
+}
+
+func (response GetPropaneStats200JSONResponse) VisitGetPropaneStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPropaneStats304Response = NotModifiedResponse
+
+func (response GetPropaneStats304Response) VisitGetPropaneStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetPropaneStats404JSONResponse ErrorResponse
+
+func (response GetPropaneStats404JSONResponse) VisitGetPropaneStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatesVisitedRequestObject struct {
+	Params GetStatesVisitedParams
+}
+
+type GetStatesVisitedResponseObject interface {
+	VisitGetStatesVisitedResponse(w http.ResponseWriter) error
+}
+
+type GetStatesVisited200JSONResponse StatesVisitedReport
+
+func (response GetStatesVisited200JSONResponse) VisitGetStatesVisitedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatesVisited422JSONResponse ErrorResponse
+
+func (response GetStatesVisited422JSONResponse) VisitGetStatesVisitedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagStatsRequestObject struct {
+}
+
+type GetTagStatsResponseObject interface {
+	VisitGetTagStatsResponse(w http.ResponseWriter) error
+}
+
+type GetTagStats200JSONResponse []TagStats
+
+func (response GetTagStats200JSONResponse) VisitGetTagStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopClustersRequestObject struct {
+	Params ListStopClustersParams
+}
+
+type ListStopClustersResponseObject interface {
+	VisitListStopClustersResponse(w http.ResponseWriter) error
+}
+
+type ListStopClusters200JSONResponse []StopCluster
+
+func (response ListStopClusters200JSONResponse) VisitListStopClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopClusters422JSONResponse ErrorResponse
+
+func (response ListStopClusters422JSONResponse) VisitListStopClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNearbyStopsRequestObject struct {
+	Params ListNearbyStopsParams
+}
+
+type ListNearbyStopsResponseObject interface {
+	VisitListNearbyStopsResponse(w http.ResponseWriter) error
+}
+
+type ListNearbyStops200JSONResponse []NearbyStop
+
+func (response ListNearbyStops200JSONResponse) VisitListNearbyStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNearbyStops422JSONResponse ErrorResponse
+
+func (response ListNearbyStops422JSONResponse) VisitListNearbyStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopOccupancyRequestObject struct {
+	Params GetStopOccupancyParams
+}
+
+type GetStopOccupancyResponseObject interface {
+	VisitGetStopOccupancyResponse(w http.ResponseWriter) error
+}
+
+type GetStopOccupancy200JSONResponse StopOccupancy
+
+func (response GetStopOccupancy200JSONResponse) VisitGetStopOccupancyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopOccupancy422JSONResponse ErrorResponse
+
+func (response GetStopOccupancy422JSONResponse) VisitGetStopOccupancyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsRequestObject struct {
+	Params ListTagsParams
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200ResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+
+type ListTags200JSONResponse struct {
+	Body    TagList
+	Headers ListTags200ResponseHeaders
+}
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTags304Response = NotModifiedResponse
+
+func (response ListTags304Response) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(w http.ResponseWriter) error
+}
+
+type CreateTag201JSONResponse Tag
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag422JSONResponse ErrorResponse
+
+func (response CreateTag422JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTag204Response struct {
+}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTag404JSONResponse ErrorResponse
+
+func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTagRequestObject struct {
+	Slug string `json:"slug"`
+	Body *PatchTagJSONRequestBody
+}
+
+type PatchTagResponseObject interface {
+	VisitPatchTagResponse(w http.ResponseWriter) error
+}
+
+type PatchTag200JSONResponse Tag
+
+func (response PatchTag200JSONResponse) VisitPatchTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTag404JSONResponse ErrorResponse
+
+func (response PatchTag404JSONResponse) VisitPatchTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTag422JSONResponse ErrorResponse
+
+func (response PatchTag422JSONResponse) VisitPatchTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTagRequestObject struct {
+	Slug string `json:"slug"`
+	Body *MergeTagJSONRequestBody
+}
+
+type MergeTagResponseObject interface {
+	VisitMergeTagResponse(w http.ResponseWriter) error
+}
+
+type MergeTag200JSONResponse Tag
+
+func (response MergeTag200JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTag404JSONResponse ErrorResponse
+
+func (response MergeTag404JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTag422JSONResponse ErrorResponse
+
+func (response MergeTag422JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearTagParentRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type ClearTagParentResponseObject interface {
+	VisitClearTagParentResponse(w http.ResponseWriter) error
+}
+
+type ClearTagParent200JSONResponse Tag
+
+func (response ClearTagParent200JSONResponse) VisitClearTagParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearTagParent404JSONResponse ErrorResponse
+
+func (response ClearTagParent404JSONResponse) VisitClearTagParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTagParentRequestObject struct {
+	Slug string `json:"slug"`
+	Body *SetTagParentJSONRequestBody
+}
+
+type SetTagParentResponseObject interface {
+	VisitSetTagParentResponse(w http.ResponseWriter) error
+}
+
+type SetTagParent200JSONResponse Tag
+
+func (response SetTagParent200JSONResponse) VisitSetTagParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTagParent404JSONResponse ErrorResponse
+
+func (response SetTagParent404JSONResponse) VisitSetTagParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTagParent422JSONResponse ErrorResponse
+
+func (response SetTagParent422JSONResponse) VisitSetTagParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrashRequestObject struct {
+}
+
+type ListTrashResponseObject interface {
+	VisitListTrashResponse(w http.ResponseWriter) error
+}
+
+type ListTrash200JSONResponse []TrashItem
+
+func (response ListTrash200JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTrashItemRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RestoreTrashItemResponseObject interface {
+	VisitRestoreTrashItemResponse(w http.ResponseWriter) error
+}
+
+type RestoreTrashItem200JSONResponse TrashItem
+
+func (response RestoreTrashItem200JSONResponse) VisitRestoreTrashItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTrashItem404JSONResponse ErrorResponse
+
+func (response RestoreTrashItem404JSONResponse) VisitRestoreTrashItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTrashItem422JSONResponse ErrorResponse
+
+func (response RestoreTrashItem422JSONResponse) VisitRestoreTrashItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripsRequestObject struct {
+	Params ListTripsParams
+}
+
+type ListTripsResponseObject interface {
+	VisitListTripsResponse(w http.ResponseWriter) error
+}
+
+type ListTrips200JSONResponse TripList
+
+func (response ListTrips200JSONResponse) VisitListTripsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrips422JSONResponse ErrorResponse
+
+func (response ListTrips422JSONResponse) VisitListTripsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripRequestObject struct {
+	Params CreateTripParams
+	Body   *CreateTripJSONRequestBody
+}
+
+type CreateTripResponseObject interface {
+	VisitCreateTripResponse(w http.ResponseWriter) error
+}
+
+type CreateTrip201JSONResponse Trip
+
+func (response CreateTrip201JSONResponse) VisitCreateTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTrip422JSONResponse ErrorResponse
+
+func (response CreateTrip422JSONResponse) VisitCreateTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentTripRequestObject struct {
+}
+
+type GetCurrentTripResponseObject interface {
+	VisitGetCurrentTripResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentTrip200JSONResponse CurrentTrip
+
+func (response GetCurrentTrip200JSONResponse) VisitGetCurrentTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentTrip404JSONResponse ErrorResponse
+
+func (response GetCurrentTrip404JSONResponse) VisitGetCurrentTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteTripResponseObject interface {
+	VisitDeleteTripResponse(w http.ResponseWriter) error
+}
+
+type DeleteTrip204Response struct {
+}
+
+func (response DeleteTrip204Response) VisitDeleteTripResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTrip403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTrip403JSONResponse) VisitDeleteTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrip404JSONResponse ErrorResponse
+
+func (response DeleteTrip404JSONResponse) VisitDeleteTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetTripParams
+}
+
+type GetTripResponseObject interface {
+	VisitGetTripResponse(w http.ResponseWriter) error
+}
+
+type GetTrip200JSONResponse Trip
+
+func (response GetTrip200JSONResponse) VisitGetTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrip404JSONResponse ErrorResponse
+
+func (response GetTrip404JSONResponse) VisitGetTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params UpdateTripParams
+	Body   *UpdateTripJSONRequestBody
+}
+
+type UpdateTripResponseObject interface {
+	VisitUpdateTripResponse(w http.ResponseWriter) error
+}
+
+type UpdateTrip200JSONResponse Trip
+
+func (response UpdateTrip200JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrip403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateTrip403JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrip404JSONResponse ErrorResponse
+
+func (response UpdateTrip404JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrip422JSONResponse ErrorResponse
+
+func (response UpdateTrip422JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTripRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params CloneTripParams
+	Body   *CloneTripJSONRequestBody
+}
+
+type CloneTripResponseObject interface {
+	VisitCloneTripResponse(w http.ResponseWriter) error
+}
+
+type CloneTrip201JSONResponse Trip
+
+func (response CloneTrip201JSONResponse) VisitCloneTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTrip404JSONResponse ErrorResponse
+
+func (response CloneTrip404JSONResponse) VisitCloneTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneTrip422JSONResponse ErrorResponse
+
+func (response CloneTrip422JSONResponse) VisitCloneTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripGapsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListTripGapsResponseObject interface {
+	VisitListTripGapsResponse(w http.ResponseWriter) error
+}
+
+type ListTripGaps200JSONResponse TripGaps
+
+func (response ListTripGaps200JSONResponse) VisitListTripGapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripGaps404JSONResponse ErrorResponse
+
+func (response ListTripGaps404JSONResponse) VisitListTripGapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripGeoJSONRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTripGeoJSONResponseObject interface {
+	VisitGetTripGeoJSONResponse(w http.ResponseWriter) error
+}
+
+type GetTripGeoJSON200ApplicationGeoPlusJSONResponse GeoJSONFeatureCollection
+
+func (response GetTripGeoJSON200ApplicationGeoPlusJSONResponse) VisitGetTripGeoJSONResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripGeoJSON404JSONResponse ErrorResponse
+
+func (response GetTripGeoJSON404JSONResponse) VisitGetTripGeoJSONResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripHistoryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListTripHistoryResponseObject interface {
+	VisitListTripHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListTripHistory200JSONResponse []TripRevision
+
+func (response ListTripHistory200JSONResponse) VisitListTripHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripHistory404JSONResponse ErrorResponse
+
+func (response ListTripHistory404JSONResponse) VisitListTripHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertTripRequestObject struct {
+	Id       openapi_types.UUID `json:"id"`
+	Revision int                `json:"revision"`
+	Params   RevertTripParams
+}
+
+type RevertTripResponseObject interface {
+	VisitRevertTripResponse(w http.ResponseWriter) error
+}
+
+type RevertTrip200JSONResponse Trip
+
+func (response RevertTrip200JSONResponse) VisitRevertTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertTrip403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevertTrip403JSONResponse) VisitRevertTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertTrip404JSONResponse ErrorResponse
+
+func (response RevertTrip404JSONResponse) VisitRevertTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertTrip422JSONResponse ErrorResponse
+
+func (response RevertTrip422JSONResponse) VisitRevertTripResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTripGPXRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body io.Reader
+}
+
+type ImportTripGPXResponseObject interface {
+	VisitImportTripGPXResponse(w http.ResponseWriter) error
+}
+
+type ImportTripGPX200JSONResponse StopImportResult
+
+func (response ImportTripGPX200JSONResponse) VisitImportTripGPXResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTripGPX403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportTripGPX403JSONResponse) VisitImportTripGPXResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTripGPX404JSONResponse ErrorResponse
+
+func (response ImportTripGPX404JSONResponse) VisitImportTripGPXResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTripGPX413JSONResponse ErrorResponse
+
+func (response ImportTripGPX413JSONResponse) VisitImportTripGPXResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTripGPX422JSONResponse ErrorResponse
+
+func (response ImportTripGPX422JSONResponse) VisitImportTripGPXResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripMapRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetTripMapParams
+}
+
+type GetTripMapResponseObject interface {
+	VisitGetTripMapResponse(w http.ResponseWriter) error
+}
+
+type GetTripMap200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTripMap200ImagepngResponse) VisitGetTripMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTripMap404JSONResponse ErrorResponse
+
+func (response GetTripMap404JSONResponse) VisitGetTripMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripMap422JSONResponse ErrorResponse
+
+func (response GetTripMap422JSONResponse) VisitGetTripMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripMileageRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetTripMileageParams
+}
+
+type GetTripMileageResponseObject interface {
+	VisitGetTripMileageResponse(w http.ResponseWriter) error
+}
+
+type GetTripMileage200JSONResponse TripMileage
+
+func (response GetTripMileage200JSONResponse) VisitGetTripMileageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripMileage404JSONResponse ErrorResponse
+
+func (response GetTripMileage404JSONResponse) VisitGetTripMileageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripPowerRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTripPowerResponseObject interface {
+	VisitGetTripPowerResponse(w http.ResponseWriter) error
+}
+
+type GetTripPower200JSONResponse TripPower
+
+func (response GetTripPower200JSONResponse) VisitGetTripPowerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripPower404JSONResponse ErrorResponse
+
+func (response GetTripPower404JSONResponse) VisitGetTripPowerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripSharesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListTripSharesResponseObject interface {
+	VisitListTripSharesResponse(w http.ResponseWriter) error
+}
+
+type ListTripShares200JSONResponse ShareList
+
+func (response ListTripShares200JSONResponse) VisitListTripSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripShares404JSONResponse ErrorResponse
+
+func (response ListTripShares404JSONResponse) VisitListTripSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripShareRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreateTripShareJSONRequestBody
+}
+
+type CreateTripShareResponseObject interface {
+	VisitCreateTripShareResponse(w http.ResponseWriter) error
+}
+
+type CreateTripShare201JSONResponse ShareLink
+
+func (response CreateTripShare201JSONResponse) VisitCreateTripShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripShare403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTripShare403JSONResponse) VisitCreateTripShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripShare404JSONResponse ErrorResponse
+
+func (response CreateTripShare404JSONResponse) VisitCreateTripShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripShare422JSONResponse ErrorResponse
+
+func (response CreateTripShare422JSONResponse) VisitCreateTripShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeTripShareRequestObject struct {
+	Id      openapi_types.UUID `json:"id"`
+	ShareId openapi_types.UUID `json:"shareId"`
+}
+
+type RevokeTripShareResponseObject interface {
+	VisitRevokeTripShareResponse(w http.ResponseWriter) error
+}
+
+type RevokeTripShare204Response struct {
+}
+
+func (response RevokeTripShare204Response) VisitRevokeTripShareResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeTripShare403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeTripShare403JSONResponse) VisitRevokeTripShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeTripShare404JSONResponse ErrorResponse
+
+func (response RevokeTripShare404JSONResponse) VisitRevokeTripShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripStatsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetTripStatsParams
+}
+
+type GetTripStatsResponseObject interface {
+	VisitGetTripStatsResponse(w http.ResponseWriter) error
+}
+
+type GetTripStats200ResponseHeaders struct {
+	CacheControl string
+	LastModified string
+}
+
+type GetTripStats200JSONResponse struct {
+	Body    TripStats
+	Headers GetTripStats200ResponseHeaders
+}
+
+func (response GetTripStats200JSONResponse) VisitGetTripStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTripStats304Response = NotModifiedResponse
+
+func (response GetTripStats304Response) VisitGetTripStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetTripStats404JSONResponse ErrorResponse
+
+func (response GetTripStats404JSONResponse) VisitGetTripStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripBorderCrossingsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+}
+
+type ListTripBorderCrossingsResponseObject interface {
+	VisitListTripBorderCrossingsResponse(w http.ResponseWriter) error
+}
+
+type ListTripBorderCrossings200JSONResponse []BorderCrossing
+
+func (response ListTripBorderCrossings200JSONResponse) VisitListTripBorderCrossingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripBorderCrossings404JSONResponse ErrorResponse
+
+func (response ListTripBorderCrossings404JSONResponse) VisitListTripBorderCrossingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripBorderCrossingRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Body   *CreateTripBorderCrossingJSONRequestBody
+}
+
+type CreateTripBorderCrossingResponseObject interface {
+	VisitCreateTripBorderCrossingResponse(w http.ResponseWriter) error
+}
+
+type CreateTripBorderCrossing201JSONResponse BorderCrossing
+
+func (response CreateTripBorderCrossing201JSONResponse) VisitCreateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripBorderCrossing403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTripBorderCrossing403JSONResponse) VisitCreateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripBorderCrossing404JSONResponse ErrorResponse
+
+func (response CreateTripBorderCrossing404JSONResponse) VisitCreateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripBorderCrossing422JSONResponse ErrorResponse
+
+func (response CreateTripBorderCrossing422JSONResponse) VisitCreateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripBorderCrossingRequestObject struct {
+	TripId     openapi_types.UUID `json:"tripId"`
+	CrossingId openapi_types.UUID `json:"crossingId"`
+}
+
+type DeleteTripBorderCrossingResponseObject interface {
+	VisitDeleteTripBorderCrossingResponse(w http.ResponseWriter) error
+}
+
+type DeleteTripBorderCrossing204Response struct {
+}
+
+func (response DeleteTripBorderCrossing204Response) VisitDeleteTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTripBorderCrossing403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTripBorderCrossing403JSONResponse) VisitDeleteTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripBorderCrossing404JSONResponse ErrorResponse
+
+func (response DeleteTripBorderCrossing404JSONResponse) VisitDeleteTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripBorderCrossingRequestObject struct {
+	TripId     openapi_types.UUID `json:"tripId"`
+	CrossingId openapi_types.UUID `json:"crossingId"`
+}
+
+type GetTripBorderCrossingResponseObject interface {
+	VisitGetTripBorderCrossingResponse(w http.ResponseWriter) error
+}
+
+type GetTripBorderCrossing200JSONResponse BorderCrossing
+
+func (response GetTripBorderCrossing200JSONResponse) VisitGetTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripBorderCrossing404JSONResponse ErrorResponse
+
+func (response GetTripBorderCrossing404JSONResponse) VisitGetTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripBorderCrossingRequestObject struct {
+	TripId     openapi_types.UUID `json:"tripId"`
+	CrossingId openapi_types.UUID `json:"crossingId"`
+	Body       *UpdateTripBorderCrossingJSONRequestBody
+}
+
+type UpdateTripBorderCrossingResponseObject interface {
+	VisitUpdateTripBorderCrossingResponse(w http.ResponseWriter) error
+}
+
+type UpdateTripBorderCrossing200JSONResponse BorderCrossing
+
+func (response UpdateTripBorderCrossing200JSONResponse) VisitUpdateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripBorderCrossing403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateTripBorderCrossing403JSONResponse) VisitUpdateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripBorderCrossing404JSONResponse ErrorResponse
+
+func (response UpdateTripBorderCrossing404JSONResponse) VisitUpdateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripBorderCrossing422JSONResponse ErrorResponse
+
+func (response UpdateTripBorderCrossing422JSONResponse) VisitUpdateTripBorderCrossingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripBudgetReportRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+}
+
+type GetTripBudgetReportResponseObject interface {
+	VisitGetTripBudgetReportResponse(w http.ResponseWriter) error
+}
+
+type GetTripBudgetReport200JSONResponse BudgetReport
+
+func (response GetTripBudgetReport200JSONResponse) VisitGetTripBudgetReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripBudgetReport404JSONResponse ErrorResponse
+
+func (response GetTripBudgetReport404JSONResponse) VisitGetTripBudgetReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckTripDrivesRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Params CheckTripDrivesParams
+	Body   *CheckTripDrivesJSONRequestBody
+}
+
+type CheckTripDrivesResponseObject interface {
+	VisitCheckTripDrivesResponse(w http.ResponseWriter) error
+}
+
+type CheckTripDrives200JSONResponse DriveCheck
+
+func (response CheckTripDrives200JSONResponse) VisitCheckTripDrivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckTripDrives404JSONResponse ErrorResponse
+
+func (response CheckTripDrives404JSONResponse) VisitCheckTripDrivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckTripDrives422JSONResponse ErrorResponse
+
+func (response CheckTripDrives422JSONResponse) VisitCheckTripDrivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripExpensesRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+}
+
+type ListTripExpensesResponseObject interface {
+	VisitListTripExpensesResponse(w http.ResponseWriter) error
+}
+
+type ListTripExpenses200JSONResponse []Expense
+
+func (response ListTripExpenses200JSONResponse) VisitListTripExpensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripExpenses404JSONResponse ErrorResponse
+
+func (response ListTripExpenses404JSONResponse) VisitListTripExpensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripExpenseRequestObject struct {
+	TripId    openapi_types.UUID `json:"tripId"`
+	ExpenseId openapi_types.UUID `json:"expenseId"`
+}
+
+type DeleteTripExpenseResponseObject interface {
+	VisitDeleteTripExpenseResponse(w http.ResponseWriter) error
+}
+
+type DeleteTripExpense204Response struct {
+}
+
+func (response DeleteTripExpense204Response) VisitDeleteTripExpenseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTripExpense403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTripExpense403JSONResponse) VisitDeleteTripExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripExpense404JSONResponse ErrorResponse
+
+func (response DeleteTripExpense404JSONResponse) VisitDeleteTripExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTripExpenseRequestObject struct {
+	TripId    openapi_types.UUID `json:"tripId"`
+	ExpenseId openapi_types.UUID `json:"expenseId"`
+	Body      *SplitTripExpenseJSONRequestBody
+}
+
+type SplitTripExpenseResponseObject interface {
+	VisitSplitTripExpenseResponse(w http.ResponseWriter) error
+}
+
+type SplitTripExpense200JSONResponse Expense
+
+func (response SplitTripExpense200JSONResponse) VisitSplitTripExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTripExpense403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SplitTripExpense403JSONResponse) VisitSplitTripExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTripExpense404JSONResponse ErrorResponse
+
+func (response SplitTripExpense404JSONResponse) VisitSplitTripExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTripExpense422JSONResponse ErrorResponse
+
+func (response SplitTripExpense422JSONResponse) VisitSplitTripExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripJournalRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Params ListTripJournalParams
+}
+
+type ListTripJournalResponseObject interface {
+	VisitListTripJournalResponse(w http.ResponseWriter) error
+}
+
+type ListTripJournal200JSONResponse []JournalDay
+
+func (response ListTripJournal200JSONResponse) VisitListTripJournalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripJournal404JSONResponse ErrorResponse
+
+func (response ListTripJournal404JSONResponse) VisitListTripJournalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripJournalEntryRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Body   *CreateTripJournalEntryJSONRequestBody
+}
+
+type CreateTripJournalEntryResponseObject interface {
+	VisitCreateTripJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type CreateTripJournalEntry201JSONResponse JournalEntry
+
+func (response CreateTripJournalEntry201JSONResponse) VisitCreateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripJournalEntry403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTripJournalEntry403JSONResponse) VisitCreateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripJournalEntry404JSONResponse ErrorResponse
+
+func (response CreateTripJournalEntry404JSONResponse) VisitCreateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTripJournalEntry422JSONResponse ErrorResponse
+
+func (response CreateTripJournalEntry422JSONResponse) VisitCreateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripJournalEntryRequestObject struct {
+	TripId  openapi_types.UUID `json:"tripId"`
+	EntryId openapi_types.UUID `json:"entryId"`
+}
+
+type DeleteTripJournalEntryResponseObject interface {
+	VisitDeleteTripJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type DeleteTripJournalEntry204Response struct {
+}
+
+func (response DeleteTripJournalEntry204Response) VisitDeleteTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTripJournalEntry403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTripJournalEntry403JSONResponse) VisitDeleteTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTripJournalEntry404JSONResponse ErrorResponse
+
+func (response DeleteTripJournalEntry404JSONResponse) VisitDeleteTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripJournalEntryRequestObject struct {
+	TripId  openapi_types.UUID `json:"tripId"`
+	EntryId openapi_types.UUID `json:"entryId"`
+}
+
+type GetTripJournalEntryResponseObject interface {
+	VisitGetTripJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type GetTripJournalEntry200JSONResponse JournalEntry
+
+func (response GetTripJournalEntry200JSONResponse) VisitGetTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripJournalEntry404JSONResponse ErrorResponse
+
+func (response GetTripJournalEntry404JSONResponse) VisitGetTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripJournalEntryRequestObject struct {
+	TripId  openapi_types.UUID `json:"tripId"`
+	EntryId openapi_types.UUID `json:"entryId"`
+	Body    *UpdateTripJournalEntryJSONRequestBody
+}
+
+type UpdateTripJournalEntryResponseObject interface {
+	VisitUpdateTripJournalEntryResponse(w http.ResponseWriter) error
+}
+
+type UpdateTripJournalEntry200JSONResponse JournalEntry
+
+func (response UpdateTripJournalEntry200JSONResponse) VisitUpdateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripJournalEntry403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateTripJournalEntry403JSONResponse) VisitUpdateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripJournalEntry404JSONResponse ErrorResponse
+
+func (response UpdateTripJournalEntry404JSONResponse) VisitUpdateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripJournalEntry422JSONResponse ErrorResponse
+
+func (response UpdateTripJournalEntry422JSONResponse) VisitUpdateTripJournalEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripRouteLegsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Params ListTripRouteLegsParams
+}
+
+type ListTripRouteLegsResponseObject interface {
+	VisitListTripRouteLegsResponse(w http.ResponseWriter) error
+}
+
+type ListTripRouteLegs200JSONResponse []RouteLeg
+
+func (response ListTripRouteLegs200JSONResponse) VisitListTripRouteLegsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripRouteLegs404JSONResponse ErrorResponse
+
+func (response ListTripRouteLegs404JSONResponse) VisitListTripRouteLegsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripRouteLegRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	LegId  openapi_types.UUID `json:"legId"`
+	Params UpdateTripRouteLegParams
+	Body   *UpdateTripRouteLegJSONRequestBody
+}
+
+type UpdateTripRouteLegResponseObject interface {
+	VisitUpdateTripRouteLegResponse(w http.ResponseWriter) error
+}
+
+type UpdateTripRouteLeg200JSONResponse RouteLeg
+
+func (response UpdateTripRouteLeg200JSONResponse) VisitUpdateTripRouteLegResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripRouteLeg403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateTripRouteLeg403JSONResponse) VisitUpdateTripRouteLegResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripRouteLeg404JSONResponse ErrorResponse
+
+func (response UpdateTripRouteLeg404JSONResponse) VisitUpdateTripRouteLegResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTripRouteLeg422JSONResponse ErrorResponse
+
+func (response UpdateTripRouteLeg422JSONResponse) VisitUpdateTripRouteLegResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripRouteLegElevationRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	LegId  openapi_types.UUID `json:"legId"`
+	Params GetTripRouteLegElevationParams
+}
+
+type GetTripRouteLegElevationResponseObject interface {
+	VisitGetTripRouteLegElevationResponse(w http.ResponseWriter) error
+}
+
+type GetTripRouteLegElevation200JSONResponse ElevationProfile
+
+func (response GetTripRouteLegElevation200JSONResponse) VisitGetTripRouteLegElevationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripRouteLegElevation404JSONResponse ErrorResponse
+
+func (response GetTripRouteLegElevation404JSONResponse) VisitGetTripRouteLegElevationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripRouteLegElevation422JSONResponse ErrorResponse
+
+func (response GetTripRouteLegElevation422JSONResponse) VisitGetTripRouteLegElevationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripRouteLegTrackRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	LegId  openapi_types.UUID `json:"legId"`
+}
+
+type GetTripRouteLegTrackResponseObject interface {
+	VisitGetTripRouteLegTrackResponse(w http.ResponseWriter) error
+}
+
+type GetTripRouteLegTrack200ApplicationgpxXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetTripRouteLegTrack200ApplicationgpxXmlResponse) VisitGetTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gpx+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTripRouteLegTrack404JSONResponse ErrorResponse
+
+func (response GetTripRouteLegTrack404JSONResponse) VisitGetTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadTripRouteLegTrackRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	LegId  openapi_types.UUID `json:"legId"`
+	Params UploadTripRouteLegTrackParams
+	Body   io.Reader
+}
+
+type UploadTripRouteLegTrackResponseObject interface {
+	VisitUploadTripRouteLegTrackResponse(w http.ResponseWriter) error
+}
+
+type UploadTripRouteLegTrack200JSONResponse RouteLeg
+
+func (response UploadTripRouteLegTrack200JSONResponse) VisitUploadTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadTripRouteLegTrack403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UploadTripRouteLegTrack403JSONResponse) VisitUploadTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadTripRouteLegTrack404JSONResponse ErrorResponse
+
+func (response UploadTripRouteLegTrack404JSONResponse) VisitUploadTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadTripRouteLegTrack413JSONResponse ErrorResponse
+
+func (response UploadTripRouteLegTrack413JSONResponse) VisitUploadTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadTripRouteLegTrack422JSONResponse ErrorResponse
+
+func (response UploadTripRouteLegTrack422JSONResponse) VisitUploadTripRouteLegTrackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripMembersRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+}
+
+type ListTripMembersResponseObject interface {
+	VisitListTripMembersResponse(w http.ResponseWriter) error
+}
+
+type ListTripMembers200JSONResponse []TripMember
+
+func (response ListTripMembers200JSONResponse) VisitListTripMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripMembers404JSONResponse ErrorResponse
+
+func (response ListTripMembers404JSONResponse) VisitListTripMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteTripMemberRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Body   *InviteTripMemberJSONRequestBody
+}
+
+type InviteTripMemberResponseObject interface {
+	VisitInviteTripMemberResponse(w http.ResponseWriter) error
+}
+
+type InviteTripMember201JSONResponse TripMember
+
+func (response InviteTripMember201JSONResponse) VisitInviteTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteTripMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response InviteTripMember403JSONResponse) VisitInviteTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteTripMember404JSONResponse ErrorResponse
+
+func (response InviteTripMember404JSONResponse) VisitInviteTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteTripMember422JSONResponse ErrorResponse
+
+func (response InviteTripMember422JSONResponse) VisitInviteTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTripMemberRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type RemoveTripMemberResponseObject interface {
+	VisitRemoveTripMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveTripMember204Response struct {
+}
+
+func (response RemoveTripMember204Response) VisitRemoveTripMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveTripMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveTripMember403JSONResponse) VisitRemoveTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTripMember404JSONResponse ErrorResponse
+
+func (response RemoveTripMember404JSONResponse) VisitRemoveTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTripMemberRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PatchTripMemberJSONRequestBody
+}
+
+type PatchTripMemberResponseObject interface {
+	VisitPatchTripMemberResponse(w http.ResponseWriter) error
+}
+
+type PatchTripMember200JSONResponse TripMember
+
+func (response PatchTripMember200JSONResponse) VisitPatchTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTripMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PatchTripMember403JSONResponse) VisitPatchTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTripMember404JSONResponse ErrorResponse
+
+func (response PatchTripMember404JSONResponse) VisitPatchTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTripMember422JSONResponse ErrorResponse
+
+func (response PatchTripMember422JSONResponse) VisitPatchTripMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickLogExpenseRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Body   *QuickLogExpenseJSONRequestBody
+}
+
+type QuickLogExpenseResponseObject interface {
+	VisitQuickLogExpenseResponse(w http.ResponseWriter) error
+}
+
+type QuickLogExpense201JSONResponse Expense
+
+func (response QuickLogExpense201JSONResponse) VisitQuickLogExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickLogExpense403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response QuickLogExpense403JSONResponse) VisitQuickLogExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickLogExpense404JSONResponse ErrorResponse
+
+func (response QuickLogExpense404JSONResponse) VisitQuickLogExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickLogExpense422JSONResponse ErrorResponse
+
+func (response QuickLogExpense422JSONResponse) VisitQuickLogExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripSettlementRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+}
+
+type GetTripSettlementResponseObject interface {
+	VisitGetTripSettlementResponse(w http.ResponseWriter) error
+}
+
+type GetTripSettlement200JSONResponse Settlement
+
+func (response GetTripSettlement200JSONResponse) VisitGetTripSettlementResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTripSettlement404JSONResponse ErrorResponse
+
+func (response GetTripSettlement404JSONResponse) VisitGetTripSettlementResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripStopSuggestionsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+}
+
+type ListTripStopSuggestionsResponseObject interface {
+	VisitListTripStopSuggestionsResponse(w http.ResponseWriter) error
+}
+
+type ListTripStopSuggestions200JSONResponse []StopSuggestion
+
+func (response ListTripStopSuggestions200JSONResponse) VisitListTripStopSuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTripStopSuggestions404JSONResponse ErrorResponse
+
+func (response ListTripStopSuggestions404JSONResponse) VisitListTripStopSuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissTripStopSuggestionRequestObject struct {
+	TripId       openapi_types.UUID `json:"tripId"`
+	SuggestionId openapi_types.UUID `json:"suggestionId"`
+}
+
+type DismissTripStopSuggestionResponseObject interface {
+	VisitDismissTripStopSuggestionResponse(w http.ResponseWriter) error
+}
+
+type DismissTripStopSuggestion204Response struct {
+}
+
+func (response DismissTripStopSuggestion204Response) VisitDismissTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DismissTripStopSuggestion403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DismissTripStopSuggestion403JSONResponse) VisitDismissTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissTripStopSuggestion404JSONResponse ErrorResponse
+
+func (response DismissTripStopSuggestion404JSONResponse) VisitDismissTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissTripStopSuggestion422JSONResponse ErrorResponse
+
+func (response DismissTripStopSuggestion422JSONResponse) VisitDismissTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptTripStopSuggestionRequestObject struct {
+	TripId       openapi_types.UUID `json:"tripId"`
+	SuggestionId openapi_types.UUID `json:"suggestionId"`
+}
+
+type AcceptTripStopSuggestionResponseObject interface {
+	VisitAcceptTripStopSuggestionResponse(w http.ResponseWriter) error
+}
+
+type AcceptTripStopSuggestion201JSONResponse Stop
+
+func (response AcceptTripStopSuggestion201JSONResponse) VisitAcceptTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptTripStopSuggestion403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AcceptTripStopSuggestion403JSONResponse) VisitAcceptTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptTripStopSuggestion404JSONResponse ErrorResponse
+
+func (response AcceptTripStopSuggestion404JSONResponse) VisitAcceptTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptTripStopSuggestion422JSONResponse ErrorResponse
+
+func (response AcceptTripStopSuggestion422JSONResponse) VisitAcceptTripStopSuggestionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Params ListStopsParams
+}
+
+type ListStopsResponseObject interface {
+	VisitListStopsResponse(w http.ResponseWriter) error
+}
+
+type ListStops200JSONResponse StopList
+
+func (response ListStops200JSONResponse) VisitListStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStops404JSONResponse ErrorResponse
+
+func (response ListStops404JSONResponse) VisitListStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStops422JSONResponse ErrorResponse
+
+func (response ListStops422JSONResponse) VisitListStopsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	Body   *CreateStopJSONRequestBody
+}
+
+type CreateStopResponseObject interface {
+	VisitCreateStopResponse(w http.ResponseWriter) error
+}
+
+type CreateStop201JSONResponse Stop
+
+func (response CreateStop201JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStop403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateStop403JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStop404JSONResponse ErrorResponse
+
+func (response CreateStop404JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStop422JSONResponse ErrorResponse
+
+func (response CreateStop422JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type DeleteStopResponseObject interface {
+	VisitDeleteStopResponse(w http.ResponseWriter) error
+}
+
+type DeleteStop204Response struct {
+}
+
+func (response DeleteStop204Response) VisitDeleteStopResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteStop403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteStop403JSONResponse) VisitDeleteStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStop404JSONResponse ErrorResponse
+
+func (response DeleteStop404JSONResponse) VisitDeleteStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type GetStopResponseObject interface {
+	VisitGetStopResponse(w http.ResponseWriter) error
+}
+
+type GetStop200JSONResponse Stop
+
+func (response GetStop200JSONResponse) VisitGetStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStop404JSONResponse ErrorResponse
+
+func (response GetStop404JSONResponse) VisitGetStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *UpdateStopJSONRequestBody
+}
+
+type UpdateStopResponseObject interface {
+	VisitUpdateStopResponse(w http.ResponseWriter) error
+}
+
+type UpdateStop200JSONResponse Stop
+
+func (response UpdateStop200JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStop403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateStop403JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStop404JSONResponse ErrorResponse
+
+func (response UpdateStop404JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStop422JSONResponse ErrorResponse
+
+func (response UpdateStop422JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArriveAtStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *ArriveAtStopJSONRequestBody
+}
+
+type ArriveAtStopResponseObject interface {
+	VisitArriveAtStopResponse(w http.ResponseWriter) error
+}
+
+type ArriveAtStop200JSONResponse Stop
+
+func (response ArriveAtStop200JSONResponse) VisitArriveAtStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArriveAtStop403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ArriveAtStop403JSONResponse) VisitArriveAtStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArriveAtStop404JSONResponse ErrorResponse
+
+func (response ArriveAtStop404JSONResponse) VisitArriveAtStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArriveAtStop422JSONResponse ErrorResponse
+
+func (response ArriveAtStop422JSONResponse) VisitArriveAtStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopChecklistsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListStopChecklistsResponseObject interface {
+	VisitListStopChecklistsResponse(w http.ResponseWriter) error
+}
+
+type ListStopChecklists200JSONResponse []Checklist
+
+func (response ListStopChecklists200JSONResponse) VisitListStopChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopChecklists404JSONResponse ErrorResponse
+
+func (response ListStopChecklists404JSONResponse) VisitListStopChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartStopChecklistRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *StartStopChecklistJSONRequestBody
+}
+
+type StartStopChecklistResponseObject interface {
+	VisitStartStopChecklistResponse(w http.ResponseWriter) error
+}
+
+type StartStopChecklist201JSONResponse Checklist
+
+func (response StartStopChecklist201JSONResponse) VisitStartStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartStopChecklist403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response StartStopChecklist403JSONResponse) VisitStartStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartStopChecklist404JSONResponse ErrorResponse
+
+func (response StartStopChecklist404JSONResponse) VisitStartStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartStopChecklist422JSONResponse ErrorResponse
+
+func (response StartStopChecklist422JSONResponse) VisitStartStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopChecklistRequestObject struct {
+	TripId      openapi_types.UUID `json:"tripId"`
+	StopId      openapi_types.UUID `json:"stopId"`
+	ChecklistId openapi_types.UUID `json:"checklistId"`
+}
+
+type DeleteStopChecklistResponseObject interface {
+	VisitDeleteStopChecklistResponse(w http.ResponseWriter) error
+}
+
+type DeleteStopChecklist204Response struct {
+}
+
+func (response DeleteStopChecklist204Response) VisitDeleteStopChecklistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteStopChecklist403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteStopChecklist403JSONResponse) VisitDeleteStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopChecklist404JSONResponse ErrorResponse
+
+func (response DeleteStopChecklist404JSONResponse) VisitDeleteStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopChecklistRequestObject struct {
+	TripId      openapi_types.UUID `json:"tripId"`
+	StopId      openapi_types.UUID `json:"stopId"`
+	ChecklistId openapi_types.UUID `json:"checklistId"`
+}
+
+type GetStopChecklistResponseObject interface {
+	VisitGetStopChecklistResponse(w http.ResponseWriter) error
+}
+
+type GetStopChecklist200JSONResponse Checklist
+
+func (response GetStopChecklist200JSONResponse) VisitGetStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopChecklist404JSONResponse ErrorResponse
+
+func (response GetStopChecklist404JSONResponse) VisitGetStopChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStopChecklistItemRequestObject struct {
+	TripId      openapi_types.UUID `json:"tripId"`
+	StopId      openapi_types.UUID `json:"stopId"`
+	ChecklistId openapi_types.UUID `json:"checklistId"`
+	ItemId      openapi_types.UUID `json:"itemId"`
+	Body        *CheckStopChecklistItemJSONRequestBody
+}
+
+type CheckStopChecklistItemResponseObject interface {
+	VisitCheckStopChecklistItemResponse(w http.ResponseWriter) error
+}
+
+type CheckStopChecklistItem200JSONResponse Checklist
+
+func (response CheckStopChecklistItem200JSONResponse) VisitCheckStopChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStopChecklistItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CheckStopChecklistItem403JSONResponse) VisitCheckStopChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStopChecklistItem404JSONResponse ErrorResponse
+
+func (response CheckStopChecklistItem404JSONResponse) VisitCheckStopChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStopChecklistItem422JSONResponse ErrorResponse
+
+func (response CheckStopChecklistItem422JSONResponse) VisitCheckStopChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDumpEventsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListDumpEventsResponseObject interface {
+	VisitListDumpEventsResponse(w http.ResponseWriter) error
+}
+
+type ListDumpEvents200JSONResponse []DumpEvent
+
+func (response ListDumpEvents200JSONResponse) VisitListDumpEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDumpEvents404JSONResponse ErrorResponse
+
+func (response ListDumpEvents404JSONResponse) VisitListDumpEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDumpEventRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *CreateDumpEventJSONRequestBody
+}
+
+type CreateDumpEventResponseObject interface {
+	VisitCreateDumpEventResponse(w http.ResponseWriter) error
+}
+
+type CreateDumpEvent201JSONResponse DumpEvent
+
+func (response CreateDumpEvent201JSONResponse) VisitCreateDumpEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDumpEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateDumpEvent403JSONResponse) VisitCreateDumpEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDumpEvent404JSONResponse ErrorResponse
+
+func (response CreateDumpEvent404JSONResponse) VisitCreateDumpEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDumpEvent422JSONResponse ErrorResponse
+
+func (response CreateDumpEvent422JSONResponse) VisitCreateDumpEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDumpEventRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	DumpId openapi_types.UUID `json:"dumpId"`
+}
+
+type DeleteDumpEventResponseObject interface {
+	VisitDeleteDumpEventResponse(w http.ResponseWriter) error
+}
+
+type DeleteDumpEvent204Response struct {
+}
+
+func (response DeleteDumpEvent204Response) VisitDeleteDumpEventResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDumpEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteDumpEvent403JSONResponse) VisitDeleteDumpEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDumpEvent404JSONResponse ErrorResponse
+
+func (response DeleteDumpEvent404JSONResponse) VisitDeleteDumpEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopHistoryRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListStopHistoryResponseObject interface {
+	VisitListStopHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListStopHistory200JSONResponse []StopRevision
+
+func (response ListStopHistory200JSONResponse) VisitListStopHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopHistory404JSONResponse ErrorResponse
+
+func (response ListStopHistory404JSONResponse) VisitListStopHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertStopRequestObject struct {
+	TripId   openapi_types.UUID `json:"tripId"`
+	StopId   openapi_types.UUID `json:"stopId"`
+	Revision int                `json:"revision"`
+}
+
+type RevertStopResponseObject interface {
+	VisitRevertStopResponse(w http.ResponseWriter) error
+}
+
+type RevertStop200JSONResponse Stop
+
+func (response RevertStop200JSONResponse) VisitRevertStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertStop404JSONResponse ErrorResponse
+
+func (response RevertStop404JSONResponse) VisitRevertStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertStop422JSONResponse ErrorResponse
+
+func (response RevertStop422JSONResponse) VisitRevertStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopPhotosRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListStopPhotosResponseObject interface {
+	VisitListStopPhotosResponse(w http.ResponseWriter) error
+}
+
+type ListStopPhotos200JSONResponse []Photo
+
+func (response ListStopPhotos200JSONResponse) VisitListStopPhotosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopPhotos404JSONResponse ErrorResponse
+
+func (response ListStopPhotos404JSONResponse) VisitListStopPhotosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadStopPhotoRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Params UploadStopPhotoParams
+	Body   *multipart.Reader
+}
+
+type UploadStopPhotoResponseObject interface {
+	VisitUploadStopPhotoResponse(w http.ResponseWriter) error
+}
+
+type UploadStopPhoto201JSONResponse Photo
+
+func (response UploadStopPhoto201JSONResponse) VisitUploadStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadStopPhoto403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UploadStopPhoto403JSONResponse) VisitUploadStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadStopPhoto404JSONResponse ErrorResponse
+
+func (response UploadStopPhoto404JSONResponse) VisitUploadStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadStopPhoto413JSONResponse ErrorResponse
+
+func (response UploadStopPhoto413JSONResponse) VisitUploadStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadStopPhoto422JSONResponse ErrorResponse
+
+func (response UploadStopPhoto422JSONResponse) VisitUploadStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopPhotoRequestObject struct {
+	TripId  openapi_types.UUID `json:"tripId"`
+	StopId  openapi_types.UUID `json:"stopId"`
+	PhotoId openapi_types.UUID `json:"photoId"`
+}
+
+type DeleteStopPhotoResponseObject interface {
+	VisitDeleteStopPhotoResponse(w http.ResponseWriter) error
+}
+
+type DeleteStopPhoto204Response struct {
+}
+
+func (response DeleteStopPhoto204Response) VisitDeleteStopPhotoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteStopPhoto403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteStopPhoto403JSONResponse) VisitDeleteStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopPhoto404JSONResponse ErrorResponse
+
+func (response DeleteStopPhoto404JSONResponse) VisitDeleteStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopPhotoRequestObject struct {
+	TripId  openapi_types.UUID `json:"tripId"`
+	StopId  openapi_types.UUID `json:"stopId"`
+	PhotoId openapi_types.UUID `json:"photoId"`
+}
+
+type GetStopPhotoResponseObject interface {
+	VisitGetStopPhotoResponse(w http.ResponseWriter) error
+}
+
+type GetStopPhoto200ImageResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetStopPhoto200ImageResponse) VisitGetStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStopPhoto404JSONResponse ErrorResponse
+
+func (response GetStopPhoto404JSONResponse) VisitGetStopPhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopReservationsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListStopReservationsResponseObject interface {
+	VisitListStopReservationsResponse(w http.ResponseWriter) error
+}
+
+type ListStopReservations200JSONResponse []Reservation
+
+func (response ListStopReservations200JSONResponse) VisitListStopReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStopReservations404JSONResponse ErrorResponse
+
+func (response ListStopReservations404JSONResponse) VisitListStopReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStopReservationRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *CreateStopReservationJSONRequestBody
+}
+
+type CreateStopReservationResponseObject interface {
+	VisitCreateStopReservationResponse(w http.ResponseWriter) error
+}
+
+type CreateStopReservation201JSONResponse Reservation
+
+func (response CreateStopReservation201JSONResponse) VisitCreateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStopReservation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateStopReservation403JSONResponse) VisitCreateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStopReservation404JSONResponse ErrorResponse
+
+func (response CreateStopReservation404JSONResponse) VisitCreateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStopReservation422JSONResponse ErrorResponse
+
+func (response CreateStopReservation422JSONResponse) VisitCreateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopReservationRequestObject struct {
+	TripId        openapi_types.UUID `json:"tripId"`
+	StopId        openapi_types.UUID `json:"stopId"`
+	ReservationId openapi_types.UUID `json:"reservationId"`
+}
+
+type DeleteStopReservationResponseObject interface {
+	VisitDeleteStopReservationResponse(w http.ResponseWriter) error
+}
+
+type DeleteStopReservation204Response struct {
+}
+
+func (response DeleteStopReservation204Response) VisitDeleteStopReservationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteStopReservation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteStopReservation403JSONResponse) VisitDeleteStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStopReservation404JSONResponse ErrorResponse
+
+func (response DeleteStopReservation404JSONResponse) VisitDeleteStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopReservationRequestObject struct {
+	TripId        openapi_types.UUID `json:"tripId"`
+	StopId        openapi_types.UUID `json:"stopId"`
+	ReservationId openapi_types.UUID `json:"reservationId"`
+}
+
+type GetStopReservationResponseObject interface {
+	VisitGetStopReservationResponse(w http.ResponseWriter) error
+}
+
+type GetStopReservation200JSONResponse Reservation
+
+func (response GetStopReservation200JSONResponse) VisitGetStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStopReservation404JSONResponse ErrorResponse
+
+func (response GetStopReservation404JSONResponse) VisitGetStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStopReservationRequestObject struct {
+	TripId        openapi_types.UUID `json:"tripId"`
+	StopId        openapi_types.UUID `json:"stopId"`
+	ReservationId openapi_types.UUID `json:"reservationId"`
+	Body          *UpdateStopReservationJSONRequestBody
+}
+
+type UpdateStopReservationResponseObject interface {
+	VisitUpdateStopReservationResponse(w http.ResponseWriter) error
+}
+
+type UpdateStopReservation200JSONResponse Reservation
+
+func (response UpdateStopReservation200JSONResponse) VisitUpdateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStopReservation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateStopReservation403JSONResponse) VisitUpdateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStopReservation404JSONResponse ErrorResponse
+
+func (response UpdateStopReservation404JSONResponse) VisitUpdateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStopReservation422JSONResponse ErrorResponse
+
+func (response UpdateStopReservation422JSONResponse) VisitUpdateStopReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsByStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListTagsByStopResponseObject interface {
+	VisitListTagsByStopResponse(w http.ResponseWriter) error
+}
+
+type ListTagsByStop200JSONResponse []Tag
+
+func (response ListTagsByStop200JSONResponse) VisitListTagsByStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsByStop404JSONResponse ErrorResponse
+
+func (response ListTagsByStop404JSONResponse) VisitListTagsByStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTagToStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *AddTagToStopJSONRequestBody
+}
+
+type AddTagToStopResponseObject interface {
+	VisitAddTagToStopResponse(w http.ResponseWriter) error
+}
+
+type AddTagToStop201JSONResponse Tag
+
+func (response AddTagToStop201JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTagToStop403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddTagToStop403JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTagToStop404JSONResponse ErrorResponse
+
+func (response AddTagToStop404JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTagToStop422JSONResponse ErrorResponse
+
+func (response AddTagToStop422JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTagFromStopRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Slug   string             `json:"slug"`
+}
+
+type RemoveTagFromStopResponseObject interface {
+	VisitRemoveTagFromStopResponse(w http.ResponseWriter) error
+}
+
+type RemoveTagFromStop204Response struct {
+}
+
+func (response RemoveTagFromStop204Response) VisitRemoveTagFromStopResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveTagFromStop403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveTagFromStop403JSONResponse) VisitRemoveTagFromStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTagFromStop404JSONResponse ErrorResponse
+
+func (response RemoveTagFromStop404JSONResponse) VisitRemoveTagFromStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTankLevelsRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+}
+
+type ListTankLevelsResponseObject interface {
+	VisitListTankLevelsResponse(w http.ResponseWriter) error
+}
+
+type ListTankLevels200JSONResponse []TankLevel
+
+func (response ListTankLevels200JSONResponse) VisitListTankLevelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTankLevels404JSONResponse ErrorResponse
+
+func (response ListTankLevels404JSONResponse) VisitListTankLevelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTankLevelRequestObject struct {
+	TripId openapi_types.UUID `json:"tripId"`
+	StopId openapi_types.UUID `json:"stopId"`
+	Body   *CreateTankLevelJSONRequestBody
+}
+
+type CreateTankLevelResponseObject interface {
+	VisitCreateTankLevelResponse(w http.ResponseWriter) error
+}
+
+type CreateTankLevel201JSONResponse TankLevel
+
+func (response CreateTankLevel201JSONResponse) VisitCreateTankLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTankLevel403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTankLevel403JSONResponse) VisitCreateTankLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTankLevel404JSONResponse ErrorResponse
+
+func (response CreateTankLevel404JSONResponse) VisitCreateTankLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTankLevel422JSONResponse ErrorResponse
+
+func (response CreateTankLevel422JSONResponse) VisitCreateTankLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTankLevelRequestObject struct {
+	TripId  openapi_types.UUID `json:"tripId"`
+	StopId  openapi_types.UUID `json:"stopId"`
+	LevelId openapi_types.UUID `json:"levelId"`
+}
+
+type DeleteTankLevelResponseObject interface {
+	VisitDeleteTankLevelResponse(w http.ResponseWriter) error
+}
+
+type DeleteTankLevel204Response struct {
+}
+
+func (response DeleteTankLevel204Response) VisitDeleteTankLevelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTankLevel403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTankLevel403JSONResponse) VisitDeleteTankLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTankLevel404JSONResponse ErrorResponse
+
+func (response DeleteTankLevel404JSONResponse) VisitDeleteTankLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse []Webhook
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse Webhook
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook422JSONResponse ErrorResponse
+
+func (response CreateWebhook422JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook404JSONResponse ErrorResponse
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetWebhook200JSONResponse Webhook
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook404JSONResponse ErrorResponse
+
+func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse Webhook
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404JSONResponse ErrorResponse
+
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook422JSONResponse ErrorResponse
+
+func (response UpdateWebhook422JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse []WebhookDelivery
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse ErrorResponse
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateWebhookSecretRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RotateWebhookSecretResponseObject interface {
+	VisitRotateWebhookSecretResponse(w http.ResponseWriter) error
+}
+
+type RotateWebhookSecret200JSONResponse Webhook
+
+func (response RotateWebhookSecret200JSONResponse) VisitRotateWebhookSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateWebhookSecret404JSONResponse ErrorResponse
+
+func (response RotateWebhookSecret404JSONResponse) VisitRotateWebhookSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhookRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type TestWebhookResponseObject interface {
+	VisitTestWebhookResponse(w http.ResponseWriter) error
+}
+
+type TestWebhook200JSONResponse WebhookDelivery
+
+func (response TestWebhook200JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhook404JSONResponse ErrorResponse
+
+func (response TestWebhook404JSONResponse) VisitTestWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Database connection pool statistics
+	// (GET /admin/pool)
+	GetPoolStats(ctx context.Context, request GetPoolStatsRequestObject) (GetPoolStatsResponseObject, error)
+	// Tidy the stop locations on a trip
+	// (POST /admin/trips/{id}/normalize-locations)
+	AdminNormalizeLocations(ctx context.Context, request AdminNormalizeLocationsRequestObject) (AdminNormalizeLocationsResponseObject, error)
+	// Move stops onto another trip
+	// (POST /admin/trips/{id}/reassign-stops)
+	AdminReassignStops(ctx context.Context, request AdminReassignStopsRequestObject) (AdminReassignStopsResponseObject, error)
+	// Move every date on a trip by some days
+	// (POST /admin/trips/{id}/shift-dates)
+	AdminShiftTripDates(ctx context.Context, request AdminShiftTripDatesRequestObject) (AdminShiftTripDatesResponseObject, error)
+	// List your API keys
+	// (GET /api-keys)
+	ListAPIKeys(ctx context.Context, request ListAPIKeysRequestObject) (ListAPIKeysResponseObject, error)
+	// Create an API key
+	// (POST /api-keys)
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error)
+	// Revoke an API key
+	// (DELETE /api-keys/{id})
+	DeleteAPIKey(ctx context.Context, request DeleteAPIKeyRequestObject) (DeleteAPIKeyResponseObject, error)
+	// Refresh an access token
+	// (POST /auth/refresh)
+	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+	// Log in
+	// (POST /auth/token)
+	CreateToken(ctx context.Context, request CreateTokenRequestObject) (CreateTokenResponseObject, error)
+	// Year-end report of border crossings
+	// (GET /border-crossings)
+	GetBorderCrossingReport(ctx context.Context, request GetBorderCrossingReportRequestObject) (GetBorderCrossingReportResponseObject, error)
+	// List checklist templates
+	// (GET /checklist-templates)
+	ListChecklistTemplates(ctx context.Context, request ListChecklistTemplatesRequestObject) (ListChecklistTemplatesResponseObject, error)
+	// Create a checklist template
+	// (POST /checklist-templates)
+	CreateChecklistTemplate(ctx context.Context, request CreateChecklistTemplateRequestObject) (CreateChecklistTemplateResponseObject, error)
+	// Delete a checklist template
+	// (DELETE /checklist-templates/{id})
+	DeleteChecklistTemplate(ctx context.Context, request DeleteChecklistTemplateRequestObject) (DeleteChecklistTemplateResponseObject, error)
+	// Get a checklist template
+	// (GET /checklist-templates/{id})
+	GetChecklistTemplate(ctx context.Context, request GetChecklistTemplateRequestObject) (GetChecklistTemplateResponseObject, error)
+	// Update a checklist template
+	// (PUT /checklist-templates/{id})
+	UpdateChecklistTemplate(ctx context.Context, request UpdateChecklistTemplateRequestObject) (UpdateChecklistTemplateResponseObject, error)
+	// List custom field definitions
+	// (GET /custom-fields)
+	ListCustomFields(ctx context.Context, request ListCustomFieldsRequestObject) (ListCustomFieldsResponseObject, error)
+	// Define a custom field
+	// (POST /custom-fields)
+	CreateCustomField(ctx context.Context, request CreateCustomFieldRequestObject) (CreateCustomFieldResponseObject, error)
+	// Delete a custom field
+	// (DELETE /custom-fields/{id})
+	DeleteCustomField(ctx context.Context, request DeleteCustomFieldRequestObject) (DeleteCustomFieldResponseObject, error)
+	// Relabel a custom field
+	// (PATCH /custom-fields/{id})
+	PatchCustomField(ctx context.Context, request PatchCustomFieldRequestObject) (PatchCustomFieldResponseObject, error)
+	// Export all trips, stops, and tags as a flat table
+	// (GET /export)
+	GetExport(ctx context.Context, request GetExportRequestObject) (GetExportResponseObject, error)
+	// List the trips and stops deleted for good
+	// (GET /export/deletions)
+	ListExportDeletions(ctx context.Context, request ListExportDeletionsRequestObject) (ListExportDeletionsResponseObject, error)
+	// Health check
+	// (GET /healthz)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Liveness probe
+	// (GET /livez)
+	GetLiveness(ctx context.Context, request GetLivenessRequestObject) (GetLivenessResponseObject, error)
+	// Report the rig's location
+	// (POST /locations)
+	IngestLocation(ctx context.Context, request IngestLocationRequestObject) (IngestLocationResponseObject, error)
+	// List maintenance coming due
+	// (GET /maintenance/due)
+	ListMaintenanceDue(ctx context.Context, request ListMaintenanceDueRequestObject) (ListMaintenanceDueResponseObject, error)
+	// List maintenance items
+	// (GET /maintenance/items)
+	ListMaintenanceItems(ctx context.Context, request ListMaintenanceItemsRequestObject) (ListMaintenanceItemsResponseObject, error)
+	// Add a maintenance item
+	// (POST /maintenance/items)
+	CreateMaintenanceItem(ctx context.Context, request CreateMaintenanceItemRequestObject) (CreateMaintenanceItemResponseObject, error)
+	// Delete a maintenance item and its service history
+	// (DELETE /maintenance/items/{itemId})
+	DeleteMaintenanceItem(ctx context.Context, request DeleteMaintenanceItemRequestObject) (DeleteMaintenanceItemResponseObject, error)
+	// Record that an item was serviced
+	// (POST /maintenance/items/{itemId}/records)
+	CreateMaintenanceRecord(ctx context.Context, request CreateMaintenanceRecordRequestObject) (CreateMaintenanceRecordResponseObject, error)
+	// List note templates
+	// (GET /note-templates)
+	ListNoteTemplates(ctx context.Context, request ListNoteTemplatesRequestObject) (ListNoteTemplatesResponseObject, error)
+	// Create a note template
+	// (POST /note-templates)
+	CreateNoteTemplate(ctx context.Context, request CreateNoteTemplateRequestObject) (CreateNoteTemplateResponseObject, error)
+	// Delete a note template
+	// (DELETE /note-templates/{id})
+	DeleteNoteTemplate(ctx context.Context, request DeleteNoteTemplateRequestObject) (DeleteNoteTemplateResponseObject, error)
+	// Get a note template
+	// (GET /note-templates/{id})
+	GetNoteTemplate(ctx context.Context, request GetNoteTemplateRequestObject) (GetNoteTemplateResponseObject, error)
+	// Update a note template
+	// (PUT /note-templates/{id})
+	UpdateNoteTemplate(ctx context.Context, request UpdateNoteTemplateRequestObject) (UpdateNoteTemplateResponseObject, error)
+	// List odometer readings
+	// (GET /odometer-readings)
+	ListOdometerReadings(ctx context.Context, request ListOdometerReadingsRequestObject) (ListOdometerReadingsResponseObject, error)
+	// Record an odometer reading
+	// (POST /odometer-readings)
+	CreateOdometerReading(ctx context.Context, request CreateOdometerReadingRequestObject) (CreateOdometerReadingResponseObject, error)
+	// Delete an odometer reading
+	// (DELETE /odometer-readings/{id})
+	DeleteOdometerReading(ctx context.Context, request DeleteOdometerReadingRequestObject) (DeleteOdometerReadingResponseObject, error)
+	// Get an odometer reading by ID
+	// (GET /odometer-readings/{id})
+	GetOdometerReading(ctx context.Context, request GetOdometerReadingRequestObject) (GetOdometerReadingResponseObject, error)
+	// List your organizations
+	// (GET /organizations)
+	ListOrganizations(ctx context.Context, request ListOrganizationsRequestObject) (ListOrganizationsResponseObject, error)
+	// Create an organization
+	// (POST /organizations)
+	CreateOrganization(ctx context.Context, request CreateOrganizationRequestObject) (CreateOrganizationResponseObject, error)
+	// Delete an organization
+	// (DELETE /organizations/{orgId})
+	DeleteOrganization(ctx context.Context, request DeleteOrganizationRequestObject) (DeleteOrganizationResponseObject, error)
+	// Get an organization
+	// (GET /organizations/{orgId})
+	GetOrganization(ctx context.Context, request GetOrganizationRequestObject) (GetOrganizationResponseObject, error)
+	// Rename an organization
+	// (PATCH /organizations/{orgId})
+	PatchOrganization(ctx context.Context, request PatchOrganizationRequestObject) (PatchOrganizationResponseObject, error)
+	// List an organization's members
+	// (GET /organizations/{orgId}/members)
+	ListOrganizationMembers(ctx context.Context, request ListOrganizationMembersRequestObject) (ListOrganizationMembersResponseObject, error)
+	// Add a member to an organization
+	// (POST /organizations/{orgId}/members)
+	AddOrganizationMember(ctx context.Context, request AddOrganizationMemberRequestObject) (AddOrganizationMemberResponseObject, error)
+	// Remove a member from an organization
+	// (DELETE /organizations/{orgId}/members/{userId})
+	RemoveOrganizationMember(ctx context.Context, request RemoveOrganizationMemberRequestObject) (RemoveOrganizationMemberResponseObject, error)
+	// Change a member's role
+	// (PATCH /organizations/{orgId}/members/{userId})
+	PatchOrganizationMember(ctx context.Context, request PatchOrganizationMemberRequestObject) (PatchOrganizationMemberResponseObject, error)
+	// List packing lists
+	// (GET /packing-lists)
+	ListPackingLists(ctx context.Context, request ListPackingListsRequestObject) (ListPackingListsResponseObject, error)
+	// Create a packing list
+	// (POST /packing-lists)
+	CreatePackingList(ctx context.Context, request CreatePackingListRequestObject) (CreatePackingListResponseObject, error)
+	// Delete a packing list
+	// (DELETE /packing-lists/{id})
+	DeletePackingList(ctx context.Context, request DeletePackingListRequestObject) (DeletePackingListResponseObject, error)
+	// Get a packing list
+	// (GET /packing-lists/{id})
+	GetPackingList(ctx context.Context, request GetPackingListRequestObject) (GetPackingListResponseObject, error)
+	// Rename a packing list
+	// (PUT /packing-lists/{id})
+	UpdatePackingList(ctx context.Context, request UpdatePackingListRequestObject) (UpdatePackingListResponseObject, error)
+	// Add an item to a packing list
+	// (POST /packing-lists/{id}/items)
+	CreatePackingItem(ctx context.Context, request CreatePackingItemRequestObject) (CreatePackingItemResponseObject, error)
+	// Remove an item from a packing list
+	// (DELETE /packing-lists/{id}/items/{itemId})
+	DeletePackingItem(ctx context.Context, request DeletePackingItemRequestObject) (DeletePackingItemResponseObject, error)
+	// Update a packing list item
+	// (PUT /packing-lists/{id}/items/{itemId})
+	UpdatePackingItem(ctx context.Context, request UpdatePackingItemRequestObject) (UpdatePackingItemResponseObject, error)
+	// Download a photo's thumbnail
+	// (GET /photos/{photoId}/thumbnail)
+	GetPhotoThumbnail(ctx context.Context, request GetPhotoThumbnailRequestObject) (GetPhotoThumbnailResponseObject, error)
+	// List points of interest
+	// (GET /points-of-interest)
+	ListPointsOfInterest(ctx context.Context, request ListPointsOfInterestRequestObject) (ListPointsOfInterestResponseObject, error)
+	// Log a sighting or point of interest
+	// (POST /points-of-interest)
+	CreatePointOfInterest(ctx context.Context, request CreatePointOfInterestRequestObject) (CreatePointOfInterestResponseObject, error)
+	// Delete a point of interest
+	// (DELETE /points-of-interest/{id})
+	DeletePointOfInterest(ctx context.Context, request DeletePointOfInterestRequestObject) (DeletePointOfInterestResponseObject, error)
+	// Get a point of interest by ID
+	// (GET /points-of-interest/{id})
+	GetPointOfInterest(ctx context.Context, request GetPointOfInterestRequestObject) (GetPointOfInterestResponseObject, error)
+	// Update a point of interest
+	// (PUT /points-of-interest/{id})
+	UpdatePointOfInterest(ctx context.Context, request UpdatePointOfInterestRequestObject) (UpdatePointOfInterestResponseObject, error)
+	// List power readings
+	// (GET /power-readings)
+	ListPowerReadings(ctx context.Context, request ListPowerReadingsRequestObject) (ListPowerReadingsResponseObject, error)
+	// Record a power reading
+	// (POST /power-readings)
+	CreatePowerReading(ctx context.Context, request CreatePowerReadingRequestObject) (CreatePowerReadingResponseObject, error)
+	// Delete a power reading
+	// (DELETE /power-readings/{id})
+	DeletePowerReading(ctx context.Context, request DeletePowerReadingRequestObject) (DeletePowerReadingResponseObject, error)
+	// Get a power reading by ID
+	// (GET /power-readings/{id})
+	GetPowerReading(ctx context.Context, request GetPowerReadingRequestObject) (GetPowerReadingResponseObject, error)
+	// List propane fills
+	// (GET /propane-fills)
+	ListPropaneFills(ctx context.Context, request ListPropaneFillsRequestObject) (ListPropaneFillsResponseObject, error)
+	// Log a propane fill
+	// (POST /propane-fills)
+	CreatePropaneFill(ctx context.Context, request CreatePropaneFillRequestObject) (CreatePropaneFillResponseObject, error)
+	// Delete a propane fill
+	// (DELETE /propane-fills/{id})
+	DeletePropaneFill(ctx context.Context, request DeletePropaneFillRequestObject) (DeletePropaneFillResponseObject, error)
+	// Get a propane fill by ID
+	// (GET /propane-fills/{id})
+	GetPropaneFill(ctx context.Context, request GetPropaneFillRequestObject) (GetPropaneFillResponseObject, error)
+	// Readiness probe
+	// (GET /readyz)
+	GetReadiness(ctx context.Context, request GetReadinessRequestObject) (GetReadinessResponseObject, error)
+	// List reservations that have not ended
+	// (GET /reservations/upcoming)
+	ListUpcomingReservations(ctx context.Context, request ListUpcomingReservationsRequestObject) (ListUpcomingReservationsResponseObject, error)
+	// List rigs
+	// (GET /rigs)
+	ListRigs(ctx context.Context, request ListRigsRequestObject) (ListRigsResponseObject, error)
+	// Add a rig
+	// (POST /rigs)
+	CreateRig(ctx context.Context, request CreateRigRequestObject) (CreateRigResponseObject, error)
+	// Delete a rig
+	// (DELETE /rigs/{rigId})
+	DeleteRig(ctx context.Context, request DeleteRigRequestObject) (DeleteRigResponseObject, error)
+	// Get a rig
+	// (GET /rigs/{rigId})
+	GetRig(ctx context.Context, request GetRigRequestObject) (GetRigResponseObject, error)
+	// Replace a rig's details
+	// (PUT /rigs/{rigId})
+	UpdateRig(ctx context.Context, request UpdateRigRequestObject) (UpdateRigResponseObject, error)
+	// List a rig's service history
+	// (GET /rigs/{rigId}/maintenance)
+	ListRigMaintenance(ctx context.Context, request ListRigMaintenanceRequestObject) (ListRigMaintenanceResponseObject, error)
+	// Log work done on a rig
+	// (POST /rigs/{rigId}/maintenance)
+	CreateRigMaintenance(ctx context.Context, request CreateRigMaintenanceRequestObject) (CreateRigMaintenanceResponseObject, error)
+	// Delete an entry from a rig's service history
+	// (DELETE /rigs/{rigId}/maintenance/{entryId})
+	DeleteRigMaintenance(ctx context.Context, request DeleteRigMaintenanceRequestObject) (DeleteRigMaintenanceResponseObject, error)
+	// View a shared trip
+	// (GET /shared/{token})
+	GetSharedTrip(ctx context.Context, request GetSharedTripRequestObject) (GetSharedTripResponseObject, error)
+	// Embed a shared trip in another site
+	// (GET /shared/{token}/embed)
+	GetSharedTripEmbed(ctx context.Context, request GetSharedTripEmbedRequestObject) (GetSharedTripEmbedResponseObject, error)
+	// Totals for every trip and the most used tags
+	// (GET /stats/dashboard)
+	GetDashboard(ctx context.Context, request GetDashboardRequestObject) (GetDashboardResponseObject, error)
+	// Nights spent, as heatmap points
+	// (GET /stats/heatmap)
+	GetStopHeatmap(ctx context.Context, request GetStopHeatmapRequestObject) (GetStopHeatmapResponseObject, error)
+	// Propane totals and consumption rate
+	// (GET /stats/propane)
+	GetPropaneStats(ctx context.Context, request GetPropaneStatsRequestObject) (GetPropaneStatsResponseObject, error)
+	// States and provinces visited
+	// (GET /stats/states-visited)
+	GetStatesVisited(ctx context.Context, request GetStatesVisitedRequestObject) (GetStatesVisitedResponseObject, error)
+	// Stop counts for every tag, rolled up through the hierarchy
+	// (GET /stats/tags)
+	GetTagStats(ctx context.Context, request GetTagStatsRequestObject) (GetTagStatsResponseObject, error)
+	// Clustered stop markers for a map view
+	// (GET /stops/clusters)
+	ListStopClusters(ctx context.Context, request ListStopClustersRequestObject) (ListStopClustersResponseObject, error)
+	// Stops near a point
+	// (GET /stops/nearby)
+	ListNearbyStops(ctx context.Context, request ListNearbyStopsRequestObject) (ListNearbyStopsResponseObject, error)
+	// Which nights of a year are accounted for by stops
+	// (GET /stops/occupancy)
+	GetStopOccupancy(ctx context.Context, request GetStopOccupancyRequestObject) (GetStopOccupancyResponseObject, error)
+	// List tags, optionally filtered by name prefix
+	// (GET /tags)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	// Create a tag by name
+	// (POST /tags)
+	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
+	// Delete a tag
+	// (DELETE /tags/{slug})
+	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
+	// Update a tag's display name
+	// (PATCH /tags/{slug})
+	PatchTag(ctx context.Context, request PatchTagRequestObject) (PatchTagResponseObject, error)
+	// Merge a tag into another
+	// (POST /tags/{slug}/merge)
+	MergeTag(ctx context.Context, request MergeTagRequestObject) (MergeTagResponseObject, error)
+	// Move a tag to the top level
+	// (DELETE /tags/{slug}/parent)
+	ClearTagParent(ctx context.Context, request ClearTagParentRequestObject) (ClearTagParentResponseObject, error)
+	// Put a tag under another
+	// (PUT /tags/{slug}/parent)
+	SetTagParent(ctx context.Context, request SetTagParentRequestObject) (SetTagParentResponseObject, error)
+	// List recently deleted trips and stops
+	// (GET /trash)
+	ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error)
+	// Restore a deleted trip or stop
+	// (POST /trash/{id}/restore)
+	RestoreTrashItem(ctx context.Context, request RestoreTrashItemRequestObject) (RestoreTrashItemResponseObject, error)
+	// List all trips
+	// (GET /trips)
+	ListTrips(ctx context.Context, request ListTripsRequestObject) (ListTripsResponseObject, error)
+	// Create a trip
+	// (POST /trips)
+	CreateTrip(ctx context.Context, request CreateTripRequestObject) (CreateTripResponseObject, error)
+	// Get the trip in progress and its tank status
+	// (GET /trips/current)
+	GetCurrentTrip(ctx context.Context, request GetCurrentTripRequestObject) (GetCurrentTripResponseObject, error)
+	// Delete a trip
+	// (DELETE /trips/{id})
+	DeleteTrip(ctx context.Context, request DeleteTripRequestObject) (DeleteTripResponseObject, error)
+	// Get a trip by ID
+	// (GET /trips/{id})
+	GetTrip(ctx context.Context, request GetTripRequestObject) (GetTripResponseObject, error)
+	// Update a trip
+	// (PUT /trips/{id})
+	UpdateTrip(ctx context.Context, request UpdateTripRequestObject) (UpdateTripResponseObject, error)
+	// Copy a trip into a new one
+	// (POST /trips/{id}/clone)
+	CloneTrip(ctx context.Context, request CloneTripRequestObject) (CloneTripResponseObject, error)
+	// Find nights within a trip that no stop accounts for
+	// (GET /trips/{id}/gaps)
+	ListTripGaps(ctx context.Context, request ListTripGapsRequestObject) (ListTripGapsResponseObject, error)
+	// Get a trip's stops and route as GeoJSON
+	// (GET /trips/{id}/geojson)
+	GetTripGeoJSON(ctx context.Context, request GetTripGeoJSONRequestObject) (GetTripGeoJSONResponseObject, error)
+	// List every recorded version of a trip
+	// (GET /trips/{id}/history)
+	ListTripHistory(ctx context.Context, request ListTripHistoryRequestObject) (ListTripHistoryResponseObject, error)
+	// Put a trip back as it was at a revision
+	// (POST /trips/{id}/history/{revision}/revert)
+	RevertTrip(ctx context.Context, request RevertTripRequestObject) (RevertTripResponseObject, error)
+	// Import stops from GPX waypoints
+	// (POST /trips/{id}/import/gpx)
+	ImportTripGPX(ctx context.Context, request ImportTripGPXRequestObject) (ImportTripGPXResponseObject, error)
+	// Render a trip as a static map image
+	// (GET /trips/{id}/map.png)
+	GetTripMap(ctx context.Context, request GetTripMapRequestObject) (GetTripMapResponseObject, error)
+	// Get the distance covered on a trip
+	// (GET /trips/{id}/mileage)
+	GetTripMileage(ctx context.Context, request GetTripMileageRequestObject) (GetTripMileageResponseObject, error)
+	// Chart a trip's battery, solar, and generator readings
+	// (GET /trips/{id}/power)
+	GetTripPower(ctx context.Context, request GetTripPowerRequestObject) (GetTripPowerResponseObject, error)
+	// List active share links for a trip
+	// (GET /trips/{id}/shares)
+	ListTripShares(ctx context.Context, request ListTripSharesRequestObject) (ListTripSharesResponseObject, error)
+	// Create a share link for a trip
+	// (POST /trips/{id}/shares)
+	CreateTripShare(ctx context.Context, request CreateTripShareRequestObject) (CreateTripShareResponseObject, error)
+	// Revoke a share link
+	// (DELETE /trips/{id}/shares/{shareId})
+	RevokeTripShare(ctx context.Context, request RevokeTripShareRequestObject) (RevokeTripShareResponseObject, error)
+	// Get a trip's stop and route totals
+	// (GET /trips/{id}/stats)
+	GetTripStats(ctx context.Context, request GetTripStatsRequestObject) (GetTripStatsResponseObject, error)
+	// List a trip's border crossings
+	// (GET /trips/{tripId}/border-crossings)
+	ListTripBorderCrossings(ctx context.Context, request ListTripBorderCrossingsRequestObject) (ListTripBorderCrossingsResponseObject, error)
+	// Log a border crossing
+	// (POST /trips/{tripId}/border-crossings)
+	CreateTripBorderCrossing(ctx context.Context, request CreateTripBorderCrossingRequestObject) (CreateTripBorderCrossingResponseObject, error)
+	// Delete a border crossing
+	// (DELETE /trips/{tripId}/border-crossings/{crossingId})
+	DeleteTripBorderCrossing(ctx context.Context, request DeleteTripBorderCrossingRequestObject) (DeleteTripBorderCrossingResponseObject, error)
+	// Get a border crossing
+	// (GET /trips/{tripId}/border-crossings/{crossingId})
+	GetTripBorderCrossing(ctx context.Context, request GetTripBorderCrossingRequestObject) (GetTripBorderCrossingResponseObject, error)
+	// Update a border crossing
+	// (PUT /trips/{tripId}/border-crossings/{crossingId})
+	UpdateTripBorderCrossing(ctx context.Context, request UpdateTripBorderCrossingRequestObject) (UpdateTripBorderCrossingResponseObject, error)
+	// Compare a trip's spending with its budget
+	// (GET /trips/{tripId}/budget-report)
+	GetTripBudgetReport(ctx context.Context, request GetTripBudgetReportRequestObject) (GetTripBudgetReportResponseObject, error)
+	// Check a trip's planned drives against driving rules
+	// (POST /trips/{tripId}/drive-check)
+	CheckTripDrives(ctx context.Context, request CheckTripDrivesRequestObject) (CheckTripDrivesResponseObject, error)
+	// List a trip's expenses
+	// (GET /trips/{tripId}/expenses)
+	ListTripExpenses(ctx context.Context, request ListTripExpensesRequestObject) (ListTripExpensesResponseObject, error)
+	// Delete an expense
+	// (DELETE /trips/{tripId}/expenses/{expenseId})
+	DeleteTripExpense(ctx context.Context, request DeleteTripExpenseRequestObject) (DeleteTripExpenseResponseObject, error)
+	// Record who paid an expense and who shares it
+	// (PUT /trips/{tripId}/expenses/{expenseId}/split)
+	SplitTripExpense(ctx context.Context, request SplitTripExpenseRequestObject) (SplitTripExpenseResponseObject, error)
+	// List a trip's journal by day
+	// (GET /trips/{tripId}/journal)
+	ListTripJournal(ctx context.Context, request ListTripJournalRequestObject) (ListTripJournalResponseObject, error)
+	// Write a journal entry
+	// (POST /trips/{tripId}/journal)
+	CreateTripJournalEntry(ctx context.Context, request CreateTripJournalEntryRequestObject) (CreateTripJournalEntryResponseObject, error)
+	// Delete a journal entry
+	// (DELETE /trips/{tripId}/journal/{entryId})
+	DeleteTripJournalEntry(ctx context.Context, request DeleteTripJournalEntryRequestObject) (DeleteTripJournalEntryResponseObject, error)
+	// Get a journal entry
+	// (GET /trips/{tripId}/journal/{entryId})
+	GetTripJournalEntry(ctx context.Context, request GetTripJournalEntryRequestObject) (GetTripJournalEntryResponseObject, error)
+	// Update a journal entry
+	// (PUT /trips/{tripId}/journal/{entryId})
+	UpdateTripJournalEntry(ctx context.Context, request UpdateTripJournalEntryRequestObject) (UpdateTripJournalEntryResponseObject, error)
+	// List the route legs between a trip's stops
+	// (GET /trips/{tripId}/legs)
+	ListTripRouteLegs(ctx context.Context, request ListTripRouteLegsRequestObject) (ListTripRouteLegsResponseObject, error)
+	// Set a route leg's distance, duration, and polyline
+	// (PUT /trips/{tripId}/legs/{legId})
+	UpdateTripRouteLeg(ctx context.Context, request UpdateTripRouteLegRequestObject) (UpdateTripRouteLegResponseObject, error)
+	// Get a route leg's elevation profile
+	// (GET /trips/{tripId}/legs/{legId}/elevation)
+	GetTripRouteLegElevation(ctx context.Context, request GetTripRouteLegElevationRequestObject) (GetTripRouteLegElevationResponseObject, error)
+	// Download a route leg's GPX track
+	// (GET /trips/{tripId}/legs/{legId}/track)
+	GetTripRouteLegTrack(ctx context.Context, request GetTripRouteLegTrackRequestObject) (GetTripRouteLegTrackResponseObject, error)
+	// Upload a GPX track for a route leg
+	// (POST /trips/{tripId}/legs/{legId}/track)
+	UploadTripRouteLegTrack(ctx context.Context, request UploadTripRouteLegTrackRequestObject) (UploadTripRouteLegTrackResponseObject, error)
+	// List a trip's members
+	// (GET /trips/{tripId}/members)
+	ListTripMembers(ctx context.Context, request ListTripMembersRequestObject) (ListTripMembersResponseObject, error)
+	// Share a trip with another user
+	// (POST /trips/{tripId}/members)
+	InviteTripMember(ctx context.Context, request InviteTripMemberRequestObject) (InviteTripMemberResponseObject, error)
+	// Stop sharing a trip with a user
+	// (DELETE /trips/{tripId}/members/{userId})
+	RemoveTripMember(ctx context.Context, request RemoveTripMemberRequestObject) (RemoveTripMemberResponseObject, error)
+	// Change a member's role
+	// (PATCH /trips/{tripId}/members/{userId})
+	PatchTripMember(ctx context.Context, request PatchTripMemberRequestObject) (PatchTripMemberResponseObject, error)
+	// Log an expense in one tap
+	// (POST /trips/{tripId}/quicklog)
+	QuickLogExpense(ctx context.Context, request QuickLogExpenseRequestObject) (QuickLogExpenseResponseObject, error)
+	// Settle up a group trip's shared costs
+	// (GET /trips/{tripId}/settlement)
+	GetTripSettlement(ctx context.Context, request GetTripSettlementRequestObject) (GetTripSettlementResponseObject, error)
+	// List stops suggested from location reports
+	// (GET /trips/{tripId}/stop-suggestions)
+	ListTripStopSuggestions(ctx context.Context, request ListTripStopSuggestionsRequestObject) (ListTripStopSuggestionsResponseObject, error)
+	// Dismiss a suggested stop
+	// (DELETE /trips/{tripId}/stop-suggestions/{suggestionId})
+	DismissTripStopSuggestion(ctx context.Context, request DismissTripStopSuggestionRequestObject) (DismissTripStopSuggestionResponseObject, error)
+	// Log a suggested stop
+	// (POST /trips/{tripId}/stop-suggestions/{suggestionId}/accept)
+	AcceptTripStopSuggestion(ctx context.Context, request AcceptTripStopSuggestionRequestObject) (AcceptTripStopSuggestionResponseObject, error)
+	// List all stops for a trip
+	// (GET /trips/{tripId}/stops)
+	ListStops(ctx context.Context, request ListStopsRequestObject) (ListStopsResponseObject, error)
+	// Create a stop on a trip
+	// (POST /trips/{tripId}/stops)
+	CreateStop(ctx context.Context, request CreateStopRequestObject) (CreateStopResponseObject, error)
+	// Delete a stop
+	// (DELETE /trips/{tripId}/stops/{stopId})
+	DeleteStop(ctx context.Context, request DeleteStopRequestObject) (DeleteStopResponseObject, error)
+	// Get a stop by ID
+	// (GET /trips/{tripId}/stops/{stopId})
+	GetStop(ctx context.Context, request GetStopRequestObject) (GetStopResponseObject, error)
+	// Update a stop
+	// (PUT /trips/{tripId}/stops/{stopId})
+	UpdateStop(ctx context.Context, request UpdateStopRequestObject) (UpdateStopResponseObject, error)
+	// Mark a planned stop as reached
+	// (POST /trips/{tripId}/stops/{stopId}/arrive)
+	ArriveAtStop(ctx context.Context, request ArriveAtStopRequestObject) (ArriveAtStopResponseObject, error)
+	// List checklists run at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/checklists)
+	ListStopChecklists(ctx context.Context, request ListStopChecklistsRequestObject) (ListStopChecklistsResponseObject, error)
+	// Start a checklist at a stop
+	// (POST /trips/{tripId}/stops/{stopId}/checklists)
+	StartStopChecklist(ctx context.Context, request StartStopChecklistRequestObject) (StartStopChecklistResponseObject, error)
+	// Delete a checklist run at a stop
+	// (DELETE /trips/{tripId}/stops/{stopId}/checklists/{checklistId})
+	DeleteStopChecklist(ctx context.Context, request DeleteStopChecklistRequestObject) (DeleteStopChecklistResponseObject, error)
+	// Get a checklist run at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/checklists/{checklistId})
+	GetStopChecklist(ctx context.Context, request GetStopChecklistRequestObject) (GetStopChecklistResponseObject, error)
+	// Check off or uncheck a checklist item
+	// (PUT /trips/{tripId}/stops/{stopId}/checklists/{checklistId}/items/{itemId})
+	CheckStopChecklistItem(ctx context.Context, request CheckStopChecklistItemRequestObject) (CheckStopChecklistItemResponseObject, error)
+	// List dump events at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/dumps)
+	ListDumpEvents(ctx context.Context, request ListDumpEventsRequestObject) (ListDumpEventsResponseObject, error)
+	// Log a dump at a stop
+	// (POST /trips/{tripId}/stops/{stopId}/dumps)
+	CreateDumpEvent(ctx context.Context, request CreateDumpEventRequestObject) (CreateDumpEventResponseObject, error)
+	// Delete a dump event
+	// (DELETE /trips/{tripId}/stops/{stopId}/dumps/{dumpId})
+	DeleteDumpEvent(ctx context.Context, request DeleteDumpEventRequestObject) (DeleteDumpEventResponseObject, error)
+	// List every recorded version of a stop
+	// (GET /trips/{tripId}/stops/{stopId}/history)
+	ListStopHistory(ctx context.Context, request ListStopHistoryRequestObject) (ListStopHistoryResponseObject, error)
+	// Put a stop back as it was at a revision
+	// (POST /trips/{tripId}/stops/{stopId}/history/{revision}/revert)
+	RevertStop(ctx context.Context, request RevertStopRequestObject) (RevertStopResponseObject, error)
+	// List a stop's photos
+	// (GET /trips/{tripId}/stops/{stopId}/photos)
+	ListStopPhotos(ctx context.Context, request ListStopPhotosRequestObject) (ListStopPhotosResponseObject, error)
+	// Attach a photo to a stop
+	// (POST /trips/{tripId}/stops/{stopId}/photos)
+	UploadStopPhoto(ctx context.Context, request UploadStopPhotoRequestObject) (UploadStopPhotoResponseObject, error)
+	// Remove a photo from a stop
+	// (DELETE /trips/{tripId}/stops/{stopId}/photos/{photoId})
+	DeleteStopPhoto(ctx context.Context, request DeleteStopPhotoRequestObject) (DeleteStopPhotoResponseObject, error)
+	// Download a photo
+	// (GET /trips/{tripId}/stops/{stopId}/photos/{photoId})
+	GetStopPhoto(ctx context.Context, request GetStopPhotoRequestObject) (GetStopPhotoResponseObject, error)
+	// List reservations for a stop
+	// (GET /trips/{tripId}/stops/{stopId}/reservations)
+	ListStopReservations(ctx context.Context, request ListStopReservationsRequestObject) (ListStopReservationsResponseObject, error)
+	// Add a reservation to a stop
+	// (POST /trips/{tripId}/stops/{stopId}/reservations)
+	CreateStopReservation(ctx context.Context, request CreateStopReservationRequestObject) (CreateStopReservationResponseObject, error)
+	// Delete a reservation
+	// (DELETE /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+	DeleteStopReservation(ctx context.Context, request DeleteStopReservationRequestObject) (DeleteStopReservationResponseObject, error)
+	// Get a reservation
+	// (GET /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+	GetStopReservation(ctx context.Context, request GetStopReservationRequestObject) (GetStopReservationResponseObject, error)
+	// Update a reservation
+	// (PUT /trips/{tripId}/stops/{stopId}/reservations/{reservationId})
+	UpdateStopReservation(ctx context.Context, request UpdateStopReservationRequestObject) (UpdateStopReservationResponseObject, error)
+	// List tags on a stop
+	// (GET /trips/{tripId}/stops/{stopId}/tags)
+	ListTagsByStop(ctx context.Context, request ListTagsByStopRequestObject) (ListTagsByStopResponseObject, error)
+	// Add a tag to a stop
+	// (POST /trips/{tripId}/stops/{stopId}/tags)
+	AddTagToStop(ctx context.Context, request AddTagToStopRequestObject) (AddTagToStopResponseObject, error)
+	// Remove a tag from a stop
+	// (DELETE /trips/{tripId}/stops/{stopId}/tags/{slug})
+	RemoveTagFromStop(ctx context.Context, request RemoveTagFromStopRequestObject) (RemoveTagFromStopResponseObject, error)
+	// List tank readings at a stop
+	// (GET /trips/{tripId}/stops/{stopId}/tank-levels)
+	ListTankLevels(ctx context.Context, request ListTankLevelsRequestObject) (ListTankLevelsResponseObject, error)
+	// Record tank levels at a stop
+	// (POST /trips/{tripId}/stops/{stopId}/tank-levels)
+	CreateTankLevel(ctx context.Context, request CreateTankLevelRequestObject) (CreateTankLevelResponseObject, error)
+	// Delete a tank reading
+	// (DELETE /trips/{tripId}/stops/{stopId}/tank-levels/{levelId})
+	DeleteTankLevel(ctx context.Context, request DeleteTankLevelRequestObject) (DeleteTankLevelResponseObject, error)
+	// List webhooks
+	// (GET /webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Subscribe a URL to events
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Get a webhook
+	// (GET /webhooks/{id})
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// Update a webhook
+	// (PUT /webhooks/{id})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// List a webhook's delivery attempts
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// Replace a webhook's secret
+	// (POST /webhooks/{id}/rotate-secret)
+	RotateWebhookSecret(ctx context.Context, request RotateWebhookSecretRequestObject) (RotateWebhookSecretResponseObject, error)
+	// Send a test delivery
+	// (POST /webhooks/{id}/test)
+	TestWebhook(ctx context.Context, request TestWebhookRequestObject) (TestWebhookResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetPoolStats operation middleware
+func (sh *strictHandler) GetPoolStats(w http.ResponseWriter, r *http.Request) {
+	var request GetPoolStatsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPoolStats(ctx, request.(GetPoolStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPoolStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPoolStatsResponseObject); ok {
+		if err := validResponse.VisitGetPoolStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminNormalizeLocations operation middleware
+func (sh *strictHandler) AdminNormalizeLocations(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminNormalizeLocationsParams) {
+	var request AdminNormalizeLocationsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminNormalizeLocations(ctx, request.(AdminNormalizeLocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminNormalizeLocations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminNormalizeLocationsResponseObject); ok {
+		if err := validResponse.VisitAdminNormalizeLocationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminReassignStops operation middleware
+func (sh *strictHandler) AdminReassignStops(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminReassignStopsParams) {
+	var request AdminReassignStopsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body AdminReassignStopsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminReassignStops(ctx, request.(AdminReassignStopsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminReassignStops")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminReassignStopsResponseObject); ok {
+		if err := validResponse.VisitAdminReassignStopsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminShiftTripDates operation middleware
+func (sh *strictHandler) AdminShiftTripDates(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params AdminShiftTripDatesParams) {
+	var request AdminShiftTripDatesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body AdminShiftTripDatesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminShiftTripDates(ctx, request.(AdminShiftTripDatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminShiftTripDates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminShiftTripDatesResponseObject); ok {
+		if err := validResponse.VisitAdminShiftTripDatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAPIKeys operation middleware
+func (sh *strictHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListAPIKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAPIKeys(ctx, request.(ListAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAPIKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAPIKeysResponseObject); ok {
+		if err := validResponse.VisitListAPIKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAPIKey operation middleware
+func (sh *strictHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPIKeyRequestObject
+
+	var body CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIKey(ctx, request.(CreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPIKey operation middleware
+func (sh *strictHandler) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAPIKeyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIKey(ctx, request.(DeleteAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefreshToken operation middleware
+func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var request RefreshTokenRequestObject
+
+	var body RefreshTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshToken(ctx, request.(RefreshTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshTokenResponseObject); ok {
+		if err := validResponse.VisitRefreshTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateToken operation middleware
+func (sh *strictHandler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateTokenRequestObject
+
+	var body CreateTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateToken(ctx, request.(CreateTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTokenResponseObject); ok {
+		if err := validResponse.VisitCreateTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBorderCrossingReport operation middleware
+func (sh *strictHandler) GetBorderCrossingReport(w http.ResponseWriter, r *http.Request, params GetBorderCrossingReportParams) {
+	var request GetBorderCrossingReportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBorderCrossingReport(ctx, request.(GetBorderCrossingReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBorderCrossingReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBorderCrossingReportResponseObject); ok {
+		if err := validResponse.VisitGetBorderCrossingReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListChecklistTemplates operation middleware
+func (sh *strictHandler) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+	var request ListChecklistTemplatesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListChecklistTemplates(ctx, request.(ListChecklistTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListChecklistTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListChecklistTemplatesResponseObject); ok {
+		if err := validResponse.VisitListChecklistTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateChecklistTemplate operation middleware
+func (sh *strictHandler) CreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateChecklistTemplateRequestObject
+
+	var body CreateChecklistTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChecklistTemplate(ctx, request.(CreateChecklistTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChecklistTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateChecklistTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteChecklistTemplate operation middleware
+func (sh *strictHandler) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteChecklistTemplateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteChecklistTemplate(ctx, request.(DeleteChecklistTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteChecklistTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteChecklistTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteChecklistTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetChecklistTemplate operation middleware
+func (sh *strictHandler) GetChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetChecklistTemplateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistTemplate(ctx, request.(GetChecklistTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetChecklistTemplateResponseObject); ok {
+		if err := validResponse.VisitGetChecklistTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateChecklistTemplate operation middleware
+func (sh *strictHandler) UpdateChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateChecklistTemplateRequestObject
+
+	request.Id = id
+
+	var body UpdateChecklistTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateChecklistTemplate(ctx, request.(UpdateChecklistTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateChecklistTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateChecklistTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCustomFields operation middleware
+func (sh *strictHandler) ListCustomFields(w http.ResponseWriter, r *http.Request, params ListCustomFieldsParams) {
+	var request ListCustomFieldsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCustomFields(ctx, request.(ListCustomFieldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCustomFields")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCustomFieldsResponseObject); ok {
+		if err := validResponse.VisitListCustomFieldsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCustomField operation middleware
+func (sh *strictHandler) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+	var request CreateCustomFieldRequestObject
+
+	var body CreateCustomFieldJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCustomField(ctx, request.(CreateCustomFieldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCustomField")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCustomFieldResponseObject); ok {
+		if err := validResponse.VisitCreateCustomFieldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCustomField operation middleware
+func (sh *strictHandler) DeleteCustomField(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteCustomFieldRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCustomField(ctx, request.(DeleteCustomFieldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCustomField")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCustomFieldResponseObject); ok {
+		if err := validResponse.VisitDeleteCustomFieldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchCustomField operation middleware
+func (sh *strictHandler) PatchCustomField(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PatchCustomFieldRequestObject
+
+	request.Id = id
+
+	var body PatchCustomFieldJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCustomField(ctx, request.(PatchCustomFieldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCustomField")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchCustomFieldResponseObject); ok {
+		if err := validResponse.VisitPatchCustomFieldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExport operation middleware
+func (sh *strictHandler) GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams) {
+	var request GetExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExport(ctx, request.(GetExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportResponseObject); ok {
+		if err := validResponse.VisitGetExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListExportDeletions operation middleware
+func (sh *strictHandler) ListExportDeletions(w http.ResponseWriter, r *http.Request, params ListExportDeletionsParams) {
+	var request ListExportDeletionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListExportDeletions(ctx, request.(ListExportDeletionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListExportDeletions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListExportDeletionsResponseObject); ok {
+		if err := validResponse.VisitListExportDeletionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams) {
+	var request GetHealthRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLiveness operation middleware
+func (sh *strictHandler) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	var request GetLivenessRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLiveness(ctx, request.(GetLivenessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLiveness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLivenessResponseObject); ok {
+		if err := validResponse.VisitGetLivenessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// IngestLocation operation middleware
+func (sh *strictHandler) IngestLocation(w http.ResponseWriter, r *http.Request) {
+	var request IngestLocationRequestObject
+
+	var body IngestLocationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IngestLocation(ctx, request.(IngestLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IngestLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IngestLocationResponseObject); ok {
+		if err := validResponse.VisitIngestLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMaintenanceDue operation middleware
+func (sh *strictHandler) ListMaintenanceDue(w http.ResponseWriter, r *http.Request, params ListMaintenanceDueParams) {
+	var request ListMaintenanceDueRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMaintenanceDue(ctx, request.(ListMaintenanceDueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMaintenanceDue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMaintenanceDueResponseObject); ok {
+		if err := validResponse.VisitListMaintenanceDueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMaintenanceItems operation middleware
+func (sh *strictHandler) ListMaintenanceItems(w http.ResponseWriter, r *http.Request, params ListMaintenanceItemsParams) {
+	var request ListMaintenanceItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMaintenanceItems(ctx, request.(ListMaintenanceItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMaintenanceItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMaintenanceItemsResponseObject); ok {
+		if err := validResponse.VisitListMaintenanceItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateMaintenanceItem operation middleware
+func (sh *strictHandler) CreateMaintenanceItem(w http.ResponseWriter, r *http.Request, params CreateMaintenanceItemParams) {
+	var request CreateMaintenanceItemRequestObject
+
+	request.Params = params
+
+	var body CreateMaintenanceItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMaintenanceItem(ctx, request.(CreateMaintenanceItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMaintenanceItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateMaintenanceItemResponseObject); ok {
+		if err := validResponse.VisitCreateMaintenanceItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteMaintenanceItem operation middleware
+func (sh *strictHandler) DeleteMaintenanceItem(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID) {
+	var request DeleteMaintenanceItemRequestObject
+
+	request.ItemId = itemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMaintenanceItem(ctx, request.(DeleteMaintenanceItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMaintenanceItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteMaintenanceItemResponseObject); ok {
+		if err := validResponse.VisitDeleteMaintenanceItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateMaintenanceRecord operation middleware
+func (sh *strictHandler) CreateMaintenanceRecord(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID, params CreateMaintenanceRecordParams) {
+	var request CreateMaintenanceRecordRequestObject
+
+	request.ItemId = itemId
+	request.Params = params
+
+	var body CreateMaintenanceRecordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMaintenanceRecord(ctx, request.(CreateMaintenanceRecordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMaintenanceRecord")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateMaintenanceRecordResponseObject); ok {
+		if err := validResponse.VisitCreateMaintenanceRecordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListNoteTemplates operation middleware
+func (sh *strictHandler) ListNoteTemplates(w http.ResponseWriter, r *http.Request) {
+	var request ListNoteTemplatesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNoteTemplates(ctx, request.(ListNoteTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNoteTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListNoteTemplatesResponseObject); ok {
+		if err := validResponse.VisitListNoteTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateNoteTemplate operation middleware
+func (sh *strictHandler) CreateNoteTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateNoteTemplateRequestObject
+
+	var body CreateNoteTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateNoteTemplate(ctx, request.(CreateNoteTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateNoteTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateNoteTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateNoteTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteNoteTemplate operation middleware
+func (sh *strictHandler) DeleteNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteNoteTemplateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNoteTemplate(ctx, request.(DeleteNoteTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNoteTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteNoteTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteNoteTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNoteTemplate operation middleware
+func (sh *strictHandler) GetNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetNoteTemplateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNoteTemplate(ctx, request.(GetNoteTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNoteTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNoteTemplateResponseObject); ok {
+		if err := validResponse.VisitGetNoteTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateNoteTemplate operation middleware
+func (sh *strictHandler) UpdateNoteTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateNoteTemplateRequestObject
+
+	request.Id = id
+
+	var body UpdateNoteTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateNoteTemplate(ctx, request.(UpdateNoteTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateNoteTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateNoteTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateNoteTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOdometerReadings operation middleware
+func (sh *strictHandler) ListOdometerReadings(w http.ResponseWriter, r *http.Request, params ListOdometerReadingsParams) {
+	var request ListOdometerReadingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOdometerReadings(ctx, request.(ListOdometerReadingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOdometerReadings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOdometerReadingsResponseObject); ok {
+		if err := validResponse.VisitListOdometerReadingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOdometerReading operation middleware
+func (sh *strictHandler) CreateOdometerReading(w http.ResponseWriter, r *http.Request, params CreateOdometerReadingParams) {
+	var request CreateOdometerReadingRequestObject
+
+	request.Params = params
+
+	var body CreateOdometerReadingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOdometerReading(ctx, request.(CreateOdometerReadingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOdometerReading")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOdometerReadingResponseObject); ok {
+		if err := validResponse.VisitCreateOdometerReadingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOdometerReading operation middleware
+func (sh *strictHandler) DeleteOdometerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteOdometerReadingRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOdometerReading(ctx, request.(DeleteOdometerReadingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOdometerReading")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOdometerReadingResponseObject); ok {
+		if err := validResponse.VisitDeleteOdometerReadingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOdometerReading operation middleware
+func (sh *strictHandler) GetOdometerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetOdometerReadingParams) {
+	var request GetOdometerReadingRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOdometerReading(ctx, request.(GetOdometerReadingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOdometerReading")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOdometerReadingResponseObject); ok {
+		if err := validResponse.VisitGetOdometerReadingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizations operation middleware
+func (sh *strictHandler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	var request ListOrganizationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizations(ctx, request.(ListOrganizationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationsResponseObject); ok {
+		if err := validResponse.VisitListOrganizationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganization operation middleware
+func (sh *strictHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	var request CreateOrganizationRequestObject
+
+	var body CreateOrganizationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganization(ctx, request.(CreateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrganizationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOrganization operation middleware
+func (sh *strictHandler) DeleteOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	var request DeleteOrganizationRequestObject
+
+	request.OrgId = orgId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrganization(ctx, request.(DeleteOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOrganizationResponseObject); ok {
+		if err := validResponse.VisitDeleteOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOrganization operation middleware
+func (sh *strictHandler) GetOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	var request GetOrganizationRequestObject
+
+	request.OrgId = orgId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganization(ctx, request.(GetOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchOrganization operation middleware
+func (sh *strictHandler) PatchOrganization(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	var request PatchOrganizationRequestObject
+
+	request.OrgId = orgId
+
+	var body PatchOrganizationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchOrganization(ctx, request.(PatchOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchOrganizationResponseObject); ok {
+		if err := validResponse.VisitPatchOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizationMembers operation middleware
+func (sh *strictHandler) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	var request ListOrganizationMembersRequestObject
+
+	request.OrgId = orgId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizationMembers(ctx, request.(ListOrganizationMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizationMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationMembersResponseObject); ok {
+		if err := validResponse.VisitListOrganizationMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddOrganizationMember operation middleware
+func (sh *strictHandler) AddOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID) {
+	var request AddOrganizationMemberRequestObject
+
+	request.OrgId = orgId
+
+	var body AddOrganizationMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddOrganizationMember(ctx, request.(AddOrganizationMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddOrganizationMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitAddOrganizationMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveOrganizationMember operation middleware
+func (sh *strictHandler) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID, userId string) {
+	var request RemoveOrganizationMemberRequestObject
+
+	request.OrgId = orgId
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveOrganizationMember(ctx, request.(RemoveOrganizationMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveOrganizationMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveOrganizationMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchOrganizationMember operation middleware
+func (sh *strictHandler) PatchOrganizationMember(w http.ResponseWriter, r *http.Request, orgId openapi_types.UUID, userId string) {
+	var request PatchOrganizationMemberRequestObject
+
+	request.OrgId = orgId
+	request.UserId = userId
+
+	var body PatchOrganizationMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchOrganizationMember(ctx, request.(PatchOrganizationMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchOrganizationMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitPatchOrganizationMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPackingLists operation middleware
+func (sh *strictHandler) ListPackingLists(w http.ResponseWriter, r *http.Request, params ListPackingListsParams) {
+	var request ListPackingListsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPackingLists(ctx, request.(ListPackingListsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPackingLists")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPackingListsResponseObject); ok {
+		if err := validResponse.VisitListPackingListsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePackingList operation middleware
+func (sh *strictHandler) CreatePackingList(w http.ResponseWriter, r *http.Request) {
+	var request CreatePackingListRequestObject
+
+	var body CreatePackingListJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePackingList(ctx, request.(CreatePackingListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePackingList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePackingListResponseObject); ok {
+		if err := validResponse.VisitCreatePackingListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePackingList operation middleware
+func (sh *strictHandler) DeletePackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePackingListRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePackingList(ctx, request.(DeletePackingListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePackingList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePackingListResponseObject); ok {
+		if err := validResponse.VisitDeletePackingListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPackingList operation middleware
+func (sh *strictHandler) GetPackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPackingListRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPackingList(ctx, request.(GetPackingListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPackingList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPackingListResponseObject); ok {
+		if err := validResponse.VisitGetPackingListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePackingList operation middleware
+func (sh *strictHandler) UpdatePackingList(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdatePackingListRequestObject
+
+	request.Id = id
+
+	var body UpdatePackingListJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePackingList(ctx, request.(UpdatePackingListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePackingList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePackingListResponseObject); ok {
+		if err := validResponse.VisitUpdatePackingListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePackingItem operation middleware
+func (sh *strictHandler) CreatePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreatePackingItemRequestObject
+
+	request.Id = id
+
+	var body CreatePackingItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePackingItem(ctx, request.(CreatePackingItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePackingItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePackingItemResponseObject); ok {
+		if err := validResponse.VisitCreatePackingItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePackingItem operation middleware
+func (sh *strictHandler) DeletePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, itemId openapi_types.UUID) {
+	var request DeletePackingItemRequestObject
+
+	request.Id = id
+	request.ItemId = itemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePackingItem(ctx, request.(DeletePackingItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePackingItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePackingItemResponseObject); ok {
+		if err := validResponse.VisitDeletePackingItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePackingItem operation middleware
+func (sh *strictHandler) UpdatePackingItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, itemId openapi_types.UUID) {
+	var request UpdatePackingItemRequestObject
+
+	request.Id = id
+	request.ItemId = itemId
+
+	var body UpdatePackingItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePackingItem(ctx, request.(UpdatePackingItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePackingItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePackingItemResponseObject); ok {
+		if err := validResponse.VisitUpdatePackingItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPhotoThumbnail operation middleware
+func (sh *strictHandler) GetPhotoThumbnail(w http.ResponseWriter, r *http.Request, photoId openapi_types.UUID, params GetPhotoThumbnailParams) {
+	var request GetPhotoThumbnailRequestObject
+
+	request.PhotoId = photoId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPhotoThumbnail(ctx, request.(GetPhotoThumbnailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPhotoThumbnail")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPhotoThumbnailResponseObject); ok {
+		if err := validResponse.VisitGetPhotoThumbnailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPointsOfInterest operation middleware
+func (sh *strictHandler) ListPointsOfInterest(w http.ResponseWriter, r *http.Request, params ListPointsOfInterestParams) {
+	var request ListPointsOfInterestRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPointsOfInterest(ctx, request.(ListPointsOfInterestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPointsOfInterest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPointsOfInterestResponseObject); ok {
+		if err := validResponse.VisitListPointsOfInterestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePointOfInterest operation middleware
+func (sh *strictHandler) CreatePointOfInterest(w http.ResponseWriter, r *http.Request) {
+	var request CreatePointOfInterestRequestObject
+
+	var body CreatePointOfInterestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePointOfInterest(ctx, request.(CreatePointOfInterestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePointOfInterest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePointOfInterestResponseObject); ok {
+		if err := validResponse.VisitCreatePointOfInterestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePointOfInterest operation middleware
+func (sh *strictHandler) DeletePointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePointOfInterestRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePointOfInterest(ctx, request.(DeletePointOfInterestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePointOfInterest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePointOfInterestResponseObject); ok {
+		if err := validResponse.VisitDeletePointOfInterestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPointOfInterest operation middleware
+func (sh *strictHandler) GetPointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPointOfInterestRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPointOfInterest(ctx, request.(GetPointOfInterestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPointOfInterest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPointOfInterestResponseObject); ok {
+		if err := validResponse.VisitGetPointOfInterestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePointOfInterest operation middleware
+func (sh *strictHandler) UpdatePointOfInterest(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdatePointOfInterestRequestObject
+
+	request.Id = id
+
+	var body UpdatePointOfInterestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePointOfInterest(ctx, request.(UpdatePointOfInterestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePointOfInterest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePointOfInterestResponseObject); ok {
+		if err := validResponse.VisitUpdatePointOfInterestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPowerReadings operation middleware
+func (sh *strictHandler) ListPowerReadings(w http.ResponseWriter, r *http.Request, params ListPowerReadingsParams) {
+	var request ListPowerReadingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPowerReadings(ctx, request.(ListPowerReadingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPowerReadings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPowerReadingsResponseObject); ok {
+		if err := validResponse.VisitListPowerReadingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePowerReading operation middleware
+func (sh *strictHandler) CreatePowerReading(w http.ResponseWriter, r *http.Request) {
+	var request CreatePowerReadingRequestObject
+
+	var body CreatePowerReadingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePowerReading(ctx, request.(CreatePowerReadingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePowerReading")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePowerReadingResponseObject); ok {
+		if err := validResponse.VisitCreatePowerReadingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePowerReading operation middleware
+func (sh *strictHandler) DeletePowerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePowerReadingRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePowerReading(ctx, request.(DeletePowerReadingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePowerReading")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePowerReadingResponseObject); ok {
+		if err := validResponse.VisitDeletePowerReadingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPowerReading operation middleware
+func (sh *strictHandler) GetPowerReading(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPowerReadingRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPowerReading(ctx, request.(GetPowerReadingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPowerReading")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPowerReadingResponseObject); ok {
+		if err := validResponse.VisitGetPowerReadingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPropaneFills operation middleware
+func (sh *strictHandler) ListPropaneFills(w http.ResponseWriter, r *http.Request, params ListPropaneFillsParams) {
+	var request ListPropaneFillsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPropaneFills(ctx, request.(ListPropaneFillsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPropaneFills")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPropaneFillsResponseObject); ok {
+		if err := validResponse.VisitListPropaneFillsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePropaneFill operation middleware
+func (sh *strictHandler) CreatePropaneFill(w http.ResponseWriter, r *http.Request, params CreatePropaneFillParams) {
+	var request CreatePropaneFillRequestObject
+
+	request.Params = params
+
+	var body CreatePropaneFillJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePropaneFill(ctx, request.(CreatePropaneFillRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePropaneFill")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePropaneFillResponseObject); ok {
+		if err := validResponse.VisitCreatePropaneFillResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePropaneFill operation middleware
+func (sh *strictHandler) DeletePropaneFill(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePropaneFillRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePropaneFill(ctx, request.(DeletePropaneFillRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePropaneFill")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePropaneFillResponseObject); ok {
+		if err := validResponse.VisitDeletePropaneFillResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPropaneFill operation middleware
+func (sh *strictHandler) GetPropaneFill(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPropaneFillParams) {
+	var request GetPropaneFillRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPropaneFill(ctx, request.(GetPropaneFillRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPropaneFill")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPropaneFillResponseObject); ok {
+		if err := validResponse.VisitGetPropaneFillResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReadiness operation middleware
+func (sh *strictHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	var request GetReadinessRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReadiness(ctx, request.(GetReadinessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReadiness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReadinessResponseObject); ok {
+		if err := validResponse.VisitGetReadinessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUpcomingReservations operation middleware
+func (sh *strictHandler) ListUpcomingReservations(w http.ResponseWriter, r *http.Request, params ListUpcomingReservationsParams) {
+	var request ListUpcomingReservationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUpcomingReservations(ctx, request.(ListUpcomingReservationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUpcomingReservations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUpcomingReservationsResponseObject); ok {
+		if err := validResponse.VisitListUpcomingReservationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRigs operation middleware
+func (sh *strictHandler) ListRigs(w http.ResponseWriter, r *http.Request, params ListRigsParams) {
+	var request ListRigsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRigs(ctx, request.(ListRigsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRigs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRigsResponseObject); ok {
+		if err := validResponse.VisitListRigsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateRig operation middleware
+func (sh *strictHandler) CreateRig(w http.ResponseWriter, r *http.Request, params CreateRigParams) {
+	var request CreateRigRequestObject
+
+	request.Params = params
+
+	var body CreateRigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRig(ctx, request.(CreateRigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRigResponseObject); ok {
+		if err := validResponse.VisitCreateRigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRig operation middleware
+func (sh *strictHandler) DeleteRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID) {
+	var request DeleteRigRequestObject
+
+	request.RigId = rigId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRig(ctx, request.(DeleteRigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRigResponseObject); ok {
+		if err := validResponse.VisitDeleteRigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRig operation middleware
+func (sh *strictHandler) GetRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params GetRigParams) {
+	var request GetRigRequestObject
+
+	request.RigId = rigId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRig(ctx, request.(GetRigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRigResponseObject); ok {
+		if err := validResponse.VisitGetRigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRig operation middleware
+func (sh *strictHandler) UpdateRig(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params UpdateRigParams) {
+	var request UpdateRigRequestObject
+
+	request.RigId = rigId
+	request.Params = params
+
+	var body UpdateRigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRig(ctx, request.(UpdateRigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateRigResponseObject); ok {
+		if err := validResponse.VisitUpdateRigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRigMaintenance operation middleware
+func (sh *strictHandler) ListRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params ListRigMaintenanceParams) {
+	var request ListRigMaintenanceRequestObject
+
+	request.RigId = rigId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRigMaintenance(ctx, request.(ListRigMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRigMaintenance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRigMaintenanceResponseObject); ok {
+		if err := validResponse.VisitListRigMaintenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateRigMaintenance operation middleware
+func (sh *strictHandler) CreateRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, params CreateRigMaintenanceParams) {
+	var request CreateRigMaintenanceRequestObject
+
+	request.RigId = rigId
+	request.Params = params
+
+	var body CreateRigMaintenanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRigMaintenance(ctx, request.(CreateRigMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRigMaintenance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRigMaintenanceResponseObject); ok {
+		if err := validResponse.VisitCreateRigMaintenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRigMaintenance operation middleware
+func (sh *strictHandler) DeleteRigMaintenance(w http.ResponseWriter, r *http.Request, rigId openapi_types.UUID, entryId openapi_types.UUID) {
+	var request DeleteRigMaintenanceRequestObject
+
+	request.RigId = rigId
+	request.EntryId = entryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRigMaintenance(ctx, request.(DeleteRigMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRigMaintenance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRigMaintenanceResponseObject); ok {
+		if err := validResponse.VisitDeleteRigMaintenanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSharedTrip operation middleware
+func (sh *strictHandler) GetSharedTrip(w http.ResponseWriter, r *http.Request, token string, params GetSharedTripParams) {
+	var request GetSharedTripRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedTrip(ctx, request.(GetSharedTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedTrip")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSharedTripResponseObject); ok {
+		if err := validResponse.VisitGetSharedTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSharedTripEmbed operation middleware
+func (sh *strictHandler) GetSharedTripEmbed(w http.ResponseWriter, r *http.Request, token string, params GetSharedTripEmbedParams) {
+	var request GetSharedTripEmbedRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedTripEmbed(ctx, request.(GetSharedTripEmbedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedTripEmbed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSharedTripEmbedResponseObject); ok {
+		if err := validResponse.VisitGetSharedTripEmbedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDashboard operation middleware
+func (sh *strictHandler) GetDashboard(w http.ResponseWriter, r *http.Request, params GetDashboardParams) {
+	var request GetDashboardRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDashboard(ctx, request.(GetDashboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDashboard")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDashboardResponseObject); ok {
+		if err := validResponse.VisitGetDashboardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStopHeatmap operation middleware
+func (sh *strictHandler) GetStopHeatmap(w http.ResponseWriter, r *http.Request, params GetStopHeatmapParams) {
+	var request GetStopHeatmapRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStopHeatmap(ctx, request.(GetStopHeatmapRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStopHeatmap")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStopHeatmapResponseObject); ok {
+		if err := validResponse.VisitGetStopHeatmapResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Create a stop on a trip
-// (POST /trips/{tripId}/stops)
-func (_ Unimplemented) CreateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetPropaneStats operation middleware
+func (sh *strictHandler) GetPropaneStats(w http.ResponseWriter, r *http.Request, params GetPropaneStatsParams) {
+	var request GetPropaneStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPropaneStats(ctx, request.(GetPropaneStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPropaneStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPropaneStatsResponseObject); ok {
+		if err := validResponse.VisitGetPropaneStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Delete a stop
-// (DELETE /trips/{tripId}/stops/{stopId})
-func (_ Unimplemented) DeleteStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetStatesVisited operation middleware
+func (sh *strictHandler) GetStatesVisited(w http.ResponseWriter, r *http.Request, params GetStatesVisitedParams) {
+	var request GetStatesVisitedRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatesVisited(ctx, request.(GetStatesVisitedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatesVisited")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatesVisitedResponseObject); ok {
+		if err := validResponse.VisitGetStatesVisitedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Get a stop by ID
-// (GET /trips/{tripId}/stops/{stopId})
-func (_ Unimplemented) GetStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetTagStats operation middleware
+func (sh *strictHandler) GetTagStats(w http.ResponseWriter, r *http.Request) {
+	var request GetTagStatsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTagStats(ctx, request.(GetTagStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTagStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTagStatsResponseObject); ok {
+		if err := validResponse.VisitGetTagStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListStopClusters operation middleware
+func (sh *strictHandler) ListStopClusters(w http.ResponseWriter, r *http.Request, params ListStopClustersParams) {
+	var request ListStopClustersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStopClusters(ctx, request.(ListStopClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStopClusters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStopClustersResponseObject); ok {
+		if err := validResponse.VisitListStopClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListNearbyStops operation middleware
+func (sh *strictHandler) ListNearbyStops(w http.ResponseWriter, r *http.Request, params ListNearbyStopsParams) {
+	var request ListNearbyStopsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNearbyStops(ctx, request.(ListNearbyStopsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNearbyStops")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListNearbyStopsResponseObject); ok {
+		if err := validResponse.VisitListNearbyStopsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStopOccupancy operation middleware
+func (sh *strictHandler) GetStopOccupancy(w http.ResponseWriter, r *http.Request, params GetStopOccupancyParams) {
+	var request GetStopOccupancyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStopOccupancy(ctx, request.(GetStopOccupancyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStopOccupancy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStopOccupancyResponseObject); ok {
+		if err := validResponse.VisitGetStopOccupancyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTags operation middleware
+func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams) {
+	var request ListTagsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
+		if err := validResponse.VisitListTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTag operation middleware
+func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var request CreateTagRequestObject
+
+	var body CreateTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
+		if err := validResponse.VisitCreateTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTag operation middleware
+func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, slug string) {
+	var request DeleteTagRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchTag operation middleware
+func (sh *strictHandler) PatchTag(w http.ResponseWriter, r *http.Request, slug string) {
+	var request PatchTagRequestObject
+
+	request.Slug = slug
+
+	var body PatchTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTag(ctx, request.(PatchTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchTagResponseObject); ok {
+		if err := validResponse.VisitPatchTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Update a stop
-// (PUT /trips/{tripId}/stops/{stopId})
-func (_ Unimplemented) UpdateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// MergeTag operation middleware
+func (sh *strictHandler) MergeTag(w http.ResponseWriter, r *http.Request, slug string) {
+	var request MergeTagRequestObject
+
+	request.Slug = slug
+
+	var body MergeTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergeTag(ctx, request.(MergeTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergeTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-// List tags on a stop
-// (GET /trips/{tripId}/stops/{stopId}/tags)
-func (_ Unimplemented) ListTagsByStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergeTagResponseObject); ok {
+		if err := validResponse.VisitMergeTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Add a tag to a stop
-// (POST /trips/{tripId}/stops/{stopId}/tags)
-func (_ Unimplemented) AddTagToStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ClearTagParent operation middleware
+func (sh *strictHandler) ClearTagParent(w http.ResponseWriter, r *http.Request, slug string) {
+	var request ClearTagParentRequestObject
 
-// Remove a tag from a stop
-// (DELETE /trips/{tripId}/stops/{stopId}/tags/{slug})
-func (_ Unimplemented) RemoveTagFromStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, slug string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	request.Slug = slug
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearTagParent(ctx, request.(ClearTagParentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearTagParent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClearTagParentResponseObject); ok {
+		if err := validResponse.VisitClearTagParentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// SetTagParent operation middleware
+func (sh *strictHandler) SetTagParent(w http.ResponseWriter, r *http.Request, slug string) {
+	var request SetTagParentRequestObject
 
-// GetExport operation middleware
-func (siw *ServerInterfaceWrapper) GetExport(w http.ResponseWriter, r *http.Request) {
+	request.Slug = slug
 
-	var err error
+	var body SetTagParentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetExportParams
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTagParent(ctx, request.(SetTagParentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTagParent")
+	}
 
-	// ------------- Optional query parameter "format" -------------
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTagParentResponseObject); ok {
+		if err := validResponse.VisitSetTagParentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetExport(w, r, params)
-	}))
+// ListTrash operation middleware
+func (sh *strictHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	var request ListTrashRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrash(ctx, request.(ListTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrash")
 	}
 
-	handler.ServeHTTP(w, r)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTrashResponseObject); ok {
+		if err := validResponse.VisitListTrashResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// RestoreTrashItem operation middleware
+func (sh *strictHandler) RestoreTrashItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RestoreTrashItemRequestObject
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
+	request.Id = id
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreTrashItem(ctx, request.(RestoreTrashItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreTrashItem")
 	}
 
-	handler.ServeHTTP(w, r)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreTrashItemResponseObject); ok {
+		if err := validResponse.VisitRestoreTrashItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListTags operation middleware
-func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+// ListTrips operation middleware
+func (sh *strictHandler) ListTrips(w http.ResponseWriter, r *http.Request, params ListTripsParams) {
+	var request ListTripsRequestObject
 
-	var err error
+	request.Params = params
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTagsParams
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrips(ctx, request.(ListTripsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrips")
+	}
 
-	// ------------- Optional query parameter "q" -------------
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripsResponseObject); ok {
+		if err := validResponse.VisitListTripsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	// ------------- Optional query parameter "page" -------------
+// CreateTrip operation middleware
+func (sh *strictHandler) CreateTrip(w http.ResponseWriter, r *http.Request, params CreateTripParams) {
+	var request CreateTripRequestObject
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+	request.Params = params
+
+	var body CreateTripJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	// ------------- Optional query parameter "limit" -------------
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTrip(ctx, request.(CreateTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTrip")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTripResponseObject); ok {
+		if err := validResponse.VisitCreateTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTags(w, r, params)
-	}))
+// GetCurrentTrip operation middleware
+func (sh *strictHandler) GetCurrentTrip(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentTripRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentTrip(ctx, request.(GetCurrentTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentTrip")
 	}
 
-	handler.ServeHTTP(w, r)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentTripResponseObject); ok {
+		if err := validResponse.VisitGetCurrentTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateTag operation middleware
-func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+// DeleteTrip operation middleware
+func (sh *strictHandler) DeleteTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteTripRequestObject
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTag(w, r)
-	}))
+	request.Id = id
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTrip(ctx, request.(DeleteTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTrip")
 	}
 
-	handler.ServeHTTP(w, r)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTripResponseObject); ok {
+		if err := validResponse.VisitDeleteTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// DeleteTag operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+// GetTrip operation middleware
+func (sh *strictHandler) GetTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripParams) {
+	var request GetTripRequestObject
 
-	var err error
+	request.Id = id
+	request.Params = params
 
-	// ------------- Path parameter "slug" -------------
-	var slug string
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTrip(ctx, request.(GetTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTrip")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripResponseObject); ok {
+		if err := validResponse.VisitGetTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateTrip operation middleware
+func (sh *strictHandler) UpdateTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UpdateTripParams) {
+	var request UpdateTripRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateTripJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTag(w, r, slug)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTrip(ctx, request.(UpdateTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTrip")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// PatchTag operation middleware
-func (siw *ServerInterfaceWrapper) PatchTag(w http.ResponseWriter, r *http.Request) {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTripResponseObject); ok {
+		if err := validResponse.VisitUpdateTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	var err error
+// CloneTrip operation middleware
+func (sh *strictHandler) CloneTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CloneTripParams) {
+	var request CloneTripRequestObject
 
-	// ------------- Path parameter "slug" -------------
-	var slug string
+	request.Id = id
+	request.Params = params
 
-	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+	var body CloneTripJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchTag(w, r, slug)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CloneTrip(ctx, request.(CloneTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloneTrip")
 	}
 
-	handler.ServeHTTP(w, r)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CloneTripResponseObject); ok {
+		if err := validResponse.VisitCloneTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListTrips operation middleware
-func (siw *ServerInterfaceWrapper) ListTrips(w http.ResponseWriter, r *http.Request) {
+// ListTripGaps operation middleware
+func (sh *strictHandler) ListTripGaps(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListTripGapsRequestObject
 
-	var err error
+	request.Id = id
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTripsParams
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripGaps(ctx, request.(ListTripGapsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripGaps")
+	}
 
-	// ------------- Optional query parameter "page" -------------
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripGapsResponseObject); ok {
+		if err := validResponse.VisitListTripGapsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// GetTripGeoJSON operation middleware
+func (sh *strictHandler) GetTripGeoJSON(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTripGeoJSONRequestObject
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripGeoJSON(ctx, request.(GetTripGeoJSONRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripGeoJSON")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTrips(w, r, params)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripGeoJSONResponseObject); ok {
+		if err := validResponse.VisitGetTripGeoJSONResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// CreateTrip operation middleware
-func (siw *ServerInterfaceWrapper) CreateTrip(w http.ResponseWriter, r *http.Request) {
+// ListTripHistory operation middleware
+func (sh *strictHandler) ListTripHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListTripHistoryRequestObject
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTrip(w, r)
-	}))
+	request.Id = id
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripHistory(ctx, request.(ListTripHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripHistory")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// DeleteTrip operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTrip(w http.ResponseWriter, r *http.Request) {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripHistoryResponseObject); ok {
+		if err := validResponse.VisitListTripHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	var err error
+// RevertTrip operation middleware
+func (sh *strictHandler) RevertTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, revision int, params RevertTripParams) {
+	var request RevertTripRequestObject
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	request.Id = id
+	request.Revision = revision
+	request.Params = params
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevertTrip(ctx, request.(RevertTripRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevertTrip")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTrip(w, r, id)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevertTripResponseObject); ok {
+		if err := validResponse.VisitRevertTripResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// GetTrip operation middleware
-func (siw *ServerInterfaceWrapper) GetTrip(w http.ResponseWriter, r *http.Request) {
+// ImportTripGPX operation middleware
+func (sh *strictHandler) ImportTripGPX(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ImportTripGPXRequestObject
 
-	var err error
+	request.Id = id
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	request.Body = r.Body
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportTripGPX(ctx, request.(ImportTripGPXRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportTripGPX")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTrip(w, r, id)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportTripGPXResponseObject); ok {
+		if err := validResponse.VisitImportTripGPXResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// UpdateTrip operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTrip(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// GetTripMap operation middleware
+func (sh *strictHandler) GetTripMap(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripMapParams) {
+	var request GetTripMapRequestObject
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	request.Id = id
+	request.Params = params
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripMap(ctx, request.(GetTripMapRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripMap")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTrip(w, r, id)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripMapResponseObject); ok {
+		if err := validResponse.VisitGetTripMapResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// ListStops operation middleware
-func (siw *ServerInterfaceWrapper) ListStops(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// GetTripMileage operation middleware
+func (sh *strictHandler) GetTripMileage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripMileageParams) {
+	var request GetTripMileageRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.Id = id
+	request.Params = params
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripMileage(ctx, request.(GetTripMileageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripMileage")
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListStopsParams
-
-	// ------------- Optional query parameter "page" -------------
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripMileageResponseObject); ok {
+		if err := validResponse.VisitGetTripMileageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// GetTripPower operation middleware
+func (sh *strictHandler) GetTripPower(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTripPowerRequestObject
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripPower(ctx, request.(GetTripPowerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripPower")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListStops(w, r, tripId, params)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripPowerResponseObject); ok {
+		if err := validResponse.VisitGetTripPowerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// CreateStop operation middleware
-func (siw *ServerInterfaceWrapper) CreateStop(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// ListTripShares operation middleware
+func (sh *strictHandler) ListTripShares(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListTripSharesRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.Id = id
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripShares(ctx, request.(ListTripSharesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripShares")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateStop(w, r, tripId)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripSharesResponseObject); ok {
+		if err := validResponse.VisitListTripSharesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// DeleteStop operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStop(w http.ResponseWriter, r *http.Request) {
+// CreateTripShare operation middleware
+func (sh *strictHandler) CreateTripShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreateTripShareRequestObject
 
-	var err error
+	request.Id = id
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	var body CreateTripShareJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		if !errors.Is(err, io.EOF) {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+	} else {
+		request.Body = &body
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTripShare(ctx, request.(CreateTripShareRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTripShare")
 	}
 
-	// ------------- Path parameter "stopId" -------------
-	var stopId openapi_types.UUID
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTripShareResponseObject); ok {
+		if err := validResponse.VisitCreateTripShareResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStop(w, r, tripId, stopId)
-	}))
+// RevokeTripShare operation middleware
+func (sh *strictHandler) RevokeTripShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, shareId openapi_types.UUID) {
+	var request RevokeTripShareRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	request.Id = id
+	request.ShareId = shareId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeTripShare(ctx, request.(RevokeTripShareRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeTripShare")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// GetStop operation middleware
-func (siw *ServerInterfaceWrapper) GetStop(w http.ResponseWriter, r *http.Request) {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeTripShareResponseObject); ok {
+		if err := validResponse.VisitRevokeTripShareResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	var err error
+// GetTripStats operation middleware
+func (sh *strictHandler) GetTripStats(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetTripStatsParams) {
+	var request GetTripStatsRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.Id = id
+	request.Params = params
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripStats(ctx, request.(GetTripStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripStats")
 	}
 
-	// ------------- Path parameter "stopId" -------------
-	var stopId openapi_types.UUID
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripStatsResponseObject); ok {
+		if err := validResponse.VisitGetTripStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStop(w, r, tripId, stopId)
-	}))
+// ListTripBorderCrossings operation middleware
+func (sh *strictHandler) ListTripBorderCrossings(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request ListTripBorderCrossingsRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	request.TripId = tripId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripBorderCrossings(ctx, request.(ListTripBorderCrossingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripBorderCrossings")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// UpdateStop operation middleware
-func (siw *ServerInterfaceWrapper) UpdateStop(w http.ResponseWriter, r *http.Request) {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripBorderCrossingsResponseObject); ok {
+		if err := validResponse.VisitListTripBorderCrossingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	var err error
+// CreateTripBorderCrossing operation middleware
+func (sh *strictHandler) CreateTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request CreateTripBorderCrossingRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.TripId = tripId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+	var body CreateTripBorderCrossingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	// ------------- Path parameter "stopId" -------------
-	var stopId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTripBorderCrossing(ctx, request.(CreateTripBorderCrossingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTripBorderCrossing")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateStop(w, r, tripId, stopId)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTripBorderCrossingResponseObject); ok {
+		if err := validResponse.VisitCreateTripBorderCrossingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// ListTagsByStop operation middleware
-func (siw *ServerInterfaceWrapper) ListTagsByStop(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// DeleteTripBorderCrossing operation middleware
+func (sh *strictHandler) DeleteTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID) {
+	var request DeleteTripBorderCrossingRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.TripId = tripId
+	request.CrossingId = crossingId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTripBorderCrossing(ctx, request.(DeleteTripBorderCrossingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTripBorderCrossing")
 	}
 
-	// ------------- Path parameter "stopId" -------------
-	var stopId openapi_types.UUID
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTripBorderCrossingResponseObject); ok {
+		if err := validResponse.VisitDeleteTripBorderCrossingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTagsByStop(w, r, tripId, stopId)
-	}))
+// GetTripBorderCrossing operation middleware
+func (sh *strictHandler) GetTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID) {
+	var request GetTripBorderCrossingRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	request.TripId = tripId
+	request.CrossingId = crossingId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripBorderCrossing(ctx, request.(GetTripBorderCrossingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripBorderCrossing")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// AddTagToStop operation middleware
-func (siw *ServerInterfaceWrapper) AddTagToStop(w http.ResponseWriter, r *http.Request) {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripBorderCrossingResponseObject); ok {
+		if err := validResponse.VisitGetTripBorderCrossingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	var err error
+// UpdateTripBorderCrossing operation middleware
+func (sh *strictHandler) UpdateTripBorderCrossing(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, crossingId openapi_types.UUID) {
+	var request UpdateTripBorderCrossingRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.TripId = tripId
+	request.CrossingId = crossingId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+	var body UpdateTripBorderCrossingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	// ------------- Path parameter "stopId" -------------
-	var stopId openapi_types.UUID
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTripBorderCrossing(ctx, request.(UpdateTripBorderCrossingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTripBorderCrossing")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTripBorderCrossingResponseObject); ok {
+		if err := validResponse.VisitUpdateTripBorderCrossingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTagToStop(w, r, tripId, stopId)
-	}))
+// GetTripBudgetReport operation middleware
+func (sh *strictHandler) GetTripBudgetReport(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request GetTripBudgetReportRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	request.TripId = tripId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripBudgetReport(ctx, request.(GetTripBudgetReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripBudgetReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripBudgetReportResponseObject); ok {
+		if err := validResponse.VisitGetTripBudgetReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r)
 }
 
-// RemoveTagFromStop operation middleware
-func (siw *ServerInterfaceWrapper) RemoveTagFromStop(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// CheckTripDrives operation middleware
+func (sh *strictHandler) CheckTripDrives(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params CheckTripDrivesParams) {
+	var request CheckTripDrivesRequestObject
 
-	// ------------- Path parameter "tripId" -------------
-	var tripId openapi_types.UUID
+	request.TripId = tripId
+	request.Params = params
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tripId", chi.URLParam(r, "tripId"), &tripId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tripId", Err: err})
+	var body CheckTripDrivesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	// ------------- Path parameter "stopId" -------------
-	var stopId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "stopId", chi.URLParam(r, "stopId"), &stopId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stopId", Err: err})
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckTripDrives(ctx, request.(CheckTripDrivesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckTripDrives")
 	}
 
-	// ------------- Path parameter "slug" -------------
-	var slug string
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckTripDrivesResponseObject); ok {
+		if err := validResponse.VisitCheckTripDrivesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveTagFromStop(w, r, tripId, stopId, slug)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ListTripExpenses operation middleware
+func (sh *strictHandler) ListTripExpenses(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request ListTripExpensesRequestObject
 
-	handler.ServeHTTP(w, r)
-}
+	request.TripId = tripId
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripExpenses(ctx, request.(ListTripExpensesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripExpenses")
+	}
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripExpensesResponseObject); ok {
+		if err := validResponse.VisitListTripExpensesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+// DeleteTripExpense operation middleware
+func (sh *strictHandler) DeleteTripExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, expenseId openapi_types.UUID) {
+	var request DeleteTripExpenseRequestObject
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	request.TripId = tripId
+	request.ExpenseId = expenseId
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTripExpense(ctx, request.(DeleteTripExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTripExpense")
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTripExpenseResponseObject); ok {
+		if err := validResponse.VisitDeleteTripExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// SplitTripExpense operation middleware
+func (sh *strictHandler) SplitTripExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, expenseId openapi_types.UUID) {
+	var request SplitTripExpenseRequestObject
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	request.TripId = tripId
+	request.ExpenseId = expenseId
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	var body SplitTripExpenseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SplitTripExpense(ctx, request.(SplitTripExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SplitTripExpense")
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SplitTripExpenseResponseObject); ok {
+		if err := validResponse.VisitSplitTripExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// ListTripJournal operation middleware
+func (sh *strictHandler) ListTripJournal(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListTripJournalParams) {
+	var request ListTripJournalRequestObject
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	request.TripId = tripId
+	request.Params = params
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripJournal(ctx, request.(ListTripJournalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripJournal")
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripJournalResponseObject); ok {
+		if err := validResponse.VisitListTripJournalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+// CreateTripJournalEntry operation middleware
+func (sh *strictHandler) CreateTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request CreateTripJournalEntryRequestObject
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	request.TripId = tripId
 
-	if r == nil {
-		r = chi.NewRouter()
+	var body CreateTripJournalEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTripJournalEntry(ctx, request.(CreateTripJournalEntryRequestObject))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTripJournalEntry")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/export", wrapper.GetExport)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/healthz", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/tags", wrapper.ListTags)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/tags", wrapper.CreateTag)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/tags/{slug}", wrapper.DeleteTag)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/tags/{slug}", wrapper.PatchTag)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/trips", wrapper.ListTrips)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/trips", wrapper.CreateTrip)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/trips/{id}", wrapper.DeleteTrip)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/trips/{id}", wrapper.GetTrip)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/trips/{id}", wrapper.UpdateTrip)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/trips/{tripId}/stops", wrapper.ListStops)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/trips/{tripId}/stops", wrapper.CreateStop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}", wrapper.DeleteStop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}", wrapper.GetStop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/trips/{tripId}/stops/{stopId}", wrapper.UpdateStop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tags", wrapper.ListTagsByStop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tags", wrapper.AddTagToStop)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/trips/{tripId}/stops/{stopId}/tags/{slug}", wrapper.RemoveTagFromStop)
-	})
+	response, err := handler(r.Context(), w, r, request)
 
-	return r
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTripJournalEntryResponseObject); ok {
+		if err := validResponse.VisitCreateTripJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetExportRequestObject struct {
-	Params GetExportParams
-}
+// DeleteTripJournalEntry operation middleware
+func (sh *strictHandler) DeleteTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID) {
+	var request DeleteTripJournalEntryRequestObject
 
-type GetExportResponseObject interface {
-	VisitGetExportResponse(w http.ResponseWriter) error
-}
+	request.TripId = tripId
+	request.EntryId = entryId
 
-type GetExport200JSONResponse []ExportRow
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTripJournalEntry(ctx, request.(DeleteTripJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTripJournalEntry")
+	}
 
-func (response GetExport200JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTripJournalEntryResponseObject); ok {
+		if err := validResponse.VisitDeleteTripJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetExport200TextcsvResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+// GetTripJournalEntry operation middleware
+func (sh *strictHandler) GetTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID) {
+	var request GetTripJournalEntryRequestObject
 
-func (response GetExport200TextcsvResponse) VisitGetExportResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/csv")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	request.TripId = tripId
+	request.EntryId = entryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripJournalEntry(ctx, request.(GetTripJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripJournalEntry")
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripJournalEntryResponseObject); ok {
+		if err := validResponse.VisitGetTripJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
 }
 
-type GetHealthRequestObject struct {
-}
+// UpdateTripJournalEntry operation middleware
+func (sh *strictHandler) UpdateTripJournalEntry(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, entryId openapi_types.UUID) {
+	var request UpdateTripJournalEntryRequestObject
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+	request.TripId = tripId
+	request.EntryId = entryId
 
-type GetHealth200JSONResponse HealthResponse
+	var body UpdateTripJournalEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTripJournalEntry(ctx, request.(UpdateTripJournalEntryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTripJournalEntry")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListTagsRequestObject struct {
-	Params ListTagsParams
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTripJournalEntryResponseObject); ok {
+		if err := validResponse.VisitUpdateTripJournalEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListTagsResponseObject interface {
-	VisitListTagsResponse(w http.ResponseWriter) error
-}
+// ListTripRouteLegs operation middleware
+func (sh *strictHandler) ListTripRouteLegs(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListTripRouteLegsParams) {
+	var request ListTripRouteLegsRequestObject
 
-type ListTags200JSONResponse TagList
+	request.TripId = tripId
+	request.Params = params
 
-func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripRouteLegs(ctx, request.(ListTripRouteLegsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripRouteLegs")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateTagRequestObject struct {
-	Body *CreateTagJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripRouteLegsResponseObject); ok {
+		if err := validResponse.VisitListTripRouteLegsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTagResponseObject interface {
-	VisitCreateTagResponse(w http.ResponseWriter) error
-}
+// UpdateTripRouteLeg operation middleware
+func (sh *strictHandler) UpdateTripRouteLeg(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params UpdateTripRouteLegParams) {
+	var request UpdateTripRouteLegRequestObject
 
-type CreateTag201JSONResponse Tag
+	request.TripId = tripId
+	request.LegId = legId
+	request.Params = params
 
-func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var body UpdateTripRouteLegJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTripRouteLeg(ctx, request.(UpdateTripRouteLegRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTripRouteLeg")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTripRouteLegResponseObject); ok {
+		if err := validResponse.VisitUpdateTripRouteLegResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTag422JSONResponse ErrorResponse
+// GetTripRouteLegElevation operation middleware
+func (sh *strictHandler) GetTripRouteLegElevation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params GetTripRouteLegElevationParams) {
+	var request GetTripRouteLegElevationRequestObject
 
-func (response CreateTag422JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	request.TripId = tripId
+	request.LegId = legId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripRouteLegElevation(ctx, request.(GetTripRouteLegElevationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripRouteLegElevation")
+	}
 
-type DeleteTagRequestObject struct {
-	Slug string `json:"slug"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteTagResponseObject interface {
-	VisitDeleteTagResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripRouteLegElevationResponseObject); ok {
+		if err := validResponse.VisitGetTripRouteLegElevationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTag204Response struct {
-}
+// GetTripRouteLegTrack operation middleware
+func (sh *strictHandler) GetTripRouteLegTrack(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID) {
+	var request GetTripRouteLegTrackRequestObject
 
-func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	request.TripId = tripId
+	request.LegId = legId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripRouteLegTrack(ctx, request.(GetTripRouteLegTrackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripRouteLegTrack")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripRouteLegTrackResponseObject); ok {
+		if err := validResponse.VisitGetTripRouteLegTrackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTag404JSONResponse ErrorResponse
+// UploadTripRouteLegTrack operation middleware
+func (sh *strictHandler) UploadTripRouteLegTrack(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, legId openapi_types.UUID, params UploadTripRouteLegTrackParams) {
+	var request UploadTripRouteLegTrackRequestObject
 
-func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.TripId = tripId
+	request.LegId = legId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadTripRouteLegTrack(ctx, request.(UploadTripRouteLegTrackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadTripRouteLegTrack")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadTripRouteLegTrackResponseObject); ok {
+		if err := validResponse.VisitUploadTripRouteLegTrackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PatchTagRequestObject struct {
-	Slug string `json:"slug"`
-	Body *PatchTagJSONRequestBody
+// ListTripMembers operation middleware
+func (sh *strictHandler) ListTripMembers(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request ListTripMembersRequestObject
+
+	request.TripId = tripId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripMembers(ctx, request.(ListTripMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripMembersResponseObject); ok {
+		if err := validResponse.VisitListTripMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PatchTagResponseObject interface {
-	VisitPatchTagResponse(w http.ResponseWriter) error
+// InviteTripMember operation middleware
+func (sh *strictHandler) InviteTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request InviteTripMemberRequestObject
+
+	request.TripId = tripId
+
+	var body InviteTripMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InviteTripMember(ctx, request.(InviteTripMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InviteTripMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InviteTripMemberResponseObject); ok {
+		if err := validResponse.VisitInviteTripMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PatchTag200JSONResponse Tag
+// RemoveTripMember operation middleware
+func (sh *strictHandler) RemoveTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, userId openapi_types.UUID) {
+	var request RemoveTripMemberRequestObject
 
-func (response PatchTag200JSONResponse) VisitPatchTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.TripId = tripId
+	request.UserId = userId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveTripMember(ctx, request.(RemoveTripMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveTripMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveTripMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveTripMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PatchTag404JSONResponse ErrorResponse
+// PatchTripMember operation middleware
+func (sh *strictHandler) PatchTripMember(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, userId openapi_types.UUID) {
+	var request PatchTripMemberRequestObject
 
-func (response PatchTag404JSONResponse) VisitPatchTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.TripId = tripId
+	request.UserId = userId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PatchTripMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PatchTag422JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTripMember(ctx, request.(PatchTripMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTripMember")
+	}
 
-func (response PatchTag422JSONResponse) VisitPatchTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchTripMemberResponseObject); ok {
+		if err := validResponse.VisitPatchTripMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListTripsRequestObject struct {
-	Params ListTripsParams
-}
+// QuickLogExpense operation middleware
+func (sh *strictHandler) QuickLogExpense(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request QuickLogExpenseRequestObject
 
-type ListTripsResponseObject interface {
-	VisitListTripsResponse(w http.ResponseWriter) error
-}
+	request.TripId = tripId
 
-type ListTrips200JSONResponse TripList
+	var body QuickLogExpenseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response ListTrips200JSONResponse) VisitListTripsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QuickLogExpense(ctx, request.(QuickLogExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuickLogExpense")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateTripRequestObject struct {
-	Body *CreateTripJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QuickLogExpenseResponseObject); ok {
+		if err := validResponse.VisitQuickLogExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTripResponseObject interface {
-	VisitCreateTripResponse(w http.ResponseWriter) error
-}
+// GetTripSettlement operation middleware
+func (sh *strictHandler) GetTripSettlement(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request GetTripSettlementRequestObject
 
-type CreateTrip201JSONResponse Trip
+	request.TripId = tripId
 
-func (response CreateTrip201JSONResponse) VisitCreateTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTripSettlement(ctx, request.(GetTripSettlementRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTripSettlement")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTripSettlementResponseObject); ok {
+		if err := validResponse.VisitGetTripSettlementResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateTrip422JSONResponse ErrorResponse
+// ListTripStopSuggestions operation middleware
+func (sh *strictHandler) ListTripStopSuggestions(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request ListTripStopSuggestionsRequestObject
 
-func (response CreateTrip422JSONResponse) VisitCreateTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	request.TripId = tripId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTripStopSuggestions(ctx, request.(ListTripStopSuggestionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTripStopSuggestions")
+	}
 
-type DeleteTripRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteTripResponseObject interface {
-	VisitDeleteTripResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTripStopSuggestionsResponseObject); ok {
+		if err := validResponse.VisitListTripStopSuggestionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteTrip204Response struct {
-}
+// DismissTripStopSuggestion operation middleware
+func (sh *strictHandler) DismissTripStopSuggestion(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, suggestionId openapi_types.UUID) {
+	var request DismissTripStopSuggestionRequestObject
 
-func (response DeleteTrip204Response) VisitDeleteTripResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.TripId = tripId
+	request.SuggestionId = suggestionId
 
-type DeleteTrip404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DismissTripStopSuggestion(ctx, request.(DismissTripStopSuggestionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DismissTripStopSuggestion")
+	}
 
-func (response DeleteTrip404JSONResponse) VisitDeleteTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DismissTripStopSuggestionResponseObject); ok {
+		if err := validResponse.VisitDismissTripStopSuggestionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTripRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// AcceptTripStopSuggestion operation middleware
+func (sh *strictHandler) AcceptTripStopSuggestion(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, suggestionId openapi_types.UUID) {
+	var request AcceptTripStopSuggestionRequestObject
 
-type GetTripResponseObject interface {
-	VisitGetTripResponse(w http.ResponseWriter) error
-}
+	request.TripId = tripId
+	request.SuggestionId = suggestionId
 
-type GetTrip200JSONResponse Trip
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptTripStopSuggestion(ctx, request.(AcceptTripStopSuggestionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptTripStopSuggestion")
+	}
 
-func (response GetTrip200JSONResponse) VisitGetTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptTripStopSuggestionResponseObject); ok {
+		if err := validResponse.VisitAcceptTripStopSuggestionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTrip404JSONResponse ErrorResponse
+// ListStops operation middleware
+func (sh *strictHandler) ListStops(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListStopsParams) {
+	var request ListStopsRequestObject
 
-func (response GetTrip404JSONResponse) VisitGetTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.TripId = tripId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStops(ctx, request.(ListStopsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStops")
+	}
 
-type UpdateTripRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateTripJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdateTripResponseObject interface {
-	VisitUpdateTripResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStopsResponseObject); ok {
+		if err := validResponse.VisitListStopsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateTrip200JSONResponse Trip
+// CreateStop operation middleware
+func (sh *strictHandler) CreateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
+	var request CreateStopRequestObject
 
-func (response UpdateTrip200JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.TripId = tripId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateStopJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateTrip404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateStop(ctx, request.(CreateStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateStop")
+	}
 
-func (response UpdateTrip404JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateStopResponseObject); ok {
+		if err := validResponse.VisitCreateStopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateTrip422JSONResponse ErrorResponse
+// DeleteStop operation middleware
+func (sh *strictHandler) DeleteStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request DeleteStopRequestObject
+
+	request.TripId = tripId
+	request.StopId = stopId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteStop(ctx, request.(DeleteStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteStop")
+	}
 
-func (response UpdateTrip422JSONResponse) VisitUpdateTripResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteStopResponseObject); ok {
+		if err := validResponse.VisitDeleteStopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListStopsRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	Params ListStopsParams
-}
+// GetStop operation middleware
+func (sh *strictHandler) GetStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request GetStopRequestObject
 
-type ListStopsResponseObject interface {
-	VisitListStopsResponse(w http.ResponseWriter) error
-}
+	request.TripId = tripId
+	request.StopId = stopId
 
-type ListStops200JSONResponse StopList
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStop(ctx, request.(GetStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStop")
+	}
 
-func (response ListStops200JSONResponse) VisitListStopsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStopResponseObject); ok {
+		if err := validResponse.VisitGetStopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListStops404JSONResponse ErrorResponse
+// UpdateStop operation middleware
+func (sh *strictHandler) UpdateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request UpdateStopRequestObject
 
-func (response ListStops404JSONResponse) VisitListStopsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.TripId = tripId
+	request.StopId = stopId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateStopJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	Body   *CreateStopJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateStop(ctx, request.(UpdateStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateStop")
+	}
 
-type CreateStopResponseObject interface {
-	VisitCreateStopResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateStopResponseObject); ok {
+		if err := validResponse.VisitUpdateStopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateStop201JSONResponse Stop
+// ArriveAtStop operation middleware
+func (sh *strictHandler) ArriveAtStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ArriveAtStopRequestObject
 
-func (response CreateStop201JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.TripId = tripId
+	request.StopId = stopId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ArriveAtStopJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		if !errors.Is(err, io.EOF) {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+	} else {
+		request.Body = &body
+	}
 
-type CreateStop404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArriveAtStop(ctx, request.(ArriveAtStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArriveAtStop")
+	}
 
-func (response CreateStop404JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArriveAtStopResponseObject); ok {
+		if err := validResponse.VisitArriveAtStopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateStop422JSONResponse ErrorResponse
+// ListStopChecklists operation middleware
+func (sh *strictHandler) ListStopChecklists(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListStopChecklistsRequestObject
 
-func (response CreateStop422JSONResponse) VisitCreateStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	request.TripId = tripId
+	request.StopId = stopId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStopChecklists(ctx, request.(ListStopChecklistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStopChecklists")
+	}
 
-type DeleteStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	StopId openapi_types.UUID `json:"stopId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteStopResponseObject interface {
-	VisitDeleteStopResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStopChecklistsResponseObject); ok {
+		if err := validResponse.VisitListStopChecklistsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteStop204Response struct {
-}
+// StartStopChecklist operation middleware
+func (sh *strictHandler) StartStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request StartStopChecklistRequestObject
 
-func (response DeleteStop204Response) VisitDeleteStopResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.TripId = tripId
+	request.StopId = stopId
 
-type DeleteStop404JSONResponse ErrorResponse
+	var body StartStopChecklistJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response DeleteStop404JSONResponse) VisitDeleteStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartStopChecklist(ctx, request.(StartStopChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartStopChecklist")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	StopId openapi_types.UUID `json:"stopId"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartStopChecklistResponseObject); ok {
+		if err := validResponse.VisitStartStopChecklistResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStopResponseObject interface {
-	VisitGetStopResponse(w http.ResponseWriter) error
-}
+// DeleteStopChecklist operation middleware
+func (sh *strictHandler) DeleteStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID) {
+	var request DeleteStopChecklistRequestObject
 
-type GetStop200JSONResponse Stop
+	request.TripId = tripId
+	request.StopId = stopId
+	request.ChecklistId = checklistId
 
-func (response GetStop200JSONResponse) VisitGetStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteStopChecklist(ctx, request.(DeleteStopChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteStopChecklist")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteStopChecklistResponseObject); ok {
+		if err := validResponse.VisitDeleteStopChecklistResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStop404JSONResponse ErrorResponse
+// GetStopChecklist operation middleware
+func (sh *strictHandler) GetStopChecklist(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID) {
+	var request GetStopChecklistRequestObject
 
-func (response GetStop404JSONResponse) VisitGetStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.TripId = tripId
+	request.StopId = stopId
+	request.ChecklistId = checklistId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStopChecklist(ctx, request.(GetStopChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStopChecklist")
+	}
 
-type UpdateStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	StopId openapi_types.UUID `json:"stopId"`
-	Body   *UpdateStopJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdateStopResponseObject interface {
-	VisitUpdateStopResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStopChecklistResponseObject); ok {
+		if err := validResponse.VisitGetStopChecklistResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateStop200JSONResponse Stop
+// CheckStopChecklistItem operation middleware
+func (sh *strictHandler) CheckStopChecklistItem(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, checklistId openapi_types.UUID, itemId openapi_types.UUID) {
+	var request CheckStopChecklistItemRequestObject
 
-func (response UpdateStop200JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.TripId = tripId
+	request.StopId = stopId
+	request.ChecklistId = checklistId
+	request.ItemId = itemId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CheckStopChecklistItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateStop404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckStopChecklistItem(ctx, request.(CheckStopChecklistItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckStopChecklistItem")
+	}
 
-func (response UpdateStop404JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckStopChecklistItemResponseObject); ok {
+		if err := validResponse.VisitCheckStopChecklistItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateStop422JSONResponse ErrorResponse
+// ListDumpEvents operation middleware
+func (sh *strictHandler) ListDumpEvents(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListDumpEventsRequestObject
 
-func (response UpdateStop422JSONResponse) VisitUpdateStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	request.TripId = tripId
+	request.StopId = stopId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDumpEvents(ctx, request.(ListDumpEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDumpEvents")
+	}
 
-type ListTagsByStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	StopId openapi_types.UUID `json:"stopId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListTagsByStopResponseObject interface {
-	VisitListTagsByStopResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDumpEventsResponseObject); ok {
+		if err := validResponse.VisitListDumpEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListTagsByStop200JSONResponse []Tag
+// CreateDumpEvent operation middleware
+func (sh *strictHandler) CreateDumpEvent(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request CreateDumpEventRequestObject
 
-func (response ListTagsByStop200JSONResponse) VisitListTagsByStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.TripId = tripId
+	request.StopId = stopId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateDumpEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListTagsByStop404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDumpEvent(ctx, request.(CreateDumpEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDumpEvent")
+	}
 
-func (response ListTagsByStop404JSONResponse) VisitListTagsByStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDumpEventResponseObject); ok {
+		if err := validResponse.VisitCreateDumpEventResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddTagToStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	StopId openapi_types.UUID `json:"stopId"`
-	Body   *AddTagToStopJSONRequestBody
-}
+// DeleteDumpEvent operation middleware
+func (sh *strictHandler) DeleteDumpEvent(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, dumpId openapi_types.UUID) {
+	var request DeleteDumpEventRequestObject
 
-type AddTagToStopResponseObject interface {
-	VisitAddTagToStopResponse(w http.ResponseWriter) error
-}
+	request.TripId = tripId
+	request.StopId = stopId
+	request.DumpId = dumpId
 
-type AddTagToStop201JSONResponse Tag
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDumpEvent(ctx, request.(DeleteDumpEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDumpEvent")
+	}
 
-func (response AddTagToStop201JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDumpEventResponseObject); ok {
+		if err := validResponse.VisitDeleteDumpEventResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddTagToStop404JSONResponse ErrorResponse
+// ListStopHistory operation middleware
+func (sh *strictHandler) ListStopHistory(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListStopHistoryRequestObject
 
-func (response AddTagToStop404JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.TripId = tripId
+	request.StopId = stopId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStopHistory(ctx, request.(ListStopHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStopHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStopHistoryResponseObject); ok {
+		if err := validResponse.VisitListStopHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddTagToStop422JSONResponse ErrorResponse
+// RevertStop operation middleware
+func (sh *strictHandler) RevertStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, revision int) {
+	var request RevertStopRequestObject
 
-func (response AddTagToStop422JSONResponse) VisitAddTagToStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	request.TripId = tripId
+	request.StopId = stopId
+	request.Revision = revision
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevertStop(ctx, request.(RevertStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevertStop")
+	}
 
-type RemoveTagFromStopRequestObject struct {
-	TripId openapi_types.UUID `json:"tripId"`
-	StopId openapi_types.UUID `json:"stopId"`
-	Slug   string             `json:"slug"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RemoveTagFromStopResponseObject interface {
-	VisitRemoveTagFromStopResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevertStopResponseObject); ok {
+		if err := validResponse.VisitRevertStopResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RemoveTagFromStop204Response struct {
-}
+// ListStopPhotos operation middleware
+func (sh *strictHandler) ListStopPhotos(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListStopPhotosRequestObject
 
-func (response RemoveTagFromStop204Response) VisitRemoveTagFromStopResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.TripId = tripId
+	request.StopId = stopId
 
-type RemoveTagFromStop404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStopPhotos(ctx, request.(ListStopPhotosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStopPhotos")
+	}
 
-func (response RemoveTagFromStop404JSONResponse) VisitRemoveTagFromStopResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStopPhotosResponseObject); ok {
+		if err := validResponse.VisitListStopPhotosResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Export all trips, stops, and tags as a flat table
-	// (GET /export)
-	GetExport(ctx context.Context, request GetExportRequestObject) (GetExportResponseObject, error)
-	// Health check
-	// (GET /healthz)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// List tags, optionally filtered by name prefix
-	// (GET /tags)
-	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
-	// Create a tag by name
-	// (POST /tags)
-	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
-	// Delete a tag
-	// (DELETE /tags/{slug})
-	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
-	// Update a tag's display name
-	// (PATCH /tags/{slug})
-	PatchTag(ctx context.Context, request PatchTagRequestObject) (PatchTagResponseObject, error)
-	// List all trips
-	// (GET /trips)
-	ListTrips(ctx context.Context, request ListTripsRequestObject) (ListTripsResponseObject, error)
-	// Create a trip
-	// (POST /trips)
-	CreateTrip(ctx context.Context, request CreateTripRequestObject) (CreateTripResponseObject, error)
-	// Delete a trip
-	// (DELETE /trips/{id})
-	DeleteTrip(ctx context.Context, request DeleteTripRequestObject) (DeleteTripResponseObject, error)
-	// Get a trip by ID
-	// (GET /trips/{id})
-	GetTrip(ctx context.Context, request GetTripRequestObject) (GetTripResponseObject, error)
-	// Update a trip
-	// (PUT /trips/{id})
-	UpdateTrip(ctx context.Context, request UpdateTripRequestObject) (UpdateTripResponseObject, error)
-	// List all stops for a trip
-	// (GET /trips/{tripId}/stops)
-	ListStops(ctx context.Context, request ListStopsRequestObject) (ListStopsResponseObject, error)
-	// Create a stop on a trip
-	// (POST /trips/{tripId}/stops)
-	CreateStop(ctx context.Context, request CreateStopRequestObject) (CreateStopResponseObject, error)
-	// Delete a stop
-	// (DELETE /trips/{tripId}/stops/{stopId})
-	DeleteStop(ctx context.Context, request DeleteStopRequestObject) (DeleteStopResponseObject, error)
-	// Get a stop by ID
-	// (GET /trips/{tripId}/stops/{stopId})
-	GetStop(ctx context.Context, request GetStopRequestObject) (GetStopResponseObject, error)
-	// Update a stop
-	// (PUT /trips/{tripId}/stops/{stopId})
-	UpdateStop(ctx context.Context, request UpdateStopRequestObject) (UpdateStopResponseObject, error)
-	// List tags on a stop
-	// (GET /trips/{tripId}/stops/{stopId}/tags)
-	ListTagsByStop(ctx context.Context, request ListTagsByStopRequestObject) (ListTagsByStopResponseObject, error)
-	// Add a tag to a stop
-	// (POST /trips/{tripId}/stops/{stopId}/tags)
-	AddTagToStop(ctx context.Context, request AddTagToStopRequestObject) (AddTagToStopResponseObject, error)
-	// Remove a tag from a stop
-	// (DELETE /trips/{tripId}/stops/{stopId}/tags/{slug})
-	RemoveTagFromStop(ctx context.Context, request RemoveTagFromStopRequestObject) (RemoveTagFromStopResponseObject, error)
-}
+// UploadStopPhoto operation middleware
+func (sh *strictHandler) UploadStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, params UploadStopPhotoParams) {
+	var request UploadStopPhotoRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.TripId = tripId
+	request.StopId = stopId
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadStopPhoto(ctx, request.(UploadStopPhotoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadStopPhoto")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadStopPhotoResponseObject); ok {
+		if err := validResponse.VisitUploadStopPhotoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetExport operation middleware
-func (sh *strictHandler) GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams) {
-	var request GetExportRequestObject
+// DeleteStopPhoto operation middleware
+func (sh *strictHandler) DeleteStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, photoId openapi_types.UUID) {
+	var request DeleteStopPhotoRequestObject
 
-	request.Params = params
+	request.TripId = tripId
+	request.StopId = stopId
+	request.PhotoId = photoId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetExport(ctx, request.(GetExportRequestObject))
+		return sh.ssi.DeleteStopPhoto(ctx, request.(DeleteStopPhotoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetExport")
+		handler = middleware(handler, "DeleteStopPhoto")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetExportResponseObject); ok {
-		if err := validResponse.VisitGetExportResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteStopPhotoResponseObject); ok {
+		if err := validResponse.VisitDeleteStopPhotoResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1808,23 +25299,27 @@ func (sh *strictHandler) GetExport(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// GetStopPhoto operation middleware
+func (sh *strictHandler) GetStopPhoto(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, photoId openapi_types.UUID) {
+	var request GetStopPhotoRequestObject
+
+	request.TripId = tripId
+	request.StopId = stopId
+	request.PhotoId = photoId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetStopPhoto(ctx, request.(GetStopPhotoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetStopPhoto")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStopPhotoResponseObject); ok {
+		if err := validResponse.VisitGetStopPhotoResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1832,25 +25327,26 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListTags operation middleware
-func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams) {
-	var request ListTagsRequestObject
+// ListStopReservations operation middleware
+func (sh *strictHandler) ListStopReservations(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListStopReservationsRequestObject
 
-	request.Params = params
+	request.TripId = tripId
+	request.StopId = stopId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
+		return sh.ssi.ListStopReservations(ctx, request.(ListStopReservationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTags")
+		handler = middleware(handler, "ListStopReservations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
-		if err := validResponse.VisitListTagsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListStopReservationsResponseObject); ok {
+		if err := validResponse.VisitListStopReservationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1858,11 +25354,14 @@ func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// CreateTag operation middleware
-func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
-	var request CreateTagRequestObject
+// CreateStopReservation operation middleware
+func (sh *strictHandler) CreateStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request CreateStopReservationRequestObject
 
-	var body CreateTagJSONRequestBody
+	request.TripId = tripId
+	request.StopId = stopId
+
+	var body CreateStopReservationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1870,18 +25369,18 @@ func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
+		return sh.ssi.CreateStopReservation(ctx, request.(CreateStopReservationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTag")
+		handler = middleware(handler, "CreateStopReservation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
-		if err := validResponse.VisitCreateTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateStopReservationResponseObject); ok {
+		if err := validResponse.VisitCreateStopReservationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1889,25 +25388,27 @@ func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteTag operation middleware
-func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, slug string) {
-	var request DeleteTagRequestObject
+// DeleteStopReservation operation middleware
+func (sh *strictHandler) DeleteStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID) {
+	var request DeleteStopReservationRequestObject
 
-	request.Slug = slug
+	request.TripId = tripId
+	request.StopId = stopId
+	request.ReservationId = reservationId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
+		return sh.ssi.DeleteStopReservation(ctx, request.(DeleteStopReservationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTag")
+		handler = middleware(handler, "DeleteStopReservation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
-		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteStopReservationResponseObject); ok {
+		if err := validResponse.VisitDeleteStopReservationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1915,13 +25416,43 @@ func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, slug
 	}
 }
 
-// PatchTag operation middleware
-func (sh *strictHandler) PatchTag(w http.ResponseWriter, r *http.Request, slug string) {
-	var request PatchTagRequestObject
+// GetStopReservation operation middleware
+func (sh *strictHandler) GetStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID) {
+	var request GetStopReservationRequestObject
 
-	request.Slug = slug
+	request.TripId = tripId
+	request.StopId = stopId
+	request.ReservationId = reservationId
 
-	var body PatchTagJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStopReservation(ctx, request.(GetStopReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStopReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStopReservationResponseObject); ok {
+		if err := validResponse.VisitGetStopReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateStopReservation operation middleware
+func (sh *strictHandler) UpdateStopReservation(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, reservationId openapi_types.UUID) {
+	var request UpdateStopReservationRequestObject
+
+	request.TripId = tripId
+	request.StopId = stopId
+	request.ReservationId = reservationId
+
+	var body UpdateStopReservationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1929,18 +25460,18 @@ func (sh *strictHandler) PatchTag(w http.ResponseWriter, r *http.Request, slug s
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTag(ctx, request.(PatchTagRequestObject))
+		return sh.ssi.UpdateStopReservation(ctx, request.(UpdateStopReservationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTag")
+		handler = middleware(handler, "UpdateStopReservation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchTagResponseObject); ok {
-		if err := validResponse.VisitPatchTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateStopReservationResponseObject); ok {
+		if err := validResponse.VisitUpdateStopReservationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1948,25 +25479,26 @@ func (sh *strictHandler) PatchTag(w http.ResponseWriter, r *http.Request, slug s
 	}
 }
 
-// ListTrips operation middleware
-func (sh *strictHandler) ListTrips(w http.ResponseWriter, r *http.Request, params ListTripsParams) {
-	var request ListTripsRequestObject
+// ListTagsByStop operation middleware
+func (sh *strictHandler) ListTagsByStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListTagsByStopRequestObject
 
-	request.Params = params
+	request.TripId = tripId
+	request.StopId = stopId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTrips(ctx, request.(ListTripsRequestObject))
+		return sh.ssi.ListTagsByStop(ctx, request.(ListTagsByStopRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTrips")
+		handler = middleware(handler, "ListTagsByStop")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTripsResponseObject); ok {
-		if err := validResponse.VisitListTripsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTagsByStopResponseObject); ok {
+		if err := validResponse.VisitListTagsByStopResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1974,11 +25506,14 @@ func (sh *strictHandler) ListTrips(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateTrip operation middleware
-func (sh *strictHandler) CreateTrip(w http.ResponseWriter, r *http.Request) {
-	var request CreateTripRequestObject
+// AddTagToStop operation middleware
+func (sh *strictHandler) AddTagToStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request AddTagToStopRequestObject
 
-	var body CreateTripJSONRequestBody
+	request.TripId = tripId
+	request.StopId = stopId
+
+	var body AddTagToStopJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1986,18 +25521,18 @@ func (sh *strictHandler) CreateTrip(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTrip(ctx, request.(CreateTripRequestObject))
+		return sh.ssi.AddTagToStop(ctx, request.(AddTagToStopRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTrip")
+		handler = middleware(handler, "AddTagToStop")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTripResponseObject); ok {
-		if err := validResponse.VisitCreateTripResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddTagToStopResponseObject); ok {
+		if err := validResponse.VisitAddTagToStopResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2005,25 +25540,27 @@ func (sh *strictHandler) CreateTrip(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteTrip operation middleware
-func (sh *strictHandler) DeleteTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteTripRequestObject
+// RemoveTagFromStop operation middleware
+func (sh *strictHandler) RemoveTagFromStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, slug string) {
+	var request RemoveTagFromStopRequestObject
 
-	request.Id = id
+	request.TripId = tripId
+	request.StopId = stopId
+	request.Slug = slug
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTrip(ctx, request.(DeleteTripRequestObject))
+		return sh.ssi.RemoveTagFromStop(ctx, request.(RemoveTagFromStopRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTrip")
+		handler = middleware(handler, "RemoveTagFromStop")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTripResponseObject); ok {
-		if err := validResponse.VisitDeleteTripResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveTagFromStopResponseObject); ok {
+		if err := validResponse.VisitRemoveTagFromStopResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2031,25 +25568,26 @@ func (sh *strictHandler) DeleteTrip(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// GetTrip operation middleware
-func (sh *strictHandler) GetTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetTripRequestObject
+// ListTankLevels operation middleware
+func (sh *strictHandler) ListTankLevels(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request ListTankLevelsRequestObject
 
-	request.Id = id
+	request.TripId = tripId
+	request.StopId = stopId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTrip(ctx, request.(GetTripRequestObject))
+		return sh.ssi.ListTankLevels(ctx, request.(ListTankLevelsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTrip")
+		handler = middleware(handler, "ListTankLevels")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTripResponseObject); ok {
-		if err := validResponse.VisitGetTripResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTankLevelsResponseObject); ok {
+		if err := validResponse.VisitListTankLevelsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2057,13 +25595,14 @@ func (sh *strictHandler) GetTrip(w http.ResponseWriter, r *http.Request, id open
 	}
 }
 
-// UpdateTrip operation middleware
-func (sh *strictHandler) UpdateTrip(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateTripRequestObject
+// CreateTankLevel operation middleware
+func (sh *strictHandler) CreateTankLevel(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
+	var request CreateTankLevelRequestObject
 
-	request.Id = id
+	request.TripId = tripId
+	request.StopId = stopId
 
-	var body UpdateTripJSONRequestBody
+	var body CreateTankLevelJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2071,18 +25610,18 @@ func (sh *strictHandler) UpdateTrip(w http.ResponseWriter, r *http.Request, id o
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTrip(ctx, request.(UpdateTripRequestObject))
+		return sh.ssi.CreateTankLevel(ctx, request.(CreateTankLevelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTrip")
+		handler = middleware(handler, "CreateTankLevel")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateTripResponseObject); ok {
-		if err := validResponse.VisitUpdateTripResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTankLevelResponseObject); ok {
+		if err := validResponse.VisitCreateTankLevelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2090,26 +25629,27 @@ func (sh *strictHandler) UpdateTrip(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// ListStops operation middleware
-func (sh *strictHandler) ListStops(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, params ListStopsParams) {
-	var request ListStopsRequestObject
+// DeleteTankLevel operation middleware
+func (sh *strictHandler) DeleteTankLevel(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, levelId openapi_types.UUID) {
+	var request DeleteTankLevelRequestObject
 
 	request.TripId = tripId
-	request.Params = params
+	request.StopId = stopId
+	request.LevelId = levelId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListStops(ctx, request.(ListStopsRequestObject))
+		return sh.ssi.DeleteTankLevel(ctx, request.(DeleteTankLevelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListStops")
+		handler = middleware(handler, "DeleteTankLevel")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListStopsResponseObject); ok {
-		if err := validResponse.VisitListStopsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTankLevelResponseObject); ok {
+		if err := validResponse.VisitDeleteTankLevelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2117,13 +25657,35 @@ func (sh *strictHandler) ListStops(w http.ResponseWriter, r *http.Request, tripI
 	}
 }
 
-// CreateStop operation middleware
-func (sh *strictHandler) CreateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID) {
-	var request CreateStopRequestObject
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhooksRequestObject
 
-	request.TripId = tripId
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhooks")
+	}
 
-	var body CreateStopJSONRequestBody
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookRequestObject
+
+	var body CreateWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2131,18 +25693,18 @@ func (sh *strictHandler) CreateStop(w http.ResponseWriter, r *http.Request, trip
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateStop(ctx, request.(CreateStopRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateStop")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateStopResponseObject); ok {
-		if err := validResponse.VisitCreateStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2150,26 +25712,25 @@ func (sh *strictHandler) CreateStop(w http.ResponseWriter, r *http.Request, trip
 	}
 }
 
-// DeleteStop operation middleware
-func (sh *strictHandler) DeleteStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	var request DeleteStopRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteWebhookRequestObject
 
-	request.TripId = tripId
-	request.StopId = stopId
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteStop(ctx, request.(DeleteStopRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteStop")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteStopResponseObject); ok {
-		if err := validResponse.VisitDeleteStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2177,26 +25738,25 @@ func (sh *strictHandler) DeleteStop(w http.ResponseWriter, r *http.Request, trip
 	}
 }
 
-// GetStop operation middleware
-func (sh *strictHandler) GetStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	var request GetStopRequestObject
+// GetWebhook operation middleware
+func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetWebhookRequestObject
 
-	request.TripId = tripId
-	request.StopId = stopId
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStop(ctx, request.(GetStopRequestObject))
+		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStop")
+		handler = middleware(handler, "GetWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStopResponseObject); ok {
-		if err := validResponse.VisitGetStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
+		if err := validResponse.VisitGetWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2204,14 +25764,13 @@ func (sh *strictHandler) GetStop(w http.ResponseWriter, r *http.Request, tripId
 	}
 }
 
-// UpdateStop operation middleware
-func (sh *strictHandler) UpdateStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	var request UpdateStopRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateWebhookRequestObject
 
-	request.TripId = tripId
-	request.StopId = stopId
+	request.Id = id
 
-	var body UpdateStopJSONRequestBody
+	var body UpdateWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2219,18 +25778,18 @@ func (sh *strictHandler) UpdateStop(w http.ResponseWriter, r *http.Request, trip
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateStop(ctx, request.(UpdateStopRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateStop")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateStopResponseObject); ok {
-		if err := validResponse.VisitUpdateStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2238,26 +25797,25 @@ func (sh *strictHandler) UpdateStop(w http.ResponseWriter, r *http.Request, trip
 	}
 }
 
-// ListTagsByStop operation middleware
-func (sh *strictHandler) ListTagsByStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	var request ListTagsByStopRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListWebhookDeliveriesRequestObject
 
-	request.TripId = tripId
-	request.StopId = stopId
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTagsByStop(ctx, request.(ListTagsByStopRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTagsByStop")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTagsByStopResponseObject); ok {
-		if err := validResponse.VisitListTagsByStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2265,33 +25823,25 @@ func (sh *strictHandler) ListTagsByStop(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// AddTagToStop operation middleware
-func (sh *strictHandler) AddTagToStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID) {
-	var request AddTagToStopRequestObject
-
-	request.TripId = tripId
-	request.StopId = stopId
+// RotateWebhookSecret operation middleware
+func (sh *strictHandler) RotateWebhookSecret(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RotateWebhookSecretRequestObject
 
-	var body AddTagToStopJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddTagToStop(ctx, request.(AddTagToStopRequestObject))
+		return sh.ssi.RotateWebhookSecret(ctx, request.(RotateWebhookSecretRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddTagToStop")
+		handler = middleware(handler, "RotateWebhookSecret")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddTagToStopResponseObject); ok {
-		if err := validResponse.VisitAddTagToStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(RotateWebhookSecretResponseObject); ok {
+		if err := validResponse.VisitRotateWebhookSecretResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2299,27 +25849,25 @@ func (sh *strictHandler) AddTagToStop(w http.ResponseWriter, r *http.Request, tr
 	}
 }
 
-// RemoveTagFromStop operation middleware
-func (sh *strictHandler) RemoveTagFromStop(w http.ResponseWriter, r *http.Request, tripId openapi_types.UUID, stopId openapi_types.UUID, slug string) {
-	var request RemoveTagFromStopRequestObject
+// TestWebhook operation middleware
+func (sh *strictHandler) TestWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request TestWebhookRequestObject
 
-	request.TripId = tripId
-	request.StopId = stopId
-	request.Slug = slug
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveTagFromStop(ctx, request.(RemoveTagFromStopRequestObject))
+		return sh.ssi.TestWebhook(ctx, request.(TestWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveTagFromStop")
+		handler = middleware(handler, "TestWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RemoveTagFromStopResponseObject); ok {
-		if err := validResponse.VisitRemoveTagFromStopResponse(w); err != nil {
+	} else if validResponse, ok := response.(TestWebhookResponseObject); ok {
+		if err := validResponse.VisitTestWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
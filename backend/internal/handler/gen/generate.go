@@ -1,7 +1,8 @@
 // Package gen contains code generated from openapi.yaml by oapi-codegen.
 // Do not edit the generated files (api.gen.go) by hand.
 //
-// To regenerate, run: make backend/generate
+// To regenerate, run: make backend/generate. The output depends on the
+// oapi-codegen version, so use the one CONTRIBUTING.md pins (v2.6.0).
 package gen
 
 //go:generate oapi-codegen --config ../../../oapi-codegen.yaml ../../../spec/openapi.yaml
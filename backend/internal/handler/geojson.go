@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetTripGeoJSON handles GET /trips/{id}/geojson.
+func (s *Server) GetTripGeoJSON(ctx context.Context, req gen.GetTripGeoJSONRequestObject) (gen.GetTripGeoJSONResponseObject, error) {
+	if _, err := s.trips.GetByID(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripGeoJSON404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+	stops, err := s.stops.ListByTripID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetTripGeoJSON200ApplicationGeoPlusJSONResponse(stopsToGeoJSON(stops)), nil
+}
+
+// stopsToGeoJSON draws stops, already in arrival order with planned stops
+// last, as a Point feature each and a LineString through them. Stops
+// without coordinates are left out of both.
+func stopsToGeoJSON(stops []domain.Stop) gen.GeoJSONFeatureCollection {
+	features := []gen.GeoJSONFeature{}
+	var line [][]float64
+	for _, st := range stops {
+		if !st.HasCoordinates() {
+			continue
+		}
+		// GeoJSON positions are longitude first.
+		position := []float64{*st.Longitude, *st.Latitude}
+		id, name := st.ID.String(), st.Name
+		line = append(line, position)
+		features = append(features, gen.GeoJSONFeature{
+			Type:     gen.Feature,
+			Id:       &id,
+			Geometry: gen.GeoJSONGeometry{Type: gen.Point, Coordinates: position},
+			Properties: gen.GeoJSONProperties{
+				Kind:       gen.GeoJSONPropertiesKindStop,
+				Name:       &name,
+				ArrivedAt:  arrivedAt(st.ArrivedAt, st.Planned),
+				DepartedAt: st.DepartedAt,
+			},
+		})
+	}
+	if len(line) >= 2 {
+		features = append(features, gen.GeoJSONFeature{
+			Type:       gen.Feature,
+			Geometry:   gen.GeoJSONGeometry{Type: gen.LineString, Coordinates: line},
+			Properties: gen.GeoJSONProperties{Kind: gen.GeoJSONPropertiesKindRoute},
+		})
+	}
+	return gen.GeoJSONFeatureCollection{Type: gen.FeatureCollection, Features: features}
+}
@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// newGeoJSONHTTPHandler wires a Server with the trip and stop service mocks.
+func newGeoJSONHTTPHandler(t *testing.T, trips handler.TripServicer, stops handler.StopServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Trips: trips, Stops: stops})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /trips/{id}/geojson -----------------------------------------------
+
+func TestGetTripGeoJSON_200(t *testing.T) {
+	trip := tripFixture()
+	arrived := time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC)
+	departed := arrived.Add(48 * time.Hour)
+	lat, lon := 44.4280, -110.5885
+	lat2, lon2 := 43.7904, -110.6818
+	visited := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Madison", Latitude: &lat, Longitude: &lon, ArrivedAt: arrived, DepartedAt: &departed}
+	nowhere := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Somewhere", ArrivedAt: departed}
+	planned := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Colter Bay", Latitude: &lat2, Longitude: &lon2, Planned: true}
+	trips := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) { return trip, nil }}
+	stops := &mockStopServicer{listByTripID: func(_ context.Context, id uuid.UUID) ([]domain.Stop, error) {
+		assert.Equal(t, trip.ID, id)
+		return []domain.Stop{visited, nowhere, planned}, nil
+	}}
+
+	rec := httptest.NewRecorder()
+	newGeoJSONHTTPHandler(t, trips, stops).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/geojson", trip.ID), nil))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/geo+json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type": "Feature", "id": %q,
+			 "geometry": {"type": "Point", "coordinates": [-110.5885, 44.428]},
+			 "properties": {"kind": "stop", "name": "Madison", "arrived_at": "2025-06-01T18:00:00Z", "departed_at": "2025-06-03T18:00:00Z"}},
+			{"type": "Feature", "id": %q,
+			 "geometry": {"type": "Point", "coordinates": [-110.6818, 43.7904]},
+			 "properties": {"kind": "stop", "name": "Colter Bay"}},
+			{"type": "Feature",
+			 "geometry": {"type": "LineString", "coordinates": [[-110.5885, 44.428], [-110.6818, 43.7904]]},
+			 "properties": {"kind": "route"}}
+		]
+	}`, visited.ID, planned.ID), rec.Body.String())
+}
+
+func TestGetTripGeoJSON_200_NoRouteForOneStop(t *testing.T) {
+	trip := tripFixture()
+	lat, lon := 44.4280, -110.5885
+	trips := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) { return trip, nil }}
+	stops := &mockStopServicer{listByTripID: func(context.Context, uuid.UUID) ([]domain.Stop, error) {
+		return []domain.Stop{{ID: uuid.New(), TripID: trip.ID, Name: "Madison", Latitude: &lat, Longitude: &lon, ArrivedAt: time.Now()}}, nil
+	}}
+
+	rec := httptest.NewRecorder()
+	newGeoJSONHTTPHandler(t, trips, stops).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/geojson", trip.ID), nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body gen.GeoJSONFeatureCollection
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Features, 1)
+	assert.Equal(t, gen.Point, body.Features[0].Geometry.Type)
+}
+
+func TestGetTripGeoJSON_404(t *testing.T) {
+	trips := &mockTripServicer{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) {
+		return domain.Trip{}, domain.ErrNotFound
+	}}
+
+	rec := httptest.NewRecorder()
+	newGeoJSONHTTPHandler(t, trips, &mockStopServicer{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/geojson", uuid.New()), nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -5,11 +5,60 @@ package handler
 import (
 	"context"
 
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
 )
 
 // GetHealth handles GET /healthz.
-// It returns HTTP 200 with {"status":"ok"} when the server is running.
-func (s *Server) GetHealth(ctx context.Context, _ gen.GetHealthRequestObject) (gen.GetHealthResponseObject, error) {
-	return gen.GetHealth200JSONResponse{Status: "ok"}, nil
+// It returns HTTP 200 with {"status":"ok"} when the server is running. With
+// ?detail=true it checks every component and returns 503 if a critical one
+// is down; the plain request never leaves the process.
+func (s *Server) GetHealth(ctx context.Context, req gen.GetHealthRequestObject) (gen.GetHealthResponseObject, error) {
+	if req.Params.Detail == nil || !*req.Params.Detail || s.health == nil {
+		return gen.GetHealth200JSONResponse{Status: "ok"}, nil
+	}
+
+	report := s.health.Report(ctx)
+	if report.Status() == domain.HealthDown {
+		return gen.GetHealth503JSONResponse(healthReportToResponse(report)), nil
+	}
+	return gen.GetHealth200JSONResponse(healthReportToResponse(report)), nil
+}
+
+// GetLiveness handles GET /livez.
+// It always returns HTTP 200 with {"status":"ok"}: answering at all is the
+// only thing liveness asks.
+func (s *Server) GetLiveness(_ context.Context, _ gen.GetLivenessRequestObject) (gen.GetLivenessResponseObject, error) {
+	return gen.GetLiveness200JSONResponse{Status: "ok"}, nil
+}
+
+// GetReadiness handles GET /readyz.
+// It returns HTTP 200 when every critical component passes and 503 when any
+// fails, listing them either way.
+func (s *Server) GetReadiness(ctx context.Context, _ gen.GetReadinessRequestObject) (gen.GetReadinessResponseObject, error) {
+	if s.health == nil {
+		return gen.GetReadiness200JSONResponse{Status: "ok"}, nil
+	}
+
+	report := s.health.Ready(ctx)
+	if report.Status() != domain.HealthOK {
+		return gen.GetReadiness503JSONResponse(healthReportToResponse(report)), nil
+	}
+	return gen.GetReadiness200JSONResponse(healthReportToResponse(report)), nil
+}
+
+// healthReportToResponse converts a domain.HealthReport to the generated API type.
+func healthReportToResponse(report domain.HealthReport) gen.HealthResponse {
+	components := make([]gen.ComponentHealth, len(report.Components))
+	for i, c := range report.Components {
+		components[i] = gen.ComponentHealth{
+			Name:      c.Name,
+			Critical:  c.Critical,
+			Status:    gen.ComponentHealthStatus(c.Status),
+			LatencyMs: roundTo(float64(c.Latency.Microseconds())/1000, 10),
+			Error:     nilIfEmpty(c.Error),
+			CheckedAt: c.CheckedAt,
+		}
+	}
+	return gen.HealthResponse{Status: string(report.Status()), Version: &report.Version, Components: &components}
 }
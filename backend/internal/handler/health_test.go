@@ -1,15 +1,20 @@
 package handler_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
 // TestGetHealth_returns200WithOKStatus verifies that GET /healthz returns
@@ -34,3 +39,123 @@ func TestGetHealth_returns200WithOKStatus(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
 	require.Equal(t, "ok", body.Status)
 }
+
+// fixedHealth returns report, and ready for readiness, and counts how often
+// it was asked.
+type fixedHealth struct {
+	report domain.HealthReport
+	ready  domain.HealthReport
+	calls  int
+}
+
+func (f *fixedHealth) Report(context.Context) domain.HealthReport {
+	f.calls++
+	return f.report
+}
+
+func (f *fixedHealth) Ready(context.Context) domain.HealthReport {
+	f.calls++
+	return f.ready
+}
+
+func newHealthHTTPHandler(t *testing.T, health handler.HealthServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Health: health})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func TestGetHealth_PlainRequestChecksNothing(t *testing.T) {
+	health := &fixedHealth{}
+	rec := httptest.NewRecorder()
+
+	newHealthHTTPHandler(t, health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+	assert.Zero(t, health.calls)
+}
+
+func TestGetHealth_Detail(t *testing.T) {
+	checked := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	health := &fixedHealth{report: domain.HealthReport{
+		Version: "v1.2.0",
+		Components: []domain.ComponentHealth{
+			{Name: "database", Critical: true, Status: domain.HealthOK, Latency: 1840 * time.Microsecond, CheckedAt: checked},
+			{Name: "geocoder", Status: domain.HealthDegraded, Latency: 2 * time.Second, Error: "context deadline exceeded", CheckedAt: checked},
+		},
+	}}
+	rec := httptest.NewRecorder()
+
+	newHealthHTTPHandler(t, health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz?detail=true", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{
+		"status": "degraded",
+		"version": "v1.2.0",
+		"components": [
+			{"name": "database", "critical": true, "status": "ok", "latency_ms": 1.8, "checked_at": "2026-03-01T09:30:00Z"},
+			{"name": "geocoder", "critical": false, "status": "degraded", "latency_ms": 2000, "error": "context deadline exceeded", "checked_at": "2026-03-01T09:30:00Z"}
+		]
+	}`, rec.Body.String())
+}
+
+func TestGetHealth_DetailCriticalDown(t *testing.T) {
+	health := &fixedHealth{report: domain.HealthReport{
+		Version:    "dev",
+		Components: []domain.ComponentHealth{{Name: "database", Critical: true, Status: domain.HealthDown, Error: "connection refused"}},
+	}}
+	rec := httptest.NewRecorder()
+
+	newHealthHTTPHandler(t, health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz?detail=true", nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var body gen.HealthResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "down", body.Status)
+}
+
+func TestGetLiveness_ChecksNothing(t *testing.T) {
+	health := &fixedHealth{}
+	rec := httptest.NewRecorder()
+
+	newHealthHTTPHandler(t, health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+	assert.Zero(t, health.calls)
+}
+
+func TestGetReadiness(t *testing.T) {
+	checked := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	health := &fixedHealth{ready: domain.HealthReport{
+		Version:    "v1.2.0",
+		Components: []domain.ComponentHealth{{Name: "database", Critical: true, Status: domain.HealthOK, CheckedAt: checked}},
+	}}
+	rec := httptest.NewRecorder()
+
+	newHealthHTTPHandler(t, health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{
+		"status": "ok",
+		"version": "v1.2.0",
+		"components": [{"name": "database", "critical": true, "status": "ok", "latency_ms": 0, "checked_at": "2026-03-01T09:30:00Z"}]
+	}`, rec.Body.String())
+}
+
+func TestGetReadiness_MigrationsPending(t *testing.T) {
+	health := &fixedHealth{ready: domain.HealthReport{
+		Version: "v1.2.0",
+		Components: []domain.ComponentHealth{
+			{Name: "database", Critical: true, Status: domain.HealthOK},
+			{Name: "migrations", Critical: true, Status: domain.HealthDown, Error: "1 migrations not applied: 034"},
+		},
+	}}
+	rec := httptest.NewRecorder()
+
+	newHealthHTTPHandler(t, health).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var body gen.HealthResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "down", body.Status)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ImportTripGPX handles POST /trips/{id}/import/gpx.
+func (s *Server) ImportTripGPX(ctx context.Context, req gen.ImportTripGPXRequestObject) (gen.ImportTripGPXResponseObject, error) {
+	result, err := s.imports.ImportGPX(ctx, req.Id, req.Body)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ImportTripGPX404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ImportTripGPX422JSONResponse(validationBody(err)), nil
+		}
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return gen.ImportTripGPX413JSONResponse(gen.ErrorResponse{Error: gen.ErrorDetail{
+				Code: "request_too_large", Message: "request body exceeds size limit",
+			}}), nil
+		}
+		return nil, err
+	}
+
+	resp := gen.ImportTripGPX200JSONResponse{
+		Created: make([]gen.Stop, len(result.Created)),
+		Failed:  make([]gen.ImportFailure, len(result.Failed)),
+	}
+	for i, st := range result.Created {
+		resp.Created[i] = stopToResponse(st)
+	}
+	for i, f := range result.Failed {
+		resp.Failed[i] = gen.ImportFailure{Index: f.Index, Reason: unwrapMessage(f.Err)}
+		if f.Name != "" {
+			name := f.Name
+			resp.Failed[i].Name = &name
+		}
+	}
+	return resp, nil
+}
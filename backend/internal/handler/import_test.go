@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+type mockImportServicer struct {
+	importGPX func(ctx context.Context, tripID uuid.UUID, r io.Reader) (domain.StopImport, error)
+}
+
+func (m *mockImportServicer) ImportGPX(ctx context.Context, tripID uuid.UUID, r io.Reader) (domain.StopImport, error) {
+	return m.importGPX(ctx, tripID, r)
+}
+
+func newImportHTTPHandler(t *testing.T, svc handler.ImportServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Imports: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+const importTestGPX = `<gpx><wpt lat="44.5263" lon="-109.0565"><name>Cody KOA</name></wpt><wpt lat="95" lon="-110"><name>Off the map</name></wpt><wpt lat="44" lon="-110"/></gpx>`
+
+func postImportGPX(t *testing.T, svc handler.ImportServicer, tripID uuid.UUID) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/import/gpx", strings.NewReader(importTestGPX))
+	req.Header.Set("Content-Type", "application/gpx+xml")
+	rec := httptest.NewRecorder()
+	newImportHTTPHandler(t, svc).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestImportTripGPX_200_PartialFailure(t *testing.T) {
+	tripID := uuid.New()
+	var gotTrip uuid.UUID
+	var gotBody string
+	svc := &mockImportServicer{
+		importGPX: func(_ context.Context, id uuid.UUID, r io.Reader) (domain.StopImport, error) {
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			gotTrip, gotBody = id, string(data)
+			return domain.StopImport{
+				Created: []domain.Stop{stopFixture(id)},
+				Failed: []domain.ImportFailure{
+					{Index: 2, Name: "Off the map", Err: fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)},
+					{Index: 3, Err: fmt.Errorf("%w: name is required", domain.ErrValidation)},
+				},
+			}, nil
+		},
+	}
+
+	rec := postImportGPX(t, svc, tripID)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, tripID, gotTrip)
+	assert.Equal(t, importTestGPX, gotBody)
+	var resp gen.StopImportResult
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Created, 1)
+	assert.Equal(t, tripID, resp.Created[0].TripId)
+	require.Len(t, resp.Failed, 2)
+	assert.Equal(t, 2, resp.Failed[0].Index)
+	require.NotNil(t, resp.Failed[0].Name)
+	assert.Equal(t, "Off the map", *resp.Failed[0].Name)
+	assert.Equal(t, "latitude must be between -90 and 90", resp.Failed[0].Reason)
+	assert.Nil(t, resp.Failed[1].Name)
+	assert.Equal(t, "name is required", resp.Failed[1].Reason)
+}
+
+func TestImportTripGPX_Errors(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"trip not found": {fmt.Errorf("svc: %w", domain.ErrNotFound), http.StatusNotFound},
+		"not gpx":        {fmt.Errorf("%w: gpx: invalid file: no waypoints", domain.ErrValidation), http.StatusUnprocessableEntity},
+		"too large":      {fmt.Errorf("svc: read: %w", &http.MaxBytesError{Limit: 10}), http.StatusRequestEntityTooLarge},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &mockImportServicer{
+				importGPX: func(_ context.Context, _ uuid.UUID, _ io.Reader) (domain.StopImport, error) {
+					return domain.StopImport{}, tt.err
+				},
+			}
+
+			rec := postImportGPX(t, svc, uuid.New())
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListTripJournal handles GET /trips/{tripId}/journal.
+// Use ?date=YYYY-MM-DD for a single day.
+func (s *Server) ListTripJournal(ctx context.Context, req gen.ListTripJournalRequestObject) (gen.ListTripJournalResponseObject, error) {
+	days, err := s.journal.ListByDay(ctx, req.TripId, dateFromAPIPtr(req.Params.Date))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripJournal404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTripJournal200JSONResponse, len(days))
+	for i, d := range days {
+		entries := make([]gen.JournalEntry, len(d.Entries))
+		for j, e := range d.Entries {
+			entries[j] = journalEntryToResponse(e)
+		}
+		resp[i] = gen.JournalDay{Date: dateToAPI(d.Date), Entries: entries}
+	}
+	return resp, nil
+}
+
+// CreateTripJournalEntry handles POST /trips/{tripId}/journal.
+func (s *Server) CreateTripJournalEntry(ctx context.Context, req gen.CreateTripJournalEntryRequestObject) (gen.CreateTripJournalEntryResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateTripJournalEntry422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	e := requestToJournalEntry(*req.Body)
+	e.TripID = req.TripId
+	created, err := s.journal.Create(ctx, e)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateTripJournalEntry404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateTripJournalEntry422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateTripJournalEntry201JSONResponse(journalEntryToResponse(created)), nil
+}
+
+// GetTripJournalEntry handles GET /trips/{tripId}/journal/{entryId}.
+func (s *Server) GetTripJournalEntry(ctx context.Context, req gen.GetTripJournalEntryRequestObject) (gen.GetTripJournalEntryResponseObject, error) {
+	e, err := s.journal.GetByID(ctx, req.TripId, req.EntryId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripJournalEntry404JSONResponse(notFoundBody("journal entry not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetTripJournalEntry200JSONResponse(journalEntryToResponse(e)), nil
+}
+
+// UpdateTripJournalEntry handles PUT /trips/{tripId}/journal/{entryId}.
+func (s *Server) UpdateTripJournalEntry(ctx context.Context, req gen.UpdateTripJournalEntryRequestObject) (gen.UpdateTripJournalEntryResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateTripJournalEntry422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	e := requestToJournalEntry(*req.Body)
+	e.ID, e.TripID = req.EntryId, req.TripId
+	updated, err := s.journal.Update(ctx, e)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateTripJournalEntry404JSONResponse(notFoundBody("journal entry not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateTripJournalEntry422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateTripJournalEntry200JSONResponse(journalEntryToResponse(updated)), nil
+}
+
+// DeleteTripJournalEntry handles DELETE /trips/{tripId}/journal/{entryId}.
+func (s *Server) DeleteTripJournalEntry(ctx context.Context, req gen.DeleteTripJournalEntryRequestObject) (gen.DeleteTripJournalEntryResponseObject, error) {
+	if err := s.journal.Delete(ctx, req.TripId, req.EntryId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteTripJournalEntry404JSONResponse(notFoundBody("journal entry not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteTripJournalEntry204Response{}, nil
+}
+
+// requestToJournalEntry converts a request body into a domain.JournalEntry.
+// The caller sets TripID (and ID on update) from the path.
+func requestToJournalEntry(body gen.JournalEntryRequest) domain.JournalEntry {
+	e := domain.JournalEntry{
+		StopID: body.StopId,
+		Date:   dateFromAPI(body.Date),
+		Body:   body.Body,
+	}
+	if body.Mood != nil {
+		e.Mood = domain.JournalMood(*body.Mood)
+	}
+	if body.Weather != nil {
+		e.Weather = domain.JournalWeather(*body.Weather)
+	}
+	return e
+}
+
+// journalEntryToResponse converts a domain.JournalEntry into the generated type.
+func journalEntryToResponse(e domain.JournalEntry) gen.JournalEntry {
+	resp := gen.JournalEntry{
+		Id:        e.ID,
+		TripId:    e.TripID,
+		StopId:    e.StopID,
+		Date:      dateToAPI(e.Date),
+		Body:      e.Body,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+	if e.Mood != "" {
+		mood := gen.JournalMood(e.Mood)
+		resp.Mood = &mood
+	}
+	if e.Weather != "" {
+		weather := gen.JournalWeather(e.Weather)
+		resp.Weather = &weather
+	}
+	return resp
+}
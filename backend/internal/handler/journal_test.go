@@ -0,0 +1,207 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock JournalServicer --------------------------------------------------
+
+type mockJournalServicer struct {
+	create    func(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error)
+	getByID   func(ctx context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error)
+	listByDay func(ctx context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalDay, error)
+	update    func(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error)
+	delete    func(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+func (m *mockJournalServicer) Create(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	return m.create(ctx, e)
+}
+func (m *mockJournalServicer) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error) {
+	return m.getByID(ctx, tripID, id)
+}
+func (m *mockJournalServicer) ListByDay(ctx context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalDay, error) {
+	return m.listByDay(ctx, tripID, day)
+}
+func (m *mockJournalServicer) Update(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	return m.update(ctx, e)
+}
+func (m *mockJournalServicer) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	return m.delete(ctx, tripID, id)
+}
+
+// compile-time check: mockJournalServicer must satisfy handler.JournalServicer.
+var _ handler.JournalServicer = (*mockJournalServicer)(nil)
+
+func newJournalHTTPHandler(t *testing.T, svc handler.JournalServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Journal: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- /trips/{tripId}/journal -----------------------------------------------
+
+func TestListTripJournal_200(t *testing.T) {
+	tripID := uuid.New()
+	day := domain.Date{Year: 2025, Month: 7, Day: 14}
+	now := time.Now().UTC()
+	svc := &mockJournalServicer{
+		listByDay: func(_ context.Context, got uuid.UUID, d *domain.Date) ([]domain.JournalDay, error) {
+			assert.Equal(t, tripID, got)
+			require.NotNil(t, d)
+			assert.Equal(t, day, *d)
+			return []domain.JournalDay{{Date: day, Entries: []domain.JournalEntry{{
+				ID: uuid.New(), TripID: tripID, Date: day, Body: "Crossed at Peace Arch.",
+				Mood: domain.MoodGreat, CreatedAt: now, UpdatedAt: now,
+			}}}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/journal?date=2025-07-14", tripID), nil)
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.JournalDay
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "2025-07-14", resp[0].Date.String())
+	require.Len(t, resp[0].Entries, 1)
+	require.NotNil(t, resp[0].Entries[0].Mood)
+	assert.Equal(t, gen.Great, *resp[0].Entries[0].Mood)
+	assert.Nil(t, resp[0].Entries[0].Weather)
+}
+
+func TestListTripJournal_404(t *testing.T) {
+	svc := &mockJournalServicer{
+		listByDay: func(_ context.Context, _ uuid.UUID, _ *domain.Date) ([]domain.JournalDay, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/journal", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCreateTripJournalEntry_201(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	svc := &mockJournalServicer{
+		create: func(_ context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+			assert.Equal(t, tripID, e.TripID)
+			assert.Equal(t, &stopID, e.StopID)
+			assert.Equal(t, domain.Date{Year: 2025, Month: 7, Day: 15}, e.Date)
+			assert.Equal(t, domain.WeatherRain, e.Weather)
+			assert.Empty(t, e.Mood)
+			e.ID = uuid.New()
+			return e, nil
+		},
+	}
+
+	body := fmt.Sprintf(`{"date":"2025-07-15","body":"Rain all day.","weather":"rain","stop_id":%q}`, stopID)
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/journal", tripID), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var resp gen.JournalEntry
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, &stopID, resp.StopId)
+}
+
+func TestCreateTripJournalEntry_422(t *testing.T) {
+	svc := &mockJournalServicer{
+		create: func(_ context.Context, _ domain.JournalEntry) (domain.JournalEntry, error) {
+			return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Create: %w: stop_id is not a stop on this trip", domain.ErrValidation)
+		},
+	}
+
+	body := fmt.Sprintf(`{"date":"2025-07-15","body":"Rain all day.","stop_id":%q}`, uuid.New())
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/journal", uuid.New()), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "stop_id is not a stop on this trip", resp.Error.Message)
+}
+
+// ---- /trips/{tripId}/journal/{entryId} -------------------------------------
+
+func TestGetTripJournalEntry_404(t *testing.T) {
+	svc := &mockJournalServicer{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.JournalEntry, error) {
+			return domain.JournalEntry{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/journal/%s", uuid.New(), uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUpdateTripJournalEntry_200(t *testing.T) {
+	tripID, entryID := uuid.New(), uuid.New()
+	svc := &mockJournalServicer{
+		update: func(_ context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+			assert.Equal(t, entryID, e.ID)
+			assert.Equal(t, tripID, e.TripID)
+			assert.Nil(t, e.StopID)
+			return e, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/trips/%s/journal/%s", tripID, entryID),
+		strings.NewReader(`{"date":"2025-07-15","body":"Rain, then a rainbow.","mood":"good","stop_id":null}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func TestDeleteTripJournalEntry_204(t *testing.T) {
+	tripID, entryID := uuid.New(), uuid.New()
+	svc := &mockJournalServicer{
+		delete: func(_ context.Context, gotTrip, gotEntry uuid.UUID) error {
+			assert.Equal(t, tripID, gotTrip)
+			assert.Equal(t, entryID, gotEntry)
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trips/%s/journal/%s", tripID, entryID), nil)
+	rec := httptest.NewRecorder()
+
+	newJournalHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// IngestLocation handles POST /locations.
+func (s *Server) IngestLocation(ctx context.Context, req gen.IngestLocationRequestObject) (gen.IngestLocationResponseObject, error) {
+	if req.Body == nil {
+		return gen.IngestLocation422JSONResponse(requestBody("request body is required")), nil
+	}
+	// OwnTracks also posts transitions, waypoints, and status messages;
+	// only positions matter here.
+	if req.Body.UnderscoreType != "location" {
+		return gen.IngestLocation200JSONResponse{}, nil
+	}
+	if req.Body.Lat == nil || req.Body.Lon == nil {
+		return gen.IngestLocation422JSONResponse(requestBody("lat and lon are required")), nil
+	}
+
+	ping := domain.LocationPing{
+		Device:         derefString(req.Body.Tid),
+		Latitude:       *req.Body.Lat,
+		Longitude:      *req.Body.Lon,
+		AccuracyMeters: req.Body.Acc,
+	}
+	if req.Body.Topic != nil && *req.Body.Topic != "" {
+		ping.Device = *req.Body.Topic
+	}
+	if req.Body.Tst != nil {
+		ping.RecordedAt = time.Unix(*req.Body.Tst, 0).UTC()
+	}
+
+	if err := s.locations.Ingest(ctx, ping); err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.IngestLocation422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	// OwnTracks treats the response as messages for the device; there are none.
+	return gen.IngestLocation200JSONResponse{}, nil
+}
+
+// ListTripStopSuggestions handles GET /trips/{tripId}/stop-suggestions.
+func (s *Server) ListTripStopSuggestions(ctx context.Context, req gen.ListTripStopSuggestionsRequestObject) (gen.ListTripStopSuggestionsResponseObject, error) {
+	dwells, err := s.locations.ListSuggestions(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripStopSuggestions404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTripStopSuggestions200JSONResponse, len(dwells))
+	for i, d := range dwells {
+		resp[i] = gen.StopSuggestion{
+			Id:         d.ID,
+			TripId:     d.TripID,
+			Device:     d.Device,
+			Latitude:   d.Latitude,
+			Longitude:  d.Longitude,
+			ArrivedAt:  d.ArrivedAt,
+			LastSeenAt: d.LastSeenAt,
+			DepartedAt: d.DepartedAt,
+			CreatedAt:  d.CreatedAt,
+		}
+	}
+	return resp, nil
+}
+
+// AcceptTripStopSuggestion handles POST /trips/{tripId}/stop-suggestions/{suggestionId}/accept.
+func (s *Server) AcceptTripStopSuggestion(ctx context.Context, req gen.AcceptTripStopSuggestionRequestObject) (gen.AcceptTripStopSuggestionResponseObject, error) {
+	stop, err := s.locations.AcceptSuggestion(ctx, req.TripId, req.SuggestionId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.AcceptTripStopSuggestion404JSONResponse(notFoundBody("stop suggestion not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.AcceptTripStopSuggestion422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.AcceptTripStopSuggestion201JSONResponse(stopToResponse(stop)), nil
+}
+
+// DismissTripStopSuggestion handles DELETE /trips/{tripId}/stop-suggestions/{suggestionId}.
+func (s *Server) DismissTripStopSuggestion(ctx context.Context, req gen.DismissTripStopSuggestionRequestObject) (gen.DismissTripStopSuggestionResponseObject, error) {
+	if err := s.locations.DismissSuggestion(ctx, req.TripId, req.SuggestionId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DismissTripStopSuggestion404JSONResponse(notFoundBody("stop suggestion not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.DismissTripStopSuggestion422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.DismissTripStopSuggestion204Response{}, nil
+}
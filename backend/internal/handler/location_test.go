@@ -0,0 +1,272 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock LocationServicer -------------------------------------------------
+
+type mockLocationServicer struct {
+	ingest            func(ctx context.Context, ping domain.LocationPing) error
+	listSuggestions   func(ctx context.Context, tripID uuid.UUID) ([]domain.Dwell, error)
+	acceptSuggestion  func(ctx context.Context, tripID, id uuid.UUID) (domain.Stop, error)
+	dismissSuggestion func(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+func (m *mockLocationServicer) Ingest(ctx context.Context, ping domain.LocationPing) error {
+	return m.ingest(ctx, ping)
+}
+func (m *mockLocationServicer) ListSuggestions(ctx context.Context, tripID uuid.UUID) ([]domain.Dwell, error) {
+	return m.listSuggestions(ctx, tripID)
+}
+func (m *mockLocationServicer) AcceptSuggestion(ctx context.Context, tripID, id uuid.UUID) (domain.Stop, error) {
+	return m.acceptSuggestion(ctx, tripID, id)
+}
+func (m *mockLocationServicer) DismissSuggestion(ctx context.Context, tripID, id uuid.UUID) error {
+	return m.dismissSuggestion(ctx, tripID, id)
+}
+
+// compile-time check: mockLocationServicer must satisfy handler.LocationServicer.
+var _ handler.LocationServicer = (*mockLocationServicer)(nil)
+
+// newLocationHTTPHandler wires a Server with only the location service mock.
+func newLocationHTTPHandler(t *testing.T, svc handler.LocationServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Locations: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- POST /locations -------------------------------------------------------
+
+func TestIngestLocation_200_OwnTracks(t *testing.T) {
+	var got domain.LocationPing
+	svc := &mockLocationServicer{
+		ingest: func(_ context.Context, p domain.LocationPing) error {
+			got = p
+			return nil
+		},
+	}
+
+	body := strings.NewReader(`{"_type":"location","lat":44.6455,"lon":-110.8617,"tst":1751382000,
+		"acc":12,"tid":"rv","topic":"owntracks/pat/rv","batt":87,"vel":0}`)
+	req := httptest.NewRequest(http.MethodPost, "/locations", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[]`, rec.Body.String())
+	assert.Equal(t, "owntracks/pat/rv", got.Device)
+	assert.InDelta(t, 44.6455, got.Latitude, 1e-9)
+	assert.InDelta(t, -110.8617, got.Longitude, 1e-9)
+	require.NotNil(t, got.AccuracyMeters)
+	assert.InDelta(t, 12, *got.AccuracyMeters, 1e-9)
+	assert.True(t, got.RecordedAt.Equal(time.Unix(1751382000, 0)))
+}
+
+func TestIngestLocation_200_TrackerIDWithoutTopic(t *testing.T) {
+	var got domain.LocationPing
+	svc := &mockLocationServicer{
+		ingest: func(_ context.Context, p domain.LocationPing) error {
+			got = p
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/locations", jsonBody(t, map[string]any{
+		"_type": "location", "lat": 44.6455, "lon": -110.8617, "tid": "rv",
+	}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "rv", got.Device)
+	assert.True(t, got.RecordedAt.IsZero(), "left for the service to stamp")
+}
+
+func TestIngestLocation_200_IgnoresOtherMessages(t *testing.T) {
+	svc := &mockLocationServicer{
+		ingest: func(_ context.Context, _ domain.LocationPing) error {
+			t.Fatal("Ingest must not be called")
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/locations", jsonBody(t, map[string]any{
+		"_type": "transition", "event": "enter", "desc": "Home",
+	}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[]`, rec.Body.String())
+}
+
+func TestIngestLocation_422(t *testing.T) {
+	tests := map[string]struct {
+		body map[string]any
+		err  error
+	}{
+		"missing coordinates": {body: map[string]any{"_type": "location", "lat": 44.6}},
+		"rejected by service": {
+			body: map[string]any{"_type": "location", "lat": 44.6, "lon": -110.8},
+			err:  fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &mockLocationServicer{
+				ingest: func(_ context.Context, _ domain.LocationPing) error { return tc.err },
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/locations", jsonBody(t, tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		})
+	}
+}
+
+// ---- /trips/{tripId}/stop-suggestions --------------------------------------
+
+func TestListTripStopSuggestions_200(t *testing.T) {
+	tripID := uuid.New()
+	arrived := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+	svc := &mockLocationServicer{
+		listSuggestions: func(_ context.Context, tid uuid.UUID) ([]domain.Dwell, error) {
+			return []domain.Dwell{{
+				ID: uuid.New(), TripID: tid, Device: "rv", Latitude: 44.6455, Longitude: -110.8617,
+				ArrivedAt: arrived, LastSeenAt: arrived.Add(time.Hour), Status: domain.DwellSuggested, CreatedAt: arrived,
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/stop-suggestions", nil)
+	rec := httptest.NewRecorder()
+
+	newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got []gen.StopSuggestion
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	require.Len(t, got, 1)
+	assert.Equal(t, tripID, got[0].TripId)
+	assert.Equal(t, "rv", got[0].Device)
+	assert.Nil(t, got[0].DepartedAt)
+}
+
+func TestListTripStopSuggestions_404(t *testing.T) {
+	svc := &mockLocationServicer{
+		listSuggestions: func(_ context.Context, _ uuid.UUID) ([]domain.Dwell, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/stop-suggestions", nil)
+	rec := httptest.NewRecorder()
+
+	newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAcceptTripStopSuggestion_201(t *testing.T) {
+	tripID, id := uuid.New(), uuid.New()
+	lat, lon := 44.6455, -110.8617
+	svc := &mockLocationServicer{
+		acceptSuggestion: func(_ context.Context, tid, sid uuid.UUID) (domain.Stop, error) {
+			assert.Equal(t, id, sid)
+			now := time.Now().UTC()
+			return domain.Stop{
+				ID: uuid.New(), TripID: tid, Name: "Check-in at 44.64550, -110.86170",
+				Latitude: &lat, Longitude: &lon, ArrivedAt: now, CreatedAt: now, UpdatedAt: now,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stop-suggestions/%s/accept", tripID, id), nil)
+	rec := httptest.NewRecorder()
+
+	newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var got gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	assert.Equal(t, tripID, got.TripId)
+	require.NotNil(t, got.Latitude)
+	assert.InDelta(t, lat, *got.Latitude, 1e-9)
+}
+
+func TestAcceptTripStopSuggestion_Errors(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"not found":        {domain.ErrNotFound, http.StatusNotFound},
+		"already accepted": {fmt.Errorf("%w: suggestion has already been accepted", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &mockLocationServicer{
+				acceptSuggestion: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) {
+					return domain.Stop{}, tc.err
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stop-suggestions/%s/accept", uuid.New(), uuid.New()), nil)
+			rec := httptest.NewRecorder()
+
+			newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.want, rec.Code)
+		})
+	}
+}
+
+func TestDismissTripStopSuggestion(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"dismissed":        {nil, http.StatusNoContent},
+		"not found":        {domain.ErrNotFound, http.StatusNotFound},
+		"already accepted": {fmt.Errorf("%w: suggestion has already been accepted", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &mockLocationServicer{
+				dismissSuggestion: func(_ context.Context, _, _ uuid.UUID) error { return tc.err },
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trips/%s/stop-suggestions/%s", uuid.New(), uuid.New()), nil)
+			rec := httptest.NewRecorder()
+
+			newLocationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.want, rec.Code)
+		})
+	}
+}
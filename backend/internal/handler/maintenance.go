@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListMaintenanceItems handles GET /maintenance/items.
+// Supports ?vehicle= to list one vehicle's items.
+func (s *Server) ListMaintenanceItems(ctx context.Context, req gen.ListMaintenanceItemsRequestObject) (gen.ListMaintenanceItemsResponseObject, error) {
+	items, err := s.maintenance.ListItems(ctx, derefString(req.Params.Vehicle))
+	if err != nil {
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	resp := make(gen.ListMaintenanceItems200JSONResponse, len(items))
+	for i, item := range items {
+		resp[i] = maintenanceItemToResponse(item, u)
+	}
+	return resp, nil
+}
+
+// CreateMaintenanceItem handles POST /maintenance/items.
+func (s *Server) CreateMaintenanceItem(ctx context.Context, req gen.CreateMaintenanceItemRequestObject) (gen.CreateMaintenanceItemResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateMaintenanceItem422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.maintenance.CreateItem(ctx, domain.MaintenanceItem{
+		Vehicle:       req.Body.Vehicle,
+		Name:          req.Body.Name,
+		IntervalMiles: req.Body.IntervalMiles,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateMaintenanceItem422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateMaintenanceItem201JSONResponse(maintenanceItemToResponse(created, unitsFor(req.Params.Units))), nil
+}
+
+// DeleteMaintenanceItem handles DELETE /maintenance/items/{itemId}.
+func (s *Server) DeleteMaintenanceItem(ctx context.Context, req gen.DeleteMaintenanceItemRequestObject) (gen.DeleteMaintenanceItemResponseObject, error) {
+	if err := s.maintenance.DeleteItem(ctx, req.ItemId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteMaintenanceItem404JSONResponse(notFoundBody("maintenance item not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteMaintenanceItem204Response{}, nil
+}
+
+// CreateMaintenanceRecord handles POST /maintenance/items/{itemId}/records.
+func (s *Server) CreateMaintenanceRecord(ctx context.Context, req gen.CreateMaintenanceRecordRequestObject) (gen.CreateMaintenanceRecordResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateMaintenanceRecord422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.maintenance.RecordService(ctx, domain.MaintenanceRecord{
+		ItemID:     req.ItemId,
+		Miles:      req.Body.Miles,
+		ServicedOn: dateFromAPI(req.Body.ServicedOn),
+		Notes:      derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateMaintenanceRecord404JSONResponse(notFoundBody("maintenance item not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateMaintenanceRecord422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateMaintenanceRecord201JSONResponse(maintenanceRecordToResponse(created, unitsFor(req.Params.Units))), nil
+}
+
+// ListMaintenanceDue handles GET /maintenance/due.
+// Supports ?within_miles= (default domain.DefaultDueSoonMiles).
+func (s *Server) ListMaintenanceDue(ctx context.Context, req gen.ListMaintenanceDueRequestObject) (gen.ListMaintenanceDueResponseObject, error) {
+	within := float64(domain.DefaultDueSoonMiles)
+	if req.Params.WithinMiles != nil {
+		within = *req.Params.WithinMiles
+	}
+
+	due, err := s.maintenance.Due(ctx, within)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListMaintenanceDue422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	resp := make(gen.ListMaintenanceDue200JSONResponse, len(due))
+	for i, d := range due {
+		resp[i] = gen.MaintenanceDueItem{
+			Item:           maintenanceItemToResponse(d.Item, u),
+			CurrentMiles:   u.distance(d.CurrentMiles),
+			MilesRemaining: u.distance(d.MilesRemaining),
+			Status:         gen.MaintenanceStatus(d.Status),
+		}
+	}
+	return resp, nil
+}
+
+// maintenanceItemToResponse converts a domain.MaintenanceItem into the
+// generated type.
+func maintenanceItemToResponse(item domain.MaintenanceItem, u units) gen.MaintenanceItem {
+	resp := gen.MaintenanceItem{
+		Id:            item.ID,
+		Vehicle:       item.Vehicle,
+		Name:          item.Name,
+		IntervalMiles: u.distance(item.IntervalMiles),
+		DueAtMiles:    u.distance(item.DueAtMiles()),
+		CreatedAt:     item.CreatedAt,
+	}
+	if item.LastService != nil {
+		last := maintenanceRecordToResponse(*item.LastService, u)
+		resp.LastService = &last
+	}
+	return resp
+}
+
+// maintenanceRecordToResponse converts a domain.MaintenanceRecord into the
+// generated type.
+func maintenanceRecordToResponse(r domain.MaintenanceRecord, u units) gen.MaintenanceRecord {
+	return gen.MaintenanceRecord{
+		Id:         r.ID,
+		ItemId:     r.ItemID,
+		Miles:      u.distance(r.Miles),
+		ServicedOn: dateToAPI(r.ServicedOn),
+		Notes:      nilIfEmpty(r.Notes),
+		CreatedAt:  r.CreatedAt,
+	}
+}
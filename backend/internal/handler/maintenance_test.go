@@ -0,0 +1,273 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock MaintenanceServicer ----------------------------------------------
+
+type mockMaintenanceServicer struct {
+	createItem    func(ctx context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error)
+	listItems     func(ctx context.Context, vehicle string) ([]domain.MaintenanceItem, error)
+	deleteItem    func(ctx context.Context, id uuid.UUID) error
+	recordService func(ctx context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error)
+	due           func(ctx context.Context, withinMiles float64) ([]domain.MaintenanceDue, error)
+}
+
+func (m *mockMaintenanceServicer) CreateItem(ctx context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error) {
+	return m.createItem(ctx, item)
+}
+func (m *mockMaintenanceServicer) ListItems(ctx context.Context, vehicle string) ([]domain.MaintenanceItem, error) {
+	return m.listItems(ctx, vehicle)
+}
+func (m *mockMaintenanceServicer) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	return m.deleteItem(ctx, id)
+}
+func (m *mockMaintenanceServicer) RecordService(ctx context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error) {
+	return m.recordService(ctx, rec)
+}
+func (m *mockMaintenanceServicer) Due(ctx context.Context, withinMiles float64) ([]domain.MaintenanceDue, error) {
+	return m.due(ctx, withinMiles)
+}
+
+// compile-time check: mockMaintenanceServicer must satisfy handler.MaintenanceServicer.
+var _ handler.MaintenanceServicer = (*mockMaintenanceServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newMaintenanceHTTPHandler wires a Server with only the maintenance service mock.
+func newMaintenanceHTTPHandler(t *testing.T, svc handler.MaintenanceServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Maintenance: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func maintenanceItemFixture() domain.MaintenanceItem {
+	id := uuid.New()
+	return domain.MaintenanceItem{
+		ID:            id,
+		Vehicle:       "Motorhome",
+		Name:          "Oil change",
+		IntervalMiles: 5000,
+		LastService: &domain.MaintenanceRecord{
+			ID:         uuid.New(),
+			ItemID:     id,
+			Miles:      40000,
+			ServicedOn: domain.NewDate(2025, 3, 1),
+			CreatedAt:  time.Now().UTC(),
+		},
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// ---- GET /maintenance/items ------------------------------------------------
+
+func TestListMaintenanceItems_200(t *testing.T) {
+	fixture := maintenanceItemFixture()
+	var gotVehicle string
+	svc := &mockMaintenanceServicer{
+		listItems: func(_ context.Context, vehicle string) ([]domain.MaintenanceItem, error) {
+			gotVehicle = vehicle
+			return []domain.MaintenanceItem{fixture}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/maintenance/items?vehicle=Motorhome", nil)
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Motorhome", gotVehicle)
+	var resp []gen.MaintenanceItem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.InDelta(t, 45000, resp[0].DueAtMiles, 0.001)
+	require.NotNil(t, resp[0].LastService)
+	assert.InDelta(t, 40000, resp[0].LastService.Miles, 0.001)
+	assert.Nil(t, resp[0].LastService.Notes)
+}
+
+// ---- POST /maintenance/items -----------------------------------------------
+
+func TestCreateMaintenanceItem_201(t *testing.T) {
+	fixture := maintenanceItemFixture()
+	fixture.LastService = nil
+	var got domain.MaintenanceItem
+	svc := &mockMaintenanceServicer{
+		createItem: func(_ context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error) {
+			got = item
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"vehicle": "Motorhome", "name": "Oil change", "interval_miles": 5000})
+	req := httptest.NewRequest(http.MethodPost, "/maintenance/items", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "Oil change", got.Name)
+	assert.InDelta(t, 5000, got.IntervalMiles, 0.001)
+	var resp gen.MaintenanceItem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, fixture.ID, resp.Id)
+	assert.InDelta(t, 5000, resp.DueAtMiles, 0.001, "never serviced, so due at the first interval")
+	assert.Nil(t, resp.LastService)
+}
+
+func TestCreateMaintenanceItem_422(t *testing.T) {
+	svc := &mockMaintenanceServicer{
+		createItem: func(_ context.Context, _ domain.MaintenanceItem) (domain.MaintenanceItem, error) {
+			return domain.MaintenanceItem{}, fmt.Errorf("%w: interval_miles must be positive", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"vehicle": "Motorhome", "name": "Oil change", "interval_miles": 0})
+	req := httptest.NewRequest(http.MethodPost, "/maintenance/items", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- DELETE /maintenance/items/{itemId} ------------------------------------
+
+func TestDeleteMaintenanceItem_404(t *testing.T) {
+	svc := &mockMaintenanceServicer{
+		deleteItem: func(_ context.Context, _ uuid.UUID) error { return domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/maintenance/items/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- POST /maintenance/items/{itemId}/records ------------------------------
+
+func TestCreateMaintenanceRecord_201(t *testing.T) {
+	itemID := uuid.New()
+	var got domain.MaintenanceRecord
+	svc := &mockMaintenanceServicer{
+		recordService: func(_ context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error) {
+			got = rec
+			rec.ID = uuid.New()
+			rec.CreatedAt = time.Now().UTC()
+			return rec, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"miles": 45120, "serviced_on": "2025-06-14", "notes": "Synthetic 15W-40"})
+	req := httptest.NewRequest(http.MethodPost, "/maintenance/items/"+itemID.String()+"/records", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, itemID, got.ItemID)
+	assert.Equal(t, domain.NewDate(2025, 6, 14), got.ServicedOn)
+	assert.Equal(t, "Synthetic 15W-40", got.Notes)
+}
+
+func TestCreateMaintenanceRecord_404(t *testing.T) {
+	svc := &mockMaintenanceServicer{
+		recordService: func(_ context.Context, _ domain.MaintenanceRecord) (domain.MaintenanceRecord, error) {
+			return domain.MaintenanceRecord{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"miles": 45120, "serviced_on": "2025-06-14"})
+	req := httptest.NewRequest(http.MethodPost, "/maintenance/items/"+uuid.NewString()+"/records", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- GET /maintenance/due --------------------------------------------------
+
+func TestListMaintenanceDue_200(t *testing.T) {
+	item := maintenanceItemFixture()
+	var gotWithin float64
+	svc := &mockMaintenanceServicer{
+		due: func(_ context.Context, within float64) ([]domain.MaintenanceDue, error) {
+			gotWithin = within
+			return []domain.MaintenanceDue{domain.CheckMaintenance(item, 44700, within)}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/maintenance/due", nil)
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.InDelta(t, domain.DefaultDueSoonMiles, gotWithin, 0.001, "defaults when within_miles is omitted")
+	var resp []gen.MaintenanceDueItem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, gen.DueSoon, resp[0].Status)
+	assert.InDelta(t, 300, resp[0].MilesRemaining, 0.001)
+	assert.Equal(t, item.ID, resp[0].Item.Id)
+}
+
+func TestListMaintenanceDue_Metric(t *testing.T) {
+	item := maintenanceItemFixture()
+	svc := &mockMaintenanceServicer{
+		due: func(_ context.Context, within float64) ([]domain.MaintenanceDue, error) {
+			return []domain.MaintenanceDue{domain.CheckMaintenance(item, 45100, within)}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/maintenance/due?within_miles=1000", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.MaintenanceDueItem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, gen.Overdue, resp[0].Status)
+	assert.InDelta(t, -160.93, resp[0].MilesRemaining, 1e-9, "kilometers")
+}
+
+func TestListMaintenanceDue_422(t *testing.T) {
+	svc := &mockMaintenanceServicer{
+		due: func(_ context.Context, _ float64) ([]domain.MaintenanceDue, error) {
+			return nil, fmt.Errorf("%w: within must not be negative", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/maintenance/due?within_miles=-1", nil)
+	rec := httptest.NewRecorder()
+
+	newMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
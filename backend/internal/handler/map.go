@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetTripMap handles GET /trips/{id}/map.png.
+func (s *Server) GetTripMap(ctx context.Context, req gen.GetTripMapRequestObject) (gen.GetTripMapResponseObject, error) {
+	var width, height int
+	if req.Params.Width != nil {
+		width = *req.Params.Width
+	}
+	if req.Params.Height != nil {
+		height = *req.Params.Height
+	}
+
+	img, err := s.maps.TripMapPNG(ctx, req.Id, width, height)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripMap404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetTripMap422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.GetTripMap200ImagepngResponse{Body: bytes.NewReader(img), ContentLength: int64(len(img))}, nil
+}
@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock MapServicer ------------------------------------------------------
+
+type mockMapServicer struct {
+	tripMapPNG  func(ctx context.Context, tripID uuid.UUID, width, height int) ([]byte, error)
+	stopsMapPNG func(ctx context.Context, stops []domain.Stop, width, height int) ([]byte, error)
+}
+
+func (m *mockMapServicer) TripMapPNG(ctx context.Context, tripID uuid.UUID, width, height int) ([]byte, error) {
+	return m.tripMapPNG(ctx, tripID, width, height)
+}
+func (m *mockMapServicer) StopsMapPNG(ctx context.Context, stops []domain.Stop, width, height int) ([]byte, error) {
+	return m.stopsMapPNG(ctx, stops, width, height)
+}
+
+// compile-time check: mockMapServicer must satisfy handler.MapServicer.
+var _ handler.MapServicer = (*mockMapServicer)(nil)
+
+// newMapHTTPHandler wires a Server with only the map service mock.
+func newMapHTTPHandler(t *testing.T, svc handler.MapServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Maps: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /trips/{id}/map.png -----------------------------------------------
+
+func TestGetTripMap_200(t *testing.T) {
+	tripID := uuid.New()
+	var gotWidth, gotHeight int
+	svc := &mockMapServicer{
+		tripMapPNG: func(_ context.Context, id uuid.UUID, w, h int) ([]byte, error) {
+			assert.Equal(t, tripID, id)
+			gotWidth, gotHeight = w, h
+			return []byte("\x89PNG\r\n\x1a\n"), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/map.png?width=400&height=300", tripID), nil)
+	rec := httptest.NewRecorder()
+
+	newMapHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "8", rec.Header().Get("Content-Length"))
+	assert.Equal(t, "\x89PNG\r\n\x1a\n", rec.Body.String())
+	assert.Equal(t, 400, gotWidth)
+	assert.Equal(t, 300, gotHeight)
+}
+
+func TestGetTripMap_200_DefaultSize(t *testing.T) {
+	var gotWidth, gotHeight int
+	svc := &mockMapServicer{
+		tripMapPNG: func(_ context.Context, _ uuid.UUID, w, h int) ([]byte, error) {
+			gotWidth, gotHeight = w, h
+			return []byte("png"), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/map.png", nil)
+	rec := httptest.NewRecorder()
+
+	newMapHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Zero(t, gotWidth, "size is left to the service")
+	assert.Zero(t, gotHeight)
+}
+
+func TestGetTripMap_404(t *testing.T) {
+	svc := &mockMapServicer{
+		tripMapPNG: func(_ context.Context, _ uuid.UUID, _, _ int) ([]byte, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/map.png", nil)
+	rec := httptest.NewRecorder()
+
+	newMapHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetTripMap_422(t *testing.T) {
+	svc := &mockMapServicer{
+		tripMapPNG: func(_ context.Context, _ uuid.UUID, _, _ int) ([]byte, error) {
+			return nil, fmt.Errorf("%w: the trip has nothing to draw", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/map.png", nil)
+	rec := httptest.NewRecorder()
+
+	newMapHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "validation_error")
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListNoteTemplates handles GET /note-templates.
+func (s *Server) ListNoteTemplates(ctx context.Context, _ gen.ListNoteTemplatesRequestObject) (gen.ListNoteTemplatesResponseObject, error) {
+	templates, err := s.noteTemplates.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.ListNoteTemplates200JSONResponse, len(templates))
+	for i, t := range templates {
+		resp[i] = noteTemplateToResponse(t)
+	}
+	return resp, nil
+}
+
+// CreateNoteTemplate handles POST /note-templates.
+func (s *Server) CreateNoteTemplate(ctx context.Context, req gen.CreateNoteTemplateRequestObject) (gen.CreateNoteTemplateResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateNoteTemplate422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.noteTemplates.Create(ctx, noteTemplateFromRequest(*req.Body))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateNoteTemplate422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateNoteTemplate201JSONResponse(noteTemplateToResponse(created)), nil
+}
+
+// GetNoteTemplate handles GET /note-templates/{id}.
+func (s *Server) GetNoteTemplate(ctx context.Context, req gen.GetNoteTemplateRequestObject) (gen.GetNoteTemplateResponseObject, error) {
+	t, err := s.noteTemplates.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetNoteTemplate404JSONResponse(notFoundBody("note template not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetNoteTemplate200JSONResponse(noteTemplateToResponse(t)), nil
+}
+
+// UpdateNoteTemplate handles PUT /note-templates/{id}.
+func (s *Server) UpdateNoteTemplate(ctx context.Context, req gen.UpdateNoteTemplateRequestObject) (gen.UpdateNoteTemplateResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateNoteTemplate422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	t := noteTemplateFromRequest(*req.Body)
+	t.ID = req.Id
+	updated, err := s.noteTemplates.Update(ctx, t)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateNoteTemplate404JSONResponse(notFoundBody("note template not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateNoteTemplate422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateNoteTemplate200JSONResponse(noteTemplateToResponse(updated)), nil
+}
+
+// DeleteNoteTemplate handles DELETE /note-templates/{id}.
+func (s *Server) DeleteNoteTemplate(ctx context.Context, req gen.DeleteNoteTemplateRequestObject) (gen.DeleteNoteTemplateResponseObject, error) {
+	if err := s.noteTemplates.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteNoteTemplate404JSONResponse(notFoundBody("note template not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteNoteTemplate204Response{}, nil
+}
+
+// noteTemplateFromRequest converts a request body into a domain.NoteTemplate.
+func noteTemplateFromRequest(body gen.NoteTemplateRequest) domain.NoteTemplate {
+	questions := make([]domain.NoteQuestion, len(body.Questions))
+	for i, q := range body.Questions {
+		questions[i] = domain.NoteQuestion{Key: q.Key, Prompt: q.Prompt}
+		if q.Choices != nil {
+			questions[i].Choices = *q.Choices
+		}
+	}
+	return domain.NoteTemplate{Name: body.Name, Questions: questions}
+}
+
+// noteTemplateToResponse converts a domain.NoteTemplate into the generated type.
+func noteTemplateToResponse(t domain.NoteTemplate) gen.NoteTemplate {
+	questions := make([]gen.NoteQuestion, len(t.Questions))
+	for i, q := range t.Questions {
+		questions[i] = gen.NoteQuestion{Key: q.Key, Prompt: q.Prompt}
+		if len(q.Choices) > 0 {
+			choices := q.Choices
+			questions[i].Choices = &choices
+		}
+	}
+	return gen.NoteTemplate{
+		Id:        t.ID,
+		Name:      t.Name,
+		Questions: questions,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// noteAnswersFromAPI converts note answers from a request; nil stays nil.
+func noteAnswersFromAPI(a *gen.NoteAnswers) domain.NoteAnswers {
+	if a == nil {
+		return nil
+	}
+	return domain.NoteAnswers(*a)
+}
+
+// noteAnswersToAPI converts note answers for a response, omitting them when
+// empty.
+func noteAnswersToAPI(a domain.NoteAnswers) *gen.NoteAnswers {
+	if len(a) == 0 {
+		return nil
+	}
+	v := gen.NoteAnswers(a)
+	return &v
+}
@@ -0,0 +1,194 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock NoteTemplateServicer ---------------------------------------------
+
+type mockNoteTemplateServicer struct {
+	create  func(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error)
+	getByID func(ctx context.Context, id uuid.UUID) (domain.NoteTemplate, error)
+	list    func(ctx context.Context) ([]domain.NoteTemplate, error)
+	update  func(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error)
+	delete  func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockNoteTemplateServicer) Create(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	return m.create(ctx, t)
+}
+func (m *mockNoteTemplateServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.NoteTemplate, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockNoteTemplateServicer) List(ctx context.Context) ([]domain.NoteTemplate, error) {
+	return m.list(ctx)
+}
+func (m *mockNoteTemplateServicer) Update(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	return m.update(ctx, t)
+}
+func (m *mockNoteTemplateServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+// compile-time check: mockNoteTemplateServicer must satisfy handler.NoteTemplateServicer.
+var _ handler.NoteTemplateServicer = (*mockNoteTemplateServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newNoteTemplateHTTPHandler wires a Server with only the note template service mock.
+func newNoteTemplateHTTPHandler(t *testing.T, svc handler.NoteTemplateServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{NoteTemplates: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- /note-templates -------------------------------------------------------
+
+func TestCreateNoteTemplate_201(t *testing.T) {
+	var got domain.NoteTemplate
+	svc := &mockNoteTemplateServicer{
+		create: func(_ context.Context, tmpl domain.NoteTemplate) (domain.NoteTemplate, error) {
+			got = tmpl
+			tmpl.ID, tmpl.CreatedAt, tmpl.UpdatedAt = uuid.New(), time.Now().UTC(), time.Now().UTC()
+			return tmpl, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name": "Campsite",
+		"questions": []map[string]any{
+			{"key": "site_number", "prompt": "Site number"},
+			{"key": "noise_level", "prompt": "Noise level", "choices": []string{"Quiet", "Loud"}},
+		},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/note-templates", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newNoteTemplateHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Len(t, got.Questions, 2)
+	assert.Nil(t, got.Questions[0].Choices)
+	assert.Equal(t, []string{"Quiet", "Loud"}, got.Questions[1].Choices)
+
+	var resp gen.NoteTemplate
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Nil(t, resp.Questions[0].Choices)
+	require.NotNil(t, resp.Questions[1].Choices)
+	assert.Equal(t, []string{"Quiet", "Loud"}, *resp.Questions[1].Choices)
+}
+
+func TestCreateNoteTemplate_422(t *testing.T) {
+	svc := &mockNoteTemplateServicer{
+		create: func(_ context.Context, _ domain.NoteTemplate) (domain.NoteTemplate, error) {
+			return domain.NoteTemplate{}, fmt.Errorf("%w: question key \"site_number\" is repeated", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name": "Campsite",
+		"questions": []map[string]any{
+			{"key": "site_number", "prompt": "Site number"},
+			{"key": "site_number", "prompt": "Site"},
+		},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/note-templates", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newNoteTemplateHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestListNoteTemplates_200(t *testing.T) {
+	svc := &mockNoteTemplateServicer{
+		list: func(_ context.Context) ([]domain.NoteTemplate, error) {
+			return []domain.NoteTemplate{{
+				ID: uuid.New(), Name: "Campsite",
+				Questions: []domain.NoteQuestion{{Key: "site_number", Prompt: "Site number"}},
+				CreatedAt: time.Now().UTC(), UpdatedAt: time.Now().UTC(),
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/note-templates", nil)
+	rec := httptest.NewRecorder()
+
+	newNoteTemplateHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.NoteTemplate
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "Campsite", resp[0].Name)
+}
+
+func TestGetNoteTemplate_404(t *testing.T) {
+	svc := &mockNoteTemplateServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.NoteTemplate, error) {
+			return domain.NoteTemplate{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/note-templates/%s", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newNoteTemplateHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUpdateNoteTemplate_200(t *testing.T) {
+	id := uuid.New()
+	var got domain.NoteTemplate
+	svc := &mockNoteTemplateServicer{
+		update: func(_ context.Context, tmpl domain.NoteTemplate) (domain.NoteTemplate, error) {
+			got = tmpl
+			tmpl.CreatedAt, tmpl.UpdatedAt = time.Now().UTC(), time.Now().UTC()
+			return tmpl, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":      "Campsite",
+		"questions": []map[string]any{{"key": "site_number", "prompt": "Site #"}},
+	})
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/note-templates/%s", id), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newNoteTemplateHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, "Site #", got.Questions[0].Prompt)
+}
+
+func TestDeleteNoteTemplate_204(t *testing.T) {
+	svc := &mockNoteTemplateServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/note-templates/%s", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newNoteTemplateHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// CreateOdometerReading handles POST /odometer-readings.
+func (s *Server) CreateOdometerReading(ctx context.Context, req gen.CreateOdometerReadingRequestObject) (gen.CreateOdometerReadingResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateOdometerReading422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.odometer.Create(ctx, domain.OdometerReading{
+		Vehicle:    req.Body.Vehicle,
+		Miles:      req.Body.Miles,
+		RecordedAt: req.Body.RecordedAt,
+		TripID:     req.Body.TripId,
+		Notes:      derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateOdometerReading404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateOdometerReading422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateOdometerReading201JSONResponse(odometerReadingToResponse(created, unitsFor(req.Params.Units))), nil
+}
+
+// ListOdometerReadings handles GET /odometer-readings.
+// Supports ?vehicle=, ?trip_id=, ?page= and ?limit= (defaults: page=1, limit and its cap from s.pagination.Readings).
+func (s *Server) ListOdometerReadings(ctx context.Context, req gen.ListOdometerReadingsRequestObject) (gen.ListOdometerReadingsResponseObject, error) {
+	params := s.pagination.Readings.Params(req.Params.Page, req.Params.Limit)
+	filter := domain.OdometerFilter{
+		Vehicle: derefString(req.Params.Vehicle),
+		TripID:  req.Params.TripId,
+	}
+
+	readings, total, err := s.odometer.ListPaged(ctx, filter, params)
+	if err != nil {
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	data := make([]gen.OdometerReading, len(readings))
+	for i, r := range readings {
+		data[i] = odometerReadingToResponse(r, u)
+	}
+	return gen.ListOdometerReadings200JSONResponse{
+		Data:       data,
+		Pagination: paginationMeta(params, s.pagination.Readings, total),
+	}, nil
+}
+
+// GetOdometerReading handles GET /odometer-readings/{id}.
+func (s *Server) GetOdometerReading(ctx context.Context, req gen.GetOdometerReadingRequestObject) (gen.GetOdometerReadingResponseObject, error) {
+	reading, err := s.odometer.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetOdometerReading404JSONResponse(notFoundBody("odometer reading not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetOdometerReading200JSONResponse(odometerReadingToResponse(reading, unitsFor(req.Params.Units))), nil
+}
+
+// DeleteOdometerReading handles DELETE /odometer-readings/{id}.
+func (s *Server) DeleteOdometerReading(ctx context.Context, req gen.DeleteOdometerReadingRequestObject) (gen.DeleteOdometerReadingResponseObject, error) {
+	if err := s.odometer.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteOdometerReading404JSONResponse(notFoundBody("odometer reading not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteOdometerReading204Response{}, nil
+}
+
+// GetTripMileage handles GET /trips/{id}/mileage.
+func (s *Server) GetTripMileage(ctx context.Context, req gen.GetTripMileageRequestObject) (gen.GetTripMileageResponseObject, error) {
+	m, err := s.odometer.TripMileage(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripMileage404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	vehicles := make([]gen.VehicleMileage, len(m.Vehicles))
+	for i, v := range m.Vehicles {
+		vehicles[i] = gen.VehicleMileage{
+			Vehicle:    v.Vehicle,
+			StartMiles: u.distance(v.StartMiles),
+			EndMiles:   u.distance(v.EndMiles),
+			Miles:      u.distance(v.Miles),
+			Readings:   v.Readings,
+		}
+	}
+	return gen.GetTripMileage200JSONResponse{
+		TripId:     m.TripID,
+		TotalMiles: u.distance(m.TotalMiles),
+		Vehicles:   vehicles,
+	}, nil
+}
+
+// odometerReadingToResponse converts a domain.OdometerReading into the generated type.
+func odometerReadingToResponse(r domain.OdometerReading, u units) gen.OdometerReading {
+	resp := gen.OdometerReading{
+		Id:         r.ID,
+		Vehicle:    r.Vehicle,
+		Miles:      u.distance(r.Miles),
+		RecordedAt: r.RecordedAt,
+		TripId:     r.TripID,
+		CreatedAt:  r.CreatedAt,
+	}
+	if r.Notes != "" {
+		notes := r.Notes
+		resp.Notes = &notes
+	}
+	return resp
+}
@@ -0,0 +1,284 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock OdometerServicer -------------------------------------------------
+
+type mockOdometerServicer struct {
+	create      func(ctx context.Context, r domain.OdometerReading) (domain.OdometerReading, error)
+	getByID     func(ctx context.Context, id uuid.UUID) (domain.OdometerReading, error)
+	listPaged   func(ctx context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error)
+	delete      func(ctx context.Context, id uuid.UUID) error
+	tripMileage func(ctx context.Context, tripID uuid.UUID) (domain.TripMileage, error)
+}
+
+func (m *mockOdometerServicer) Create(ctx context.Context, r domain.OdometerReading) (domain.OdometerReading, error) {
+	return m.create(ctx, r)
+}
+func (m *mockOdometerServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.OdometerReading, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockOdometerServicer) ListPaged(ctx context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error) {
+	return m.listPaged(ctx, f, p)
+}
+func (m *mockOdometerServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+func (m *mockOdometerServicer) TripMileage(ctx context.Context, tripID uuid.UUID) (domain.TripMileage, error) {
+	return m.tripMileage(ctx, tripID)
+}
+
+// compile-time check: mockOdometerServicer must satisfy handler.OdometerServicer.
+var _ handler.OdometerServicer = (*mockOdometerServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newOdometerHTTPHandler wires a Server with only the odometer service mock.
+func newOdometerHTTPHandler(t *testing.T, svc handler.OdometerServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Odometer: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func odometerFixture() domain.OdometerReading {
+	tripID := uuid.New()
+	return domain.OdometerReading{
+		ID:         uuid.New(),
+		Vehicle:    "Motorhome",
+		Miles:      48213.6,
+		RecordedAt: time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC),
+		TripID:     &tripID,
+		Notes:      "Before leaving Moab",
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// ---- POST /odometer-readings -----------------------------------------------
+
+func TestCreateOdometerReading_201(t *testing.T) {
+	fixture := odometerFixture()
+	var got domain.OdometerReading
+	svc := &mockOdometerServicer{
+		create: func(_ context.Context, r domain.OdometerReading) (domain.OdometerReading, error) {
+			got = r
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"vehicle":     "Motorhome",
+		"miles":       48213.6,
+		"recorded_at": "2025-06-01T08:30:00Z",
+		"trip_id":     fixture.TripID.String(),
+		"notes":       "Before leaving Moab",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/odometer-readings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "Motorhome", got.Vehicle)
+	assert.InDelta(t, 48213.6, got.Miles, 0.001)
+	require.NotNil(t, got.TripID)
+	assert.Equal(t, *fixture.TripID, *got.TripID)
+
+	var resp gen.OdometerReading
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, fixture.ID, resp.Id)
+	require.NotNil(t, resp.Notes)
+	assert.Equal(t, "Before leaving Moab", *resp.Notes)
+}
+
+func TestCreateOdometerReading_422(t *testing.T) {
+	svc := &mockOdometerServicer{
+		create: func(_ context.Context, _ domain.OdometerReading) (domain.OdometerReading, error) {
+			return domain.OdometerReading{}, fmt.Errorf("%w: miles must be at least 1000.0, the reading for Motorhome on 2025-05-30", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"vehicle": "Motorhome", "miles": 900, "recorded_at": "2025-06-01T08:30:00Z"})
+	req := httptest.NewRequest(http.MethodPost, "/odometer-readings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "validation_error", resp.Error.Code)
+	assert.Contains(t, resp.Error.Message, "at least 1000.0")
+}
+
+func TestCreateOdometerReading_404_UnknownTrip(t *testing.T) {
+	svc := &mockOdometerServicer{
+		create: func(_ context.Context, _ domain.OdometerReading) (domain.OdometerReading, error) {
+			return domain.OdometerReading{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"vehicle": "Motorhome", "miles": 1, "recorded_at": "2025-06-01T08:30:00Z", "trip_id": uuid.NewString(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/odometer-readings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- GET /odometer-readings ------------------------------------------------
+
+func TestListOdometerReadings_200_Filters(t *testing.T) {
+	tripID := uuid.New()
+	var gotFilter domain.OdometerFilter
+	var gotPage domain.PaginationParams
+	svc := &mockOdometerServicer{
+		listPaged: func(_ context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error) {
+			gotFilter, gotPage = f, p
+			return []domain.OdometerReading{odometerFixture()}, 7, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/odometer-readings?vehicle=Motorhome&trip_id="+tripID.String()+"&page=2&limit=5", nil)
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Motorhome", gotFilter.Vehicle)
+	require.NotNil(t, gotFilter.TripID)
+	assert.Equal(t, tripID, *gotFilter.TripID)
+	assert.Equal(t, domain.PaginationParams{Page: 2, Limit: 5}, gotPage)
+
+	var resp gen.OdometerReadingList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, 7, resp.Pagination.Total)
+}
+
+// ---- GET/DELETE /odometer-readings/{id} ------------------------------------
+
+func TestGetOdometerReading_404(t *testing.T) {
+	svc := &mockOdometerServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.OdometerReading, error) {
+			return domain.OdometerReading{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/odometer-readings/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "not_found", resp.Error.Code)
+}
+
+func TestDeleteOdometerReading_204(t *testing.T) {
+	svc := &mockOdometerServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/odometer-readings/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+// ---- GET /trips/{id}/mileage -----------------------------------------------
+
+func TestGetTripMileage_200(t *testing.T) {
+	tripID := uuid.New()
+	svc := &mockOdometerServicer{
+		tripMileage: func(_ context.Context, id uuid.UUID) (domain.TripMileage, error) {
+			return domain.TripMileage{
+				TripID:     id,
+				TotalMiles: 410,
+				Vehicles: []domain.VehicleMileage{
+					{Vehicle: "Motorhome", StartMiles: 48000, EndMiles: 48410, Miles: 410, Readings: 3},
+				},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/mileage", nil)
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.TripMileage
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, tripID, resp.TripId)
+	assert.InDelta(t, 410, resp.TotalMiles, 0.001)
+	require.Len(t, resp.Vehicles, 1)
+	assert.Equal(t, 3, resp.Vehicles[0].Readings)
+}
+
+func TestGetTripMileage_200_Metric(t *testing.T) {
+	svc := &mockOdometerServicer{
+		tripMileage: func(_ context.Context, id uuid.UUID) (domain.TripMileage, error) {
+			return domain.TripMileage{
+				TripID:     id,
+				TotalMiles: 410,
+				Vehicles: []domain.VehicleMileage{
+					{Vehicle: "Motorhome", StartMiles: 48000, EndMiles: 48410, Miles: 410, Readings: 3},
+				},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/mileage", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.TripMileage
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.InDelta(t, 659.83, resp.TotalMiles, 1e-9, "kilometers")
+	require.Len(t, resp.Vehicles, 1)
+	assert.InDelta(t, 77248.51, resp.Vehicles[0].StartMiles, 1e-9)
+	assert.InDelta(t, 77908.34, resp.Vehicles[0].EndMiles, 1e-9)
+}
+
+func TestGetTripMileage_404(t *testing.T) {
+	svc := &mockOdometerServicer{
+		tripMileage: func(_ context.Context, _ uuid.UUID) (domain.TripMileage, error) {
+			return domain.TripMileage{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/mileage", nil)
+	rec := httptest.NewRecorder()
+
+	newOdometerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
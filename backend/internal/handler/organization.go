@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListOrganizations handles GET /organizations.
+func (s *Server) ListOrganizations(ctx context.Context, req gen.ListOrganizationsRequestObject) (gen.ListOrganizationsResponseObject, error) {
+	orgs, err := s.orgs.List(ctx)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.ListOrganizations401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListOrganizations200JSONResponse, len(orgs))
+	for i, o := range orgs {
+		resp[i] = organizationToResponse(o)
+	}
+	return resp, nil
+}
+
+// CreateOrganization handles POST /organizations.
+func (s *Server) CreateOrganization(ctx context.Context, req gen.CreateOrganizationRequestObject) (gen.CreateOrganizationResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateOrganization422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.orgs.Create(ctx, req.Body.Name)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.CreateOrganization401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateOrganization422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateOrganization201JSONResponse(organizationToResponse(created)), nil
+}
+
+// GetOrganization handles GET /organizations/{orgId}.
+func (s *Server) GetOrganization(ctx context.Context, req gen.GetOrganizationRequestObject) (gen.GetOrganizationResponseObject, error) {
+	org, err := s.orgs.GetByID(ctx, req.OrgId)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.GetOrganization401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.GetOrganization403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetOrganization404JSONResponse(notFoundBody("organization not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetOrganization200JSONResponse(organizationToResponse(org)), nil
+}
+
+// PatchOrganization handles PATCH /organizations/{orgId}.
+func (s *Server) PatchOrganization(ctx context.Context, req gen.PatchOrganizationRequestObject) (gen.PatchOrganizationResponseObject, error) {
+	if req.Body == nil {
+		return gen.PatchOrganization422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	org, err := s.orgs.Rename(ctx, req.OrgId, req.Body.Name)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.PatchOrganization401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.PatchOrganization403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.PatchOrganization404JSONResponse(notFoundBody("organization not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.PatchOrganization422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.PatchOrganization200JSONResponse(organizationToResponse(org)), nil
+}
+
+// DeleteOrganization handles DELETE /organizations/{orgId}.
+func (s *Server) DeleteOrganization(ctx context.Context, req gen.DeleteOrganizationRequestObject) (gen.DeleteOrganizationResponseObject, error) {
+	if err := s.orgs.Delete(ctx, req.OrgId); err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.DeleteOrganization401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.DeleteOrganization403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteOrganization404JSONResponse(notFoundBody("organization not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.DeleteOrganization422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteOrganization204Response{}, nil
+}
+
+// ListOrganizationMembers handles GET /organizations/{orgId}/members.
+func (s *Server) ListOrganizationMembers(ctx context.Context, req gen.ListOrganizationMembersRequestObject) (gen.ListOrganizationMembersResponseObject, error) {
+	members, err := s.orgs.ListMembers(ctx, req.OrgId)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.ListOrganizationMembers401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.ListOrganizationMembers403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListOrganizationMembers404JSONResponse(notFoundBody("organization not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListOrganizationMembers200JSONResponse, len(members))
+	for i, m := range members {
+		resp[i] = memberToResponse(m)
+	}
+	return resp, nil
+}
+
+// AddOrganizationMember handles POST /organizations/{orgId}/members.
+func (s *Server) AddOrganizationMember(ctx context.Context, req gen.AddOrganizationMemberRequestObject) (gen.AddOrganizationMemberResponseObject, error) {
+	if req.Body == nil {
+		return gen.AddOrganizationMember422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	m, err := s.orgs.AddMember(ctx, req.OrgId, req.Body.UserId, domain.MemberRole(req.Body.Role))
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.AddOrganizationMember401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.AddOrganizationMember403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.AddOrganizationMember404JSONResponse(notFoundBody("organization not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.AddOrganizationMember422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.AddOrganizationMember201JSONResponse(memberToResponse(m)), nil
+}
+
+// PatchOrganizationMember handles PATCH /organizations/{orgId}/members/{userId}.
+func (s *Server) PatchOrganizationMember(ctx context.Context, req gen.PatchOrganizationMemberRequestObject) (gen.PatchOrganizationMemberResponseObject, error) {
+	if req.Body == nil {
+		return gen.PatchOrganizationMember422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	m, err := s.orgs.UpdateMemberRole(ctx, req.OrgId, req.UserId, domain.MemberRole(req.Body.Role))
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.PatchOrganizationMember401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.PatchOrganizationMember403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.PatchOrganizationMember404JSONResponse(notFoundBody("member not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.PatchOrganizationMember422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.PatchOrganizationMember200JSONResponse(memberToResponse(m)), nil
+}
+
+// RemoveOrganizationMember handles DELETE /organizations/{orgId}/members/{userId}.
+func (s *Server) RemoveOrganizationMember(ctx context.Context, req gen.RemoveOrganizationMemberRequestObject) (gen.RemoveOrganizationMemberResponseObject, error) {
+	if err := s.orgs.RemoveMember(ctx, req.OrgId, req.UserId); err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return gen.RemoveOrganizationMember401JSONResponse(unauthorizedBody("sign in to manage organizations")), nil
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return gen.RemoveOrganizationMember403JSONResponse(forbiddenBody(err)), nil
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RemoveOrganizationMember404JSONResponse(notFoundBody("member not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.RemoveOrganizationMember422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.RemoveOrganizationMember204Response{}, nil
+}
+
+// organizationToResponse converts a domain.Organization to the generated API type.
+func organizationToResponse(o domain.Organization) gen.Organization {
+	return gen.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+// memberToResponse converts a domain.Member to the generated API type.
+func memberToResponse(m domain.Member) gen.OrganizationMember {
+	return gen.OrganizationMember{
+		OrganizationId: m.OrganizationID,
+		UserId:         m.UserID,
+		Role:           gen.OrganizationRole(m.Role),
+		CreatedAt:      m.CreatedAt,
+	}
+}
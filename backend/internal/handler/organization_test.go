@@ -0,0 +1,262 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock OrganizationServicer ---------------------------------------------
+
+type mockOrganizationServicer struct {
+	create           func(ctx context.Context, name string) (domain.Organization, error)
+	getByID          func(ctx context.Context, id uuid.UUID) (domain.Organization, error)
+	list             func(ctx context.Context) ([]domain.Organization, error)
+	rename           func(ctx context.Context, id uuid.UUID, name string) (domain.Organization, error)
+	delete           func(ctx context.Context, id uuid.UUID) error
+	listMembers      func(ctx context.Context, orgID uuid.UUID) ([]domain.Member, error)
+	addMember        func(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error)
+	updateMemberRole func(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error)
+	removeMember     func(ctx context.Context, orgID uuid.UUID, userID string) error
+}
+
+func (m *mockOrganizationServicer) Create(ctx context.Context, name string) (domain.Organization, error) {
+	return m.create(ctx, name)
+}
+
+func (m *mockOrganizationServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error) {
+	return m.getByID(ctx, id)
+}
+
+func (m *mockOrganizationServicer) List(ctx context.Context) ([]domain.Organization, error) {
+	return m.list(ctx)
+}
+
+func (m *mockOrganizationServicer) Rename(ctx context.Context, id uuid.UUID, name string) (domain.Organization, error) {
+	return m.rename(ctx, id, name)
+}
+
+func (m *mockOrganizationServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+func (m *mockOrganizationServicer) ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.Member, error) {
+	return m.listMembers(ctx, orgID)
+}
+
+func (m *mockOrganizationServicer) AddMember(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+	return m.addMember(ctx, orgID, userID, role)
+}
+
+func (m *mockOrganizationServicer) UpdateMemberRole(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+	return m.updateMemberRole(ctx, orgID, userID, role)
+}
+
+func (m *mockOrganizationServicer) RemoveMember(ctx context.Context, orgID uuid.UUID, userID string) error {
+	return m.removeMember(ctx, orgID, userID)
+}
+
+// compile-time check: mockOrganizationServicer must satisfy handler.OrganizationServicer.
+var _ handler.OrganizationServicer = (*mockOrganizationServicer)(nil)
+
+func newOrganizationHTTPHandler(t *testing.T, svc handler.OrganizationServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Orgs: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- /organizations --------------------------------------------------------
+
+func TestListOrganizations_200(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		list: func(_ context.Context) ([]domain.Organization, error) {
+			return []domain.Organization{{ID: uuid.New(), Name: "The Smiths", CreatedAt: time.Now(), UpdatedAt: time.Now()}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/organizations", nil)
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.Organization
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "The Smiths", resp[0].Name)
+}
+
+func TestCreateOrganization_201(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		create: func(_ context.Context, name string) (domain.Organization, error) {
+			assert.Equal(t, "The Smiths", name)
+			return domain.Organization{ID: uuid.New(), Name: name, CreatedAt: time.Now(), UpdatedAt: time.Now()}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/organizations", strings.NewReader(`{"name":"The Smiths"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+func TestCreateOrganization_422(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		create: func(_ context.Context, _ string) (domain.Organization, error) {
+			return domain.Organization{}, fmt.Errorf("service.OrganizationService.Create: %w: name is required", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/organizations", strings.NewReader(`{"name":" "}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "name is required", resp.Error.Message)
+}
+
+func TestListOrganizations_401_Anonymous(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		list: func(_ context.Context) ([]domain.Organization, error) {
+			return nil, fmt.Errorf("service.OrganizationService.List: sign in to manage organizations: %w", domain.ErrUnauthorized)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/organizations", nil)
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+// ---- /organizations/{orgId} ------------------------------------------------
+
+func TestGetOrganization_404(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Organization, error) {
+			return domain.Organization{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/organizations/%s", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPatchOrganization_403_NotOwner(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		rename: func(_ context.Context, _ uuid.UUID, _ string) (domain.Organization, error) {
+			return domain.Organization{}, fmt.Errorf("service.OrganizationService.Rename: %w: only the organization's owners may do this", domain.ErrForbidden)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/organizations/%s", uuid.New()), strings.NewReader(`{"name":"Mine now"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "forbidden", resp.Error.Code)
+	assert.Equal(t, "only the organization's owners may do this", resp.Error.Message)
+}
+
+func TestDeleteOrganization_422_HasTrips(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error {
+			return fmt.Errorf("repo.OrganizationRepo.Delete: %w: the organization still has trips", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/organizations/%s", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- /organizations/{orgId}/members ----------------------------------------
+
+func TestAddOrganizationMember_201(t *testing.T) {
+	orgID := uuid.New()
+	svc := &mockOrganizationServicer{
+		addMember: func(_ context.Context, gotOrg uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+			assert.Equal(t, orgID, gotOrg)
+			return domain.Member{OrganizationID: gotOrg, UserID: userID, Role: role, CreatedAt: time.Now()}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/organizations/%s/members", orgID), strings.NewReader(`{"user_id":"user-2","role":"member"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var resp gen.OrganizationMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "user-2", resp.UserId)
+	assert.Equal(t, gen.Member, resp.Role)
+}
+
+func TestAddOrganizationMember_403_NotMember(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		addMember: func(_ context.Context, _ uuid.UUID, _ string, _ domain.MemberRole) (domain.Member, error) {
+			return domain.Member{}, fmt.Errorf("service.OrganizationService.AddMember: %w: you are not a member of this organization", domain.ErrForbidden)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/organizations/%s/members", uuid.New()), strings.NewReader(`{"user_id":"intruder","role":"owner"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRemoveOrganizationMember_422_LastOwner(t *testing.T) {
+	svc := &mockOrganizationServicer{
+		removeMember: func(_ context.Context, _ uuid.UUID, userID string) error {
+			assert.Equal(t, "user-1", userID)
+			return fmt.Errorf("repo.OrganizationRepo.RemoveMember: %w: an organization must keep at least one owner", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/organizations/%s/members/user-1", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newOrganizationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "an organization must keep at least one owner", resp.Error.Message)
+}
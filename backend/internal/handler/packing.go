@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListPackingLists handles GET /packing-lists.
+func (s *Server) ListPackingLists(ctx context.Context, req gen.ListPackingListsRequestObject) (gen.ListPackingListsResponseObject, error) {
+	f := domain.PackingListFilter{TripID: req.Params.TripId}
+	if req.Params.Templates != nil {
+		f.Templates = *req.Params.Templates
+	}
+
+	lists, err := s.packing.ListLists(ctx, f)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListPackingLists422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListPackingLists200JSONResponse, len(lists))
+	for i, l := range lists {
+		resp[i] = packingListToResponse(l)
+	}
+	return resp, nil
+}
+
+// CreatePackingList handles POST /packing-lists.
+func (s *Server) CreatePackingList(ctx context.Context, req gen.CreatePackingListRequestObject) (gen.CreatePackingListResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreatePackingList422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.packing.CreateList(ctx, domain.PackingList{
+		TripID: req.Body.TripId,
+		Name:   derefString(req.Body.Name),
+	}, req.Body.SourceListId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreatePackingList404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreatePackingList422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreatePackingList201JSONResponse(packingListToResponse(created)), nil
+}
+
+// GetPackingList handles GET /packing-lists/{id}.
+func (s *Server) GetPackingList(ctx context.Context, req gen.GetPackingListRequestObject) (gen.GetPackingListResponseObject, error) {
+	l, err := s.packing.GetList(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetPackingList404JSONResponse(notFoundBody("packing list not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetPackingList200JSONResponse(packingListToResponse(l)), nil
+}
+
+// UpdatePackingList handles PUT /packing-lists/{id}.
+func (s *Server) UpdatePackingList(ctx context.Context, req gen.UpdatePackingListRequestObject) (gen.UpdatePackingListResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdatePackingList422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	updated, err := s.packing.RenameList(ctx, req.Id, req.Body.Name)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdatePackingList404JSONResponse(notFoundBody("packing list not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdatePackingList422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdatePackingList200JSONResponse(packingListToResponse(updated)), nil
+}
+
+// DeletePackingList handles DELETE /packing-lists/{id}.
+func (s *Server) DeletePackingList(ctx context.Context, req gen.DeletePackingListRequestObject) (gen.DeletePackingListResponseObject, error) {
+	if err := s.packing.DeleteList(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeletePackingList404JSONResponse(notFoundBody("packing list not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeletePackingList204Response{}, nil
+}
+
+// CreatePackingItem handles POST /packing-lists/{id}/items.
+func (s *Server) CreatePackingItem(ctx context.Context, req gen.CreatePackingItemRequestObject) (gen.CreatePackingItemResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreatePackingItem422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	item := domain.PackingItem{ListID: req.Id, Name: req.Body.Name, Quantity: 1}
+	if req.Body.Quantity != nil {
+		item.Quantity = *req.Body.Quantity
+	}
+	if req.Body.Packed != nil {
+		item.Packed = *req.Body.Packed
+	}
+
+	created, err := s.packing.AddItem(ctx, item)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreatePackingItem404JSONResponse(notFoundBody("packing list not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreatePackingItem422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreatePackingItem201JSONResponse(packingItemToResponse(created)), nil
+}
+
+// UpdatePackingItem handles PUT /packing-lists/{id}/items/{itemId}.
+func (s *Server) UpdatePackingItem(ctx context.Context, req gen.UpdatePackingItemRequestObject) (gen.UpdatePackingItemResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdatePackingItem422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	updated, err := s.packing.UpdateItem(ctx, domain.PackingItem{
+		ID:       req.ItemId,
+		ListID:   req.Id,
+		Name:     req.Body.Name,
+		Quantity: req.Body.Quantity,
+		Packed:   req.Body.Packed,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdatePackingItem404JSONResponse(notFoundBody("packing item not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdatePackingItem422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdatePackingItem200JSONResponse(packingItemToResponse(updated)), nil
+}
+
+// DeletePackingItem handles DELETE /packing-lists/{id}/items/{itemId}.
+func (s *Server) DeletePackingItem(ctx context.Context, req gen.DeletePackingItemRequestObject) (gen.DeletePackingItemResponseObject, error) {
+	if err := s.packing.DeleteItem(ctx, req.Id, req.ItemId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeletePackingItem404JSONResponse(notFoundBody("packing item not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeletePackingItem204Response{}, nil
+}
+
+// packingListToResponse converts a domain.PackingList into the generated type.
+func packingListToResponse(l domain.PackingList) gen.PackingList {
+	items := make([]gen.PackingItem, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = packingItemToResponse(item)
+	}
+	return gen.PackingList{
+		Id:         l.ID,
+		TripId:     l.TripID,
+		Name:       l.Name,
+		IsTemplate: l.IsTemplate(),
+		Items:      items,
+		CreatedAt:  l.CreatedAt,
+		UpdatedAt:  l.UpdatedAt,
+	}
+}
+
+// packingItemToResponse converts a domain.PackingItem into the generated type.
+func packingItemToResponse(item domain.PackingItem) gen.PackingItem {
+	return gen.PackingItem{
+		Id:        item.ID,
+		ListId:    item.ListID,
+		Position:  item.Position,
+		Name:      item.Name,
+		Quantity:  item.Quantity,
+		Packed:    item.Packed,
+		CreatedAt: item.CreatedAt,
+	}
+}
@@ -0,0 +1,252 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock PackingServicer --------------------------------------------------
+
+type mockPackingServicer struct {
+	createList func(ctx context.Context, l domain.PackingList, sourceID *uuid.UUID) (domain.PackingList, error)
+	getList    func(ctx context.Context, id uuid.UUID) (domain.PackingList, error)
+	listLists  func(ctx context.Context, f domain.PackingListFilter) ([]domain.PackingList, error)
+	renameList func(ctx context.Context, id uuid.UUID, name string) (domain.PackingList, error)
+	deleteList func(ctx context.Context, id uuid.UUID) error
+	addItem    func(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error)
+	updateItem func(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error)
+	deleteItem func(ctx context.Context, listID, id uuid.UUID) error
+}
+
+func (m *mockPackingServicer) CreateList(ctx context.Context, l domain.PackingList, sourceID *uuid.UUID) (domain.PackingList, error) {
+	return m.createList(ctx, l, sourceID)
+}
+func (m *mockPackingServicer) GetList(ctx context.Context, id uuid.UUID) (domain.PackingList, error) {
+	return m.getList(ctx, id)
+}
+func (m *mockPackingServicer) ListLists(ctx context.Context, f domain.PackingListFilter) ([]domain.PackingList, error) {
+	return m.listLists(ctx, f)
+}
+func (m *mockPackingServicer) RenameList(ctx context.Context, id uuid.UUID, name string) (domain.PackingList, error) {
+	return m.renameList(ctx, id, name)
+}
+func (m *mockPackingServicer) DeleteList(ctx context.Context, id uuid.UUID) error {
+	return m.deleteList(ctx, id)
+}
+func (m *mockPackingServicer) AddItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	return m.addItem(ctx, item)
+}
+func (m *mockPackingServicer) UpdateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	return m.updateItem(ctx, item)
+}
+func (m *mockPackingServicer) DeleteItem(ctx context.Context, listID, id uuid.UUID) error {
+	return m.deleteItem(ctx, listID, id)
+}
+
+// compile-time check: mockPackingServicer must satisfy handler.PackingServicer.
+var _ handler.PackingServicer = (*mockPackingServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newPackingHTTPHandler wires a Server with only the packing service mock.
+func newPackingHTTPHandler(t *testing.T, svc handler.PackingServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Packing: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- /packing-lists --------------------------------------------------------
+
+func TestCreatePackingList_201FromSource(t *testing.T) {
+	tripID, sourceID := uuid.New(), uuid.New()
+	var got domain.PackingList
+	var gotSource *uuid.UUID
+	svc := &mockPackingServicer{
+		createList: func(_ context.Context, l domain.PackingList, src *uuid.UUID) (domain.PackingList, error) {
+			got, gotSource = l, src
+			l.ID, l.Name, l.CreatedAt, l.UpdatedAt = uuid.New(), "Summer basics", time.Now().UTC(), time.Now().UTC()
+			l.Items = []domain.PackingItem{{ID: uuid.New(), ListID: l.ID, Position: 1, Name: "Sewer hose", Quantity: 1}}
+			return l, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"trip_id": tripID, "source_list_id": sourceID})
+	req := httptest.NewRequest(http.MethodPost, "/packing-lists", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, &tripID, got.TripID)
+	assert.Equal(t, "", got.Name)
+	assert.Equal(t, &sourceID, gotSource)
+	var resp gen.PackingList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.False(t, resp.IsTemplate)
+	assert.Len(t, resp.Items, 1)
+}
+
+func TestCreatePackingList_404Trip(t *testing.T) {
+	svc := &mockPackingServicer{
+		createList: func(_ context.Context, _ domain.PackingList, _ *uuid.UUID) (domain.PackingList, error) {
+			return domain.PackingList{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Fall", "trip_id": uuid.New()})
+	req := httptest.NewRequest(http.MethodPost, "/packing-lists", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestListPackingLists_Templates(t *testing.T) {
+	var got domain.PackingListFilter
+	svc := &mockPackingServicer{
+		listLists: func(_ context.Context, f domain.PackingListFilter) ([]domain.PackingList, error) {
+			got = f
+			return []domain.PackingList{{ID: uuid.New(), Name: "Summer basics", Items: []domain.PackingItem{}}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/packing-lists?templates=true", nil)
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, got.Templates)
+	assert.Nil(t, got.TripID)
+	var resp []gen.PackingList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.True(t, resp[0].IsTemplate)
+}
+
+func TestListPackingLists_422(t *testing.T) {
+	svc := &mockPackingServicer{
+		listLists: func(_ context.Context, _ domain.PackingListFilter) ([]domain.PackingList, error) {
+			return nil, fmt.Errorf("%w: trip_id and templates cannot be combined", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/packing-lists?templates=true&trip_id="+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestGetPackingList_404(t *testing.T) {
+	svc := &mockPackingServicer{
+		getList: func(_ context.Context, _ uuid.UUID) (domain.PackingList, error) {
+			return domain.PackingList{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/packing-lists/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- /packing-lists/{id}/items ---------------------------------------------
+
+func TestCreatePackingItem_DefaultsQuantity(t *testing.T) {
+	listID := uuid.New()
+	var got domain.PackingItem
+	svc := &mockPackingServicer{
+		addItem: func(_ context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+			got = item
+			item.ID, item.Position, item.CreatedAt = uuid.New(), 1, time.Now().UTC()
+			return item, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Sewer hose"})
+	req := httptest.NewRequest(http.MethodPost, "/packing-lists/"+listID.String()+"/items", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, listID, got.ListID)
+	assert.Equal(t, 1, got.Quantity)
+	assert.False(t, got.Packed)
+}
+
+func TestCreatePackingItem_422(t *testing.T) {
+	svc := &mockPackingServicer{
+		addItem: func(_ context.Context, _ domain.PackingItem) (domain.PackingItem, error) {
+			return domain.PackingItem{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": " "})
+	req := httptest.NewRequest(http.MethodPost, "/packing-lists/"+uuid.NewString()+"/items", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestUpdatePackingItem_200(t *testing.T) {
+	listID, itemID := uuid.New(), uuid.New()
+	var got domain.PackingItem
+	svc := &mockPackingServicer{
+		updateItem: func(_ context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+			got = item
+			item.Position, item.CreatedAt = 3, time.Now().UTC()
+			return item, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Leveling blocks", "quantity": 8, "packed": true})
+	req := httptest.NewRequest(http.MethodPut, "/packing-lists/"+listID.String()+"/items/"+itemID.String(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, itemID, got.ID)
+	assert.Equal(t, listID, got.ListID)
+	assert.Equal(t, 8, got.Quantity)
+	assert.True(t, got.Packed)
+}
+
+func TestDeletePackingItem_404(t *testing.T) {
+	svc := &mockPackingServicer{
+		deleteItem: func(_ context.Context, _, _ uuid.UUID) error { return domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/packing-lists/"+uuid.NewString()+"/items/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPackingHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
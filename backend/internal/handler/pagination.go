@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// PaginationLimits are the page sizes allowed by each class of list
+// endpoint, echoed in every list response's pagination.
+type PaginationLimits struct {
+	// Default covers trips, stops, tags, and points of interest.
+	Default domain.PaginationLimits
+	// Readings covers the odometer, propane, and power logs, which grow a
+	// row at a time and are often read in long runs.
+	Readings domain.PaginationLimits
+}
+
+// paginationMeta is the pagination block of a list response.
+func paginationMeta(p domain.PaginationParams, l domain.PaginationLimits, total int64) gen.Pagination {
+	return gen.Pagination{
+		Page:         p.Page,
+		Limit:        p.Limit,
+		Total:        int(total),
+		DefaultLimit: l.Default,
+		MaxLimit:     l.Max,
+	}
+}
@@ -0,0 +1,69 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+var testPagination = handler.PaginationLimits{
+	Default:  domain.PaginationLimits{Default: 10, Max: 50},
+	Readings: domain.PaginationLimits{Default: 200, Max: 1000},
+}
+
+func TestListTrips_ConfiguredPaginationLimits(t *testing.T) {
+	var got []domain.PaginationParams
+	trips := &mockTripServicer{
+		listPaged: func(_ context.Context, _ []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+			got = append(got, p)
+			return []domain.Trip{}, 0, nil
+		},
+	}
+	srv := handler.NewServer(handler.ServerDeps{Trips: trips, Pagination: &testPagination})
+	h := testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+
+	for _, target := range []string{"/trips", "/trips?limit=500"} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+		require.Equal(t, http.StatusOK, rec.Code, target)
+		var resp gen.TripList
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.Equal(t, 10, resp.Pagination.DefaultLimit, target)
+		assert.Equal(t, 50, resp.Pagination.MaxLimit, target)
+	}
+	assert.Equal(t, []domain.PaginationParams{{Page: 1, Limit: 10}, {Page: 1, Limit: 50}}, got)
+}
+
+func TestListOdometerReadings_ReadingsPaginationLimits(t *testing.T) {
+	var got domain.PaginationParams
+	odometer := &mockOdometerServicer{
+		listPaged: func(_ context.Context, _ domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error) {
+			got = p
+			return []domain.OdometerReading{}, 0, nil
+		},
+	}
+	srv := handler.NewServer(handler.ServerDeps{Odometer: odometer, Pagination: &testPagination})
+	h := testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/odometer-readings?limit=500", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, domain.PaginationParams{Page: 1, Limit: 500}, got, "readings allow larger pages")
+	var resp gen.OdometerReadingList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 500, resp.Pagination.Limit)
+	assert.Equal(t, 200, resp.Pagination.DefaultLimit)
+	assert.Equal(t, 1000, resp.Pagination.MaxLimit)
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// photoFormMemory is how much of a photo upload is held in memory while the
+// form is parsed; the rest is spooled to a temporary file. The max-body-size
+// middleware bounds the whole upload.
+const photoFormMemory = 8 << 20
+
+// ListStopPhotos handles GET /trips/{tripId}/stops/{stopId}/photos.
+func (s *Server) ListStopPhotos(ctx context.Context, req gen.ListStopPhotosRequestObject) (gen.ListStopPhotosResponseObject, error) {
+	photos, err := s.photos.ListByStop(ctx, req.TripId, req.StopId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListStopPhotos404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListStopPhotos200JSONResponse, len(photos))
+	for i, p := range photos {
+		resp[i] = photoToResponse(p)
+	}
+	return resp, nil
+}
+
+// UploadStopPhoto handles POST /trips/{tripId}/stops/{stopId}/photos.
+func (s *Server) UploadStopPhoto(ctx context.Context, req gen.UploadStopPhotoRequestObject) (gen.UploadStopPhotoResponseObject, error) {
+	form, err := req.Body.ReadForm(photoFormMemory)
+	if err != nil {
+		// A chunked upload carries no Content-Length, so the body-size
+		// middleware can only stop it part-way through the read.
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) || errors.Is(err, multipart.ErrMessageTooLarge) {
+			return gen.UploadStopPhoto413JSONResponse(gen.ErrorResponse{Error: gen.ErrorDetail{
+				Code: "request_too_large", Message: "request body exceeds size limit",
+			}}), nil
+		}
+		return gen.UploadStopPhoto422JSONResponse(requestBody("request body is not a valid multipart form")), nil
+	}
+	defer form.RemoveAll()
+
+	files := form.File["file"]
+	if len(files) == 0 {
+		return gen.UploadStopPhoto422JSONResponse(requestBody("a file part is required")), nil
+	}
+	var caption string
+	if values := form.Value["caption"]; len(values) > 0 {
+		caption = values[0]
+	}
+	f, err := files[0].Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	applyEXIF := req.Params.ApplyExif != nil && *req.Params.ApplyExif
+	photo, err := s.photos.Upload(ctx, req.TripId, req.StopId, files[0].Filename, caption, applyEXIF, f)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UploadStopPhoto404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UploadStopPhoto422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.UploadStopPhoto201JSONResponse(photoToResponse(photo)), nil
+}
+
+// GetStopPhoto handles GET /trips/{tripId}/stops/{stopId}/photos/{photoId}.
+func (s *Server) GetStopPhoto(ctx context.Context, req gen.GetStopPhotoRequestObject) (gen.GetStopPhotoResponseObject, error) {
+	photo, rc, err := s.photos.Open(ctx, req.TripId, req.StopId, req.PhotoId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetStopPhoto404JSONResponse(notFoundBody("photo not found")), nil
+		}
+		return nil, err
+	}
+	// The generated response closes Body once it has been copied out.
+	return gen.GetStopPhoto200ImageResponse{Body: rc, ContentType: photo.ContentType, ContentLength: photo.SizeBytes}, nil
+}
+
+// GetPhotoThumbnail handles GET /photos/{photoId}/thumbnail.
+func (s *Server) GetPhotoThumbnail(ctx context.Context, req gen.GetPhotoThumbnailRequestObject) (gen.GetPhotoThumbnailResponseObject, error) {
+	size := domain.ThumbnailSmall
+	if req.Params.Size != nil {
+		size = domain.ThumbnailSize(*req.Params.Size)
+	}
+	rc, err := s.photos.Thumbnail(ctx, req.PhotoId, size)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetPhotoThumbnail404JSONResponse(notFoundBody("thumbnail not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetPhotoThumbnail422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	// The generated response closes Body once it has been copied out.
+	return gen.GetPhotoThumbnail200ImagejpegResponse{Body: rc}, nil
+}
+
+// DeleteStopPhoto handles DELETE /trips/{tripId}/stops/{stopId}/photos/{photoId}.
+func (s *Server) DeleteStopPhoto(ctx context.Context, req gen.DeleteStopPhotoRequestObject) (gen.DeleteStopPhotoResponseObject, error) {
+	if err := s.photos.Delete(ctx, req.TripId, req.StopId, req.PhotoId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteStopPhoto404JSONResponse(notFoundBody("photo not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteStopPhoto204Response{}, nil
+}
+
+// photoToResponse converts a domain.Photo to its API representation.
+func photoToResponse(p domain.Photo) gen.Photo {
+	sizes := []gen.ThumbnailSize{}
+	for _, size := range domain.ThumbnailSizes {
+		if _, ok := p.Thumbnails[size]; ok {
+			sizes = append(sizes, gen.ThumbnailSize(size))
+		}
+	}
+	return gen.Photo{
+		Id:             p.ID,
+		StopId:         p.StopID,
+		Filename:       nilIfEmpty(p.Filename),
+		Caption:        nilIfEmpty(p.Caption),
+		ContentType:    p.ContentType,
+		SizeBytes:      p.SizeBytes,
+		ThumbnailSizes: sizes,
+		TakenAt:        p.TakenAt,
+		Latitude:       p.Latitude,
+		Longitude:      p.Longitude,
+		CreatedAt:      p.CreatedAt,
+	}
+}
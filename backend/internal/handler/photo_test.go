@@ -0,0 +1,353 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock PhotoServicer ----------------------------------------------------
+
+type mockPhotoServicer struct {
+	upload     func(ctx context.Context, tripID, stopID uuid.UUID, filename, caption string, applyEXIF bool, r io.Reader) (domain.Photo, error)
+	listByStop func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Photo, error)
+	open       func(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Photo, io.ReadCloser, error)
+	thumbnail  func(ctx context.Context, id uuid.UUID, size domain.ThumbnailSize) (io.ReadCloser, error)
+	delete     func(ctx context.Context, tripID, stopID, id uuid.UUID) error
+}
+
+func (m *mockPhotoServicer) Upload(ctx context.Context, tripID, stopID uuid.UUID, filename, caption string, applyEXIF bool, r io.Reader) (domain.Photo, error) {
+	return m.upload(ctx, tripID, stopID, filename, caption, applyEXIF, r)
+}
+func (m *mockPhotoServicer) ListByStop(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Photo, error) {
+	return m.listByStop(ctx, tripID, stopID)
+}
+func (m *mockPhotoServicer) Open(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Photo, io.ReadCloser, error) {
+	return m.open(ctx, tripID, stopID, id)
+}
+func (m *mockPhotoServicer) Thumbnail(ctx context.Context, id uuid.UUID, size domain.ThumbnailSize) (io.ReadCloser, error) {
+	return m.thumbnail(ctx, id, size)
+}
+func (m *mockPhotoServicer) Delete(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	return m.delete(ctx, tripID, stopID, id)
+}
+
+// compile-time check: mockPhotoServicer must satisfy handler.PhotoServicer.
+var _ handler.PhotoServicer = (*mockPhotoServicer)(nil)
+
+func newPhotoHTTPHandler(t *testing.T, svc handler.PhotoServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Photos: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// testPNG is the signature and header of a PNG file, enough for content
+// sniffing.
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00")
+
+// photoForm builds a multipart upload with the given parts. An empty
+// filename leaves out the file part.
+func photoForm(t *testing.T, filename string, data []byte, caption string) (*bytes.Buffer, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if filename != "" {
+		part, err := w.CreateFormFile("file", filename)
+		require.NoError(t, err)
+		_, err = part.Write(data)
+		require.NoError(t, err)
+	}
+	if caption != "" {
+		require.NoError(t, w.WriteField("caption", caption))
+	}
+	require.NoError(t, w.Close())
+	return &buf, w.FormDataContentType()
+}
+
+// ---- POST /trips/{tripId}/stops/{stopId}/photos ----------------------------
+
+func TestUploadStopPhoto_201(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	var gotFilename, gotCaption string
+	var gotData []byte
+	svc := &mockPhotoServicer{
+		upload: func(_ context.Context, tid, sid uuid.UUID, filename, caption string, applyEXIF bool, r io.Reader) (domain.Photo, error) {
+			assert.Equal(t, tripID, tid)
+			assert.False(t, applyEXIF, "off unless asked for")
+			gotFilename, gotCaption = filename, caption
+			var err error
+			gotData, err = io.ReadAll(r)
+			require.NoError(t, err)
+			return domain.Photo{
+				ID: uuid.New(), StopID: sid, ObjectKey: "photos/x.png", ContentType: "image/png",
+				SizeBytes: int64(len(gotData)), Filename: filename, Caption: caption, CreatedAt: time.Now().UTC(),
+			}, nil
+		},
+	}
+	body, contentType := photoForm(t, "lake.png", testPNG, "Sunset over the lake")
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/stops/"+stopID.String()+"/photos", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, "lake.png", gotFilename)
+	assert.Equal(t, "Sunset over the lake", gotCaption)
+	assert.Equal(t, testPNG, gotData)
+	var resp gen.Photo
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, stopID, resp.StopId)
+	assert.Equal(t, "image/png", resp.ContentType)
+	require.NotNil(t, resp.Filename)
+	assert.Equal(t, "lake.png", *resp.Filename)
+}
+
+func TestUploadStopPhoto_201_ApplyEXIF(t *testing.T) {
+	lat, lon := 44.6615, -110.4995
+	takenAt := time.Date(2025, 7, 15, 0, 32, 5, 0, time.UTC)
+	var gotApply bool
+	svc := &mockPhotoServicer{
+		upload: func(_ context.Context, _, sid uuid.UUID, _, _ string, applyEXIF bool, _ io.Reader) (domain.Photo, error) {
+			gotApply = applyEXIF
+			return domain.Photo{
+				ID: uuid.New(), StopID: sid, ContentType: "image/jpeg", SizeBytes: 1,
+				TakenAt: &takenAt, Latitude: &lat, Longitude: &lon, CreatedAt: time.Now().UTC(),
+			}, nil
+		},
+	}
+	body, contentType := photoForm(t, "lake.jpg", testPNG, "")
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos?apply_exif=true", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.True(t, gotApply)
+	var resp gen.Photo
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.TakenAt)
+	assert.True(t, takenAt.Equal(*resp.TakenAt))
+	require.NotNil(t, resp.Latitude)
+	assert.InDelta(t, lat, *resp.Latitude, 1e-9)
+	require.NotNil(t, resp.Longitude)
+	assert.InDelta(t, lon, *resp.Longitude, 1e-9)
+}
+
+func TestUploadStopPhoto_422_NoFile(t *testing.T) {
+	svc := &mockPhotoServicer{}
+	body, contentType := photoForm(t, "", nil, "no photo")
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "a file part is required")
+}
+
+func TestUploadStopPhoto_422_NotAnImage(t *testing.T) {
+	svc := &mockPhotoServicer{
+		upload: func(context.Context, uuid.UUID, uuid.UUID, string, string, bool, io.Reader) (domain.Photo, error) {
+			return domain.Photo{}, fmt.Errorf("%w: the file is not a JPEG, PNG, GIF, or WebP image", domain.ErrValidation)
+		},
+	}
+	body, contentType := photoForm(t, "notes.txt", []byte("hello"), "")
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "not a JPEG")
+}
+
+func TestUploadStopPhoto_404(t *testing.T) {
+	svc := &mockPhotoServicer{
+		upload: func(context.Context, uuid.UUID, uuid.UUID, string, string, bool, io.Reader) (domain.Photo, error) {
+			return domain.Photo{}, domain.ErrNotFound
+		},
+	}
+	body, contentType := photoForm(t, "lake.png", testPNG, "")
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUploadStopPhoto_413(t *testing.T) {
+	svc := &mockPhotoServicer{}
+	body, contentType := photoForm(t, "lake.png", bytes.Repeat([]byte("x"), 4096), "")
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos", body)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	req.Body = http.MaxBytesReader(rec, req.Body, 1024)
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+// ---- GET /trips/{tripId}/stops/{stopId}/photos -----------------------------
+
+func TestListStopPhotos_200(t *testing.T) {
+	stopID := uuid.New()
+	svc := &mockPhotoServicer{
+		listByStop: func(context.Context, uuid.UUID, uuid.UUID) ([]domain.Photo, error) {
+			return []domain.Photo{
+				{ID: uuid.New(), StopID: stopID, ContentType: "image/jpeg", SizeBytes: 10, CreatedAt: time.Now().UTC()},
+				{
+					ID: uuid.New(), StopID: stopID, ContentType: "image/jpeg", SizeBytes: 10, CreatedAt: time.Now().UTC(),
+					Thumbnails: map[domain.ThumbnailSize]string{domain.ThumbnailMedium: "m.jpg", domain.ThumbnailSmall: "s.jpg"},
+				},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/stops/"+stopID.String()+"/photos", nil)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.Photo
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 2)
+	assert.Nil(t, resp[0].Filename)
+	assert.Nil(t, resp[0].Caption)
+	assert.Empty(t, resp[0].ThumbnailSizes)
+	assert.Equal(t, []gen.ThumbnailSize{gen.Small, gen.Medium}, resp[1].ThumbnailSizes)
+}
+
+// ---- GET /trips/{tripId}/stops/{stopId}/photos/{photoId} -------------------
+
+func TestGetStopPhoto_200(t *testing.T) {
+	svc := &mockPhotoServicer{
+		open: func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (domain.Photo, io.ReadCloser, error) {
+			return domain.Photo{ContentType: "image/png", SizeBytes: int64(len(testPNG))}, io.NopCloser(bytes.NewReader(testPNG)), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, fmt.Sprint(len(testPNG)), rec.Header().Get("Content-Length"))
+	assert.Equal(t, testPNG, rec.Body.Bytes())
+}
+
+func TestGetStopPhoto_404(t *testing.T) {
+	svc := &mockPhotoServicer{
+		open: func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (domain.Photo, io.ReadCloser, error) {
+			return domain.Photo{}, nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "photo not found")
+}
+
+// ---- GET /photos/{photoId}/thumbnail ---------------------------------------
+
+func TestGetPhotoThumbnail_200(t *testing.T) {
+	photoID := uuid.New()
+	var gotSize domain.ThumbnailSize
+	svc := &mockPhotoServicer{
+		thumbnail: func(_ context.Context, id uuid.UUID, size domain.ThumbnailSize) (io.ReadCloser, error) {
+			assert.Equal(t, photoID, id)
+			gotSize = size
+			return io.NopCloser(strings.NewReader("\xff\xd8\xff")), nil
+		},
+	}
+
+	for _, tc := range []struct {
+		query string
+		want  domain.ThumbnailSize
+	}{
+		{query: "", want: domain.ThumbnailSmall},
+		{query: "?size=medium", want: domain.ThumbnailMedium},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/photos/"+photoID.String()+"/thumbnail"+tc.query, nil)
+		rec := httptest.NewRecorder()
+		newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, "image/jpeg", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "\xff\xd8\xff", rec.Body.String())
+		assert.Equal(t, tc.want, gotSize, tc.query)
+	}
+}
+
+func TestGetPhotoThumbnail_404(t *testing.T) {
+	svc := &mockPhotoServicer{
+		thumbnail: func(context.Context, uuid.UUID, domain.ThumbnailSize) (io.ReadCloser, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/photos/"+uuid.NewString()+"/thumbnail", nil)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "thumbnail not found")
+}
+
+func TestGetPhotoThumbnail_422(t *testing.T) {
+	svc := &mockPhotoServicer{
+		thumbnail: func(context.Context, uuid.UUID, domain.ThumbnailSize) (io.ReadCloser, error) {
+			return nil, fmt.Errorf("%w: size must be small or medium", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/photos/"+uuid.NewString()+"/thumbnail?size=huge", nil)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- DELETE /trips/{tripId}/stops/{stopId}/photos/{photoId} ----------------
+
+func TestDeleteStopPhoto_204(t *testing.T) {
+	photoID := uuid.New()
+	svc := &mockPhotoServicer{
+		delete: func(_ context.Context, _, _, id uuid.UUID) error {
+			assert.Equal(t, photoID, id)
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.NewString()+"/stops/"+uuid.NewString()+"/photos/"+photoID.String(), nil)
+	rec := httptest.NewRecorder()
+	newPhotoHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
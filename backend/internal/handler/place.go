@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetStatesVisited handles GET /stats/states-visited.
+func (s *Server) GetStatesVisited(ctx context.Context, req gen.GetStatesVisitedRequestObject) (gen.GetStatesVisitedResponseObject, error) {
+	// Coalescing also keeps concurrent reports from geocoding the same stops.
+	report, err := coalesce(ctx, s, flightKey("states_visited", time.Time{}, req.Params.Year), func(ctx context.Context) (domain.StatesVisitedReport, error) {
+		return s.places.StatesVisited(ctx, req.Params.Year)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetStatesVisited422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	regions := make([]gen.RegionVisit, len(report.Regions))
+	for i, v := range report.Regions {
+		regions[i] = gen.RegionVisit{
+			CountryCode:  v.CountryCode,
+			RegionCode:   nilIfEmpty(v.RegionCode),
+			RegionName:   nilIfEmpty(v.RegionName),
+			Stops:        v.Stops,
+			Trips:        v.Trips,
+			FirstVisited: v.FirstVisited,
+			LastVisited:  v.LastVisited,
+		}
+	}
+	return gen.GetStatesVisited200JSONResponse{Regions: regions, UnresolvedStops: report.UnresolvedStops}, nil
+}
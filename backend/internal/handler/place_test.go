@@ -0,0 +1,106 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock PlaceServicer ----------------------------------------------------
+
+type mockPlaceServicer struct {
+	statesVisited func(ctx context.Context, year *int) (domain.StatesVisitedReport, error)
+}
+
+func (m *mockPlaceServicer) StatesVisited(ctx context.Context, year *int) (domain.StatesVisitedReport, error) {
+	return m.statesVisited(ctx, year)
+}
+
+// compile-time check: mockPlaceServicer must satisfy handler.PlaceServicer.
+var _ handler.PlaceServicer = (*mockPlaceServicer)(nil)
+
+// newPlaceHTTPHandler wires a Server with only the place service mock.
+func newPlaceHTTPHandler(t *testing.T, svc handler.PlaceServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Places: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /stats/states-visited ---------------------------------------------
+
+func TestGetStatesVisited_200(t *testing.T) {
+	first := time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC)
+	var gotYear *int
+	svc := &mockPlaceServicer{
+		statesVisited: func(_ context.Context, year *int) (domain.StatesVisitedReport, error) {
+			gotYear = year
+			return domain.StatesVisitedReport{
+				Regions: []domain.RegionVisit{
+					{CountryCode: "CA", RegionCode: "CA-AB", RegionName: "Alberta", Stops: 2, Trips: 1, FirstVisited: first, LastVisited: first.AddDate(0, 0, 4)},
+					{CountryCode: "MX", Stops: 1, Trips: 1, FirstVisited: first, LastVisited: first},
+				},
+				UnresolvedStops: 3,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/states-visited?year=2025", nil)
+	rec := httptest.NewRecorder()
+
+	newPlaceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, gotYear)
+	assert.Equal(t, 2025, *gotYear)
+	var resp gen.StatesVisitedReport
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 3, resp.UnresolvedStops)
+	require.Len(t, resp.Regions, 2)
+	require.NotNil(t, resp.Regions[0].RegionCode)
+	assert.Equal(t, "CA-AB", *resp.Regions[0].RegionCode)
+	assert.Equal(t, 2, resp.Regions[0].Stops)
+	assert.Nil(t, resp.Regions[1].RegionCode, "country without a region")
+}
+
+func TestGetStatesVisited_AllYears(t *testing.T) {
+	svc := &mockPlaceServicer{
+		statesVisited: func(_ context.Context, year *int) (domain.StatesVisitedReport, error) {
+			assert.Nil(t, year)
+			return domain.StatesVisitedReport{Regions: []domain.RegionVisit{}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/states-visited", nil)
+	rec := httptest.NewRecorder()
+
+	newPlaceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"regions":[],"unresolved_stops":0}`, rec.Body.String())
+}
+
+func TestGetStatesVisited_422(t *testing.T) {
+	svc := &mockPlaceServicer{
+		statesVisited: func(_ context.Context, _ *int) (domain.StatesVisitedReport, error) {
+			return domain.StatesVisitedReport{}, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/states-visited?year=12345", nil)
+	rec := httptest.NewRecorder()
+
+	newPlaceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// CreatePointOfInterest handles POST /points-of-interest.
+func (s *Server) CreatePointOfInterest(ctx context.Context, req gen.CreatePointOfInterestRequestObject) (gen.CreatePointOfInterestResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreatePointOfInterest422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	p, tags := requestToPOI(*req.Body)
+	created, err := s.pois.Create(ctx, p, tags)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreatePointOfInterest404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreatePointOfInterest422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreatePointOfInterest201JSONResponse(poiToResponse(created)), nil
+}
+
+// ListPointsOfInterest handles GET /points-of-interest.
+// Supports ?trip_id=, ?category=, ?tag=, ?page= and ?limit= (defaults: page=1, limit and its cap from s.pagination.Default).
+func (s *Server) ListPointsOfInterest(ctx context.Context, req gen.ListPointsOfInterestRequestObject) (gen.ListPointsOfInterestResponseObject, error) {
+	params := s.pagination.Default.Params(req.Params.Page, req.Params.Limit)
+	filter := domain.POIFilter{
+		TripID:  req.Params.TripId,
+		TagSlug: derefString(req.Params.Tag),
+	}
+	if req.Params.Category != nil {
+		filter.Category = domain.POICategory(*req.Params.Category)
+	}
+
+	points, total, err := s.pois.ListPaged(ctx, filter, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListPointsOfInterest422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	data := make([]gen.PointOfInterest, len(points))
+	for i, p := range points {
+		data[i] = poiToResponse(p)
+	}
+	return gen.ListPointsOfInterest200JSONResponse{
+		Data:       data,
+		Pagination: paginationMeta(params, s.pagination.Default, total),
+	}, nil
+}
+
+// GetPointOfInterest handles GET /points-of-interest/{id}.
+func (s *Server) GetPointOfInterest(ctx context.Context, req gen.GetPointOfInterestRequestObject) (gen.GetPointOfInterestResponseObject, error) {
+	p, err := s.pois.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetPointOfInterest404JSONResponse(notFoundBody("point of interest not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetPointOfInterest200JSONResponse(poiToResponse(p)), nil
+}
+
+// UpdatePointOfInterest handles PUT /points-of-interest/{id}.
+func (s *Server) UpdatePointOfInterest(ctx context.Context, req gen.UpdatePointOfInterestRequestObject) (gen.UpdatePointOfInterestResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdatePointOfInterest422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	p, tags := requestToPOI(*req.Body)
+	p.ID = req.Id
+	updated, err := s.pois.Update(ctx, p, tags)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdatePointOfInterest404JSONResponse(notFoundBody("point of interest or trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdatePointOfInterest422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdatePointOfInterest200JSONResponse(poiToResponse(updated)), nil
+}
+
+// DeletePointOfInterest handles DELETE /points-of-interest/{id}.
+func (s *Server) DeletePointOfInterest(ctx context.Context, req gen.DeletePointOfInterestRequestObject) (gen.DeletePointOfInterestResponseObject, error) {
+	if err := s.pois.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeletePointOfInterest404JSONResponse(notFoundBody("point of interest not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeletePointOfInterest204Response{}, nil
+}
+
+// requestToPOI converts a request body into a domain.PointOfInterest and its
+// tag names. The caller sets ID on update.
+func requestToPOI(body gen.PointOfInterestRequest) (domain.PointOfInterest, []string) {
+	p := domain.PointOfInterest{
+		TripID:    body.TripId,
+		Name:      body.Name,
+		Category:  domain.POICategory(body.Category),
+		Latitude:  body.Latitude,
+		Longitude: body.Longitude,
+		SeenAt:    body.SeenAt,
+		Notes:     derefString(body.Notes),
+	}
+	var tags []string
+	if body.Tags != nil {
+		tags = *body.Tags
+	}
+	return p, tags
+}
+
+// poiToResponse converts a domain.PointOfInterest into the generated type.
+func poiToResponse(p domain.PointOfInterest) gen.PointOfInterest {
+	tags := make([]gen.Tag, len(p.Tags))
+	for i, t := range p.Tags {
+		tags[i] = tagToResponse(t)
+	}
+	return gen.PointOfInterest{
+		Id:        p.ID,
+		TripId:    p.TripID,
+		Name:      p.Name,
+		Category:  gen.POICategory(p.Category),
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		SeenAt:    p.SeenAt,
+		Notes:     nilIfEmpty(p.Notes),
+		Tags:      tags,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
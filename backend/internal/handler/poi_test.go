@@ -0,0 +1,224 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock POIServicer ------------------------------------------------------
+
+type mockPOIServicer struct {
+	create    func(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error)
+	getByID   func(ctx context.Context, id uuid.UUID) (domain.PointOfInterest, error)
+	listPaged func(ctx context.Context, f domain.POIFilter, p domain.PaginationParams) ([]domain.PointOfInterest, int64, error)
+	update    func(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error)
+	delete    func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockPOIServicer) Create(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error) {
+	return m.create(ctx, p, tagNames)
+}
+func (m *mockPOIServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.PointOfInterest, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockPOIServicer) ListPaged(ctx context.Context, f domain.POIFilter, p domain.PaginationParams) ([]domain.PointOfInterest, int64, error) {
+	return m.listPaged(ctx, f, p)
+}
+func (m *mockPOIServicer) Update(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error) {
+	return m.update(ctx, p, tagNames)
+}
+func (m *mockPOIServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+// compile-time check: mockPOIServicer must satisfy handler.POIServicer.
+var _ handler.POIServicer = (*mockPOIServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newPOIHTTPHandler wires a Server with only the point-of-interest service mock.
+func newPOIHTTPHandler(t *testing.T, svc handler.POIServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{POIs: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- POST /points-of-interest ----------------------------------------------
+
+func TestCreatePointOfInterest_201(t *testing.T) {
+	tripID := uuid.New()
+	var (
+		got     domain.PointOfInterest
+		gotTags []string
+	)
+	svc := &mockPOIServicer{
+		create: func(_ context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error) {
+			got, gotTags = p, tagNames
+			p.ID, p.CreatedAt, p.UpdatedAt = uuid.New(), time.Now().UTC(), time.Now().UTC()
+			p.Tags = []domain.Tag{{ID: uuid.New(), Name: "Moose", Slug: "moose", CreatedAt: time.Now().UTC()}}
+			return p, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":      "Bull moose",
+		"category":  "wildlife",
+		"latitude":  61.2181,
+		"longitude": -149.9003,
+		"trip_id":   tripID.String(),
+		"seen_at":   "2025-07-20T06:45:00Z",
+		"tags":      []string{"Moose"},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/points-of-interest", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, domain.POIWildlife, got.Category)
+	require.NotNil(t, got.TripID)
+	assert.Equal(t, tripID, *got.TripID)
+	require.NotNil(t, got.SeenAt)
+	assert.Equal(t, []string{"Moose"}, gotTags)
+	var resp gen.PointOfInterest
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Tags, 1)
+	assert.Equal(t, "moose", resp.Tags[0].Slug)
+	assert.InDelta(t, -149.9003, resp.Longitude, 1e-9)
+}
+
+func TestCreatePointOfInterest_404UnknownTrip(t *testing.T) {
+	svc := &mockPOIServicer{
+		create: func(_ context.Context, _ domain.PointOfInterest, _ []string) (domain.PointOfInterest, error) {
+			return domain.PointOfInterest{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name": "Arch", "category": "landmark", "latitude": 38.7, "longitude": -109.6, "trip_id": uuid.NewString(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/points-of-interest", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCreatePointOfInterest_422(t *testing.T) {
+	svc := &mockPOIServicer{
+		create: func(_ context.Context, _ domain.PointOfInterest, _ []string) (domain.PointOfInterest, error) {
+			return domain.PointOfInterest{}, fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Arch", "category": "landmark", "latitude": 95, "longitude": 0})
+	req := httptest.NewRequest(http.MethodPost, "/points-of-interest", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- GET /points-of-interest -----------------------------------------------
+
+func TestListPointsOfInterest_Filters(t *testing.T) {
+	tripID := uuid.New()
+	var got domain.POIFilter
+	svc := &mockPOIServicer{
+		listPaged: func(_ context.Context, f domain.POIFilter, _ domain.PaginationParams) ([]domain.PointOfInterest, int64, error) {
+			got = f
+			now := time.Now().UTC()
+			return []domain.PointOfInterest{{
+				ID: uuid.New(), TripID: &tripID, Name: "Delicate Arch", Category: domain.POILandmark,
+				Latitude: 38.7436, Longitude: -109.4993, Tags: []domain.Tag{}, CreatedAt: now, UpdatedAt: now,
+			}}, 1, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/points-of-interest?trip_id="+tripID.String()+"&category=landmark&tag=arches", nil)
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, got.TripID)
+	assert.Equal(t, tripID, *got.TripID)
+	assert.Equal(t, domain.POILandmark, got.Category)
+	assert.Equal(t, "arches", got.TagSlug)
+	var resp gen.PointOfInterestList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, 1, resp.Pagination.Total)
+	assert.Equal(t, []gen.Tag{}, resp.Data[0].Tags)
+}
+
+// ---- /points-of-interest/{id} ----------------------------------------------
+
+func TestUpdatePointOfInterest_UsesPathID(t *testing.T) {
+	id := uuid.New()
+	var got domain.PointOfInterest
+	svc := &mockPOIServicer{
+		update: func(_ context.Context, p domain.PointOfInterest, _ []string) (domain.PointOfInterest, error) {
+			got = p
+			p.CreatedAt, p.UpdatedAt, p.Tags = time.Now().UTC(), time.Now().UTC(), []domain.Tag{}
+			return p, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Brewery", "category": "brewery", "latitude": 44.05, "longitude": -121.31})
+	req := httptest.NewRequest(http.MethodPut, "/points-of-interest/"+id.String(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, id, got.ID)
+	assert.Nil(t, got.TripID)
+}
+
+func TestGetPointOfInterest_404(t *testing.T) {
+	svc := &mockPOIServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.PointOfInterest, error) {
+			return domain.PointOfInterest{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/points-of-interest/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeletePointOfInterest_204(t *testing.T) {
+	svc := &mockPOIServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/points-of-interest/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPOIHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// CreatePowerReading handles POST /power-readings.
+func (s *Server) CreatePowerReading(ctx context.Context, req gen.CreatePowerReadingRequestObject) (gen.CreatePowerReadingResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreatePowerReading422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.power.Create(ctx, domain.PowerReading{
+		RecordedAt:     req.Body.RecordedAt,
+		StateOfCharge:  req.Body.StateOfCharge,
+		SolarWh:        req.Body.SolarWh,
+		GeneratorHours: req.Body.GeneratorHours,
+		TripID:         req.Body.TripId,
+		Notes:          derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreatePowerReading404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreatePowerReading422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreatePowerReading201JSONResponse(powerReadingToResponse(created)), nil
+}
+
+// ListPowerReadings handles GET /power-readings.
+// Supports ?trip_id=, ?page= and ?limit= (defaults: page=1, limit and its cap from s.pagination.Readings).
+func (s *Server) ListPowerReadings(ctx context.Context, req gen.ListPowerReadingsRequestObject) (gen.ListPowerReadingsResponseObject, error) {
+	params := s.pagination.Readings.Params(req.Params.Page, req.Params.Limit)
+
+	readings, total, err := s.power.ListPaged(ctx, domain.PowerFilter{TripID: req.Params.TripId}, params)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]gen.PowerReading, len(readings))
+	for i, r := range readings {
+		data[i] = powerReadingToResponse(r)
+	}
+	return gen.ListPowerReadings200JSONResponse{
+		Data:       data,
+		Pagination: paginationMeta(params, s.pagination.Readings, total),
+	}, nil
+}
+
+// GetPowerReading handles GET /power-readings/{id}.
+func (s *Server) GetPowerReading(ctx context.Context, req gen.GetPowerReadingRequestObject) (gen.GetPowerReadingResponseObject, error) {
+	reading, err := s.power.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetPowerReading404JSONResponse(notFoundBody("power reading not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetPowerReading200JSONResponse(powerReadingToResponse(reading)), nil
+}
+
+// DeletePowerReading handles DELETE /power-readings/{id}.
+func (s *Server) DeletePowerReading(ctx context.Context, req gen.DeletePowerReadingRequestObject) (gen.DeletePowerReadingResponseObject, error) {
+	if err := s.power.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeletePowerReading404JSONResponse(notFoundBody("power reading not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeletePowerReading204Response{}, nil
+}
+
+// GetTripPower handles GET /trips/{id}/power.
+func (s *Server) GetTripPower(ctx context.Context, req gen.GetTripPowerRequestObject) (gen.GetTripPowerResponseObject, error) {
+	tp, err := s.power.TripPower(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripPower404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	days := make([]gen.PowerDay, len(tp.Days))
+	for i, d := range tp.Days {
+		days[i] = gen.PowerDay{
+			Date:             openapi_types.Date{Time: d.Date},
+			MinStateOfCharge: d.MinStateOfCharge,
+			SolarWh:          d.SolarWh,
+			GeneratorHours:   d.GeneratorHours,
+			Readings:         d.Readings,
+		}
+	}
+	return gen.GetTripPower200JSONResponse{
+		TripId:              tp.TripID,
+		TotalSolarWh:        tp.TotalSolarWh,
+		TotalGeneratorHours: tp.TotalGeneratorHours,
+		MinStateOfCharge:    tp.MinStateOfCharge,
+		Days:                days,
+	}, nil
+}
+
+// powerReadingToResponse converts a domain.PowerReading into the generated type.
+func powerReadingToResponse(r domain.PowerReading) gen.PowerReading {
+	resp := gen.PowerReading{
+		Id:             r.ID,
+		RecordedAt:     r.RecordedAt,
+		StateOfCharge:  r.StateOfCharge,
+		SolarWh:        r.SolarWh,
+		GeneratorHours: r.GeneratorHours,
+		TripId:         r.TripID,
+		CreatedAt:      r.CreatedAt,
+	}
+	if r.Notes != "" {
+		notes := r.Notes
+		resp.Notes = &notes
+	}
+	return resp
+}
@@ -0,0 +1,229 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock PowerServicer ----------------------------------------------------
+
+type mockPowerServicer struct {
+	create    func(ctx context.Context, r domain.PowerReading) (domain.PowerReading, error)
+	getByID   func(ctx context.Context, id uuid.UUID) (domain.PowerReading, error)
+	listPaged func(ctx context.Context, f domain.PowerFilter, p domain.PaginationParams) ([]domain.PowerReading, int64, error)
+	delete    func(ctx context.Context, id uuid.UUID) error
+	tripPower func(ctx context.Context, tripID uuid.UUID) (domain.TripPower, error)
+}
+
+func (m *mockPowerServicer) Create(ctx context.Context, r domain.PowerReading) (domain.PowerReading, error) {
+	return m.create(ctx, r)
+}
+func (m *mockPowerServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.PowerReading, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockPowerServicer) ListPaged(ctx context.Context, f domain.PowerFilter, p domain.PaginationParams) ([]domain.PowerReading, int64, error) {
+	return m.listPaged(ctx, f, p)
+}
+func (m *mockPowerServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+func (m *mockPowerServicer) TripPower(ctx context.Context, tripID uuid.UUID) (domain.TripPower, error) {
+	return m.tripPower(ctx, tripID)
+}
+
+// compile-time check: mockPowerServicer must satisfy handler.PowerServicer.
+var _ handler.PowerServicer = (*mockPowerServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newPowerHTTPHandler wires a Server with only the power service mock.
+func newPowerHTTPHandler(t *testing.T, svc handler.PowerServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Power: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func powerFixture() domain.PowerReading {
+	soc, solar := 78.5, 1840.0
+	return domain.PowerReading{
+		ID:            uuid.New(),
+		RecordedAt:    time.Date(2025, 6, 3, 20, 0, 0, 0, time.UTC),
+		StateOfCharge: &soc,
+		SolarWh:       &solar,
+		Notes:         "Overcast all afternoon",
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// ---- POST /power-readings --------------------------------------------------
+
+func TestCreatePowerReading_201(t *testing.T) {
+	fixture := powerFixture()
+	var got domain.PowerReading
+	svc := &mockPowerServicer{
+		create: func(_ context.Context, r domain.PowerReading) (domain.PowerReading, error) {
+			got = r
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"recorded_at":     "2025-06-03T20:00:00Z",
+		"state_of_charge": 78.5,
+		"solar_wh":        1840,
+		"notes":           "Overcast all afternoon",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/power-readings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, got.StateOfCharge)
+	assert.InDelta(t, 78.5, *got.StateOfCharge, 0.001)
+	assert.Nil(t, got.GeneratorHours, "omitted measurements stay nil")
+
+	var resp gen.PowerReading
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, fixture.ID, resp.Id)
+	assert.Nil(t, resp.GeneratorHours)
+}
+
+func TestCreatePowerReading_422(t *testing.T) {
+	svc := &mockPowerServicer{
+		create: func(_ context.Context, _ domain.PowerReading) (domain.PowerReading, error) {
+			return domain.PowerReading{}, fmt.Errorf("%w: at least one of state_of_charge, solar_wh, or generator_hours is required", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"recorded_at": "2025-06-03T20:00:00Z"})
+	req := httptest.NewRequest(http.MethodPost, "/power-readings", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "validation_error", resp.Error.Code)
+}
+
+// ---- GET /power-readings ---------------------------------------------------
+
+func TestListPowerReadings_200(t *testing.T) {
+	tripID := uuid.New()
+	var gotFilter domain.PowerFilter
+	svc := &mockPowerServicer{
+		listPaged: func(_ context.Context, f domain.PowerFilter, _ domain.PaginationParams) ([]domain.PowerReading, int64, error) {
+			gotFilter = f
+			return []domain.PowerReading{powerFixture()}, 1, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/power-readings?trip_id="+tripID.String(), nil)
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, gotFilter.TripID)
+	assert.Equal(t, tripID, *gotFilter.TripID)
+
+	var resp gen.PowerReadingList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, 1, resp.Pagination.Total)
+}
+
+// ---- GET/DELETE /power-readings/{id} ---------------------------------------
+
+func TestGetPowerReading_404(t *testing.T) {
+	svc := &mockPowerServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.PowerReading, error) {
+			return domain.PowerReading{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/power-readings/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeletePowerReading_204(t *testing.T) {
+	svc := &mockPowerServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/power-readings/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+// ---- GET /trips/{id}/power -------------------------------------------------
+
+func TestGetTripPower_200(t *testing.T) {
+	tripID := uuid.New()
+	soc := 55.0
+	svc := &mockPowerServicer{
+		tripPower: func(_ context.Context, id uuid.UUID) (domain.TripPower, error) {
+			return domain.TripPower{
+				TripID:           id,
+				TotalSolarWh:     1900,
+				MinStateOfCharge: &soc,
+				Days: []domain.PowerDay{
+					{Date: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), MinStateOfCharge: &soc, SolarWh: 1900, Readings: 2},
+				},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/power", nil)
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"date":"2025-06-01"`)
+
+	var resp gen.TripPower
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, tripID, resp.TripId)
+	require.Len(t, resp.Days, 1)
+	assert.Equal(t, 2, resp.Days[0].Readings)
+}
+
+func TestGetTripPower_404(t *testing.T) {
+	svc := &mockPowerServicer{
+		tripPower: func(_ context.Context, _ uuid.UUID) (domain.TripPower, error) {
+			return domain.TripPower{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/power", nil)
+	rec := httptest.NewRecorder()
+
+	newPowerHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// CreatePropaneFill handles POST /propane-fills.
+func (s *Server) CreatePropaneFill(ctx context.Context, req gen.CreatePropaneFillRequestObject) (gen.CreatePropaneFillResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreatePropaneFill422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.propane.Create(ctx, domain.PropaneFill{
+		FilledAt: req.Body.FilledAt,
+		Quantity: req.Body.Quantity,
+		Unit:     domain.PropaneUnit(req.Body.Unit),
+		Price:    req.Body.Price,
+		Location: derefString(req.Body.Location),
+		TripID:   req.Body.TripId,
+		Notes:    derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreatePropaneFill404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreatePropaneFill422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreatePropaneFill201JSONResponse(propaneFillToResponse(created, unitsFor(req.Params.Units))), nil
+}
+
+// ListPropaneFills handles GET /propane-fills.
+// Supports ?trip_id=, ?page= and ?limit= (defaults: page=1, limit and its cap from s.pagination.Readings).
+func (s *Server) ListPropaneFills(ctx context.Context, req gen.ListPropaneFillsRequestObject) (gen.ListPropaneFillsResponseObject, error) {
+	params := s.pagination.Readings.Params(req.Params.Page, req.Params.Limit)
+
+	fills, total, err := s.propane.ListPaged(ctx, domain.PropaneFilter{TripID: req.Params.TripId}, params)
+	if err != nil {
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	data := make([]gen.PropaneFill, len(fills))
+	for i, f := range fills {
+		data[i] = propaneFillToResponse(f, u)
+	}
+	return gen.ListPropaneFills200JSONResponse{
+		Data:       data,
+		Pagination: paginationMeta(params, s.pagination.Readings, total),
+	}, nil
+}
+
+// GetPropaneFill handles GET /propane-fills/{id}.
+func (s *Server) GetPropaneFill(ctx context.Context, req gen.GetPropaneFillRequestObject) (gen.GetPropaneFillResponseObject, error) {
+	fill, err := s.propane.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetPropaneFill404JSONResponse(notFoundBody("propane fill not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetPropaneFill200JSONResponse(propaneFillToResponse(fill, unitsFor(req.Params.Units))), nil
+}
+
+// DeletePropaneFill handles DELETE /propane-fills/{id}.
+func (s *Server) DeletePropaneFill(ctx context.Context, req gen.DeletePropaneFillRequestObject) (gen.DeletePropaneFillResponseObject, error) {
+	if err := s.propane.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeletePropaneFill404JSONResponse(notFoundBody("propane fill not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeletePropaneFill204Response{}, nil
+}
+
+// GetPropaneStats handles GET /stats/propane.
+func (s *Server) GetPropaneStats(ctx context.Context, req gen.GetPropaneStatsRequestObject) (gen.GetPropaneStatsResponseObject, error) {
+	modified, err := s.cache.LastModified(ctx, domain.ViewPropaneStats)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := coalesce(ctx, s, flightKey("propane_stats", modified, req.Params.TripId), func(ctx context.Context) (domain.PropaneStats, error) {
+		return s.propane.Stats(ctx, domain.PropaneFilter{TripID: req.Params.TripId})
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetPropaneStats404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+	if notModified(req.Params.IfModifiedSince, modified) {
+		return gen.GetPropaneStats304Response{Headers: notModifiedHeaders(modified)}, nil
+	}
+	u := unitsFor(req.Params.Units)
+	return gen.GetPropaneStats200JSONResponse{
+		Body: gen.PropaneStats{
+			Fills:             stats.Fills,
+			TotalGallons:      u.volume(stats.TotalGallons),
+			TotalCost:         stats.TotalCost,
+			AvgPricePerGallon: u.perVolume(stats.AvgPricePerGallon),
+			GallonsPerDay:     u.volumePtr(stats.GallonsPerDay),
+			FirstFillAt:       stats.FirstFillAt,
+			LastFillAt:        stats.LastFillAt,
+		},
+		Headers: gen.GetPropaneStats200ResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)},
+	}, nil
+}
+
+// propaneFillToResponse converts a domain.PropaneFill into the generated type.
+func propaneFillToResponse(f domain.PropaneFill, u units) gen.PropaneFill {
+	resp := gen.PropaneFill{
+		Id:        f.ID,
+		FilledAt:  f.FilledAt,
+		Quantity:  f.Quantity,
+		Unit:      gen.PropaneFillUnit(f.Unit),
+		Gallons:   u.volume(f.Gallons()),
+		Price:     f.Price,
+		TripId:    f.TripID,
+		CreatedAt: f.CreatedAt,
+	}
+	if f.Location != "" {
+		location := f.Location
+		resp.Location = &location
+	}
+	if f.Notes != "" {
+		notes := f.Notes
+		resp.Notes = &notes
+	}
+	return resp
+}
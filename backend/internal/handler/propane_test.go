@@ -0,0 +1,281 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock PropaneServicer --------------------------------------------------
+
+type mockPropaneServicer struct {
+	create    func(ctx context.Context, f domain.PropaneFill) (domain.PropaneFill, error)
+	getByID   func(ctx context.Context, id uuid.UUID) (domain.PropaneFill, error)
+	listPaged func(ctx context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error)
+	delete    func(ctx context.Context, id uuid.UUID) error
+	stats     func(ctx context.Context, f domain.PropaneFilter) (domain.PropaneStats, error)
+}
+
+func (m *mockPropaneServicer) Create(ctx context.Context, f domain.PropaneFill) (domain.PropaneFill, error) {
+	return m.create(ctx, f)
+}
+func (m *mockPropaneServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.PropaneFill, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockPropaneServicer) ListPaged(ctx context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error) {
+	return m.listPaged(ctx, f, p)
+}
+func (m *mockPropaneServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+func (m *mockPropaneServicer) Stats(ctx context.Context, f domain.PropaneFilter) (domain.PropaneStats, error) {
+	return m.stats(ctx, f)
+}
+
+// compile-time check: mockPropaneServicer must satisfy handler.PropaneServicer.
+var _ handler.PropaneServicer = (*mockPropaneServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newPropaneHTTPHandler wires a Server with only the propane service mock.
+func newPropaneHTTPHandler(t *testing.T, svc handler.PropaneServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Propane: svc, Cache: fixedCache(testModified)})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func propaneFixture() domain.PropaneFill {
+	price := 18.50
+	return domain.PropaneFill{
+		ID:        uuid.New(),
+		FilledAt:  time.Date(2025, 6, 3, 16, 0, 0, 0, time.UTC),
+		Quantity:  21.2,
+		Unit:      domain.PropanePounds,
+		Price:     &price,
+		Location:  "Blue Ox Propane, Moab UT",
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// ---- POST /propane-fills ---------------------------------------------------
+
+func TestCreatePropaneFill_201(t *testing.T) {
+	fixture := propaneFixture()
+	var got domain.PropaneFill
+	svc := &mockPropaneServicer{
+		create: func(_ context.Context, f domain.PropaneFill) (domain.PropaneFill, error) {
+			got = f
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"filled_at": "2025-06-03T16:00:00Z",
+		"quantity":  21.2,
+		"unit":      "lb",
+		"price":     18.50,
+		"location":  "Blue Ox Propane, Moab UT",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/propane-fills", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, domain.PropanePounds, got.Unit)
+	require.NotNil(t, got.Price)
+	assert.InDelta(t, 18.50, *got.Price, 0.001)
+	assert.Equal(t, "Blue Ox Propane, Moab UT", got.Location)
+
+	var resp gen.PropaneFill
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, fixture.ID, resp.Id)
+	assert.InDelta(t, 5, resp.Gallons, 0.001, "21.2 lb is 5 gal")
+}
+
+func TestCreatePropaneFill_422(t *testing.T) {
+	svc := &mockPropaneServicer{
+		create: func(_ context.Context, _ domain.PropaneFill) (domain.PropaneFill, error) {
+			return domain.PropaneFill{}, fmt.Errorf("%w: quantity must be greater than zero", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"filled_at": "2025-06-03T16:00:00Z", "quantity": 0, "unit": "gal"})
+	req := httptest.NewRequest(http.MethodPost, "/propane-fills", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "validation_error", resp.Error.Code)
+}
+
+func TestCreatePropaneFill_404_UnknownTrip(t *testing.T) {
+	svc := &mockPropaneServicer{
+		create: func(_ context.Context, _ domain.PropaneFill) (domain.PropaneFill, error) {
+			return domain.PropaneFill{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"filled_at": "2025-06-03T16:00:00Z", "quantity": 5, "unit": "gal", "trip_id": uuid.NewString(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/propane-fills", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- GET /propane-fills ----------------------------------------------------
+
+func TestListPropaneFills_200_Filters(t *testing.T) {
+	tripID := uuid.New()
+	var gotFilter domain.PropaneFilter
+	var gotPage domain.PaginationParams
+	svc := &mockPropaneServicer{
+		listPaged: func(_ context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error) {
+			gotFilter, gotPage = f, p
+			return []domain.PropaneFill{propaneFixture()}, 4, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/propane-fills?trip_id="+tripID.String()+"&page=2&limit=3", nil)
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, gotFilter.TripID)
+	assert.Equal(t, tripID, *gotFilter.TripID)
+	assert.Equal(t, domain.PaginationParams{Page: 2, Limit: 3}, gotPage)
+
+	var resp gen.PropaneFillList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, 4, resp.Pagination.Total)
+}
+
+// ---- GET/DELETE /propane-fills/{id} ----------------------------------------
+
+func TestGetPropaneFill_404(t *testing.T) {
+	svc := &mockPropaneServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.PropaneFill, error) {
+			return domain.PropaneFill{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/propane-fills/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "not_found", resp.Error.Code)
+}
+
+func TestDeletePropaneFill_204(t *testing.T) {
+	svc := &mockPropaneServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/propane-fills/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+// ---- GET /stats/propane ----------------------------------------------------
+
+func TestGetPropaneStats_200(t *testing.T) {
+	rate, avg := 0.8, 3.19
+	first := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 0, 10)
+	svc := &mockPropaneServicer{
+		stats: func(_ context.Context, f domain.PropaneFilter) (domain.PropaneStats, error) {
+			assert.Nil(t, f.TripID)
+			return domain.PropaneStats{
+				Fills: 3, TotalGallons: 18, TotalCost: 51,
+				AvgPricePerGallon: &avg, GallonsPerDay: &rate,
+				FirstFillAt: &first, LastFillAt: &last,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/propane", nil)
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.PropaneStats
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 3, resp.Fills)
+	require.NotNil(t, resp.GallonsPerDay)
+	assert.InDelta(t, 0.8, *resp.GallonsPerDay, 0.001)
+}
+
+func TestGetPropaneStats_200_Metric(t *testing.T) {
+	rate, avg := 0.8, 3.79
+	svc := &mockPropaneServicer{
+		stats: func(_ context.Context, _ domain.PropaneFilter) (domain.PropaneStats, error) {
+			return domain.PropaneStats{
+				Fills: 3, TotalGallons: 18, TotalCost: 51,
+				AvgPricePerGallon: &avg, GallonsPerDay: &rate,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/propane", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.PropaneStats
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.InDelta(t, 68.14, resp.TotalGallons, 1e-9, "liters")
+	assert.InDelta(t, 51, resp.TotalCost, 1e-9, "costs are not converted")
+	require.NotNil(t, resp.AvgPricePerGallon)
+	assert.InDelta(t, 1.001, *resp.AvgPricePerGallon, 1e-9, "per liter")
+	require.NotNil(t, resp.GallonsPerDay)
+	assert.InDelta(t, 3.03, *resp.GallonsPerDay, 1e-9, "liters per day")
+}
+
+func TestGetPropaneStats_404_UnknownTrip(t *testing.T) {
+	svc := &mockPropaneServicer{
+		stats: func(_ context.Context, _ domain.PropaneFilter) (domain.PropaneStats, error) {
+			return domain.PropaneStats{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/propane?trip_id="+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newPropaneHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetTripBudgetReport handles GET /trips/{tripId}/budget-report.
+func (s *Server) GetTripBudgetReport(ctx context.Context, req gen.GetTripBudgetReportRequestObject) (gen.GetTripBudgetReportResponseObject, error) {
+	report, err := s.reports.BudgetReport(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripBudgetReport404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := gen.BudgetReport{
+		TripId:         report.TripID,
+		BudgetCents:    report.BudgetCents,
+		SpentCents:     report.SpentCents,
+		RemainingCents: report.RemainingCents,
+		OverBudget:     report.OverBudget(),
+		Categories:     make([]gen.BudgetCategory, len(report.Categories)),
+	}
+	for i, c := range report.Categories {
+		resp.Categories[i] = gen.BudgetCategory{
+			Category:     gen.ExpenseCategory(c.Category),
+			SpentCents:   c.SpentCents,
+			ExpenseCount: c.Count,
+		}
+		if report.SpentCents > 0 {
+			resp.Categories[i].Share = float64(c.SpentCents) / float64(report.SpentCents)
+		}
+	}
+	return gen.GetTripBudgetReport200JSONResponse(resp), nil
+}
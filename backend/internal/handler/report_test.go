@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock ReportServicer ---------------------------------------------------
+
+type mockReportServicer struct {
+	budgetReport func(ctx context.Context, tripID uuid.UUID) (domain.BudgetReport, error)
+}
+
+func (m *mockReportServicer) BudgetReport(ctx context.Context, tripID uuid.UUID) (domain.BudgetReport, error) {
+	return m.budgetReport(ctx, tripID)
+}
+
+// compile-time check: mockReportServicer must satisfy handler.ReportServicer.
+var _ handler.ReportServicer = (*mockReportServicer)(nil)
+
+// newReportHTTPHandler wires a Server with only the report service mock.
+func newReportHTTPHandler(t *testing.T, svc handler.ReportServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Reports: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /trips/{tripId}/budget-report -------------------------------------
+
+func TestGetTripBudgetReport_200(t *testing.T) {
+	tripID := uuid.New()
+	budget, remaining := int64(20000), int64(-500)
+	svc := &mockReportServicer{
+		budgetReport: func(_ context.Context, id uuid.UUID) (domain.BudgetReport, error) {
+			return domain.BudgetReport{
+				TripID:         id,
+				BudgetCents:    &budget,
+				SpentCents:     20500,
+				RemainingCents: &remaining,
+				Categories: []domain.CategorySpend{
+					{Category: domain.ExpenseFuel, SpentCents: 16400, Count: 3},
+					{Category: domain.ExpenseToll, SpentCents: 4100, Count: 2},
+				},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/budget-report", tripID), nil)
+	rec := httptest.NewRecorder()
+	newReportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got gen.BudgetReport
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	assert.Equal(t, tripID, got.TripId)
+	require.NotNil(t, got.RemainingCents)
+	assert.Equal(t, int64(-500), *got.RemainingCents)
+	assert.True(t, got.OverBudget)
+	require.Len(t, got.Categories, 2)
+	assert.Equal(t, gen.ExpenseCategoryFuel, got.Categories[0].Category)
+	assert.Equal(t, 3, got.Categories[0].ExpenseCount)
+	assert.InDelta(t, 0.8, got.Categories[0].Share, 0.001)
+}
+
+func TestGetTripBudgetReport_200_NoBudget(t *testing.T) {
+	svc := &mockReportServicer{
+		budgetReport: func(_ context.Context, id uuid.UUID) (domain.BudgetReport, error) {
+			return domain.BudgetReport{TripID: id, Categories: []domain.CategorySpend{}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/budget-report", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+	newReportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got map[string]any
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	assert.NotContains(t, got, "budget_cents")
+	assert.NotContains(t, got, "remaining_cents")
+	assert.Equal(t, false, got["over_budget"])
+	assert.Equal(t, []any{}, got["categories"])
+}
+
+func TestGetTripBudgetReport_404(t *testing.T) {
+	svc := &mockReportServicer{
+		budgetReport: func(context.Context, uuid.UUID) (domain.BudgetReport, error) {
+			return domain.BudgetReport{}, fmt.Errorf("wrap: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/budget-report", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+	newReportHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListUpcomingReservations handles GET /reservations/upcoming.
+func (s *Server) ListUpcomingReservations(ctx context.Context, req gen.ListUpcomingReservationsRequestObject) (gen.ListUpcomingReservationsResponseObject, error) {
+	remindDays := domain.DefaultReservationReminderDays
+	if req.Params.RemindDays != nil {
+		remindDays = *req.Params.RemindDays
+	}
+
+	upcoming, err := s.reservations.Upcoming(ctx, remindDays)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListUpcomingReservations422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListUpcomingReservations200JSONResponse, len(upcoming))
+	for i, u := range upcoming {
+		resp[i] = gen.UpcomingReservation{
+			Reservation:          reservationToResponse(u.Reservation),
+			TripId:               u.TripID,
+			StopName:             u.StopName,
+			DaysUntilCheckIn:     u.DaysUntilCheckIn,
+			CancellationReminder: u.CancellationReminder,
+		}
+	}
+	return resp, nil
+}
+
+// ListStopReservations handles GET /trips/{tripId}/stops/{stopId}/reservations.
+func (s *Server) ListStopReservations(ctx context.Context, req gen.ListStopReservationsRequestObject) (gen.ListStopReservationsResponseObject, error) {
+	reservations, err := s.reservations.ListByStop(ctx, req.TripId, req.StopId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListStopReservations404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListStopReservations200JSONResponse, len(reservations))
+	for i, r := range reservations {
+		resp[i] = reservationToResponse(r)
+	}
+	return resp, nil
+}
+
+// CreateStopReservation handles POST /trips/{tripId}/stops/{stopId}/reservations.
+func (s *Server) CreateStopReservation(ctx context.Context, req gen.CreateStopReservationRequestObject) (gen.CreateStopReservationResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateStopReservation422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	r := requestToReservation(*req.Body)
+	r.StopID = req.StopId
+	created, err := s.reservations.Create(ctx, req.TripId, r)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateStopReservation404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateStopReservation422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateStopReservation201JSONResponse(reservationToResponse(created)), nil
+}
+
+// GetStopReservation handles GET /trips/{tripId}/stops/{stopId}/reservations/{reservationId}.
+func (s *Server) GetStopReservation(ctx context.Context, req gen.GetStopReservationRequestObject) (gen.GetStopReservationResponseObject, error) {
+	r, err := s.reservations.GetByID(ctx, req.TripId, req.StopId, req.ReservationId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetStopReservation404JSONResponse(notFoundBody("reservation not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetStopReservation200JSONResponse(reservationToResponse(r)), nil
+}
+
+// UpdateStopReservation handles PUT /trips/{tripId}/stops/{stopId}/reservations/{reservationId}.
+func (s *Server) UpdateStopReservation(ctx context.Context, req gen.UpdateStopReservationRequestObject) (gen.UpdateStopReservationResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateStopReservation422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	r := requestToReservation(*req.Body)
+	r.ID, r.StopID = req.ReservationId, req.StopId
+	updated, err := s.reservations.Update(ctx, req.TripId, r)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateStopReservation404JSONResponse(notFoundBody("reservation not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateStopReservation422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateStopReservation200JSONResponse(reservationToResponse(updated)), nil
+}
+
+// DeleteStopReservation handles DELETE /trips/{tripId}/stops/{stopId}/reservations/{reservationId}.
+func (s *Server) DeleteStopReservation(ctx context.Context, req gen.DeleteStopReservationRequestObject) (gen.DeleteStopReservationResponseObject, error) {
+	if err := s.reservations.Delete(ctx, req.TripId, req.StopId, req.ReservationId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteStopReservation404JSONResponse(notFoundBody("reservation not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteStopReservation204Response{}, nil
+}
+
+// requestToReservation converts a request body into a domain.Reservation.
+// The caller sets StopID (and ID on update) from the path.
+func requestToReservation(body gen.ReservationRequest) domain.Reservation {
+	return domain.Reservation{
+		ConfirmationNumber:   derefString(body.ConfirmationNumber),
+		SiteNumber:           derefString(body.SiteNumber),
+		CheckIn:              body.CheckIn.Time,
+		CheckOut:             body.CheckOut.Time,
+		Cost:                 body.Cost,
+		CancellationDeadline: body.CancellationDeadline,
+		Notes:                derefString(body.Notes),
+	}
+}
+
+// reservationToResponse converts a domain.Reservation into the generated type.
+func reservationToResponse(r domain.Reservation) gen.Reservation {
+	return gen.Reservation{
+		Id:                   r.ID,
+		StopId:               r.StopID,
+		ConfirmationNumber:   nilIfEmpty(r.ConfirmationNumber),
+		SiteNumber:           nilIfEmpty(r.SiteNumber),
+		CheckIn:              openapi_types.Date{Time: r.CheckIn},
+		CheckOut:             openapi_types.Date{Time: r.CheckOut},
+		Nights:               r.Nights(),
+		Cost:                 r.Cost,
+		CancellationDeadline: r.CancellationDeadline,
+		Notes:                nilIfEmpty(r.Notes),
+		CreatedAt:            r.CreatedAt,
+		UpdatedAt:            r.UpdatedAt,
+	}
+}
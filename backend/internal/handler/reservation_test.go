@@ -0,0 +1,220 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock ReservationServicer ----------------------------------------------
+
+type mockReservationServicer struct {
+	create     func(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error)
+	getByID    func(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Reservation, error)
+	listByStop func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Reservation, error)
+	update     func(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error)
+	delete     func(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	upcoming   func(ctx context.Context, remindDays int) ([]domain.UpcomingReservation, error)
+}
+
+func (m *mockReservationServicer) Create(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error) {
+	return m.create(ctx, tripID, r)
+}
+func (m *mockReservationServicer) GetByID(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Reservation, error) {
+	return m.getByID(ctx, tripID, stopID, id)
+}
+func (m *mockReservationServicer) ListByStop(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Reservation, error) {
+	return m.listByStop(ctx, tripID, stopID)
+}
+func (m *mockReservationServicer) Update(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error) {
+	return m.update(ctx, tripID, r)
+}
+func (m *mockReservationServicer) Delete(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	return m.delete(ctx, tripID, stopID, id)
+}
+func (m *mockReservationServicer) Upcoming(ctx context.Context, remindDays int) ([]domain.UpcomingReservation, error) {
+	return m.upcoming(ctx, remindDays)
+}
+
+// compile-time check: mockReservationServicer must satisfy handler.ReservationServicer.
+var _ handler.ReservationServicer = (*mockReservationServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newReservationHTTPHandler wires a Server with only the reservation service mock.
+func newReservationHTTPHandler(t *testing.T, svc handler.ReservationServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Reservations: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /reservations/upcoming --------------------------------------------
+
+func TestListUpcomingReservations_DefaultWindow(t *testing.T) {
+	var gotDays int
+	tripID := uuid.New()
+	deadline := time.Date(2025, 6, 24, 23, 59, 0, 0, time.UTC)
+	svc := &mockReservationServicer{
+		upcoming: func(_ context.Context, remindDays int) ([]domain.UpcomingReservation, error) {
+			gotDays = remindDays
+			return []domain.UpcomingReservation{{
+				Reservation: domain.Reservation{
+					ID: uuid.New(), StopID: uuid.New(), SiteNumber: "B12",
+					CheckIn: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), CheckOut: time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC),
+					CancellationDeadline: &deadline,
+				},
+				TripID: tripID, StopName: "Moab KOA", DaysUntilCheckIn: 11, CancellationReminder: true,
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/reservations/upcoming", nil)
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, domain.DefaultReservationReminderDays, gotDays)
+	var resp []gen.UpcomingReservation
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, tripID, resp[0].TripId)
+	assert.True(t, resp[0].CancellationReminder)
+	assert.Equal(t, 3, resp[0].Reservation.Nights)
+	assert.Equal(t, "2025-07-01", resp[0].Reservation.CheckIn.String())
+}
+
+func TestListUpcomingReservations_RemindDays(t *testing.T) {
+	var gotDays int
+	svc := &mockReservationServicer{
+		upcoming: func(_ context.Context, remindDays int) ([]domain.UpcomingReservation, error) {
+			gotDays = remindDays
+			return []domain.UpcomingReservation{}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/reservations/upcoming?remind_days=14", nil)
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 14, gotDays)
+}
+
+// ---- /trips/{tripId}/stops/{stopId}/reservations ---------------------------
+
+func TestCreateStopReservation_201(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	var gotTrip uuid.UUID
+	var got domain.Reservation
+	svc := &mockReservationServicer{
+		create: func(_ context.Context, tid uuid.UUID, r domain.Reservation) (domain.Reservation, error) {
+			gotTrip, got = tid, r
+			r.ID, r.CreatedAt, r.UpdatedAt = uuid.New(), time.Now().UTC(), time.Now().UTC()
+			return r, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"confirmation_number":   "KOA-48213",
+		"site_number":           "B12",
+		"check_in":              "2025-07-01",
+		"check_out":             "2025-07-04",
+		"cost":                  142.5,
+		"cancellation_deadline": "2025-06-24T23:59:00Z",
+	})
+	req := httptest.NewRequest(http.MethodPost, stopPath(tripID, stopID, "/reservations"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, tripID, gotTrip)
+	assert.Equal(t, stopID, got.StopID)
+	assert.Equal(t, "B12", got.SiteNumber)
+	assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), got.CheckIn)
+	require.NotNil(t, got.Cost)
+	assert.InDelta(t, 142.5, *got.Cost, 0.001)
+	require.NotNil(t, got.CancellationDeadline)
+}
+
+func TestCreateStopReservation_422(t *testing.T) {
+	svc := &mockReservationServicer{
+		create: func(_ context.Context, _ uuid.UUID, _ domain.Reservation) (domain.Reservation, error) {
+			return domain.Reservation{}, fmt.Errorf("%w: check_out must be after check_in", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"check_in": "2025-07-04", "check_out": "2025-07-01"})
+	req := httptest.NewRequest(http.MethodPost, stopPath(uuid.New(), uuid.New(), "/reservations"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestUpdateStopReservation_UsesPathIDs(t *testing.T) {
+	stopID, reservationID := uuid.New(), uuid.New()
+	var got domain.Reservation
+	svc := &mockReservationServicer{
+		update: func(_ context.Context, _ uuid.UUID, r domain.Reservation) (domain.Reservation, error) {
+			got = r
+			return r, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"check_in": "2025-07-01", "check_out": "2025-07-05"})
+	req := httptest.NewRequest(http.MethodPut, stopPath(uuid.New(), stopID, "/reservations/"+reservationID.String()), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, reservationID, got.ID)
+	assert.Equal(t, stopID, got.StopID)
+}
+
+func TestGetStopReservation_404(t *testing.T) {
+	svc := &mockReservationServicer{
+		getByID: func(_ context.Context, _, _, _ uuid.UUID) (domain.Reservation, error) {
+			return domain.Reservation{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, stopPath(uuid.New(), uuid.New(), "/reservations/"+uuid.NewString()), nil)
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeleteStopReservation_204(t *testing.T) {
+	svc := &mockReservationServicer{
+		delete: func(_ context.Context, _, _, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, stopPath(uuid.New(), uuid.New(), "/reservations/"+uuid.NewString()), nil)
+	rec := httptest.NewRecorder()
+
+	newReservationHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListRigs handles GET /rigs.
+func (s *Server) ListRigs(ctx context.Context, req gen.ListRigsRequestObject) (gen.ListRigsResponseObject, error) {
+	rigs, err := s.rigs.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	resp := make(gen.ListRigs200JSONResponse, len(rigs))
+	for i, rig := range rigs {
+		resp[i] = rigToResponse(rig, u)
+	}
+	return resp, nil
+}
+
+// CreateRig handles POST /rigs.
+func (s *Server) CreateRig(ctx context.Context, req gen.CreateRigRequestObject) (gen.CreateRigResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateRig422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.rigs.Create(ctx, requestToRig(req.Body))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateRig422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateRig201JSONResponse(rigToResponse(created, unitsFor(req.Params.Units))), nil
+}
+
+// GetRig handles GET /rigs/{rigId}.
+func (s *Server) GetRig(ctx context.Context, req gen.GetRigRequestObject) (gen.GetRigResponseObject, error) {
+	rig, err := s.rigs.GetByID(ctx, req.RigId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetRig404JSONResponse(notFoundBody("rig not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetRig200JSONResponse(rigToResponse(rig, unitsFor(req.Params.Units))), nil
+}
+
+// UpdateRig handles PUT /rigs/{rigId}.
+func (s *Server) UpdateRig(ctx context.Context, req gen.UpdateRigRequestObject) (gen.UpdateRigResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateRig422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	rig := requestToRig(req.Body)
+	rig.ID = req.RigId
+	updated, err := s.rigs.Update(ctx, rig)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateRig404JSONResponse(notFoundBody("rig not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateRig422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.UpdateRig200JSONResponse(rigToResponse(updated, unitsFor(req.Params.Units))), nil
+}
+
+// DeleteRig handles DELETE /rigs/{rigId}.
+func (s *Server) DeleteRig(ctx context.Context, req gen.DeleteRigRequestObject) (gen.DeleteRigResponseObject, error) {
+	if err := s.rigs.Delete(ctx, req.RigId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteRig404JSONResponse(notFoundBody("rig not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteRig204Response{}, nil
+}
+
+// requestToRig converts a create or update body to a domain.Rig. Request
+// bodies are always in imperial units.
+func requestToRig(body *gen.RigRequest) domain.Rig {
+	return domain.Rig{
+		Name:       body.Name,
+		Make:       derefString(body.Make),
+		Model:      derefString(body.Model),
+		LengthFeet: body.LengthFeet,
+		WeightLbs:  body.WeightLbs,
+	}
+}
+
+// rigToResponse converts a domain.Rig to the API response shape.
+func rigToResponse(r domain.Rig, u units) gen.Rig {
+	return gen.Rig{
+		Id:         r.ID,
+		Name:       r.Name,
+		Make:       nilIfEmpty(r.Make),
+		Model:      nilIfEmpty(r.Model),
+		LengthFeet: u.lengthPtr(r.LengthFeet),
+		WeightLbs:  u.weightPtr(r.WeightLbs),
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListRigMaintenance handles GET /rigs/{rigId}/maintenance.
+func (s *Server) ListRigMaintenance(ctx context.Context, req gen.ListRigMaintenanceRequestObject) (gen.ListRigMaintenanceResponseObject, error) {
+	entries, err := s.rigLog.List(ctx, req.RigId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListRigMaintenance404JSONResponse(notFoundBody("rig not found")), nil
+		}
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	resp := make(gen.ListRigMaintenance200JSONResponse, len(entries))
+	for i, m := range entries {
+		resp[i] = rigMaintenanceToResponse(m, u)
+	}
+	return resp, nil
+}
+
+// CreateRigMaintenance handles POST /rigs/{rigId}/maintenance.
+func (s *Server) CreateRigMaintenance(ctx context.Context, req gen.CreateRigMaintenanceRequestObject) (gen.CreateRigMaintenanceResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateRigMaintenance422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.rigLog.Create(ctx, domain.RigMaintenance{
+		RigID:         req.RigId,
+		Name:          req.Body.Name,
+		ServicedOn:    dateFromAPI(req.Body.ServicedOn),
+		OdometerMiles: req.Body.OdometerMiles,
+		EngineHours:   req.Body.EngineHours,
+		Notes:         derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateRigMaintenance404JSONResponse(notFoundBody("rig not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateRigMaintenance422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateRigMaintenance201JSONResponse(rigMaintenanceToResponse(created, unitsFor(req.Params.Units))), nil
+}
+
+// DeleteRigMaintenance handles DELETE /rigs/{rigId}/maintenance/{entryId}.
+func (s *Server) DeleteRigMaintenance(ctx context.Context, req gen.DeleteRigMaintenanceRequestObject) (gen.DeleteRigMaintenanceResponseObject, error) {
+	if err := s.rigLog.Delete(ctx, req.RigId, req.EntryId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteRigMaintenance404JSONResponse(notFoundBody("maintenance entry not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteRigMaintenance204Response{}, nil
+}
+
+// rigMaintenanceToResponse converts a domain.RigMaintenance to the API
+// response shape. Engine hours are the same in either unit system.
+func rigMaintenanceToResponse(m domain.RigMaintenance, u units) gen.RigMaintenance {
+	return gen.RigMaintenance{
+		Id:            m.ID,
+		RigId:         m.RigID,
+		Name:          m.Name,
+		ServicedOn:    dateToAPI(m.ServicedOn),
+		OdometerMiles: u.distancePtr(m.OdometerMiles),
+		EngineHours:   m.EngineHours,
+		Notes:         nilIfEmpty(m.Notes),
+		CreatedAt:     m.CreatedAt,
+	}
+}
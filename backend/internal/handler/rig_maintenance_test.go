@@ -0,0 +1,168 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock RigMaintenanceServicer -------------------------------------------
+
+type mockRigMaintenanceServicer struct {
+	create func(ctx context.Context, m domain.RigMaintenance) (domain.RigMaintenance, error)
+	list   func(ctx context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error)
+	delete func(ctx context.Context, rigID, id uuid.UUID) error
+}
+
+func (m *mockRigMaintenanceServicer) Create(ctx context.Context, entry domain.RigMaintenance) (domain.RigMaintenance, error) {
+	return m.create(ctx, entry)
+}
+func (m *mockRigMaintenanceServicer) List(ctx context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error) {
+	return m.list(ctx, rigID)
+}
+func (m *mockRigMaintenanceServicer) Delete(ctx context.Context, rigID, id uuid.UUID) error {
+	return m.delete(ctx, rigID, id)
+}
+
+// compile-time check: mockRigMaintenanceServicer must satisfy handler.RigMaintenanceServicer.
+var _ handler.RigMaintenanceServicer = (*mockRigMaintenanceServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newRigMaintenanceHTTPHandler wires a Server with only the rig maintenance service mock.
+func newRigMaintenanceHTTPHandler(t *testing.T, svc handler.RigMaintenanceServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{RigLog: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func rigMaintenanceFixture(rigID uuid.UUID) domain.RigMaintenance {
+	miles, hours := 42180.0, 312.5
+	return domain.RigMaintenance{
+		ID:            uuid.New(),
+		RigID:         rigID,
+		Name:          "Generator service",
+		ServicedOn:    domain.NewDate(2025, 5, 12),
+		OdometerMiles: &miles,
+		EngineHours:   &hours,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// ---- GET /rigs/{rigId}/maintenance -----------------------------------------
+
+func TestListRigMaintenance_200_Metric(t *testing.T) {
+	rigID := uuid.New()
+	fixture := rigMaintenanceFixture(rigID)
+	svc := &mockRigMaintenanceServicer{
+		list: func(_ context.Context, id uuid.UUID) ([]domain.RigMaintenance, error) {
+			assert.Equal(t, rigID, id)
+			return []domain.RigMaintenance{fixture}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/rigs/"+rigID.String()+"/maintenance", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newRigMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.RigMaintenance
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.InDelta(t, 67882.13, *resp[0].OdometerMiles, 0.001, "converted to km")
+	assert.InDelta(t, 312.5, *resp[0].EngineHours, 0.001, "hours are not converted")
+	assert.Nil(t, resp[0].Notes)
+}
+
+func TestListRigMaintenance_404(t *testing.T) {
+	svc := &mockRigMaintenanceServicer{
+		list: func(_ context.Context, _ uuid.UUID) ([]domain.RigMaintenance, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/rigs/"+uuid.NewString()+"/maintenance", nil)
+	rec := httptest.NewRecorder()
+
+	newRigMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- POST /rigs/{rigId}/maintenance ----------------------------------------
+
+func TestCreateRigMaintenance_201(t *testing.T) {
+	rigID := uuid.New()
+	var got domain.RigMaintenance
+	svc := &mockRigMaintenanceServicer{
+		create: func(_ context.Context, m domain.RigMaintenance) (domain.RigMaintenance, error) {
+			got = m
+			m.ID = uuid.New()
+			return m, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Generator service", "serviced_on": "2025-05-12", "engine_hours": 312.5})
+	req := httptest.NewRequest(http.MethodPost, "/rigs/"+rigID.String()+"/maintenance", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRigMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, rigID, got.RigID)
+	assert.Equal(t, domain.NewDate(2025, 5, 12), got.ServicedOn)
+	require.NotNil(t, got.EngineHours)
+	assert.InDelta(t, 312.5, *got.EngineHours, 0.001)
+	assert.Nil(t, got.OdometerMiles)
+}
+
+func TestCreateRigMaintenance_404(t *testing.T) {
+	svc := &mockRigMaintenanceServicer{
+		create: func(_ context.Context, _ domain.RigMaintenance) (domain.RigMaintenance, error) {
+			return domain.RigMaintenance{}, domain.ErrNotFound
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Oil change", "serviced_on": "2025-05-12"})
+	req := httptest.NewRequest(http.MethodPost, "/rigs/"+uuid.NewString()+"/maintenance", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRigMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- DELETE /rigs/{rigId}/maintenance/{entryId} ----------------------------
+
+func TestDeleteRigMaintenance_204(t *testing.T) {
+	rigID, entryID := uuid.New(), uuid.New()
+	svc := &mockRigMaintenanceServicer{
+		delete: func(_ context.Context, gotRig, gotEntry uuid.UUID) error {
+			assert.Equal(t, rigID, gotRig)
+			assert.Equal(t, entryID, gotEntry)
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/rigs/"+rigID.String()+"/maintenance/"+entryID.String(), nil)
+	rec := httptest.NewRecorder()
+
+	newRigMaintenanceHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
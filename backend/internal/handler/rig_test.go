@@ -0,0 +1,218 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock RigServicer ------------------------------------------------------
+
+type mockRigServicer struct {
+	create  func(ctx context.Context, rig domain.Rig) (domain.Rig, error)
+	getByID func(ctx context.Context, id uuid.UUID) (domain.Rig, error)
+	list    func(ctx context.Context) ([]domain.Rig, error)
+	update  func(ctx context.Context, rig domain.Rig) (domain.Rig, error)
+	delete  func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockRigServicer) Create(ctx context.Context, rig domain.Rig) (domain.Rig, error) {
+	return m.create(ctx, rig)
+}
+func (m *mockRigServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.Rig, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockRigServicer) List(ctx context.Context) ([]domain.Rig, error) {
+	return m.list(ctx)
+}
+func (m *mockRigServicer) Update(ctx context.Context, rig domain.Rig) (domain.Rig, error) {
+	return m.update(ctx, rig)
+}
+func (m *mockRigServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+
+// compile-time check: mockRigServicer must satisfy handler.RigServicer.
+var _ handler.RigServicer = (*mockRigServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newRigHTTPHandler wires a Server with only the rig service mock.
+func newRigHTTPHandler(t *testing.T, svc handler.RigServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Rigs: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func rigFixture() domain.Rig {
+	length, weight := 33.5, 18000.0
+	return domain.Rig{
+		ID:         uuid.New(),
+		Name:       "Motorhome",
+		Make:       "Winnebago",
+		LengthFeet: &length,
+		WeightLbs:  &weight,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}
+}
+
+// ---- GET /rigs -------------------------------------------------------------
+
+func TestListRigs_200(t *testing.T) {
+	fixture := rigFixture()
+	svc := &mockRigServicer{
+		list: func(_ context.Context) ([]domain.Rig, error) {
+			return []domain.Rig{fixture}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/rigs", nil)
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.Rig
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "Motorhome", resp[0].Name)
+	require.NotNil(t, resp[0].Make)
+	assert.Equal(t, "Winnebago", *resp[0].Make)
+	assert.Nil(t, resp[0].Model)
+	require.NotNil(t, resp[0].LengthFeet)
+	assert.InDelta(t, 33.5, *resp[0].LengthFeet, 0.001)
+}
+
+func TestListRigs_Metric(t *testing.T) {
+	fixture := rigFixture()
+	svc := &mockRigServicer{
+		list: func(_ context.Context) ([]domain.Rig, error) {
+			return []domain.Rig{fixture}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/rigs", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.Rig
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.InDelta(t, 10.21, *resp[0].LengthFeet, 0.001)
+	assert.InDelta(t, 8164.66, *resp[0].WeightLbs, 0.001)
+}
+
+// ---- POST /rigs ------------------------------------------------------------
+
+func TestCreateRig_201(t *testing.T) {
+	fixture := rigFixture()
+	var got domain.Rig
+	svc := &mockRigServicer{
+		create: func(_ context.Context, rig domain.Rig) (domain.Rig, error) {
+			got = rig
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Motorhome", "make": "Winnebago", "length_feet": 33.5})
+	req := httptest.NewRequest(http.MethodPost, "/rigs", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "Motorhome", got.Name)
+	assert.Equal(t, "Winnebago", got.Make)
+	require.NotNil(t, got.LengthFeet)
+	assert.InDelta(t, 33.5, *got.LengthFeet, 0.001)
+	assert.Nil(t, got.WeightLbs)
+}
+
+func TestCreateRig_422(t *testing.T) {
+	svc := &mockRigServicer{
+		create: func(_ context.Context, _ domain.Rig) (domain.Rig, error) {
+			return domain.Rig{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": " "})
+	req := httptest.NewRequest(http.MethodPost, "/rigs", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- /rigs/{rigId} ---------------------------------------------------------
+
+func TestGetRig_404(t *testing.T) {
+	svc := &mockRigServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Rig, error) {
+			return domain.Rig{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/rigs/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUpdateRig_200(t *testing.T) {
+	fixture := rigFixture()
+	var got domain.Rig
+	svc := &mockRigServicer{
+		update: func(_ context.Context, rig domain.Rig) (domain.Rig, error) {
+			got = rig
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Motorhome", "model": "Vista 32YE"})
+	req := httptest.NewRequest(http.MethodPut, "/rigs/"+fixture.ID.String(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, fixture.ID, got.ID, "path ID is used")
+	assert.Equal(t, "Vista 32YE", got.Model)
+	assert.Empty(t, got.Make, "omitted fields are cleared")
+}
+
+func TestDeleteRig_404(t *testing.T) {
+	svc := &mockRigServicer{
+		delete: func(_ context.Context, _ uuid.UUID) error {
+			return domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/rigs/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newRigHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
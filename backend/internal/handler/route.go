@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListTripRouteLegs handles GET /trips/{tripId}/legs.
+func (s *Server) ListTripRouteLegs(ctx context.Context, req gen.ListTripRouteLegsRequestObject) (gen.ListTripRouteLegsResponseObject, error) {
+	legs, err := s.routes.ListByTrip(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripRouteLegs404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	resp := make(gen.ListTripRouteLegs200JSONResponse, len(legs))
+	for i, l := range legs {
+		resp[i] = routeLegToResponse(l, u)
+	}
+	return resp, nil
+}
+
+// UpdateTripRouteLeg handles PUT /trips/{tripId}/legs/{legId}.
+func (s *Server) UpdateTripRouteLeg(ctx context.Context, req gen.UpdateTripRouteLegRequestObject) (gen.UpdateTripRouteLegResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateTripRouteLeg422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	leg, err := s.routes.Update(ctx, domain.RouteLeg{
+		ID:              req.LegId,
+		TripID:          req.TripId,
+		DistanceMiles:   req.Body.DistanceMiles,
+		DurationMinutes: req.Body.DurationMinutes,
+		Polyline:        derefString(req.Body.Polyline),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateTripRouteLeg404JSONResponse(notFoundBody("route leg not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateTripRouteLeg422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateTripRouteLeg200JSONResponse(routeLegToResponse(leg, unitsFor(req.Params.Units))), nil
+}
+
+// UploadTripRouteLegTrack handles POST /trips/{tripId}/legs/{legId}/track.
+func (s *Server) UploadTripRouteLegTrack(ctx context.Context, req gen.UploadTripRouteLegTrackRequestObject) (gen.UploadTripRouteLegTrackResponseObject, error) {
+	leg, err := s.routes.UploadTrack(ctx, req.TripId, req.LegId, req.Body)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UploadTripRouteLegTrack404JSONResponse(notFoundBody("route leg not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UploadTripRouteLegTrack422JSONResponse(validationBody(err)), nil
+		}
+		// A chunked upload carries no Content-Length, so the body-size
+		// middleware can only stop it part-way through the read.
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return gen.UploadTripRouteLegTrack413JSONResponse(gen.ErrorResponse{Error: gen.ErrorDetail{
+				Code: "request_too_large", Message: "request body exceeds size limit",
+			}}), nil
+		}
+		return nil, err
+	}
+
+	return gen.UploadTripRouteLegTrack200JSONResponse(routeLegToResponse(leg, unitsFor(req.Params.Units))), nil
+}
+
+// GetTripRouteLegTrack handles GET /trips/{tripId}/legs/{legId}/track.
+func (s *Server) GetTripRouteLegTrack(ctx context.Context, req gen.GetTripRouteLegTrackRequestObject) (gen.GetTripRouteLegTrackResponseObject, error) {
+	rc, err := s.routes.Track(ctx, req.TripId, req.LegId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripRouteLegTrack404JSONResponse(notFoundBody("track not found")), nil
+		}
+		return nil, err
+	}
+	// The generated response closes Body once it has been copied out.
+	return gen.GetTripRouteLegTrack200ApplicationgpxXmlResponse{Body: rc}, nil
+}
+
+// Elevation profiles are stored in meters and served in the feet and miles
+// the rest of the API uses, unless the request asks for metric.
+const (
+	feetPerMeter  = 3.28084
+	metersPerMile = 1609.344
+)
+
+// GetTripRouteLegElevation handles GET /trips/{tripId}/legs/{legId}/elevation.
+func (s *Server) GetTripRouteLegElevation(ctx context.Context, req gen.GetTripRouteLegElevationRequestObject) (gen.GetTripRouteLegElevationResponseObject, error) {
+	leg, err := s.routes.Elevation(ctx, req.TripId, req.LegId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripRouteLegElevation404JSONResponse(notFoundBody("route leg not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetTripRouteLegElevation422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	p := leg.Elevation
+	ascent, descent := p.Climb()
+	lowest, highest := p.Range()
+	points := make([]gen.ElevationPoint, len(p.Meters))
+	for i, m := range p.Meters {
+		points[i] = gen.ElevationPoint{
+			DistanceMiles: u.lengthFromMeters(float64(i) * p.SpacingMeters),
+			ElevationFeet: u.heightFromMeters(m),
+		}
+	}
+	return gen.GetTripRouteLegElevation200JSONResponse{
+		LegId:            leg.ID,
+		DistanceMiles:    u.lengthFromMeters(float64(len(p.Meters)-1) * p.SpacingMeters),
+		AscentFeet:       u.heightFromMeters(ascent),
+		DescentFeet:      u.heightFromMeters(descent),
+		MinElevationFeet: u.heightFromMeters(lowest),
+		MaxElevationFeet: u.heightFromMeters(highest),
+		Points:           points,
+		FetchedAt:        p.FetchedAt,
+	}, nil
+}
+
+// CheckTripDrives handles POST /trips/{tripId}/drive-check.
+func (s *Server) CheckTripDrives(ctx context.Context, req gen.CheckTripDrivesRequestObject) (gen.CheckTripDrivesResponseObject, error) {
+	if req.Body == nil {
+		return gen.CheckTripDrives422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	rules := domain.DriveRules{
+		ArriveBeforeDark: req.Body.ArriveBeforeDark != nil && *req.Body.ArriveBeforeDark,
+		DepartAt:         req.Body.DepartAt,
+	}
+	if req.Body.MaxMilesPerDay != nil {
+		rules.MaxMiles = *req.Body.MaxMilesPerDay
+	}
+	if req.Body.MaxDriveHours != nil {
+		rules.MaxDriveTime = time.Duration(*req.Body.MaxDriveHours * float64(time.Hour))
+	}
+
+	days, err := s.routes.CheckDrives(ctx, req.TripId, rules)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CheckTripDrives404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CheckTripDrives422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	u := unitsFor(req.Params.Units)
+	resp := gen.CheckTripDrives200JSONResponse{TripId: req.TripId, Ok: true, Days: make([]gen.DriveDay, len(days))}
+	for i, d := range days {
+		resp.Days[i] = driveDayToResponse(d, u)
+		resp.Ok = resp.Ok && d.OK()
+	}
+	return resp, nil
+}
+
+// driveDayToResponse converts a domain.DriveDay into the generated type.
+func driveDayToResponse(d domain.DriveDay, u units) gen.DriveDay {
+	resp := gen.DriveDay{
+		LegId:           d.LegID,
+		FromStopId:      d.FromStopID,
+		ToStopId:        d.ToStopID,
+		DistanceMiles:   u.distancePtr(d.DistanceMiles),
+		DurationMinutes: d.DurationMinutes,
+		Source:          gen.DriveDaySource(d.Source),
+		DepartAt:        d.DepartAt,
+		ArriveAt:        d.ArriveAt,
+		Sunset:          d.Sunset,
+		Ok:              d.OK(),
+		Violations:      make([]gen.DriveViolation, len(d.Violations)),
+		Unchecked:       make([]gen.DriveRule, len(d.Unchecked)),
+	}
+	for i, v := range d.Violations {
+		resp.Violations[i] = gen.DriveViolation{Rule: gen.DriveRule(v.Rule), Message: v.Message}
+	}
+	for i, r := range d.Unchecked {
+		resp.Unchecked[i] = gen.DriveRule(r)
+	}
+	return resp
+}
+
+// GetTripStats handles GET /trips/{id}/stats.
+func (s *Server) GetTripStats(ctx context.Context, req gen.GetTripStatsRequestObject) (gen.GetTripStatsResponseObject, error) {
+	modified, err := s.cache.LastModified(ctx, domain.ViewTripStats)
+	if err != nil {
+		return nil, err
+	}
+	st, err := coalesce(ctx, s, flightKey("trip_stats", modified, req.Id), func(ctx context.Context) (domain.TripStats, error) {
+		return s.routes.TripStats(ctx, req.Id)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetTripStats404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+	if notModified(req.Params.IfModifiedSince, modified) {
+		return gen.GetTripStats304Response{Headers: notModifiedHeaders(modified)}, nil
+	}
+
+	return gen.GetTripStats200JSONResponse{
+		Body: gen.TripStats{
+			TripId:               st.TripID,
+			Stops:                st.Stops,
+			Legs:                 st.Legs,
+			LegsMissingDistance:  st.LegsMissingDistance,
+			TotalDistanceMiles:   unitsFor(req.Params.Units).distance(st.TotalDistanceMiles),
+			TotalDurationMinutes: st.TotalDurationMinutes,
+		},
+		Headers: gen.GetTripStats200ResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)},
+	}, nil
+}
+
+// routeLegToResponse converts a domain.RouteLeg into the generated type.
+func routeLegToResponse(l domain.RouteLeg, u units) gen.RouteLeg {
+	resp := gen.RouteLeg{
+		Id:              l.ID,
+		TripId:          l.TripID,
+		FromStopId:      l.FromStopID,
+		ToStopId:        l.ToStopID,
+		DistanceMiles:   u.distancePtr(l.DistanceMiles),
+		DurationMinutes: l.DurationMinutes,
+		Polyline:        nilIfEmpty(l.Polyline),
+		TrackPoints:     trackPoints(l),
+		TrackUploadedAt: l.TrackUploadedAt,
+		CreatedAt:       l.CreatedAt,
+		UpdatedAt:       l.UpdatedAt,
+	}
+	if l.Elevation != nil {
+		ascent, descent := l.Elevation.Climb()
+		ascentFeet, descentFeet := u.heightFromMeters(ascent), u.heightFromMeters(descent)
+		resp.AscentFeet, resp.DescentFeet = &ascentFeet, &descentFeet
+	}
+	return resp
+}
+
+// toFeet converts meters to whole feet.
+func toFeet(meters float64) float64 {
+	return math.Round(meters * feetPerMeter)
+}
+
+// roundTo rounds v to the nearest 1/per.
+func roundTo(v, per float64) float64 {
+	return math.Round(v*per) / per
+}
+
+// trackPoints returns the leg's track point count, or nil without a track.
+func trackPoints(l domain.RouteLeg) *int {
+	if !l.HasTrack() {
+		return nil
+	}
+	n := l.TrackPoints
+	return &n
+}
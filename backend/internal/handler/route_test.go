@@ -0,0 +1,493 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock RouteLegServicer -------------------------------------------------
+
+type mockRouteLegServicer struct {
+	listByTrip  func(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error)
+	update      func(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error)
+	uploadTrack func(ctx context.Context, tripID, legID uuid.UUID, r io.Reader) (domain.RouteLeg, error)
+	track       func(ctx context.Context, tripID, legID uuid.UUID) (io.ReadCloser, error)
+	elevation   func(ctx context.Context, tripID, legID uuid.UUID) (domain.RouteLeg, error)
+	checkDrives func(ctx context.Context, tripID uuid.UUID, rules domain.DriveRules) ([]domain.DriveDay, error)
+	tripStats   func(ctx context.Context, tripID uuid.UUID) (domain.TripStats, error)
+}
+
+func (m *mockRouteLegServicer) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error) {
+	return m.listByTrip(ctx, tripID)
+}
+func (m *mockRouteLegServicer) Update(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	return m.update(ctx, leg)
+}
+func (m *mockRouteLegServicer) UploadTrack(ctx context.Context, tripID, legID uuid.UUID, r io.Reader) (domain.RouteLeg, error) {
+	return m.uploadTrack(ctx, tripID, legID, r)
+}
+func (m *mockRouteLegServicer) Track(ctx context.Context, tripID, legID uuid.UUID) (io.ReadCloser, error) {
+	return m.track(ctx, tripID, legID)
+}
+func (m *mockRouteLegServicer) Elevation(ctx context.Context, tripID, legID uuid.UUID) (domain.RouteLeg, error) {
+	return m.elevation(ctx, tripID, legID)
+}
+func (m *mockRouteLegServicer) CheckDrives(ctx context.Context, tripID uuid.UUID, rules domain.DriveRules) ([]domain.DriveDay, error) {
+	return m.checkDrives(ctx, tripID, rules)
+}
+func (m *mockRouteLegServicer) TripStats(ctx context.Context, tripID uuid.UUID) (domain.TripStats, error) {
+	return m.tripStats(ctx, tripID)
+}
+
+// compile-time check: mockRouteLegServicer must satisfy handler.RouteLegServicer.
+var _ handler.RouteLegServicer = (*mockRouteLegServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newRouteHTTPHandler wires a Server with only the route leg service mock.
+func newRouteHTTPHandler(t *testing.T, svc handler.RouteLegServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Routes: svc, Cache: fixedCache(testModified)})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /trips/{tripId}/legs ----------------------------------------------
+
+func TestListTripRouteLegs_200(t *testing.T) {
+	tripID := uuid.New()
+	miles := 212.5
+	svc := &mockRouteLegServicer{
+		listByTrip: func(_ context.Context, tid uuid.UUID) ([]domain.RouteLeg, error) {
+			now := time.Now().UTC()
+			return []domain.RouteLeg{
+				{ID: uuid.New(), TripID: tid, FromStopID: uuid.New(), ToStopID: uuid.New(), DistanceMiles: &miles, Polyline: "_p~iF~ps|U", CreatedAt: now, UpdatedAt: now},
+				{ID: uuid.New(), TripID: tid, FromStopID: uuid.New(), ToStopID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/legs", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.RouteLeg
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 2)
+	require.NotNil(t, resp[0].DistanceMiles)
+	assert.InDelta(t, 212.5, *resp[0].DistanceMiles, 0.001)
+	require.NotNil(t, resp[0].Polyline)
+	assert.Nil(t, resp[1].DistanceMiles)
+	assert.Nil(t, resp[1].Polyline)
+}
+
+func TestListTripRouteLegs_404(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		listByTrip: func(_ context.Context, _ uuid.UUID) ([]domain.RouteLeg, error) {
+			return nil, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/legs", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- PUT /trips/{tripId}/legs/{legId} --------------------------------------
+
+func TestUpdateTripRouteLeg_200(t *testing.T) {
+	tripID, legID := uuid.New(), uuid.New()
+	var got domain.RouteLeg
+	svc := &mockRouteLegServicer{
+		update: func(_ context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+			got = leg
+			leg.FromStopID, leg.ToStopID = uuid.New(), uuid.New()
+			leg.CreatedAt, leg.UpdatedAt = time.Now().UTC(), time.Now().UTC()
+			return leg, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"distance_miles": 95.5, "duration_minutes": 120})
+	req := httptest.NewRequest(http.MethodPut, "/trips/"+tripID.String()+"/legs/"+legID.String(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, tripID, got.TripID)
+	assert.Equal(t, legID, got.ID)
+	require.NotNil(t, got.DistanceMiles)
+	assert.InDelta(t, 95.5, *got.DistanceMiles, 0.001)
+	require.NotNil(t, got.DurationMinutes)
+	assert.Equal(t, 120, *got.DurationMinutes)
+	assert.Empty(t, got.Polyline)
+}
+
+func TestUpdateTripRouteLeg_404(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		update: func(_ context.Context, _ domain.RouteLeg) (domain.RouteLeg, error) {
+			return domain.RouteLeg{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"distance_miles": 10})
+	req := httptest.NewRequest(http.MethodPut, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUpdateTripRouteLeg_422(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		update: func(_ context.Context, _ domain.RouteLeg) (domain.RouteLeg, error) {
+			return domain.RouteLeg{}, fmt.Errorf("%w: polyline is not an encoded polyline", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"polyline": "not a polyline"})
+	req := httptest.NewRequest(http.MethodPut, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- /trips/{tripId}/legs/{legId}/track -----------------------------------
+
+const handlerTestGPX = `<gpx><trk><trkseg><trkpt lat="45" lon="-110"/><trkpt lat="45" lon="-109.9"/></trkseg></trk></gpx>`
+
+func TestUploadTripRouteLegTrack_200(t *testing.T) {
+	tripID, legID := uuid.New(), uuid.New()
+	var gotBody string
+	svc := &mockRouteLegServicer{
+		uploadTrack: func(_ context.Context, tid, lid uuid.UUID, r io.Reader) (domain.RouteLeg, error) {
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			gotBody = string(data)
+			miles, now := 4.9, time.Now().UTC()
+			return domain.RouteLeg{
+				ID: lid, TripID: tid, FromStopID: uuid.New(), ToStopID: uuid.New(),
+				DistanceMiles: &miles, Polyline: "_c`|G~t`cT?_pR",
+				TrackKey: "tracks/x.gpx", TrackPoints: 2, TrackUploadedAt: &now,
+				CreatedAt: now, UpdatedAt: now,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/legs/"+legID.String()+"/track", strings.NewReader(handlerTestGPX))
+	req.Header.Set("Content-Type", "application/gpx+xml")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, handlerTestGPX, gotBody)
+	var resp gen.RouteLeg
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.TrackPoints)
+	assert.Equal(t, 2, *resp.TrackPoints)
+	assert.NotNil(t, resp.TrackUploadedAt)
+}
+
+func TestUploadTripRouteLegTrack_422(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		uploadTrack: func(_ context.Context, _, _ uuid.UUID, _ io.Reader) (domain.RouteLeg, error) {
+			return domain.RouteLeg{}, fmt.Errorf("%w: gpx: invalid file", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString()+"/track", strings.NewReader("{}"))
+	req.Header.Set("Content-Type", "application/gpx+xml")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestUploadTripRouteLegTrack_413(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		uploadTrack: func(_ context.Context, _, _ uuid.UUID, r io.Reader) (domain.RouteLeg, error) {
+			_, err := io.ReadAll(r)
+			return domain.RouteLeg{}, fmt.Errorf("svc: read: %w", err)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString()+"/track", strings.NewReader(handlerTestGPX))
+	req.Header.Set("Content-Type", "application/gpx+xml")
+	rec := httptest.NewRecorder()
+	req.Body = http.MaxBytesReader(rec, req.Body, 10)
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestGetTripRouteLegTrack_200(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		track: func(_ context.Context, _, _ uuid.UUID) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(handlerTestGPX)), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString()+"/track", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/gpx+xml", rec.Header().Get("Content-Type"))
+	assert.Equal(t, handlerTestGPX, rec.Body.String())
+}
+
+func TestGetTripRouteLegTrack_404(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		track: func(_ context.Context, _, _ uuid.UUID) (io.ReadCloser, error) {
+			return nil, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString()+"/track", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- GET /trips/{tripId}/legs/{legId}/elevation ----------------------------
+
+func TestGetTripRouteLegElevation_200(t *testing.T) {
+	tripID, legID := uuid.New(), uuid.New()
+	fetched := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	svc := &mockRouteLegServicer{
+		elevation: func(_ context.Context, tid, lid uuid.UUID) (domain.RouteLeg, error) {
+			return domain.RouteLeg{ID: lid, TripID: tid, Elevation: &domain.ElevationProfile{
+				SpacingMeters: 1609.344,
+				Meters:        []float64{3000, 3600, 3300},
+				FetchedAt:     fetched,
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/legs/"+legID.String()+"/elevation", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.ElevationProfile
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, legID, resp.LegId)
+	assert.InDelta(t, 2, resp.DistanceMiles, 1e-9)
+	require.Len(t, resp.Points, 3)
+	assert.InDelta(t, 1, resp.Points[1].DistanceMiles, 1e-9)
+	assert.InDelta(t, 11811, resp.Points[1].ElevationFeet, 1e-9)
+	assert.InDelta(t, 1969, resp.AscentFeet, 1e-9)
+	assert.InDelta(t, 984, resp.DescentFeet, 1e-9)
+	assert.InDelta(t, 9843, resp.MinElevationFeet, 1e-9)
+	assert.InDelta(t, 11811, resp.MaxElevationFeet, 1e-9)
+	assert.True(t, resp.FetchedAt.Equal(fetched))
+}
+
+func TestGetTripRouteLegElevation_200_Metric(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		elevation: func(_ context.Context, tid, lid uuid.UUID) (domain.RouteLeg, error) {
+			return domain.RouteLeg{ID: lid, TripID: tid, Elevation: &domain.ElevationProfile{
+				SpacingMeters: 1609.344,
+				Meters:        []float64{3000, 3600.4, 3300},
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString()+"/elevation", nil)
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.ElevationProfile
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.InDelta(t, 3.22, resp.DistanceMiles, 1e-9, "kilometers")
+	require.Len(t, resp.Points, 3)
+	assert.InDelta(t, 1.61, resp.Points[1].DistanceMiles, 1e-9)
+	assert.InDelta(t, 3600, resp.Points[1].ElevationFeet, 1e-9, "whole meters")
+	assert.InDelta(t, 600, resp.AscentFeet, 1e-9)
+	assert.InDelta(t, 3000, resp.MinElevationFeet, 1e-9)
+}
+
+func TestGetTripRouteLegElevation_Errors(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want int
+	}{
+		"not found":  {domain.ErrNotFound, http.StatusNotFound},
+		"validation": {fmt.Errorf("%w: the leg has no polyline", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := &mockRouteLegServicer{
+				elevation: func(_ context.Context, _, _ uuid.UUID) (domain.RouteLeg, error) {
+					return domain.RouteLeg{}, fmt.Errorf("svc: %w", tc.err)
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/legs/"+uuid.NewString()+"/elevation", nil)
+			rec := httptest.NewRecorder()
+
+			newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.want, rec.Code)
+		})
+	}
+}
+
+// ---- GET /trips/{id}/stats -------------------------------------------------
+
+// ---- POST /trips/{tripId}/drive-check -------------------------------------
+
+func TestCheckTripDrives_200(t *testing.T) {
+	tripID := uuid.New()
+	depart := time.Date(2025, 6, 21, 15, 0, 0, 0, time.UTC)
+	arrive := depart.Add(330 * time.Minute)
+	miles, minutes := 354.0, 330
+	var got domain.DriveRules
+	svc := &mockRouteLegServicer{
+		checkDrives: func(_ context.Context, _ uuid.UUID, rules domain.DriveRules) ([]domain.DriveDay, error) {
+			got = rules
+			return []domain.DriveDay{
+				{
+					LegID: uuid.New(), FromStopID: uuid.New(), ToStopID: uuid.New(),
+					DistanceMiles: &miles, DurationMinutes: &minutes, Source: domain.DriveEntered,
+					DepartAt: &depart, ArriveAt: &arrive,
+					Violations: []domain.DriveViolation{{Rule: domain.RuleMaxMiles, Message: "354 miles is over the 300-mile limit"}},
+				},
+				{
+					LegID: uuid.New(), FromStopID: uuid.New(), ToStopID: uuid.New(), Source: domain.DriveUnknown,
+					Unchecked: []domain.DriveRule{domain.RuleMaxMiles},
+				},
+			}, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"max_miles_per_day": 300, "max_drive_hours": 6.5, "arrive_before_dark": true, "depart_at": "2025-06-21T09:00:00-06:00"})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/drive-check", body)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Units", "metric")
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 300.0, got.MaxMiles)
+	assert.Equal(t, 6*time.Hour+30*time.Minute, got.MaxDriveTime)
+	assert.True(t, got.ArriveBeforeDark)
+	require.NotNil(t, got.DepartAt)
+	assert.True(t, depart.Equal(*got.DepartAt))
+
+	var resp gen.DriveCheck
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, tripID, resp.TripId)
+	assert.False(t, resp.Ok)
+	require.Len(t, resp.Days, 2)
+	assert.False(t, resp.Days[0].Ok)
+	assert.InDelta(t, 569.71, *resp.Days[0].DistanceMiles, 0.01, "kilometers")
+	assert.Equal(t, gen.DriveRule("max_miles"), resp.Days[0].Violations[0].Rule)
+	assert.True(t, resp.Days[1].Ok)
+	assert.Nil(t, resp.Days[1].DistanceMiles)
+	assert.Equal(t, []gen.DriveRule{"max_miles"}, resp.Days[1].Unchecked)
+}
+
+func TestCheckTripDrives_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"trip not found", domain.ErrNotFound, http.StatusNotFound},
+		{"no rules", fmt.Errorf("%w: set at least one rule", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockRouteLegServicer{
+				checkDrives: func(_ context.Context, _ uuid.UUID, _ domain.DriveRules) ([]domain.DriveDay, error) {
+					return nil, tt.err
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/drive-check", strings.NewReader(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestGetTripStats_200(t *testing.T) {
+	tripID := uuid.New()
+	svc := &mockRouteLegServicer{
+		tripStats: func(_ context.Context, tid uuid.UUID) (domain.TripStats, error) {
+			return domain.TripStats{
+				TripID: tid, Stops: 4, Legs: 3, LegsMissingDistance: 1,
+				TotalDistanceMiles: 275.5, TotalDurationMinutes: 300,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/stats", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.TripStats
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, tripID, resp.TripId)
+	assert.Equal(t, 3, resp.Legs)
+	assert.InDelta(t, 275.5, resp.TotalDistanceMiles, 0.001)
+	assert.Equal(t, 300, resp.TotalDurationMinutes)
+}
+
+func TestGetTripStats_404(t *testing.T) {
+	svc := &mockRouteLegServicer{
+		tripStats: func(_ context.Context, _ uuid.UUID) (domain.TripStats, error) {
+			return domain.TripStats{}, fmt.Errorf("svc: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/stats", nil)
+	rec := httptest.NewRecorder()
+
+	newRouteHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
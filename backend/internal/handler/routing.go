@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// routeMethods are the methods MethodNotAllowed tries when building the
+// Allow header.
+var routeMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// NewRouter returns a chi router whose unmatched requests get the standard
+// ErrorResponse instead of chi's plain-text defaults. Register the API on it
+// with gen.HandlerFromMux.
+func NewRouter() chi.Router {
+	r := chi.NewRouter()
+	r.NotFound(routeNotFound)
+	r.MethodNotAllowed(methodNotAllowed(r))
+	return r
+}
+
+// NewStrictHandler wraps server as gen.NewStrictHandler does, except that a
+// query that ran past its time limit is answered with 504 and the
+// query_timeout code rather than a bare 500.
+func NewStrictHandler(server gen.StrictServerInterface) gen.ServerInterface {
+	return gen.NewStrictHandlerWithOptions(server, nil, gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, _ *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: responseError,
+	})
+}
+
+// responseError answers an error a handler returned instead of a response.
+// Trip roles are checked by the services behind many operations, so a
+// domain.ErrForbidden is answered here rather than in each handler.
+func responseError(w http.ResponseWriter, _ *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTimeout):
+		writeErrorResponse(w, http.StatusGatewayTimeout, gen.ErrorDetail{
+			Code:    "query_timeout",
+			Message: "the database took too long to answer; try again or narrow the request",
+		})
+		return
+	case errors.Is(err, domain.ErrForbidden):
+		writeErrorResponse(w, http.StatusForbidden, forbiddenBody(err).Error)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// routeNotFound answers a path no route matches with 404.
+func routeNotFound(w http.ResponseWriter, r *http.Request) {
+	writeErrorResponse(w, http.StatusNotFound, gen.ErrorDetail{
+		Code:    "not_found",
+		Message: fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path),
+	})
+}
+
+// methodNotAllowed answers a path that routes only under other methods with
+// 405, listing those methods in the Allow header as RFC 9110 requires. chi
+// passes custom handlers no method list, so it asks routes for each.
+func methodNotAllowed(routes chi.Routes) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+			path = rctx.RoutePath // relative to where the router is mounted
+		}
+		var allowed []string
+		for _, m := range routeMethods {
+			if routes.Match(chi.NewRouteContext(), m, path) {
+				allowed = append(allowed, m)
+			}
+		}
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeErrorResponse(w, http.StatusMethodNotAllowed, gen.ErrorDetail{
+			Code:    "method_not_allowed",
+			Message: fmt.Sprintf("%s is not allowed on %s; allowed: %s", r.Method, r.URL.Path, strings.Join(allowed, ", ")),
+		})
+	}
+}
+
+// writeErrorResponse writes detail in the standard error envelope.
+func writeErrorResponse(w http.ResponseWriter, status int, detail gen.ErrorDetail) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(gen.ErrorResponse{Error: detail})
+}
@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// newRoutedHTTPHandler mounts the API the way the app does, so the route
+// context carries a mount prefix.
+func newRoutedHTTPHandler() http.Handler {
+	r := chi.NewRouter()
+	r.Mount("/", gen.HandlerFromMux(gen.NewStrictHandler(handler.NewHealthHandler(), nil), handler.NewRouter()))
+	return r
+}
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) gen.ErrorDetail {
+	t.Helper()
+	var body gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	return body.Error
+}
+
+func TestRouter_UnknownRoute(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	newRoutedHTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/nope", nil))
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, gen.ErrorDetail{Code: "not_found", Message: "no route for GET /nope"}, decodeError(t, rec))
+}
+
+func TestRouter_WrongMethod(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	newRoutedHTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/healthz", nil))
+
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET", rec.Header().Get("Allow"))
+	assert.Equal(t, "method_not_allowed", decodeError(t, rec).Code)
+}
+
+func TestRouter_WrongMethodListsEveryAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	newRoutedHTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/trips/7f1c2a9e-3b5d-4e8f-9a0b-1c2d3e4f5a6b", nil))
+
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET, PUT, DELETE", rec.Header().Get("Allow"))
+	assert.Equal(t, "POST is not allowed on /trips/7f1c2a9e-3b5d-4e8f-9a0b-1c2d3e4f5a6b; allowed: GET, PUT, DELETE", decodeError(t, rec).Message)
+}
+
+// newStrictTripHandler serves trips through handler.NewStrictHandler. The
+// 504 it answers is not a per-operation response, so the contract check is
+// left out.
+func newStrictTripHandler(err error) http.Handler {
+	trips := &mockTripServicer{
+		getByID: func(context.Context, uuid.UUID) (domain.Trip, error) { return domain.Trip{}, err },
+	}
+	srv := handler.NewServer(handler.ServerDeps{Trips: trips})
+	return gen.HandlerFromMux(handler.NewStrictHandler(srv), handler.NewRouter())
+}
+
+func TestStrictHandler_QueryTimeout(t *testing.T) {
+	rec := httptest.NewRecorder()
+	err := fmt.Errorf("service.TripService.GetByID: repo.TripRepo.GetByID: %w: canceling statement", domain.ErrTimeout)
+
+	newStrictTripHandler(err).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString(), nil))
+
+	require.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "query_timeout", decodeError(t, rec).Code)
+}
+
+func TestStrictHandler_Forbidden(t *testing.T) {
+	trips := &mockTripServicer{
+		delete: func(context.Context, uuid.UUID) error {
+			return fmt.Errorf("service.TripService.Delete: %w: only the trip's owner may do this", domain.ErrForbidden)
+		},
+	}
+	srv := handler.NewServer(handler.ServerDeps{Trips: trips})
+	h := testutil.ContractHandler(t, gen.HandlerFromMux(handler.NewStrictHandler(srv), handler.NewRouter()))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.NewString(), nil))
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	e := decodeError(t, rec)
+	assert.Equal(t, "forbidden", e.Code)
+	assert.Equal(t, "only the trip's owner may do this", e.Message)
+}
+
+func TestStrictHandler_OtherErrorIs500(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	newStrictTripHandler(errors.New("connection refused")).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString(), nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
@@ -6,8 +6,11 @@ package handler
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 )
@@ -20,9 +23,12 @@ type TripServicer interface {
 	Create(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error)
 	List(ctx context.Context) ([]domain.Trip, error)
-	ListPaged(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error)
+	ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error)
 	Update(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	History(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error)
+	Revert(ctx context.Context, id uuid.UUID, revision int) (domain.Trip, error)
+	Clone(ctx context.Context, sourceID uuid.UUID, c domain.TripClone) (domain.Trip, error)
 }
 
 // StopServicer defines the business operations the stop handler depends on.
@@ -30,12 +36,20 @@ type StopServicer interface {
 	Create(ctx context.Context, stop domain.Stop) (domain.Stop, error)
 	GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error)
 	ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error)
-	ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, fields []string, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	Nearby(ctx context.Context, lat, lon float64, radiusKm *float64) ([]domain.NearbyStop, error)
+	Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error)
+	Heatmap(ctx context.Context, year *int, cellDegrees *float64) (domain.Heatmap, error)
+	Occupancy(ctx context.Context, year *int) (domain.Occupancy, error)
+	Gaps(ctx context.Context, tripID uuid.UUID) ([]domain.TripGap, error)
 	Update(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	Arrive(ctx context.Context, tripID, stopID uuid.UUID, at *time.Time) (domain.Stop, error)
 	Delete(ctx context.Context, tripID, stopID uuid.UUID) error
-	AddTag(ctx context.Context, stopID uuid.UUID, tagName string) (domain.Tag, error)
-	RemoveTagFromStop(ctx context.Context, stopID uuid.UUID, slug string) error
+	AddTag(ctx context.Context, tripID, stopID uuid.UUID, tagName string) (domain.Tag, error)
+	RemoveTagFromStop(ctx context.Context, tripID, stopID uuid.UUID, slug string) error
 	ListTagsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Tag, error)
+	History(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error)
+	Revert(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.Stop, error)
 }
 
 // TagServicer defines the business operations the tag handler depends on.
@@ -45,30 +59,458 @@ type TagServicer interface {
 	UpdateName(ctx context.Context, slug, name string) (domain.Tag, error)
 	Delete(ctx context.Context, slug string) error
 	UpsertByName(ctx context.Context, name string) (domain.Tag, error)
+	Merge(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error)
+	SetParent(ctx context.Context, slug, parentSlug string) (domain.Tag, error)
+	Stats(ctx context.Context) ([]domain.TagStats, error)
 }
 
 // ExportServicer defines the business operations the export handler depends on.
 type ExportServicer interface {
-	Export(ctx context.Context) ([]domain.ExportRow, error)
+	Stream(ctx context.Context, fn func(domain.ExportRow) error) error
+	Deletions(ctx context.Context, since time.Time) ([]domain.Tombstone, error)
+}
+
+// ShareServicer defines the business operations the share handler depends on.
+type ShareServicer interface {
+	Create(ctx context.Context, tripID uuid.UUID, ttl time.Duration) (domain.Share, error)
+	List(ctx context.Context, tripID uuid.UUID) ([]domain.Share, error)
+	Revoke(ctx context.Context, tripID, shareID uuid.UUID) error
+	Resolve(ctx context.Context, token string) (domain.SharedTrip, error)
+}
+
+// OdometerServicer defines the business operations the odometer handlers depend on.
+type OdometerServicer interface {
+	Create(ctx context.Context, reading domain.OdometerReading) (domain.OdometerReading, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.OdometerReading, error)
+	ListPaged(ctx context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	TripMileage(ctx context.Context, tripID uuid.UUID) (domain.TripMileage, error)
+}
+
+// PropaneServicer defines the business operations the propane handlers depend on.
+type PropaneServicer interface {
+	Create(ctx context.Context, fill domain.PropaneFill) (domain.PropaneFill, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.PropaneFill, error)
+	ListPaged(ctx context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Stats(ctx context.Context, f domain.PropaneFilter) (domain.PropaneStats, error)
+}
+
+// PowerServicer defines the business operations the power handlers depend on.
+type PowerServicer interface {
+	Create(ctx context.Context, reading domain.PowerReading) (domain.PowerReading, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.PowerReading, error)
+	ListPaged(ctx context.Context, f domain.PowerFilter, p domain.PaginationParams) ([]domain.PowerReading, int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	TripPower(ctx context.Context, tripID uuid.UUID) (domain.TripPower, error)
+}
+
+// TankServicer defines the business operations the tank and current-trip
+// handlers depend on.
+type TankServicer interface {
+	CreateLevel(ctx context.Context, tripID uuid.UUID, level domain.TankLevel) (domain.TankLevel, error)
+	ListLevels(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.TankLevel, error)
+	DeleteLevel(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	CreateDump(ctx context.Context, tripID uuid.UUID, dump domain.DumpEvent) (domain.DumpEvent, error)
+	ListDumps(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.DumpEvent, error)
+	DeleteDump(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	CurrentTrip(ctx context.Context) (domain.CurrentTrip, error)
+}
+
+// ChecklistServicer defines the business operations the checklist handlers depend on.
+type ChecklistServicer interface {
+	CreateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (domain.ChecklistTemplate, error)
+	ListTemplates(ctx context.Context) ([]domain.ChecklistTemplate, error)
+	UpdateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error)
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	StartChecklist(ctx context.Context, tripID, stopID, templateID uuid.UUID) (domain.Checklist, error)
+	ListChecklists(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Checklist, error)
+	GetChecklist(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Checklist, error)
+	DeleteChecklist(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	SetItemChecked(ctx context.Context, tripID, stopID, checklistID, itemID uuid.UUID, checked bool) (domain.Checklist, error)
+}
+
+// PackingServicer defines the business operations the packing list handlers depend on.
+type PackingServicer interface {
+	CreateList(ctx context.Context, l domain.PackingList, sourceID *uuid.UUID) (domain.PackingList, error)
+	GetList(ctx context.Context, id uuid.UUID) (domain.PackingList, error)
+	ListLists(ctx context.Context, f domain.PackingListFilter) ([]domain.PackingList, error)
+	RenameList(ctx context.Context, id uuid.UUID, name string) (domain.PackingList, error)
+	DeleteList(ctx context.Context, id uuid.UUID) error
+	AddItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error)
+	UpdateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error)
+	DeleteItem(ctx context.Context, listID, id uuid.UUID) error
+}
+
+// ReservationServicer defines the business operations the reservation handlers depend on.
+type ReservationServicer interface {
+	Create(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error)
+	GetByID(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Reservation, error)
+	ListByStop(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Reservation, error)
+	Update(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error)
+	Delete(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	Upcoming(ctx context.Context, remindDays int) ([]domain.UpcomingReservation, error)
+}
+
+// ExpenseServicer defines the business operations the expense handlers depend on.
+type ExpenseServicer interface {
+	QuickLog(ctx context.Context, tripID uuid.UUID, category domain.ExpenseCategory, amount float64, note string, fillUp *domain.FillUp) (domain.Expense, error)
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.Expense, error)
+	SetSplit(ctx context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error)
+	Settlement(ctx context.Context, tripID uuid.UUID) (domain.Settlement, error)
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+// BorderCrossingServicer defines the business operations the border crossing handlers depend on.
+type BorderCrossingServicer interface {
+	Create(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error)
+	GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error)
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error)
+	Update(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error)
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+	YearReport(ctx context.Context, year int) ([]domain.BorderCrossingReportRow, error)
+}
+
+// POIServicer defines the business operations the point-of-interest handlers depend on.
+type POIServicer interface {
+	Create(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.PointOfInterest, error)
+	ListPaged(ctx context.Context, f domain.POIFilter, p domain.PaginationParams) ([]domain.PointOfInterest, int64, error)
+	Update(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// RouteLegServicer defines the business operations the route leg and trip stats handlers depend on.
+type RouteLegServicer interface {
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error)
+	Update(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error)
+	UploadTrack(ctx context.Context, tripID, legID uuid.UUID, r io.Reader) (domain.RouteLeg, error)
+	Track(ctx context.Context, tripID, legID uuid.UUID) (io.ReadCloser, error)
+	Elevation(ctx context.Context, tripID, legID uuid.UUID) (domain.RouteLeg, error)
+	CheckDrives(ctx context.Context, tripID uuid.UUID, rules domain.DriveRules) ([]domain.DriveDay, error)
+	TripStats(ctx context.Context, tripID uuid.UUID) (domain.TripStats, error)
+}
+
+// MapServicer defines the business operations the static map handler depends on.
+type MapServicer interface {
+	TripMapPNG(ctx context.Context, tripID uuid.UUID, width, height int) ([]byte, error)
+	StopsMapPNG(ctx context.Context, stops []domain.Stop, width, height int) ([]byte, error)
+}
+
+// LocationServicer defines the business operations the location ingest and stop suggestion handlers depend on.
+type LocationServicer interface {
+	Ingest(ctx context.Context, ping domain.LocationPing) error
+	ListSuggestions(ctx context.Context, tripID uuid.UUID) ([]domain.Dwell, error)
+	AcceptSuggestion(ctx context.Context, tripID, id uuid.UUID) (domain.Stop, error)
+	DismissSuggestion(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+// PlaceServicer defines the business operations the states-visited report handler depends on.
+type PlaceServicer interface {
+	StatesVisited(ctx context.Context, year *int) (domain.StatesVisitedReport, error)
+}
+
+// CacheServicer defines the lookup the conditional GET handlers depend on.
+type CacheServicer interface {
+	LastModified(ctx context.Context, view domain.CachedView) (time.Time, error)
+}
+
+// DashboardServicer defines the business operations the dashboard handler depends on.
+type DashboardServicer interface {
+	Dashboard(ctx context.Context, tagLimit *int) (domain.Dashboard, error)
+}
+
+// HealthServicer defines the component checks the detailed health and readiness handlers depend on.
+type HealthServicer interface {
+	Report(ctx context.Context) domain.HealthReport
+	Ready(ctx context.Context) domain.HealthReport
+}
+
+// PoolServicer defines the connection pool statistics the admin handler depends on.
+type PoolServicer interface {
+	Stats() domain.PoolStats
+}
+
+// TrashServicer defines the business operations the trash handlers depend on.
+type TrashServicer interface {
+	List(ctx context.Context) ([]domain.TrashItem, error)
+	Restore(ctx context.Context, id uuid.UUID) (domain.TrashItem, error)
+}
+
+// OrganizationServicer defines the business operations the organization handlers depend on.
+type OrganizationServicer interface {
+	Create(ctx context.Context, name string) (domain.Organization, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error)
+	List(ctx context.Context) ([]domain.Organization, error)
+	Rename(ctx context.Context, id uuid.UUID, name string) (domain.Organization, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.Member, error)
+	AddMember(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error)
+	UpdateMemberRole(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error)
+	RemoveMember(ctx context.Context, orgID uuid.UUID, userID string) error
+}
+
+// CustomFieldServicer defines the business operations the custom field handlers depend on.
+type CustomFieldServicer interface {
+	Create(ctx context.Context, f domain.CustomField) (domain.CustomField, error)
+	List(ctx context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error)
+	UpdateLabel(ctx context.Context, id uuid.UUID, label string) (domain.CustomField, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// JournalServicer defines the business operations the journal handlers depend on.
+type JournalServicer interface {
+	Create(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error)
+	GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error)
+	ListByDay(ctx context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalDay, error)
+	Update(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error)
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+// WebhookServicer defines the business operations the webhook handlers depend on.
+type WebhookServicer interface {
+	Create(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+	List(ctx context.Context) ([]domain.Webhook, error)
+	Update(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	RotateSecret(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+	Test(ctx context.Context, id uuid.UUID) (domain.WebhookDelivery, error)
+	Deliveries(ctx context.Context, id uuid.UUID) ([]domain.WebhookDelivery, error)
+}
+
+// AuthServicer defines the business operations the auth handlers depend on.
+type AuthServicer interface {
+	Login(ctx context.Context, username, password string) (domain.Tokens, error)
+	Refresh(ctx context.Context, refreshToken string) (domain.Tokens, error)
+}
+
+// APIKeyServicer defines the business operations the API key handlers depend on.
+type APIKeyServicer interface {
+	Create(ctx context.Context, name string) (domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// MaintenanceServicer defines the business operations the maintenance handlers depend on.
+type MaintenanceServicer interface {
+	CreateItem(ctx context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error)
+	ListItems(ctx context.Context, vehicle string) ([]domain.MaintenanceItem, error)
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+	RecordService(ctx context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error)
+	Due(ctx context.Context, withinMiles float64) ([]domain.MaintenanceDue, error)
+}
+
+// MembershipServicer defines the business operations the trip member handlers depend on.
+type MembershipServicer interface {
+	List(ctx context.Context, tripID uuid.UUID) ([]domain.TripMember, error)
+	Invite(ctx context.Context, tripID uuid.UUID, username string, role domain.TripRole) (domain.TripMember, error)
+	UpdateRole(ctx context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error)
+	Remove(ctx context.Context, tripID, userID uuid.UUID) error
+}
+
+// RigServicer defines the business operations the rig handlers depend on.
+type RigServicer interface {
+	Create(ctx context.Context, rig domain.Rig) (domain.Rig, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Rig, error)
+	List(ctx context.Context) ([]domain.Rig, error)
+	Update(ctx context.Context, rig domain.Rig) (domain.Rig, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// RigMaintenanceServicer defines the business operations the rig maintenance handlers depend on.
+type RigMaintenanceServicer interface {
+	Create(ctx context.Context, m domain.RigMaintenance) (domain.RigMaintenance, error)
+	List(ctx context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error)
+	Delete(ctx context.Context, rigID, id uuid.UUID) error
+}
+
+// ReportServicer defines the business operations the report handlers depend on.
+type ReportServicer interface {
+	BudgetReport(ctx context.Context, tripID uuid.UUID) (domain.BudgetReport, error)
+}
+
+// PhotoServicer defines the business operations the stop photo handlers depend on.
+type PhotoServicer interface {
+	Upload(ctx context.Context, tripID, stopID uuid.UUID, filename, caption string, applyEXIF bool, r io.Reader) (domain.Photo, error)
+	ListByStop(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Photo, error)
+	Open(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Photo, io.ReadCloser, error)
+	Thumbnail(ctx context.Context, id uuid.UUID, size domain.ThumbnailSize) (io.ReadCloser, error)
+	Delete(ctx context.Context, tripID, stopID, id uuid.UUID) error
+}
+
+// NoteTemplateServicer defines the business operations the note template handlers depend on.
+type NoteTemplateServicer interface {
+	Create(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.NoteTemplate, error)
+	List(ctx context.Context) ([]domain.NoteTemplate, error)
+	Update(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ImportServicer defines the business operations the import handlers depend on.
+type ImportServicer interface {
+	ImportGPX(ctx context.Context, tripID uuid.UUID, r io.Reader) (domain.StopImport, error)
+}
+
+// DataFixServicer defines the business operations the admin data-fix
+// handlers depend on.
+type DataFixServicer interface {
+	ShiftDates(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) (domain.DataFix, error)
+	ReassignStops(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) (domain.DataFix, error)
+	NormalizeLocations(ctx context.Context, tripID uuid.UUID, dryRun bool) (domain.DataFix, error)
 }
 
 // Server implements gen.StrictServerInterface for all API endpoints.
-// Wire it in main.go via gen.NewStrictHandler(server, nil).
+// Wire it in main.go via NewStrictHandler(server).
 // Methods are in domain-specific files but all operate on this struct.
 type Server struct {
-	trips  TripServicer
-	stops  StopServicer
-	tags   TagServicer
-	export ExportServicer
+	trips         TripServicer
+	stops         StopServicer
+	tags          TagServicer
+	export        ExportServicer
+	shares        ShareServicer
+	odometer      OdometerServicer
+	propane       PropaneServicer
+	power         PowerServicer
+	tanks         TankServicer
+	checklists    ChecklistServicer
+	packing       PackingServicer
+	reservations  ReservationServicer
+	expenses      ExpenseServicer
+	crossings     BorderCrossingServicer
+	pois          POIServicer
+	routes        RouteLegServicer
+	maps          MapServicer
+	locations     LocationServicer
+	places        PlaceServicer
+	cache         CacheServicer
+	pool          PoolServicer
+	dashboard     DashboardServicer
+	health        HealthServicer
+	trash         TrashServicer
+	orgs          OrganizationServicer
+	customFields  CustomFieldServicer
+	journal       JournalServicer
+	webhooks      WebhookServicer
+	auth          AuthServicer
+	apiKeys       APIKeyServicer
+	maintenance   MaintenanceServicer
+	members       MembershipServicer
+	rigs          RigServicer
+	rigLog        RigMaintenanceServicer
+	reports       ReportServicer
+	photos        PhotoServicer
+	noteTemplates NoteTemplateServicer
+	imports       ImportServicer
+	dataFixes     DataFixServicer
+	pagination    PaginationLimits
+
+	flights singleflight.Group // see coalesce
+}
+
+// ServerDeps holds the services a Server delegates to. Leave unused services
+// nil: a handler test sets only the one it exercises, and an endpoint whose
+// service is nil panics.
+type ServerDeps struct {
+	Trips         TripServicer
+	Stops         StopServicer
+	Tags          TagServicer
+	Export        ExportServicer
+	Shares        ShareServicer
+	Odometer      OdometerServicer
+	Propane       PropaneServicer
+	Power         PowerServicer
+	Tanks         TankServicer
+	Checklists    ChecklistServicer
+	Packing       PackingServicer
+	Reservations  ReservationServicer
+	Expenses      ExpenseServicer
+	Crossings     BorderCrossingServicer
+	POIs          POIServicer
+	Routes        RouteLegServicer
+	Maps          MapServicer
+	Locations     LocationServicer
+	Places        PlaceServicer
+	Cache         CacheServicer
+	Pool          PoolServicer
+	Dashboard     DashboardServicer
+	Health        HealthServicer
+	Trash         TrashServicer
+	Orgs          OrganizationServicer
+	CustomFields  CustomFieldServicer
+	Journal       JournalServicer
+	Webhooks      WebhookServicer
+	Auth          AuthServicer
+	APIKeys       APIKeyServicer
+	Maintenance   MaintenanceServicer
+	Members       MembershipServicer
+	Rigs          RigServicer
+	RigLog        RigMaintenanceServicer
+	Reports       ReportServicer
+	Photos        PhotoServicer
+	NoteTemplates NoteTemplateServicer
+	Imports       ImportServicer
+	DataFixes     DataFixServicer
+
+	// Pagination sets the page size limits. Nil uses
+	// domain.DefaultPaginationLimits for every class.
+	Pagination *PaginationLimits
 }
 
-// NewServer constructs the Server with all its dependencies.
-func NewServer(trips TripServicer, stops StopServicer, tags TagServicer, export ExportServicer) *Server {
-	return &Server{trips: trips, stops: stops, tags: tags, export: export}
+// NewServer constructs the Server from its dependencies.
+func NewServer(deps ServerDeps) *Server {
+	s := &Server{
+		trips:         deps.Trips,
+		stops:         deps.Stops,
+		tags:          deps.Tags,
+		export:        deps.Export,
+		shares:        deps.Shares,
+		odometer:      deps.Odometer,
+		propane:       deps.Propane,
+		power:         deps.Power,
+		tanks:         deps.Tanks,
+		checklists:    deps.Checklists,
+		packing:       deps.Packing,
+		reservations:  deps.Reservations,
+		expenses:      deps.Expenses,
+		crossings:     deps.Crossings,
+		pois:          deps.POIs,
+		routes:        deps.Routes,
+		maps:          deps.Maps,
+		locations:     deps.Locations,
+		places:        deps.Places,
+		cache:         deps.Cache,
+		pool:          deps.Pool,
+		dashboard:     deps.Dashboard,
+		health:        deps.Health,
+		trash:         deps.Trash,
+		orgs:          deps.Orgs,
+		customFields:  deps.CustomFields,
+		journal:       deps.Journal,
+		webhooks:      deps.Webhooks,
+		auth:          deps.Auth,
+		apiKeys:       deps.APIKeys,
+		maintenance:   deps.Maintenance,
+		members:       deps.Members,
+		rigs:          deps.Rigs,
+		rigLog:        deps.RigLog,
+		reports:       deps.Reports,
+		photos:        deps.Photos,
+		noteTemplates: deps.NoteTemplates,
+		imports:       deps.Imports,
+		dataFixes:     deps.DataFixes,
+	}
+	s.pagination = PaginationLimits{Default: domain.DefaultPaginationLimits, Readings: domain.DefaultPaginationLimits}
+	if deps.Pagination != nil {
+		s.pagination = *deps.Pagination
+	}
+	return s
 }
 
 // NewHealthHandler returns a Server for health-check-only use.
 // Keeps existing handler tests compiling without modification.
 func NewHealthHandler() *Server {
-	return NewServer(nil, nil, nil, nil)
+	return NewServer(ServerDeps{})
 }
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// CreateTripShare handles POST /trips/{id}/shares.
+// The body is optional; without expires_in_hours the link lives for
+// service.DefaultShareTTL.
+func (s *Server) CreateTripShare(ctx context.Context, req gen.CreateTripShareRequestObject) (gen.CreateTripShareResponseObject, error) {
+	ttl := service.DefaultShareTTL
+	if req.Body != nil && req.Body.ExpiresInHours != nil {
+		ttl = time.Duration(*req.Body.ExpiresInHours) * time.Hour
+	}
+
+	share, err := s.shares.Create(ctx, req.Id, ttl)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateTripShare404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateTripShare422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateTripShare201JSONResponse{
+		Id:        share.ID,
+		TripId:    share.TripID,
+		Token:     share.Token,
+		ExpiresAt: share.ExpiresAt,
+		CreatedAt: share.CreatedAt,
+	}, nil
+}
+
+// ListTripShares handles GET /trips/{id}/shares.
+func (s *Server) ListTripShares(ctx context.Context, req gen.ListTripSharesRequestObject) (gen.ListTripSharesResponseObject, error) {
+	shares, err := s.shares.List(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripShares404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	data := make([]gen.Share, len(shares))
+	for i, sh := range shares {
+		data[i] = shareToResponse(sh)
+	}
+	return gen.ListTripShares200JSONResponse{Data: data}, nil
+}
+
+// RevokeTripShare handles DELETE /trips/{id}/shares/{shareId}.
+func (s *Server) RevokeTripShare(ctx context.Context, req gen.RevokeTripShareRequestObject) (gen.RevokeTripShareResponseObject, error) {
+	if err := s.shares.Revoke(ctx, req.Id, req.ShareId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RevokeTripShare404JSONResponse(notFoundBody("share not found")), nil
+		}
+		return nil, err
+	}
+	return gen.RevokeTripShare204Response{}, nil
+}
+
+// GetSharedTrip handles GET /shared/{token}.
+func (s *Server) GetSharedTrip(ctx context.Context, req gen.GetSharedTripRequestObject) (gen.GetSharedTripResponseObject, error) {
+	modified, err := s.cache.LastModified(ctx, domain.ViewSharedTrip)
+	if err != nil {
+		return nil, err
+	}
+	// Resolve even when the client's copy is current: a link can expire
+	// without any row changing.
+	shared, err := coalesce(ctx, s, flightKey("shared_trip", modified, req.Token), func(ctx context.Context) (domain.SharedTrip, error) {
+		return s.shares.Resolve(ctx, req.Token)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetSharedTrip404JSONResponse(notFoundBody("share link is invalid or has expired")), nil
+		}
+		return nil, err
+	}
+	if notModified(req.Params.IfModifiedSince, modified) {
+		return gen.GetSharedTrip304Response{Headers: notModifiedHeaders(modified)}, nil
+	}
+
+	stops := make([]gen.Stop, len(shared.Stops))
+	for i, st := range shared.Stops {
+		stops[i] = stopToResponse(st)
+	}
+	return gen.GetSharedTrip200JSONResponse{
+		Body: gen.SharedTrip{
+			Trip:      tripToResponse(shared.Trip, units{}),
+			Stops:     stops,
+			ExpiresAt: shared.ExpiresAt,
+		},
+		Headers: gen.GetSharedTrip200ResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)},
+	}, nil
+}
+
+// shareToResponse converts a domain.Share into the generated gen.Share type.
+func shareToResponse(sh domain.Share) gen.Share {
+	return gen.Share{
+		Id:        sh.ID,
+		TripId:    sh.TripID,
+		ExpiresAt: sh.ExpiresAt,
+		CreatedAt: sh.CreatedAt,
+	}
+}
@@ -0,0 +1,304 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock ShareServicer ----------------------------------------------------
+
+type mockShareServicer struct {
+	create  func(ctx context.Context, tripID uuid.UUID, ttl time.Duration) (domain.Share, error)
+	list    func(ctx context.Context, tripID uuid.UUID) ([]domain.Share, error)
+	revoke  func(ctx context.Context, tripID, shareID uuid.UUID) error
+	resolve func(ctx context.Context, token string) (domain.SharedTrip, error)
+}
+
+func (m *mockShareServicer) Create(ctx context.Context, tripID uuid.UUID, ttl time.Duration) (domain.Share, error) {
+	return m.create(ctx, tripID, ttl)
+}
+func (m *mockShareServicer) List(ctx context.Context, tripID uuid.UUID) ([]domain.Share, error) {
+	return m.list(ctx, tripID)
+}
+func (m *mockShareServicer) Revoke(ctx context.Context, tripID, shareID uuid.UUID) error {
+	return m.revoke(ctx, tripID, shareID)
+}
+func (m *mockShareServicer) Resolve(ctx context.Context, token string) (domain.SharedTrip, error) {
+	return m.resolve(ctx, token)
+}
+
+// compile-time check: mockShareServicer must satisfy handler.ShareServicer.
+var _ handler.ShareServicer = (*mockShareServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newShareHTTPHandler wires a Server with only the share service mock.
+func newShareHTTPHandler(t *testing.T, svc handler.ShareServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Shares: svc, Cache: fixedCache(testModified)})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- POST /trips/{id}/shares -----------------------------------------------
+
+func TestCreateTripShare_DefaultTTL(t *testing.T) {
+	tripID := uuid.New()
+	var gotTTL time.Duration
+	svc := &mockShareServicer{
+		create: func(_ context.Context, id uuid.UUID, ttl time.Duration) (domain.Share, error) {
+			gotTTL = ttl
+			return domain.Share{ID: uuid.New(), TripID: id, Token: "tok", ExpiresAt: time.Now().Add(ttl)}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/shares", tripID), nil)
+	rec := httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, service.DefaultShareTTL, gotTTL)
+
+	var body gen.ShareLink
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "tok", body.Token)
+	assert.Equal(t, tripID, body.TripId)
+}
+
+func TestCreateTripShare_CustomTTL(t *testing.T) {
+	var gotTTL time.Duration
+	svc := &mockShareServicer{
+		create: func(_ context.Context, id uuid.UUID, ttl time.Duration) (domain.Share, error) {
+			gotTTL = ttl
+			return domain.Share{ID: uuid.New(), TripID: id}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/shares", uuid.New()),
+		strings.NewReader(`{"expires_in_hours":48}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, 48*time.Hour, gotTTL)
+}
+
+func TestCreateTripShare_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"trip not found", domain.ErrNotFound, http.StatusNotFound},
+		{"validation", fmt.Errorf("%w: expires_in_hours must be positive", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockShareServicer{
+				create: func(context.Context, uuid.UUID, time.Duration) (domain.Share, error) {
+					return domain.Share{}, tt.err
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/shares", uuid.New()), nil)
+			rec := httptest.NewRecorder()
+			newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+// ---- GET /trips/{id}/shares ------------------------------------------------
+
+// TestListTripShares_OmitsTokens verifies tokens are never re-exposed by the
+// list endpoint — they are only shown once, at creation.
+func TestListTripShares_OmitsTokens(t *testing.T) {
+	svc := &mockShareServicer{
+		list: func(_ context.Context, id uuid.UUID) ([]domain.Share, error) {
+			return []domain.Share{{ID: uuid.New(), TripID: id, Token: "should-not-leak"}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/shares", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "should-not-leak")
+
+	var body gen.ShareList
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Len(t, body.Data, 1)
+}
+
+func TestListTripShares_TripNotFound(t *testing.T) {
+	svc := &mockShareServicer{
+		list: func(context.Context, uuid.UUID) ([]domain.Share, error) { return nil, domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/shares", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- DELETE /trips/{id}/shares/{shareId} -----------------------------------
+
+func TestRevokeTripShare(t *testing.T) {
+	tripID, shareID := uuid.New(), uuid.New()
+	svc := &mockShareServicer{
+		revoke: func(_ context.Context, tID, sID uuid.UUID) error {
+			if tID != tripID || sID != shareID {
+				return domain.ErrNotFound
+			}
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trips/%s/shares/%s", tripID, shareID), nil)
+	rec := httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trips/%s/shares/%s", tripID, uuid.New()), nil)
+	rec = httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- GET /shared/{token} ---------------------------------------------------
+
+func TestGetSharedTrip(t *testing.T) {
+	trip := tripFixture()
+	svc := &mockShareServicer{
+		resolve: func(_ context.Context, token string) (domain.SharedTrip, error) {
+			if token != "good" {
+				return domain.SharedTrip{}, domain.ErrNotFound
+			}
+			return domain.SharedTrip{
+				Trip:      trip,
+				Stops:     []domain.Stop{{ID: uuid.New(), TripID: trip.ID, Name: "Moab", Tags: []domain.Tag{}}},
+				ExpiresAt: time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/good", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body gen.SharedTrip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, trip.ID, body.Trip.Id)
+	require.Len(t, body.Stops, 1)
+	assert.Equal(t, "Moab", body.Stops[0].Name)
+
+	rec = httptest.NewRecorder()
+	newShareHTTPHandler(t, svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/bad", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- GET /shared/{token}/embed ---------------------------------------------
+
+// newEmbedHTTPHandler wires a Server with the share and map service mocks.
+func newEmbedHTTPHandler(t *testing.T, shares handler.ShareServicer, maps handler.MapServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Shares: shares, Maps: maps, Cache: fixedCache(testModified)})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// embedShares resolves the token "good" to a trip with an arrived stop and a
+// planned one.
+func embedShares() *mockShareServicer {
+	lat, lon := 38.5733, -109.5498
+	return &mockShareServicer{
+		resolve: func(_ context.Context, token string) (domain.SharedTrip, error) {
+			if token != "good" {
+				return domain.SharedTrip{}, domain.ErrNotFound
+			}
+			return domain.SharedTrip{
+				Trip: domain.Trip{ID: uuid.New(), Name: "Canyon <Country>", StartDate: domain.NewDate(2025, 5, 1)},
+				Stops: []domain.Stop{
+					{ID: uuid.New(), Name: "Moab", Location: "Moab, UT", Latitude: &lat, Longitude: &lon,
+						ArrivedAt: time.Date(2025, 5, 2, 17, 0, 0, 0, time.UTC), Tags: []domain.Tag{{Name: "Arches"}}},
+					{ID: uuid.New(), Name: "Bryce", Planned: true, Tags: []domain.Tag{}},
+				},
+				ExpiresAt: time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+}
+
+func TestGetSharedTripEmbed_JSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newEmbedHTTPHandler(t, embedShares(), nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/good/embed", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body gen.SharedTripEmbed
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, "Canyon <Country>", body.Name)
+	assert.Nil(t, body.EndDate)
+	require.Len(t, body.Stops, 2)
+	assert.Equal(t, []string{"Arches"}, body.Stops[0].Tags)
+	require.NotNil(t, body.Stops[0].ArrivedAt)
+	assert.True(t, body.Stops[1].Planned)
+	assert.Nil(t, body.Stops[1].ArrivedAt)
+}
+
+func TestGetSharedTripEmbed_HTML(t *testing.T) {
+	var gotStops int
+	maps := &mockMapServicer{
+		stopsMapPNG: func(_ context.Context, stops []domain.Stop, _, _ int) ([]byte, error) {
+			gotStops = len(stops)
+			return []byte("png"), nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newEmbedHTTPHandler(t, embedShares(), maps).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/good/embed?format=html", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, 2, gotStops)
+	page := rec.Body.String()
+	assert.Contains(t, page, "<h1>Canyon &lt;Country&gt;</h1>", "names are escaped")
+	assert.Contains(t, page, "Since May 1, 2025")
+	assert.Contains(t, page, `src="data:image/png;base64,cG5n"`)
+	assert.Contains(t, page, "Moab")
+	assert.Contains(t, page, "May 2")
+	assert.Contains(t, page, "(planned)")
+}
+
+func TestGetSharedTripEmbed_HTMLWithoutMap(t *testing.T) {
+	maps := &mockMapServicer{
+		stopsMapPNG: func(_ context.Context, _ []domain.Stop, _, _ int) ([]byte, error) {
+			return nil, fmt.Errorf("%w: nothing to draw", domain.ErrValidation)
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	newEmbedHTTPHandler(t, embedShares(), maps).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/good/embed?format=html", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "<img")
+}
+
+func TestGetSharedTripEmbed_404(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newEmbedHTTPHandler(t, embedShares(), nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/bad/embed?format=html", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
@@ -13,12 +14,19 @@ import (
 // CreateStop handles POST /trips/{tripId}/stops.
 func (s *Server) CreateStop(ctx context.Context, req gen.CreateStopRequestObject) (gen.CreateStopResponseObject, error) {
 	stop := domain.Stop{
-		TripID:     req.TripId,
-		Name:       req.Body.Name,
-		Location:   derefString(req.Body.Location),
-		ArrivedAt:  req.Body.ArrivedAt,
-		DepartedAt: req.Body.DepartedAt,
-		Notes:      derefString(req.Body.Notes),
+		TripID:         req.TripId,
+		Name:           req.Body.Name,
+		Location:       derefString(req.Body.Location),
+		Latitude:       req.Body.Latitude,
+		Longitude:      req.Body.Longitude,
+		ArrivedAt:      derefTime(req.Body.ArrivedAt),
+		Planned:        req.Body.Planned != nil && *req.Body.Planned,
+		DepartedAt:     req.Body.DepartedAt,
+		Notes:          derefString(req.Body.Notes),
+		OdometerMiles:  req.Body.OdometerMiles,
+		Metadata:       metadataFromAPI(req.Body.Metadata),
+		NoteTemplateID: req.Body.NoteTemplateId,
+		NoteAnswers:    noteAnswersFromAPI(req.Body.NoteAnswers),
 	}
 
 	created, err := s.stops.Create(ctx, stop)
@@ -36,11 +44,15 @@ func (s *Server) CreateStop(ctx context.Context, req gen.CreateStopRequestObject
 }
 
 // ListStops handles GET /trips/{tripId}/stops.
-// Supports ?page= and ?limit= query parameters (defaults: page=1, limit=20, max=100).
+// Supports ?page= and ?limit= query parameters (defaults: page=1, limit and its cap from s.pagination.Default)
+// and repeated ?field=key:value custom field filters.
 func (s *Server) ListStops(ctx context.Context, req gen.ListStopsRequestObject) (gen.ListStopsResponseObject, error) {
-	params := domain.NewPaginationParams(req.Params.Page, req.Params.Limit)
-	stops, total, err := s.stops.ListByTripIDPaged(ctx, req.TripId, params)
+	params := s.pagination.Default.Params(req.Params.Page, req.Params.Limit)
+	stops, total, err := s.stops.ListByTripIDPaged(ctx, req.TripId, fieldFilters(req.Params.Field), params)
 	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListStops422JSONResponse(validationBody(err)), nil
+		}
 		return nil, err
 	}
 
@@ -49,12 +61,8 @@ func (s *Server) ListStops(ctx context.Context, req gen.ListStopsRequestObject)
 		data[i] = stopToResponse(st)
 	}
 	return gen.ListStops200JSONResponse{
-		Data: data,
-		Pagination: gen.Pagination{
-			Page:  params.Page,
-			Limit: params.Limit,
-			Total: int(total),
-		},
+		Data:       data,
+		Pagination: paginationMeta(params, s.pagination.Default, total),
 	}, nil
 }
 
@@ -74,13 +82,18 @@ func (s *Server) GetStop(ctx context.Context, req gen.GetStopRequestObject) (gen
 // UpdateStop handles PUT /trips/{tripId}/stops/{stopId}.
 func (s *Server) UpdateStop(ctx context.Context, req gen.UpdateStopRequestObject) (gen.UpdateStopResponseObject, error) {
 	stop := domain.Stop{
-		ID:         req.StopId,
-		TripID:     req.TripId,
-		Name:       req.Body.Name,
-		Location:   derefString(req.Body.Location),
-		ArrivedAt:  req.Body.ArrivedAt,
-		DepartedAt: req.Body.DepartedAt,
-		Notes:      derefString(req.Body.Notes),
+		ID:            req.StopId,
+		TripID:        req.TripId,
+		Name:          req.Body.Name,
+		Location:      derefString(req.Body.Location),
+		Latitude:      req.Body.Latitude,
+		Longitude:     req.Body.Longitude,
+		ArrivedAt:     derefTime(req.Body.ArrivedAt),
+		Planned:       req.Body.Planned != nil && *req.Body.Planned,
+		DepartedAt:    req.Body.DepartedAt,
+		Notes:         derefString(req.Body.Notes),
+		OdometerMiles: req.Body.OdometerMiles,
+		Metadata:      metadataFromAPI(req.Body.Metadata),
 	}
 
 	updated, err := s.stops.Update(ctx, stop)
@@ -97,6 +110,28 @@ func (s *Server) UpdateStop(ctx context.Context, req gen.UpdateStopRequestObject
 	return gen.UpdateStop200JSONResponse(stopToResponse(updated)), nil
 }
 
+// ArriveAtStop handles POST /trips/{tripId}/stops/{stopId}/arrive.
+// Without a body the stop is reached now.
+func (s *Server) ArriveAtStop(ctx context.Context, req gen.ArriveAtStopRequestObject) (gen.ArriveAtStopResponseObject, error) {
+	var at *time.Time
+	if req.Body != nil {
+		at = req.Body.ArrivedAt
+	}
+
+	stop, err := s.stops.Arrive(ctx, req.TripId, req.StopId, at)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ArriveAtStop404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ArriveAtStop422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.ArriveAtStop200JSONResponse(stopToResponse(stop)), nil
+}
+
 // DeleteStop handles DELETE /trips/{tripId}/stops/{stopId}.
 func (s *Server) DeleteStop(ctx context.Context, req gen.DeleteStopRequestObject) (gen.DeleteStopResponseObject, error) {
 	err := s.stops.Delete(ctx, req.TripId, req.StopId)
@@ -110,9 +145,210 @@ func (s *Server) DeleteStop(ctx context.Context, req gen.DeleteStopRequestObject
 	return gen.DeleteStop204Response{}, nil
 }
 
+// ListStopHistory handles GET /trips/{tripId}/stops/{stopId}/history.
+func (s *Server) ListStopHistory(ctx context.Context, req gen.ListStopHistoryRequestObject) (gen.ListStopHistoryResponseObject, error) {
+	revisions, err := s.stops.History(ctx, req.TripId, req.StopId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListStopHistory404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListStopHistory200JSONResponse, len(revisions))
+	for i, rev := range revisions {
+		resp[i] = stopRevisionToResponse(rev)
+	}
+	return resp, nil
+}
+
+// RevertStop handles POST /trips/{tripId}/stops/{stopId}/history/{revision}/revert.
+func (s *Server) RevertStop(ctx context.Context, req gen.RevertStopRequestObject) (gen.RevertStopResponseObject, error) {
+	stop, err := s.stops.Revert(ctx, req.TripId, req.StopId, req.Revision)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RevertStop404JSONResponse(notFoundBody("stop or revision not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.RevertStop422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.RevertStop200JSONResponse(stopToResponse(stop)), nil
+}
+
+// ListNearbyStops handles GET /stops/nearby. The longitude may be given as
+// lon or its alias lng.
+func (s *Server) ListNearbyStops(ctx context.Context, req gen.ListNearbyStopsRequestObject) (gen.ListNearbyStopsResponseObject, error) {
+	lon, lng := req.Params.Lon, req.Params.Lng
+	switch {
+	case lon == nil && lng == nil:
+		return gen.ListNearbyStops422JSONResponse(requestBody("lon (or lng) is required")), nil
+	case lon == nil:
+		lon = lng
+	case lng != nil && *lng != *lon:
+		return gen.ListNearbyStops422JSONResponse(requestBody("lon and lng must be the same when both are given")), nil
+	}
+
+	nearby, err := s.stops.Nearby(ctx, req.Params.Lat, *lon, req.Params.RadiusKm)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListNearbyStops422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListNearbyStops200JSONResponse, len(nearby))
+	for i, n := range nearby {
+		resp[i] = gen.NearbyStop{
+			Stop:       stopToResponse(n.Stop),
+			TripName:   n.TripName,
+			DistanceKm: roundTo(n.DistanceKm, 100),
+		}
+	}
+	return resp, nil
+}
+
+// ListStopClusters handles GET /stops/clusters.
+func (s *Server) ListStopClusters(ctx context.Context, req gen.ListStopClustersRequestObject) (gen.ListStopClustersResponseObject, error) {
+	b := req.Params.Bbox
+	if len(b) != 4 {
+		return gen.ListStopClusters422JSONResponse(requestBody("bbox must be west,south,east,north")), nil
+	}
+	box := domain.BoundingBox{West: b[0], South: b[1], East: b[2], North: b[3]}
+
+	clusters, err := s.stops.Clusters(ctx, box, req.Params.Zoom)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListStopClusters422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListStopClusters200JSONResponse, len(clusters))
+	for i, c := range clusters {
+		resp[i] = gen.StopCluster{
+			Latitude:  c.Latitude,
+			Longitude: c.Longitude,
+			Count:     c.Count,
+			Bounds: gen.BoundingBox{
+				West:  c.Bounds.West,
+				South: c.Bounds.South,
+				East:  c.Bounds.East,
+				North: c.Bounds.North,
+			},
+		}
+		if c.Stop != nil {
+			resp[i].Stop = &gen.ClusteredStop{
+				Id:     openapi_types.UUID(c.Stop.ID),
+				TripId: openapi_types.UUID(c.Stop.TripID),
+				Name:   c.Stop.Name,
+			}
+		}
+	}
+	return resp, nil
+}
+
+// GetStopHeatmap handles GET /stats/heatmap.
+func (s *Server) GetStopHeatmap(ctx context.Context, req gen.GetStopHeatmapRequestObject) (gen.GetStopHeatmapResponseObject, error) {
+	modified, err := s.cache.LastModified(ctx, domain.ViewHeatmap)
+	if err != nil {
+		return nil, err
+	}
+	heatmap, err := coalesce(ctx, s, flightKey("heatmap", modified, req.Params.Year, req.Params.Cell), func(ctx context.Context) (domain.Heatmap, error) {
+		return s.stops.Heatmap(ctx, req.Params.Year, req.Params.Cell)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetStopHeatmap422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	if notModified(req.Params.IfModifiedSince, modified) {
+		return gen.GetStopHeatmap304Response{Headers: notModifiedHeaders(modified)}, nil
+	}
+
+	points := make([][]float64, len(heatmap.Cells))
+	for i, c := range heatmap.Cells {
+		// Cell centers are multiples of the cell size; rounding drops the
+		// floating-point noise that leaves on them.
+		points[i] = []float64{roundTo(c.Latitude, 1e6), roundTo(c.Longitude, 1e6), float64(c.Nights)}
+	}
+	return gen.GetStopHeatmap200JSONResponse{
+		Body: gen.StopHeatmap{
+			CellDegrees: heatmap.CellDegrees,
+			MaxNights:   heatmap.MaxNights(),
+			Points:      points,
+		},
+		Headers: gen.GetStopHeatmap200ResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)},
+	}, nil
+}
+
+// GetStopOccupancy handles GET /stops/occupancy.
+func (s *Server) GetStopOccupancy(ctx context.Context, req gen.GetStopOccupancyRequestObject) (gen.GetStopOccupancyResponseObject, error) {
+	occ, err := s.stops.Occupancy(ctx, req.Params.Year)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.GetStopOccupancy422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	spans := make([]gen.NightSpan, len(occ.Spans))
+	for i, sp := range occ.Spans {
+		spans[i] = gen.NightSpan{
+			FirstNight: dateToAPI(sp.First),
+			LastNight:  dateToAPI(sp.Last),
+			Nights:     sp.Nights(),
+			Status:     gen.NightSpanStatus(sp.Status),
+			TripId:     sp.TripID,
+			StopId:     sp.StopID,
+			StopName:   nilIfEmpty(sp.StopName),
+		}
+	}
+	return gen.GetStopOccupancy200JSONResponse{
+		Year:          occ.Year,
+		StopNights:    occ.Count(domain.NightAtStop),
+		GapNights:     occ.Count(domain.NightGap),
+		OffTripNights: occ.Count(domain.NightOffTrip),
+		Spans:         spans,
+	}, nil
+}
+
+// ListTripGaps handles GET /trips/{id}/gaps.
+func (s *Server) ListTripGaps(ctx context.Context, req gen.ListTripGapsRequestObject) (gen.ListTripGapsResponseObject, error) {
+	gaps, err := s.stops.Gaps(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripGaps404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := gen.ListTripGaps200JSONResponse{TripId: req.Id, Gaps: make([]gen.TripGap, len(gaps))}
+	for i, g := range gaps {
+		gap := gen.TripGap{
+			FirstNight: dateToAPI(g.First),
+			LastNight:  dateToAPI(g.Last),
+			Nights:     g.Nights(),
+		}
+		if g.Previous != nil {
+			gap.PreviousStopId, gap.PreviousStopName = &g.Previous.ID, &g.Previous.Name
+		}
+		if g.Next != nil {
+			gap.NextStopId, gap.NextStopName = &g.Next.ID, &g.Next.Name
+		}
+		resp.Gaps[i] = gap
+		resp.GapNights += gap.Nights
+	}
+	return resp, nil
+}
+
 // stopToResponse converts a domain.Stop to the generated API response type.
 // Empty strings become nil pointers for optional JSON fields (location, notes)
 // so they are omitted from the response rather than sent as empty strings.
+// A planned stop's arrived_at is null.
 // Tags are always included — the repo guarantees a non-nil slice.
 func stopToResponse(s domain.Stop) gen.Stop {
 	tags := make([]gen.Tag, len(s.Tags))
@@ -120,17 +356,71 @@ func stopToResponse(s domain.Stop) gen.Stop {
 		tags[i] = tagToResponse(t)
 	}
 	return gen.Stop{
-		Id:         openapi_types.UUID(s.ID),
-		TripId:     openapi_types.UUID(s.TripID),
-		Name:       s.Name,
-		Location:   nilIfEmpty(s.Location),
-		ArrivedAt:  s.ArrivedAt,
-		DepartedAt: s.DepartedAt,
-		Notes:      nilIfEmpty(s.Notes),
-		CreatedAt:  s.CreatedAt,
-		UpdatedAt:  s.UpdatedAt,
-		Tags:       &tags,
+		Id:             openapi_types.UUID(s.ID),
+		TripId:         openapi_types.UUID(s.TripID),
+		Name:           s.Name,
+		Location:       nilIfEmpty(s.Location),
+		Latitude:       s.Latitude,
+		Longitude:      s.Longitude,
+		ArrivedAt:      arrivedAt(s.ArrivedAt, s.Planned),
+		Planned:        s.Planned,
+		DepartedAt:     s.DepartedAt,
+		Notes:          nilIfEmpty(s.Notes),
+		OdometerMiles:  s.OdometerMiles,
+		Metadata:       metadataToAPI(s.Metadata),
+		NoteTemplateId: s.NoteTemplateID,
+		NoteAnswers:    noteAnswersToAPI(s.NoteAnswers),
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+		Tags:           &tags,
+		Daylight:       daylightToResponse(s.Daylight),
+	}
+}
+
+// daylightToResponse converts a stop's Daylight, omitting it when empty.
+func daylightToResponse(days []domain.SunDay) *[]gen.SunDay {
+	if len(days) == 0 {
+		return nil
+	}
+	out := make([]gen.SunDay, len(days))
+	for i, d := range days {
+		out[i] = gen.SunDay{Date: dateToAPI(d.Date), Sunrise: d.Sunrise, Sunset: d.Sunset}
+	}
+	return &out
+}
+
+// stopRevisionToResponse converts a domain.StopRevision to the generated API type.
+func stopRevisionToResponse(r domain.StopRevision) gen.StopRevision {
+	return gen.StopRevision{
+		StopId:     openapi_types.UUID(r.StopID),
+		Revision:   r.Revision,
+		Name:       r.Name,
+		Location:   nilIfEmpty(r.Location),
+		Latitude:   r.Latitude,
+		Longitude:  r.Longitude,
+		ArrivedAt:  arrivedAt(r.ArrivedAt, r.Planned),
+		Planned:    r.Planned,
+		DepartedAt: r.DepartedAt,
+		Notes:      nilIfEmpty(r.Notes),
+		RecordedAt: r.RecordedAt,
+	}
+}
+
+// arrivedAt returns a stop's arrival for a response: nil while it is
+// planned.
+func arrivedAt(at time.Time, planned bool) *time.Time {
+	if planned {
+		return nil
+	}
+	return &at
+}
+
+// derefTime dereferences a *time.Time, returning the zero time when nil.
+func derefTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
 	}
+	return *t
 }
 
 // derefString safely dereferences a *string, returning "" when nil.
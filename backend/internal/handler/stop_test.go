@@ -16,6 +16,7 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
 // mockStopServicer is a test double for handler.StopServicer.
@@ -24,12 +25,20 @@ type mockStopServicer struct {
 	create            func(ctx context.Context, stop domain.Stop) (domain.Stop, error)
 	getByID           func(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error)
 	listByTripID      func(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error)
-	listByTripIDPaged func(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	listByTripIDPaged func(ctx context.Context, tripID uuid.UUID, fields []string, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	nearby            func(ctx context.Context, lat, lon float64, radiusKm *float64) ([]domain.NearbyStop, error)
+	clusters          func(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error)
+	heatmap           func(ctx context.Context, year *int, cellDegrees *float64) (domain.Heatmap, error)
+	occupancy         func(ctx context.Context, year *int) (domain.Occupancy, error)
+	gaps              func(ctx context.Context, tripID uuid.UUID) ([]domain.TripGap, error)
 	update            func(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	arrive            func(ctx context.Context, tripID, stopID uuid.UUID, at *time.Time) (domain.Stop, error)
 	delete            func(ctx context.Context, tripID, stopID uuid.UUID) error
 	addTag            func(ctx context.Context, stopID uuid.UUID, tagName string) (domain.Tag, error)
 	removeTagFrom     func(ctx context.Context, stopID uuid.UUID, slug string) error
 	listTagsByStop    func(ctx context.Context, stopID uuid.UUID) ([]domain.Tag, error)
+	history           func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error)
+	revert            func(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.Stop, error)
 }
 
 func (m *mockStopServicer) Create(ctx context.Context, s domain.Stop) (domain.Stop, error) {
@@ -41,32 +50,56 @@ func (m *mockStopServicer) GetByID(ctx context.Context, tripID, stopID uuid.UUID
 func (m *mockStopServicer) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
 	return m.listByTripID(ctx, tripID)
 }
-func (m *mockStopServicer) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error) {
-	return m.listByTripIDPaged(ctx, tripID, p)
+func (m *mockStopServicer) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, fields []string, p domain.PaginationParams) ([]domain.Stop, int64, error) {
+	return m.listByTripIDPaged(ctx, tripID, fields, p)
+}
+func (m *mockStopServicer) Nearby(ctx context.Context, lat, lon float64, radiusKm *float64) ([]domain.NearbyStop, error) {
+	return m.nearby(ctx, lat, lon, radiusKm)
+}
+func (m *mockStopServicer) Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+	return m.clusters(ctx, box, zoom)
+}
+func (m *mockStopServicer) Heatmap(ctx context.Context, year *int, cellDegrees *float64) (domain.Heatmap, error) {
+	return m.heatmap(ctx, year, cellDegrees)
+}
+func (m *mockStopServicer) Occupancy(ctx context.Context, year *int) (domain.Occupancy, error) {
+	return m.occupancy(ctx, year)
+}
+func (m *mockStopServicer) Gaps(ctx context.Context, tripID uuid.UUID) ([]domain.TripGap, error) {
+	return m.gaps(ctx, tripID)
 }
 func (m *mockStopServicer) Update(ctx context.Context, s domain.Stop) (domain.Stop, error) {
 	return m.update(ctx, s)
 }
+func (m *mockStopServicer) Arrive(ctx context.Context, tripID, stopID uuid.UUID, at *time.Time) (domain.Stop, error) {
+	return m.arrive(ctx, tripID, stopID, at)
+}
 func (m *mockStopServicer) Delete(ctx context.Context, tripID, stopID uuid.UUID) error {
 	return m.delete(ctx, tripID, stopID)
 }
-func (m *mockStopServicer) AddTag(ctx context.Context, stopID uuid.UUID, tagName string) (domain.Tag, error) {
+func (m *mockStopServicer) AddTag(ctx context.Context, _, stopID uuid.UUID, tagName string) (domain.Tag, error) {
 	return m.addTag(ctx, stopID, tagName)
 }
-func (m *mockStopServicer) RemoveTagFromStop(ctx context.Context, stopID uuid.UUID, slug string) error {
+func (m *mockStopServicer) RemoveTagFromStop(ctx context.Context, _, stopID uuid.UUID, slug string) error {
 	return m.removeTagFrom(ctx, stopID, slug)
 }
 func (m *mockStopServicer) ListTagsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Tag, error) {
 	return m.listTagsByStop(ctx, stopID)
 }
+func (m *mockStopServicer) History(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error) {
+	return m.history(ctx, tripID, stopID)
+}
+func (m *mockStopServicer) Revert(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.Stop, error) {
+	return m.revert(ctx, tripID, stopID, revision)
+}
 
 // compile-time check: mockStopServicer must satisfy handler.StopServicer.
 var _ handler.StopServicer = (*mockStopServicer)(nil)
 
 // newStopHTTPHandler wires a Server with the given stop mock (no trip service needed).
-func newStopHTTPHandler(svc handler.StopServicer) http.Handler {
-	srv := handler.NewServer(nil, svc, nil, nil)
-	return gen.Handler(gen.NewStrictHandler(srv, nil))
+func newStopHTTPHandler(t *testing.T, svc handler.StopServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Stops: svc, Cache: fixedCache(testModified)})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
 }
 
 func stopFixture(tripID uuid.UUID) domain.Stop {
@@ -101,11 +134,116 @@ func TestCreateStop_201(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusCreated, rec.Code)
 }
 
+func TestCreateStop_201_Coordinates(t *testing.T) {
+	tripID := uuid.New()
+	var got domain.Stop
+	svc := &mockStopServicer{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			got = s
+			s.ID = uuid.New()
+			return s, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":       "Madison Campground",
+		"arrived_at": time.Now().UTC().Format(time.RFC3339),
+		"latitude":   44.6455,
+		"longitude":  -110.8617,
+	})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops", tripID), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.True(t, got.HasCoordinates())
+	assert.InDelta(t, 44.6455, *got.Latitude, 1e-9)
+	assert.InDelta(t, -110.8617, *got.Longitude, 1e-9)
+
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Latitude)
+	require.NotNil(t, resp.Longitude)
+	assert.InDelta(t, 44.6455, *resp.Latitude, 1e-9)
+	assert.InDelta(t, -110.8617, *resp.Longitude, 1e-9)
+}
+
+func TestCreateStop_201_Odometer(t *testing.T) {
+	tripID := uuid.New()
+	var got domain.Stop
+	svc := &mockStopServicer{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			got = s
+			s.ID = uuid.New()
+			return s, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":           "Madison Campground",
+		"arrived_at":     time.Now().UTC().Format(time.RFC3339),
+		"odometer_miles": 42180.5,
+	})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops", tripID), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, got.OdometerMiles)
+	assert.InDelta(t, 42180.5, *got.OdometerMiles, 1e-9)
+
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.OdometerMiles)
+	assert.InDelta(t, 42180.5, *resp.OdometerMiles, 1e-9)
+}
+
+func TestCreateStop_201_NoteAnswers(t *testing.T) {
+	tripID, templateID := uuid.New(), uuid.New()
+	var got domain.Stop
+	svc := &mockStopServicer{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			got = s
+			s.ID = uuid.New()
+			s.Notes = "Site number: B14"
+			return s, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":             "Madison Campground",
+		"arrived_at":       time.Now().UTC().Format(time.RFC3339),
+		"note_template_id": templateID,
+		"note_answers":     map[string]string{"site_number": "B14"},
+	})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops", tripID), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, got.NoteTemplateID)
+	assert.Equal(t, templateID, *got.NoteTemplateID)
+	assert.Equal(t, domain.NoteAnswers{"site_number": "B14"}, got.NoteAnswers)
+
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.NoteTemplateId)
+	assert.Equal(t, templateID, *resp.NoteTemplateId)
+	require.NotNil(t, resp.NoteAnswers)
+	assert.Equal(t, "B14", (*resp.NoteAnswers)["site_number"])
+}
+
 func TestCreateStop_404_TripNotFound(t *testing.T) {
 	tripID := uuid.New()
 	svc := &mockStopServicer{
@@ -122,7 +260,7 @@ func TestCreateStop_404_TripNotFound(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -147,7 +285,7 @@ func TestCreateStop_422_Validation(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
 
@@ -156,13 +294,41 @@ func TestCreateStop_422_Validation(t *testing.T) {
 	assert.Equal(t, "validation_error", errResp.Error.Code)
 }
 
+func TestCreateStop_201_Planned(t *testing.T) {
+	tripID := uuid.New()
+	var got domain.Stop
+	svc := &mockStopServicer{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			got = s
+			s.ID = uuid.New()
+			return s, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"name": "Grand Teton", "planned": true})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops", tripID), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.True(t, got.Planned)
+	assert.True(t, got.ArrivedAt.IsZero())
+
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.True(t, resp.Planned)
+	assert.Nil(t, resp.ArrivedAt)
+}
+
 // ---- GET /trips/{tripId}/stops --------------------------------------------
 
 func TestListStops_200(t *testing.T) {
 	tripID := uuid.New()
 	stops := []domain.Stop{stopFixture(tripID), stopFixture(tripID)}
 	svc := &mockStopServicer{
-		listByTripIDPaged: func(_ context.Context, _ uuid.UUID, _ domain.PaginationParams) ([]domain.Stop, int64, error) {
+		listByTripIDPaged: func(_ context.Context, _ uuid.UUID, _ []string, _ domain.PaginationParams) ([]domain.Stop, int64, error) {
 			return stops, int64(len(stops)), nil
 		},
 	}
@@ -170,7 +336,7 @@ func TestListStops_200(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops", tripID), nil)
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -184,7 +350,7 @@ func TestListStops_200(t *testing.T) {
 func TestListStops_200_Empty(t *testing.T) {
 	tripID := uuid.New()
 	svc := &mockStopServicer{
-		listByTripIDPaged: func(_ context.Context, _ uuid.UUID, _ domain.PaginationParams) ([]domain.Stop, int64, error) {
+		listByTripIDPaged: func(_ context.Context, _ uuid.UUID, _ []string, _ domain.PaginationParams) ([]domain.Stop, int64, error) {
 			return []domain.Stop{}, 0, nil
 		},
 	}
@@ -192,7 +358,7 @@ func TestListStops_200_Empty(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops", tripID), nil)
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -202,6 +368,295 @@ func TestListStops_200_Empty(t *testing.T) {
 	assert.Equal(t, 0, resp.Pagination.Total)
 }
 
+// ---- GET /stops/nearby -----------------------------------------------------
+
+func TestListNearbyStops_200(t *testing.T) {
+	stop := stopFixture(uuid.New())
+	var gotRadius *float64
+	svc := &mockStopServicer{
+		nearby: func(_ context.Context, lat, lon float64, radiusKm *float64) ([]domain.NearbyStop, error) {
+			assert.Equal(t, 44.43, lat)
+			assert.Equal(t, -110.59, lon)
+			gotRadius = radiusKm
+			return []domain.NearbyStop{{Stop: stop, TripName: "Yellowstone", DistanceKm: 12.3456}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/nearby?lat=44.43&lon=-110.59&radius_km=40", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.NearbyStop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, stop.ID, uuid.UUID(resp[0].Stop.Id))
+	assert.Equal(t, "Yellowstone", resp[0].TripName)
+	assert.Equal(t, 12.35, resp[0].DistanceKm)
+	require.NotNil(t, gotRadius)
+	assert.Equal(t, 40.0, *gotRadius)
+}
+
+func TestListNearbyStops_422(t *testing.T) {
+	svc := &mockStopServicer{
+		nearby: func(_ context.Context, _, _ float64, _ *float64) ([]domain.NearbyStop, error) {
+			return nil, fmt.Errorf("%w: radius_km must be greater than 0 and at most 1000", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/nearby?lat=44.43&lon=-110.59&radius_km=5000", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestListNearbyStops_Longitude(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+	}{
+		{"lng alias", "lat=44.43&lng=-110.59", http.StatusOK},
+		{"lon and lng agree", "lat=44.43&lon=-110.59&lng=-110.59", http.StatusOK},
+		{"lon and lng disagree", "lat=44.43&lon=-110.59&lng=-110.6", http.StatusUnprocessableEntity},
+		{"no longitude", "lat=44.43", http.StatusUnprocessableEntity},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &mockStopServicer{
+				nearby: func(_ context.Context, _, lon float64, _ *float64) ([]domain.NearbyStop, error) {
+					assert.Equal(t, -110.59, lon)
+					return nil, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/stops/nearby?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+// ---- GET /stops/clusters ---------------------------------------------------
+
+func TestListStopClusters_200(t *testing.T) {
+	stopID, tripID := uuid.New(), uuid.New()
+	svc := &mockStopServicer{
+		clusters: func(_ context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+			assert.Equal(t, domain.BoundingBox{West: -112.5, South: 40.2, East: -104.1, North: 45.6}, box)
+			assert.Equal(t, 7, zoom)
+			return []domain.StopCluster{
+				{Latitude: 44.6, Longitude: -110.7, Count: 12, Bounds: domain.BoundingBox{West: -111, South: 44.1, East: -110.2, North: 45}},
+				{Latitude: 40.76, Longitude: -111.89, Count: 1, Bounds: domain.BoundingBox{West: -111.89, South: 40.76, East: -111.89, North: 40.76},
+					Stop: &domain.ClusteredStop{ID: stopID, TripID: tripID, Name: "Salt Lake City"}},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/clusters?bbox=-112.5,40.2,-104.1,45.6&zoom=7", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.StopCluster
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 2)
+	assert.Equal(t, 12, resp[0].Count)
+	assert.Nil(t, resp[0].Stop)
+	assert.Equal(t, 45.0, resp[0].Bounds.North)
+	require.NotNil(t, resp[1].Stop)
+	assert.Equal(t, stopID, uuid.UUID(resp[1].Stop.Id))
+	assert.Equal(t, tripID, uuid.UUID(resp[1].Stop.TripId))
+	assert.Equal(t, "Salt Lake City", resp[1].Stop.Name)
+}
+
+func TestListStopClusters_422_BBoxShape(t *testing.T) {
+	svc := &mockStopServicer{}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/clusters?bbox=-112.5,40.2,-104.1&zoom=7", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestListStopClusters_422_Validation(t *testing.T) {
+	svc := &mockStopServicer{
+		clusters: func(_ context.Context, _ domain.BoundingBox, _ int) ([]domain.StopCluster, error) {
+			return nil, fmt.Errorf("%w: zoom must be between 0 and 22", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/clusters?bbox=-112.5,40.2,-104.1,45.6&zoom=30", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- GET /stats/heatmap ----------------------------------------------------
+
+func TestGetStopHeatmap_200(t *testing.T) {
+	svc := &mockStopServicer{
+		heatmap: func(_ context.Context, year *int, cellDegrees *float64) (domain.Heatmap, error) {
+			require.NotNil(t, year)
+			assert.Equal(t, 2024, *year)
+			require.NotNil(t, cellDegrees)
+			assert.Equal(t, 0.1, *cellDegrees)
+			return domain.Heatmap{CellDegrees: 0.1, Cells: []domain.HeatmapCell{
+				{Latitude: 44.50000000000001, Longitude: -110.80000000000001, Nights: 9},
+				{Latitude: 40.8, Longitude: -111.9, Nights: 2},
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/heatmap?year=2024&cell=0.1", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.StopHeatmap
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 0.1, resp.CellDegrees)
+	assert.Equal(t, 9, resp.MaxNights)
+	assert.Equal(t, [][]float64{{44.5, -110.8, 9}, {40.8, -111.9, 2}}, resp.Points)
+}
+
+func TestGetStopHeatmap_200_Empty(t *testing.T) {
+	svc := &mockStopServicer{
+		heatmap: func(_ context.Context, _ *int, _ *float64) (domain.Heatmap, error) {
+			return domain.Heatmap{CellDegrees: 0.1, Cells: []domain.HeatmapCell{}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/heatmap", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"cell_degrees":0.1,"max_nights":0,"points":[]}`, rec.Body.String())
+}
+
+func TestGetStopHeatmap_422(t *testing.T) {
+	svc := &mockStopServicer{
+		heatmap: func(_ context.Context, _ *int, _ *float64) (domain.Heatmap, error) {
+			return domain.Heatmap{}, fmt.Errorf("%w: cell must be between 0.001 and 10 degrees", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/heatmap?cell=50", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestGetStopOccupancy_200(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	svc := &mockStopServicer{
+		occupancy: func(_ context.Context, year *int) (domain.Occupancy, error) {
+			require.NotNil(t, year)
+			assert.Equal(t, 2025, *year)
+			return domain.Occupancy{Year: 2025, Spans: []domain.NightSpan{
+				{First: domain.NewDate(2025, time.January, 1), Last: domain.NewDate(2025, time.June, 9), Status: domain.NightOffTrip},
+				{First: domain.NewDate(2025, time.June, 10), Last: domain.NewDate(2025, time.June, 12), Status: domain.NightAtStop,
+					TripID: &tripID, StopID: &stopID, StopName: "Madison Campground"},
+				{First: domain.NewDate(2025, time.June, 13), Last: domain.NewDate(2025, time.June, 13), Status: domain.NightGap, TripID: &tripID},
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/occupancy?year=2025", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `{
+		"year": 2025, "stop_nights": 3, "gap_nights": 1, "off_trip_nights": 160,
+		"spans": [
+			{"first_night": "2025-01-01", "last_night": "2025-06-09", "nights": 160, "status": "off_trip"},
+			{"first_night": "2025-06-10", "last_night": "2025-06-12", "nights": 3, "status": "stop",
+			 "trip_id": "`+tripID.String()+`", "stop_id": "`+stopID.String()+`", "stop_name": "Madison Campground"},
+			{"first_night": "2025-06-13", "last_night": "2025-06-13", "nights": 1, "status": "gap", "trip_id": "`+tripID.String()+`"}
+		]}`, rec.Body.String())
+}
+
+func TestGetStopOccupancy_422(t *testing.T) {
+	svc := &mockStopServicer{
+		occupancy: func(_ context.Context, _ *int) (domain.Occupancy, error) {
+			return domain.Occupancy{}, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stops/occupancy?year=0", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- GET /trips/{id}/gaps ---------------------------------------------------
+
+func TestListTripGaps_200(t *testing.T) {
+	tripID := uuid.New()
+	madison := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Madison"}
+	tetons := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Tetons"}
+	svc := &mockStopServicer{
+		gaps: func(_ context.Context, id uuid.UUID) ([]domain.TripGap, error) {
+			assert.Equal(t, tripID, id)
+			return []domain.TripGap{
+				{First: domain.NewDate(2025, time.June, 12), Last: domain.NewDate(2025, time.June, 14), Previous: &madison, Next: &tetons},
+				{First: domain.NewDate(2025, time.June, 18), Last: domain.NewDate(2025, time.June, 18), Previous: &tetons},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/gaps", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `{
+		"trip_id": "`+tripID.String()+`", "gap_nights": 4,
+		"gaps": [
+			{"first_night": "2025-06-12", "last_night": "2025-06-14", "nights": 3,
+			 "previous_stop_id": "`+madison.ID.String()+`", "previous_stop_name": "Madison",
+			 "next_stop_id": "`+tetons.ID.String()+`", "next_stop_name": "Tetons"},
+			{"first_night": "2025-06-18", "last_night": "2025-06-18", "nights": 1,
+			 "previous_stop_id": "`+tetons.ID.String()+`", "previous_stop_name": "Tetons"}
+		]}`, rec.Body.String())
+}
+
+func TestListTripGaps_404(t *testing.T) {
+	svc := &mockStopServicer{
+		gaps: func(_ context.Context, _ uuid.UUID) ([]domain.TripGap, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.New().String()+"/gaps", nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
 // ---- GET /trips/{tripId}/stops/{stopId} -----------------------------------
 
 func TestGetStop_200(t *testing.T) {
@@ -216,11 +671,41 @@ func TestGetStop_200(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops/%s", tripID, fixture.ID), nil)
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
 
+func TestGetStop_200_Daylight(t *testing.T) {
+	tripID := uuid.New()
+	fixture := stopFixture(tripID)
+	sunset := time.Date(2025, 6, 3, 2, 29, 0, 0, time.UTC)
+	fixture.Daylight = []domain.SunDay{
+		{Date: domain.NewDate(2025, 6, 2), Sunset: &sunset},
+	}
+	svc := &mockStopServicer{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) {
+			return fixture, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops/%s", tripID, fixture.ID), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Daylight)
+	require.Len(t, *resp.Daylight, 1)
+	day := (*resp.Daylight)[0]
+	assert.Equal(t, "2025-06-02", day.Date.String())
+	assert.Nil(t, day.Sunrise, "the sun did not rise")
+	require.NotNil(t, day.Sunset)
+	assert.True(t, sunset.Equal(*day.Sunset))
+}
+
 func TestGetStop_404(t *testing.T) {
 	tripID := uuid.New()
 	svc := &mockStopServicer{
@@ -232,7 +717,7 @@ func TestGetStop_404(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops/%s", tripID, uuid.New()), nil)
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -261,7 +746,7 @@ func TestUpdateStop_200(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
@@ -282,7 +767,7 @@ func TestUpdateStop_404(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -291,6 +776,91 @@ func TestUpdateStop_404(t *testing.T) {
 	assert.Equal(t, "not_found", errResp.Error.Code)
 }
 
+// ---- POST /trips/{tripId}/stops/{stopId}/arrive ---------------------------
+
+func TestArriveAtStop_200(t *testing.T) {
+	tripID := uuid.New()
+	fixture := stopFixture(tripID)
+	var gotAt *time.Time
+	svc := &mockStopServicer{
+		arrive: func(_ context.Context, _, _ uuid.UUID, at *time.Time) (domain.Stop, error) {
+			gotAt = at
+			return fixture, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"arrived_at": fixture.ArrivedAt.Format(time.RFC3339)})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops/%s/arrive", tripID, fixture.ID), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, gotAt)
+	assert.True(t, fixture.ArrivedAt.Equal(*gotAt))
+
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.False(t, resp.Planned)
+	require.NotNil(t, resp.ArrivedAt)
+}
+
+func TestArriveAtStop_200_NoBody(t *testing.T) {
+	tripID := uuid.New()
+	fixture := stopFixture(tripID)
+	called := false
+	svc := &mockStopServicer{
+		arrive: func(_ context.Context, _, _ uuid.UUID, at *time.Time) (domain.Stop, error) {
+			called = true
+			assert.Nil(t, at, "no body means arrive now")
+			return fixture, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops/%s/arrive", tripID, fixture.ID), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, called)
+}
+
+func TestArriveAtStop_404(t *testing.T) {
+	svc := &mockStopServicer{
+		arrive: func(_ context.Context, _, _ uuid.UUID, _ *time.Time) (domain.Stop, error) {
+			return domain.Stop{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops/%s/arrive", uuid.New(), uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestArriveAtStop_422_NotPlanned(t *testing.T) {
+	svc := &mockStopServicer{
+		arrive: func(_ context.Context, _, _ uuid.UUID, _ *time.Time) (domain.Stop, error) {
+			return domain.Stop{}, fmt.Errorf("%w: the stop is not planned", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops/%s/arrive", uuid.New(), uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	var errResp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+	assert.Equal(t, "validation_error", errResp.Error.Code)
+}
+
 // ---- DELETE /trips/{tripId}/stops/{stopId} --------------------------------
 
 func TestDeleteStop_204(t *testing.T) {
@@ -305,7 +875,7 @@ func TestDeleteStop_204(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trips/%s/stops/%s", tripID, stopID), nil)
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusNoContent, rec.Code)
 }
@@ -322,7 +892,7 @@ func TestDeleteStop_404(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/trips/%s/stops/%s", tripID, stopID), nil)
 	rec := httptest.NewRecorder()
 
-	newStopHTTPHandler(svc).ServeHTTP(rec, req)
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -330,3 +900,87 @@ func TestDeleteStop_404(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
 	assert.Equal(t, "not_found", errResp.Error.Code)
 }
+
+// ---- GET /trips/{tripId}/stops/{stopId}/history ----------------------------
+
+func TestListStopHistory_200(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	svc := &mockStopServicer{
+		history: func(_ context.Context, gotTrip, gotStop uuid.UUID) ([]domain.StopRevision, error) {
+			assert.Equal(t, tripID, gotTrip)
+			assert.Equal(t, stopID, gotStop)
+			return []domain.StopRevision{{
+				StopID:     stopID,
+				Revision:   1,
+				Name:       "Yellowstone Camp",
+				ArrivedAt:  time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
+				RecordedAt: time.Date(2025, 6, 2, 10, 5, 0, 0, time.UTC),
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops/%s/history", tripID, stopID), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.StopRevision
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, stopID, resp[0].StopId)
+	assert.Nil(t, resp[0].Location)
+}
+
+func TestListStopHistory_404(t *testing.T) {
+	svc := &mockStopServicer{
+		history: func(_ context.Context, _, _ uuid.UUID) ([]domain.StopRevision, error) { return nil, domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/stops/%s/history", uuid.New(), uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- POST /trips/{tripId}/stops/{stopId}/history/{revision}/revert ---------
+
+func TestRevertStop_200(t *testing.T) {
+	tripID := uuid.New()
+	stop := stopFixture(tripID)
+	svc := &mockStopServicer{
+		revert: func(_ context.Context, gotTrip, gotStop uuid.UUID, revision int) (domain.Stop, error) {
+			assert.Equal(t, tripID, gotTrip)
+			assert.Equal(t, stop.ID, gotStop)
+			assert.Equal(t, 2, revision)
+			return stop, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops/%s/history/2/revert", tripID, stop.ID), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.Stop
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, stop.ID, resp.Id)
+}
+
+func TestRevertStop_404(t *testing.T) {
+	svc := &mockStopServicer{
+		revert: func(_ context.Context, _, _ uuid.UUID, _ int) (domain.Stop, error) {
+			return domain.Stop{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/stops/%s/history/5/revert", uuid.New(), uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newStopHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -12,27 +12,33 @@ import (
 
 // ListTags handles GET /tags.
 // The optional ?q= query parameter filters tags by slug prefix.
-// Supports ?page= and ?limit= query parameters (defaults: page=1, limit=20, max=100).
+// Supports ?page= and ?limit= query parameters (defaults: page=1, limit and its cap from s.pagination.Default).
 func (s *Server) ListTags(ctx context.Context, req gen.ListTagsRequestObject) (gen.ListTagsResponseObject, error) {
 	prefix := derefString(req.Params.Q)
-	params := domain.NewPaginationParams(req.Params.Page, req.Params.Limit)
+	params := s.pagination.Default.Params(req.Params.Page, req.Params.Limit)
 
+	modified, err := s.cache.LastModified(ctx, domain.ViewTags)
+	if err != nil {
+		return nil, err
+	}
 	tags, total, err := s.tags.ListPaged(ctx, prefix, params)
 	if err != nil {
 		return nil, err
 	}
+	if notModified(req.Params.IfModifiedSince, modified) {
+		return gen.ListTags304Response{Headers: notModifiedHeaders(modified)}, nil
+	}
 
 	data := make([]gen.Tag, len(tags))
 	for i, t := range tags {
 		data[i] = tagToResponse(t)
 	}
 	return gen.ListTags200JSONResponse{
-		Data: data,
-		Pagination: gen.Pagination{
-			Page:  params.Page,
-			Limit: params.Limit,
-			Total: int(total),
+		Body: gen.TagList{
+			Data:       data,
+			Pagination: paginationMeta(params, s.pagination.Default, total),
 		},
+		Headers: gen.ListTags200ResponseHeaders{CacheControl: cacheControl, LastModified: httpDate(modified)},
 	}, nil
 }
 
@@ -55,7 +61,7 @@ func (s *Server) ListTagsByStop(ctx context.Context, req gen.ListTagsByStopReque
 
 // AddTagToStop handles POST /trips/{tripId}/stops/{stopId}/tags.
 func (s *Server) AddTagToStop(ctx context.Context, req gen.AddTagToStopRequestObject) (gen.AddTagToStopResponseObject, error) {
-	tag, err := s.stops.AddTag(ctx, req.StopId, req.Body.Name)
+	tag, err := s.stops.AddTag(ctx, req.TripId, req.StopId, req.Body.Name)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return gen.AddTagToStop404JSONResponse(notFoundBody("stop not found")), nil
@@ -71,7 +77,7 @@ func (s *Server) AddTagToStop(ctx context.Context, req gen.AddTagToStopRequestOb
 
 // RemoveTagFromStop handles DELETE /trips/{tripId}/stops/{stopId}/tags/{slug}.
 func (s *Server) RemoveTagFromStop(ctx context.Context, req gen.RemoveTagFromStopRequestObject) (gen.RemoveTagFromStopResponseObject, error) {
-	err := s.stops.RemoveTagFromStop(ctx, req.StopId, req.Slug)
+	err := s.stops.RemoveTagFromStop(ctx, req.TripId, req.StopId, req.Slug)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return gen.RemoveTagFromStop404JSONResponse(notFoundBody("tag not linked to stop")), nil
@@ -88,6 +94,7 @@ func tagToResponse(t domain.Tag) gen.Tag {
 		Id:        openapi_types.UUID(t.ID),
 		Name:      t.Name,
 		Slug:      t.Slug,
+		Parent:    nilIfEmpty(t.Parent),
 		CreatedAt: t.CreatedAt,
 	}
 }
@@ -122,6 +129,68 @@ func (s *Server) DeleteTag(ctx context.Context, req gen.DeleteTagRequestObject)
 	return gen.DeleteTag204Response{}, nil
 }
 
+// MergeTag handles POST /tags/{slug}/merge.
+func (s *Server) MergeTag(ctx context.Context, req gen.MergeTagRequestObject) (gen.MergeTagResponseObject, error) {
+	tag, err := s.tags.Merge(ctx, req.Slug, req.Body.Into)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.MergeTag404JSONResponse(notFoundBody("tag not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.MergeTag422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.MergeTag200JSONResponse(tagToResponse(tag)), nil
+}
+
+// SetTagParent handles PUT /tags/{slug}/parent.
+func (s *Server) SetTagParent(ctx context.Context, req gen.SetTagParentRequestObject) (gen.SetTagParentResponseObject, error) {
+	tag, err := s.tags.SetParent(ctx, req.Slug, req.Body.Parent)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.SetTagParent404JSONResponse(notFoundBody("tag not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.SetTagParent422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.SetTagParent200JSONResponse(tagToResponse(tag)), nil
+}
+
+// ClearTagParent handles DELETE /tags/{slug}/parent.
+func (s *Server) ClearTagParent(ctx context.Context, req gen.ClearTagParentRequestObject) (gen.ClearTagParentResponseObject, error) {
+	tag, err := s.tags.SetParent(ctx, req.Slug, "")
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ClearTagParent404JSONResponse(notFoundBody("tag not found")), nil
+		}
+		return nil, err
+	}
+	return gen.ClearTagParent200JSONResponse(tagToResponse(tag)), nil
+}
+
+// GetTagStats handles GET /stats/tags.
+func (s *Server) GetTagStats(ctx context.Context, _ gen.GetTagStatsRequestObject) (gen.GetTagStatsResponseObject, error) {
+	stats, err := s.tags.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.GetTagStats200JSONResponse, len(stats))
+	for i, st := range stats {
+		resp[i] = gen.TagStats{
+			Slug:       st.Slug,
+			Name:       st.Name,
+			Parent:     nilIfEmpty(st.Parent),
+			Stops:      st.Stops,
+			TotalStops: st.TotalStops,
+		}
+	}
+	return resp, nil
+}
+
 // CreateTag handles POST /tags.
 // Upserts a tag by name — normalises to a slug and returns the existing tag
 // if the slug already exists. Returns 201 in both cases.
@@ -17,6 +17,7 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
 // ---- mock TagServicer -------------------------------------------------------
@@ -27,6 +28,9 @@ type mockTagServicer struct {
 	updateName   func(ctx context.Context, slug, name string) (domain.Tag, error)
 	deleteTag    func(ctx context.Context, slug string) error
 	upsertByName func(ctx context.Context, name string) (domain.Tag, error)
+	merge        func(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error)
+	setParent    func(ctx context.Context, slug, parentSlug string) (domain.Tag, error)
+	stats        func(ctx context.Context) ([]domain.TagStats, error)
 }
 
 func (m *mockTagServicer) List(ctx context.Context, prefix string) ([]domain.Tag, error) {
@@ -54,6 +58,18 @@ func (m *mockTagServicer) UpsertByName(ctx context.Context, name string) (domain
 	return domain.Tag{}, nil
 }
 
+func (m *mockTagServicer) Merge(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error) {
+	return m.merge(ctx, fromSlug, intoSlug)
+}
+
+func (m *mockTagServicer) SetParent(ctx context.Context, slug, parentSlug string) (domain.Tag, error) {
+	return m.setParent(ctx, slug, parentSlug)
+}
+
+func (m *mockTagServicer) Stats(ctx context.Context) ([]domain.TagStats, error) {
+	return m.stats(ctx)
+}
+
 // compile-time check: mockTagServicer must satisfy handler.TagServicer.
 var _ handler.TagServicer = (*mockTagServicer)(nil)
 
@@ -61,9 +77,9 @@ var _ handler.TagServicer = (*mockTagServicer)(nil)
 
 // newTagHTTPHandler wires a Server with tag and stop service mocks.
 // Pass nil for mocks that the test does not use.
-func newTagHTTPHandler(tagSvc handler.TagServicer, stopSvc handler.StopServicer) http.Handler {
-	srv := handler.NewServer(nil, stopSvc, tagSvc, nil)
-	return gen.Handler(gen.NewStrictHandler(srv, nil))
+func newTagHTTPHandler(t *testing.T, tagSvc handler.TagServicer, stopSvc handler.StopServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Stops: stopSvc, Tags: tagSvc, Cache: fixedCache(testModified)})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
 }
 
 func tagFixture() domain.Tag {
@@ -88,7 +104,7 @@ func TestListTags_200(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -110,7 +126,7 @@ func TestListTags_200_WithPrefix(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/tags?q=cam", nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 	assert.Equal(t, "cam", capturedPrefix)
@@ -132,7 +148,7 @@ func TestListTagsByStop_200(t *testing.T) {
 	url := fmt.Sprintf("/trips/%s/stops/%s/tags", tripID, stopID)
 	req := httptest.NewRequest(http.MethodGet, url, nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(nil, svc).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, nil, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
@@ -156,7 +172,7 @@ func TestAddTagToStop_201(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, url, body)
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(nil, svc).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, nil, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusCreated, rec.Code)
 }
@@ -175,7 +191,7 @@ func TestAddTagToStop_422_ValidationError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, url, body)
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(nil, svc).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, nil, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
 
@@ -198,7 +214,7 @@ func TestAddTagToStop_404_StopNotFound(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, url, body)
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(nil, svc).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, nil, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -223,7 +239,7 @@ func TestRemoveTagFromStop_204(t *testing.T) {
 	url := fmt.Sprintf("/trips/%s/stops/%s/tags/camping", tripID, stopID)
 	req := httptest.NewRequest(http.MethodDelete, url, nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(nil, svc).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, nil, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusNoContent, rec.Code)
 }
@@ -240,7 +256,7 @@ func TestRemoveTagFromStop_404_NotLinked(t *testing.T) {
 	url := fmt.Sprintf("/trips/%s/stops/%s/tags/camping", tripID, stopID)
 	req := httptest.NewRequest(http.MethodDelete, url, nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(nil, svc).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, nil, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -267,7 +283,7 @@ func TestPatchTag_200(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPatch, "/tags/"+tag.Slug, strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
 	var resp gen.Tag
@@ -286,7 +302,7 @@ func TestPatchTag_404(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPatch, "/tags/no-such-slug", strings.NewReader(`{"name":"X"}`))
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 	var errResp gen.ErrorResponse
@@ -304,7 +320,7 @@ func TestPatchTag_422_EmptyName(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPatch, "/tags/some-slug", strings.NewReader(`{"name":""}`))
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
 	var errResp gen.ErrorResponse
@@ -319,7 +335,7 @@ func TestDeleteTag_204(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodDelete, "/tags/national-park", nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNoContent, rec.Code)
 }
@@ -333,7 +349,7 @@ func TestDeleteTag_404(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodDelete, "/tags/no-such-slug", nil)
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 	var errResp gen.ErrorResponse
@@ -341,6 +357,136 @@ func TestDeleteTag_404(t *testing.T) {
 	assert.Equal(t, "not_found", errResp.Error.Code)
 }
 
+// ---- POST /tags/{slug}/merge -----------------------------------------------
+
+func TestMergeTag_200(t *testing.T) {
+	into := tagFixture()
+	svc := &mockTagServicer{
+		merge: func(_ context.Context, fromSlug, intoSlug string) (domain.Tag, error) {
+			assert.Equal(t, "wal-mart", fromSlug)
+			assert.Equal(t, into.Slug, intoSlug)
+			return into, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/tags/wal-mart/merge", strings.NewReader(`{"into":"`+into.Slug+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.Tag
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, into.Slug, resp.Slug)
+}
+
+func TestMergeTag_404(t *testing.T) {
+	svc := &mockTagServicer{
+		merge: func(_ context.Context, _, _ string) (domain.Tag, error) {
+			return domain.Tag{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/tags/no-such-slug/merge", strings.NewReader(`{"into":"walmart"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- PUT/DELETE /tags/{slug}/parent ----------------------------------------
+
+func TestSetTagParent_200(t *testing.T) {
+	svc := &mockTagServicer{
+		setParent: func(_ context.Context, slug, parentSlug string) (domain.Tag, error) {
+			assert.Equal(t, "national-park", slug)
+			assert.Equal(t, "public-lands", parentSlug)
+			tag := tagFixture()
+			tag.Parent = parentSlug
+			return tag, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPut, "/tags/national-park/parent", strings.NewReader(`{"parent":"public-lands"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.Tag
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Parent)
+	assert.Equal(t, "public-lands", *resp.Parent)
+}
+
+func TestSetTagParent_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"either tag missing", domain.ErrNotFound, http.StatusNotFound},
+		{"cycle", fmt.Errorf("%w: a tag cannot sit under itself or a tag beneath it", domain.ErrValidation), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockTagServicer{
+				setParent: func(_ context.Context, _, _ string) (domain.Tag, error) {
+					return domain.Tag{}, tt.err
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/tags/public-lands/parent", strings.NewReader(`{"parent":"national-park"}`))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestClearTagParent_200(t *testing.T) {
+	svc := &mockTagServicer{
+		setParent: func(_ context.Context, slug, parentSlug string) (domain.Tag, error) {
+			assert.Equal(t, "national-park", slug)
+			assert.Empty(t, parentSlug)
+			return tagFixture(), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/tags/national-park/parent", nil)
+	rec := httptest.NewRecorder()
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), `"parent"`)
+}
+
+// ---- GET /stats/tags --------------------------------------------------------
+
+func TestGetTagStats_200(t *testing.T) {
+	svc := &mockTagServicer{
+		stats: func(_ context.Context) ([]domain.TagStats, error) {
+			return []domain.TagStats{
+				{Tag: domain.Tag{Name: "Public Lands", Slug: "public-lands"}, Stops: 1, TotalStops: 5},
+				{Tag: domain.Tag{Name: "National Park", Slug: "national-park", Parent: "public-lands"}, Stops: 4, TotalStops: 4},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/tags", nil)
+	rec := httptest.NewRecorder()
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `[
+		{"slug": "public-lands", "name": "Public Lands", "stops": 1, "total_stops": 5},
+		{"slug": "national-park", "name": "National Park", "parent": "public-lands", "stops": 4, "total_stops": 4}
+	]`, rec.Body.String())
+}
+
 // ---- CreateTag -------------------------------------------------------------
 
 func TestCreateTag_201(t *testing.T) {
@@ -353,7 +499,7 @@ func TestCreateTag_201(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tags", strings.NewReader(`{"name":"National Park"}`))
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var tag gen.Tag
@@ -371,7 +517,7 @@ func TestCreateTag_422_EmptyName(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tags", strings.NewReader(`{"name":""}`))
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
-	newTagHTTPHandler(svc, nil).ServeHTTP(rec, req)
+	newTagHTTPHandler(t, svc, nil).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
 	var errResp gen.ErrorResponse
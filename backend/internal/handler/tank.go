@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// GetCurrentTrip handles GET /trips/current.
+func (s *Server) GetCurrentTrip(ctx context.Context, _ gen.GetCurrentTripRequestObject) (gen.GetCurrentTripResponseObject, error) {
+	current, err := s.tanks.CurrentTrip(ctx)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetCurrentTrip404JSONResponse(notFoundBody("no trip in progress")), nil
+		}
+		return nil, err
+	}
+
+	resp := gen.GetCurrentTrip200JSONResponse{
+		Trip:              tripToResponse(current.Trip, units{}),
+		DaysSinceLastDump: current.DaysSinceLastDump,
+	}
+	if current.LastDump != nil {
+		last := dumpEventToResponse(*current.LastDump)
+		resp.LastDump = &last
+	}
+	return resp, nil
+}
+
+// ListTankLevels handles GET /trips/{tripId}/stops/{stopId}/tank-levels.
+func (s *Server) ListTankLevels(ctx context.Context, req gen.ListTankLevelsRequestObject) (gen.ListTankLevelsResponseObject, error) {
+	levels, err := s.tanks.ListLevels(ctx, req.TripId, req.StopId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTankLevels404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTankLevels200JSONResponse, len(levels))
+	for i, l := range levels {
+		resp[i] = tankLevelToResponse(l)
+	}
+	return resp, nil
+}
+
+// CreateTankLevel handles POST /trips/{tripId}/stops/{stopId}/tank-levels.
+func (s *Server) CreateTankLevel(ctx context.Context, req gen.CreateTankLevelRequestObject) (gen.CreateTankLevelResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateTankLevel422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.tanks.CreateLevel(ctx, req.TripId, domain.TankLevel{
+		StopID:     req.StopId,
+		RecordedAt: req.Body.RecordedAt,
+		FreshPct:   req.Body.FreshPct,
+		GreyPct:    req.Body.GreyPct,
+		BlackPct:   req.Body.BlackPct,
+		Notes:      derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateTankLevel404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateTankLevel422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateTankLevel201JSONResponse(tankLevelToResponse(created)), nil
+}
+
+// DeleteTankLevel handles DELETE /trips/{tripId}/stops/{stopId}/tank-levels/{levelId}.
+func (s *Server) DeleteTankLevel(ctx context.Context, req gen.DeleteTankLevelRequestObject) (gen.DeleteTankLevelResponseObject, error) {
+	if err := s.tanks.DeleteLevel(ctx, req.TripId, req.StopId, req.LevelId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteTankLevel404JSONResponse(notFoundBody("tank reading not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteTankLevel204Response{}, nil
+}
+
+// ListDumpEvents handles GET /trips/{tripId}/stops/{stopId}/dumps.
+func (s *Server) ListDumpEvents(ctx context.Context, req gen.ListDumpEventsRequestObject) (gen.ListDumpEventsResponseObject, error) {
+	dumps, err := s.tanks.ListDumps(ctx, req.TripId, req.StopId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListDumpEvents404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListDumpEvents200JSONResponse, len(dumps))
+	for i, d := range dumps {
+		resp[i] = dumpEventToResponse(d)
+	}
+	return resp, nil
+}
+
+// CreateDumpEvent handles POST /trips/{tripId}/stops/{stopId}/dumps.
+func (s *Server) CreateDumpEvent(ctx context.Context, req gen.CreateDumpEventRequestObject) (gen.CreateDumpEventResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateDumpEvent422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.tanks.CreateDump(ctx, req.TripId, domain.DumpEvent{
+		StopID:   req.StopId,
+		DumpedAt: req.Body.DumpedAt,
+		Location: derefString(req.Body.Location),
+		Notes:    derefString(req.Body.Notes),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CreateDumpEvent404JSONResponse(notFoundBody("stop not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateDumpEvent422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateDumpEvent201JSONResponse(dumpEventToResponse(created)), nil
+}
+
+// DeleteDumpEvent handles DELETE /trips/{tripId}/stops/{stopId}/dumps/{dumpId}.
+func (s *Server) DeleteDumpEvent(ctx context.Context, req gen.DeleteDumpEventRequestObject) (gen.DeleteDumpEventResponseObject, error) {
+	if err := s.tanks.DeleteDump(ctx, req.TripId, req.StopId, req.DumpId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteDumpEvent404JSONResponse(notFoundBody("dump event not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteDumpEvent204Response{}, nil
+}
+
+// tankLevelToResponse converts a domain.TankLevel into the generated type.
+func tankLevelToResponse(l domain.TankLevel) gen.TankLevel {
+	return gen.TankLevel{
+		Id:         l.ID,
+		StopId:     l.StopID,
+		RecordedAt: l.RecordedAt,
+		FreshPct:   l.FreshPct,
+		GreyPct:    l.GreyPct,
+		BlackPct:   l.BlackPct,
+		Notes:      nilIfEmpty(l.Notes),
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+// dumpEventToResponse converts a domain.DumpEvent into the generated type.
+func dumpEventToResponse(d domain.DumpEvent) gen.DumpEvent {
+	return gen.DumpEvent{
+		Id:        d.ID,
+		StopId:    d.StopID,
+		DumpedAt:  d.DumpedAt,
+		Location:  nilIfEmpty(d.Location),
+		Notes:     nilIfEmpty(d.Notes),
+		CreatedAt: d.CreatedAt,
+	}
+}
@@ -0,0 +1,255 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock TankServicer -----------------------------------------------------
+
+type mockTankServicer struct {
+	createLevel func(ctx context.Context, tripID uuid.UUID, l domain.TankLevel) (domain.TankLevel, error)
+	listLevels  func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.TankLevel, error)
+	deleteLevel func(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	createDump  func(ctx context.Context, tripID uuid.UUID, d domain.DumpEvent) (domain.DumpEvent, error)
+	listDumps   func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.DumpEvent, error)
+	deleteDump  func(ctx context.Context, tripID, stopID, id uuid.UUID) error
+	currentTrip func(ctx context.Context) (domain.CurrentTrip, error)
+}
+
+func (m *mockTankServicer) CreateLevel(ctx context.Context, tripID uuid.UUID, l domain.TankLevel) (domain.TankLevel, error) {
+	return m.createLevel(ctx, tripID, l)
+}
+func (m *mockTankServicer) ListLevels(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.TankLevel, error) {
+	return m.listLevels(ctx, tripID, stopID)
+}
+func (m *mockTankServicer) DeleteLevel(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	return m.deleteLevel(ctx, tripID, stopID, id)
+}
+func (m *mockTankServicer) CreateDump(ctx context.Context, tripID uuid.UUID, d domain.DumpEvent) (domain.DumpEvent, error) {
+	return m.createDump(ctx, tripID, d)
+}
+func (m *mockTankServicer) ListDumps(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.DumpEvent, error) {
+	return m.listDumps(ctx, tripID, stopID)
+}
+func (m *mockTankServicer) DeleteDump(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	return m.deleteDump(ctx, tripID, stopID, id)
+}
+func (m *mockTankServicer) CurrentTrip(ctx context.Context) (domain.CurrentTrip, error) {
+	return m.currentTrip(ctx)
+}
+
+// compile-time check: mockTankServicer must satisfy handler.TankServicer.
+var _ handler.TankServicer = (*mockTankServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newTankHTTPHandler wires a Server with only the tank service mock.
+func newTankHTTPHandler(t *testing.T, svc handler.TankServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Tanks: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func stopPath(tripID, stopID uuid.UUID, rest string) string {
+	return "/trips/" + tripID.String() + "/stops/" + stopID.String() + rest
+}
+
+// ---- GET /trips/current ----------------------------------------------------
+
+func TestGetCurrentTrip_200(t *testing.T) {
+	days := 3
+	dump := domain.DumpEvent{ID: uuid.New(), StopID: uuid.New(), DumpedAt: time.Now().UTC(), Location: "Love's", CreatedAt: time.Now().UTC()}
+	svc := &mockTankServicer{
+		currentTrip: func(_ context.Context) (domain.CurrentTrip, error) {
+			return domain.CurrentTrip{
+				Trip:              domain.Trip{ID: uuid.New(), Name: "Utah", StartDate: domain.NewDate(2025, 6, 7)},
+				LastDump:          &dump,
+				DaysSinceLastDump: &days,
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/current", nil)
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.CurrentTrip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "Utah", resp.Trip.Name)
+	require.NotNil(t, resp.DaysSinceLastDump)
+	assert.Equal(t, 3, *resp.DaysSinceLastDump)
+	require.NotNil(t, resp.LastDump)
+	assert.Equal(t, dump.ID, resp.LastDump.Id)
+}
+
+func TestGetCurrentTrip_404(t *testing.T) {
+	svc := &mockTankServicer{
+		currentTrip: func(_ context.Context) (domain.CurrentTrip, error) {
+			return domain.CurrentTrip{}, fmt.Errorf("wrapped: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/current", nil)
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- /tank-levels ----------------------------------------------------------
+
+func TestCreateTankLevel_201(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	var gotTrip uuid.UUID
+	var got domain.TankLevel
+	svc := &mockTankServicer{
+		createLevel: func(_ context.Context, tid uuid.UUID, l domain.TankLevel) (domain.TankLevel, error) {
+			gotTrip, got = tid, l
+			l.ID, l.CreatedAt = uuid.New(), time.Now().UTC()
+			return l, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"recorded_at": "2025-06-03T18:00:00Z", "fresh_pct": 66, "black_pct": 33})
+	req := httptest.NewRequest(http.MethodPost, stopPath(tripID, stopID, "/tank-levels"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, tripID, gotTrip)
+	assert.Equal(t, stopID, got.StopID)
+	require.NotNil(t, got.FreshPct)
+	assert.Equal(t, 66, *got.FreshPct)
+	assert.Nil(t, got.GreyPct)
+}
+
+func TestCreateTankLevel_422(t *testing.T) {
+	svc := &mockTankServicer{
+		createLevel: func(_ context.Context, _ uuid.UUID, _ domain.TankLevel) (domain.TankLevel, error) {
+			return domain.TankLevel{}, fmt.Errorf("%w: at least one of fresh_pct, grey_pct, or black_pct is required", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"recorded_at": "2025-06-03T18:00:00Z"})
+	req := httptest.NewRequest(http.MethodPost, stopPath(uuid.New(), uuid.New(), "/tank-levels"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestListTankLevels_404(t *testing.T) {
+	svc := &mockTankServicer{
+		listLevels: func(_ context.Context, _, _ uuid.UUID) ([]domain.TankLevel, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, stopPath(uuid.New(), uuid.New(), "/tank-levels"), nil)
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeleteTankLevel_204(t *testing.T) {
+	levelID := uuid.New()
+	var gotID uuid.UUID
+	svc := &mockTankServicer{
+		deleteLevel: func(_ context.Context, _, _, id uuid.UUID) error {
+			gotID = id
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, stopPath(uuid.New(), uuid.New(), "/tank-levels/"+levelID.String()), nil)
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, levelID, gotID)
+}
+
+// ---- /dumps ----------------------------------------------------------------
+
+func TestCreateDumpEvent_201(t *testing.T) {
+	var got domain.DumpEvent
+	svc := &mockTankServicer{
+		createDump: func(_ context.Context, _ uuid.UUID, d domain.DumpEvent) (domain.DumpEvent, error) {
+			got = d
+			d.ID, d.CreatedAt = uuid.New(), time.Now().UTC()
+			return d, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"dumped_at": "2025-06-05T10:15:00Z", "location": "Love's"})
+	req := httptest.NewRequest(http.MethodPost, stopPath(uuid.New(), uuid.New(), "/dumps"), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "Love's", got.Location)
+
+	var resp gen.DumpEvent
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Location)
+	assert.Nil(t, resp.Notes)
+}
+
+func TestListDumpEvents_200(t *testing.T) {
+	stopID := uuid.New()
+	svc := &mockTankServicer{
+		listDumps: func(_ context.Context, _, sid uuid.UUID) ([]domain.DumpEvent, error) {
+			return []domain.DumpEvent{{ID: uuid.New(), StopID: sid, DumpedAt: time.Now().UTC(), CreatedAt: time.Now().UTC()}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, stopPath(uuid.New(), stopID, "/dumps"), nil)
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.DumpEvent
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, stopID, resp[0].StopId)
+}
+
+func TestDeleteDumpEvent_404(t *testing.T) {
+	svc := &mockTankServicer{
+		deleteDump: func(_ context.Context, _, _, _ uuid.UUID) error { return domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, stopPath(uuid.New(), uuid.New(), "/dumps/"+uuid.NewString()), nil)
+	rec := httptest.NewRecorder()
+
+	newTankHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListTrash handles GET /trash.
+func (s *Server) ListTrash(ctx context.Context, _ gen.ListTrashRequestObject) (gen.ListTrashResponseObject, error) {
+	items, err := s.trash.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.ListTrash200JSONResponse, len(items))
+	for i, item := range items {
+		resp[i] = trashItemToResponse(item)
+	}
+	return resp, nil
+}
+
+// RestoreTrashItem handles POST /trash/{id}/restore.
+func (s *Server) RestoreTrashItem(ctx context.Context, req gen.RestoreTrashItemRequestObject) (gen.RestoreTrashItemResponseObject, error) {
+	item, err := s.trash.Restore(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RestoreTrashItem404JSONResponse(notFoundBody("no trip or stop with that id is in the trash")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.RestoreTrashItem422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.RestoreTrashItem200JSONResponse(trashItemToResponse(item)), nil
+}
+
+// trashItemToResponse converts a domain.TrashItem to the generated API type.
+func trashItemToResponse(item domain.TrashItem) gen.TrashItem {
+	return gen.TrashItem{
+		Id:        item.ID,
+		Kind:      gen.TrashItemKind(item.Kind),
+		Name:      item.Name,
+		TripId:    item.TripID,
+		TripName:  item.TripName,
+		DeletedAt: item.DeletedAt,
+		PurgeAt:   item.PurgeAt(),
+	}
+}
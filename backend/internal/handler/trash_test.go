@@ -0,0 +1,143 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock TrashServicer ----------------------------------------------------
+
+type mockTrashServicer struct {
+	list    func(ctx context.Context) ([]domain.TrashItem, error)
+	restore func(ctx context.Context, id uuid.UUID) (domain.TrashItem, error)
+}
+
+func (m *mockTrashServicer) List(ctx context.Context) ([]domain.TrashItem, error) {
+	return m.list(ctx)
+}
+
+func (m *mockTrashServicer) Restore(ctx context.Context, id uuid.UUID) (domain.TrashItem, error) {
+	return m.restore(ctx, id)
+}
+
+// compile-time check: mockTrashServicer must satisfy handler.TrashServicer.
+var _ handler.TrashServicer = (*mockTrashServicer)(nil)
+
+func newTrashHTTPHandler(t *testing.T, svc handler.TrashServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Trash: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+// ---- GET /trash ------------------------------------------------------------
+
+func TestListTrash_200(t *testing.T) {
+	tripID := uuid.New()
+	deleted := time.Date(2025, 8, 20, 18, 30, 0, 0, time.UTC)
+	svc := &mockTrashServicer{
+		list: func(_ context.Context) ([]domain.TrashItem, error) {
+			return []domain.TrashItem{
+				{ID: uuid.New(), Kind: domain.TrashStop, Name: "Moab KOA", TripID: tripID, TripName: "Canyons", DeletedAt: deleted},
+				{ID: tripID, Kind: domain.TrashTrip, Name: "Canyons", TripID: tripID, TripName: "Canyons", DeletedAt: deleted.Add(-time.Hour)},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trash", nil)
+	rec := httptest.NewRecorder()
+
+	newTrashHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.TrashItem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 2)
+	assert.Equal(t, gen.TrashItemKindStop, resp[0].Kind)
+	assert.Equal(t, "Moab KOA", resp[0].Name)
+	assert.Equal(t, "Canyons", resp[0].TripName)
+	assert.True(t, deleted.Add(30*24*time.Hour).Equal(resp[0].PurgeAt))
+	assert.Equal(t, gen.TrashItemKindTrip, resp[1].Kind)
+}
+
+func TestListTrash_200_Empty(t *testing.T) {
+	svc := &mockTrashServicer{
+		list: func(_ context.Context) ([]domain.TrashItem, error) { return []domain.TrashItem{}, nil },
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trash", nil)
+	rec := httptest.NewRecorder()
+
+	newTrashHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[]`, rec.Body.String())
+}
+
+// ---- POST /trash/{id}/restore ----------------------------------------------
+
+func TestRestoreTrashItem_200(t *testing.T) {
+	id := uuid.New()
+	svc := &mockTrashServicer{
+		restore: func(_ context.Context, got uuid.UUID) (domain.TrashItem, error) {
+			assert.Equal(t, id, got)
+			return domain.TrashItem{ID: id, Kind: domain.TrashTrip, Name: "Canyons", TripID: id, TripName: "Canyons", DeletedAt: time.Now()}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trash/%s/restore", id), nil)
+	rec := httptest.NewRecorder()
+
+	newTrashHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.TrashItem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, id, resp.Id)
+	assert.Equal(t, gen.TrashItemKindTrip, resp.Kind)
+}
+
+func TestRestoreTrashItem_404(t *testing.T) {
+	svc := &mockTrashServicer{
+		restore: func(_ context.Context, _ uuid.UUID) (domain.TrashItem, error) {
+			return domain.TrashItem{}, fmt.Errorf("service.TrashService.Restore: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trash/%s/restore", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newTrashHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRestoreTrashItem_422_TripInTrash(t *testing.T) {
+	svc := &mockTrashServicer{
+		restore: func(_ context.Context, _ uuid.UUID) (domain.TrashItem, error) {
+			return domain.TrashItem{}, fmt.Errorf("repo.TrashRepo.Restore: %w: the stop's trip is in the trash; restore the trip first", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trash/%s/restore", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newTrashHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "the stop's trip is in the trash; restore the trip first", resp.Error.Message)
+}
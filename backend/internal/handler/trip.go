@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
@@ -25,29 +26,30 @@ func (s *Server) CreateTrip(ctx context.Context, req gen.CreateTripRequestObject
 		return nil, err
 	}
 
-	return gen.CreateTrip201JSONResponse(tripToResponse(created)), nil
+	return gen.CreateTrip201JSONResponse(tripToResponse(created, unitsFor(req.Params.Units))), nil
 }
 
 // ListTrips handles GET /trips.
-// Supports ?page= and ?limit= query parameters (defaults: page=1, limit=20, max=100).
+// Supports ?page= and ?limit= query parameters (defaults: page=1, limit and its cap from s.pagination.Default)
+// and repeated ?field=key:value custom field filters.
 func (s *Server) ListTrips(ctx context.Context, req gen.ListTripsRequestObject) (gen.ListTripsResponseObject, error) {
-	params := domain.NewPaginationParams(req.Params.Page, req.Params.Limit)
-	trips, total, err := s.trips.ListPaged(ctx, params)
+	params := s.pagination.Default.Params(req.Params.Page, req.Params.Limit)
+	trips, total, err := s.trips.ListPaged(ctx, fieldFilters(req.Params.Field), params)
 	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.ListTrips422JSONResponse(validationBody(err)), nil
+		}
 		return nil, err
 	}
 
+	u := unitsFor(req.Params.Units)
 	data := make([]gen.Trip, len(trips))
 	for i, t := range trips {
-		data[i] = tripToResponse(t)
+		data[i] = tripToResponse(t, u)
 	}
 	return gen.ListTrips200JSONResponse{
-		Data: data,
-		Pagination: gen.Pagination{
-			Page:  params.Page,
-			Limit: params.Limit,
-			Total: int(total),
-		},
+		Data:       data,
+		Pagination: paginationMeta(params, s.pagination.Default, total),
 	}, nil
 }
 
@@ -61,7 +63,7 @@ func (s *Server) GetTrip(ctx context.Context, req gen.GetTripRequestObject) (gen
 		return nil, err
 	}
 
-	return gen.GetTrip200JSONResponse(tripToResponse(trip)), nil
+	return gen.GetTrip200JSONResponse(tripToResponse(trip, unitsFor(req.Params.Units))), nil
 }
 
 // UpdateTrip handles PUT /trips/{id}.
@@ -82,7 +84,7 @@ func (s *Server) UpdateTrip(ctx context.Context, req gen.UpdateTripRequestObject
 		return nil, err
 	}
 
-	return gen.UpdateTrip200JSONResponse(tripToResponse(updated)), nil
+	return gen.UpdateTrip200JSONResponse(tripToResponse(updated, unitsFor(req.Params.Units))), nil
 }
 
 // DeleteTrip handles DELETE /trips/{id}.
@@ -98,8 +100,87 @@ func (s *Server) DeleteTrip(ctx context.Context, req gen.DeleteTripRequestObject
 	return gen.DeleteTrip204Response{}, nil
 }
 
+// ListTripHistory handles GET /trips/{id}/history.
+func (s *Server) ListTripHistory(ctx context.Context, req gen.ListTripHistoryRequestObject) (gen.ListTripHistoryResponseObject, error) {
+	revisions, err := s.trips.History(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripHistory404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTripHistory200JSONResponse, len(revisions))
+	for i, rev := range revisions {
+		resp[i] = tripRevisionToResponse(rev)
+	}
+	return resp, nil
+}
+
+// RevertTrip handles POST /trips/{id}/history/{revision}/revert.
+func (s *Server) RevertTrip(ctx context.Context, req gen.RevertTripRequestObject) (gen.RevertTripResponseObject, error) {
+	trip, err := s.trips.Revert(ctx, req.Id, req.Revision)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RevertTrip404JSONResponse(notFoundBody("trip or revision not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.RevertTrip422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.RevertTrip200JSONResponse(tripToResponse(trip, unitsFor(req.Params.Units))), nil
+}
+
+// CloneTrip handles POST /trips/{id}/clone.
+func (s *Server) CloneTrip(ctx context.Context, req gen.CloneTripRequestObject) (gen.CloneTripResponseObject, error) {
+	if req.Body == nil {
+		return gen.CloneTrip422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	trip, err := s.trips.Clone(ctx, req.Id, requestToTripClone(req.Body))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.CloneTrip404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CloneTrip422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+
+	return gen.CloneTrip201JSONResponse(tripToResponse(trip, unitsFor(req.Params.Units))), nil
+}
+
 // --- mapping helpers --------------------------------------------------------
 
+// requestToTripClone converts a TripCloneRequest body into a domain.TripClone.
+// Leaving out include copies the stops and their tags.
+func requestToTripClone(body *gen.TripCloneRequest) domain.TripClone {
+	c := domain.TripClone{StartDate: dateFromAPIPtr(body.StartDate)}
+	if body.Name != nil {
+		c.Name = *body.Name
+	}
+	if body.Include == nil {
+		c.Parts = domain.TripCloneParts{Stops: true, Tags: true}
+		return c
+	}
+	for _, part := range *body.Include {
+		switch part {
+		case gen.Stops:
+			c.Parts.Stops = true
+		case gen.Tags:
+			c.Parts.Tags = true
+		case gen.Expenses:
+			c.Parts.Expenses = true
+		case gen.Checklists:
+			c.Parts.Checklists = true
+		}
+	}
+	return c
+}
+
 // requestToTrip converts a CreateTripRequest body into a domain.Trip.
 // Returns an error if required fields are missing.
 func requestToTrip(body *gen.CreateTripRequest) (domain.Trip, error) {
@@ -107,12 +188,12 @@ func requestToTrip(body *gen.CreateTripRequest) (domain.Trip, error) {
 		return domain.Trip{}, errors.New("request body is required")
 	}
 	t := domain.Trip{
-		Name:      body.Name,
-		StartDate: body.StartDate.Time,
-	}
-	if body.EndDate != nil {
-		ed := body.EndDate.Time
-		t.EndDate = &ed
+		Name:        body.Name,
+		StartDate:   dateFromAPI(body.StartDate),
+		EndDate:     dateFromAPIPtr(body.EndDate),
+		Metadata:    metadataFromAPI(body.Metadata),
+		RigID:       body.RigId,
+		BudgetCents: body.BudgetCents,
 	}
 	if body.Notes != nil {
 		t.Notes = *body.Notes
@@ -126,13 +207,13 @@ func requestToTripUpdate(id openapi_types.UUID, body *gen.UpdateTripRequest) (do
 		return domain.Trip{}, errors.New("request body is required")
 	}
 	t := domain.Trip{
-		ID:        id,
-		Name:      body.Name,
-		StartDate: body.StartDate.Time,
-	}
-	if body.EndDate != nil {
-		ed := body.EndDate.Time
-		t.EndDate = &ed
+		ID:          id,
+		Name:        body.Name,
+		StartDate:   dateFromAPI(body.StartDate),
+		EndDate:     dateFromAPIPtr(body.EndDate),
+		Metadata:    metadataFromAPI(body.Metadata),
+		RigID:       body.RigId,
+		BudgetCents: body.BudgetCents,
 	}
 	if body.Notes != nil {
 		t.Notes = *body.Notes
@@ -140,21 +221,66 @@ func requestToTripUpdate(id openapi_types.UUID, body *gen.UpdateTripRequest) (do
 	return t, nil
 }
 
-// tripToResponse converts a domain.Trip into the generated gen.Trip type.
-func tripToResponse(t domain.Trip) gen.Trip {
+// tripToResponse converts a domain.Trip into the generated gen.Trip type,
+// giving its distance traveled in u.
+func tripToResponse(t domain.Trip, u units) gen.Trip {
 	resp := gen.Trip{
-		Id:        t.ID,
-		Name:      t.Name,
-		StartDate: openapi_types.Date{Time: t.StartDate},
-		CreatedAt: t.CreatedAt,
-		UpdatedAt: t.UpdatedAt,
+		Id:               t.ID,
+		Name:             t.Name,
+		StartDate:        dateToAPI(t.StartDate),
+		EndDate:          dateToAPIPtr(t.EndDate),
+		Metadata:         metadataToAPI(t.Metadata),
+		RigId:            t.RigID,
+		BudgetCents:      t.BudgetCents,
+		DistanceTraveled: u.distancePtr(t.DistanceTraveled),
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        t.UpdatedAt,
 	}
 	if t.Notes != "" {
 		resp.Notes = &t.Notes
 	}
-	if t.EndDate != nil {
-		ed := openapi_types.Date{Time: *t.EndDate}
-		resp.EndDate = &ed
-	}
 	return resp
 }
+
+// tripRevisionToResponse converts a domain.TripRevision to the generated API type.
+func tripRevisionToResponse(r domain.TripRevision) gen.TripRevision {
+	return gen.TripRevision{
+		TripId:     r.TripID,
+		Revision:   r.Revision,
+		Name:       r.Name,
+		StartDate:  dateToAPI(r.StartDate),
+		EndDate:    dateToAPIPtr(r.EndDate),
+		Notes:      nilIfEmpty(r.Notes),
+		RecordedAt: r.RecordedAt,
+	}
+}
+
+// dateFromAPI converts a decoded API date. The generated type parses
+// "2006-01-02" as midnight UTC, so its UTC calendar day is the one sent.
+func dateFromAPI(d openapi_types.Date) domain.Date {
+	return domain.DateOf(d.Time.UTC())
+}
+
+// dateFromAPIPtr is dateFromAPI for an optional date.
+func dateFromAPIPtr(d *openapi_types.Date) *domain.Date {
+	if d == nil {
+		return nil
+	}
+	v := dateFromAPI(*d)
+	return &v
+}
+
+// dateToAPI converts a domain.Date for a response; the generated type
+// formats only the calendar day.
+func dateToAPI(d domain.Date) openapi_types.Date {
+	return openapi_types.Date{Time: d.In(time.UTC)}
+}
+
+// dateToAPIPtr is dateToAPI for an optional date.
+func dateToAPIPtr(d *domain.Date) *openapi_types.Date {
+	if d == nil {
+		return nil
+	}
+	v := dateToAPI(*d)
+	return &v
+}
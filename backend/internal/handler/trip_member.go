@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListTripMembers handles GET /trips/{tripId}/members.
+func (s *Server) ListTripMembers(ctx context.Context, req gen.ListTripMembersRequestObject) (gen.ListTripMembersResponseObject, error) {
+	members, err := s.members.List(ctx, req.TripId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListTripMembers404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListTripMembers200JSONResponse, len(members))
+	for i, m := range members {
+		resp[i] = tripMemberToResponse(m)
+	}
+	return resp, nil
+}
+
+// InviteTripMember handles POST /trips/{tripId}/members.
+func (s *Server) InviteTripMember(ctx context.Context, req gen.InviteTripMemberRequestObject) (gen.InviteTripMemberResponseObject, error) {
+	if req.Body == nil {
+		return gen.InviteTripMember422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	m, err := s.members.Invite(ctx, req.TripId, req.Body.Username, domain.TripRole(req.Body.Role))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.InviteTripMember404JSONResponse(notFoundBody("trip not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.InviteTripMember422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.InviteTripMember201JSONResponse(tripMemberToResponse(m)), nil
+}
+
+// PatchTripMember handles PATCH /trips/{tripId}/members/{userId}.
+func (s *Server) PatchTripMember(ctx context.Context, req gen.PatchTripMemberRequestObject) (gen.PatchTripMemberResponseObject, error) {
+	if req.Body == nil {
+		return gen.PatchTripMember422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	m, err := s.members.UpdateRole(ctx, req.TripId, req.UserId, domain.TripRole(req.Body.Role))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.PatchTripMember404JSONResponse(notFoundBody("member not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.PatchTripMember422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.PatchTripMember200JSONResponse(tripMemberToResponse(m)), nil
+}
+
+// RemoveTripMember handles DELETE /trips/{tripId}/members/{userId}.
+func (s *Server) RemoveTripMember(ctx context.Context, req gen.RemoveTripMemberRequestObject) (gen.RemoveTripMemberResponseObject, error) {
+	if err := s.members.Remove(ctx, req.TripId, req.UserId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RemoveTripMember404JSONResponse(notFoundBody("member not found")), nil
+		}
+		return nil, err
+	}
+	return gen.RemoveTripMember204Response{}, nil
+}
+
+// tripMemberToResponse converts a domain.TripMember to the API response shape.
+func tripMemberToResponse(m domain.TripMember) gen.TripMember {
+	return gen.TripMember{
+		TripId:    m.TripID,
+		UserId:    m.UserID,
+		Username:  m.Username,
+		Role:      gen.TripMemberRole(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
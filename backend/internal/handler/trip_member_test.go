@@ -0,0 +1,204 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock MembershipServicer -----------------------------------------------
+
+type mockMembershipServicer struct {
+	list       func(ctx context.Context, tripID uuid.UUID) ([]domain.TripMember, error)
+	invite     func(ctx context.Context, tripID uuid.UUID, username string, role domain.TripRole) (domain.TripMember, error)
+	updateRole func(ctx context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error)
+	remove     func(ctx context.Context, tripID, userID uuid.UUID) error
+}
+
+func (m *mockMembershipServicer) List(ctx context.Context, tripID uuid.UUID) ([]domain.TripMember, error) {
+	return m.list(ctx, tripID)
+}
+func (m *mockMembershipServicer) Invite(ctx context.Context, tripID uuid.UUID, username string, role domain.TripRole) (domain.TripMember, error) {
+	return m.invite(ctx, tripID, username, role)
+}
+func (m *mockMembershipServicer) UpdateRole(ctx context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error) {
+	return m.updateRole(ctx, tripID, userID, role)
+}
+func (m *mockMembershipServicer) Remove(ctx context.Context, tripID, userID uuid.UUID) error {
+	return m.remove(ctx, tripID, userID)
+}
+
+// compile-time check: mockMembershipServicer must satisfy handler.MembershipServicer.
+var _ handler.MembershipServicer = (*mockMembershipServicer)(nil)
+
+// ---- helpers ---------------------------------------------------------------
+
+// newTripMemberHTTPHandler wires a Server with only the membership service mock.
+func newTripMemberHTTPHandler(t *testing.T, svc handler.MembershipServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Members: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func tripMemberFixture(tripID uuid.UUID, role domain.TripRole) domain.TripMember {
+	return domain.TripMember{
+		TripID:    tripID,
+		UserID:    uuid.New(),
+		Username:  "bob",
+		Role:      role,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// ---- GET /trips/{tripId}/members -------------------------------------------
+
+func TestListTripMembers_200(t *testing.T) {
+	tripID := uuid.New()
+	svc := &mockMembershipServicer{
+		list: func(_ context.Context, id uuid.UUID) ([]domain.TripMember, error) {
+			return []domain.TripMember{tripMemberFixture(id, domain.TripRoleViewer)}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+tripID.String()+"/members", nil)
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []gen.TripMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, tripID, resp[0].TripId)
+	assert.Equal(t, "bob", resp[0].Username)
+	assert.Equal(t, gen.Viewer, resp[0].Role)
+}
+
+func TestListTripMembers_404(t *testing.T) {
+	svc := &mockMembershipServicer{
+		list: func(_ context.Context, _ uuid.UUID) ([]domain.TripMember, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.NewString()+"/members", nil)
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- POST /trips/{tripId}/members ------------------------------------------
+
+func TestInviteTripMember_201(t *testing.T) {
+	tripID := uuid.New()
+	var gotUsername string
+	var gotRole domain.TripRole
+	svc := &mockMembershipServicer{
+		invite: func(_ context.Context, id uuid.UUID, username string, role domain.TripRole) (domain.TripMember, error) {
+			gotUsername, gotRole = username, role
+			return tripMemberFixture(id, role), nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"username": "bob", "role": "editor"})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+tripID.String()+"/members", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "bob", gotUsername)
+	assert.Equal(t, domain.TripRoleEditor, gotRole)
+	var resp gen.TripMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, gen.Editor, resp.Role)
+}
+
+func TestInviteTripMember_422(t *testing.T) {
+	svc := &mockMembershipServicer{
+		invite: func(_ context.Context, _ uuid.UUID, username string, _ domain.TripRole) (domain.TripMember, error) {
+			return domain.TripMember{}, fmt.Errorf("%w: no user named %q", domain.ErrValidation, username)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"username": "carol", "role": "viewer"})
+	req := httptest.NewRequest(http.MethodPost, "/trips/"+uuid.NewString()+"/members", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, `no user named "carol"`, resp.Error.Message)
+}
+
+// ---- PATCH /trips/{tripId}/members/{userId} --------------------------------
+
+func TestPatchTripMember_200(t *testing.T) {
+	tripID, userID := uuid.New(), uuid.New()
+	svc := &mockMembershipServicer{
+		updateRole: func(_ context.Context, tID, uID uuid.UUID, role domain.TripRole) (domain.TripMember, error) {
+			m := tripMemberFixture(tID, role)
+			m.UserID = uID
+			return m, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"role": "editor"})
+	req := httptest.NewRequest(http.MethodPatch, "/trips/"+tripID.String()+"/members/"+userID.String(), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp gen.TripMember
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, userID, resp.UserId)
+	assert.Equal(t, gen.Editor, resp.Role)
+}
+
+// ---- DELETE /trips/{tripId}/members/{userId} -------------------------------
+
+func TestRemoveTripMember_204(t *testing.T) {
+	svc := &mockMembershipServicer{
+		remove: func(_ context.Context, _, _ uuid.UUID) error { return nil },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.NewString()+"/members/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestRemoveTripMember_404(t *testing.T) {
+	svc := &mockMembershipServicer{
+		remove: func(_ context.Context, _, _ uuid.UUID) error { return domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.NewString()+"/members/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+
+	newTripMemberHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
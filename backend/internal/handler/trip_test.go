@@ -17,6 +17,7 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/handler"
 	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
 // mockTripServicer is a test double for handler.TripServicer.
@@ -25,9 +26,12 @@ type mockTripServicer struct {
 	create    func(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 	getByID   func(ctx context.Context, id uuid.UUID) (domain.Trip, error)
 	list      func(ctx context.Context) ([]domain.Trip, error)
-	listPaged func(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error)
+	listPaged func(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error)
 	update    func(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 	delete    func(ctx context.Context, id uuid.UUID) error
+	history   func(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error)
+	revert    func(ctx context.Context, id uuid.UUID, revision int) (domain.Trip, error)
+	clone     func(ctx context.Context, sourceID uuid.UUID, c domain.TripClone) (domain.Trip, error)
 }
 
 func (m *mockTripServicer) Create(ctx context.Context, t domain.Trip) (domain.Trip, error) {
@@ -39,8 +43,8 @@ func (m *mockTripServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.Tr
 func (m *mockTripServicer) List(ctx context.Context) ([]domain.Trip, error) {
 	return m.list(ctx)
 }
-func (m *mockTripServicer) ListPaged(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error) {
-	return m.listPaged(ctx, p)
+func (m *mockTripServicer) ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+	return m.listPaged(ctx, fields, p)
 }
 func (m *mockTripServicer) Update(ctx context.Context, t domain.Trip) (domain.Trip, error) {
 	return m.update(ctx, t)
@@ -48,6 +52,16 @@ func (m *mockTripServicer) Update(ctx context.Context, t domain.Trip) (domain.Tr
 func (m *mockTripServicer) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.delete(ctx, id)
 }
+func (m *mockTripServicer) History(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error) {
+	return m.history(ctx, id)
+}
+func (m *mockTripServicer) Revert(ctx context.Context, id uuid.UUID, revision int) (domain.Trip, error) {
+	return m.revert(ctx, id, revision)
+}
+
+func (m *mockTripServicer) Clone(ctx context.Context, sourceID uuid.UUID, c domain.TripClone) (domain.Trip, error) {
+	return m.clone(ctx, sourceID, c)
+}
 
 // compile-time check: mockTripServicer must satisfy handler.TripServicer.
 var _ handler.TripServicer = (*mockTripServicer)(nil)
@@ -56,18 +70,17 @@ var _ handler.TripServicer = (*mockTripServicer)(nil)
 
 // newHTTPHandler wires a Server with the given mock into the generated chi router.
 // This mirrors exactly how main.go wires it in production.
-func newHTTPHandler(svc handler.TripServicer) http.Handler {
-	srv := handler.NewServer(svc, nil, nil, nil)
-	return gen.Handler(gen.NewStrictHandler(srv, nil))
+func newHTTPHandler(t *testing.T, svc handler.TripServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Trips: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
 }
 
 func tripFixture() domain.Trip {
-	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+	end := domain.NewDate(2025, 6, 15)
 	return domain.Trip{
 		ID:        uuid.New(),
 		Name:      "Summer Tour",
-		StartDate: start,
+		StartDate: domain.NewDate(2025, 6, 1),
 		EndDate:   &end,
 		Notes:     "test notes",
 		CreatedAt: time.Now().UTC(),
@@ -82,10 +95,6 @@ func jsonBody(t *testing.T, v any) *bytes.Buffer {
 	return bytes.NewBuffer(b)
 }
 
-func dateStr(t time.Time) string {
-	return t.Format("2006-01-02")
-}
-
 // ---- POST /trips -----------------------------------------------------------
 
 func TestCreateTrip_201(t *testing.T) {
@@ -98,15 +107,15 @@ func TestCreateTrip_201(t *testing.T) {
 
 	body := jsonBody(t, map[string]any{
 		"name":       "Summer Tour",
-		"start_date": dateStr(fixture.StartDate),
-		"end_date":   dateStr(*fixture.EndDate),
+		"start_date": fixture.StartDate.String(),
+		"end_date":   fixture.EndDate.String(),
 	})
 
 	req := httptest.NewRequest(http.MethodPost, "/trips", body)
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusCreated, rec.Code)
 
@@ -116,6 +125,98 @@ func TestCreateTrip_201(t *testing.T) {
 	assert.Equal(t, fixture.ID, resp.Id)
 }
 
+func TestCreateTrip_201_DatesAreCalendarDays(t *testing.T) {
+	var got domain.Trip
+	svc := &mockTripServicer{
+		create: func(_ context.Context, trip domain.Trip) (domain.Trip, error) {
+			got = trip
+			return trip, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":       "Summer Tour",
+		"start_date": "2025-06-30",
+		"end_date":   "2025-07-04",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/trips", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, domain.NewDate(2025, 6, 30), got.StartDate)
+	require.NotNil(t, got.EndDate)
+	assert.Equal(t, domain.NewDate(2025, 7, 4), *got.EndDate)
+	var resp map[string]any
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "2025-06-30", resp["start_date"])
+	assert.Equal(t, "2025-07-04", resp["end_date"])
+}
+
+func TestCreateTrip_201_RigID(t *testing.T) {
+	rigID := uuid.New()
+	var got domain.Trip
+	svc := &mockTripServicer{
+		create: func(_ context.Context, trip domain.Trip) (domain.Trip, error) {
+			got = trip
+			return trip, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":       "Summer Tour",
+		"start_date": "2025-06-30",
+		"rig_id":     rigID.String(),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/trips", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, got.RigID)
+	assert.Equal(t, rigID, *got.RigID)
+	var resp gen.Trip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.RigId)
+	assert.Equal(t, rigID, *resp.RigId)
+}
+
+func TestCreateTrip_201_Budget(t *testing.T) {
+	var got domain.Trip
+	svc := &mockTripServicer{
+		create: func(_ context.Context, trip domain.Trip) (domain.Trip, error) {
+			got = trip
+			return trip, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":         "Summer Tour",
+		"start_date":   "2025-06-30",
+		"budget_cents": 250000,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/trips", body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, got.BudgetCents)
+	assert.Equal(t, int64(250000), *got.BudgetCents)
+	var resp gen.Trip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.BudgetCents)
+	assert.Equal(t, int64(250000), *resp.BudgetCents)
+}
+
 func TestCreateTrip_422_ValidationError(t *testing.T) {
 	svc := &mockTripServicer{
 		create: func(_ context.Context, _ domain.Trip) (domain.Trip, error) {
@@ -132,7 +233,7 @@ func TestCreateTrip_422_ValidationError(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
 
@@ -146,7 +247,7 @@ func TestCreateTrip_422_ValidationError(t *testing.T) {
 func TestListTrips_200(t *testing.T) {
 	trips := []domain.Trip{tripFixture(), tripFixture()}
 	svc := &mockTripServicer{
-		listPaged: func(_ context.Context, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
+		listPaged: func(_ context.Context, _ []string, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
 			return trips, int64(len(trips)), nil
 		},
 	}
@@ -154,7 +255,7 @@ func TestListTrips_200(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -165,11 +266,13 @@ func TestListTrips_200(t *testing.T) {
 	assert.Equal(t, 2, resp.Pagination.Total)
 	assert.Equal(t, 1, resp.Pagination.Page)
 	assert.Equal(t, 20, resp.Pagination.Limit)
+	assert.Equal(t, 20, resp.Pagination.DefaultLimit)
+	assert.Equal(t, 100, resp.Pagination.MaxLimit)
 }
 
 func TestListTrips_200_Empty(t *testing.T) {
 	svc := &mockTripServicer{
-		listPaged: func(_ context.Context, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
+		listPaged: func(_ context.Context, _ []string, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
 			return []domain.Trip{}, 0, nil
 		},
 	}
@@ -177,7 +280,7 @@ func TestListTrips_200_Empty(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -200,7 +303,7 @@ func TestGetTrip_200(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/trips/"+fixture.ID.String(), nil)
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -209,6 +312,33 @@ func TestGetTrip_200(t *testing.T) {
 	assert.Equal(t, fixture.ID, resp.Id)
 }
 
+func TestGetTrip_200_DistanceTraveled(t *testing.T) {
+	fixture := tripFixture()
+	miles := 100.0
+	fixture.DistanceTraveled = &miles
+	svc := &mockTripServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) {
+			return fixture, nil
+		},
+	}
+
+	for units, want := range map[string]float64{"": 100, "metric": 160.93} {
+		req := httptest.NewRequest(http.MethodGet, "/trips/"+fixture.ID.String(), nil)
+		if units != "" {
+			req.Header.Set("Units", units)
+		}
+		rec := httptest.NewRecorder()
+
+		newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp gen.Trip
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		require.NotNil(t, resp.DistanceTraveled)
+		assert.InDelta(t, want, *resp.DistanceTraveled, 0.001, "Units: %q", units)
+	}
+}
+
 func TestGetTrip_404(t *testing.T) {
 	svc := &mockTripServicer{
 		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) {
@@ -219,7 +349,7 @@ func TestGetTrip_404(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/trips/"+uuid.New().String(), nil)
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -241,14 +371,14 @@ func TestUpdateTrip_200(t *testing.T) {
 
 	body := jsonBody(t, map[string]any{
 		"name":       "Updated Name",
-		"start_date": dateStr(fixture.StartDate),
+		"start_date": fixture.StartDate.String(),
 	})
 
 	req := httptest.NewRequest(http.MethodPut, "/trips/"+fixture.ID.String(), body)
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -273,7 +403,7 @@ func TestUpdateTrip_404(t *testing.T) {
 	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -292,7 +422,7 @@ func TestDeleteTrip_204(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.New().String(), nil)
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusNoContent, rec.Code)
 }
@@ -305,7 +435,7 @@ func TestDeleteTrip_404(t *testing.T) {
 	req := httptest.NewRequest(http.MethodDelete, "/trips/"+uuid.New().String(), nil)
 	rec := httptest.NewRecorder()
 
-	newHTTPHandler(svc).ServeHTTP(rec, req)
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -313,3 +443,184 @@ func TestDeleteTrip_404(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
 	assert.Equal(t, "not_found", errResp.Error.Code)
 }
+
+// ---- GET /trips/{id}/history -----------------------------------------------
+
+func TestListTripHistory_200(t *testing.T) {
+	id := uuid.New()
+	recorded := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+	svc := &mockTripServicer{
+		history: func(_ context.Context, got uuid.UUID) ([]domain.TripRevision, error) {
+			assert.Equal(t, id, got)
+			return []domain.TripRevision{
+				{TripID: id, Revision: 2, Name: "Summer Tour", StartDate: domain.NewDate(2025, 6, 1), Notes: "longer", RecordedAt: recorded},
+				{TripID: id, Revision: 1, Name: "Summer", StartDate: domain.NewDate(2025, 6, 1), RecordedAt: recorded.Add(-time.Hour)},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/history", id), nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.TripRevision
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 2)
+	assert.Equal(t, 2, resp[0].Revision)
+	require.NotNil(t, resp[0].Notes)
+	assert.Equal(t, "longer", *resp[0].Notes)
+	assert.Equal(t, "Summer", resp[1].Name)
+	assert.Nil(t, resp[1].Notes)
+}
+
+func TestListTripHistory_404(t *testing.T) {
+	svc := &mockTripServicer{
+		history: func(_ context.Context, _ uuid.UUID) ([]domain.TripRevision, error) { return nil, domain.ErrNotFound },
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/trips/%s/history", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// ---- POST /trips/{id}/history/{revision}/revert ----------------------------
+
+func TestRevertTrip_200(t *testing.T) {
+	trip := tripFixture()
+	svc := &mockTripServicer{
+		revert: func(_ context.Context, id uuid.UUID, revision int) (domain.Trip, error) {
+			assert.Equal(t, trip.ID, id)
+			assert.Equal(t, 3, revision)
+			return trip, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/history/3/revert", trip.ID), nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.Trip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, trip.ID, resp.Id)
+}
+
+func TestRevertTrip_404(t *testing.T) {
+	svc := &mockTripServicer{
+		revert: func(_ context.Context, _ uuid.UUID, _ int) (domain.Trip, error) {
+			return domain.Trip{}, fmt.Errorf("service.TripService.Revert: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/history/9/revert", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRevertTrip_422(t *testing.T) {
+	svc := &mockTripServicer{
+		revert: func(_ context.Context, _ uuid.UUID, _ int) (domain.Trip, error) {
+			return domain.Trip{}, fmt.Errorf("%w: end_date must not be before start_date", domain.ErrValidation)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/history/1/revert", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+// ---- POST /trips/{id}/clone ------------------------------------------------
+
+func TestCloneTrip_201(t *testing.T) {
+	source, clone := uuid.New(), tripFixture()
+	svc := &mockTripServicer{
+		clone: func(_ context.Context, sourceID uuid.UUID, c domain.TripClone) (domain.Trip, error) {
+			assert.Equal(t, source, sourceID)
+			assert.Equal(t, "Summer Tour 2026", c.Name)
+			require.NotNil(t, c.StartDate)
+			assert.Equal(t, domain.NewDate(2026, 6, 1), *c.StartDate)
+			assert.Equal(t, domain.TripCloneParts{Stops: true, Checklists: true}, c.Parts)
+			return clone, nil
+		},
+	}
+
+	body := jsonBody(t, map[string]any{
+		"name":       "Summer Tour 2026",
+		"start_date": "2026-06-01",
+		"include":    []string{"stops", "checklists"},
+	})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/clone", source), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var resp gen.Trip
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, clone.ID, resp.Id)
+}
+
+func TestCloneTrip_201_DefaultsToStopsAndTags(t *testing.T) {
+	var got domain.TripClone
+	svc := &mockTripServicer{
+		clone: func(_ context.Context, _ uuid.UUID, c domain.TripClone) (domain.Trip, error) {
+			got = c
+			return tripFixture(), nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/clone", uuid.New()), jsonBody(t, map[string]any{}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(t, domain.TripClone{Parts: domain.TripCloneParts{Stops: true, Tags: true}}, got)
+}
+
+func TestCloneTrip_404(t *testing.T) {
+	svc := &mockTripServicer{
+		clone: func(_ context.Context, _ uuid.UUID, _ domain.TripClone) (domain.Trip, error) {
+			return domain.Trip{}, fmt.Errorf("service.TripService.Clone: %w", domain.ErrNotFound)
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/clone", uuid.New()), jsonBody(t, map[string]any{}))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCloneTrip_422(t *testing.T) {
+	svc := &mockTripServicer{
+		clone: func(_ context.Context, _ uuid.UUID, _ domain.TripClone) (domain.Trip, error) {
+			return domain.Trip{}, fmt.Errorf("%w: stops must be included", domain.ErrValidation)
+		},
+	}
+
+	body := jsonBody(t, map[string]any{"include": []string{"tags"}})
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/trips/%s/clone", uuid.New()), body)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
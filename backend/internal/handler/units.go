@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"math"
+
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// Conversions from the imperial units the API stores to the metric ones a
+// request can ask for with the Units header.
+const (
+	kmPerMile       = 1.609344
+	litersPerGallon = 3.785411784
+	kgPerPound      = 0.45359237
+)
+
+// units converts the quantities in a response to the system the request
+// asked for. The zero value serves imperial units, as the fields are named.
+// Converted values are rounded to two decimal places (three for prices), so
+// they read like the figures a person would enter.
+type units struct {
+	metric bool
+}
+
+// unitsFor returns the converter for a request's Units header. A missing or
+// unrecognised value serves imperial units: the header is a preference, not
+// a requirement.
+func unitsFor(h *gen.Units) units {
+	return units{metric: h != nil && *h == gen.Metric}
+}
+
+// distance converts miles.
+func (u units) distance(miles float64) float64 {
+	if !u.metric {
+		return miles
+	}
+	return roundTo(miles*kmPerMile, 100)
+}
+
+// distancePtr converts optional miles.
+func (u units) distancePtr(miles *float64) *float64 {
+	if miles == nil {
+		return nil
+	}
+	v := u.distance(*miles)
+	return &v
+}
+
+// volume converts US gallons.
+func (u units) volume(gallons float64) float64 {
+	if !u.metric {
+		return gallons
+	}
+	return roundTo(gallons*litersPerGallon, 100)
+}
+
+// volumePtr converts optional US gallons.
+func (u units) volumePtr(gallons *float64) *float64 {
+	if gallons == nil {
+		return nil
+	}
+	v := u.volume(*gallons)
+	return &v
+}
+
+// perVolume converts an optional price per US gallon.
+func (u units) perVolume(perGallon *float64) *float64 {
+	if perGallon == nil || !u.metric {
+		return perGallon
+	}
+	v := roundTo(*perGallon/litersPerGallon, 1000)
+	return &v
+}
+
+// lengthPtr converts optional feet.
+func (u units) lengthPtr(feet *float64) *float64 {
+	if feet == nil || !u.metric {
+		return feet
+	}
+	v := roundTo(*feet/feetPerMeter, 100)
+	return &v
+}
+
+// weightPtr converts optional pounds.
+func (u units) weightPtr(pounds *float64) *float64 {
+	if pounds == nil || !u.metric {
+		return pounds
+	}
+	v := roundTo(*pounds*kgPerPound, 100)
+	return &v
+}
+
+// lengthFromMeters converts a distance stored in meters to miles, or
+// kilometers, to two decimal places.
+func (u units) lengthFromMeters(meters float64) float64 {
+	if u.metric {
+		return roundTo(meters/1000, 100)
+	}
+	return roundTo(meters/metersPerMile, 100)
+}
+
+// heightFromMeters converts an elevation or climb stored in meters to whole
+// feet, or whole meters.
+func (u units) heightFromMeters(meters float64) float64 {
+	if u.metric {
+		return math.Round(meters)
+	}
+	return toFeet(meters)
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+)
+
+// ListWebhooks handles GET /webhooks.
+func (s *Server) ListWebhooks(ctx context.Context, _ gen.ListWebhooksRequestObject) (gen.ListWebhooksResponseObject, error) {
+	webhooks, err := s.webhooks.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(gen.ListWebhooks200JSONResponse, len(webhooks))
+	for i, w := range webhooks {
+		resp[i] = webhookToResponse(w, false)
+	}
+	return resp, nil
+}
+
+// CreateWebhook handles POST /webhooks. The response is the only one, with
+// RotateWebhookSecret, that includes the secret.
+func (s *Server) CreateWebhook(ctx context.Context, req gen.CreateWebhookRequestObject) (gen.CreateWebhookResponseObject, error) {
+	if req.Body == nil {
+		return gen.CreateWebhook422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	created, err := s.webhooks.Create(ctx, requestToWebhook(*req.Body))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.CreateWebhook422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.CreateWebhook201JSONResponse(webhookToResponse(created, true)), nil
+}
+
+// GetWebhook handles GET /webhooks/{id}.
+func (s *Server) GetWebhook(ctx context.Context, req gen.GetWebhookRequestObject) (gen.GetWebhookResponseObject, error) {
+	w, err := s.webhooks.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.GetWebhook404JSONResponse(notFoundBody("webhook not found")), nil
+		}
+		return nil, err
+	}
+	return gen.GetWebhook200JSONResponse(webhookToResponse(w, false)), nil
+}
+
+// UpdateWebhook handles PUT /webhooks/{id}.
+func (s *Server) UpdateWebhook(ctx context.Context, req gen.UpdateWebhookRequestObject) (gen.UpdateWebhookResponseObject, error) {
+	if req.Body == nil {
+		return gen.UpdateWebhook422JSONResponse(requestBody("request body is required")), nil
+	}
+
+	w := requestToWebhook(*req.Body)
+	w.ID = req.Id
+	updated, err := s.webhooks.Update(ctx, w)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.UpdateWebhook404JSONResponse(notFoundBody("webhook not found")), nil
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return gen.UpdateWebhook422JSONResponse(validationBody(err)), nil
+		}
+		return nil, err
+	}
+	return gen.UpdateWebhook200JSONResponse(webhookToResponse(updated, false)), nil
+}
+
+// DeleteWebhook handles DELETE /webhooks/{id}.
+func (s *Server) DeleteWebhook(ctx context.Context, req gen.DeleteWebhookRequestObject) (gen.DeleteWebhookResponseObject, error) {
+	if err := s.webhooks.Delete(ctx, req.Id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.DeleteWebhook404JSONResponse(notFoundBody("webhook not found")), nil
+		}
+		return nil, err
+	}
+	return gen.DeleteWebhook204Response{}, nil
+}
+
+// RotateWebhookSecret handles POST /webhooks/{id}/rotate-secret.
+func (s *Server) RotateWebhookSecret(ctx context.Context, req gen.RotateWebhookSecretRequestObject) (gen.RotateWebhookSecretResponseObject, error) {
+	w, err := s.webhooks.RotateSecret(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.RotateWebhookSecret404JSONResponse(notFoundBody("webhook not found")), nil
+		}
+		return nil, err
+	}
+	return gen.RotateWebhookSecret200JSONResponse(webhookToResponse(w, true)), nil
+}
+
+// TestWebhook handles POST /webhooks/{id}/test.
+func (s *Server) TestWebhook(ctx context.Context, req gen.TestWebhookRequestObject) (gen.TestWebhookResponseObject, error) {
+	d, err := s.webhooks.Test(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.TestWebhook404JSONResponse(notFoundBody("webhook not found")), nil
+		}
+		return nil, err
+	}
+	resp, err := webhookDeliveryToResponse(d)
+	if err != nil {
+		return nil, err
+	}
+	return gen.TestWebhook200JSONResponse(resp), nil
+}
+
+// ListWebhookDeliveries handles GET /webhooks/{id}/deliveries.
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req gen.ListWebhookDeliveriesRequestObject) (gen.ListWebhookDeliveriesResponseObject, error) {
+	deliveries, err := s.webhooks.Deliveries(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return gen.ListWebhookDeliveries404JSONResponse(notFoundBody("webhook not found")), nil
+		}
+		return nil, err
+	}
+
+	resp := make(gen.ListWebhookDeliveries200JSONResponse, len(deliveries))
+	for i, d := range deliveries {
+		if resp[i], err = webhookDeliveryToResponse(d); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// requestToWebhook converts a request body into a domain.Webhook. A webhook
+// is active unless the body says otherwise.
+func requestToWebhook(body gen.WebhookRequest) domain.Webhook {
+	w := domain.Webhook{
+		URL:         body.Url,
+		Description: derefString(body.Description),
+		Active:      body.Active == nil || *body.Active,
+		Events:      make([]domain.WebhookEvent, len(body.Events)),
+	}
+	for i, e := range body.Events {
+		w.Events[i] = domain.WebhookEvent(e)
+	}
+	return w
+}
+
+// webhookToResponse converts a domain.Webhook into the generated type,
+// with the secret only when withSecret is set.
+func webhookToResponse(w domain.Webhook, withSecret bool) gen.Webhook {
+	events := make([]gen.WebhookEvent, len(w.Events))
+	for i, e := range w.Events {
+		events[i] = gen.WebhookEvent(e)
+	}
+	resp := gen.Webhook{
+		Id:          w.ID,
+		Url:         w.URL,
+		Events:      events,
+		Description: nilIfEmpty(w.Description),
+		Active:      w.Active,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+	if withSecret {
+		resp.Secret = &w.Secret
+	}
+	return resp
+}
+
+// webhookDeliveryToResponse converts a domain.WebhookDelivery into the
+// generated type, decoding the payload that was sent.
+func webhookDeliveryToResponse(d domain.WebhookDelivery) (gen.WebhookDelivery, error) {
+	resp := gen.WebhookDelivery{
+		Id:          d.ID,
+		WebhookId:   d.WebhookID,
+		Event:       string(d.Event),
+		Error:       nilIfEmpty(d.Error),
+		Succeeded:   d.Succeeded(),
+		DurationMs:  int(d.Duration.Milliseconds()),
+		AttemptedAt: d.AttemptedAt,
+	}
+	if d.StatusCode != 0 {
+		code := d.StatusCode
+		resp.StatusCode = &code
+	}
+	if err := json.Unmarshal(d.Payload, &resp.Payload); err != nil {
+		return gen.WebhookDelivery{}, err
+	}
+	return resp, nil
+}
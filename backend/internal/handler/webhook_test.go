@@ -0,0 +1,301 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/handler"
+	"github.com/pkordes/rv-logbook/backend/internal/handler/gen"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// ---- mock WebhookServicer --------------------------------------------------
+
+type mockWebhookServicer struct {
+	create       func(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+	getByID      func(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+	list         func(ctx context.Context) ([]domain.Webhook, error)
+	update       func(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+	delete       func(ctx context.Context, id uuid.UUID) error
+	rotateSecret func(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+	test         func(ctx context.Context, id uuid.UUID) (domain.WebhookDelivery, error)
+	deliveries   func(ctx context.Context, id uuid.UUID) ([]domain.WebhookDelivery, error)
+}
+
+func (m *mockWebhookServicer) Create(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	return m.create(ctx, w)
+}
+func (m *mockWebhookServicer) GetByID(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	return m.getByID(ctx, id)
+}
+func (m *mockWebhookServicer) List(ctx context.Context) ([]domain.Webhook, error) {
+	return m.list(ctx)
+}
+func (m *mockWebhookServicer) Update(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	return m.update(ctx, w)
+}
+func (m *mockWebhookServicer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.delete(ctx, id)
+}
+func (m *mockWebhookServicer) RotateSecret(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	return m.rotateSecret(ctx, id)
+}
+func (m *mockWebhookServicer) Test(ctx context.Context, id uuid.UUID) (domain.WebhookDelivery, error) {
+	return m.test(ctx, id)
+}
+func (m *mockWebhookServicer) Deliveries(ctx context.Context, id uuid.UUID) ([]domain.WebhookDelivery, error) {
+	return m.deliveries(ctx, id)
+}
+
+// compile-time check: mockWebhookServicer must satisfy handler.WebhookServicer.
+var _ handler.WebhookServicer = (*mockWebhookServicer)(nil)
+
+func newWebhookHTTPHandler(t *testing.T, svc handler.WebhookServicer) http.Handler {
+	srv := handler.NewServer(handler.ServerDeps{Webhooks: svc})
+	return testutil.ContractHandler(t, gen.Handler(gen.NewStrictHandler(srv, nil)))
+}
+
+func sampleWebhook() domain.Webhook {
+	now := time.Now().UTC()
+	return domain.Webhook{
+		ID:        uuid.New(),
+		URL:       "https://ha.local/api/webhook/rv",
+		Events:    []domain.WebhookEvent{domain.EventStopCreated},
+		Active:    true,
+		Secret:    "whsec_0123",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// ---- /webhooks -------------------------------------------------------------
+
+func TestListWebhooks_200_HidesSecret(t *testing.T) {
+	svc := &mockWebhookServicer{
+		list: func(_ context.Context) ([]domain.Webhook, error) {
+			return []domain.Webhook{sampleWebhook()}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), "secret")
+}
+
+func TestCreateWebhook_201(t *testing.T) {
+	svc := &mockWebhookServicer{
+		create: func(_ context.Context, w domain.Webhook) (domain.Webhook, error) {
+			assert.Equal(t, "https://ha.local/api/webhook/rv", w.URL)
+			assert.Equal(t, []domain.WebhookEvent{domain.EventStopCreated, domain.EventStopUpdated}, w.Events)
+			assert.True(t, w.Active, "webhooks are active by default")
+			created := sampleWebhook()
+			created.Events = w.Events
+			return created, nil
+		},
+	}
+
+	body := `{"url":"https://ha.local/api/webhook/rv","events":["stop.created","stop.updated"]}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var resp gen.Webhook
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Secret)
+	assert.Equal(t, "whsec_0123", *resp.Secret)
+}
+
+func TestCreateWebhook_422(t *testing.T) {
+	svc := &mockWebhookServicer{
+		create: func(_ context.Context, _ domain.Webhook) (domain.Webhook, error) {
+			return domain.Webhook{}, fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrValidation)
+		},
+	}
+
+	body := `{"url":"ha.local","events":["stop.created"]}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var resp gen.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "url must be an absolute http or https URL", resp.Error.Message)
+}
+
+// ---- /webhooks/{id} --------------------------------------------------------
+
+func TestGetWebhook_404(t *testing.T) {
+	svc := &mockWebhookServicer{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Webhook, error) {
+			return domain.Webhook{}, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/webhooks/%s", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUpdateWebhook_200(t *testing.T) {
+	id := uuid.New()
+	svc := &mockWebhookServicer{
+		update: func(_ context.Context, w domain.Webhook) (domain.Webhook, error) {
+			assert.Equal(t, id, w.ID)
+			assert.False(t, w.Active)
+			assert.Equal(t, "Home Assistant", w.Description)
+			return w, nil
+		},
+	}
+
+	body := `{"url":"https://ha.local/api/webhook/rv","events":["stop.deleted"],"description":"Home Assistant","active":false}`
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/webhooks/%s", id), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), "secret")
+}
+
+func TestDeleteWebhook_204(t *testing.T) {
+	id := uuid.New()
+	svc := &mockWebhookServicer{
+		delete: func(_ context.Context, got uuid.UUID) error {
+			assert.Equal(t, id, got)
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/webhooks/%s", id), nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestRotateWebhookSecret_200(t *testing.T) {
+	svc := &mockWebhookServicer{
+		rotateSecret: func(_ context.Context, id uuid.UUID) (domain.Webhook, error) {
+			w := sampleWebhook()
+			w.ID = id
+			w.Secret = "whsec_4567"
+			return w, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/webhooks/%s/rotate-secret", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.Webhook
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.NotNil(t, resp.Secret)
+	assert.Equal(t, "whsec_4567", *resp.Secret)
+}
+
+// ---- /webhooks/{id}/test and /deliveries -----------------------------------
+
+func TestTestWebhook_200(t *testing.T) {
+	id := uuid.New()
+	svc := &mockWebhookServicer{
+		test: func(_ context.Context, got uuid.UUID) (domain.WebhookDelivery, error) {
+			assert.Equal(t, id, got)
+			deliveryID := uuid.New()
+			return domain.WebhookDelivery{
+				ID: deliveryID, WebhookID: id, Event: domain.EventPing,
+				Payload:     json.RawMessage(fmt.Sprintf(`{"id":%q,"event":"ping","occurred_at":"2025-07-14T16:00:00Z","data":{"webhook_id":%q}}`, deliveryID, id)),
+				StatusCode:  http.StatusOK,
+				Duration:    42 * time.Millisecond,
+				AttemptedAt: time.Now().UTC(),
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/webhooks/%s/test", id), nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp gen.WebhookDelivery
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.True(t, resp.Succeeded)
+	assert.Equal(t, 42, resp.DurationMs)
+	require.NotNil(t, resp.StatusCode)
+	assert.Equal(t, http.StatusOK, *resp.StatusCode)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "ping", resp.Payload.Event)
+	assert.Equal(t, id.String(), resp.Payload.Data["webhook_id"])
+}
+
+func TestListWebhookDeliveries_200_Failure(t *testing.T) {
+	id := uuid.New()
+	svc := &mockWebhookServicer{
+		deliveries: func(_ context.Context, _ uuid.UUID) ([]domain.WebhookDelivery, error) {
+			deliveryID := uuid.New()
+			return []domain.WebhookDelivery{{
+				ID: deliveryID, WebhookID: id, Event: domain.EventStopCreated,
+				Payload:     json.RawMessage(fmt.Sprintf(`{"id":%q,"event":"stop.created","occurred_at":"2025-07-14T16:00:00Z","data":{}}`, deliveryID)),
+				Error:       "connection refused",
+				AttemptedAt: time.Now().UTC(),
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/webhooks/%s/deliveries", id), nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp []gen.WebhookDelivery
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp, 1)
+	assert.False(t, resp[0].Succeeded)
+	assert.Nil(t, resp[0].StatusCode)
+	require.NotNil(t, resp[0].Error)
+	assert.Equal(t, "connection refused", *resp[0].Error)
+}
+
+func TestListWebhookDeliveries_404(t *testing.T) {
+	svc := &mockWebhookServicer{
+		deliveries: func(_ context.Context, _ uuid.UUID) ([]domain.WebhookDelivery, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/webhooks/%s/deliveries", uuid.New()), nil)
+	rec := httptest.NewRecorder()
+
+	newWebhookHTTPHandler(t, svc).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
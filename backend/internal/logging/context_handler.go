@@ -0,0 +1,77 @@
+// Package logging provides slog plumbing shared by every layer of the API.
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+)
+
+// contextHandler decorates another slog.Handler, adding request-scoped
+// attributes found in the context to every record.
+type contextHandler struct {
+	next slog.Handler
+}
+
+// NewContextHandler wraps next so that every log call made with a context
+// (slog.InfoContext, logger.ErrorContext, …) automatically includes:
+//
+//   - request_id — set by chi's RequestID middleware
+//   - actor_type, actor_id, and user_id — set by the authentication middleware
+//
+// Attributes are only added when present, so background jobs and startup
+// logging are unaffected. Wire it once in main.go around the JSON handler;
+// handlers and services then get attribution for free as long as they use
+// the *Context logging variants.
+func NewContextHandler(next slog.Handler) slog.Handler {
+	return &contextHandler{next: next}
+}
+
+// Enabled reports whether the wrapped handler handles records at level.
+func (h *contextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the context attributes to r and forwards it.
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := chimiddleware.GetReqID(ctx); id != "" && !hasAttr(r, "request_id") {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if a, ok := auth.ActorFromContext(ctx); ok {
+		r.AddAttrs(
+			slog.String("actor_type", string(a.Type)),
+			slog.String("actor_id", a.ID),
+		)
+		if a.UserID != "" {
+			r.AddAttrs(slog.String("user_id", a.UserID))
+		}
+	}
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a contextHandler wrapping next.WithAttrs.
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{next: h.next.WithAttrs(attrs)}
+}
+
+// WithGroup returns a contextHandler wrapping next.WithGroup.
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{next: h.next.WithGroup(name)}
+}
+
+// hasAttr reports whether r already carries a top-level attribute named key.
+// The request logger sets request_id explicitly; this avoids a duplicate key.
+func hasAttr(r slog.Record, key string) bool {
+	found := false
+	r.Attrs(func(a slog.Attr) bool {
+		if a.Key == key {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,68 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/logging"
+)
+
+// newLogger returns a logger writing JSON through the context handler into buf.
+func newLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(logging.NewContextHandler(slog.NewJSONHandler(buf, nil)))
+}
+
+func decode(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	return entry
+}
+
+// TestContextHandler_AddsActorAndRequestID verifies that a log call made with
+// an authenticated request context is attributed automatically.
+func TestContextHandler_AddsActorAndRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := context.WithValue(context.Background(), chimiddleware.RequestIDKey, "req-1")
+	ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorAPIKey, ID: "key-42"})
+
+	newLogger(&buf).InfoContext(ctx, "trip created")
+
+	entry := decode(t, &buf)
+	assert.Equal(t, "req-1", entry["request_id"])
+	assert.Equal(t, "api_key", entry["actor_type"])
+	assert.Equal(t, "key-42", entry["actor_id"])
+}
+
+// TestContextHandler_AnonymousContext verifies that nothing is added when the
+// context carries no request or actor — e.g. startup and background logging.
+func TestContextHandler_AnonymousContext(t *testing.T) {
+	var buf bytes.Buffer
+
+	newLogger(&buf).InfoContext(context.Background(), "server starting")
+
+	entry := decode(t, &buf)
+	assert.NotContains(t, entry, "request_id")
+	assert.NotContains(t, entry, "actor_id")
+}
+
+// TestContextHandler_WithAttrsKeepsDecoration verifies that derived loggers
+// (logger.With(...)) still pick up context attributes.
+func TestContextHandler_WithAttrsKeepsDecoration(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := auth.WithActor(context.Background(), auth.Actor{Type: auth.ActorUser, ID: "u-7"})
+
+	newLogger(&buf).With("component", "export").InfoContext(ctx, "export built")
+
+	entry := decode(t, &buf)
+	assert.Equal(t, "export", entry["component"])
+	assert.Equal(t, "u-7", entry["actor_id"])
+}
@@ -0,0 +1,83 @@
+// Package metrics serves /metrics in the Prometheus text exposition format.
+//
+// The API exposes a handful of values, all sampled when Prometheus scrapes,
+// so they are written by hand instead of through a client library and its
+// registry.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ContentType is the media type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Kind is a Prometheus metric type.
+type Kind string
+
+const (
+	Gauge   Kind = "gauge"
+	Counter Kind = "counter"
+)
+
+// Metric is one unlabelled sample. Counter names end in _total.
+type Metric struct {
+	Name  string
+	Help  string
+	Kind  Kind
+	Value float64
+}
+
+// Source samples a group of metrics. It is called on every scrape.
+type Source func() []Metric
+
+// Handler serves the metrics from sources, in order.
+func Handler(sources ...Source) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		out := bufio.NewWriter(w)
+		for _, source := range sources {
+			for _, m := range source() {
+				fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n%s %s\n",
+					m.Name, m.Help, m.Name, m.Kind, m.Name, strconv.FormatFloat(m.Value, 'g', -1, 64))
+			}
+		}
+		_ = out.Flush() // the client hung up; nothing to tell it
+	})
+}
+
+// Pool reports connection pool statistics as rvlogbook_db_pool_* metrics.
+func Pool(stats func() domain.PoolStats) Source {
+	const prefix = "rvlogbook_db_pool_"
+	return func() []Metric {
+		s := stats()
+		return []Metric{
+			{prefix + "acquired_conns", "Connections currently checked out.", Gauge, float64(s.AcquiredConns)},
+			{prefix + "idle_conns", "Open connections waiting in the pool.", Gauge, float64(s.IdleConns)},
+			{prefix + "constructing_conns", "Connections being opened.", Gauge, float64(s.ConstructingConns)},
+			{prefix + "total_conns", "All open connections.", Gauge, float64(s.TotalConns)},
+			{prefix + "max_conns", "The most connections the pool will open.", Gauge, float64(s.MaxConns)},
+			{prefix + "acquires_total", "Connections handed out.", Counter, float64(s.AcquireCount)},
+			{prefix + "empty_acquires_total", "Acquires that had to wait for a connection.", Counter, float64(s.EmptyAcquireCount)},
+			{prefix + "canceled_acquires_total", "Acquires abandoned when their context ended.", Counter, float64(s.CanceledAcquireCount)},
+			{prefix + "new_conns_total", "Connections opened.", Counter, float64(s.NewConnsCount)},
+			{prefix + "acquire_seconds_total", "Time spent acquiring connections.", Counter, s.AcquireDuration.Seconds()},
+			{prefix + "empty_acquire_wait_seconds_total", "Time acquires spent waiting for a connection.", Counter, s.EmptyAcquireWait.Seconds()},
+		}
+	}
+}
+
+// Panics reports how many handler panics have been recovered, as counted by
+// middleware.NewRecoverer, as rvlogbook_http_panics_total.
+func Panics(count func() uint64) Source {
+	return func() []Metric {
+		return []Metric{
+			{"rvlogbook_http_panics_total", "Handler panics recovered and answered with a 500.", Counter, float64(count())},
+		}
+	}
+}
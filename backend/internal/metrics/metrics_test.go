@@ -0,0 +1,71 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/metrics"
+)
+
+func TestHandler_TextFormat(t *testing.T) {
+	source := func() []metrics.Metric {
+		return []metrics.Metric{
+			{Name: "up", Help: "Whether the API is up.", Kind: metrics.Gauge, Value: 1},
+			{Name: "requests_total", Help: "Requests served.", Kind: metrics.Counter, Value: 1500000},
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.Handler(source).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, metrics.ContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, `# HELP up Whether the API is up.
+# TYPE up gauge
+up 1
+# HELP requests_total Requests served.
+# TYPE requests_total counter
+requests_total 1.5e+06
+`, rec.Body.String())
+}
+
+func TestPool(t *testing.T) {
+	stats := domain.PoolStats{
+		AcquiredConns:    4,
+		MaxConns:         4,
+		AcquireCount:     120,
+		AcquireDuration:  1500 * time.Millisecond,
+		EmptyAcquireWait: 250 * time.Millisecond,
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.Handler(metrics.Pool(func() domain.PoolStats { return stats })).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body := rec.Body.String()
+	for _, line := range []string{
+		"rvlogbook_db_pool_acquired_conns 4",
+		"rvlogbook_db_pool_max_conns 4",
+		"rvlogbook_db_pool_acquires_total 120",
+		"rvlogbook_db_pool_acquire_seconds_total 1.5",
+		"rvlogbook_db_pool_empty_acquire_wait_seconds_total 0.25",
+		"# TYPE rvlogbook_db_pool_idle_conns gauge",
+	} {
+		assert.Contains(t, strings.Split(body, "\n"), line)
+	}
+}
+
+func TestPanics(t *testing.T) {
+	rec := httptest.NewRecorder()
+	metrics.Handler(metrics.Panics(func() uint64 { return 3 })).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Contains(t, rec.Body.String(), "# TYPE rvlogbook_http_panics_total counter\nrvlogbook_http_panics_total 3\n")
+}
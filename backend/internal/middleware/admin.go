@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// adminPrefix is the path prefix of the routes NewAdminHandler guards.
+const adminPrefix = "/admin/"
+
+// UserLookup is what NewAdminHandler needs to find the request's user.
+// repo.UserRepo satisfies it.
+type UserLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+}
+
+// NewAdminHandler returns middleware that lets only administrators — the
+// users named in admins — reach the routes under /admin. Every other route
+// passes through untouched.
+//
+// A request with no signed-in user, or whose user is not an administrator,
+// is rejected with 403, so /admin is closed while authentication is off.
+// Owning a trip is not enough: the data fixes change trips wholesale, and
+// the pool statistics describe the whole server. Wire it after the
+// authentication middleware.
+func NewAdminHandler(users UserLookup, admins []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, adminPrefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			userID, ok := domain.UserFromContext(ctx)
+			if !ok {
+				writeAdminForbidden(w)
+				return
+			}
+			user, err := users.GetByID(ctx, userID)
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				writeError(w, http.StatusInternalServerError, internalError)
+				return
+			}
+			if err != nil || !slices.Contains(admins, user.Username) {
+				writeAdminForbidden(w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeAdminForbidden rejects a request to /admin from a non-administrator.
+func writeAdminForbidden(w http.ResponseWriter) {
+	writeError(w, http.StatusForbidden, errorDetail{Code: "forbidden", Message: "only administrators may use /admin"})
+}
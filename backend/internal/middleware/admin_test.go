@@ -0,0 +1,73 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// fakeUsers knows users by ID; broken fails every lookup.
+type fakeUsers struct {
+	users  map[uuid.UUID]string
+	broken bool
+}
+
+func (f fakeUsers) GetByID(_ context.Context, id uuid.UUID) (domain.User, error) {
+	if f.broken {
+		return domain.User{}, errors.New("db down")
+	}
+	name, ok := f.users[id]
+	if !ok {
+		return domain.User{}, domain.ErrNotFound
+	}
+	return domain.User{ID: id, Username: name}, nil
+}
+
+func TestAdminHandler(t *testing.T) {
+	root, owner := uuid.New(), uuid.New()
+	users := fakeUsers{users: map[uuid.UUID]string{root: "root", owner: "alice"}}
+	shift := "/admin/trips/" + uuid.NewString() + "/shift-dates"
+
+	tests := []struct {
+		name     string
+		users    fakeUsers
+		path     string
+		user     uuid.UUID
+		wantCode int
+	}{
+		{"administrator", users, shift, root, http.StatusOK},
+		{"trip owner who is not an administrator", users, shift, owner, http.StatusForbidden},
+		{"pool statistics", users, "/admin/pool", owner, http.StatusForbidden},
+		{"no signed-in user", users, shift, uuid.Nil, http.StatusForbidden},
+		{"deleted user", users, shift, uuid.New(), http.StatusForbidden},
+		{"lookup failure", fakeUsers{broken: true}, shift, root, http.StatusInternalServerError},
+		{"other routes pass through", users, "/trips", owner, http.StatusOK},
+		{"other routes need no user", users, "/administrators", uuid.Nil, http.StatusOK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodPost, tc.path, nil)
+			if tc.user != uuid.Nil {
+				req = req.WithContext(domain.WithUser(req.Context(), tc.user))
+			}
+			rec := httptest.NewRecorder()
+			middleware.NewAdminHandler(tc.users, []string{"root"})(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			if tc.wantCode != http.StatusOK {
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
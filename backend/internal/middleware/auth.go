@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TokenVerifier is what NewAuthHandler needs to check a bearer token.
+// *service.AuthService satisfies it.
+type TokenVerifier interface {
+	Verify(ctx context.Context, accessToken string) (uuid.UUID, error)
+}
+
+// APIKeyVerifier is what NewAuthHandler needs to check an API key.
+// *service.APIKeyService satisfies it.
+type APIKeyVerifier interface {
+	Verify(ctx context.Context, key string) (domain.APIKey, error)
+}
+
+// APIKeyHeader is the request header automation clients send their API key
+// in, instead of a bearer token.
+const APIKeyHeader = "X-API-Key"
+
+// NewAuthHandler returns middleware that requires a valid JWT bearer token
+// in the Authorization header and records the user it was issued to as the
+// request's actor and, with domain.WithUser, as the owner the repos scope
+// trips to. Requests for which public reports true pass through
+// untouched; public may be nil.
+//
+// A request carrying an X-API-Key header is authenticated by that key
+// instead, as the API key actor, acting for the user who created the key.
+// keys may be nil, in which case the header is ignored.
+//
+// A missing or malformed header, and an invalid or expired token, are
+// rejected with 401 and a WWW-Authenticate challenge; an unknown or revoked
+// API key with 401. Wire it before NewOrganizationHandler, which checks the
+// actor's membership.
+func NewAuthHandler(tokens TokenVerifier, keys APIKeyVerifier, public func(*http.Request) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if public != nil && public(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if key := strings.TrimSpace(r.Header.Get(APIKeyHeader)); key != "" && keys != nil {
+				ctx := r.Context()
+				apiKey, err := keys.Verify(ctx, key)
+				if err != nil {
+					if errors.Is(err, domain.ErrUnauthorized) {
+						writeError(w, http.StatusUnauthorized, errorDetail{Code: "unauthorized", Message: "the API key is invalid or revoked"})
+						return
+					}
+					writeError(w, http.StatusInternalServerError, internalError)
+					return
+				}
+				ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorAPIKey, ID: apiKey.ID.String(), UserID: apiKey.UserID.String()})
+				next.ServeHTTP(w, r.WithContext(domain.WithUser(ctx, apiKey.UserID)))
+				return
+			}
+
+			scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+			token = strings.TrimSpace(token)
+			if !strings.EqualFold(scheme, "Bearer") || token == "" {
+				w.Header().Set("WWW-Authenticate", `Bearer`)
+				writeError(w, http.StatusUnauthorized, errorDetail{Code: "unauthorized", Message: "a bearer token is required"})
+				return
+			}
+
+			ctx := r.Context()
+			userID, err := tokens.Verify(ctx, token)
+			if err != nil {
+				if errors.Is(err, domain.ErrUnauthorized) {
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					writeError(w, http.StatusUnauthorized, errorDetail{Code: "unauthorized", Message: "the bearer token is invalid or expired"})
+					return
+				}
+				writeError(w, http.StatusInternalServerError, internalError)
+				return
+			}
+
+			ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorUser, ID: userID.String(), UserID: userID.String()})
+			next.ServeHTTP(w, r.WithContext(domain.WithUser(ctx, userID)))
+		})
+	}
+}
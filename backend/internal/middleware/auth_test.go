@@ -0,0 +1,165 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// alice is the user fakeTokens issued "good" to.
+var alice = uuid.MustParse("0b6f8a52-3c1e-4d2a-9f4b-7e5d6c8a9b10")
+
+// fakeTokens accepts "good" as alice's token and fails "broken" with an
+// unexpected error; anything else is unauthorized.
+type fakeTokens struct{}
+
+func (fakeTokens) Verify(_ context.Context, token string) (uuid.UUID, error) {
+	switch token {
+	case "good":
+		return alice, nil
+	case "broken":
+		return uuid.Nil, errors.New("key store unavailable")
+	}
+	return uuid.Nil, domain.ErrUnauthorized
+}
+
+// piKey is the API key fakeKeys accepts; alice created it.
+var piKey = domain.APIKey{ID: uuid.MustParse("5a4e3c2b-1d0f-4e9a-8b7c-6d5e4f3a2b1c"), UserID: alice}
+
+// fakeKeys accepts "rvk_good" as piKey and fails "rvk_broken" with an
+// unexpected error; anything else is unauthorized.
+type fakeKeys struct{}
+
+func (fakeKeys) Verify(_ context.Context, key string) (domain.APIKey, error) {
+	switch key {
+	case "rvk_good":
+		return piKey, nil
+	case "rvk_broken":
+		return domain.APIKey{}, errors.New("database unavailable")
+	}
+	return domain.APIKey{}, domain.ErrUnauthorized
+}
+
+func TestAuthHandler(t *testing.T) {
+	public := func(r *http.Request) bool { return r.URL.Path == "/healthz" }
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantCode      int
+		wantActor     string
+		wantChallenge string
+	}{
+		{"valid token", "/trips", "Bearer good", http.StatusOK, alice.String(), ""},
+		{"scheme is case-insensitive", "/trips", "bearer good", http.StatusOK, alice.String(), ""},
+		{"no header", "/trips", "", http.StatusUnauthorized, "", "Bearer"},
+		{"wrong scheme", "/trips", "Basic YWxpY2U6czNjcmV0", http.StatusUnauthorized, "", "Bearer"},
+		{"empty token", "/trips", "Bearer ", http.StatusUnauthorized, "", "Bearer"},
+		{"invalid token", "/trips", "Bearer forged", http.StatusUnauthorized, "", `Bearer error="invalid_token"`},
+		{"verifier failure", "/trips", "Bearer broken", http.StatusInternalServerError, "", ""},
+		{"public route needs no token", "/healthz", "", http.StatusOK, "", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				actor   auth.Actor
+				user    uuid.UUID
+				hasUser bool
+			)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actor, _ = auth.ActorFromContext(r.Context())
+				user, hasUser = domain.UserFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			middleware.NewAuthHandler(fakeTokens{}, fakeKeys{}, public)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.Equal(t, tc.wantActor, actor.ID)
+			assert.Equal(t, tc.wantChallenge, rec.Header().Get("WWW-Authenticate"))
+			if tc.wantCode != http.StatusOK {
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			}
+			if tc.wantActor != "" {
+				assert.Equal(t, auth.ActorUser, actor.Type)
+				assert.Equal(t, alice, user, "the repos scope trips to the user")
+			} else {
+				assert.False(t, hasUser)
+			}
+		})
+	}
+}
+
+// TestAuthHandler_FillsActorSlot checks that the request logger, which sits
+// outside the middleware, sees the authenticated user.
+func TestAuthHandler_FillsActorSlot(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	req.Header.Set("Authorization", "Bearer good")
+	ctx := auth.WithActorSlot(req.Context())
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {})
+
+	middleware.NewAuthHandler(fakeTokens{}, fakeKeys{}, nil)(next).ServeHTTP(httptest.NewRecorder(), req.WithContext(ctx))
+
+	actor, ok := auth.ActorFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, alice.String(), actor.ID)
+	assert.Equal(t, alice.String(), actor.UserID)
+}
+
+func TestAuthHandler_APIKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		authorization string
+		wantCode      int
+		wantActor     auth.Actor
+	}{
+		{"valid key", "rvk_good", "", http.StatusOK, auth.Actor{Type: auth.ActorAPIKey, ID: piKey.ID.String(), UserID: alice.String()}},
+		{"key wins over a bearer token", "rvk_good", "Bearer good", http.StatusOK, auth.Actor{Type: auth.ActorAPIKey, ID: piKey.ID.String(), UserID: alice.String()}},
+		{"unknown key", "rvk_revoked", "", http.StatusUnauthorized, auth.Actor{}},
+		{"unknown key does not fall back to the token", "rvk_revoked", "Bearer good", http.StatusUnauthorized, auth.Actor{}},
+		{"verifier failure", "rvk_broken", "", http.StatusInternalServerError, auth.Actor{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				actor auth.Actor
+				user  uuid.UUID
+			)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actor, _ = auth.ActorFromContext(r.Context())
+				user, _ = domain.UserFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/trips", nil)
+			req.Header.Set(middleware.APIKeyHeader, tc.key)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			middleware.NewAuthHandler(fakeTokens{}, fakeKeys{}, nil)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.Equal(t, tc.wantActor, actor)
+			if tc.wantCode == http.StatusOK {
+				assert.Equal(t, alice, user, "a key acts for the user who created it")
+			} else {
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// secretKeyPattern matches the names of fields whose values are never
+// logged: secrets — passwords, tokens, API keys, webhook secrets — and the
+// free-text notes users write about their trips, which may be personal.
+var secretKeyPattern = regexp.MustCompile(`(?i)password|secret|token|credential|authorization|api_?key|notes?$`)
+
+// redactedValue replaces the value of every secret field.
+const redactedValue = "[REDACTED]"
+
+// NewFailedBodyLogger returns middleware that logs the request body of a
+// POST, PUT, PATCH, or DELETE answered with a 4xx or 5xx, so a failure
+// reported from a mobile client can be reproduced. It is a debugging aid,
+// off unless LOG_FAILED_BODIES is set.
+//
+// At most limit bytes of the body are kept, copied as the handler reads
+// them; the log line says when the body was truncated. JSON and form bodies
+// are logged with the values of secret-looking fields (password,
+// refresh_token, secret, notes, …) masked, whatever their type; other
+// bodies, such as GPX uploads, are logged by size and content type only. Successful requests cost a copy of
+// at most limit bytes and log nothing.
+//
+// Wire it after chimiddleware.RequestID and before NewRecoverer, so a
+// panic's 500 is seen here too.
+func NewFailedBodyLogger(log *slog.Logger, limit int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			default:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			capture := &bodyCapture{ReadCloser: r.Body, limit: limit}
+			r.Body = capture
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			if ww.Status() < http.StatusBadRequest {
+				return
+			}
+			attrs := []any{
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", ww.Status(),
+				"request_id", chimiddleware.GetReqID(r.Context()),
+				"content_type", r.Header.Get("Content-Type"),
+				"bytes", capture.read,
+				"truncated", capture.read > int64(capture.buf.Len()),
+			}
+			if body, ok := redactBody(r.Header.Get("Content-Type"), capture.buf.Bytes()); ok {
+				attrs = append(attrs, "body", body)
+			}
+			log.WarnContext(r.Context(), "failed request body", attrs...)
+		})
+	}
+}
+
+// bodyCapture copies the first limit bytes read from a request body and
+// counts the rest.
+type bodyCapture struct {
+	io.ReadCloser
+	limit int
+	buf   bytes.Buffer
+	read  int64
+}
+
+func (c *bodyCapture) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	if room := c.limit - c.buf.Len(); room > 0 {
+		c.buf.Write(p[:min(n, room)])
+	}
+	c.read += int64(n)
+	return n, err
+}
+
+// redactBody masks the values of secret-looking fields in a JSON or form
+// body. The boolean is false for any other content type, whose body is not
+// logged.
+func redactBody(contentType string, body []byte) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+	switch mediaType {
+	case "application/json":
+		return redactJSON(body), true
+	case "application/x-www-form-urlencoded":
+		return redactForm(string(body)), true
+	}
+	return "", false
+}
+
+// jsonContainer is an object or array open while redactJSON walks a body.
+type jsonContainer struct {
+	object  bool
+	members int
+	inValue bool // an object key has been written and its value is next
+}
+
+// redactJSON re-encodes a JSON body with the value of every secret field,
+// object and array values included, replaced by "[REDACTED]". It walks the
+// body token by token instead of decoding it whole so that a truncated body
+// is still logged, up to where it was cut.
+func redactJSON(body []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var out bytes.Buffer
+	var open []*jsonContainer
+	redactNext := false
+	skipDepth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if redactNext {
+				writeJSON(&out, redactedValue)
+			}
+			return out.String()
+		}
+		if skipDepth > 0 {
+			switch tok {
+			case json.Delim('{'), json.Delim('['):
+				skipDepth++
+			case json.Delim('}'), json.Delim(']'):
+				skipDepth--
+			}
+			continue
+		}
+		if tok == json.Delim('}') || tok == json.Delim(']') {
+			out.WriteString(tok.(json.Delim).String())
+			open = open[:len(open)-1]
+			continue
+		}
+
+		if len(open) > 0 {
+			c := open[len(open)-1]
+			switch {
+			case c.object && !c.inValue:
+				key, _ := tok.(string)
+				if c.members > 0 {
+					out.WriteByte(',')
+				}
+				c.members++
+				writeJSON(&out, key)
+				out.WriteByte(':')
+				c.inValue = true
+				redactNext = secretKeyPattern.MatchString(key)
+				continue
+			case c.object:
+				c.inValue = false
+			default:
+				if c.members > 0 {
+					out.WriteByte(',')
+				}
+				c.members++
+			}
+		}
+
+		if redactNext {
+			redactNext = false
+			writeJSON(&out, redactedValue)
+			if tok == json.Delim('{') || tok == json.Delim('[') {
+				skipDepth = 1
+			}
+			continue
+		}
+		if d, ok := tok.(json.Delim); ok {
+			out.WriteString(d.String())
+			open = append(open, &jsonContainer{object: d == '{'})
+			continue
+		}
+		writeJSON(&out, tok)
+	}
+}
+
+// writeJSON appends the JSON encoding of v to out.
+func writeJSON(out *bytes.Buffer, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	out.Write(b)
+}
+
+// redactForm masks the values of secret-looking fields in a form-encoded
+// body. The body is rewritten in place rather than parsed, so a truncated
+// body keeps its order and its cut-off last field.
+func redactForm(body string) string {
+	fields := strings.Split(body, "&")
+	for i, field := range fields {
+		name, _, hasValue := strings.Cut(field, "=")
+		key, err := url.QueryUnescape(name)
+		if err != nil {
+			key = name
+		}
+		if hasValue && secretKeyPattern.MatchString(key) {
+			fields[i] = name + "=" + redactedValue
+		}
+	}
+	return strings.Join(fields, "&")
+}
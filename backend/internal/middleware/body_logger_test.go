@@ -0,0 +1,121 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// readAndRespond reads the whole body, as a handler decoding JSON would, and
+// answers with status.
+func readAndRespond(status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	})
+}
+
+func TestFailedBodyLogger(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		status      int
+		limit       int
+		wantLogged  bool
+		wantBody    string
+		wantTrunc   bool
+	}{
+		{
+			name: "failed JSON mutation is logged with secrets masked", method: http.MethodPost,
+			contentType: "application/json", status: http.StatusUnprocessableEntity, limit: 1024,
+			body:       `{"username":"pat","password":"hunter2","refresh_token":"ey\"J"}`,
+			wantLogged: true, wantBody: `{"username":"pat","password":"[REDACTED]","refresh_token":"[REDACTED]"}`,
+		},
+		{
+			name: "secret values are masked whatever their type", method: http.MethodPatch,
+			contentType: "application/json", status: http.StatusBadRequest, limit: 1024,
+			body:       `{"pin_token":1234,"credentials":{"user":"pat","key":"k"},"api_keys":["a","b"],"enabled":true}`,
+			wantLogged: true, wantBody: `{"pin_token":"[REDACTED]","credentials":"[REDACTED]","api_keys":"[REDACTED]","enabled":true}`,
+		},
+		{
+			name: "notes are masked at any depth", method: http.MethodPost,
+			contentType: "application/json", status: http.StatusUnprocessableEntity, limit: 1024,
+			body:       `{"name":"Moab","notes":"gate code 4411","stops":[{"location":"Arches","stop_notes":null,"nights":2}]}`,
+			wantLogged: true, wantBody: `{"name":"Moab","notes":"[REDACTED]","stops":[{"location":"Arches","stop_notes":"[REDACTED]","nights":2}]}`,
+		},
+		{
+			name: "truncated body still masks a cut-off secret", method: http.MethodPut,
+			contentType: "application/json; charset=utf-8", status: http.StatusBadRequest, limit: 30,
+			body:       `{"name":"hook","secret":"abcdefghijklmnop"}`,
+			wantLogged: true, wantBody: `{"name":"hook","secret":"[REDACTED]"`, wantTrunc: true,
+		},
+		{
+			name: "form body is masked", method: http.MethodPost,
+			contentType: "application/x-www-form-urlencoded", status: http.StatusUnauthorized, limit: 1024,
+			body:       "username=pat&password=hunter2",
+			wantLogged: true, wantBody: "username=pat&password=[REDACTED]",
+		},
+		{
+			name: "form notes are masked", method: http.MethodPost,
+			contentType: "application/x-www-form-urlencoded", status: http.StatusBadRequest, limit: 1024,
+			body:       "location=Moab&notes=gate+code+4411",
+			wantLogged: true, wantBody: "location=Moab&notes=[REDACTED]",
+		},
+		{
+			name: "binary body is not logged", method: http.MethodPut,
+			contentType: "application/gpx+xml", status: http.StatusUnprocessableEntity, limit: 1024,
+			body: "<gpx/>", wantLogged: true,
+		},
+		{
+			name: "successful mutation is not logged", method: http.MethodPost,
+			contentType: "application/json", status: http.StatusCreated, limit: 1024,
+			body: `{"name":"Moab"}`,
+		},
+		{
+			name: "failed read is not logged", method: http.MethodGet,
+			status: http.StatusNotFound, limit: 1024,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			h := middleware.NewFailedBodyLogger(slog.New(slog.NewJSONHandler(&buf, nil)), tc.limit)(readAndRespond(tc.status))
+			req := httptest.NewRequest(tc.method, "/trips", strings.NewReader(tc.body))
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.status, rec.Code)
+			if !tc.wantLogged {
+				assert.Empty(t, buf.String())
+				return
+			}
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, "WARN", entry["level"])
+			assert.EqualValues(t, tc.status, entry["status"])
+			assert.EqualValues(t, len(tc.body), entry["bytes"])
+			assert.Equal(t, tc.wantTrunc, entry["truncated"])
+			if tc.wantBody == "" {
+				assert.NotContains(t, entry, "body")
+			} else {
+				assert.Equal(t, tc.wantBody, entry["body"])
+			}
+		})
+	}
+}
@@ -10,13 +10,29 @@ import (
 // NewCORSHandler returns a middleware that applies CORS headers based on allowedOrigins.
 // Each entry in allowedOrigins must be a full origin (scheme + host, no trailing slash).
 // Allowed methods and headers cover the full REST surface of the API.
-func NewCORSHandler(allowedOrigins []string) func(http.Handler) http.Handler {
+//
+// Requests for which embeddable reports true may instead be read from any
+// origin, so a page on someone else's site can fetch them; only GET is
+// allowed and no credentials are sent. embeddable may be nil.
+func NewCORSHandler(allowedOrigins []string, embeddable func(*http.Request) bool) func(http.Handler) http.Handler {
 	c := cors.New(cors.Options{
 		AllowedOrigins: allowedOrigins,
 		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		AllowedHeaders: []string{"Content-Type", "Authorization", "If-Modified-Since", "Units", "X-Organization-ID"},
+	})
+	open := cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET"},
+		AllowedHeaders: []string{"If-Modified-Since"},
 	})
 	return func(next http.Handler) http.Handler {
-		return c.Handler(next)
+		restricted, anyOrigin := c.Handler(next), open.Handler(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if embeddable != nil && embeddable(r) {
+				anyOrigin.ServeHTTP(w, r)
+				return
+			}
+			restricted.ServeHTTP(w, r)
+		})
 	}
 }
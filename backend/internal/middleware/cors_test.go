@@ -3,6 +3,7 @@ package middleware_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -19,7 +20,7 @@ var trivialHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Reques
 // TestCORSHandler_GET_AllowedOrigin verifies that a GET from an allowed origin
 // receives the Access-Control-Allow-Origin header in the response.
 func TestCORSHandler_GET_AllowedOrigin(t *testing.T) {
-	h := middleware.NewCORSHandler([]string{"http://localhost:5173"})(trivialHandler)
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(trivialHandler)
 
 	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
 	req.Header.Set("Origin", "http://localhost:5173")
@@ -35,7 +36,7 @@ func TestCORSHandler_GET_AllowedOrigin(t *testing.T) {
 // Browsers send this before any cross-origin request with custom headers or
 // non-simple methods (e.g. PUT, DELETE, or Content-Type: application/json).
 func TestCORSHandler_OPTIONS_Preflight(t *testing.T) {
-	h := middleware.NewCORSHandler([]string{"http://localhost:5173"})(trivialHandler)
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(trivialHandler)
 
 	req := httptest.NewRequest(http.MethodOptions, "/trips", nil)
 	req.Header.Set("Origin", "http://localhost:5173")
@@ -54,12 +55,57 @@ func TestCORSHandler_OPTIONS_Preflight(t *testing.T) {
 	assert.NotEmpty(t, rec.Header().Get("Access-Control-Allow-Methods"))
 }
 
+// TestCORSHandler_OPTIONS_PreflightConditionalGet verifies that the SPA may
+// revalidate cached read endpoints with If-Modified-Since.
+func TestCORSHandler_OPTIONS_PreflightConditionalGet(t *testing.T) {
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(trivialHandler)
+
+	req := httptest.NewRequest(http.MethodOptions, "/tags", nil)
+	req.Header.Set("Origin", "http://localhost:5173")
+	req.Header.Set("Access-Control-Request-Method", "GET")
+	req.Header.Set("Access-Control-Request-Headers", "if-modified-since")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, "if-modified-since", rec.Header().Get("Access-Control-Allow-Headers"))
+}
+
+// TestCORSHandler_OPTIONS_PreflightUnits verifies that the SPA may ask for
+// metric quantities with the Units header.
+func TestCORSHandler_OPTIONS_PreflightUnits(t *testing.T) {
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(trivialHandler)
+
+	req := httptest.NewRequest(http.MethodOptions, "/trips/x/stats", nil)
+	req.Header.Set("Origin", "http://localhost:5173")
+	req.Header.Set("Access-Control-Request-Method", "GET")
+	req.Header.Set("Access-Control-Request-Headers", "units")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, "units", rec.Header().Get("Access-Control-Allow-Headers"))
+}
+
+// TestCORSHandler_OPTIONS_PreflightOrganization verifies that the SPA may act
+// for an organization with the X-Organization-ID header.
+func TestCORSHandler_OPTIONS_PreflightOrganization(t *testing.T) {
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(trivialHandler)
+
+	req := httptest.NewRequest(http.MethodOptions, "/trips", nil)
+	req.Header.Set("Origin", "http://localhost:5173")
+	req.Header.Set("Access-Control-Request-Method", "GET")
+	req.Header.Set("Access-Control-Request-Headers", "x-organization-id")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, "x-organization-id", rec.Header().Get("Access-Control-Allow-Headers"))
+}
+
 // TestCORSHandler_GET_DisallowedOrigin verifies that a request from a
 // disallowed origin does NOT receive the Access-Control-Allow-Origin header.
 // The browser will then block the response — the response itself can still be 200,
 // but the CORS header must be absent.
 func TestCORSHandler_GET_DisallowedOrigin(t *testing.T) {
-	h := middleware.NewCORSHandler([]string{"http://localhost:5173"})(trivialHandler)
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(trivialHandler)
 
 	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
 	req.Header.Set("Origin", "http://evil.example.com")
@@ -68,3 +114,38 @@ func TestCORSHandler_GET_DisallowedOrigin(t *testing.T) {
 
 	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
 }
+
+// isEmbed stands in for the app's embeddable-route check.
+func isEmbed(r *http.Request) bool { return strings.HasSuffix(r.URL.Path, "/embed") }
+
+// TestCORSHandler_Embeddable_AnyOrigin verifies that an embeddable route may
+// be read from any origin, while the rest of the API stays restricted.
+func TestCORSHandler_Embeddable_AnyOrigin(t *testing.T) {
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, isEmbed)(trivialHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/shared/tok/embed", nil)
+	req.Header.Set("Origin", "https://blog.example.com")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	req = httptest.NewRequest(http.MethodGet, "/shared/tok", nil)
+	req.Header.Set("Origin", "https://blog.example.com")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+// TestCORSHandler_Embeddable_ReadOnly verifies that the relaxed policy
+// allows GET only.
+func TestCORSHandler_Embeddable_ReadOnly(t *testing.T) {
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, isEmbed)(trivialHandler)
+
+	req := httptest.NewRequest(http.MethodOptions, "/shared/tok/embed", nil)
+	req.Header.Set("Origin", "https://blog.example.com")
+	req.Header.Set("Access-Control-Request-Method", "DELETE")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
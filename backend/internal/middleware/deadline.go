@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+)
+
+// NewStreamDeadlineHandler returns middleware that gives requests matched by
+// isStream timeout to read their body and write their response, in place of
+// the server-wide ReadTimeout and WriteTimeout. Those are kept short to shed
+// slow clients, which would cut off a large file upload or download part way
+// through.
+//
+// The deadlines are set per connection through http.ResponseController, so
+// they apply to this request only. Writers that do not support deadlines
+// (httptest.ResponseRecorder) keep the server's.
+func NewStreamDeadlineHandler(timeout time.Duration, isStream func(*http.Request) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isStream(r) {
+				deadline := time.Now().Add(timeout)
+				rc := http.NewResponseController(w)
+				_ = rc.SetReadDeadline(deadline)
+				_ = rc.SetWriteDeadline(deadline)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// slowHandler takes longer to answer than the test server's WriteTimeout.
+var slowHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	time.Sleep(150 * time.Millisecond)
+	_, _ = w.Write([]byte("done"))
+})
+
+// newStreamDeadlineServer serves slowHandler with a 50ms WriteTimeout,
+// lifted to a second for /export.
+func newStreamDeadlineServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	isStream := func(r *http.Request) bool { return r.URL.Path == "/export" }
+	srv := httptest.NewUnstartedServer(middleware.NewStreamDeadlineHandler(time.Second, isStream)(slowHandler))
+	srv.Config.WriteTimeout = 50 * time.Millisecond
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// TestStreamDeadlineHandler_StreamOutlastsWriteTimeout verifies that a matched
+// route can take longer than the server's WriteTimeout.
+func TestStreamDeadlineHandler_StreamOutlastsWriteTimeout(t *testing.T) {
+	srv := newStreamDeadlineServer(t)
+
+	resp, err := srv.Client().Get(srv.URL + "/export")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+
+	require.NoError(t, err)
+	assert.Equal(t, "done", string(body))
+}
+
+// TestStreamDeadlineHandler_OtherRoutesKeepWriteTimeout verifies that routes
+// the predicate does not match are still cut off at the server's WriteTimeout.
+func TestStreamDeadlineHandler_OtherRoutesKeepWriteTimeout(t *testing.T) {
+	srv := newStreamDeadlineServer(t)
+
+	resp, err := srv.Client().Get(srv.URL + "/trips")
+	if err == nil {
+		defer resp.Body.Close()
+		_, err = io.ReadAll(resp.Body)
+	}
+
+	assert.Error(t, err)
+}
+
+// TestStreamDeadlineHandler_Recorder verifies that writers without deadline
+// support pass through untouched.
+func TestStreamDeadlineHandler_Recorder(t *testing.T) {
+	h := middleware.NewStreamDeadlineHandler(time.Second, func(*http.Request) bool { return true })(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
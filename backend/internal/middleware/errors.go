@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// errorDetail is the body of the API's error envelope, the same shape as
+// gen.ErrorDetail. RequestID is set only on 500s from a server fault.
+type errorDetail struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// writeError writes detail in the error envelope every handler uses,
+// {"error": {...}}, so a request rejected by middleware fails the same way
+// as one rejected by its handler. Headers set on w beforehand are kept.
+func writeError(w http.ResponseWriter, status int, detail errorDetail) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error errorDetail `json:"error"`
+	}{detail})
+}
+
+// internalError is the detail of a 500 that says no more than that the
+// server failed.
+var internalError = errorDetail{Code: "internal_error", Message: "an unexpected error occurred"}
@@ -17,9 +17,7 @@ func NewMaxBodySizeHandler(limit int64) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.ContentLength > limit {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusRequestEntityTooLarge)
-				_, _ = w.Write([]byte(`{"error":{"code":"request_too_large","message":"request body exceeds size limit"}}`))
+				writeError(w, http.StatusRequestEntityTooLarge, errorDetail{Code: "request_too_large", Message: "request body exceeds size limit"})
 				return
 			}
 			r.Body = http.MaxBytesReader(w, r.Body, limit)
@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// OpenAPIValidatorOptions controls what NewOpenAPIValidator checks.
+type OpenAPIValidatorOptions struct {
+	// ValidateRequests rejects requests that do not match the spec with
+	// 400 Bad Request before they reach the handler.
+	ValidateRequests bool
+
+	// ValidateResponses checks every response against the spec (status code,
+	// content type, and body schema) and reports mismatches to
+	// OnResponseError. The response is still sent to the client unchanged —
+	// drift is a bug to fix, not a reason to fail the caller.
+	ValidateResponses bool
+
+	// OnResponseError is called for each response that does not match the
+	// spec. Required when ValidateResponses is set.
+	OnResponseError func(r *http.Request, status int, err error)
+}
+
+// GeoJSON is JSON under its own media type, which the validator does not
+// know by default.
+func init() {
+	openapi3filter.RegisterBodyDecoder("application/geo+json", openapi3filter.JSONBodyDecoder)
+}
+
+// NewOpenAPIValidator returns middleware that validates traffic against the
+// given OpenAPI document (normally spec.OpenAPI).
+//
+// It exists to catch drift between the handlers and the spec — a field the
+// handler forgets to set, a status code the spec never declared — which the
+// generated types alone cannot prevent. Response validation buffers each
+// response in memory, so this is meant for tests and local development, not
+// production traffic.
+//
+// Requests whose path is not in the spec (e.g. /docs) pass through untouched.
+func NewOpenAPIValidator(specYAML []byte, opts OpenAPIValidatorOptions) (func(http.Handler) http.Handler, error) {
+	if opts.ValidateResponses && opts.OnResponseError == nil {
+		return nil, errors.New("middleware.NewOpenAPIValidator: OnResponseError is required when validating responses")
+	}
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(specYAML)
+	if err != nil {
+		return nil, fmt.Errorf("middleware.NewOpenAPIValidator: load spec: %w", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("middleware.NewOpenAPIValidator: invalid spec: %w", err)
+	}
+	// Match on path only — the spec's servers list (if any) describes
+	// deployments, and validation must work on any host, including httptest.
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("middleware.NewOpenAPIValidator: build router: %w", err)
+	}
+
+	filterOpts := &openapi3filter.Options{
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		IncludeResponseStatus: true,
+		MultiError:            true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				// Unknown path or method — not the validator's business.
+				// The router's own 404/405 handling applies.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			reqInput := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    filterOpts,
+			}
+
+			if opts.ValidateRequests {
+				// ValidateRequest consumes the body; keep a copy for the handler.
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					writeError(w, http.StatusBadRequest, errorDetail{Code: "invalid_request", Message: err.Error()})
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+				if err := openapi3filter.ValidateRequest(r.Context(), reqInput); err != nil {
+					writeError(w, http.StatusBadRequest, errorDetail{Code: "invalid_request", Message: err.Error()})
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			if !opts.ValidateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			// The request body has been consumed by the handler by now; the
+			// response check only needs the route and parameters.
+			respInput := &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: reqInput,
+				Status:                 rec.status,
+				Header:                 rec.header,
+				Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+				Options:                filterOpts,
+			}
+			if err := openapi3filter.ValidateResponse(context.WithoutCancel(r.Context()), respInput); err != nil {
+				opts.OnResponseError(r, rec.status, err)
+			}
+
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+		})
+	}, nil
+}
+
+// bufferedResponse captures a response so it can be validated before being
+// written to the real ResponseWriter. Headers are shared with the real writer.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+	wrote  bool
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.wrote {
+		return
+	}
+	b.status = status
+	b.wrote = true
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	b.wrote = true
+	return b.body.Write(p)
+}
@@ -0,0 +1,144 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+	"github.com/pkordes/rv-logbook/backend/spec"
+)
+
+// jsonHandler returns an http.Handler that always writes status and body as JSON.
+func jsonHandler(status int, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+// responseValidator builds a response-only validator that records every
+// mismatch it reports.
+func responseValidator(t *testing.T) (func(http.Handler) http.Handler, *[]error) {
+	t.Helper()
+	var drift []error
+	mw, err := middleware.NewOpenAPIValidator(spec.OpenAPI, middleware.OpenAPIValidatorOptions{
+		ValidateResponses: true,
+		OnResponseError: func(_ *http.Request, _ int, err error) {
+			drift = append(drift, err)
+		},
+	})
+	require.NoError(t, err)
+	return mw, &drift
+}
+
+// TestOpenAPIValidator_ConformingResponse_NoDrift verifies that a response
+// matching the spec is passed through without being reported.
+func TestOpenAPIValidator_ConformingResponse_NoDrift(t *testing.T) {
+	mw, drift := responseValidator(t)
+	h := mw(jsonHandler(http.StatusOK, `{"data":[],"pagination":{"page":1,"limit":20,"total":0,"default_limit":20,"max_limit":100}}`))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, *drift)
+}
+
+// TestOpenAPIValidator_MissingRequiredField_ReportsDrift verifies the class of
+// bug this middleware exists for: a list response without its pagination block.
+// The client still receives the handler's response unchanged.
+func TestOpenAPIValidator_MissingRequiredField_ReportsDrift(t *testing.T) {
+	mw, drift := responseValidator(t)
+	h := mw(jsonHandler(http.StatusOK, `{"data":[]}`))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips", nil))
+
+	require.Len(t, *drift, 1)
+	assert.Contains(t, (*drift)[0].Error(), "pagination")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":[]}`, rec.Body.String())
+}
+
+// TestOpenAPIValidator_UndeclaredStatus_ReportsDrift verifies that a status
+// code the spec does not list for the operation is reported.
+func TestOpenAPIValidator_UndeclaredStatus_ReportsDrift(t *testing.T) {
+	mw, drift := responseValidator(t)
+	h := mw(jsonHandler(http.StatusTeapot, `{}`))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Len(t, *drift, 1)
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+}
+
+// TestOpenAPIValidator_UnknownPath_PassesThrough verifies that routes outside
+// the spec (e.g. /docs) are neither validated nor blocked.
+func TestOpenAPIValidator_UnknownPath_PassesThrough(t *testing.T) {
+	mw, drift := responseValidator(t)
+	h := mw(jsonHandler(http.StatusOK, `not json at all`))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, *drift)
+}
+
+// TestOpenAPIValidator_InvalidRequest_Returns400 verifies that with request
+// validation on, a body missing a required field never reaches the handler.
+func TestOpenAPIValidator_InvalidRequest_Returns400(t *testing.T) {
+	mw, err := middleware.NewOpenAPIValidator(spec.OpenAPI, middleware.OpenAPIValidatorOptions{ValidateRequests: true})
+	require.NoError(t, err)
+
+	called := false
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { called = true }))
+
+	req := httptest.NewRequest(http.MethodPost, "/trips", strings.NewReader(`{"name":"No start date"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"invalid_request"`)
+	assert.False(t, called, "handler must not run for an invalid request")
+}
+
+// TestOpenAPIValidator_ValidRequest_BodyStillReadable verifies that validating
+// the request body does not consume it before the handler runs.
+func TestOpenAPIValidator_ValidRequest_BodyStillReadable(t *testing.T) {
+	mw, err := middleware.NewOpenAPIValidator(spec.OpenAPI, middleware.OpenAPIValidatorOptions{ValidateRequests: true})
+	require.NoError(t, err)
+
+	const body = `{"name":"Summer Tour","start_date":"2025-06-01"}`
+	var got string
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		got = string(b)
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/trips", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, body, got)
+}
+
+// TestOpenAPIValidator_ResponsesWithoutCallback_Errors verifies that response
+// validation cannot be enabled without somewhere to report drift.
+func TestOpenAPIValidator_ResponsesWithoutCallback_Errors(t *testing.T) {
+	_, err := middleware.NewOpenAPIValidator(spec.OpenAPI, middleware.OpenAPIValidatorOptions{ValidateResponses: true})
+
+	assert.Error(t, err)
+}
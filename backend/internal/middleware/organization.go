@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// OrganizationHeader names the organization a request acts for.
+const OrganizationHeader = "X-Organization-ID"
+
+// OrganizationLookup is what NewOrganizationHandler needs to check the
+// organization a request names. repo.OrganizationRepo satisfies it.
+type OrganizationLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error)
+	GetMember(ctx context.Context, orgID uuid.UUID, userID string) (domain.Member, error)
+}
+
+// NewOrganizationHandler returns middleware that makes the request act for
+// the organization named in the X-Organization-ID header, so the repos
+// scope every query to it. Requests without the header act for the default
+// organization.
+//
+// A malformed ID is rejected with 400 and an unknown organization with 404.
+// When the request was authenticated, the user it acts for must be a
+// member of the organization, or it is rejected with 403. For an API key
+// that is the user who created the key, so a key reaches only the
+// organizations its owner belongs to. Wire it after the authentication
+// middleware.
+func NewOrganizationHandler(orgs OrganizationLookup) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get(OrganizationHeader)
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			id, err := uuid.Parse(header)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, errorDetail{Code: "validation_error", Message: "X-Organization-ID must be a UUID"})
+				return
+			}
+			ctx := r.Context()
+			if _, err := orgs.GetByID(ctx, id); err != nil {
+				if errors.Is(err, domain.ErrNotFound) {
+					writeError(w, http.StatusNotFound, errorDetail{Code: "not_found", Message: "organization not found"})
+					return
+				}
+				writeError(w, http.StatusInternalServerError, internalError)
+				return
+			}
+			if actor, ok := auth.ActorFromContext(ctx); ok {
+				if _, err := orgs.GetMember(ctx, id, actor.UserID); err != nil {
+					if errors.Is(err, domain.ErrNotFound) {
+						writeError(w, http.StatusForbidden, errorDetail{Code: "forbidden", Message: "you are not a member of this organization"})
+						return
+					}
+					writeError(w, http.StatusInternalServerError, internalError)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(domain.WithOrganization(ctx, id)))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// fakeOrganizations knows one organization with one member.
+type fakeOrganizations struct {
+	id     uuid.UUID
+	member string
+}
+
+func (f fakeOrganizations) GetByID(_ context.Context, id uuid.UUID) (domain.Organization, error) {
+	if id != f.id {
+		return domain.Organization{}, domain.ErrNotFound
+	}
+	return domain.Organization{ID: id}, nil
+}
+
+func (f fakeOrganizations) GetMember(_ context.Context, orgID uuid.UUID, userID string) (domain.Member, error) {
+	if orgID != f.id || userID != f.member {
+		return domain.Member{}, domain.ErrNotFound
+	}
+	return domain.Member{OrganizationID: orgID, UserID: userID, Role: domain.RoleMember}, nil
+}
+
+// serveOrganization runs a request with the given header value and actor
+// through the middleware, and returns the response and the organization the
+// next handler saw.
+func serveOrganization(t *testing.T, orgs fakeOrganizations, header string, actor *auth.Actor) (*httptest.ResponseRecorder, uuid.UUID) {
+	t.Helper()
+	var seen uuid.UUID
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = domain.OrganizationFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	if header != "" {
+		req.Header.Set(middleware.OrganizationHeader, header)
+	}
+	if actor != nil {
+		req = req.WithContext(auth.WithActor(req.Context(), *actor))
+	}
+	rec := httptest.NewRecorder()
+	middleware.NewOrganizationHandler(orgs)(next).ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func TestOrganizationHandler(t *testing.T) {
+	orgs := fakeOrganizations{id: uuid.New(), member: "user-1"}
+
+	tests := []struct {
+		name     string
+		header   string
+		actor    *auth.Actor
+		wantCode int
+		wantOrg  uuid.UUID
+	}{
+		{"no header acts for the default organization", "", nil, http.StatusOK, domain.DefaultOrganizationID},
+		{"named organization", orgs.id.String(), nil, http.StatusOK, orgs.id},
+		{"member", orgs.id.String(), &auth.Actor{Type: auth.ActorUser, ID: "user-1", UserID: "user-1"}, http.StatusOK, orgs.id},
+		{"api key of a member", orgs.id.String(), &auth.Actor{Type: auth.ActorAPIKey, ID: "key-1", UserID: "user-1"}, http.StatusOK, orgs.id},
+		{"api key of a non-member", orgs.id.String(), &auth.Actor{Type: auth.ActorAPIKey, ID: "key-2", UserID: "user-2"}, http.StatusForbidden, uuid.Nil},
+		{"malformed id", "not-a-uuid", nil, http.StatusBadRequest, uuid.Nil},
+		{"unknown organization", uuid.NewString(), nil, http.StatusNotFound, uuid.Nil},
+		{"non-member", orgs.id.String(), &auth.Actor{Type: auth.ActorUser, ID: "user-2", UserID: "user-2"}, http.StatusForbidden, uuid.Nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec, seen := serveOrganization(t, orgs, tc.header, tc.actor)
+
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.Equal(t, tc.wantOrg, seen)
+			if tc.wantCode != http.StatusOK {
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// maxStackFrames caps how much of a panicking goroutine's stack is logged.
+const maxStackFrames = 32
+
+// NewRecoverer returns middleware that turns a panic in a downstream handler
+// into a 500 with the standard error envelope, carrying the request ID so a
+// client can quote it. The panic is logged at error level with the panic
+// value and the stack as a list of "function file:line" frames, and counted
+// in panics, which app.New reports on /metrics.
+//
+// http.ErrAbortHandler is re-panicked: net/http uses it to abort a response
+// on purpose and logs nothing for it.
+//
+// Wire it after chimiddleware.RequestID and NewSlogLogger, so the request ID
+// is set and the 500 appears in the request log.
+func NewRecoverer(log *slog.Logger, panics *atomic.Uint64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+				panics.Add(1)
+
+				ctx := r.Context()
+				reqID := chimiddleware.GetReqID(ctx)
+				log.ErrorContext(ctx, "panic",
+					"panic", fmt.Sprint(v),
+					"stack", stackFrames(),
+					"method", r.Method,
+					"path", r.URL.Path,
+					"request_id", reqID,
+				)
+
+				detail := internalError
+				detail.RequestID = reqID
+				writeError(w, http.StatusInternalServerError, detail)
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// stackFrames returns the stack of the panicking goroutine, innermost call
+// first, starting at the frame that panicked. It must be called from the
+// deferred function that recovered.
+func stackFrames() []string {
+	pcs := make([]uintptr, maxStackFrames)
+	// Skip runtime.Callers, stackFrames, and the deferred function.
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var out []string
+	for {
+		f, more := frames.Next()
+		// The runtime's own panic machinery tells the reader nothing.
+		if !strings.HasPrefix(f.Function, "runtime.") {
+			out = append(out, fmt.Sprintf("%s %s:%d", f.Function, f.File, f.Line))
+		}
+		if !more {
+			return out
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+func TestRecoverer_Panic(t *testing.T) {
+	var buf bytes.Buffer
+	var panics atomic.Uint64
+	h := middleware.NewRecoverer(slog.New(slog.NewJSONHandler(&buf, nil)), &panics)(
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic("tank sensor offline")
+		}),
+	)
+	req := httptest.NewRequest(http.MethodGet, "/tanks", nil)
+	req = req.WithContext(context.WithValue(req.Context(), chimiddleware.RequestIDKey, "test-req-id"))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error":{"code":"internal_error","message":"an unexpected error occurred","request_id":"test-req-id"}}`, rec.Body.String())
+	assert.EqualValues(t, 1, panics.Load())
+
+	var entry struct {
+		Level     string   `json:"level"`
+		Panic     string   `json:"panic"`
+		Stack     []string `json:"stack"`
+		Path      string   `json:"path"`
+		RequestID string   `json:"request_id"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "ERROR", entry.Level)
+	assert.Equal(t, "tank sensor offline", entry.Panic)
+	assert.Equal(t, "/tanks", entry.Path)
+	assert.Equal(t, "test-req-id", entry.RequestID)
+	require.NotEmpty(t, entry.Stack)
+	// The innermost frame is the handler that panicked, not the runtime.
+	assert.True(t, strings.HasPrefix(entry.Stack[0], "github.com/pkordes/rv-logbook/backend/internal/middleware_test.TestRecoverer_Panic"), entry.Stack[0])
+}
+
+func TestRecoverer_NoPanic(t *testing.T) {
+	var buf bytes.Buffer
+	var panics atomic.Uint64
+	h := middleware.NewRecoverer(slog.New(slog.NewJSONHandler(&buf, nil)), &panics)(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Zero(t, panics.Load())
+	assert.Empty(t, buf.String())
+}
+
+func TestRecoverer_AbortHandlerIsRepanicked(t *testing.T) {
+	var panics atomic.Uint64
+	h := middleware.NewRecoverer(slog.New(slog.DiscardHandler), &panics)(
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic(http.ErrAbortHandler)
+		}),
+	)
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+	assert.Zero(t, panics.Load())
+}
@@ -15,11 +15,16 @@ import "net/http"
 //
 // These three headers are the minimum baseline recommended by OWASP's
 // Secure Headers Project for any HTTP API.
-func NewSecurityHeadersHandler() func(http.Handler) http.Handler {
+//
+// Requests for which embeddable reports true are meant to be framed by other
+// sites and get no X-Frame-Options. embeddable may be nil.
+func NewSecurityHeadersHandler(embeddable func(*http.Request) bool) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("X-Content-Type-Options", "nosniff")
-			w.Header().Set("X-Frame-Options", "DENY")
+			if embeddable == nil || !embeddable(r) {
+				w.Header().Set("X-Frame-Options", "DENY")
+			}
 			w.Header().Set("Referrer-Policy", "no-referrer")
 			next.ServeHTTP(w, r)
 		})
@@ -19,7 +19,7 @@ var noopHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request)
 // TestSecurityHeaders_SetsExpectedHeaders verifies that every required defensive
 // header is present on the response regardless of the route or method.
 func TestSecurityHeaders_SetsExpectedHeaders(t *testing.T) {
-	h := middleware.NewSecurityHeadersHandler()(noopHandler)
+	h := middleware.NewSecurityHeadersHandler(nil)(noopHandler)
 
 	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
 	rec := httptest.NewRecorder()
@@ -41,7 +41,7 @@ func TestSecurityHeaders_PassesThroughStatus(t *testing.T) {
 	createdHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusCreated)
 	})
-	h := middleware.NewSecurityHeadersHandler()(createdHandler)
+	h := middleware.NewSecurityHeadersHandler(nil)(createdHandler)
 
 	req := httptest.NewRequest(http.MethodPost, "/trips", nil)
 	rec := httptest.NewRecorder()
@@ -50,3 +50,19 @@ func TestSecurityHeaders_PassesThroughStatus(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, rec.Code,
 		"security headers middleware must not modify the downstream status code")
 }
+
+// TestSecurityHeaders_EmbeddableMayBeFramed verifies that embeddable routes
+// drop X-Frame-Options so other sites can frame them, and keep the rest.
+func TestSecurityHeaders_EmbeddableMayBeFramed(t *testing.T) {
+	embeddable := func(r *http.Request) bool { return r.URL.Path == "/shared/tok/embed" }
+	h := middleware.NewSecurityHeadersHandler(embeddable)(noopHandler)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/tok/embed", nil))
+	assert.Empty(t, rec.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shared/tok", nil))
+	assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+}
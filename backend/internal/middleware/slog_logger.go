@@ -2,36 +2,70 @@
 package middleware
 
 import (
+	"context"
 	"log/slog"
+	"math/rand/v2"
 	"net/http"
 	"time"
 
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
 )
 
 // NewSlogLogger returns a middleware that logs each request as a structured
 // JSON line via the provided slog.Logger. It captures method, path, HTTP
-// status, duration, and the request ID set by chi's RequestID middleware.
+// status, bytes written, duration, user agent, referer, and the request ID set
+// by chi's RequestID middleware. When the request was authenticated,
+// actor_type, actor_id, and user_id are included too.
+//
+// sampleOKPercent is the share of 200 responses that are logged, from 0 to
+// 100; every other status is always logged. Lower it to cut the cost of
+// logging busy read endpoints.
 //
-// Wire it after chimiddleware.RequestID so the request ID is available.
-func NewSlogLogger(log *slog.Logger) func(http.Handler) http.Handler {
+// Wire it after chimiddleware.RequestID so the request ID is available, and
+// before any authentication middleware: it places an empty actor slot in the
+// context that the auth middleware fills in further down the chain.
+func NewSlogLogger(log *slog.Logger, sampleOKPercent int) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 
-			// WrapResponseWriter intercepts WriteHeader so we can read the
-			// status code after the downstream handler has run.
+			// WrapResponseWriter intercepts WriteHeader and Write so we can
+			// read the status code and size after the downstream handler has run.
 			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
 
+			r = r.WithContext(auth.WithActorSlot(r.Context()))
 			next.ServeHTTP(ww, r)
 
-			log.InfoContext(r.Context(), "request",
+			if ww.Status() == http.StatusOK && rand.IntN(100) >= sampleOKPercent {
+				return
+			}
+
+			attrs := []any{
 				"method", r.Method,
 				"path", r.URL.Path,
 				"status", ww.Status(),
+				"bytes", ww.BytesWritten(),
 				"duration_ms", time.Since(start).Milliseconds(),
 				"request_id", chimiddleware.GetReqID(r.Context()),
-			)
+			}
+			if ua := r.UserAgent(); ua != "" {
+				attrs = append(attrs, "user_agent", ua)
+			}
+			if ref := r.Referer(); ref != "" {
+				attrs = append(attrs, "referer", ref)
+			}
+			if a, ok := auth.ActorFromContext(r.Context()); ok {
+				attrs = append(attrs, "actor_type", string(a.Type), "actor_id", a.ID)
+				if a.UserID != "" {
+					attrs = append(attrs, "user_id", a.UserID)
+				}
+			}
+			// Log with a context-free logger call: the actor is already in attrs,
+			// and a context-aware handler (logging.NewContextHandler) would
+			// otherwise append it a second time.
+			log.Log(context.Background(), slog.LevelInfo, "request", attrs...)
 		})
 	}
 }
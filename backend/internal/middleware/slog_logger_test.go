@@ -12,6 +12,7 @@ import (
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
 	"github.com/pkordes/rv-logbook/backend/internal/middleware"
 )
 
@@ -23,7 +24,7 @@ func TestSlogLogger_logsRequestFields(t *testing.T) {
 	logger := slog.New(slog.NewJSONHandler(&buf, nil))
 
 	// Wrap the SlogLogger around a trivial 200 handler.
-	h := middleware.NewSlogLogger(logger)(
+	h := middleware.NewSlogLogger(logger, 100)(
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusOK)
 		}),
@@ -51,3 +52,92 @@ func TestSlogLogger_logsRequestFields(t *testing.T) {
 	require.Equal(t, "test-req-id", logEntry["request_id"])
 	require.NotNil(t, logEntry["duration_ms"])
 }
+
+// TestSlogLogger_logsActorSetDownstream verifies that an actor attached by
+// middleware running *inside* the logger (i.e. the auth middleware) still
+// appears on the request log line.
+func TestSlogLogger_logsActorSetDownstream(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	h := middleware.NewSlogLogger(logger, 100)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Simulate the auth middleware: it does not pass the new context
+			// back up the chain, it only fills the slot.
+			auth.WithActor(r.Context(), auth.Actor{Type: auth.ActorUser, ID: "user-123"})
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/trips/1", nil))
+
+	var logEntry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &logEntry))
+	require.Equal(t, "user", logEntry["actor_type"])
+	require.Equal(t, "user-123", logEntry["actor_id"])
+}
+
+// TestSlogLogger_anonymousOmitsActor verifies that unauthenticated requests
+// carry no actor fields rather than empty strings.
+func TestSlogLogger_anonymousOmitsActor(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	h := middleware.NewSlogLogger(logger, 100)(trivialHandler)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	var logEntry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &logEntry))
+	require.NotContains(t, logEntry, "actor_id")
+}
+
+// TestSlogLogger_logsClientAndSize verifies that the request line carries the
+// response size, user agent, referer, and the user the actor acts for.
+func TestSlogLogger_logsClientAndSize(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	h := middleware.NewSlogLogger(logger, 100)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth.WithActor(r.Context(), auth.Actor{Type: auth.ActorAPIKey, ID: "key-1", UserID: "user-123"})
+			_, _ = w.Write([]byte("hello"))
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	req.Header.Set("User-Agent", "rvctl/1.0")
+	req.Header.Set("Referer", "https://blog.example.com/")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	var logEntry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &logEntry))
+	require.EqualValues(t, 5, logEntry["bytes"])
+	require.Equal(t, "rvctl/1.0", logEntry["user_agent"])
+	require.Equal(t, "https://blog.example.com/", logEntry["referer"])
+	require.Equal(t, "key-1", logEntry["actor_id"])
+	require.Equal(t, "user-123", logEntry["user_id"])
+}
+
+// TestSlogLogger_samplesOnlyOK verifies that sampling drops 200s but never
+// any other status.
+func TestSlogLogger_samplesOnlyOK(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	status := http.StatusOK
+	h := middleware.NewSlogLogger(logger, 0)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}),
+	)
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/trips", nil))
+	require.Zero(t, buf.Len(), "a 200 is sampled out")
+
+	status = http.StatusNotFound
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/trips/1", nil))
+	var logEntry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &logEntry))
+	require.EqualValues(t, http.StatusNotFound, logEntry["status"])
+}
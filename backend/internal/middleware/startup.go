@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// StartupGate keeps traffic away from an instance that is listening but not
+// ready to serve yet. Until Open is called, only the requests allow accepts
+// (the liveness probe) reach the router; every other request is answered
+// 503 with Retry-After, so load balancers and clients try again rather
+// than hitting a half-ready instance.
+type StartupGate struct {
+	allow func(*http.Request) bool
+	open  atomic.Bool
+}
+
+// NewStartupGate returns a closed gate that lets allow's requests through.
+func NewStartupGate(allow func(*http.Request) bool) *StartupGate {
+	return &StartupGate{allow: allow}
+}
+
+// Open lets every request through from now on. It is safe to call more than
+// once and from any goroutine.
+func (g *StartupGate) Open() { g.open.Store(true) }
+
+// IsOpen reports whether Open has been called.
+func (g *StartupGate) IsOpen() bool { return g.open.Load() }
+
+// Handler wraps next in the gate.
+func (g *StartupGate) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !g.open.Load() && !g.allow(r) {
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusServiceUnavailable, errorDetail{Code: "starting_up", Message: "the server is starting up"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,39 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+func isLivez(r *http.Request) bool { return r.URL.Path == "/livez" }
+
+// TestStartupGate_ServesOnlyLivenessUntilOpen verifies that a closed gate
+// answers 503 to everything but the allowed routes, and opening it lets the
+// rest through.
+func TestStartupGate_ServesOnlyLivenessUntilOpen(t *testing.T) {
+	gate := middleware.NewStartupGate(isLivez)
+	h := gate.Handler(trivialHandler)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"error":{"code":"starting_up","message":"the server is starting up"}}`, rec.Body.String())
+	assert.False(t, gate.IsOpen())
+
+	gate.Open()
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/trips", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, gate.IsOpen())
+}
@@ -0,0 +1,18 @@
+package middleware
+
+import "net/http"
+
+// NewVaryHandler returns a middleware that adds headers to the Vary header of
+// every response, so that caches key responses on the request headers that
+// change them. It adds rather than sets, leaving the Origin that CORS varies
+// on in place.
+func NewVaryHandler(headers ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, h := range headers {
+				w.Header().Add("Vary", h)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+)
+
+// TestVaryHandler_AddsHeaders verifies the listed headers are added to Vary
+// alongside any the rest of the chain sets.
+func TestVaryHandler_AddsHeaders(t *testing.T) {
+	h := middleware.NewCORSHandler([]string{"http://localhost:5173"}, nil)(
+		middleware.NewVaryHandler("Units")(trivialHandler))
+
+	req := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	req.Header.Set("Origin", "http://localhost:5173")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"Origin", "Units"}, rec.Header().Values("Vary"))
+}
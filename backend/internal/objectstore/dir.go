@@ -0,0 +1,97 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Dir is a Store backed by a directory tree. Each key becomes a file under
+// the root, with intermediate directories created as needed.
+type Dir struct {
+	root string
+}
+
+var _ Store = (*Dir)(nil)
+
+// NewDir returns a Store rooted at root, creating the directory if it does
+// not exist.
+func NewDir(root string) (*Dir, error) {
+	if root == "" {
+		return nil, errors.New("objectstore.NewDir: root is required")
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("objectstore.NewDir: %w", err)
+	}
+	return &Dir{root: root}, nil
+}
+
+// Put writes r to a temporary file beside the target and renames it into
+// place, so readers never see a partly written object.
+func (d *Dir) Put(_ context.Context, key string, r io.Reader) error {
+	target, err := d.path(key)
+	if err != nil {
+		return fmt.Errorf("objectstore.Dir.Put: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return fmt.Errorf("objectstore.Dir.Put: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("objectstore.Dir.Put: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("objectstore.Dir.Put: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("objectstore.Dir.Put: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("objectstore.Dir.Put: %w", err)
+	}
+	return nil
+}
+
+// Get opens the file for key.
+func (d *Dir) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	p, err := d.path(key)
+	if err != nil {
+		return nil, fmt.Errorf("objectstore.Dir.Get: %w", err)
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("objectstore.Dir.Get: %w", ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("objectstore.Dir.Get: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes the file for key.
+func (d *Dir) Delete(_ context.Context, key string) error {
+	p, err := d.path(key)
+	if err != nil {
+		return fmt.Errorf("objectstore.Dir.Delete: %w", err)
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("objectstore.Dir.Delete: %w", err)
+	}
+	return nil
+}
+
+// path maps a key to its file, rejecting keys that would escape the root.
+func (d *Dir) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return filepath.Join(d.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,64 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Memory is a Store that keeps objects in a map. Objects are lost when the
+// process exits; it is safe for concurrent use.
+type Memory struct {
+	mu      sync.RWMutex
+	objects map[string][]byte
+}
+
+var _ Store = (*Memory)(nil)
+
+// NewMemory returns an empty in-memory Store.
+func NewMemory() *Memory {
+	return &Memory{objects: map[string][]byte{}}
+}
+
+// Put reads r fully and stores a copy of its content.
+func (m *Memory) Put(_ context.Context, key string, r io.Reader) error {
+	if !validKey(key) {
+		return fmt.Errorf("objectstore.Memory.Put: %w: %q", ErrInvalidKey, key)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("objectstore.Memory.Put: %w", err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = data
+	return nil
+}
+
+// Get returns a reader over the stored content.
+func (m *Memory) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("objectstore.Memory.Get: %w", ErrNotFound)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Delete removes the object, if any.
+func (m *Memory) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+// Len returns the number of stored objects.
+func (m *Memory) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.objects)
+}
@@ -0,0 +1,85 @@
+// Package objectstore keeps uploaded files — GPX tracks, photos — out of
+// Postgres. Rows store only an object's key; the bytes live in a Store.
+//
+// Keys are slash-separated relative paths such as "tracks/<trip>/<leg>.gpx".
+// Three implementations ship: Dir, backed by a directory (a local disk or a
+// mounted volume); S3, backed by an S3 bucket or an S3-compatible service;
+// and Memory, for tests and for running without configured storage.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// ErrNotFound is returned by Get when no object has the key.
+var ErrNotFound = errors.New("objectstore: object not found")
+
+// ErrInvalidKey is returned for keys that are empty, absolute, or try to
+// leave the store with "..".
+var ErrInvalidKey = errors.New("objectstore: invalid key")
+
+// Store saves and retrieves objects by key.
+type Store interface {
+	// Put stores the content of r under key, replacing any existing object.
+	// A failed Put leaves any previous object in place.
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Get opens the object stored under key. The caller closes it.
+	// Returns ErrNotFound if there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key. Deleting a missing object
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// ProbeKey is the object Probe writes and removes.
+const ProbeKey = "health/probe"
+
+// Probe checks that s can store, read back, and delete an object, using
+// ProbeKey. The health check calls it to catch a full or read-only volume.
+func Probe(ctx context.Context, s Store) error {
+	want := []byte("ok")
+	if err := s.Put(ctx, ProbeKey, bytes.NewReader(want)); err != nil {
+		return fmt.Errorf("objectstore.Probe: %w", err)
+	}
+	rc, err := s.Get(ctx, ProbeKey)
+	if err != nil {
+		return fmt.Errorf("objectstore.Probe: %w", err)
+	}
+	got, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		return fmt.Errorf("objectstore.Probe: %w", err)
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("objectstore.Probe: read back %q, wrote %q", got, want)
+	}
+	if err := s.Delete(ctx, ProbeKey); err != nil {
+		return fmt.Errorf("objectstore.Probe: %w", err)
+	}
+	return nil
+}
+
+// validKey reports whether key is a clean relative path that stays inside
+// the store.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	if path.Clean(key) != key {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == ".." || part == "." {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package objectstore_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+)
+
+// stores runs each test against every implementation.
+func stores(t *testing.T) map[string]objectstore.Store {
+	dir, err := objectstore.NewDir(filepath.Join(t.TempDir(), "objects"))
+	require.NoError(t, err)
+	s3, _ := newFakeS3Store(t)
+	return map[string]objectstore.Store{"dir": dir, "memory": objectstore.NewMemory(), "s3": s3}
+}
+
+func read(t *testing.T, s objectstore.Store, key string) string {
+	t.Helper()
+	rc, err := s.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestStore_PutGetReplaceDelete(t *testing.T) {
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "tracks/trip/leg.gpx"
+
+			require.NoError(t, s.Put(ctx, key, strings.NewReader("first")))
+			assert.Equal(t, "first", read(t, s, key))
+
+			require.NoError(t, s.Put(ctx, key, strings.NewReader("second")))
+			assert.Equal(t, "second", read(t, s, key))
+
+			require.NoError(t, s.Delete(ctx, key))
+			_, err := s.Get(ctx, key)
+			assert.True(t, errors.Is(err, objectstore.ErrNotFound), "got %v", err)
+
+			assert.NoError(t, s.Delete(ctx, key), "deleting a missing object is not an error")
+		})
+	}
+}
+
+func TestStore_RejectsEscapingKeys(t *testing.T) {
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"", "/etc/passwd", "../outside", "tracks/../../outside", "a//b", `a\b`, "./a"} {
+				err := s.Put(context.Background(), key, strings.NewReader("x"))
+				assert.True(t, errors.Is(err, objectstore.ErrInvalidKey), "%q: got %v", key, err)
+			}
+		})
+	}
+}
+
+func TestDir_FailedPutKeepsPreviousObject(t *testing.T) {
+	s, err := objectstore.NewDir(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, s.Put(ctx, "a.gpx", strings.NewReader("good")))
+
+	err = s.Put(ctx, "a.gpx", io.MultiReader(strings.NewReader("partial"), errReader{}))
+
+	require.Error(t, err)
+	assert.Equal(t, "good", read(t, s, "a.gpx"))
+}
+
+func TestNewDir_RequiresRoot(t *testing.T) {
+	_, err := objectstore.NewDir("")
+	assert.Error(t, err)
+}
+
+func TestNewDir_CreatesRoot(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "nested", "objects")
+
+	_, err := objectstore.NewDir(root)
+
+	require.NoError(t, err)
+	info, err := os.Stat(root)
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestProbe(t *testing.T) {
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			require.NoError(t, objectstore.Probe(ctx, s))
+
+			_, err := s.Get(ctx, objectstore.ProbeKey)
+			assert.True(t, errors.Is(err, objectstore.ErrNotFound), "probe object left behind: %v", err)
+		})
+	}
+}
+
+func TestProbe_ReadOnlyDir(t *testing.T) {
+	if os.Getuid() == 0 {
+		t.Skip("root can write to a read-only directory")
+	}
+	root := filepath.Join(t.TempDir(), "objects")
+	dir, err := objectstore.NewDir(root)
+	require.NoError(t, err)
+	require.NoError(t, os.Chmod(root, 0o500))
+	t.Cleanup(func() { _ = os.Chmod(root, 0o700) })
+
+	assert.Error(t, objectstore.Probe(context.Background(), dir))
+}
@@ -0,0 +1,264 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config says which bucket an S3 store uses and how to reach it.
+type S3Config struct {
+	// Bucket is the bucket objects are kept in. Required.
+	Bucket string
+
+	// Region is the bucket's region. Defaults to us-east-1.
+	Region string
+
+	// Endpoint is the base URL of an S3-compatible service such as MinIO,
+	// e.g. "http://localhost:9000". Requests to it address the bucket in
+	// the path. Leave empty for AWS, where the bucket is addressed by host.
+	Endpoint string
+
+	// AccessKeyID and SecretAccessKey are the credentials requests are
+	// signed with. Required.
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// SessionToken accompanies temporary credentials. Optional.
+	SessionToken string
+
+	// Client sends the requests. Defaults to a client with a one-minute timeout.
+	Client *http.Client
+
+	// Now is the clock requests are signed at. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// S3 is a Store backed by an S3 bucket, or any service that speaks the S3
+// API. Requests are signed with AWS Signature Version 4.
+type S3 struct {
+	cfg  S3Config
+	base *url.URL
+}
+
+var _ Store = (*S3)(nil)
+
+// emptyPayloadHash is the SHA-256 of an empty body, signed for GET and DELETE.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// NewS3 returns a Store that keeps objects in the configured bucket. It
+// does not contact the service; the health check's Probe does.
+func NewS3(cfg S3Config) (*S3, error) {
+	if cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("objectstore.NewS3: bucket, access key ID, and secret access key are required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: time.Minute}
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	var base *url.URL
+	if cfg.Endpoint != "" {
+		u, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("objectstore.NewS3: endpoint %q is not an absolute URL", cfg.Endpoint)
+		}
+		u.Path += "/" + cfg.Bucket
+		base = u
+	} else {
+		base = &url.URL{Scheme: "https", Host: cfg.Bucket + ".s3." + cfg.Region + ".amazonaws.com"}
+	}
+	return &S3{cfg: cfg, base: base}, nil
+}
+
+// Put uploads the content of r. The body is read into memory first: S3
+// needs its length up front, and the signature covers its hash. S3 replaces
+// objects atomically, so a failed Put leaves the previous object in place.
+func (s *S3) Put(ctx context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("objectstore.S3.Put: %w", err)
+	}
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return fmt.Errorf("objectstore.S3.Put: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("objectstore.S3.Put: %w", statusError(resp))
+	}
+	return nil
+}
+
+// Get streams the object's content.
+func (s *S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("objectstore.S3.Get: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("objectstore.S3.Get: %w", ErrNotFound)
+	}
+	defer resp.Body.Close()
+	return nil, fmt.Errorf("objectstore.S3.Get: %w", statusError(resp))
+}
+
+// Delete removes the object. S3 reports success for a missing key too.
+func (s *S3) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return fmt.Errorf("objectstore.S3.Delete: %w", err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	return fmt.Errorf("objectstore.S3.Delete: %w", statusError(resp))
+}
+
+// do sends a signed request for key with body, which may be nil.
+func (s *S3) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	if !validKey(key) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	u := *s.base
+	u.Path += "/" + key
+	u.RawPath = encodePath(u.Path)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		sum := sha256.Sum256(body)
+		payloadHash = hex.EncodeToString(sum[:])
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+	s.sign(req, payloadHash, s.cfg.Now())
+	return s.cfg.Client.Do(req)
+}
+
+// sign adds the X-Amz-Date and Authorization headers of AWS Signature
+// Version 4 to req, covering its host and every header already set.
+func (s *S3) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := day + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex(canonicalRequest)
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+s.cfg.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// canonicalQuery encodes a query string as Signature Version 4 expects:
+// sorted by name, then value, with every reserved character escaped.
+func canonicalQuery(q url.Values) string {
+	var pairs []string
+	for name, values := range q {
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(name, true)+"="+uriEncode(v, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// encodePath escapes each segment of an object path for the request line
+// and the signature alike.
+func encodePath(p string) string {
+	return uriEncode(p, false)
+}
+
+// uriEncode escapes everything but unreserved characters, and slashes too
+// when encodeSlash is set, as Signature Version 4 requires.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// statusError describes an unexpected response, with the start of its body,
+// where S3 puts the error code.
+func statusError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
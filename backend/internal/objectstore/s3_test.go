@@ -0,0 +1,163 @@
+package objectstore_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+)
+
+// fakeS3 serves the slice of the S3 API the store uses, for one bucket,
+// rejecting requests whose payload hash does not match their body. It does
+// not check signatures; TestS3_SignsRequests does.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, ok := strings.CutPrefix(r.URL.Path, "/logbook/")
+	if !ok {
+		http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+		http.Error(w, "<Error><Code>XAmzContentSHA256Mismatch</Code></Error>", http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = body
+	case http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// newFakeS3Store returns an S3 store pointed at a fresh fakeS3.
+func newFakeS3Store(t *testing.T) (*objectstore.S3, *fakeS3) {
+	t.Helper()
+	fake := &fakeS3{objects: map[string][]byte{}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	s, err := objectstore.NewS3(objectstore.S3Config{
+		Bucket:          "logbook",
+		Endpoint:        srv.URL,
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	})
+	require.NoError(t, err)
+	return s, fake
+}
+
+// roundTripFunc lets a test see the request a store would send.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestS3_SignsRequests(t *testing.T) {
+	var sent *http.Request
+	s, err := objectstore.NewS3(objectstore.S3Config{
+		Bucket:          "logbook",
+		Region:          "us-west-2",
+		Endpoint:        "http://minio.test:9000",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		Client: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			sent = r
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		})},
+		Now: func() time.Time { return time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) },
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Put(context.Background(), "photos/a b.jpg", strings.NewReader("hello")))
+
+	require.NotNil(t, sent)
+	assert.Equal(t, "/logbook/photos/a%20b.jpg", sent.URL.EscapedPath())
+	assert.Equal(t, "20261017T120000Z", sent.Header.Get("X-Amz-Date"))
+	assert.EqualValues(t, 5, sent.ContentLength)
+	// Computed independently of this package from the same request.
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20261017/us-west-2/s3/aws4_request, "+
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date, "+
+		"Signature=13960900fdb15b8f7dfa93f45d7c4b9a9221a0508d2884b5f96fbaf63e172bab",
+		sent.Header.Get("Authorization"))
+}
+
+func TestS3_VirtualHostedWithoutEndpoint(t *testing.T) {
+	var sent *http.Request
+	s, err := objectstore.NewS3(objectstore.S3Config{
+		Bucket:          "logbook",
+		Region:          "eu-west-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		SessionToken:    "session",
+		Client: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			sent = r
+			return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+		})},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Delete(context.Background(), "photos/a.jpg"))
+
+	assert.Equal(t, "https://logbook.s3.eu-west-1.amazonaws.com/photos/a.jpg", sent.URL.String())
+	assert.Equal(t, "session", sent.Header.Get("X-Amz-Security-Token"))
+	assert.Contains(t, sent.Header.Get("Authorization"), "SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-security-token,")
+}
+
+func TestS3_ErrorStatus(t *testing.T) {
+	s, err := objectstore.NewS3(objectstore.S3Config{
+		Bucket:          "logbook",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		Client: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusForbidden,
+				Status:     "403 Forbidden",
+				Body:       io.NopCloser(strings.NewReader("<Error><Code>AccessDenied</Code></Error>")),
+			}, nil
+		})},
+	})
+	require.NoError(t, err)
+
+	err = s.Put(context.Background(), "photos/a.jpg", strings.NewReader("x"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessDenied")
+	_, err = s.Get(context.Background(), "photos/a.jpg")
+	assert.False(t, errors.Is(err, objectstore.ErrNotFound), "a refusal is not a missing object: %v", err)
+}
+
+func TestNewS3_Validates(t *testing.T) {
+	_, err := objectstore.NewS3(objectstore.S3Config{Bucket: "logbook"})
+	assert.Error(t, err, "credentials are required")
+
+	_, err = objectstore.NewS3(objectstore.S3Config{
+		Bucket: "logbook", AccessKeyID: "a", SecretAccessKey: "b", Endpoint: "minio:9000",
+	})
+	assert.Error(t, err, "an endpoint needs a scheme")
+}
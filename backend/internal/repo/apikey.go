@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// APIKeyRepo defines the persistence operations for API keys. Keys belong to
+// the request's user, read with domain.UserFromContext, rather than to an
+// organization; an anonymous request has none.
+type APIKeyRepo interface {
+	// Create inserts a key owned by the request's user and returns the
+	// persisted record. key.UserID is ignored.
+	Create(ctx context.Context, key domain.APIKey) (domain.APIKey, error)
+
+	// GetByHash retrieves a key by the hash of the key, whoever owns it.
+	// Returns domain.ErrNotFound if there is no such key.
+	GetByHash(ctx context.Context, keyHash string) (domain.APIKey, error)
+
+	// List returns the request's user's keys, oldest first.
+	List(ctx context.Context) ([]domain.APIKey, error)
+
+	// Delete removes one of the request's user's keys. Returns
+	// domain.ErrNotFound if it does not exist or is someone else's.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgAPIKeyRepo is the Postgres implementation of APIKeyRepo.
+type pgAPIKeyRepo struct {
+	db db
+}
+
+// NewAPIKeyRepo constructs an APIKeyRepo backed by the provided db connection.
+func NewAPIKeyRepo(db db) APIKeyRepo {
+	return &pgAPIKeyRepo{db: db}
+}
+
+const apiKeyColumns = `id, user_id, name, prefix, key_hash, created_at`
+
+// Create inserts the key row.
+func (r *pgAPIKeyRepo) Create(ctx context.Context, key domain.APIKey) (domain.APIKey, error) {
+	const q = `
+		INSERT INTO api_keys (user_id, name, prefix, key_hash)
+		VALUES (@user_id, @name, @prefix, @key_hash)
+		RETURNING ` + apiKeyColumns
+
+	result, err := scanAPIKey(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"name":     key.Name,
+		"prefix":   key.Prefix,
+		"key_hash": key.KeyHash,
+	})))
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("repo.APIKeyRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByHash looks the key up through the unique key_hash.
+func (r *pgAPIKeyRepo) GetByHash(ctx context.Context, keyHash string) (domain.APIKey, error) {
+	const q = `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = @key_hash`
+
+	result, err := scanAPIKey(r.db.QueryRow(ctx, q, pgx.NamedArgs{"key_hash": keyHash}))
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("repo.APIKeyRepo.GetByHash: %w", err)
+	}
+	return result, nil
+}
+
+// List returns the user's keys by creation time.
+func (r *pgAPIKeyRepo) List(ctx context.Context) ([]domain.APIKey, error) {
+	const q = `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE user_id = @user_id ORDER BY created_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.APIKeyRepo.List: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []domain.APIKey{}
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.APIKeyRepo.List: scan: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.APIKeyRepo.List: rows: %w", err)
+	}
+	return keys, nil
+}
+
+// Delete removes the key if the user owns it.
+func (r *pgAPIKeyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM api_keys WHERE id = @id AND user_id = @user_id`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.APIKeyRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.APIKeyRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// scanAPIKey maps a single api_keys row into a domain.APIKey.
+func scanAPIKey(s scanner) (domain.APIKey, error) {
+	var (
+		k      domain.APIKey
+		id     pgtype.UUID
+		userID pgtype.UUID
+	)
+	if err := s.Scan(&id, &userID, &k.Name, &k.Prefix, &k.KeyHash, &k.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.APIKey{}, domain.ErrNotFound
+		}
+		return domain.APIKey{}, err
+	}
+	k.ID = uuid.UUID(id.Bytes)
+	k.UserID = uuid.UUID(userID.Bytes)
+	return k, nil
+}
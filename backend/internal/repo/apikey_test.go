@@ -0,0 +1,83 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// newAPIKeyTestRepos returns a user and API key repo on one rolled-back
+// transaction.
+func newAPIKeyTestRepos(t *testing.T) (repo.UserRepo, repo.APIKeyRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewUserRepo(tx), repo.NewAPIKeyRepo(tx)
+}
+
+func TestAPIKeyRepo_CreateAndGetByHash(t *testing.T) {
+	users, keys := newAPIKeyTestRepos(t)
+	alice, err := users.Create(context.Background(), domain.User{Username: "alice", PasswordHash: "hash"})
+	require.NoError(t, err)
+	ctx := domain.WithUser(context.Background(), alice.ID)
+
+	created, err := keys.Create(ctx, domain.APIKey{Name: "Pi", Prefix: "rvk_abcde", KeyHash: "hash-1"})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, alice.ID, created.UserID)
+	assert.Equal(t, "Pi", created.Name)
+	assert.Equal(t, "rvk_abcde", created.Prefix)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	got, err := keys.GetByHash(context.Background(), "hash-1")
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+
+	_, err = keys.GetByHash(context.Background(), "hash-2")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestAPIKeyRepo_ScopedToUser(t *testing.T) {
+	users, keys := newAPIKeyTestRepos(t)
+	alice, err := users.Create(context.Background(), domain.User{Username: "alice", PasswordHash: "hash"})
+	require.NoError(t, err)
+	bob, err := users.Create(context.Background(), domain.User{Username: "bob", PasswordHash: "hash"})
+	require.NoError(t, err)
+	asAlice := domain.WithUser(context.Background(), alice.ID)
+	asBob := domain.WithUser(context.Background(), bob.ID)
+
+	first, err := keys.Create(asAlice, domain.APIKey{Name: "Pi", Prefix: "rvk_aaaaa", KeyHash: "hash-1"})
+	require.NoError(t, err)
+	second, err := keys.Create(asAlice, domain.APIKey{Name: "Laptop", Prefix: "rvk_bbbbb", KeyHash: "hash-2"})
+	require.NoError(t, err)
+
+	list, err := keys.List(asAlice)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, first.ID, list[0].ID, "oldest first")
+	assert.Equal(t, second.ID, list[1].ID)
+
+	list, err = keys.List(asBob)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	assert.ErrorIs(t, keys.Delete(asBob, first.ID), domain.ErrNotFound, "bob cannot revoke alice's key")
+	require.NoError(t, keys.Delete(asAlice, first.ID))
+	assert.ErrorIs(t, keys.Delete(asAlice, first.ID), domain.ErrNotFound)
+
+	_, err = keys.GetByHash(context.Background(), "hash-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,225 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// BorderCrossingRepo defines the persistence operations for border crossings.
+// Crossings belong to a trip; callers check the trip exists.
+type BorderCrossingRepo interface {
+	// Create inserts a crossing and returns the persisted record.
+	Create(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error)
+
+	// GetByID retrieves a trip's crossing.
+	// Returns domain.ErrNotFound if the trip has no crossing with that ID.
+	GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error)
+
+	// ListByTrip returns a trip's crossings in date order.
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error)
+
+	// ListByYear returns every crossing made in a calendar year, across all
+	// trips, in date order with each trip's name filled in.
+	ListByYear(ctx context.Context, year int) ([]domain.BorderCrossingReportRow, error)
+
+	// Update overwrites a trip's crossing.
+	// Returns domain.ErrNotFound if the trip has no crossing with that ID.
+	Update(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error)
+
+	// Delete removes a trip's crossing.
+	// Returns domain.ErrNotFound if the trip has no crossing with that ID.
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+// pgBorderCrossingRepo is the Postgres implementation of BorderCrossingRepo.
+type pgBorderCrossingRepo struct {
+	db db
+}
+
+// NewBorderCrossingRepo constructs a BorderCrossingRepo backed by the provided db connection.
+func NewBorderCrossingRepo(db db) BorderCrossingRepo {
+	return &pgBorderCrossingRepo{db: db}
+}
+
+const borderCrossingColumns = `id, trip_id, crossed_on, port_of_entry, country, direction, documents, notes, created_at, updated_at`
+
+// Create inserts a border_crossings row and returns the full persisted record.
+func (r *pgBorderCrossingRepo) Create(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	const q = `
+		INSERT INTO border_crossings (trip_id, crossed_on, port_of_entry, country, direction, documents, notes)
+		VALUES (@trip_id, @crossed_on, @port_of_entry, @country, @direction, @documents, @notes)
+		RETURNING ` + borderCrossingColumns
+
+	result, err := scanBorderCrossing(r.db.QueryRow(ctx, q, borderCrossingArgs(c)))
+	if err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("repo.BorderCrossingRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a crossing scoped to its trip.
+func (r *pgBorderCrossingRepo) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error) {
+	const q = `
+		SELECT ` + borderCrossingColumns + ` FROM border_crossings
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL
+
+	result, err := scanBorderCrossing(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID})))
+	if err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("repo.BorderCrossingRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListByTrip returns a trip's crossings in date order.
+func (r *pgBorderCrossingRepo) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error) {
+	const q = `
+		SELECT ` + borderCrossingColumns + `
+		FROM border_crossings
+		WHERE trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		ORDER BY crossed_on, created_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.BorderCrossingRepo.ListByTrip: %w", err)
+	}
+	defer rows.Close()
+
+	crossings := []domain.BorderCrossing{}
+	for rows.Next() {
+		c, err := scanBorderCrossing(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.BorderCrossingRepo.ListByTrip: scan: %w", err)
+		}
+		crossings = append(crossings, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.BorderCrossingRepo.ListByTrip: rows: %w", err)
+	}
+	return crossings, nil
+}
+
+// ListByYear returns a calendar year's crossings joined to their trips.
+func (r *pgBorderCrossingRepo) ListByYear(ctx context.Context, year int) ([]domain.BorderCrossingReportRow, error) {
+	const q = `
+		SELECT c.id, c.trip_id, c.crossed_on, c.port_of_entry, c.country, c.direction,
+		       c.documents, c.notes, c.created_at, c.updated_at,
+		       t.name
+		FROM border_crossings c
+		JOIN trips t ON t.id = c.trip_id
+		WHERE t.deleted_at IS NULL AND t.organization_id = @organization_id
+		  AND (t.user_id IS NULL OR t.user_id = @user_id OR t.id IN ` + memberTripsSQL + `)
+		  AND c.crossed_on >= make_date(@year, 1, 1)
+		  AND c.crossed_on < make_date(@year + 1, 1, 1)
+		ORDER BY c.crossed_on, c.created_at, c.id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"year": year}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.BorderCrossingRepo.ListByYear: %w", err)
+	}
+	defer rows.Close()
+
+	report := []domain.BorderCrossingReportRow{}
+	for rows.Next() {
+		var row domain.BorderCrossingReportRow
+		c, err := scanBorderCrossing(rowWithExtras{rows, []any{&row.TripName}})
+		if err != nil {
+			return nil, fmt.Errorf("repo.BorderCrossingRepo.ListByYear: scan: %w", err)
+		}
+		row.Crossing = c
+		report = append(report, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.BorderCrossingRepo.ListByYear: rows: %w", err)
+	}
+	return report, nil
+}
+
+// Update overwrites a crossing scoped to its trip.
+func (r *pgBorderCrossingRepo) Update(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	const q = `
+		UPDATE border_crossings
+		SET crossed_on = @crossed_on,
+		    port_of_entry = @port_of_entry,
+		    country = @country,
+		    direction = @direction,
+		    documents = @documents,
+		    notes = @notes,
+		    updated_at = now()
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		RETURNING ` + borderCrossingColumns
+
+	args := scoped(ctx, borderCrossingArgs(c))
+	args["id"] = c.ID
+	result, err := scanBorderCrossing(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("repo.BorderCrossingRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a crossing scoped to its trip.
+func (r *pgBorderCrossingRepo) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	const q = `DELETE FROM border_crossings WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID}))
+	if err != nil {
+		return fmt.Errorf("repo.BorderCrossingRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.BorderCrossingRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// borderCrossingArgs returns the named arguments shared by Create and Update.
+func borderCrossingArgs(c domain.BorderCrossing) pgx.NamedArgs {
+	documents := c.Documents
+	if documents == nil {
+		documents = []string{}
+	}
+	return pgx.NamedArgs{
+		"trip_id":       c.TripID,
+		"crossed_on":    c.CrossedOn,
+		"port_of_entry": c.PortOfEntry,
+		"country":       c.Country,
+		"direction":     string(c.Direction),
+		"documents":     documents,
+		"notes":         nullableString(c.Notes),
+	}
+}
+
+// scanBorderCrossing maps a single border_crossings row into a domain.BorderCrossing.
+func scanBorderCrossing(s scanner) (domain.BorderCrossing, error) {
+	var (
+		c         domain.BorderCrossing
+		id        pgtype.UUID
+		tripID    pgtype.UUID
+		direction string
+		notes     *string
+	)
+	err := s.Scan(&id, &tripID, &c.CrossedOn, &c.PortOfEntry, &c.Country, &direction,
+		&c.Documents, &notes, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.BorderCrossing{}, domain.ErrNotFound
+		}
+		return domain.BorderCrossing{}, err
+	}
+	c.ID = uuid.UUID(id.Bytes)
+	c.TripID = uuid.UUID(tripID.Bytes)
+	c.Direction = domain.CrossingDirection(direction)
+	if c.Documents == nil {
+		c.Documents = []string{}
+	}
+	if notes != nil {
+		c.Notes = *notes
+	}
+	return c, nil
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newBorderCrossingTestRepo returns a BorderCrossingRepo and its rolled-back
+// transaction, so parent trips can be inserted with testutil/factory.
+func newBorderCrossingTestRepo(t *testing.T) (pgx.Tx, repo.BorderCrossingRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewBorderCrossingRepo(tx)
+}
+
+func TestBorderCrossingRepo_CRUD(t *testing.T) {
+	tx, crossings := newBorderCrossingTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+
+	created, err := crossings.Create(ctx, domain.BorderCrossing{
+		TripID:      trip.ID,
+		CrossedOn:   day(2025, 7, 14),
+		PortOfEntry: "Blaine, WA",
+		Country:     "CA",
+		Direction:   domain.CrossingEntry,
+		Documents:   []string{"passport", "vehicle registration"},
+	})
+	require.NoError(t, err)
+	assert.True(t, created.CrossedOn.Equal(day(2025, 7, 14)))
+	assert.Equal(t, []string{"passport", "vehicle registration"}, created.Documents)
+	assert.Empty(t, created.Notes)
+
+	created.Direction = domain.CrossingExit
+	created.Documents = nil
+	updated, err := crossings.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CrossingExit, updated.Direction)
+	assert.Equal(t, []string{}, updated.Documents)
+
+	got, err := crossings.GetByID(ctx, trip.ID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CrossingExit, got.Direction)
+
+	other := factory.Trip().Insert(t, tx)
+	_, err = crossings.GetByID(ctx, other.ID, created.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "crossing is scoped to its trip")
+
+	require.NoError(t, crossings.Delete(ctx, trip.ID, created.ID))
+	err = crossings.Delete(ctx, trip.ID, created.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestBorderCrossingRepo_ListByYear(t *testing.T) {
+	tx, crossings := newBorderCrossingTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().WithName("Alaska run").Insert(t, tx)
+
+	for _, c := range []domain.BorderCrossing{
+		{CrossedOn: day(1987, 12, 31), PortOfEntry: "Sweetgrass, MT", Country: "CA", Direction: domain.CrossingEntry},
+		{CrossedOn: day(1988, 1, 1), PortOfEntry: "Beaver Creek, YT", Country: "US", Direction: domain.CrossingEntry},
+		{CrossedOn: day(1988, 12, 31), PortOfEntry: "Blaine, WA", Country: "US", Direction: domain.CrossingEntry},
+		{CrossedOn: day(1989, 1, 1), PortOfEntry: "Sumas, WA", Country: "CA", Direction: domain.CrossingEntry},
+	} {
+		c.TripID = trip.ID
+		_, err := crossings.Create(ctx, c)
+		require.NoError(t, err)
+	}
+
+	report, err := crossings.ListByYear(ctx, 1988)
+	require.NoError(t, err)
+
+	var ports []string
+	for _, row := range report {
+		if row.Crossing.TripID == trip.ID {
+			assert.Equal(t, "Alaska run", row.TripName)
+			ports = append(ports, row.Crossing.PortOfEntry)
+		}
+	}
+	assert.Equal(t, []string{"Beaver Creek, YT", "Blaine, WA"}, ports)
+
+	byTrip, err := crossings.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.Len(t, byTrip, 4)
+}
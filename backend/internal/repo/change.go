@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ChangeRepo reports when the data behind a cached view last changed.
+type ChangeRepo interface {
+	// LastModified returns when any table view is built from last changed,
+	// to the whole second, for use as an HTTP Last-Modified date. A change
+	// made during the current second is reported a second early: another
+	// change may still land in that second, and a client holding the earlier
+	// date must not be told its copy is current. Returns an error for an
+	// unknown view.
+	LastModified(ctx context.Context, view domain.CachedView) (time.Time, error)
+}
+
+// viewTables lists the tables each cached view reads. Every table here needs
+// a note_table_change trigger (migrations 032 and 057). Views filtered to the
+// trips the request's user can see include trip_members, so adding or
+// removing a member moves their Last-Modified.
+var viewTables = map[domain.CachedView][]string{
+	domain.ViewTags:         {"tags"},
+	domain.ViewPropaneStats: {"propane_fills", "trips", "trip_members"},
+	domain.ViewTripStats:    {"trips", "stops", "route_legs", "trip_members"},
+	domain.ViewHeatmap:      {"stops", "trips", "trip_members"},
+	domain.ViewSharedTrip:   {"trips", "stops", "tags", "stop_tags", "trip_shares"},
+	domain.ViewDashboard:    {"trips", "stops", "tags", "stop_tags", "trip_members"},
+}
+
+// pgChangeRepo is the Postgres implementation of ChangeRepo.
+type pgChangeRepo struct {
+	db db
+}
+
+// NewChangeRepo constructs a ChangeRepo backed by the provided db connection.
+func NewChangeRepo(db db) ChangeRepo {
+	return &pgChangeRepo{db: db}
+}
+
+// LastModified reads the newest changed_at among the view's tables. Both
+// sides of the comparison come from the database clock, so the app server's
+// clock cannot skew which second counts as current.
+func (r *pgChangeRepo) LastModified(ctx context.Context, view domain.CachedView) (time.Time, error) {
+	tables, ok := viewTables[view]
+	if !ok {
+		return time.Time{}, fmt.Errorf("repo.ChangeRepo.LastModified: unknown view %q", view)
+	}
+
+	const q = `
+		SELECT CASE
+			WHEN max(changed_at) >= date_trunc('second', clock_timestamp())
+				THEN date_trunc('second', max(changed_at)) - interval '1 second'
+			ELSE date_trunc('second', max(changed_at))
+		END
+		FROM table_changes
+		WHERE table_name = ANY(@tables)`
+
+	var modified *time.Time
+	if err := r.db.QueryRow(ctx, q, pgx.NamedArgs{"tables": tables}).Scan(&modified); err != nil {
+		return time.Time{}, fmt.Errorf("repo.ChangeRepo.LastModified: %w", err)
+	}
+	if modified == nil {
+		// Migration 032 seeds a row for every table, so only a hand-edited
+		// table_changes gets here.
+		return time.Time{}, fmt.Errorf("repo.ChangeRepo.LastModified: no change recorded for %s", view)
+	}
+	return modified.UTC(), nil
+}
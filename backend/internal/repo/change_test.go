@@ -0,0 +1,93 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func TestChangeRepo_LastModified(t *testing.T) {
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	changes := repo.NewChangeRepo(tx)
+
+	// The change triggers are deferred to commit, which a rolled-back test
+	// never reaches; fire them as each statement runs instead.
+	_, err = tx.Exec(ctx, `SET CONSTRAINTS ALL IMMEDIATE`)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `UPDATE table_changes SET changed_at = '2026-01-01 12:00:00.75+00'`)
+	require.NoError(t, err)
+	settled := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	got, err := changes.LastModified(ctx, domain.ViewTags)
+	require.NoError(t, err)
+	assert.Equal(t, settled, got, "truncated to the second")
+
+	before := time.Now()
+	factory.Tag().Insert(t, tx)
+
+	got, err = changes.LastModified(ctx, domain.ViewTags)
+	require.NoError(t, err)
+	assert.True(t, got.After(settled), "a new tag moves the tags view")
+	assert.WithinDuration(t, before.Truncate(time.Second), got, 2*time.Second)
+	assert.False(t, got.After(time.Now()))
+
+	got, err = changes.LastModified(ctx, domain.ViewPropaneStats)
+	require.NoError(t, err)
+	assert.Equal(t, settled, got, "tags do not feed the propane stats")
+
+	_, err = changes.LastModified(ctx, domain.CachedView("nope"))
+	assert.Error(t, err)
+}
+
+// TestChangeRepo_TripMembersMoveFilteredViews verifies that adding a trip
+// member moves the views filtered to the trips a user can see.
+func TestChangeRepo_TripMembersMoveFilteredViews(t *testing.T) {
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	changes := repo.NewChangeRepo(tx)
+
+	users := repo.NewUserRepo(tx)
+	owner, err := users.Create(ctx, domain.User{Username: uuid.NewString(), PasswordHash: "hash"})
+	require.NoError(t, err)
+	member, err := users.Create(ctx, domain.User{Username: uuid.NewString(), PasswordHash: "hash"})
+	require.NoError(t, err)
+	asOwner := domain.WithUser(ctx, owner.ID)
+	trip, err := repo.NewTripRepo(tx).Create(asOwner, factory.Trip().Build())
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `SET CONSTRAINTS ALL IMMEDIATE`)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `UPDATE table_changes SET changed_at = '2026-01-01 12:00:00+00'`)
+	require.NoError(t, err)
+	settled := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err = repo.NewTripMemberRepo(tx).Add(asOwner, domain.TripMember{TripID: trip.ID, UserID: member.ID, Role: domain.TripRoleViewer})
+	require.NoError(t, err)
+
+	for _, view := range []domain.CachedView{domain.ViewDashboard, domain.ViewTripStats, domain.ViewHeatmap, domain.ViewPropaneStats} {
+		got, err := changes.LastModified(ctx, view)
+		require.NoError(t, err)
+		assert.True(t, got.After(settled), "%s moved", view)
+	}
+	got, err := changes.LastModified(ctx, domain.ViewTags)
+	require.NoError(t, err)
+	assert.Equal(t, settled, got, "members do not feed the tags view")
+}
@@ -0,0 +1,365 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ChecklistRepo defines the persistence operations for checklist templates
+// and the checklist runs started from them at stops. Callers check that a
+// stop exists and belongs to its trip.
+type ChecklistRepo interface {
+	// CreateTemplate inserts a template and returns the persisted record.
+	CreateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error)
+
+	// GetTemplate retrieves a template by ID.
+	// Returns domain.ErrNotFound if it does not exist.
+	GetTemplate(ctx context.Context, id uuid.UUID) (domain.ChecklistTemplate, error)
+
+	// ListTemplates returns every template ordered by kind, then name.
+	ListTemplates(ctx context.Context) ([]domain.ChecklistTemplate, error)
+
+	// UpdateTemplate overwrites a template's name, kind, and items.
+	// Returns domain.ErrNotFound if it does not exist.
+	UpdateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error)
+
+	// DeleteTemplate removes a template. Checklists started from it are kept.
+	// Returns domain.ErrNotFound if it does not exist.
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+
+	// CreateChecklist inserts a checklist and its items in one statement and
+	// returns the persisted record. Only the labels of c.Items are used.
+	CreateChecklist(ctx context.Context, c domain.Checklist) (domain.Checklist, error)
+
+	// GetChecklist retrieves a stop's checklist with its items.
+	// Returns domain.ErrNotFound if the stop has no checklist with that ID.
+	GetChecklist(ctx context.Context, stopID, id uuid.UUID) (domain.Checklist, error)
+
+	// ListChecklistsByStop returns a stop's checklists with their items,
+	// oldest first.
+	ListChecklistsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Checklist, error)
+
+	// DeleteChecklist removes a stop's checklist and its items.
+	// Returns domain.ErrNotFound if the stop has no checklist with that ID.
+	DeleteChecklist(ctx context.Context, stopID, id uuid.UUID) error
+
+	// SetItemChecked sets an item's checked_at; nil unchecks it.
+	// Returns domain.ErrNotFound if the checklist has no item with that ID.
+	SetItemChecked(ctx context.Context, checklistID, itemID uuid.UUID, checkedAt *time.Time) error
+}
+
+// pgChecklistRepo is the Postgres implementation of ChecklistRepo.
+type pgChecklistRepo struct {
+	db db
+}
+
+// NewChecklistRepo constructs a ChecklistRepo backed by the provided db connection.
+func NewChecklistRepo(db db) ChecklistRepo {
+	return &pgChecklistRepo{db: db}
+}
+
+const (
+	checklistTemplateColumns = `id, name, kind, items, created_at, updated_at`
+	checklistColumns         = `id, stop_id, template_id, name, kind, created_at`
+)
+
+// CreateTemplate inserts a checklist_templates row.
+func (r *pgChecklistRepo) CreateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	const q = `
+		INSERT INTO checklist_templates (organization_id, name, kind, items)
+		VALUES (@organization_id, @name, @kind, @items)
+		RETURNING ` + checklistTemplateColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"name": t.Name, "kind": string(t.Kind), "items": t.Items}))
+	result, err := scanChecklistTemplate(row)
+	if err != nil {
+		return domain.ChecklistTemplate{}, fmt.Errorf("repo.ChecklistRepo.CreateTemplate: %w", err)
+	}
+	return result, nil
+}
+
+// GetTemplate retrieves a template by ID.
+func (r *pgChecklistRepo) GetTemplate(ctx context.Context, id uuid.UUID) (domain.ChecklistTemplate, error) {
+	const q = `SELECT ` + checklistTemplateColumns + ` FROM checklist_templates WHERE id = @id AND organization_id = @organization_id`
+
+	result, err := scanChecklistTemplate(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.ChecklistTemplate{}, fmt.Errorf("repo.ChecklistRepo.GetTemplate: %w", err)
+	}
+	return result, nil
+}
+
+// ListTemplates returns every template.
+func (r *pgChecklistRepo) ListTemplates(ctx context.Context) ([]domain.ChecklistTemplate, error) {
+	const q = `
+		SELECT ` + checklistTemplateColumns + ` FROM checklist_templates
+		WHERE organization_id = @organization_id
+		ORDER BY kind, name, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ChecklistRepo.ListTemplates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []domain.ChecklistTemplate{}
+	for rows.Next() {
+		t, err := scanChecklistTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ChecklistRepo.ListTemplates: scan: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ChecklistRepo.ListTemplates: rows: %w", err)
+	}
+	return templates, nil
+}
+
+// UpdateTemplate overwrites a template's mutable fields.
+func (r *pgChecklistRepo) UpdateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	const q = `
+		UPDATE checklist_templates
+		SET name = @name, kind = @kind, items = @items, updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id
+		RETURNING ` + checklistTemplateColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": t.ID, "name": t.Name, "kind": string(t.Kind), "items": t.Items}))
+	result, err := scanChecklistTemplate(row)
+	if err != nil {
+		return domain.ChecklistTemplate{}, fmt.Errorf("repo.ChecklistRepo.UpdateTemplate: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteTemplate removes a template by ID.
+func (r *pgChecklistRepo) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM checklist_templates WHERE id = @id AND organization_id = @organization_id`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.ChecklistRepo.DeleteTemplate: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.ChecklistRepo.DeleteTemplate: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateChecklist inserts the checklist row and one item row per label.
+// A single statement keeps the two inserts atomic without a transaction.
+func (r *pgChecklistRepo) CreateChecklist(ctx context.Context, c domain.Checklist) (domain.Checklist, error) {
+	const q = `
+		WITH checklist AS (
+			INSERT INTO stop_checklists (stop_id, template_id, name, kind)
+			VALUES (@stop_id, @template_id, @name, @kind)
+			RETURNING ` + checklistColumns + `
+		), items AS (
+			INSERT INTO stop_checklist_items (checklist_id, position, label)
+			SELECT checklist.id, item.position::int, item.label
+			FROM checklist, unnest(@labels::text[]) WITH ORDINALITY AS item(label, position)
+		)
+		SELECT ` + checklistColumns + ` FROM checklist`
+
+	labels := make([]string, len(c.Items))
+	for i, item := range c.Items {
+		labels[i] = item.Label
+	}
+	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"stop_id":     c.StopID,
+		"template_id": c.TemplateID, // nil becomes NULL
+		"name":        c.Name,
+		"kind":        string(c.Kind),
+		"labels":      labels,
+	})
+	created, err := scanChecklist(row)
+	if err != nil {
+		return domain.Checklist{}, fmt.Errorf("repo.ChecklistRepo.CreateChecklist: %w", err)
+	}
+
+	// The items CTE's rows are not visible to the statement that inserted
+	// them, so they are read back separately.
+	if err := r.loadItems(ctx, []*domain.Checklist{&created}); err != nil {
+		return domain.Checklist{}, fmt.Errorf("repo.ChecklistRepo.CreateChecklist: %w", err)
+	}
+	return created, nil
+}
+
+// GetChecklist retrieves a checklist scoped to its stop.
+func (r *pgChecklistRepo) GetChecklist(ctx context.Context, stopID, id uuid.UUID) (domain.Checklist, error) {
+	const q = `
+		SELECT ` + checklistColumns + ` FROM stop_checklists
+		WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	c, err := scanChecklist(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID})))
+	if err != nil {
+		return domain.Checklist{}, fmt.Errorf("repo.ChecklistRepo.GetChecklist: %w", err)
+	}
+	if err := r.loadItems(ctx, []*domain.Checklist{&c}); err != nil {
+		return domain.Checklist{}, fmt.Errorf("repo.ChecklistRepo.GetChecklist: %w", err)
+	}
+	return c, nil
+}
+
+// ListChecklistsByStop returns a stop's checklists, oldest first.
+func (r *pgChecklistRepo) ListChecklistsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Checklist, error) {
+	const q = `
+		SELECT ` + checklistColumns + `
+		FROM stop_checklists
+		WHERE stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		ORDER BY created_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ChecklistRepo.ListChecklistsByStop: %w", err)
+	}
+	defer rows.Close()
+
+	checklists := []domain.Checklist{}
+	for rows.Next() {
+		c, err := scanChecklist(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ChecklistRepo.ListChecklistsByStop: scan: %w", err)
+		}
+		checklists = append(checklists, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ChecklistRepo.ListChecklistsByStop: rows: %w", err)
+	}
+
+	ptrs := make([]*domain.Checklist, len(checklists))
+	for i := range checklists {
+		ptrs[i] = &checklists[i]
+	}
+	if err := r.loadItems(ctx, ptrs); err != nil {
+		return nil, fmt.Errorf("repo.ChecklistRepo.ListChecklistsByStop: %w", err)
+	}
+	return checklists, nil
+}
+
+// DeleteChecklist removes a checklist scoped to its stop; items cascade.
+func (r *pgChecklistRepo) DeleteChecklist(ctx context.Context, stopID, id uuid.UUID) error {
+	const q = `DELETE FROM stop_checklists WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID}))
+	if err != nil {
+		return fmt.Errorf("repo.ChecklistRepo.DeleteChecklist: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.ChecklistRepo.DeleteChecklist: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// SetItemChecked sets or clears an item's checked_at, scoped to its checklist.
+func (r *pgChecklistRepo) SetItemChecked(ctx context.Context, checklistID, itemID uuid.UUID, checkedAt *time.Time) error {
+	const q = `
+		UPDATE stop_checklist_items
+		SET checked_at = @checked_at
+		WHERE id = @id AND checklist_id = @checklist_id
+		  AND checklist_id IN (SELECT id FROM stop_checklists WHERE stop_id IN ` + orgStopsSQL + `)`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": itemID, "checklist_id": checklistID, "checked_at": checkedAt}))
+	if err != nil {
+		return fmt.Errorf("repo.ChecklistRepo.SetItemChecked: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.ChecklistRepo.SetItemChecked: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// loadItems fills in Items for each checklist with one query.
+func (r *pgChecklistRepo) loadItems(ctx context.Context, checklists []*domain.Checklist) error {
+	if len(checklists) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*domain.Checklist, len(checklists))
+	ids := make([]uuid.UUID, len(checklists))
+	for i, c := range checklists {
+		c.Items = []domain.ChecklistItem{}
+		byID[c.ID] = c
+		ids[i] = c.ID
+	}
+
+	const q = `
+		SELECT checklist_id, id, position, label, checked_at
+		FROM stop_checklist_items
+		WHERE checklist_id = ANY(@ids::uuid[])
+		ORDER BY checklist_id, position`
+
+	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return fmt.Errorf("items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			checklistID pgtype.UUID
+			id          pgtype.UUID
+			item        domain.ChecklistItem
+		)
+		if err := rows.Scan(&checklistID, &id, &item.Position, &item.Label, &item.CheckedAt); err != nil {
+			return fmt.Errorf("items: scan: %w", err)
+		}
+		item.ID = uuid.UUID(id.Bytes)
+		c := byID[uuid.UUID(checklistID.Bytes)]
+		c.Items = append(c.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("items: rows: %w", err)
+	}
+	return nil
+}
+
+// scanChecklistTemplate maps a single checklist_templates row.
+func scanChecklistTemplate(s scanner) (domain.ChecklistTemplate, error) {
+	var (
+		t    domain.ChecklistTemplate
+		id   pgtype.UUID
+		kind string
+	)
+	if err := s.Scan(&id, &t.Name, &kind, &t.Items, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ChecklistTemplate{}, domain.ErrNotFound
+		}
+		return domain.ChecklistTemplate{}, err
+	}
+	t.ID = uuid.UUID(id.Bytes)
+	t.Kind = domain.ChecklistKind(kind)
+	return t, nil
+}
+
+// scanChecklist maps a single stop_checklists row. Items are loaded separately.
+func scanChecklist(s scanner) (domain.Checklist, error) {
+	var (
+		c          domain.Checklist
+		id         pgtype.UUID
+		stopID     pgtype.UUID
+		templateID pgtype.UUID
+		kind       string
+	)
+	if err := s.Scan(&id, &stopID, &templateID, &c.Name, &kind, &c.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Checklist{}, domain.ErrNotFound
+		}
+		return domain.Checklist{}, err
+	}
+	c.ID = uuid.UUID(id.Bytes)
+	c.StopID = uuid.UUID(stopID.Bytes)
+	c.Kind = domain.ChecklistKind(kind)
+	if templateID.Valid {
+		t := uuid.UUID(templateID.Bytes)
+		c.TemplateID = &t
+	}
+	return c, nil
+}
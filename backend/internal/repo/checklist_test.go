@@ -0,0 +1,155 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newChecklistTestRepo returns a ChecklistRepo and its rolled-back
+// transaction, so parent trips and stops can be inserted with testutil/factory.
+func newChecklistTestRepo(t *testing.T) (pgx.Tx, repo.ChecklistRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewChecklistRepo(tx)
+}
+
+func pullOutTemplate() domain.ChecklistTemplate {
+	return domain.ChecklistTemplate{
+		Name:  "Pull out",
+		Kind:  domain.ChecklistDeparture,
+		Items: []string{"Antenna down", "Steps up", "Hitch locked"},
+	}
+}
+
+func TestChecklistRepo_Templates(t *testing.T) {
+	_, checklists := newChecklistTestRepo(t)
+	ctx := context.Background()
+
+	created, err := checklists.CreateTemplate(ctx, pullOutTemplate())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Antenna down", "Steps up", "Hitch locked"}, created.Items)
+
+	created.Items = []string{"Antenna down", "Hitch locked"}
+	updated, err := checklists.UpdateTemplate(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Antenna down", "Hitch locked"}, updated.Items)
+	assert.False(t, updated.UpdatedAt.Before(created.UpdatedAt))
+
+	got, err := checklists.GetTemplate(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, updated.Items, got.Items)
+
+	all, err := checklists.ListTemplates(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, all)
+
+	require.NoError(t, checklists.DeleteTemplate(ctx, created.ID))
+	_, err = checklists.GetTemplate(ctx, created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, checklists.DeleteTemplate(ctx, created.ID), domain.ErrNotFound)
+}
+
+func TestChecklistRepo_ChecklistLifecycle(t *testing.T) {
+	tx, checklists := newChecklistTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	tmpl, err := checklists.CreateTemplate(ctx, pullOutTemplate())
+	require.NoError(t, err)
+
+	created, err := checklists.CreateChecklist(ctx, domain.Checklist{
+		StopID:     stop.ID,
+		TemplateID: &tmpl.ID,
+		Name:       tmpl.Name,
+		Kind:       tmpl.Kind,
+		Items: []domain.ChecklistItem{
+			{Label: "Antenna down"}, {Label: "Steps up"}, {Label: "Hitch locked"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, created.Items, 3)
+	assert.Equal(t, 1, created.Items[0].Position)
+	assert.Equal(t, "Hitch locked", created.Items[2].Label)
+	assert.Nil(t, created.Items[0].CheckedAt)
+
+	at := time.Date(2025, 6, 10, 8, 30, 0, 0, time.UTC)
+	require.NoError(t, checklists.SetItemChecked(ctx, created.ID, created.Items[1].ID, &at))
+	assert.ErrorIs(t, checklists.SetItemChecked(ctx, uuid.New(), created.Items[1].ID, &at), domain.ErrNotFound,
+		"scoped to the checklist")
+
+	got, err := checklists.GetChecklist(ctx, stop.ID, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.Items[1].CheckedAt)
+	assert.True(t, got.Items[1].CheckedAt.Equal(at))
+
+	_, err = checklists.GetChecklist(ctx, uuid.New(), created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "scoped to the stop")
+
+	list, err := checklists.ListChecklistsByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Len(t, list[0].Items, 3)
+
+	require.NoError(t, checklists.DeleteChecklist(ctx, stop.ID, created.ID))
+	assert.ErrorIs(t, checklists.DeleteChecklist(ctx, stop.ID, created.ID), domain.ErrNotFound)
+}
+
+func TestChecklistRepo_TemplateDeleteKeepsChecklists(t *testing.T) {
+	tx, checklists := newChecklistTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	tmpl, err := checklists.CreateTemplate(ctx, pullOutTemplate())
+	require.NoError(t, err)
+	created, err := checklists.CreateChecklist(ctx, domain.Checklist{
+		StopID: stop.ID, TemplateID: &tmpl.ID, Name: tmpl.Name, Kind: tmpl.Kind,
+		Items: []domain.ChecklistItem{{Label: "Antenna down"}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, checklists.DeleteTemplate(ctx, tmpl.ID))
+
+	got, err := checklists.GetChecklist(ctx, stop.ID, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.TemplateID)
+	assert.Equal(t, "Pull out", got.Name)
+}
+
+// TestChecklistRepo_StopPurgeCascades verifies checklists go with their stop
+// once it is purged from the trash.
+func TestChecklistRepo_StopPurgeCascades(t *testing.T) {
+	tx, checklists := newChecklistTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	_, err := checklists.CreateChecklist(ctx, domain.Checklist{
+		StopID: stop.ID, Name: "Set up", Kind: domain.ChecklistArrival,
+		Items: []domain.ChecklistItem{{Label: "Level"}},
+	})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `DELETE FROM stops WHERE id = $1`, stop.ID)
+	require.NoError(t, err)
+
+	list, err := checklists.ListChecklistsByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
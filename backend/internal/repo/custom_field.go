@@ -0,0 +1,200 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// CustomFieldRepo defines the persistence operations for custom field
+// definitions. The values themselves are stored with the trips and stops.
+type CustomFieldRepo interface {
+	// Create inserts a definition and returns the persisted record.
+	// Returns domain.ErrValidation if the entity already has a field with
+	// that key.
+	Create(ctx context.Context, f domain.CustomField) (domain.CustomField, error)
+
+	// GetByID retrieves a definition. Returns domain.ErrNotFound if it does
+	// not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.CustomField, error)
+
+	// List returns the definitions for entity, ordered by key, or for every
+	// entity, ordered by entity then key, when entity is empty.
+	List(ctx context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error)
+
+	// UpdateLabel sets a definition's label. Returns domain.ErrNotFound if it
+	// does not exist.
+	UpdateLabel(ctx context.Context, id uuid.UUID, label string) (domain.CustomField, error)
+
+	// Delete removes a definition and its values from every trip or stop.
+	// Returns domain.ErrNotFound if it does not exist.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgCustomFieldRepo is the Postgres implementation of CustomFieldRepo.
+type pgCustomFieldRepo struct {
+	db db
+}
+
+// NewCustomFieldRepo constructs a CustomFieldRepo backed by the provided db connection.
+func NewCustomFieldRepo(db db) CustomFieldRepo {
+	return &pgCustomFieldRepo{db: db}
+}
+
+// Create inserts a definition. A key already in use for the entity inserts
+// nothing, and the empty result is reported as a validation error.
+func (r *pgCustomFieldRepo) Create(ctx context.Context, f domain.CustomField) (domain.CustomField, error) {
+	const q = `
+		INSERT INTO custom_fields (organization_id, entity, key, label, type)
+		VALUES (@organization_id, @entity, @key, @label, @type)
+		ON CONFLICT (organization_id, entity, key) DO NOTHING
+		RETURNING id, entity, key, label, type, created_at`
+
+	result, err := scanCustomField(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"entity": string(f.Entity),
+		"key":    f.Key,
+		"label":  f.Label,
+		"type":   string(f.Type),
+	})))
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.CustomField{}, fmt.Errorf("repo.CustomFieldRepo.Create: %w: %ss already have a field %q", domain.ErrValidation, f.Entity, f.Key)
+	}
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("repo.CustomFieldRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a definition by primary key.
+func (r *pgCustomFieldRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.CustomField, error) {
+	const q = `
+		SELECT id, entity, key, label, type, created_at
+		FROM custom_fields
+		WHERE id = @id AND organization_id = @organization_id`
+
+	result, err := scanCustomField(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("repo.CustomFieldRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// List returns the definitions for one entity, or all of them.
+func (r *pgCustomFieldRepo) List(ctx context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error) {
+	const q = `
+		SELECT id, entity, key, label, type, created_at
+		FROM custom_fields
+		WHERE organization_id = @organization_id AND (@entity = '' OR entity = @entity)
+		ORDER BY entity, key`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"entity": string(entity)}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.CustomFieldRepo.List: %w", err)
+	}
+	defer rows.Close()
+
+	fields := []domain.CustomField{}
+	for rows.Next() {
+		f, err := scanCustomField(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.CustomFieldRepo.List: scan: %w", err)
+		}
+		fields = append(fields, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.CustomFieldRepo.List: rows: %w", err)
+	}
+	return fields, nil
+}
+
+// UpdateLabel sets a definition's label. The key and type never change:
+// values already stored depend on them.
+func (r *pgCustomFieldRepo) UpdateLabel(ctx context.Context, id uuid.UUID, label string) (domain.CustomField, error) {
+	const q = `
+		UPDATE custom_fields SET label = @label
+		WHERE id = @id AND organization_id = @organization_id
+		RETURNING id, entity, key, label, type, created_at`
+
+	result, err := scanCustomField(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "label": label})))
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("repo.CustomFieldRepo.UpdateLabel: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a definition and strips its key from the metadata of the
+// organization's trips or stops in the same statement, trashed ones
+// included, so no value outlives its definition.
+func (r *pgCustomFieldRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `
+		WITH removed AS (
+			DELETE FROM custom_fields
+			WHERE id = @id AND organization_id = @organization_id
+			RETURNING entity, key
+		), trips_cleared AS (
+			UPDATE trips t SET metadata = t.metadata - removed.key
+			FROM removed
+			WHERE removed.entity = 'trip' AND t.organization_id = @organization_id
+			  AND t.metadata ? removed.key
+		), stops_cleared AS (
+			UPDATE stops s SET metadata = s.metadata - removed.key
+			FROM removed
+			WHERE removed.entity = 'stop' AND s.id IN ` + orgStopsSQL + `
+			  AND s.metadata ? removed.key
+		)
+		SELECT count(*) FROM removed`
+
+	var n int
+	if err := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})).Scan(&n); err != nil {
+		return fmt.Errorf("repo.CustomFieldRepo.Delete: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("repo.CustomFieldRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// scanCustomField maps a single custom_fields row into a domain.CustomField.
+func scanCustomField(s scanner) (domain.CustomField, error) {
+	var (
+		f         domain.CustomField
+		id        pgtype.UUID
+		entity    string
+		fieldType string
+	)
+	if err := s.Scan(&id, &entity, &f.Key, &f.Label, &fieldType, &f.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.CustomField{}, domain.ErrNotFound
+		}
+		return domain.CustomField{}, err
+	}
+	f.ID = uuid.UUID(id.Bytes)
+	f.Entity = domain.CustomFieldEntity(entity)
+	f.Type = domain.CustomFieldType(fieldType)
+	return f, nil
+}
+
+// metadataArg is the query argument for a trip's or stop's metadata. A nil
+// map becomes SQL NULL, which writes coalesce to the default or the current
+// value; pgx would otherwise encode it as the JSON null.
+func metadataArg(m domain.Metadata) any {
+	if m == nil {
+		return nil
+	}
+	return m
+}
+
+// metadataFilterArg is the query argument for a metadata @> filter. An empty
+// filter becomes {}, which every object contains.
+func metadataFilterArg(f domain.MetadataFilter) domain.MetadataFilter {
+	if f == nil {
+		return domain.MetadataFilter{}
+	}
+	return f
+}
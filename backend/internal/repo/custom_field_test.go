@@ -0,0 +1,115 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func newCustomFieldTestRepo(t *testing.T) (pgx.Tx, repo.CustomFieldRepo) {
+	t.Helper()
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	return tx, repo.NewCustomFieldRepo(tx)
+}
+
+func TestCustomFieldRepo_CreateAndList(t *testing.T) {
+	_, fields := newCustomFieldTestRepo(t)
+	ctx := context.Background()
+
+	created, err := fields.Create(ctx, domain.CustomField{Entity: domain.CustomFieldStop, Key: "pets_allowed", Label: "Pets allowed", Type: domain.FieldBoolean})
+	require.NoError(t, err)
+	assert.Equal(t, domain.FieldBoolean, created.Type)
+
+	_, err = fields.Create(ctx, domain.CustomField{Entity: domain.CustomFieldTrip, Key: "pets_allowed", Label: "Pets", Type: domain.FieldText})
+	require.NoError(t, err, "the same key may be used for trips and stops")
+
+	stops, err := fields.List(ctx, domain.CustomFieldStop)
+	require.NoError(t, err)
+	require.Len(t, stops, 1)
+	assert.Equal(t, created.ID, stops[0].ID)
+
+	all, err := fields.List(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
+
+func TestCustomFieldRepo_CreateDuplicateKey(t *testing.T) {
+	_, fields := newCustomFieldTestRepo(t)
+	ctx := context.Background()
+
+	f := domain.CustomField{Entity: domain.CustomFieldTrip, Key: "altitude_notes", Label: "Altitude", Type: domain.FieldText}
+	_, err := fields.Create(ctx, f)
+	require.NoError(t, err)
+
+	_, err = fields.Create(ctx, f)
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
+
+func TestCustomFieldRepo_DeleteStripsValues(t *testing.T) {
+	tx, fields := newCustomFieldTestRepo(t)
+	ctx := context.Background()
+
+	f, err := fields.Create(ctx, domain.CustomField{Entity: domain.CustomFieldStop, Key: "pets_allowed", Label: "Pets allowed", Type: domain.FieldBoolean})
+	require.NoError(t, err)
+	stop := factory.Stop().WithMetadata(domain.Metadata{"pets_allowed": true}).Insert(t, tx)
+
+	require.NoError(t, fields.Delete(ctx, f.ID))
+
+	got, err := repo.NewStopRepo(tx).GetByID(ctx, stop.TripID, stop.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Metadata)
+
+	err = fields.Delete(ctx, f.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestTripRepo_MetadataFilter(t *testing.T) {
+	tx, _ := newCustomFieldTestRepo(t)
+	trips := repo.NewTripRepo(tx)
+	ctx := context.Background()
+
+	match := factory.Trip().WithName("Rockies").WithMetadata(domain.Metadata{"altitude_ft": 9200.0, "pets": "dogs"}).Insert(t, tx)
+	factory.Trip().WithName("Coast").WithMetadata(domain.Metadata{"altitude_ft": 20.0}).Insert(t, tx)
+	factory.Trip().WithName("Plain").Insert(t, tx)
+
+	got, total, err := trips.ListPaged(ctx, domain.MetadataFilter{"altitude_ft": 9200.0}, domain.NewPaginationParams(nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, got, 1)
+	assert.Equal(t, match.ID, got[0].ID)
+	assert.Equal(t, "dogs", got[0].Metadata["pets"])
+}
+
+func TestTripRepo_UpdateKeepsMetadataWhenNil(t *testing.T) {
+	tx, _ := newCustomFieldTestRepo(t)
+	trips := repo.NewTripRepo(tx)
+	ctx := context.Background()
+
+	trip := factory.Trip().WithMetadata(domain.Metadata{"pets": "dogs"}).Insert(t, tx)
+
+	trip.Metadata = nil
+	trip.Name = "Renamed"
+	updated, err := trips.Update(ctx, trip)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Metadata{"pets": "dogs"}, updated.Metadata)
+
+	trip.Metadata = domain.Metadata{}
+	cleared, err := trips.Update(ctx, trip)
+	require.NoError(t, err)
+	assert.Empty(t, cleared.Metadata)
+}
@@ -0,0 +1,288 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// DataFixRepo makes bulk corrections to a trip's data. Each fix is a single
+// statement, so it is made whole or not at all. With dryRun nothing is
+// written, and the changes returned are the ones the fix would make.
+//
+// Trips are matched as the request's user sees them; the caller checks
+// that they may change them.
+type DataFixRepo interface {
+	// ShiftTripDates moves every date and time on a trip days days later
+	// (earlier when negative): the trip's start and end, its live stops'
+	// arrivals and departures, their reservations, and the trip's expenses,
+	// border crossings, and journal entries. The trip and each stop moved
+	// get a new revision.
+	ShiftTripDates(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) ([]domain.FieldChange, error)
+
+	// ReassignStops moves live stops of one trip onto another, with the
+	// expenses and journal entries tied to them, and the route legs between
+	// two moved stops. An empty stopIDs moves every live stop. If any of
+	// stopIDs is not a live stop of the trip nothing is moved, and the
+	// changes returned cover only the stops that are.
+	ReassignStops(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) ([]domain.FieldChange, error)
+
+	// NormalizeLocations tidies the location of each live stop on a trip:
+	// runs of whitespace become one space, commas are followed by exactly
+	// one space, stray leading and trailing commas are dropped, and a
+	// location left empty becomes null. Each stop changed gets a new
+	// revision.
+	NormalizeLocations(ctx context.Context, tripID uuid.UUID, dryRun bool) ([]domain.FieldChange, error)
+}
+
+// pgDataFixRepo is the Postgres implementation of DataFixRepo.
+type pgDataFixRepo struct {
+	db db
+}
+
+// NewDataFixRepo constructs a DataFixRepo backed by the provided db
+// connection.
+func NewDataFixRepo(db db) DataFixRepo {
+	return &pgDataFixRepo{db: db}
+}
+
+// fixTripSQL matches a trips row t the request's user can see and that is
+// not in the trash.
+const fixTripSQL = `t.organization_id = @organization_id AND (t.user_id IS NULL OR t.user_id = @user_id OR t.id IN ` + memberTripsSQL + `) AND t.deleted_at IS NULL`
+
+// fixTimeFormat is the to_char pattern for times reported in FieldChange,
+// applied to a time at UTC.
+const fixTimeFormat = `'YYYY-MM-DD"T"HH24:MI:SS"Z"'`
+
+// ShiftTripDates moves dates by whole days and times by whole 24-hour days,
+// as Clone does. The rows to move are read up front, so the preview is built
+// from the values before the fix whether or not it is written.
+func (r *pgDataFixRepo) ShiftTripDates(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) ([]domain.FieldChange, error) {
+	const q = `
+		WITH trip AS (
+			SELECT t.id, t.start_date, t.end_date FROM trips t
+			WHERE t.id = @trip_id AND ` + fixTripSQL + `
+		), shift AS (
+			SELECT make_interval(hours => 24 * @days::int) AS span
+		), stop_rows AS (
+			SELECT s.id, s.arrived_at, s.departed_at FROM stops s JOIN trip ON trip.id = s.trip_id
+			WHERE s.deleted_at IS NULL
+		), reservation_rows AS (
+			SELECT r.id, r.check_in, r.check_out, r.cancellation_deadline FROM reservations r JOIN stop_rows s ON s.id = r.stop_id
+		), expense_rows AS (
+			SELECT e.id, e.spent_at FROM expenses e JOIN trip ON trip.id = e.trip_id
+		), crossing_rows AS (
+			SELECT b.id, b.crossed_on FROM border_crossings b JOIN trip ON trip.id = b.trip_id
+		), journal_rows AS (
+			SELECT j.id, j.entry_date FROM journal_entries j JOIN trip ON trip.id = j.trip_id
+		), trip_updated AS (
+			UPDATE trips t
+			SET start_date = t.start_date + @days::int,
+			    end_date   = t.end_date + @days::int,
+			    revision   = t.revision + 1,
+			    updated_at = now()
+			FROM trip WHERE t.id = trip.id AND NOT @dry_run
+			RETURNING t.id, t.revision, t.name, t.start_date, t.end_date, t.notes, t.updated_at
+		), trip_revised AS (
+			INSERT INTO trip_revisions (` + tripRevisionColumns + `)
+			SELECT * FROM trip_updated
+		), stops_updated AS (
+			UPDATE stops s
+			SET arrived_at  = s.arrived_at + shift.span,
+			    departed_at = s.departed_at + shift.span,
+			    revision    = s.revision + 1,
+			    updated_at  = now()
+			FROM stop_rows x, shift WHERE s.id = x.id AND NOT @dry_run
+			RETURNING s.id, s.revision, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.updated_at
+		), stops_revised AS (
+			INSERT INTO stop_revisions (` + stopRevisionWriteColumns + `)
+			SELECT * FROM stops_updated
+		), reservations_updated AS (
+			UPDATE reservations r
+			SET check_in              = r.check_in + @days::int,
+			    check_out             = r.check_out + @days::int,
+			    cancellation_deadline = r.cancellation_deadline + shift.span,
+			    updated_at            = now()
+			FROM reservation_rows x, shift WHERE r.id = x.id AND NOT @dry_run
+		), expenses_updated AS (
+			UPDATE expenses e SET spent_at = e.spent_at + shift.span
+			FROM expense_rows x, shift WHERE e.id = x.id AND NOT @dry_run
+		), crossings_updated AS (
+			UPDATE border_crossings b SET crossed_on = b.crossed_on + @days::int, updated_at = now()
+			FROM crossing_rows x WHERE b.id = x.id AND NOT @dry_run
+		), journal_updated AS (
+			UPDATE journal_entries j SET entry_date = j.entry_date + @days::int, updated_at = now()
+			FROM journal_rows x WHERE j.id = x.id AND NOT @dry_run
+		)
+		SELECT c.entity, c.id, c.field, c.before, c.after FROM (
+			SELECT 1 AS ord, 'trip' AS entity, id, 'start_date' AS field,
+			       to_char(start_date, 'YYYY-MM-DD') AS before, to_char(start_date + @days::int, 'YYYY-MM-DD') AS after
+			FROM trip
+			UNION ALL
+			SELECT 1, 'trip', id, 'end_date', to_char(end_date, 'YYYY-MM-DD'), to_char(end_date + @days::int, 'YYYY-MM-DD')
+			FROM trip WHERE end_date IS NOT NULL
+			UNION ALL
+			SELECT 2, 'stop', id, 'arrived_at',
+			       to_char(arrived_at AT TIME ZONE 'UTC', ` + fixTimeFormat + `), to_char((arrived_at + span) AT TIME ZONE 'UTC', ` + fixTimeFormat + `)
+			FROM stop_rows, shift WHERE arrived_at IS NOT NULL
+			UNION ALL
+			SELECT 2, 'stop', id, 'departed_at',
+			       to_char(departed_at AT TIME ZONE 'UTC', ` + fixTimeFormat + `), to_char((departed_at + span) AT TIME ZONE 'UTC', ` + fixTimeFormat + `)
+			FROM stop_rows, shift WHERE departed_at IS NOT NULL
+			UNION ALL
+			SELECT 3, 'reservation', id, 'check_in', to_char(check_in, 'YYYY-MM-DD'), to_char(check_in + @days::int, 'YYYY-MM-DD')
+			FROM reservation_rows
+			UNION ALL
+			SELECT 3, 'reservation', id, 'check_out', to_char(check_out, 'YYYY-MM-DD'), to_char(check_out + @days::int, 'YYYY-MM-DD')
+			FROM reservation_rows
+			UNION ALL
+			SELECT 3, 'reservation', id, 'cancellation_deadline',
+			       to_char(cancellation_deadline AT TIME ZONE 'UTC', ` + fixTimeFormat + `), to_char((cancellation_deadline + span) AT TIME ZONE 'UTC', ` + fixTimeFormat + `)
+			FROM reservation_rows, shift WHERE cancellation_deadline IS NOT NULL
+			UNION ALL
+			SELECT 4, 'expense', id, 'spent_at',
+			       to_char(spent_at AT TIME ZONE 'UTC', ` + fixTimeFormat + `), to_char((spent_at + span) AT TIME ZONE 'UTC', ` + fixTimeFormat + `)
+			FROM expense_rows, shift
+			UNION ALL
+			SELECT 5, 'border_crossing', id, 'crossed_on', to_char(crossed_on, 'YYYY-MM-DD'), to_char(crossed_on + @days::int, 'YYYY-MM-DD')
+			FROM crossing_rows
+			UNION ALL
+			SELECT 6, 'journal_entry', id, 'entry_date', to_char(entry_date, 'YYYY-MM-DD'), to_char(entry_date + @days::int, 'YYYY-MM-DD')
+			FROM journal_rows
+		) c
+		ORDER BY c.ord, c.before, c.field, c.id`
+
+	args := scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "days": days, "dry_run": dryRun})
+	changes, err := r.changes(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("repo.DataFixRepo.ShiftTripDates: %w", err)
+	}
+	return changes, nil
+}
+
+// ReassignStops writes only when proceed says so: not a dry run, and every
+// stop asked for was found. Legs with one end moved are left behind for the
+// next leg sync of their trip to remove.
+func (r *pgDataFixRepo) ReassignStops(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) ([]domain.FieldChange, error) {
+	const q = `
+		WITH target AS (
+			SELECT t.id FROM trips t
+			WHERE t.id = @target_id AND ` + fixTripSQL + `
+		), moving AS (
+			SELECT s.id, s.trip_id FROM stops s
+			WHERE s.trip_id = @trip_id AND ` + liveStopSQL + `
+			  AND EXISTS (SELECT 1 FROM target)
+			  AND (cardinality(@stop_ids::uuid[]) = 0 OR s.id = ANY(@stop_ids::uuid[]))
+		), proceed AS (
+			SELECT NOT @dry_run
+			       AND (cardinality(@stop_ids::uuid[]) = 0 OR (SELECT count(*) FROM moving) = cardinality(@stop_ids::uuid[])) AS ok
+		), expense_rows AS (
+			SELECT e.id, e.trip_id FROM expenses e JOIN moving m ON m.id = e.stop_id
+		), journal_rows AS (
+			SELECT j.id, j.trip_id FROM journal_entries j JOIN moving m ON m.id = j.stop_id
+		), stops_moved AS (
+			UPDATE stops s SET trip_id = @target_id, updated_at = now()
+			FROM moving m, proceed WHERE s.id = m.id AND proceed.ok
+		), expenses_moved AS (
+			UPDATE expenses e SET trip_id = @target_id
+			FROM expense_rows x, proceed WHERE e.id = x.id AND proceed.ok
+		), journal_moved AS (
+			UPDATE journal_entries j SET trip_id = @target_id, updated_at = now()
+			FROM journal_rows x, proceed WHERE j.id = x.id AND proceed.ok
+		), dwells_moved AS (
+			UPDATE location_dwells d SET trip_id = @target_id, updated_at = now()
+			FROM moving m, proceed WHERE d.stop_id = m.id AND proceed.ok
+		), legs_moved AS (
+			UPDATE route_legs l SET trip_id = @target_id
+			FROM proceed
+			WHERE proceed.ok AND l.from_stop_id IN (SELECT id FROM moving) AND l.to_stop_id IN (SELECT id FROM moving)
+		)
+		SELECT c.entity, c.id, c.field, c.before, c.after FROM (
+			SELECT 1 AS ord, 'stop' AS entity, id, 'trip_id' AS field, trip_id::text AS before, @target_id::text AS after FROM moving
+			UNION ALL
+			SELECT 2, 'expense', id, 'trip_id', trip_id::text, @target_id::text FROM expense_rows
+			UNION ALL
+			SELECT 3, 'journal_entry', id, 'trip_id', trip_id::text, @target_id::text FROM journal_rows
+		) c
+		ORDER BY c.ord, c.id`
+
+	if stopIDs == nil {
+		stopIDs = []uuid.UUID{}
+	}
+	args := scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "target_id": targetID, "stop_ids": stopIDs, "dry_run": dryRun})
+	changes, err := r.changes(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("repo.DataFixRepo.ReassignStops: %w", err)
+	}
+	return changes, nil
+}
+
+// normalizedLocationSQL is s.location tidied as NormalizeLocations
+// describes.
+const normalizedLocationSQL = `NULLIF(regexp_replace(
+			regexp_replace(regexp_replace(s.location, '\s+', ' ', 'g'), '\s*(,\s*)+', ', ', 'g'),
+			'^[\s,]+|[\s,]+$', '', 'g'), '')`
+
+// NormalizeLocations rewrites only the locations that normalizing changes.
+func (r *pgDataFixRepo) NormalizeLocations(ctx context.Context, tripID uuid.UUID, dryRun bool) ([]domain.FieldChange, error) {
+	const q = `
+		WITH changed AS (
+			SELECT id, before, after FROM (
+				SELECT s.id, s.location AS before, ` + normalizedLocationSQL + ` AS after
+				FROM stops s
+				WHERE s.trip_id = @trip_id AND s.location IS NOT NULL AND ` + liveStopSQL + `
+			) n
+			WHERE after IS DISTINCT FROM before
+		), updated AS (
+			UPDATE stops s
+			SET location   = c.after,
+			    revision   = s.revision + 1,
+			    updated_at = now()
+			FROM changed c WHERE s.id = c.id AND NOT @dry_run
+			RETURNING s.id, s.revision, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.updated_at
+		), revised AS (
+			INSERT INTO stop_revisions (` + stopRevisionWriteColumns + `)
+			SELECT * FROM updated
+		)
+		SELECT 'stop', id, 'location', before, after FROM changed
+		ORDER BY before, id`
+
+	args := scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "dry_run": dryRun})
+	changes, err := r.changes(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("repo.DataFixRepo.NormalizeLocations: %w", err)
+	}
+	return changes, nil
+}
+
+// changes runs a fix and collects the (entity, id, field, before, after)
+// rows it reports.
+func (r *pgDataFixRepo) changes(ctx context.Context, q string, args pgx.NamedArgs) ([]domain.FieldChange, error) {
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []domain.FieldChange{}
+	for rows.Next() {
+		var (
+			c  domain.FieldChange
+			id pgtype.UUID
+		)
+		if err := rows.Scan(&c.Entity, &id, &c.Field, &c.Before, &c.After); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		c.ID = uuid.UUID(id.Bytes)
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+	return changes, nil
+}
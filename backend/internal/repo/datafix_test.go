@@ -0,0 +1,126 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func newTestDataFixRepo(t *testing.T) (pgx.Tx, repo.DataFixRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+	return tx, repo.NewDataFixRepo(tx)
+}
+
+func TestDataFixRepo_ShiftTripDates(t *testing.T) {
+	tx, fixes := newTestDataFixRepo(t)
+	ctx := context.Background()
+	end := domain.NewDate(2025, 6, 10)
+	trip := factory.Trip().WithStartDate(domain.NewDate(2025, 6, 1)).WithEndDate(&end).Insert(t, tx)
+	arrived := time.Date(2025, 6, 2, 18, 0, 0, 0, time.UTC)
+	stop := factory.Stop().WithTripID(trip.ID).WithArrivedAt(arrived).Insert(t, tx)
+
+	preview, err := fixes.ShiftTripDates(ctx, trip.ID, 7, true)
+	require.NoError(t, err)
+
+	var start time.Time
+	require.NoError(t, tx.QueryRow(ctx, `SELECT start_date FROM trips WHERE id = $1`, trip.ID).Scan(&start))
+	assert.Equal(t, "2025-06-01", start.Format(time.DateOnly), "a dry run writes nothing")
+
+	changes, err := fixes.ShiftTripDates(ctx, trip.ID, 7, false)
+	require.NoError(t, err)
+	assert.Equal(t, preview, changes, "the preview is what the fix does")
+
+	require.Len(t, changes, 3)
+	assert.Equal(t, "start_date", changes[0].Field)
+	assert.Equal(t, "2025-06-08", *changes[0].After)
+	assert.Equal(t, "end_date", changes[1].Field)
+	assert.Equal(t, stop.ID, changes[2].ID)
+	assert.Equal(t, "2025-06-02T18:00:00Z", *changes[2].Before)
+	assert.Equal(t, "2025-06-09T18:00:00Z", *changes[2].After)
+
+	var got time.Time
+	require.NoError(t, tx.QueryRow(ctx, `SELECT arrived_at FROM stops WHERE id = $1`, stop.ID).Scan(&got))
+	assert.True(t, got.Equal(arrived.AddDate(0, 0, 7)))
+	var revisions int
+	require.NoError(t, tx.QueryRow(ctx, `SELECT count(*) FROM stop_revisions WHERE stop_id = $1`, stop.ID).Scan(&revisions))
+	assert.Equal(t, 2, revisions, "the shift is recorded as a revision")
+}
+
+func TestDataFixRepo_ShiftTripDates_UnknownTrip(t *testing.T) {
+	_, fixes := newTestDataFixRepo(t)
+
+	changes, err := fixes.ShiftTripDates(context.Background(), uuid.New(), 7, false)
+
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDataFixRepo_ReassignStops(t *testing.T) {
+	tx, fixes := newTestDataFixRepo(t)
+	ctx := context.Background()
+	from := factory.Trip().Insert(t, tx)
+	to := factory.Trip().Insert(t, tx)
+	moved := factory.Stop().WithTripID(from.ID).Insert(t, tx)
+	kept := factory.Stop().WithTripID(from.ID).Insert(t, tx)
+
+	changes, err := fixes.ReassignStops(ctx, from.ID, to.ID, []uuid.UUID{moved.ID, uuid.New()}, false)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assertStopTrip(t, tx, moved.ID, from.ID, "nothing moves while a stop named is missing")
+
+	changes, err = fixes.ReassignStops(ctx, from.ID, to.ID, []uuid.UUID{moved.ID}, false)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	fromID, toID := from.ID.String(), to.ID.String()
+	assert.Equal(t, domain.FieldChange{Entity: "stop", ID: moved.ID, Field: "trip_id", Before: &fromID, After: &toID}, changes[0])
+	assertStopTrip(t, tx, moved.ID, to.ID, "")
+	assertStopTrip(t, tx, kept.ID, from.ID, "")
+}
+
+func TestDataFixRepo_NormalizeLocations(t *testing.T) {
+	tx, fixes := newTestDataFixRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	messy := factory.Stop().WithTripID(trip.ID).WithLocation("  Moab ,UT  ").Insert(t, tx)
+	factory.Stop().WithTripID(trip.ID).WithLocation("Moab, UT").Insert(t, tx)
+	blank := factory.Stop().WithTripID(trip.ID).WithLocation(" , ").Insert(t, tx)
+
+	changes, err := fixes.NormalizeLocations(ctx, trip.ID, false)
+	require.NoError(t, err)
+
+	require.Len(t, changes, 2, "only the locations that change")
+	byID := map[uuid.UUID]domain.FieldChange{}
+	for _, c := range changes {
+		byID[c.ID] = c
+	}
+	assert.Equal(t, "Moab, UT", *byID[messy.ID].After)
+	assert.Nil(t, byID[blank.ID].After, "a location left empty is cleared")
+
+	var location *string
+	require.NoError(t, tx.QueryRow(ctx, `SELECT location FROM stops WHERE id = $1`, messy.ID).Scan(&location))
+	require.NotNil(t, location)
+	assert.Equal(t, "Moab, UT", *location)
+}
+
+func assertStopTrip(t *testing.T, tx pgx.Tx, stopID, wantTrip uuid.UUID, msg string) {
+	t.Helper()
+	var got uuid.UUID
+	require.NoError(t, tx.QueryRow(context.Background(), `SELECT trip_id FROM stops WHERE id = $1`, stopID).Scan(&got))
+	assert.Equal(t, wantTrip, got, msg)
+}
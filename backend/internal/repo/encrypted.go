@@ -0,0 +1,340 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// FieldCipher encrypts and decrypts a single string column.
+// *fieldcrypt.Cipher satisfies it; tests can supply a fake.
+type FieldCipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+}
+
+// encryptedTripRepo is a TripRepo decorator that encrypts Trip.Notes on the
+// way in and decrypts it on the way out. Every other field passes through
+// untouched, and the wrapped repo never sees plaintext notes.
+type encryptedTripRepo struct {
+	next   TripRepo
+	cipher FieldCipher
+}
+
+// NewEncryptedTripRepo wraps next so trip notes are stored encrypted.
+// Services are unaware of the decorator — they receive plaintext as before.
+func NewEncryptedTripRepo(next TripRepo, cipher FieldCipher) TripRepo {
+	return &encryptedTripRepo{next: next, cipher: cipher}
+}
+
+func (r *encryptedTripRepo) Create(ctx context.Context, trip domain.Trip) (domain.Trip, error) {
+	if err := r.seal(&trip); err != nil {
+		return domain.Trip{}, fmt.Errorf("repo.encryptedTripRepo.Create: %w", err)
+	}
+	result, err := r.next.Create(ctx, trip)
+	if err != nil {
+		return domain.Trip{}, err
+	}
+	return r.open(result, "Create")
+}
+
+func (r *encryptedTripRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error) {
+	result, err := r.next.GetByID(ctx, id)
+	if err != nil {
+		return domain.Trip{}, err
+	}
+	return r.open(result, "GetByID")
+}
+
+func (r *encryptedTripRepo) Role(ctx context.Context, tripID uuid.UUID) (domain.TripRole, error) {
+	return r.next.Role(ctx, tripID)
+}
+
+func (r *encryptedTripRepo) List(ctx context.Context) ([]domain.Trip, error) {
+	trips, err := r.next.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.openAll(trips, "List")
+}
+
+func (r *encryptedTripRepo) ListPaged(ctx context.Context, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+	trips, total, err := r.next.ListPaged(ctx, f, p)
+	if err != nil {
+		return nil, 0, err
+	}
+	trips, err = r.openAll(trips, "ListPaged")
+	if err != nil {
+		return nil, 0, err
+	}
+	return trips, total, nil
+}
+
+func (r *encryptedTripRepo) Update(ctx context.Context, trip domain.Trip) (domain.Trip, error) {
+	if err := r.seal(&trip); err != nil {
+		return domain.Trip{}, fmt.Errorf("repo.encryptedTripRepo.Update: %w", err)
+	}
+	result, err := r.next.Update(ctx, trip)
+	if err != nil {
+		return domain.Trip{}, err
+	}
+	return r.open(result, "Update")
+}
+
+func (r *encryptedTripRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.next.Delete(ctx, id)
+}
+
+func (r *encryptedTripRepo) Clone(ctx context.Context, sourceID uuid.UUID, name string, shiftDays int, parts domain.TripCloneParts) (domain.Trip, error) {
+	result, err := r.next.Clone(ctx, sourceID, name, shiftDays, parts)
+	if err != nil {
+		return domain.Trip{}, err
+	}
+	return r.open(result, "Clone")
+}
+
+func (r *encryptedTripRepo) ListRevisions(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error) {
+	revisions, err := r.next.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range revisions {
+		if revisions[i], err = r.openRevision(revisions[i], "ListRevisions"); err != nil {
+			return nil, err
+		}
+	}
+	return revisions, nil
+}
+
+func (r *encryptedTripRepo) GetRevision(ctx context.Context, id uuid.UUID, revision int) (domain.TripRevision, error) {
+	result, err := r.next.GetRevision(ctx, id, revision)
+	if err != nil {
+		return domain.TripRevision{}, err
+	}
+	return r.openRevision(result, "GetRevision")
+}
+
+func (r *encryptedTripRepo) seal(t *domain.Trip) error {
+	sealed, err := r.cipher.Encrypt(t.Notes)
+	if err != nil {
+		return err
+	}
+	t.Notes = sealed
+	return nil
+}
+
+func (r *encryptedTripRepo) open(t domain.Trip, op string) (domain.Trip, error) {
+	plain, err := r.cipher.Decrypt(t.Notes)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("repo.encryptedTripRepo.%s: trip %s: %w", op, t.ID, err)
+	}
+	t.Notes = plain
+	return t, nil
+}
+
+func (r *encryptedTripRepo) openRevision(rev domain.TripRevision, op string) (domain.TripRevision, error) {
+	plain, err := r.cipher.Decrypt(rev.Notes)
+	if err != nil {
+		return domain.TripRevision{}, fmt.Errorf("repo.encryptedTripRepo.%s: trip %s revision %d: %w", op, rev.TripID, rev.Revision, err)
+	}
+	rev.Notes = plain
+	return rev, nil
+}
+
+func (r *encryptedTripRepo) openAll(trips []domain.Trip, op string) ([]domain.Trip, error) {
+	for i := range trips {
+		t, err := r.open(trips[i], op)
+		if err != nil {
+			return nil, err
+		}
+		trips[i] = t
+	}
+	return trips, nil
+}
+
+// encryptedStopRepo is the StopRepo counterpart of encryptedTripRepo: it
+// encrypts Stop.Notes on write and decrypts it on read.
+type encryptedStopRepo struct {
+	next   StopRepo
+	cipher FieldCipher
+}
+
+// NewEncryptedStopRepo wraps next so stop notes are stored encrypted.
+func NewEncryptedStopRepo(next StopRepo, cipher FieldCipher) StopRepo {
+	return &encryptedStopRepo{next: next, cipher: cipher}
+}
+
+func (r *encryptedStopRepo) Create(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
+	if err := r.seal(&stop); err != nil {
+		return domain.Stop{}, fmt.Errorf("repo.encryptedStopRepo.Create: %w", err)
+	}
+	result, err := r.next.Create(ctx, stop)
+	if err != nil {
+		return domain.Stop{}, err
+	}
+	return r.open(result, "Create")
+}
+
+func (r *encryptedStopRepo) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+	result, err := r.next.GetByID(ctx, tripID, stopID)
+	if err != nil {
+		return domain.Stop{}, err
+	}
+	return r.open(result, "GetByID")
+}
+
+func (r *encryptedStopRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
+	stops, err := r.next.ListByTripID(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	return r.openAll(stops, "ListByTripID")
+}
+
+func (r *encryptedStopRepo) ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) ([]domain.Stop, error) {
+	stops, err := r.next.ListByTripIDs(ctx, tripIDs)
+	if err != nil {
+		return nil, err
+	}
+	return r.openAll(stops, "ListByTripIDs")
+}
+
+func (r *encryptedStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Stop, int64, error) {
+	stops, total, err := r.next.ListByTripIDPaged(ctx, tripID, f, p)
+	if err != nil {
+		return nil, 0, err
+	}
+	stops, err = r.openAll(stops, "ListByTripIDPaged")
+	if err != nil {
+		return nil, 0, err
+	}
+	return stops, total, nil
+}
+
+func (r *encryptedStopRepo) ListNearby(ctx context.Context, lat, lon, radiusKm float64, limit int) ([]domain.NearbyStop, error) {
+	nearby, err := r.next.ListNearby(ctx, lat, lon, radiusKm, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range nearby {
+		s, err := r.open(nearby[i].Stop, "ListNearby")
+		if err != nil {
+			return nil, err
+		}
+		nearby[i].Stop = s
+	}
+	return nearby, nil
+}
+
+func (r *encryptedStopRepo) Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+	return r.next.Clusters(ctx, box, zoom)
+}
+
+func (r *encryptedStopRepo) Heatmap(ctx context.Context, year int, cellDegrees float64) ([]domain.HeatmapCell, error) {
+	return r.next.Heatmap(ctx, year, cellDegrees)
+}
+
+func (r *encryptedStopRepo) Update(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
+	if err := r.seal(&stop); err != nil {
+		return domain.Stop{}, fmt.Errorf("repo.encryptedStopRepo.Update: %w", err)
+	}
+	result, err := r.next.Update(ctx, stop)
+	if err != nil {
+		return domain.Stop{}, err
+	}
+	return r.open(result, "Update")
+}
+
+func (r *encryptedStopRepo) Delete(ctx context.Context, tripID, stopID uuid.UUID) error {
+	return r.next.Delete(ctx, tripID, stopID)
+}
+
+func (r *encryptedStopRepo) ListRevisions(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error) {
+	revisions, err := r.next.ListRevisions(ctx, tripID, stopID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range revisions {
+		if revisions[i], err = r.openRevision(revisions[i], "ListRevisions"); err != nil {
+			return nil, err
+		}
+	}
+	return revisions, nil
+}
+
+func (r *encryptedStopRepo) GetRevision(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.StopRevision, error) {
+	result, err := r.next.GetRevision(ctx, tripID, stopID, revision)
+	if err != nil {
+		return domain.StopRevision{}, err
+	}
+	return r.openRevision(result, "GetRevision")
+}
+
+func (r *encryptedStopRepo) seal(s *domain.Stop) error {
+	sealed, err := r.cipher.Encrypt(s.Notes)
+	if err != nil {
+		return err
+	}
+	s.Notes = sealed
+	return nil
+}
+
+func (r *encryptedStopRepo) open(s domain.Stop, op string) (domain.Stop, error) {
+	plain, err := r.cipher.Decrypt(s.Notes)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("repo.encryptedStopRepo.%s: stop %s: %w", op, s.ID, err)
+	}
+	s.Notes = plain
+	return s, nil
+}
+
+func (r *encryptedStopRepo) openRevision(rev domain.StopRevision, op string) (domain.StopRevision, error) {
+	plain, err := r.cipher.Decrypt(rev.Notes)
+	if err != nil {
+		return domain.StopRevision{}, fmt.Errorf("repo.encryptedStopRepo.%s: stop %s revision %d: %w", op, rev.StopID, rev.Revision, err)
+	}
+	rev.Notes = plain
+	return rev, nil
+}
+
+func (r *encryptedStopRepo) openAll(stops []domain.Stop, op string) ([]domain.Stop, error) {
+	for i := range stops {
+		s, err := r.open(stops[i], op)
+		if err != nil {
+			return nil, err
+		}
+		stops[i] = s
+	}
+	return stops, nil
+}
+
+// encryptedExportRepo decrypts the stop notes in each export row. The export
+// is read-only, so there is nothing to seal.
+type encryptedExportRepo struct {
+	next   ExportRepo
+	cipher FieldCipher
+}
+
+// NewEncryptedExportRepo wraps next so exported stop notes are decrypted.
+func NewEncryptedExportRepo(next ExportRepo, cipher FieldCipher) ExportRepo {
+	return &encryptedExportRepo{next: next, cipher: cipher}
+}
+
+func (r *encryptedExportRepo) Stream(ctx context.Context, fn func(domain.ExportRow) error) error {
+	return r.next.Stream(ctx, func(row domain.ExportRow) error {
+		plain, err := r.cipher.Decrypt(row.StopNotes)
+		if err != nil {
+			return fmt.Errorf("repo.encryptedExportRepo.Stream: trip %s: %w", row.TripID, err)
+		}
+		row.StopNotes = plain
+		return fn(row)
+	})
+}
+
+func (r *encryptedExportRepo) Deletions(ctx context.Context, since time.Time) ([]domain.Tombstone, error) {
+	return r.next.Deletions(ctx, since)
+}
@@ -0,0 +1,230 @@
+package repo_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/fieldcrypt"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// memTripRepo is an in-memory TripRepo that records exactly what the
+// decorator handed it, so tests can assert nothing plaintext is persisted.
+type memTripRepo struct {
+	rows      map[uuid.UUID]domain.Trip
+	revisions map[uuid.UUID][]domain.TripRevision
+}
+
+func newMemTripRepo() *memTripRepo {
+	return &memTripRepo{rows: map[uuid.UUID]domain.Trip{}, revisions: map[uuid.UUID][]domain.TripRevision{}}
+}
+
+// record appends t's current fields as its next revision, as the pg repo does.
+func (m *memTripRepo) record(t domain.Trip) {
+	revs := m.revisions[t.ID]
+	m.revisions[t.ID] = append(revs, domain.TripRevision{TripID: t.ID, Revision: len(revs) + 1, Name: t.Name, Notes: t.Notes})
+}
+
+func (m *memTripRepo) Create(_ context.Context, t domain.Trip) (domain.Trip, error) {
+	t.ID = uuid.New()
+	m.rows[t.ID] = t
+	m.record(t)
+	return t, nil
+}
+func (m *memTripRepo) GetByID(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+	t, ok := m.rows[id]
+	if !ok {
+		return domain.Trip{}, domain.ErrNotFound
+	}
+	return t, nil
+}
+func (m *memTripRepo) Role(_ context.Context, id uuid.UUID) (domain.TripRole, error) {
+	if _, ok := m.rows[id]; !ok {
+		return "", domain.ErrNotFound
+	}
+	return domain.TripRoleOwner, nil
+}
+func (m *memTripRepo) List(_ context.Context) ([]domain.Trip, error) {
+	out := []domain.Trip{}
+	for _, t := range m.rows {
+		out = append(out, t)
+	}
+	return out, nil
+}
+func (m *memTripRepo) ListPaged(ctx context.Context, _ domain.MetadataFilter, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
+	out, _ := m.List(ctx)
+	return out, int64(len(out)), nil
+}
+func (m *memTripRepo) Update(_ context.Context, t domain.Trip) (domain.Trip, error) {
+	m.rows[t.ID] = t
+	m.record(t)
+	return t, nil
+}
+func (m *memTripRepo) Delete(_ context.Context, id uuid.UUID) error {
+	delete(m.rows, id)
+	return nil
+}
+func (m *memTripRepo) Clone(_ context.Context, sourceID uuid.UUID, name string, shiftDays int, _ domain.TripCloneParts) (domain.Trip, error) {
+	t, ok := m.rows[sourceID]
+	if !ok {
+		return domain.Trip{}, domain.ErrNotFound
+	}
+	t.ID, t.Name = uuid.New(), name
+	m.rows[t.ID] = t
+	m.record(t)
+	return t, nil
+}
+func (m *memTripRepo) ListRevisions(_ context.Context, id uuid.UUID) ([]domain.TripRevision, error) {
+	revs := m.revisions[id]
+	out := make([]domain.TripRevision, len(revs))
+	for i, rev := range revs {
+		out[len(revs)-1-i] = rev
+	}
+	return out, nil
+}
+func (m *memTripRepo) GetRevision(_ context.Context, id uuid.UUID, revision int) (domain.TripRevision, error) {
+	revs := m.revisions[id]
+	if revision < 1 || revision > len(revs) {
+		return domain.TripRevision{}, domain.ErrNotFound
+	}
+	return revs[revision-1], nil
+}
+
+var _ repo.TripRepo = (*memTripRepo)(nil)
+
+func testCipher(t *testing.T) *fieldcrypt.Cipher {
+	t.Helper()
+	c, err := fieldcrypt.NewCipher("k1", map[string][]byte{"k1": bytes.Repeat([]byte{7}, fieldcrypt.KeyBytes)})
+	require.NoError(t, err)
+	return c
+}
+
+func TestEncryptedTripRepo_StoresCiphertextReturnsPlaintext(t *testing.T) {
+	ctx := context.Background()
+	inner := newMemTripRepo()
+	r := repo.NewEncryptedTripRepo(inner, testCipher(t))
+
+	created, err := r.Create(ctx, domain.Trip{Name: "Utah", Notes: "gate code 1234"})
+	require.NoError(t, err)
+	assert.Equal(t, "gate code 1234", created.Notes)
+
+	stored := inner.rows[created.ID].Notes
+	assert.True(t, strings.HasPrefix(stored, "enc:v1:"))
+	assert.NotContains(t, stored, "1234")
+
+	got, err := r.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "gate code 1234", got.Notes)
+
+	list, total, err := r.ListPaged(ctx, nil, domain.NewPaginationParams(nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "gate code 1234", list[0].Notes)
+}
+
+// TestEncryptedTripRepo_ReadsLegacyPlaintext verifies rows written before
+// encryption was switched on are still readable.
+func TestEncryptedTripRepo_ReadsLegacyPlaintext(t *testing.T) {
+	ctx := context.Background()
+	inner := newMemTripRepo()
+	legacy, _ := inner.Create(ctx, domain.Trip{Name: "Old", Notes: "plain note"})
+
+	got, err := repo.NewEncryptedTripRepo(inner, testCipher(t)).GetByID(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "plain note", got.Notes)
+}
+
+// TestEncryptedTripRepo_UndecryptableIsError verifies that a value sealed with
+// an unknown key surfaces as an error rather than leaking ciphertext to clients.
+func TestEncryptedTripRepo_UndecryptableIsError(t *testing.T) {
+	ctx := context.Background()
+	inner := newMemTripRepo()
+	bad, _ := inner.Create(ctx, domain.Trip{Name: "X", Notes: "enc:v1:gone:AAAA"})
+
+	_, err := repo.NewEncryptedTripRepo(inner, testCipher(t)).GetByID(ctx, bad.ID)
+	assert.ErrorIs(t, err, fieldcrypt.ErrDecrypt)
+}
+
+func TestEncryptedTripRepo_PassesThroughNotFound(t *testing.T) {
+	r := repo.NewEncryptedTripRepo(newMemTripRepo(), testCipher(t))
+
+	_, err := r.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// TestEncryptedTripRepo_DecryptsRevisionNotes verifies history is stored
+// sealed like the trip itself and comes back as plaintext.
+func TestEncryptedTripRepo_DecryptsRevisionNotes(t *testing.T) {
+	ctx := context.Background()
+	inner := newMemTripRepo()
+	r := repo.NewEncryptedTripRepo(inner, testCipher(t))
+
+	created, err := r.Create(ctx, domain.Trip{Name: "Utah", Notes: "gate code 1234"})
+	require.NoError(t, err)
+	created.Notes = "gate code 5678"
+	_, err = r.Update(ctx, created)
+	require.NoError(t, err)
+
+	assert.NotContains(t, inner.revisions[created.ID][0].Notes, "1234")
+
+	revs, err := r.ListRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, "gate code 5678", revs[0].Notes)
+	assert.Equal(t, "gate code 1234", revs[1].Notes)
+
+	rev, err := r.GetRevision(ctx, created.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "gate code 1234", rev.Notes)
+}
+
+// sliceExportRepo streams a fixed list of export rows. It has no deletions.
+type sliceExportRepo []domain.ExportRow
+
+func (s sliceExportRepo) Stream(_ context.Context, fn func(domain.ExportRow) error) error {
+	for _, row := range s {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s sliceExportRepo) Deletions(context.Context, time.Time) ([]domain.Tombstone, error) {
+	return []domain.Tombstone{}, nil
+}
+
+func TestEncryptedExportRepo_DecryptsStopNotes(t *testing.T) {
+	c := testCipher(t)
+	sealed, err := c.Encrypt("gate code 1234")
+	require.NoError(t, err)
+	inner := sliceExportRepo{{TripName: "Utah", StopNotes: sealed}, {TripName: "Old", StopNotes: "plain note"}}
+
+	var notes []string
+	err = repo.NewEncryptedExportRepo(inner, c).Stream(context.Background(), func(row domain.ExportRow) error {
+		notes = append(notes, row.StopNotes)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gate code 1234", "plain note"}, notes)
+}
+
+func TestEncryptedExportRepo_UndecryptableIsError(t *testing.T) {
+	inner := sliceExportRepo{{TripName: "X", StopNotes: "enc:v1:gone:AAAA"}}
+
+	err := repo.NewEncryptedExportRepo(inner, testCipher(t)).Stream(context.Background(), func(domain.ExportRow) error {
+		t.Fatal("undecryptable row must not reach the consumer")
+		return nil
+	})
+
+	assert.ErrorIs(t, err, fieldcrypt.ErrDecrypt)
+}
@@ -0,0 +1,178 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ExpenseRepo defines the persistence operations for trip expenses.
+// Callers check the trip exists.
+type ExpenseRepo interface {
+	// Create inserts an expense and returns the persisted record.
+	Create(ctx context.Context, e domain.Expense) (domain.Expense, error)
+
+	// ListByTrip returns a trip's expenses, most recent first.
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.Expense, error)
+
+	// UpdateSplit replaces who paid a trip's expense and whom it is split
+	// among, and returns the updated record. Returns domain.ErrNotFound if
+	// the trip has no expense with that ID.
+	UpdateSplit(ctx context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error)
+
+	// Delete removes a trip's expense.
+	// Returns domain.ErrNotFound if the trip has no expense with that ID.
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+// pgExpenseRepo is the Postgres implementation of ExpenseRepo.
+type pgExpenseRepo struct {
+	db db
+}
+
+// NewExpenseRepo constructs an ExpenseRepo backed by the provided db connection.
+func NewExpenseRepo(db db) ExpenseRepo {
+	return &pgExpenseRepo{db: db}
+}
+
+const expenseColumns = `id, trip_id, stop_id, category, amount, note, location, paid_by, split_among, spent_at, created_at`
+
+// Create inserts an expenses row and returns the full persisted record.
+func (r *pgExpenseRepo) Create(ctx context.Context, e domain.Expense) (domain.Expense, error) {
+	const q = `
+		INSERT INTO expenses (trip_id, stop_id, category, amount, note, location, paid_by, split_among, spent_at)
+		VALUES (@trip_id, @stop_id, @category, @amount, @note, @location, @paid_by, @split_among, @spent_at)
+		RETURNING ` + expenseColumns
+
+	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"trip_id":     e.TripID,
+		"stop_id":     e.StopID, // nil becomes NULL
+		"category":    string(e.Category),
+		"amount":      e.Amount,
+		"note":        nullableString(e.Note),
+		"location":    nullableString(e.Location),
+		"paid_by":     nullableString(e.Split.PaidBy),
+		"split_among": splitAmong(e.Split),
+		"spent_at":    e.SpentAt,
+	})
+	result, err := scanExpense(row)
+	if err != nil {
+		return domain.Expense{}, fmt.Errorf("repo.ExpenseRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// ListByTrip returns a trip's expenses, newest first.
+func (r *pgExpenseRepo) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.Expense, error) {
+	const q = `
+		SELECT ` + expenseColumns + `
+		FROM expenses
+		WHERE trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		ORDER BY spent_at DESC, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ExpenseRepo.ListByTrip: %w", err)
+	}
+	defer rows.Close()
+
+	expenses := []domain.Expense{}
+	for rows.Next() {
+		e, err := scanExpense(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ExpenseRepo.ListByTrip: scan: %w", err)
+		}
+		expenses = append(expenses, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ExpenseRepo.ListByTrip: rows: %w", err)
+	}
+	return expenses, nil
+}
+
+// UpdateSplit sets paid_by and split_among on an expense scoped to its trip.
+func (r *pgExpenseRepo) UpdateSplit(ctx context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error) {
+	const q = `
+		UPDATE expenses
+		SET paid_by = @paid_by, split_among = @split_among
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		RETURNING ` + expenseColumns
+
+	result, err := scanExpense(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":          id,
+		"trip_id":     tripID,
+		"paid_by":     nullableString(split.PaidBy),
+		"split_among": splitAmong(split),
+	})))
+	if err != nil {
+		return domain.Expense{}, fmt.Errorf("repo.ExpenseRepo.UpdateSplit: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes an expense scoped to its trip.
+func (r *pgExpenseRepo) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	const q = `DELETE FROM expenses WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID}))
+	if err != nil {
+		return fmt.Errorf("repo.ExpenseRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.ExpenseRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// scanExpense maps a single expenses row into a domain.Expense.
+func scanExpense(s scanner) (domain.Expense, error) {
+	var (
+		e        domain.Expense
+		id       pgtype.UUID
+		tripID   pgtype.UUID
+		stopID   pgtype.UUID
+		category string
+		note     *string
+		location *string
+		paidBy   *string
+	)
+	err := s.Scan(&id, &tripID, &stopID, &category, &e.Amount, &note, &location, &paidBy, &e.Split.SplitAmong, &e.SpentAt, &e.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Expense{}, domain.ErrNotFound
+		}
+		return domain.Expense{}, err
+	}
+	e.ID = uuid.UUID(id.Bytes)
+	e.TripID = uuid.UUID(tripID.Bytes)
+	if stopID.Valid {
+		sid := uuid.UUID(stopID.Bytes)
+		e.StopID = &sid
+	}
+	e.Category = domain.ExpenseCategory(category)
+	if note != nil {
+		e.Note = *note
+	}
+	if location != nil {
+		e.Location = *location
+	}
+	if paidBy != nil {
+		e.Split.PaidBy = *paidBy
+	}
+	return e, nil
+}
+
+// splitAmong returns split.SplitAmong for the NOT NULL split_among column;
+// pgx would write a nil slice as NULL.
+func splitAmong(split domain.ExpenseSplit) []string {
+	if split.SplitAmong == nil {
+		return []string{}
+	}
+	return split.SplitAmong
+}
@@ -0,0 +1,122 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newExpenseTestRepo returns an ExpenseRepo and its rolled-back transaction,
+// so parent trips and stops can be inserted with testutil/factory.
+func newExpenseTestRepo(t *testing.T) (pgx.Tx, repo.ExpenseRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewExpenseRepo(tx)
+}
+
+func TestExpenseRepo_CreateListDelete(t *testing.T) {
+	tx, expenses := newExpenseTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	morning := time.Date(2025, 7, 2, 9, 0, 0, 0, time.UTC)
+
+	toll, err := expenses.Create(ctx, domain.Expense{
+		TripID: trip.ID, Category: domain.ExpenseToll, Amount: 8.75,
+		Note: "Golden Gate Bridge", SpentAt: morning,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, toll.StopID)
+	assert.Empty(t, toll.Location)
+	assert.InDelta(t, 8.75, toll.Amount, 0.001)
+
+	parking, err := expenses.Create(ctx, domain.Expense{
+		TripID: trip.ID, StopID: &stop.ID, Category: domain.ExpenseParking, Amount: 12,
+		Location: "Crescent City, CA", SpentAt: morning.Add(3 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, parking.StopID)
+	assert.Equal(t, stop.ID, *parking.StopID)
+	assert.Empty(t, parking.Note)
+
+	list, err := expenses.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, parking.ID, list[0].ID, "newest first")
+	assert.Equal(t, domain.ExpenseToll, list[1].Category)
+
+	require.NoError(t, expenses.Delete(ctx, trip.ID, toll.ID))
+	err = expenses.Delete(ctx, trip.ID, toll.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestExpenseRepo_StopDeletedKeepsLocation(t *testing.T) {
+	tx, expenses := newExpenseTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+
+	_, err := expenses.Create(ctx, domain.Expense{
+		TripID: trip.ID, StopID: &stop.ID, Category: domain.ExpenseParking, Amount: 5,
+		Location: "Harbor lot", SpentAt: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `DELETE FROM stops WHERE id = $1`, stop.ID)
+	require.NoError(t, err)
+
+	list, err := expenses.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Nil(t, list[0].StopID)
+	assert.Equal(t, "Harbor lot", list[0].Location)
+}
+
+func TestExpenseRepo_UpdateSplit(t *testing.T) {
+	tx, expenses := newExpenseTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+
+	fuel, err := expenses.Create(ctx, domain.Expense{
+		TripID: trip.ID, Category: domain.ExpenseFuel, Amount: 90, SpentAt: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, fuel.Split.PaidBy)
+	assert.Empty(t, fuel.Split.SplitAmong)
+
+	split := domain.ExpenseSplit{PaidBy: "Ana", SplitAmong: []string{"Ana", "Ben", "Cy"}}
+	got, err := expenses.UpdateSplit(ctx, trip.ID, fuel.ID, split)
+	require.NoError(t, err)
+	assert.Equal(t, split, got.Split)
+
+	list, err := expenses.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, split, list[0].Split)
+
+	got, err = expenses.UpdateSplit(ctx, trip.ID, fuel.ID, domain.ExpenseSplit{})
+	require.NoError(t, err)
+	assert.Empty(t, got.Split.PaidBy, "clearing the split unclaims the expense")
+	assert.Empty(t, got.Split.SplitAmong)
+
+	other := factory.Trip().Insert(t, tx)
+	_, err = expenses.UpdateSplit(ctx, other.ID, fuel.ID, split)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
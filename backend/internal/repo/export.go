@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ExportRepo reads the full-data export.
+type ExportRepo interface {
+	// Stream calls fn with one row per stop across the organization's trips, plus one row
+	// with empty stop fields for each trip without stops. Trips come newest
+	// start date first, and each trip's stops in arrival order.
+	//
+	// Rows are read off the connection only as fn returns, so a slow
+	// consumer slows the query down instead of rows piling up in memory.
+	// Stream stops at, and returns, the first error fn returns.
+	Stream(ctx context.Context, fn func(domain.ExportRow) error) error
+
+	// Deletions returns the tombstones of the organization's trips and stops
+	// purged at or after since, oldest first. A private trip's tombstones,
+	// and those of its stops, are returned only to its owner.
+	Deletions(ctx context.Context, since time.Time) ([]domain.Tombstone, error)
+}
+
+// pgExportRepo is the Postgres implementation of ExportRepo.
+type pgExportRepo struct {
+	db db
+}
+
+// NewExportRepo constructs an ExportRepo backed by the provided db connection.
+func NewExportRepo(db db) ExportRepo {
+	return &pgExportRepo{db: db}
+}
+
+// Stream runs the export as a single query and scans it row by row. Tags
+// and expense totals come from correlated subqueries rather than a GROUP
+// BY, so Postgres can send the first rows before it has read the last.
+func (r *pgExportRepo) Stream(ctx context.Context, fn func(domain.ExportRow) error) error {
+	const q = `
+		SELECT t.id, t.name, t.start_date, t.end_date,
+		       COALESCE(s.name, ''), COALESCE(s.location, ''), s.arrived_at, s.departed_at, COALESCE(s.notes, ''),
+		       CASE WHEN s.id IS NOT NULL THEN COALESCE((
+		           SELECT array_agg(tg.slug ORDER BY tg.slug)
+		           FROM stop_tags st
+		           JOIN tags tg ON tg.id = st.tag_id
+		           WHERE st.stop_id = s.id
+		       ), '{}') END,
+		       s.latitude, s.longitude, COALESCE(sp.region_name, ''),
+		       (s.odometer_miles - lag(s.odometer_miles) OVER (PARTITION BY t.id ORDER BY s.arrived_at, s.id))::float8,
+		       spent.total, CASE WHEN s.id IS NOT NULL THEN COALESCE(spent.categories, '{}') END
+		FROM trips t
+		LEFT JOIN stops s ON s.trip_id = t.id AND s.deleted_at IS NULL
+		LEFT JOIN stop_places sp ON sp.stop_id = s.id AND sp.latitude = s.latitude AND sp.longitude = s.longitude
+		LEFT JOIN LATERAL (
+		    SELECT sum(e.amount)::float8 AS total, array_agg(DISTINCT e.category ORDER BY e.category) AS categories
+		    FROM expenses e WHERE e.stop_id = s.id
+		) spent ON s.id IS NOT NULL
+		WHERE t.organization_id = @organization_id AND t.deleted_at IS NULL
+		  AND (t.user_id IS NULL OR t.user_id = @user_id OR t.id IN ` + memberTripsSQL + `)
+		ORDER BY t.start_date DESC, t.id, s.arrived_at, s.id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return fmt.Errorf("repo.ExportRepo.Stream: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			row       domain.ExportRow
+			tripID    pgtype.UUID
+			startDate pgtype.Date
+			endDate   pgtype.Date
+		)
+		if err := rows.Scan(&tripID, &row.TripName, &startDate, &endDate,
+			&row.StopName, &row.StopLocation, &row.ArrivedAt, &row.DepartedAt, &row.StopNotes, &row.Tags,
+			&row.Latitude, &row.Longitude, &row.State, &row.DistanceMiles, &row.Cost, &row.ExpenseCategories); err != nil {
+			return fmt.Errorf("repo.ExportRepo.Stream: scan: %w", err)
+		}
+		row.TripID = uuid.UUID(tripID.Bytes).String()
+		row.TripStartDate = domain.DateOf(startDate.Time)
+		row.TripEndDate = nullDate(endDate)
+
+		if err := fn(row); err != nil {
+			return fmt.Errorf("repo.ExportRepo.Stream: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("repo.ExportRepo.Stream: rows: %w", err)
+	}
+	return nil
+}
+
+// Deletions reads the tombstones the trash purge wrote.
+func (r *pgExportRepo) Deletions(ctx context.Context, since time.Time) ([]domain.Tombstone, error) {
+	const q = `
+		SELECT entity_type, id, deleted_at
+		FROM tombstones
+		WHERE organization_id = @organization_id AND deleted_at >= @since
+		  AND (user_id IS NULL OR user_id = @user_id)
+		ORDER BY deleted_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"since": since}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ExportRepo.Deletions: %w", err)
+	}
+	defer rows.Close()
+
+	tombstones := []domain.Tombstone{}
+	for rows.Next() {
+		var (
+			tombstone domain.Tombstone
+			kind      string
+			id        pgtype.UUID
+		)
+		if err := rows.Scan(&kind, &id, &tombstone.DeletedAt); err != nil {
+			return nil, fmt.Errorf("repo.ExportRepo.Deletions: scan: %w", err)
+		}
+		tombstone.Kind = domain.TrashKind(kind)
+		tombstone.ID = uuid.UUID(id.Bytes)
+		tombstones = append(tombstones, tombstone)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ExportRepo.Deletions: rows: %w", err)
+	}
+	return tombstones, nil
+}
@@ -0,0 +1,223 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func TestExportRepo_Stream(t *testing.T) {
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	exports := repo.NewExportRepo(tx)
+
+	end := domain.NewDate(2025, 6, 15)
+	june := factory.Trip().WithName("June").WithStartDate(domain.NewDate(2025, 6, 1)).WithEndDate(&end).Insert(t, tx)
+	july := factory.Trip().WithName("July").WithStartDate(domain.NewDate(2025, 7, 1)).Insert(t, tx)
+	factory.Trip().WithName("Empty").WithStartDate(domain.NewDate(2025, 5, 1)).Insert(t, tx)
+	departed := time.Date(2025, 6, 4, 10, 0, 0, 0, time.UTC)
+	factory.Stop().WithTripID(june.ID).WithName("Moab").WithArrivedAt(day(2025, 6, 5)).Insert(t, tx)
+	factory.Stop().WithTripID(june.ID).WithName("Arches").WithLocation("Utah").WithNotes("windy").
+		WithArrivedAt(day(2025, 6, 2)).WithDepartedAt(&departed).WithTags("Hiking", "Camping").Insert(t, tx)
+	factory.Stop().WithTripID(july.ID).WithName("Bend").WithArrivedAt(day(2025, 7, 2)).Insert(t, tx)
+
+	var rows []domain.ExportRow
+	err = exports.Stream(ctx, func(row domain.ExportRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var names []string
+	for _, r := range rows {
+		names = append(names, r.TripName+"/"+r.StopName)
+	}
+	assert.Equal(t, []string{"July/Bend", "June/Arches", "June/Moab", "Empty/"}, names,
+		"newest trip first, stops in arrival order, an empty trip once")
+
+	arches := rows[1]
+	assert.Equal(t, june.ID.String(), arches.TripID)
+	assert.Equal(t, domain.NewDate(2025, 6, 1), arches.TripStartDate)
+	assert.Equal(t, &end, arches.TripEndDate)
+	assert.Equal(t, "Utah", arches.StopLocation)
+	assert.Equal(t, "windy", arches.StopNotes)
+	require.NotNil(t, arches.DepartedAt)
+	assert.True(t, departed.Equal(*arches.DepartedAt))
+	assert.Equal(t, []string{"camping", "hiking"}, arches.Tags)
+
+	assert.Equal(t, []string{}, rows[2].Tags, "a stop without tags has an empty list")
+	assert.Nil(t, rows[0].TripEndDate)
+
+	empty := rows[3]
+	assert.Nil(t, empty.ArrivedAt)
+	assert.Nil(t, empty.Tags)
+}
+
+func TestExportRepo_Stream_StopFigures(t *testing.T) {
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	stops := repo.NewStopRepo(tx)
+
+	trip := factory.Trip().Insert(t, tx)
+	odometer := func(b *factory.StopBuilder, miles float64) domain.Stop {
+		st := b.WithTripID(trip.ID).Build()
+		st.OdometerMiles = &miles
+		created, err := stops.Create(ctx, st)
+		require.NoError(t, err)
+		return created
+	}
+	moab := odometer(factory.Stop().WithName("Moab").WithCoordinates(38.5733, -109.5498).WithArrivedAt(day(2025, 6, 2)), 42000)
+	bryce := odometer(factory.Stop().WithName("Bryce").WithArrivedAt(day(2025, 6, 5)), 42270.5)
+	_, err = repo.NewPlaceRepo(tx).Upsert(ctx, domain.StopPlace{
+		StopID: moab.ID, Latitude: 38.5733, Longitude: -109.5498, CountryCode: "US", RegionCode: "US-UT", RegionName: "Utah",
+	})
+	require.NoError(t, err)
+	expenses := repo.NewExpenseRepo(tx)
+	for _, e := range []domain.Expense{
+		{Category: domain.ExpenseFuel, Amount: 80.25},
+		{Category: domain.ExpenseCampground, Amount: 35},
+		{Category: domain.ExpenseFuel, Amount: 20},
+	} {
+		e.TripID, e.StopID, e.SpentAt = trip.ID, &bryce.ID, day(2025, 6, 5)
+		_, err := expenses.Create(ctx, e)
+		require.NoError(t, err)
+	}
+
+	var rows []domain.ExportRow
+	require.NoError(t, repo.NewExportRepo(tx).Stream(ctx, func(row domain.ExportRow) error {
+		rows = append(rows, row)
+		return nil
+	}))
+
+	require.Len(t, rows, 2)
+	first, second := rows[0], rows[1]
+	require.NotNil(t, first.Latitude)
+	assert.InDelta(t, 38.5733, *first.Latitude, 1e-9)
+	assert.Equal(t, "Utah", first.State)
+	assert.Nil(t, first.DistanceMiles, "no earlier stop to drive from")
+	assert.Nil(t, first.Cost)
+	assert.Equal(t, []string{}, first.ExpenseCategories)
+
+	assert.Nil(t, second.Latitude)
+	assert.Empty(t, second.State)
+	require.NotNil(t, second.DistanceMiles)
+	assert.InDelta(t, 270.5, *second.DistanceMiles, 1e-9)
+	require.NotNil(t, second.Cost)
+	assert.InDelta(t, 135.25, *second.Cost, 1e-9)
+	assert.Equal(t, []string{"campground", "fuel"}, second.ExpenseCategories)
+}
+
+func TestExportRepo_Stream_StopsAtConsumerError(t *testing.T) {
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+
+	trip := factory.Trip().Insert(t, tx)
+	for i := range 3 {
+		factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, i+1)).Insert(t, tx)
+	}
+
+	stop := errors.New("client went away")
+	calls := 0
+	err = repo.NewExportRepo(tx).Stream(ctx, func(domain.ExportRow) error {
+		calls++
+		return stop
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+
+	// The connection is usable again once Stream returns.
+	var one int
+	require.NoError(t, tx.QueryRow(ctx, `SELECT 1`).Scan(&one))
+}
+
+// purgeAll deletes for good everything in the trash, in every organization.
+func purgeAll(t *testing.T, tx pgx.Tx) {
+	t.Helper()
+	_, err := repo.NewTrashRepo(tx).Purge(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+}
+
+// tombstoneIDs returns the IDs of tombstones, by kind.
+func tombstoneIDs(tombstones []domain.Tombstone) map[domain.TrashKind][]uuid.UUID {
+	ids := map[domain.TrashKind][]uuid.UUID{}
+	for _, ts := range tombstones {
+		ids[ts.Kind] = append(ids[ts.Kind], ts.ID)
+	}
+	return ids
+}
+
+func TestExportRepo_Deletions(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.NewPool(t).Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	exports := repo.NewExportRepo(tx)
+
+	dropped := factory.Trip().Insert(t, tx)
+	droppedStop := factory.Stop().WithTripID(dropped.ID).Insert(t, tx)
+	kept := factory.Trip().Insert(t, tx)
+	keptStop := factory.Stop().WithTripID(kept.ID).Insert(t, tx)
+	loneStop := factory.Stop().WithTripID(kept.ID).Insert(t, tx)
+	trashedStop := factory.Stop().WithTripID(kept.ID).Insert(t, tx)
+	require.NoError(t, repo.NewTripRepo(tx).Delete(ctx, dropped.ID))
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, kept.ID, loneStop.ID))
+
+	before := time.Now().Add(-time.Hour)
+	got, err := exports.Deletions(ctx, before)
+	require.NoError(t, err)
+	assert.Empty(t, got, "nothing is tombstoned while it can still be restored")
+
+	purgeAll(t, tx)
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, kept.ID, trashedStop.ID))
+
+	got, err = exports.Deletions(ctx, before)
+	require.NoError(t, err)
+	ids := tombstoneIDs(got)
+	assert.Equal(t, []uuid.UUID{dropped.ID}, ids[domain.TrashTrip])
+	assert.ElementsMatch(t, []uuid.UUID{droppedStop.ID, loneStop.ID}, ids[domain.TrashStop],
+		"a purged trip's stops are tombstoned with it")
+	assert.NotContains(t, ids[domain.TrashStop], keptStop.ID)
+
+	got, err = exports.Deletions(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, got, "since leaves out earlier deletions")
+}
+
+func TestExportRepo_Deletions_PrivateTrip(t *testing.T) {
+	tx, trip, asOwner, asOther := newPrivateTrip(t)
+	exports := repo.NewExportRepo(tx)
+	require.NoError(t, repo.NewTripRepo(tx).Delete(asOwner, trip.ID))
+	purgeAll(t, tx)
+	before := time.Now().Add(-time.Hour)
+
+	got, err := exports.Deletions(asOwner, before)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{trip.ID}, tombstoneIDs(got)[domain.TrashTrip])
+
+	got, err = exports.Deletions(asOther, before)
+	require.NoError(t, err)
+	assert.Empty(t, got, "another user's private trip is not theirs to know about")
+}
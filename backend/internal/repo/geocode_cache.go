@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// GeocodeCacheRepo defines the persistence operations for cached geocoder
+// answers. Unlike most repos it is not scoped to an organization: where a
+// point lies is the same for everyone, and sharing answers is what keeps
+// lookups of a popular campground down to one.
+type GeocodeCacheRepo interface {
+	// Get returns the provider's answer for query if it is still fresh at
+	// now. Returns domain.ErrNotFound if there is none or it has expired.
+	Get(ctx context.Context, provider, query string, now time.Time) (domain.GeocodeCacheEntry, error)
+
+	// Put stores an answer, replacing any earlier one for the same provider
+	// and query.
+	Put(ctx context.Context, entry domain.GeocodeCacheEntry) error
+}
+
+// pgGeocodeCacheRepo is the Postgres implementation of GeocodeCacheRepo.
+type pgGeocodeCacheRepo struct {
+	db db
+}
+
+// NewGeocodeCacheRepo constructs a GeocodeCacheRepo backed by the provided
+// db connection.
+func NewGeocodeCacheRepo(db db) GeocodeCacheRepo {
+	return &pgGeocodeCacheRepo{db: db}
+}
+
+// Get reads one unexpired answer.
+func (r *pgGeocodeCacheRepo) Get(ctx context.Context, provider, query string, now time.Time) (domain.GeocodeCacheEntry, error) {
+	const q = `
+		SELECT provider, query, country_code, region_code, region_name, fetched_at, expires_at
+		FROM geocode_cache
+		WHERE provider = @provider AND query = @query AND expires_at > @now`
+
+	e := domain.GeocodeCacheEntry{}
+	err := r.db.QueryRow(ctx, q, pgx.NamedArgs{"provider": provider, "query": query, "now": now}).
+		Scan(&e.Provider, &e.Query, &e.CountryCode, &e.RegionCode, &e.RegionName, &e.FetchedAt, &e.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GeocodeCacheEntry{}, fmt.Errorf("repo.GeocodeCacheRepo.Get: %w", domain.ErrNotFound)
+		}
+		return domain.GeocodeCacheEntry{}, fmt.Errorf("repo.GeocodeCacheRepo.Get: %w", err)
+	}
+	return e, nil
+}
+
+// Put upserts an answer. Expired rows are not deleted separately; they are
+// overwritten the next time their query is looked up.
+func (r *pgGeocodeCacheRepo) Put(ctx context.Context, entry domain.GeocodeCacheEntry) error {
+	const q = `
+		INSERT INTO geocode_cache (provider, query, country_code, region_code, region_name, fetched_at, expires_at)
+		VALUES (@provider, @query, @country_code, @region_code, @region_name, @fetched_at, @expires_at)
+		ON CONFLICT (provider, query) DO UPDATE
+		SET country_code = EXCLUDED.country_code,
+		    region_code = EXCLUDED.region_code,
+		    region_name = EXCLUDED.region_name,
+		    fetched_at = EXCLUDED.fetched_at,
+		    expires_at = EXCLUDED.expires_at`
+
+	_, err := r.db.Exec(ctx, q, pgx.NamedArgs{
+		"provider":     entry.Provider,
+		"query":        entry.Query,
+		"country_code": entry.CountryCode,
+		"region_code":  entry.RegionCode,
+		"region_name":  entry.RegionName,
+		"fetched_at":   entry.FetchedAt,
+		"expires_at":   entry.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("repo.GeocodeCacheRepo.Put: %w", err)
+	}
+	return nil
+}
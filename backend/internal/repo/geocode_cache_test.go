@@ -0,0 +1,67 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// newGeocodeCacheTestRepo returns a GeocodeCacheRepo inside a rolled-back
+// transaction.
+func newGeocodeCacheTestRepo(t *testing.T) repo.GeocodeCacheRepo {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewGeocodeCacheRepo(tx)
+}
+
+func TestGeocodeCacheRepo_PutAndGet(t *testing.T) {
+	cache := newGeocodeCacheTestRepo(t)
+	ctx := context.Background()
+	fetched := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	entry := domain.GeocodeCacheEntry{
+		Provider: "nominatim", Query: "48.696,-113.718",
+		CountryCode: "US", RegionCode: "US-MT", RegionName: "Montana",
+		FetchedAt: fetched, ExpiresAt: fetched.Add(24 * time.Hour),
+	}
+	require.NoError(t, cache.Put(ctx, entry))
+
+	got, err := cache.Get(ctx, "nominatim", "48.696,-113.718", fetched.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "US-MT", got.RegionCode)
+	assert.True(t, entry.ExpiresAt.Equal(got.ExpiresAt))
+
+	_, err = cache.Get(ctx, "other", "48.696,-113.718", fetched.Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrNotFound, "answers are per provider")
+	_, err = cache.Get(ctx, "nominatim", "48.696,-113.718", entry.ExpiresAt)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "expired")
+}
+
+func TestGeocodeCacheRepo_PutReplaces(t *testing.T) {
+	cache := newGeocodeCacheTestRepo(t)
+	ctx := context.Background()
+	fetched := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	entry := domain.GeocodeCacheEntry{Provider: "nominatim", Query: "0.000,-30.000", FetchedAt: fetched, ExpiresAt: fetched.Add(time.Hour)}
+	require.NoError(t, cache.Put(ctx, entry))
+
+	entry.FetchedAt, entry.ExpiresAt = fetched.Add(2*time.Hour), fetched.Add(3*time.Hour)
+	require.NoError(t, cache.Put(ctx, entry))
+
+	got, err := cache.Get(ctx, "nominatim", "0.000,-30.000", fetched.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, got.CountryCode, "nothing there is an answer")
+	assert.True(t, entry.ExpiresAt.Equal(got.ExpiresAt))
+}
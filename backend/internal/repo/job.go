@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// JobLocker coordinates scheduled jobs between API replicas sharing one
+// database, so that a job runs once per interval however many replicas are
+// scheduling it.
+type JobLocker interface {
+	// RunExclusive runs fn as the job called name, unless another replica
+	// is running it or its last run started less than every before now.
+	// Reports whether fn ran. A run is recorded only when fn succeeds, so a
+	// failed one is retried by the next replica to try; fn's error is
+	// returned as is.
+	RunExclusive(ctx context.Context, name string, every time.Duration, now time.Time, fn func(context.Context) error) (bool, error)
+}
+
+// pgJobLocker is the Postgres implementation of JobLocker. It holds a
+// session advisory lock keyed by the job's name on one pooled connection
+// while the job runs; Postgres drops the lock if the replica dies.
+type pgJobLocker struct {
+	pool *pgxpool.Pool
+}
+
+// NewJobLocker constructs a JobLocker on pool. It needs the pool itself,
+// not just a db, since the lock belongs to the connection that takes it.
+func NewJobLocker(pool *pgxpool.Pool) JobLocker {
+	return &pgJobLocker{pool: pool}
+}
+
+// RunExclusive takes the job's lock without waiting, checks job_runs, and
+// runs fn.
+func (l *pgJobLocker) RunExclusive(ctx context.Context, name string, every time.Duration, now time.Time, fn func(context.Context) error) (bool, error) {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("repo.JobLocker.RunExclusive: %s: %w", name, err)
+	}
+	defer conn.Release()
+
+	key := pgx.NamedArgs{"key": jobLockKey(name)}
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(@key)`, key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("repo.JobLocker.RunExclusive: %s: lock: %w", name, err)
+	}
+	if !locked {
+		return false, nil
+	}
+	defer func() {
+		// A connection that cannot be unlocked must not go back to the pool
+		// still holding the lock; closing it releases the lock.
+		unlockCtx := context.WithoutCancel(ctx)
+		if _, err := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock(@key)`, key); err != nil {
+			_ = conn.Conn().Close(unlockCtx)
+		}
+	}()
+
+	var due bool
+	const dueQ = `SELECT NOT EXISTS (SELECT 1 FROM job_runs WHERE name = @name AND last_run_at > @since)`
+	if err := conn.QueryRow(ctx, dueQ, pgx.NamedArgs{"name": name, "since": now.Add(-every)}).Scan(&due); err != nil {
+		return false, fmt.Errorf("repo.JobLocker.RunExclusive: %s: last run: %w", name, err)
+	}
+	if !due {
+		return false, nil
+	}
+
+	if err := fn(ctx); err != nil {
+		return true, err
+	}
+
+	const recordQ = `
+		INSERT INTO job_runs (name, last_run_at) VALUES (@name, @now)
+		ON CONFLICT (name) DO UPDATE SET last_run_at = EXCLUDED.last_run_at`
+	if _, err := conn.Exec(ctx, recordQ, pgx.NamedArgs{"name": name, "now": now}); err != nil {
+		return true, fmt.Errorf("repo.JobLocker.RunExclusive: %s: record run: %w", name, err)
+	}
+	return true, nil
+}
+
+// jobLockKey maps a job name to the 64-bit key of its advisory lock.
+func jobLockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("rv-logbook job:" + name))
+	return int64(h.Sum64())
+}
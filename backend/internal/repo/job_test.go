@@ -0,0 +1,58 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// The lock is per connection, so these tests use the pool rather than a
+// rolled-back transaction, and a job name of their own.
+
+func TestJobLocker_SkipsWhileAnotherReplicaRuns(t *testing.T) {
+	pool := testutil.NewPool(t)
+	replicaA, replicaB := repo.NewJobLocker(pool), repo.NewJobLocker(pool)
+	ctx := context.Background()
+	name := "test_" + uuid.NewString()
+	now := time.Now()
+
+	ran, err := replicaA.RunExclusive(ctx, name, time.Hour, now, func(ctx context.Context) error {
+		inner, err := replicaB.RunExclusive(ctx, name, time.Hour, now, func(context.Context) error {
+			t.Error("ran while the other replica held the lock")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, inner)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, ran)
+}
+
+func TestJobLocker_RunsOncePerInterval(t *testing.T) {
+	pool := testutil.NewPool(t)
+	jobs := repo.NewJobLocker(pool)
+	ctx := context.Background()
+	name := "test_" + uuid.NewString()
+	now := time.Now()
+	runs := 0
+	job := func(context.Context) error { runs++; return nil }
+
+	for _, at := range []time.Time{now, now.Add(30 * time.Minute), now.Add(time.Hour)} {
+		_, err := jobs.RunExclusive(ctx, name, time.Hour, at, job)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, runs)
+	_, err := pool.Exec(ctx, `DELETE FROM job_runs WHERE name = $1`, name)
+	require.NoError(t, err)
+}
@@ -0,0 +1,186 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// JournalRepo defines the persistence operations for trip journal entries.
+// Entries belong to a trip; callers check the trip and any linked stop exist.
+type JournalRepo interface {
+	// Create inserts an entry and returns the persisted record.
+	Create(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error)
+
+	// GetByID retrieves a trip's entry.
+	// Returns domain.ErrNotFound if the trip has no entry with that ID.
+	GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error)
+
+	// ListByTrip returns a trip's entries by date, then in the order they
+	// were written. A non-nil day returns only that day's entries.
+	ListByTrip(ctx context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalEntry, error)
+
+	// Update overwrites a trip's entry.
+	// Returns domain.ErrNotFound if the trip has no entry with that ID.
+	Update(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error)
+
+	// Delete removes a trip's entry.
+	// Returns domain.ErrNotFound if the trip has no entry with that ID.
+	Delete(ctx context.Context, tripID, id uuid.UUID) error
+}
+
+// pgJournalRepo is the Postgres implementation of JournalRepo.
+type pgJournalRepo struct {
+	db db
+}
+
+// NewJournalRepo constructs a JournalRepo backed by the provided db connection.
+func NewJournalRepo(db db) JournalRepo {
+	return &pgJournalRepo{db: db}
+}
+
+const journalColumns = `id, trip_id, stop_id, entry_date, body, mood, weather, created_at, updated_at`
+
+// Create inserts a journal_entries row and returns the full persisted record.
+func (r *pgJournalRepo) Create(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	const q = `
+		INSERT INTO journal_entries (trip_id, stop_id, entry_date, body, mood, weather)
+		VALUES (@trip_id, @stop_id, @entry_date, @body, @mood, @weather)
+		RETURNING ` + journalColumns
+
+	result, err := scanJournalEntry(r.db.QueryRow(ctx, q, journalArgs(e)))
+	if err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("repo.JournalRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves an entry scoped to its trip.
+func (r *pgJournalRepo) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error) {
+	const q = `
+		SELECT ` + journalColumns + ` FROM journal_entries
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL
+
+	result, err := scanJournalEntry(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID})))
+	if err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("repo.JournalRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListByTrip returns a trip's entries in date order, optionally for one day.
+func (r *pgJournalRepo) ListByTrip(ctx context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalEntry, error) {
+	const q = `
+		SELECT ` + journalColumns + `
+		FROM journal_entries
+		WHERE trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		  AND (@day::date IS NULL OR entry_date = @day)
+		ORDER BY entry_date, created_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "day": pgNullDate(day)}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.JournalRepo.ListByTrip: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []domain.JournalEntry{}
+	for rows.Next() {
+		e, err := scanJournalEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.JournalRepo.ListByTrip: scan: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.JournalRepo.ListByTrip: rows: %w", err)
+	}
+	return entries, nil
+}
+
+// Update overwrites an entry scoped to its trip.
+func (r *pgJournalRepo) Update(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	const q = `
+		UPDATE journal_entries
+		SET stop_id = @stop_id,
+		    entry_date = @entry_date,
+		    body = @body,
+		    mood = @mood,
+		    weather = @weather,
+		    updated_at = now()
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		RETURNING ` + journalColumns
+
+	args := scoped(ctx, journalArgs(e))
+	args["id"] = e.ID
+	result, err := scanJournalEntry(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("repo.JournalRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes an entry scoped to its trip.
+func (r *pgJournalRepo) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	const q = `DELETE FROM journal_entries WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID}))
+	if err != nil {
+		return fmt.Errorf("repo.JournalRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.JournalRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// journalArgs returns the named arguments shared by Create and Update.
+func journalArgs(e domain.JournalEntry) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"trip_id":    e.TripID,
+		"stop_id":    e.StopID, // nil becomes NULL
+		"entry_date": pgDate(e.Date),
+		"body":       e.Body,
+		"mood":       nullableString(string(e.Mood)),
+		"weather":    nullableString(string(e.Weather)),
+	}
+}
+
+// scanJournalEntry maps a single journal_entries row into a domain.JournalEntry.
+func scanJournalEntry(s scanner) (domain.JournalEntry, error) {
+	var (
+		e       domain.JournalEntry
+		id      pgtype.UUID
+		tripID  pgtype.UUID
+		stopID  pgtype.UUID
+		day     pgtype.Date
+		mood    *string
+		weather *string
+	)
+	err := s.Scan(&id, &tripID, &stopID, &day, &e.Body, &mood, &weather, &e.CreatedAt, &e.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.JournalEntry{}, domain.ErrNotFound
+		}
+		return domain.JournalEntry{}, err
+	}
+	e.ID = uuid.UUID(id.Bytes)
+	e.TripID = uuid.UUID(tripID.Bytes)
+	if stopID.Valid {
+		sid := uuid.UUID(stopID.Bytes)
+		e.StopID = &sid
+	}
+	e.Date = domain.DateOf(day.Time)
+	if mood != nil {
+		e.Mood = domain.JournalMood(*mood)
+	}
+	if weather != nil {
+		e.Weather = domain.JournalWeather(*weather)
+	}
+	return e, nil
+}
@@ -0,0 +1,117 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newJournalTestRepo returns a JournalRepo and its rolled-back transaction,
+// so parent trips and stops can be inserted with testutil/factory.
+func newJournalTestRepo(t *testing.T) (pgx.Tx, repo.JournalRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewJournalRepo(tx)
+}
+
+func TestJournalRepo_CRUD(t *testing.T) {
+	tx, journal := newJournalTestRepo(t)
+	ctx := context.Background()
+	stop := factory.Stop().Insert(t, tx)
+
+	created, err := journal.Create(ctx, domain.JournalEntry{
+		TripID:  stop.TripID,
+		StopID:  &stop.ID,
+		Date:    domain.Date{Year: 2025, Month: 7, Day: 14},
+		Body:    "Crossed at **Peace Arch**.",
+		Weather: domain.WeatherSunny,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &stop.ID, created.StopID)
+	assert.Equal(t, domain.WeatherSunny, created.Weather)
+	assert.Empty(t, created.Mood)
+
+	created.StopID = nil
+	created.Mood = domain.MoodGreat
+	updated, err := journal.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Nil(t, updated.StopID)
+	assert.Equal(t, domain.MoodGreat, updated.Mood)
+
+	got, err := journal.GetByID(ctx, stop.TripID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Crossed at **Peace Arch**.", got.Body)
+
+	other := factory.Trip().Insert(t, tx)
+	_, err = journal.GetByID(ctx, other.ID, created.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "entry is scoped to its trip")
+
+	require.NoError(t, journal.Delete(ctx, stop.TripID, created.ID))
+	err = journal.Delete(ctx, stop.TripID, created.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestJournalRepo_ListByTrip(t *testing.T) {
+	tx, journal := newJournalTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	jul14 := domain.Date{Year: 2025, Month: 7, Day: 14}
+	jul15 := domain.Date{Year: 2025, Month: 7, Day: 15}
+
+	for _, e := range []domain.JournalEntry{
+		{Date: jul15, Body: "Rain all day."},
+		{Date: jul14, Body: "Border in the morning."},
+		{Date: jul14, Body: "Campfire at night."},
+	} {
+		e.TripID = trip.ID
+		_, err := journal.Create(ctx, e)
+		require.NoError(t, err)
+	}
+
+	all, err := journal.ListByTrip(ctx, trip.ID, nil)
+	require.NoError(t, err)
+	var bodies []string
+	for _, e := range all {
+		bodies = append(bodies, e.Body)
+	}
+	assert.Equal(t, []string{"Border in the morning.", "Campfire at night.", "Rain all day."}, bodies)
+
+	oneDay, err := journal.ListByTrip(ctx, trip.ID, &jul15)
+	require.NoError(t, err)
+	require.Len(t, oneDay, 1)
+	assert.Equal(t, jul15, oneDay[0].Date)
+}
+
+func TestJournalRepo_StopDeleteKeepsEntry(t *testing.T) {
+	tx, journal := newJournalTestRepo(t)
+	ctx := context.Background()
+	stop := factory.Stop().Insert(t, tx)
+
+	created, err := journal.Create(ctx, domain.JournalEntry{
+		TripID: stop.TripID, StopID: &stop.ID, Date: domain.Date{Year: 2025, Month: 7, Day: 14}, Body: "Quiet site.",
+	})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, "DELETE FROM stops WHERE id = $1", stop.ID)
+	require.NoError(t, err)
+
+	got, err := journal.GetByID(ctx, stop.TripID, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.StopID)
+}
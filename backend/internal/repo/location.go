@@ -0,0 +1,254 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// LocationRepo defines the persistence operations for location pings and
+// the dwells detected from them.
+type LocationRepo interface {
+	// CreatePing stores a report. Returns false, without error, if the device
+	// has already sent one recorded at the same instant.
+	CreatePing(ctx context.Context, ping domain.LocationPing) (bool, error)
+
+	// ListPingsSince returns a device's reports recorded at or after since,
+	// oldest first.
+	ListPingsSince(ctx context.Context, device string, since time.Time) ([]domain.LocationPing, error)
+
+	// LatestDwell returns the device's most recently started dwell, or nil if
+	// it has none.
+	LatestDwell(ctx context.Context, device string) (*domain.Dwell, error)
+
+	// CreateDwell inserts an open dwell. Returns false, without error, if the
+	// device already has an open dwell.
+	CreateDwell(ctx context.Context, dwell domain.Dwell) (domain.Dwell, bool, error)
+
+	// GetDwell returns one of a trip's dwells.
+	// Returns domain.ErrNotFound if the trip has no dwell with that ID.
+	GetDwell(ctx context.Context, tripID, id uuid.UUID) (domain.Dwell, error)
+
+	// ListDwells returns a trip's dwells with the given status, in arrival order.
+	ListDwells(ctx context.Context, tripID uuid.UUID, status domain.DwellStatus) ([]domain.Dwell, error)
+
+	// UpdateDwell overwrites a dwell's last_seen_at, departed_at, status, and
+	// stop_id. Returns domain.ErrNotFound if the trip has no dwell with that ID.
+	UpdateDwell(ctx context.Context, dwell domain.Dwell) (domain.Dwell, error)
+}
+
+// pgLocationRepo is the Postgres implementation of LocationRepo.
+type pgLocationRepo struct {
+	db db
+}
+
+// NewLocationRepo constructs a LocationRepo backed by the provided db connection.
+func NewLocationRepo(db db) LocationRepo {
+	return &pgLocationRepo{db: db}
+}
+
+const (
+	locationPingColumns = `id, device, latitude, longitude, accuracy_meters, recorded_at, created_at`
+	dwellColumns        = `id, trip_id, device, latitude, longitude, arrived_at, last_seen_at, departed_at,
+	status, stop_id, created_at, updated_at`
+)
+
+// CreatePing inserts a ping, skipping a repeat of one already stored.
+func (r *pgLocationRepo) CreatePing(ctx context.Context, ping domain.LocationPing) (bool, error) {
+	const q = `
+		INSERT INTO location_pings (organization_id, device, latitude, longitude, accuracy_meters, recorded_at)
+		VALUES (@organization_id, @device, @latitude, @longitude, @accuracy_meters, @recorded_at)
+		ON CONFLICT (organization_id, device, recorded_at) DO NOTHING`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"device":          ping.Device,
+		"latitude":        ping.Latitude,
+		"longitude":       ping.Longitude,
+		"accuracy_meters": ping.AccuracyMeters, // nil becomes NULL
+		"recorded_at":     ping.RecordedAt,
+	}))
+	if err != nil {
+		return false, fmt.Errorf("repo.LocationRepo.CreatePing: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ListPingsSince returns a device's recent pings in time order.
+func (r *pgLocationRepo) ListPingsSince(ctx context.Context, device string, since time.Time) ([]domain.LocationPing, error) {
+	const q = `
+		SELECT ` + locationPingColumns + `
+		FROM location_pings
+		WHERE organization_id = @organization_id AND device = @device AND recorded_at >= @since
+		ORDER BY recorded_at`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"device": device, "since": since}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.LocationRepo.ListPingsSince: %w", err)
+	}
+	defer rows.Close()
+
+	pings := []domain.LocationPing{}
+	for rows.Next() {
+		var (
+			p  domain.LocationPing
+			id pgtype.UUID
+		)
+		if err := rows.Scan(&id, &p.Device, &p.Latitude, &p.Longitude, &p.AccuracyMeters, &p.RecordedAt, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("repo.LocationRepo.ListPingsSince: scan: %w", err)
+		}
+		p.ID = uuid.UUID(id.Bytes)
+		pings = append(pings, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.LocationRepo.ListPingsSince: rows: %w", err)
+	}
+	return pings, nil
+}
+
+// LatestDwell returns the device's newest dwell, or nil.
+func (r *pgLocationRepo) LatestDwell(ctx context.Context, device string) (*domain.Dwell, error) {
+	const q = `
+		SELECT ` + dwellColumns + `
+		FROM location_dwells
+		WHERE organization_id = @organization_id AND device = @device
+		ORDER BY arrived_at DESC
+		LIMIT 1`
+
+	dwell, err := scanDwell(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"device": device})))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.LocationRepo.LatestDwell: %w", err)
+	}
+	return &dwell, nil
+}
+
+// CreateDwell inserts an open dwell unless the device already has one. The
+// partial unique index on open dwells makes the check race-free.
+func (r *pgLocationRepo) CreateDwell(ctx context.Context, dwell domain.Dwell) (domain.Dwell, bool, error) {
+	const q = `
+		INSERT INTO location_dwells (organization_id, trip_id, device, latitude, longitude, arrived_at, last_seen_at, status)
+		VALUES (@organization_id, @trip_id, @device, @latitude, @longitude, @arrived_at, @last_seen_at, @status)
+		ON CONFLICT (organization_id, device) WHERE departed_at IS NULL DO NOTHING
+		RETURNING ` + dwellColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"trip_id":      dwell.TripID,
+		"device":       dwell.Device,
+		"latitude":     dwell.Latitude,
+		"longitude":    dwell.Longitude,
+		"arrived_at":   dwell.ArrivedAt,
+		"last_seen_at": dwell.LastSeenAt,
+		"status":       string(dwell.Status),
+	}))
+	result, err := scanDwell(row)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.Dwell{}, false, nil
+	}
+	if err != nil {
+		return domain.Dwell{}, false, fmt.Errorf("repo.LocationRepo.CreateDwell: %w", err)
+	}
+	return result, true, nil
+}
+
+// GetDwell retrieves a dwell by primary key, scoped to its trip.
+func (r *pgLocationRepo) GetDwell(ctx context.Context, tripID, id uuid.UUID) (domain.Dwell, error) {
+	const q = `
+		SELECT ` + dwellColumns + ` FROM location_dwells
+		WHERE id = @id AND trip_id = @trip_id AND organization_id = @organization_id AND trip_id IN ` + orgTripsSQL
+
+	result, err := scanDwell(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID})))
+	if err != nil {
+		return domain.Dwell{}, fmt.Errorf("repo.LocationRepo.GetDwell: %w", err)
+	}
+	return result, nil
+}
+
+// ListDwells returns a trip's dwells in one status, oldest arrival first.
+func (r *pgLocationRepo) ListDwells(ctx context.Context, tripID uuid.UUID, status domain.DwellStatus) ([]domain.Dwell, error) {
+	const q = `
+		SELECT ` + dwellColumns + `
+		FROM location_dwells
+		WHERE trip_id = @trip_id AND organization_id = @organization_id AND status = @status AND trip_id IN ` + orgTripsSQL + `
+		ORDER BY arrived_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "status": string(status)}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.LocationRepo.ListDwells: %w", err)
+	}
+	defer rows.Close()
+
+	dwells := []domain.Dwell{}
+	for rows.Next() {
+		d, err := scanDwell(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.LocationRepo.ListDwells: scan: %w", err)
+		}
+		dwells = append(dwells, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.LocationRepo.ListDwells: rows: %w", err)
+	}
+	return dwells, nil
+}
+
+// UpdateDwell overwrites the mutable columns of a trip's dwell.
+func (r *pgLocationRepo) UpdateDwell(ctx context.Context, dwell domain.Dwell) (domain.Dwell, error) {
+	const q = `
+		UPDATE location_dwells
+		SET last_seen_at = @last_seen_at,
+		    departed_at = @departed_at,
+		    status = @status,
+		    stop_id = @stop_id,
+		    updated_at = now()
+		WHERE id = @id AND trip_id = @trip_id AND organization_id = @organization_id AND trip_id IN ` + orgTripsSQL + `
+		RETURNING ` + dwellColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":           dwell.ID,
+		"trip_id":      dwell.TripID,
+		"last_seen_at": dwell.LastSeenAt,
+		"departed_at":  dwell.DepartedAt, // nil becomes NULL
+		"status":       string(dwell.Status),
+		"stop_id":      dwell.StopID, // nil becomes NULL
+	}))
+	result, err := scanDwell(row)
+	if err != nil {
+		return domain.Dwell{}, fmt.Errorf("repo.LocationRepo.UpdateDwell: %w", err)
+	}
+	return result, nil
+}
+
+// scanDwell maps a single location_dwells row into a domain.Dwell.
+func scanDwell(s scanner) (domain.Dwell, error) {
+	var (
+		d          domain.Dwell
+		id, tripID pgtype.UUID
+		stopID     pgtype.UUID
+		status     string
+	)
+	err := s.Scan(&id, &tripID, &d.Device, &d.Latitude, &d.Longitude, &d.ArrivedAt, &d.LastSeenAt, &d.DepartedAt,
+		&status, &stopID, &d.CreatedAt, &d.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Dwell{}, domain.ErrNotFound
+		}
+		return domain.Dwell{}, err
+	}
+	d.ID = uuid.UUID(id.Bytes)
+	d.TripID = uuid.UUID(tripID.Bytes)
+	d.Status = domain.DwellStatus(status)
+	if stopID.Valid {
+		sid := uuid.UUID(stopID.Bytes)
+		d.StopID = &sid
+	}
+	return d, nil
+}
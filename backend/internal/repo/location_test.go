@@ -0,0 +1,162 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newLocationTestRepo returns a LocationRepo and its rolled-back transaction,
+// so parent trips and stops can be inserted with testutil/factory.
+func newLocationTestRepo(t *testing.T) (pgx.Tx, repo.LocationRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewLocationRepo(tx)
+}
+
+func TestLocationRepo_Pings(t *testing.T) {
+	_, locations := newLocationTestRepo(t)
+	ctx := context.Background()
+	start := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+	acc := 12.0
+
+	for _, p := range []domain.LocationPing{
+		{Device: "rv", Latitude: 44.6455, Longitude: -110.8617, RecordedAt: start.Add(10 * time.Minute), AccuracyMeters: &acc},
+		{Device: "rv", Latitude: 44.6456, Longitude: -110.8618, RecordedAt: start},
+		{Device: "truck", Latitude: 40, Longitude: -105, RecordedAt: start},
+	} {
+		inserted, err := locations.CreatePing(ctx, p)
+		require.NoError(t, err)
+		assert.True(t, inserted)
+	}
+
+	inserted, err := locations.CreatePing(ctx, domain.LocationPing{Device: "rv", Latitude: 1, Longitude: 1, RecordedAt: start})
+	require.NoError(t, err)
+	assert.False(t, inserted, "a resent report is dropped")
+
+	pings, err := locations.ListPingsSince(ctx, "rv", start)
+	require.NoError(t, err)
+	require.Len(t, pings, 2)
+	assert.True(t, pings[0].RecordedAt.Equal(start), "oldest first")
+	assert.Nil(t, pings[0].AccuracyMeters)
+	require.NotNil(t, pings[1].AccuracyMeters)
+	assert.InDelta(t, 12, *pings[1].AccuracyMeters, 1e-9)
+
+	pings, err = locations.ListPingsSince(ctx, "rv", start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Len(t, pings, 1)
+}
+
+func TestLocationRepo_Dwells(t *testing.T) {
+	tx, locations := newLocationTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	arrived := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+
+	latest, err := locations.LatestDwell(ctx, "rv")
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	dwell, created, err := locations.CreateDwell(ctx, domain.Dwell{
+		TripID: trip.ID, Device: "rv", Latitude: 44.6455, Longitude: -110.8617,
+		ArrivedAt: arrived, LastSeenAt: arrived.Add(30 * time.Minute), Status: domain.DwellSuggested,
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+	assert.True(t, dwell.Open())
+
+	_, created, err = locations.CreateDwell(ctx, domain.Dwell{
+		TripID: trip.ID, Device: "rv", Latitude: 44, Longitude: -110,
+		ArrivedAt: arrived.Add(time.Hour), LastSeenAt: arrived.Add(time.Hour), Status: domain.DwellSuggested,
+	})
+	require.NoError(t, err)
+	assert.False(t, created, "one open dwell per device")
+
+	suggested, err := locations.ListDwells(ctx, trip.ID, domain.DwellSuggested)
+	require.NoError(t, err)
+	require.Len(t, suggested, 1)
+
+	departed := arrived.Add(2 * time.Hour)
+	dwell.LastSeenAt = arrived.Add(90 * time.Minute)
+	dwell.DepartedAt = &departed
+	dwell.Status = domain.DwellAccepted
+	dwell.StopID = &stop.ID
+	updated, err := locations.UpdateDwell(ctx, dwell)
+	require.NoError(t, err)
+	assert.False(t, updated.Open())
+	require.NotNil(t, updated.StopID)
+	assert.Equal(t, stop.ID, *updated.StopID)
+
+	got, err := locations.GetDwell(ctx, trip.ID, dwell.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DwellAccepted, got.Status)
+	assert.True(t, got.LastSeenAt.Equal(dwell.LastSeenAt))
+
+	latest, err = locations.LatestDwell(ctx, "rv")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, dwell.ID, latest.ID)
+
+	suggested, err = locations.ListDwells(ctx, trip.ID, domain.DwellSuggested)
+	require.NoError(t, err)
+	assert.Empty(t, suggested)
+}
+
+func TestLocationRepo_DeletingStopKeepsDwell(t *testing.T) {
+	tx, locations := newLocationTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	arrived := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+
+	dwell, _, err := locations.CreateDwell(ctx, domain.Dwell{
+		TripID: trip.ID, Device: "rv", Latitude: 44.6455, Longitude: -110.8617,
+		ArrivedAt: arrived, LastSeenAt: arrived, Status: domain.DwellSuggested,
+	})
+	require.NoError(t, err)
+	dwell.Status, dwell.StopID = domain.DwellAccepted, &stop.ID
+	_, err = locations.UpdateDwell(ctx, dwell)
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `DELETE FROM stops WHERE id = $1`, stop.ID)
+	require.NoError(t, err)
+
+	got, err := locations.GetDwell(ctx, trip.ID, dwell.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.StopID)
+	assert.Equal(t, domain.DwellAccepted, got.Status, "not suggested again")
+}
+
+func TestLocationRepo_GetDwell_WrongTrip(t *testing.T) {
+	tx, locations := newLocationTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	other := factory.Trip().Insert(t, tx)
+	arrived := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+
+	dwell, _, err := locations.CreateDwell(ctx, domain.Dwell{
+		TripID: trip.ID, Device: "rv", Latitude: 44.6455, Longitude: -110.8617,
+		ArrivedAt: arrived, LastSeenAt: arrived, Status: domain.DwellSuggested,
+	})
+	require.NoError(t, err)
+
+	_, err = locations.GetDwell(ctx, other.ID, dwell.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -3,14 +3,9 @@
 package repo_test
 
 import (
-	"context"
-	"log"
 	"os"
 	"testing"
 
-	"github.com/pressly/goose/v3"
-
-	"github.com/pkordes/rv-logbook/backend/migrations"
 	"github.com/pkordes/rv-logbook/backend/testutil"
 )
 
@@ -18,28 +13,22 @@ import (
 // It applies all pending migrations to the test database so individual tests
 // never need to think about schema state.
 //
+// When TEST_DATABASE_URL is unset, SetupTestDatabase first starts a
+// throwaway Postgres container, if docker or podman is installed, and points
+// TEST_DATABASE_URL at it; the container is removed once the tests finish.
+//
 // This is the Go equivalent of a JUnit @BeforeAll — it runs once for the
 // entire test binary, not once per test function.
 func TestMain(m *testing.M) {
-	if os.Getenv("TEST_DATABASE_URL") == "" {
-		// No test DB configured — skip all tests in this package cleanly.
-		os.Exit(m.Run())
-	}
-
-	// Use a plain *sql.DB for goose (it needs database/sql, not pgx pool).
-	// We construct it manually here rather than through testutil.NewPool
-	// because TestMain doesn't have a *testing.T to pass.
-	db := testutil.MustOpenSQLDB(os.Getenv("TEST_DATABASE_URL"))
-	defer db.Close()
-
-	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS)
-	if err != nil {
-		log.Fatalf("TestMain: create goose provider: %v", err)
-	}
+	teardown := testutil.MustSetupTestDatabase()
 
-	if _, err := provider.Up(context.Background()); err != nil {
-		log.Fatalf("TestMain: run migrations: %v", err)
+	if dsn := os.Getenv("TEST_DATABASE_URL"); dsn != "" {
+		testutil.MustMigrate(dsn)
 	}
+	// Else: no test DB configured — tests skip cleanly via testutil.NewPool.
 
-	os.Exit(m.Run())
+	// os.Exit skips deferred calls, so tear down explicitly before exiting.
+	code := m.Run()
+	teardown()
+	os.Exit(code)
 }
@@ -0,0 +1,215 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// MaintenanceRepo defines the persistence operations for maintenance items
+// and their service records. Items are read with their last service.
+type MaintenanceRepo interface {
+	// CreateItem inserts an item and returns the persisted record.
+	CreateItem(ctx context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error)
+
+	// GetItem retrieves an item by primary key.
+	// Returns domain.ErrNotFound if no item with that ID exists.
+	GetItem(ctx context.Context, id uuid.UUID) (domain.MaintenanceItem, error)
+
+	// ListItems returns the items for vehicle, or for every vehicle when it
+	// is empty, ordered by vehicle and name.
+	ListItems(ctx context.Context, vehicle string) ([]domain.MaintenanceItem, error)
+
+	// DeleteItem removes an item and its service records.
+	// Returns domain.ErrNotFound if no item with that ID exists.
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+
+	// CreateRecord inserts a service record and returns the persisted record.
+	// Returns domain.ErrNotFound if its item does not exist.
+	CreateRecord(ctx context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error)
+}
+
+// pgMaintenanceRepo is the Postgres implementation of MaintenanceRepo.
+type pgMaintenanceRepo struct {
+	db db
+}
+
+// NewMaintenanceRepo constructs a MaintenanceRepo backed by the provided db connection.
+func NewMaintenanceRepo(db db) MaintenanceRepo {
+	return &pgMaintenanceRepo{db: db}
+}
+
+const maintenanceRecordColumns = `id, item_id, miles, serviced_on, notes, created_at`
+
+// maintenanceItemSelect reads items joined to their last service, which is
+// NULL throughout for an item never serviced.
+const maintenanceItemSelect = `
+	SELECT i.id, i.vehicle, i.name, i.interval_miles, i.created_at,
+	       r.id, r.miles, r.serviced_on, r.notes, r.created_at
+	FROM maintenance_items i
+	LEFT JOIN LATERAL (
+		SELECT id, miles, serviced_on, notes, created_at
+		FROM maintenance_records
+		WHERE item_id = i.id
+		ORDER BY miles DESC, serviced_on DESC
+		LIMIT 1
+	) r ON true`
+
+// CreateItem inserts a maintenance_items row in the request's organization.
+func (r *pgMaintenanceRepo) CreateItem(ctx context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error) {
+	const q = `
+		INSERT INTO maintenance_items (organization_id, vehicle, name, interval_miles)
+		VALUES (@organization_id, @vehicle, @name, @interval_miles)
+		RETURNING id, vehicle, name, interval_miles, created_at`
+
+	var (
+		created domain.MaintenanceItem
+		id      pgtype.UUID
+	)
+	err := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"vehicle":        item.Vehicle,
+		"name":           item.Name,
+		"interval_miles": item.IntervalMiles,
+	})).Scan(&id, &created.Vehicle, &created.Name, &created.IntervalMiles, &created.CreatedAt)
+	if err != nil {
+		return domain.MaintenanceItem{}, fmt.Errorf("repo.MaintenanceRepo.CreateItem: %w", err)
+	}
+	created.ID = uuid.UUID(id.Bytes)
+	return created, nil
+}
+
+// GetItem retrieves an item with its last service.
+func (r *pgMaintenanceRepo) GetItem(ctx context.Context, id uuid.UUID) (domain.MaintenanceItem, error) {
+	const q = maintenanceItemSelect + `
+		WHERE i.id = @id AND i.organization_id = @organization_id`
+
+	item, err := scanMaintenanceItem(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.MaintenanceItem{}, fmt.Errorf("repo.MaintenanceRepo.GetItem: %w", err)
+	}
+	return item, nil
+}
+
+// ListItems returns the organization's items, optionally for one vehicle.
+func (r *pgMaintenanceRepo) ListItems(ctx context.Context, vehicle string) ([]domain.MaintenanceItem, error) {
+	const q = maintenanceItemSelect + `
+		WHERE i.organization_id = @organization_id
+		  AND (@vehicle::text = '' OR i.vehicle = @vehicle)
+		ORDER BY i.vehicle, i.name, i.id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"vehicle": vehicle}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.MaintenanceRepo.ListItems: %w", err)
+	}
+	defer rows.Close()
+
+	items := []domain.MaintenanceItem{}
+	for rows.Next() {
+		item, err := scanMaintenanceItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.MaintenanceRepo.ListItems: scan: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.MaintenanceRepo.ListItems: rows: %w", err)
+	}
+	return items, nil
+}
+
+// DeleteItem removes an item; its records go with it by cascade.
+func (r *pgMaintenanceRepo) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM maintenance_items WHERE id = @id AND organization_id = @organization_id`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.MaintenanceRepo.DeleteItem: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.MaintenanceRepo.DeleteItem: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateRecord inserts a record for an item in the request's organization;
+// no row is inserted, and ErrNotFound returned, for any other item.
+func (r *pgMaintenanceRepo) CreateRecord(ctx context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error) {
+	const q = `
+		INSERT INTO maintenance_records (item_id, miles, serviced_on, notes)
+		SELECT id, @miles, @serviced_on, @notes
+		FROM maintenance_items
+		WHERE id = @item_id AND organization_id = @organization_id
+		RETURNING ` + maintenanceRecordColumns
+
+	var (
+		created  domain.MaintenanceRecord
+		id       pgtype.UUID
+		itemID   pgtype.UUID
+		serviced pgtype.Date
+		notes    *string
+	)
+	err := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"item_id":     rec.ItemID,
+		"miles":       rec.Miles,
+		"serviced_on": pgDate(rec.ServicedOn),
+		"notes":       nullableString(rec.Notes),
+	})).Scan(&id, &itemID, &created.Miles, &serviced, &notes, &created.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.MaintenanceRecord{}, fmt.Errorf("repo.MaintenanceRepo.CreateRecord: %w", domain.ErrNotFound)
+		}
+		return domain.MaintenanceRecord{}, fmt.Errorf("repo.MaintenanceRepo.CreateRecord: %w", err)
+	}
+	created.ID = uuid.UUID(id.Bytes)
+	created.ItemID = uuid.UUID(itemID.Bytes)
+	created.ServicedOn = domain.DateOf(serviced.Time)
+	if notes != nil {
+		created.Notes = *notes
+	}
+	return created, nil
+}
+
+// scanMaintenanceItem maps a maintenanceItemSelect row into a
+// domain.MaintenanceItem.
+func scanMaintenanceItem(s scanner) (domain.MaintenanceItem, error) {
+	var (
+		item          domain.MaintenanceItem
+		id            pgtype.UUID
+		recordID      pgtype.UUID
+		recordMiles   *float64
+		serviced      pgtype.Date
+		recordNotes   *string
+		recordCreated pgtype.Timestamptz
+	)
+	err := s.Scan(&id, &item.Vehicle, &item.Name, &item.IntervalMiles, &item.CreatedAt,
+		&recordID, &recordMiles, &serviced, &recordNotes, &recordCreated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.MaintenanceItem{}, domain.ErrNotFound
+		}
+		return domain.MaintenanceItem{}, err
+	}
+	item.ID = uuid.UUID(id.Bytes)
+	if recordID.Valid {
+		last := domain.MaintenanceRecord{
+			ID:         uuid.UUID(recordID.Bytes),
+			ItemID:     item.ID,
+			ServicedOn: domain.DateOf(serviced.Time),
+			CreatedAt:  recordCreated.Time,
+		}
+		if recordMiles != nil {
+			last.Miles = *recordMiles
+		}
+		if recordNotes != nil {
+			last.Notes = *recordNotes
+		}
+		item.LastService = &last
+	}
+	return item, nil
+}
@@ -0,0 +1,101 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// newMaintenanceTestRepo returns a MaintenanceRepo inside a rolled-back
+// transaction.
+func newMaintenanceTestRepo(t *testing.T) repo.MaintenanceRepo {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewMaintenanceRepo(tx)
+}
+
+func TestMaintenanceRepo_LastServiceIsHighestMileage(t *testing.T) {
+	items := newMaintenanceTestRepo(t)
+	ctx := context.Background()
+	vehicle := uniqueVehicle()
+
+	item, err := items.CreateItem(ctx, domain.MaintenanceItem{Vehicle: vehicle, Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+	assert.Nil(t, item.LastService)
+
+	_, err = items.CreateRecord(ctx, domain.MaintenanceRecord{ItemID: item.ID, Miles: 40000, ServicedOn: domain.NewDate(2025, 3, 1), Notes: "Synthetic"})
+	require.NoError(t, err)
+	// Back-filled older history does not become the last service.
+	_, err = items.CreateRecord(ctx, domain.MaintenanceRecord{ItemID: item.ID, Miles: 35000, ServicedOn: domain.NewDate(2024, 9, 1)})
+	require.NoError(t, err)
+
+	got, err := items.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastService)
+	assert.InDelta(t, 40000, got.LastService.Miles, 0.001)
+	assert.Equal(t, domain.NewDate(2025, 3, 1), got.LastService.ServicedOn)
+	assert.Equal(t, "Synthetic", got.LastService.Notes)
+	assert.InDelta(t, 45000, got.DueAtMiles(), 0.001)
+}
+
+func TestMaintenanceRepo_ListItems_ByVehicle(t *testing.T) {
+	items := newMaintenanceTestRepo(t)
+	ctx := context.Background()
+	vehicle := uniqueVehicle()
+
+	for _, name := range []string{"Tire rotation", "Oil change"} {
+		_, err := items.CreateItem(ctx, domain.MaintenanceItem{Vehicle: vehicle, Name: name, IntervalMiles: 5000})
+		require.NoError(t, err)
+	}
+	_, err := items.CreateItem(ctx, domain.MaintenanceItem{Vehicle: uniqueVehicle(), Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+
+	got, err := items.ListItems(ctx, vehicle)
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "Oil change", got[0].Name, "ordered by name")
+	assert.Equal(t, "Tire rotation", got[1].Name)
+}
+
+func TestMaintenanceRepo_NotFound(t *testing.T) {
+	items := newMaintenanceTestRepo(t)
+	ctx := context.Background()
+
+	_, err := items.GetItem(ctx, uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	assert.ErrorIs(t, items.DeleteItem(ctx, uuid.New()), domain.ErrNotFound)
+
+	_, err = items.CreateRecord(ctx, domain.MaintenanceRecord{ItemID: uuid.New(), Miles: 1, ServicedOn: domain.NewDate(2025, 1, 1)})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestMaintenanceRepo_DeleteItem_RemovesRecords(t *testing.T) {
+	items := newMaintenanceTestRepo(t)
+	ctx := context.Background()
+
+	item, err := items.CreateItem(ctx, domain.MaintenanceItem{Vehicle: uniqueVehicle(), Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+	_, err = items.CreateRecord(ctx, domain.MaintenanceRecord{ItemID: item.ID, Miles: 1000, ServicedOn: domain.NewDate(2025, 1, 1)})
+	require.NoError(t, err)
+
+	require.NoError(t, items.DeleteItem(ctx, item.ID))
+
+	_, err = items.GetItem(ctx, item.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,178 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// NoteTemplateRepo defines the persistence operations for note templates.
+// The answers given to them are stored with the stops.
+type NoteTemplateRepo interface {
+	// Create inserts a template and returns the persisted record.
+	Create(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error)
+
+	// GetByID retrieves a template. Returns domain.ErrNotFound if it does
+	// not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.NoteTemplate, error)
+
+	// List returns every template ordered by name.
+	List(ctx context.Context) ([]domain.NoteTemplate, error)
+
+	// Update overwrites a template's name and questions.
+	// Returns domain.ErrNotFound if it does not exist.
+	Update(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error)
+
+	// Delete removes a template. Stops created from it keep their answers
+	// and notes. Returns domain.ErrNotFound if it does not exist.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgNoteTemplateRepo is the Postgres implementation of NoteTemplateRepo.
+type pgNoteTemplateRepo struct {
+	db db
+}
+
+// NewNoteTemplateRepo constructs a NoteTemplateRepo backed by the provided db connection.
+func NewNoteTemplateRepo(db db) NoteTemplateRepo {
+	return &pgNoteTemplateRepo{db: db}
+}
+
+const noteTemplateColumns = `id, name, questions, created_at, updated_at`
+
+// noteQuestionJSON is how a domain.NoteQuestion is stored in
+// note_templates.questions.
+type noteQuestionJSON struct {
+	Key     string   `json:"key"`
+	Prompt  string   `json:"prompt"`
+	Choices []string `json:"choices,omitempty"`
+}
+
+// Create inserts a note_templates row.
+func (r *pgNoteTemplateRepo) Create(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	const q = `
+		INSERT INTO note_templates (organization_id, name, questions)
+		VALUES (@organization_id, @name, @questions)
+		RETURNING ` + noteTemplateColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"name": t.Name, "questions": noteQuestionsArg(t.Questions)}))
+	result, err := scanNoteTemplate(row)
+	if err != nil {
+		return domain.NoteTemplate{}, fmt.Errorf("repo.NoteTemplateRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a template by primary key.
+func (r *pgNoteTemplateRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.NoteTemplate, error) {
+	const q = `SELECT ` + noteTemplateColumns + ` FROM note_templates WHERE id = @id AND organization_id = @organization_id`
+
+	result, err := scanNoteTemplate(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.NoteTemplate{}, fmt.Errorf("repo.NoteTemplateRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// List returns every template.
+func (r *pgNoteTemplateRepo) List(ctx context.Context) ([]domain.NoteTemplate, error) {
+	const q = `
+		SELECT ` + noteTemplateColumns + ` FROM note_templates
+		WHERE organization_id = @organization_id
+		ORDER BY name, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.NoteTemplateRepo.List: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []domain.NoteTemplate{}
+	for rows.Next() {
+		t, err := scanNoteTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.NoteTemplateRepo.List: scan: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.NoteTemplateRepo.List: rows: %w", err)
+	}
+	return templates, nil
+}
+
+// Update overwrites a template's mutable fields.
+func (r *pgNoteTemplateRepo) Update(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	const q = `
+		UPDATE note_templates
+		SET name = @name, questions = @questions, updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id
+		RETURNING ` + noteTemplateColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": t.ID, "name": t.Name, "questions": noteQuestionsArg(t.Questions)}))
+	result, err := scanNoteTemplate(row)
+	if err != nil {
+		return domain.NoteTemplate{}, fmt.Errorf("repo.NoteTemplateRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a template by ID. The foreign key clears the
+// note_template_id of the stops created from it.
+func (r *pgNoteTemplateRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM note_templates WHERE id = @id AND organization_id = @organization_id`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.NoteTemplateRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.NoteTemplateRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// noteQuestionsArg is the query argument for a template's questions.
+func noteQuestionsArg(questions []domain.NoteQuestion) []noteQuestionJSON {
+	out := make([]noteQuestionJSON, len(questions))
+	for i, q := range questions {
+		out[i] = noteQuestionJSON{Key: q.Key, Prompt: q.Prompt, Choices: q.Choices}
+	}
+	return out
+}
+
+// scanNoteTemplate maps a single note_templates row into a domain.NoteTemplate.
+func scanNoteTemplate(s scanner) (domain.NoteTemplate, error) {
+	var (
+		t         domain.NoteTemplate
+		id        pgtype.UUID
+		questions []noteQuestionJSON
+	)
+	if err := s.Scan(&id, &t.Name, &questions, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.NoteTemplate{}, domain.ErrNotFound
+		}
+		return domain.NoteTemplate{}, err
+	}
+	t.ID = uuid.UUID(id.Bytes)
+	t.Questions = make([]domain.NoteQuestion, len(questions))
+	for i, q := range questions {
+		t.Questions[i] = domain.NoteQuestion{Key: q.Key, Prompt: q.Prompt, Choices: q.Choices}
+	}
+	return t, nil
+}
+
+// noteAnswersArg is the query argument for a stop's note answers. A nil map
+// becomes SQL NULL, which the insert coalesces to {}.
+func noteAnswersArg(a domain.NoteAnswers) any {
+	if a == nil {
+		return nil
+	}
+	return a
+}
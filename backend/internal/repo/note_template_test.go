@@ -0,0 +1,93 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newNoteTemplateTestRepo returns a NoteTemplateRepo and its rolled-back
+// transaction, so trips and stops can be inserted beside it.
+func newNoteTemplateTestRepo(t *testing.T) (pgx.Tx, repo.NoteTemplateRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewNoteTemplateRepo(tx)
+}
+
+func campsiteNoteTemplate() domain.NoteTemplate {
+	return domain.NoteTemplate{
+		Name: "Campsite",
+		Questions: []domain.NoteQuestion{
+			{Key: "site_number", Prompt: "Site number"},
+			{Key: "noise_level", Prompt: "Noise level", Choices: []string{"Quiet", "Loud"}},
+		},
+	}
+}
+
+func TestNoteTemplateRepo_CRUD(t *testing.T) {
+	_, templates := newNoteTemplateTestRepo(t)
+	ctx := context.Background()
+
+	created, err := templates.Create(ctx, campsiteNoteTemplate())
+	require.NoError(t, err)
+	assert.Equal(t, campsiteNoteTemplate().Questions, created.Questions)
+
+	created.Questions = created.Questions[:1]
+	updated, err := templates.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Len(t, updated.Questions, 1)
+	assert.False(t, updated.UpdatedAt.Before(created.UpdatedAt))
+
+	got, err := templates.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, updated.Questions, got.Questions)
+
+	all, err := templates.List(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, all)
+
+	require.NoError(t, templates.Delete(ctx, created.ID))
+	_, err = templates.GetByID(ctx, created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, templates.Delete(ctx, created.ID), domain.ErrNotFound)
+}
+
+func TestNoteTemplateRepo_DeleteKeepsStopAnswers(t *testing.T) {
+	tx, templates := newNoteTemplateTestRepo(t)
+	stops := repo.NewStopRepo(tx)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	tmpl, err := templates.Create(ctx, campsiteNoteTemplate())
+	require.NoError(t, err)
+
+	stop := factory.Stop().WithTripID(trip.ID).Build()
+	stop.NoteTemplateID = &tmpl.ID
+	stop.NoteAnswers = domain.NoteAnswers{"site_number": "B14"}
+	created, err := stops.Create(ctx, stop)
+	require.NoError(t, err)
+	require.NotNil(t, created.NoteTemplateID)
+	assert.Equal(t, tmpl.ID, *created.NoteTemplateID)
+	assert.Equal(t, domain.NoteAnswers{"site_number": "B14"}, created.NoteAnswers)
+
+	require.NoError(t, templates.Delete(ctx, tmpl.ID))
+
+	got, err := stops.GetByID(ctx, trip.ID, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.NoteTemplateID, "the foreign key clears the template")
+	assert.Equal(t, domain.NoteAnswers{"site_number": "B14"}, got.NoteAnswers)
+}
@@ -0,0 +1,234 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// OdometerRepo defines the persistence operations for odometer readings.
+type OdometerRepo interface {
+	// Create inserts a new reading and returns the persisted record.
+	Create(ctx context.Context, reading domain.OdometerReading) (domain.OdometerReading, error)
+
+	// GetByID retrieves a reading by primary key.
+	// Returns domain.ErrNotFound if no reading with that ID exists.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.OdometerReading, error)
+
+	// ListPaged returns one page of readings matching f, newest first, and the
+	// total number of matching readings across all pages.
+	ListPaged(ctx context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error)
+
+	// ListByTripID returns every reading linked to a trip, ordered by vehicle
+	// and then recorded_at ascending.
+	ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.OdometerReading, error)
+
+	// Adjacent returns the vehicle's latest reading recorded at or before at
+	// and its earliest reading recorded after at. Either is nil when no such
+	// reading exists.
+	Adjacent(ctx context.Context, vehicle string, at time.Time) (prev, next *domain.OdometerReading, err error)
+
+	// Delete removes a reading by ID.
+	// Returns domain.ErrNotFound if no reading with that ID exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgOdometerRepo is the Postgres implementation of OdometerRepo.
+type pgOdometerRepo struct {
+	db db
+}
+
+// NewOdometerRepo constructs an OdometerRepo backed by the provided db connection.
+func NewOdometerRepo(db db) OdometerRepo {
+	return &pgOdometerRepo{db: db}
+}
+
+const odometerColumns = `id, vehicle, miles, recorded_at, trip_id, notes, created_at`
+
+// Create inserts a reading row and returns the full persisted record.
+func (r *pgOdometerRepo) Create(ctx context.Context, reading domain.OdometerReading) (domain.OdometerReading, error) {
+	const q = `
+		INSERT INTO odometer_readings (organization_id, vehicle, miles, recorded_at, trip_id, notes)
+		VALUES (@organization_id, @vehicle, @miles, @recorded_at, @trip_id, @notes)
+		RETURNING ` + odometerColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"vehicle":     reading.Vehicle,
+		"miles":       reading.Miles,
+		"recorded_at": reading.RecordedAt,
+		"trip_id":     reading.TripID, // nil becomes NULL
+		"notes":       nullableString(reading.Notes),
+	}))
+	result, err := scanOdometerReading(row)
+	if err != nil {
+		return domain.OdometerReading{}, fmt.Errorf("repo.OdometerRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a reading by primary key.
+func (r *pgOdometerRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.OdometerReading, error) {
+	const q = `SELECT ` + odometerColumns + ` FROM odometer_readings WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	result, err := scanOdometerReading(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.OdometerReading{}, fmt.Errorf("repo.OdometerRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListPaged returns one page of matching readings, newest first, with the total count.
+// Empty filter fields are passed as NULL and match every row.
+func (r *pgOdometerRepo) ListPaged(ctx context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error) {
+	const where = `
+		WHERE organization_id = @organization_id
+		  AND (@vehicle::text IS NULL OR vehicle = @vehicle)
+		  AND (@trip_id::uuid IS NULL OR trip_id = @trip_id)
+		  AND ` + optionalTripSQL
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"vehicle": nullableString(f.Vehicle),
+		"trip_id": f.TripID,
+		"limit":   p.Limit,
+		"offset":  p.Offset(),
+	})
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM odometer_readings`+where, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("repo.OdometerRepo.ListPaged: count: %w", err)
+	}
+
+	q := `SELECT ` + odometerColumns + ` FROM odometer_readings` + where + `
+		ORDER BY recorded_at DESC, id
+		LIMIT @limit OFFSET @offset`
+	readings, err := r.query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("repo.OdometerRepo.ListPaged: %w", err)
+	}
+	return readings, total, nil
+}
+
+// ListByTripID returns every reading linked to a trip, grouped by vehicle in time order.
+func (r *pgOdometerRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.OdometerReading, error) {
+	const q = `
+		SELECT ` + odometerColumns + `
+		FROM odometer_readings
+		WHERE trip_id = @trip_id AND organization_id = @organization_id AND ` + optionalTripSQL + `
+		ORDER BY vehicle, recorded_at`
+
+	readings, err := r.query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.OdometerRepo.ListByTripID: %w", err)
+	}
+	return readings, nil
+}
+
+// Adjacent returns the readings immediately around at for one vehicle. It
+// sees readings on every trip, private ones included: the odometer only
+// rises, whoever logged the reading.
+func (r *pgOdometerRepo) Adjacent(ctx context.Context, vehicle string, at time.Time) (*domain.OdometerReading, *domain.OdometerReading, error) {
+	const prevQ = `
+		SELECT ` + odometerColumns + `
+		FROM odometer_readings
+		WHERE organization_id = @organization_id AND vehicle = @vehicle AND recorded_at <= @at
+		ORDER BY recorded_at DESC
+		LIMIT 1`
+	const nextQ = `
+		SELECT ` + odometerColumns + `
+		FROM odometer_readings
+		WHERE organization_id = @organization_id AND vehicle = @vehicle AND recorded_at > @at
+		ORDER BY recorded_at ASC
+		LIMIT 1`
+
+	args := scoped(ctx, pgx.NamedArgs{"vehicle": vehicle, "at": at})
+	prev, err := r.optional(ctx, prevQ, args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("repo.OdometerRepo.Adjacent: previous: %w", err)
+	}
+	next, err := r.optional(ctx, nextQ, args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("repo.OdometerRepo.Adjacent: next: %w", err)
+	}
+	return prev, next, nil
+}
+
+// Delete removes a reading by ID.
+func (r *pgOdometerRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM odometer_readings WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.OdometerRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.OdometerRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// query runs a multi-row reading query and scans every row.
+func (r *pgOdometerRepo) query(ctx context.Context, q string, args pgx.NamedArgs) ([]domain.OdometerReading, error) {
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	readings := []domain.OdometerReading{}
+	for rows.Next() {
+		reading, err := scanOdometerReading(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		readings = append(readings, reading)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+	return readings, nil
+}
+
+// optional runs a single-row reading query, returning nil when no row matches.
+func (r *pgOdometerRepo) optional(ctx context.Context, q string, args pgx.NamedArgs) (*domain.OdometerReading, error) {
+	reading, err := scanOdometerReading(r.db.QueryRow(ctx, q, args))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &reading, nil
+}
+
+// scanOdometerReading maps a single odometer_readings row into a domain.OdometerReading.
+func scanOdometerReading(s scanner) (domain.OdometerReading, error) {
+	var (
+		o      domain.OdometerReading
+		id     pgtype.UUID
+		tripID pgtype.UUID
+		notes  *string
+	)
+	err := s.Scan(&id, &o.Vehicle, &o.Miles, &o.RecordedAt, &tripID, &notes, &o.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.OdometerReading{}, domain.ErrNotFound
+		}
+		return domain.OdometerReading{}, err
+	}
+	o.ID = uuid.UUID(id.Bytes)
+	if tripID.Valid {
+		t := uuid.UUID(tripID.Bytes)
+		o.TripID = &t
+	}
+	if notes != nil {
+		o.Notes = *notes
+	}
+	return o, nil
+}
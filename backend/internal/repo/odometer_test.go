@@ -0,0 +1,205 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newOdometerTestRepos returns a TripRepo and OdometerRepo sharing one
+// rolled-back transaction, so readings can reference a trip created in the
+// same test.
+func newOdometerTestRepos(t *testing.T) (repo.TripRepo, repo.OdometerRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewTripRepo(tx), repo.NewOdometerRepo(tx)
+}
+
+// uniqueVehicle keeps tests independent of readings left by other packages.
+func uniqueVehicle() string {
+	return "Test rig " + uuid.NewString()
+}
+
+func TestOdometerRepo_CreateAndGet(t *testing.T) {
+	trips, readings := newOdometerTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+
+	at := time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC)
+	created, err := readings.Create(ctx, domain.OdometerReading{
+		Vehicle: "Motorhome", Miles: 48213.6, RecordedAt: at, TripID: &trip.ID, Notes: "Moab",
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.InDelta(t, 48213.6, created.Miles, 0.001)
+	require.NotNil(t, created.TripID)
+	assert.Equal(t, trip.ID, *created.TripID)
+
+	got, err := readings.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Moab", got.Notes)
+	assert.True(t, got.RecordedAt.Equal(at))
+}
+
+func TestOdometerRepo_Create_WithoutTrip(t *testing.T) {
+	_, readings := newOdometerTestRepos(t)
+
+	created, err := readings.Create(context.Background(), domain.OdometerReading{
+		Vehicle: "Tow car", Miles: 100, RecordedAt: time.Now().UTC(),
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, created.TripID)
+	assert.Empty(t, created.Notes)
+}
+
+func TestOdometerRepo_GetByID_NotFound(t *testing.T) {
+	_, readings := newOdometerTestRepos(t)
+
+	_, err := readings.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestOdometerRepo_ListPaged_Filters(t *testing.T) {
+	trips, readings := newOdometerTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	vehicle := uniqueVehicle()
+	t0 := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 3 {
+		_, err := readings.Create(ctx, domain.OdometerReading{
+			Vehicle: vehicle, Miles: float64(1000 + i), RecordedAt: t0.Add(time.Duration(i) * time.Hour), TripID: &trip.ID,
+		})
+		require.NoError(t, err)
+	}
+	_, err = readings.Create(ctx, domain.OdometerReading{Vehicle: vehicle, Miles: 2000, RecordedAt: t0.Add(24 * time.Hour)})
+	require.NoError(t, err)
+
+	all, total, err := readings.ListPaged(ctx, domain.OdometerFilter{Vehicle: vehicle}, domain.PaginationParams{Page: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	require.Len(t, all, 2)
+	assert.InDelta(t, 2000, all[0].Miles, 0.001, "newest first")
+
+	onTrip, total, err := readings.ListPaged(ctx, domain.OdometerFilter{TripID: &trip.ID}, domain.PaginationParams{Page: 1, Limit: 20})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Len(t, onTrip, 3)
+}
+
+func TestOdometerRepo_ListByTripID(t *testing.T) {
+	trips, readings := newOdometerTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	t0 := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = readings.Create(ctx, domain.OdometerReading{Vehicle: "B", Miles: 10, RecordedAt: t0, TripID: &trip.ID})
+	require.NoError(t, err)
+	_, err = readings.Create(ctx, domain.OdometerReading{Vehicle: "A", Miles: 20, RecordedAt: t0.Add(time.Hour), TripID: &trip.ID})
+	require.NoError(t, err)
+	_, err = readings.Create(ctx, domain.OdometerReading{Vehicle: "A", Miles: 5, RecordedAt: t0, TripID: &trip.ID})
+	require.NoError(t, err)
+
+	got, err := readings.ListByTripID(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{"A", "A", "B"}, []string{got[0].Vehicle, got[1].Vehicle, got[2].Vehicle})
+	assert.InDelta(t, 5, got[0].Miles, 0.001, "ordered by recorded_at within a vehicle")
+}
+
+func TestOdometerRepo_Adjacent(t *testing.T) {
+	_, readings := newOdometerTestRepos(t)
+	ctx := context.Background()
+	vehicle := uniqueVehicle()
+	t0 := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	prev, next, err := readings.Adjacent(ctx, vehicle, t0)
+	require.NoError(t, err)
+	assert.Nil(t, prev)
+	assert.Nil(t, next)
+
+	for i, miles := range []float64{100, 200, 300} {
+		_, err := readings.Create(ctx, domain.OdometerReading{Vehicle: vehicle, Miles: miles, RecordedAt: t0.Add(time.Duration(i) * 24 * time.Hour)})
+		require.NoError(t, err)
+	}
+
+	prev, next, err = readings.Adjacent(ctx, vehicle, t0.Add(36*time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, prev)
+	require.NotNil(t, next)
+	assert.InDelta(t, 200, prev.Miles, 0.001)
+	assert.InDelta(t, 300, next.Miles, 0.001)
+}
+
+func TestOdometerRepo_Delete(t *testing.T) {
+	_, readings := newOdometerTestRepos(t)
+	ctx := context.Background()
+	created, err := readings.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 1, RecordedAt: time.Now().UTC()})
+	require.NoError(t, err)
+
+	require.NoError(t, readings.Delete(ctx, created.ID))
+	assert.ErrorIs(t, readings.Delete(ctx, created.ID), domain.ErrNotFound)
+}
+
+// TestOdometerRepo_TripPurgeKeepsReadings verifies readings outlive the trip
+// they were taken on: the vehicle's history is still true.
+func TestOdometerRepo_TripPurgeKeepsReadings(t *testing.T) {
+	tx, _ := newTestStopRepos(t)
+	trips, readings := repo.NewTripRepo(tx), repo.NewOdometerRepo(tx)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	created, err := readings.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 1, RecordedAt: time.Now().UTC(), TripID: &trip.ID})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `DELETE FROM trips WHERE id = $1`, trip.ID)
+	require.NoError(t, err)
+
+	got, err := readings.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.TripID)
+}
+
+// TestOdometerRepo_PrivateTrip verifies that readings logged on a private
+// trip are hidden from other users, with or without a trip filter.
+func TestOdometerRepo_PrivateTrip(t *testing.T) {
+	tx, trip, asOwner, asOther := newPrivateTrip(t)
+	readings := repo.NewOdometerRepo(tx)
+	vehicle := uniqueVehicle()
+	created, err := readings.Create(asOwner, domain.OdometerReading{Vehicle: vehicle, Miles: 1000, RecordedAt: time.Now(), TripID: &trip.ID})
+	require.NoError(t, err)
+
+	_, err = readings.GetByID(asOther, created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	listed, total, err := readings.ListPaged(asOther, domain.OdometerFilter{Vehicle: vehicle}, domain.PaginationParams{Page: 1, Limit: 20})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, listed)
+	onTrip, err := readings.ListByTripID(asOther, trip.ID)
+	require.NoError(t, err)
+	assert.Empty(t, onTrip)
+	assert.ErrorIs(t, readings.Delete(asOther, created.ID), domain.ErrNotFound)
+
+	_, err = readings.GetByID(asOwner, created.ID)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,369 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// Every repo scopes its queries to the organization the request acts for,
+// read from the context with domain.OrganizationFromContext. Queries take
+// it as the named argument @organization_id; scoped adds it to their args.
+//
+// Tables whose rows can stand alone carry organization_id themselves. Rows
+// that hang off a trip or a stop are matched through it with orgTripsSQL and
+// orgStopsSQL, or through liveStopSQL, which checks the trip anyway. Rows
+// that carry organization_id and may also be logged against a trip, such as
+// propane fills, add optionalTripSQL.
+//
+// Trips are further scoped to the request's user, read with
+// domain.UserFromContext and taken as @user_id: a trip with an owner is seen
+// only by that user and the members they invited, and one without by
+// everyone in the organization. Every query that reads trips checks
+// "t.user_id IS NULL OR t.user_id = @user_id OR t.id IN memberTripsSQL";
+// for an anonymous request @user_id is NULL and only unowned trips match.
+// Deleting a trip, its share links, and the trash are for its owner alone
+// and leave out memberTripsSQL.
+
+// memberTripsSQL is the IDs of the trips the request's user is a member of,
+// for use after IN.
+const memberTripsSQL = `(SELECT tm.trip_id FROM trip_members tm WHERE tm.user_id = @user_id)`
+
+// orgTripsSQL is the IDs of the organization's trips the request's user can
+// see, trashed or not, for use after IN.
+const orgTripsSQL = `(SELECT ot.id FROM trips ot WHERE ot.organization_id = @organization_id AND (ot.user_id IS NULL OR ot.user_id = @user_id OR ot.id IN ` + memberTripsSQL + `))`
+
+// orgStopsSQL is the IDs of the stops on the trips orgTripsSQL matches,
+// trashed or not, for use after IN.
+const orgStopsSQL = `(SELECT os.id FROM stops os JOIN trips ot ON ot.id = os.trip_id WHERE ot.organization_id = @organization_id AND (ot.user_id IS NULL OR ot.user_id = @user_id OR ot.id IN ` + memberTripsSQL + `))`
+
+// optionalTripSQL matches a row with an optional trip_id that is on no trip,
+// or on one orgTripsSQL matches, so readings logged on another user's
+// private trip stay hidden with it.
+const optionalTripSQL = `(trip_id IS NULL OR trip_id IN ` + orgTripsSQL + `)`
+
+// orgRigsSQL is the IDs of the organization's rigs, for use after IN.
+const orgRigsSQL = `(SELECT orr.id FROM rigs orr WHERE orr.organization_id = @organization_id)`
+
+// scoped adds the request's organization to args as @organization_id and its
+// user as @user_id (NULL when anonymous), and returns args.
+func scoped(ctx context.Context, args pgx.NamedArgs) pgx.NamedArgs {
+	args["organization_id"] = domain.OrganizationFromContext(ctx)
+	args["user_id"] = nil
+	if id, ok := domain.UserFromContext(ctx); ok {
+		args["user_id"] = id
+	}
+	return args
+}
+
+// OrganizationRepo defines the persistence operations for organizations and
+// their members. Unlike every other repo it is not scoped by the request's
+// organization: it is how organizations are found in the first place.
+type OrganizationRepo interface {
+	// Create inserts an organization together with its first member, an
+	// owner, and returns the persisted organization.
+	Create(ctx context.Context, org domain.Organization, owner string) (domain.Organization, error)
+
+	// GetByID retrieves an organization. Returns domain.ErrNotFound if it
+	// does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error)
+
+	// ListByUser returns the organizations userID is a member of, by name.
+	ListByUser(ctx context.Context, userID string) ([]domain.Organization, error)
+
+	// Rename sets an organization's name. Returns domain.ErrNotFound if it
+	// does not exist.
+	Rename(ctx context.Context, id uuid.UUID, name string) (domain.Organization, error)
+
+	// Delete removes an organization with everything it owns. Returns
+	// domain.ErrNotFound if it does not exist and domain.ErrValidation if it
+	// still has trips, in the trash or not.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListMembers returns an organization's members, owners first.
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.Member, error)
+
+	// GetMember retrieves one membership. Returns domain.ErrNotFound if
+	// userID is not a member of the organization.
+	GetMember(ctx context.Context, orgID uuid.UUID, userID string) (domain.Member, error)
+
+	// AddMember inserts a membership. Returns domain.ErrNotFound if the
+	// organization does not exist and domain.ErrValidation if userID is
+	// already a member.
+	AddMember(ctx context.Context, m domain.Member) (domain.Member, error)
+
+	// UpdateMemberRole changes a member's role. Returns domain.ErrNotFound if
+	// userID is not a member and domain.ErrValidation if it would leave the
+	// organization without an owner.
+	UpdateMemberRole(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error)
+
+	// RemoveMember deletes a membership. Returns domain.ErrNotFound if userID
+	// is not a member and domain.ErrValidation if it would leave the
+	// organization without an owner.
+	RemoveMember(ctx context.Context, orgID uuid.UUID, userID string) error
+}
+
+// pgOrganizationRepo is the Postgres implementation of OrganizationRepo.
+type pgOrganizationRepo struct {
+	db db
+}
+
+// NewOrganizationRepo constructs an OrganizationRepo backed by the provided
+// db connection.
+func NewOrganizationRepo(db db) OrganizationRepo {
+	return &pgOrganizationRepo{db: db}
+}
+
+// Create inserts the organization and its owner in one statement.
+func (r *pgOrganizationRepo) Create(ctx context.Context, org domain.Organization, owner string) (domain.Organization, error) {
+	const q = `
+		WITH created AS (
+			INSERT INTO organizations (name) VALUES (@name)
+			RETURNING id, name, created_at, updated_at
+		), owned AS (
+			INSERT INTO organization_members (organization_id, user_id, role)
+			SELECT id, @owner, 'owner' FROM created
+		)
+		SELECT id, name, created_at, updated_at FROM created`
+
+	result, err := scanOrganization(r.db.QueryRow(ctx, q, pgx.NamedArgs{"name": org.Name, "owner": owner}))
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("repo.OrganizationRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves an organization by primary key.
+func (r *pgOrganizationRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error) {
+	const q = `SELECT id, name, created_at, updated_at FROM organizations WHERE id = @id`
+
+	result, err := scanOrganization(r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("repo.OrganizationRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListByUser returns a user's organizations through their memberships.
+func (r *pgOrganizationRepo) ListByUser(ctx context.Context, userID string) ([]domain.Organization, error) {
+	const q = `
+		SELECT o.id, o.name, o.created_at, o.updated_at
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.user_id = @user_id
+		ORDER BY o.name, o.id`
+
+	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("repo.OrganizationRepo.ListByUser: %w", err)
+	}
+	defer rows.Close()
+
+	orgs := []domain.Organization{}
+	for rows.Next() {
+		o, err := scanOrganization(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.OrganizationRepo.ListByUser: scan: %w", err)
+		}
+		orgs = append(orgs, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.OrganizationRepo.ListByUser: rows: %w", err)
+	}
+	return orgs, nil
+}
+
+// Rename updates an organization's name.
+func (r *pgOrganizationRepo) Rename(ctx context.Context, id uuid.UUID, name string) (domain.Organization, error) {
+	const q = `
+		UPDATE organizations SET name = @name, updated_at = now()
+		WHERE id = @id
+		RETURNING id, name, created_at, updated_at`
+
+	result, err := scanOrganization(r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": id, "name": name}))
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("repo.OrganizationRepo.Rename: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes an organization unless it still has trips.
+func (r *pgOrganizationRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `
+		DELETE FROM organizations
+		WHERE id = @id AND NOT EXISTS (SELECT 1 FROM trips WHERE organization_id = @id)`
+
+	tag, err := r.db.Exec(ctx, q, pgx.NamedArgs{"id": id})
+	if err != nil {
+		return fmt.Errorf("repo.OrganizationRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return fmt.Errorf("repo.OrganizationRepo.Delete: %w", err)
+		}
+		return fmt.Errorf("repo.OrganizationRepo.Delete: %w: the organization still has trips", domain.ErrValidation)
+	}
+	return nil
+}
+
+// ListMembers returns the members of an organization, owners first.
+func (r *pgOrganizationRepo) ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.Member, error) {
+	const q = `
+		SELECT organization_id, user_id, role, created_at
+		FROM organization_members
+		WHERE organization_id = @organization_id
+		ORDER BY role = 'owner' DESC, user_id`
+
+	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"organization_id": orgID})
+	if err != nil {
+		return nil, fmt.Errorf("repo.OrganizationRepo.ListMembers: %w", err)
+	}
+	defer rows.Close()
+
+	members := []domain.Member{}
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.OrganizationRepo.ListMembers: scan: %w", err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.OrganizationRepo.ListMembers: rows: %w", err)
+	}
+	return members, nil
+}
+
+// GetMember retrieves one membership by its composite key.
+func (r *pgOrganizationRepo) GetMember(ctx context.Context, orgID uuid.UUID, userID string) (domain.Member, error) {
+	const q = `
+		SELECT organization_id, user_id, role, created_at
+		FROM organization_members
+		WHERE organization_id = @organization_id AND user_id = @user_id`
+
+	result, err := scanMember(r.db.QueryRow(ctx, q, pgx.NamedArgs{"organization_id": orgID, "user_id": userID}))
+	if err != nil {
+		return domain.Member{}, fmt.Errorf("repo.OrganizationRepo.GetMember: %w", err)
+	}
+	return result, nil
+}
+
+// AddMember inserts a membership. When nothing is inserted, either the
+// organization is missing or the user is already a member.
+func (r *pgOrganizationRepo) AddMember(ctx context.Context, m domain.Member) (domain.Member, error) {
+	const q = `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		SELECT id, @user_id, @role FROM organizations WHERE id = @organization_id
+		ON CONFLICT (organization_id, user_id) DO NOTHING
+		RETURNING organization_id, user_id, role, created_at`
+
+	result, err := scanMember(r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"organization_id": m.OrganizationID,
+		"user_id":         m.UserID,
+		"role":            string(m.Role),
+	}))
+	if errors.Is(err, domain.ErrNotFound) {
+		if _, err := r.GetByID(ctx, m.OrganizationID); err != nil {
+			return domain.Member{}, fmt.Errorf("repo.OrganizationRepo.AddMember: %w", err)
+		}
+		return domain.Member{}, fmt.Errorf("repo.OrganizationRepo.AddMember: %w: %s is already a member", domain.ErrValidation, m.UserID)
+	}
+	if err != nil {
+		return domain.Member{}, fmt.Errorf("repo.OrganizationRepo.AddMember: %w", err)
+	}
+	return result, nil
+}
+
+// lastOwnerSQL is true when the member @user_id is the only owner of
+// @organization_id. The row locks keep two concurrent demotions from each
+// seeing the other as the remaining owner.
+const lastOwnerSQL = `
+	(SELECT count(*) = 1 AND bool_or(user_id = @user_id)
+	 FROM (SELECT user_id FROM organization_members
+	       WHERE organization_id = @organization_id AND role = 'owner'
+	       FOR UPDATE) owners)`
+
+// UpdateMemberRole sets a member's role unless that demotes the last owner.
+func (r *pgOrganizationRepo) UpdateMemberRole(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+	const q = `
+		UPDATE organization_members
+		SET role = @role
+		WHERE organization_id = @organization_id AND user_id = @user_id
+		  AND (@role = 'owner' OR NOT ` + lastOwnerSQL + `)
+		RETURNING organization_id, user_id, role, created_at`
+
+	args := pgx.NamedArgs{"organization_id": orgID, "user_id": userID, "role": string(role)}
+	result, err := scanMember(r.db.QueryRow(ctx, q, args))
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.Member{}, r.notMemberOrLastOwner(ctx, "UpdateMemberRole", orgID, userID)
+	}
+	if err != nil {
+		return domain.Member{}, fmt.Errorf("repo.OrganizationRepo.UpdateMemberRole: %w", err)
+	}
+	return result, nil
+}
+
+// RemoveMember deletes a membership unless it is the last owner's.
+func (r *pgOrganizationRepo) RemoveMember(ctx context.Context, orgID uuid.UUID, userID string) error {
+	const q = `
+		DELETE FROM organization_members
+		WHERE organization_id = @organization_id AND user_id = @user_id
+		  AND NOT ` + lastOwnerSQL
+
+	tag, err := r.db.Exec(ctx, q, pgx.NamedArgs{"organization_id": orgID, "user_id": userID})
+	if err != nil {
+		return fmt.Errorf("repo.OrganizationRepo.RemoveMember: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return r.notMemberOrLastOwner(ctx, "RemoveMember", orgID, userID)
+	}
+	return nil
+}
+
+// notMemberOrLastOwner explains why a membership change matched no row.
+func (r *pgOrganizationRepo) notMemberOrLastOwner(ctx context.Context, op string, orgID uuid.UUID, userID string) error {
+	if _, err := r.GetMember(ctx, orgID, userID); err != nil {
+		return fmt.Errorf("repo.OrganizationRepo.%s: %w", op, err)
+	}
+	return fmt.Errorf("repo.OrganizationRepo.%s: %w: an organization must keep at least one owner", op, domain.ErrValidation)
+}
+
+// scanOrganization maps a single organizations row into a domain.Organization.
+func scanOrganization(s scanner) (domain.Organization, error) {
+	var (
+		o  domain.Organization
+		id pgtype.UUID
+	)
+	if err := s.Scan(&id, &o.Name, &o.CreatedAt, &o.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Organization{}, domain.ErrNotFound
+		}
+		return domain.Organization{}, err
+	}
+	o.ID = uuid.UUID(id.Bytes)
+	return o, nil
+}
+
+// scanMember maps a single organization_members row into a domain.Member.
+func scanMember(s scanner) (domain.Member, error) {
+	var (
+		m     domain.Member
+		orgID pgtype.UUID
+		role  string
+	)
+	if err := s.Scan(&orgID, &m.UserID, &role, &m.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Member{}, domain.ErrNotFound
+		}
+		return domain.Member{}, err
+	}
+	m.OrganizationID = uuid.UUID(orgID.Bytes)
+	m.Role = domain.MemberRole(role)
+	return m, nil
+}
@@ -0,0 +1,154 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// orgTestRepos bundles the repos the organization tests use, all on one
+// rolled-back transaction.
+type orgTestRepos struct {
+	orgs  repo.OrganizationRepo
+	trips repo.TripRepo
+	tags  repo.TagRepo
+}
+
+func newOrgTestRepos(t *testing.T) orgTestRepos {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return orgTestRepos{
+		orgs:  repo.NewOrganizationRepo(tx),
+		trips: repo.NewTripRepo(tx),
+		tags:  repo.NewTagRepo(tx),
+	}
+}
+
+func TestOrganizationRepo_CreateAddsOwner(t *testing.T) {
+	r := newOrgTestRepos(t)
+	ctx := context.Background()
+
+	org, err := r.orgs.Create(ctx, domain.Organization{Name: "The Smiths"}, "alice")
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, org.ID)
+	assert.Equal(t, "The Smiths", org.Name)
+
+	members, err := r.orgs.ListMembers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "alice", members[0].UserID)
+	assert.Equal(t, domain.RoleOwner, members[0].Role)
+
+	mine, err := r.orgs.ListByUser(ctx, "alice")
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	assert.Equal(t, org.ID, mine[0].ID)
+}
+
+func TestOrganizationRepo_DefaultExists(t *testing.T) {
+	r := newOrgTestRepos(t)
+
+	org, err := r.orgs.GetByID(context.Background(), domain.DefaultOrganizationID)
+	require.NoError(t, err)
+	assert.Equal(t, "Default", org.Name)
+}
+
+func TestOrganizationRepo_AddMember(t *testing.T) {
+	r := newOrgTestRepos(t)
+	ctx := context.Background()
+	org, err := r.orgs.Create(ctx, domain.Organization{Name: "The Smiths"}, "alice")
+	require.NoError(t, err)
+
+	m, err := r.orgs.AddMember(ctx, domain.Member{OrganizationID: org.ID, UserID: "bob", Role: domain.RoleMember})
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleMember, m.Role)
+
+	_, err = r.orgs.AddMember(ctx, domain.Member{OrganizationID: org.ID, UserID: "bob", Role: domain.RoleOwner})
+	assert.ErrorIs(t, err, domain.ErrValidation, "already a member")
+
+	_, err = r.orgs.AddMember(ctx, domain.Member{OrganizationID: uuid.New(), UserID: "bob", Role: domain.RoleMember})
+	assert.ErrorIs(t, err, domain.ErrNotFound, "no such organization")
+}
+
+// TestOrganizationRepo_KeepsAnOwner verifies the last owner can be neither
+// demoted nor removed, while a second owner frees the first.
+func TestOrganizationRepo_KeepsAnOwner(t *testing.T) {
+	r := newOrgTestRepos(t)
+	ctx := context.Background()
+	org, err := r.orgs.Create(ctx, domain.Organization{Name: "The Smiths"}, "alice")
+	require.NoError(t, err)
+
+	_, err = r.orgs.UpdateMemberRole(ctx, org.ID, "alice", domain.RoleMember)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.ErrorIs(t, r.orgs.RemoveMember(ctx, org.ID, "alice"), domain.ErrValidation)
+	assert.ErrorIs(t, r.orgs.RemoveMember(ctx, org.ID, "nobody"), domain.ErrNotFound)
+
+	_, err = r.orgs.AddMember(ctx, domain.Member{OrganizationID: org.ID, UserID: "bob", Role: domain.RoleOwner})
+	require.NoError(t, err)
+	m, err := r.orgs.UpdateMemberRole(ctx, org.ID, "alice", domain.RoleMember)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleMember, m.Role)
+	require.NoError(t, r.orgs.RemoveMember(ctx, org.ID, "alice"))
+}
+
+func TestOrganizationRepo_Delete_RefusedWithTrips(t *testing.T) {
+	r := newOrgTestRepos(t)
+	ctx := context.Background()
+	org, err := r.orgs.Create(ctx, domain.Organization{Name: "The Smiths"}, "alice")
+	require.NoError(t, err)
+	trip, err := r.trips.Create(domain.WithOrganization(ctx, org.ID), factory.Trip().Build())
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, r.orgs.Delete(ctx, org.ID), domain.ErrValidation)
+
+	require.NoError(t, r.trips.Delete(domain.WithOrganization(ctx, org.ID), trip.ID))
+	assert.ErrorIs(t, r.orgs.Delete(ctx, org.ID), domain.ErrValidation, "trashed trips still count")
+
+	assert.ErrorIs(t, r.orgs.Delete(ctx, uuid.New()), domain.ErrNotFound)
+}
+
+// TestOrganizationRepo_DataIsolated verifies trips and tags created for one
+// organization are invisible to another, and that tag slugs only need to be
+// unique within an organization.
+func TestOrganizationRepo_DataIsolated(t *testing.T) {
+	r := newOrgTestRepos(t)
+	ctx := context.Background()
+	org, err := r.orgs.Create(ctx, domain.Organization{Name: "The Smiths"}, "alice")
+	require.NoError(t, err)
+	theirs := domain.WithOrganization(ctx, org.ID)
+
+	trip, err := r.trips.Create(theirs, factory.Trip().Build())
+	require.NoError(t, err)
+
+	_, err = r.trips.GetByID(ctx, trip.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "the default organization cannot read it")
+	assert.ErrorIs(t, r.trips.Delete(ctx, trip.ID), domain.ErrNotFound, "nor delete it")
+	_, err = r.trips.GetByID(theirs, trip.ID)
+	require.NoError(t, err)
+
+	ours, err := r.tags.Upsert(ctx, "Beach", "beach")
+	require.NoError(t, err)
+	their, err := r.tags.Upsert(theirs, "Beach", "beach")
+	require.NoError(t, err)
+	assert.NotEqual(t, ours.ID, their.ID)
+
+	listed, err := r.tags.List(theirs, "beach")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, their.ID, listed[0].ID)
+}
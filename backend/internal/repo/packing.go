@@ -0,0 +1,332 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// PackingRepo defines the persistence operations for packing lists and
+// their items.
+type PackingRepo interface {
+	// CreateList inserts a list and its items in one statement and returns
+	// the persisted record. Only the name, quantity, and packed fields of
+	// l.Items are used.
+	CreateList(ctx context.Context, l domain.PackingList) (domain.PackingList, error)
+
+	// GetList retrieves a list with its items.
+	// Returns domain.ErrNotFound if it does not exist.
+	GetList(ctx context.Context, id uuid.UUID) (domain.PackingList, error)
+
+	// ListLists returns the lists matching f with their items, ordered by name.
+	ListLists(ctx context.Context, f domain.PackingListFilter) ([]domain.PackingList, error)
+
+	// UpdateList renames a list. Items are left untouched.
+	// Returns domain.ErrNotFound if it does not exist.
+	UpdateList(ctx context.Context, l domain.PackingList) (domain.PackingList, error)
+
+	// DeleteList removes a list and its items.
+	// Returns domain.ErrNotFound if it does not exist.
+	DeleteList(ctx context.Context, id uuid.UUID) error
+
+	// CreateItem appends an item to the end of its list.
+	CreateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error)
+
+	// UpdateItem overwrites an item's name, quantity, and packed state.
+	// Returns domain.ErrNotFound if the list has no item with that ID.
+	UpdateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error)
+
+	// DeleteItem removes an item from a list.
+	// Returns domain.ErrNotFound if the list has no item with that ID.
+	DeleteItem(ctx context.Context, listID, id uuid.UUID) error
+}
+
+// pgPackingRepo is the Postgres implementation of PackingRepo.
+type pgPackingRepo struct {
+	db db
+}
+
+// NewPackingRepo constructs a PackingRepo backed by the provided db connection.
+func NewPackingRepo(db db) PackingRepo {
+	return &pgPackingRepo{db: db}
+}
+
+const (
+	packingListColumns = `id, trip_id, name, created_at, updated_at`
+	packingItemColumns = `id, list_id, position, name, quantity, packed, created_at`
+)
+
+// CreateList inserts the list row and one item row per entry of l.Items.
+// A single statement keeps the two inserts atomic without a transaction.
+func (r *pgPackingRepo) CreateList(ctx context.Context, l domain.PackingList) (domain.PackingList, error) {
+	const q = `
+		WITH list AS (
+			INSERT INTO packing_lists (organization_id, trip_id, name)
+			VALUES (@organization_id, @trip_id, @name)
+			RETURNING ` + packingListColumns + `
+		), items AS (
+			INSERT INTO packing_items (list_id, position, name, quantity, packed)
+			SELECT list.id, item.position::int, item.name, item.quantity, item.packed
+			FROM list, unnest(@names::text[], @quantities::int[], @packed::bool[])
+				WITH ORDINALITY AS item(name, quantity, packed, position)
+		)
+		SELECT ` + packingListColumns + ` FROM list`
+
+	names := make([]string, len(l.Items))
+	quantities := make([]int, len(l.Items))
+	packed := make([]bool, len(l.Items))
+	for i, item := range l.Items {
+		names[i], quantities[i], packed[i] = item.Name, item.Quantity, item.Packed
+	}
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"trip_id":    l.TripID, // nil becomes NULL
+		"name":       l.Name,
+		"names":      names,
+		"quantities": quantities,
+		"packed":     packed,
+	}))
+	created, err := scanPackingList(row)
+	if err != nil {
+		return domain.PackingList{}, fmt.Errorf("repo.PackingRepo.CreateList: %w", err)
+	}
+
+	// The items CTE's rows are not visible to the statement that inserted
+	// them, so they are read back separately.
+	if err := r.loadItems(ctx, []*domain.PackingList{&created}); err != nil {
+		return domain.PackingList{}, fmt.Errorf("repo.PackingRepo.CreateList: %w", err)
+	}
+	return created, nil
+}
+
+// GetList retrieves a list by ID.
+func (r *pgPackingRepo) GetList(ctx context.Context, id uuid.UUID) (domain.PackingList, error) {
+	const q = `SELECT ` + packingListColumns + ` FROM packing_lists WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	l, err := scanPackingList(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.PackingList{}, fmt.Errorf("repo.PackingRepo.GetList: %w", err)
+	}
+	if err := r.loadItems(ctx, []*domain.PackingList{&l}); err != nil {
+		return domain.PackingList{}, fmt.Errorf("repo.PackingRepo.GetList: %w", err)
+	}
+	return l, nil
+}
+
+// ListLists returns the matching lists. A nil TripID is passed as NULL and
+// matches every trip.
+func (r *pgPackingRepo) ListLists(ctx context.Context, f domain.PackingListFilter) ([]domain.PackingList, error) {
+	const q = `
+		SELECT ` + packingListColumns + `
+		FROM packing_lists
+		WHERE organization_id = @organization_id
+		  AND (@trip_id::uuid IS NULL OR trip_id = @trip_id)
+		  AND ` + optionalTripSQL + `
+		  AND (NOT @templates::bool OR trip_id IS NULL)
+		ORDER BY name, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": f.TripID, "templates": f.Templates}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.PackingRepo.ListLists: %w", err)
+	}
+	defer rows.Close()
+
+	lists := []domain.PackingList{}
+	for rows.Next() {
+		l, err := scanPackingList(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.PackingRepo.ListLists: scan: %w", err)
+		}
+		lists = append(lists, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.PackingRepo.ListLists: rows: %w", err)
+	}
+
+	ptrs := make([]*domain.PackingList, len(lists))
+	for i := range lists {
+		ptrs[i] = &lists[i]
+	}
+	if err := r.loadItems(ctx, ptrs); err != nil {
+		return nil, fmt.Errorf("repo.PackingRepo.ListLists: %w", err)
+	}
+	return lists, nil
+}
+
+// UpdateList renames a list and returns it with its items.
+func (r *pgPackingRepo) UpdateList(ctx context.Context, l domain.PackingList) (domain.PackingList, error) {
+	const q = `
+		UPDATE packing_lists
+		SET name = @name, updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL + `
+		RETURNING ` + packingListColumns
+
+	updated, err := scanPackingList(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": l.ID, "name": l.Name})))
+	if err != nil {
+		return domain.PackingList{}, fmt.Errorf("repo.PackingRepo.UpdateList: %w", err)
+	}
+	if err := r.loadItems(ctx, []*domain.PackingList{&updated}); err != nil {
+		return domain.PackingList{}, fmt.Errorf("repo.PackingRepo.UpdateList: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteList removes a list by ID; items cascade.
+func (r *pgPackingRepo) DeleteList(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM packing_lists WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.PackingRepo.DeleteList: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.PackingRepo.DeleteList: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateItem inserts an item after the list's current last item.
+func (r *pgPackingRepo) CreateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	const q = `
+		INSERT INTO packing_items (list_id, position, name, quantity, packed)
+		VALUES (
+			@list_id,
+			(SELECT COALESCE(MAX(position), 0) + 1 FROM packing_items WHERE list_id = @list_id),
+			@name, @quantity, @packed
+		)
+		RETURNING ` + packingItemColumns
+
+	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"list_id":  item.ListID,
+		"name":     item.Name,
+		"quantity": item.Quantity,
+		"packed":   item.Packed,
+	})
+	result, err := scanPackingItem(row)
+	if err != nil {
+		return domain.PackingItem{}, fmt.Errorf("repo.PackingRepo.CreateItem: %w", err)
+	}
+	return result, nil
+}
+
+// UpdateItem overwrites an item scoped to its list.
+func (r *pgPackingRepo) UpdateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	const q = `
+		UPDATE packing_items
+		SET name = @name, quantity = @quantity, packed = @packed
+		WHERE id = @id AND list_id = @list_id AND list_id IN (SELECT id FROM packing_lists WHERE organization_id = @organization_id AND ` + optionalTripSQL + `)
+		RETURNING ` + packingItemColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":       item.ID,
+		"list_id":  item.ListID,
+		"name":     item.Name,
+		"quantity": item.Quantity,
+		"packed":   item.Packed,
+	}))
+	result, err := scanPackingItem(row)
+	if err != nil {
+		return domain.PackingItem{}, fmt.Errorf("repo.PackingRepo.UpdateItem: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteItem removes an item scoped to its list.
+func (r *pgPackingRepo) DeleteItem(ctx context.Context, listID, id uuid.UUID) error {
+	const q = `
+		DELETE FROM packing_items
+		WHERE id = @id AND list_id = @list_id AND list_id IN (SELECT id FROM packing_lists WHERE organization_id = @organization_id AND ` + optionalTripSQL + `)`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "list_id": listID}))
+	if err != nil {
+		return fmt.Errorf("repo.PackingRepo.DeleteItem: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.PackingRepo.DeleteItem: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// loadItems fills in Items for each list with one query.
+func (r *pgPackingRepo) loadItems(ctx context.Context, lists []*domain.PackingList) error {
+	if len(lists) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*domain.PackingList, len(lists))
+	ids := make([]uuid.UUID, len(lists))
+	for i, l := range lists {
+		l.Items = []domain.PackingItem{}
+		byID[l.ID] = l
+		ids[i] = l.ID
+	}
+
+	const q = `
+		SELECT ` + packingItemColumns + `
+		FROM packing_items
+		WHERE list_id = ANY(@ids::uuid[])
+		ORDER BY list_id, position, id`
+
+	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return fmt.Errorf("items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanPackingItem(rows)
+		if err != nil {
+			return fmt.Errorf("items: scan: %w", err)
+		}
+		l := byID[item.ListID]
+		l.Items = append(l.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("items: rows: %w", err)
+	}
+	return nil
+}
+
+// scanPackingList maps a single packing_lists row. Items are loaded separately.
+func scanPackingList(s scanner) (domain.PackingList, error) {
+	var (
+		l      domain.PackingList
+		id     pgtype.UUID
+		tripID pgtype.UUID
+	)
+	if err := s.Scan(&id, &tripID, &l.Name, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PackingList{}, domain.ErrNotFound
+		}
+		return domain.PackingList{}, err
+	}
+	l.ID = uuid.UUID(id.Bytes)
+	if tripID.Valid {
+		t := uuid.UUID(tripID.Bytes)
+		l.TripID = &t
+	}
+	return l, nil
+}
+
+// scanPackingItem maps a single packing_items row into a domain.PackingItem.
+func scanPackingItem(s scanner) (domain.PackingItem, error) {
+	var (
+		item   domain.PackingItem
+		id     pgtype.UUID
+		listID pgtype.UUID
+	)
+	err := s.Scan(&id, &listID, &item.Position, &item.Name, &item.Quantity, &item.Packed, &item.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PackingItem{}, domain.ErrNotFound
+		}
+		return domain.PackingItem{}, err
+	}
+	item.ID = uuid.UUID(id.Bytes)
+	item.ListID = uuid.UUID(listID.Bytes)
+	return item, nil
+}
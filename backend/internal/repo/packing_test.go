@@ -0,0 +1,144 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newPackingTestRepo returns a PackingRepo and its rolled-back transaction,
+// so parent trips can be inserted with testutil/factory.
+func newPackingTestRepo(t *testing.T) (pgx.Tx, repo.PackingRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewPackingRepo(tx)
+}
+
+func TestPackingRepo_CreateListWithItems(t *testing.T) {
+	tx, packing := newPackingTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+
+	created, err := packing.CreateList(ctx, domain.PackingList{
+		TripID: &trip.ID,
+		Name:   "Summer basics",
+		Items: []domain.PackingItem{
+			{Name: "Sewer hose", Quantity: 1},
+			{Name: "Leveling blocks", Quantity: 8, Packed: true},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &trip.ID, created.TripID)
+	require.Len(t, created.Items, 2)
+	assert.Equal(t, 1, created.Items[0].Position)
+	assert.Equal(t, "Leveling blocks", created.Items[1].Name)
+	assert.Equal(t, 8, created.Items[1].Quantity)
+	assert.True(t, created.Items[1].Packed)
+}
+
+func TestPackingRepo_ItemLifecycle(t *testing.T) {
+	_, packing := newPackingTestRepo(t)
+	ctx := context.Background()
+	l, err := packing.CreateList(ctx, domain.PackingList{Name: "Summer basics"})
+	require.NoError(t, err)
+	assert.Empty(t, l.Items)
+
+	first, err := packing.CreateItem(ctx, domain.PackingItem{ListID: l.ID, Name: "Chairs", Quantity: 2})
+	require.NoError(t, err)
+	second, err := packing.CreateItem(ctx, domain.PackingItem{ListID: l.ID, Name: "Sewer hose", Quantity: 1})
+	require.NoError(t, err)
+	assert.Equal(t, first.Position+1, second.Position, "appended at the end")
+
+	first.Packed, first.Quantity = true, 4
+	updated, err := packing.UpdateItem(ctx, first)
+	require.NoError(t, err)
+	assert.True(t, updated.Packed)
+	assert.Equal(t, 4, updated.Quantity)
+
+	first.ListID = uuid.New()
+	_, err = packing.UpdateItem(ctx, first)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "scoped to the list")
+
+	assert.ErrorIs(t, packing.DeleteItem(ctx, uuid.New(), second.ID), domain.ErrNotFound)
+	require.NoError(t, packing.DeleteItem(ctx, l.ID, second.ID))
+
+	got, err := packing.GetList(ctx, l.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, "Chairs", got.Items[0].Name)
+}
+
+func TestPackingRepo_ListLists_Filters(t *testing.T) {
+	tx, packing := newPackingTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	tmpl, err := packing.CreateList(ctx, domain.PackingList{Name: "Summer basics"})
+	require.NoError(t, err)
+	tripList, err := packing.CreateList(ctx, domain.PackingList{TripID: &trip.ID, Name: "Rockies 2025"})
+	require.NoError(t, err)
+
+	forTrip, err := packing.ListLists(ctx, domain.PackingListFilter{TripID: &trip.ID})
+	require.NoError(t, err)
+	require.Len(t, forTrip, 1)
+	assert.Equal(t, tripList.ID, forTrip[0].ID)
+
+	templates, err := packing.ListLists(ctx, domain.PackingListFilter{Templates: true})
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, len(templates))
+	for i, l := range templates {
+		assert.True(t, l.IsTemplate())
+		ids[i] = l.ID
+	}
+	assert.Contains(t, ids, tmpl.ID)
+	assert.NotContains(t, ids, tripList.ID)
+}
+
+func TestPackingRepo_UpdateAndDeleteList(t *testing.T) {
+	_, packing := newPackingTestRepo(t)
+	ctx := context.Background()
+	l, err := packing.CreateList(ctx, domain.PackingList{Name: "Summer", Items: []domain.PackingItem{{Name: "Chairs", Quantity: 2}}})
+	require.NoError(t, err)
+
+	renamed, err := packing.UpdateList(ctx, domain.PackingList{ID: l.ID, Name: "Summer basics"})
+	require.NoError(t, err)
+	assert.Equal(t, "Summer basics", renamed.Name)
+	assert.Len(t, renamed.Items, 1, "items are untouched")
+
+	require.NoError(t, packing.DeleteList(ctx, l.ID))
+	_, err = packing.GetList(ctx, l.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, packing.DeleteList(ctx, l.ID), domain.ErrNotFound)
+}
+
+// TestPackingRepo_TripPurgeCascades verifies a trip's lists go with it once
+// it is purged from the trash.
+func TestPackingRepo_TripPurgeCascades(t *testing.T) {
+	tx, packing := newPackingTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	l, err := packing.CreateList(ctx, domain.PackingList{TripID: &trip.ID, Name: "Rockies 2025"})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `DELETE FROM trips WHERE id = $1`, trip.ID)
+	require.NoError(t, err)
+
+	_, err = packing.GetList(ctx, l.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// PhotoRepo defines the persistence operations for stop photos. It stores
+// only their metadata; the images live in object storage. Photos belong to a
+// stop; callers check the stop exists and belongs to the trip.
+type PhotoRepo interface {
+	// Create inserts a photo and returns the persisted record.
+	Create(ctx context.Context, p domain.Photo) (domain.Photo, error)
+
+	// GetByID retrieves a stop's photo.
+	// Returns domain.ErrNotFound if the stop has no photo with that ID.
+	GetByID(ctx context.Context, stopID, id uuid.UUID) (domain.Photo, error)
+
+	// Get retrieves a photo by ID alone, provided its stop and trip are live.
+	// Returns domain.ErrNotFound if there is no such photo.
+	Get(ctx context.Context, id uuid.UUID) (domain.Photo, error)
+
+	// ListByStop returns a stop's photos, oldest first.
+	ListByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Photo, error)
+
+	// Delete removes a stop's photo and returns it, so the caller can remove
+	// the image. Returns domain.ErrNotFound if the stop has no photo with that ID.
+	Delete(ctx context.Context, stopID, id uuid.UUID) (domain.Photo, error)
+}
+
+// pgPhotoRepo is the Postgres implementation of PhotoRepo.
+type pgPhotoRepo struct {
+	db db
+}
+
+// NewPhotoRepo constructs a PhotoRepo backed by the provided db connection.
+func NewPhotoRepo(db db) PhotoRepo {
+	return &pgPhotoRepo{db: db}
+}
+
+const photoColumns = `id, stop_id, object_key, content_type, size_bytes, filename, caption, thumbnails, taken_at, latitude, longitude, created_at`
+
+// Create inserts a stop_photos row and returns the full persisted record.
+func (r *pgPhotoRepo) Create(ctx context.Context, p domain.Photo) (domain.Photo, error) {
+	const q = `
+		INSERT INTO stop_photos (stop_id, object_key, content_type, size_bytes, filename, caption, thumbnails, taken_at, latitude, longitude)
+		VALUES (@stop_id, @object_key, @content_type, @size_bytes, @filename, @caption, COALESCE(@thumbnails::jsonb, '{}'), @taken_at, @latitude, @longitude)
+		RETURNING ` + photoColumns
+
+	args := pgx.NamedArgs{
+		"stop_id":      p.StopID,
+		"object_key":   p.ObjectKey,
+		"content_type": p.ContentType,
+		"size_bytes":   p.SizeBytes,
+		"filename":     nullableString(p.Filename),
+		"caption":      nullableString(p.Caption),
+		"thumbnails":   p.Thumbnails, // nil becomes {}
+		"taken_at":     p.TakenAt,
+		"latitude":     p.Latitude,
+		"longitude":    p.Longitude,
+	}
+	result, err := scanPhoto(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.Photo{}, fmt.Errorf("repo.PhotoRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a photo scoped to its stop.
+func (r *pgPhotoRepo) GetByID(ctx context.Context, stopID, id uuid.UUID) (domain.Photo, error) {
+	const q = `
+		SELECT ` + photoColumns + ` FROM stop_photos
+		WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	result, err := scanPhoto(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID})))
+	if err != nil {
+		return domain.Photo{}, fmt.Errorf("repo.PhotoRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// Get retrieves a photo whose stop is live, without knowing the stop.
+func (r *pgPhotoRepo) Get(ctx context.Context, id uuid.UUID) (domain.Photo, error) {
+	const q = `
+		SELECT ` + photoColumns + ` FROM stop_photos
+		WHERE id = @id AND stop_id IN (SELECT s.id FROM stops s WHERE ` + liveStopSQL + `)`
+
+	result, err := scanPhoto(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.Photo{}, fmt.Errorf("repo.PhotoRepo.Get: %w", err)
+	}
+	return result, nil
+}
+
+// ListByStop returns a stop's photos in upload order.
+func (r *pgPhotoRepo) ListByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Photo, error) {
+	const q = `
+		SELECT ` + photoColumns + `
+		FROM stop_photos
+		WHERE stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		ORDER BY created_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.PhotoRepo.ListByStop: %w", err)
+	}
+	defer rows.Close()
+
+	photos := []domain.Photo{}
+	for rows.Next() {
+		p, err := scanPhoto(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.PhotoRepo.ListByStop: scan: %w", err)
+		}
+		photos = append(photos, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.PhotoRepo.ListByStop: rows: %w", err)
+	}
+	return photos, nil
+}
+
+// Delete removes a photo scoped to its stop.
+func (r *pgPhotoRepo) Delete(ctx context.Context, stopID, id uuid.UUID) (domain.Photo, error) {
+	const q = `
+		DELETE FROM stop_photos
+		WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		RETURNING ` + photoColumns
+
+	result, err := scanPhoto(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID})))
+	if err != nil {
+		return domain.Photo{}, fmt.Errorf("repo.PhotoRepo.Delete: %w", err)
+	}
+	return result, nil
+}
+
+// scanPhoto maps a single stop_photos row into a domain.Photo.
+func scanPhoto(s scanner) (domain.Photo, error) {
+	var (
+		p        domain.Photo
+		id       pgtype.UUID
+		stopID   pgtype.UUID
+		filename *string
+		caption  *string
+	)
+	err := s.Scan(&id, &stopID, &p.ObjectKey, &p.ContentType, &p.SizeBytes, &filename, &caption, &p.Thumbnails, &p.TakenAt, &p.Latitude, &p.Longitude, &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Photo{}, domain.ErrNotFound
+		}
+		return domain.Photo{}, err
+	}
+	p.ID = uuid.UUID(id.Bytes)
+	p.StopID = uuid.UUID(stopID.Bytes)
+	if filename != nil {
+		p.Filename = *filename
+	}
+	if caption != nil {
+		p.Caption = *caption
+	}
+	return p, nil
+}
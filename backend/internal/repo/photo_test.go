@@ -0,0 +1,129 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newPhotoTestRepo returns a PhotoRepo and its rolled-back transaction, so
+// parent trips and stops can be inserted with testutil/factory.
+func newPhotoTestRepo(t *testing.T) (pgx.Tx, repo.PhotoRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewPhotoRepo(tx)
+}
+
+func TestPhotoRepo_CRUD(t *testing.T) {
+	tx, photos := newPhotoTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+
+	created, err := photos.Create(ctx, domain.Photo{
+		StopID:      stop.ID,
+		ObjectKey:   "photos/a.jpg",
+		ContentType: "image/jpeg",
+		SizeBytes:   2483112,
+		Filename:    "IMG_2041.jpg",
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, "IMG_2041.jpg", created.Filename)
+	assert.Empty(t, created.Caption)
+	assert.EqualValues(t, 2483112, created.SizeBytes)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	got, err := photos.GetByID(ctx, stop.ID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "photos/a.jpg", got.ObjectKey)
+
+	_, err = photos.GetByID(ctx, uuid.New(), created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "scoped to the stop")
+
+	second, err := photos.Create(ctx, domain.Photo{StopID: stop.ID, ObjectKey: "photos/b.png", ContentType: "image/png", SizeBytes: 10, Caption: "Lake"})
+	require.NoError(t, err)
+	list, err := photos.ListByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, created.ID, list[0].ID, "oldest first")
+	assert.Equal(t, "Lake", list[1].Caption)
+
+	deleted, err := photos.Delete(ctx, stop.ID, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "photos/b.png", deleted.ObjectKey, "the caller needs the key to remove the image")
+	_, err = photos.Delete(ctx, stop.ID, second.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPhotoRepo_Thumbnails(t *testing.T) {
+	tx, photos := newPhotoTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+
+	bare, err := photos.Create(ctx, domain.Photo{StopID: stop.ID, ObjectKey: "photos/a.webp", ContentType: "image/webp", SizeBytes: 10})
+	require.NoError(t, err)
+	assert.Empty(t, bare.Thumbnails)
+
+	thumbs := map[domain.ThumbnailSize]string{
+		domain.ThumbnailSmall:  "photos/b-small.jpg",
+		domain.ThumbnailMedium: "photos/b-medium.jpg",
+	}
+	created, err := photos.Create(ctx, domain.Photo{StopID: stop.ID, ObjectKey: "photos/b.png", ContentType: "image/png", SizeBytes: 10, Thumbnails: thumbs})
+	require.NoError(t, err)
+	assert.Equal(t, thumbs, created.Thumbnails)
+
+	got, err := photos.Get(ctx, created.ID)
+	require.NoError(t, err, "found by ID alone")
+	assert.Equal(t, thumbs, got.Thumbnails)
+
+	_, err = photos.Get(ctx, uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPhotoRepo_EXIF(t *testing.T) {
+	tx, photos := newPhotoTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+
+	bare, err := photos.Create(ctx, domain.Photo{StopID: stop.ID, ObjectKey: "photos/a.png", ContentType: "image/png", SizeBytes: 10})
+	require.NoError(t, err)
+	assert.Nil(t, bare.TakenAt)
+	assert.Nil(t, bare.Latitude)
+
+	takenAt := time.Date(2025, 7, 15, 0, 32, 5, 0, time.UTC)
+	lat, lon := 44.6615, -110.4995
+	created, err := photos.Create(ctx, domain.Photo{
+		StopID: stop.ID, ObjectKey: "photos/b.jpg", ContentType: "image/jpeg", SizeBytes: 10,
+		TakenAt: &takenAt, Latitude: &lat, Longitude: &lon,
+	})
+	require.NoError(t, err)
+
+	got, err := photos.GetByID(ctx, stop.ID, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.TakenAt)
+	assert.True(t, takenAt.Equal(*got.TakenAt))
+	require.NotNil(t, got.Latitude)
+	assert.InDelta(t, lat, *got.Latitude, 1e-9)
+	require.NotNil(t, got.Longitude)
+	assert.InDelta(t, lon, *got.Longitude, 1e-9)
+}
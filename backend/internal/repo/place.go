@@ -0,0 +1,197 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// PlaceRepo defines the persistence operations for the places stops are in.
+// A stop's place is current while the coordinates it was looked up for
+// match the stop's; otherwise it is pending, as is a stop with coordinates
+// and no place at all. Stops without coordinates are never pending.
+//
+// year limits each operation to stops arrived at in that calendar year
+// (UTC); zero means every year.
+type PlaceRepo interface {
+	// Pending returns up to limit stops whose place is pending, oldest
+	// arrival first, as StopPlaces holding only the stop ID and its current
+	// coordinates. The count is of every pending stop, not just those returned.
+	Pending(ctx context.Context, year, limit int) ([]domain.StopPlace, int, error)
+
+	// Upsert stores a stop's place, replacing any earlier one, and stamps
+	// resolved_at. Returns domain.ErrNotFound if the stop no longer exists.
+	Upsert(ctx context.Context, place domain.StopPlace) (domain.StopPlace, error)
+
+	// Visits groups the stops with a current place in a country by region,
+	// ordered by country and then region code.
+	Visits(ctx context.Context, year int) ([]domain.RegionVisit, error)
+}
+
+// pgPlaceRepo is the Postgres implementation of PlaceRepo.
+type pgPlaceRepo struct {
+	db db
+}
+
+// NewPlaceRepo constructs a PlaceRepo backed by the provided db connection.
+func NewPlaceRepo(db db) PlaceRepo {
+	return &pgPlaceRepo{db: db}
+}
+
+// pendingPlaceFilter matches stops joined to stop_places p whose place needs
+// looking up, within the @from/@to arrival window. Trashed stops wait until
+// they are restored.
+const pendingPlaceFilter = `
+		s.latitude IS NOT NULL
+		AND ` + liveStopSQL + `
+		AND (p.stop_id IS NULL OR p.latitude <> s.latitude OR p.longitude <> s.longitude)
+		AND (@from::timestamptz IS NULL OR s.arrived_at >= @from)
+		AND (@to::timestamptz IS NULL OR s.arrived_at < @to)`
+
+// Pending counts the pending stops and returns the first limit of them.
+func (r *pgPlaceRepo) Pending(ctx context.Context, year, limit int) ([]domain.StopPlace, int, error) {
+	const countQ = `
+		SELECT COUNT(*)
+		FROM stops s
+		LEFT JOIN stop_places p ON p.stop_id = s.id
+		WHERE` + pendingPlaceFilter
+
+	const q = `
+		SELECT s.id, s.latitude, s.longitude
+		FROM stops s
+		LEFT JOIN stop_places p ON p.stop_id = s.id
+		WHERE` + pendingPlaceFilter + `
+		ORDER BY s.arrived_at, s.id
+		LIMIT @limit`
+
+	args := scoped(ctx, yearWindow(year))
+	var total int
+	if err := r.db.QueryRow(ctx, countQ, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("repo.PlaceRepo.Pending: count: %w", err)
+	}
+
+	args["limit"] = limit
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("repo.PlaceRepo.Pending: %w", err)
+	}
+	defer rows.Close()
+
+	pending := []domain.StopPlace{}
+	for rows.Next() {
+		var (
+			p  domain.StopPlace
+			id pgtype.UUID
+		)
+		if err := rows.Scan(&id, &p.Latitude, &p.Longitude); err != nil {
+			return nil, 0, fmt.Errorf("repo.PlaceRepo.Pending: scan: %w", err)
+		}
+		p.StopID = uuid.UUID(id.Bytes)
+		pending = append(pending, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("repo.PlaceRepo.Pending: rows: %w", err)
+	}
+	return pending, total, nil
+}
+
+// Upsert inserts or replaces a stop's place. Selecting from stops turns a
+// stop deleted since it was looked up into no row rather than an FK error.
+func (r *pgPlaceRepo) Upsert(ctx context.Context, place domain.StopPlace) (domain.StopPlace, error) {
+	const q = `
+		INSERT INTO stop_places (stop_id, latitude, longitude, country_code, region_code, region_name)
+		SELECT id, @latitude::double precision, @longitude::double precision,
+		       @country_code::text, @region_code::text, @region_name::text
+		FROM stops WHERE id = @stop_id AND id IN ` + orgStopsSQL + `
+		ON CONFLICT (stop_id) DO UPDATE
+		SET latitude = EXCLUDED.latitude,
+		    longitude = EXCLUDED.longitude,
+		    country_code = EXCLUDED.country_code,
+		    region_code = EXCLUDED.region_code,
+		    region_name = EXCLUDED.region_name,
+		    resolved_at = now()
+		RETURNING stop_id, latitude, longitude, country_code, region_code, region_name, resolved_at`
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"stop_id":      place.StopID,
+		"latitude":     place.Latitude,
+		"longitude":    place.Longitude,
+		"country_code": nullableString(place.CountryCode),
+		"region_code":  nullableString(place.RegionCode),
+		"region_name":  nullableString(place.RegionName),
+	}))
+	var (
+		result                          domain.StopPlace
+		stopID                          pgtype.UUID
+		country, regionCode, regionName *string
+	)
+	if err := row.Scan(&stopID, &result.Latitude, &result.Longitude, &country, &regionCode, &regionName, &result.ResolvedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.StopPlace{}, fmt.Errorf("repo.PlaceRepo.Upsert: %w", domain.ErrNotFound)
+		}
+		return domain.StopPlace{}, fmt.Errorf("repo.PlaceRepo.Upsert: %w", err)
+	}
+	result.StopID = uuid.UUID(stopID.Bytes)
+	if country != nil {
+		result.CountryCode = *country
+	}
+	if regionCode != nil {
+		result.RegionCode = *regionCode
+	}
+	if regionName != nil {
+		result.RegionName = *regionName
+	}
+	return result, nil
+}
+
+// Visits aggregates current places by country and region. Planned stops
+// have not been visited yet.
+func (r *pgPlaceRepo) Visits(ctx context.Context, year int) ([]domain.RegionVisit, error) {
+	const q = `
+		SELECT p.country_code, COALESCE(p.region_code, ''), COALESCE(p.region_name, ''),
+		       COUNT(*), COUNT(DISTINCT s.trip_id), MIN(s.arrived_at), MAX(s.arrived_at)
+		FROM stops s
+		JOIN stop_places p ON p.stop_id = s.id AND p.latitude = s.latitude AND p.longitude = s.longitude
+		WHERE p.country_code IS NOT NULL AND s.arrived_at IS NOT NULL
+		  AND ` + liveStopSQL + `
+		  AND (@from::timestamptz IS NULL OR s.arrived_at >= @from)
+		  AND (@to::timestamptz IS NULL OR s.arrived_at < @to)
+		GROUP BY 1, 2, 3
+		ORDER BY 1, 2, 3`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, yearWindow(year)))
+	if err != nil {
+		return nil, fmt.Errorf("repo.PlaceRepo.Visits: %w", err)
+	}
+	defer rows.Close()
+
+	visits := []domain.RegionVisit{}
+	for rows.Next() {
+		var v domain.RegionVisit
+		if err := rows.Scan(&v.CountryCode, &v.RegionCode, &v.RegionName, &v.Stops, &v.Trips, &v.FirstVisited, &v.LastVisited); err != nil {
+			return nil, fmt.Errorf("repo.PlaceRepo.Visits: scan: %w", err)
+		}
+		visits = append(visits, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.PlaceRepo.Visits: rows: %w", err)
+	}
+	return visits, nil
+}
+
+// yearWindow returns the @from and @to arrival bounds for a calendar year
+// in UTC, both NULL for year zero.
+func yearWindow(year int) pgx.NamedArgs {
+	if year == 0 {
+		return pgx.NamedArgs{"from": nil, "to": nil}
+	}
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return pgx.NamedArgs{"from": from, "to": from.AddDate(1, 0, 0)}
+}
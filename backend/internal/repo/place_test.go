@@ -0,0 +1,145 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newPlaceTestRepo returns a PlaceRepo and its rolled-back transaction, so
+// trips and stops can be inserted with testutil/factory.
+func newPlaceTestRepo(t *testing.T) (pgx.Tx, repo.PlaceRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewPlaceRepo(tx)
+}
+
+func TestPlaceRepo_PendingAndVisits(t *testing.T) {
+	tx, places := newPlaceTestRepo(t)
+	ctx := context.Background()
+	tripA := factory.Trip().Insert(t, tx)
+	tripB := factory.Trip().Insert(t, tx)
+	glacier := factory.Stop().WithTripID(tripA.ID).WithArrivedAt(day(2025, 6, 1)).WithCoordinates(48.7, -113.8).Insert(t, tx)
+	banff := factory.Stop().WithTripID(tripA.ID).WithArrivedAt(day(2025, 6, 4)).WithCoordinates(51.2, -115.6).Insert(t, tx)
+	factory.Stop().WithTripID(tripA.ID).WithArrivedAt(day(2025, 6, 6)).Insert(t, tx) // no coordinates
+	bozeman := factory.Stop().WithTripID(tripB.ID).WithArrivedAt(day(2024, 8, 2)).WithCoordinates(45.7, -111.0).Insert(t, tx)
+
+	pending, total, err := places.Pending(ctx, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, pending, 2)
+	assert.Equal(t, bozeman.ID, pending[0].StopID, "oldest arrival first")
+	assert.InDelta(t, 45.7, pending[0].Latitude, 1e-9)
+
+	_, total, err = places.Pending(ctx, 2025, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	montana := func(p domain.StopPlace) domain.StopPlace {
+		p.CountryCode, p.RegionCode, p.RegionName = "US", "US-MT", "Montana"
+		return p
+	}
+	for _, p := range []domain.StopPlace{
+		montana(domain.StopPlace{StopID: glacier.ID, Latitude: 48.7, Longitude: -113.8}),
+		montana(domain.StopPlace{StopID: bozeman.ID, Latitude: 45.7, Longitude: -111.0}),
+		{StopID: banff.ID, Latitude: 51.2, Longitude: -115.6, CountryCode: "CA", RegionCode: "CA-AB", RegionName: "Alberta"},
+	} {
+		stored, err := places.Upsert(ctx, p)
+		require.NoError(t, err)
+		assert.False(t, stored.ResolvedAt.IsZero())
+	}
+
+	_, total, err = places.Pending(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	visits, err := places.Visits(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, visits, 2)
+	assert.Equal(t, "CA-AB", visits[0].RegionCode)
+	mt := visits[1]
+	assert.Equal(t, "Montana", mt.RegionName)
+	assert.Equal(t, 2, mt.Stops)
+	assert.Equal(t, 2, mt.Trips)
+	assert.True(t, mt.FirstVisited.Equal(day(2024, 8, 2)))
+	assert.True(t, mt.LastVisited.Equal(day(2025, 6, 1)))
+
+	visits, err = places.Visits(ctx, 2024)
+	require.NoError(t, err)
+	require.Len(t, visits, 1)
+	assert.Equal(t, 1, visits[0].Stops)
+}
+
+func TestPlaceRepo_MovedStopIsPendingAgain(t *testing.T) {
+	tx, places := newPlaceTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).WithCoordinates(48.7, -113.8).Insert(t, tx)
+	_, err := places.Upsert(ctx, domain.StopPlace{StopID: stop.ID, Latitude: 48.7, Longitude: -113.8, CountryCode: "US", RegionCode: "US-MT"})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `UPDATE stops SET latitude = 51.2, longitude = -115.6 WHERE id = $1`, stop.ID)
+	require.NoError(t, err)
+
+	pending, total, err := places.Pending(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, pending, 1)
+	assert.InDelta(t, 51.2, pending[0].Latitude, 1e-9)
+
+	visits, err := places.Visits(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, visits, "a stale place is not counted")
+
+	_, err = places.Upsert(ctx, domain.StopPlace{StopID: stop.ID, Latitude: 51.2, Longitude: -115.6, CountryCode: "CA", RegionCode: "CA-AB"})
+	require.NoError(t, err)
+	visits, err = places.Visits(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, visits, 1)
+	assert.Equal(t, "CA-AB", visits[0].RegionCode)
+	assert.Empty(t, visits[0].RegionName)
+}
+
+func TestPlaceRepo_AtSeaCountsAsResolved(t *testing.T) {
+	tx, places := newPlaceTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).WithCoordinates(0, -30).Insert(t, tx)
+
+	_, err := places.Upsert(ctx, domain.StopPlace{StopID: stop.ID, Latitude: 0, Longitude: -30})
+	require.NoError(t, err)
+
+	_, total, err := places.Pending(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	visits, err := places.Visits(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, visits)
+}
+
+func TestPlaceRepo_Upsert_DeletedStop(t *testing.T) {
+	tx, places := newPlaceTestRepo(t)
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).WithCoordinates(45, -110).Insert(t, tx)
+	_, err := tx.Exec(context.Background(), `DELETE FROM stops WHERE id = $1`, stop.ID)
+	require.NoError(t, err)
+
+	_, err = places.Upsert(context.Background(), domain.StopPlace{StopID: stop.ID, Latitude: 45, Longitude: -110, CountryCode: "US"})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,291 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// POIRepo defines the persistence operations for points of interest and
+// the poi_tags join table. Returned points always have Tags loaded.
+type POIRepo interface {
+	// Create inserts a point of interest and returns the persisted record.
+	Create(ctx context.Context, p domain.PointOfInterest) (domain.PointOfInterest, error)
+
+	// GetByID retrieves a point of interest by primary key.
+	// Returns domain.ErrNotFound if no point with that ID exists.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.PointOfInterest, error)
+
+	// ListPaged returns one page of points matching f, most recently seen
+	// first (points with no seen_at last), and the total number of matches.
+	ListPaged(ctx context.Context, f domain.POIFilter, p domain.PaginationParams) ([]domain.PointOfInterest, int64, error)
+
+	// Update overwrites a point's fields. Tags are left unchanged.
+	// Returns domain.ErrNotFound if no point with that ID exists.
+	Update(ctx context.Context, p domain.PointOfInterest) (domain.PointOfInterest, error)
+
+	// Delete removes a point by ID. Its poi_tags rows go with it.
+	// Returns domain.ErrNotFound if no point with that ID exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// SetTags replaces the tags linked to a point with tagIDs.
+	SetTags(ctx context.Context, id uuid.UUID, tagIDs []uuid.UUID) error
+}
+
+// pgPOIRepo is the Postgres implementation of POIRepo.
+type pgPOIRepo struct {
+	db db
+}
+
+// NewPOIRepo constructs a POIRepo backed by the provided db connection.
+func NewPOIRepo(db db) POIRepo {
+	return &pgPOIRepo{db: db}
+}
+
+const poiColumns = `id, trip_id, name, category, latitude, longitude, seen_at, notes, created_at, updated_at`
+
+// Create inserts a points_of_interest row and returns the full persisted record.
+func (r *pgPOIRepo) Create(ctx context.Context, p domain.PointOfInterest) (domain.PointOfInterest, error) {
+	const q = `
+		INSERT INTO points_of_interest (organization_id, trip_id, name, category, latitude, longitude, seen_at, notes)
+		VALUES (@organization_id, @trip_id, @name, @category, @latitude, @longitude, @seen_at, @notes)
+		RETURNING ` + poiColumns
+
+	result, err := scanPOI(r.db.QueryRow(ctx, q, scoped(ctx, poiArgs(p))))
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("repo.POIRepo.Create: %w", err)
+	}
+	result.Tags = []domain.Tag{}
+	return result, nil
+}
+
+// GetByID retrieves a point of interest and its tags.
+func (r *pgPOIRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.PointOfInterest, error) {
+	const q = `SELECT ` + poiColumns + ` FROM points_of_interest WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	result, err := scanPOI(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("repo.POIRepo.GetByID: %w", err)
+	}
+	if err := r.loadTags(ctx, []*domain.PointOfInterest{&result}); err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("repo.POIRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListPaged returns one page of matching points with their tags, and the total count.
+// Empty filter fields are passed as NULL and match every row. The tag filter
+// walks down the tag hierarchy, so it also matches the tags beneath it.
+func (r *pgPOIRepo) ListPaged(ctx context.Context, f domain.POIFilter, p domain.PaginationParams) ([]domain.PointOfInterest, int64, error) {
+	const where = `
+		WHERE organization_id = @organization_id
+		  AND (@trip_id::uuid IS NULL OR trip_id = @trip_id)
+		  AND ` + optionalTripSQL + `
+		  AND (@category::text IS NULL OR category = @category)
+		  AND (@tag::text IS NULL OR EXISTS (
+		        WITH RECURSIVE subtree (id) AS (
+		            SELECT id FROM tags WHERE organization_id = @organization_id AND slug = @tag
+		            UNION
+		            SELECT c.id FROM tags c JOIN subtree s ON c.parent_id = s.id
+		        )
+		        SELECT 1 FROM poi_tags pt JOIN subtree ON subtree.id = pt.tag_id
+		        WHERE pt.poi_id = points_of_interest.id))`
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"trip_id":  f.TripID,
+		"category": nullableString(string(f.Category)),
+		"tag":      nullableString(f.TagSlug),
+		"limit":    p.Limit,
+		"offset":   p.Offset(),
+	})
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM points_of_interest`+where, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("repo.POIRepo.ListPaged: count: %w", err)
+	}
+
+	q := `SELECT ` + poiColumns + ` FROM points_of_interest` + where + `
+		ORDER BY seen_at DESC NULLS LAST, created_at DESC, id
+		LIMIT @limit OFFSET @offset`
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("repo.POIRepo.ListPaged: %w", err)
+	}
+	defer rows.Close()
+
+	points := []domain.PointOfInterest{}
+	for rows.Next() {
+		point, err := scanPOI(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("repo.POIRepo.ListPaged: scan: %w", err)
+		}
+		points = append(points, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("repo.POIRepo.ListPaged: rows: %w", err)
+	}
+
+	ptrs := make([]*domain.PointOfInterest, len(points))
+	for i := range points {
+		ptrs[i] = &points[i]
+	}
+	if err := r.loadTags(ctx, ptrs); err != nil {
+		return nil, 0, fmt.Errorf("repo.POIRepo.ListPaged: %w", err)
+	}
+	return points, total, nil
+}
+
+// Update overwrites a point's fields and returns it with its tags.
+func (r *pgPOIRepo) Update(ctx context.Context, p domain.PointOfInterest) (domain.PointOfInterest, error) {
+	const q = `
+		UPDATE points_of_interest
+		SET trip_id = @trip_id,
+		    name = @name,
+		    category = @category,
+		    latitude = @latitude,
+		    longitude = @longitude,
+		    seen_at = @seen_at,
+		    notes = @notes,
+		    updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL + `
+		RETURNING ` + poiColumns
+
+	args := scoped(ctx, poiArgs(p))
+	args["id"] = p.ID
+	result, err := scanPOI(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("repo.POIRepo.Update: %w", err)
+	}
+	if err := r.loadTags(ctx, []*domain.PointOfInterest{&result}); err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("repo.POIRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a point of interest by ID.
+func (r *pgPOIRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM points_of_interest WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.POIRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.POIRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// SetTags replaces a point's tag links in one statement: links not in
+// tagIDs are deleted, and missing ones are inserted. The two sets never
+// overlap, so the delete and insert cannot conflict.
+func (r *pgPOIRepo) SetTags(ctx context.Context, id uuid.UUID, tagIDs []uuid.UUID) error {
+	const q = `
+		WITH removed AS (
+			DELETE FROM poi_tags
+			WHERE poi_id = @poi_id AND tag_id <> ALL(@tag_ids::uuid[])
+		)
+		INSERT INTO poi_tags (poi_id, tag_id)
+		SELECT @poi_id, unnest(@tag_ids::uuid[])
+		ON CONFLICT DO NOTHING`
+
+	if tagIDs == nil {
+		tagIDs = []uuid.UUID{}
+	}
+	if _, err := r.db.Exec(ctx, q, pgx.NamedArgs{"poi_id": id, "tag_ids": tagIDs}); err != nil {
+		return fmt.Errorf("repo.POIRepo.SetTags: %w", err)
+	}
+	return nil
+}
+
+// loadTags fills in Tags for each point with one query.
+func (r *pgPOIRepo) loadTags(ctx context.Context, points []*domain.PointOfInterest) error {
+	if len(points) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*domain.PointOfInterest, len(points))
+	ids := make([]uuid.UUID, len(points))
+	for i, p := range points {
+		p.Tags = []domain.Tag{}
+		byID[p.ID] = p
+		ids[i] = p.ID
+	}
+
+	const q = `
+		SELECT pt.poi_id, t.id, t.name, t.slug, t.created_at
+		FROM poi_tags pt
+		JOIN tags t ON t.id = pt.tag_id
+		WHERE pt.poi_id = ANY(@ids::uuid[])
+		ORDER BY pt.poi_id, t.slug`
+
+	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return fmt.Errorf("tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			poiID pgtype.UUID
+			t     domain.Tag
+			tagID pgtype.UUID
+		)
+		if err := rows.Scan(&poiID, &tagID, &t.Name, &t.Slug, &t.CreatedAt); err != nil {
+			return fmt.Errorf("tags: scan: %w", err)
+		}
+		t.ID = uuid.UUID(tagID.Bytes)
+		p := byID[uuid.UUID(poiID.Bytes)]
+		p.Tags = append(p.Tags, t)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("tags: rows: %w", err)
+	}
+	return nil
+}
+
+// poiArgs returns the named arguments shared by Create and Update.
+func poiArgs(p domain.PointOfInterest) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"trip_id":   p.TripID, // nil becomes NULL
+		"name":      p.Name,
+		"category":  string(p.Category),
+		"latitude":  p.Latitude,
+		"longitude": p.Longitude,
+		"seen_at":   p.SeenAt,
+		"notes":     nullableString(p.Notes),
+	}
+}
+
+// scanPOI maps a single points_of_interest row. Tags are loaded separately.
+func scanPOI(s scanner) (domain.PointOfInterest, error) {
+	var (
+		p        domain.PointOfInterest
+		id       pgtype.UUID
+		tripID   pgtype.UUID
+		category string
+		notes    *string
+	)
+	err := s.Scan(&id, &tripID, &p.Name, &category, &p.Latitude, &p.Longitude, &p.SeenAt, &notes, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PointOfInterest{}, domain.ErrNotFound
+		}
+		return domain.PointOfInterest{}, err
+	}
+	p.ID = uuid.UUID(id.Bytes)
+	if tripID.Valid {
+		t := uuid.UUID(tripID.Bytes)
+		p.TripID = &t
+	}
+	p.Category = domain.POICategory(category)
+	if notes != nil {
+		p.Notes = *notes
+	}
+	return p, nil
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newPOITestRepo returns a POIRepo, a TagRepo on the same rolled-back
+// transaction, and the transaction itself for inserting parent trips.
+func newPOITestRepo(t *testing.T) (pgx.Tx, repo.POIRepo, repo.TagRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewPOIRepo(tx), repo.NewTagRepo(tx)
+}
+
+func TestPOIRepo_CRUDAndTags(t *testing.T) {
+	tx, pois, tags := newPOITestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	seen := time.Date(2025, 7, 20, 6, 45, 0, 0, time.UTC)
+
+	created, err := pois.Create(ctx, domain.PointOfInterest{
+		TripID: &trip.ID, Name: "Bull moose", Category: domain.POIWildlife,
+		Latitude: 61.2181, Longitude: -149.9003, SeenAt: &seen,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Tag{}, created.Tags)
+	require.NotNil(t, created.SeenAt)
+	assert.True(t, created.SeenAt.Equal(seen))
+
+	moose, err := tags.Upsert(ctx, "Moose", "poi-test-moose")
+	require.NoError(t, err)
+	alaska, err := tags.Upsert(ctx, "Alaska", "poi-test-alaska")
+	require.NoError(t, err)
+
+	require.NoError(t, pois.SetTags(ctx, created.ID, []uuid.UUID{moose.ID, alaska.ID}))
+	got, err := pois.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Tags, 2)
+	assert.Equal(t, "poi-test-alaska", got.Tags[0].Slug, "tags ordered by slug")
+
+	require.NoError(t, pois.SetTags(ctx, created.ID, []uuid.UUID{moose.ID}))
+	got, err = pois.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Tags, 1)
+	assert.Equal(t, "poi-test-moose", got.Tags[0].Slug)
+
+	got.Name, got.TripID = "Cow moose", nil
+	updated, err := pois.Update(ctx, got)
+	require.NoError(t, err)
+	assert.Equal(t, "Cow moose", updated.Name)
+	assert.Nil(t, updated.TripID)
+	assert.Len(t, updated.Tags, 1, "update leaves tags alone")
+
+	require.NoError(t, pois.Delete(ctx, created.ID))
+	_, err = pois.GetByID(ctx, created.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestPOIRepo_ListPaged_Filters(t *testing.T) {
+	tx, pois, tags := newPOITestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	early := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	late := early.Add(48 * time.Hour)
+
+	arch, err := pois.Create(ctx, domain.PointOfInterest{
+		TripID: &trip.ID, Name: "Delicate Arch", Category: domain.POILandmark, Latitude: 38.74, Longitude: -109.50, SeenAt: &early,
+	})
+	require.NoError(t, err)
+	brewery, err := pois.Create(ctx, domain.PointOfInterest{
+		TripID: &trip.ID, Name: "Moab Brewery", Category: domain.POIBrewery, Latitude: 38.57, Longitude: -109.55, SeenAt: &late,
+	})
+	require.NoError(t, err)
+	_, err = pois.Create(ctx, domain.PointOfInterest{
+		TripID: &trip.ID, Name: "Overlook", Category: domain.POIViewpoint, Latitude: 38.6, Longitude: -109.6,
+	})
+	require.NoError(t, err)
+
+	hike, err := tags.Upsert(ctx, "Hike", "poi-test-hike")
+	require.NoError(t, err)
+	require.NoError(t, pois.SetTags(ctx, arch.ID, []uuid.UUID{hike.ID}))
+
+	page := domain.NewPaginationParams(nil, nil)
+	all, total, err := pois.ListPaged(ctx, domain.POIFilter{TripID: &trip.ID}, page)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, all, 3)
+	assert.Equal(t, brewery.ID, all[0].ID, "most recently seen first")
+	assert.Equal(t, "Overlook", all[2].Name, "unseen last")
+	assert.Len(t, all[1].Tags, 1)
+
+	byCategory, _, err := pois.ListPaged(ctx, domain.POIFilter{TripID: &trip.ID, Category: domain.POIBrewery}, page)
+	require.NoError(t, err)
+	require.Len(t, byCategory, 1)
+	assert.Equal(t, brewery.ID, byCategory[0].ID)
+
+	byTag, _, err := pois.ListPaged(ctx, domain.POIFilter{TripID: &trip.ID, TagSlug: "poi-test-hike"}, page)
+	require.NoError(t, err)
+	require.Len(t, byTag, 1)
+	assert.Equal(t, arch.ID, byTag[0].ID)
+
+	_, err = tags.Upsert(ctx, "Outdoors", "poi-test-outdoors")
+	require.NoError(t, err)
+	_, err = tags.SetParent(ctx, "poi-test-hike", "poi-test-outdoors")
+	require.NoError(t, err)
+	byParent, _, err := pois.ListPaged(ctx, domain.POIFilter{TripID: &trip.ID, TagSlug: "poi-test-outdoors"}, page)
+	require.NoError(t, err)
+	require.Len(t, byParent, 1, "a tag matches the tags beneath it")
+	assert.Equal(t, arch.ID, byParent[0].ID)
+}
@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// PoolMonitor reports on the connection pool every repo shares.
+type PoolMonitor struct {
+	pool *pgxpool.Pool
+}
+
+// NewPoolMonitor constructs a PoolMonitor for pool.
+func NewPoolMonitor(pool *pgxpool.Pool) *PoolMonitor {
+	return &PoolMonitor{pool: pool}
+}
+
+// Stats returns the pool's current statistics. It takes the pool's lock
+// briefly but never touches the database, so it answers even when every
+// connection is busy.
+func (m *PoolMonitor) Stats() domain.PoolStats {
+	st := m.pool.Stat()
+	return domain.PoolStats{
+		AcquiredConns:        int(st.AcquiredConns()),
+		IdleConns:            int(st.IdleConns()),
+		ConstructingConns:    int(st.ConstructingConns()),
+		TotalConns:           int(st.TotalConns()),
+		MaxConns:             int(st.MaxConns()),
+		AcquireCount:         st.AcquireCount(),
+		EmptyAcquireCount:    st.EmptyAcquireCount(),
+		CanceledAcquireCount: st.CanceledAcquireCount(),
+		NewConnsCount:        st.NewConnsCount(),
+		AcquireDuration:      st.AcquireDuration(),
+		EmptyAcquireWait:     st.EmptyAcquireWaitTime(),
+	}
+}
+
+// Ping checks that a connection can be acquired and the server answers.
+func (m *PoolMonitor) Ping(ctx context.Context) error {
+	if err := m.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("repo.PoolMonitor.Ping: %w", err)
+	}
+	return nil
+}
+
+// WarmUp runs a read against the busiest tables, so that a connection is
+// open and their catalog entries are cached before the first request, and
+// a schema that has the migration rows but not the tables is caught.
+func (m *PoolMonitor) WarmUp(ctx context.Context) error {
+	var trips, stops bool
+	if err := m.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM trips), EXISTS (SELECT 1 FROM stops)`).Scan(&trips, &stops); err != nil {
+		return fmt.Errorf("repo.PoolMonitor.WarmUp: %w", err)
+	}
+	return nil
+}
+
+// PostGISAvailable reports whether the database server can run PostGIS:
+// the extension is either installed already or available for migration 030
+// to create. Stock Postgres images lack it; the spatial stop queries then
+// fall back to haversine SQL.
+func (m *PoolMonitor) PostGISAvailable(ctx context.Context) (bool, error) {
+	var available bool
+	if err := m.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'postgis')`).Scan(&available); err != nil {
+		return false, fmt.Errorf("repo.PoolMonitor.PostGISAvailable: %w", err)
+	}
+	return available, nil
+}
@@ -0,0 +1,25 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// TestPoolMonitor_PostGISAvailable verifies that the test database, which
+// the test containers run on a PostGIS image, reports the extension.
+func TestPoolMonitor_PostGISAvailable(t *testing.T) {
+	pool := testutil.NewPool(t)
+
+	available, err := repo.NewPoolMonitor(pool).PostGISAvailable(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, available)
+}
@@ -0,0 +1,176 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// PowerRepo defines the persistence operations for power readings.
+type PowerRepo interface {
+	// Create inserts a new reading and returns the persisted record.
+	Create(ctx context.Context, reading domain.PowerReading) (domain.PowerReading, error)
+
+	// GetByID retrieves a reading by primary key.
+	// Returns domain.ErrNotFound if no reading with that ID exists.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.PowerReading, error)
+
+	// ListPaged returns one page of readings matching f, newest first, and the
+	// total number of matching readings across all pages.
+	ListPaged(ctx context.Context, f domain.PowerFilter, p domain.PaginationParams) ([]domain.PowerReading, int64, error)
+
+	// ListByTripID returns every reading linked to a trip, oldest first.
+	ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.PowerReading, error)
+
+	// Delete removes a reading by ID.
+	// Returns domain.ErrNotFound if no reading with that ID exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgPowerRepo is the Postgres implementation of PowerRepo.
+type pgPowerRepo struct {
+	db db
+}
+
+// NewPowerRepo constructs a PowerRepo backed by the provided db connection.
+func NewPowerRepo(db db) PowerRepo {
+	return &pgPowerRepo{db: db}
+}
+
+const powerColumns = `id, recorded_at, state_of_charge, solar_wh, generator_hours, trip_id, notes, created_at`
+
+// Create inserts a reading row and returns the full persisted record.
+func (r *pgPowerRepo) Create(ctx context.Context, reading domain.PowerReading) (domain.PowerReading, error) {
+	const q = `
+		INSERT INTO power_readings (organization_id, recorded_at, state_of_charge, solar_wh, generator_hours, trip_id, notes)
+		VALUES (@organization_id, @recorded_at, @state_of_charge, @solar_wh, @generator_hours, @trip_id, @notes)
+		RETURNING ` + powerColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"recorded_at":     reading.RecordedAt,
+		"state_of_charge": reading.StateOfCharge, // nil becomes NULL
+		"solar_wh":        reading.SolarWh,
+		"generator_hours": reading.GeneratorHours,
+		"trip_id":         reading.TripID,
+		"notes":           nullableString(reading.Notes),
+	}))
+	result, err := scanPowerReading(row)
+	if err != nil {
+		return domain.PowerReading{}, fmt.Errorf("repo.PowerRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a reading by primary key.
+func (r *pgPowerRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.PowerReading, error) {
+	const q = `SELECT ` + powerColumns + ` FROM power_readings WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	result, err := scanPowerReading(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.PowerReading{}, fmt.Errorf("repo.PowerRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListPaged returns one page of matching readings, newest first, with the total count.
+func (r *pgPowerRepo) ListPaged(ctx context.Context, f domain.PowerFilter, p domain.PaginationParams) ([]domain.PowerReading, int64, error) {
+	const where = ` WHERE organization_id = @organization_id AND (@trip_id::uuid IS NULL OR trip_id = @trip_id) AND ` + optionalTripSQL
+	args := scoped(ctx, pgx.NamedArgs{"trip_id": f.TripID, "limit": p.Limit, "offset": p.Offset()})
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM power_readings`+where, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("repo.PowerRepo.ListPaged: count: %w", err)
+	}
+
+	q := `SELECT ` + powerColumns + ` FROM power_readings` + where + `
+		ORDER BY recorded_at DESC, id
+		LIMIT @limit OFFSET @offset`
+	readings, err := r.query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("repo.PowerRepo.ListPaged: %w", err)
+	}
+	return readings, total, nil
+}
+
+// ListByTripID returns every reading linked to a trip in time order.
+func (r *pgPowerRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.PowerReading, error) {
+	const q = `
+		SELECT ` + powerColumns + `
+		FROM power_readings
+		WHERE trip_id = @trip_id AND organization_id = @organization_id AND ` + optionalTripSQL + `
+		ORDER BY recorded_at, id`
+
+	readings, err := r.query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.PowerRepo.ListByTripID: %w", err)
+	}
+	return readings, nil
+}
+
+// Delete removes a reading by ID.
+func (r *pgPowerRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM power_readings WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.PowerRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.PowerRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// query runs a multi-row reading query and scans every row.
+func (r *pgPowerRepo) query(ctx context.Context, q string, args pgx.NamedArgs) ([]domain.PowerReading, error) {
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	readings := []domain.PowerReading{}
+	for rows.Next() {
+		reading, err := scanPowerReading(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		readings = append(readings, reading)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+	return readings, nil
+}
+
+// scanPowerReading maps a single power_readings row into a domain.PowerReading.
+func scanPowerReading(s scanner) (domain.PowerReading, error) {
+	var (
+		p      domain.PowerReading
+		id     pgtype.UUID
+		tripID pgtype.UUID
+		notes  *string
+	)
+	err := s.Scan(&id, &p.RecordedAt, &p.StateOfCharge, &p.SolarWh, &p.GeneratorHours, &tripID, &notes, &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PowerReading{}, domain.ErrNotFound
+		}
+		return domain.PowerReading{}, err
+	}
+	p.ID = uuid.UUID(id.Bytes)
+	if tripID.Valid {
+		t := uuid.UUID(tripID.Bytes)
+		p.TripID = &t
+	}
+	if notes != nil {
+		p.Notes = *notes
+	}
+	return p, nil
+}
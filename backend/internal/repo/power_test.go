@@ -0,0 +1,112 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newPowerTestRepos returns a TripRepo and PowerRepo sharing one
+// rolled-back transaction.
+func newPowerTestRepos(t *testing.T) (repo.TripRepo, repo.PowerRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewTripRepo(tx), repo.NewPowerRepo(tx)
+}
+
+func TestPowerRepo_CreateAndGet(t *testing.T) {
+	trips, readings := newPowerTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+
+	at := time.Date(2025, 6, 3, 20, 0, 0, 0, time.UTC)
+	soc, gen := 78.5, 1.25
+	created, err := readings.Create(ctx, domain.PowerReading{
+		RecordedAt: at, StateOfCharge: &soc, GeneratorHours: &gen, TripID: &trip.ID, Notes: "Cloudy",
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+
+	got, err := readings.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.RecordedAt.Equal(at))
+	require.NotNil(t, got.StateOfCharge)
+	assert.InDelta(t, 78.5, *got.StateOfCharge, 0.001)
+	assert.Nil(t, got.SolarWh, "unset measurements round-trip as NULL")
+	require.NotNil(t, got.GeneratorHours)
+	assert.InDelta(t, 1.25, *got.GeneratorHours, 0.001)
+	require.NotNil(t, got.TripID)
+	assert.Equal(t, trip.ID, *got.TripID)
+	assert.Equal(t, "Cloudy", got.Notes)
+}
+
+func TestPowerRepo_GetByID_NotFound(t *testing.T) {
+	_, readings := newPowerTestRepos(t)
+
+	_, err := readings.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPowerRepo_ListPagedAndListByTripID(t *testing.T) {
+	trips, readings := newPowerTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	t0 := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 3 {
+		solar := float64(100 * (i + 1))
+		_, err := readings.Create(ctx, domain.PowerReading{RecordedAt: t0.AddDate(0, 0, i), SolarWh: &solar, TripID: &trip.ID})
+		require.NoError(t, err)
+	}
+	solar := 5.0
+	_, err = readings.Create(ctx, domain.PowerReading{RecordedAt: t0, SolarWh: &solar})
+	require.NoError(t, err)
+
+	page, total, err := readings.ListPaged(ctx, domain.PowerFilter{TripID: &trip.ID}, domain.PaginationParams{Page: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, page, 2)
+	assert.InDelta(t, 300, *page[0].SolarWh, 0.001, "newest first")
+
+	onTrip, err := readings.ListByTripID(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, onTrip, 3)
+	assert.InDelta(t, 100, *onTrip[0].SolarWh, 0.001, "oldest first")
+}
+
+func TestPowerRepo_RejectsEmptyReading(t *testing.T) {
+	_, readings := newPowerTestRepos(t)
+
+	// The service validates first; the CHECK constraint is the backstop.
+	_, err := readings.Create(context.Background(), domain.PowerReading{RecordedAt: time.Now().UTC()})
+	assert.Error(t, err)
+}
+
+func TestPowerRepo_Delete(t *testing.T) {
+	_, readings := newPowerTestRepos(t)
+	ctx := context.Background()
+	soc := 50.0
+	created, err := readings.Create(ctx, domain.PowerReading{RecordedAt: time.Now().UTC(), StateOfCharge: &soc})
+	require.NoError(t, err)
+
+	require.NoError(t, readings.Delete(ctx, created.ID))
+	assert.ErrorIs(t, readings.Delete(ctx, created.ID), domain.ErrNotFound)
+}
@@ -0,0 +1,181 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// PropaneRepo defines the persistence operations for propane fills.
+type PropaneRepo interface {
+	// Create inserts a new fill and returns the persisted record.
+	Create(ctx context.Context, fill domain.PropaneFill) (domain.PropaneFill, error)
+
+	// GetByID retrieves a fill by primary key.
+	// Returns domain.ErrNotFound if no fill with that ID exists.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.PropaneFill, error)
+
+	// ListPaged returns one page of fills matching f, newest first, and the
+	// total number of matching fills across all pages.
+	ListPaged(ctx context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error)
+
+	// List returns every fill matching f, oldest first.
+	List(ctx context.Context, f domain.PropaneFilter) ([]domain.PropaneFill, error)
+
+	// Delete removes a fill by ID.
+	// Returns domain.ErrNotFound if no fill with that ID exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgPropaneRepo is the Postgres implementation of PropaneRepo.
+type pgPropaneRepo struct {
+	db db
+}
+
+// NewPropaneRepo constructs a PropaneRepo backed by the provided db connection.
+func NewPropaneRepo(db db) PropaneRepo {
+	return &pgPropaneRepo{db: db}
+}
+
+const (
+	propaneColumns = `id, filled_at, quantity, unit, price, location, trip_id, notes, created_at`
+	propaneWhere   = ` WHERE organization_id = @organization_id AND (@trip_id::uuid IS NULL OR trip_id = @trip_id) AND ` + optionalTripSQL
+)
+
+// Create inserts a fill row and returns the full persisted record.
+func (r *pgPropaneRepo) Create(ctx context.Context, fill domain.PropaneFill) (domain.PropaneFill, error) {
+	const q = `
+		INSERT INTO propane_fills (organization_id, filled_at, quantity, unit, price, location, trip_id, notes)
+		VALUES (@organization_id, @filled_at, @quantity, @unit, @price, @location, @trip_id, @notes)
+		RETURNING ` + propaneColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"filled_at": fill.FilledAt,
+		"quantity":  fill.Quantity,
+		"unit":      string(fill.Unit),
+		"price":     fill.Price, // nil becomes NULL
+		"location":  nullableString(fill.Location),
+		"trip_id":   fill.TripID,
+		"notes":     nullableString(fill.Notes),
+	}))
+	result, err := scanPropaneFill(row)
+	if err != nil {
+		return domain.PropaneFill{}, fmt.Errorf("repo.PropaneRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a fill by primary key.
+func (r *pgPropaneRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.PropaneFill, error) {
+	const q = `SELECT ` + propaneColumns + ` FROM propane_fills WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	result, err := scanPropaneFill(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.PropaneFill{}, fmt.Errorf("repo.PropaneRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListPaged returns one page of matching fills, newest first, with the total count.
+func (r *pgPropaneRepo) ListPaged(ctx context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error) {
+	args := scoped(ctx, pgx.NamedArgs{"trip_id": f.TripID, "limit": p.Limit, "offset": p.Offset()})
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM propane_fills`+propaneWhere, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("repo.PropaneRepo.ListPaged: count: %w", err)
+	}
+
+	q := `SELECT ` + propaneColumns + ` FROM propane_fills` + propaneWhere + `
+		ORDER BY filled_at DESC, id
+		LIMIT @limit OFFSET @offset`
+	fills, err := r.query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("repo.PropaneRepo.ListPaged: %w", err)
+	}
+	return fills, total, nil
+}
+
+// List returns every matching fill, oldest first.
+func (r *pgPropaneRepo) List(ctx context.Context, f domain.PropaneFilter) ([]domain.PropaneFill, error) {
+	q := `SELECT ` + propaneColumns + ` FROM propane_fills` + propaneWhere + `
+		ORDER BY filled_at ASC, id`
+	fills, err := r.query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": f.TripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.PropaneRepo.List: %w", err)
+	}
+	return fills, nil
+}
+
+// Delete removes a fill by ID.
+func (r *pgPropaneRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM propane_fills WHERE id = @id AND organization_id = @organization_id AND ` + optionalTripSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.PropaneRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.PropaneRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// query runs a multi-row fill query and scans every row.
+func (r *pgPropaneRepo) query(ctx context.Context, q string, args pgx.NamedArgs) ([]domain.PropaneFill, error) {
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fills := []domain.PropaneFill{}
+	for rows.Next() {
+		fill, err := scanPropaneFill(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		fills = append(fills, fill)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+	return fills, nil
+}
+
+// scanPropaneFill maps a single propane_fills row into a domain.PropaneFill.
+func scanPropaneFill(s scanner) (domain.PropaneFill, error) {
+	var (
+		f        domain.PropaneFill
+		id       pgtype.UUID
+		unit     string
+		location *string
+		tripID   pgtype.UUID
+		notes    *string
+	)
+	err := s.Scan(&id, &f.FilledAt, &f.Quantity, &unit, &f.Price, &location, &tripID, &notes, &f.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PropaneFill{}, domain.ErrNotFound
+		}
+		return domain.PropaneFill{}, err
+	}
+	f.ID = uuid.UUID(id.Bytes)
+	f.Unit = domain.PropaneUnit(unit)
+	if location != nil {
+		f.Location = *location
+	}
+	if tripID.Valid {
+		t := uuid.UUID(tripID.Bytes)
+		f.TripID = &t
+	}
+	if notes != nil {
+		f.Notes = *notes
+	}
+	return f, nil
+}
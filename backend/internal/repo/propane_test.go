@@ -0,0 +1,158 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newPropaneTestRepos returns a TripRepo and PropaneRepo sharing one
+// rolled-back transaction.
+func newPropaneTestRepos(t *testing.T) (repo.TripRepo, repo.PropaneRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewTripRepo(tx), repo.NewPropaneRepo(tx)
+}
+
+func TestPropaneRepo_CreateAndGet(t *testing.T) {
+	trips, fills := newPropaneTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+
+	at := time.Date(2025, 6, 3, 16, 0, 0, 0, time.UTC)
+	price := 18.5
+	created, err := fills.Create(ctx, domain.PropaneFill{
+		FilledAt: at, Quantity: 21.2, Unit: domain.PropanePounds, Price: &price,
+		Location: "Blue Ox Propane", TripID: &trip.ID, Notes: "Both tanks",
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+
+	got, err := fills.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.FilledAt.Equal(at))
+	assert.InDelta(t, 21.2, got.Quantity, 0.001)
+	assert.Equal(t, domain.PropanePounds, got.Unit)
+	require.NotNil(t, got.Price)
+	assert.InDelta(t, 18.5, *got.Price, 0.001)
+	assert.Equal(t, "Blue Ox Propane", got.Location)
+	require.NotNil(t, got.TripID)
+	assert.Equal(t, trip.ID, *got.TripID)
+	assert.Equal(t, "Both tanks", got.Notes)
+}
+
+func TestPropaneRepo_Create_Minimal(t *testing.T) {
+	_, fills := newPropaneTestRepos(t)
+
+	created, err := fills.Create(context.Background(), domain.PropaneFill{
+		FilledAt: time.Now().UTC(), Quantity: 5, Unit: domain.PropaneGallons,
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, created.Price)
+	assert.Nil(t, created.TripID)
+	assert.Empty(t, created.Location)
+}
+
+func TestPropaneRepo_GetByID_NotFound(t *testing.T) {
+	_, fills := newPropaneTestRepos(t)
+
+	_, err := fills.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPropaneRepo_ListPagedAndList(t *testing.T) {
+	trips, fills := newPropaneTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	t0 := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 3 {
+		_, err := fills.Create(ctx, domain.PropaneFill{
+			FilledAt: t0.AddDate(0, 0, i), Quantity: float64(5 + i), Unit: domain.PropaneGallons, TripID: &trip.ID,
+		})
+		require.NoError(t, err)
+	}
+	_, err = fills.Create(ctx, domain.PropaneFill{FilledAt: t0, Quantity: 1, Unit: domain.PropaneGallons})
+	require.NoError(t, err)
+
+	filter := domain.PropaneFilter{TripID: &trip.ID}
+	page, total, err := fills.ListPaged(ctx, filter, domain.PaginationParams{Page: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, page, 2)
+	assert.InDelta(t, 7, page[0].Quantity, 0.001, "newest first")
+
+	all, err := fills.List(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.InDelta(t, 5, all[0].Quantity, 0.001, "oldest first")
+}
+
+func TestPropaneRepo_RejectsBadRows(t *testing.T) {
+	_, fills := newPropaneTestRepos(t)
+
+	// The service validates first; the CHECK constraints are the backstop.
+	_, err := fills.Create(context.Background(), domain.PropaneFill{
+		FilledAt: time.Now().UTC(), Quantity: 5, Unit: "litre",
+	})
+	assert.Error(t, err)
+}
+
+func TestPropaneRepo_Delete(t *testing.T) {
+	_, fills := newPropaneTestRepos(t)
+	ctx := context.Background()
+	created, err := fills.Create(ctx, domain.PropaneFill{FilledAt: time.Now().UTC(), Quantity: 5, Unit: domain.PropaneGallons})
+	require.NoError(t, err)
+
+	require.NoError(t, fills.Delete(ctx, created.ID))
+	assert.ErrorIs(t, fills.Delete(ctx, created.ID), domain.ErrNotFound)
+}
+
+// TestPropaneRepo_PrivateTrip verifies that fills logged on a private trip
+// are hidden from other users, with or without a trip filter.
+func TestPropaneRepo_PrivateTrip(t *testing.T) {
+	tx, trip, asOwner, asOther := newPrivateTrip(t)
+	fills := repo.NewPropaneRepo(tx)
+	onTrip, err := fills.Create(asOwner, domain.PropaneFill{FilledAt: time.Now(), Quantity: 5, Unit: domain.PropaneGallons, TripID: &trip.ID})
+	require.NoError(t, err)
+	loose, err := fills.Create(asOwner, domain.PropaneFill{FilledAt: time.Now(), Quantity: 3, Unit: domain.PropaneGallons})
+	require.NoError(t, err)
+
+	_, err = fills.GetByID(asOther, onTrip.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = fills.GetByID(asOther, loose.ID)
+	assert.NoError(t, err, "a fill on no trip is the organization's")
+
+	listed, _, err := fills.ListPaged(asOther, domain.PropaneFilter{}, domain.PaginationParams{Page: 1, Limit: 100})
+	require.NoError(t, err)
+	for _, f := range listed {
+		assert.NotEqual(t, onTrip.ID, f.ID)
+	}
+	listed, err = fills.List(asOther, domain.PropaneFilter{TripID: &trip.ID})
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+	assert.ErrorIs(t, fills.Delete(asOther, onTrip.ID), domain.ErrNotFound)
+
+	listed, err = fills.List(asOwner, domain.PropaneFilter{TripID: &trip.ID})
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+}
@@ -0,0 +1,242 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ReservationRepo defines the persistence operations for reservations.
+// Reservations belong to a stop; callers check the stop exists and belongs
+// to the trip.
+type ReservationRepo interface {
+	// Create inserts a reservation and returns the persisted record.
+	Create(ctx context.Context, r domain.Reservation) (domain.Reservation, error)
+
+	// GetByID retrieves a stop's reservation.
+	// Returns domain.ErrNotFound if the stop has no reservation with that ID.
+	GetByID(ctx context.Context, stopID, id uuid.UUID) (domain.Reservation, error)
+
+	// ListByStop returns a stop's reservations ordered by check-in date.
+	ListByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Reservation, error)
+
+	// Update overwrites a stop's reservation.
+	// Returns domain.ErrNotFound if the stop has no reservation with that ID.
+	Update(ctx context.Context, r domain.Reservation) (domain.Reservation, error)
+
+	// Delete removes a stop's reservation.
+	// Returns domain.ErrNotFound if the stop has no reservation with that ID.
+	Delete(ctx context.Context, stopID, id uuid.UUID) error
+
+	// ListUpcoming returns every reservation that checks out on or after
+	// date, ordered by check-in, with its stop's trip and name filled in.
+	// The countdown fields are left zero for the caller to compute.
+	ListUpcoming(ctx context.Context, date time.Time) ([]domain.UpcomingReservation, error)
+}
+
+// pgReservationRepo is the Postgres implementation of ReservationRepo.
+type pgReservationRepo struct {
+	db db
+}
+
+// NewReservationRepo constructs a ReservationRepo backed by the provided db connection.
+func NewReservationRepo(db db) ReservationRepo {
+	return &pgReservationRepo{db: db}
+}
+
+const reservationColumns = `id, stop_id, confirmation_number, site_number, check_in, check_out, cost, cancellation_deadline, notes, created_at, updated_at`
+
+// Create inserts a reservations row and returns the full persisted record.
+func (r *pgReservationRepo) Create(ctx context.Context, res domain.Reservation) (domain.Reservation, error) {
+	const q = `
+		INSERT INTO reservations (stop_id, confirmation_number, site_number, check_in, check_out, cost, cancellation_deadline, notes)
+		VALUES (@stop_id, @confirmation_number, @site_number, @check_in, @check_out, @cost, @cancellation_deadline, @notes)
+		RETURNING ` + reservationColumns
+
+	result, err := scanReservation(r.db.QueryRow(ctx, q, reservationArgs(res)))
+	if err != nil {
+		return domain.Reservation{}, fmt.Errorf("repo.ReservationRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a reservation scoped to its stop.
+func (r *pgReservationRepo) GetByID(ctx context.Context, stopID, id uuid.UUID) (domain.Reservation, error) {
+	const q = `
+		SELECT ` + reservationColumns + ` FROM reservations
+		WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	result, err := scanReservation(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID})))
+	if err != nil {
+		return domain.Reservation{}, fmt.Errorf("repo.ReservationRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListByStop returns a stop's reservations in check-in order.
+func (r *pgReservationRepo) ListByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Reservation, error) {
+	const q = `
+		SELECT ` + reservationColumns + `
+		FROM reservations
+		WHERE stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		ORDER BY check_in, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ReservationRepo.ListByStop: %w", err)
+	}
+	defer rows.Close()
+
+	reservations := []domain.Reservation{}
+	for rows.Next() {
+		res, err := scanReservation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ReservationRepo.ListByStop: scan: %w", err)
+		}
+		reservations = append(reservations, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ReservationRepo.ListByStop: rows: %w", err)
+	}
+	return reservations, nil
+}
+
+// Update overwrites a reservation scoped to its stop.
+func (r *pgReservationRepo) Update(ctx context.Context, res domain.Reservation) (domain.Reservation, error) {
+	const q = `
+		UPDATE reservations
+		SET confirmation_number = @confirmation_number,
+		    site_number = @site_number,
+		    check_in = @check_in,
+		    check_out = @check_out,
+		    cost = @cost,
+		    cancellation_deadline = @cancellation_deadline,
+		    notes = @notes,
+		    updated_at = now()
+		WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		RETURNING ` + reservationColumns
+
+	args := scoped(ctx, reservationArgs(res))
+	args["id"] = res.ID
+	result, err := scanReservation(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.Reservation{}, fmt.Errorf("repo.ReservationRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a reservation scoped to its stop.
+func (r *pgReservationRepo) Delete(ctx context.Context, stopID, id uuid.UUID) error {
+	const q = `DELETE FROM reservations WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID}))
+	if err != nil {
+		return fmt.Errorf("repo.ReservationRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.ReservationRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// ListUpcoming returns reservations that have not ended by date, joined to
+// their stops.
+func (r *pgReservationRepo) ListUpcoming(ctx context.Context, date time.Time) ([]domain.UpcomingReservation, error) {
+	const q = `
+		SELECT r.id, r.stop_id, r.confirmation_number, r.site_number, r.check_in, r.check_out,
+		       r.cost, r.cancellation_deadline, r.notes, r.created_at, r.updated_at,
+		       s.trip_id, s.name
+		FROM reservations r
+		JOIN stops s ON s.id = r.stop_id
+		WHERE r.check_out >= @date::date AND ` + liveStopSQL + `
+		ORDER BY r.check_in, r.id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"date": date}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ReservationRepo.ListUpcoming: %w", err)
+	}
+	defer rows.Close()
+
+	upcoming := []domain.UpcomingReservation{}
+	for rows.Next() {
+		var (
+			u      domain.UpcomingReservation
+			tripID pgtype.UUID
+		)
+		res, err := scanReservation(rowWithExtras{rows, []any{&tripID, &u.StopName}})
+		if err != nil {
+			return nil, fmt.Errorf("repo.ReservationRepo.ListUpcoming: scan: %w", err)
+		}
+		u.Reservation = res
+		u.TripID = uuid.UUID(tripID.Bytes)
+		upcoming = append(upcoming, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ReservationRepo.ListUpcoming: rows: %w", err)
+	}
+	return upcoming, nil
+}
+
+// reservationArgs returns the named arguments shared by Create and Update.
+func reservationArgs(res domain.Reservation) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"stop_id":               res.StopID,
+		"confirmation_number":   nullableString(res.ConfirmationNumber),
+		"site_number":           nullableString(res.SiteNumber),
+		"check_in":              res.CheckIn,
+		"check_out":             res.CheckOut,
+		"cost":                  res.Cost, // nil becomes NULL
+		"cancellation_deadline": res.CancellationDeadline,
+		"notes":                 nullableString(res.Notes),
+	}
+}
+
+// rowWithExtras scans a row that has joined columns appended after an
+// entity's own, passing those columns to extra.
+type rowWithExtras struct {
+	scanner
+	extra []any
+}
+
+func (r rowWithExtras) Scan(dest ...any) error {
+	return r.scanner.Scan(append(dest, r.extra...)...)
+}
+
+// scanReservation maps a single reservations row into a domain.Reservation.
+func scanReservation(s scanner) (domain.Reservation, error) {
+	var (
+		res                domain.Reservation
+		id                 pgtype.UUID
+		stopID             pgtype.UUID
+		confirmationNumber *string
+		siteNumber         *string
+		notes              *string
+	)
+	err := s.Scan(&id, &stopID, &confirmationNumber, &siteNumber, &res.CheckIn, &res.CheckOut,
+		&res.Cost, &res.CancellationDeadline, &notes, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Reservation{}, domain.ErrNotFound
+		}
+		return domain.Reservation{}, err
+	}
+	res.ID = uuid.UUID(id.Bytes)
+	res.StopID = uuid.UUID(stopID.Bytes)
+	if confirmationNumber != nil {
+		res.ConfirmationNumber = *confirmationNumber
+	}
+	if siteNumber != nil {
+		res.SiteNumber = *siteNumber
+	}
+	if notes != nil {
+		res.Notes = *notes
+	}
+	return res, nil
+}
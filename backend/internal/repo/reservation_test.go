@@ -0,0 +1,120 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newReservationTestRepo returns a ReservationRepo and its rolled-back
+// transaction, so parent trips and stops can be inserted with testutil/factory.
+func newReservationTestRepo(t *testing.T) (pgx.Tx, repo.ReservationRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewReservationRepo(tx)
+}
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestReservationRepo_CRUD(t *testing.T) {
+	tx, reservations := newReservationTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	cost := 142.5
+	deadline := time.Date(2025, 6, 24, 23, 59, 0, 0, time.UTC)
+
+	created, err := reservations.Create(ctx, domain.Reservation{
+		StopID:               stop.ID,
+		ConfirmationNumber:   "KOA-48213",
+		SiteNumber:           "B12",
+		CheckIn:              day(2025, 7, 1),
+		CheckOut:             day(2025, 7, 4),
+		Cost:                 &cost,
+		CancellationDeadline: &deadline,
+	})
+	require.NoError(t, err)
+	assert.True(t, created.CheckIn.Equal(day(2025, 7, 1)))
+	assert.Equal(t, 3, created.Nights())
+	require.NotNil(t, created.Cost)
+	assert.InDelta(t, 142.5, *created.Cost, 0.001)
+	assert.Empty(t, created.Notes)
+
+	created.SiteNumber, created.Cost = "C4", nil
+	updated, err := reservations.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, "C4", updated.SiteNumber)
+	assert.Nil(t, updated.Cost)
+
+	_, err = reservations.GetByID(ctx, uuid.New(), created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "scoped to the stop")
+
+	list, err := reservations.ListByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	require.NoError(t, reservations.Delete(ctx, stop.ID, created.ID))
+	assert.ErrorIs(t, reservations.Delete(ctx, stop.ID, created.ID), domain.ErrNotFound)
+}
+
+func TestReservationRepo_ListUpcoming(t *testing.T) {
+	tx, reservations := newReservationTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	// Far-future dates keep rows committed by other tests out of the way.
+	for _, r := range []domain.Reservation{
+		{CheckIn: day(2090, 7, 10), CheckOut: day(2090, 7, 12)},
+		{CheckIn: day(2090, 6, 1), CheckOut: day(2090, 6, 3)}, // ended
+		{CheckIn: day(2090, 6, 28), CheckOut: day(2090, 7, 1)},
+	} {
+		r.StopID = stop.ID
+		_, err := reservations.Create(ctx, r)
+		require.NoError(t, err)
+	}
+
+	got, err := reservations.ListUpcoming(ctx, day(2090, 7, 1))
+
+	require.NoError(t, err)
+	var mine []domain.UpcomingReservation
+	for _, u := range got {
+		if u.Reservation.StopID == stop.ID {
+			mine = append(mine, u)
+		}
+	}
+	require.Len(t, mine, 2, "a stay checking out on the date is still upcoming")
+	assert.True(t, mine[0].Reservation.CheckIn.Equal(day(2090, 6, 28)), "check-in order")
+	assert.Equal(t, trip.ID, mine[0].TripID)
+	assert.Equal(t, stop.Name, mine[0].StopName)
+}
+
+func TestReservationRepo_RejectsBackwardsDates(t *testing.T) {
+	tx, reservations := newReservationTestRepo(t)
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+
+	// The service validates first; the CHECK constraint is the backstop.
+	_, err := reservations.Create(context.Background(), domain.Reservation{
+		StopID: stop.ID, CheckIn: day(2025, 7, 4), CheckOut: day(2025, 7, 1),
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,171 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// RigRepo defines the persistence operations for rigs.
+type RigRepo interface {
+	// Create inserts a rig and returns the persisted record.
+	Create(ctx context.Context, rig domain.Rig) (domain.Rig, error)
+
+	// GetByID retrieves a rig by primary key.
+	// Returns domain.ErrNotFound if no rig with that ID exists.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Rig, error)
+
+	// List returns every rig ordered by name.
+	List(ctx context.Context) ([]domain.Rig, error)
+
+	// Update overwrites a rig's fields and returns the updated record.
+	// Returns domain.ErrNotFound if no rig with that ID exists.
+	Update(ctx context.Context, rig domain.Rig) (domain.Rig, error)
+
+	// Delete removes a rig by ID. Trips taken in it are kept, with no rig.
+	// Returns domain.ErrNotFound if no rig with that ID exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// pgRigRepo is the Postgres implementation of RigRepo.
+type pgRigRepo struct {
+	db db
+}
+
+// NewRigRepo constructs a RigRepo backed by the provided db connection.
+func NewRigRepo(db db) RigRepo {
+	return &pgRigRepo{db: db}
+}
+
+const rigColumns = `id, name, make, model, length_feet, weight_lbs, created_at, updated_at`
+
+// Create inserts a rigs row in the request's organization.
+func (r *pgRigRepo) Create(ctx context.Context, rig domain.Rig) (domain.Rig, error) {
+	const q = `
+		INSERT INTO rigs (organization_id, name, make, model, length_feet, weight_lbs)
+		VALUES (@organization_id, @name, @make, @model, @length_feet, @weight_lbs)
+		RETURNING ` + rigColumns
+
+	result, err := scanRig(r.db.QueryRow(ctx, q, scoped(ctx, rigArgs(rig))))
+	if err != nil {
+		return domain.Rig{}, fmt.Errorf("repo.RigRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves one of the organization's rigs.
+func (r *pgRigRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Rig, error) {
+	const q = `SELECT ` + rigColumns + ` FROM rigs WHERE id = @id AND organization_id = @organization_id`
+
+	result, err := scanRig(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.Rig{}, fmt.Errorf("repo.RigRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// List returns the organization's rigs ordered by name.
+func (r *pgRigRepo) List(ctx context.Context) ([]domain.Rig, error) {
+	const q = `
+		SELECT ` + rigColumns + ` FROM rigs
+		WHERE organization_id = @organization_id
+		ORDER BY name, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.RigRepo.List: %w", err)
+	}
+	defer rows.Close()
+
+	rigs := []domain.Rig{}
+	for rows.Next() {
+		rig, err := scanRig(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.RigRepo.List: scan: %w", err)
+		}
+		rigs = append(rigs, rig)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.RigRepo.List: rows: %w", err)
+	}
+	return rigs, nil
+}
+
+// Update overwrites a rig's fields.
+func (r *pgRigRepo) Update(ctx context.Context, rig domain.Rig) (domain.Rig, error) {
+	const q = `
+		UPDATE rigs
+		SET name = @name,
+		    make = @make,
+		    model = @model,
+		    length_feet = @length_feet,
+		    weight_lbs = @weight_lbs,
+		    updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id
+		RETURNING ` + rigColumns
+
+	args := scoped(ctx, rigArgs(rig))
+	args["id"] = rig.ID
+	result, err := scanRig(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.Rig{}, fmt.Errorf("repo.RigRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a rig; trips referencing it have rig_id cleared by the
+// foreign key.
+func (r *pgRigRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM rigs WHERE id = @id AND organization_id = @organization_id`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.RigRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.RigRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// rigArgs returns the named arguments shared by Create and Update.
+func rigArgs(rig domain.Rig) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"name":        rig.Name,
+		"make":        nullableString(rig.Make),
+		"model":       nullableString(rig.Model),
+		"length_feet": rig.LengthFeet, // nil becomes NULL
+		"weight_lbs":  rig.WeightLbs,
+	}
+}
+
+// scanRig maps a rigColumns row into a domain.Rig.
+func scanRig(s scanner) (domain.Rig, error) {
+	var (
+		rig      domain.Rig
+		id       pgtype.UUID
+		rigMake  *string
+		rigModel *string
+	)
+	err := s.Scan(&id, &rig.Name, &rigMake, &rigModel, &rig.LengthFeet, &rig.WeightLbs, &rig.CreatedAt, &rig.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Rig{}, domain.ErrNotFound
+		}
+		return domain.Rig{}, err
+	}
+	rig.ID = uuid.UUID(id.Bytes)
+	if rigMake != nil {
+		rig.Make = *rigMake
+	}
+	if rigModel != nil {
+		rig.Model = *rigModel
+	}
+	return rig, nil
+}
@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// RigMaintenanceRepo defines the persistence operations for the work logged
+// against rigs. Every method sees only the organization's rigs.
+type RigMaintenanceRepo interface {
+	// Create inserts an entry and returns the persisted record.
+	// Returns domain.ErrNotFound if its rig does not exist.
+	Create(ctx context.Context, m domain.RigMaintenance) (domain.RigMaintenance, error)
+
+	// ListByRig returns a rig's entries, most recently serviced first.
+	// Returns an empty slice for a rig with none, including one that does
+	// not exist.
+	ListByRig(ctx context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error)
+
+	// Delete removes one of a rig's entries.
+	// Returns domain.ErrNotFound if the rig has no entry with that ID.
+	Delete(ctx context.Context, rigID, id uuid.UUID) error
+}
+
+// pgRigMaintenanceRepo is the Postgres implementation of RigMaintenanceRepo.
+type pgRigMaintenanceRepo struct {
+	db db
+}
+
+// NewRigMaintenanceRepo constructs a RigMaintenanceRepo backed by the provided db connection.
+func NewRigMaintenanceRepo(db db) RigMaintenanceRepo {
+	return &pgRigMaintenanceRepo{db: db}
+}
+
+const rigMaintenanceColumns = `id, rig_id, name, serviced_on, odometer_miles, engine_hours, notes, created_at`
+
+// Create inserts an entry for a rig in the request's organization; no row is
+// inserted, and ErrNotFound returned, for any other rig.
+func (r *pgRigMaintenanceRepo) Create(ctx context.Context, m domain.RigMaintenance) (domain.RigMaintenance, error) {
+	const q = `
+		INSERT INTO rig_maintenance (rig_id, name, serviced_on, odometer_miles, engine_hours, notes)
+		SELECT id, @name, @serviced_on, @odometer_miles, @engine_hours, @notes
+		FROM rigs
+		WHERE id = @rig_id AND organization_id = @organization_id
+		RETURNING ` + rigMaintenanceColumns
+
+	result, err := scanRigMaintenance(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"rig_id":         m.RigID,
+		"name":           m.Name,
+		"serviced_on":    pgDate(m.ServicedOn),
+		"odometer_miles": m.OdometerMiles, // nil becomes NULL
+		"engine_hours":   m.EngineHours,
+		"notes":          nullableString(m.Notes),
+	})))
+	if err != nil {
+		return domain.RigMaintenance{}, fmt.Errorf("repo.RigMaintenanceRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// ListByRig returns a rig's entries, newest first.
+func (r *pgRigMaintenanceRepo) ListByRig(ctx context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error) {
+	const q = `
+		SELECT ` + rigMaintenanceColumns + ` FROM rig_maintenance
+		WHERE rig_id = @rig_id AND rig_id IN ` + orgRigsSQL + `
+		ORDER BY serviced_on DESC, created_at DESC`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"rig_id": rigID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.RigMaintenanceRepo.ListByRig: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []domain.RigMaintenance{}
+	for rows.Next() {
+		m, err := scanRigMaintenance(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.RigMaintenanceRepo.ListByRig: scan: %w", err)
+		}
+		entries = append(entries, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.RigMaintenanceRepo.ListByRig: rows: %w", err)
+	}
+	return entries, nil
+}
+
+// Delete removes an entry of one of the organization's rigs.
+func (r *pgRigMaintenanceRepo) Delete(ctx context.Context, rigID, id uuid.UUID) error {
+	const q = `DELETE FROM rig_maintenance WHERE id = @id AND rig_id = @rig_id AND rig_id IN ` + orgRigsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "rig_id": rigID}))
+	if err != nil {
+		return fmt.Errorf("repo.RigMaintenanceRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.RigMaintenanceRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// scanRigMaintenance maps a rigMaintenanceColumns row into a domain.RigMaintenance.
+func scanRigMaintenance(s scanner) (domain.RigMaintenance, error) {
+	var (
+		m        domain.RigMaintenance
+		id       pgtype.UUID
+		rigID    pgtype.UUID
+		serviced pgtype.Date
+		notes    *string
+	)
+	err := s.Scan(&id, &rigID, &m.Name, &serviced, &m.OdometerMiles, &m.EngineHours, &notes, &m.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RigMaintenance{}, domain.ErrNotFound
+		}
+		return domain.RigMaintenance{}, err
+	}
+	m.ID = uuid.UUID(id.Bytes)
+	m.RigID = uuid.UUID(rigID.Bytes)
+	m.ServicedOn = domain.DateOf(serviced.Time)
+	if notes != nil {
+		m.Notes = *notes
+	}
+	return m, nil
+}
@@ -0,0 +1,64 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// newRigMaintenanceTestRepos returns a RigRepo and a RigMaintenanceRepo
+// sharing one rolled-back transaction.
+func newRigMaintenanceTestRepos(t *testing.T) (repo.RigRepo, repo.RigMaintenanceRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewRigRepo(tx), repo.NewRigMaintenanceRepo(tx)
+}
+
+func TestRigMaintenanceRepo_ListNewestFirst(t *testing.T) {
+	rigs, entries := newRigMaintenanceTestRepos(t)
+	ctx := context.Background()
+
+	rig, err := rigs.Create(ctx, domain.Rig{Name: "Motorhome"})
+	require.NoError(t, err)
+	miles := 42180.0
+	_, err = entries.Create(ctx, domain.RigMaintenance{RigID: rig.ID, Name: "Oil change", ServicedOn: domain.NewDate(2025, 3, 1), OdometerMiles: &miles, Notes: "Synthetic"})
+	require.NoError(t, err)
+	_, err = entries.Create(ctx, domain.RigMaintenance{RigID: rig.ID, Name: "Generator service", ServicedOn: domain.NewDate(2025, 5, 12)})
+	require.NoError(t, err)
+
+	got, err := entries.ListByRig(ctx, rig.ID)
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "Generator service", got[0].Name)
+	assert.Nil(t, got[0].OdometerMiles)
+	assert.Equal(t, "Synthetic", got[1].Notes)
+	require.NotNil(t, got[1].OdometerMiles)
+	assert.InDelta(t, 42180, *got[1].OdometerMiles, 0.001)
+}
+
+func TestRigMaintenanceRepo_NotFound(t *testing.T) {
+	rigs, entries := newRigMaintenanceTestRepos(t)
+	ctx := context.Background()
+
+	_, err := entries.Create(ctx, domain.RigMaintenance{RigID: uuid.New(), Name: "Oil change", ServicedOn: domain.NewDate(2025, 3, 1)})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	rig, err := rigs.Create(ctx, domain.Rig{Name: "Trailer"})
+	require.NoError(t, err)
+	assert.ErrorIs(t, entries.Delete(ctx, rig.ID, uuid.New()), domain.ErrNotFound)
+}
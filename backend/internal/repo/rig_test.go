@@ -0,0 +1,91 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newRigTestRepos returns a RigRepo and a TripRepo sharing one rolled-back
+// transaction.
+func newRigTestRepos(t *testing.T) (repo.RigRepo, repo.TripRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewRigRepo(tx), repo.NewTripRepo(tx)
+}
+
+func TestRigRepo_CRUD(t *testing.T) {
+	rigs, _ := newRigTestRepos(t)
+	ctx := context.Background()
+	length := 34.5
+
+	created, err := rigs.Create(ctx, domain.Rig{Name: "Motorhome", Make: "Winnebago", Model: "Vista 32YE", LengthFeet: &length})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, "Winnebago", created.Make)
+	require.NotNil(t, created.LengthFeet)
+	assert.InDelta(t, 34.5, *created.LengthFeet, 0.001)
+	assert.Nil(t, created.WeightLbs)
+
+	weight := 18000.0
+	created.Model = ""
+	created.WeightLbs = &weight
+	updated, err := rigs.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Model)
+	require.NotNil(t, updated.WeightLbs)
+	assert.InDelta(t, 18000, *updated.WeightLbs, 0.001)
+
+	got, err := rigs.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, updated, got)
+
+	require.NoError(t, rigs.Delete(ctx, created.ID))
+	_, err = rigs.GetByID(ctx, created.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestRigRepo_TripsReferenceRig(t *testing.T) {
+	rigs, trips := newRigTestRepos(t)
+	ctx := context.Background()
+
+	rig, err := rigs.Create(ctx, domain.Rig{Name: "Trailer"})
+	require.NoError(t, err)
+
+	trip := factory.Trip().Build()
+	trip.RigID = &rig.ID
+	created, err := trips.Create(ctx, trip)
+	require.NoError(t, err)
+	require.NotNil(t, created.RigID)
+	assert.Equal(t, rig.ID, *created.RigID)
+
+	// Deleting the rig keeps the trip, with no rig.
+	require.NoError(t, rigs.Delete(ctx, rig.ID))
+	got, err := trips.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.RigID)
+}
+
+func TestRigRepo_NotFound(t *testing.T) {
+	rigs, _ := newRigTestRepos(t)
+	ctx := context.Background()
+
+	_, err := rigs.Update(ctx, domain.Rig{ID: uuid.New(), Name: "Ghost"})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, rigs.Delete(ctx, uuid.New()), domain.ErrNotFound)
+}
@@ -0,0 +1,295 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// RouteLegRepo defines the persistence operations for route legs.
+// Callers check the trip exists.
+type RouteLegRepo interface {
+	// Sync makes a trip's legs match pairs: a leg is inserted for each pair
+	// that lacks one and every other leg of the trip is deleted. Existing legs
+	// for pairs in the list are left untouched, so their edits survive, and so
+	// are legs touching a trashed stop, which stay hidden until it is restored
+	// or purged.
+	// Returns the track keys of the deleted legs that had one, so the caller
+	// can remove the files.
+	Sync(ctx context.Context, tripID uuid.UUID, pairs []domain.StopPair) (removedTrackKeys []string, err error)
+
+	// GetByID returns one of a trip's legs.
+	// Returns domain.ErrNotFound if the trip has no leg with that ID.
+	GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.RouteLeg, error)
+
+	// ListByTrip returns a trip's legs in route order, by the arrival time
+	// of each leg's starting stop.
+	ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error)
+
+	// Update overwrites a leg's distance, duration, and polyline.
+	// Returns domain.ErrNotFound if the trip has no leg with that ID.
+	Update(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error)
+
+	// AttachTrack records an uploaded track: its key and point count, and the
+	// polyline, distance, and duration derived from it. Stamps
+	// track_uploaded_at. Returns domain.ErrNotFound if the trip has no leg
+	// with that ID.
+	AttachTrack(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error)
+
+	// SetElevation stores leg.Elevation, stamping its fetched time, provided
+	// the leg's polyline still matches leg.Polyline. Returns
+	// domain.ErrNotFound if the trip has no leg with that ID and polyline.
+	SetElevation(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error)
+}
+
+// Update and AttachTrack clear a leg's elevation profile whenever they change
+// its polyline, since the profile was sampled along the old one.
+
+// pgRouteLegRepo is the Postgres implementation of RouteLegRepo.
+type pgRouteLegRepo struct {
+	db db
+}
+
+// NewRouteLegRepo constructs a RouteLegRepo backed by the provided db connection.
+func NewRouteLegRepo(db db) RouteLegRepo {
+	return &pgRouteLegRepo{db: db}
+}
+
+const (
+	routeLegColumns = `id, trip_id, from_stop_id, to_stop_id, distance_miles, duration_minutes, polyline,
+	track_key, track_points, track_uploaded_at, elevation_meters, elevation_spacing_meters, elevation_fetched_at,
+	created_at, updated_at`
+
+	keepElevationIfSamePolyline = `
+		    elevation_meters = CASE WHEN polyline IS NOT DISTINCT FROM @polyline THEN elevation_meters END,
+		    elevation_spacing_meters = CASE WHEN polyline IS NOT DISTINCT FROM @polyline THEN elevation_spacing_meters END,
+		    elevation_fetched_at = CASE WHEN polyline IS NOT DISTINCT FROM @polyline THEN elevation_fetched_at END,`
+
+	// liveLegSQL holds for a leg neither of whose stops is in the trash.
+	// Legs touching a trashed stop are hidden, not deleted, so restoring the
+	// stop brings its legs and their tracks back.
+	liveLegSQL = `NOT EXISTS (
+		SELECT 1 FROM stops ts WHERE ts.id IN (from_stop_id, to_stop_id) AND ts.deleted_at IS NOT NULL)`
+)
+
+// Sync deletes the trip's legs that are not in pairs and inserts the missing
+// ones in a single statement. The two never touch the same row: deleted legs
+// are exactly those not wanted, inserted ones are only wanted pairs.
+func (r *pgRouteLegRepo) Sync(ctx context.Context, tripID uuid.UUID, pairs []domain.StopPair) ([]string, error) {
+	const q = `
+		WITH wanted AS (
+			SELECT * FROM unnest(@from_ids::uuid[], @to_ids::uuid[]) AS w(from_stop_id, to_stop_id)
+		), removed AS (
+			DELETE FROM route_legs l
+			WHERE l.trip_id = @trip_id AND l.trip_id IN ` + orgTripsSQL + `
+			  AND NOT EXISTS (
+			      SELECT 1 FROM wanted w
+			      WHERE w.from_stop_id = l.from_stop_id AND w.to_stop_id = l.to_stop_id)
+			  AND ` + liveLegSQL + `
+			RETURNING l.track_key
+		), inserted AS (
+			INSERT INTO route_legs (trip_id, from_stop_id, to_stop_id)
+			SELECT @trip_id, from_stop_id, to_stop_id FROM wanted
+			ON CONFLICT (from_stop_id, to_stop_id) DO NOTHING
+		)
+		SELECT track_key FROM removed WHERE track_key IS NOT NULL`
+
+	fromIDs := make([]uuid.UUID, len(pairs))
+	toIDs := make([]uuid.UUID, len(pairs))
+	for i, p := range pairs {
+		fromIDs[i], toIDs[i] = p.From, p.To
+	}
+	args := scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "from_ids": fromIDs, "to_ids": toIDs})
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("repo.RouteLegRepo.Sync: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("repo.RouteLegRepo.Sync: scan: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.RouteLegRepo.Sync: rows: %w", err)
+	}
+	return keys, nil
+}
+
+// GetByID retrieves a leg by primary key, scoped to its trip.
+func (r *pgRouteLegRepo) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.RouteLeg, error) {
+	const q = `
+		SELECT ` + routeLegColumns + ` FROM route_legs
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + ` AND ` + liveLegSQL
+
+	result, err := scanRouteLeg(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "trip_id": tripID})))
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("repo.RouteLegRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListByTrip returns a trip's legs ordered by when the leg's first stop was reached.
+func (r *pgRouteLegRepo) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error) {
+	const q = `
+		SELECT ` + routeLegColumns + `
+		FROM route_legs
+		WHERE trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + ` AND ` + liveLegSQL + `
+		ORDER BY (SELECT s.arrived_at FROM stops s WHERE s.id = from_stop_id), id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.RouteLegRepo.ListByTrip: %w", err)
+	}
+	defer rows.Close()
+
+	legs := []domain.RouteLeg{}
+	for rows.Next() {
+		leg, err := scanRouteLeg(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.RouteLegRepo.ListByTrip: scan: %w", err)
+		}
+		legs = append(legs, leg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.RouteLegRepo.ListByTrip: rows: %w", err)
+	}
+	return legs, nil
+}
+
+// Update overwrites the editable columns of a trip's leg.
+func (r *pgRouteLegRepo) Update(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	const q = `
+		UPDATE route_legs
+		SET distance_miles = @distance_miles,
+		    duration_minutes = @duration_minutes,` + keepElevationIfSamePolyline + `
+		    polyline = @polyline,
+		    updated_at = now()
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		RETURNING ` + routeLegColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":               leg.ID,
+		"trip_id":          leg.TripID,
+		"distance_miles":   leg.DistanceMiles,   // nil becomes NULL
+		"duration_minutes": leg.DurationMinutes, // nil becomes NULL
+		"polyline":         nullableString(leg.Polyline),
+	}))
+	result, err := scanRouteLeg(row)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("repo.RouteLegRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// AttachTrack stores an uploaded track's key and the values derived from it.
+func (r *pgRouteLegRepo) AttachTrack(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	const q = `
+		UPDATE route_legs
+		SET distance_miles = @distance_miles,
+		    duration_minutes = @duration_minutes,` + keepElevationIfSamePolyline + `
+		    polyline = @polyline,
+		    track_key = @track_key,
+		    track_points = @track_points,
+		    track_uploaded_at = now(),
+		    updated_at = now()
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		RETURNING ` + routeLegColumns
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":               leg.ID,
+		"trip_id":          leg.TripID,
+		"distance_miles":   leg.DistanceMiles,
+		"duration_minutes": leg.DurationMinutes,
+		"polyline":         nullableString(leg.Polyline),
+		"track_key":        leg.TrackKey,
+		"track_points":     leg.TrackPoints,
+	}))
+	result, err := scanRouteLeg(row)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("repo.RouteLegRepo.AttachTrack: %w", err)
+	}
+	return result, nil
+}
+
+// SetElevation stores a leg's elevation profile unless its polyline has
+// changed since the profile was sampled.
+func (r *pgRouteLegRepo) SetElevation(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	const q = `
+		UPDATE route_legs
+		SET elevation_meters = @elevation_meters,
+		    elevation_spacing_meters = @elevation_spacing_meters,
+		    elevation_fetched_at = now()
+		WHERE id = @id AND trip_id = @trip_id AND trip_id IN ` + orgTripsSQL + `
+		  AND polyline IS NOT DISTINCT FROM @polyline
+		RETURNING ` + routeLegColumns
+
+	var (
+		meters  []float64
+		spacing *float64
+	)
+	if leg.Elevation != nil {
+		meters, spacing = leg.Elevation.Meters, &leg.Elevation.SpacingMeters
+	}
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":                       leg.ID,
+		"trip_id":                  leg.TripID,
+		"polyline":                 nullableString(leg.Polyline),
+		"elevation_meters":         meters,
+		"elevation_spacing_meters": spacing,
+	}))
+	result, err := scanRouteLeg(row)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("repo.RouteLegRepo.SetElevation: %w", err)
+	}
+	return result, nil
+}
+
+// scanRouteLeg maps a single route_legs row into a domain.RouteLeg.
+func scanRouteLeg(s scanner) (domain.RouteLeg, error) {
+	var (
+		l                    domain.RouteLeg
+		id, tripID, from, to pgtype.UUID
+		polyline, trackKey   *string
+		trackPoints          *int
+		elevation            []float64
+		spacing              *float64
+		fetchedAt            *time.Time
+	)
+	err := s.Scan(&id, &tripID, &from, &to, &l.DistanceMiles, &l.DurationMinutes, &polyline,
+		&trackKey, &trackPoints, &l.TrackUploadedAt, &elevation, &spacing, &fetchedAt, &l.CreatedAt, &l.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RouteLeg{}, domain.ErrNotFound
+		}
+		return domain.RouteLeg{}, err
+	}
+	l.ID = uuid.UUID(id.Bytes)
+	l.TripID = uuid.UUID(tripID.Bytes)
+	l.FromStopID = uuid.UUID(from.Bytes)
+	l.ToStopID = uuid.UUID(to.Bytes)
+	if polyline != nil {
+		l.Polyline = *polyline
+	}
+	if trackKey != nil {
+		l.TrackKey = *trackKey
+	}
+	if trackPoints != nil {
+		l.TrackPoints = *trackPoints
+	}
+	if elevation != nil && spacing != nil && fetchedAt != nil {
+		l.Elevation = &domain.ElevationProfile{SpacingMeters: *spacing, Meters: elevation, FetchedAt: *fetchedAt}
+	}
+	return l, nil
+}
@@ -0,0 +1,250 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newRouteLegTestRepo returns a RouteLegRepo and its rolled-back transaction,
+// so parent trips and stops can be inserted with testutil/factory.
+func newRouteLegTestRepo(t *testing.T) (pgx.Tx, repo.RouteLegRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewRouteLegRepo(tx)
+}
+
+// syncLegs runs Sync and fails the test on error.
+func syncLegs(t *testing.T, legs repo.RouteLegRepo, tripID uuid.UUID, pairs []domain.StopPair) []string {
+	t.Helper()
+	removed, err := legs.Sync(context.Background(), tripID, pairs)
+	require.NoError(t, err)
+	return removed
+}
+
+func TestRouteLegRepo_SyncListUpdate(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	// Inserted out of order: legs follow arrived_at, not insertion.
+	c := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 5)).Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}, {From: b.ID, To: c.ID}})
+
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, a.ID, list[0].FromStopID)
+	assert.Equal(t, c.ID, list[1].ToStopID)
+	assert.Nil(t, list[0].DistanceMiles)
+
+	miles, minutes := 212.5, 245
+	edited := list[0]
+	edited.DistanceMiles, edited.DurationMinutes, edited.Polyline = &miles, &minutes, "_p~iF~ps|U"
+	updated, err := legs.Update(ctx, edited)
+	require.NoError(t, err)
+	require.NotNil(t, updated.DistanceMiles)
+	assert.InDelta(t, 212.5, *updated.DistanceMiles, 0.001)
+	assert.Equal(t, "_p~iF~ps|U", updated.Polyline)
+
+	// b is removed from the route: a→b and b→c go, a→c is new.
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: c.ID}})
+	list, err = legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, a.ID, list[0].FromStopID)
+	assert.Equal(t, c.ID, list[0].ToStopID)
+}
+
+// TestRouteLegRepo_Sync_TrashedStop verifies legs touching a trashed stop are
+// hidden rather than deleted, so restoring the stop brings them back.
+func TestRouteLegRepo_Sync_TrashedStop(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	c := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 5)).Insert(t, tx)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}, {From: b.ID, To: c.ID}})
+
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, trip.ID, b.ID))
+	assert.Empty(t, syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: c.ID}}))
+
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, c.ID, list[0].ToStopID)
+
+	_, err = repo.NewTrashRepo(tx).Restore(ctx, b.ID, day(2000, 1, 1))
+	require.NoError(t, err)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}, {From: b.ID, To: c.ID}})
+	list, err = legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
+func TestRouteLegRepo_Sync_KeepsEditsForUnchangedPairs(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	pairs := []domain.StopPair{{From: a.ID, To: b.ID}}
+
+	syncLegs(t, legs, trip.ID, pairs)
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	miles := 80.0
+	list[0].DistanceMiles = &miles
+	_, err = legs.Update(ctx, list[0])
+	require.NoError(t, err)
+
+	syncLegs(t, legs, trip.ID, pairs)
+
+	again, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, again, 1)
+	assert.Equal(t, list[0].ID, again[0].ID)
+	require.NotNil(t, again[0].DistanceMiles)
+	assert.InDelta(t, 80, *again[0].DistanceMiles, 0.001)
+}
+
+func TestRouteLegRepo_Sync_EmptyRemovesAll(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}})
+
+	syncLegs(t, legs, trip.ID, nil)
+
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestRouteLegRepo_Update_WrongTrip(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	other := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}})
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+
+	leg := list[0]
+	leg.TripID = other.ID
+	_, err = legs.Update(ctx, leg)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestRouteLegRepo_AttachTrack(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	c := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 5)).Insert(t, tx)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}, {From: b.ID, To: c.ID}})
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.False(t, list[0].HasTrack())
+
+	leg := list[0]
+	miles, minutes := 4.9, 10
+	leg.DistanceMiles, leg.DurationMinutes, leg.Polyline = &miles, &minutes, "_c`|G~t`cT?_pR"
+	leg.TrackKey, leg.TrackPoints = "tracks/t/l.gpx", 11
+
+	got, err := legs.AttachTrack(ctx, leg)
+	require.NoError(t, err)
+	assert.True(t, got.HasTrack())
+	assert.Equal(t, 11, got.TrackPoints)
+	require.NotNil(t, got.TrackUploadedAt)
+
+	fetched, err := legs.GetByID(ctx, trip.ID, leg.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "tracks/t/l.gpx", fetched.TrackKey)
+
+	// Dropping b removes both legs; only the one with a track reports a key.
+	removed := syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: c.ID}})
+	assert.Equal(t, []string{"tracks/t/l.gpx"}, removed)
+}
+
+func TestRouteLegRepo_SetElevation(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}})
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+	leg := list[0]
+	leg.Polyline = "_c`|G~t`cT?_pR"
+	leg, err = legs.Update(ctx, leg)
+	require.NoError(t, err)
+	assert.Nil(t, leg.Elevation)
+
+	leg.Elevation = &domain.ElevationProfile{SpacingMeters: 250, Meters: []float64{3000, 3655.5, 3300}}
+	got, err := legs.SetElevation(ctx, leg)
+	require.NoError(t, err)
+	require.NotNil(t, got.Elevation)
+	assert.Equal(t, []float64{3000, 3655.5, 3300}, got.Elevation.Meters)
+	assert.InDelta(t, 250, got.Elevation.SpacingMeters, 1e-9)
+	assert.False(t, got.Elevation.FetchedAt.IsZero())
+
+	// Editing the distance keeps the profile; changing the route drops it.
+	miles := 12.0
+	leg.DistanceMiles = &miles
+	kept, err := legs.Update(ctx, leg)
+	require.NoError(t, err)
+	assert.NotNil(t, kept.Elevation)
+
+	stale := leg
+	leg.Polyline = "_p~iF~ps|U_ulLnnqC"
+	cleared, err := legs.Update(ctx, leg)
+	require.NoError(t, err)
+	assert.Nil(t, cleared.Elevation)
+
+	// A profile sampled along the old route is not stored.
+	_, err = legs.SetElevation(ctx, stale)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestRouteLegRepo_GetByID_WrongTrip(t *testing.T) {
+	tx, legs := newRouteLegTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	a := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	b := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	syncLegs(t, legs, trip.ID, []domain.StopPair{{From: a.ID, To: b.ID}})
+	list, err := legs.ListByTrip(ctx, trip.ID)
+	require.NoError(t, err)
+
+	_, err = legs.GetByID(ctx, uuid.New(), list[0].ID)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pressly/goose/v3"
+
+	"github.com/pkordes/rv-logbook/backend/migrations"
+)
+
+// SchemaMonitor reports whether the database has every migration this
+// build embeds. The binary is deployed before `goose up` runs, so for a
+// while a new instance can be talking to an old schema.
+type SchemaMonitor struct {
+	pool     *pgxpool.Pool
+	versions []int64 // embedded migration versions, ascending
+}
+
+// NewSchemaMonitor constructs a SchemaMonitor for pool.
+func NewSchemaMonitor(pool *pgxpool.Pool) (*SchemaMonitor, error) {
+	names, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("repo.NewSchemaMonitor: %w", err)
+	}
+	versions := make([]int64, len(names))
+	for i, name := range names {
+		if versions[i], err = goose.NumericComponent(name); err != nil {
+			return nil, fmt.Errorf("repo.NewSchemaMonitor: %w", err)
+		}
+	}
+	slices.Sort(versions)
+	return &SchemaMonitor{pool: pool, versions: versions}, nil
+}
+
+// Pending returns the embedded migration versions the database has not
+// applied, oldest first. goose deletes a version's row when it is rolled
+// back, so a row means the migration is in place.
+func (m *SchemaMonitor) Pending(ctx context.Context) ([]int64, error) {
+	rows, err := m.pool.Query(ctx, `SELECT version_id FROM goose_db_version`)
+	if err != nil {
+		return nil, fmt.Errorf("repo.SchemaMonitor.Pending: %w", err)
+	}
+	defer rows.Close()
+	applied := map[int64]bool{}
+	for rows.Next() {
+		var v int64
+		if err := rows.Scan(&v); err != nil {
+			return nil, fmt.Errorf("repo.SchemaMonitor.Pending: %w", err)
+		}
+		applied[v] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.SchemaMonitor.Pending: %w", err)
+	}
+
+	var pending []int64
+	for _, v := range m.versions {
+		if !applied[v] {
+			pending = append(pending, v)
+		}
+	}
+	return pending, nil
+}
+
+// Current returns an error naming the pending migrations, if there are any.
+func (m *SchemaMonitor) Current(ctx context.Context) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	names := make([]string, len(pending))
+	for i, v := range pending {
+		names[i] = fmt.Sprintf("%03d", v)
+	}
+	return fmt.Errorf("repo.SchemaMonitor.Current: %d migrations not applied: %s", len(pending), strings.Join(names, ", "))
+}
@@ -0,0 +1,46 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// TestSchemaMonitor_Current verifies that a database TestMain has migrated
+// has nothing pending.
+func TestSchemaMonitor_Current(t *testing.T) {
+	pool := testutil.NewPool(t)
+	m, err := repo.NewSchemaMonitor(pool)
+	require.NoError(t, err)
+
+	pending, err := m.Pending(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	assert.NoError(t, m.Current(context.Background()))
+}
+
+// TestSchemaMonitor_Pending verifies that a rolled-back migration is
+// reported. goose deletes the version row on rollback, so deleting it does
+// the same to the query; the private schema keeps that from other tests.
+func TestSchemaMonitor_Pending(t *testing.T) {
+	pool := testutil.NewSchemaPool(t)
+	m, err := repo.NewSchemaMonitor(pool)
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = pool.Exec(ctx, `DELETE FROM goose_db_version WHERE version_id = 33`)
+	require.NoError(t, err)
+
+	pending, err := m.Pending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{33}, pending)
+	assert.ErrorContains(t, m.Current(ctx), "1 migrations not applied: 033")
+}
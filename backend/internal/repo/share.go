@@ -0,0 +1,162 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ShareRepo defines the persistence operations for trip share links.
+// The table doubles as the revocation list: a token is honoured only while its
+// row exists, is unrevoked, and has not expired.
+type ShareRepo interface {
+	// Create inserts a new share for share.TripID expiring at share.ExpiresAt
+	// and returns the persisted record with its generated id.
+	Create(ctx context.Context, share domain.Share) (domain.Share, error)
+
+	// GetByID retrieves a share by primary key, including revoked and expired
+	// shares. Returns domain.ErrNotFound if no share with that ID exists.
+	// Unlike the other methods it looks across organizations: it is how a
+	// share token, which names none, is resolved.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Share, error)
+
+	// ListActiveByTripID returns the trip's shares that are unrevoked and
+	// unexpired at now, newest first.
+	ListActiveByTripID(ctx context.Context, tripID uuid.UUID, now time.Time) ([]domain.Share, error)
+
+	// Revoke marks a share as revoked at now. Returns domain.ErrNotFound if the
+	// share does not exist, belongs to a different trip, or is already revoked.
+	Revoke(ctx context.Context, tripID, shareID uuid.UUID, now time.Time) error
+}
+
+// pgShareRepo is the Postgres implementation of ShareRepo.
+type pgShareRepo struct {
+	db db
+}
+
+// NewShareRepo constructs a ShareRepo backed by the provided db connection.
+func NewShareRepo(db db) ShareRepo {
+	return &pgShareRepo{db: db}
+}
+
+// Create inserts a share row and returns the full persisted record.
+func (r *pgShareRepo) Create(ctx context.Context, share domain.Share) (domain.Share, error) {
+	const q = `
+		WITH created AS (
+			INSERT INTO trip_shares (trip_id, expires_at)
+			VALUES (@trip_id, @expires_at)
+			RETURNING id, trip_id, expires_at, revoked_at, created_at
+		)
+		SELECT c.id, c.trip_id, c.expires_at, c.revoked_at, c.created_at, t.organization_id, t.user_id
+		FROM created c
+		JOIN trips t ON t.id = c.trip_id`
+
+	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"trip_id":    share.TripID,
+		"expires_at": share.ExpiresAt,
+	})
+	result, err := scanShare(row)
+	if err != nil {
+		return domain.Share{}, fmt.Errorf("repo.ShareRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a share by primary key.
+func (r *pgShareRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Share, error) {
+	const q = `
+		SELECT sh.id, sh.trip_id, sh.expires_at, sh.revoked_at, sh.created_at, t.organization_id, t.user_id
+		FROM trip_shares sh
+		JOIN trips t ON t.id = sh.trip_id
+		WHERE sh.id = @id`
+
+	result, err := scanShare(r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return domain.Share{}, fmt.Errorf("repo.ShareRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// ListActiveByTripID returns the live shares for a trip, newest first.
+func (r *pgShareRepo) ListActiveByTripID(ctx context.Context, tripID uuid.UUID, now time.Time) ([]domain.Share, error) {
+	const q = `
+		SELECT sh.id, sh.trip_id, sh.expires_at, sh.revoked_at, sh.created_at, t.organization_id, t.user_id
+		FROM trip_shares sh
+		JOIN trips t ON t.id = sh.trip_id
+		WHERE sh.trip_id = @trip_id
+		  AND t.organization_id = @organization_id
+		  AND (t.user_id IS NULL OR t.user_id = @user_id)
+		  AND sh.revoked_at IS NULL
+		  AND sh.expires_at > @now
+		ORDER BY sh.created_at DESC`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "now": now}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.ShareRepo.ListActiveByTripID: %w", err)
+	}
+	defer rows.Close()
+
+	shares := []domain.Share{}
+	for rows.Next() {
+		s, err := scanShare(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ShareRepo.ListActiveByTripID: scan: %w", err)
+		}
+		shares = append(shares, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.ShareRepo.ListActiveByTripID: rows: %w", err)
+	}
+	return shares, nil
+}
+
+// Revoke sets revoked_at on an unrevoked share belonging to tripID.
+func (r *pgShareRepo) Revoke(ctx context.Context, tripID, shareID uuid.UUID, now time.Time) error {
+	const q = `
+		UPDATE trip_shares
+		SET revoked_at = @now
+		WHERE id = @id AND trip_id = @trip_id AND revoked_at IS NULL
+		  AND trip_id IN ` + orgTripsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": shareID, "trip_id": tripID, "now": now}))
+	if err != nil {
+		return fmt.Errorf("repo.ShareRepo.Revoke: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.ShareRepo.Revoke: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// scanShare maps a single trip_shares row into a domain.Share.
+func scanShare(s scanner) (domain.Share, error) {
+	var (
+		sh     domain.Share
+		id     pgtype.UUID
+		tripID pgtype.UUID
+		orgID  pgtype.UUID
+		userID pgtype.UUID
+	)
+	err := s.Scan(&id, &tripID, &sh.ExpiresAt, &sh.RevokedAt, &sh.CreatedAt, &orgID, &userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Share{}, domain.ErrNotFound
+		}
+		return domain.Share{}, err
+	}
+	sh.ID = uuid.UUID(id.Bytes)
+	sh.TripID = uuid.UUID(tripID.Bytes)
+	sh.OrganizationID = uuid.UUID(orgID.Bytes)
+	if userID.Valid {
+		uid := uuid.UUID(userID.Bytes)
+		sh.OwnerID = &uid
+	}
+	return sh, nil
+}
@@ -0,0 +1,119 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newShareTestRepos returns a TripRepo and ShareRepo sharing one rolled-back
+// transaction, so shares can reference a trip created in the same test.
+func newShareTestRepos(t *testing.T) (repo.TripRepo, repo.ShareRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return repo.NewTripRepo(tx), repo.NewShareRepo(tx)
+}
+
+func TestShareRepo_CreateAndGet(t *testing.T) {
+	trips, shares := newShareTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+
+	expires := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Microsecond)
+	created, err := shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: expires})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, trip.ID, created.TripID)
+	assert.True(t, created.ExpiresAt.Equal(expires))
+	assert.Nil(t, created.RevokedAt)
+
+	got, err := shares.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+}
+
+func TestShareRepo_GetByID_NotFound(t *testing.T) {
+	_, shares := newShareTestRepos(t)
+
+	_, err := shares.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// TestShareRepo_ListActive_ExcludesRevokedAndExpired verifies the list only
+// contains shares that would still be honoured.
+func TestShareRepo_ListActive_ExcludesRevokedAndExpired(t *testing.T) {
+	trips, shares := newShareTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	now := time.Now()
+
+	live, err := shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: now.Add(time.Hour)})
+	require.NoError(t, err)
+	_, err = shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: now.Add(-time.Hour)})
+	require.NoError(t, err)
+	revoked, err := shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: now.Add(time.Hour)})
+	require.NoError(t, err)
+	require.NoError(t, shares.Revoke(ctx, trip.ID, revoked.ID, now))
+
+	got, err := shares.ListActiveByTripID(ctx, trip.ID, now)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, live.ID, got[0].ID)
+}
+
+func TestShareRepo_Revoke(t *testing.T) {
+	trips, shares := newShareTestRepos(t)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	share, err := shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	require.NoError(t, shares.Revoke(ctx, trip.ID, share.ID, time.Now()))
+
+	got, err := shares.GetByID(ctx, share.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, got.RevokedAt)
+
+	// A second revoke, or a revoke under the wrong trip, reports not found.
+	assert.ErrorIs(t, shares.Revoke(ctx, trip.ID, share.ID, time.Now()), domain.ErrNotFound)
+	other, err := shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	assert.ErrorIs(t, shares.Revoke(ctx, uuid.New(), other.ID, time.Now()), domain.ErrNotFound)
+}
+
+// TestShareRepo_CascadesOnTripPurge verifies a trip's shares go with it once
+// it is purged from the trash.
+func TestShareRepo_CascadesOnTripPurge(t *testing.T) {
+	tx, _ := newTestStopRepos(t)
+	trips, shares := repo.NewTripRepo(tx), repo.NewShareRepo(tx)
+	ctx := context.Background()
+	trip, err := trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	share, err := shares.Create(ctx, domain.Share{TripID: trip.ID, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `DELETE FROM trips WHERE id = $1`, trip.ID)
+	require.NoError(t, err)
+
+	_, err = shares.GetByID(ctx, share.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
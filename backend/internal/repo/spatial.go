@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// Spatial queries use PostGIS geography values where the database has them,
+// so distances are measured on the WGS 84 spheroid in meters and need no
+// special handling near the poles or the antimeridian. stops.coordinates is
+// the geography column derived from a stop's latitude and longitude; it is
+// NULL for stops without them, which every spatial predicate therefore
+// excludes.
+//
+// Migration 030 adds that column only when the database server has PostGIS.
+// Without it the same queries fall back to plain SQL on latitude and
+// longitude: haversine distances on a sphere of the Earth's mean radius,
+// which are within 0.5% of the spheroid's, and boxes and grid cells worked
+// out by hand. Which one a StopRepo uses is decided by spatialFor.
+
+// spatialSQL holds the fragments of the spatial stop queries that differ
+// with and without PostGIS. Each is an expression over the stops row s.
+type spatialSQL struct {
+	// distanceKm is the distance from the @lat and @lon point to the stop,
+	// in kilometers.
+	distanceKm string
+	// near matches stops within @radius_km of the point.
+	near string
+	// inBox matches stops inside a latitude/longitude box. It takes the
+	// arguments from boxArgs.
+	inBox string
+	// mercatorCell is the cell of a Web Mercator grid @cell_meters wide the
+	// stop falls in. Stops beyond @max_lat count as on its edge.
+	mercatorCell string
+	// gridLatitude and gridLongitude are the point of a grid @cell degrees
+	// wide nearest the stop.
+	gridLatitude, gridLongitude string
+}
+
+// pointSQL is the geography point at the @lat and @lon query arguments.
+const pointSQL = `ST_SetSRID(ST_MakePoint(@lon::float8, @lat::float8), 4326)::geography`
+
+// postgisSQL uses stops.coordinates and its GiST indexes: ST_DWithin and
+// the geometry box both answer from an index without measuring every stop.
+var postgisSQL = spatialSQL{
+	distanceKm: `ST_Distance(s.coordinates, ` + pointSQL + `) / 1000`,
+	near:       `ST_DWithin(s.coordinates, ` + pointSQL + `, @radius_km::float8 * 1000)`,
+	inBox: `(s.coordinates::geometry && ST_MakeEnvelope(@west1::float8, @south::float8, @east1::float8, @north::float8, 4326)
+		    OR s.coordinates::geometry && ST_MakeEnvelope(@west2::float8, @south::float8, @east2::float8, @north::float8, 4326))`,
+	mercatorCell: `ST_SnapToGrid(ST_Transform(ST_SetSRID(ST_MakePoint(
+		        s.longitude, greatest(-@max_lat::float8, least(@max_lat::float8, s.latitude))
+		    ), 4326), 3857), @cell_meters::float8)`,
+	gridLatitude:  `ST_Y(ST_SnapToGrid(s.coordinates::geometry, @cell::float8))`,
+	gridLongitude: `ST_X(ST_SnapToGrid(s.coordinates::geometry, @cell::float8))`,
+}
+
+// haversineKmSQL is the great-circle distance from the @lat and @lon point
+// to the stop, in kilometers. least keeps rounding from taking asin out of
+// its domain for antipodal points.
+const haversineKmSQL = `(2 * 6371.0088 * asin(least(1, sqrt(
+		        power(sin(radians(s.latitude - @lat::float8) / 2), 2)
+		        + cos(radians(@lat::float8)) * cos(radians(s.latitude)) * power(sin(radians(s.longitude - @lon::float8) / 2), 2)))))`
+
+// haversineSQL works on stops.latitude and stops.longitude alone. near
+// narrows to a band of latitudes first, so the (latitude, longitude) index
+// from migration 029 limits the stops that are measured. The Mercator cell
+// is the one ST_Transform and ST_SnapToGrid find: x and y on a sphere of
+// the WGS 84 equatorial radius, rounded to the grid.
+var haversineSQL = spatialSQL{
+	distanceKm: haversineKmSQL,
+	near: `(s.latitude BETWEEN @lat::float8 - degrees(@radius_km::float8 / 6371.0088) AND @lat::float8 + degrees(@radius_km::float8 / 6371.0088)
+		    AND ` + haversineKmSQL + ` <= @radius_km::float8)`,
+	inBox: `(s.latitude BETWEEN @south::float8 AND @north::float8
+		    AND (s.longitude BETWEEN @west1::float8 AND @east1::float8 OR s.longitude BETWEEN @west2::float8 AND @east2::float8))`,
+	mercatorCell: `ARRAY[
+		        round(6378137 * radians(s.longitude) / @cell_meters::float8),
+		        round(6378137 * ln(tan(pi() / 4 + radians(greatest(-@max_lat::float8, least(@max_lat::float8, s.latitude))) / 2)) / @cell_meters::float8)
+		    ]`,
+	gridLatitude:  `round(s.latitude / @cell::float8) * @cell::float8`,
+	gridLongitude: `round(s.longitude / @cell::float8) * @cell::float8`,
+}
+
+// spatialCheck remembers whether a database has stops.coordinates once it
+// has been asked, so the catalog is read once per StopRepo.
+type spatialCheck struct {
+	mu      sync.Mutex
+	checked bool
+	postgis bool
+}
+
+// spatialFor returns the fragments for db: postgisSQL when migration 030
+// added stops.coordinates, haversineSQL when it did not. A failed lookup is
+// not remembered, so the next query asks again.
+func (c *spatialCheck) spatialFor(ctx context.Context, db db) (spatialSQL, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.checked {
+		const q = `
+			SELECT EXISTS (
+				SELECT 1 FROM pg_attribute
+				WHERE attrelid = 'stops'::regclass AND attname = 'coordinates' AND NOT attisdropped)`
+		if err := db.QueryRow(ctx, q).Scan(&c.postgis); err != nil {
+			return spatialSQL{}, fmt.Errorf("repo.spatialFor: %w", err)
+		}
+		c.checked = true
+	}
+	if c.postgis {
+		return postgisSQL, nil
+	}
+	return haversineSQL, nil
+}
+
+// boxArgs returns the arguments for spatialSQL.inBox. A box that crosses the
+// antimeridian is searched as its two halves, west of it and east of it;
+// any other box is searched twice, which costs nothing.
+func boxArgs(b domain.BoundingBox) pgx.NamedArgs {
+	args := pgx.NamedArgs{
+		"south": b.South, "north": b.North,
+		"west1": b.West, "east1": b.East,
+		"west2": b.West, "east2": b.East,
+	}
+	if b.CrossesAntimeridian() {
+		args["east1"], args["west2"] = 180.0, -180.0
+	}
+	return args
+}
+
+// Web Mercator (EPSG:3857) is the projection of web map tiles. Clustering
+// happens in it so a cluster covers the same number of pixels anywhere on
+// the map.
+const (
+	// mercatorWorldMeters is the width of the whole map.
+	mercatorWorldMeters = 40075016.685578488
+	// mercatorMaxLatitude is where the map ends. Stops beyond it are
+	// clustered as if they were on its edge.
+	mercatorMaxLatitude = 85.05112878
+	// tilePixels is the width of a map tile.
+	tilePixels = 256
+)
+
+// mercatorCellMeters returns the width in Web Mercator meters of a square
+// that a map at zoom draws pixels wide.
+func mercatorCellMeters(zoom int, pixels float64) float64 {
+	return mercatorWorldMeters / (tilePixels * math.Exp2(float64(zoom))) * pixels
+}
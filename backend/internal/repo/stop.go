@@ -17,6 +17,7 @@ import (
 // StopRepo defines the persistence operations for Stops.
 // All write and single-read operations are scoped by tripID to enforce ownership:
 // a caller cannot read or mutate a stop that does not belong to the given trip.
+// Every operation treats a stop in the trash, or on a trip in the trash, as gone.
 type StopRepo interface {
 	// Create inserts a new stop and returns the persisted record.
 	Create(ctx context.Context, stop domain.Stop) (domain.Stop, error)
@@ -25,47 +26,104 @@ type StopRepo interface {
 	// Returns domain.ErrNotFound if no stop with that ID exists under that trip.
 	GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error)
 
-	// ListByTripID returns all stops for a trip ordered by arrived_at ascending.
+	// ListByTripID returns all stops for a trip ordered by arrived_at
+	// ascending, with planned stops last in the order they were added.
 	ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error)
 
-	// ListByTripIDPaged returns one page of stops for a trip and the total count across all pages.
-	// Results are ordered by arrived_at ascending.
-	ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	// ListByTripIDs returns the stops of every trip in tripIDs in one query,
+	// grouped by trip and ordered by arrived_at ascending within each. Trips
+	// that do not exist, or have no stops, simply contribute none.
+	ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) ([]domain.Stop, error)
+
+	// ListByTripIDPaged returns one page of the stops for a trip matching f and the total count
+	// across all pages. Results are ordered by arrived_at ascending.
+	ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Stop, int64, error)
+
+	// ListNearby returns the stops, across all trips, within radiusKm of the
+	// given point, nearest first, and at most limit of them. Stops without
+	// coordinates are never included.
+	ListNearby(ctx context.Context, lat, lon, radiusKm float64, limit int) ([]domain.NearbyStop, error)
+
+	// Clusters groups the stops, across all trips, inside box into one
+	// cluster per grid cell of a map at the given zoom, largest first.
+	Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error)
+
+	// Heatmap sums the nights spent at stops with coordinates into the cells
+	// of a grid cellDegrees wide, most nights first, leaving out cells with
+	// none. Year zero counts every year; otherwise only stops arrived at in
+	// that calendar year (UTC) count. Planned stops never count.
+	Heatmap(ctx context.Context, year int, cellDegrees float64) ([]domain.HeatmapCell, error)
 
 	// Update overwrites the mutable fields of a stop, scoped to the given tripID.
+	// A nil Metadata leaves the stop's metadata as it is.
 	// Returns domain.ErrNotFound if no stop with that ID exists under that trip.
 	Update(ctx context.Context, stop domain.Stop) (domain.Stop, error)
 
-	// Delete removes a stop by ID, scoped to the given tripID.
+	// Delete moves a stop to the trash, scoped to the given tripID.
 	// Returns domain.ErrNotFound if no stop with that ID exists under that trip.
 	Delete(ctx context.Context, tripID, stopID uuid.UUID) error
+
+	// ListRevisions returns every recorded version of a stop, newest first,
+	// scoped to the given tripID. Create and Update each record one. Returns
+	// an empty slice for a stop with no history under that trip.
+	ListRevisions(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error)
+
+	// GetRevision returns one recorded version of a stop, scoped to the
+	// given tripID. Returns domain.ErrNotFound if there is no such revision.
+	GetRevision(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.StopRevision, error)
 }
 
+// stopRevisionWriteColumns are the stop_revisions columns Create and Update
+// fill, in the order they select them.
+const stopRevisionWriteColumns = `stop_id, revision, name, location, latitude, longitude, arrived_at, departed_at, notes, recorded_at`
+
+// liveStopSQL matches a stops row s that is not in the trash and whose trip
+// is not either, belongs to @organization_id, and is unowned, @user_id's, or
+// shared with @user_id.
+const liveStopSQL = `s.deleted_at IS NULL
+		AND EXISTS (SELECT 1 FROM trips lt WHERE lt.id = s.trip_id AND lt.deleted_at IS NULL AND lt.organization_id = @organization_id
+		              AND (lt.user_id IS NULL OR lt.user_id = @user_id OR lt.id IN ` + memberTripsSQL + `))`
+
 // pgStopRepo is the Postgres implementation of StopRepo.
 type pgStopRepo struct {
-	db db
+	db      db
+	spatial *spatialCheck
 }
 
 // NewStopRepo constructs a StopRepo backed by the provided db connection.
 // In production pass *pgxpool.Pool; in tests pass a pgx.Tx for rollback isolation.
 func NewStopRepo(db db) StopRepo {
-	return &pgStopRepo{db: db}
+	return &pgStopRepo{db: db, spatial: &spatialCheck{}}
 }
 
-// Create inserts a new stop row and returns the full persisted record.
+// Create inserts a new stop row and its first revision, and returns the full
+// persisted record.
 func (r *pgStopRepo) Create(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
 	const q = `
-		INSERT INTO stops (trip_id, name, location, arrived_at, departed_at, notes)
-		VALUES (@trip_id, @name, @location, @arrived_at, @departed_at, @notes)
-		RETURNING id, trip_id, name, location, arrived_at, departed_at, notes, created_at, updated_at`
+		WITH created AS (
+			INSERT INTO stops (trip_id, name, location, latitude, longitude, arrived_at, departed_at, notes, odometer_miles, metadata, note_template_id, note_answers)
+			VALUES (@trip_id, @name, @location, @latitude, @longitude, @arrived_at, @departed_at, @notes, @odometer_miles, COALESCE(@metadata::jsonb, '{}'),
+			        @note_template_id, COALESCE(@note_answers::jsonb, '{}'))
+			RETURNING id, trip_id, name, location, latitude, longitude, arrived_at, departed_at, notes, odometer_miles, metadata, note_template_id, note_answers, created_at, updated_at, revision
+		), revised AS (
+			INSERT INTO stop_revisions (` + stopRevisionWriteColumns + `)
+			SELECT id, revision, name, location, latitude, longitude, arrived_at, departed_at, notes, updated_at FROM created
+		)
+		SELECT id, trip_id, name, location, latitude, longitude, arrived_at, departed_at, notes, odometer_miles, metadata, note_template_id, note_answers, created_at, updated_at FROM created`
 
 	args := pgx.NamedArgs{
-		"trip_id":     stop.TripID,
-		"name":        stop.Name,
-		"location":    nullableString(stop.Location),
-		"arrived_at":  stop.ArrivedAt,
-		"departed_at": stop.DepartedAt, // nil becomes NULL
-		"notes":       nullableString(stop.Notes),
+		"trip_id":          stop.TripID,
+		"name":             stop.Name,
+		"location":         nullableString(stop.Location),
+		"latitude":         stop.Latitude,      // nil becomes NULL
+		"longitude":        stop.Longitude,     // nil becomes NULL
+		"arrived_at":       arrivedAtArg(stop), // NULL while planned
+		"departed_at":      stop.DepartedAt,    // nil becomes NULL
+		"notes":            nullableString(stop.Notes),
+		"odometer_miles":   stop.OdometerMiles,               // nil becomes NULL
+		"metadata":         metadataArg(stop.Metadata),       // nil becomes {}
+		"note_template_id": stop.NoteTemplateID,              // nil becomes NULL
+		"note_answers":     noteAnswersArg(stop.NoteAnswers), // nil becomes {}
 	}
 
 	row := r.db.QueryRow(ctx, q, args)
@@ -80,7 +138,7 @@ func (r *pgStopRepo) Create(ctx context.Context, stop domain.Stop) (domain.Stop,
 // GetByID retrieves a stop by primary key, scoped to the given tripID.
 func (r *pgStopRepo) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
 	const q = `
-		SELECT s.id, s.trip_id, s.name, s.location, s.arrived_at, s.departed_at, s.notes, s.created_at, s.updated_at,
+		SELECT s.id, s.trip_id, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.odometer_miles, s.metadata, s.note_template_id, s.note_answers, s.created_at, s.updated_at,
 		       COALESCE(
 		           json_agg(
 		               json_build_object('id', t.id, 'name', t.name, 'slug', t.slug, 'created_at', t.created_at)
@@ -91,10 +149,10 @@ func (r *pgStopRepo) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (dom
 		FROM stops s
 		LEFT JOIN stop_tags st ON st.stop_id = s.id
 		LEFT JOIN tags t ON t.id = st.tag_id
-		WHERE s.id = @id AND s.trip_id = @trip_id
+		WHERE s.id = @id AND s.trip_id = @trip_id AND ` + liveStopSQL + `
 		GROUP BY s.id`
 
-	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": stopID, "trip_id": tripID})
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": stopID, "trip_id": tripID}))
 	result, err := scanStopFull(row)
 	if err != nil {
 		return domain.Stop{}, fmt.Errorf("repo.StopRepo.GetByID: %w", err)
@@ -105,7 +163,7 @@ func (r *pgStopRepo) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (dom
 // ListByTripID returns all stops for a trip, ordered by arrival time.
 func (r *pgStopRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
 	const q = `
-		SELECT s.id, s.trip_id, s.name, s.location, s.arrived_at, s.departed_at, s.notes, s.created_at, s.updated_at,
+		SELECT s.id, s.trip_id, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.odometer_miles, s.metadata, s.note_template_id, s.note_answers, s.created_at, s.updated_at,
 		       COALESCE(
 		           json_agg(
 		               json_build_object('id', t.id, 'name', t.name, 'slug', t.slug, 'created_at', t.created_at)
@@ -116,11 +174,11 @@ func (r *pgStopRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]doma
 		FROM stops s
 		LEFT JOIN stop_tags st ON st.stop_id = s.id
 		LEFT JOIN tags t ON t.id = st.tag_id
-		WHERE s.trip_id = @trip_id
+		WHERE s.trip_id = @trip_id AND ` + liveStopSQL + `
 		GROUP BY s.id
-		ORDER BY s.arrived_at ASC`
+		ORDER BY s.arrived_at ASC NULLS LAST, s.created_at`
 
-	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"trip_id": tripID})
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
 	if err != nil {
 		return nil, fmt.Errorf("repo.StopRepo.ListByTripID: %w", err)
 	}
@@ -141,19 +199,61 @@ func (r *pgStopRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]doma
 	return stops, nil
 }
 
-// ListByTripIDPaged returns one page of stops for a trip ordered by arrived_at ascending,
-// together with the total number of stops for that trip across all pages.
+// ListByTripIDs returns the stops of several trips at once, so a caller
+// rendering many trips does not query once per trip. Each stop includes its
+// linked tags, aggregated in the same query.
+func (r *pgStopRepo) ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) ([]domain.Stop, error) {
+	const q = `
+		SELECT s.id, s.trip_id, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.odometer_miles, s.metadata, s.note_template_id, s.note_answers, s.created_at, s.updated_at,
+		       COALESCE(
+		           json_agg(
+		               json_build_object('id', t.id, 'name', t.name, 'slug', t.slug, 'created_at', t.created_at)
+		               ORDER BY t.slug
+		           ) FILTER (WHERE t.id IS NOT NULL),
+		           '[]'::json
+		       ) AS tags
+		FROM stops s
+		LEFT JOIN stop_tags st ON st.stop_id = s.id
+		LEFT JOIN tags t ON t.id = st.tag_id
+		WHERE s.trip_id = ANY(@trip_ids::uuid[]) AND ` + liveStopSQL + `
+		GROUP BY s.id
+		ORDER BY s.trip_id, s.arrived_at ASC NULLS LAST, s.created_at`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_ids": tripIDs}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListByTripIDs: %w", err)
+	}
+	defer rows.Close()
+
+	stops := []domain.Stop{}
+	for rows.Next() {
+		s, err := scanStopFull(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.StopRepo.ListByTripIDs: scan: %w", err)
+		}
+		stops = append(stops, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListByTripIDs: rows: %w", err)
+	}
+
+	return stops, nil
+}
+
+// ListByTripIDPaged returns one page of the stops for a trip whose metadata
+// contains f, ordered by arrived_at ascending, together with the total number
+// of matches across all pages.
 // Each stop includes its linked tags, aggregated in a single query.
-func (r *pgStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error) {
-	const countQ = `SELECT COUNT(*) FROM stops WHERE trip_id = @trip_id`
+func (r *pgStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Stop, int64, error) {
+	const countQ = `SELECT COUNT(*) FROM stops s WHERE s.trip_id = @trip_id AND s.metadata @> @filter::jsonb AND ` + liveStopSQL
 
 	var total int64
-	if err := r.db.QueryRow(ctx, countQ, pgx.NamedArgs{"trip_id": tripID}).Scan(&total); err != nil {
+	if err := r.db.QueryRow(ctx, countQ, scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "filter": metadataFilterArg(f)})).Scan(&total); err != nil {
 		return nil, 0, fmt.Errorf("repo.StopRepo.ListByTripIDPaged: count: %w", err)
 	}
 
 	const q = `
-		SELECT s.id, s.trip_id, s.name, s.location, s.arrived_at, s.departed_at, s.notes, s.created_at, s.updated_at,
+		SELECT s.id, s.trip_id, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.odometer_miles, s.metadata, s.note_template_id, s.note_answers, s.created_at, s.updated_at,
 		       COALESCE(
 		           json_agg(
 		               json_build_object('id', t.id, 'name', t.name, 'slug', t.slug, 'created_at', t.created_at)
@@ -164,16 +264,17 @@ func (r *pgStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p
 		FROM stops s
 		LEFT JOIN stop_tags st ON st.stop_id = s.id
 		LEFT JOIN tags t ON t.id = st.tag_id
-		WHERE s.trip_id = @trip_id
+		WHERE s.trip_id = @trip_id AND s.metadata @> @filter::jsonb AND ` + liveStopSQL + `
 		GROUP BY s.id
-		ORDER BY s.arrived_at ASC
+		ORDER BY s.arrived_at ASC NULLS LAST, s.created_at
 		LIMIT @limit OFFSET @offset`
 
-	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{
 		"trip_id": tripID,
+		"filter":  metadataFilterArg(f),
 		"limit":   p.Limit,
 		"offset":  p.Offset(),
-	})
+	}))
 	if err != nil {
 		return nil, 0, fmt.Errorf("repo.StopRepo.ListByTripIDPaged: query: %w", err)
 	}
@@ -194,28 +295,218 @@ func (r *pgStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p
 	return stops, total, nil
 }
 
-// Update overwrites the mutable fields of a stop and returns the updated record.
-func (r *pgStopRepo) Update(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
-	const q = `
-		UPDATE stops
-		SET name        = @name,
-		    location    = @location,
-		    arrived_at  = @arrived_at,
-		    departed_at = @departed_at,
-		    notes       = @notes,
-		    updated_at  = now()
-		WHERE id = @id AND trip_id = @trip_id
-		RETURNING id, trip_id, name, location, arrived_at, departed_at, notes, created_at, updated_at`
+// ListNearby finds stops with spatialSQL.near, which answers from an index
+// without measuring every stop.
+func (r *pgStopRepo) ListNearby(ctx context.Context, lat, lon, radiusKm float64, limit int) ([]domain.NearbyStop, error) {
+	spatial, err := r.spatial.spatialFor(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListNearby: %w", err)
+	}
+	q := `
+		SELECT s.id, s.trip_id, s.name, s.location, s.latitude, s.longitude, s.arrived_at, s.departed_at, s.notes, s.odometer_miles, s.metadata, s.note_template_id, s.note_answers, s.created_at, s.updated_at,
+		       COALESCE(
+		           json_agg(
+		               json_build_object('id', t.id, 'name', t.name, 'slug', t.slug, 'created_at', t.created_at)
+		               ORDER BY t.slug
+		           ) FILTER (WHERE t.id IS NOT NULL),
+		           '[]'::json
+		       ) AS tags,
+		       tr.name, d.distance_km
+		FROM stops s
+		JOIN trips tr ON tr.id = s.trip_id
+		CROSS JOIN LATERAL (
+		    SELECT ` + spatial.distanceKm + ` AS distance_km
+		) d
+		LEFT JOIN stop_tags st ON st.stop_id = s.id
+		LEFT JOIN tags t ON t.id = st.tag_id
+		WHERE ` + spatial.near + `
+		  AND s.deleted_at IS NULL AND tr.deleted_at IS NULL AND tr.organization_id = @organization_id
+		  AND (tr.user_id IS NULL OR tr.user_id = @user_id OR tr.id IN ` + memberTripsSQL + `)
+		GROUP BY s.id, tr.name, d.distance_km
+		ORDER BY d.distance_km, s.arrived_at
+		LIMIT @limit`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"lat":       lat,
+		"lon":       lon,
+		"radius_km": radiusKm,
+		"limit":     limit,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListNearby: %w", err)
+	}
+	defer rows.Close()
 
-	args := pgx.NamedArgs{
-		"id":          stop.ID,
-		"trip_id":     stop.TripID,
-		"name":        stop.Name,
-		"location":    nullableString(stop.Location),
-		"arrived_at":  stop.ArrivedAt,
-		"departed_at": stop.DepartedAt,
-		"notes":       nullableString(stop.Notes),
+	nearby := []domain.NearbyStop{}
+	for rows.Next() {
+		var n domain.NearbyStop
+		s, err := scanStopFull(trailing{rows, []any{&n.TripName, &n.DistanceKm}})
+		if err != nil {
+			return nil, fmt.Errorf("repo.StopRepo.ListNearby: scan: %w", err)
+		}
+		n.Stop = s
+		nearby = append(nearby, n)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListNearby: rows: %w", err)
+	}
+	return nearby, nil
+}
+
+// clusterCellPixels is how wide, on screen, the grid cell stops are
+// clustered by is.
+const clusterCellPixels = 64
+
+// Clusters snaps each stop in the box to a grid in Web Mercator and groups
+// the stops that land on the same grid point.
+func (r *pgStopRepo) Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+	spatial, err := r.spatial.spatialFor(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.Clusters: %w", err)
+	}
+	q := `
+		SELECT count(*), avg(s.latitude), avg(s.longitude),
+		       min(s.longitude), min(s.latitude), max(s.longitude), max(s.latitude),
+		       (array_agg(s.id ORDER BY s.arrived_at))[1],
+		       (array_agg(s.trip_id ORDER BY s.arrived_at))[1],
+		       (array_agg(s.name ORDER BY s.arrived_at))[1]
+		FROM stops s
+		CROSS JOIN LATERAL (
+		    SELECT ` + spatial.mercatorCell + ` AS cell
+		) g
+		WHERE ` + spatial.inBox + ` AND ` + liveStopSQL + `
+		GROUP BY g.cell
+		ORDER BY count(*) DESC, 2, 3`
+
+	args := scoped(ctx, boxArgs(box))
+	args["max_lat"] = mercatorMaxLatitude
+	args["cell_meters"] = mercatorCellMeters(zoom, clusterCellPixels)
+
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.Clusters: %w", err)
+	}
+	defer rows.Close()
+
+	clusters := []domain.StopCluster{}
+	for rows.Next() {
+		var (
+			c          domain.StopCluster
+			id, tripID pgtype.UUID
+			name       string
+		)
+		if err := rows.Scan(&c.Count, &c.Latitude, &c.Longitude,
+			&c.Bounds.West, &c.Bounds.South, &c.Bounds.East, &c.Bounds.North,
+			&id, &tripID, &name); err != nil {
+			return nil, fmt.Errorf("repo.StopRepo.Clusters: scan: %w", err)
+		}
+		if c.Count == 1 {
+			c.Stop = &domain.ClusteredStop{ID: uuid.UUID(id.Bytes), TripID: uuid.UUID(tripID.Bytes), Name: name}
+		}
+		clusters = append(clusters, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.Clusters: rows: %w", err)
+	}
+	return clusters, nil
+}
+
+// Heatmap counts a stop's nights as the calendar days (UTC) between arrival
+// and departure, and one night for a stop with no departure yet.
+func (r *pgStopRepo) Heatmap(ctx context.Context, year int, cellDegrees float64) ([]domain.HeatmapCell, error) {
+	spatial, err := r.spatial.spatialFor(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.Heatmap: %w", err)
+	}
+	q := `
+		SELECT g.latitude, g.longitude, sum(n.nights)
+		FROM stops s
+		CROSS JOIN LATERAL (
+		    SELECT ` + spatial.gridLatitude + ` AS latitude, ` + spatial.gridLongitude + ` AS longitude
+		) g
+		CROSS JOIN LATERAL (
+		    SELECT CASE WHEN s.departed_at IS NULL THEN 1
+		                ELSE (s.departed_at AT TIME ZONE 'UTC')::date - (s.arrived_at AT TIME ZONE 'UTC')::date
+		           END AS nights
+		) n
+		WHERE s.latitude IS NOT NULL AND s.arrived_at IS NOT NULL AND ` + liveStopSQL + `
+		  AND (@from::timestamptz IS NULL OR s.arrived_at >= @from)
+		  AND (@to::timestamptz IS NULL OR s.arrived_at < @to)
+		GROUP BY g.latitude, g.longitude
+		HAVING sum(n.nights) > 0
+		ORDER BY 3 DESC, 1, 2`
+
+	args := scoped(ctx, yearWindow(year))
+	args["cell"] = cellDegrees
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.Heatmap: %w", err)
+	}
+	defer rows.Close()
+
+	cells := []domain.HeatmapCell{}
+	for rows.Next() {
+		var c domain.HeatmapCell
+		if err := rows.Scan(&c.Latitude, &c.Longitude, &c.Nights); err != nil {
+			return nil, fmt.Errorf("repo.StopRepo.Heatmap: scan: %w", err)
+		}
+		cells = append(cells, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.Heatmap: rows: %w", err)
+	}
+	return cells, nil
+}
+
+// trailing scans extra columns that follow the ones a scan function reads,
+// so a row scanner can be reused for a query that selects a few more.
+type trailing struct {
+	scanner
+	extra []any
+}
+
+func (t trailing) Scan(dest ...any) error {
+	return t.scanner.Scan(append(dest, t.extra...)...)
+}
+
+// Update overwrites the mutable fields of a stop, records them as its next
+// revision, and returns the updated record.
+func (r *pgStopRepo) Update(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
+	const q = `
+		WITH updated AS (
+			UPDATE stops s
+			SET name           = @name,
+			    location       = @location,
+			    latitude       = @latitude,
+			    longitude      = @longitude,
+			    arrived_at     = @arrived_at,
+			    departed_at    = @departed_at,
+			    notes          = @notes,
+			    odometer_miles = @odometer_miles,
+			    metadata       = COALESCE(@metadata::jsonb, metadata),
+			    revision       = revision + 1,
+			    updated_at     = now()
+			WHERE s.id = @id AND s.trip_id = @trip_id AND ` + liveStopSQL + `
+			RETURNING id, trip_id, name, location, latitude, longitude, arrived_at, departed_at, notes, odometer_miles, metadata, note_template_id, note_answers, created_at, updated_at, revision
+		), revised AS (
+			INSERT INTO stop_revisions (` + stopRevisionWriteColumns + `)
+			SELECT id, revision, name, location, latitude, longitude, arrived_at, departed_at, notes, updated_at FROM updated
+		)
+		SELECT id, trip_id, name, location, latitude, longitude, arrived_at, departed_at, notes, odometer_miles, metadata, note_template_id, note_answers, created_at, updated_at FROM updated`
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"id":             stop.ID,
+		"trip_id":        stop.TripID,
+		"name":           stop.Name,
+		"location":       nullableString(stop.Location),
+		"latitude":       stop.Latitude,      // nil becomes NULL
+		"longitude":      stop.Longitude,     // nil becomes NULL
+		"arrived_at":     arrivedAtArg(stop), // NULL while planned
+		"departed_at":    stop.DepartedAt,
+		"notes":          nullableString(stop.Notes),
+		"odometer_miles": stop.OdometerMiles,
+		"metadata":       metadataArg(stop.Metadata), // nil keeps the current metadata
+	})
 
 	row := r.db.QueryRow(ctx, q, args)
 	result, err := scanStop(row)
@@ -226,11 +517,60 @@ func (r *pgStopRepo) Update(ctx context.Context, stop domain.Stop) (domain.Stop,
 	return result, nil
 }
 
-// Delete removes a stop by primary key, scoped to the given tripID.
+// ListRevisions returns a stop's revisions, newest first. The join to stops
+// scopes them to the trip.
+func (r *pgStopRepo) ListRevisions(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error) {
+	const q = `
+		SELECT r.stop_id, r.revision, r.name, r.location, r.latitude, r.longitude,
+		       r.arrived_at, r.departed_at, r.notes, r.recorded_at
+		FROM stop_revisions r
+		JOIN stops s ON s.id = r.stop_id
+		WHERE r.stop_id = @stop_id AND s.trip_id = @trip_id AND s.trip_id IN ` + orgTripsSQL + `
+		ORDER BY r.revision DESC`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID, "trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListRevisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []domain.StopRevision{}
+	for rows.Next() {
+		rev, err := scanStopRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.StopRepo.ListRevisions: scan: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.StopRepo.ListRevisions: rows: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetRevision retrieves one revision of a stop, scoped to its trip.
+func (r *pgStopRepo) GetRevision(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.StopRevision, error) {
+	const q = `
+		SELECT r.stop_id, r.revision, r.name, r.location, r.latitude, r.longitude,
+		       r.arrived_at, r.departed_at, r.notes, r.recorded_at
+		FROM stop_revisions r
+		JOIN stops s ON s.id = r.stop_id
+		WHERE r.stop_id = @stop_id AND s.trip_id = @trip_id AND r.revision = @revision
+		  AND s.trip_id IN ` + orgTripsSQL
+
+	args := scoped(ctx, pgx.NamedArgs{"stop_id": stopID, "trip_id": tripID, "revision": revision})
+	result, err := scanStopRevision(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.StopRevision{}, fmt.Errorf("repo.StopRepo.GetRevision: %w", err)
+	}
+	return result, nil
+}
+
+// Delete stamps deleted_at on a live stop, scoped to the given tripID.
 func (r *pgStopRepo) Delete(ctx context.Context, tripID, stopID uuid.UUID) error {
-	const q = `DELETE FROM stops WHERE id = @id AND trip_id = @trip_id`
+	const q = `UPDATE stops s SET deleted_at = now() WHERE s.id = @id AND s.trip_id = @trip_id AND ` + liveStopSQL
 
-	tag, err := r.db.Exec(ctx, q, pgx.NamedArgs{"id": stopID, "trip_id": tripID})
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": stopID, "trip_id": tripID}))
 	if err != nil {
 		return fmt.Errorf("repo.StopRepo.Delete: %w", err)
 	}
@@ -241,7 +581,8 @@ func (r *pgStopRepo) Delete(ctx context.Context, tripID, stopID uuid.UUID) error
 }
 
 // scanStop maps a single database row into a domain.Stop.
-// It handles UUID conversions and nullable location, departed_at, and notes columns.
+// It handles UUID conversions and nullable location, coordinate, arrived_at,
+// departed_at, notes, and note_template_id columns.
 // Use this for write operations (Create, Update) whose RETURNING clause does not
 // include the tag aggregation column.
 func scanStop(s scanner) (domain.Stop, error) {
@@ -250,11 +591,13 @@ func scanStop(s scanner) (domain.Stop, error) {
 		id         pgtype.UUID
 		tripID     pgtype.UUID
 		location   *string
+		arrivedAt  *time.Time
 		departedAt *time.Time
 		notes      *string
+		templateID pgtype.UUID
 	)
 
-	err := s.Scan(&id, &tripID, &t.Name, &location, &t.ArrivedAt, &departedAt, &notes, &t.CreatedAt, &t.UpdatedAt)
+	err := s.Scan(&id, &tripID, &t.Name, &location, &t.Latitude, &t.Longitude, &arrivedAt, &departedAt, &notes, &t.OdometerMiles, &t.Metadata, &templateID, &t.NoteAnswers, &t.CreatedAt, &t.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Stop{}, domain.ErrNotFound
@@ -267,10 +610,15 @@ func scanStop(s scanner) (domain.Stop, error) {
 	if location != nil {
 		t.Location = *location
 	}
+	setArrival(&t, arrivedAt)
 	t.DepartedAt = departedAt
 	if notes != nil {
 		t.Notes = *notes
 	}
+	if templateID.Valid {
+		tid := uuid.UUID(templateID.Bytes)
+		t.NoteTemplateID = &tid
+	}
 
 	return t, nil
 }
@@ -294,12 +642,14 @@ func scanStopFull(s scanner) (domain.Stop, error) {
 		id         pgtype.UUID
 		tripID     pgtype.UUID
 		location   *string
+		arrivedAt  *time.Time
 		departedAt *time.Time
 		notes      *string
+		templateID pgtype.UUID
 		tagsJSON   []byte
 	)
 
-	err := s.Scan(&id, &tripID, &t.Name, &location, &t.ArrivedAt, &departedAt, &notes, &t.CreatedAt, &t.UpdatedAt, &tagsJSON)
+	err := s.Scan(&id, &tripID, &t.Name, &location, &t.Latitude, &t.Longitude, &arrivedAt, &departedAt, &notes, &t.OdometerMiles, &t.Metadata, &templateID, &t.NoteAnswers, &t.CreatedAt, &t.UpdatedAt, &tagsJSON)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Stop{}, domain.ErrNotFound
@@ -312,10 +662,15 @@ func scanStopFull(s scanner) (domain.Stop, error) {
 	if location != nil {
 		t.Location = *location
 	}
+	setArrival(&t, arrivedAt)
 	t.DepartedAt = departedAt
 	if notes != nil {
 		t.Notes = *notes
 	}
+	if templateID.Valid {
+		tid := uuid.UUID(templateID.Bytes)
+		t.NoteTemplateID = &tid
+	}
 
 	// Parse the JSON-aggregated tags. The COALESCE guarantees at least '[]',
 	// so tagsJSON is never nil or empty.
@@ -336,6 +691,25 @@ func scanStopFull(s scanner) (domain.Stop, error) {
 	return t, nil
 }
 
+// arrivedAtArg returns the arrived_at argument for stop: NULL while it is
+// planned.
+func arrivedAtArg(stop domain.Stop) *time.Time {
+	if stop.Planned {
+		return nil
+	}
+	return &stop.ArrivedAt
+}
+
+// setArrival sets t's arrival from a scanned arrived_at, which is NULL for a
+// planned stop.
+func setArrival(t *domain.Stop, arrivedAt *time.Time) {
+	if arrivedAt == nil {
+		t.Planned = true
+		return
+	}
+	t.ArrivedAt = *arrivedAt
+}
+
 // nullableString converts an empty Go string to nil so it is stored as SQL NULL.
 // This keeps the domain model clean (no *string fields) while storing NULL in the DB
 // for columns that are logically optional.
@@ -345,3 +719,35 @@ func nullableString(s string) *string {
 	}
 	return &s
 }
+
+// scanStopRevision maps a single stop_revisions row into a domain.StopRevision.
+func scanStopRevision(s scanner) (domain.StopRevision, error) {
+	var (
+		rev       domain.StopRevision
+		stopID    pgtype.UUID
+		location  *string
+		arrivedAt *time.Time
+		notes     *string
+	)
+	err := s.Scan(&stopID, &rev.Revision, &rev.Name, &location, &rev.Latitude, &rev.Longitude,
+		&arrivedAt, &rev.DepartedAt, &notes, &rev.RecordedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.StopRevision{}, domain.ErrNotFound
+		}
+		return domain.StopRevision{}, err
+	}
+	rev.StopID = uuid.UUID(stopID.Bytes)
+	if location != nil {
+		rev.Location = *location
+	}
+	if arrivedAt != nil {
+		rev.ArrivedAt = *arrivedAt
+	} else {
+		rev.Planned = true
+	}
+	if notes != nil {
+		rev.Notes = *notes
+	}
+	return rev, nil
+}
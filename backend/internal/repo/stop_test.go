@@ -11,16 +11,18 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
 )
 
-// newTestStopRepos opens a single transaction and returns both a TripRepo and
-// a StopRepo backed by it. Tests can create a parent trip and child stops within
-// the same transaction, which is rolled back automatically when the test finishes.
-func newTestStopRepos(t *testing.T) (repo.TripRepo, repo.StopRepo) {
+// newTestStopRepos opens a single transaction and returns it together with a
+// StopRepo backed by it. Tests insert parent trips with testutil/factory on the
+// same transaction, which is rolled back automatically when the test finishes.
+func newTestStopRepos(t *testing.T) (pgx.Tx, repo.StopRepo) {
 	t.Helper()
 	pool := testutil.NewPool(t)
 
@@ -31,39 +33,33 @@ func newTestStopRepos(t *testing.T) (repo.TripRepo, repo.StopRepo) {
 		_ = tx.Rollback(context.Background())
 	})
 
-	return repo.NewTripRepo(tx), repo.NewStopRepo(tx)
+	return tx, repo.NewStopRepo(tx)
 }
 
-// mustCreateTrip is a test helper that inserts a parent trip and fails the test
-// if the insert does not succeed.
-func mustCreateTrip(t *testing.T, r repo.TripRepo) domain.Trip {
+// newHaversineStopRepos is newTestStopRepos on a schema without PostGIS:
+// stops.coordinates, which migration 030 adds only where the server has
+// the extension, is dropped inside the test's transaction, so the repo
+// falls back to haversine SQL.
+func newHaversineStopRepos(t *testing.T) (pgx.Tx, repo.StopRepo) {
 	t.Helper()
-	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
-	trip, err := r.Create(context.Background(), domain.Trip{
-		Name:      "Test Trip",
-		StartDate: start,
-	})
-	require.NoError(t, err, "create parent trip")
-	return trip
+	tx, _ := newTestStopRepos(t)
+	_, err := tx.Exec(context.Background(), `ALTER TABLE stops DROP COLUMN coordinates`)
+	require.NoError(t, err)
+	return tx, repo.NewStopRepo(tx)
 }
 
-// stopFixture returns a Stop ready for insertion against the given tripID.
-func stopFixture(tripID uuid.UUID) domain.Stop {
-	return domain.Stop{
-		TripID:    tripID,
-		Name:      "Camp Grounds A",
-		Location:  "Yellowstone, WY",
-		ArrivedAt: time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
-		Notes:     "Great spot",
-	}
+// spatialStopRepos runs the spatial query tests with PostGIS and without.
+var spatialStopRepos = map[string]func(*testing.T) (pgx.Tx, repo.StopRepo){
+	"postgis":   newTestStopRepos,
+	"haversine": newHaversineStopRepos,
 }
 
 func TestStopRepo_Create(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	input := stopFixture(parent.ID)
+	parent := factory.Trip().Insert(t, tx)
+	input := factory.Stop().WithTripID(parent.ID).Build()
 
 	got, err := stopRepo.Create(ctx, input)
 
@@ -80,11 +76,11 @@ func TestStopRepo_Create(t *testing.T) {
 }
 
 func TestStopRepo_Create_WithDepartedAt(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	input := stopFixture(parent.ID)
+	parent := factory.Trip().Insert(t, tx)
+	input := factory.Stop().WithTripID(parent.ID).Build()
 	departed := time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC)
 	input.DepartedAt = &departed
 
@@ -95,12 +91,62 @@ func TestStopRepo_Create_WithDepartedAt(t *testing.T) {
 	assert.True(t, got.DepartedAt.Equal(departed), "DepartedAt mismatch")
 }
 
+func TestStopRepo_Create_WithCoordinates(t *testing.T) {
+	tx, stopRepo := newTestStopRepos(t)
+	ctx := context.Background()
+
+	parent := factory.Trip().Insert(t, tx)
+	input := factory.Stop().WithTripID(parent.ID).WithCoordinates(44.4280, -110.5885).Build()
+
+	got, err := stopRepo.Create(ctx, input)
+
+	require.NoError(t, err)
+	require.True(t, got.HasCoordinates())
+	assert.InDelta(t, 44.4280, *got.Latitude, 1e-9)
+	assert.InDelta(t, -110.5885, *got.Longitude, 1e-9)
+
+	fetched, err := stopRepo.GetByID(ctx, parent.ID, got.ID)
+	require.NoError(t, err)
+	assert.Equal(t, got.Latitude, fetched.Latitude)
+	assert.Equal(t, got.Longitude, fetched.Longitude)
+}
+
+func TestStopRepo_Odometer_TripDistanceTraveled(t *testing.T) {
+	tx, stopRepo := newTestStopRepos(t)
+	tripRepo := repo.NewTripRepo(tx)
+	ctx := context.Background()
+
+	parent := factory.Trip().Insert(t, tx)
+	startMiles, endMiles := 42000.0, 42310.5
+	first := factory.Stop().WithTripID(parent.ID).Build()
+	first.OdometerMiles = &startMiles
+	created, err := stopRepo.Create(ctx, first)
+	require.NoError(t, err)
+	require.NotNil(t, created.OdometerMiles)
+	assert.InDelta(t, 42000, *created.OdometerMiles, 0.001)
+
+	trip, err := tripRepo.GetByID(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.Nil(t, trip.DistanceTraveled, "one reading covers no distance")
+
+	second := factory.Stop().WithTripID(parent.ID).Build()
+	second.ArrivedAt = first.ArrivedAt.Add(48 * time.Hour)
+	second.OdometerMiles = &endMiles
+	_, err = stopRepo.Create(ctx, second)
+	require.NoError(t, err)
+
+	trip, err = tripRepo.GetByID(ctx, parent.ID)
+	require.NoError(t, err)
+	require.NotNil(t, trip.DistanceTraveled)
+	assert.InDelta(t, 310.5, *trip.DistanceTraveled, 0.001)
+}
+
 func TestStopRepo_GetByID(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	got, err := stopRepo.GetByID(ctx, parent.ID, created.ID)
@@ -111,11 +157,11 @@ func TestStopRepo_GetByID(t *testing.T) {
 }
 
 func TestStopRepo_GetByID_WrongTrip(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	// Use a different (random) tripID — should not find the stop.
@@ -125,20 +171,20 @@ func TestStopRepo_GetByID_WrongTrip(t *testing.T) {
 }
 
 func TestStopRepo_ListByTripID(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	other := mustCreateTrip(t, tripRepo)
+	parent := factory.Trip().Insert(t, tx)
+	other := factory.Trip().Insert(t, tx)
 
 	// Create two stops for parent, one for other.
-	_, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	_, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
-	s2 := stopFixture(parent.ID)
+	s2 := factory.Stop().WithTripID(parent.ID).Build()
 	s2.Name = "Stop 2"
 	_, err = stopRepo.Create(ctx, s2)
 	require.NoError(t, err)
-	_, err = stopRepo.Create(ctx, stopFixture(other.ID))
+	_, err = stopRepo.Create(ctx, factory.Stop().WithTripID(other.ID).Build())
 	require.NoError(t, err)
 
 	got, err := stopRepo.ListByTripID(ctx, parent.ID)
@@ -151,10 +197,10 @@ func TestStopRepo_ListByTripID(t *testing.T) {
 }
 
 func TestStopRepo_ListByTripID_Empty(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
+	parent := factory.Trip().Insert(t, tx)
 
 	got, err := stopRepo.ListByTripID(ctx, parent.ID)
 
@@ -163,12 +209,39 @@ func TestStopRepo_ListByTripID_Empty(t *testing.T) {
 	assert.Len(t, got, 0)
 }
 
+func TestStopRepo_ListByTripIDs(t *testing.T) {
+	tx, stopRepo := newTestStopRepos(t)
+	ctx := context.Background()
+
+	a := factory.Trip().Insert(t, tx)
+	b := factory.Trip().Insert(t, tx)
+	other := factory.Trip().Insert(t, tx)
+
+	for _, tripID := range []uuid.UUID{a.ID, a.ID, b.ID, other.ID} {
+		_, err := stopRepo.Create(ctx, factory.Stop().WithTripID(tripID).Build())
+		require.NoError(t, err)
+	}
+	trashed, err := stopRepo.Create(ctx, factory.Stop().WithTripID(b.ID).Build())
+	require.NoError(t, err)
+	require.NoError(t, stopRepo.Delete(ctx, b.ID, trashed.ID))
+
+	got, err := stopRepo.ListByTripIDs(ctx, []uuid.UUID{a.ID, b.ID, uuid.New()})
+
+	require.NoError(t, err)
+	perTrip := map[uuid.UUID]int{}
+	for _, s := range got {
+		perTrip[s.TripID]++
+		assert.NotNil(t, s.Tags)
+	}
+	assert.Equal(t, map[uuid.UUID]int{a.ID: 2, b.ID: 1}, perTrip, "only live stops of the requested trips")
+}
+
 func TestStopRepo_Update(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	created.Name = "Updated Name"
@@ -186,11 +259,11 @@ func TestStopRepo_Update(t *testing.T) {
 }
 
 func TestStopRepo_Update_WrongTrip(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	// Swap the TripID to a random UUID — should not find the stop.
@@ -201,11 +274,11 @@ func TestStopRepo_Update_WrongTrip(t *testing.T) {
 }
 
 func TestStopRepo_Delete(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	err = stopRepo.Delete(ctx, parent.ID, created.ID)
@@ -216,11 +289,11 @@ func TestStopRepo_Delete(t *testing.T) {
 }
 
 func TestStopRepo_Delete_WrongTrip(t *testing.T) {
-	tripRepo, stopRepo := newTestStopRepos(t)
+	tx, stopRepo := newTestStopRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	err = stopRepo.Delete(ctx, uuid.New(), created.ID)
@@ -234,11 +307,11 @@ func TestStopRepo_Delete_WrongTrip(t *testing.T) {
 
 func TestStopRepo_GetByID_IncludesTags(t *testing.T) {
 	// reuse newTestTagRepos so all three repos share the same transaction.
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	tag, err := tagRepo.Upsert(ctx, "Mountains", "mountains")
@@ -254,11 +327,11 @@ func TestStopRepo_GetByID_IncludesTags(t *testing.T) {
 }
 
 func TestStopRepo_GetByID_EmptyTags(t *testing.T) {
-	tripRepo, stopRepo, _ := newTestTagRepos(t)
+	tx, stopRepo, _ := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	got, err := stopRepo.GetByID(ctx, parent.ID, created.ID)
@@ -269,11 +342,11 @@ func TestStopRepo_GetByID_EmptyTags(t *testing.T) {
 }
 
 func TestStopRepo_ListByTripIDPaged_IncludesTags(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	created, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	created, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	tag1, err := tagRepo.Upsert(ctx, "Desert", "desert")
@@ -283,7 +356,7 @@ func TestStopRepo_ListByTripIDPaged_IncludesTags(t *testing.T) {
 	require.NoError(t, tagRepo.AddToStop(ctx, created.ID, tag1.ID))
 	require.NoError(t, tagRepo.AddToStop(ctx, created.ID, tag2.ID))
 
-	stops, total, err := stopRepo.ListByTripIDPaged(ctx, parent.ID, domain.NewPaginationParams(nil, nil))
+	stops, total, err := stopRepo.ListByTripIDPaged(ctx, parent.ID, nil, domain.NewPaginationParams(nil, nil))
 
 	require.NoError(t, err)
 	require.EqualValues(t, 1, total)
@@ -293,3 +366,236 @@ func TestStopRepo_ListByTripIDPaged_IncludesTags(t *testing.T) {
 	assert.Equal(t, "desert", stops[0].Tags[0].Slug)
 	assert.Equal(t, "mountains", stops[0].Tags[1].Slug)
 }
+
+func TestStopRepo_ListNearby(t *testing.T) {
+	for name, newRepos := range spatialStopRepos {
+		t.Run(name, func(t *testing.T) {
+			tx, stopRepo := newRepos(t)
+			ctx := context.Background()
+
+			trip := factory.Trip().WithName("Yellowstone").Insert(t, tx)
+			// About 1 km, 10 km, and 150 km from the search point at Old Faithful.
+			near := factory.Stop().WithTripID(trip.ID).WithCoordinates(44.4670, -110.8420).WithTags("geysers").Insert(t, tx)
+			mid := factory.Stop().WithTripID(trip.ID).WithCoordinates(44.5500, -110.8300).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(45.7833, -111.0000).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).Insert(t, tx) // no coordinates
+
+			got, err := stopRepo.ListNearby(ctx, 44.4605, -110.8281, 50, 10)
+
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			assert.Equal(t, near.ID, got[0].Stop.ID, "nearest first")
+			assert.Equal(t, mid.ID, got[1].Stop.ID)
+			assert.Equal(t, "Yellowstone", got[0].TripName)
+			assert.InDelta(t, 1.3, got[0].DistanceKm, 0.1)
+			assert.InDelta(t, 9.95, got[1].DistanceKm, 0.1)
+			require.Len(t, got[0].Stop.Tags, 1)
+			assert.Equal(t, "geysers", got[0].Stop.Tags[0].Slug)
+
+			limited, err := stopRepo.ListNearby(ctx, 44.4605, -110.8281, 50, 1)
+			require.NoError(t, err)
+			require.Len(t, limited, 1)
+			assert.Equal(t, near.ID, limited[0].Stop.ID)
+		})
+	}
+}
+
+func TestStopRepo_ListNearby_AcrossAntimeridian(t *testing.T) {
+	for name, newRepos := range spatialStopRepos {
+		t.Run(name, func(t *testing.T) {
+			tx, stopRepo := newRepos(t)
+			ctx := context.Background()
+
+			trip := factory.Trip().Insert(t, tx)
+			east := factory.Stop().WithTripID(trip.ID).WithCoordinates(-17.0, 179.9).Insert(t, tx)
+
+			got, err := stopRepo.ListNearby(ctx, -17.0, -179.9, 50, 10)
+
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, east.ID, got[0].Stop.ID)
+			assert.Less(t, got[0].DistanceKm, 25.0)
+		})
+	}
+}
+
+func TestStopRepo_Clusters(t *testing.T) {
+	for name, newRepos := range spatialStopRepos {
+		t.Run(name, func(t *testing.T) {
+			tx, stopRepo := newRepos(t)
+			ctx := context.Background()
+
+			trip := factory.Trip().Insert(t, tx)
+			// Two stops a few hundred meters apart in Yellowstone, one in Salt Lake
+			// City, and one in Denver, outside the box.
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(44.4605, -110.8281).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(44.4630, -110.8300).Insert(t, tx)
+			slc := factory.Stop().WithTripID(trip.ID).WithName("Salt Lake City").WithCoordinates(40.7608, -111.8910).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(39.7392, -104.9903).Insert(t, tx)
+			box := domain.BoundingBox{West: -115, South: 38, East: -106, North: 47}
+
+			got, err := stopRepo.Clusters(ctx, box, 8)
+
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			assert.Equal(t, 2, got[0].Count, "largest first")
+			assert.Nil(t, got[0].Stop)
+			assert.InDelta(t, 44.46175, got[0].Latitude, 1e-6)
+			assert.Equal(t, -110.8300, got[0].Bounds.West)
+			assert.Equal(t, -110.8281, got[0].Bounds.East)
+			assert.Equal(t, 1, got[1].Count)
+			require.NotNil(t, got[1].Stop)
+			assert.Equal(t, slc.ID, got[1].Stop.ID)
+			assert.Equal(t, trip.ID, got[1].Stop.TripID)
+			assert.Equal(t, "Salt Lake City", got[1].Stop.Name)
+
+			zoomedOut, err := stopRepo.Clusters(ctx, box, 2)
+			require.NoError(t, err)
+			require.Len(t, zoomedOut, 1)
+			assert.Equal(t, 3, zoomedOut[0].Count)
+		})
+	}
+}
+
+func TestStopRepo_Clusters_AcrossAntimeridian(t *testing.T) {
+	for name, newRepos := range spatialStopRepos {
+		t.Run(name, func(t *testing.T) {
+			tx, stopRepo := newRepos(t)
+			ctx := context.Background()
+
+			trip := factory.Trip().Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(-17.0, 179.5).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(-17.0, -179.5).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(-17.0, 0).Insert(t, tx)
+
+			got, err := stopRepo.Clusters(ctx, domain.BoundingBox{West: 170, South: -20, East: -170, North: -10}, 10)
+
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			assert.Equal(t, 1, got[0].Count)
+			assert.Equal(t, 1, got[1].Count)
+		})
+	}
+}
+
+func TestStopRepo_Heatmap(t *testing.T) {
+	for name, newRepos := range spatialStopRepos {
+		t.Run(name, func(t *testing.T) {
+			tx, stopRepo := newRepos(t)
+			ctx := context.Background()
+
+			trip := factory.Trip().Insert(t, tx)
+			departed := func(at time.Time) *time.Time { return &at }
+			// Two stays in the same 1° cell, one day stop that adds nothing, one stay
+			// the year before, and a stop still in progress.
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(44.46, -110.83).
+				WithArrivedAt(day(2024, 7, 1).Add(15*time.Hour)).WithDepartedAt(departed(day(2024, 7, 4).Add(10*time.Hour))).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(44.38, -110.61).
+				WithArrivedAt(day(2024, 7, 4).Add(14*time.Hour)).WithDepartedAt(departed(day(2024, 7, 6).Add(9*time.Hour))).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(43.48, -110.76).
+				WithArrivedAt(day(2024, 7, 6).Add(12*time.Hour)).WithDepartedAt(departed(day(2024, 7, 6).Add(13*time.Hour))).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(40.76, -111.89).
+				WithArrivedAt(day(2023, 5, 1).Add(15*time.Hour)).WithDepartedAt(departed(day(2023, 5, 2).Add(10*time.Hour))).Insert(t, tx)
+			factory.Stop().WithTripID(trip.ID).WithCoordinates(39.74, -104.99).
+				WithArrivedAt(day(2024, 8, 1).Add(15*time.Hour)).Insert(t, tx)
+
+			got, err := stopRepo.Heatmap(ctx, 2024, 1)
+
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			assert.Equal(t, domain.HeatmapCell{Latitude: 44, Longitude: -111, Nights: 5}, got[0])
+			assert.Equal(t, domain.HeatmapCell{Latitude: 40, Longitude: -105, Nights: 1}, got[1])
+
+			all, err := stopRepo.Heatmap(ctx, 0, 1)
+			require.NoError(t, err)
+			assert.Len(t, all, 3)
+		})
+	}
+}
+
+func TestStopRepo_Revisions_RecordCreateAndUpdate(t *testing.T) {
+	tx, stopRepo := newTestStopRepos(t)
+	ctx := context.Background()
+
+	stop := factory.Stop().WithName("Moab").WithCoordinates(38.57, -109.55).Insert(t, tx)
+	stop.Name = "Moab KOA"
+	stop.Latitude, stop.Longitude = nil, nil
+	_, err := stopRepo.Update(ctx, stop)
+	require.NoError(t, err)
+
+	revs, err := stopRepo.ListRevisions(ctx, stop.TripID, stop.ID)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, 2, revs[0].Revision, "newest first")
+	assert.Equal(t, "Moab KOA", revs[0].Name)
+	assert.Nil(t, revs[0].Latitude)
+	assert.Equal(t, "Moab", revs[1].Name)
+	require.NotNil(t, revs[1].Latitude)
+	assert.InDelta(t, 38.57, *revs[1].Latitude, 1e-9)
+
+	first, err := stopRepo.GetRevision(ctx, stop.TripID, stop.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Moab", first.Name)
+}
+
+func TestStopRepo_Revisions_ScopedToTrip(t *testing.T) {
+	tx, stopRepo := newTestStopRepos(t)
+	ctx := context.Background()
+
+	stop := factory.Stop().Insert(t, tx)
+	other := factory.Trip().Insert(t, tx)
+
+	revs, err := stopRepo.ListRevisions(ctx, other.ID, stop.ID)
+	require.NoError(t, err)
+	assert.Empty(t, revs)
+
+	_, err = stopRepo.GetRevision(ctx, other.ID, stop.ID, 1)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestStopRepo_PlannedStops(t *testing.T) {
+	tx, stopRepo := newTestStopRepos(t)
+	ctx := context.Background()
+
+	trip := factory.Trip().Insert(t, tx)
+	visited := factory.Stop().WithTripID(trip.ID).WithCoordinates(44.46, -110.83).
+		WithArrivedAt(day(2024, 7, 1).Add(15*time.Hour)).Insert(t, tx)
+	next := factory.Stop().WithTripID(trip.ID).WithName("Grand Teton").WithCoordinates(43.79, -110.68).Build()
+	next.Planned = true
+	next.ArrivedAt = time.Time{}
+	planned, err := stopRepo.Create(ctx, next)
+	require.NoError(t, err)
+	assert.True(t, planned.Planned)
+	assert.True(t, planned.ArrivedAt.IsZero())
+
+	got, err := stopRepo.ListByTripID(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, visited.ID, got[0].ID)
+	assert.Equal(t, planned.ID, got[1].ID, "planned stops come after the visited ones")
+
+	cells, err := stopRepo.Heatmap(ctx, 0, 1)
+	require.NoError(t, err)
+	assert.Len(t, cells, 1, "planned stops add no nights")
+
+	summaries, err := repo.NewSummaryRepo(tx).ListTrips(ctx)
+	require.NoError(t, err)
+	for _, s := range summaries {
+		if s.TripID == trip.ID {
+			assert.Equal(t, 1, s.Stops)
+			assert.Equal(t, 1, s.Nights)
+		}
+	}
+
+	revs, err := stopRepo.ListRevisions(ctx, trip.ID, planned.ID)
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	assert.True(t, revs[0].Planned)
+
+	planned.Planned = false
+	planned.ArrivedAt = day(2024, 7, 3).Add(16 * time.Hour)
+	reached, err := stopRepo.Update(ctx, planned)
+	require.NoError(t, err)
+	assert.False(t, reached.Planned)
+	assert.True(t, reached.ArrivedAt.Equal(planned.ArrivedAt))
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// SummaryRepo reads the per-trip and per-tag totals that triggers keep in
+// trip_summaries and tag_summaries (migration 033). Reads cost one row per
+// trip or tag however many stops have been logged.
+type SummaryRepo interface {
+	// ListTrips returns every trip's summary, newest trip first.
+	ListTrips(ctx context.Context) ([]domain.TripSummary, error)
+
+	// TopTags returns the limit tags on the most stops, most first and then
+	// by slug. Tags on no stop are left out.
+	TopTags(ctx context.Context, limit int) ([]domain.TagCount, error)
+}
+
+// pgSummaryRepo is the Postgres implementation of SummaryRepo.
+type pgSummaryRepo struct {
+	db db
+}
+
+// NewSummaryRepo constructs a SummaryRepo backed by the provided db connection.
+func NewSummaryRepo(db db) SummaryRepo {
+	return &pgSummaryRepo{db: db}
+}
+
+// ListTrips joins each trip to its summary row.
+func (r *pgSummaryRepo) ListTrips(ctx context.Context) ([]domain.TripSummary, error) {
+	const q = `
+		SELECT t.id, t.name, t.start_date, t.end_date,
+		       ts.stop_count, ts.nights, ts.first_arrived_at, ts.last_arrived_at
+		FROM trips t
+		JOIN trip_summaries ts ON ts.trip_id = t.id
+		WHERE t.organization_id = @organization_id AND t.deleted_at IS NULL
+		  AND (t.user_id IS NULL OR t.user_id = @user_id OR t.id IN ` + memberTripsSQL + `)
+		ORDER BY t.start_date DESC, t.id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.SummaryRepo.ListTrips: %w", err)
+	}
+	defer rows.Close()
+
+	trips := []domain.TripSummary{}
+	for rows.Next() {
+		var (
+			s         domain.TripSummary
+			id        pgtype.UUID
+			startDate pgtype.Date
+			endDate   pgtype.Date
+		)
+		if err := rows.Scan(&id, &s.Name, &startDate, &endDate,
+			&s.Stops, &s.Nights, &s.FirstArrivedAt, &s.LastArrivedAt); err != nil {
+			return nil, fmt.Errorf("repo.SummaryRepo.ListTrips: scan: %w", err)
+		}
+		s.TripID = uuid.UUID(id.Bytes)
+		s.StartDate = domain.DateOf(startDate.Time)
+		s.EndDate = nullDate(endDate)
+		trips = append(trips, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.SummaryRepo.ListTrips: rows: %w", err)
+	}
+	return trips, nil
+}
+
+// TopTags joins the busiest tag summaries to their tags.
+func (r *pgSummaryRepo) TopTags(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	const q = `
+		SELECT t.slug, t.name, ts.stop_count
+		FROM tag_summaries ts
+		JOIN tags t ON t.id = ts.tag_id
+		WHERE t.organization_id = @organization_id AND ts.stop_count > 0
+		ORDER BY ts.stop_count DESC, t.slug
+		LIMIT @limit`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"limit": limit}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.SummaryRepo.TopTags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []domain.TagCount{}
+	for rows.Next() {
+		var c domain.TagCount
+		if err := rows.Scan(&c.Slug, &c.Name, &c.Stops); err != nil {
+			return nil, fmt.Errorf("repo.SummaryRepo.TopTags: scan: %w", err)
+		}
+		tags = append(tags, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.SummaryRepo.TopTags: rows: %w", err)
+	}
+	return tags, nil
+}
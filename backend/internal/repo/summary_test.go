@@ -0,0 +1,110 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func newSummaryTestRepo(t *testing.T) (pgx.Tx, repo.SummaryRepo) {
+	t.Helper()
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	return tx, repo.NewSummaryRepo(tx)
+}
+
+func TestSummaryRepo_ListTrips_FollowsStops(t *testing.T) {
+	tx, summaries := newSummaryTestRepo(t)
+	ctx := context.Background()
+	stops := repo.NewStopRepo(tx)
+
+	older := factory.Trip().WithName("Spring").WithStartDate(domain.NewDate(2024, 4, 1)).Insert(t, tx)
+	newer := factory.Trip().WithName("Fall").WithStartDate(domain.NewDate(2024, 9, 1)).Insert(t, tx)
+	departed := func(at time.Time) *time.Time { return &at }
+
+	factory.Stop().WithTripID(older.ID).
+		WithArrivedAt(day(2024, 4, 1).Add(15*time.Hour)).WithDepartedAt(departed(day(2024, 4, 4).Add(10*time.Hour))).Insert(t, tx)
+	moving := factory.Stop().WithTripID(older.ID).
+		WithArrivedAt(day(2024, 4, 4).Add(14*time.Hour)).WithDepartedAt(departed(day(2024, 4, 6).Add(9*time.Hour))).Insert(t, tx)
+	current := factory.Stop().WithTripID(older.ID).WithArrivedAt(day(2024, 4, 6).Add(12*time.Hour)).Insert(t, tx)
+
+	got, err := summaries.ListTrips(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, newer.ID, got[0].TripID, "newest trip first")
+	assert.Zero(t, got[0].Stops)
+	assert.Nil(t, got[0].FirstArrivedAt)
+	assert.Equal(t, "Spring", got[1].Name)
+	assert.Equal(t, 3, got[1].Stops)
+	assert.Equal(t, 3+2+1, got[1].Nights, "the stop not yet departed counts one night")
+	require.NotNil(t, got[1].FirstArrivedAt)
+	assert.True(t, day(2024, 4, 1).Add(15*time.Hour).Equal(*got[1].FirstArrivedAt))
+	assert.True(t, current.ArrivedAt.Equal(*got[1].LastArrivedAt))
+
+	// Moving a stop to the other trip updates both summaries; deleting one
+	// takes it off.
+	_, err = tx.Exec(ctx, `UPDATE stops SET trip_id = $1 WHERE id = $2`, newer.ID, moving.ID)
+	require.NoError(t, err)
+	require.NoError(t, stops.Delete(ctx, older.ID, current.ID))
+
+	got, err = summaries.ListTrips(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got[0].Stops)
+	assert.Equal(t, 2, got[0].Nights)
+	assert.Equal(t, 1, got[1].Stops)
+	assert.Equal(t, 3, got[1].Nights)
+	assert.True(t, got[1].FirstArrivedAt.Equal(*got[1].LastArrivedAt))
+}
+
+func TestSummaryRepo_ListTrips_DeletedTrip(t *testing.T) {
+	tx, summaries := newSummaryTestRepo(t)
+	ctx := context.Background()
+
+	stop := factory.Stop().Insert(t, tx)
+	require.NoError(t, repo.NewTripRepo(tx).Delete(ctx, stop.TripID))
+
+	got, err := summaries.ListTrips(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestSummaryRepo_TopTags(t *testing.T) {
+	tx, summaries := newSummaryTestRepo(t)
+	ctx := context.Background()
+	tags := repo.NewTagRepo(tx)
+
+	factory.Stop().WithTags("Mountains", "Lakes").Insert(t, tx)
+	factory.Stop().WithTags("Mountains").Insert(t, tx)
+	desert := factory.Stop().WithTags("Desert", "Mountains").Insert(t, tx)
+	factory.Tag().WithName("Unused").Insert(t, tx)
+
+	got, err := summaries.TopTags(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.TagCount{
+		{Slug: "mountains", Name: "Mountains", Stops: 3},
+		{Slug: "desert", Name: "Desert", Stops: 1},
+		{Slug: "lakes", Name: "Lakes", Stops: 1},
+	}, got, "unused tags are left out; ties by slug")
+
+	// Untagging and deleting a stop both count down.
+	require.NoError(t, tags.RemoveFromStop(ctx, desert.ID, "desert"))
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, desert.TripID, desert.ID))
+
+	got, err = summaries.TopTags(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.TagCount{{Slug: "mountains", Name: "Mountains", Stops: 2}}, got)
+}
@@ -42,9 +42,27 @@ type TagRepo interface {
 	UpdateName(ctx context.Context, slug, name string) (domain.Tag, error)
 
 	// Delete permanently removes a tag by slug.
-	// All stop_tags rows referencing this tag are removed via ON DELETE CASCADE.
+	// All stop_tags and poi_tags rows referencing this tag are removed via ON DELETE CASCADE.
 	// Returns domain.ErrNotFound if no tag with that slug exists.
 	Delete(ctx context.Context, slug string) error
+
+	// Merge moves every stop and point of interest tagged fromSlug over to
+	// intoSlug, then deletes fromSlug, and returns the surviving tag.
+	// Returns domain.ErrNotFound if either tag does not exist.
+	Merge(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error)
+
+	// SetParent puts the tag identified by slug under parentSlug, or at the
+	// top level when parentSlug is empty. It does not check for cycles.
+	// Returns domain.ErrNotFound if either tag does not exist.
+	SetParent(ctx context.Context, slug, parentSlug string) (domain.Tag, error)
+
+	// Subtree returns the slugs of the tag and of every tag beneath it, the
+	// tag's own first. Returns domain.ErrNotFound if the tag does not exist.
+	Subtree(ctx context.Context, slug string) ([]string, error)
+
+	// Stats returns every tag with its stop counts, the tags on the most
+	// stops (counting the tags beneath them) first and then by slug.
+	Stats(ctx context.Context) ([]domain.TagStats, error)
 }
 
 // pgTagRepo is the Postgres implementation of TagRepo.
@@ -63,12 +81,12 @@ func NewTagRepo(db db) TagRepo {
 // nothing on DO NOTHING conflicts.
 func (r *pgTagRepo) Upsert(ctx context.Context, name, slug string) (domain.Tag, error) {
 	const q = `
-		INSERT INTO tags (name, slug)
-		VALUES (@name, @slug)
-		ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
-		RETURNING id, name, slug, created_at`
+		INSERT INTO tags (organization_id, name, slug)
+		VALUES (@organization_id, @name, @slug)
+		ON CONFLICT (organization_id, slug) DO UPDATE SET slug = EXCLUDED.slug
+		RETURNING id, name, slug, created_at, ` + parentSlugSQL
 
-	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{"name": name, "slug": slug})
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"name": name, "slug": slug}))
 	result, err := scanTag(row)
 	if err != nil {
 		return domain.Tag{}, fmt.Errorf("repo.TagRepo.Upsert: %w", err)
@@ -80,12 +98,12 @@ func (r *pgTagRepo) Upsert(ctx context.Context, name, slug string) (domain.Tag,
 // Pass prefix="" to return all tags.
 func (r *pgTagRepo) List(ctx context.Context, prefix string) ([]domain.Tag, error) {
 	const q = `
-		SELECT id, name, slug, created_at
+		SELECT id, name, slug, created_at, ` + parentSlugSQL + `
 		FROM tags
-		WHERE slug LIKE @prefix || '%'
+		WHERE organization_id = @organization_id AND slug LIKE @prefix || '%'
 		ORDER BY slug`
 
-	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"prefix": prefix})
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"prefix": prefix}))
 	if err != nil {
 		return nil, fmt.Errorf("repo.TagRepo.List: %w", err)
 	}
@@ -109,25 +127,25 @@ func (r *pgTagRepo) List(ctx context.Context, prefix string) ([]domain.Tag, erro
 // together with the total matching count across all pages.
 // Pass prefix="" to include all tags.
 func (r *pgTagRepo) ListPaged(ctx context.Context, prefix string, p domain.PaginationParams) ([]domain.Tag, int64, error) {
-	const countQ = `SELECT COUNT(*) FROM tags WHERE slug LIKE @prefix || '%'`
+	const countQ = `SELECT COUNT(*) FROM tags WHERE organization_id = @organization_id AND slug LIKE @prefix || '%'`
 
 	var total int64
-	if err := r.db.QueryRow(ctx, countQ, pgx.NamedArgs{"prefix": prefix}).Scan(&total); err != nil {
+	if err := r.db.QueryRow(ctx, countQ, scoped(ctx, pgx.NamedArgs{"prefix": prefix})).Scan(&total); err != nil {
 		return nil, 0, fmt.Errorf("repo.TagRepo.ListPaged: count: %w", err)
 	}
 
 	const q = `
-		SELECT id, name, slug, created_at
+		SELECT id, name, slug, created_at, ` + parentSlugSQL + `
 		FROM tags
-		WHERE slug LIKE @prefix || '%'
+		WHERE organization_id = @organization_id AND slug LIKE @prefix || '%'
 		ORDER BY slug
 		LIMIT @limit OFFSET @offset`
 
-	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{
 		"prefix": prefix,
 		"limit":  p.Limit,
 		"offset": p.Offset(),
-	})
+	}))
 	if err != nil {
 		return nil, 0, fmt.Errorf("repo.TagRepo.ListPaged: query: %w", err)
 	}
@@ -167,9 +185,9 @@ func (r *pgTagRepo) RemoveFromStop(ctx context.Context, stopID uuid.UUID, slug s
 	const q = `
 		DELETE FROM stop_tags
 		WHERE stop_id = @stop_id
-		  AND tag_id = (SELECT id FROM tags WHERE slug = @slug)`
+		  AND tag_id = (SELECT id FROM tags WHERE organization_id = @organization_id AND slug = @slug)`
 
-	tag, err := r.db.Exec(ctx, q, pgx.NamedArgs{"stop_id": stopID, "slug": slug})
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID, "slug": slug}))
 	if err != nil {
 		return fmt.Errorf("repo.TagRepo.RemoveFromStop: %w", err)
 	}
@@ -182,13 +200,13 @@ func (r *pgTagRepo) RemoveFromStop(ctx context.Context, stopID uuid.UUID, slug s
 // ListByStop returns all tags linked to a stop, ordered by slug.
 func (r *pgTagRepo) ListByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Tag, error) {
 	const q = `
-		SELECT t.id, t.name, t.slug, t.created_at
-		FROM tags t
-		JOIN stop_tags st ON st.tag_id = t.id
-		WHERE st.stop_id = @stop_id
-		ORDER BY t.slug`
+		SELECT tags.id, tags.name, tags.slug, tags.created_at, ` + parentSlugSQL + `
+		FROM tags
+		JOIN stop_tags st ON st.tag_id = tags.id
+		WHERE st.stop_id = @stop_id AND tags.organization_id = @organization_id
+		ORDER BY tags.slug`
 
-	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"stop_id": stopID})
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID}))
 	if err != nil {
 		return nil, fmt.Errorf("repo.TagRepo.ListByStop: %w", err)
 	}
@@ -214,10 +232,10 @@ func (r *pgTagRepo) UpdateName(ctx context.Context, slug, name string) (domain.T
 	const q = `
 		UPDATE tags
 		SET name = @name
-		WHERE slug = @slug
-		RETURNING id, name, slug, created_at`
+		WHERE organization_id = @organization_id AND slug = @slug
+		RETURNING id, name, slug, created_at, ` + parentSlugSQL
 
-	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{"slug": slug, "name": name})
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"slug": slug, "name": name}))
 	result, err := scanTag(row)
 	if err != nil {
 		return domain.Tag{}, fmt.Errorf("repo.TagRepo.UpdateName: %w", err)
@@ -227,9 +245,9 @@ func (r *pgTagRepo) UpdateName(ctx context.Context, slug, name string) (domain.T
 
 // Delete permanently removes a tag by slug.
 func (r *pgTagRepo) Delete(ctx context.Context, slug string) error {
-	const q = `DELETE FROM tags WHERE slug = @slug`
+	const q = `DELETE FROM tags WHERE organization_id = @organization_id AND slug = @slug`
 
-	tag, err := r.db.Exec(ctx, q, pgx.NamedArgs{"slug": slug})
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"slug": slug}))
 	if err != nil {
 		return fmt.Errorf("repo.TagRepo.Delete: %w", err)
 	}
@@ -239,13 +257,162 @@ func (r *pgTagRepo) Delete(ctx context.Context, slug string) error {
 	return nil
 }
 
-// scanTag maps a single database row into a domain.Tag.
+// Merge relinks fromSlug's stops and points of interest to intoSlug, moves
+// the tags under fromSlug to intoSlug, and deletes fromSlug in one statement.
+// Records already carrying both tags keep one link; the old links go with the
+// deleted tag via ON DELETE CASCADE.
+// The caller must not merge a tag into one beneath it.
+func (r *pgTagRepo) Merge(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error) {
+	const q = `
+		WITH src AS (
+			SELECT id FROM tags WHERE organization_id = @organization_id AND slug = @from
+		), dst AS (
+			SELECT id, name, slug, created_at, ` + parentSlugSQL + ` AS parent
+			FROM tags WHERE organization_id = @organization_id AND slug = @into
+		), children_moved AS (
+			UPDATE tags c SET parent_id = dst.id FROM src, dst WHERE c.parent_id = src.id AND c.id <> dst.id
+		), stops_moved AS (
+			INSERT INTO stop_tags (stop_id, tag_id)
+			SELECT st.stop_id, dst.id FROM stop_tags st, src, dst WHERE st.tag_id = src.id
+			ON CONFLICT DO NOTHING
+		), pois_moved AS (
+			INSERT INTO poi_tags (poi_id, tag_id)
+			SELECT pt.poi_id, dst.id FROM poi_tags pt, src, dst WHERE pt.tag_id = src.id
+			ON CONFLICT DO NOTHING
+		), removed AS (
+			DELETE FROM tags t USING src, dst WHERE t.id = src.id
+		)
+		SELECT dst.id, dst.name, dst.slug, dst.created_at, dst.parent FROM dst, src`
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"from": fromSlug, "into": intoSlug}))
+	result, err := scanTag(row)
+	if err != nil {
+		return domain.Tag{}, fmt.Errorf("repo.TagRepo.Merge: %w", err)
+	}
+	return result, nil
+}
+
+// SetParent sets or clears the tag's parent_id. Setting it joins the parent
+// by slug, so a missing parent updates no row, just as a missing tag does.
+func (r *pgTagRepo) SetParent(ctx context.Context, slug, parentSlug string) (domain.Tag, error) {
+	q := `
+		UPDATE tags
+		SET parent_id = NULL
+		WHERE organization_id = @organization_id AND slug = @slug
+		RETURNING id, name, slug, created_at, NULL::text`
+	if parentSlug != "" {
+		q = `
+			UPDATE tags
+			SET parent_id = p.id
+			FROM tags p
+			WHERE tags.organization_id = @organization_id AND tags.slug = @slug
+			  AND p.organization_id = @organization_id AND p.slug = @parent
+			RETURNING tags.id, tags.name, tags.slug, tags.created_at, p.slug`
+	}
+
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"slug": slug, "parent": parentSlug}))
+	result, err := scanTag(row)
+	if err != nil {
+		return domain.Tag{}, fmt.Errorf("repo.TagRepo.SetParent: %w", err)
+	}
+	return result, nil
+}
+
+// Subtree walks down from the tag with a recursive query. UNION rather than
+// UNION ALL stops the walk should the hierarchy ever hold a cycle.
+func (r *pgTagRepo) Subtree(ctx context.Context, slug string) ([]string, error) {
+	const q = `
+		WITH RECURSIVE subtree (id) AS (
+			SELECT id FROM tags WHERE organization_id = @organization_id AND slug = @slug
+			UNION
+			SELECT c.id FROM tags c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT t.slug FROM subtree JOIN tags t ON t.id = subtree.id
+		ORDER BY t.slug <> @slug, t.slug`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"slug": slug}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TagRepo.Subtree: %w", err)
+	}
+	defer rows.Close()
+
+	var slugs []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, fmt.Errorf("repo.TagRepo.Subtree: scan: %w", err)
+		}
+		slugs = append(slugs, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TagRepo.Subtree: rows: %w", err)
+	}
+	if len(slugs) == 0 {
+		return nil, fmt.Errorf("repo.TagRepo.Subtree: %w", domain.ErrNotFound)
+	}
+	return slugs, nil
+}
+
+// Stats reads each tag's direct count from tag_summaries and rolls up the
+// distinct stops across the tag and everything beneath it from stop_tags.
+func (r *pgTagRepo) Stats(ctx context.Context) ([]domain.TagStats, error) {
+	const q = `
+		WITH RECURSIVE subtree (root, id) AS (
+			SELECT id, id FROM tags WHERE organization_id = @organization_id
+			UNION
+			SELECT s.root, c.id FROM tags c JOIN subtree s ON c.parent_id = s.id
+		), rolled AS (
+			SELECT s.root, count(DISTINCT st.stop_id) AS total
+			FROM subtree s JOIN stop_tags st ON st.tag_id = s.id
+			GROUP BY s.root
+		)
+		SELECT tags.id, tags.name, tags.slug, tags.created_at, ` + parentSlugSQL + `,
+		       coalesce(ts.stop_count, 0), coalesce(rolled.total, 0) AS total
+		FROM tags
+		LEFT JOIN tag_summaries ts ON ts.tag_id = tags.id
+		LEFT JOIN rolled ON rolled.root = tags.id
+		WHERE tags.organization_id = @organization_id
+		ORDER BY total DESC, tags.slug`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TagRepo.Stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []domain.TagStats{}
+	for rows.Next() {
+		var (
+			st     domain.TagStats
+			id     pgtype.UUID
+			parent pgtype.Text
+		)
+		if err := rows.Scan(&id, &st.Name, &st.Slug, &st.CreatedAt, &parent, &st.Stops, &st.TotalStops); err != nil {
+			return nil, fmt.Errorf("repo.TagRepo.Stats: scan: %w", err)
+		}
+		st.ID = uuid.UUID(id.Bytes)
+		st.Parent = parent.String
+		stats = append(stats, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TagRepo.Stats: rows: %w", err)
+	}
+	return stats, nil
+}
+
+// parentSlugSQL selects the slug of the parent of the tags row in scope, or
+// NULL for a top-level tag.
+const parentSlugSQL = `(SELECT p.slug FROM tags p WHERE p.id = tags.parent_id)`
+
+// scanTag maps a single database row into a domain.Tag. The row's columns
+// are id, name, slug, created_at, and the parent's slug.
 func scanTag(s scanner) (domain.Tag, error) {
 	var (
-		t  domain.Tag
-		id pgtype.UUID
+		t      domain.Tag
+		id     pgtype.UUID
+		parent pgtype.Text
 	)
-	err := s.Scan(&id, &t.Name, &t.Slug, &t.CreatedAt)
+	err := s.Scan(&id, &t.Name, &t.Slug, &t.CreatedAt, &parent)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Tag{}, domain.ErrNotFound
@@ -253,5 +420,6 @@ func scanTag(s scanner) (domain.Tag, error) {
 		return domain.Tag{}, err
 	}
 	t.ID = uuid.UUID(id.Bytes)
+	t.Parent = parent.String
 	return t, nil
 }
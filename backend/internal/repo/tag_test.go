@@ -4,20 +4,23 @@ package repo_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
 )
 
-// newTestTagRepos opens a single transaction and returns TripRepo, StopRepo,
-// and TagRepo all backed by the same tx — so tests can create full hierarchies
-// (trip → stop → tag) within one rolled-back transaction.
-func newTestTagRepos(t *testing.T) (repo.TripRepo, repo.StopRepo, repo.TagRepo) {
+// newTestTagRepos opens a single transaction and returns it with a StopRepo and
+// TagRepo backed by the same tx — so tests can create full hierarchies
+// (trip → stop → tag) with testutil/factory within one rolled-back transaction.
+func newTestTagRepos(t *testing.T) (pgx.Tx, repo.StopRepo, repo.TagRepo) {
 	t.Helper()
 	pool := testutil.NewPool(t)
 
@@ -28,7 +31,7 @@ func newTestTagRepos(t *testing.T) (repo.TripRepo, repo.StopRepo, repo.TagRepo)
 		_ = tx.Rollback(context.Background())
 	})
 
-	return repo.NewTripRepo(tx), repo.NewStopRepo(tx), repo.NewTagRepo(tx)
+	return tx, repo.NewStopRepo(tx), repo.NewTagRepo(tx)
 }
 
 // ---- Upsert ----------------------------------------------------------------
@@ -111,11 +114,11 @@ func TestTagRepo_List_Empty(t *testing.T) {
 // ---- AddToStop / RemoveFromStop / ListByStop -------------------------------
 
 func TestTagRepo_AddToStop(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	stop, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	stop, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 	tag, err := tagRepo.Upsert(ctx, "Mountains", "mountains")
 	require.NoError(t, err)
@@ -126,11 +129,11 @@ func TestTagRepo_AddToStop(t *testing.T) {
 }
 
 func TestTagRepo_AddToStop_Idempotent(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	stop, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	stop, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 	tag, err := tagRepo.Upsert(ctx, "Mountains", "mountains")
 	require.NoError(t, err)
@@ -142,11 +145,11 @@ func TestTagRepo_AddToStop_Idempotent(t *testing.T) {
 }
 
 func TestTagRepo_ListByStop(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	stop, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	stop, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	tag1, err := tagRepo.Upsert(ctx, "Mountains", "mountains")
@@ -163,11 +166,11 @@ func TestTagRepo_ListByStop(t *testing.T) {
 }
 
 func TestTagRepo_ListByStop_Empty(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	stop, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	stop, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	got, err := tagRepo.ListByStop(ctx, stop.ID)
@@ -178,11 +181,11 @@ func TestTagRepo_ListByStop_Empty(t *testing.T) {
 }
 
 func TestTagRepo_RemoveFromStop(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	stop, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	stop, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 	tag, err := tagRepo.Upsert(ctx, "Mountains", "mountains")
 	require.NoError(t, err)
@@ -197,11 +200,11 @@ func TestTagRepo_RemoveFromStop(t *testing.T) {
 }
 
 func TestTagRepo_RemoveFromStop_NotFound(t *testing.T) {
-	tripRepo, stopRepo, tagRepo := newTestTagRepos(t)
+	tx, stopRepo, tagRepo := newTestTagRepos(t)
 	ctx := context.Background()
 
-	parent := mustCreateTrip(t, tripRepo)
-	stop, err := stopRepo.Create(ctx, stopFixture(parent.ID))
+	parent := factory.Trip().Insert(t, tx)
+	stop, err := stopRepo.Create(ctx, factory.Stop().WithTripID(parent.ID).Build())
 	require.NoError(t, err)
 
 	err = tagRepo.RemoveFromStop(ctx, stop.ID, "nonexistent-slug")
@@ -260,3 +263,156 @@ func TestTagRepo_Delete_NotFound(t *testing.T) {
 
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
+
+// ---- Merge -----------------------------------------------------------------
+
+func TestTagRepo_Merge_MovesStops(t *testing.T) {
+	tx, _, tagRepo := newTestTagRepos(t)
+	ctx := context.Background()
+
+	trip := factory.Trip().Insert(t, tx)
+	onlyOld := factory.Stop().WithTripID(trip.ID).WithTags("Wal-Mart").Insert(t, tx)
+	both := factory.Stop().WithTripID(trip.ID).WithTags("Wal-Mart", "Walmart").Insert(t, tx)
+
+	got, err := tagRepo.Merge(ctx, "wal-mart", "walmart")
+
+	require.NoError(t, err)
+	assert.Equal(t, "walmart", got.Slug)
+	for _, stop := range []domain.Stop{onlyOld, both} {
+		tags, err := tagRepo.ListByStop(ctx, stop.ID)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "walmart", tags[0].Slug)
+	}
+	gone, err := tagRepo.List(ctx, "wal-mart")
+	require.NoError(t, err)
+	assert.Empty(t, gone)
+}
+
+func TestTagRepo_Merge_NotFound(t *testing.T) {
+	_, _, tagRepo := newTestTagRepos(t)
+	ctx := context.Background()
+
+	_, err := tagRepo.Upsert(ctx, "Walmart", "walmart")
+	require.NoError(t, err)
+
+	_, err = tagRepo.Merge(ctx, "nonexistent-slug", "walmart")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = tagRepo.Merge(ctx, "walmart", "nonexistent-slug")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	tags, err := tagRepo.List(ctx, "walmart")
+	require.NoError(t, err)
+	assert.Len(t, tags, 1, "a failed merge must not delete the source tag")
+}
+
+func TestTagRepo_Merge_MovesChildren(t *testing.T) {
+	_, _, tagRepo := newTestTagRepos(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"Wal-Mart", "Walmart", "Supercenter"} {
+		_, err := tagRepo.Upsert(ctx, name, toSlug(name))
+		require.NoError(t, err)
+	}
+	_, err := tagRepo.SetParent(ctx, "supercenter", "wal-mart")
+	require.NoError(t, err)
+
+	_, err = tagRepo.Merge(ctx, "wal-mart", "walmart")
+	require.NoError(t, err)
+
+	tags, err := tagRepo.List(ctx, "supercenter")
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "walmart", tags[0].Parent)
+}
+
+// ---- SetParent / Subtree ----------------------------------------------------
+
+func TestTagRepo_SetParent(t *testing.T) {
+	_, _, tagRepo := newTestTagRepos(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"Public Lands", "National Park"} {
+		_, err := tagRepo.Upsert(ctx, name, toSlug(name))
+		require.NoError(t, err)
+	}
+
+	got, err := tagRepo.SetParent(ctx, "national-park", "public-lands")
+	require.NoError(t, err)
+	assert.Equal(t, "public-lands", got.Parent)
+
+	listed, err := tagRepo.List(ctx, "national-park")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "public-lands", listed[0].Parent)
+
+	got, err = tagRepo.SetParent(ctx, "national-park", "")
+	require.NoError(t, err)
+	assert.Empty(t, got.Parent)
+
+	_, err = tagRepo.SetParent(ctx, "national-park", "nonexistent-slug")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = tagRepo.SetParent(ctx, "nonexistent-slug", "public-lands")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTagRepo_Subtree(t *testing.T) {
+	_, _, tagRepo := newTestTagRepos(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"Public Lands", "National Park", "Yellowstone", "BLM", "Walmart"} {
+		_, err := tagRepo.Upsert(ctx, name, toSlug(name))
+		require.NoError(t, err)
+	}
+	for child, parent := range map[string]string{"national-park": "public-lands", "yellowstone": "national-park", "blm": "public-lands"} {
+		_, err := tagRepo.SetParent(ctx, child, parent)
+		require.NoError(t, err)
+	}
+
+	got, err := tagRepo.Subtree(ctx, "public-lands")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public-lands", "blm", "national-park", "yellowstone"}, got, "the tag first")
+
+	got, err = tagRepo.Subtree(ctx, "walmart")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"walmart"}, got)
+
+	_, err = tagRepo.Subtree(ctx, "nonexistent-slug")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// ---- Stats -----------------------------------------------------------------
+
+func TestTagRepo_Stats_RollsUp(t *testing.T) {
+	tx, _, tagRepo := newTestTagRepos(t)
+	ctx := context.Background()
+
+	trip := factory.Trip().Insert(t, tx)
+	factory.Stop().WithTripID(trip.ID).WithTags("Stats National Park").Insert(t, tx)
+	factory.Stop().WithTripID(trip.ID).WithTags("Stats National Park", "Stats Public Lands").Insert(t, tx)
+	factory.Stop().WithTripID(trip.ID).WithTags("Stats BLM").Insert(t, tx)
+	_, err := tagRepo.Upsert(ctx, "Stats Unused", "stats-unused")
+	require.NoError(t, err)
+	for _, child := range []string{"stats-national-park", "stats-blm"} {
+		_, err := tagRepo.SetParent(ctx, child, "stats-public-lands")
+		require.NoError(t, err)
+	}
+
+	stats, err := tagRepo.Stats(ctx)
+	require.NoError(t, err)
+
+	bySlug := map[string]domain.TagStats{}
+	for _, s := range stats {
+		bySlug[s.Slug] = s
+	}
+	assert.Equal(t, 1, bySlug["stats-public-lands"].Stops)
+	assert.Equal(t, 3, bySlug["stats-public-lands"].TotalStops, "a stop carrying parent and child counts once")
+	assert.Equal(t, 2, bySlug["stats-national-park"].TotalStops)
+	assert.Equal(t, "stats-public-lands", bySlug["stats-national-park"].Parent)
+	assert.Zero(t, bySlug["stats-unused"].TotalStops)
+}
+
+// toSlug mirrors the service's slug normalization for the simple names used here.
+func toSlug(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", "-"))
+}
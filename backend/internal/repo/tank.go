@@ -0,0 +1,252 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TankRepo defines the persistence operations for tank levels and dump events.
+// Both belong to a stop; callers check the stop exists and belongs to the trip.
+type TankRepo interface {
+	// CreateLevel inserts a tank reading and returns the persisted record.
+	CreateLevel(ctx context.Context, level domain.TankLevel) (domain.TankLevel, error)
+
+	// ListLevelsByStop returns a stop's tank readings, oldest first.
+	ListLevelsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.TankLevel, error)
+
+	// DeleteLevel removes a tank reading from a stop.
+	// Returns domain.ErrNotFound if the stop has no reading with that ID.
+	DeleteLevel(ctx context.Context, stopID, id uuid.UUID) error
+
+	// CreateDump inserts a dump event and returns the persisted record.
+	CreateDump(ctx context.Context, dump domain.DumpEvent) (domain.DumpEvent, error)
+
+	// ListDumpsByStop returns a stop's dump events, oldest first.
+	ListDumpsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.DumpEvent, error)
+
+	// DeleteDump removes a dump event from a stop.
+	// Returns domain.ErrNotFound if the stop has no event with that ID.
+	DeleteDump(ctx context.Context, stopID, id uuid.UUID) error
+
+	// LatestDump returns the newest dump event at or before at, across the
+	// organization's stops, or nil if there is none.
+	LatestDump(ctx context.Context, at time.Time) (*domain.DumpEvent, error)
+}
+
+// pgTankRepo is the Postgres implementation of TankRepo.
+type pgTankRepo struct {
+	db db
+}
+
+// NewTankRepo constructs a TankRepo backed by the provided db connection.
+func NewTankRepo(db db) TankRepo {
+	return &pgTankRepo{db: db}
+}
+
+const (
+	tankLevelColumns = `id, stop_id, recorded_at, fresh_pct, grey_pct, black_pct, notes, created_at`
+	dumpEventColumns = `id, stop_id, dumped_at, location, notes, created_at`
+)
+
+// CreateLevel inserts a tank_levels row and returns the full persisted record.
+func (r *pgTankRepo) CreateLevel(ctx context.Context, level domain.TankLevel) (domain.TankLevel, error) {
+	const q = `
+		INSERT INTO tank_levels (stop_id, recorded_at, fresh_pct, grey_pct, black_pct, notes)
+		VALUES (@stop_id, @recorded_at, @fresh_pct, @grey_pct, @black_pct, @notes)
+		RETURNING ` + tankLevelColumns
+
+	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"stop_id":     level.StopID,
+		"recorded_at": level.RecordedAt,
+		"fresh_pct":   level.FreshPct, // nil becomes NULL
+		"grey_pct":    level.GreyPct,
+		"black_pct":   level.BlackPct,
+		"notes":       nullableString(level.Notes),
+	})
+	result, err := scanTankLevel(row)
+	if err != nil {
+		return domain.TankLevel{}, fmt.Errorf("repo.TankRepo.CreateLevel: %w", err)
+	}
+	return result, nil
+}
+
+// ListLevelsByStop returns a stop's tank readings in time order.
+func (r *pgTankRepo) ListLevelsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.TankLevel, error) {
+	const q = `
+		SELECT ` + tankLevelColumns + `
+		FROM tank_levels
+		WHERE stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		ORDER BY recorded_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TankRepo.ListLevelsByStop: %w", err)
+	}
+	defer rows.Close()
+
+	levels := []domain.TankLevel{}
+	for rows.Next() {
+		level, err := scanTankLevel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.TankRepo.ListLevelsByStop: scan: %w", err)
+		}
+		levels = append(levels, level)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TankRepo.ListLevelsByStop: rows: %w", err)
+	}
+	return levels, nil
+}
+
+// DeleteLevel removes a tank reading, scoped to its stop.
+func (r *pgTankRepo) DeleteLevel(ctx context.Context, stopID, id uuid.UUID) error {
+	const q = `DELETE FROM tank_levels WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID}))
+	if err != nil {
+		return fmt.Errorf("repo.TankRepo.DeleteLevel: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.TankRepo.DeleteLevel: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateDump inserts a dump_events row and returns the full persisted record.
+func (r *pgTankRepo) CreateDump(ctx context.Context, dump domain.DumpEvent) (domain.DumpEvent, error) {
+	const q = `
+		INSERT INTO dump_events (stop_id, dumped_at, location, notes)
+		VALUES (@stop_id, @dumped_at, @location, @notes)
+		RETURNING ` + dumpEventColumns
+
+	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"stop_id":   dump.StopID,
+		"dumped_at": dump.DumpedAt,
+		"location":  nullableString(dump.Location),
+		"notes":     nullableString(dump.Notes),
+	})
+	result, err := scanDumpEvent(row)
+	if err != nil {
+		return domain.DumpEvent{}, fmt.Errorf("repo.TankRepo.CreateDump: %w", err)
+	}
+	return result, nil
+}
+
+// ListDumpsByStop returns a stop's dump events in time order.
+func (r *pgTankRepo) ListDumpsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.DumpEvent, error) {
+	const q = `
+		SELECT ` + dumpEventColumns + `
+		FROM dump_events
+		WHERE stop_id = @stop_id AND stop_id IN ` + orgStopsSQL + `
+		ORDER BY dumped_at, id`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"stop_id": stopID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TankRepo.ListDumpsByStop: %w", err)
+	}
+	defer rows.Close()
+
+	dumps := []domain.DumpEvent{}
+	for rows.Next() {
+		dump, err := scanDumpEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.TankRepo.ListDumpsByStop: scan: %w", err)
+		}
+		dumps = append(dumps, dump)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TankRepo.ListDumpsByStop: rows: %w", err)
+	}
+	return dumps, nil
+}
+
+// DeleteDump removes a dump event, scoped to its stop.
+func (r *pgTankRepo) DeleteDump(ctx context.Context, stopID, id uuid.UUID) error {
+	const q = `DELETE FROM dump_events WHERE id = @id AND stop_id = @stop_id AND stop_id IN ` + orgStopsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "stop_id": stopID}))
+	if err != nil {
+		return fmt.Errorf("repo.TankRepo.DeleteDump: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.TankRepo.DeleteDump: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// LatestDump returns the newest dump event at or before at.
+func (r *pgTankRepo) LatestDump(ctx context.Context, at time.Time) (*domain.DumpEvent, error) {
+	const q = `
+		SELECT ` + dumpEventColumns + `
+		FROM dump_events
+		WHERE dumped_at <= @at AND stop_id IN ` + orgStopsSQL + `
+		ORDER BY dumped_at DESC
+		LIMIT 1`
+
+	dump, err := scanDumpEvent(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"at": at})))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.TankRepo.LatestDump: %w", err)
+	}
+	return &dump, nil
+}
+
+// scanTankLevel maps a single tank_levels row into a domain.TankLevel.
+func scanTankLevel(s scanner) (domain.TankLevel, error) {
+	var (
+		l      domain.TankLevel
+		id     pgtype.UUID
+		stopID pgtype.UUID
+		notes  *string
+	)
+	err := s.Scan(&id, &stopID, &l.RecordedAt, &l.FreshPct, &l.GreyPct, &l.BlackPct, &notes, &l.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TankLevel{}, domain.ErrNotFound
+		}
+		return domain.TankLevel{}, err
+	}
+	l.ID = uuid.UUID(id.Bytes)
+	l.StopID = uuid.UUID(stopID.Bytes)
+	if notes != nil {
+		l.Notes = *notes
+	}
+	return l, nil
+}
+
+// scanDumpEvent maps a single dump_events row into a domain.DumpEvent.
+func scanDumpEvent(s scanner) (domain.DumpEvent, error) {
+	var (
+		d        domain.DumpEvent
+		id       pgtype.UUID
+		stopID   pgtype.UUID
+		location *string
+		notes    *string
+	)
+	err := s.Scan(&id, &stopID, &d.DumpedAt, &location, &notes, &d.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DumpEvent{}, domain.ErrNotFound
+		}
+		return domain.DumpEvent{}, err
+	}
+	d.ID = uuid.UUID(id.Bytes)
+	d.StopID = uuid.UUID(stopID.Bytes)
+	if location != nil {
+		d.Location = *location
+	}
+	if notes != nil {
+		d.Notes = *notes
+	}
+	return d, nil
+}
@@ -0,0 +1,134 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// newTankTestRepo returns a TankRepo and its rolled-back transaction, so
+// parent trips and stops can be inserted with testutil/factory.
+func newTankTestRepo(t *testing.T) (pgx.Tx, repo.TankRepo) {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return tx, repo.NewTankRepo(tx)
+}
+
+func TestTankRepo_Levels(t *testing.T) {
+	tx, tanks := newTankTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	t0 := time.Date(2025, 6, 3, 18, 0, 0, 0, time.UTC)
+
+	fresh, black := 66, 33
+	later, err := tanks.CreateLevel(ctx, domain.TankLevel{StopID: stop.ID, RecordedAt: t0.Add(time.Hour), FreshPct: &fresh})
+	require.NoError(t, err)
+	_, err = tanks.CreateLevel(ctx, domain.TankLevel{StopID: stop.ID, RecordedAt: t0, BlackPct: &black, Notes: "after dinner"})
+	require.NoError(t, err)
+
+	got, err := tanks.ListLevelsByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.True(t, got[0].RecordedAt.Equal(t0), "oldest first")
+	require.NotNil(t, got[0].BlackPct)
+	assert.Equal(t, 33, *got[0].BlackPct)
+	assert.Nil(t, got[0].FreshPct)
+	assert.Equal(t, "after dinner", got[0].Notes)
+
+	assert.ErrorIs(t, tanks.DeleteLevel(ctx, uuid.New(), later.ID), domain.ErrNotFound, "scoped to the stop")
+	require.NoError(t, tanks.DeleteLevel(ctx, stop.ID, later.ID))
+	assert.ErrorIs(t, tanks.DeleteLevel(ctx, stop.ID, later.ID), domain.ErrNotFound)
+}
+
+func TestTankRepo_Dumps(t *testing.T) {
+	tx, tanks := newTankTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	t0 := time.Date(2025, 6, 5, 10, 15, 0, 0, time.UTC)
+
+	created, err := tanks.CreateDump(ctx, domain.DumpEvent{StopID: stop.ID, DumpedAt: t0, Location: "Love's"})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+
+	got, err := tanks.ListDumpsByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "Love's", got[0].Location)
+
+	require.NoError(t, tanks.DeleteDump(ctx, stop.ID, created.ID))
+	assert.ErrorIs(t, tanks.DeleteDump(ctx, stop.ID, created.ID), domain.ErrNotFound)
+}
+
+func TestTankRepo_LatestDump(t *testing.T) {
+	tx, tanks := newTankTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	first := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	second := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	// Far in the past so dumps left by other packages cannot be newer.
+	t0 := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := tanks.CreateDump(ctx, domain.DumpEvent{StopID: first.ID, DumpedAt: t0})
+	require.NoError(t, err)
+	_, err = tanks.CreateDump(ctx, domain.DumpEvent{StopID: second.ID, DumpedAt: t0.Add(48 * time.Hour)})
+	require.NoError(t, err)
+
+	got, err := tanks.LatestDump(ctx, t0.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, first.ID, got.StopID, "dumps after at are ignored")
+
+	none, err := tanks.LatestDump(ctx, t0.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, none)
+}
+
+func TestTankRepo_StopDeleteCascades(t *testing.T) {
+	tx, tanks := newTankTestRepo(t)
+	ctx := context.Background()
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+	grey := 50
+	_, err := tanks.CreateLevel(ctx, domain.TankLevel{StopID: stop.ID, RecordedAt: time.Now().UTC(), GreyPct: &grey})
+	require.NoError(t, err)
+	_, err = tanks.CreateDump(ctx, domain.DumpEvent{StopID: stop.ID, DumpedAt: time.Now().UTC()})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, trip.ID, stop.ID))
+
+	levels, err := tanks.ListLevelsByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+	dumps, err := tanks.ListDumpsByStop(ctx, stop.ID)
+	require.NoError(t, err)
+	assert.Empty(t, dumps)
+}
+
+func TestTankRepo_RejectsEmptyLevel(t *testing.T) {
+	tx, tanks := newTankTestRepo(t)
+	trip := factory.Trip().Insert(t, tx)
+	stop := factory.Stop().WithTripID(trip.ID).Insert(t, tx)
+
+	// The service validates first; the CHECK constraint is the backstop.
+	_, err := tanks.CreateLevel(context.Background(), domain.TankLevel{StopID: stop.ID, RecordedAt: time.Now().UTC()})
+	assert.Error(t, err)
+}
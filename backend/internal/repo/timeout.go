@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TimeoutDB gives every statement run through it its own deadline, so that
+// one runaway query gives its connection back to the pool after timeout
+// rather than holding it for as long as the request lives. The deadline is
+// taken from the caller's context, so a request that ends sooner still
+// cancels the query. Statements cut off by it fail with domain.ErrTimeout.
+type TimeoutDB struct {
+	db      db
+	timeout time.Duration
+}
+
+// NewTimeoutDB wraps db, usually the pool, for passing to repo constructors.
+// A timeout of zero or less leaves statements with the caller's deadline
+// alone.
+func NewTimeoutDB(db db, timeout time.Duration) *TimeoutDB {
+	return &TimeoutDB{db: db, timeout: timeout}
+}
+
+// Exec runs a statement that returns no rows.
+func (t *TimeoutDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	qctx, cancel := t.context(ctx)
+	defer cancel()
+	tag, err := t.db.Exec(qctx, sql, args...)
+	return tag, timeoutError(ctx, qctx, err)
+}
+
+// Query runs a statement and returns its rows. The deadline lasts until the
+// rows are closed.
+func (t *TimeoutDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	qctx, cancel := t.context(ctx)
+	rows, err := t.db.Query(qctx, sql, args...)
+	if err != nil {
+		cancel()
+		return nil, timeoutError(ctx, qctx, err)
+	}
+	return &timeoutRows{Rows: rows, ctx: ctx, qctx: qctx, cancel: cancel}, nil
+}
+
+// QueryRow runs a statement that returns at most one row. The deadline
+// lasts until the row is scanned.
+func (t *TimeoutDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	qctx, cancel := t.context(ctx)
+	return &timeoutRow{row: t.db.QueryRow(qctx, sql, args...), ctx: ctx, qctx: qctx, cancel: cancel}
+}
+
+func (t *TimeoutDB) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, t.timeout)
+}
+
+// timeoutError marks err as domain.ErrTimeout when the statement's own
+// deadline cut it off, as opposed to the caller's context ending.
+func timeoutError(ctx, qctx context.Context, err error) error {
+	if err == nil || errors.Is(err, domain.ErrTimeout) {
+		return err
+	}
+	if errors.Is(qctx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("%w: %w", domain.ErrTimeout, err)
+	}
+	return err
+}
+
+type timeoutRow struct {
+	row    pgx.Row
+	ctx    context.Context
+	qctx   context.Context
+	cancel context.CancelFunc
+}
+
+func (r *timeoutRow) Scan(dest ...any) error {
+	defer r.cancel()
+	return timeoutError(r.ctx, r.qctx, r.row.Scan(dest...))
+}
+
+type timeoutRows struct {
+	pgx.Rows
+	ctx    context.Context
+	qctx   context.Context
+	cancel context.CancelFunc
+}
+
+func (r *timeoutRows) Close() {
+	r.Rows.Close()
+	r.cancel()
+}
+
+func (r *timeoutRows) Err() error {
+	return timeoutError(r.ctx, r.qctx, r.Rows.Err())
+}
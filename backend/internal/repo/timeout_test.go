@@ -0,0 +1,55 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+func TestTimeoutDB_SlowQueryTimesOut(t *testing.T) {
+	db := repo.NewTimeoutDB(testutil.NewPool(t), 100*time.Millisecond)
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, `SELECT pg_sleep(5)`)
+	assert.ErrorIs(t, err, domain.ErrTimeout)
+
+	err = db.QueryRow(ctx, `SELECT pg_sleep(5)`).Scan(new(any))
+	assert.ErrorIs(t, err, domain.ErrTimeout)
+
+	rows, err := db.Query(ctx, `SELECT 1 FROM pg_sleep(5)`)
+	if err == nil {
+		for rows.Next() {
+		}
+		rows.Close()
+		err = rows.Err()
+	}
+	assert.ErrorIs(t, err, domain.ErrTimeout)
+}
+
+func TestTimeoutDB_FastQuerySucceeds(t *testing.T) {
+	db := repo.NewTimeoutDB(testutil.NewPool(t), time.Second)
+
+	var n int
+	require.NoError(t, db.QueryRow(context.Background(), `SELECT 42`).Scan(&n))
+	assert.Equal(t, 42, n)
+}
+
+func TestTimeoutDB_CallerCancelIsNotATimeout(t *testing.T) {
+	db := repo.NewTimeoutDB(testutil.NewPool(t), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := db.Exec(ctx, `SELECT pg_sleep(5)`)
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrTimeout)
+}
@@ -0,0 +1,216 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TrashRepo defines the operations on deleted trips and stops. TripRepo and
+// StopRepo Delete put rows in the trash; every other repo reads past them.
+type TrashRepo interface {
+	// List returns the trips, and the stops of live trips, deleted at or
+	// after since, most recently deleted first. The stops of a trashed trip
+	// are not listed: they come back with it.
+	List(ctx context.Context, since time.Time) ([]domain.TrashItem, error)
+
+	// Restore takes the trip or stop with the given ID out of the trash,
+	// provided it was deleted at or after since, and returns it as it was
+	// listed. Returns domain.ErrNotFound if no such item is in the trash and
+	// domain.ErrValidation for a stop whose trip is in the trash too.
+	Restore(ctx context.Context, id uuid.UUID, since time.Time) (domain.TrashItem, error)
+
+	// Purge deletes for good the trips and stops deleted before before, in
+	// every organization, along with everything that hangs off them, and
+	// records a tombstone for each trip and stop it removes. Returns the
+	// object keys of the route leg tracks, stop photos, and photo thumbnails that
+	// went with them, so the caller can remove the files.
+	Purge(ctx context.Context, before time.Time) (removedObjectKeys []string, err error)
+}
+
+// pgTrashRepo is the Postgres implementation of TrashRepo.
+type pgTrashRepo struct {
+	db db
+}
+
+// NewTrashRepo constructs a TrashRepo backed by the provided db connection.
+func NewTrashRepo(db db) TrashRepo {
+	return &pgTrashRepo{db: db}
+}
+
+// List reads trashed trips and individually trashed stops in one query.
+func (r *pgTrashRepo) List(ctx context.Context, since time.Time) ([]domain.TrashItem, error) {
+	const q = `
+		SELECT t.id, 'trip', t.name, t.id, t.name, t.deleted_at
+		FROM trips t
+		WHERE t.deleted_at >= @since AND t.organization_id = @organization_id
+		  AND (t.user_id IS NULL OR t.user_id = @user_id)
+		UNION ALL
+		SELECT s.id, 'stop', s.name, t.id, t.name, s.deleted_at
+		FROM stops s
+		JOIN trips t ON t.id = s.trip_id
+		WHERE s.deleted_at >= @since AND t.deleted_at IS NULL AND t.organization_id = @organization_id
+		  AND (t.user_id IS NULL OR t.user_id = @user_id)
+		ORDER BY 6 DESC, 1`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"since": since}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TrashRepo.List: %w", err)
+	}
+	defer rows.Close()
+
+	items := []domain.TrashItem{}
+	for rows.Next() {
+		item, err := scanTrashItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.TrashRepo.List: scan: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TrashRepo.List: rows: %w", err)
+	}
+	return items, nil
+}
+
+// Restore tries the ID as a trip, then as a stop. Each UPDATE joins the row
+// to itself so RETURNING can report the deleted_at it is clearing.
+func (r *pgTrashRepo) Restore(ctx context.Context, id uuid.UUID, since time.Time) (domain.TrashItem, error) {
+	const tripQ = `
+		UPDATE trips t SET deleted_at = NULL
+		FROM trips old
+		WHERE old.id = t.id AND t.id = @id AND t.deleted_at >= @since AND t.organization_id = @organization_id
+		  AND (t.user_id IS NULL OR t.user_id = @user_id)
+		RETURNING t.id, 'trip', t.name, t.id, t.name, old.deleted_at`
+
+	const stopQ = `
+		UPDATE stops s SET deleted_at = NULL
+		FROM stops old
+		JOIN trips t ON t.id = old.trip_id
+		WHERE old.id = s.id AND s.id = @id AND s.deleted_at >= @since AND t.deleted_at IS NULL
+		  AND t.organization_id = @organization_id AND (t.user_id IS NULL OR t.user_id = @user_id)
+		RETURNING s.id, 'stop', s.name, t.id, t.name, old.deleted_at`
+
+	// tripTrashedQ tells a stop hidden behind its trashed trip apart from
+	// one that is not in the trash at all.
+	const tripTrashedQ = `
+		SELECT EXISTS (
+			SELECT 1 FROM stops s JOIN trips t ON t.id = s.trip_id
+			WHERE s.id = @id AND s.deleted_at >= @since AND t.deleted_at IS NOT NULL
+			  AND t.organization_id = @organization_id AND (t.user_id IS NULL OR t.user_id = @user_id))`
+
+	args := scoped(ctx, pgx.NamedArgs{"id": id, "since": since})
+	item, err := scanTrashItem(r.db.QueryRow(ctx, tripQ, args))
+	if !errors.Is(err, domain.ErrNotFound) {
+		if err != nil {
+			return domain.TrashItem{}, fmt.Errorf("repo.TrashRepo.Restore: trip: %w", err)
+		}
+		return item, nil
+	}
+
+	item, err = scanTrashItem(r.db.QueryRow(ctx, stopQ, args))
+	if !errors.Is(err, domain.ErrNotFound) {
+		if err != nil {
+			return domain.TrashItem{}, fmt.Errorf("repo.TrashRepo.Restore: stop: %w", err)
+		}
+		return item, nil
+	}
+
+	var tripTrashed bool
+	if err := r.db.QueryRow(ctx, tripTrashedQ, args).Scan(&tripTrashed); err != nil {
+		return domain.TrashItem{}, fmt.Errorf("repo.TrashRepo.Restore: trip trashed: %w", err)
+	}
+	if tripTrashed {
+		return domain.TrashItem{}, fmt.Errorf("repo.TrashRepo.Restore: %w: the stop's trip is in the trash; restore the trip first", domain.ErrValidation)
+	}
+	return domain.TrashItem{}, fmt.Errorf("repo.TrashRepo.Restore: %w", domain.ErrNotFound)
+}
+
+// Purge deletes expired stops and expired trips in a single statement. The
+// stops of an expired trip go with it by cascade rather than being deleted
+// twice, as do the route legs and photos of both; their object keys are read
+// first. Every trip and stop removed, cascaded or not, leaves a tombstone.
+func (r *pgTrashRepo) Purge(ctx context.Context, before time.Time) ([]string, error) {
+	const q = `
+		WITH expired_trips AS (
+			SELECT id FROM trips WHERE deleted_at < @before
+		), expired_stops AS (
+			SELECT id FROM stops
+			WHERE deleted_at < @before AND trip_id NOT IN (SELECT id FROM expired_trips)
+		), tracks AS (
+			SELECT track_key FROM route_legs
+			WHERE track_key IS NOT NULL
+			  AND (trip_id IN (SELECT id FROM expired_trips)
+			       OR from_stop_id IN (SELECT id FROM expired_stops)
+			       OR to_stop_id IN (SELECT id FROM expired_stops))
+		), photos AS (
+			SELECT object_key, thumbnails FROM stop_photos
+			WHERE stop_id IN (SELECT id FROM expired_stops)
+			   OR stop_id IN (SELECT id FROM stops WHERE trip_id IN (SELECT id FROM expired_trips))
+		), tombstoned AS (
+			INSERT INTO tombstones (entity_type, id, organization_id, user_id)
+			SELECT 'trip', id, organization_id, user_id FROM trips WHERE id IN (SELECT id FROM expired_trips)
+			UNION ALL
+			SELECT 'stop', s.id, t.organization_id, t.user_id
+			FROM stops s
+			JOIN trips t ON t.id = s.trip_id
+			WHERE s.id IN (SELECT id FROM expired_stops) OR s.trip_id IN (SELECT id FROM expired_trips)
+			ON CONFLICT DO NOTHING
+		), purged_stops AS (
+			DELETE FROM stops WHERE id IN (SELECT id FROM expired_stops)
+		), purged_trips AS (
+			DELETE FROM trips WHERE id IN (SELECT id FROM expired_trips)
+		)
+		SELECT track_key FROM tracks
+		UNION ALL
+		SELECT object_key FROM photos
+		UNION ALL
+		SELECT thumb.value FROM photos, jsonb_each_text(photos.thumbnails) AS thumb`
+
+	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{"before": before})
+	if err != nil {
+		return nil, fmt.Errorf("repo.TrashRepo.Purge: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("repo.TrashRepo.Purge: scan: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TrashRepo.Purge: rows: %w", err)
+	}
+	return keys, nil
+}
+
+// scanTrashItem maps a single trash row into a domain.TrashItem.
+func scanTrashItem(s scanner) (domain.TrashItem, error) {
+	var (
+		item   domain.TrashItem
+		id     pgtype.UUID
+		tripID pgtype.UUID
+		kind   string
+	)
+	err := s.Scan(&id, &kind, &item.Name, &tripID, &item.TripName, &item.DeletedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TrashItem{}, domain.ErrNotFound
+		}
+		return domain.TrashItem{}, err
+	}
+	item.ID = uuid.UUID(id.Bytes)
+	item.Kind = domain.TrashKind(kind)
+	item.TripID = uuid.UUID(tripID.Bytes)
+	return item, nil
+}
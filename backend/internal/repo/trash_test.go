@@ -0,0 +1,161 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func newTrashTestRepo(t *testing.T) (pgx.Tx, repo.TrashRepo) {
+	t.Helper()
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	return tx, repo.NewTrashRepo(tx)
+}
+
+// trashed returns the IDs of the items in the trash, in order, leaving out
+// any committed by other tests.
+func trashed(t *testing.T, trash repo.TrashRepo, ids ...uuid.UUID) []uuid.UUID {
+	t.Helper()
+	items, err := trash.List(context.Background(), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	got := []uuid.UUID{}
+	for _, item := range items {
+		for _, id := range ids {
+			if item.ID == id {
+				got = append(got, id)
+			}
+		}
+	}
+	return got
+}
+
+func TestTrashRepo_ListAndRestore(t *testing.T) {
+	tx, trash := newTrashTestRepo(t)
+	ctx := context.Background()
+	trips, stops := repo.NewTripRepo(tx), repo.NewStopRepo(tx)
+
+	kept := factory.Trip().WithName("Rockies").Insert(t, tx)
+	gone := factory.Stop().WithTripID(kept.ID).WithName("Moab").Insert(t, tx)
+	other := factory.Stop().WithTripID(kept.ID).Insert(t, tx)
+	dropped := factory.Trip().Insert(t, tx)
+	hidden := factory.Stop().WithTripID(dropped.ID).Insert(t, tx)
+
+	require.NoError(t, stops.Delete(ctx, kept.ID, gone.ID))
+	require.NoError(t, trips.Delete(ctx, dropped.ID))
+
+	assert.ElementsMatch(t, []uuid.UUID{gone.ID, dropped.ID},
+		trashed(t, trash, gone.ID, other.ID, dropped.ID, hidden.ID, kept.ID),
+		"the stops of a trashed trip are not items of their own")
+	_, err := stops.GetByID(ctx, dropped.ID, hidden.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "a trashed trip hides its stops")
+
+	item, err := trash.Restore(ctx, gone.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, domain.TrashStop, item.Kind)
+	assert.Equal(t, "Moab", item.Name)
+	assert.Equal(t, kept.ID, item.TripID)
+	assert.Equal(t, "Rockies", item.TripName)
+	assert.False(t, item.DeletedAt.IsZero())
+
+	_, err = stops.GetByID(ctx, kept.ID, gone.ID)
+	require.NoError(t, err)
+	_, err = trash.Restore(ctx, gone.ID, time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, domain.ErrNotFound, "no longer in the trash")
+
+	item, err = trash.Restore(ctx, dropped.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, domain.TrashTrip, item.Kind)
+	_, err = stops.GetByID(ctx, dropped.ID, hidden.ID)
+	assert.NoError(t, err, "restoring the trip brings its stops back")
+}
+
+func TestTrashRepo_Restore_OutsideWindow(t *testing.T) {
+	tx, trash := newTrashTestRepo(t)
+	ctx := context.Background()
+
+	trip := factory.Trip().Insert(t, tx)
+	require.NoError(t, repo.NewTripRepo(tx).Delete(ctx, trip.ID))
+
+	_, err := trash.Restore(ctx, trip.ID, time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTrashRepo_Restore_StopOfTrashedTrip(t *testing.T) {
+	tx, trash := newTrashTestRepo(t)
+	ctx := context.Background()
+	since := time.Now().Add(-time.Hour)
+
+	stop := factory.Stop().Insert(t, tx)
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, stop.TripID, stop.ID))
+	require.NoError(t, repo.NewTripRepo(tx).Delete(ctx, stop.TripID))
+
+	_, err := trash.Restore(ctx, stop.ID, since)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = trash.Restore(ctx, stop.TripID, since)
+	require.NoError(t, err)
+	_, err = trash.Restore(ctx, stop.ID, since)
+	assert.NoError(t, err)
+}
+
+func TestTrashRepo_Restore_UnknownID(t *testing.T) {
+	_, trash := newTrashTestRepo(t)
+
+	_, err := trash.Restore(context.Background(), uuid.New(), time.Now().Add(-time.Hour))
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTrashRepo_Purge(t *testing.T) {
+	tx, trash := newTrashTestRepo(t)
+	ctx := context.Background()
+
+	trip := factory.Trip().Insert(t, tx)
+	from := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 1)).Insert(t, tx)
+	to := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 2)).Insert(t, tx)
+	live := factory.Stop().WithTripID(trip.ID).WithArrivedAt(day(2025, 6, 3)).Insert(t, tx)
+	_, err := tx.Exec(ctx, `
+		INSERT INTO route_legs (trip_id, from_stop_id, to_stop_id, track_key) VALUES ($1, $2, $3, 'tracks/leg.gpx')`,
+		trip.ID, from.ID, to.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.NewStopRepo(tx).Delete(ctx, trip.ID, to.ID))
+	dropped := factory.Stop().Insert(t, tx)
+	require.NoError(t, repo.NewTripRepo(tx).Delete(ctx, dropped.TripID))
+	photos := repo.NewPhotoRepo(tx)
+	for key, stopID := range map[string]uuid.UUID{"photos/to.jpg": to.ID, "photos/dropped.jpg": dropped.ID, "photos/live.jpg": live.ID} {
+		_, err := photos.Create(ctx, domain.Photo{StopID: stopID, ObjectKey: key, ContentType: "image/jpeg", SizeBytes: 1,
+			Thumbnails: map[domain.ThumbnailSize]string{domain.ThumbnailSmall: key + "-small.jpg"}})
+		require.NoError(t, err)
+	}
+
+	keys, err := trash.Purge(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, keys, "nothing has been in the trash long enough")
+	assert.Len(t, trashed(t, trash, to.ID, dropped.TripID), 2)
+
+	keys, err = trash.Purge(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"tracks/leg.gpx", "photos/to.jpg", "photos/to.jpg-small.jpg", "photos/dropped.jpg", "photos/dropped.jpg-small.jpg"}, keys)
+	assert.Empty(t, trashed(t, trash, to.ID, dropped.TripID))
+
+	var remaining int
+	require.NoError(t, tx.QueryRow(ctx,
+		`SELECT count(*) FROM stops WHERE id = ANY($1)`, []uuid.UUID{from.ID, to.ID, live.ID, dropped.ID}).Scan(&remaining))
+	assert.Equal(t, 2, remaining, "the live stops stay; the purged trip's stop goes with it")
+}
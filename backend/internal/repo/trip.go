@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -29,28 +30,57 @@ type db interface {
 // TripRepo defines the persistence operations for Trips.
 // The service layer depends on this interface, not the concrete Postgres implementation,
 // which allows the service to be unit-tested with a mock.
+//
+// Every method sees only the organization's unowned trips and those of the
+// request's user; another user's trips are reported as domain.ErrNotFound.
 type TripRepo interface {
 	// Create inserts a new trip and returns the persisted record (with DB-generated
-	// id, created_at, and updated_at populated).
+	// id, created_at, and updated_at populated). The trip is owned by the
+	// request's user, if any; trip.UserID is ignored.
 	Create(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 
 	// GetByID retrieves a single trip by its UUID primary key.
-	// Returns domain.ErrNotFound if no trip with that ID exists.
+	// Returns domain.ErrNotFound if no trip with that ID exists. Like every
+	// method here, it treats a trip in the trash as gone.
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error)
 
+	// Role returns what the request's user may do with a trip: owner when
+	// it is theirs or unowned, otherwise their membership's role.
+	// Returns domain.ErrNotFound if they cannot see the trip.
+	Role(ctx context.Context, tripID uuid.UUID) (domain.TripRole, error)
+
 	// List returns all trips ordered by start_date descending.
 	List(ctx context.Context) ([]domain.Trip, error)
 
-	// ListPaged returns one page of trips and the total count across all pages.
-	// Results are ordered by start_date descending.
-	ListPaged(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error)
+	// ListPaged returns one page of the trips matching f and the total count
+	// across all pages. Results are ordered by start_date descending.
+	ListPaged(ctx context.Context, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Trip, int64, error)
 
-	// Update overwrites the mutable fields of an existing trip and returns the
-	// updated record. Returns domain.ErrNotFound if no trip with that ID exists.
+	// Update overwrites the mutable fields of an existing trip, including its
+	// rig, and returns the updated record. A nil Metadata leaves the trip's
+	// metadata as it is.
+	// Returns domain.ErrNotFound if no trip with that ID exists.
 	Update(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 
-	// Delete removes a trip by ID. Returns domain.ErrNotFound if it does not exist.
+	// Delete moves a trip, and with it its stops, to the trash. Returns
+	// domain.ErrNotFound if it does not exist.
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Clone copies a trip, and the parts of it chosen, into a new trip named
+	// name, owned by the request's user. Every date and time copied is moved
+	// shiftDays days later (earlier when negative). The copy and its stops
+	// each get a first revision. Returns domain.ErrNotFound if the source
+	// trip does not exist.
+	Clone(ctx context.Context, sourceID uuid.UUID, name string, shiftDays int, parts domain.TripCloneParts) (domain.Trip, error)
+
+	// ListRevisions returns every recorded version of a trip, newest first.
+	// Create and Update each record one. Returns an empty slice for a trip
+	// with no history, including one that does not exist.
+	ListRevisions(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error)
+
+	// GetRevision returns one recorded version of a trip. Returns
+	// domain.ErrNotFound if the trip has no such revision.
+	GetRevision(ctx context.Context, id uuid.UUID, revision int) (domain.TripRevision, error)
 }
 
 // pgTripRepo is the Postgres implementation of TripRepo.
@@ -64,19 +94,29 @@ func NewTripRepo(db db) TripRepo {
 	return &pgTripRepo{db: db}
 }
 
-// Create inserts a new trip row and returns the full persisted record.
+// Create inserts a new trip row and its first revision, and returns the full
+// persisted record.
 func (r *pgTripRepo) Create(ctx context.Context, trip domain.Trip) (domain.Trip, error) {
 	const q = `
-		INSERT INTO trips (name, start_date, end_date, notes)
-		VALUES (@name, @start_date, @end_date, @notes)
-		RETURNING id, name, start_date, end_date, notes, created_at, updated_at`
-
-	args := pgx.NamedArgs{
-		"name":       trip.Name,
-		"start_date": trip.StartDate,
-		"end_date":   trip.EndDate, // nil becomes NULL
-		"notes":      trip.Notes,
-	}
+		WITH created AS (
+			INSERT INTO trips (organization_id, user_id, name, start_date, end_date, notes, metadata, rig_id, budget_cents)
+			VALUES (@organization_id, @user_id, @name, @start_date, @end_date, @notes, COALESCE(@metadata::jsonb, '{}'), @rig_id, @budget_cents)
+			RETURNING id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, revision
+		), revised AS (
+			INSERT INTO trip_revisions (trip_id, revision, name, start_date, end_date, notes, recorded_at)
+			SELECT id, revision, name, start_date, end_date, notes, updated_at FROM created
+		)
+		SELECT id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, ` + tripDistanceSQL + ` FROM created t`
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"name":         trip.Name,
+		"start_date":   pgDate(trip.StartDate),
+		"end_date":     pgNullDate(trip.EndDate), // nil becomes NULL
+		"notes":        trip.Notes,
+		"metadata":     metadataArg(trip.Metadata), // nil becomes {}
+		"rig_id":       trip.RigID,                 // nil becomes NULL
+		"budget_cents": trip.BudgetCents,           // nil becomes NULL
+	})
 
 	row := r.db.QueryRow(ctx, q, args)
 	result, err := scanTrip(row)
@@ -89,11 +129,11 @@ func (r *pgTripRepo) Create(ctx context.Context, trip domain.Trip) (domain.Trip,
 // GetByID retrieves a trip by primary key.
 func (r *pgTripRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error) {
 	const q = `
-		SELECT id, name, start_date, end_date, notes, created_at, updated_at
-		FROM trips
-		WHERE id = @id`
+		SELECT id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, ` + tripDistanceSQL + `
+		FROM trips t
+		WHERE id = @id AND organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id OR id IN ` + memberTripsSQL + `) AND deleted_at IS NULL`
 
-	row := r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": id})
+	row := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
 	result, err := scanTrip(row)
 	if err != nil {
 		return domain.Trip{}, fmt.Errorf("repo.TripRepo.GetByID: %w", err)
@@ -101,14 +141,35 @@ func (r *pgTripRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, er
 	return result, nil
 }
 
+// Role checks ownership first; the membership row only matters for a trip
+// someone else owns.
+func (r *pgTripRepo) Role(ctx context.Context, tripID uuid.UUID) (domain.TripRole, error) {
+	const q = `
+		SELECT CASE WHEN t.user_id IS NULL OR t.user_id = @user_id THEN 'owner' ELSE tm.role END
+		FROM trips t
+		LEFT JOIN trip_members tm ON tm.trip_id = t.id AND tm.user_id = @user_id
+		WHERE t.id = @trip_id AND t.organization_id = @organization_id AND t.deleted_at IS NULL
+		  AND (t.user_id IS NULL OR t.user_id = @user_id OR tm.user_id IS NOT NULL)`
+
+	var role string
+	if err := r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID})).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("repo.TripRepo.Role: %w", domain.ErrNotFound)
+		}
+		return "", fmt.Errorf("repo.TripRepo.Role: %w", err)
+	}
+	return domain.TripRole(role), nil
+}
+
 // List returns all trips ordered by start_date descending (most recent first).
 func (r *pgTripRepo) List(ctx context.Context) ([]domain.Trip, error) {
 	const q = `
-		SELECT id, name, start_date, end_date, notes, created_at, updated_at
-		FROM trips
+		SELECT id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, ` + tripDistanceSQL + `
+		FROM trips t
+		WHERE organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id OR id IN ` + memberTripsSQL + `) AND deleted_at IS NULL
 		ORDER BY start_date DESC`
 
-	rows, err := r.db.Query(ctx, q)
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
 	if err != nil {
 		return nil, fmt.Errorf("repo.TripRepo.List: %w", err)
 	}
@@ -129,26 +190,31 @@ func (r *pgTripRepo) List(ctx context.Context) ([]domain.Trip, error) {
 	return trips, nil
 }
 
-// ListPaged returns one page of trips ordered by start_date descending,
-// together with the total number of trips across all pages.
-func (r *pgTripRepo) ListPaged(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error) {
-	const countQ = `SELECT COUNT(*) FROM trips`
+// ListPaged returns one page of the trips whose metadata contains f, ordered
+// by start_date descending, together with the total number of matches across
+// all pages.
+func (r *pgTripRepo) ListPaged(ctx context.Context, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+	const countQ = `
+		SELECT COUNT(*) FROM trips
+		WHERE organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id OR id IN ` + memberTripsSQL + `) AND deleted_at IS NULL AND metadata @> @filter::jsonb`
 
 	var total int64
-	if err := r.db.QueryRow(ctx, countQ).Scan(&total); err != nil {
+	if err := r.db.QueryRow(ctx, countQ, scoped(ctx, pgx.NamedArgs{"filter": metadataFilterArg(f)})).Scan(&total); err != nil {
 		return nil, 0, fmt.Errorf("repo.TripRepo.ListPaged: count: %w", err)
 	}
 
 	const q = `
-		SELECT id, name, start_date, end_date, notes, created_at, updated_at
-		FROM trips
+		SELECT id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, ` + tripDistanceSQL + `
+		FROM trips t
+		WHERE organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id OR id IN ` + memberTripsSQL + `) AND deleted_at IS NULL AND metadata @> @filter::jsonb
 		ORDER BY start_date DESC
 		LIMIT @limit OFFSET @offset`
 
-	rows, err := r.db.Query(ctx, q, pgx.NamedArgs{
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"filter": metadataFilterArg(f),
 		"limit":  p.Limit,
 		"offset": p.Offset(),
-	})
+	}))
 	if err != nil {
 		return nil, 0, fmt.Errorf("repo.TripRepo.ListPaged: query: %w", err)
 	}
@@ -169,25 +235,39 @@ func (r *pgTripRepo) ListPaged(ctx context.Context, p domain.PaginationParams) (
 	return trips, total, nil
 }
 
-// Update overwrites the mutable fields of a trip and returns the updated record.
+// Update overwrites the mutable fields of a trip, records them as its next
+// revision, and returns the updated record.
 func (r *pgTripRepo) Update(ctx context.Context, trip domain.Trip) (domain.Trip, error) {
 	const q = `
-		UPDATE trips
-		SET name       = @name,
-		    start_date = @start_date,
-		    end_date   = @end_date,
-		    notes      = @notes,
-		    updated_at = now()
-		WHERE id = @id
-		RETURNING id, name, start_date, end_date, notes, created_at, updated_at`
-
-	args := pgx.NamedArgs{
-		"id":         trip.ID,
-		"name":       trip.Name,
-		"start_date": trip.StartDate,
-		"end_date":   trip.EndDate,
-		"notes":      trip.Notes,
-	}
+		WITH updated AS (
+			UPDATE trips
+			SET name         = @name,
+			    start_date   = @start_date,
+			    end_date     = @end_date,
+			    notes        = @notes,
+			    metadata     = COALESCE(@metadata::jsonb, metadata),
+			    rig_id       = @rig_id,
+			    budget_cents = @budget_cents,
+			    revision     = revision + 1,
+			    updated_at   = now()
+			WHERE id = @id AND organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id OR id IN ` + memberTripsSQL + `) AND deleted_at IS NULL
+			RETURNING id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, revision
+		), revised AS (
+			INSERT INTO trip_revisions (trip_id, revision, name, start_date, end_date, notes, recorded_at)
+			SELECT id, revision, name, start_date, end_date, notes, updated_at FROM updated
+		)
+		SELECT id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, ` + tripDistanceSQL + ` FROM updated t`
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"id":           trip.ID,
+		"name":         trip.Name,
+		"start_date":   pgDate(trip.StartDate),
+		"end_date":     pgNullDate(trip.EndDate),
+		"notes":        trip.Notes,
+		"metadata":     metadataArg(trip.Metadata), // nil keeps the current metadata
+		"rig_id":       trip.RigID,
+		"budget_cents": trip.BudgetCents,
+	})
 
 	row := r.db.QueryRow(ctx, q, args)
 	result, err := scanTrip(row)
@@ -197,11 +277,11 @@ func (r *pgTripRepo) Update(ctx context.Context, trip domain.Trip) (domain.Trip,
 	return result, nil
 }
 
-// Delete removes a trip by primary key.
+// Delete stamps deleted_at on a trip not already in the trash.
 func (r *pgTripRepo) Delete(ctx context.Context, id uuid.UUID) error {
-	const q = `DELETE FROM trips WHERE id = @id`
+	const q = `UPDATE trips SET deleted_at = now() WHERE id = @id AND organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id) AND deleted_at IS NULL`
 
-	tag, err := r.db.Exec(ctx, q, pgx.NamedArgs{"id": id})
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
 	if err != nil {
 		return fmt.Errorf("repo.TripRepo.Delete: %w", err)
 	}
@@ -211,6 +291,128 @@ func (r *pgTripRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Clone copies a trip in one statement, so a clone is made whole or not at
+// all. New stop and checklist IDs are drawn up front in stop_map and
+// checklist_map, which tie each copy to its source for the rows that hang
+// off it. Times move by whole 24-hour days, so the spacing between them is
+// kept exactly, across daylight saving changes too.
+func (r *pgTripRepo) Clone(ctx context.Context, sourceID uuid.UUID, name string, shiftDays int, parts domain.TripCloneParts) (domain.Trip, error) {
+	const q = `
+		WITH shift AS (
+			SELECT make_interval(hours => 24 * @shift_days::int) AS span
+		), source AS (
+			SELECT * FROM trips
+			WHERE id = @source_id AND organization_id = @organization_id AND (user_id IS NULL OR user_id = @user_id OR id IN ` + memberTripsSQL + `) AND deleted_at IS NULL
+		), created AS (
+			INSERT INTO trips (organization_id, user_id, name, start_date, end_date, notes, metadata, rig_id, budget_cents)
+			SELECT organization_id, @user_id, @name, start_date + @shift_days::int, end_date + @shift_days::int, notes, metadata, rig_id, budget_cents
+			FROM source
+			RETURNING id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, revision
+		), revised AS (
+			INSERT INTO trip_revisions (trip_id, revision, name, start_date, end_date, notes, recorded_at)
+			SELECT id, revision, name, start_date, end_date, notes, updated_at FROM created
+		), stop_map AS (
+			SELECT s.id AS source_id, gen_random_uuid() AS id
+			FROM stops s JOIN source ON source.id = s.trip_id
+			WHERE @stops::boolean AND s.deleted_at IS NULL
+		), stops_copied AS (
+			INSERT INTO stops (id, trip_id, name, location, latitude, longitude, arrived_at, departed_at, notes, metadata, note_template_id, note_answers)
+			SELECT m.id, created.id, s.name, s.location, s.latitude, s.longitude, s.arrived_at + shift.span, s.departed_at + shift.span,
+			       s.notes, s.metadata, s.note_template_id, s.note_answers
+			FROM stop_map m JOIN stops s ON s.id = m.source_id, created, shift
+			RETURNING id, revision, name, location, latitude, longitude, arrived_at, departed_at, notes, updated_at
+		), stops_revised AS (
+			INSERT INTO stop_revisions (` + stopRevisionWriteColumns + `)
+			SELECT id, revision, name, location, latitude, longitude, arrived_at, departed_at, notes, updated_at FROM stops_copied
+		), tags_copied AS (
+			INSERT INTO stop_tags (stop_id, tag_id)
+			SELECT m.id, st.tag_id FROM stop_map m JOIN stop_tags st ON st.stop_id = m.source_id
+			WHERE @tags::boolean
+		), expenses_copied AS (
+			INSERT INTO expenses (trip_id, stop_id, category, amount, note, location, paid_by, split_among, spent_at)
+			SELECT created.id, m.id, e.category, e.amount, e.note, e.location, e.paid_by, e.split_among, e.spent_at + shift.span
+			FROM expenses e CROSS JOIN created CROSS JOIN shift LEFT JOIN stop_map m ON m.source_id = e.stop_id
+			WHERE @expenses::boolean AND e.trip_id = @source_id
+		), checklist_map AS (
+			SELECT c.id AS source_id, gen_random_uuid() AS id, m.id AS stop_id
+			FROM stop_checklists c JOIN stop_map m ON m.source_id = c.stop_id
+			WHERE @checklists::boolean
+		), checklists_copied AS (
+			INSERT INTO stop_checklists (id, stop_id, template_id, name, kind)
+			SELECT cm.id, cm.stop_id, c.template_id, c.name, c.kind
+			FROM checklist_map cm JOIN stop_checklists c ON c.id = cm.source_id
+		), items_copied AS (
+			INSERT INTO stop_checklist_items (checklist_id, position, label)
+			SELECT cm.id, i.position, i.label
+			FROM checklist_map cm JOIN stop_checklist_items i ON i.checklist_id = cm.source_id
+		)
+		SELECT id, name, start_date, end_date, notes, metadata, user_id, rig_id, budget_cents, created_at, updated_at, ` + tripDistanceSQL + ` FROM created t`
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"source_id":  sourceID,
+		"name":       name,
+		"shift_days": shiftDays,
+		"stops":      parts.Stops,
+		"tags":       parts.Tags,
+		"expenses":   parts.Expenses,
+		"checklists": parts.Checklists,
+	})
+	result, err := scanTrip(r.db.QueryRow(ctx, q, args))
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("repo.TripRepo.Clone: %w", err)
+	}
+	return result, nil
+}
+
+const tripRevisionColumns = `trip_id, revision, name, start_date, end_date, notes, recorded_at`
+
+// ListRevisions returns a trip's revisions, newest first.
+func (r *pgTripRepo) ListRevisions(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error) {
+	const q = `
+		SELECT ` + tripRevisionColumns + `
+		FROM trip_revisions
+		WHERE trip_id = @id AND trip_id IN ` + orgTripsSQL + `
+		ORDER BY revision DESC`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TripRepo.ListRevisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []domain.TripRevision{}
+	for rows.Next() {
+		rev, err := scanTripRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.TripRepo.ListRevisions: scan: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TripRepo.ListRevisions: rows: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetRevision retrieves one revision of a trip.
+func (r *pgTripRepo) GetRevision(ctx context.Context, id uuid.UUID, revision int) (domain.TripRevision, error) {
+	const q = `
+		SELECT ` + tripRevisionColumns + ` FROM trip_revisions
+		WHERE trip_id = @id AND revision = @revision AND trip_id IN ` + orgTripsSQL
+
+	result, err := scanTripRevision(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "revision": revision})))
+	if err != nil {
+		return domain.TripRevision{}, fmt.Errorf("repo.TripRepo.GetRevision: %w", err)
+	}
+	return result, nil
+}
+
+// tripDistanceSQL is a trip's Trip.DistanceTraveled, worked out from the
+// odometer readings on its live stops. It reads the trip as t.
+const tripDistanceSQL = `(
+		SELECT CASE WHEN count(ds.odometer_miles) > 1 THEN max(ds.odometer_miles) - min(ds.odometer_miles) END
+		FROM stops ds WHERE ds.trip_id = t.id AND ds.deleted_at IS NULL)`
+
 // scanner is satisfied by both pgx.Row and pgx.Rows, allowing scanTrip to be
 // reused for both QueryRow and Query calls.
 type scanner interface {
@@ -225,9 +427,11 @@ func scanTrip(s scanner) (domain.Trip, error) {
 		id      pgtype.UUID
 		endDate pgtype.Date
 		sdRaw   pgtype.Date
+		userID  pgtype.UUID
+		rigID   pgtype.UUID
 	)
 
-	err := s.Scan(&id, &t.Name, &sdRaw, &endDate, &t.Notes, &t.CreatedAt, &t.UpdatedAt)
+	err := s.Scan(&id, &t.Name, &sdRaw, &endDate, &t.Notes, &t.Metadata, &userID, &rigID, &t.BudgetCents, &t.CreatedAt, &t.UpdatedAt, &t.DistanceTraveled)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Trip{}, domain.ErrNotFound
@@ -236,11 +440,64 @@ func scanTrip(s scanner) (domain.Trip, error) {
 	}
 
 	t.ID = uuid.UUID(id.Bytes)
-	t.StartDate = sdRaw.Time
-	if endDate.Valid {
-		ed := endDate.Time
-		t.EndDate = &ed
+	t.StartDate = domain.DateOf(sdRaw.Time)
+	t.EndDate = nullDate(endDate)
+	if userID.Valid {
+		uid := uuid.UUID(userID.Bytes)
+		t.UserID = &uid
+	}
+	if rigID.Valid {
+		rid := uuid.UUID(rigID.Bytes)
+		t.RigID = &rid
 	}
 
 	return t, nil
 }
+
+// scanTripRevision maps a single trip_revisions row into a domain.TripRevision.
+func scanTripRevision(s scanner) (domain.TripRevision, error) {
+	var (
+		rev       domain.TripRevision
+		tripID    pgtype.UUID
+		startDate pgtype.Date
+		endDate   pgtype.Date
+		notes     *string
+	)
+	err := s.Scan(&tripID, &rev.Revision, &rev.Name, &startDate, &endDate, &notes, &rev.RecordedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TripRevision{}, domain.ErrNotFound
+		}
+		return domain.TripRevision{}, err
+	}
+	rev.TripID = uuid.UUID(tripID.Bytes)
+	rev.StartDate = domain.DateOf(startDate.Time)
+	rev.EndDate = nullDate(endDate)
+	if notes != nil {
+		rev.Notes = *notes
+	}
+	return rev, nil
+}
+
+// pgDate converts a domain.Date for a date column. pgx encodes the midnight
+// UTC it goes through as that calendar day, whatever the session time zone.
+func pgDate(d domain.Date) pgtype.Date {
+	return pgtype.Date{Time: d.In(time.UTC), Valid: true}
+}
+
+// pgNullDate is pgDate for a nullable column: nil becomes NULL.
+func pgNullDate(d *domain.Date) pgtype.Date {
+	if d == nil {
+		return pgtype.Date{}
+	}
+	return pgDate(*d)
+}
+
+// nullDate converts a nullable date column: NULL becomes nil.
+func nullDate(d pgtype.Date) *domain.Date {
+	if !d.Valid {
+		return nil
+	}
+	v := domain.DateOf(d.Time)
+	return &v
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TripMemberRepo defines the persistence operations for the users a trip is
+// shared with. Trips are matched as the request's user sees them, so a trip
+// they cannot see has no members.
+type TripMemberRepo interface {
+	// List returns a trip's members in the order they were added.
+	List(ctx context.Context, tripID uuid.UUID) ([]domain.TripMember, error)
+
+	// Add inserts a membership and returns it. Returns domain.ErrNotFound if
+	// the trip does not exist and domain.ErrValidation if the user is
+	// already a member.
+	Add(ctx context.Context, m domain.TripMember) (domain.TripMember, error)
+
+	// UpdateRole sets a member's role. Returns domain.ErrNotFound if the
+	// user is not a member.
+	UpdateRole(ctx context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error)
+
+	// Remove deletes a membership. Returns domain.ErrNotFound if the user is
+	// not a member.
+	Remove(ctx context.Context, tripID, userID uuid.UUID) error
+}
+
+// pgTripMemberRepo is the Postgres implementation of TripMemberRepo.
+type pgTripMemberRepo struct {
+	db db
+}
+
+// NewTripMemberRepo constructs a TripMemberRepo backed by the provided db
+// connection.
+func NewTripMemberRepo(db db) TripMemberRepo {
+	return &pgTripMemberRepo{db: db}
+}
+
+// List joins each membership to its user for the username.
+func (r *pgTripMemberRepo) List(ctx context.Context, tripID uuid.UUID) ([]domain.TripMember, error) {
+	const q = `
+		SELECT tm.trip_id, tm.user_id, u.username, tm.role, tm.created_at
+		FROM trip_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.trip_id = @trip_id AND tm.trip_id IN ` + orgTripsSQL + `
+		ORDER BY tm.created_at, u.username`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.TripMemberRepo.List: %w", err)
+	}
+	defer rows.Close()
+
+	members := []domain.TripMember{}
+	for rows.Next() {
+		m, err := scanTripMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.TripMemberRepo.List: scan: %w", err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.TripMemberRepo.List: rows: %w", err)
+	}
+	return members, nil
+}
+
+// Add inserts the membership. When nothing is inserted, either the trip is
+// missing or the user is already a member.
+func (r *pgTripMemberRepo) Add(ctx context.Context, m domain.TripMember) (domain.TripMember, error) {
+	const q = `
+		WITH added AS (
+			INSERT INTO trip_members (trip_id, user_id, role)
+			SELECT id, @member_id, @role FROM trips WHERE id = @trip_id AND id IN ` + orgTripsSQL + `
+			ON CONFLICT (trip_id, user_id) DO NOTHING
+			RETURNING trip_id, user_id, role, created_at
+		)
+		SELECT a.trip_id, a.user_id, u.username, a.role, a.created_at
+		FROM added a
+		JOIN users u ON u.id = a.user_id`
+
+	args := scoped(ctx, pgx.NamedArgs{
+		"trip_id":   m.TripID,
+		"member_id": m.UserID,
+		"role":      string(m.Role),
+	})
+	result, err := scanTripMember(r.db.QueryRow(ctx, q, args))
+	if errors.Is(err, domain.ErrNotFound) {
+		const existsQ = `SELECT EXISTS (SELECT 1 FROM trip_members WHERE trip_id = @trip_id AND user_id = @member_id AND trip_id IN ` + orgTripsSQL + `)`
+		var exists bool
+		if err := r.db.QueryRow(ctx, existsQ, args).Scan(&exists); err != nil {
+			return domain.TripMember{}, fmt.Errorf("repo.TripMemberRepo.Add: %w", err)
+		}
+		if exists {
+			return domain.TripMember{}, fmt.Errorf("repo.TripMemberRepo.Add: %w: the user is already a member", domain.ErrValidation)
+		}
+		return domain.TripMember{}, fmt.Errorf("repo.TripMemberRepo.Add: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return domain.TripMember{}, fmt.Errorf("repo.TripMemberRepo.Add: %w", err)
+	}
+	return result, nil
+}
+
+// UpdateRole sets the membership's role.
+func (r *pgTripMemberRepo) UpdateRole(ctx context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error) {
+	const q = `
+		WITH updated AS (
+			UPDATE trip_members
+			SET role = @role
+			WHERE trip_id = @trip_id AND user_id = @member_id AND trip_id IN ` + orgTripsSQL + `
+			RETURNING trip_id, user_id, role, created_at
+		)
+		SELECT up.trip_id, up.user_id, u.username, up.role, up.created_at
+		FROM updated up
+		JOIN users u ON u.id = up.user_id`
+
+	result, err := scanTripMember(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"trip_id":   tripID,
+		"member_id": userID,
+		"role":      string(role),
+	})))
+	if err != nil {
+		return domain.TripMember{}, fmt.Errorf("repo.TripMemberRepo.UpdateRole: %w", err)
+	}
+	return result, nil
+}
+
+// Remove deletes the membership row.
+func (r *pgTripMemberRepo) Remove(ctx context.Context, tripID, userID uuid.UUID) error {
+	const q = `DELETE FROM trip_members WHERE trip_id = @trip_id AND user_id = @member_id AND trip_id IN ` + orgTripsSQL
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"trip_id": tripID, "member_id": userID}))
+	if err != nil {
+		return fmt.Errorf("repo.TripMemberRepo.Remove: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.TripMemberRepo.Remove: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// scanTripMember reads trip_id, user_id, username, role, created_at.
+func scanTripMember(s scanner) (domain.TripMember, error) {
+	var (
+		m      domain.TripMember
+		tripID pgtype.UUID
+		userID pgtype.UUID
+		role   string
+	)
+	if err := s.Scan(&tripID, &userID, &m.Username, &role, &m.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TripMember{}, domain.ErrNotFound
+		}
+		return domain.TripMember{}, err
+	}
+	m.TripID = uuid.UUID(tripID.Bytes)
+	m.UserID = uuid.UUID(userID.Bytes)
+	m.Role = domain.TripRole(role)
+	return m, nil
+}
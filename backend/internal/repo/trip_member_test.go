@@ -0,0 +1,156 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// tripMemberTestRepos bundles the repos the trip member tests use, all on
+// one rolled-back transaction, with a trip alice owns and bob and carol as
+// other users.
+type tripMemberTestRepos struct {
+	members repo.TripMemberRepo
+	trips   repo.TripRepo
+	stops   repo.StopRepo
+
+	trip                    domain.Trip
+	alice, bob, carol       domain.User
+	asAlice, asBob, asCarol context.Context
+}
+
+func newTripMemberTestRepos(t *testing.T) tripMemberTestRepos {
+	t.Helper()
+	pool := testutil.NewPool(t)
+	ctx := context.Background()
+
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	r := tripMemberTestRepos{
+		members: repo.NewTripMemberRepo(tx),
+		trips:   repo.NewTripRepo(tx),
+		stops:   repo.NewStopRepo(tx),
+	}
+	users := repo.NewUserRepo(tx)
+	for _, u := range []*domain.User{&r.alice, &r.bob, &r.carol} {
+		*u, err = users.Create(ctx, domain.User{Username: uuid.NewString(), PasswordHash: "hash"})
+		require.NoError(t, err)
+	}
+	r.asAlice = domain.WithUser(ctx, r.alice.ID)
+	r.asBob = domain.WithUser(ctx, r.bob.ID)
+	r.asCarol = domain.WithUser(ctx, r.carol.ID)
+
+	r.trip, err = r.trips.Create(r.asAlice, factory.Trip().Build())
+	require.NoError(t, err)
+	return r
+}
+
+// newPrivateTrip begins a rolled-back transaction and creates a trip owned by
+// one new user. It returns contexts acting as the owner and as another user
+// of the same organization, who must not see the trip or what is logged on
+// it.
+func newPrivateTrip(t *testing.T) (tx pgx.Tx, trip domain.Trip, asOwner, asOther context.Context) {
+	t.Helper()
+	ctx := context.Background()
+	tx, err := testutil.NewPool(t).Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	users := repo.NewUserRepo(tx)
+	owner, err := users.Create(ctx, domain.User{Username: uuid.NewString(), PasswordHash: "hash"})
+	require.NoError(t, err)
+	other, err := users.Create(ctx, domain.User{Username: uuid.NewString(), PasswordHash: "hash"})
+	require.NoError(t, err)
+	asOwner, asOther = domain.WithUser(ctx, owner.ID), domain.WithUser(ctx, other.ID)
+
+	trip, err = repo.NewTripRepo(tx).Create(asOwner, factory.Trip().Build())
+	require.NoError(t, err)
+	return tx, trip, asOwner, asOther
+}
+
+func TestTripMemberRepo_AddListAndRole(t *testing.T) {
+	r := newTripMemberTestRepos(t)
+
+	added, err := r.members.Add(r.asAlice, domain.TripMember{TripID: r.trip.ID, UserID: r.bob.ID, Role: domain.TripRoleViewer})
+	require.NoError(t, err)
+	assert.Equal(t, r.bob.Username, added.Username)
+	assert.Equal(t, domain.TripRoleViewer, added.Role)
+
+	members, err := r.members.List(r.asAlice, r.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, r.bob.ID, members[0].UserID)
+
+	role, err := r.trips.Role(r.asAlice, r.trip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripRoleOwner, role)
+	role, err = r.trips.Role(r.asBob, r.trip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripRoleViewer, role)
+	_, err = r.trips.Role(r.asCarol, r.trip.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "a non-member cannot see the trip")
+
+	_, err = r.members.Add(r.asAlice, domain.TripMember{TripID: r.trip.ID, UserID: r.bob.ID, Role: domain.TripRoleEditor})
+	assert.ErrorIs(t, err, domain.ErrValidation, "already a member")
+	_, err = r.members.Add(r.asAlice, domain.TripMember{TripID: uuid.New(), UserID: r.bob.ID, Role: domain.TripRoleEditor})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTripMemberRepo_MembersSeeTheTrip(t *testing.T) {
+	r := newTripMemberTestRepos(t)
+	stop, err := r.stops.Create(r.asAlice, factory.Stop().WithTripID(r.trip.ID).Build())
+	require.NoError(t, err)
+
+	_, err = r.trips.GetByID(r.asBob, r.trip.ID)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = r.members.Add(r.asAlice, domain.TripMember{TripID: r.trip.ID, UserID: r.bob.ID, Role: domain.TripRoleEditor})
+	require.NoError(t, err)
+
+	_, err = r.trips.GetByID(r.asBob, r.trip.ID)
+	require.NoError(t, err)
+	bobs, err := r.trips.List(r.asBob)
+	require.NoError(t, err)
+	assert.Contains(t, tripIDs(bobs), r.trip.ID)
+	_, err = r.stops.GetByID(r.asBob, r.trip.ID, stop.ID)
+	require.NoError(t, err, "and its stops")
+
+	updated := r.trip
+	updated.Name = "Renamed by an editor"
+	_, err = r.trips.Update(r.asBob, updated)
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.trips.Delete(r.asBob, r.trip.ID), domain.ErrNotFound, "only the owner deletes the trip")
+
+	_, err = r.trips.GetByID(r.asCarol, r.trip.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "other users still cannot")
+}
+
+func TestTripMemberRepo_UpdateRoleAndRemove(t *testing.T) {
+	r := newTripMemberTestRepos(t)
+	_, err := r.members.Add(r.asAlice, domain.TripMember{TripID: r.trip.ID, UserID: r.bob.ID, Role: domain.TripRoleViewer})
+	require.NoError(t, err)
+
+	updated, err := r.members.UpdateRole(r.asAlice, r.trip.ID, r.bob.ID, domain.TripRoleEditor)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripRoleEditor, updated.Role)
+	_, err = r.members.UpdateRole(r.asAlice, r.trip.ID, r.carol.ID, domain.TripRoleEditor)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, r.members.Remove(r.asAlice, r.trip.ID, r.bob.ID))
+	assert.ErrorIs(t, r.members.Remove(r.asAlice, r.trip.ID, r.bob.ID), domain.ErrNotFound)
+	_, err = r.trips.GetByID(r.asBob, r.trip.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "a removed member loses the trip")
+}
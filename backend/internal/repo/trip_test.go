@@ -7,12 +7,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
 )
 
 // newTestRepo opens a transaction against the test database and returns a
@@ -36,32 +38,19 @@ func newTestRepo(t *testing.T) repo.TripRepo {
 	return repo.NewTripRepo(tx)
 }
 
-// tripFixture returns a domain.Trip with sensible defaults for use in tests.
-// Callers can override individual fields after calling this function.
-func tripFixture() domain.Trip {
-	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
-	return domain.Trip{
-		Name:      "Summer Tour",
-		StartDate: start,
-		EndDate:   &end,
-		Notes:     "Test notes",
-	}
-}
-
 func TestTripRepo_Create(t *testing.T) {
 	r := newTestRepo(t)
 	ctx := context.Background()
 
-	input := tripFixture()
+	input := factory.Trip().Build()
 	got, err := r.Create(ctx, input)
 
 	require.NoError(t, err)
 	assert.NotEqual(t, [16]byte{}, got.ID, "ID should be DB-generated UUID")
 	assert.Equal(t, input.Name, got.Name)
-	assert.True(t, got.StartDate.Equal(input.StartDate), "StartDate mismatch")
+	assert.Equal(t, input.StartDate, got.StartDate)
 	require.NotNil(t, got.EndDate, "EndDate should not be nil")
-	assert.True(t, got.EndDate.Equal(*input.EndDate), "EndDate mismatch")
+	assert.Equal(t, *input.EndDate, *got.EndDate)
 	assert.Equal(t, input.Notes, got.Notes)
 	assert.False(t, got.CreatedAt.IsZero(), "CreatedAt should be set by DB")
 	assert.False(t, got.UpdatedAt.IsZero(), "UpdatedAt should be set by DB")
@@ -71,7 +60,7 @@ func TestTripRepo_Create_NilEndDate(t *testing.T) {
 	r := newTestRepo(t)
 	ctx := context.Background()
 
-	input := tripFixture()
+	input := factory.Trip().Build()
 	input.EndDate = nil // trip still in progress
 
 	got, err := r.Create(ctx, input)
@@ -84,7 +73,7 @@ func TestTripRepo_GetByID(t *testing.T) {
 	r := newTestRepo(t)
 	ctx := context.Background()
 
-	created, err := r.Create(ctx, tripFixture())
+	created, err := r.Create(ctx, factory.Trip().Build())
 	require.NoError(t, err)
 
 	got, err := r.GetByID(ctx, created.ID)
@@ -112,12 +101,12 @@ func TestTripRepo_List(t *testing.T) {
 	ctx := context.Background()
 
 	// Create two trips.
-	t1 := tripFixture()
+	t1 := factory.Trip().Build()
 	t1.Name = "First Trip"
 
-	t2 := tripFixture()
+	t2 := factory.Trip().Build()
 	t2.Name = "Second Trip"
-	t2.StartDate = t1.StartDate.AddDate(0, 1, 0) // one month later
+	t2.StartDate = t1.StartDate.AddDays(30) // a month later
 
 	_, err := r.Create(ctx, t1)
 	require.NoError(t, err)
@@ -142,7 +131,7 @@ func TestTripRepo_Update(t *testing.T) {
 	r := newTestRepo(t)
 	ctx := context.Background()
 
-	created, err := r.Create(ctx, tripFixture())
+	created, err := r.Create(ctx, factory.Trip().Build())
 	require.NoError(t, err)
 
 	created.Name = "Updated Name"
@@ -165,7 +154,7 @@ func TestTripRepo_Update_NotFound(t *testing.T) {
 	r := newTestRepo(t)
 	ctx := context.Background()
 
-	ghost := tripFixture()
+	ghost := factory.Trip().Build()
 	ghost.ID = [16]byte{0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef,
 		0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef}
 
@@ -174,11 +163,29 @@ func TestTripRepo_Update_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
 
+func TestTripRepo_Budget_SetAndCleared(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	budget := int64(250000)
+	input := factory.Trip().Build()
+	input.BudgetCents = &budget
+	created, err := r.Create(ctx, input)
+	require.NoError(t, err)
+	require.NotNil(t, created.BudgetCents)
+	assert.Equal(t, budget, *created.BudgetCents)
+
+	created.BudgetCents = nil
+	updated, err := r.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Nil(t, updated.BudgetCents)
+}
+
 func TestTripRepo_Delete(t *testing.T) {
 	r := newTestRepo(t)
 	ctx := context.Background()
 
-	created, err := r.Create(ctx, tripFixture())
+	created, err := r.Create(ctx, factory.Trip().Build())
 	require.NoError(t, err)
 
 	err = r.Delete(ctx, created.ID)
@@ -199,3 +206,124 @@ func TestTripRepo_Delete_NotFound(t *testing.T) {
 
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
+
+func TestTripRepo_Revisions_RecordCreateAndUpdate(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	created, err := r.Create(ctx, factory.Trip().WithName("Utah").WithNotes("first").Build())
+	require.NoError(t, err)
+	created.Name = "Utah and Arizona"
+	created.Notes = ""
+	_, err = r.Update(ctx, created)
+	require.NoError(t, err)
+
+	revs, err := r.ListRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, 2, revs[0].Revision, "newest first")
+	assert.Equal(t, "Utah and Arizona", revs[0].Name)
+	assert.Empty(t, revs[0].Notes)
+	assert.Equal(t, 1, revs[1].Revision)
+	assert.Equal(t, "Utah", revs[1].Name)
+	assert.Equal(t, "first", revs[1].Notes)
+
+	first, err := r.GetRevision(ctx, created.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, revs[1], first)
+}
+
+func TestTripRepo_GetRevision_NotFound(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	created, err := r.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+
+	_, err = r.GetRevision(ctx, created.ID, 2)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTripRepo_Clone_ShiftsDatesAndCopiesParts(t *testing.T) {
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+	r := repo.NewTripRepo(tx)
+	ctx := context.Background()
+
+	source := factory.Trip().WithName("Utah").Insert(t, tx)
+	stop := factory.Stop().WithTripID(source.ID).WithTags("Desert").Insert(t, tx)
+	checklists := repo.NewChecklistRepo(tx)
+	list, err := checklists.CreateChecklist(ctx, domain.Checklist{
+		StopID: stop.ID, Name: "Pull out", Kind: domain.ChecklistDeparture,
+		Items: []domain.ChecklistItem{{Label: "Antenna down"}, {Label: "Steps up"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, checklists.SetItemChecked(ctx, list.ID, list.Items[0].ID, &stop.ArrivedAt))
+	expenses := repo.NewExpenseRepo(tx)
+	_, err = expenses.Create(ctx, domain.Expense{
+		TripID: source.ID, StopID: &stop.ID, Category: domain.ExpenseParking, Amount: 12, SpentAt: stop.ArrivedAt,
+	})
+	require.NoError(t, err)
+
+	clone, err := r.Clone(ctx, source.ID, "Utah again", 365, domain.TripCloneParts{Stops: true, Tags: true, Expenses: true, Checklists: true})
+
+	require.NoError(t, err)
+	assert.NotEqual(t, source.ID, clone.ID)
+	assert.Equal(t, "Utah again", clone.Name)
+	assert.Equal(t, source.StartDate.AddDays(365), clone.StartDate)
+	require.NotNil(t, clone.EndDate)
+	assert.Equal(t, source.EndDate.AddDays(365), *clone.EndDate)
+
+	stops, err := repo.NewStopRepo(tx).ListByTripID(ctx, clone.ID)
+	require.NoError(t, err)
+	require.Len(t, stops, 1)
+	assert.NotEqual(t, stop.ID, stops[0].ID)
+	assert.True(t, stop.ArrivedAt.Add(365*24*time.Hour).Equal(stops[0].ArrivedAt), stops[0].ArrivedAt)
+	require.Len(t, stops[0].Tags, 1)
+	assert.Equal(t, "desert", stops[0].Tags[0].Slug)
+
+	copied, err := checklists.ListChecklistsByStop(ctx, stops[0].ID)
+	require.NoError(t, err)
+	require.Len(t, copied, 1)
+	require.Len(t, copied[0].Items, 2)
+	assert.Nil(t, copied[0].Items[0].CheckedAt, "copies start unchecked")
+
+	spent, err := expenses.ListByTrip(ctx, clone.ID)
+	require.NoError(t, err)
+	require.Len(t, spent, 1)
+	require.NotNil(t, spent[0].StopID)
+	assert.Equal(t, stops[0].ID, *spent[0].StopID)
+
+	revs, err := r.ListRevisions(ctx, clone.ID)
+	require.NoError(t, err)
+	assert.Len(t, revs, 1)
+}
+
+func TestTripRepo_Clone_TripOnly(t *testing.T) {
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+	r := repo.NewTripRepo(tx)
+	ctx := context.Background()
+	source := factory.Trip().Insert(t, tx)
+	factory.Stop().WithTripID(source.ID).Insert(t, tx)
+
+	clone, err := r.Clone(ctx, source.ID, "Copy", 0, domain.TripCloneParts{})
+
+	require.NoError(t, err)
+	assert.Equal(t, source.StartDate, clone.StartDate)
+	stops, err := repo.NewStopRepo(tx).ListByTripID(ctx, clone.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stops)
+}
+
+func TestTripRepo_Clone_NotFound(t *testing.T) {
+	r := newTestRepo(t)
+
+	_, err := r.Clone(context.Background(), uuid.New(), "Copy", 0, domain.TripCloneParts{Stops: true})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
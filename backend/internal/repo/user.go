@@ -0,0 +1,119 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// UserRepo defines the persistence operations for user accounts. Like
+// OrganizationRepo it is not scoped by the request's organization: users
+// log in before they act for one.
+type UserRepo interface {
+	// Create inserts a user and returns the persisted record. Returns
+	// domain.ErrValidation if the username is taken.
+	Create(ctx context.Context, user domain.User) (domain.User, error)
+
+	// GetByID retrieves a user. Returns domain.ErrNotFound if it does not
+	// exist.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+
+	// GetByUsername retrieves a user by the name they log in with. Returns
+	// domain.ErrNotFound if there is no such user.
+	GetByUsername(ctx context.Context, username string) (domain.User, error)
+
+	// UpdatePassword replaces a user's password hash. Returns
+	// domain.ErrNotFound if the user does not exist.
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) (domain.User, error)
+}
+
+// pgUserRepo is the Postgres implementation of UserRepo.
+type pgUserRepo struct {
+	db db
+}
+
+// NewUserRepo constructs a UserRepo backed by the provided db connection.
+func NewUserRepo(db db) UserRepo {
+	return &pgUserRepo{db: db}
+}
+
+const userColumns = `id, username, password_hash, created_at, updated_at`
+
+// Create inserts the user unless the username is taken, in which case
+// nothing is returned.
+func (r *pgUserRepo) Create(ctx context.Context, user domain.User) (domain.User, error) {
+	const q = `
+		INSERT INTO users (username, password_hash) VALUES (@username, @password_hash)
+		ON CONFLICT (username) DO NOTHING
+		RETURNING ` + userColumns
+
+	result, err := scanUser(r.db.QueryRow(ctx, q, pgx.NamedArgs{
+		"username":      user.Username,
+		"password_hash": user.PasswordHash,
+	}))
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.User{}, fmt.Errorf("repo.UserRepo.Create: %w: username %q is taken", domain.ErrValidation, user.Username)
+	}
+	if err != nil {
+		return domain.User{}, fmt.Errorf("repo.UserRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a user by primary key.
+func (r *pgUserRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.User, error) {
+	const q = `SELECT ` + userColumns + ` FROM users WHERE id = @id`
+
+	result, err := scanUser(r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return domain.User{}, fmt.Errorf("repo.UserRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// GetByUsername retrieves a user through the unique username.
+func (r *pgUserRepo) GetByUsername(ctx context.Context, username string) (domain.User, error) {
+	const q = `SELECT ` + userColumns + ` FROM users WHERE username = @username`
+
+	result, err := scanUser(r.db.QueryRow(ctx, q, pgx.NamedArgs{"username": username}))
+	if err != nil {
+		return domain.User{}, fmt.Errorf("repo.UserRepo.GetByUsername: %w", err)
+	}
+	return result, nil
+}
+
+// UpdatePassword overwrites the hash and bumps updated_at.
+func (r *pgUserRepo) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) (domain.User, error) {
+	const q = `
+		UPDATE users SET password_hash = @password_hash, updated_at = now()
+		WHERE id = @id
+		RETURNING ` + userColumns
+
+	result, err := scanUser(r.db.QueryRow(ctx, q, pgx.NamedArgs{"id": id, "password_hash": passwordHash}))
+	if err != nil {
+		return domain.User{}, fmt.Errorf("repo.UserRepo.UpdatePassword: %w", err)
+	}
+	return result, nil
+}
+
+// scanUser maps a single users row into a domain.User.
+func scanUser(s scanner) (domain.User, error) {
+	var (
+		u  domain.User
+		id pgtype.UUID
+	)
+	if err := s.Scan(&id, &u.Username, &u.PasswordHash, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.User{}, domain.ErrNotFound
+		}
+		return domain.User{}, err
+	}
+	u.ID = uuid.UUID(id.Bytes)
+	return u, nil
+}
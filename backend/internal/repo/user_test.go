@@ -0,0 +1,143 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// userTestRepos bundles the repos the user tests use, all on one
+// rolled-back transaction.
+type userTestRepos struct {
+	users repo.UserRepo
+	trips repo.TripRepo
+	stops repo.StopRepo
+}
+
+func newUserTestRepos(t *testing.T) userTestRepos {
+	t.Helper()
+	pool := testutil.NewPool(t)
+
+	tx, err := pool.Begin(context.Background())
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+
+	return userTestRepos{
+		users: repo.NewUserRepo(tx),
+		trips: repo.NewTripRepo(tx),
+		stops: repo.NewStopRepo(tx),
+	}
+}
+
+func TestUserRepo_CreateAndGet(t *testing.T) {
+	r := newUserTestRepos(t)
+	ctx := context.Background()
+
+	created, err := r.users.Create(ctx, domain.User{Username: "alice", PasswordHash: "hash-1"})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, "alice", created.Username)
+	assert.Equal(t, "hash-1", created.PasswordHash)
+
+	byID, err := r.users.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created, byID)
+
+	byName, err := r.users.GetByUsername(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+
+	_, err = r.users.GetByUsername(ctx, "bob")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = r.users.GetByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestUserRepo_Create_UsernameTaken(t *testing.T) {
+	r := newUserTestRepos(t)
+	ctx := context.Background()
+
+	_, err := r.users.Create(ctx, domain.User{Username: "alice", PasswordHash: "hash-1"})
+	require.NoError(t, err)
+	_, err = r.users.Create(ctx, domain.User{Username: "alice", PasswordHash: "hash-2"})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestUserRepo_UpdatePassword(t *testing.T) {
+	r := newUserTestRepos(t)
+	ctx := context.Background()
+
+	created, err := r.users.Create(ctx, domain.User{Username: "alice", PasswordHash: "hash-1"})
+	require.NoError(t, err)
+
+	updated, err := r.users.UpdatePassword(ctx, created.ID, "hash-2")
+	require.NoError(t, err)
+	assert.Equal(t, "hash-2", updated.PasswordHash)
+
+	_, err = r.users.UpdatePassword(ctx, uuid.New(), "hash-3")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestUserRepo_TripsScopedToOwner(t *testing.T) {
+	r := newUserTestRepos(t)
+	ctx := context.Background()
+
+	alice, err := r.users.Create(ctx, domain.User{Username: "alice", PasswordHash: "hash"})
+	require.NoError(t, err)
+	bob, err := r.users.Create(ctx, domain.User{Username: "bob", PasswordHash: "hash"})
+	require.NoError(t, err)
+	asAlice := domain.WithUser(ctx, alice.ID)
+	asBob := domain.WithUser(ctx, bob.ID)
+
+	owned, err := r.trips.Create(asAlice, factory.Trip().Build())
+	require.NoError(t, err)
+	require.NotNil(t, owned.UserID)
+	assert.Equal(t, alice.ID, *owned.UserID)
+	shared, err := r.trips.Create(ctx, factory.Trip().Build())
+	require.NoError(t, err)
+	assert.Nil(t, shared.UserID, "an anonymous trip has no owner")
+
+	stop, err := r.stops.Create(asAlice, factory.Stop().WithTripID(owned.ID).Build())
+	require.NoError(t, err)
+
+	_, err = r.trips.GetByID(asAlice, owned.ID)
+	require.NoError(t, err)
+	_, err = r.trips.GetByID(asBob, owned.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "another user cannot read it")
+	_, err = r.trips.GetByID(ctx, owned.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "nor can an anonymous request")
+	assert.ErrorIs(t, r.trips.Delete(asBob, owned.ID), domain.ErrNotFound, "nor delete it")
+
+	_, err = r.stops.GetByID(asBob, owned.ID, stop.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "its stops are hidden too")
+	_, err = r.stops.GetByID(asAlice, owned.ID, stop.ID)
+	require.NoError(t, err)
+
+	bobs, err := r.trips.List(asBob)
+	require.NoError(t, err)
+	assert.NotContains(t, tripIDs(bobs), owned.ID)
+	assert.Contains(t, tripIDs(bobs), shared.ID, "unowned trips are everyone's")
+
+	alices, err := r.trips.List(asAlice)
+	require.NoError(t, err)
+	assert.Contains(t, tripIDs(alices), owned.ID)
+	assert.Contains(t, tripIDs(alices), shared.ID)
+}
+
+func tripIDs(trips []domain.Trip) []uuid.UUID {
+	ids := make([]uuid.UUID, len(trips))
+	for i, t := range trips {
+		ids[i] = t.ID
+	}
+	return ids
+}
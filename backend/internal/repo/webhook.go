@@ -0,0 +1,342 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// WebhookRepo defines the persistence operations for webhook subscriptions
+// and their delivery log.
+type WebhookRepo interface {
+	// Create inserts a webhook and returns the persisted record.
+	Create(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+
+	// GetByID retrieves a webhook, secret included.
+	// Returns domain.ErrNotFound if it does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+
+	// List returns every webhook in the order they were created.
+	List(ctx context.Context) ([]domain.Webhook, error)
+
+	// ListSubscribed returns the active webhooks subscribed to event. When
+	// tripID is not nil, only the webhooks whose owner can see that trip are
+	// returned, so private trips are not announced to other users.
+	ListSubscribed(ctx context.Context, event domain.WebhookEvent, tripID *uuid.UUID) ([]domain.Webhook, error)
+
+	// Update overwrites a webhook's URL, events, description, and active
+	// flag; the secret is left as it is.
+	// Returns domain.ErrNotFound if it does not exist.
+	Update(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+
+	// SetSecret replaces a webhook's secret.
+	// Returns domain.ErrNotFound if it does not exist.
+	SetSecret(ctx context.Context, id uuid.UUID, secret string) (domain.Webhook, error)
+
+	// Delete removes a webhook and its delivery log.
+	// Returns domain.ErrNotFound if it does not exist.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// CreateDelivery records a delivery attempt under d.ID, which is also
+	// the ID sent to the subscriber.
+	CreateDelivery(ctx context.Context, d domain.WebhookDelivery) (domain.WebhookDelivery, error)
+
+	// ListDeliveries returns a webhook's most recent delivery attempts,
+	// newest first, at most limit of them.
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]domain.WebhookDelivery, error)
+}
+
+// pgWebhookRepo is the Postgres implementation of WebhookRepo.
+type pgWebhookRepo struct {
+	db db
+}
+
+// NewWebhookRepo constructs a WebhookRepo backed by the provided db connection.
+func NewWebhookRepo(db db) WebhookRepo {
+	return &pgWebhookRepo{db: db}
+}
+
+const webhookColumns = `id, user_id, url, events, description, active, secret, created_at, updated_at`
+
+const webhookDeliveryColumns = `id, webhook_id, event, payload, status_code, error, duration_ms, attempted_at`
+
+// Create inserts a webhooks row in the request's organization, owned by the
+// request's user.
+func (r *pgWebhookRepo) Create(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	const q = `
+		INSERT INTO webhooks (organization_id, user_id, url, events, description, active, secret)
+		VALUES (@organization_id, @user_id, @url, @events, @description, @active, @secret)
+		RETURNING ` + webhookColumns
+
+	args := webhookArgs(w)
+	args["secret"] = w.Secret
+	result, err := scanWebhook(r.db.QueryRow(ctx, q, scoped(ctx, args)))
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("repo.WebhookRepo.Create: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID retrieves a webhook by primary key.
+func (r *pgWebhookRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	const q = `
+		SELECT ` + webhookColumns + ` FROM webhooks
+		WHERE id = @id AND organization_id = @organization_id`
+
+	result, err := scanWebhook(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id})))
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("repo.WebhookRepo.GetByID: %w", err)
+	}
+	return result, nil
+}
+
+// List returns the organization's webhooks, oldest first.
+func (r *pgWebhookRepo) List(ctx context.Context) ([]domain.Webhook, error) {
+	const q = `
+		SELECT ` + webhookColumns + ` FROM webhooks
+		WHERE organization_id = @organization_id
+		ORDER BY created_at, id`
+
+	webhooks, err := r.query(ctx, q, scoped(ctx, pgx.NamedArgs{}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.WebhookRepo.List: %w", err)
+	}
+	return webhooks, nil
+}
+
+// ListSubscribed returns the organization's active webhooks for event. The
+// trip check is the one trips are read with, made for each webhook's owner
+// rather than the request's user; a trashed trip still counts, so its
+// deletion is announced.
+func (r *pgWebhookRepo) ListSubscribed(ctx context.Context, event domain.WebhookEvent, tripID *uuid.UUID) ([]domain.Webhook, error) {
+	const q = `
+		SELECT ` + webhookColumns + ` FROM webhooks w
+		WHERE w.organization_id = @organization_id AND w.active AND @event = ANY(w.events)
+		  AND (@trip_id::uuid IS NULL OR EXISTS (
+		      SELECT 1 FROM trips t
+		      WHERE t.id = @trip_id AND t.organization_id = w.organization_id
+		        AND (t.user_id IS NULL OR t.user_id = w.user_id
+		             OR t.id IN (SELECT tm.trip_id FROM trip_members tm WHERE tm.user_id = w.user_id))))
+		ORDER BY w.created_at, w.id`
+
+	webhooks, err := r.query(ctx, q, scoped(ctx, pgx.NamedArgs{"event": string(event), "trip_id": tripID}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.WebhookRepo.ListSubscribed: %w", err)
+	}
+	return webhooks, nil
+}
+
+// Update overwrites everything but the secret.
+func (r *pgWebhookRepo) Update(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	const q = `
+		UPDATE webhooks
+		SET url = @url,
+		    events = @events,
+		    description = @description,
+		    active = @active,
+		    updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id
+		RETURNING ` + webhookColumns
+
+	args := webhookArgs(w)
+	args["id"] = w.ID
+	result, err := scanWebhook(r.db.QueryRow(ctx, q, scoped(ctx, args)))
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("repo.WebhookRepo.Update: %w", err)
+	}
+	return result, nil
+}
+
+// SetSecret replaces the signing secret.
+func (r *pgWebhookRepo) SetSecret(ctx context.Context, id uuid.UUID, secret string) (domain.Webhook, error) {
+	const q = `
+		UPDATE webhooks SET secret = @secret, updated_at = now()
+		WHERE id = @id AND organization_id = @organization_id
+		RETURNING ` + webhookColumns
+
+	result, err := scanWebhook(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id, "secret": secret})))
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("repo.WebhookRepo.SetSecret: %w", err)
+	}
+	return result, nil
+}
+
+// Delete removes a webhook; its deliveries cascade.
+func (r *pgWebhookRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	const q = `DELETE FROM webhooks WHERE id = @id AND organization_id = @organization_id`
+
+	tag, err := r.db.Exec(ctx, q, scoped(ctx, pgx.NamedArgs{"id": id}))
+	if err != nil {
+		return fmt.Errorf("repo.WebhookRepo.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("repo.WebhookRepo.Delete: %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// CreateDelivery inserts a webhook_deliveries row. A webhook deleted while
+// the delivery was in flight inserts nothing and reports domain.ErrNotFound.
+func (r *pgWebhookRepo) CreateDelivery(ctx context.Context, d domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	const q = `
+		INSERT INTO webhook_deliveries (id, webhook_id, event, payload, status_code, error, duration_ms)
+		SELECT @id, w.id, @event, @payload, @status_code, @error, @duration_ms
+		FROM webhooks w
+		WHERE w.id = @webhook_id AND w.organization_id = @organization_id
+		RETURNING ` + webhookDeliveryColumns
+
+	var statusCode *int
+	if d.StatusCode != 0 {
+		statusCode = &d.StatusCode
+	}
+	result, err := scanWebhookDelivery(r.db.QueryRow(ctx, q, scoped(ctx, pgx.NamedArgs{
+		"id":          d.ID,
+		"webhook_id":  d.WebhookID,
+		"event":       string(d.Event),
+		"payload":     []byte(d.Payload),
+		"status_code": statusCode,
+		"error":       nullableString(d.Error),
+		"duration_ms": d.Duration.Milliseconds(),
+	})))
+	if err != nil {
+		return domain.WebhookDelivery{}, fmt.Errorf("repo.WebhookRepo.CreateDelivery: %w", err)
+	}
+	return result, nil
+}
+
+// ListDeliveries returns a webhook's latest attempts, newest first.
+func (r *pgWebhookRepo) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]domain.WebhookDelivery, error) {
+	const q = `
+		SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries
+		WHERE webhook_id = @webhook_id
+		  AND webhook_id IN (SELECT ow.id FROM webhooks ow WHERE ow.organization_id = @organization_id)
+		ORDER BY attempted_at DESC, id
+		LIMIT @limit`
+
+	rows, err := r.db.Query(ctx, q, scoped(ctx, pgx.NamedArgs{"webhook_id": webhookID, "limit": limit}))
+	if err != nil {
+		return nil, fmt.Errorf("repo.WebhookRepo.ListDeliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []domain.WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("repo.WebhookRepo.ListDeliveries: scan: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.WebhookRepo.ListDeliveries: rows: %w", err)
+	}
+	return deliveries, nil
+}
+
+// query runs a webhooks SELECT and scans every row.
+func (r *pgWebhookRepo) query(ctx context.Context, q string, args pgx.NamedArgs) ([]domain.Webhook, error) {
+	rows, err := r.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []domain.Webhook{}
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		webhooks = append(webhooks, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+	return webhooks, nil
+}
+
+// webhookArgs returns the named arguments shared by Create and Update.
+func webhookArgs(w domain.Webhook) pgx.NamedArgs {
+	events := make([]string, len(w.Events))
+	for i, e := range w.Events {
+		events[i] = string(e)
+	}
+	return pgx.NamedArgs{
+		"url":         w.URL,
+		"events":      events,
+		"description": nullableString(w.Description),
+		"active":      w.Active,
+	}
+}
+
+// scanWebhook maps a single webhooks row into a domain.Webhook.
+func scanWebhook(s scanner) (domain.Webhook, error) {
+	var (
+		w           domain.Webhook
+		id          pgtype.UUID
+		userID      pgtype.UUID
+		events      []string
+		description *string
+	)
+	err := s.Scan(&id, &userID, &w.URL, &events, &description, &w.Active, &w.Secret, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Webhook{}, domain.ErrNotFound
+		}
+		return domain.Webhook{}, err
+	}
+	w.ID = uuid.UUID(id.Bytes)
+	if userID.Valid {
+		owner := uuid.UUID(userID.Bytes)
+		w.UserID = &owner
+	}
+	w.Events = make([]domain.WebhookEvent, len(events))
+	for i, e := range events {
+		w.Events[i] = domain.WebhookEvent(e)
+	}
+	if description != nil {
+		w.Description = *description
+	}
+	return w, nil
+}
+
+// scanWebhookDelivery maps a single webhook_deliveries row into a
+// domain.WebhookDelivery.
+func scanWebhookDelivery(s scanner) (domain.WebhookDelivery, error) {
+	var (
+		d          domain.WebhookDelivery
+		id         pgtype.UUID
+		webhookID  pgtype.UUID
+		event      string
+		payload    []byte
+		statusCode *int32
+		errText    *string
+		durationMs int32
+	)
+	err := s.Scan(&id, &webhookID, &event, &payload, &statusCode, &errText, &durationMs, &d.AttemptedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.WebhookDelivery{}, domain.ErrNotFound
+		}
+		return domain.WebhookDelivery{}, err
+	}
+	d.ID = uuid.UUID(id.Bytes)
+	d.WebhookID = uuid.UUID(webhookID.Bytes)
+	d.Event = domain.WebhookEvent(event)
+	d.Payload = payload
+	if statusCode != nil {
+		d.StatusCode = int(*statusCode)
+	}
+	if errText != nil {
+		d.Error = *errText
+	}
+	d.Duration = time.Duration(durationMs) * time.Millisecond
+	return d, nil
+}
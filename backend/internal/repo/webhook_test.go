@@ -0,0 +1,174 @@
+//go:build integration
+
+package repo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func newWebhookTestRepo(t *testing.T) (pgx.Tx, repo.WebhookRepo) {
+	t.Helper()
+	ctx := context.Background()
+	pool := testutil.NewPool(t)
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err, "begin transaction")
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+	return tx, repo.NewWebhookRepo(tx)
+}
+
+func TestWebhookRepo_CRUD(t *testing.T) {
+	_, webhooks := newWebhookTestRepo(t)
+	ctx := context.Background()
+
+	created, err := webhooks.Create(ctx, domain.Webhook{
+		URL:    "https://ha.local/api/webhook/rv",
+		Events: []domain.WebhookEvent{domain.EventStopCreated, domain.EventStopDeleted},
+		Active: true,
+		Secret: "whsec_one",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_one", created.Secret)
+	assert.Empty(t, created.Description)
+
+	created.Description = "Home Assistant"
+	created.Active = false
+	created.Secret = "ignored"
+	updated, err := webhooks.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, "Home Assistant", updated.Description)
+	assert.False(t, updated.Active)
+	assert.Equal(t, "whsec_one", updated.Secret, "Update leaves the secret alone")
+
+	rotated, err := webhooks.SetSecret(ctx, created.ID, "whsec_two")
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_two", rotated.Secret)
+
+	all, err := webhooks.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, []domain.WebhookEvent{domain.EventStopCreated, domain.EventStopDeleted}, all[0].Events)
+
+	require.NoError(t, webhooks.Delete(ctx, created.ID))
+	_, err = webhooks.GetByID(ctx, created.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.True(t, errors.Is(webhooks.Delete(ctx, created.ID), domain.ErrNotFound))
+}
+
+func TestWebhookRepo_ListSubscribed(t *testing.T) {
+	_, webhooks := newWebhookTestRepo(t)
+	ctx := context.Background()
+
+	created, err := webhooks.Create(ctx, domain.Webhook{URL: "https://a.example", Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: true, Secret: "s"})
+	require.NoError(t, err)
+	_, err = webhooks.Create(ctx, domain.Webhook{URL: "https://b.example", Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: false, Secret: "s"})
+	require.NoError(t, err)
+	_, err = webhooks.Create(ctx, domain.Webhook{URL: "https://c.example", Events: []domain.WebhookEvent{domain.EventStopUpdated}, Active: true, Secret: "s"})
+	require.NoError(t, err)
+
+	got, err := webhooks.ListSubscribed(ctx, domain.EventStopCreated, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, created.ID, got[0].ID)
+}
+
+// TestWebhookRepo_ListSubscribed_PrivateTrip verifies that an event on
+// alice's private trip reaches her webhook and a trip member's, but not the
+// webhook bob registered in the same organization.
+func TestWebhookRepo_ListSubscribed_PrivateTrip(t *testing.T) {
+	tx, webhooks := newWebhookTestRepo(t)
+	ctx := context.Background()
+
+	users := repo.NewUserRepo(tx)
+	var alice, bob, carol domain.User
+	for _, u := range []*domain.User{&alice, &bob, &carol} {
+		var err error
+		*u, err = users.Create(ctx, domain.User{Username: uuid.NewString(), PasswordHash: "hash"})
+		require.NoError(t, err)
+	}
+	asAlice, asBob, asCarol := domain.WithUser(ctx, alice.ID), domain.WithUser(ctx, bob.ID), domain.WithUser(ctx, carol.ID)
+	trip, err := repo.NewTripRepo(tx).Create(asAlice, factory.Trip().Build())
+	require.NoError(t, err)
+	stop, err := repo.NewStopRepo(tx).Create(asAlice, factory.Stop().WithTripID(trip.ID).Build())
+	require.NoError(t, err)
+	_, err = repo.NewTripMemberRepo(tx).Add(asAlice, domain.TripMember{TripID: trip.ID, UserID: carol.ID, Role: domain.TripRoleViewer})
+	require.NoError(t, err)
+
+	hook := domain.Webhook{URL: "https://example.com/hook", Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: true, Secret: "s"}
+	alices, err := webhooks.Create(asAlice, hook)
+	require.NoError(t, err)
+	require.NotNil(t, alices.UserID)
+	assert.Equal(t, alice.ID, *alices.UserID)
+	_, err = webhooks.Create(asBob, hook)
+	require.NoError(t, err)
+	carols, err := webhooks.Create(asCarol, hook)
+	require.NoError(t, err)
+	anonymous, err := webhooks.Create(ctx, hook)
+	require.NoError(t, err)
+	assert.Nil(t, anonymous.UserID)
+
+	got, err := webhooks.ListSubscribed(asAlice, domain.EventStopCreated, domain.EventTripID(domain.StopEvent(stop)))
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, len(got))
+	for i, w := range got {
+		ids[i] = w.ID
+	}
+	assert.Equal(t, []uuid.UUID{alices.ID, carols.ID}, ids, "bob's and the anonymous webhook do not hear of alice's private stop")
+
+	all, err := webhooks.ListSubscribed(asAlice, domain.EventStopCreated, nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 4, "events about no trip reach every webhook")
+}
+
+func TestWebhookRepo_Deliveries(t *testing.T) {
+	_, webhooks := newWebhookTestRepo(t)
+	ctx := context.Background()
+
+	w, err := webhooks.Create(ctx, domain.Webhook{URL: "https://ha.local/hook", Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: true, Secret: "s"})
+	require.NoError(t, err)
+
+	ok, err := webhooks.CreateDelivery(ctx, domain.WebhookDelivery{
+		ID: uuid.New(), WebhookID: w.ID, Event: domain.EventPing,
+		Payload:    json.RawMessage(`{"event":"ping"}`),
+		StatusCode: 204,
+		Duration:   35 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 204, ok.StatusCode)
+	assert.Equal(t, 35*time.Millisecond, ok.Duration)
+	assert.JSONEq(t, `{"event":"ping"}`, string(ok.Payload))
+
+	failed, err := webhooks.CreateDelivery(ctx, domain.WebhookDelivery{
+		ID: uuid.New(), WebhookID: w.ID, Event: domain.EventStopCreated,
+		Payload: json.RawMessage(`{}`),
+		Error:   "connection refused",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, failed.StatusCode)
+
+	log, err := webhooks.ListDeliveries(ctx, w.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, log, 1)
+	assert.Contains(t, []uuid.UUID{ok.ID, failed.ID}, log[0].ID)
+
+	_, err = webhooks.CreateDelivery(ctx, domain.WebhookDelivery{ID: uuid.New(), WebhookID: uuid.New(), Event: domain.EventPing, Payload: json.RawMessage(`{}`)})
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "a delivery for a missing webhook is not recorded")
+
+	require.NoError(t, webhooks.Delete(ctx, w.ID))
+	log, err = webhooks.ListDeliveries(ctx, w.ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, log, "deliveries are deleted with their webhook")
+}
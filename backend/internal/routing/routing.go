@@ -0,0 +1,126 @@
+// Package routing looks up driving routes between points on the map from a
+// routing API.
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+// ErrNoRoute is returned when the API finds no road between the points.
+var ErrNoRoute = errors.New("no route found")
+
+// Route is the drive between two points.
+type Route struct {
+	Meters   float64
+	Duration time.Duration
+}
+
+// Router returns the driving route from one point to another.
+type Router interface {
+	Route(ctx context.Context, from, to geo.Point) (Route, error)
+}
+
+// maxResponseBytes caps how much of a response is read. Without the route
+// geometry a response is a few hundred bytes.
+const maxResponseBytes = 1 << 20
+
+// UserAgent identifies the logbook to routing APIs.
+const UserAgent = "rv-logbook (+https://github.com/pkordes/rv-logbook)"
+
+// HTTP looks up routes from an API that speaks the OSRM route service:
+// GET {url}/route/v1/driving/{lon},{lat};{lon},{lat}?overview=false
+// answered with {"code": "Ok", "routes": [{"distance": m, "duration": s}]}.
+type HTTP struct {
+	url    string
+	client *http.Client
+}
+
+var _ Router = (*HTTP)(nil)
+
+// NewHTTP returns a Router for the API at baseURL — for example
+// "https://router.project-osrm.org". A nil client uses one with a
+// ten-second timeout.
+func NewHTTP(baseURL string, client *http.Client) *HTTP {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &HTTP{url: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+// Route looks up the fastest driving route from one point to another.
+func (h *HTTP) Route(ctx context.Context, from, to geo.Point) (Route, error) {
+	r, err := h.route(ctx, from, to)
+	if err != nil {
+		return Route{}, fmt.Errorf("routing.HTTP.Route: %w", err)
+	}
+	return r, nil
+}
+
+// Ping routes a point to itself to check that the API answers.
+func (h *HTTP) Ping(ctx context.Context) error {
+	p := geo.Point{Lat: 39.74, Lon: -104.99}
+	if _, err := h.route(ctx, p, p); err != nil {
+		return fmt.Errorf("routing.HTTP.Ping: %w", err)
+	}
+	return nil
+}
+
+// route makes one request.
+func (h *HTTP) route(ctx context.Context, from, to geo.Point) (Route, error) {
+	u := h.url + "/route/v1/driving/" + coordinate(from) + ";" + coordinate(to) + "?overview=false"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Route{}, err
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return Route{}, err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Code   string `json:"code"`
+		Routes []struct {
+			Distance float64 `json:"distance"`
+			Duration float64 `json:"duration"`
+		} `json:"routes"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return Route{}, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return Route{}, fmt.Errorf("decode response: %w", err)
+	}
+	// OSRM answers a point it cannot snap to a road, or two points with no
+	// road between them, with 400 and a code saying so.
+	if body.Code == "NoRoute" || body.Code == "NoSegment" {
+		return Route{}, ErrNoRoute
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Route{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if body.Code != "Ok" || len(body.Routes) == 0 {
+		return Route{}, ErrNoRoute
+	}
+	return Route{
+		Meters:   body.Routes[0].Distance,
+		Duration: time.Duration(body.Routes[0].Duration * float64(time.Second)),
+	}, nil
+}
+
+// coordinate formats p the way OSRM takes it: longitude first.
+func coordinate(p geo.Point) string {
+	return strconv.FormatFloat(p.Lon, 'f', 5, 64) + "," + strconv.FormatFloat(p.Lat, 'f', 5, 64)
+}
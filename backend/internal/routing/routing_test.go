@@ -0,0 +1,62 @@
+package routing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/routing"
+)
+
+func TestHTTP_Route(t *testing.T) {
+	var gotPath, gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, routing.UserAgent, r.UserAgent())
+		gotPath, gotQuery = r.URL.Path, r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"code":"Ok","routes":[{"distance":482803.2,"duration":18900.5}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	got, err := routing.NewHTTP(srv.URL+"/", srv.Client()).Route(context.Background(),
+		geo.Point{Lat: 44.6455, Lon: -110.8617}, geo.Point{Lat: 43.7904, Lon: -110.6818})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/route/v1/driving/-110.86170,44.64550;-110.68180,43.79040", gotPath, "longitude first")
+	assert.Equal(t, "overview=false", gotQuery)
+	assert.InDelta(t, 482803.2, got.Meters, 1e-9)
+	assert.Equal(t, 18900500*time.Millisecond, got.Duration)
+}
+
+func TestHTTP_Route_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/noroute/route/v1/driving/0.00000,0.00000;1.00000,1.00000":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"NoRoute","message":"Impossible route between points"}`))
+		case "/empty/route/v1/driving/0.00000,0.00000;1.00000,1.00000":
+			_, _ = w.Write([]byte(`{"code":"Ok","routes":[]}`))
+		default:
+			http.Error(w, "upstream down", http.StatusBadGateway)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	from, to := geo.Point{}, geo.Point{Lat: 1, Lon: 1}
+
+	_, err := routing.NewHTTP(srv.URL+"/noroute", srv.Client()).Route(context.Background(), from, to)
+	assert.True(t, errors.Is(err, routing.ErrNoRoute), "got %v", err)
+
+	_, err = routing.NewHTTP(srv.URL+"/empty", srv.Client()).Route(context.Background(), from, to)
+	assert.True(t, errors.Is(err, routing.ErrNoRoute), "got %v", err)
+
+	_, err = routing.NewHTTP(srv.URL+"/down", srv.Client()).Route(context.Background(), from, to)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, routing.ErrNoRoute))
+	assert.Contains(t, err.Error(), "502")
+}
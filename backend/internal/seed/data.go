@@ -0,0 +1,101 @@
+package seed
+
+// Demo catalog. Names are real, well-known public campgrounds and parks so
+// the data reads naturally; details (coordinates included) are illustrative,
+// not authoritative.
+
+type camp struct {
+	name     string
+	location string
+	lat, lon float64
+	tags     []string
+}
+
+type region struct {
+	name  string
+	notes string
+	camps []camp
+}
+
+var regions = []region{
+	{
+		name:  "Desert Southwest",
+		notes: "Winter loop chasing sun through Utah and Arizona.",
+		camps: []camp{
+			{"Devils Garden Campground", "Arches NP, UT", 38.7795, -109.5926, []string{"national-park", "dry-camping"}},
+			{"Watchman Campground", "Zion NP, UT", 37.1986, -112.9863, []string{"national-park", "30-amp"}},
+			{"Mather Campground", "Grand Canyon NP, AZ", 36.0505, -112.1213, []string{"national-park"}},
+			{"Lone Rock Beach", "Big Water, UT", 37.0161, -111.5442, []string{"boondocking", "lake"}},
+			{"Catalina State Park", "Tucson, AZ", 32.4265, -110.9256, []string{"state-park", "50-amp"}},
+			{"Dead Horse Point State Park", "Moab, UT", 38.4850, -109.7400, []string{"state-park", "dark-skies"}},
+		},
+	},
+	{
+		name:  "Pacific Coast",
+		notes: "Highway 1 and 101, north to south.",
+		camps: []camp{
+			{"Kirk Creek Campground", "Big Sur, CA", 35.9904, -121.4949, []string{"ocean", "dry-camping"}},
+			{"Jedediah Smith Campground", "Crescent City, CA", 41.7966, -124.0870, []string{"redwoods", "state-park"}},
+			{"Cape Lookout State Park", "Tillamook, OR", 45.3630, -123.9700, []string{"ocean", "state-park"}},
+			{"Harris Beach State Park", "Brookings, OR", 42.0660, -124.3080, []string{"ocean", "full-hookups"}},
+			{"Pismo Coast Village", "Pismo Beach, CA", 35.1372, -120.6390, []string{"full-hookups", "50-amp"}},
+		},
+	},
+	{
+		name:  "Rocky Mountain",
+		notes: "Summer in the high country; watch the passes.",
+		camps: []camp{
+			{"Moraine Park Campground", "Rocky Mountain NP, CO", 40.3600, -105.5970, []string{"national-park", "elk"}},
+			{"Madison Campground", "Yellowstone NP, WY", 44.6452, -110.8612, []string{"national-park", "bison"}},
+			{"Colter Bay RV Park", "Grand Teton NP, WY", 43.9050, -110.6410, []string{"full-hookups", "lake"}},
+			{"Many Glacier Campground", "Glacier NP, MT", 48.7970, -113.6720, []string{"national-park", "dry-camping"}},
+			{"Ridgway State Park", "Ridgway, CO", 38.2050, -107.7270, []string{"state-park", "50-amp"}},
+		},
+	},
+	{
+		name:  "Great Lakes",
+		notes: "Lighthouses, fudge shops, and lake breezes.",
+		camps: []camp{
+			{"Pictured Rocks Twelvemile Beach", "Grand Marais, MI", 46.6460, -86.1800, []string{"lake", "dry-camping"}},
+			{"Door County KOA", "Sturgeon Bay, WI", 44.8700, -87.2900, []string{"full-hookups", "laundry"}},
+			{"Tahquamenon Falls State Park", "Paradise, MI", 46.5750, -85.2560, []string{"state-park", "waterfall"}},
+			{"Split Rock Lighthouse State Park", "Two Harbors, MN", 47.1990, -91.3670, []string{"state-park", "lake"}},
+		},
+	},
+	{
+		name:  "Blue Ridge",
+		notes: "Fall colors along the Parkway.",
+		camps: []camp{
+			{"Julian Price Park Campground", "Blowing Rock, NC", 36.1400, -81.7300, []string{"dry-camping"}},
+			{"Big Meadows Campground", "Shenandoah NP, VA", 38.5280, -78.4410, []string{"national-park"}},
+			{"Cades Cove Campground", "Great Smoky Mountains NP, TN", 35.6030, -83.7760, []string{"national-park", "wildlife"}},
+			{"Asheville West KOA", "Candler, NC", 35.5420, -82.6920, []string{"full-hookups", "50-amp"}},
+		},
+	},
+	{
+		name:  "Gulf Coast",
+		notes: "Snowbird run along the Gulf.",
+		camps: []camp{
+			{"Gulf State Park", "Gulf Shores, AL", 30.2600, -87.6430, []string{"state-park", "full-hookups"}},
+			{"Fort Pickens Campground", "Pensacola Beach, FL", 30.3250, -87.2780, []string{"ocean", "national-seashore"}},
+			{"Goose Island State Park", "Rockport, TX", 28.1330, -96.9870, []string{"state-park", "birding"}},
+			{"St. George Island State Park", "St. George Island, FL", 29.7190, -84.7560, []string{"ocean", "state-park"}},
+		},
+	},
+}
+
+// generalTags are sprinkled across stops regardless of region.
+var generalTags = []string{"quiet", "good-cell", "no-cell", "pet-friendly", "level-sites", "would-return", "laundry"}
+
+var stopNotes = []string{
+	"Pull-through site, easy in and out.",
+	"Site was unlevel — needed blocks on the driver side.",
+	"Great sunset views from the site.",
+	"Generator hours 8am–8pm.",
+	"Dump station near the entrance.",
+	"Long walk to the bathhouse but very clean.",
+	"Verizon 2 bars, T-Mobile none.",
+	"Camp host sells firewood.",
+	"Tight turns inside the loop — watch the tail swing.",
+	"",
+}
@@ -0,0 +1,194 @@
+// Package seed generates realistic demo data — trips, stops, and tags — so a
+// fresh install (and the frontend) has something to show.
+//
+// Data is written through the service layer, not raw SQL, so every seeded
+// record passes the same validation and slug normalisation as user input.
+// Generation is deterministic for a given Options.Seed and Options.Now, which
+// keeps screenshots and demos reproducible.
+//
+// Photos are not seeded: their images would have to be put in object storage,
+// which the seeder does not touch.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// TripCreator is the subset of the trip service the seeder needs.
+type TripCreator interface {
+	Create(ctx context.Context, trip domain.Trip) (domain.Trip, error)
+}
+
+// StopCreator is the subset of the stop service the seeder needs.
+type StopCreator interface {
+	Create(ctx context.Context, stop domain.Stop) (domain.Stop, error)
+	AddTag(ctx context.Context, tripID, stopID uuid.UUID, tagName string) (domain.Tag, error)
+}
+
+// Options controls how much data is generated.
+type Options struct {
+	// Trips is the number of trips to create. Defaults to 12.
+	Trips int
+
+	// Seed makes generation reproducible. The same Seed and Now always
+	// produce the same data.
+	Seed uint64
+
+	// Now anchors the timeline: the most recent trip is in progress at Now and
+	// earlier trips run back from there. Defaults to time.Now().
+	Now time.Time
+}
+
+// Result reports what Run created.
+type Result struct {
+	Trips   int
+	Stops   int
+	TagUses int
+}
+
+// Run creates opts.Trips trips, each with a handful of consecutive stops and
+// a few tags per stop. The newest trip is left in progress: it has no end date
+// and its last stop has no departure time.
+func Run(ctx context.Context, trips TripCreator, stops StopCreator, opts Options) (Result, error) {
+	if opts.Trips <= 0 {
+		opts.Trips = 12
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x9e3779b97f4a7c15))
+
+	plans := plan(rng, opts)
+
+	var res Result
+	for _, p := range plans {
+		trip, err := trips.Create(ctx, p.trip)
+		if err != nil {
+			return res, fmt.Errorf("seed.Run: trip %q: %w", p.trip.Name, err)
+		}
+		res.Trips++
+
+		for _, sp := range p.stops {
+			sp.stop.TripID = trip.ID
+			stop, err := stops.Create(ctx, sp.stop)
+			if err != nil {
+				return res, fmt.Errorf("seed.Run: stop %q: %w", sp.stop.Name, err)
+			}
+			res.Stops++
+
+			for _, tag := range sp.tags {
+				if _, err := stops.AddTag(ctx, trip.ID, stop.ID, tag); err != nil {
+					return res, fmt.Errorf("seed.Run: tag %q on %q: %w", tag, stop.Name, err)
+				}
+				res.TagUses++
+			}
+		}
+	}
+	return res, nil
+}
+
+type tripPlan struct {
+	trip  domain.Trip
+	stops []stopPlan
+}
+
+type stopPlan struct {
+	stop domain.Stop
+	tags []string
+}
+
+// plan builds every trip and stop in memory, newest trip first, walking
+// backwards in time from opts.Now with a few weeks at home between trips.
+func plan(rng *rand.Rand, opts Options) []tripPlan {
+	plans := make([]tripPlan, 0, opts.Trips)
+	// The newest trip started a few days ago and is still under way.
+	cursor := day(opts.Now).AddDate(0, 0, -(3 + rng.IntN(5)))
+
+	for i := 0; i < opts.Trips; i++ {
+		region := regions[rng.IntN(len(regions))]
+		inProgress := i == 0
+		numStops := 3 + rng.IntN(6)
+
+		// Lay the stops out forward from the trip's start date. For past trips
+		// the start is chosen so the trip ends before the next one begins.
+		nights := make([]int, numStops)
+		total := 0
+		for j := range nights {
+			nights[j] = 1 + rng.IntN(4)
+			total += nights[j]
+		}
+		start := cursor
+		if !inProgress {
+			start = cursor.AddDate(0, 0, -total)
+		}
+
+		p := tripPlan{trip: domain.Trip{
+			Name:      fmt.Sprintf("%s %d", region.name, start.Year()),
+			StartDate: domain.DateOf(start),
+			Notes:     region.notes,
+		}}
+		if !inProgress {
+			end := domain.DateOf(start.AddDate(0, 0, total))
+			p.trip.EndDate = &end
+		}
+
+		arrive := start.Add(15 * time.Hour) // mid-afternoon check-in
+		for j, n := range nights {
+			camp := region.camps[rng.IntN(len(region.camps))]
+			lat, lon := camp.lat, camp.lon
+			s := domain.Stop{
+				Name:      camp.name,
+				Location:  camp.location,
+				Latitude:  &lat,
+				Longitude: &lon,
+				ArrivedAt: arrive,
+				Notes:     stopNotes[rng.IntN(len(stopNotes))],
+			}
+			depart := arrive.AddDate(0, 0, n).Add(-4 * time.Hour) // late-morning checkout
+			if !(inProgress && j == numStops-1) {
+				s.DepartedAt = &depart
+			}
+			p.stops = append(p.stops, stopPlan{stop: s, tags: pickTags(rng, camp.tags)})
+			arrive = arrive.AddDate(0, 0, n)
+		}
+
+		plans = append(plans, p)
+		// Two to six weeks at home before the next (earlier) trip ended.
+		cursor = start.AddDate(0, 0, -(14 + rng.IntN(29)))
+	}
+	return plans
+}
+
+// pickTags returns the camp's own tags plus up to two general ones.
+func pickTags(rng *rand.Rand, own []string) []string {
+	tags := append([]string(nil), own...)
+	for range rng.IntN(3) {
+		t := generalTags[rng.IntN(len(generalTags))]
+		if !contains(tags, t) {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// day truncates t to midnight UTC, matching how trip dates are stored.
+func day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
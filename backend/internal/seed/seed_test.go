@@ -0,0 +1,145 @@
+package seed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/seed"
+)
+
+// ---- in-memory fakes -------------------------------------------------------
+
+type memTrips struct {
+	trips []domain.Trip
+	err   error
+}
+
+func (m *memTrips) Create(_ context.Context, t domain.Trip) (domain.Trip, error) {
+	if m.err != nil {
+		return domain.Trip{}, m.err
+	}
+	t.ID = uuid.New()
+	m.trips = append(m.trips, t)
+	return t, nil
+}
+
+type memStops struct {
+	stops []domain.Stop
+	tags  map[uuid.UUID][]string
+}
+
+func (m *memStops) Create(_ context.Context, s domain.Stop) (domain.Stop, error) {
+	s.ID = uuid.New()
+	m.stops = append(m.stops, s)
+	return s, nil
+}
+
+func (m *memStops) AddTag(_ context.Context, _, stopID uuid.UUID, name string) (domain.Tag, error) {
+	if m.tags == nil {
+		m.tags = map[uuid.UUID][]string{}
+	}
+	m.tags[stopID] = append(m.tags[stopID], name)
+	return domain.Tag{Name: name, Slug: name}, nil
+}
+
+// compile-time checks: the fakes must satisfy the seeder's interfaces.
+var (
+	_ seed.TripCreator = (*memTrips)(nil)
+	_ seed.StopCreator = (*memStops)(nil)
+)
+
+var now = time.Date(2026, 7, 4, 18, 0, 0, 0, time.UTC)
+
+func run(t *testing.T, opts seed.Options) (*memTrips, *memStops, seed.Result) {
+	t.Helper()
+	trips, stops := &memTrips{}, &memStops{}
+	res, err := seed.Run(context.Background(), trips, stops, opts)
+	require.NoError(t, err)
+	return trips, stops, res
+}
+
+// ---- tests -----------------------------------------------------------------
+
+func TestRun_DefaultsToTwelveTrips(t *testing.T) {
+	trips, stops, res := run(t, seed.Options{Now: now})
+
+	assert.Equal(t, 12, res.Trips)
+	assert.Len(t, trips.trips, 12)
+	assert.Len(t, stops.stops, res.Stops)
+	assert.GreaterOrEqual(t, res.Stops, 12*3, "every trip has at least three stops")
+	assert.Positive(t, res.TagUses)
+}
+
+func TestRun_Deterministic(t *testing.T) {
+	a, as, _ := run(t, seed.Options{Trips: 5, Seed: 42, Now: now})
+	b, bs, _ := run(t, seed.Options{Trips: 5, Seed: 42, Now: now})
+
+	require.Len(t, b.trips, len(a.trips))
+	for i := range a.trips {
+		assert.Equal(t, a.trips[i].Name, b.trips[i].Name)
+		assert.Equal(t, a.trips[i].StartDate, b.trips[i].StartDate)
+	}
+	require.Len(t, bs.stops, len(as.stops))
+	for i := range as.stops {
+		assert.Equal(t, as.stops[i].Name, bs.stops[i].Name)
+		assert.Equal(t, as.stops[i].ArrivedAt, bs.stops[i].ArrivedAt)
+	}
+}
+
+func TestRun_NewestTripInProgress(t *testing.T) {
+	trips, stops, _ := run(t, seed.Options{Trips: 3, Now: now})
+
+	newest := trips.trips[0]
+	assert.Nil(t, newest.EndDate, "newest trip should be ongoing")
+	assert.True(t, newest.StartDate.Before(domain.DateOf(now)))
+
+	var last domain.Stop
+	for _, s := range stops.stops {
+		if s.TripID == newest.ID {
+			last = s
+		}
+	}
+	assert.Nil(t, last.DepartedAt, "last stop of the ongoing trip should not be departed")
+
+	for _, past := range trips.trips[1:] {
+		require.NotNil(t, past.EndDate)
+		assert.True(t, past.EndDate.Before(newest.StartDate), "past trips end before the newest begins")
+	}
+}
+
+func TestRun_StopsAreValid(t *testing.T) {
+	trips, stops, _ := run(t, seed.Options{Seed: 7, Now: now})
+
+	byID := map[uuid.UUID]domain.Trip{}
+	for _, tr := range trips.trips {
+		byID[tr.ID] = tr
+	}
+	for _, s := range stops.stops {
+		trip, ok := byID[s.TripID]
+		require.True(t, ok, "stop %q must belong to a seeded trip", s.Name)
+		assert.NotEmpty(t, s.Name)
+		assert.False(t, domain.DateOf(s.ArrivedAt).Before(trip.StartDate), "stop %q arrives before its trip starts", s.Name)
+		if s.DepartedAt != nil {
+			assert.True(t, s.DepartedAt.After(s.ArrivedAt), "stop %q departs before arriving", s.Name)
+		}
+		if trip.EndDate != nil && s.DepartedAt != nil {
+			assert.False(t, s.DepartedAt.After(trip.EndDate.AddDays(1).In(time.UTC)), "stop %q departs after its trip ends", s.Name)
+		}
+		assert.NotEmpty(t, stops.tags[s.ID], "stop %q should be tagged", s.Name)
+	}
+}
+
+func TestRun_PropagatesErrors(t *testing.T) {
+	boom := errors.New("db down")
+
+	_, err := seed.Run(context.Background(), &memTrips{err: boom}, &memStops{}, seed.Options{Now: now})
+
+	assert.ErrorIs(t, err, boom)
+}
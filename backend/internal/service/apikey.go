@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+const (
+	// apiKeyPrefix starts every API key, so a leaked one is easy to spot.
+	apiKeyPrefix = "rvk_"
+
+	// apiKeyShownChars is how much of a key, prefix included, is kept to
+	// recognize it by.
+	apiKeyShownChars = len(apiKeyPrefix) + 5
+)
+
+// APIKeyService issues, lists, revokes, and verifies API keys.
+//
+// A key is 32 random bytes, so unlike a password it needs no slow hash: it
+// is stored as its SHA-256 and looked up by it.
+type APIKeyService struct {
+	keys repo.APIKeyRepo
+}
+
+// NewAPIKeyService constructs an APIKeyService.
+func NewAPIKeyService(keys repo.APIKeyRepo) *APIKeyService {
+	return &APIKeyService{keys: keys}
+}
+
+// Create issues a new key for the request's user. The returned APIKey is the
+// only one whose Key is set. Returns domain.ErrValidation if name is empty
+// and domain.ErrUnauthorized if no one is signed in to own the key.
+func (s *APIKeyService) Create(ctx context.Context, name string) (domain.APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.APIKey{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if _, ok := domain.UserFromContext(ctx); !ok {
+		return domain.APIKey{}, fmt.Errorf("service.APIKeyService.Create: sign in to create an API key: %w", domain.ErrUnauthorized)
+	}
+
+	key, err := newAPIKey()
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("service.APIKeyService.Create: %w", err)
+	}
+	created, err := s.keys.Create(ctx, domain.APIKey{
+		Name:    name,
+		Prefix:  key[:apiKeyShownChars],
+		KeyHash: hashAPIKey(key),
+	})
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("service.APIKeyService.Create: %w", err)
+	}
+	created.Key = key
+	return created, nil
+}
+
+// List returns the request's user's keys, oldest first.
+func (s *APIKeyService) List(ctx context.Context) ([]domain.APIKey, error) {
+	keys, err := s.keys.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.APIKeyService.List: %w", err)
+	}
+	return keys, nil
+}
+
+// Delete revokes one of the request's user's keys. Returns
+// domain.ErrNotFound if it does not exist or is someone else's.
+func (s *APIKeyService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.keys.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.APIKeyService.Delete: %w", err)
+	}
+	return nil
+}
+
+// Verify returns the key that key is. Returns domain.ErrUnauthorized if it
+// is not a key this server issued or has been revoked.
+func (s *APIKeyService) Verify(ctx context.Context, key string) (domain.APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return domain.APIKey{}, fmt.Errorf("service.APIKeyService.Verify: %w", domain.ErrUnauthorized)
+	}
+	found, err := s.keys.GetByHash(ctx, hashAPIKey(key))
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.APIKey{}, fmt.Errorf("service.APIKeyService.Verify: %w", domain.ErrUnauthorized)
+	}
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("service.APIKeyService.Verify: %w", err)
+	}
+	return found, nil
+}
+
+// newAPIKey returns a random API key.
+func newAPIKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashAPIKey returns the hex SHA-256 of key, as stored.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
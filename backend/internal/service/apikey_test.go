@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memAPIKeyRepo is an in-memory repo.APIKeyRepo scoped by domain.WithUser.
+type memAPIKeyRepo struct {
+	keys []domain.APIKey
+}
+
+func (m *memAPIKeyRepo) Create(ctx context.Context, k domain.APIKey) (domain.APIKey, error) {
+	k.ID = uuid.New()
+	k.UserID, _ = domain.UserFromContext(ctx)
+	m.keys = append(m.keys, k)
+	return k, nil
+}
+func (m *memAPIKeyRepo) GetByHash(_ context.Context, hash string) (domain.APIKey, error) {
+	for _, k := range m.keys {
+		if k.KeyHash == hash {
+			return k, nil
+		}
+	}
+	return domain.APIKey{}, domain.ErrNotFound
+}
+func (m *memAPIKeyRepo) List(ctx context.Context) ([]domain.APIKey, error) {
+	user, _ := domain.UserFromContext(ctx)
+	var out []domain.APIKey
+	for _, k := range m.keys {
+		if k.UserID == user {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+func (m *memAPIKeyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	user, _ := domain.UserFromContext(ctx)
+	for i, k := range m.keys {
+		if k.ID == id && k.UserID == user {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func TestAPIKeyService_Create(t *testing.T) {
+	keys := &memAPIKeyRepo{}
+	svc := service.NewAPIKeyService(keys)
+	user := uuid.New()
+
+	created, err := svc.Create(domain.WithUser(context.Background(), user), "  Pi tank monitor ")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Pi tank monitor", created.Name)
+	assert.Equal(t, user, created.UserID)
+	assert.True(t, strings.HasPrefix(created.Key, "rvk_"), created.Key)
+	assert.Len(t, created.Prefix, 9)
+	assert.True(t, strings.HasPrefix(created.Key, created.Prefix))
+	require.Len(t, keys.keys, 1)
+	assert.NotEqual(t, created.Key, keys.keys[0].KeyHash, "only the hash is stored")
+	assert.Empty(t, keys.keys[0].Key)
+}
+
+func TestAPIKeyService_Create_Errors(t *testing.T) {
+	svc := service.NewAPIKeyService(&memAPIKeyRepo{})
+
+	_, err := svc.Create(domain.WithUser(context.Background(), uuid.New()), " ")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.Create(context.Background(), "Pi tank monitor")
+	assert.ErrorIs(t, err, domain.ErrUnauthorized, "a key must belong to someone")
+}
+
+func TestAPIKeyService_Verify(t *testing.T) {
+	svc := service.NewAPIKeyService(&memAPIKeyRepo{})
+	user := uuid.New()
+	ctx := domain.WithUser(context.Background(), user)
+	created, err := svc.Create(ctx, "Pi tank monitor")
+	require.NoError(t, err)
+
+	got, err := svc.Verify(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, user, got.UserID)
+
+	for _, bad := range []string{"rvk_unknown", strings.TrimPrefix(created.Key, "rvk_"), ""} {
+		_, err := svc.Verify(context.Background(), bad)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized, bad)
+	}
+
+	require.NoError(t, svc.Delete(ctx, created.ID))
+	_, err = svc.Verify(context.Background(), created.Key)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized, "a revoked key no longer works")
+}
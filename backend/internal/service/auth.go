@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+const (
+	// AccessTokenTTL is how long an access token authenticates requests.
+	// It is short because an access token cannot be revoked.
+	AccessTokenTTL = 15 * time.Minute
+
+	// RefreshTokenTTL is how long a refresh token can be traded for a new
+	// pair, and so how long a user stays logged in without using the app.
+	RefreshTokenTTL = 30 * 24 * time.Hour
+
+	// accessAudience and refreshAudience scope login tokens, as shareAudience
+	// does share tokens, so neither kind can be presented as the other or as
+	// a share link.
+	accessAudience  = "api"
+	refreshAudience = "refresh"
+)
+
+// dummyPasswordHash is checked against when a login names an unknown user,
+// so it takes as long as a wrong password and timing does not reveal which
+// usernames exist. No password matches it.
+const dummyPasswordHash = "pbkdf2-sha256$600000$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+// AuthService provisions user accounts and issues, refreshes, and verifies
+// their login tokens.
+//
+// Both tokens are signed JWTs whose subject is the user's ID; neither is
+// stored. Refreshing checks that the user still exists, so removing a user
+// locks them out once their access token expires.
+type AuthService struct {
+	users  repo.UserRepo
+	signer TokenSigner
+	clock  domain.Clock
+}
+
+// NewAuthService constructs an AuthService. Pass domain.SystemClock in
+// production; token lifetimes are counted from clock.
+func NewAuthService(users repo.UserRepo, signer TokenSigner, clock domain.Clock) *AuthService {
+	return &AuthService{users: users, signer: signer, clock: clock}
+}
+
+// Login issues a token pair for username if password is theirs.
+// Returns domain.ErrValidation if either is empty and domain.ErrUnauthorized
+// if the username is unknown or the password wrong.
+func (s *AuthService) Login(ctx context.Context, username, password string) (domain.Tokens, error) {
+	username = strings.TrimSpace(username)
+	if username == "" || password == "" {
+		return domain.Tokens{}, fmt.Errorf("%w: username and password are required", domain.ErrValidation)
+	}
+	user, err := s.users.GetByUsername(ctx, username)
+	if errors.Is(err, domain.ErrNotFound) {
+		auth.CheckPassword(dummyPasswordHash, password)
+		return domain.Tokens{}, fmt.Errorf("service.AuthService.Login: %w", domain.ErrUnauthorized)
+	}
+	if err != nil {
+		return domain.Tokens{}, fmt.Errorf("service.AuthService.Login: %w", err)
+	}
+	if !auth.CheckPassword(user.PasswordHash, password) {
+		return domain.Tokens{}, fmt.Errorf("service.AuthService.Login: %w", domain.ErrUnauthorized)
+	}
+	return s.issue(user.ID)
+}
+
+// Refresh trades a refresh token for a new pair. Returns domain.ErrValidation
+// if the token is empty and domain.ErrUnauthorized if it is invalid,
+// expired, not a refresh token, or its user no longer exists.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (domain.Tokens, error) {
+	if refreshToken == "" {
+		return domain.Tokens{}, fmt.Errorf("%w: refresh_token is required", domain.ErrValidation)
+	}
+	userID, err := s.parse(refreshToken, refreshAudience)
+	if err != nil {
+		return domain.Tokens{}, fmt.Errorf("service.AuthService.Refresh: %w", err)
+	}
+	if _, err := s.users.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Tokens{}, fmt.Errorf("service.AuthService.Refresh: user removed: %w", domain.ErrUnauthorized)
+		}
+		return domain.Tokens{}, fmt.Errorf("service.AuthService.Refresh: %w", err)
+	}
+	return s.issue(userID)
+}
+
+// Verify checks an access token and returns the ID of the user it was issued
+// to. Returns domain.ErrUnauthorized if the token is invalid, expired, or not
+// an access token.
+func (s *AuthService) Verify(_ context.Context, accessToken string) (uuid.UUID, error) {
+	userID, err := s.parse(accessToken, accessAudience)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("service.AuthService.Verify: %w", err)
+	}
+	return userID, nil
+}
+
+// ProvisionUsers makes sure each account in accounts, a password by
+// username as configured in AUTH_USERS, can log in: a missing user is
+// created and a changed password is reset. Users not listed are left alone.
+func (s *AuthService) ProvisionUsers(ctx context.Context, accounts map[string]string) error {
+	usernames := make([]string, 0, len(accounts))
+	for username := range accounts {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+
+	for _, username := range usernames {
+		password := accounts[username]
+		user, err := s.users.GetByUsername(ctx, username)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("service.AuthService.ProvisionUsers: %w", err)
+		}
+		if err == nil && auth.CheckPassword(user.PasswordHash, password) {
+			continue
+		}
+
+		hash, err := auth.HashPassword(password)
+		if err != nil {
+			return fmt.Errorf("service.AuthService.ProvisionUsers: %w", err)
+		}
+		if user.ID == uuid.Nil {
+			_, err = s.users.Create(ctx, domain.User{Username: username, PasswordHash: hash})
+		} else {
+			_, err = s.users.UpdatePassword(ctx, user.ID, hash)
+		}
+		if err != nil {
+			return fmt.Errorf("service.AuthService.ProvisionUsers: %s: %w", username, err)
+		}
+	}
+	return nil
+}
+
+// issue signs a new access and refresh token for the user.
+func (s *AuthService) issue(userID uuid.UUID) (domain.Tokens, error) {
+	// JWT times have second precision.
+	now := s.clock.Now().Truncate(time.Second)
+	tokens := domain.Tokens{ExpiresIn: AccessTokenTTL}
+
+	var err error
+	tokens.AccessToken, err = s.sign(userID, accessAudience, now, now.Add(AccessTokenTTL))
+	if err != nil {
+		return domain.Tokens{}, err
+	}
+	tokens.RefreshToken, err = s.sign(userID, refreshAudience, now, now.Add(RefreshTokenTTL))
+	if err != nil {
+		return domain.Tokens{}, err
+	}
+	return tokens, nil
+}
+
+func (s *AuthService) sign(userID uuid.UUID, audience string, issued, expires time.Time) (string, error) {
+	token, err := s.signer.Sign(jwt.RegisteredClaims{
+		// A unique ID keeps two tokens issued in the same second distinct.
+		ID:        uuid.NewString(),
+		Subject:   userID.String(),
+		Audience:  jwt.ClaimStrings{audience},
+		IssuedAt:  jwt.NewNumericDate(issued),
+		ExpiresAt: jwt.NewNumericDate(expires),
+	})
+	if err != nil {
+		return "", fmt.Errorf("service.AuthService: sign: %w", err)
+	}
+	return token, nil
+}
+
+// parse verifies token and that it was issued for audience, and returns the
+// user ID in its subject. Every failure is reported as domain.ErrUnauthorized.
+func (s *AuthService) parse(token, audience string) (uuid.UUID, error) {
+	var claims jwt.RegisteredClaims
+	if err := s.signer.Parse(token, &claims); err != nil {
+		return uuid.Nil, domain.ErrUnauthorized
+	}
+	if !slices.Contains(claims.Audience, audience) {
+		return uuid.Nil, fmt.Errorf("wrong audience: %w", domain.ErrUnauthorized)
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("bad subject: %w", domain.ErrUnauthorized)
+	}
+	return userID, nil
+}
@@ -0,0 +1,176 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memUserRepo is an in-memory repo.UserRepo.
+type memUserRepo struct {
+	users []domain.User
+}
+
+func (m *memUserRepo) Create(_ context.Context, u domain.User) (domain.User, error) {
+	for _, existing := range m.users {
+		if existing.Username == u.Username {
+			return domain.User{}, domain.ErrValidation
+		}
+	}
+	u.ID = uuid.New()
+	m.users = append(m.users, u)
+	return u, nil
+}
+func (m *memUserRepo) GetByID(_ context.Context, id uuid.UUID) (domain.User, error) {
+	for _, u := range m.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return domain.User{}, domain.ErrNotFound
+}
+func (m *memUserRepo) GetByUsername(_ context.Context, username string) (domain.User, error) {
+	for _, u := range m.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return domain.User{}, domain.ErrNotFound
+}
+func (m *memUserRepo) UpdatePassword(_ context.Context, id uuid.UUID, hash string) (domain.User, error) {
+	for i := range m.users {
+		if m.users[i].ID == id {
+			m.users[i].PasswordHash = hash
+			return m.users[i], nil
+		}
+	}
+	return domain.User{}, domain.ErrNotFound
+}
+
+// newAuthService returns an AuthService for one user, alice, running on a
+// fake clock at the current second (see newShareService).
+func newAuthService(t *testing.T) (*service.AuthService, *memUserRepo, *auth.KeySet) {
+	t.Helper()
+	users := &memUserRepo{}
+	ks := testKeySet(t)
+	svc := service.NewAuthService(users, ks, &fakeClock{now: time.Now().Truncate(time.Second)})
+	require.NoError(t, svc.ProvisionUsers(context.Background(), map[string]string{"alice": "s3cret"}))
+	return svc, users, ks
+}
+
+func TestAuthService_LoginAndVerify(t *testing.T) {
+	svc, users, _ := newAuthService(t)
+	ctx := context.Background()
+
+	tokens, err := svc.Login(ctx, " alice ", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, service.AccessTokenTTL, tokens.ExpiresIn)
+	assert.NotEqual(t, tokens.AccessToken, tokens.RefreshToken)
+
+	userID, err := svc.Verify(ctx, tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, users.users[0].ID, userID)
+}
+
+func TestAuthService_Login_Rejected(t *testing.T) {
+	svc, _, _ := newAuthService(t)
+	ctx := context.Background()
+
+	_, err := svc.Login(ctx, "alice", "wrong")
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+
+	_, err = svc.Login(ctx, "bob", "s3cret")
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+
+	_, err = svc.Login(ctx, "alice", "")
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	svc, users, _ := newAuthService(t)
+	ctx := context.Background()
+	tokens, err := svc.Login(ctx, "alice", "s3cret")
+	require.NoError(t, err)
+
+	refreshed, err := svc.Refresh(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+	userID, err := svc.Verify(ctx, refreshed.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, users.users[0].ID, userID)
+
+	_, err = svc.Refresh(ctx, "")
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
+
+// TestAuthService_TokensAreNotInterchangeable checks the audiences: a
+// refresh token cannot authenticate a request, an access token cannot be
+// refreshed, and a share token is neither.
+func TestAuthService_TokensAreNotInterchangeable(t *testing.T) {
+	svc, _, ks := newAuthService(t)
+	ctx := context.Background()
+	tokens, err := svc.Login(ctx, "alice", "s3cret")
+	require.NoError(t, err)
+
+	_, err = svc.Verify(ctx, tokens.RefreshToken)
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+
+	_, err = svc.Refresh(ctx, tokens.AccessToken)
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+
+	share, err := ks.Sign(jwt.RegisteredClaims{
+		Subject:   "alice",
+		Audience:  jwt.ClaimStrings{"trip-share"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	_, err = svc.Verify(ctx, share)
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+
+	_, err = svc.Verify(ctx, "not-a-token")
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+}
+
+// TestAuthService_Refresh_RemovedUser checks that a deleted user cannot stay
+// logged in by refreshing.
+func TestAuthService_Refresh_RemovedUser(t *testing.T) {
+	svc, users, _ := newAuthService(t)
+	tokens, err := svc.Login(context.Background(), "alice", "s3cret")
+	require.NoError(t, err)
+
+	users.users = nil
+	_, err = svc.Refresh(context.Background(), tokens.RefreshToken)
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+}
+
+// TestAuthService_ProvisionUsers checks that provisioning creates missing
+// users, resets changed passwords, and leaves everything else alone.
+func TestAuthService_ProvisionUsers(t *testing.T) {
+	svc, users, _ := newAuthService(t)
+	ctx := context.Background()
+	alice := users.users[0]
+	require.NotContains(t, alice.PasswordHash, "s3cret", "passwords are stored hashed")
+
+	require.NoError(t, svc.ProvisionUsers(ctx, map[string]string{"alice": "s3cret"}))
+	assert.Equal(t, []domain.User{alice}, users.users, "an unchanged account is untouched")
+
+	require.NoError(t, svc.ProvisionUsers(ctx, map[string]string{"alice": "n3w", "bob": "hunter2"}))
+	require.Len(t, users.users, 2)
+	assert.Equal(t, alice.ID, users.users[0].ID, "a changed password keeps the user")
+
+	_, err := svc.Login(ctx, "alice", "s3cret")
+	assert.True(t, errors.Is(err, domain.ErrUnauthorized), "got %v", err)
+	_, err = svc.Login(ctx, "alice", "n3w")
+	require.NoError(t, err)
+	_, err = svc.Login(ctx, "bob", "hunter2")
+	require.NoError(t, err)
+}
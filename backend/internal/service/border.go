@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// BorderCrossingService manages a trip's border crossings and the year-end
+// crossing report.
+type BorderCrossingService struct {
+	trips     repo.TripRepo
+	crossings repo.BorderCrossingRepo
+	events    EventPublisher
+}
+
+// NewBorderCrossingService constructs a BorderCrossingService. events may be
+// nil, and then no border crossing events are published.
+func NewBorderCrossingService(trips repo.TripRepo, crossings repo.BorderCrossingRepo, events EventPublisher) *BorderCrossingService {
+	return &BorderCrossingService{trips: trips, crossings: crossings, events: events}
+}
+
+// Create validates and persists a crossing.
+// Returns domain.ErrNotFound if the trip does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *BorderCrossingService) Create(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	c, err := normalizeBorderCrossing(c)
+	if err != nil {
+		return domain.BorderCrossing{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, c.TripID, domain.TripRoleEditor); err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("service.BorderCrossingService.Create: %w", err)
+	}
+
+	created, err := s.crossings.Create(ctx, c)
+	if err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("service.BorderCrossingService.Create: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventBorderCrossingCreated, domain.TripRecordEvent(created.ID, created.TripID, nil))
+	return created, nil
+}
+
+// GetByID returns one of a trip's crossings.
+// Returns domain.ErrNotFound if the trip has no crossing with that ID.
+func (s *BorderCrossingService) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error) {
+	c, err := s.crossings.GetByID(ctx, tripID, id)
+	if err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("service.BorderCrossingService.GetByID: %w", err)
+	}
+	return c, nil
+}
+
+// ListByTrip returns a trip's crossings in date order.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *BorderCrossingService) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.BorderCrossingService.ListByTrip: %w", err)
+	}
+	crossings, err := s.crossings.ListByTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.BorderCrossingService.ListByTrip: %w", err)
+	}
+	return crossings, nil
+}
+
+// Update validates and overwrites a crossing.
+// Returns domain.ErrNotFound if the trip has no crossing with that ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *BorderCrossingService) Update(ctx context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	c, err := normalizeBorderCrossing(c)
+	if err != nil {
+		return domain.BorderCrossing{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, c.TripID, domain.TripRoleEditor); err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("service.BorderCrossingService.Update: %w", err)
+	}
+	updated, err := s.crossings.Update(ctx, c)
+	if err != nil {
+		return domain.BorderCrossing{}, fmt.Errorf("service.BorderCrossingService.Update: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventBorderCrossingUpdated, domain.TripRecordEvent(updated.ID, updated.TripID, nil))
+	return updated, nil
+}
+
+// Delete removes a crossing.
+// Returns domain.ErrNotFound if the trip has no crossing with that ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *BorderCrossingService) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.BorderCrossingService.Delete: %w", err)
+	}
+	if err := s.crossings.Delete(ctx, tripID, id); err != nil {
+		return fmt.Errorf("service.BorderCrossingService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventBorderCrossingDeleted, domain.TripRecordEvent(id, tripID, nil))
+	return nil
+}
+
+// YearReport returns every crossing made in a calendar year, across all
+// trips, in date order.
+func (s *BorderCrossingService) YearReport(ctx context.Context, year int) ([]domain.BorderCrossingReportRow, error) {
+	if year < 1 || year > 9999 {
+		return nil, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+	}
+	report, err := s.crossings.ListByYear(ctx, year)
+	if err != nil {
+		return nil, fmt.Errorf("service.BorderCrossingService.YearReport: %w", err)
+	}
+	return report, nil
+}
+
+// normalizeBorderCrossing trims a crossing's text fields, upper-cases the
+// country code, drops blank documents, and enforces the rules shared by
+// create and update.
+func normalizeBorderCrossing(c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	c.PortOfEntry = strings.TrimSpace(c.PortOfEntry)
+	c.Country = strings.ToUpper(strings.TrimSpace(c.Country))
+
+	if c.CrossedOn.IsZero() {
+		return c, fmt.Errorf("%w: crossed_on is required", domain.ErrValidation)
+	}
+	if c.PortOfEntry == "" {
+		return c, fmt.Errorf("%w: port_of_entry is required", domain.ErrValidation)
+	}
+	if !isCountryCode(c.Country) {
+		return c, fmt.Errorf("%w: country must be a two-letter ISO 3166-1 code", domain.ErrValidation)
+	}
+	if !c.Direction.Valid() {
+		return c, fmt.Errorf("%w: direction must be entry or exit", domain.ErrValidation)
+	}
+
+	documents := make([]string, 0, len(c.Documents))
+	for _, d := range c.Documents {
+		if d = strings.TrimSpace(d); d != "" {
+			documents = append(documents, d)
+		}
+	}
+	c.Documents = documents
+	return c, nil
+}
+
+// isCountryCode reports whether s is two upper-case ASCII letters.
+func isCountryCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memBorderCrossingRepo is an in-memory repo.BorderCrossingRepo. Every
+// crossing's trip is named tripName.
+type memBorderCrossingRepo struct {
+	crossings []domain.BorderCrossing
+	tripName  string
+}
+
+func (m *memBorderCrossingRepo) Create(_ context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	c.ID = uuid.New()
+	m.crossings = append(m.crossings, c)
+	return c, nil
+}
+func (m *memBorderCrossingRepo) GetByID(_ context.Context, tripID, id uuid.UUID) (domain.BorderCrossing, error) {
+	for _, c := range m.crossings {
+		if c.ID == id && c.TripID == tripID {
+			return c, nil
+		}
+	}
+	return domain.BorderCrossing{}, domain.ErrNotFound
+}
+func (m *memBorderCrossingRepo) ListByTrip(_ context.Context, tripID uuid.UUID) ([]domain.BorderCrossing, error) {
+	out := []domain.BorderCrossing{}
+	for _, c := range m.crossings {
+		if c.TripID == tripID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+func (m *memBorderCrossingRepo) ListByYear(_ context.Context, year int) ([]domain.BorderCrossingReportRow, error) {
+	out := []domain.BorderCrossingReportRow{}
+	for _, c := range m.crossings {
+		if c.CrossedOn.Year() == year {
+			out = append(out, domain.BorderCrossingReportRow{Crossing: c, TripName: m.tripName})
+		}
+	}
+	return out, nil
+}
+func (m *memBorderCrossingRepo) Update(_ context.Context, c domain.BorderCrossing) (domain.BorderCrossing, error) {
+	for i := range m.crossings {
+		if m.crossings[i].ID == c.ID && m.crossings[i].TripID == c.TripID {
+			m.crossings[i] = c
+			return c, nil
+		}
+	}
+	return domain.BorderCrossing{}, domain.ErrNotFound
+}
+func (m *memBorderCrossingRepo) Delete(_ context.Context, tripID, id uuid.UUID) error {
+	for i, c := range m.crossings {
+		if c.ID == id && c.TripID == tripID {
+			m.crossings = append(m.crossings[:i], m.crossings[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.BorderCrossingRepo = (*memBorderCrossingRepo)(nil)
+
+func newBorderCrossingService() (*service.BorderCrossingService, *memBorderCrossingRepo, uuid.UUID) {
+	tripID := uuid.New()
+	crossings := &memBorderCrossingRepo{tripName: "Alaska 2025"}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id}, nil
+		},
+	}
+	return service.NewBorderCrossingService(trips, crossings, nil), crossings, tripID
+}
+
+func TestBorderCrossingService_Create_Normalizes(t *testing.T) {
+	svc, _, tripID := newBorderCrossingService()
+
+	got, err := svc.Create(context.Background(), domain.BorderCrossing{
+		TripID:      tripID,
+		CrossedOn:   date(2025, 7, 14),
+		PortOfEntry: "  Blaine – Peace Arch ",
+		Country:     " ca",
+		Direction:   domain.CrossingEntry,
+		Documents:   []string{" passport ", "", "  ", "vehicle registration"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Blaine – Peace Arch", got.PortOfEntry)
+	assert.Equal(t, "CA", got.Country)
+	assert.Equal(t, []string{"passport", "vehicle registration"}, got.Documents)
+}
+
+func TestBorderCrossingService_Create_Validation(t *testing.T) {
+	valid := func() domain.BorderCrossing {
+		return domain.BorderCrossing{
+			CrossedOn: date(2025, 7, 14), PortOfEntry: "Blaine", Country: "CA", Direction: domain.CrossingEntry,
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(c *domain.BorderCrossing)
+	}{
+		{"missing date", func(c *domain.BorderCrossing) { c.CrossedOn = time.Time{} }},
+		{"blank port", func(c *domain.BorderCrossing) { c.PortOfEntry = "  " }},
+		{"three-letter country", func(c *domain.BorderCrossing) { c.Country = "CAN" }},
+		{"non-letter country", func(c *domain.BorderCrossing) { c.Country = "C1" }},
+		{"unknown direction", func(c *domain.BorderCrossing) { c.Direction = "sideways" }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, crossings, tripID := newBorderCrossingService()
+			c := valid()
+			c.TripID = tripID
+			tc.mutate(&c)
+
+			_, err := svc.Create(context.Background(), c)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Empty(t, crossings.crossings)
+		})
+	}
+}
+
+func TestBorderCrossingService_Create_UnknownTrip(t *testing.T) {
+	svc, _, _ := newBorderCrossingService()
+
+	_, err := svc.Create(context.Background(), domain.BorderCrossing{
+		TripID: uuid.New(), CrossedOn: date(2025, 7, 14), PortOfEntry: "Blaine", Country: "CA", Direction: domain.CrossingEntry,
+	})
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestBorderCrossingService_YearReport(t *testing.T) {
+	svc, _, tripID := newBorderCrossingService()
+	for _, c := range []domain.BorderCrossing{
+		{TripID: tripID, CrossedOn: date(2024, 12, 30), PortOfEntry: "Sweetgrass", Country: "CA", Direction: domain.CrossingEntry},
+		{TripID: tripID, CrossedOn: date(2025, 1, 3), PortOfEntry: "Coutts", Country: "CA", Direction: domain.CrossingExit},
+	} {
+		_, err := svc.Create(context.Background(), c)
+		require.NoError(t, err)
+	}
+
+	report, err := svc.YearReport(context.Background(), 2025)
+
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, "Coutts", report[0].Crossing.PortOfEntry)
+	assert.Equal(t, "Alaska 2025", report[0].TripName)
+
+	_, err = svc.YearReport(context.Background(), 0)
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// CacheService tells read handlers when their data last changed, so clients
+// that already hold a current copy can be answered 304 Not Modified.
+type CacheService struct {
+	changes repo.ChangeRepo
+}
+
+// NewCacheService constructs a CacheService.
+func NewCacheService(changes repo.ChangeRepo) *CacheService {
+	return &CacheService{changes: changes}
+}
+
+// LastModified returns the Last-Modified date for a view: when any of the
+// data it is built from was last created, updated, or deleted, to the whole
+// second. Read it before the data itself, so a change that lands in between
+// makes the date look older, not newer, than the response.
+func (s *CacheService) LastModified(ctx context.Context, view domain.CachedView) (time.Time, error) {
+	t, err := s.changes.LastModified(ctx, view)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("service.CacheService.LastModified: %w", err)
+	}
+	return t, nil
+}
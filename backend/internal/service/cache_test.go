@@ -0,0 +1,45 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// stubChangeRepo answers every view with the same time or error.
+type stubChangeRepo struct {
+	modified time.Time
+	err      error
+	views    []domain.CachedView
+}
+
+func (s *stubChangeRepo) LastModified(_ context.Context, view domain.CachedView) (time.Time, error) {
+	s.views = append(s.views, view)
+	return s.modified, s.err
+}
+
+func TestCacheService_LastModified(t *testing.T) {
+	modified := time.Date(2026, 5, 1, 12, 30, 0, 0, time.UTC)
+	changes := &stubChangeRepo{modified: modified}
+
+	got, err := service.NewCacheService(changes).LastModified(context.Background(), domain.ViewTags)
+
+	require.NoError(t, err)
+	assert.Equal(t, modified, got)
+	assert.Equal(t, []domain.CachedView{domain.ViewTags}, changes.views)
+}
+
+func TestCacheService_LastModified_RepoError(t *testing.T) {
+	boom := errors.New("connection refused")
+
+	_, err := service.NewCacheService(&stubChangeRepo{err: boom}).LastModified(context.Background(), domain.ViewHeatmap)
+
+	assert.ErrorIs(t, err, boom)
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// ChecklistService manages checklist templates and the checklists run from
+// them at stops.
+type ChecklistService struct {
+	checklists repo.ChecklistRepo
+	trips      repo.TripRepo
+	stops      repo.StopRepo
+	events     EventPublisher
+	clock      domain.Clock
+}
+
+// NewChecklistService constructs a ChecklistService. Pass domain.SystemClock
+// in production; items are stamped with clock.Now() when checked off.
+// events may be nil, and then no checklist events are published.
+func NewChecklistService(checklists repo.ChecklistRepo, trips repo.TripRepo, stops repo.StopRepo, events EventPublisher, clock domain.Clock) *ChecklistService {
+	return &ChecklistService{checklists: checklists, trips: trips, stops: stops, events: events, clock: clock}
+}
+
+// CreateTemplate validates and persists a template.
+func (s *ChecklistService) CreateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	t, err := normalizeChecklistTemplate(t)
+	if err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+	created, err := s.checklists.CreateTemplate(ctx, t)
+	if err != nil {
+		return domain.ChecklistTemplate{}, fmt.Errorf("service.ChecklistService.CreateTemplate: %w", err)
+	}
+	return created, nil
+}
+
+// GetTemplate returns a single template.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *ChecklistService) GetTemplate(ctx context.Context, id uuid.UUID) (domain.ChecklistTemplate, error) {
+	t, err := s.checklists.GetTemplate(ctx, id)
+	if err != nil {
+		return domain.ChecklistTemplate{}, fmt.Errorf("service.ChecklistService.GetTemplate: %w", err)
+	}
+	return t, nil
+}
+
+// ListTemplates returns every template ordered by kind, then name.
+func (s *ChecklistService) ListTemplates(ctx context.Context) ([]domain.ChecklistTemplate, error) {
+	templates, err := s.checklists.ListTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.ChecklistService.ListTemplates: %w", err)
+	}
+	return templates, nil
+}
+
+// UpdateTemplate validates and overwrites a template. Checklists already
+// started from it keep their own copy of the items.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *ChecklistService) UpdateTemplate(ctx context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	t, err := normalizeChecklistTemplate(t)
+	if err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+	updated, err := s.checklists.UpdateTemplate(ctx, t)
+	if err != nil {
+		return domain.ChecklistTemplate{}, fmt.Errorf("service.ChecklistService.UpdateTemplate: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteTemplate removes a template.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *ChecklistService) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	if err := s.checklists.DeleteTemplate(ctx, id); err != nil {
+		return fmt.Errorf("service.ChecklistService.DeleteTemplate: %w", err)
+	}
+	return nil
+}
+
+// StartChecklist starts a run of a template at a stop, copying its name,
+// kind, and items. Returns domain.ErrNotFound if the stop does not exist on
+// the trip, and domain.ErrValidation if the template does not exist — the
+// template is named in the body, not the path.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ChecklistService) StartChecklist(ctx context.Context, tripID, stopID, templateID uuid.UUID) (domain.Checklist, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.StartChecklist: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.StartChecklist: %w", err)
+	}
+	t, err := s.checklists.GetTemplate(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Checklist{}, fmt.Errorf("%w: checklist template %s does not exist", domain.ErrValidation, templateID)
+		}
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.StartChecklist: %w", err)
+	}
+
+	items := make([]domain.ChecklistItem, len(t.Items))
+	for i, label := range t.Items {
+		items[i] = domain.ChecklistItem{Position: i + 1, Label: label}
+	}
+	created, err := s.checklists.CreateChecklist(ctx, domain.Checklist{
+		StopID:     stopID,
+		TemplateID: &t.ID,
+		Name:       t.Name,
+		Kind:       t.Kind,
+		Items:      items,
+	})
+	if err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.StartChecklist: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventChecklistCreated, domain.TripRecordEvent(created.ID, tripID, &stopID))
+	return created, nil
+}
+
+// ListChecklists returns a stop's checklists, oldest first.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+func (s *ChecklistService) ListChecklists(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Checklist, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, fmt.Errorf("service.ChecklistService.ListChecklists: %w", err)
+	}
+	checklists, err := s.checklists.ListChecklistsByStop(ctx, stopID)
+	if err != nil {
+		return nil, fmt.Errorf("service.ChecklistService.ListChecklists: %w", err)
+	}
+	return checklists, nil
+}
+
+// GetChecklist returns one of a stop's checklists.
+// Returns domain.ErrNotFound if the stop or the checklist does not exist.
+func (s *ChecklistService) GetChecklist(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Checklist, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.GetChecklist: %w", err)
+	}
+	c, err := s.checklists.GetChecklist(ctx, stopID, id)
+	if err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.GetChecklist: %w", err)
+	}
+	return c, nil
+}
+
+// DeleteChecklist removes one of a stop's checklists.
+// Returns domain.ErrNotFound if the stop or the checklist does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ChecklistService) DeleteChecklist(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.ChecklistService.DeleteChecklist: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return fmt.Errorf("service.ChecklistService.DeleteChecklist: %w", err)
+	}
+	if err := s.checklists.DeleteChecklist(ctx, stopID, id); err != nil {
+		return fmt.Errorf("service.ChecklistService.DeleteChecklist: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventChecklistDeleted, domain.TripRecordEvent(id, tripID, &stopID))
+	return nil
+}
+
+// SetItemChecked checks an item off (stamped with the current time) or
+// unchecks it, and returns the whole checklist so callers see progress.
+// Checking an already-checked item keeps its original time.
+// Returns domain.ErrNotFound if the stop, checklist, or item does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ChecklistService) SetItemChecked(ctx context.Context, tripID, stopID, checklistID, itemID uuid.UUID, checked bool) (domain.Checklist, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.SetItemChecked: %w", err)
+	}
+	c, err := s.GetChecklist(ctx, tripID, stopID, checklistID)
+	if err != nil {
+		return domain.Checklist{}, err
+	}
+
+	var item *domain.ChecklistItem
+	for i := range c.Items {
+		if c.Items[i].ID == itemID {
+			item = &c.Items[i]
+		}
+	}
+	if item == nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.SetItemChecked: %w", domain.ErrNotFound)
+	}
+	if checked == (item.CheckedAt != nil) {
+		return c, nil
+	}
+
+	var at *time.Time
+	if checked {
+		now := s.clock.Now()
+		at = &now
+	}
+	if err := s.checklists.SetItemChecked(ctx, checklistID, itemID, at); err != nil {
+		return domain.Checklist{}, fmt.Errorf("service.ChecklistService.SetItemChecked: %w", err)
+	}
+	item.CheckedAt = at
+	publishEvent(ctx, s.events, domain.EventChecklistUpdated, domain.TripRecordEvent(checklistID, tripID, &stopID))
+	return c, nil
+}
+
+// normalizeChecklistTemplate trims a template's name and items and enforces
+// the rules shared by create and update: a name, a known kind, and at least
+// one non-blank item.
+func normalizeChecklistTemplate(t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return t, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if !t.Kind.Valid() {
+		return t, fmt.Errorf("%w: kind must be %q or %q", domain.ErrValidation, domain.ChecklistDeparture, domain.ChecklistArrival)
+	}
+	items := make([]string, 0, len(t.Items))
+	for i, item := range t.Items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			return t, fmt.Errorf("%w: item %d is blank", domain.ErrValidation, i+1)
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return t, fmt.Errorf("%w: at least one item is required", domain.ErrValidation)
+	}
+	t.Items = items
+	return t, nil
+}
@@ -0,0 +1,274 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memChecklistRepo is an in-memory repo.ChecklistRepo.
+type memChecklistRepo struct {
+	templates  []domain.ChecklistTemplate
+	checklists []domain.Checklist
+}
+
+func (m *memChecklistRepo) CreateTemplate(_ context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	t.ID = uuid.New()
+	m.templates = append(m.templates, t)
+	return t, nil
+}
+func (m *memChecklistRepo) GetTemplate(_ context.Context, id uuid.UUID) (domain.ChecklistTemplate, error) {
+	for _, t := range m.templates {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return domain.ChecklistTemplate{}, domain.ErrNotFound
+}
+func (m *memChecklistRepo) ListTemplates(_ context.Context) ([]domain.ChecklistTemplate, error) {
+	return append([]domain.ChecklistTemplate{}, m.templates...), nil
+}
+func (m *memChecklistRepo) UpdateTemplate(_ context.Context, t domain.ChecklistTemplate) (domain.ChecklistTemplate, error) {
+	for i := range m.templates {
+		if m.templates[i].ID == t.ID {
+			m.templates[i] = t
+			return t, nil
+		}
+	}
+	return domain.ChecklistTemplate{}, domain.ErrNotFound
+}
+func (m *memChecklistRepo) DeleteTemplate(_ context.Context, id uuid.UUID) error {
+	for i, t := range m.templates {
+		if t.ID == id {
+			m.templates = append(m.templates[:i], m.templates[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memChecklistRepo) CreateChecklist(_ context.Context, c domain.Checklist) (domain.Checklist, error) {
+	c.ID = uuid.New()
+	items := make([]domain.ChecklistItem, len(c.Items))
+	for i, item := range c.Items {
+		items[i] = domain.ChecklistItem{ID: uuid.New(), Position: i + 1, Label: item.Label}
+	}
+	c.Items = items
+	m.checklists = append(m.checklists, c)
+	return c, nil
+}
+func (m *memChecklistRepo) GetChecklist(_ context.Context, stopID, id uuid.UUID) (domain.Checklist, error) {
+	for _, c := range m.checklists {
+		if c.ID == id && c.StopID == stopID {
+			c.Items = append([]domain.ChecklistItem{}, c.Items...)
+			return c, nil
+		}
+	}
+	return domain.Checklist{}, domain.ErrNotFound
+}
+func (m *memChecklistRepo) ListChecklistsByStop(_ context.Context, stopID uuid.UUID) ([]domain.Checklist, error) {
+	out := []domain.Checklist{}
+	for _, c := range m.checklists {
+		if c.StopID == stopID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+func (m *memChecklistRepo) DeleteChecklist(_ context.Context, stopID, id uuid.UUID) error {
+	for i, c := range m.checklists {
+		if c.ID == id && c.StopID == stopID {
+			m.checklists = append(m.checklists[:i], m.checklists[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memChecklistRepo) SetItemChecked(_ context.Context, checklistID, itemID uuid.UUID, checkedAt *time.Time) error {
+	for _, c := range m.checklists {
+		if c.ID != checklistID {
+			continue
+		}
+		for i := range c.Items {
+			if c.Items[i].ID == itemID {
+				c.Items[i].CheckedAt = checkedAt
+				return nil
+			}
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.ChecklistRepo = (*memChecklistRepo)(nil)
+
+// checklistFixture is the world a ChecklistService test runs in: one trip
+// with one stop, one departure template, and a fixed clock.
+type checklistFixture struct {
+	svc        *service.ChecklistService
+	checklists *memChecklistRepo
+	tripID     uuid.UUID
+	stopID     uuid.UUID
+	template   domain.ChecklistTemplate
+	clock      *fakeClock
+}
+
+var checklistNow = time.Date(2025, 6, 10, 8, 30, 0, 0, time.UTC)
+
+func newChecklistService() *checklistFixture {
+	f := &checklistFixture{
+		checklists: &memChecklistRepo{},
+		tripID:     uuid.New(),
+		stopID:     uuid.New(),
+		clock:      &fakeClock{now: checklistNow},
+	}
+	f.template, _ = f.checklists.CreateTemplate(context.Background(), domain.ChecklistTemplate{
+		Name:  "Pull out",
+		Kind:  domain.ChecklistDeparture,
+		Items: []string{"Antenna down", "Steps up", "Hitch locked"},
+	})
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+			if tripID != f.tripID || stopID != f.stopID {
+				return domain.Stop{}, domain.ErrNotFound
+			}
+			return domain.Stop{ID: stopID, TripID: tripID}, nil
+		},
+	}
+	f.svc = service.NewChecklistService(f.checklists, &mockTripRepo{}, stops, nil, f.clock)
+	return f
+}
+
+func TestChecklistService_CreateTemplate_Trims(t *testing.T) {
+	f := newChecklistService()
+
+	got, err := f.svc.CreateTemplate(context.Background(), domain.ChecklistTemplate{
+		Name:  "  Set up ",
+		Kind:  domain.ChecklistArrival,
+		Items: []string{" Level ", "Chock wheels"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Set up", got.Name)
+	assert.Equal(t, []string{"Level", "Chock wheels"}, got.Items)
+}
+
+func TestChecklistService_CreateTemplate_Validation(t *testing.T) {
+	cases := map[string]domain.ChecklistTemplate{
+		"missing name": {Kind: domain.ChecklistArrival, Items: []string{"Level"}},
+		"unknown kind": {Name: "Set up", Kind: "midway", Items: []string{"Level"}},
+		"no items":     {Name: "Set up", Kind: domain.ChecklistArrival},
+		"blank item":   {Name: "Set up", Kind: domain.ChecklistArrival, Items: []string{"Level", "  "}},
+	}
+
+	for name, tmpl := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newChecklistService()
+
+			_, err := f.svc.CreateTemplate(context.Background(), tmpl)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Len(t, f.checklists.templates, 1, "only the fixture template exists")
+		})
+	}
+}
+
+func TestChecklistService_StartChecklist_CopiesTemplate(t *testing.T) {
+	f := newChecklistService()
+
+	got, err := f.svc.StartChecklist(context.Background(), f.tripID, f.stopID, f.template.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, f.stopID, got.StopID)
+	assert.Equal(t, &f.template.ID, got.TemplateID)
+	assert.Equal(t, "Pull out", got.Name)
+	assert.Equal(t, domain.ChecklistDeparture, got.Kind)
+	require.Len(t, got.Items, 3)
+	assert.Equal(t, "Hitch locked", got.Items[2].Label)
+	assert.Equal(t, 3, got.Items[2].Position)
+	assert.False(t, got.Complete())
+}
+
+func TestChecklistService_StartChecklist_UnknownTemplate(t *testing.T) {
+	f := newChecklistService()
+
+	_, err := f.svc.StartChecklist(context.Background(), f.tripID, f.stopID, uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Empty(t, f.checklists.checklists)
+}
+
+func TestChecklistService_StartChecklist_StopOnOtherTrip(t *testing.T) {
+	f := newChecklistService()
+
+	_, err := f.svc.StartChecklist(context.Background(), uuid.New(), f.stopID, f.template.ID)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestChecklistService_SetItemChecked(t *testing.T) {
+	f := newChecklistService()
+	ctx := context.Background()
+	c, err := f.svc.StartChecklist(ctx, f.tripID, f.stopID, f.template.ID)
+	require.NoError(t, err)
+
+	for _, item := range c.Items {
+		c, err = f.svc.SetItemChecked(ctx, f.tripID, f.stopID, c.ID, item.ID, true)
+		require.NoError(t, err)
+	}
+
+	require.NotNil(t, c.Items[0].CheckedAt)
+	assert.Equal(t, checklistNow, *c.Items[0].CheckedAt)
+	assert.True(t, c.Complete())
+
+	c, err = f.svc.SetItemChecked(ctx, f.tripID, f.stopID, c.ID, c.Items[1].ID, false)
+	require.NoError(t, err)
+	assert.Nil(t, c.Items[1].CheckedAt)
+	assert.False(t, c.Complete())
+}
+
+func TestChecklistService_SetItemChecked_KeepsFirstCheckTime(t *testing.T) {
+	f := newChecklistService()
+	ctx := context.Background()
+	c, err := f.svc.StartChecklist(ctx, f.tripID, f.stopID, f.template.ID)
+	require.NoError(t, err)
+	itemID := c.Items[0].ID
+
+	_, err = f.svc.SetItemChecked(ctx, f.tripID, f.stopID, c.ID, itemID, true)
+	require.NoError(t, err)
+	f.clock.now = checklistNow.Add(time.Hour)
+	c, err = f.svc.SetItemChecked(ctx, f.tripID, f.stopID, c.ID, itemID, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, checklistNow, *c.Items[0].CheckedAt)
+}
+
+func TestChecklistService_SetItemChecked_UnknownItem(t *testing.T) {
+	f := newChecklistService()
+	ctx := context.Background()
+	c, err := f.svc.StartChecklist(ctx, f.tripID, f.stopID, f.template.ID)
+	require.NoError(t, err)
+
+	_, err = f.svc.SetItemChecked(ctx, f.tripID, f.stopID, c.ID, uuid.New(), true)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestChecklistService_DeleteTemplate_KeepsChecklists(t *testing.T) {
+	f := newChecklistService()
+	ctx := context.Background()
+	_, err := f.svc.StartChecklist(ctx, f.tripID, f.stopID, f.template.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, f.svc.DeleteTemplate(ctx, f.template.ID))
+
+	got, err := f.svc.ListChecklists(ctx, f.tripID, f.stopID)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
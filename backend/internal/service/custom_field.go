@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// customFieldKey is the shape of a custom field key: it is used as a JSON
+// object key and in ?field= filters, so it stays short and plain.
+var customFieldKey = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// CustomFieldService manages the custom field definitions that trip and
+// stop metadata is validated against.
+type CustomFieldService struct {
+	fields repo.CustomFieldRepo
+}
+
+// NewCustomFieldService constructs a CustomFieldService.
+func NewCustomFieldService(fields repo.CustomFieldRepo) *CustomFieldService {
+	return &CustomFieldService{fields: fields}
+}
+
+// Create validates and persists a definition.
+// Returns domain.ErrValidation for an unknown entity or type, a malformed
+// key, a blank label, or a key the entity already uses.
+func (s *CustomFieldService) Create(ctx context.Context, f domain.CustomField) (domain.CustomField, error) {
+	if !f.Entity.Valid() {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.Create: %w: entity must be trip or stop", domain.ErrValidation)
+	}
+	if !customFieldKey.MatchString(f.Key) {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.Create: %w: key must start with a lowercase letter and contain only lowercase letters, digits, and underscores (at most 64)", domain.ErrValidation)
+	}
+	f.Label = strings.TrimSpace(f.Label)
+	if f.Label == "" {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.Create: %w: label is required", domain.ErrValidation)
+	}
+	if !f.Type.Valid() {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.Create: %w: type must be text, number, boolean, or date", domain.ErrValidation)
+	}
+
+	created, err := s.fields.Create(ctx, f)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.Create: %w", err)
+	}
+	return created, nil
+}
+
+// List returns the definitions for entity, or for both entities when entity
+// is empty. Returns domain.ErrValidation for an unknown entity.
+func (s *CustomFieldService) List(ctx context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error) {
+	if entity != "" && !entity.Valid() {
+		return nil, fmt.Errorf("service.CustomFieldService.List: %w: entity must be trip or stop", domain.ErrValidation)
+	}
+	fields, err := s.fields.List(ctx, entity)
+	if err != nil {
+		return nil, fmt.Errorf("service.CustomFieldService.List: %w", err)
+	}
+	return fields, nil
+}
+
+// UpdateLabel sets a definition's label.
+// Returns domain.ErrValidation for a blank label, domain.ErrNotFound if the
+// definition does not exist.
+func (s *CustomFieldService) UpdateLabel(ctx context.Context, id uuid.UUID, label string) (domain.CustomField, error) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.UpdateLabel: %w: label is required", domain.ErrValidation)
+	}
+	f, err := s.fields.UpdateLabel(ctx, id, label)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("service.CustomFieldService.UpdateLabel: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes a definition along with every value stored for it.
+// Returns domain.ErrNotFound if the definition does not exist.
+func (s *CustomFieldService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.fields.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.CustomFieldService.Delete: %w", err)
+	}
+	return nil
+}
+
+// definitionsByKey loads entity's definitions keyed by field key.
+func definitionsByKey(ctx context.Context, fields repo.CustomFieldRepo, entity domain.CustomFieldEntity) (map[string]domain.CustomField, error) {
+	defs, err := fields.List(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]domain.CustomField, len(defs))
+	for _, f := range defs {
+		byKey[f.Key] = f
+	}
+	return byKey, nil
+}
+
+// checkMetadata validates m against entity's field definitions and returns
+// it with null values dropped. A nil m is returned as is, so an update keeps
+// the record's current metadata; an empty one clears it. Definitions are
+// only loaded when m has values.
+func checkMetadata(ctx context.Context, fields repo.CustomFieldRepo, entity domain.CustomFieldEntity, m domain.Metadata) (domain.Metadata, error) {
+	if len(m) == 0 {
+		return m, nil
+	}
+	defs, err := definitionsByKey(ctx, fields, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(domain.Metadata, len(m))
+	for key, v := range m {
+		f, ok := defs[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: metadata field %q is not defined for %ss", domain.ErrValidation, key, entity)
+		}
+		if v == nil {
+			continue
+		}
+		if !validFieldValue(f.Type, v) {
+			return nil, fmt.Errorf("%w: metadata field %q must be a %s", domain.ErrValidation, key, f.Type)
+		}
+		out[key] = v
+	}
+	return out, nil
+}
+
+// validFieldValue reports whether v, as decoded from JSON, is a value of
+// type t.
+func validFieldValue(t domain.CustomFieldType, v any) bool {
+	switch t {
+	case domain.FieldText:
+		_, ok := v.(string)
+		return ok
+	case domain.FieldNumber:
+		_, ok := v.(float64)
+		return ok
+	case domain.FieldBoolean:
+		_, ok := v.(bool)
+		return ok
+	case domain.FieldDate:
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		_, err := domain.ParseDate(s)
+		return err == nil
+	}
+	return false
+}
+
+// metadataFilter parses ?field= filters, each "key:value", against entity's
+// field definitions. The value is read as the field's type, so
+// "pets_allowed:true" matches the boolean true and not the string "true".
+// Returns domain.ErrValidation for a malformed filter, an undefined key, a
+// key filtered twice, or a value that is not of the field's type.
+func metadataFilter(ctx context.Context, fields repo.CustomFieldRepo, entity domain.CustomFieldEntity, raw []string) (domain.MetadataFilter, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	defs, err := definitionsByKey(ctx, fields, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := make(domain.MetadataFilter, len(raw))
+	for _, r := range raw {
+		key, value, ok := strings.Cut(r, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: field filter %q must be key:value", domain.ErrValidation, r)
+		}
+		f, ok := defs[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: metadata field %q is not defined for %ss", domain.ErrValidation, key, entity)
+		}
+		if _, dup := filter[key]; dup {
+			return nil, fmt.Errorf("%w: metadata field %q is filtered more than once", domain.ErrValidation, key)
+		}
+		v, err := parseFieldValue(f.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: metadata field %q must be a %s", domain.ErrValidation, key, f.Type)
+		}
+		filter[key] = v
+	}
+	return filter, nil
+}
+
+// parseFieldValue reads s as a value of type t, in the form checkMetadata
+// stores it.
+func parseFieldValue(t domain.CustomFieldType, s string) (any, error) {
+	switch t {
+	case domain.FieldNumber:
+		return strconv.ParseFloat(s, 64)
+	case domain.FieldBoolean:
+		return strconv.ParseBool(s)
+	case domain.FieldDate:
+		d, err := domain.ParseDate(s)
+		if err != nil {
+			return nil, err
+		}
+		return d.String(), nil
+	}
+	return s, nil
+}
@@ -0,0 +1,203 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// fakeCustomFieldRepo holds a fixed set of definitions and records the
+// last one created.
+type fakeCustomFieldRepo struct {
+	fields  []domain.CustomField
+	created domain.CustomField
+}
+
+func (f *fakeCustomFieldRepo) Create(_ context.Context, field domain.CustomField) (domain.CustomField, error) {
+	field.ID = uuid.New()
+	f.created = field
+	return field, nil
+}
+
+func (f *fakeCustomFieldRepo) GetByID(_ context.Context, id uuid.UUID) (domain.CustomField, error) {
+	for _, field := range f.fields {
+		if field.ID == id {
+			return field, nil
+		}
+	}
+	return domain.CustomField{}, domain.ErrNotFound
+}
+
+func (f *fakeCustomFieldRepo) List(_ context.Context, entity domain.CustomFieldEntity) ([]domain.CustomField, error) {
+	out := []domain.CustomField{}
+	for _, field := range f.fields {
+		if entity == "" || field.Entity == entity {
+			out = append(out, field)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeCustomFieldRepo) UpdateLabel(_ context.Context, id uuid.UUID, label string) (domain.CustomField, error) {
+	return domain.CustomField{ID: id, Label: label}, nil
+}
+
+func (f *fakeCustomFieldRepo) Delete(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// tripFields defines one field of each type for trips, plus a stop field.
+func tripFields() *fakeCustomFieldRepo {
+	return &fakeCustomFieldRepo{fields: []domain.CustomField{
+		{Entity: domain.CustomFieldTrip, Key: "pets", Type: domain.FieldText},
+		{Entity: domain.CustomFieldTrip, Key: "altitude_ft", Type: domain.FieldNumber},
+		{Entity: domain.CustomFieldTrip, Key: "sick", Type: domain.FieldBoolean},
+		{Entity: domain.CustomFieldTrip, Key: "permit_until", Type: domain.FieldDate},
+		{Entity: domain.CustomFieldStop, Key: "pets_allowed", Type: domain.FieldBoolean},
+	}}
+}
+
+// ---- CustomFieldService ----------------------------------------------------
+
+func TestCustomFieldService_Create_Valid(t *testing.T) {
+	fields := &fakeCustomFieldRepo{}
+	svc := service.NewCustomFieldService(fields)
+
+	got, err := svc.Create(context.Background(), domain.CustomField{
+		Entity: domain.CustomFieldStop, Key: "pets_allowed", Label: "  Pets allowed ", Type: domain.FieldBoolean,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Pets allowed", got.Label)
+	assert.Equal(t, "pets_allowed", fields.created.Key)
+}
+
+func TestCustomFieldService_Create_Invalid(t *testing.T) {
+	valid := domain.CustomField{Entity: domain.CustomFieldTrip, Key: "pets", Label: "Pets", Type: domain.FieldText}
+	tests := []struct {
+		name   string
+		mutate func(*domain.CustomField)
+	}{
+		{"unknown entity", func(f *domain.CustomField) { f.Entity = "expense" }},
+		{"uppercase key", func(f *domain.CustomField) { f.Key = "Pets" }},
+		{"key starting with a digit", func(f *domain.CustomField) { f.Key = "2pets" }},
+		{"key with a space", func(f *domain.CustomField) { f.Key = "pet policy" }},
+		{"blank label", func(f *domain.CustomField) { f.Label = " " }},
+		{"unknown type", func(f *domain.CustomField) { f.Type = "json" }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := valid
+			tc.mutate(&f)
+
+			_, err := service.NewCustomFieldService(&fakeCustomFieldRepo{}).Create(context.Background(), f)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestCustomFieldService_List_UnknownEntity(t *testing.T) {
+	_, err := service.NewCustomFieldService(&fakeCustomFieldRepo{}).List(context.Background(), "expense")
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// ---- metadata validation ---------------------------------------------------
+
+func TestTripService_Create_Metadata(t *testing.T) {
+	svc := service.NewTripService(echoRepo(), tripFields(), nil, nil)
+
+	trip := factory.Trip().WithMetadata(domain.Metadata{
+		"pets":         "two dogs",
+		"altitude_ft":  9200.0,
+		"sick":         nil,
+		"permit_until": "2025-06-30",
+	}).Build()
+
+	got, err := svc.Create(context.Background(), trip)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.Metadata{"pets": "two dogs", "altitude_ft": 9200.0, "permit_until": "2025-06-30"}, got.Metadata, "null values are dropped")
+}
+
+func TestTripService_Create_InvalidMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata domain.Metadata
+	}{
+		{"undefined key", domain.Metadata{"wifi": "good"}},
+		{"stop field on a trip", domain.Metadata{"pets_allowed": true}},
+		{"text given a number", domain.Metadata{"pets": 2.0}},
+		{"number given a string", domain.Metadata{"altitude_ft": "9200"}},
+		{"boolean given a string", domain.Metadata{"sick": "yes"}},
+		{"malformed date", domain.Metadata{"permit_until": "06/30/2025"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewTripService(echoRepo(), tripFields(), nil, nil)
+
+			_, err := svc.Create(context.Background(), factory.Trip().WithMetadata(tc.metadata).Build())
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestTripService_Update_NilMetadataSkipsDefinitions(t *testing.T) {
+	// A nil fields repo would panic if the definitions were loaded.
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
+
+	got, err := svc.Update(context.Background(), factory.Trip().Build())
+
+	require.NoError(t, err)
+	assert.Nil(t, got.Metadata)
+}
+
+// ---- metadata filters ------------------------------------------------------
+
+func TestTripService_ListPaged_FieldFilters(t *testing.T) {
+	var got domain.MetadataFilter
+	r := &mockTripRepo{
+		listPaged: func(_ context.Context, f domain.MetadataFilter, _ domain.PaginationParams) ([]domain.Trip, int64, error) {
+			got = f
+			return nil, 0, nil
+		},
+	}
+	svc := service.NewTripService(r, tripFields(), nil, nil)
+
+	_, _, err := svc.ListPaged(context.Background(), []string{"sick:true", "altitude_ft:9200", "pets:dogs: two", "permit_until:2025-06-30"}, domain.NewPaginationParams(nil, nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.MetadataFilter{"sick": true, "altitude_ft": 9200.0, "pets": "dogs: two", "permit_until": "2025-06-30"}, got)
+}
+
+func TestTripService_ListPaged_InvalidFieldFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []string
+	}{
+		{"no separator", []string{"sick"}},
+		{"undefined key", []string{"wifi:good"}},
+		{"not a boolean", []string{"sick:maybe"}},
+		{"not a number", []string{"altitude_ft:high"}},
+		{"not a date", []string{"permit_until:soon"}},
+		{"repeated key", []string{"sick:true", "sick:false"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewTripService(&mockTripRepo{}, tripFields(), nil, nil)
+
+			_, _, err := svc.ListPaged(context.Background(), tc.fields, domain.NewPaginationParams(nil, nil))
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
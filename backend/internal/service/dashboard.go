@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// Dashboard tag list sizes.
+const (
+	DefaultDashboardTags = 10
+	MaxDashboardTags     = 100
+)
+
+// DashboardService builds the overview from the summary tables, so it costs
+// the same however many stops have been logged.
+type DashboardService struct {
+	summaries repo.SummaryRepo
+}
+
+// NewDashboardService constructs a DashboardService.
+func NewDashboardService(summaries repo.SummaryRepo) *DashboardService {
+	return &DashboardService{summaries: summaries}
+}
+
+// Dashboard returns every trip's totals and the tagLimit most used tags
+// (DefaultDashboardTags when nil).
+// Returns domain.ErrValidation for a tagLimit outside 1..MaxDashboardTags.
+func (s *DashboardService) Dashboard(ctx context.Context, tagLimit *int) (domain.Dashboard, error) {
+	limit := DefaultDashboardTags
+	if tagLimit != nil {
+		limit = *tagLimit
+	}
+	if limit < 1 || limit > MaxDashboardTags {
+		return domain.Dashboard{}, fmt.Errorf("%w: tags must be between 1 and %d", domain.ErrValidation, MaxDashboardTags)
+	}
+
+	trips, err := s.summaries.ListTrips(ctx)
+	if err != nil {
+		return domain.Dashboard{}, fmt.Errorf("service.DashboardService.Dashboard: %w", err)
+	}
+	tags, err := s.summaries.TopTags(ctx, limit)
+	if err != nil {
+		return domain.Dashboard{}, fmt.Errorf("service.DashboardService.Dashboard: %w", err)
+	}
+	return domain.Dashboard{Trips: trips, Tags: tags}, nil
+}
@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// stubSummaryRepo returns fixed summaries and records the tag limit asked for.
+type stubSummaryRepo struct {
+	trips []domain.TripSummary
+	tags  []domain.TagCount
+	err   error
+	limit int
+}
+
+func (s *stubSummaryRepo) ListTrips(_ context.Context) ([]domain.TripSummary, error) {
+	return s.trips, s.err
+}
+
+func (s *stubSummaryRepo) TopTags(_ context.Context, limit int) ([]domain.TagCount, error) {
+	s.limit = limit
+	return s.tags, s.err
+}
+
+func TestDashboardService_Dashboard(t *testing.T) {
+	summaries := &stubSummaryRepo{
+		trips: []domain.TripSummary{{Name: "Fall", Stops: 3, Nights: 5}, {Name: "Spring", Stops: 2, Nights: 4}},
+		tags:  []domain.TagCount{{Slug: "boondocking", Name: "Boondocking", Stops: 4}},
+	}
+
+	got, err := service.NewDashboardService(summaries).Dashboard(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, service.DefaultDashboardTags, summaries.limit)
+	assert.Equal(t, summaries.trips, got.Trips)
+	assert.Equal(t, summaries.tags, got.Tags)
+	assert.Equal(t, 5, got.TotalStops())
+	assert.Equal(t, 9, got.TotalNights())
+}
+
+func TestDashboardService_Dashboard_TagLimit(t *testing.T) {
+	summaries := &stubSummaryRepo{}
+	limit := 3
+
+	_, err := service.NewDashboardService(summaries).Dashboard(context.Background(), &limit)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, summaries.limit)
+}
+
+func TestDashboardService_Dashboard_TagLimitOutOfRange(t *testing.T) {
+	for _, limit := range []int{0, service.MaxDashboardTags + 1} {
+		_, err := service.NewDashboardService(&stubSummaryRepo{}).Dashboard(context.Background(), &limit)
+		assert.ErrorIs(t, err, domain.ErrValidation, "limit %d", limit)
+	}
+}
+
+func TestDashboardService_Dashboard_RepoError(t *testing.T) {
+	boom := errors.New("connection refused")
+
+	_, err := service.NewDashboardService(&stubSummaryRepo{err: boom}).Dashboard(context.Background(), nil)
+
+	assert.ErrorIs(t, err, boom)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// DataFixService makes the bulk corrections an administrator needs after
+// data was entered wrong: dates off by some days, stops logged on the wrong
+// trip, locations typed every which way. Each fix can be previewed with a
+// dry run, which reports the same changes without writing them.
+//
+// Fixes rewrite a whole trip at once, so they are for its owner alone. The
+// trip that stops are moved onto need only be one the user may edit.
+type DataFixService struct {
+	fixes repo.DataFixRepo
+	trips repo.TripRepo
+}
+
+// NewDataFixService constructs a DataFixService.
+func NewDataFixService(fixes repo.DataFixRepo, trips repo.TripRepo) *DataFixService {
+	return &DataFixService{fixes: fixes, trips: trips}
+}
+
+// ShiftDates moves every date and time on a trip by days days.
+// Returns domain.ErrNotFound if the trip does not exist, domain.ErrForbidden
+// if the request's user does not own it, and domain.ErrValidation if days
+// is zero or more than domain.MaxDateShiftDays either way.
+func (s *DataFixService) ShiftDates(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) (domain.DataFix, error) {
+	if days == 0 || days > domain.MaxDateShiftDays || days < -domain.MaxDateShiftDays {
+		return domain.DataFix{}, fmt.Errorf("%w: days must be between -%d and %d and not 0", domain.ErrValidation, domain.MaxDateShiftDays, domain.MaxDateShiftDays)
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.ShiftDates: %w", err)
+	}
+	changes, err := s.fixes.ShiftTripDates(ctx, tripID, days, dryRun)
+	if err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.ShiftDates: %w", err)
+	}
+	return domain.DataFix{DryRun: dryRun, Changes: changes}, nil
+}
+
+// ReassignStops moves stops of one trip onto another; an empty stopIDs
+// moves them all. Nothing is moved unless every stop named is on the trip.
+// Returns domain.ErrNotFound if either trip does not exist,
+// domain.ErrForbidden if the request's user does not own the first or
+// cannot edit the second, and domain.ErrValidation if the trips are the
+// same or a stop named is not on the trip.
+func (s *DataFixService) ReassignStops(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) (domain.DataFix, error) {
+	if tripID == targetID {
+		return domain.DataFix{}, fmt.Errorf("%w: stops are already on this trip", domain.ErrValidation)
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.ReassignStops: %w", err)
+	}
+	if err := requireTripRole(ctx, s.trips, targetID, domain.TripRoleEditor); err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.ReassignStops: target trip: %w", err)
+	}
+	// The repo moves nothing unless it finds as many stops as it is given,
+	// so a stop named twice must be passed once.
+	unique := make([]uuid.UUID, 0, len(stopIDs))
+	seen := make(map[uuid.UUID]bool, len(stopIDs))
+	for _, id := range stopIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	stopIDs = unique
+	changes, err := s.fixes.ReassignStops(ctx, tripID, targetID, stopIDs, dryRun)
+	if err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.ReassignStops: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(changes))
+	for _, c := range changes {
+		if c.Entity == "stop" {
+			found[c.ID] = true
+		}
+	}
+	for _, id := range stopIDs {
+		if !found[id] {
+			return domain.DataFix{}, fmt.Errorf("%w: stop %s is not on this trip", domain.ErrValidation, id)
+		}
+	}
+	return domain.DataFix{DryRun: dryRun, Changes: changes}, nil
+}
+
+// NormalizeLocations tidies the spacing and commas of every stop location
+// on a trip.
+// Returns domain.ErrNotFound if the trip does not exist and
+// domain.ErrForbidden if the request's user does not own it.
+func (s *DataFixService) NormalizeLocations(ctx context.Context, tripID uuid.UUID, dryRun bool) (domain.DataFix, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.NormalizeLocations: %w", err)
+	}
+	changes, err := s.fixes.NormalizeLocations(ctx, tripID, dryRun)
+	if err != nil {
+		return domain.DataFix{}, fmt.Errorf("service.DataFixService.NormalizeLocations: %w", err)
+	}
+	return domain.DataFix{DryRun: dryRun, Changes: changes}, nil
+}
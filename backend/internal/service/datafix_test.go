@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// mockDataFixRepo records the fixes asked of it and reports changes.
+type mockDataFixRepo struct {
+	shift    func(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) ([]domain.FieldChange, error)
+	reassign func(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) ([]domain.FieldChange, error)
+	calls    int
+}
+
+func (m *mockDataFixRepo) ShiftTripDates(ctx context.Context, tripID uuid.UUID, days int, dryRun bool) ([]domain.FieldChange, error) {
+	m.calls++
+	return m.shift(ctx, tripID, days, dryRun)
+}
+func (m *mockDataFixRepo) ReassignStops(ctx context.Context, tripID, targetID uuid.UUID, stopIDs []uuid.UUID, dryRun bool) ([]domain.FieldChange, error) {
+	m.calls++
+	return m.reassign(ctx, tripID, targetID, stopIDs, dryRun)
+}
+func (m *mockDataFixRepo) NormalizeLocations(_ context.Context, _ uuid.UUID, _ bool) ([]domain.FieldChange, error) {
+	m.calls++
+	return []domain.FieldChange{}, nil
+}
+
+var _ repo.DataFixRepo = (*mockDataFixRepo)(nil)
+
+func TestDataFixService_ShiftDates(t *testing.T) {
+	tripID := uuid.New()
+	var gotDays int
+	var gotDryRun bool
+	fixes := &mockDataFixRepo{shift: func(_ context.Context, _ uuid.UUID, days int, dryRun bool) ([]domain.FieldChange, error) {
+		gotDays, gotDryRun = days, dryRun
+		before, after := "2025-06-01", "2025-06-08"
+		return []domain.FieldChange{{Entity: "trip", ID: tripID, Field: "start_date", Before: &before, After: &after}}, nil
+	}}
+	svc := service.NewDataFixService(fixes, rolesTripRepo(map[uuid.UUID]domain.TripRole{tripID: domain.TripRoleOwner}))
+
+	fix, err := svc.ShiftDates(context.Background(), tripID, 7, true)
+
+	require.NoError(t, err)
+	assert.True(t, fix.DryRun)
+	require.Len(t, fix.Changes, 1)
+	assert.Equal(t, 7, gotDays)
+	assert.True(t, gotDryRun)
+}
+
+func TestDataFixService_ShiftDates_Validation(t *testing.T) {
+	fixes := &mockDataFixRepo{}
+	svc := service.NewDataFixService(fixes, rolesTripRepo(nil))
+
+	for _, days := range []int{0, domain.MaxDateShiftDays + 1, -domain.MaxDateShiftDays - 1} {
+		_, err := svc.ShiftDates(context.Background(), uuid.New(), days, false)
+		assert.ErrorIs(t, err, domain.ErrValidation, days)
+	}
+	assert.Zero(t, fixes.calls)
+}
+
+func TestDataFixService_OwnerOnly(t *testing.T) {
+	tripID := uuid.New()
+	fixes := &mockDataFixRepo{}
+	svc := service.NewDataFixService(fixes, rolesTripRepo(map[uuid.UUID]domain.TripRole{tripID: domain.TripRoleEditor}))
+
+	_, err := svc.ShiftDates(context.Background(), tripID, 7, false)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.NormalizeLocations(context.Background(), tripID, false)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.NormalizeLocations(context.Background(), uuid.New(), false)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Zero(t, fixes.calls)
+}
+
+func TestDataFixService_ReassignStops(t *testing.T) {
+	from, to := uuid.New(), uuid.New()
+	stopA, stopB := uuid.New(), uuid.New()
+	var gotIDs []uuid.UUID
+	fixes := &mockDataFixRepo{reassign: func(_ context.Context, _, _ uuid.UUID, stopIDs []uuid.UUID, _ bool) ([]domain.FieldChange, error) {
+		gotIDs = stopIDs
+		return []domain.FieldChange{{Entity: "stop", ID: stopA, Field: "trip_id"}}, nil
+	}}
+	roles := map[uuid.UUID]domain.TripRole{from: domain.TripRoleOwner, to: domain.TripRoleEditor}
+	svc := service.NewDataFixService(fixes, rolesTripRepo(roles))
+
+	fix, err := svc.ReassignStops(context.Background(), from, to, []uuid.UUID{stopA, stopA}, false)
+	require.NoError(t, err)
+	assert.Len(t, fix.Changes, 1)
+	assert.Equal(t, []uuid.UUID{stopA}, gotIDs, "a stop named twice is passed once")
+
+	_, err = svc.ReassignStops(context.Background(), from, to, []uuid.UUID{stopA, stopB}, false)
+	assert.ErrorIs(t, err, domain.ErrValidation, "a stop not on the trip")
+
+	_, err = svc.ReassignStops(context.Background(), from, from, nil, false)
+	assert.ErrorIs(t, err, domain.ErrValidation, "the same trip")
+
+	roles[to] = domain.TripRoleViewer
+	_, err = svc.ReassignStops(context.Background(), from, to, nil, false)
+	assert.ErrorIs(t, err, domain.ErrForbidden, "a target the user cannot edit")
+}
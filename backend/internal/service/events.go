@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// EventHandler reacts to an event published on an EventBus. It runs on the
+// publishing request's goroutine, after the change is saved, so it must not
+// block: anything slow belongs in a goroutine of its own, as
+// WebhookService.Publish does.
+type EventHandler func(ctx context.Context, event domain.WebhookEvent, data any)
+
+// EventBus hands every event the services publish to each subscribed
+// handler, in the order they subscribed. It is how side effects — webhook
+// deliveries today — hear about changes without the services that make
+// them knowing who is listening.
+//
+// It is in-process only: events are not stored, and a handler subscribed
+// after an event was published never sees it.
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers []EventHandler
+}
+
+var _ EventPublisher = (*EventBus)(nil)
+
+// NewEventBus returns a bus with no subscribers.
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Subscribe adds h to the handlers every later event is passed to.
+func (b *EventBus) Subscribe(h EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, h)
+}
+
+// Publish passes event to each subscribed handler in turn.
+func (b *EventBus) Publish(ctx context.Context, event domain.WebhookEvent, data any) {
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+	for _, h := range handlers {
+		h(ctx, event, data)
+	}
+}
+
+// publishEvent passes event to events, unless the service was given none.
+func publishEvent(ctx context.Context, events EventPublisher, event domain.WebhookEvent, data any) {
+	if events != nil {
+		events.Publish(ctx, event, data)
+	}
+}
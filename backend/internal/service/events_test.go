@@ -0,0 +1,53 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+func TestEventBus_PublishesToEachSubscriberInOrder(t *testing.T) {
+	bus := service.NewEventBus()
+	var got []string
+	bus.Subscribe(func(_ context.Context, event domain.WebhookEvent, _ any) {
+		got = append(got, "first "+string(event))
+	})
+	bus.Subscribe(func(_ context.Context, event domain.WebhookEvent, _ any) {
+		got = append(got, "second "+string(event))
+	})
+
+	bus.Publish(context.Background(), domain.EventTripCreated, domain.TripEventData{ID: uuid.New()})
+	bus.Publish(context.Background(), domain.EventTripDeleted, domain.TripEventData{ID: uuid.New()})
+
+	assert.Equal(t, []string{
+		"first trip.created", "second trip.created",
+		"first trip.deleted", "second trip.deleted",
+	}, got)
+}
+
+func TestEventBus_NoSubscribers(t *testing.T) {
+	bus := service.NewEventBus()
+
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), domain.EventStopDeleted, domain.StopEventData{ID: uuid.New()})
+	})
+}
+
+func TestEventBus_HandsServiceEventsToTheWebhookRelay(t *testing.T) {
+	bus := service.NewEventBus()
+	relay := &recordingPublisher{}
+	bus.Subscribe(relay.Publish)
+	stops := &mockStopRepo{delete: func(_ context.Context, _, _ uuid.UUID) error { return nil }}
+	svc := service.NewStopService(&mockTripRepo{}, stops, nil, nil, nil, bus, nil)
+
+	tripID, stopID := uuid.New(), uuid.New()
+	assert.NoError(t, svc.Delete(context.Background(), tripID, stopID))
+
+	assert.Equal(t, []domain.WebhookEvent{domain.EventStopDeleted}, relay.events)
+	assert.Equal(t, []any{domain.StopEventData{ID: stopID, TripID: tripID}}, relay.data)
+}
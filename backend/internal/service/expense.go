@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// ExpenseService records and lists trip expenses.
+type ExpenseService struct {
+	trips    repo.TripRepo
+	stops    repo.StopRepo
+	tags     repo.TagRepo
+	expenses repo.ExpenseRepo
+	events   EventPublisher
+	clock    domain.Clock
+}
+
+// NewExpenseService constructs an ExpenseService. Pass domain.SystemClock in
+// production; quick-logged expenses are stamped with clock's time. tags marks
+// the stops created for fill-ups. events may be nil, and then no expense
+// events are published.
+func NewExpenseService(trips repo.TripRepo, stops repo.StopRepo, tags repo.TagRepo, expenses repo.ExpenseRepo, events EventPublisher, clock domain.Clock) *ExpenseService {
+	return &ExpenseService{trips: trips, stops: stops, tags: tags, expenses: expenses, events: events, clock: clock}
+}
+
+// QuickLog records an expense with nothing but a category, an amount, and an
+// optional note — the rest is filled in from context. The expense is stamped
+// with the current time and tied to the stop the traveller is at right now
+// (see domain.StopAt); on the road between stops it has no stop.
+//
+// A fuel expense may carry the fillUp where it was paid. While the trip is
+// in progress the expense is then tied to the nearest stop within
+// domain.FuelStopRadiusMeters instead, or to a new stop tagged
+// domain.FuelStopTag, arrived at and departed now; fillUp is ignored
+// otherwise.
+// Returns domain.ErrNotFound if the trip does not exist, and
+// domain.ErrValidation if fillUp is given for another category or is out of
+// range.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ExpenseService) QuickLog(ctx context.Context, tripID uuid.UUID, category domain.ExpenseCategory, amount float64, note string, fillUp *domain.FillUp) (domain.Expense, error) {
+	if !category.Valid() {
+		return domain.Expense{}, fmt.Errorf("%w: unknown expense type %q", domain.ErrValidation, category)
+	}
+	if amount <= 0 {
+		return domain.Expense{}, fmt.Errorf("%w: amount must be positive", domain.ErrValidation)
+	}
+	if fillUp != nil {
+		if category != domain.ExpenseFuel {
+			return domain.Expense{}, fmt.Errorf("%w: fuel_stop is only for fuel expenses", domain.ErrValidation)
+		}
+		if fillUp.Latitude < -90 || fillUp.Latitude > 90 {
+			return domain.Expense{}, fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+		}
+		if fillUp.Longitude < -180 || fillUp.Longitude > 180 {
+			return domain.Expense{}, fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrValidation)
+		}
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Expense{}, fmt.Errorf("service.ExpenseService.QuickLog: %w", err)
+	}
+	trip, err := s.trips.GetByID(ctx, tripID)
+	if err != nil {
+		return domain.Expense{}, fmt.Errorf("service.ExpenseService.QuickLog: %w", err)
+	}
+	stops, err := s.stops.ListByTripID(ctx, tripID)
+	if err != nil {
+		return domain.Expense{}, fmt.Errorf("service.ExpenseService.QuickLog: %w", err)
+	}
+
+	now := s.clock.Now()
+	e := domain.Expense{
+		TripID:   tripID,
+		Category: category,
+		Amount:   amount,
+		Note:     strings.TrimSpace(note),
+		SpentAt:  now,
+	}
+	_, underway := domain.TripInProgress([]domain.Trip{trip}, now)
+	if fillUp != nil && underway {
+		stop, err := s.fuelStop(ctx, tripID, stops, *fillUp, now)
+		if err != nil {
+			return domain.Expense{}, fmt.Errorf("service.ExpenseService.QuickLog: %w", err)
+		}
+		e.StopID = &stop.ID
+		e.Location = stop.Location
+	} else if stop, ok := domain.StopAt(stops, now); ok {
+		e.StopID = &stop.ID
+		e.Location = stop.Location
+	}
+
+	created, err := s.expenses.Create(ctx, e)
+	if err != nil {
+		return domain.Expense{}, fmt.Errorf("service.ExpenseService.QuickLog: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventExpenseCreated, domain.TripRecordEvent(created.ID, tripID, created.StopID))
+	return created, nil
+}
+
+// ListByTrip returns a trip's expenses, most recent first.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *ExpenseService) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.Expense, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.ExpenseService.ListByTrip: %w", err)
+	}
+	expenses, err := s.expenses.ListByTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.ExpenseService.ListByTrip: %w", err)
+	}
+	return expenses, nil
+}
+
+// SetSplit records who paid one of a trip's expenses and whom it is split
+// among. Names are trimmed and repeats dropped; an empty split clears it.
+// Returns domain.ErrValidation if a name is blank or the expense is split
+// with no payer, and domain.ErrNotFound if the trip has no expense with that
+// ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ExpenseService) SetSplit(ctx context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error) {
+	split, err := normalizeSplit(split)
+	if err != nil {
+		return domain.Expense{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Expense{}, fmt.Errorf("service.ExpenseService.SetSplit: %w", err)
+	}
+	updated, err := s.expenses.UpdateSplit(ctx, tripID, id, split)
+	if err != nil {
+		return domain.Expense{}, fmt.Errorf("service.ExpenseService.SetSplit: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventExpenseUpdated, domain.TripRecordEvent(updated.ID, tripID, updated.StopID))
+	return updated, nil
+}
+
+// Settlement works out who owes whom for a trip's split expenses; see
+// domain.Settle. Returns domain.ErrNotFound if the trip does not exist.
+func (s *ExpenseService) Settlement(ctx context.Context, tripID uuid.UUID) (domain.Settlement, error) {
+	expenses, err := s.ListByTrip(ctx, tripID)
+	if err != nil {
+		return domain.Settlement{}, fmt.Errorf("service.ExpenseService.Settlement: %w", err)
+	}
+	return domain.Settle(expenses), nil
+}
+
+// Delete removes one of a trip's expenses.
+// Returns domain.ErrNotFound if the trip has no expense with that ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ExpenseService) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.ExpenseService.Delete: %w", err)
+	}
+	if err := s.expenses.Delete(ctx, tripID, id); err != nil {
+		return fmt.Errorf("service.ExpenseService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventExpenseDeleted, domain.TripRecordEvent(id, tripID, nil))
+	return nil
+}
+
+// fuelStop returns the stop a fill-up at the given spot belongs to: the
+// nearest stop already reached within domain.FuelStopRadiusMeters, or else a
+// new one tagged domain.FuelStopTag that is arrived at and departed at now,
+// so it does not become the stop the traveller is at.
+func (s *ExpenseService) fuelStop(ctx context.Context, tripID uuid.UUID, stops []domain.Stop, fillUp domain.FillUp, now time.Time) (domain.Stop, error) {
+	here := geo.Point{Lat: fillUp.Latitude, Lon: fillUp.Longitude}
+	var (
+		nearest domain.Stop
+		best    = domain.FuelStopRadiusMeters
+		found   bool
+	)
+	for _, st := range stops {
+		if st.Planned || !st.HasCoordinates() {
+			continue
+		}
+		if d := geo.DistanceMeters(here, geo.Point{Lat: *st.Latitude, Lon: *st.Longitude}); d <= best {
+			nearest, best, found = st, d, true
+		}
+	}
+	if found {
+		return nearest, nil
+	}
+
+	lat, lon := fillUp.Latitude, fillUp.Longitude
+	created, err := s.stops.Create(ctx, domain.Stop{
+		TripID:     tripID,
+		Name:       "Fuel stop",
+		Latitude:   &lat,
+		Longitude:  &lon,
+		ArrivedAt:  now,
+		DepartedAt: &now,
+	})
+	if err != nil {
+		return domain.Stop{}, err
+	}
+	tag, err := s.tags.Upsert(ctx, domain.FuelStopTag, toSlug(domain.FuelStopTag))
+	if err != nil {
+		return domain.Stop{}, err
+	}
+	if err := s.tags.AddToStop(ctx, created.ID, tag.ID); err != nil {
+		return domain.Stop{}, err
+	}
+	publishEvent(ctx, s.events, domain.EventStopCreated, domain.StopEvent(created))
+	return created, nil
+}
+
+// normalizeSplit trims the names in split and drops repeats from SplitAmong,
+// keeping the first.
+func normalizeSplit(split domain.ExpenseSplit) (domain.ExpenseSplit, error) {
+	out := domain.ExpenseSplit{PaidBy: strings.TrimSpace(split.PaidBy), SplitAmong: []string{}}
+	for _, name := range split.SplitAmong {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return domain.ExpenseSplit{}, fmt.Errorf("%w: split_among names must not be blank", domain.ErrValidation)
+		}
+		if !slices.Contains(out.SplitAmong, name) {
+			out.SplitAmong = append(out.SplitAmong, name)
+		}
+	}
+	if out.PaidBy == "" && len(out.SplitAmong) > 0 {
+		return domain.ExpenseSplit{}, fmt.Errorf("%w: paid_by is required to split an expense", domain.ErrValidation)
+	}
+	return out, nil
+}
@@ -0,0 +1,401 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memExpenseRepo is an in-memory repo.ExpenseRepo.
+type memExpenseRepo struct {
+	expenses []domain.Expense
+}
+
+func (m *memExpenseRepo) Create(_ context.Context, e domain.Expense) (domain.Expense, error) {
+	e.ID = uuid.New()
+	m.expenses = append(m.expenses, e)
+	return e, nil
+}
+func (m *memExpenseRepo) ListByTrip(_ context.Context, tripID uuid.UUID) ([]domain.Expense, error) {
+	out := []domain.Expense{}
+	for _, e := range m.expenses {
+		if e.TripID == tripID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+func (m *memExpenseRepo) UpdateSplit(_ context.Context, tripID, id uuid.UUID, split domain.ExpenseSplit) (domain.Expense, error) {
+	for i, e := range m.expenses {
+		if e.ID == id && e.TripID == tripID {
+			m.expenses[i].Split = split
+			return m.expenses[i], nil
+		}
+	}
+	return domain.Expense{}, domain.ErrNotFound
+}
+func (m *memExpenseRepo) Delete(_ context.Context, tripID, id uuid.UUID) error {
+	for i, e := range m.expenses {
+		if e.ID == id && e.TripID == tripID {
+			m.expenses = append(m.expenses[:i], m.expenses[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.ExpenseRepo = (*memExpenseRepo)(nil)
+
+// expenseFixture is the world an ExpenseService test runs in: one trip whose
+// stops are whatever the test puts in stops, and a fixed clock. Stops the
+// service creates are appended to stops and the slugs it tags them with
+// recorded in tagged.
+type expenseFixture struct {
+	svc      *service.ExpenseService
+	expenses *memExpenseRepo
+	events   *recordingPublisher
+	tripID   uuid.UUID
+	trip     domain.Trip
+	stops    []domain.Stop
+	tagged   map[uuid.UUID][]string
+}
+
+// expenseNow is early afternoon on 2025-07-02.
+var expenseNow = time.Date(2025, 7, 2, 13, 30, 0, 0, time.UTC)
+
+func newExpenseService() *expenseFixture {
+	f := &expenseFixture{
+		tripID:   uuid.New(),
+		trip:     domain.Trip{StartDate: domain.NewDate(2025, 6, 28)},
+		expenses: &memExpenseRepo{},
+		events:   &recordingPublisher{},
+		tagged:   map[uuid.UUID][]string{},
+	}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != f.tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			trip := f.trip
+			trip.ID = id
+			return trip, nil
+		},
+	}
+	stops := &mockStopRepo{
+		listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
+			return f.stops, nil
+		},
+		create: func(_ context.Context, stop domain.Stop) (domain.Stop, error) {
+			stop.ID = uuid.New()
+			f.stops = append(f.stops, stop)
+			return stop, nil
+		},
+	}
+	tags := map[string]domain.Tag{}
+	tagRepo := &mockTagRepo{
+		upsert: func(_ context.Context, name, slug string) (domain.Tag, error) {
+			if _, ok := tags[slug]; !ok {
+				tags[slug] = domain.Tag{ID: uuid.New(), Name: name, Slug: slug}
+			}
+			return tags[slug], nil
+		},
+		addToStop: func(_ context.Context, stopID, tagID uuid.UUID) error {
+			for slug, tag := range tags {
+				if tag.ID == tagID {
+					f.tagged[stopID] = append(f.tagged[stopID], slug)
+				}
+			}
+			return nil
+		},
+	}
+	f.svc = service.NewExpenseService(trips, stops, tagRepo, f.expenses, f.events, &fakeClock{now: expenseNow})
+	return f
+}
+
+func TestExpenseService_QuickLog_TiesToCurrentStop(t *testing.T) {
+	f := newExpenseService()
+	departed := expenseNow.Add(-20 * time.Hour)
+	earlier := domain.Stop{ID: uuid.New(), Location: "Bend, OR", ArrivedAt: expenseNow.Add(-48 * time.Hour), DepartedAt: &departed}
+	current := domain.Stop{ID: uuid.New(), Location: "Crescent City, CA", ArrivedAt: expenseNow.Add(-2 * time.Hour)}
+	f.stops = []domain.Stop{earlier, current}
+
+	got, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseParking, 12, "  harbor lot ", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, f.tripID, got.TripID)
+	assert.Equal(t, domain.ExpenseParking, got.Category)
+	assert.Equal(t, 12.0, got.Amount)
+	assert.Equal(t, "harbor lot", got.Note)
+	assert.Equal(t, expenseNow, got.SpentAt)
+	require.NotNil(t, got.StopID)
+	assert.Equal(t, current.ID, *got.StopID)
+	assert.Equal(t, "Crescent City, CA", got.Location)
+}
+
+func TestExpenseService_QuickLog_BetweenStops(t *testing.T) {
+	f := newExpenseService()
+	departed := expenseNow.Add(-time.Hour)
+	f.stops = []domain.Stop{
+		{ID: uuid.New(), Location: "Bend, OR", ArrivedAt: expenseNow.Add(-24 * time.Hour), DepartedAt: &departed},
+		{ID: uuid.New(), Location: "Redwoods", ArrivedAt: expenseNow.Add(3 * time.Hour)}, // planned, not reached
+	}
+
+	got, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseToll, 6.5, "", nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, got.StopID)
+	assert.Empty(t, got.Location)
+	assert.Equal(t, expenseNow, got.SpentAt)
+}
+
+func TestExpenseService_QuickLog_FuelStopLinksNearbyStop(t *testing.T) {
+	f := newExpenseService()
+	lat, lon := 41.7558, -124.2026
+	departed := expenseNow.Add(-time.Hour)
+	station := domain.Stop{ID: uuid.New(), Name: "Chevron", Location: "Crescent City, CA", Latitude: &lat, Longitude: &lon, ArrivedAt: expenseNow.Add(-25 * time.Hour), DepartedAt: &departed}
+	f.stops = []domain.Stop{station}
+
+	// About 100 m north of the station.
+	got, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseFuel, 92.4, "", &domain.FillUp{Latitude: lat + 0.0009, Longitude: lon})
+
+	require.NoError(t, err)
+	require.NotNil(t, got.StopID)
+	assert.Equal(t, station.ID, *got.StopID)
+	assert.Equal(t, "Crescent City, CA", got.Location)
+	assert.Len(t, f.stops, 1, "no stop created")
+}
+
+func TestExpenseService_QuickLog_FuelStopCreatesStop(t *testing.T) {
+	f := newExpenseService()
+	lat, lon := 41.7558, -124.2026
+	current := domain.Stop{ID: uuid.New(), Location: "Crescent City, CA", Latitude: &lat, Longitude: &lon, ArrivedAt: expenseNow.Add(-2 * time.Hour)}
+	f.stops = []domain.Stop{current}
+
+	// About 1 km north of the stop the traveller is at.
+	got, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseFuel, 92.4, "", &domain.FillUp{Latitude: lat + 0.009, Longitude: lon})
+
+	require.NoError(t, err)
+	require.Len(t, f.stops, 2)
+	created := f.stops[1]
+	require.NotNil(t, got.StopID)
+	assert.Equal(t, created.ID, *got.StopID)
+	assert.Equal(t, f.tripID, created.TripID)
+	assert.InDelta(t, lat+0.009, *created.Latitude, 1e-9)
+	assert.InDelta(t, lon, *created.Longitude, 1e-9)
+	assert.Equal(t, expenseNow, created.ArrivedAt)
+	require.NotNil(t, created.DepartedAt)
+	assert.Equal(t, expenseNow, *created.DepartedAt)
+	assert.Equal(t, []string{domain.FuelStopTag}, f.tagged[created.ID])
+}
+
+func TestExpenseService_QuickLog_FuelStopIgnoredAfterTrip(t *testing.T) {
+	f := newExpenseService()
+	ended := domain.NewDate(2025, 7, 1)
+	f.trip.EndDate = &ended
+
+	got, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseFuel, 92.4, "", &domain.FillUp{Latitude: 41.7558, Longitude: -124.2026})
+
+	require.NoError(t, err)
+	assert.Nil(t, got.StopID)
+	assert.Empty(t, f.stops)
+}
+
+func TestExpenseService_QuickLog_FuelStopValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		category domain.ExpenseCategory
+		fillUp   domain.FillUp
+	}{
+		{"not fuel", domain.ExpenseToll, domain.FillUp{Latitude: 41.7, Longitude: -124.2}},
+		{"latitude out of range", domain.ExpenseFuel, domain.FillUp{Latitude: 91, Longitude: -124.2}},
+		{"longitude out of range", domain.ExpenseFuel, domain.FillUp{Latitude: 41.7, Longitude: -181}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newExpenseService()
+
+			_, err := f.svc.QuickLog(context.Background(), f.tripID, tc.category, 50, "", &tc.fillUp)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Empty(t, f.expenses.expenses)
+			assert.Empty(t, f.stops)
+		})
+	}
+}
+
+func TestExpenseService_QuickLog_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		category domain.ExpenseCategory
+		amount   float64
+	}{
+		{"unknown type", "souvenirs", 5},
+		{"zero amount", domain.ExpenseToll, 0},
+		{"negative amount", domain.ExpenseToll, -3},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newExpenseService()
+
+			_, err := f.svc.QuickLog(context.Background(), f.tripID, tc.category, tc.amount, "", nil)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Empty(t, f.expenses.expenses)
+		})
+	}
+}
+
+func TestExpenseService_QuickLog_UnknownTrip(t *testing.T) {
+	f := newExpenseService()
+
+	_, err := f.svc.QuickLog(context.Background(), uuid.New(), domain.ExpenseToll, 6.5, "", nil)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestExpenseService_ListAndDelete(t *testing.T) {
+	f := newExpenseService()
+	logged, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseToll, 6.5, "", nil)
+	require.NoError(t, err)
+
+	list, err := f.svc.ListByTrip(context.Background(), f.tripID)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	require.NoError(t, f.svc.Delete(context.Background(), f.tripID, logged.ID))
+	err = f.svc.Delete(context.Background(), f.tripID, logged.ID)
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+
+	_, err = f.svc.ListByTrip(context.Background(), uuid.New())
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestExpenseService_Publishes(t *testing.T) {
+	f := newExpenseService()
+	ctx := context.Background()
+
+	// A fill-up far from any stop logs a fuel stop first.
+	logged, err := f.svc.QuickLog(ctx, f.tripID, domain.ExpenseFuel, 92.4, "", &domain.FillUp{Latitude: 41.76, Longitude: -124.2})
+	require.NoError(t, err)
+	_, err = f.svc.SetSplit(ctx, f.tripID, logged.ID, domain.ExpenseSplit{PaidBy: "Ana"})
+	require.NoError(t, err)
+	require.NoError(t, f.svc.Delete(ctx, f.tripID, logged.ID))
+	require.Error(t, f.svc.Delete(ctx, f.tripID, logged.ID))
+
+	assert.Equal(t, []domain.WebhookEvent{
+		domain.EventStopCreated, domain.EventExpenseCreated, domain.EventExpenseUpdated, domain.EventExpenseDeleted,
+	}, f.events.events, "a failed change publishes nothing")
+	assert.Equal(t, f.stops[0].ID, f.events.data[0].(domain.StopEventData).ID)
+	assert.Equal(t, domain.TripRecordEvent(logged.ID, f.tripID, logged.StopID), f.events.data[1])
+	assert.Equal(t, domain.TripRecordEvent(logged.ID, f.tripID, nil), f.events.data[3])
+}
+
+func TestExpenseService_SetSplit(t *testing.T) {
+	f := newExpenseService()
+	logged, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseFuel, 90, "", nil)
+	require.NoError(t, err)
+
+	got, err := f.svc.SetSplit(context.Background(), f.tripID, logged.ID, domain.ExpenseSplit{
+		PaidBy:     " Ana ",
+		SplitAmong: []string{"Ana", " Ben", "Ana"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExpenseSplit{PaidBy: "Ana", SplitAmong: []string{"Ana", "Ben"}}, got.Split)
+
+	got, err = f.svc.SetSplit(context.Background(), f.tripID, logged.ID, domain.ExpenseSplit{})
+	require.NoError(t, err)
+	assert.Empty(t, got.Split.PaidBy)
+
+	_, err = f.svc.SetSplit(context.Background(), f.tripID, uuid.New(), domain.ExpenseSplit{PaidBy: "Ana"})
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestExpenseService_SetSplit_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		split domain.ExpenseSplit
+	}{
+		{"no payer", domain.ExpenseSplit{SplitAmong: []string{"Ana", "Ben"}}},
+		{"blank payer", domain.ExpenseSplit{PaidBy: "  ", SplitAmong: []string{"Ana"}}},
+		{"blank name", domain.ExpenseSplit{PaidBy: "Ana", SplitAmong: []string{"Ana", " "}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newExpenseService()
+			logged, err := f.svc.QuickLog(context.Background(), f.tripID, domain.ExpenseFuel, 90, "", nil)
+			require.NoError(t, err)
+
+			_, err = f.svc.SetSplit(context.Background(), f.tripID, logged.ID, tc.split)
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+		})
+	}
+}
+
+func TestExpenseService_Settlement(t *testing.T) {
+	f := newExpenseService()
+	f.expenses.expenses = []domain.Expense{
+		// Ana fuels up for all three; Ben pays for a campsite he and Cy share.
+		{TripID: f.tripID, Amount: 90, Split: domain.ExpenseSplit{PaidBy: "Ana", SplitAmong: []string{"Ana", "Ben", "Cy"}}},
+		{TripID: f.tripID, Amount: 40, Split: domain.ExpenseSplit{PaidBy: "Ben", SplitAmong: []string{"Ben", "Cy"}}},
+		// Cy's own souvenir and an unclaimed toll change nothing.
+		{TripID: f.tripID, Amount: 15, Split: domain.ExpenseSplit{PaidBy: "Cy"}},
+		{TripID: f.tripID, Amount: 6.5},
+	}
+
+	got, err := f.svc.Settlement(context.Background(), f.tripID)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 145, got.Total, 0.001)
+	assert.Equal(t, []domain.Balance{
+		{Name: "Ana", Paid: 90, Owed: 30, Net: 60},
+		{Name: "Ben", Paid: 40, Owed: 50, Net: -10},
+		{Name: "Cy", Paid: 15, Owed: 65, Net: -50},
+	}, got.Balances)
+	assert.Equal(t, []domain.Transfer{
+		{From: "Cy", To: "Ana", Amount: 50},
+		{From: "Ben", To: "Ana", Amount: 10},
+	}, got.Transfers)
+}
+
+func TestExpenseService_Settlement_UnevenCents(t *testing.T) {
+	f := newExpenseService()
+	f.expenses.expenses = []domain.Expense{
+		{TripID: f.tripID, Amount: 10, Split: domain.ExpenseSplit{PaidBy: "Ana", SplitAmong: []string{"Ben", "Cy", "Ana"}}},
+	}
+
+	got, err := f.svc.Settlement(context.Background(), f.tripID)
+	require.NoError(t, err)
+
+	// $10 splits 3.34 / 3.33 / 3.33, the odd cent going to the first named.
+	assert.Equal(t, []domain.Balance{
+		{Name: "Ana", Paid: 10, Owed: 3.33, Net: 6.67},
+		{Name: "Ben", Paid: 0, Owed: 3.34, Net: -3.34},
+		{Name: "Cy", Paid: 0, Owed: 3.33, Net: -3.33},
+	}, got.Balances)
+	assert.Equal(t, []domain.Transfer{
+		{From: "Ben", To: "Ana", Amount: 3.34},
+		{From: "Cy", To: "Ana", Amount: 3.33},
+	}, got.Transfers)
+}
+
+func TestExpenseService_Settlement_Empty(t *testing.T) {
+	f := newExpenseService()
+
+	got, err := f.svc.Settlement(context.Background(), f.tripID)
+	require.NoError(t, err)
+	assert.Zero(t, got.Total)
+	assert.Empty(t, got.Balances)
+	assert.Empty(t, got.Transfers)
+
+	_, err = f.svc.Settlement(context.Background(), uuid.New())
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
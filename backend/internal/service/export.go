@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
@@ -10,74 +11,49 @@ import (
 
 // ExportService assembles a full flat export of all trips, stops, and tags.
 type ExportService struct {
-	trips repo.TripRepo
-	stops repo.StopRepo
-	tags  repo.TagRepo
+	exports repo.ExportRepo
 }
 
-// NewExportService constructs an ExportService backed by the provided repos.
-func NewExportService(trips repo.TripRepo, stops repo.StopRepo, tags repo.TagRepo) *ExportService {
-	return &ExportService{trips: trips, stops: stops, tags: tags}
+// NewExportService constructs an ExportService backed by the provided repo.
+func NewExportService(exports repo.ExportRepo) *ExportService {
+	return &ExportService{exports: exports}
 }
 
 // Export returns one ExportRow per stop across all trips.
 // Trips with no stops contribute one row with empty stop fields.
-// Rows are ordered by trip (in list order) then by stop (in arrived_at order).
+// Rows are ordered by trip (newest start date first) then by stop (in
+// arrived_at order). It holds the whole export in memory; use Stream for
+// large exports.
 func (s *ExportService) Export(ctx context.Context) ([]domain.ExportRow, error) {
-	trips, err := s.trips.List(ctx)
+	rows := []domain.ExportRow{}
+	err := s.Stream(ctx, func(row domain.ExportRow) error {
+		rows = append(rows, row)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("service.ExportService.Export: %w", err)
+		return nil, err
 	}
+	return rows, nil
+}
 
-	rows := make([]domain.ExportRow, 0, len(trips))
-
-	for _, trip := range trips {
-		endDate := ""
-		if trip.EndDate != nil {
-			endDate = trip.EndDate.Format("2006-01-02")
-		}
-
-		tripBase := domain.ExportRow{
-			TripID:        trip.ID.String(),
-			TripName:      trip.Name,
-			TripStartDate: trip.StartDate.Format("2006-01-02"),
-			TripEndDate:   endDate,
-		}
-
-		stops, err := s.stops.ListByTripID(ctx, trip.ID)
-		if err != nil {
-			return nil, fmt.Errorf("service.ExportService.Export: %w", err)
-		}
-
-		if len(stops) == 0 {
-			// Trip exists but has no stops — emit one row with empty stop fields.
-			rows = append(rows, tripBase)
-			continue
-		}
-
-		for _, stop := range stops {
-			tags, err := s.tags.ListByStop(ctx, stop.ID)
-			if err != nil {
-				return nil, fmt.Errorf("service.ExportService.Export: %w", err)
-			}
-
-			slugs := make([]string, len(tags))
-			for i, t := range tags {
-				slugs[i] = t.Slug
-			}
-
-			row := tripBase // copy trip fields
-			row.StopName = stop.Name
-			row.StopLocation = stop.Location
-			arrivedAt := stop.ArrivedAt // copy to local so the pointer stays valid after the iteration
-			row.ArrivedAt = &arrivedAt
-			row.DepartedAt = stop.DepartedAt
-			row.StopNotes = stop.Notes
-			row.Tags = slugs
-
-			rows = append(rows, row)
-		}
+// Stream calls fn with the rows Export would return, in the same order,
+// reading each from the database only once fn has handled the one before.
+// Memory use stays flat however large the logbook is. Stream stops at, and
+// returns, the first error fn returns.
+func (s *ExportService) Stream(ctx context.Context, fn func(domain.ExportRow) error) error {
+	if err := s.exports.Stream(ctx, fn); err != nil {
+		return fmt.Errorf("service.ExportService.Stream: %w", err)
 	}
+	return nil
+}
 
-	return rows, nil
+// Deletions returns the trips and stops purged from the trash at or after
+// since, oldest first, so a client that synced an earlier export can drop
+// them. The zero since returns every one.
+func (s *ExportService) Deletions(ctx context.Context, since time.Time) ([]domain.Tombstone, error) {
+	tombstones, err := s.exports.Deletions(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("service.ExportService.Deletions: %w", err)
+	}
+	return tombstones, nil
 }
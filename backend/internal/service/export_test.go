@@ -2,10 +2,12 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -14,230 +16,113 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/service"
 )
 
-// ---- mock repos for ExportService -----------------------------------------
-// TripRepo and TagRepo mocks are already declared in trip_test.go and
-// tag_test.go (same package). Only StopRepo is reused from stop_test.go.
-// ExportService receives all three, so we wire them up below.
-
-// newExportService is a convenience constructor for tests.
-func newExportService(trips repo.TripRepo, stops repo.StopRepo, tags repo.TagRepo) *service.ExportService {
-	return service.NewExportService(trips, stops, tags)
+// ---- mock ExportRepo -------------------------------------------------------
+
+// mockExportRepo streams rows, then fails with err if it is set. streamed
+// counts the rows handed to the consumer. Deletions returns tombstones and
+// records the since it was asked for.
+type mockExportRepo struct {
+	rows       []domain.ExportRow
+	err        error
+	streamed   int
+	tombstones []domain.Tombstone
+	since      time.Time
 }
 
-// ---- helpers ---------------------------------------------------------------
+func (m *mockExportRepo) Stream(_ context.Context, fn func(domain.ExportRow) error) error {
+	for _, row := range m.rows {
+		m.streamed++
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return m.err
+}
 
-func tripFixtureExport(name string, start time.Time) domain.Trip {
-	return domain.Trip{
-		ID:        uuid.New(),
-		Name:      name,
-		StartDate: start,
+func (m *mockExportRepo) Deletions(_ context.Context, since time.Time) ([]domain.Tombstone, error) {
+	m.since = since
+	if m.err != nil {
+		return nil, m.err
 	}
+	return m.tombstones, nil
 }
 
-func stopFixtureExport(tripID uuid.UUID, name string, arrived time.Time) domain.Stop {
-	return domain.Stop{
-		ID:        uuid.New(),
-		TripID:    tripID,
-		Name:      name,
-		ArrivedAt: arrived,
+var _ repo.ExportRepo = (*mockExportRepo)(nil)
+
+func exportRows() []domain.ExportRow {
+	return []domain.ExportRow{
+		{TripName: "Trip B", TripStartDate: domain.NewDate(2025, 7, 1), StopName: "Stop B1", Tags: []string{}},
+		{TripName: "Trip A", TripStartDate: domain.NewDate(2025, 6, 1), StopName: "Stop A1", Tags: []string{"camping", "national-park"}},
+		{TripName: "Empty Trip", TripStartDate: domain.NewDate(2025, 5, 1)},
 	}
 }
 
 // ---- Export ----------------------------------------------------------------
 
-func TestExportService_Export_OneTrip_OneStop_NoTags(t *testing.T) {
-	trip := tripFixtureExport("Summer Tour", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
-	stop := stopFixtureExport(trip.ID, "Yellowstone Camp", time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC))
-
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) {
-				return []domain.Trip{trip}, nil
-			},
-		},
-		&mockStopRepo{
-			listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
-				return []domain.Stop{stop}, nil
-			},
-		},
-		&mockTagRepo{
-			listByStop: func(_ context.Context, _ uuid.UUID) ([]domain.Tag, error) {
-				return []domain.Tag{}, nil
-			},
-		},
-	)
+func TestExportService_Export_CollectsRowsInOrder(t *testing.T) {
+	svc := service.NewExportService(&mockExportRepo{rows: exportRows()})
 
 	rows, err := svc.Export(context.Background())
 
 	require.NoError(t, err)
-	require.Len(t, rows, 1)
-	assert.Equal(t, "Summer Tour", rows[0].TripName)
-	assert.Equal(t, "2025-06-01", rows[0].TripStartDate)
-	assert.Equal(t, "Yellowstone Camp", rows[0].StopName)
-	require.NotNil(t, rows[0].ArrivedAt)
-	assert.Equal(t, stop.ArrivedAt, *rows[0].ArrivedAt)
-	assert.Empty(t, rows[0].Tags)
+	assert.Equal(t, exportRows(), rows)
 }
 
-func TestExportService_Export_StopWithTags(t *testing.T) {
-	trip := tripFixtureExport("Tour", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
-	stop := stopFixtureExport(trip.ID, "Yosemite", time.Date(2025, 6, 5, 0, 0, 0, 0, time.UTC))
-	tags := []domain.Tag{
-		{ID: uuid.New(), Slug: "camping"},
-		{ID: uuid.New(), Slug: "national-park"},
-	}
-
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) { return []domain.Trip{trip}, nil },
-		},
-		&mockStopRepo{
-			listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
-				return []domain.Stop{stop}, nil
-			},
-		},
-		&mockTagRepo{
-			listByStop: func(_ context.Context, _ uuid.UUID) ([]domain.Tag, error) {
-				return tags, nil
-			},
-		},
-	)
+func TestExportService_Export_NoTrips(t *testing.T) {
+	svc := service.NewExportService(&mockExportRepo{})
 
 	rows, err := svc.Export(context.Background())
 
 	require.NoError(t, err)
-	require.Len(t, rows, 1)
-	assert.Equal(t, []string{"camping", "national-park"}, rows[0].Tags)
+	assert.NotNil(t, rows)
+	assert.Empty(t, rows)
 }
 
-func TestExportService_Export_TripWithNoStops(t *testing.T) {
-	trip := tripFixtureExport("Empty Trip", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
-
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) { return []domain.Trip{trip}, nil },
-		},
-		&mockStopRepo{
-			listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
-				return []domain.Stop{}, nil
-			},
-		},
-		&mockTagRepo{},
-	)
+func TestExportService_Export_RepoError(t *testing.T) {
+	boom := errors.New("connection reset")
+	svc := service.NewExportService(&mockExportRepo{rows: exportRows(), err: boom})
 
 	rows, err := svc.Export(context.Background())
 
-	require.NoError(t, err)
-	require.Len(t, rows, 1, "trips with no stops should still produce one row")
-	assert.Equal(t, "Empty Trip", rows[0].TripName)
-	assert.Empty(t, rows[0].StopName)
-	assert.Nil(t, rows[0].ArrivedAt)
-	assert.Empty(t, rows[0].Tags)
+	assert.ErrorIs(t, err, boom)
+	assert.Nil(t, rows, "a failed export returns no partial rows")
 }
 
-func TestExportService_Export_MultipleTripsMultipleStops(t *testing.T) {
-	trip1 := tripFixtureExport("Trip A", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
-	trip2 := tripFixtureExport("Trip B", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
-
-	stopsByTrip := map[uuid.UUID][]domain.Stop{
-		trip1.ID: {
-			stopFixtureExport(trip1.ID, "Stop A1", time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)),
-			stopFixtureExport(trip1.ID, "Stop A2", time.Date(2025, 6, 5, 0, 0, 0, 0, time.UTC)),
-		},
-		trip2.ID: {
-			stopFixtureExport(trip2.ID, "Stop B1", time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC)),
-		},
-	}
+// ---- Stream ----------------------------------------------------------------
 
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) {
-				return []domain.Trip{trip1, trip2}, nil
-			},
-		},
-		&mockStopRepo{
-			listByTripID: func(_ context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
-				return stopsByTrip[tripID], nil
-			},
-		},
-		&mockTagRepo{
-			listByStop: func(_ context.Context, _ uuid.UUID) ([]domain.Tag, error) {
-				return []domain.Tag{}, nil
-			},
-		},
-	)
+func TestExportService_Stream_StopsAtConsumerError(t *testing.T) {
+	stop := errors.New("client went away")
+	exports := &mockExportRepo{rows: exportRows()}
+	var got []string
 
-	rows, err := svc.Export(context.Background())
+	err := service.NewExportService(exports).Stream(context.Background(), func(row domain.ExportRow) error {
+		got = append(got, row.TripName)
+		return stop
+	})
 
-	require.NoError(t, err)
-	assert.Len(t, rows, 3) // 2 stops for trip1, 1 stop for trip2
-	// First two rows belong to trip1
-	assert.Equal(t, "Trip A", rows[0].TripName)
-	assert.Equal(t, "Trip A", rows[1].TripName)
-	assert.Equal(t, "Stop A1", rows[0].StopName)
-	assert.Equal(t, "Stop A2", rows[1].StopName)
-	// Third row belongs to trip2
-	assert.Equal(t, "Trip B", rows[2].TripName)
-	assert.Equal(t, "Stop B1", rows[2].StopName)
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"Trip B"}, got)
+	assert.Equal(t, 1, exports.streamed, "no rows are read past the failure")
 }
 
-func TestExportService_Export_NoTrips(t *testing.T) {
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) { return []domain.Trip{}, nil },
-		},
-		&mockStopRepo{},
-		&mockTagRepo{},
-	)
-
-	rows, err := svc.Export(context.Background())
+// ---- Deletions -------------------------------------------------------------
 
-	require.NoError(t, err)
-	assert.NotNil(t, rows)
-	assert.Empty(t, rows)
-}
+func TestExportService_Deletions(t *testing.T) {
+	since := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	want := []domain.Tombstone{{Kind: domain.TrashTrip, ID: uuid.New(), DeletedAt: since.Add(time.Hour)}}
+	exports := &mockExportRepo{tombstones: want}
 
-func TestExportService_Export_TripRepoError(t *testing.T) {
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) {
-				return nil, domain.ErrNotFound
-			},
-		},
-		&mockStopRepo{},
-		&mockTagRepo{},
-	)
+	got, err := service.NewExportService(exports).Deletions(context.Background(), since)
 
-	_, err := svc.Export(context.Background())
-
-	assert.Error(t, err)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, since, exports.since)
 }
 
-func TestExportService_Export_TripEndDateIncluded(t *testing.T) {
-	endDate := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
-	trip := domain.Trip{
-		ID:        uuid.New(),
-		Name:      "Dated Trip",
-		StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-		EndDate:   &endDate,
-	}
-
-	svc := newExportService(
-		&mockTripRepo{
-			list: func(_ context.Context) ([]domain.Trip, error) { return []domain.Trip{trip}, nil },
-		},
-		&mockStopRepo{
-			listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
-				return []domain.Stop{}, nil
-			},
-		},
-		&mockTagRepo{},
-	)
+func TestExportService_Deletions_RepoError(t *testing.T) {
+	boom := errors.New("connection reset")
 
-	rows, err := svc.Export(context.Background())
+	_, err := service.NewExportService(&mockExportRepo{err: boom}).Deletions(context.Background(), time.Time{})
 
-	require.NoError(t, err)
-	require.Len(t, rows, 1)
-	assert.Equal(t, "2025-06-01", rows[0].TripStartDate)
-	assert.Equal(t, "2025-06-15", rows[0].TripEndDate)
+	assert.ErrorIs(t, err, boom)
 }
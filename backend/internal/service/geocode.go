@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/geocode"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// geocodeQueryDecimals is how many decimal places of latitude and longitude
+// a cached lookup is keyed by. Three is about 110 m, the size of a
+// campground, so every site in one shares an answer.
+const geocodeQueryDecimals = 3
+
+// CachedGeocoder answers reverse lookups from repo.GeocodeCacheRepo,
+// falling back to another geocoder for points it holds no fresh answer for.
+// Answers are kept for a fixed time, after which the point is looked up
+// again in case a boundary or the provider's data has changed.
+//
+// The provider it falls back to does its own rate limiting, such as the
+// request spacing of geocode.Nominatim; cache hits never wait for it.
+type CachedGeocoder struct {
+	next     geocode.Reverser
+	provider string
+	cache    repo.GeocodeCacheRepo
+	ttl      time.Duration
+	clock    domain.Clock
+}
+
+var _ geocode.Reverser = (*CachedGeocoder)(nil)
+
+// NewCachedGeocoder constructs a CachedGeocoder keeping next's answers under
+// provider for ttl. Pass domain.SystemClock in production.
+func NewCachedGeocoder(next geocode.Reverser, provider string, cache repo.GeocodeCacheRepo, ttl time.Duration, clock domain.Clock) *CachedGeocoder {
+	return &CachedGeocoder{next: next, provider: provider, cache: cache, ttl: ttl, clock: clock}
+}
+
+// Reverse returns the cached place for p, looking it up and caching it on a
+// miss. "Nothing there" is cached like any other answer. Failed lookups are
+// not cached, and the cache failing to read or store an answer does not
+// fail the call.
+func (c *CachedGeocoder) Reverse(ctx context.Context, p geo.Point) (geocode.Place, error) {
+	query := geocodeQuery(p)
+	now := c.clock.Now()
+
+	entry, err := c.cache.Get(ctx, c.provider, query, now)
+	if err == nil {
+		return geocode.Place{CountryCode: entry.CountryCode, RegionCode: entry.RegionCode, RegionName: entry.RegionName}, nil
+	}
+
+	place, err := c.next.Reverse(ctx, p)
+	if err != nil {
+		return geocode.Place{}, fmt.Errorf("service.CachedGeocoder.Reverse: %w", err)
+	}
+	_ = c.cache.Put(ctx, domain.GeocodeCacheEntry{
+		Provider:    c.provider,
+		Query:       query,
+		CountryCode: place.CountryCode,
+		RegionCode:  place.RegionCode,
+		RegionName:  place.RegionName,
+		FetchedAt:   now,
+		ExpiresAt:   now.Add(c.ttl),
+	})
+	return place, nil
+}
+
+// geocodeQuery normalizes p to the "lat,lon" key its answer is cached under,
+// rounded to geocodeQueryDecimals.
+func geocodeQuery(p geo.Point) string {
+	return formatRounded(p.Lat) + "," + formatRounded(p.Lon)
+}
+
+// formatRounded formats v to geocodeQueryDecimals places. Adding zero turns
+// a negative zero from rounding into a plain one, so points either side of
+// the equator or meridian share a key.
+func formatRounded(v float64) string {
+	scale := math.Pow10(geocodeQueryDecimals)
+	return strconv.FormatFloat(math.Round(v*scale)/scale+0, 'f', geocodeQueryDecimals, 64)
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/geocode"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memGeocodeCache is an in-memory repo.GeocodeCacheRepo.
+type memGeocodeCache struct {
+	entries map[string]domain.GeocodeCacheEntry
+	err     error
+}
+
+func (m *memGeocodeCache) Get(_ context.Context, provider, query string, now time.Time) (domain.GeocodeCacheEntry, error) {
+	if m.err != nil {
+		return domain.GeocodeCacheEntry{}, m.err
+	}
+	e, ok := m.entries[provider+"|"+query]
+	if !ok || !e.ExpiresAt.After(now) {
+		return domain.GeocodeCacheEntry{}, fmt.Errorf("mem: %w", domain.ErrNotFound)
+	}
+	return e, nil
+}
+func (m *memGeocodeCache) Put(_ context.Context, e domain.GeocodeCacheEntry) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.entries[e.Provider+"|"+e.Query] = e
+	return nil
+}
+
+var _ repo.GeocodeCacheRepo = (*memGeocodeCache)(nil)
+
+func newCachedGeocoder(now *time.Time) (*service.CachedGeocoder, *fakeGeocoder, *memGeocodeCache) {
+	upstream := &fakeGeocoder{}
+	cache := &memGeocodeCache{entries: map[string]domain.GeocodeCacheEntry{}}
+	clock := domain.ClockFunc(func() time.Time { return *now })
+	return service.NewCachedGeocoder(upstream, "nominatim", cache, 24*time.Hour, clock), upstream, cache
+}
+
+func TestCachedGeocoder_LooksUpEachCampgroundOnce(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cached, upstream, cache := newCachedGeocoder(&now)
+	ctx := context.Background()
+
+	// Two sites a few meters apart in the same campground.
+	first, err := cached.Reverse(ctx, geo.Point{Lat: 48.69612, Lon: -113.71834})
+	require.NoError(t, err)
+	second, err := cached.Reverse(ctx, geo.Point{Lat: 48.69588, Lon: -113.71791})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, upstream.calls)
+	assert.Equal(t, geocode.Place{CountryCode: "US", RegionCode: "US-MT", RegionName: "Montana"}, first)
+	assert.Equal(t, first, second)
+	e, ok := cache.entries["nominatim|48.696,-113.718"]
+	require.True(t, ok, "keyed by coordinates rounded to three places")
+	assert.Equal(t, now.Add(24*time.Hour), e.ExpiresAt)
+}
+
+func TestCachedGeocoder_CachesNothingThere(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cached, upstream, cache := newCachedGeocoder(&now)
+	ctx := context.Background()
+
+	for _, p := range []geo.Point{{Lat: 0, Lon: -30}, {Lat: -0.0002, Lon: -30}} {
+		place, err := cached.Reverse(ctx, p)
+		require.NoError(t, err)
+		assert.Zero(t, place)
+	}
+
+	assert.Equal(t, 1, upstream.calls, "either side of the equator rounds to one key")
+	assert.Contains(t, cache.entries, "nominatim|0.000,-30.000")
+}
+
+func TestCachedGeocoder_ExpiredAnswerIsLookedUpAgain(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cached, upstream, _ := newCachedGeocoder(&now)
+	ctx := context.Background()
+	banff := geo.Point{Lat: 51.1789, Lon: -115.5708}
+
+	_, err := cached.Reverse(ctx, banff)
+	require.NoError(t, err)
+	now = now.Add(23 * time.Hour)
+	_, err = cached.Reverse(ctx, banff)
+	require.NoError(t, err)
+	assert.Equal(t, 1, upstream.calls)
+
+	now = now.Add(time.Hour)
+	_, err = cached.Reverse(ctx, banff)
+	require.NoError(t, err)
+	assert.Equal(t, 2, upstream.calls)
+}
+
+func TestCachedGeocoder_DoesNotCacheFailures(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cached, upstream, cache := newCachedGeocoder(&now)
+	upstream.err = errors.New("rate limited")
+
+	_, err := cached.Reverse(context.Background(), geo.Point{Lat: 45.7, Lon: -111.0})
+
+	assert.ErrorContains(t, err, "rate limited")
+	assert.Empty(t, cache.entries)
+}
+
+func TestCachedGeocoder_CacheFailureFallsBackToProvider(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cached, upstream, cache := newCachedGeocoder(&now)
+	cache.err = errors.New("database down")
+
+	place, err := cached.Reverse(context.Background(), geo.Point{Lat: 45.7, Lon: -111.0})
+
+	require.NoError(t, err)
+	assert.Equal(t, "US-MT", place.RegionCode)
+	assert.Equal(t, 1, upstream.calls)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+)
+
+// ProbeTimeout bounds each health check, so one hung dependency cannot hold
+// up the report.
+const ProbeTimeout = 2 * time.Second
+
+// HealthCheck describes one component the detailed health report covers.
+type HealthCheck struct {
+	Name string
+	// Critical marks a component the API cannot serve requests without; its
+	// failure reports the API as down rather than degraded.
+	Critical bool
+	// Every, when set, reuses a result for that long instead of probing on
+	// every report. External providers set it so that monitoring does not
+	// spend their rate limits.
+	Every time.Duration
+	// Probe returns nil when the component is healthy.
+	Probe func(ctx context.Context) error
+}
+
+// HealthService probes the components the API depends on.
+type HealthService struct {
+	version string
+	checks  []HealthCheck
+	clock   domain.Clock
+
+	mu   sync.Mutex
+	last map[string]domain.ComponentHealth // by name, for checks with Every
+}
+
+// NewHealthService constructs a HealthService that reports version and runs
+// checks, in that order.
+func NewHealthService(version string, checks []HealthCheck, clock domain.Clock) *HealthService {
+	return &HealthService{version: version, checks: checks, clock: clock, last: map[string]domain.ComponentHealth{}}
+}
+
+// Report runs every check concurrently, each bounded by ProbeTimeout.
+// Failures are reported in the result, never returned.
+func (s *HealthService) Report(ctx context.Context) domain.HealthReport {
+	return s.run(ctx, s.checks)
+}
+
+// Ready runs only the critical checks: the instance should take traffic
+// exactly when they all pass. A failing provider leaves it ready, since
+// taking every instance out of rotation would not bring the provider back.
+func (s *HealthService) Ready(ctx context.Context) domain.HealthReport {
+	var critical []HealthCheck
+	for _, c := range s.checks {
+		if c.Critical {
+			critical = append(critical, c)
+		}
+	}
+	return s.run(ctx, critical)
+}
+
+// run runs checks concurrently.
+func (s *HealthService) run(ctx context.Context, checks []HealthCheck) domain.HealthReport {
+	components := make([]domain.ComponentHealth, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = s.check(ctx, c)
+		}()
+	}
+	wg.Wait()
+	return domain.HealthReport{Version: s.version, Components: components}
+}
+
+// check returns c's remembered result while it is fresh, or probes it.
+func (s *HealthService) check(ctx context.Context, c HealthCheck) domain.ComponentHealth {
+	if c.Every > 0 {
+		s.mu.Lock()
+		last, ok := s.last[c.Name]
+		s.mu.Unlock()
+		if ok && s.clock.Now().Sub(last.CheckedAt) < c.Every {
+			return last
+		}
+		// The result outlives this request, so a client hanging up must
+		// not record a failure.
+		ctx = context.WithoutCancel(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ProbeTimeout)
+	defer cancel()
+	result := domain.ComponentHealth{Name: c.Name, Critical: c.Critical, Status: domain.HealthOK, CheckedAt: s.clock.Now()}
+	start := time.Now()
+	err := c.Probe(ctx)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Status = domain.HealthDegraded
+		if c.Critical {
+			result.Status = domain.HealthDown
+		}
+		result.Error = err.Error()
+	}
+
+	if c.Every > 0 {
+		s.mu.Lock()
+		s.last[c.Name] = result
+		s.mu.Unlock()
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// countingProbe returns err and counts its calls.
+func countingProbe(calls *atomic.Int32, err error) func(context.Context) error {
+	return func(context.Context) error {
+		calls.Add(1)
+		return err
+	}
+}
+
+func TestHealthService_Report(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	var dbCalls, tileCalls atomic.Int32
+	svc := service.NewHealthService("v1.2.0", []service.HealthCheck{
+		{Name: "database", Critical: true, Probe: countingProbe(&dbCalls, nil)},
+		{Name: "map_tiles", Probe: countingProbe(&tileCalls, errors.New("unexpected status 429"))},
+	}, domain.ClockFunc(func() time.Time { return now }))
+
+	report := svc.Report(context.Background())
+
+	assert.Equal(t, "v1.2.0", report.Version)
+	assert.Equal(t, domain.HealthDegraded, report.Status())
+	require.Len(t, report.Components, 2)
+	db, tiles := report.Components[0], report.Components[1]
+	assert.Equal(t, "database", db.Name)
+	assert.True(t, db.Critical)
+	assert.Equal(t, domain.HealthOK, db.Status)
+	assert.Empty(t, db.Error)
+	assert.Equal(t, now, db.CheckedAt)
+	assert.Equal(t, "map_tiles", tiles.Name)
+	assert.Equal(t, domain.HealthDegraded, tiles.Status)
+	assert.Equal(t, "unexpected status 429", tiles.Error)
+}
+
+func TestHealthService_Report_CriticalFailureIsDown(t *testing.T) {
+	var calls atomic.Int32
+	svc := service.NewHealthService("dev", []service.HealthCheck{
+		{Name: "database", Critical: true, Probe: countingProbe(&calls, errors.New("connection refused"))},
+	}, domain.SystemClock)
+
+	report := svc.Report(context.Background())
+
+	assert.Equal(t, domain.HealthDown, report.Status())
+	assert.Equal(t, domain.HealthDown, report.Components[0].Status)
+}
+
+func TestHealthService_Report_ProbeHasDeadline(t *testing.T) {
+	svc := service.NewHealthService("dev", []service.HealthCheck{
+		{Name: "geocoder", Probe: func(ctx context.Context) error {
+			_, ok := ctx.Deadline()
+			assert.True(t, ok, "probe should run with a deadline")
+			return nil
+		}},
+	}, domain.SystemClock)
+
+	svc.Report(context.Background())
+}
+
+func TestHealthService_Report_Every(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	var dbCalls, geocoderCalls atomic.Int32
+	svc := service.NewHealthService("dev", []service.HealthCheck{
+		{Name: "database", Critical: true, Probe: countingProbe(&dbCalls, nil)},
+		{Name: "geocoder", Every: time.Minute, Probe: countingProbe(&geocoderCalls, nil)},
+	}, domain.ClockFunc(func() time.Time { return now }))
+	ctx := context.Background()
+
+	svc.Report(ctx)
+	now = now.Add(30 * time.Second)
+	report := svc.Report(ctx)
+
+	assert.Equal(t, int32(2), dbCalls.Load(), "checks without Every run every time")
+	assert.Equal(t, int32(1), geocoderCalls.Load(), "a fresh result is reused")
+	assert.Equal(t, now.Add(-30*time.Second), report.Components[1].CheckedAt)
+
+	now = now.Add(30 * time.Second)
+	svc.Report(ctx)
+
+	assert.Equal(t, int32(2), geocoderCalls.Load(), "a stale result is probed again")
+}
+
+func TestHealthService_Report_CancelledRequestDoesNotStick(t *testing.T) {
+	svc := service.NewHealthService("dev", []service.HealthCheck{
+		{Name: "geocoder", Every: time.Minute, Probe: func(ctx context.Context) error { return ctx.Err() }},
+	}, domain.SystemClock)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report := svc.Report(ctx)
+
+	assert.Equal(t, domain.HealthOK, report.Components[0].Status)
+}
+
+func TestHealthService_Ready(t *testing.T) {
+	var dbCalls, tileCalls atomic.Int32
+	svc := service.NewHealthService("dev", []service.HealthCheck{
+		{Name: "database", Critical: true, Probe: countingProbe(&dbCalls, nil)},
+		{Name: "map_tiles", Probe: countingProbe(&tileCalls, errors.New("unexpected status 429"))},
+	}, domain.SystemClock)
+
+	report := svc.Ready(context.Background())
+
+	assert.Equal(t, domain.HealthOK, report.Status())
+	require.Len(t, report.Components, 1)
+	assert.Equal(t, "database", report.Components[0].Name)
+	assert.Zero(t, tileCalls.Load(), "providers do not decide readiness")
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/gpx"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// ImportService creates records in bulk from files exported by other apps.
+// Each item of a file is validated and saved on its own, and the ones that
+// fail are reported alongside the ones created instead of failing the
+// upload.
+type ImportService struct {
+	trips  repo.TripRepo
+	stops  repo.StopRepo
+	events EventPublisher
+}
+
+// NewImportService constructs an ImportService. Stops it creates are
+// published to events, which may be nil, as if each had been added by hand.
+func NewImportService(trips repo.TripRepo, stops repo.StopRepo, events EventPublisher) *ImportService {
+	return &ImportService{trips: trips, stops: stops, events: events}
+}
+
+// ImportGPX creates a stop on the trip for each waypoint of a GPX file,
+// named after the waypoint and placed at its coordinates, with its
+// description as the notes. The waypoint's time is the arrival; a waypoint
+// with no time, as route planners write them, becomes a planned stop.
+//
+// Waypoints with no name or with bad coordinates are reported in Failed, as
+// are those whose stop could not be saved; the import carries on past both,
+// so Created is always every stop that was saved.
+// Returns domain.ErrNotFound if the trip does not exist, and
+// domain.ErrValidation if the file is not GPX or has no waypoints.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ImportService) ImportGPX(ctx context.Context, tripID uuid.UUID, r io.Reader) (domain.StopImport, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.StopImport{}, fmt.Errorf("service.ImportService.ImportGPX: %w", err)
+	}
+	// Read the whole body first so that one cut off by the max-body-size
+	// middleware fails as too large rather than as malformed XML.
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return domain.StopImport{}, fmt.Errorf("service.ImportService.ImportGPX: read: %w", err)
+	}
+	wpts, err := gpx.ParseWaypoints(bytes.NewReader(data))
+	if err != nil {
+		return domain.StopImport{}, fmt.Errorf("%w: %v", domain.ErrValidation, err)
+	}
+
+	result := domain.StopImport{Created: []domain.Stop{}, Failed: []domain.ImportFailure{}}
+	for i, w := range wpts {
+		stop, err := waypointStop(tripID, w)
+		if err != nil {
+			result.Failed = append(result.Failed, domain.ImportFailure{Index: i + 1, Name: w.Name, Err: err})
+			continue
+		}
+		created, err := s.stops.Create(ctx, stop)
+		if err != nil {
+			// The stops before it are saved already, so report this one
+			// rather than fail an upload a retry would then duplicate.
+			slog.ErrorContext(ctx, "saving imported stop failed", "trip_id", tripID, "index", i+1, "error", err)
+			result.Failed = append(result.Failed, domain.ImportFailure{Index: i + 1, Name: w.Name, Err: errStopNotSaved})
+			continue
+		}
+		publishEvent(ctx, s.events, domain.EventStopCreated, domain.StopEvent(created))
+		result.Created = append(result.Created, created)
+	}
+	return result, nil
+}
+
+// errStopNotSaved is the reason reported for a valid waypoint whose stop
+// could not be saved. The cause is logged rather than shown to the client.
+var errStopNotSaved = errors.New("the stop could not be saved; import this waypoint again")
+
+// waypointStop returns the stop a waypoint is imported as, validated as
+// StopService.Create would.
+func waypointStop(tripID uuid.UUID, w gpx.Waypoint) (domain.Stop, error) {
+	if w.Err != nil {
+		return domain.Stop{}, fmt.Errorf("%w: %v", domain.ErrValidation, w.Err)
+	}
+	lat, lon := w.Lat, w.Lon
+	stop := domain.Stop{
+		TripID:    tripID,
+		Name:      w.Name,
+		Latitude:  &lat,
+		Longitude: &lon,
+		Notes:     w.Description,
+		Planned:   w.Time == nil,
+	}
+	if w.Time != nil {
+		stop.ArrivedAt = *w.Time
+	}
+	if err := validateStop(stop); err != nil {
+		return domain.Stop{}, err
+	}
+	return stop, nil
+}
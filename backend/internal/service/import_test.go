@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+const importGPX = `<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <wpt lat="44.5263" lon="-109.0565"><time>2025-07-01T15:00:00Z</time><name>Cody KOA</name><desc>Site 14</desc></wpt>
+  <wpt lat="95" lon="-110.0"><name>Off the map</name></wpt>
+  <wpt lat="44.60" lon="-110.40"><time>2025-07-02T18:00:00Z</time></wpt>
+  <wpt lat="44.4605" lon="-110.0000"><name>Fishing Bridge RV Park</name></wpt>
+</gpx>`
+
+func foundTrips() *mockTripRepo {
+	return &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			return domain.Trip{ID: id}, nil
+		},
+	}
+}
+
+func TestImportService_ImportGPX_PartialFailure(t *testing.T) {
+	tripID := uuid.New()
+	var saved []domain.Stop
+	stops := &mockStopRepo{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			s.ID = uuid.New()
+			saved = append(saved, s)
+			return s, nil
+		},
+	}
+	events := &recordingPublisher{}
+	svc := service.NewImportService(foundTrips(), stops, events)
+
+	got, err := svc.ImportGPX(context.Background(), tripID, strings.NewReader(importGPX))
+
+	require.NoError(t, err)
+	require.Len(t, got.Created, 2)
+	assert.Equal(t, saved, got.Created)
+
+	cody := got.Created[0]
+	assert.Equal(t, tripID, cody.TripID)
+	assert.Equal(t, "Cody KOA", cody.Name)
+	assert.Equal(t, "Site 14", cody.Notes)
+	require.True(t, cody.HasCoordinates())
+	assert.InDelta(t, 44.5263, *cody.Latitude, 1e-9)
+	assert.Equal(t, time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC), cody.ArrivedAt)
+	assert.False(t, cody.Planned)
+
+	bridge := got.Created[1]
+	assert.True(t, bridge.Planned, "a waypoint with no time is a planned stop")
+	assert.True(t, bridge.ArrivedAt.IsZero())
+
+	require.Len(t, got.Failed, 2)
+	assert.Equal(t, 2, got.Failed[0].Index)
+	assert.Equal(t, "Off the map", got.Failed[0].Name)
+	assert.ErrorIs(t, got.Failed[0].Err, domain.ErrValidation)
+	assert.Equal(t, 3, got.Failed[1].Index)
+	assert.ErrorContains(t, got.Failed[1].Err, "name is required")
+
+	assert.Equal(t, []domain.WebhookEvent{domain.EventStopCreated, domain.EventStopCreated}, events.events)
+}
+
+func TestImportService_ImportGPX_TripNotFound(t *testing.T) {
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) {
+			return domain.Trip{}, domain.ErrNotFound
+		},
+	}
+	svc := service.NewImportService(trips, &mockStopRepo{}, nil)
+
+	_, err := svc.ImportGPX(context.Background(), uuid.New(), strings.NewReader(importGPX))
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestImportService_ImportGPX_NotGPX(t *testing.T) {
+	svc := service.NewImportService(foundTrips(), &mockStopRepo{}, nil)
+
+	for _, doc := range []string{`{"stops": []}`, `<gpx><trk><trkseg><trkpt lat="40" lon="-105"/></trkseg></trk></gpx>`} {
+		_, err := svc.ImportGPX(context.Background(), uuid.New(), strings.NewReader(doc))
+
+		assert.ErrorIs(t, err, domain.ErrValidation, doc)
+	}
+}
+
+func TestImportService_ImportGPX_SaveErrorIsReported(t *testing.T) {
+	boom := errors.New("connection reset")
+	calls := 0
+	stops := &mockStopRepo{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			calls++
+			if calls == 1 {
+				return domain.Stop{}, boom
+			}
+			s.ID = uuid.New()
+			return s, nil
+		},
+	}
+	events := &recordingPublisher{}
+	svc := service.NewImportService(foundTrips(), stops, events)
+
+	got, err := svc.ImportGPX(context.Background(), uuid.New(), strings.NewReader(importGPX))
+
+	require.NoError(t, err, "the stops saved before and after are reported, not lost behind an error")
+	assert.Equal(t, 2, calls)
+	require.Len(t, got.Created, 1)
+	assert.Equal(t, "Fishing Bridge RV Park", got.Created[0].Name)
+	require.Len(t, got.Failed, 3)
+	assert.Equal(t, 1, got.Failed[0].Index)
+	assert.Equal(t, "Cody KOA", got.Failed[0].Name)
+	assert.NotErrorIs(t, got.Failed[0].Err, domain.ErrValidation)
+	assert.NotContains(t, got.Failed[0].Err.Error(), "connection reset", "the cause is logged, not shown")
+	assert.Equal(t, []domain.WebhookEvent{domain.EventStopCreated}, events.events)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// JobService runs the server's scheduled jobs, such as the trash purge, so
+// that each runs once per interval across every replica of the API rather
+// than once per replica.
+type JobService struct {
+	jobs  repo.JobLocker
+	clock domain.Clock
+}
+
+// NewJobService constructs a JobService. Pass domain.SystemClock in
+// production; intervals are measured from clock.
+func NewJobService(jobs repo.JobLocker, clock domain.Clock) *JobService {
+	return &JobService{jobs: jobs, clock: clock}
+}
+
+// Run runs fn as the job called name if no replica is running it and none
+// has run it in the last every. Reports whether fn ran; a run that fails is
+// not counted, so the job is tried again at the next tick.
+func (s *JobService) Run(ctx context.Context, name string, every time.Duration, fn func(context.Context) error) (bool, error) {
+	ran, err := s.jobs.RunExclusive(ctx, name, every, s.clock.Now(), fn)
+	if err != nil {
+		return ran, fmt.Errorf("service.JobService.Run: %w", err)
+	}
+	return ran, nil
+}
@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memJobLocker is an in-memory repo.JobLocker shared by the "replicas" of
+// a test, as the database is in production.
+type memJobLocker struct {
+	mu      sync.Mutex
+	running map[string]bool
+	lastRun map[string]time.Time
+}
+
+func newMemJobLocker() *memJobLocker {
+	return &memJobLocker{running: map[string]bool{}, lastRun: map[string]time.Time{}}
+}
+
+func (m *memJobLocker) RunExclusive(ctx context.Context, name string, every time.Duration, now time.Time, fn func(context.Context) error) (bool, error) {
+	m.mu.Lock()
+	last, ok := m.lastRun[name]
+	if m.running[name] || ok && last.After(now.Add(-every)) {
+		m.mu.Unlock()
+		return false, nil
+	}
+	m.running[name] = true
+	m.mu.Unlock()
+
+	err := fn(ctx)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running[name] = false
+	if err == nil {
+		m.lastRun[name] = now
+	}
+	return true, err
+}
+
+var _ repo.JobLocker = (*memJobLocker)(nil)
+
+func TestJobService_RunsOncePerIntervalAcrossReplicas(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := domain.ClockFunc(func() time.Time { return now })
+	locker := newMemJobLocker()
+	replicaA, replicaB := service.NewJobService(locker, clock), service.NewJobService(locker, clock)
+	runs := 0
+	job := func(context.Context) error { runs++; return nil }
+	ctx := context.Background()
+
+	ran, err := replicaA.Run(ctx, "trash_purge", time.Hour, job)
+	require.NoError(t, err)
+	assert.True(t, ran)
+	ran, err = replicaB.Run(ctx, "trash_purge", time.Hour, job)
+	require.NoError(t, err)
+	assert.False(t, ran, "already run this hour by the other replica")
+
+	now = now.Add(time.Hour)
+	ran, err = replicaB.Run(ctx, "trash_purge", time.Hour, job)
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, 2, runs)
+}
+
+func TestJobService_FailedRunIsRetried(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	jobs := service.NewJobService(newMemJobLocker(), domain.ClockFunc(func() time.Time { return now }))
+	ctx := context.Background()
+
+	ran, err := jobs.Run(ctx, "trash_purge", time.Hour, func(context.Context) error { return errors.New("database down") })
+	assert.True(t, ran)
+	assert.ErrorContains(t, err, "database down")
+
+	ran, err = jobs.Run(ctx, "trash_purge", time.Hour, func(context.Context) error { return nil })
+	require.NoError(t, err)
+	assert.True(t, ran, "a failed run does not count")
+}
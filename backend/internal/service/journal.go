@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// JournalService manages a trip's daily journal entries. Unlike a trip's
+// single notes field, a journal holds any number of dated entries.
+type JournalService struct {
+	trips   repo.TripRepo
+	stops   repo.StopRepo
+	journal repo.JournalRepo
+	events  EventPublisher
+}
+
+// NewJournalService constructs a JournalService. events may be nil, and then
+// no journal events are published.
+func NewJournalService(trips repo.TripRepo, stops repo.StopRepo, journal repo.JournalRepo, events EventPublisher) *JournalService {
+	return &JournalService{trips: trips, stops: stops, journal: journal, events: events}
+}
+
+// Create validates and persists an entry.
+// Returns domain.ErrNotFound if the trip does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *JournalService) Create(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	e, err := normalizeJournalEntry(e)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, e.TripID, domain.TripRoleEditor); err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Create: %w", err)
+	}
+	if err := s.checkStop(ctx, e); err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Create: %w", err)
+	}
+
+	created, err := s.journal.Create(ctx, e)
+	if err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Create: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventJournalEntryCreated, domain.TripRecordEvent(created.ID, created.TripID, created.StopID))
+	return created, nil
+}
+
+// GetByID returns one of a trip's entries.
+// Returns domain.ErrNotFound if the trip has no entry with that ID.
+func (s *JournalService) GetByID(ctx context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error) {
+	e, err := s.journal.GetByID(ctx, tripID, id)
+	if err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.GetByID: %w", err)
+	}
+	return e, nil
+}
+
+// ListByDay returns a trip's journal grouped into days, in date order.
+// A non-nil day limits the result to that day.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *JournalService) ListByDay(ctx context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalDay, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.JournalService.ListByDay: %w", err)
+	}
+	entries, err := s.journal.ListByTrip(ctx, tripID, day)
+	if err != nil {
+		return nil, fmt.Errorf("service.JournalService.ListByDay: %w", err)
+	}
+	return domain.GroupJournalByDay(entries), nil
+}
+
+// Update validates and overwrites an entry.
+// Returns domain.ErrNotFound if the trip has no entry with that ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *JournalService) Update(ctx context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	e, err := normalizeJournalEntry(e)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, e.TripID, domain.TripRoleEditor); err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Update: %w", err)
+	}
+	if err := s.checkStop(ctx, e); err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Update: %w", err)
+	}
+
+	updated, err := s.journal.Update(ctx, e)
+	if err != nil {
+		return domain.JournalEntry{}, fmt.Errorf("service.JournalService.Update: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventJournalEntryUpdated, domain.TripRecordEvent(updated.ID, updated.TripID, updated.StopID))
+	return updated, nil
+}
+
+// Delete removes an entry.
+// Returns domain.ErrNotFound if the trip has no entry with that ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *JournalService) Delete(ctx context.Context, tripID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.JournalService.Delete: %w", err)
+	}
+	if err := s.journal.Delete(ctx, tripID, id); err != nil {
+		return fmt.Errorf("service.JournalService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventJournalEntryDeleted, domain.TripRecordEvent(id, tripID, nil))
+	return nil
+}
+
+// checkStop rejects a stop link that points outside the entry's trip.
+// A stop on another trip is a bad request, not a missing resource.
+func (s *JournalService) checkStop(ctx context.Context, e domain.JournalEntry) error {
+	if e.StopID == nil {
+		return nil
+	}
+	if _, err := s.stops.GetByID(ctx, e.TripID, *e.StopID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: stop_id is not a stop on this trip", domain.ErrValidation)
+		}
+		return err
+	}
+	return nil
+}
+
+// normalizeJournalEntry trims the body and enforces the rules shared by
+// create and update.
+func normalizeJournalEntry(e domain.JournalEntry) (domain.JournalEntry, error) {
+	e.Body = strings.TrimSpace(e.Body)
+
+	if e.Date.IsZero() {
+		return e, fmt.Errorf("%w: date is required", domain.ErrValidation)
+	}
+	if e.Body == "" {
+		return e, fmt.Errorf("%w: body is required", domain.ErrValidation)
+	}
+	if e.Mood != "" && !e.Mood.Valid() {
+		return e, fmt.Errorf("%w: mood must be one of great, good, okay, rough, awful", domain.ErrValidation)
+	}
+	if e.Weather != "" && !e.Weather.Valid() {
+		return e, fmt.Errorf("%w: weather must be one of sunny, partly_cloudy, cloudy, rain, storm, snow, fog, wind", domain.ErrValidation)
+	}
+	return e, nil
+}
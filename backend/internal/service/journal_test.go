@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memJournalRepo is an in-memory repo.JournalRepo.
+type memJournalRepo struct {
+	entries []domain.JournalEntry
+}
+
+func (m *memJournalRepo) Create(_ context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	e.ID = uuid.New()
+	m.entries = append(m.entries, e)
+	return e, nil
+}
+func (m *memJournalRepo) GetByID(_ context.Context, tripID, id uuid.UUID) (domain.JournalEntry, error) {
+	for _, e := range m.entries {
+		if e.ID == id && e.TripID == tripID {
+			return e, nil
+		}
+	}
+	return domain.JournalEntry{}, domain.ErrNotFound
+}
+func (m *memJournalRepo) ListByTrip(_ context.Context, tripID uuid.UUID, day *domain.Date) ([]domain.JournalEntry, error) {
+	out := []domain.JournalEntry{}
+	for _, e := range m.entries {
+		if e.TripID == tripID && (day == nil || e.Date == *day) {
+			out = append(out, e)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out, nil
+}
+func (m *memJournalRepo) Update(_ context.Context, e domain.JournalEntry) (domain.JournalEntry, error) {
+	for i := range m.entries {
+		if m.entries[i].ID == e.ID && m.entries[i].TripID == e.TripID {
+			m.entries[i] = e
+			return e, nil
+		}
+	}
+	return domain.JournalEntry{}, domain.ErrNotFound
+}
+func (m *memJournalRepo) Delete(_ context.Context, tripID, id uuid.UUID) error {
+	for i, e := range m.entries {
+		if e.ID == id && e.TripID == tripID {
+			m.entries = append(m.entries[:i], m.entries[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.JournalRepo = (*memJournalRepo)(nil)
+
+// newJournalService returns a service over one trip with one stop.
+func newJournalService() (*service.JournalService, *memJournalRepo, uuid.UUID, uuid.UUID) {
+	tripID, stopID := uuid.New(), uuid.New()
+	journal := &memJournalRepo{}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id}, nil
+		},
+	}
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, tID, sID uuid.UUID) (domain.Stop, error) {
+			if tID != tripID || sID != stopID {
+				return domain.Stop{}, domain.ErrNotFound
+			}
+			return domain.Stop{ID: sID, TripID: tID}, nil
+		},
+	}
+	return service.NewJournalService(trips, stops, journal, nil), journal, tripID, stopID
+}
+
+func TestJournalService_Create_Valid(t *testing.T) {
+	svc, _, tripID, stopID := newJournalService()
+
+	got, err := svc.Create(context.Background(), domain.JournalEntry{
+		TripID:  tripID,
+		StopID:  &stopID,
+		Date:    domain.Date{Year: 2025, Month: 7, Day: 14},
+		Body:    "  Crossed at **Peace Arch**.\n",
+		Mood:    domain.MoodGood,
+		Weather: domain.WeatherPartlyCloudy,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Crossed at **Peace Arch**.", got.Body)
+	assert.Equal(t, &stopID, got.StopID)
+}
+
+func TestJournalService_Create_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(e *domain.JournalEntry)
+	}{
+		{"missing date", func(e *domain.JournalEntry) { e.Date = domain.Date{} }},
+		{"blank body", func(e *domain.JournalEntry) { e.Body = " \n " }},
+		{"unknown mood", func(e *domain.JournalEntry) { e.Mood = "ecstatic" }},
+		{"unknown weather", func(e *domain.JournalEntry) { e.Weather = "hail" }},
+		{"stop on another trip", func(e *domain.JournalEntry) { other := uuid.New(); e.StopID = &other }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, journal, tripID, _ := newJournalService()
+			e := domain.JournalEntry{TripID: tripID, Date: domain.Date{Year: 2025, Month: 7, Day: 14}, Body: "Drove to Hope."}
+			tc.mutate(&e)
+
+			_, err := svc.Create(context.Background(), e)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Empty(t, journal.entries)
+		})
+	}
+}
+
+func TestJournalService_Create_UnknownTrip(t *testing.T) {
+	svc, _, _, _ := newJournalService()
+
+	_, err := svc.Create(context.Background(), domain.JournalEntry{
+		TripID: uuid.New(), Date: domain.Date{Year: 2025, Month: 7, Day: 14}, Body: "Drove to Hope.",
+	})
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestJournalService_ListByDay(t *testing.T) {
+	svc, _, tripID, _ := newJournalService()
+	jul14 := domain.Date{Year: 2025, Month: 7, Day: 14}
+	jul15 := domain.Date{Year: 2025, Month: 7, Day: 15}
+	for _, e := range []domain.JournalEntry{
+		{TripID: tripID, Date: jul15, Body: "Rain all day."},
+		{TripID: tripID, Date: jul14, Body: "Border in the morning."},
+		{TripID: tripID, Date: jul14, Body: "Campfire at night."},
+	} {
+		_, err := svc.Create(context.Background(), e)
+		require.NoError(t, err)
+	}
+
+	days, err := svc.ListByDay(context.Background(), tripID, nil)
+
+	require.NoError(t, err)
+	require.Len(t, days, 2)
+	assert.Equal(t, jul14, days[0].Date)
+	require.Len(t, days[0].Entries, 2)
+	assert.Equal(t, "Border in the morning.", days[0].Entries[0].Body)
+	assert.Equal(t, "Campfire at night.", days[0].Entries[1].Body)
+	assert.Equal(t, jul15, days[1].Date)
+
+	days, err = svc.ListByDay(context.Background(), tripID, &jul15)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, "Rain all day.", days[0].Entries[0].Body)
+
+	_, err = svc.ListByDay(context.Background(), uuid.New(), nil)
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestJournalService_ListByDay_Empty(t *testing.T) {
+	svc, _, tripID, _ := newJournalService()
+
+	days, err := svc.ListByDay(context.Background(), tripID, nil)
+
+	require.NoError(t, err)
+	assert.NotNil(t, days)
+	assert.Empty(t, days)
+}
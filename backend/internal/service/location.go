@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// dwellLookback bounds how far back reports are read when looking for a new
+// dwell. A device that has reported nothing for this long starts afresh.
+const dwellLookback = 24 * time.Hour
+
+// defaultDevice names reports that do not say which tracker sent them.
+const defaultDevice = "default"
+
+// LocationService turns location reports from a tracking app into stops.
+//
+// Reports are stored as they arrive. Once a device's reports have stayed
+// within the geofence radius for the dwell time, the device is dwelling: a
+// stop is suggested on the trip in progress, or created outright when
+// domain.GeofenceSettings.AutoCreateStops is set. The first report from
+// outside the radius ends the dwell and checks the device out of its stop.
+type LocationService struct {
+	trips     repo.TripRepo
+	stops     repo.StopRepo
+	locations repo.LocationRepo
+	settings  domain.GeofenceSettings
+	clock     domain.Clock
+}
+
+// NewLocationService constructs a LocationService. Pass domain.SystemClock
+// in production; it stamps reports that carry no time of their own.
+func NewLocationService(trips repo.TripRepo, stops repo.StopRepo, locations repo.LocationRepo, settings domain.GeofenceSettings, clock domain.Clock) *LocationService {
+	return &LocationService{trips: trips, stops: stops, locations: locations, settings: settings, clock: clock}
+}
+
+// Ingest records a location report and updates the device's dwell.
+//
+// An empty Device is stored as "default" and a zero RecordedAt as now. A
+// report the device already sent is ignored, as is one less accurate than the
+// geofence radius for the purpose of dwell detection. Returns
+// domain.ErrValidation for coordinates out of range, and domain.ErrForbidden
+// if a trip is in progress that the request's user may only view.
+func (s *LocationService) Ingest(ctx context.Context, ping domain.LocationPing) error {
+	ping.Device = strings.TrimSpace(ping.Device)
+	if ping.Device == "" {
+		ping.Device = defaultDevice
+	}
+	if ping.RecordedAt.IsZero() {
+		ping.RecordedAt = s.clock.Now()
+	}
+	if ping.Latitude < -90 || ping.Latitude > 90 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+	}
+	if ping.Longitude < -180 || ping.Longitude > 180 {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrValidation)
+	}
+	if ping.AccuracyMeters != nil && *ping.AccuracyMeters < 0 {
+		return fmt.Errorf("%w: accuracy must not be negative", domain.ErrValidation)
+	}
+	if err := s.checkTripInProgress(ctx, ping.RecordedAt); err != nil {
+		return fmt.Errorf("service.LocationService.Ingest: %w", err)
+	}
+
+	inserted, err := s.locations.CreatePing(ctx, ping)
+	if err != nil {
+		return fmt.Errorf("service.LocationService.Ingest: %w", err)
+	}
+	if !inserted || !s.precise(ping) {
+		return nil
+	}
+
+	latest, err := s.locations.LatestDwell(ctx, ping.Device)
+	if err != nil {
+		return fmt.Errorf("service.LocationService.Ingest: %w", err)
+	}
+	if latest != nil && latest.Open() {
+		if err := s.track(ctx, *latest, ping); err != nil {
+			return fmt.Errorf("service.LocationService.Ingest: %w", err)
+		}
+		return nil
+	}
+
+	since := ping.RecordedAt.Add(-dwellLookback)
+	if latest != nil && latest.DepartedAt.After(since) {
+		since = *latest.DepartedAt
+	}
+	if err := s.detect(ctx, ping.Device, since); err != nil {
+		return fmt.Errorf("service.LocationService.Ingest: %w", err)
+	}
+	return nil
+}
+
+// ListSuggestions returns the stops suggested for a trip that are still
+// waiting for a decision, in arrival order.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *LocationService) ListSuggestions(ctx context.Context, tripID uuid.UUID) ([]domain.Dwell, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.LocationService.ListSuggestions: %w", err)
+	}
+	dwells, err := s.locations.ListDwells(ctx, tripID, domain.DwellSuggested)
+	if err != nil {
+		return nil, fmt.Errorf("service.LocationService.ListSuggestions: %w", err)
+	}
+	return dwells, nil
+}
+
+// AcceptSuggestion logs the suggested stop on its trip and returns it.
+// Returns domain.ErrNotFound if the trip has no such suggestion, and
+// domain.ErrValidation if it was already accepted or dismissed.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *LocationService) AcceptSuggestion(ctx context.Context, tripID, id uuid.UUID) (domain.Stop, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Stop{}, fmt.Errorf("service.LocationService.AcceptSuggestion: %w", err)
+	}
+	dwell, err := s.locations.GetDwell(ctx, tripID, id)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.LocationService.AcceptSuggestion: %w", err)
+	}
+	if dwell.Status != domain.DwellSuggested {
+		return domain.Stop{}, fmt.Errorf("%w: suggestion has already been %s", domain.ErrValidation, dwell.Status)
+	}
+	stop, err := s.accept(ctx, dwell)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.LocationService.AcceptSuggestion: %w", err)
+	}
+	return stop, nil
+}
+
+// DismissSuggestion marks a suggested stop as not worth logging. Dismissing
+// twice is not an error. Returns domain.ErrNotFound if the trip has no such
+// suggestion, and domain.ErrValidation if it was already accepted.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *LocationService) DismissSuggestion(ctx context.Context, tripID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.LocationService.DismissSuggestion: %w", err)
+	}
+	dwell, err := s.locations.GetDwell(ctx, tripID, id)
+	if err != nil {
+		return fmt.Errorf("service.LocationService.DismissSuggestion: %w", err)
+	}
+	switch dwell.Status {
+	case domain.DwellDismissed:
+		return nil
+	case domain.DwellAccepted:
+		return fmt.Errorf("%w: suggestion has already been accepted", domain.ErrValidation)
+	}
+	dwell.Status = domain.DwellDismissed
+	if _, err := s.locations.UpdateDwell(ctx, dwell); err != nil {
+		return fmt.Errorf("service.LocationService.DismissSuggestion: %w", err)
+	}
+	return nil
+}
+
+// checkTripInProgress returns domain.ErrForbidden if the trip in progress at
+// t, which a ping may add stops to or check out of, is one the request's
+// user may only view.
+func (s *LocationService) checkTripInProgress(ctx context.Context, t time.Time) error {
+	trips, err := s.trips.List(ctx)
+	if err != nil {
+		return err
+	}
+	trip, ok := domain.TripInProgress(trips, t)
+	if !ok {
+		return nil
+	}
+	return requireTripRole(ctx, s.trips, trip.ID, domain.TripRoleEditor)
+}
+
+// precise reports whether a ping is accurate enough to place it inside or
+// outside the geofence. Pings that report no accuracy are trusted.
+func (s *LocationService) precise(p domain.LocationPing) bool {
+	return p.AccuracyMeters == nil || *p.AccuracyMeters <= s.settings.RadiusMeters
+}
+
+// track applies a new ping to the device's open dwell: one from inside
+// extends it, one from outside closes it and checks out of its stop. Pings
+// older than the dwell's last sighting arrived out of order and are skipped.
+func (s *LocationService) track(ctx context.Context, dwell domain.Dwell, p domain.LocationPing) error {
+	if !p.RecordedAt.After(dwell.LastSeenAt) {
+		return nil
+	}
+	center := geo.Point{Lat: dwell.Latitude, Lon: dwell.Longitude}
+	if geo.DistanceMeters(center, pingPoint(p)) <= s.settings.RadiusMeters {
+		dwell.LastSeenAt = p.RecordedAt
+		_, err := s.locations.UpdateDwell(ctx, dwell)
+		return err
+	}
+
+	departed := p.RecordedAt
+	dwell.DepartedAt = &departed
+	if _, err := s.locations.UpdateDwell(ctx, dwell); err != nil {
+		return err
+	}
+	if dwell.StopID == nil {
+		return nil
+	}
+	stop, err := s.stops.GetByID(ctx, dwell.TripID, *dwell.StopID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if stop.DepartedAt != nil {
+		return nil // entered by hand; leave it be
+	}
+	lastSeen := dwell.LastSeenAt
+	stop.DepartedAt = &lastSeen
+	_, err = s.stops.Update(ctx, stop)
+	return err
+}
+
+// detect looks for a dwell in the device's pings since the given time and
+// records it against the trip in progress.
+func (s *LocationService) detect(ctx context.Context, device string, since time.Time) error {
+	pings, err := s.locations.ListPingsSince(ctx, device, since)
+	if err != nil {
+		return err
+	}
+	var precise []domain.LocationPing
+	for _, p := range pings {
+		if s.precise(p) {
+			precise = append(precise, p)
+		}
+	}
+	cluster := dwellCluster(precise, s.settings.RadiusMeters)
+	if len(cluster) == 0 {
+		return nil
+	}
+	arrived, lastSeen := cluster[0].RecordedAt, cluster[len(cluster)-1].RecordedAt
+	if lastSeen.Sub(arrived) < s.settings.Dwell {
+		return nil
+	}
+
+	trips, err := s.trips.List(ctx)
+	if err != nil {
+		return err
+	}
+	trip, ok := domain.TripInProgress(trips, arrived)
+	if !ok {
+		return nil
+	}
+
+	center := centroid(cluster)
+	dwell, created, err := s.locations.CreateDwell(ctx, domain.Dwell{
+		TripID:     trip.ID,
+		Device:     device,
+		Latitude:   center.Lat,
+		Longitude:  center.Lon,
+		ArrivedAt:  arrived,
+		LastSeenAt: lastSeen,
+		Status:     domain.DwellSuggested,
+	})
+	if err != nil || !created || !s.settings.AutoCreateStops {
+		return err
+	}
+	_, err = s.accept(ctx, dwell)
+	return err
+}
+
+// accept creates the stop for a dwell and marks the dwell accepted.
+func (s *LocationService) accept(ctx context.Context, dwell domain.Dwell) (domain.Stop, error) {
+	lat, lon := dwell.Latitude, dwell.Longitude
+	stop := domain.Stop{
+		TripID:    dwell.TripID,
+		Name:      fmt.Sprintf("Check-in at %.5f, %.5f", lat, lon),
+		Latitude:  &lat,
+		Longitude: &lon,
+		ArrivedAt: dwell.ArrivedAt,
+	}
+	if dwell.DepartedAt != nil {
+		// Checked out when the device was last seen there, not when it was
+		// first seen elsewhere.
+		lastSeen := dwell.LastSeenAt
+		stop.DepartedAt = &lastSeen
+	}
+	if err := validateStop(stop); err != nil {
+		return domain.Stop{}, err
+	}
+	created, err := s.stops.Create(ctx, stop)
+	if err != nil {
+		return domain.Stop{}, err
+	}
+
+	dwell.Status = domain.DwellAccepted
+	dwell.StopID = &created.ID
+	if _, err := s.locations.UpdateDwell(ctx, dwell); err != nil {
+		return domain.Stop{}, err
+	}
+	return created, nil
+}
+
+// dwellCluster returns the newest run of pings that all lie within radius
+// of the newest one, oldest first. pings must be in time order.
+func dwellCluster(pings []domain.LocationPing, radiusMeters float64) []domain.LocationPing {
+	if len(pings) == 0 {
+		return nil
+	}
+	anchor := pingPoint(pings[len(pings)-1])
+	start := len(pings) - 1
+	for start > 0 && geo.DistanceMeters(anchor, pingPoint(pings[start-1])) <= radiusMeters {
+		start--
+	}
+	return pings[start:]
+}
+
+// centroid returns the mean position of pings. The cluster is at most a few
+// hundred meters across, so averaging degrees is accurate enough.
+func centroid(pings []domain.LocationPing) geo.Point {
+	var c geo.Point
+	for _, p := range pings {
+		c.Lat += p.Latitude
+		c.Lon += p.Longitude
+	}
+	n := float64(len(pings))
+	return geo.Point{Lat: c.Lat / n, Lon: c.Lon / n}
+}
+
+func pingPoint(p domain.LocationPing) geo.Point {
+	return geo.Point{Lat: p.Latitude, Lon: p.Longitude}
+}
@@ -0,0 +1,356 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memLocationRepo is an in-memory repo.LocationRepo.
+type memLocationRepo struct {
+	pings  []domain.LocationPing
+	dwells []domain.Dwell
+}
+
+func (m *memLocationRepo) CreatePing(_ context.Context, p domain.LocationPing) (bool, error) {
+	for _, existing := range m.pings {
+		if existing.Device == p.Device && existing.RecordedAt.Equal(p.RecordedAt) {
+			return false, nil
+		}
+	}
+	p.ID = uuid.New()
+	m.pings = append(m.pings, p)
+	sort.SliceStable(m.pings, func(i, j int) bool { return m.pings[i].RecordedAt.Before(m.pings[j].RecordedAt) })
+	return true, nil
+}
+func (m *memLocationRepo) ListPingsSince(_ context.Context, device string, since time.Time) ([]domain.LocationPing, error) {
+	out := []domain.LocationPing{}
+	for _, p := range m.pings {
+		if p.Device == device && !p.RecordedAt.Before(since) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+func (m *memLocationRepo) LatestDwell(_ context.Context, device string) (*domain.Dwell, error) {
+	var latest *domain.Dwell
+	for i := range m.dwells {
+		d := m.dwells[i]
+		if d.Device == device && (latest == nil || d.ArrivedAt.After(latest.ArrivedAt)) {
+			latest = &d
+		}
+	}
+	return latest, nil
+}
+func (m *memLocationRepo) CreateDwell(_ context.Context, d domain.Dwell) (domain.Dwell, bool, error) {
+	for _, existing := range m.dwells {
+		if existing.Device == d.Device && existing.Open() {
+			return domain.Dwell{}, false, nil
+		}
+	}
+	d.ID = uuid.New()
+	m.dwells = append(m.dwells, d)
+	return d, true, nil
+}
+func (m *memLocationRepo) GetDwell(_ context.Context, tripID, id uuid.UUID) (domain.Dwell, error) {
+	for _, d := range m.dwells {
+		if d.ID == id && d.TripID == tripID {
+			return d, nil
+		}
+	}
+	return domain.Dwell{}, domain.ErrNotFound
+}
+func (m *memLocationRepo) ListDwells(_ context.Context, tripID uuid.UUID, status domain.DwellStatus) ([]domain.Dwell, error) {
+	out := []domain.Dwell{}
+	for _, d := range m.dwells {
+		if d.TripID == tripID && d.Status == status {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+func (m *memLocationRepo) UpdateDwell(_ context.Context, d domain.Dwell) (domain.Dwell, error) {
+	for i := range m.dwells {
+		if m.dwells[i].ID == d.ID && m.dwells[i].TripID == d.TripID {
+			m.dwells[i] = d
+			return d, nil
+		}
+	}
+	return domain.Dwell{}, domain.ErrNotFound
+}
+
+var _ repo.LocationRepo = (*memLocationRepo)(nil)
+
+// locationFixture is a trip in progress since June 30, 2025, with stops kept
+// in memory.
+type locationFixture struct {
+	svc       *service.LocationService
+	locations *memLocationRepo
+	trip      domain.Trip
+	stops     map[uuid.UUID]domain.Stop
+}
+
+func newLocationFixture(autoCreate bool) *locationFixture {
+	f := &locationFixture{
+		locations: &memLocationRepo{},
+		trip:      domain.Trip{ID: uuid.New(), StartDate: domain.NewDate(2025, 6, 30)},
+		stops:     map[uuid.UUID]domain.Stop{},
+	}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != f.trip.ID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return f.trip, nil
+		},
+		list: func(_ context.Context) ([]domain.Trip, error) {
+			return []domain.Trip{f.trip}, nil
+		},
+	}
+	stops := &mockStopRepo{
+		create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			s.ID = uuid.New()
+			f.stops[s.ID] = s
+			return s, nil
+		},
+		getByID: func(_ context.Context, tripID, id uuid.UUID) (domain.Stop, error) {
+			s, ok := f.stops[id]
+			if !ok || s.TripID != tripID {
+				return domain.Stop{}, domain.ErrNotFound
+			}
+			return s, nil
+		},
+		update: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			f.stops[s.ID] = s
+			return s, nil
+		},
+	}
+	settings := domain.GeofenceSettings{Dwell: 30 * time.Minute, RadiusMeters: 150, AutoCreateStops: autoCreate}
+	clock := domain.ClockFunc(func() time.Time { return time.Date(2025, 7, 1, 18, 0, 0, 0, time.UTC) })
+	f.svc = service.NewLocationService(trips, stops, f.locations, settings, clock)
+	return f
+}
+
+var checkInStart = time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+
+// report ingests a ping from the "rv" device the given number of minutes
+// after checkInStart.
+func (f *locationFixture) report(t *testing.T, minutes int, lat, lon float64) {
+	t.Helper()
+	err := f.svc.Ingest(context.Background(), domain.LocationPing{
+		Device: "rv", Latitude: lat, Longitude: lon,
+		RecordedAt: checkInStart.Add(time.Duration(minutes) * time.Minute),
+	})
+	require.NoError(t, err)
+}
+
+// Madison Campground, and a spot a few meters away within the geofence.
+const (
+	campLat, campLon     = 44.6455, -110.8617
+	nearbyLat, nearbyLon = 44.6456, -110.8618
+	roadLat, roadLon     = 44.7000, -110.9000
+)
+
+func TestLocationService_Ingest_SuggestsStopAfterDwell(t *testing.T) {
+	f := newLocationFixture(false)
+
+	f.report(t, 0, campLat, campLon)
+	f.report(t, 15, nearbyLat, nearbyLon)
+	require.Empty(t, f.locations.dwells, "not there long enough yet")
+	f.report(t, 30, campLat, campLon)
+
+	suggestions, err := f.svc.ListSuggestions(context.Background(), f.trip.ID)
+
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	s := suggestions[0]
+	assert.Equal(t, "rv", s.Device)
+	assert.True(t, s.ArrivedAt.Equal(checkInStart))
+	assert.InDelta(t, campLat, s.Latitude, 0.001)
+	assert.InDelta(t, campLon, s.Longitude, 0.001)
+	assert.True(t, s.Open())
+	assert.Empty(t, f.stops, "suggested, not created")
+}
+
+func TestLocationService_Ingest_MovingDoesNotDwell(t *testing.T) {
+	f := newLocationFixture(false)
+
+	for i := 0; i <= 6; i++ {
+		f.report(t, i*10, campLat+float64(i)*0.01, campLon)
+	}
+
+	assert.Empty(t, f.locations.dwells)
+}
+
+func TestLocationService_Ingest_AutoCreatesStopAndChecksOut(t *testing.T) {
+	f := newLocationFixture(true)
+
+	f.report(t, 0, campLat, campLon)
+	f.report(t, 30, campLat, campLon)
+	f.report(t, 90, nearbyLat, nearbyLon)
+	f.report(t, 120, roadLat, roadLon)
+
+	require.Len(t, f.locations.dwells, 1)
+	d := f.locations.dwells[0]
+	assert.Equal(t, domain.DwellAccepted, d.Status)
+	require.NotNil(t, d.StopID)
+	require.NotNil(t, d.DepartedAt)
+	assert.True(t, d.DepartedAt.Equal(checkInStart.Add(120*time.Minute)))
+
+	stop := f.stops[*d.StopID]
+	assert.Equal(t, f.trip.ID, stop.TripID)
+	assert.True(t, stop.HasCoordinates())
+	assert.True(t, stop.ArrivedAt.Equal(checkInStart))
+	require.NotNil(t, stop.DepartedAt)
+	assert.True(t, stop.DepartedAt.Equal(checkInStart.Add(90*time.Minute)), "checked out when last seen at camp")
+}
+
+func TestLocationService_Ingest_OneDwellPerVisit(t *testing.T) {
+	f := newLocationFixture(true)
+
+	for m := 0; m <= 240; m += 30 {
+		f.report(t, m, campLat, campLon)
+	}
+
+	assert.Len(t, f.locations.dwells, 1)
+	assert.Len(t, f.stops, 1)
+	assert.True(t, f.locations.dwells[0].LastSeenAt.Equal(checkInStart.Add(240*time.Minute)))
+}
+
+func TestLocationService_Ingest_NextStopAfterLeaving(t *testing.T) {
+	f := newLocationFixture(false)
+
+	f.report(t, 0, campLat, campLon)
+	f.report(t, 30, campLat, campLon)
+	f.report(t, 60, roadLat, roadLon)
+	f.report(t, 100, roadLat, roadLon)
+
+	require.Len(t, f.locations.dwells, 2)
+	assert.False(t, f.locations.dwells[0].Open())
+	assert.True(t, f.locations.dwells[1].ArrivedAt.Equal(checkInStart.Add(60*time.Minute)))
+}
+
+func TestLocationService_Ingest_NoTripInProgress(t *testing.T) {
+	f := newLocationFixture(true)
+	f.trip.StartDate = domain.NewDate(2025, 8, 1)
+
+	f.report(t, 0, campLat, campLon)
+	f.report(t, 45, campLat, campLon)
+
+	assert.Empty(t, f.locations.dwells)
+	assert.Len(t, f.locations.pings, 2, "reports are still stored")
+}
+
+func TestLocationService_Ingest_IgnoresImpreciseAndRepeatedReports(t *testing.T) {
+	f := newLocationFixture(false)
+	ctx := context.Background()
+	vague := 2000.0
+
+	f.report(t, 0, campLat, campLon)
+	require.NoError(t, f.svc.Ingest(ctx, domain.LocationPing{
+		Device: "rv", Latitude: roadLat, Longitude: roadLon, AccuracyMeters: &vague,
+		RecordedAt: checkInStart.Add(20 * time.Minute),
+	}))
+	f.report(t, 0, campLat, campLon) // resent
+	f.report(t, 40, campLat, campLon)
+
+	assert.Len(t, f.locations.pings, 3)
+	require.Len(t, f.locations.dwells, 1, "the vague report did not break the dwell")
+}
+
+func TestLocationService_Ingest_Defaults(t *testing.T) {
+	f := newLocationFixture(false)
+
+	err := f.svc.Ingest(context.Background(), domain.LocationPing{Latitude: campLat, Longitude: campLon})
+
+	require.NoError(t, err)
+	require.Len(t, f.locations.pings, 1)
+	assert.Equal(t, "default", f.locations.pings[0].Device)
+	assert.True(t, f.locations.pings[0].RecordedAt.Equal(time.Date(2025, 7, 1, 18, 0, 0, 0, time.UTC)))
+}
+
+func TestLocationService_Ingest_Validation(t *testing.T) {
+	negative := -1.0
+	tests := map[string]domain.LocationPing{
+		"latitude":  {Latitude: 95, Longitude: 0},
+		"longitude": {Latitude: 0, Longitude: 200},
+		"accuracy":  {Latitude: 0, Longitude: 0, AccuracyMeters: &negative},
+	}
+	for name, ping := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newLocationFixture(false)
+
+			err := f.svc.Ingest(context.Background(), ping)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Empty(t, f.locations.pings)
+		})
+	}
+}
+
+func TestLocationService_AcceptSuggestion(t *testing.T) {
+	ctx := context.Background()
+	f := newLocationFixture(false)
+	f.report(t, 0, campLat, campLon)
+	f.report(t, 30, campLat, campLon)
+	id := f.locations.dwells[0].ID
+
+	stop, err := f.svc.AcceptSuggestion(ctx, f.trip.ID, id)
+
+	require.NoError(t, err)
+	assert.Equal(t, f.trip.ID, stop.TripID)
+	assert.NotEmpty(t, stop.Name)
+	assert.True(t, stop.ArrivedAt.Equal(checkInStart))
+	assert.Nil(t, stop.DepartedAt, "still there")
+
+	suggestions, err := f.svc.ListSuggestions(ctx, f.trip.ID)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+
+	_, err = f.svc.AcceptSuggestion(ctx, f.trip.ID, id)
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
+
+func TestLocationService_DismissSuggestion(t *testing.T) {
+	ctx := context.Background()
+	f := newLocationFixture(false)
+	f.report(t, 0, campLat, campLon)
+	f.report(t, 30, campLat, campLon)
+	id := f.locations.dwells[0].ID
+
+	require.NoError(t, f.svc.DismissSuggestion(ctx, f.trip.ID, id))
+	require.NoError(t, f.svc.DismissSuggestion(ctx, f.trip.ID, id), "dismissing twice is fine")
+
+	// Staying longer does not bring it back.
+	f.report(t, 90, campLat, campLon)
+	suggestions, err := f.svc.ListSuggestions(ctx, f.trip.ID)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+
+	_, err = f.svc.AcceptSuggestion(ctx, f.trip.ID, id)
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
+
+func TestLocationService_Suggestion_NotFound(t *testing.T) {
+	ctx := context.Background()
+	f := newLocationFixture(false)
+
+	_, err := f.svc.AcceptSuggestion(ctx, f.trip.ID, uuid.New())
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+
+	err = f.svc.DismissSuggestion(ctx, f.trip.ID, uuid.New())
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+
+	_, err = f.svc.ListSuggestions(ctx, uuid.New())
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
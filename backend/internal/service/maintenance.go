@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// MaintenanceService tracks mileage-based maintenance: the items each
+// vehicle needs serviced every so many miles, the services done, and which
+// items the odometer says are coming due.
+type MaintenanceService struct {
+	items    repo.MaintenanceRepo
+	readings repo.OdometerRepo
+	clock    domain.Clock
+}
+
+// NewMaintenanceService constructs a MaintenanceService. Pass
+// domain.SystemClock in production; a vehicle's current mileage is its latest
+// reading as of clock's time.
+func NewMaintenanceService(items repo.MaintenanceRepo, readings repo.OdometerRepo, clock domain.Clock) *MaintenanceService {
+	return &MaintenanceService{items: items, readings: readings, clock: clock}
+}
+
+// CreateItem validates and persists an item.
+func (s *MaintenanceService) CreateItem(ctx context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error) {
+	item.Vehicle = strings.TrimSpace(item.Vehicle)
+	item.Name = strings.TrimSpace(item.Name)
+	if item.Vehicle == "" {
+		return domain.MaintenanceItem{}, fmt.Errorf("%w: vehicle is required", domain.ErrValidation)
+	}
+	if item.Name == "" {
+		return domain.MaintenanceItem{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if item.IntervalMiles <= 0 {
+		return domain.MaintenanceItem{}, fmt.Errorf("%w: interval_miles must be positive", domain.ErrValidation)
+	}
+
+	created, err := s.items.CreateItem(ctx, item)
+	if err != nil {
+		return domain.MaintenanceItem{}, fmt.Errorf("service.MaintenanceService.CreateItem: %w", err)
+	}
+	return created, nil
+}
+
+// ListItems returns the items for vehicle, or for every vehicle when it is
+// empty, ordered by vehicle and name.
+func (s *MaintenanceService) ListItems(ctx context.Context, vehicle string) ([]domain.MaintenanceItem, error) {
+	items, err := s.items.ListItems(ctx, strings.TrimSpace(vehicle))
+	if err != nil {
+		return nil, fmt.Errorf("service.MaintenanceService.ListItems: %w", err)
+	}
+	return items, nil
+}
+
+// DeleteItem removes an item and its service history.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *MaintenanceService) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	if err := s.items.DeleteItem(ctx, id); err != nil {
+		return fmt.Errorf("service.MaintenanceService.DeleteItem: %w", err)
+	}
+	return nil
+}
+
+// RecordService logs that an item was serviced. The record with the most
+// miles is the item's last service, so back-filling older history does not
+// move the next due point.
+// Returns domain.ErrNotFound if the item does not exist.
+func (s *MaintenanceService) RecordService(ctx context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error) {
+	if rec.Miles < 0 {
+		return domain.MaintenanceRecord{}, fmt.Errorf("%w: miles must not be negative", domain.ErrValidation)
+	}
+	if rec.ServicedOn.IsZero() {
+		return domain.MaintenanceRecord{}, fmt.Errorf("%w: serviced_on is required", domain.ErrValidation)
+	}
+	rec.Notes = strings.TrimSpace(rec.Notes)
+
+	created, err := s.items.CreateRecord(ctx, rec)
+	if err != nil {
+		return domain.MaintenanceRecord{}, fmt.Errorf("service.MaintenanceService.RecordService: %w", err)
+	}
+	return created, nil
+}
+
+// Due returns the items overdue or due within withinMiles, most overdue
+// first. Each is checked against its vehicle's latest odometer reading; a
+// vehicle with no readings is skipped, since there is nothing to check.
+func (s *MaintenanceService) Due(ctx context.Context, withinMiles float64) ([]domain.MaintenanceDue, error) {
+	if withinMiles < 0 {
+		return nil, fmt.Errorf("%w: within must not be negative", domain.ErrValidation)
+	}
+	items, err := s.items.ListItems(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("service.MaintenanceService.Due: %w", err)
+	}
+
+	now := s.clock.Now()
+	current := map[string]*domain.OdometerReading{}
+	due := []domain.MaintenanceDue{}
+	for _, item := range items {
+		latest, seen := current[item.Vehicle]
+		if !seen {
+			latest, _, err = s.readings.Adjacent(ctx, item.Vehicle, now)
+			if err != nil {
+				return nil, fmt.Errorf("service.MaintenanceService.Due: %w", err)
+			}
+			current[item.Vehicle] = latest
+		}
+		if latest == nil {
+			continue
+		}
+		if d := domain.CheckMaintenance(item, latest.Miles, withinMiles); d.Status != domain.MaintenanceOK {
+			due = append(due, d)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].MilesRemaining < due[j].MilesRemaining })
+	return due, nil
+}
@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memMaintenanceRepo is an in-memory repo.MaintenanceRepo that, like the
+// Postgres one, reads each item with its highest-mileage service.
+type memMaintenanceRepo struct {
+	items   []domain.MaintenanceItem
+	records []domain.MaintenanceRecord
+}
+
+func (m *memMaintenanceRepo) CreateItem(_ context.Context, item domain.MaintenanceItem) (domain.MaintenanceItem, error) {
+	item.ID = uuid.New()
+	item.CreatedAt = time.Now()
+	m.items = append(m.items, item)
+	return item, nil
+}
+func (m *memMaintenanceRepo) GetItem(_ context.Context, id uuid.UUID) (domain.MaintenanceItem, error) {
+	for _, item := range m.items {
+		if item.ID == id {
+			return m.withLastService(item), nil
+		}
+	}
+	return domain.MaintenanceItem{}, domain.ErrNotFound
+}
+func (m *memMaintenanceRepo) ListItems(_ context.Context, vehicle string) ([]domain.MaintenanceItem, error) {
+	out := []domain.MaintenanceItem{}
+	for _, item := range m.items {
+		if vehicle == "" || item.Vehicle == vehicle {
+			out = append(out, m.withLastService(item))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Vehicle != out[j].Vehicle {
+			return out[i].Vehicle < out[j].Vehicle
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+func (m *memMaintenanceRepo) DeleteItem(_ context.Context, id uuid.UUID) error {
+	for i, item := range m.items {
+		if item.ID == id {
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memMaintenanceRepo) CreateRecord(_ context.Context, rec domain.MaintenanceRecord) (domain.MaintenanceRecord, error) {
+	for _, item := range m.items {
+		if item.ID == rec.ItemID {
+			rec.ID = uuid.New()
+			rec.CreatedAt = time.Now()
+			m.records = append(m.records, rec)
+			return rec, nil
+		}
+	}
+	return domain.MaintenanceRecord{}, domain.ErrNotFound
+}
+
+func (m *memMaintenanceRepo) withLastService(item domain.MaintenanceItem) domain.MaintenanceItem {
+	item.LastService = nil
+	for i, rec := range m.records {
+		if rec.ItemID == item.ID && (item.LastService == nil || rec.Miles > item.LastService.Miles) {
+			item.LastService = &m.records[i]
+		}
+	}
+	return item
+}
+
+var _ repo.MaintenanceRepo = (*memMaintenanceRepo)(nil)
+
+func newMaintenanceService() (*service.MaintenanceService, *memMaintenanceRepo, *memOdometerRepo) {
+	items := &memMaintenanceRepo{}
+	readings := &memOdometerRepo{}
+	return service.NewMaintenanceService(items, readings, &fakeClock{now: odometerT0.Add(24 * time.Hour)}), items, readings
+}
+
+func TestMaintenanceService_CreateItem_Validation(t *testing.T) {
+	cases := map[string]domain.MaintenanceItem{
+		"missing vehicle":   {Vehicle: " ", Name: "Oil change", IntervalMiles: 5000},
+		"missing name":      {Vehicle: "Motorhome", Name: " ", IntervalMiles: 5000},
+		"zero interval":     {Vehicle: "Motorhome", Name: "Oil change"},
+		"negative interval": {Vehicle: "Motorhome", Name: "Oil change", IntervalMiles: -1},
+	}
+	for name, in := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, _, _ := newMaintenanceService()
+			_, err := svc.CreateItem(context.Background(), in)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestMaintenanceService_RecordService_Validation(t *testing.T) {
+	svc, _, _ := newMaintenanceService()
+	ctx := context.Background()
+
+	_, err := svc.RecordService(ctx, domain.MaintenanceRecord{ItemID: uuid.New(), Miles: -1, ServicedOn: domain.NewDate(2025, 6, 1)})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.RecordService(ctx, domain.MaintenanceRecord{ItemID: uuid.New(), Miles: 100})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestMaintenanceService_RecordService_UnknownItem(t *testing.T) {
+	svc, _, _ := newMaintenanceService()
+
+	_, err := svc.RecordService(context.Background(), domain.MaintenanceRecord{ItemID: uuid.New(), Miles: 100, ServicedOn: domain.NewDate(2025, 6, 1)})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestMaintenanceService_Due(t *testing.T) {
+	svc, _, readings := newMaintenanceService()
+	ctx := context.Background()
+	oil, err := svc.CreateItem(ctx, domain.MaintenanceItem{Vehicle: "Motorhome", Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+	tires, err := svc.CreateItem(ctx, domain.MaintenanceItem{Vehicle: "Motorhome", Name: "Tire rotation", IntervalMiles: 7500})
+	require.NoError(t, err)
+	_, err = svc.CreateItem(ctx, domain.MaintenanceItem{Vehicle: "Motorhome", Name: "Generator service", IntervalMiles: 50000})
+	require.NoError(t, err)
+	_, err = svc.CreateItem(ctx, domain.MaintenanceItem{Vehicle: "Tow car", Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+
+	_, err = svc.RecordService(ctx, domain.MaintenanceRecord{ItemID: oil.ID, Miles: 40000, ServicedOn: domain.NewDate(2025, 3, 1)})
+	require.NoError(t, err)
+	_, err = svc.RecordService(ctx, domain.MaintenanceRecord{ItemID: tires.ID, Miles: 36300, ServicedOn: domain.NewDate(2025, 1, 1)})
+	require.NoError(t, err)
+	readings.rows = append(readings.rows,
+		domain.OdometerReading{Vehicle: "Motorhome", Miles: 44000, RecordedAt: odometerT0.Add(-time.Hour)},
+		domain.OdometerReading{Vehicle: "Motorhome", Miles: 44700, RecordedAt: odometerT0},
+		// Recorded after the clock's now, so not yet the current mileage.
+		domain.OdometerReading{Vehicle: "Motorhome", Miles: 90000, RecordedAt: odometerT0.Add(48 * time.Hour)},
+	)
+
+	due, err := svc.Due(ctx, 500)
+
+	require.NoError(t, err)
+	require.Len(t, due, 2, "the generator is not due and the tow car has no readings")
+	assert.Equal(t, tires.ID, due[0].Item.ID, "most overdue first")
+	assert.Equal(t, domain.MaintenanceOverdue, due[0].Status)
+	assert.InDelta(t, -900, due[0].MilesRemaining, 0.001)
+	assert.Equal(t, oil.ID, due[1].Item.ID)
+	assert.Equal(t, domain.MaintenanceDueSoon, due[1].Status)
+	assert.InDelta(t, 44700, due[1].CurrentMiles, 0.001)
+	assert.InDelta(t, 45000, due[1].DueAtMiles, 0.001)
+	assert.InDelta(t, 300, due[1].MilesRemaining, 0.001)
+}
+
+func TestMaintenanceService_Due_NegativeWithin(t *testing.T) {
+	svc, _, _ := newMaintenanceService()
+
+	_, err := svc.Due(context.Background(), -1)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/png"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/staticmap"
+)
+
+// Static map sizes, in pixels. Either dimension left at zero gets the default.
+const (
+	DefaultMapWidth  = 800
+	DefaultMapHeight = 600
+	MinMapSize       = 64
+	MaxMapSize       = 2048
+)
+
+// MapService renders trips as static map images.
+type MapService struct {
+	trips    repo.TripRepo
+	stops    repo.StopRepo
+	legs     repo.RouteLegRepo
+	renderer *staticmap.Renderer
+}
+
+// NewMapService constructs a MapService that draws on tiles. A nil tiles
+// draws maps on a plain background.
+func NewMapService(trips repo.TripRepo, stops repo.StopRepo, legs repo.RouteLegRepo, tiles staticmap.Tiles) *MapService {
+	return &MapService{trips: trips, stops: stops, legs: legs, renderer: staticmap.NewRenderer(tiles)}
+}
+
+// TripMapPNG renders a trip as a width×height PNG: a marker at every stop
+// with coordinates (green for the first, red for the last) and the route
+// between them.
+//
+// Each leg is drawn along its polyline when it has one, and as a straight
+// line between its stops otherwise. Legs are matched to the current stop
+// order, so a leg left over from before stops were reordered is ignored.
+//
+// Returns domain.ErrNotFound if the trip does not exist, and
+// domain.ErrValidation for an out-of-range size or a trip with nothing to
+// draw.
+func (s *MapService) TripMapPNG(ctx context.Context, tripID uuid.UUID, width, height int) ([]byte, error) {
+	width, height, err := mapSize(width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.MapService.TripMapPNG: %w", err)
+	}
+	stops, err := s.stops.ListByTripID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.MapService.TripMapPNG: %w", err)
+	}
+	legs, err := s.legs.ListByTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.MapService.TripMapPNG: %w", err)
+	}
+
+	img, err := s.render(ctx, tripMap(stops, legs), width, height)
+	if err != nil {
+		return nil, fmt.Errorf("service.MapService.TripMapPNG: %w", err)
+	}
+	return img, nil
+}
+
+// StopsMapPNG renders stops, in arrival order, as TripMapPNG renders a
+// trip, with straight lines between them. It is for trips read through a
+// share link, whose route legs the reader cannot load.
+// Returns domain.ErrValidation for an out-of-range size or stops with
+// nothing to draw.
+func (s *MapService) StopsMapPNG(ctx context.Context, stops []domain.Stop, width, height int) ([]byte, error) {
+	width, height, err := mapSize(width, height)
+	if err != nil {
+		return nil, err
+	}
+	img, err := s.render(ctx, tripMap(stops, nil), width, height)
+	if err != nil {
+		return nil, fmt.Errorf("service.MapService.StopsMapPNG: %w", err)
+	}
+	return img, nil
+}
+
+// mapSize applies the default to a zero width or height and checks both are
+// within range.
+func mapSize(width, height int) (int, int, error) {
+	if width == 0 {
+		width = DefaultMapWidth
+	}
+	if height == 0 {
+		height = DefaultMapHeight
+	}
+	if width < MinMapSize || width > MaxMapSize || height < MinMapSize || height > MaxMapSize {
+		return 0, 0, fmt.Errorf("%w: width and height must be between %d and %d", domain.ErrValidation, MinMapSize, MaxMapSize)
+	}
+	return width, height, nil
+}
+
+// render draws m at width×height and encodes it as a PNG.
+func (s *MapService) render(ctx context.Context, m staticmap.Map, width, height int) ([]byte, error) {
+	m.Width, m.Height = width, height
+	img, err := s.renderer.Render(ctx, m)
+	if errors.Is(err, staticmap.ErrEmpty) {
+		return nil, fmt.Errorf("%w: the trip has no stops with coordinates and no route to draw", domain.ErrValidation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// tripMap lays out the markers and route lines for stops, which are in
+// arrival order, and the trip's legs.
+func tripMap(stops []domain.Stop, legs []domain.RouteLeg) staticmap.Map {
+	var m staticmap.Map
+
+	var located []domain.Stop
+	for _, st := range stops {
+		if st.HasCoordinates() {
+			located = append(located, st)
+		}
+	}
+	for i, st := range located {
+		c := staticmap.StopColor
+		switch {
+		case i == 0:
+			c = staticmap.StartColor
+		case i == len(located)-1:
+			c = staticmap.EndColor
+		}
+		m.Markers = append(m.Markers, staticmap.Marker{Point: stopPoint(st), Color: c})
+	}
+
+	byPair := make(map[domain.StopPair]domain.RouteLeg, len(legs))
+	for _, l := range legs {
+		byPair[domain.StopPair{From: l.FromStopID, To: l.ToStopID}] = l
+	}
+	for i := 1; i < len(stops); i++ {
+		from, to := stops[i-1], stops[i]
+		if l, ok := byPair[domain.StopPair{From: from.ID, To: to.ID}]; ok && l.Polyline != "" {
+			// Polylines are validated on write; one that still fails to
+			// decode falls back to the straight line below.
+			if pts, err := geo.DecodePolyline(l.Polyline); err == nil && len(pts) > 1 {
+				m.Paths = append(m.Paths, pts)
+				continue
+			}
+		}
+		if from.HasCoordinates() && to.HasCoordinates() {
+			m.Paths = append(m.Paths, []geo.Point{stopPoint(from), stopPoint(to)})
+		}
+	}
+	return m
+}
+
+// stopPoint returns the position of a stop that has coordinates.
+func stopPoint(st domain.Stop) geo.Point {
+	return geo.Point{Lat: *st.Latitude, Lon: *st.Longitude}
+}
@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/internal/staticmap"
+)
+
+type mapFixture struct {
+	svc    *service.MapService
+	legs   *memRouteLegRepo
+	tripID uuid.UUID
+	stops  []domain.Stop
+}
+
+func newMapFixture(stops ...domain.Stop) *mapFixture {
+	f := &mapFixture{legs: &memRouteLegRepo{}, tripID: uuid.New(), stops: stops}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != f.tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id}, nil
+		},
+	}
+	stopRepo := &mockStopRepo{
+		listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
+			return f.stops, nil
+		},
+	}
+	f.svc = service.NewMapService(trips, stopRepo, f.legs, nil)
+	return f
+}
+
+func locatedStop(lat, lon float64) domain.Stop {
+	return domain.Stop{ID: uuid.New(), Latitude: &lat, Longitude: &lon}
+}
+
+func TestMapService_TripMapPNG(t *testing.T) {
+	f := newMapFixture(locatedStop(44.6455, -110.8617), domain.Stop{ID: uuid.New()}, locatedStop(43.7904, -110.6818))
+
+	data, err := f.svc.TripMapPNG(context.Background(), f.tripID, 320, 240)
+
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 320, img.Bounds().Dx())
+	assert.Equal(t, 240, img.Bounds().Dy())
+}
+
+func TestMapService_TripMapPNG_DefaultSize(t *testing.T) {
+	f := newMapFixture(locatedStop(44.6455, -110.8617))
+
+	data, err := f.svc.TripMapPNG(context.Background(), f.tripID, 0, 0)
+
+	require.NoError(t, err)
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, service.DefaultMapWidth, cfg.Width)
+	assert.Equal(t, service.DefaultMapHeight, cfg.Height)
+}
+
+func TestMapService_TripMapPNG_Validation(t *testing.T) {
+	tests := []struct {
+		name          string
+		stops         []domain.Stop
+		width, height int
+	}{
+		{"too narrow", []domain.Stop{locatedStop(45, -110)}, 10, 100},
+		{"too tall", []domain.Stop{locatedStop(45, -110)}, 100, service.MaxMapSize + 1},
+		{"no stops", nil, 0, 0},
+		{"no coordinates", []domain.Stop{{ID: uuid.New()}, {ID: uuid.New()}}, 0, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newMapFixture(tc.stops...)
+
+			_, err := f.svc.TripMapPNG(context.Background(), f.tripID, tc.width, tc.height)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+		})
+	}
+}
+
+func TestMapService_TripMapPNG_UnknownTrip(t *testing.T) {
+	f := newMapFixture(locatedStop(45, -110))
+
+	_, err := f.svc.TripMapPNG(context.Background(), uuid.New(), 0, 0)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestMapService_TripMapPNG_DrawsLegPolyline(t *testing.T) {
+	// Neither stop has coordinates, so the leg's polyline is all there is
+	// to draw.
+	a, b := domain.Stop{ID: uuid.New()}, domain.Stop{ID: uuid.New()}
+	f := newMapFixture(a, b)
+	f.legs.legs = []domain.RouteLeg{{
+		ID: uuid.New(), TripID: f.tripID, FromStopID: a.ID, ToStopID: b.ID,
+		Polyline: "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+	}}
+
+	data, err := f.svc.TripMapPNG(context.Background(), f.tripID, 200, 200)
+
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	var route bool
+	for y := 0; y < 200 && !route; y++ {
+		for x := 0; x < 200; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			want := staticmap.RouteColor
+			if uint8(r>>8) == want.R && uint8(g>>8) == want.G && uint8(b>>8) == want.B {
+				route = true
+				break
+			}
+		}
+	}
+	assert.True(t, route, "route line drawn")
+}
+
+func TestMapService_StopsMapPNG(t *testing.T) {
+	f := newMapFixture()
+	stops := []domain.Stop{locatedStop(44.6455, -110.8617), locatedStop(43.7904, -110.6818)}
+
+	data, err := f.svc.StopsMapPNG(context.Background(), stops, 320, 180)
+
+	require.NoError(t, err)
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 320, cfg.Width)
+	assert.Equal(t, 180, cfg.Height)
+
+	_, err = f.svc.StopsMapPNG(context.Background(), []domain.Stop{{ID: uuid.New()}}, 320, 180)
+	assert.True(t, errors.Is(err, domain.ErrValidation), "nothing to draw: got %v", err)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// MembershipService lets a trip's owner share it with other users as editors
+// or viewers.
+type MembershipService struct {
+	members repo.TripMemberRepo
+	trips   repo.TripRepo
+	users   repo.UserRepo
+}
+
+// NewMembershipService constructs a MembershipService.
+func NewMembershipService(members repo.TripMemberRepo, trips repo.TripRepo, users repo.UserRepo) *MembershipService {
+	return &MembershipService{members: members, trips: trips, users: users}
+}
+
+// List returns a trip's members in the order they were invited.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *MembershipService) List(ctx context.Context, tripID uuid.UUID) ([]domain.TripMember, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.MembershipService.List: %w", err)
+	}
+	members, err := s.members.List(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.MembershipService.List: %w", err)
+	}
+	return members, nil
+}
+
+// Invite adds the user with username to a trip with role. Only a trip with
+// an owner can be shared; an unowned one is already everyone's.
+// Returns domain.ErrNotFound if the trip does not exist and
+// domain.ErrForbidden if the request's user does not own it.
+func (s *MembershipService) Invite(ctx context.Context, tripID uuid.UUID, username string, role domain.TripRole) (domain.TripMember, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return domain.TripMember{}, fmt.Errorf("%w: username is required", domain.ErrValidation)
+	}
+	if !role.Valid() {
+		return domain.TripMember{}, fmt.Errorf("%w: role must be editor or viewer", domain.ErrValidation)
+	}
+
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return domain.TripMember{}, fmt.Errorf("service.MembershipService.Invite: %w", err)
+	}
+	trip, err := s.trips.GetByID(ctx, tripID)
+	if err != nil {
+		return domain.TripMember{}, fmt.Errorf("service.MembershipService.Invite: %w", err)
+	}
+	if trip.UserID == nil {
+		return domain.TripMember{}, fmt.Errorf("%w: the trip has no owner, so everyone can already see it", domain.ErrValidation)
+	}
+	user, err := s.users.GetByUsername(ctx, username)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.TripMember{}, fmt.Errorf("%w: no user named %q", domain.ErrValidation, username)
+	}
+	if err != nil {
+		return domain.TripMember{}, fmt.Errorf("service.MembershipService.Invite: %w", err)
+	}
+	if user.ID == *trip.UserID {
+		return domain.TripMember{}, fmt.Errorf("%w: %s owns the trip", domain.ErrValidation, username)
+	}
+
+	m, err := s.members.Add(ctx, domain.TripMember{TripID: tripID, UserID: user.ID, Role: role})
+	if err != nil {
+		return domain.TripMember{}, fmt.Errorf("service.MembershipService.Invite: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateRole changes a member's role.
+// Returns domain.ErrNotFound if userID is not a member of the trip and
+// domain.ErrForbidden if the request's user does not own it.
+func (s *MembershipService) UpdateRole(ctx context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error) {
+	if !role.Valid() {
+		return domain.TripMember{}, fmt.Errorf("%w: role must be editor or viewer", domain.ErrValidation)
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return domain.TripMember{}, fmt.Errorf("service.MembershipService.UpdateRole: %w", err)
+	}
+	m, err := s.members.UpdateRole(ctx, tripID, userID, role)
+	if err != nil {
+		return domain.TripMember{}, fmt.Errorf("service.MembershipService.UpdateRole: %w", err)
+	}
+	return m, nil
+}
+
+// Remove takes userID off a trip.
+// Returns domain.ErrNotFound if userID is not a member of the trip and
+// domain.ErrForbidden if the request's user does not own it.
+func (s *MembershipService) Remove(ctx context.Context, tripID, userID uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return fmt.Errorf("service.MembershipService.Remove: %w", err)
+	}
+	if err := s.members.Remove(ctx, tripID, userID); err != nil {
+		return fmt.Errorf("service.MembershipService.Remove: %w", err)
+	}
+	return nil
+}
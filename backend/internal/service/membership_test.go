@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memTripMemberRepo is an in-memory repo.TripMemberRepo for one trip,
+// which every member can see.
+type memTripMemberRepo struct {
+	members []domain.TripMember
+}
+
+func (m *memTripMemberRepo) List(_ context.Context, tripID uuid.UUID) ([]domain.TripMember, error) {
+	out := []domain.TripMember{}
+	for _, tm := range m.members {
+		if tm.TripID == tripID {
+			out = append(out, tm)
+		}
+	}
+	return out, nil
+}
+func (m *memTripMemberRepo) Add(_ context.Context, tm domain.TripMember) (domain.TripMember, error) {
+	for _, existing := range m.members {
+		if existing.TripID == tm.TripID && existing.UserID == tm.UserID {
+			return domain.TripMember{}, domain.ErrValidation
+		}
+	}
+	tm.CreatedAt = time.Now()
+	m.members = append(m.members, tm)
+	return tm, nil
+}
+func (m *memTripMemberRepo) UpdateRole(_ context.Context, tripID, userID uuid.UUID, role domain.TripRole) (domain.TripMember, error) {
+	for i := range m.members {
+		if m.members[i].TripID == tripID && m.members[i].UserID == userID {
+			m.members[i].Role = role
+			return m.members[i], nil
+		}
+	}
+	return domain.TripMember{}, domain.ErrNotFound
+}
+func (m *memTripMemberRepo) Remove(_ context.Context, tripID, userID uuid.UUID) error {
+	for i, tm := range m.members {
+		if tm.TripID == tripID && tm.UserID == userID {
+			m.members = append(m.members[:i], m.members[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.TripMemberRepo = (*memTripMemberRepo)(nil)
+
+// newMembershipService returns a MembershipService over one trip owned by
+// alice, with bob as another user.
+func newMembershipService() (*service.MembershipService, *memTripMemberRepo, domain.Trip) {
+	alice := domain.User{ID: uuid.New(), Username: "alice"}
+	bob := domain.User{ID: uuid.New(), Username: "bob"}
+	trip := domain.Trip{ID: uuid.New(), UserID: &alice.ID}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != trip.ID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return trip, nil
+		},
+	}
+	members := &memTripMemberRepo{}
+	users := &memUserRepo{users: []domain.User{alice, bob}}
+	return service.NewMembershipService(members, trips, users), members, trip
+}
+
+func TestMembershipService_Invite(t *testing.T) {
+	svc, members, trip := newMembershipService()
+
+	got, err := svc.Invite(context.Background(), trip.ID, "  bob ", domain.TripRoleViewer)
+
+	require.NoError(t, err)
+	assert.Equal(t, trip.ID, got.TripID)
+	assert.Equal(t, domain.TripRoleViewer, got.Role)
+	require.Len(t, members.members, 1)
+	assert.NotEqual(t, *trip.UserID, members.members[0].UserID)
+}
+
+func TestMembershipService_Invite_Validation(t *testing.T) {
+	cases := map[string]struct {
+		username string
+		role     domain.TripRole
+	}{
+		"missing username": {" ", domain.TripRoleEditor},
+		"owner role":       {"bob", domain.TripRoleOwner},
+		"unknown role":     {"bob", "admin"},
+		"unknown user":     {"carol", domain.TripRoleEditor},
+		"the owner":        {"alice", domain.TripRoleEditor},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, members, trip := newMembershipService()
+
+			_, err := svc.Invite(context.Background(), trip.ID, tc.username, tc.role)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Empty(t, members.members)
+		})
+	}
+}
+
+func TestMembershipService_Invite_UnownedTrip(t *testing.T) {
+	trip := domain.Trip{ID: uuid.New()}
+	trips := &mockTripRepo{getByID: func(context.Context, uuid.UUID) (domain.Trip, error) { return trip, nil }}
+	users := &memUserRepo{users: []domain.User{{ID: uuid.New(), Username: "bob"}}}
+	svc := service.NewMembershipService(&memTripMemberRepo{}, trips, users)
+
+	_, err := svc.Invite(context.Background(), trip.ID, "bob", domain.TripRoleEditor)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestMembershipService_Invite_UnknownTrip(t *testing.T) {
+	svc, _, _ := newMembershipService()
+
+	_, err := svc.Invite(context.Background(), uuid.New(), "bob", domain.TripRoleEditor)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestMembershipService_UpdateRole(t *testing.T) {
+	svc, _, trip := newMembershipService()
+	ctx := context.Background()
+	m, err := svc.Invite(ctx, trip.ID, "bob", domain.TripRoleViewer)
+	require.NoError(t, err)
+
+	got, err := svc.UpdateRole(ctx, trip.ID, m.UserID, domain.TripRoleEditor)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripRoleEditor, got.Role)
+
+	_, err = svc.UpdateRole(ctx, trip.ID, m.UserID, domain.TripRoleOwner)
+	assert.ErrorIs(t, err, domain.ErrValidation, "ownership cannot be given away")
+
+	_, err = svc.UpdateRole(ctx, trip.ID, uuid.New(), domain.TripRoleEditor)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestMembershipService_List_UnknownTrip(t *testing.T) {
+	svc, _, _ := newMembershipService()
+
+	_, err := svc.List(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
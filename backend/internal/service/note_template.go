@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// NoteTemplateService manages the note templates stops are created from.
+type NoteTemplateService struct {
+	templates repo.NoteTemplateRepo
+}
+
+// NewNoteTemplateService constructs a NoteTemplateService.
+func NewNoteTemplateService(templates repo.NoteTemplateRepo) *NoteTemplateService {
+	return &NoteTemplateService{templates: templates}
+}
+
+// Create validates and persists a template.
+// Returns domain.ErrValidation if it breaks the rules of normalizeNoteTemplate.
+func (s *NoteTemplateService) Create(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	t, err := normalizeNoteTemplate(t)
+	if err != nil {
+		return domain.NoteTemplate{}, err
+	}
+	created, err := s.templates.Create(ctx, t)
+	if err != nil {
+		return domain.NoteTemplate{}, fmt.Errorf("service.NoteTemplateService.Create: %w", err)
+	}
+	return created, nil
+}
+
+// GetByID returns a single template.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *NoteTemplateService) GetByID(ctx context.Context, id uuid.UUID) (domain.NoteTemplate, error) {
+	t, err := s.templates.GetByID(ctx, id)
+	if err != nil {
+		return domain.NoteTemplate{}, fmt.Errorf("service.NoteTemplateService.GetByID: %w", err)
+	}
+	return t, nil
+}
+
+// List returns every template ordered by name.
+func (s *NoteTemplateService) List(ctx context.Context) ([]domain.NoteTemplate, error) {
+	templates, err := s.templates.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.NoteTemplateService.List: %w", err)
+	}
+	return templates, nil
+}
+
+// Update validates and overwrites a template. Stops already created from it
+// keep their answers and notes.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *NoteTemplateService) Update(ctx context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	t, err := normalizeNoteTemplate(t)
+	if err != nil {
+		return domain.NoteTemplate{}, err
+	}
+	updated, err := s.templates.Update(ctx, t)
+	if err != nil {
+		return domain.NoteTemplate{}, fmt.Errorf("service.NoteTemplateService.Update: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a template.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *NoteTemplateService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.templates.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.NoteTemplateService.Delete: %w", err)
+	}
+	return nil
+}
+
+// normalizeNoteTemplate trims a template's name, prompts, and choices and
+// enforces the rules shared by create and update: a name, at least one
+// question, and for each question a key shaped like a custom field key and
+// unused by the others, a prompt, and choices that are neither blank nor
+// repeated.
+func normalizeNoteTemplate(t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return t, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if len(t.Questions) == 0 {
+		return t, fmt.Errorf("%w: at least one question is required", domain.ErrValidation)
+	}
+
+	questions := make([]domain.NoteQuestion, len(t.Questions))
+	seen := make(map[string]bool, len(t.Questions))
+	for i, q := range t.Questions {
+		if !customFieldKey.MatchString(q.Key) {
+			return t, fmt.Errorf("%w: question %d: key must start with a lowercase letter and contain only lowercase letters, digits, and underscores (at most 64)", domain.ErrValidation, i+1)
+		}
+		if seen[q.Key] {
+			return t, fmt.Errorf("%w: question %d: key %q is already used", domain.ErrValidation, i+1, q.Key)
+		}
+		seen[q.Key] = true
+
+		q.Prompt = strings.TrimSpace(q.Prompt)
+		if q.Prompt == "" {
+			return t, fmt.Errorf("%w: question %d: prompt is required", domain.ErrValidation, i+1)
+		}
+		var choices []string
+		for _, c := range q.Choices {
+			c = strings.TrimSpace(c)
+			if c == "" {
+				return t, fmt.Errorf("%w: question %d: choices must not be blank", domain.ErrValidation, i+1)
+			}
+			if slices.Contains(choices, c) {
+				return t, fmt.Errorf("%w: question %d: choice %q is repeated", domain.ErrValidation, i+1, c)
+			}
+			choices = append(choices, c)
+		}
+		q.Choices = choices
+		questions[i] = q
+	}
+	t.Questions = questions
+	return t, nil
+}
+
+// applyNoteTemplate checks a new stop's note answers against the template it
+// names, and writes them into the notes ahead of any the stop already has.
+// Blank answers are dropped. A stop without a template may have no answers.
+// Returns domain.ErrValidation if the template does not exist, an answer is
+// to a question the template does not ask, is too long, or is not one of the
+// question's choices.
+func applyNoteTemplate(ctx context.Context, templates repo.NoteTemplateRepo, stop domain.Stop) (domain.Stop, error) {
+	if stop.NoteTemplateID == nil {
+		if len(stop.NoteAnswers) > 0 {
+			return stop, fmt.Errorf("%w: note_answers require a note_template_id", domain.ErrValidation)
+		}
+		return stop, nil
+	}
+	t, err := templates.GetByID(ctx, *stop.NoteTemplateID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return stop, fmt.Errorf("%w: note template %s does not exist", domain.ErrValidation, *stop.NoteTemplateID)
+		}
+		return stop, err
+	}
+
+	answers := make(domain.NoteAnswers, len(stop.NoteAnswers))
+	for key, a := range stop.NoteAnswers {
+		q, ok := t.Question(key)
+		if !ok {
+			return stop, fmt.Errorf("%w: note template %q has no question %q", domain.ErrValidation, t.Name, key)
+		}
+		a = strings.TrimSpace(a)
+		if a == "" {
+			continue
+		}
+		if utf8.RuneCountInString(a) > domain.MaxNoteAnswerLength {
+			return stop, fmt.Errorf("%w: answer to %q must be at most %d characters", domain.ErrValidation, key, domain.MaxNoteAnswerLength)
+		}
+		if len(q.Choices) > 0 && !slices.Contains(q.Choices, a) {
+			return stop, fmt.Errorf("%w: answer to %q must be one of %s", domain.ErrValidation, key, strings.Join(q.Choices, ", "))
+		}
+		answers[key] = a
+	}
+	stop.NoteAnswers = answers
+
+	if rendered := t.Render(answers); rendered != "" {
+		if notes := strings.TrimSpace(stop.Notes); notes != "" {
+			rendered += "\n\n" + notes
+		}
+		stop.Notes = rendered
+	}
+	return stop, nil
+}
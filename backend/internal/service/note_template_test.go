@@ -0,0 +1,200 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// memNoteTemplateRepo is an in-memory repo.NoteTemplateRepo.
+type memNoteTemplateRepo struct {
+	templates map[uuid.UUID]domain.NoteTemplate
+}
+
+func newMemNoteTemplateRepo(templates ...domain.NoteTemplate) *memNoteTemplateRepo {
+	m := &memNoteTemplateRepo{templates: map[uuid.UUID]domain.NoteTemplate{}}
+	for _, t := range templates {
+		m.templates[t.ID] = t
+	}
+	return m
+}
+
+func (m *memNoteTemplateRepo) Create(_ context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	t.ID = uuid.New()
+	m.templates[t.ID] = t
+	return t, nil
+}
+func (m *memNoteTemplateRepo) GetByID(_ context.Context, id uuid.UUID) (domain.NoteTemplate, error) {
+	t, ok := m.templates[id]
+	if !ok {
+		return domain.NoteTemplate{}, domain.ErrNotFound
+	}
+	return t, nil
+}
+func (m *memNoteTemplateRepo) List(_ context.Context) ([]domain.NoteTemplate, error) {
+	out := []domain.NoteTemplate{}
+	for _, t := range m.templates {
+		out = append(out, t)
+	}
+	return out, nil
+}
+func (m *memNoteTemplateRepo) Update(_ context.Context, t domain.NoteTemplate) (domain.NoteTemplate, error) {
+	if _, ok := m.templates[t.ID]; !ok {
+		return domain.NoteTemplate{}, domain.ErrNotFound
+	}
+	m.templates[t.ID] = t
+	return t, nil
+}
+func (m *memNoteTemplateRepo) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := m.templates[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(m.templates, id)
+	return nil
+}
+
+var _ repo.NoteTemplateRepo = (*memNoteTemplateRepo)(nil)
+
+// campsiteTemplate asks the questions of the request that introduced note
+// templates.
+var campsiteTemplate = domain.NoteTemplate{
+	ID:   uuid.New(),
+	Name: "Campsite",
+	Questions: []domain.NoteQuestion{
+		{Key: "site_number", Prompt: "Site number"},
+		{Key: "cell_coverage", Prompt: "Cell coverage"},
+		{Key: "noise_level", Prompt: "Noise level", Choices: []string{"Quiet", "Some", "Loud"}},
+	},
+}
+
+// ---- NoteTemplateService ---------------------------------------------------
+
+func TestNoteTemplateService_Create_Normalizes(t *testing.T) {
+	svc := service.NewNoteTemplateService(newMemNoteTemplateRepo())
+
+	got, err := svc.Create(context.Background(), domain.NoteTemplate{
+		Name: "  Campsite ",
+		Questions: []domain.NoteQuestion{
+			{Key: "site_number", Prompt: " Site number "},
+			{Key: "noise_level", Prompt: "Noise level", Choices: []string{" Quiet", "Loud "}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Campsite", got.Name)
+	assert.Equal(t, "Site number", got.Questions[0].Prompt)
+	assert.Nil(t, got.Questions[0].Choices)
+	assert.Equal(t, []string{"Quiet", "Loud"}, got.Questions[1].Choices)
+}
+
+func TestNoteTemplateService_Create_Validation(t *testing.T) {
+	cases := map[string]domain.NoteTemplate{
+		"no name":         {Questions: []domain.NoteQuestion{{Key: "site", Prompt: "Site"}}},
+		"no questions":    {Name: "Campsite"},
+		"malformed key":   {Name: "Campsite", Questions: []domain.NoteQuestion{{Key: "Site Number", Prompt: "Site"}}},
+		"repeated key":    {Name: "Campsite", Questions: []domain.NoteQuestion{{Key: "site", Prompt: "Site"}, {Key: "site", Prompt: "Spot"}}},
+		"blank prompt":    {Name: "Campsite", Questions: []domain.NoteQuestion{{Key: "site", Prompt: "  "}}},
+		"blank choice":    {Name: "Campsite", Questions: []domain.NoteQuestion{{Key: "noise", Prompt: "Noise", Choices: []string{"Quiet", " "}}}},
+		"repeated choice": {Name: "Campsite", Questions: []domain.NoteQuestion{{Key: "noise", Prompt: "Noise", Choices: []string{"Quiet", "Quiet "}}}},
+	}
+	for name, tmpl := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc := service.NewNoteTemplateService(newMemNoteTemplateRepo())
+
+			_, err := svc.Create(context.Background(), tmpl)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestNoteTemplateService_Update_NotFound(t *testing.T) {
+	svc := service.NewNoteTemplateService(newMemNoteTemplateRepo())
+
+	_, err := svc.Update(context.Background(), domain.NoteTemplate{
+		ID: uuid.New(), Name: "Campsite", Questions: []domain.NoteQuestion{{Key: "site", Prompt: "Site"}},
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// ---- StopService.Create with a note template -------------------------------
+
+// newNoteStopService returns a StopService whose trip exists and whose
+// repo returns the stop it is given, and a pointer to that stop.
+func newNoteStopService(templates repo.NoteTemplateRepo) (*service.StopService, *domain.Stop) {
+	var stored domain.Stop
+	svc := service.NewStopService(
+		&mockTripRepo{getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) { return domain.Trip{ID: id}, nil }},
+		&mockStopRepo{create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			stored = s
+			return s, nil
+		}},
+		nil, nil, templates, nil, nil,
+	)
+	return svc, &stored
+}
+
+func TestStopService_Create_NoteTemplate(t *testing.T) {
+	svc, stored := newNoteStopService(newMemNoteTemplateRepo(campsiteTemplate))
+	stop := factory.Stop().Build()
+	stop.Notes = "Great views of the lake."
+	stop.NoteTemplateID = &campsiteTemplate.ID
+	stop.NoteAnswers = domain.NoteAnswers{"noise_level": "Quiet", "site_number": " B14 ", "cell_coverage": "  "}
+
+	_, err := svc.Create(context.Background(), stop)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.NoteAnswers{"site_number": "B14", "noise_level": "Quiet"}, stored.NoteAnswers, "blank answers are dropped")
+	assert.Equal(t, "Site number: B14\nNoise level: Quiet\n\nGreat views of the lake.", stored.Notes)
+	assert.Equal(t, &campsiteTemplate.ID, stored.NoteTemplateID)
+}
+
+func TestStopService_Create_NoteTemplate_NoAnswers(t *testing.T) {
+	svc, stored := newNoteStopService(newMemNoteTemplateRepo(campsiteTemplate))
+	stop := factory.Stop().Build()
+	stop.Notes = "Great views"
+	stop.NoteTemplateID = &campsiteTemplate.ID
+
+	_, err := svc.Create(context.Background(), stop)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Great views", stored.Notes, "nothing to render")
+}
+
+func TestStopService_Create_NoteTemplate_Validation(t *testing.T) {
+	missing := uuid.New()
+	cases := map[string]struct {
+		templateID *uuid.UUID
+		answers    domain.NoteAnswers
+		want       string
+	}{
+		"unknown template":     {templateID: &missing, answers: domain.NoteAnswers{"site_number": "B14"}, want: "does not exist"},
+		"answers, no template": {answers: domain.NoteAnswers{"site_number": "B14"}, want: "require a note_template_id"},
+		"unknown question":     {templateID: &campsiteTemplate.ID, answers: domain.NoteAnswers{"pets": "yes"}, want: `no question "pets"`},
+		"not a choice":         {templateID: &campsiteTemplate.ID, answers: domain.NoteAnswers{"noise_level": "Deafening"}, want: "must be one of Quiet, Some, Loud"},
+		"too long":             {templateID: &campsiteTemplate.ID, answers: domain.NoteAnswers{"site_number": strings.Repeat("9", domain.MaxNoteAnswerLength+1)}, want: "at most"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, _ := newNoteStopService(newMemNoteTemplateRepo(campsiteTemplate))
+			stop := factory.Stop().Build()
+			stop.NoteTemplateID = tc.templateID
+			stop.NoteAnswers = tc.answers
+
+			_, err := svc.Create(context.Background(), stop)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.ErrorContains(t, err, tc.want)
+		})
+	}
+}
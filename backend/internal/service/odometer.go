@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// OdometerService records odometer readings and derives mileage from them.
+// A new reading also checks the vehicle's maintenance items and announces
+// any it brings due.
+type OdometerService struct {
+	readings    repo.OdometerRepo
+	trips       repo.TripRepo
+	maintenance repo.MaintenanceRepo
+	events      EventPublisher
+}
+
+// NewOdometerService constructs an OdometerService. events may be nil, and
+// then no odometer reading or maintenance.due events are published.
+func NewOdometerService(readings repo.OdometerRepo, trips repo.TripRepo, maintenance repo.MaintenanceRepo, events EventPublisher) *OdometerService {
+	return &OdometerService{readings: readings, trips: trips, maintenance: maintenance, events: events}
+}
+
+// Create validates and persists a reading.
+//
+// Odometers only count up, so a reading must be no lower than the vehicle's
+// previous reading and no higher than its next one; anything else is almost
+// always a typo and is rejected with domain.ErrValidation. Returns
+// domain.ErrNotFound if TripID names a trip that does not exist, and
+// domain.ErrForbidden if it names one the request's user may only view.
+func (s *OdometerService) Create(ctx context.Context, reading domain.OdometerReading) (domain.OdometerReading, error) {
+	reading.Vehicle = strings.TrimSpace(reading.Vehicle)
+	if reading.Vehicle == "" {
+		return domain.OdometerReading{}, fmt.Errorf("%w: vehicle is required", domain.ErrValidation)
+	}
+	if reading.Miles < 0 {
+		return domain.OdometerReading{}, fmt.Errorf("%w: miles must not be negative", domain.ErrValidation)
+	}
+	if reading.RecordedAt.IsZero() {
+		return domain.OdometerReading{}, fmt.Errorf("%w: recorded_at is required", domain.ErrValidation)
+	}
+	if reading.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *reading.TripID, domain.TripRoleEditor); err != nil {
+			return domain.OdometerReading{}, fmt.Errorf("service.OdometerService.Create: %w", err)
+		}
+	}
+
+	prev, next, err := s.readings.Adjacent(ctx, reading.Vehicle, reading.RecordedAt)
+	if err != nil {
+		return domain.OdometerReading{}, fmt.Errorf("service.OdometerService.Create: %w", err)
+	}
+	if prev != nil && reading.Miles < prev.Miles {
+		return domain.OdometerReading{}, fmt.Errorf("%w: miles must be at least %.1f, the reading for %s on %s",
+			domain.ErrValidation, prev.Miles, reading.Vehicle, prev.RecordedAt.Format("2006-01-02"))
+	}
+	if next != nil && reading.Miles > next.Miles {
+		return domain.OdometerReading{}, fmt.Errorf("%w: miles must be at most %.1f, the reading for %s on %s",
+			domain.ErrValidation, next.Miles, reading.Vehicle, next.RecordedAt.Format("2006-01-02"))
+	}
+
+	created, err := s.readings.Create(ctx, reading)
+	if err != nil {
+		return domain.OdometerReading{}, fmt.Errorf("service.OdometerService.Create: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventOdometerReadingCreated, domain.RecordEventData{ID: created.ID, TripID: created.TripID})
+	if next == nil {
+		s.announceDue(ctx, created, prev)
+	}
+	return created, nil
+}
+
+// announceDue publishes maintenance.due for each of the vehicle's items that
+// reading, its latest, brings due soon or overdue. An item that was already
+// as due at the previous reading is not announced again. The reading is
+// saved by now, so failing to check is only logged.
+func (s *OdometerService) announceDue(ctx context.Context, reading domain.OdometerReading, prev *domain.OdometerReading) {
+	if s.events == nil {
+		return
+	}
+	items, err := s.maintenance.ListItems(ctx, reading.Vehicle)
+	if err != nil {
+		slog.ErrorContext(ctx, "checking maintenance failed", "vehicle", reading.Vehicle, "error", err)
+		return
+	}
+	for _, item := range items {
+		due := domain.CheckMaintenance(item, reading.Miles, domain.DefaultDueSoonMiles)
+		if due.Status == domain.MaintenanceOK {
+			continue
+		}
+		if prev != nil && domain.CheckMaintenance(item, prev.Miles, domain.DefaultDueSoonMiles).Status == due.Status {
+			continue
+		}
+		s.events.Publish(ctx, domain.EventMaintenanceDue, domain.MaintenanceEvent(due))
+	}
+}
+
+// GetByID returns a single reading.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *OdometerService) GetByID(ctx context.Context, id uuid.UUID) (domain.OdometerReading, error) {
+	reading, err := s.readings.GetByID(ctx, id)
+	if err != nil {
+		return domain.OdometerReading{}, fmt.Errorf("service.OdometerService.GetByID: %w", err)
+	}
+	return reading, nil
+}
+
+// ListPaged returns one page of readings matching f, newest first, and the
+// total number of matches.
+func (s *OdometerService) ListPaged(ctx context.Context, f domain.OdometerFilter, p domain.PaginationParams) ([]domain.OdometerReading, int64, error) {
+	f.Vehicle = strings.TrimSpace(f.Vehicle)
+	readings, total, err := s.readings.ListPaged(ctx, f, p)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service.OdometerService.ListPaged: %w", err)
+	}
+	return readings, total, nil
+}
+
+// Delete removes a reading.
+// Returns domain.ErrNotFound if it does not exist, and domain.ErrForbidden if
+// it is linked to a trip the request's user may only view.
+func (s *OdometerService) Delete(ctx context.Context, id uuid.UUID) error {
+	reading, err := s.readings.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("service.OdometerService.Delete: %w", err)
+	}
+	if reading.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *reading.TripID, domain.TripRoleEditor); err != nil {
+			return fmt.Errorf("service.OdometerService.Delete: %w", err)
+		}
+	}
+	if err := s.readings.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.OdometerService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventOdometerReadingDeleted, domain.RecordEventData{ID: id, TripID: reading.TripID})
+	return nil
+}
+
+// TripMileage derives the distance covered on a trip from the readings
+// linked to it: for each vehicle, its last reading minus its first.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *OdometerService) TripMileage(ctx context.Context, tripID uuid.UUID) (domain.TripMileage, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return domain.TripMileage{}, fmt.Errorf("service.OdometerService.TripMileage: %w", err)
+	}
+	readings, err := s.readings.ListByTripID(ctx, tripID)
+	if err != nil {
+		return domain.TripMileage{}, fmt.Errorf("service.OdometerService.TripMileage: %w", err)
+	}
+
+	m := domain.TripMileage{TripID: tripID, Vehicles: domain.MileageByVehicle(readings)}
+	for _, v := range m.Vehicles {
+		m.TotalMiles += v.Miles
+	}
+	return m, nil
+}
@@ -0,0 +1,276 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memOdometerRepo is an in-memory repo.OdometerRepo. The ordering rules live
+// in the service, so a working fake exercises them better than canned returns.
+type memOdometerRepo struct {
+	rows []domain.OdometerReading
+}
+
+func (m *memOdometerRepo) Create(_ context.Context, r domain.OdometerReading) (domain.OdometerReading, error) {
+	r.ID = uuid.New()
+	r.CreatedAt = time.Now()
+	m.rows = append(m.rows, r)
+	return r, nil
+}
+func (m *memOdometerRepo) GetByID(_ context.Context, id uuid.UUID) (domain.OdometerReading, error) {
+	for _, r := range m.rows {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return domain.OdometerReading{}, domain.ErrNotFound
+}
+func (m *memOdometerRepo) ListPaged(_ context.Context, f domain.OdometerFilter, _ domain.PaginationParams) ([]domain.OdometerReading, int64, error) {
+	out := []domain.OdometerReading{}
+	for _, r := range m.rows {
+		if f.Vehicle != "" && r.Vehicle != f.Vehicle {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out, int64(len(out)), nil
+}
+func (m *memOdometerRepo) ListByTripID(_ context.Context, tripID uuid.UUID) ([]domain.OdometerReading, error) {
+	out := []domain.OdometerReading{}
+	for _, r := range m.rows {
+		if r.TripID != nil && *r.TripID == tripID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+func (m *memOdometerRepo) Adjacent(_ context.Context, vehicle string, at time.Time) (*domain.OdometerReading, *domain.OdometerReading, error) {
+	rs := []domain.OdometerReading{}
+	for _, r := range m.rows {
+		if r.Vehicle == vehicle {
+			rs = append(rs, r)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].RecordedAt.Before(rs[j].RecordedAt) })
+	var prev, next *domain.OdometerReading
+	for i := range rs {
+		if !rs[i].RecordedAt.After(at) {
+			prev = &rs[i]
+		} else if next == nil {
+			next = &rs[i]
+		}
+	}
+	return prev, next, nil
+}
+func (m *memOdometerRepo) Delete(_ context.Context, id uuid.UUID) error {
+	for i, r := range m.rows {
+		if r.ID == id {
+			m.rows = append(m.rows[:i], m.rows[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.OdometerRepo = (*memOdometerRepo)(nil)
+
+// newOdometerService returns an OdometerService over one existing trip.
+func newOdometerService() (*service.OdometerService, *memOdometerRepo, uuid.UUID) {
+	tripID := uuid.New()
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: tripID}, nil
+		},
+	}
+	readings := &memOdometerRepo{}
+	return service.NewOdometerService(readings, trips, nil, nil), readings, tripID
+}
+
+var odometerT0 = time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+
+func TestOdometerService_Create(t *testing.T) {
+	svc, _, tripID := newOdometerService()
+
+	got, err := svc.Create(context.Background(), domain.OdometerReading{
+		Vehicle:    "  Motorhome ",
+		Miles:      48213.6,
+		RecordedAt: odometerT0,
+		TripID:     &tripID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Motorhome", got.Vehicle, "vehicle is trimmed")
+	assert.InDelta(t, 48213.6, got.Miles, 0.001)
+}
+
+func TestOdometerService_Create_Validation(t *testing.T) {
+	cases := map[string]domain.OdometerReading{
+		"missing vehicle":     {Vehicle: " ", Miles: 1, RecordedAt: odometerT0},
+		"negative miles":      {Vehicle: "Motorhome", Miles: -1, RecordedAt: odometerT0},
+		"missing recorded_at": {Vehicle: "Motorhome", Miles: 1},
+	}
+	for name, in := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, _, _ := newOdometerService()
+			_, err := svc.Create(context.Background(), in)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestOdometerService_Create_UnknownTrip(t *testing.T) {
+	svc, _, _ := newOdometerService()
+	other := uuid.New()
+
+	_, err := svc.Create(context.Background(), domain.OdometerReading{
+		Vehicle: "Motorhome", Miles: 1, RecordedAt: odometerT0, TripID: &other,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// TestOdometerService_ViewerCannotWrite verifies a reading naming a trip in
+// its body is held to the user's role on that trip.
+func TestOdometerService_ViewerCannotWrite(t *testing.T) {
+	tripID := uuid.New()
+	roles := map[uuid.UUID]domain.TripRole{tripID: domain.TripRoleEditor}
+	svc := service.NewOdometerService(&memOdometerRepo{}, rolesTripRepo(roles), nil, nil)
+	ctx := context.Background()
+	reading := domain.OdometerReading{Vehicle: "Motorhome", Miles: 1, RecordedAt: odometerT0, TripID: &tripID}
+	created, err := svc.Create(ctx, reading)
+	require.NoError(t, err)
+
+	roles[tripID] = domain.TripRoleViewer
+	_, err = svc.Create(ctx, reading)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	assert.ErrorIs(t, svc.Delete(ctx, created.ID), domain.ErrForbidden)
+}
+
+// TestOdometerService_Create_MustBeMonotonic verifies a reading has to fit
+// between the vehicle's neighbouring readings — odometers never run backwards.
+func TestOdometerService_Create_MustBeMonotonic(t *testing.T) {
+	svc, _, _ := newOdometerService()
+	ctx := context.Background()
+	_, err := svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 1000, RecordedAt: odometerT0})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 1500, RecordedAt: odometerT0.Add(48 * time.Hour)})
+	require.NoError(t, err)
+
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 900, RecordedAt: odometerT0.Add(time.Hour)})
+	require.ErrorIs(t, err, domain.ErrValidation)
+	assert.Contains(t, err.Error(), "at least 1000.0")
+
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 1600, RecordedAt: odometerT0.Add(time.Hour)})
+	require.ErrorIs(t, err, domain.ErrValidation)
+	assert.Contains(t, err.Error(), "at most 1500.0")
+
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 1200, RecordedAt: odometerT0.Add(time.Hour)})
+	assert.NoError(t, err, "a reading between its neighbours is accepted")
+
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Tow car", Miles: 10, RecordedAt: odometerT0.Add(time.Hour)})
+	assert.NoError(t, err, "other vehicles are checked independently")
+}
+
+func TestOdometerService_TripMileage(t *testing.T) {
+	svc, _, tripID := newOdometerService()
+	ctx := context.Background()
+	for i, miles := range []float64{48000, 48120.5, 48410} {
+		_, err := svc.Create(ctx, domain.OdometerReading{
+			Vehicle: "Motorhome", Miles: miles, RecordedAt: odometerT0.Add(time.Duration(i) * 24 * time.Hour), TripID: &tripID,
+		})
+		require.NoError(t, err)
+	}
+	_, err := svc.Create(ctx, domain.OdometerReading{Vehicle: "Tow car", Miles: 30210, RecordedAt: odometerT0, TripID: &tripID})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Tow car", Miles: 30250, RecordedAt: odometerT0.Add(24 * time.Hour), TripID: &tripID})
+	require.NoError(t, err)
+	// A reading on no trip does not count towards the trip's mileage.
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 49000, RecordedAt: odometerT0.Add(30 * 24 * time.Hour)})
+	require.NoError(t, err)
+
+	m, err := svc.TripMileage(ctx, tripID)
+
+	require.NoError(t, err)
+	require.Len(t, m.Vehicles, 2)
+	assert.Equal(t, "Motorhome", m.Vehicles[0].Vehicle)
+	assert.InDelta(t, 410, m.Vehicles[0].Miles, 0.001)
+	assert.Equal(t, 3, m.Vehicles[0].Readings)
+	assert.Equal(t, "Tow car", m.Vehicles[1].Vehicle)
+	assert.InDelta(t, 40, m.Vehicles[1].Miles, 0.001)
+	assert.InDelta(t, 450, m.TotalMiles, 0.001)
+}
+
+func TestOdometerService_TripMileage_NoReadings(t *testing.T) {
+	svc, _, tripID := newOdometerService()
+
+	m, err := svc.TripMileage(context.Background(), tripID)
+
+	require.NoError(t, err)
+	assert.Empty(t, m.Vehicles)
+	assert.Zero(t, m.TotalMiles)
+}
+
+func TestOdometerService_TripMileage_UnknownTrip(t *testing.T) {
+	svc, _, _ := newOdometerService()
+
+	_, err := svc.TripMileage(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestOdometerService_Create_AnnouncesMaintenanceDue(t *testing.T) {
+	ctx := context.Background()
+	readings := &memOdometerRepo{}
+	items := &memMaintenanceRepo{}
+	oil, err := items.CreateItem(ctx, domain.MaintenanceItem{Vehicle: "Motorhome", Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+	events := &recordingPublisher{}
+	svc := service.NewOdometerService(readings, &mockTripRepo{}, items, events)
+
+	for i, miles := range []float64{4000, 4600, 4700, 5100} {
+		_, err := svc.Create(ctx, domain.OdometerReading{
+			Vehicle: "Motorhome", Miles: miles, RecordedAt: odometerT0.Add(time.Duration(i) * time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	// 4600 brings the oil change due soon and 5100 makes it overdue; 4700
+	// changes nothing, so it is not announced again.
+	due := events.of(domain.EventMaintenanceDue)
+	require.Len(t, due, 2)
+	first := due[0].(domain.MaintenanceEventData)
+	assert.Equal(t, oil.ID, first.ItemID)
+	assert.Equal(t, domain.MaintenanceDueSoon, first.Status)
+	assert.InDelta(t, 400, first.MilesRemaining, 0.001)
+	assert.Equal(t, domain.MaintenanceOverdue, due[1].(domain.MaintenanceEventData).Status)
+	assert.Len(t, events.of(domain.EventOdometerReadingCreated), 4)
+}
+
+func TestOdometerService_Create_BackfillDoesNotAnnounce(t *testing.T) {
+	ctx := context.Background()
+	readings := &memOdometerRepo{}
+	items := &memMaintenanceRepo{}
+	_, err := items.CreateItem(ctx, domain.MaintenanceItem{Vehicle: "Motorhome", Name: "Oil change", IntervalMiles: 5000})
+	require.NoError(t, err)
+	readings.rows = append(readings.rows, domain.OdometerReading{ID: uuid.New(), Vehicle: "Motorhome", Miles: 6000, RecordedAt: odometerT0.Add(time.Hour)})
+	events := &recordingPublisher{}
+	svc := service.NewOdometerService(readings, &mockTripRepo{}, items, events)
+
+	_, err = svc.Create(ctx, domain.OdometerReading{Vehicle: "Motorhome", Miles: 4800, RecordedAt: odometerT0})
+
+	require.NoError(t, err)
+	assert.Empty(t, events.of(domain.EventMaintenanceDue), "only a vehicle's latest reading is checked")
+}
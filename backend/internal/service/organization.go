@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// OrganizationService manages organizations and their members. Every call
+// acts for the request's user, read with domain.UserFromContext: only an
+// organization's members may see it and its members, and only its owners
+// may rename or delete it or change who belongs to it. Anonymous requests
+// may do neither.
+type OrganizationService struct {
+	orgs repo.OrganizationRepo
+}
+
+// NewOrganizationService constructs an OrganizationService.
+func NewOrganizationService(orgs repo.OrganizationRepo) *OrganizationService {
+	return &OrganizationService{orgs: orgs}
+}
+
+// Create validates and persists an organization with the request's user as
+// its first owner.
+// Returns domain.ErrUnauthorized if no one is signed in.
+func (s *OrganizationService) Create(ctx context.Context, name string) (domain.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.Create: %w: name is required", domain.ErrValidation)
+	}
+	owner, err := memberID(ctx)
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.Create: %w", err)
+	}
+
+	created, err := s.orgs.Create(ctx, domain.Organization{Name: name}, owner)
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.Create: %w", err)
+	}
+	return created, nil
+}
+
+// GetByID returns an organization the request's user is a member of.
+// Returns domain.ErrNotFound if it does not exist and domain.ErrForbidden if
+// the user is not a member.
+func (s *OrganizationService) GetByID(ctx context.Context, id uuid.UUID) (domain.Organization, error) {
+	if err := s.requireRole(ctx, id, domain.RoleMember); err != nil {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.GetByID: %w", err)
+	}
+	org, err := s.orgs.GetByID(ctx, id)
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.GetByID: %w", err)
+	}
+	return org, nil
+}
+
+// List returns the organizations the request's user is a member of, by name.
+// Returns domain.ErrUnauthorized if no one is signed in.
+func (s *OrganizationService) List(ctx context.Context) ([]domain.Organization, error) {
+	userID, err := memberID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.OrganizationService.List: %w", err)
+	}
+	orgs, err := s.orgs.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service.OrganizationService.List: %w", err)
+	}
+	return orgs, nil
+}
+
+// Rename sets an organization's name.
+// Returns domain.ErrNotFound if it does not exist and domain.ErrForbidden if
+// the request's user does not own it.
+func (s *OrganizationService) Rename(ctx context.Context, id uuid.UUID, name string) (domain.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.Rename: %w: name is required", domain.ErrValidation)
+	}
+	if err := s.requireRole(ctx, id, domain.RoleOwner); err != nil {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.Rename: %w", err)
+	}
+	org, err := s.orgs.Rename(ctx, id, name)
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("service.OrganizationService.Rename: %w", err)
+	}
+	return org, nil
+}
+
+// Delete removes an organization and everything it owns. An organization
+// must have no trips left, in the trash or not, and the default
+// organization is never deleted: it acts for requests that name none.
+// Returns domain.ErrForbidden if the request's user does not own it.
+func (s *OrganizationService) Delete(ctx context.Context, id uuid.UUID) error {
+	if id == domain.DefaultOrganizationID {
+		return fmt.Errorf("service.OrganizationService.Delete: %w: the default organization cannot be deleted", domain.ErrValidation)
+	}
+	if err := s.requireRole(ctx, id, domain.RoleOwner); err != nil {
+		return fmt.Errorf("service.OrganizationService.Delete: %w", err)
+	}
+	if err := s.orgs.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.OrganizationService.Delete: %w", err)
+	}
+	return nil
+}
+
+// ListMembers returns an organization's members, owners first.
+// Returns domain.ErrNotFound if the organization does not exist and
+// domain.ErrForbidden if the request's user is not a member.
+func (s *OrganizationService) ListMembers(ctx context.Context, orgID uuid.UUID) ([]domain.Member, error) {
+	if err := s.requireRole(ctx, orgID, domain.RoleMember); err != nil {
+		return nil, fmt.Errorf("service.OrganizationService.ListMembers: %w", err)
+	}
+	members, err := s.orgs.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("service.OrganizationService.ListMembers: %w", err)
+	}
+	return members, nil
+}
+
+// AddMember validates and adds userID to an organization with role.
+// Returns domain.ErrNotFound if the organization does not exist and
+// domain.ErrForbidden if the request's user does not own it.
+func (s *OrganizationService) AddMember(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+	userID = strings.TrimSpace(userID)
+	if err := validateMember(userID, role); err != nil {
+		return domain.Member{}, fmt.Errorf("service.OrganizationService.AddMember: %w", err)
+	}
+	if err := s.requireRole(ctx, orgID, domain.RoleOwner); err != nil {
+		return domain.Member{}, fmt.Errorf("service.OrganizationService.AddMember: %w", err)
+	}
+	m, err := s.orgs.AddMember(ctx, domain.Member{OrganizationID: orgID, UserID: userID, Role: role})
+	if err != nil {
+		return domain.Member{}, fmt.Errorf("service.OrganizationService.AddMember: %w", err)
+	}
+	return m, nil
+}
+
+// UpdateMemberRole changes a member's role. The last owner cannot step down.
+// Returns domain.ErrNotFound if userID is not a member and
+// domain.ErrForbidden if the request's user does not own the organization.
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+	if err := validateMember(userID, role); err != nil {
+		return domain.Member{}, fmt.Errorf("service.OrganizationService.UpdateMemberRole: %w", err)
+	}
+	if err := s.requireRole(ctx, orgID, domain.RoleOwner); err != nil {
+		return domain.Member{}, fmt.Errorf("service.OrganizationService.UpdateMemberRole: %w", err)
+	}
+	m, err := s.orgs.UpdateMemberRole(ctx, orgID, userID, role)
+	if err != nil {
+		return domain.Member{}, fmt.Errorf("service.OrganizationService.UpdateMemberRole: %w", err)
+	}
+	return m, nil
+}
+
+// RemoveMember removes userID from an organization. The last owner cannot
+// be removed.
+// Returns domain.ErrNotFound if userID is not a member and
+// domain.ErrForbidden if the request's user does not own the organization.
+func (s *OrganizationService) RemoveMember(ctx context.Context, orgID uuid.UUID, userID string) error {
+	if err := s.requireRole(ctx, orgID, domain.RoleOwner); err != nil {
+		return fmt.Errorf("service.OrganizationService.RemoveMember: %w", err)
+	}
+	if err := s.orgs.RemoveMember(ctx, orgID, userID); err != nil {
+		return fmt.Errorf("service.OrganizationService.RemoveMember: %w", err)
+	}
+	return nil
+}
+
+// requireRole returns domain.ErrForbidden unless the request's user is a
+// member of the organization with at least need: an owner, or for
+// RoleMember any member. It returns domain.ErrNotFound if the organization
+// does not exist and domain.ErrUnauthorized if no one is signed in.
+func (s *OrganizationService) requireRole(ctx context.Context, orgID uuid.UUID, need domain.MemberRole) error {
+	userID, err := memberID(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.orgs.GetByID(ctx, orgID); err != nil {
+		return err
+	}
+	m, err := s.orgs.GetMember(ctx, orgID, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("%w: you are not a member of this organization", domain.ErrForbidden)
+	}
+	if err != nil {
+		return err
+	}
+	if need == domain.RoleOwner && m.Role != domain.RoleOwner {
+		return fmt.Errorf("%w: only the organization's owners may do this", domain.ErrForbidden)
+	}
+	return nil
+}
+
+// memberID returns the request's user as an organization member ID.
+// Returns domain.ErrUnauthorized for anonymous requests.
+func memberID(ctx context.Context) (string, error) {
+	id, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("sign in to manage organizations: %w", domain.ErrUnauthorized)
+	}
+	return id.String(), nil
+}
+
+// validateMember checks a membership's user ID and role.
+func validateMember(userID string, role domain.MemberRole) error {
+	if userID == "" {
+		return fmt.Errorf("%w: user is required", domain.ErrValidation)
+	}
+	if !role.Valid() {
+		return fmt.Errorf("%w: unknown role %q", domain.ErrValidation, role)
+	}
+	return nil
+}
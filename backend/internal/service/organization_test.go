@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// fakeOrganizationRepo records the calls it is given. Only one organization,
+// org, exists; err is returned by every method when set.
+type fakeOrganizationRepo struct {
+	org     domain.Organization
+	members []domain.Member
+	owner   string
+	listed  string
+	deleted uuid.UUID
+	err     error
+}
+
+func (f *fakeOrganizationRepo) Create(_ context.Context, org domain.Organization, owner string) (domain.Organization, error) {
+	f.owner = owner
+	org.ID = uuid.New()
+	return org, f.err
+}
+
+func (f *fakeOrganizationRepo) GetByID(_ context.Context, id uuid.UUID) (domain.Organization, error) {
+	if f.err != nil {
+		return domain.Organization{}, f.err
+	}
+	if id != f.org.ID {
+		return domain.Organization{}, domain.ErrNotFound
+	}
+	return f.org, nil
+}
+
+func (f *fakeOrganizationRepo) ListByUser(_ context.Context, userID string) ([]domain.Organization, error) {
+	f.listed = userID
+	return []domain.Organization{f.org}, f.err
+}
+
+func (f *fakeOrganizationRepo) Rename(_ context.Context, id uuid.UUID, name string) (domain.Organization, error) {
+	return domain.Organization{ID: id, Name: name}, f.err
+}
+
+func (f *fakeOrganizationRepo) Delete(_ context.Context, id uuid.UUID) error {
+	f.deleted = id
+	return f.err
+}
+
+func (f *fakeOrganizationRepo) ListMembers(_ context.Context, _ uuid.UUID) ([]domain.Member, error) {
+	return f.members, f.err
+}
+
+func (f *fakeOrganizationRepo) GetMember(_ context.Context, orgID uuid.UUID, userID string) (domain.Member, error) {
+	for _, m := range f.members {
+		if m.OrganizationID == orgID && m.UserID == userID {
+			return m, nil
+		}
+	}
+	return domain.Member{}, domain.ErrNotFound
+}
+
+func (f *fakeOrganizationRepo) AddMember(_ context.Context, m domain.Member) (domain.Member, error) {
+	f.members = append(f.members, m)
+	return m, f.err
+}
+
+func (f *fakeOrganizationRepo) UpdateMemberRole(_ context.Context, orgID uuid.UUID, userID string, role domain.MemberRole) (domain.Member, error) {
+	return domain.Member{OrganizationID: orgID, UserID: userID, Role: role}, f.err
+}
+
+func (f *fakeOrganizationRepo) RemoveMember(_ context.Context, _ uuid.UUID, _ string) error {
+	return f.err
+}
+
+// orgWithOwner returns a fake repo holding one organization owned by a new
+// user, and a context acting for that user.
+func orgWithOwner() (*fakeOrganizationRepo, context.Context) {
+	owner := uuid.New()
+	orgID := uuid.New()
+	repo := &fakeOrganizationRepo{
+		org:     domain.Organization{ID: orgID},
+		members: []domain.Member{{OrganizationID: orgID, UserID: owner.String(), Role: domain.RoleOwner}},
+	}
+	return repo, domain.WithUser(context.Background(), owner)
+}
+
+func TestOrganizationService_Create_TrimsAndSetsOwner(t *testing.T) {
+	repo := &fakeOrganizationRepo{}
+	svc := service.NewOrganizationService(repo)
+	user := uuid.New()
+
+	org, err := svc.Create(domain.WithUser(context.Background(), user), "  The Smiths ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "The Smiths", org.Name)
+	assert.Equal(t, user.String(), repo.owner)
+}
+
+func TestOrganizationService_Create_Validation(t *testing.T) {
+	svc := service.NewOrganizationService(&fakeOrganizationRepo{})
+
+	_, err := svc.Create(domain.WithUser(context.Background(), uuid.New()), " ")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.Create(context.Background(), "The Smiths")
+	assert.ErrorIs(t, err, domain.ErrUnauthorized, "an anonymous request owns nothing")
+}
+
+func TestOrganizationService_Delete_RefusesDefault(t *testing.T) {
+	repo, ctx := orgWithOwner()
+	svc := service.NewOrganizationService(repo)
+
+	err := svc.Delete(ctx, domain.DefaultOrganizationID)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Equal(t, uuid.Nil, repo.deleted, "repo must not be called")
+}
+
+func TestOrganizationService_ListMembers_UnknownOrganization(t *testing.T) {
+	repo, ctx := orgWithOwner()
+	svc := service.NewOrganizationService(repo)
+
+	_, err := svc.ListMembers(ctx, uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestOrganizationService_AddMember_Validation(t *testing.T) {
+	repo, ctx := orgWithOwner()
+	orgID := repo.org.ID
+	svc := service.NewOrganizationService(repo)
+
+	_, err := svc.AddMember(ctx, orgID, "  ", domain.RoleMember)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = svc.AddMember(ctx, orgID, "user-2", "admin")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Len(t, repo.members, 1, "repo must not be called")
+
+	m, err := svc.AddMember(ctx, orgID, " user-2 ", domain.RoleMember)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Member{OrganizationID: orgID, UserID: "user-2", Role: domain.RoleMember}, m)
+}
+
+func TestOrganizationService_UpdateMemberRole_PropagatesLastOwner(t *testing.T) {
+	repo, ctx := orgWithOwner()
+	svc := service.NewOrganizationService(repo)
+	repo.err = domain.ErrValidation
+
+	_, err := svc.UpdateMemberRole(ctx, repo.org.ID, "user-1", domain.RoleMember)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestOrganizationService_OutsiderIsForbidden(t *testing.T) {
+	repo, _ := orgWithOwner()
+	orgID := repo.org.ID
+	svc := service.NewOrganizationService(repo)
+	outsider := uuid.New()
+	ctx := domain.WithUser(context.Background(), outsider)
+
+	_, err := svc.GetByID(ctx, orgID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.ListMembers(ctx, orgID)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.Rename(ctx, orgID, "Mine now")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	assert.ErrorIs(t, svc.Delete(ctx, orgID), domain.ErrForbidden)
+	_, err = svc.AddMember(ctx, orgID, outsider.String(), domain.RoleOwner)
+	assert.ErrorIs(t, err, domain.ErrForbidden, "no one adds themself to another household")
+	_, err = svc.UpdateMemberRole(ctx, orgID, outsider.String(), domain.RoleOwner)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	assert.ErrorIs(t, svc.RemoveMember(ctx, orgID, repo.members[0].UserID), domain.ErrForbidden)
+
+	assert.Len(t, repo.members, 1)
+	assert.Equal(t, uuid.Nil, repo.deleted)
+}
+
+func TestOrganizationService_MemberReadsButDoesNotManage(t *testing.T) {
+	repo, _ := orgWithOwner()
+	orgID := repo.org.ID
+	member := uuid.New()
+	repo.members = append(repo.members, domain.Member{OrganizationID: orgID, UserID: member.String(), Role: domain.RoleMember})
+	svc := service.NewOrganizationService(repo)
+	ctx := domain.WithUser(context.Background(), member)
+
+	_, err := svc.GetByID(ctx, orgID)
+	require.NoError(t, err)
+	members, err := svc.ListMembers(ctx, orgID)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+
+	_, err = svc.UpdateMemberRole(ctx, orgID, member.String(), domain.RoleOwner)
+	assert.ErrorIs(t, err, domain.ErrForbidden, "a member cannot promote themself")
+	_, err = svc.Rename(ctx, orgID, "Ours")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestOrganizationService_List_UsesRequestUser(t *testing.T) {
+	repo, ctx := orgWithOwner()
+	svc := service.NewOrganizationService(repo)
+
+	orgs, err := svc.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, orgs, 1)
+	assert.Equal(t, repo.members[0].UserID, repo.listed)
+
+	_, err = svc.List(context.Background())
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// PackingService manages packing lists, both templates and the lists
+// attached to trips.
+type PackingService struct {
+	packing repo.PackingRepo
+	trips   repo.TripRepo
+}
+
+// NewPackingService constructs a PackingService.
+func NewPackingService(packing repo.PackingRepo, trips repo.TripRepo) *PackingService {
+	return &PackingService{packing: packing, trips: trips}
+}
+
+// CreateList creates a packing list. A list with no TripID is a template.
+//
+// When sourceID is set, the new list starts with a copy of that list's items,
+// all unpacked, and takes the source's name if l.Name is blank — this is how
+// a template or last season's list is reused. Returns domain.ErrNotFound if
+// TripID names a trip that does not exist, domain.ErrForbidden if it names
+// one the request's user may only view, and domain.ErrValidation if sourceID
+// names a list that does not exist.
+func (s *PackingService) CreateList(ctx context.Context, l domain.PackingList, sourceID *uuid.UUID) (domain.PackingList, error) {
+	l.Name = strings.TrimSpace(l.Name)
+	l.Items = nil
+	if l.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *l.TripID, domain.TripRoleEditor); err != nil {
+			return domain.PackingList{}, fmt.Errorf("service.PackingService.CreateList: %w", err)
+		}
+	}
+
+	if sourceID != nil {
+		source, err := s.packing.GetList(ctx, *sourceID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.PackingList{}, fmt.Errorf("%w: packing list %s does not exist", domain.ErrValidation, *sourceID)
+			}
+			return domain.PackingList{}, fmt.Errorf("service.PackingService.CreateList: %w", err)
+		}
+		if l.Name == "" {
+			l.Name = source.Name
+		}
+		for _, item := range source.Items {
+			l.Items = append(l.Items, domain.PackingItem{Name: item.Name, Quantity: item.Quantity})
+		}
+	}
+	if l.Name == "" {
+		return domain.PackingList{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+
+	created, err := s.packing.CreateList(ctx, l)
+	if err != nil {
+		return domain.PackingList{}, fmt.Errorf("service.PackingService.CreateList: %w", err)
+	}
+	return created, nil
+}
+
+// GetList returns a single list with its items.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *PackingService) GetList(ctx context.Context, id uuid.UUID) (domain.PackingList, error) {
+	l, err := s.packing.GetList(ctx, id)
+	if err != nil {
+		return domain.PackingList{}, fmt.Errorf("service.PackingService.GetList: %w", err)
+	}
+	return l, nil
+}
+
+// ListLists returns the lists matching f, ordered by name. Asking for one
+// trip's lists and for templates at once matches nothing, so it is rejected
+// with domain.ErrValidation.
+func (s *PackingService) ListLists(ctx context.Context, f domain.PackingListFilter) ([]domain.PackingList, error) {
+	if f.TripID != nil && f.Templates {
+		return nil, fmt.Errorf("%w: trip_id and templates cannot be combined", domain.ErrValidation)
+	}
+	lists, err := s.packing.ListLists(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("service.PackingService.ListLists: %w", err)
+	}
+	return lists, nil
+}
+
+// RenameList changes a list's name.
+// Returns domain.ErrNotFound if it does not exist, and domain.ErrForbidden if
+// it belongs to a trip the request's user may only view.
+func (s *PackingService) RenameList(ctx context.Context, id uuid.UUID, name string) (domain.PackingList, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.PackingList{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if _, err := s.editableList(ctx, id); err != nil {
+		return domain.PackingList{}, fmt.Errorf("service.PackingService.RenameList: %w", err)
+	}
+	updated, err := s.packing.UpdateList(ctx, domain.PackingList{ID: id, Name: name})
+	if err != nil {
+		return domain.PackingList{}, fmt.Errorf("service.PackingService.RenameList: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteList removes a list and its items. Lists copied from it are kept.
+// Returns domain.ErrNotFound if it does not exist, and domain.ErrForbidden if
+// it belongs to a trip the request's user may only view.
+func (s *PackingService) DeleteList(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.editableList(ctx, id); err != nil {
+		return fmt.Errorf("service.PackingService.DeleteList: %w", err)
+	}
+	if err := s.packing.DeleteList(ctx, id); err != nil {
+		return fmt.Errorf("service.PackingService.DeleteList: %w", err)
+	}
+	return nil
+}
+
+// AddItem validates an item and appends it to its list.
+// Returns domain.ErrNotFound if the list does not exist, and
+// domain.ErrForbidden if it belongs to a trip the request's user may only view.
+func (s *PackingService) AddItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	item, err := normalizePackingItem(item)
+	if err != nil {
+		return domain.PackingItem{}, err
+	}
+	if _, err := s.editableList(ctx, item.ListID); err != nil {
+		return domain.PackingItem{}, fmt.Errorf("service.PackingService.AddItem: %w", err)
+	}
+	created, err := s.packing.CreateItem(ctx, item)
+	if err != nil {
+		return domain.PackingItem{}, fmt.Errorf("service.PackingService.AddItem: %w", err)
+	}
+	return created, nil
+}
+
+// UpdateItem validates and overwrites an item's name, quantity, and packed
+// state. Returns domain.ErrNotFound if the list has no item with that ID, and
+// domain.ErrForbidden if the list belongs to a trip the request's user may
+// only view.
+func (s *PackingService) UpdateItem(ctx context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	item, err := normalizePackingItem(item)
+	if err != nil {
+		return domain.PackingItem{}, err
+	}
+	if _, err := s.editableList(ctx, item.ListID); err != nil {
+		return domain.PackingItem{}, fmt.Errorf("service.PackingService.UpdateItem: %w", err)
+	}
+	updated, err := s.packing.UpdateItem(ctx, item)
+	if err != nil {
+		return domain.PackingItem{}, fmt.Errorf("service.PackingService.UpdateItem: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteItem removes an item from a list.
+// Returns domain.ErrNotFound if the list has no item with that ID, and
+// domain.ErrForbidden if the list belongs to a trip the request's user may
+// only view.
+func (s *PackingService) DeleteItem(ctx context.Context, listID, id uuid.UUID) error {
+	if _, err := s.editableList(ctx, listID); err != nil {
+		return fmt.Errorf("service.PackingService.DeleteItem: %w", err)
+	}
+	if err := s.packing.DeleteItem(ctx, listID, id); err != nil {
+		return fmt.Errorf("service.PackingService.DeleteItem: %w", err)
+	}
+	return nil
+}
+
+// editableList returns a list, or domain.ErrForbidden if it belongs to a
+// trip the request's user may only view. Templates belong to no trip.
+func (s *PackingService) editableList(ctx context.Context, id uuid.UUID) (domain.PackingList, error) {
+	l, err := s.packing.GetList(ctx, id)
+	if err != nil {
+		return domain.PackingList{}, err
+	}
+	if l.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *l.TripID, domain.TripRoleEditor); err != nil {
+			return domain.PackingList{}, err
+		}
+	}
+	return l, nil
+}
+
+// normalizePackingItem trims an item's name and enforces the rules shared by
+// add and update: a name and a positive quantity.
+func normalizePackingItem(item domain.PackingItem) (domain.PackingItem, error) {
+	item.Name = strings.TrimSpace(item.Name)
+	if item.Name == "" {
+		return item, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if item.Quantity < 1 {
+		return item, fmt.Errorf("%w: quantity must be at least 1", domain.ErrValidation)
+	}
+	return item, nil
+}
@@ -0,0 +1,257 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memPackingRepo is an in-memory repo.PackingRepo.
+type memPackingRepo struct {
+	lists []domain.PackingList
+}
+
+func (m *memPackingRepo) CreateList(_ context.Context, l domain.PackingList) (domain.PackingList, error) {
+	l.ID = uuid.New()
+	items := make([]domain.PackingItem, len(l.Items))
+	for i, item := range l.Items {
+		item.ID, item.ListID, item.Position = uuid.New(), l.ID, i+1
+		items[i] = item
+	}
+	l.Items = items
+	m.lists = append(m.lists, l)
+	return l, nil
+}
+func (m *memPackingRepo) GetList(_ context.Context, id uuid.UUID) (domain.PackingList, error) {
+	for _, l := range m.lists {
+		if l.ID == id {
+			return l, nil
+		}
+	}
+	return domain.PackingList{}, domain.ErrNotFound
+}
+func (m *memPackingRepo) ListLists(_ context.Context, f domain.PackingListFilter) ([]domain.PackingList, error) {
+	out := []domain.PackingList{}
+	for _, l := range m.lists {
+		if f.TripID != nil && (l.TripID == nil || *l.TripID != *f.TripID) {
+			continue
+		}
+		if f.Templates && !l.IsTemplate() {
+			continue
+		}
+		out = append(out, l)
+	}
+	return out, nil
+}
+func (m *memPackingRepo) UpdateList(_ context.Context, l domain.PackingList) (domain.PackingList, error) {
+	for i := range m.lists {
+		if m.lists[i].ID == l.ID {
+			m.lists[i].Name = l.Name
+			return m.lists[i], nil
+		}
+	}
+	return domain.PackingList{}, domain.ErrNotFound
+}
+func (m *memPackingRepo) DeleteList(_ context.Context, id uuid.UUID) error {
+	for i, l := range m.lists {
+		if l.ID == id {
+			m.lists = append(m.lists[:i], m.lists[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memPackingRepo) CreateItem(_ context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	for i := range m.lists {
+		if m.lists[i].ID == item.ListID {
+			item.ID, item.Position = uuid.New(), len(m.lists[i].Items)+1
+			m.lists[i].Items = append(m.lists[i].Items, item)
+			return item, nil
+		}
+	}
+	return domain.PackingItem{}, domain.ErrNotFound
+}
+func (m *memPackingRepo) UpdateItem(_ context.Context, item domain.PackingItem) (domain.PackingItem, error) {
+	for _, l := range m.lists {
+		for i := range l.Items {
+			if l.ID == item.ListID && l.Items[i].ID == item.ID {
+				item.Position = l.Items[i].Position
+				l.Items[i] = item
+				return item, nil
+			}
+		}
+	}
+	return domain.PackingItem{}, domain.ErrNotFound
+}
+func (m *memPackingRepo) DeleteItem(_ context.Context, listID, id uuid.UUID) error {
+	for li := range m.lists {
+		l := &m.lists[li]
+		for i, item := range l.Items {
+			if l.ID == listID && item.ID == id {
+				l.Items = append(l.Items[:i], l.Items[i+1:]...)
+				return nil
+			}
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.PackingRepo = (*memPackingRepo)(nil)
+
+// newPackingService returns a PackingService over an empty in-memory repo
+// and one existing trip.
+func newPackingService() (*service.PackingService, *memPackingRepo, uuid.UUID) {
+	tripID := uuid.New()
+	packing := &memPackingRepo{}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id}, nil
+		},
+	}
+	return service.NewPackingService(packing, trips), packing, tripID
+}
+
+func TestPackingService_CreateList_Template(t *testing.T) {
+	svc, _, _ := newPackingService()
+
+	got, err := svc.CreateList(context.Background(), domain.PackingList{Name: "  Summer basics "}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Summer basics", got.Name)
+	assert.True(t, got.IsTemplate())
+}
+
+func TestPackingService_CreateList_RequiresName(t *testing.T) {
+	svc, packing, _ := newPackingService()
+
+	_, err := svc.CreateList(context.Background(), domain.PackingList{Name: " "}, nil)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Empty(t, packing.lists)
+}
+
+func TestPackingService_CreateList_UnknownTrip(t *testing.T) {
+	svc, _, _ := newPackingService()
+	other := uuid.New()
+
+	_, err := svc.CreateList(context.Background(), domain.PackingList{Name: "Fall", TripID: &other}, nil)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPackingService_CreateList_CopiesSourceUnpacked(t *testing.T) {
+	svc, _, tripID := newPackingService()
+	ctx := context.Background()
+	tmpl, err := svc.CreateList(ctx, domain.PackingList{Name: "Summer basics"}, nil)
+	require.NoError(t, err)
+	hose, err := svc.AddItem(ctx, domain.PackingItem{ListID: tmpl.ID, Name: "Sewer hose", Quantity: 1, Packed: true})
+	require.NoError(t, err)
+	_, err = svc.AddItem(ctx, domain.PackingItem{ListID: tmpl.ID, Name: "Leveling blocks", Quantity: 8})
+	require.NoError(t, err)
+
+	got, err := svc.CreateList(ctx, domain.PackingList{TripID: &tripID}, &tmpl.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Summer basics", got.Name, "name defaults to the source's")
+	assert.Equal(t, &tripID, got.TripID)
+	require.Len(t, got.Items, 2)
+	assert.Equal(t, "Leveling blocks", got.Items[1].Name)
+	assert.Equal(t, 8, got.Items[1].Quantity)
+	assert.False(t, got.Items[0].Packed, "copies start unpacked")
+	assert.NotEqual(t, hose.ID, got.Items[0].ID)
+}
+
+func TestPackingService_CreateList_UnknownSource(t *testing.T) {
+	svc, _, _ := newPackingService()
+	source := uuid.New()
+
+	_, err := svc.CreateList(context.Background(), domain.PackingList{Name: "Fall"}, &source)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestPackingService_ListLists_RejectsTripAndTemplates(t *testing.T) {
+	svc, _, tripID := newPackingService()
+
+	_, err := svc.ListLists(context.Background(), domain.PackingListFilter{TripID: &tripID, Templates: true})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestPackingService_AddItem_Validation(t *testing.T) {
+	cases := map[string]domain.PackingItem{
+		"missing name":  {Name: " ", Quantity: 1},
+		"zero quantity": {Name: "Chairs", Quantity: 0},
+	}
+
+	for name, item := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, _, _ := newPackingService()
+			l, err := svc.CreateList(context.Background(), domain.PackingList{Name: "Summer basics"}, nil)
+			require.NoError(t, err)
+			item.ListID = l.ID
+
+			_, err = svc.AddItem(context.Background(), item)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestPackingService_AddItem_UnknownList(t *testing.T) {
+	svc, _, _ := newPackingService()
+
+	_, err := svc.AddItem(context.Background(), domain.PackingItem{ListID: uuid.New(), Name: "Chairs", Quantity: 2})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPackingService_ViewerCannotChangeTripList(t *testing.T) {
+	tripID := uuid.New()
+	roles := map[uuid.UUID]domain.TripRole{tripID: domain.TripRoleOwner}
+	svc := service.NewPackingService(&memPackingRepo{}, rolesTripRepo(roles))
+	ctx := context.Background()
+	l, err := svc.CreateList(ctx, domain.PackingList{Name: "Summer basics", TripID: &tripID}, nil)
+	require.NoError(t, err)
+	item, err := svc.AddItem(ctx, domain.PackingItem{ListID: l.ID, Name: "Chairs", Quantity: 2})
+	require.NoError(t, err)
+
+	roles[tripID] = domain.TripRoleViewer
+	_, err = svc.CreateList(ctx, domain.PackingList{Name: "Winter", TripID: &tripID}, nil)
+	assert.ErrorIs(t, err, domain.ErrForbidden, "create")
+	_, err = svc.RenameList(ctx, l.ID, "Winter")
+	assert.ErrorIs(t, err, domain.ErrForbidden, "rename")
+	_, err = svc.AddItem(ctx, domain.PackingItem{ListID: l.ID, Name: "Heater", Quantity: 1})
+	assert.ErrorIs(t, err, domain.ErrForbidden, "add item")
+	item.Packed = true
+	_, err = svc.UpdateItem(ctx, item)
+	assert.ErrorIs(t, err, domain.ErrForbidden, "update item")
+	assert.ErrorIs(t, svc.DeleteItem(ctx, l.ID, item.ID), domain.ErrForbidden, "delete item")
+	assert.ErrorIs(t, svc.DeleteList(ctx, l.ID), domain.ErrForbidden, "delete list")
+}
+
+func TestPackingService_UpdateItem(t *testing.T) {
+	svc, _, _ := newPackingService()
+	ctx := context.Background()
+	l, err := svc.CreateList(ctx, domain.PackingList{Name: "Summer basics"}, nil)
+	require.NoError(t, err)
+	item, err := svc.AddItem(ctx, domain.PackingItem{ListID: l.ID, Name: "Chairs", Quantity: 2})
+	require.NoError(t, err)
+
+	item.Name, item.Packed = " Camp chairs ", true
+	got, err := svc.UpdateItem(ctx, item)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Camp chairs", got.Name)
+	assert.True(t, got.Packed)
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/exif"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/thumbnail"
+)
+
+// photoTypes are the image formats a stop photo may be, by the content type
+// http.DetectContentType gives them, with the extension their objects get.
+var photoTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// PhotoService attaches photos to stops. The images are kept as uploaded in
+// objects, beside a JPEG thumbnail in each domain.ThumbnailSize made when
+// they are uploaded; the database holds only what describes them. Stops are
+// checked before their photos are touched, and updated only when an upload
+// asks for its EXIF position and time to be applied to its stop.
+type PhotoService struct {
+	trips   repo.TripRepo
+	stops   repo.StopRepo
+	photos  repo.PhotoRepo
+	objects objectstore.Store
+	events  EventPublisher
+}
+
+// NewPhotoService constructs a PhotoService. events may be nil, and then no
+// photo events are published.
+func NewPhotoService(trips repo.TripRepo, stops repo.StopRepo, photos repo.PhotoRepo, objects objectstore.Store, events EventPublisher) *PhotoService {
+	return &PhotoService{trips: trips, stops: stops, photos: photos, objects: objects, events: events}
+}
+
+// Upload stores the image read from r and attaches it to a stop. filename is
+// the name it was uploaded under and may be empty. The format is recognized
+// from the content, whatever the name or declared type say. Thumbnails are
+// made of every image that can be decoded; a WebP image, which cannot, is
+// stored without them. Where and when a JPEG was taken is read from its EXIF
+// metadata and kept with the photo.
+//
+// When applyEXIF is set, the stop is also moved to where the photo was taken
+// and its arrival set to when, for whichever of the two the photo records; a
+// planned stop takes only the position, as a photo does not mean it has been
+// reached. A stop the photo places nowhere is left as it is.
+//
+// Returns domain.ErrNotFound if the stop does not exist on the trip,
+// domain.ErrForbidden if the request's user may only view the trip, and
+// domain.ErrValidation if the file is empty or not a JPEG, PNG, GIF, or WebP
+// image, the caption is too long, or the time the photo was taken is after
+// the stop was left.
+func (s *PhotoService) Upload(ctx context.Context, tripID, stopID uuid.UUID, filename, caption string, applyEXIF bool, r io.Reader) (domain.Photo, error) {
+	caption = strings.TrimSpace(caption)
+	if utf8.RuneCountInString(caption) > domain.MaxPhotoCaptionLength {
+		return domain.Photo{}, fmt.Errorf("%w: caption must be at most %d characters", domain.ErrValidation, domain.MaxPhotoCaptionLength)
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Photo{}, fmt.Errorf("service.PhotoService.Upload: %w", err)
+	}
+	stop, err := s.stops.GetByID(ctx, tripID, stopID)
+	if err != nil {
+		return domain.Photo{}, fmt.Errorf("service.PhotoService.Upload: %w", err)
+	}
+
+	// Request bodies are already capped by the max-body-size middleware.
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return domain.Photo{}, fmt.Errorf("service.PhotoService.Upload: read: %w", err)
+	}
+	if len(data) == 0 {
+		return domain.Photo{}, fmt.Errorf("%w: the file is empty", domain.ErrValidation)
+	}
+	contentType := http.DetectContentType(data)
+	ext, ok := photoTypes[contentType]
+	if !ok {
+		return domain.Photo{}, fmt.Errorf("%w: the file is not a JPEG, PNG, GIF, or WebP image", domain.ErrValidation)
+	}
+
+	// A photo without EXIF, or with EXIF that cannot be read, is still a
+	// photo; it just says nothing about where it was taken.
+	taken, _ := exif.Read(data)
+	moved := applyEXIF && placeStop(&stop, taken)
+	if moved {
+		if err := validateStop(stop); err != nil {
+			return domain.Photo{}, err
+		}
+	}
+
+	base := photoKeyBase(tripID, stopID)
+	photo := domain.Photo{
+		StopID:      stopID,
+		ObjectKey:   base + ext,
+		ContentType: contentType,
+		SizeBytes:   int64(len(data)),
+		Filename:    photoFilename(filename),
+		Caption:     caption,
+		TakenAt:     taken.TakenAt,
+		Latitude:    taken.Latitude,
+		Longitude:   taken.Longitude,
+	}
+	if err := s.objects.Put(ctx, photo.ObjectKey, bytes.NewReader(data)); err != nil {
+		return domain.Photo{}, fmt.Errorf("service.PhotoService.Upload: %w", err)
+	}
+	photo.Thumbnails, err = s.putThumbnails(ctx, base, data)
+	var created domain.Photo
+	if err == nil {
+		created, err = s.photos.Create(ctx, photo)
+	}
+	if err != nil {
+		// Nothing refers to the objects; do not leave them behind.
+		s.deleteObjects(ctx, photo)
+		return domain.Photo{}, fmt.Errorf("service.PhotoService.Upload: %w", err)
+	}
+	if moved {
+		stop.Metadata = nil // keep the stop's metadata as it is
+		updated, err := s.stops.Update(ctx, stop)
+		if err != nil {
+			// The upload asked for both; take back the photo rather than
+			// keep it without the change to its stop.
+			if _, delErr := s.photos.Delete(ctx, stopID, created.ID); delErr == nil {
+				s.deleteObjects(ctx, photo)
+			}
+			return domain.Photo{}, fmt.Errorf("service.PhotoService.Upload: apply exif: %w", err)
+		}
+		publishEvent(ctx, s.events, domain.EventStopUpdated, domain.StopEvent(updated))
+	}
+	publishEvent(ctx, s.events, domain.EventPhotoCreated, domain.TripRecordEvent(created.ID, tripID, &stopID))
+	return created, nil
+}
+
+// placeStop moves stop to where and when the photo described by taken was
+// taken, and reports whether it changed anything. A planned stop takes only
+// the position.
+func placeStop(stop *domain.Stop, taken exif.Data) bool {
+	changed := false
+	if taken.Latitude != nil && taken.Longitude != nil {
+		stop.Latitude, stop.Longitude = taken.Latitude, taken.Longitude
+		changed = true
+	}
+	if taken.TakenAt != nil && !stop.Planned {
+		stop.ArrivedAt = *taken.TakenAt
+		changed = true
+	}
+	return changed
+}
+
+// putThumbnails stores a thumbnail of the image in data in every size, under
+// keys beginning with base, and returns their keys. An image that cannot be
+// decoded gets none, which is not an error.
+func (s *PhotoService) putThumbnails(ctx context.Context, base string, data []byte) (map[domain.ThumbnailSize]string, error) {
+	img, err := thumbnail.Decode(data)
+	if err != nil {
+		return nil, nil
+	}
+	keys := map[domain.ThumbnailSize]string{}
+	for _, size := range domain.ThumbnailSizes {
+		var buf bytes.Buffer
+		if err := thumbnail.Encode(&buf, thumbnail.Fit(img, size.MaxEdge())); err != nil {
+			return keys, fmt.Errorf("thumbnail %s: %w", size, err)
+		}
+		key := base + "-" + string(size) + ".jpg"
+		if err := s.objects.Put(ctx, key, &buf); err != nil {
+			return keys, fmt.Errorf("thumbnail %s: %w", size, err)
+		}
+		keys[size] = key
+	}
+	return keys, nil
+}
+
+// ListByStop returns a stop's photos, oldest first.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+func (s *PhotoService) ListByStop(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Photo, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, fmt.Errorf("service.PhotoService.ListByStop: %w", err)
+	}
+	photos, err := s.photos.ListByStop(ctx, stopID)
+	if err != nil {
+		return nil, fmt.Errorf("service.PhotoService.ListByStop: %w", err)
+	}
+	return photos, nil
+}
+
+// Open returns one of a stop's photos and opens its image. The caller closes
+// it. Returns domain.ErrNotFound if the stop or the photo does not exist, or
+// the image has gone missing from storage.
+func (s *PhotoService) Open(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Photo, io.ReadCloser, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return domain.Photo{}, nil, fmt.Errorf("service.PhotoService.Open: %w", err)
+	}
+	p, err := s.photos.GetByID(ctx, stopID, id)
+	if err != nil {
+		return domain.Photo{}, nil, fmt.Errorf("service.PhotoService.Open: %w", err)
+	}
+	rc, err := s.objects.Get(ctx, p.ObjectKey)
+	if errors.Is(err, objectstore.ErrNotFound) {
+		return domain.Photo{}, nil, fmt.Errorf("service.PhotoService.Open: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return domain.Photo{}, nil, fmt.Errorf("service.PhotoService.Open: %w", err)
+	}
+	return p, rc, nil
+}
+
+// Thumbnail opens a photo's thumbnail in the given size, a JPEG. The caller
+// closes it. Returns domain.ErrValidation for an unknown size and
+// domain.ErrNotFound if the photo does not exist or has no thumbnails.
+func (s *PhotoService) Thumbnail(ctx context.Context, id uuid.UUID, size domain.ThumbnailSize) (io.ReadCloser, error) {
+	if !size.Valid() {
+		return nil, fmt.Errorf("%w: size must be small or medium", domain.ErrValidation)
+	}
+	p, err := s.photos.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("service.PhotoService.Thumbnail: %w", err)
+	}
+	key, ok := p.Thumbnails[size]
+	if !ok {
+		return nil, fmt.Errorf("service.PhotoService.Thumbnail: no thumbnail: %w", domain.ErrNotFound)
+	}
+	rc, err := s.objects.Get(ctx, key)
+	if errors.Is(err, objectstore.ErrNotFound) {
+		return nil, fmt.Errorf("service.PhotoService.Thumbnail: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.PhotoService.Thumbnail: %w", err)
+	}
+	return rc, nil
+}
+
+// Delete removes a photo from a stop, then its image and thumbnails. An
+// object that cannot be removed is left behind rather than failing the
+// delete, as the trash purge does. Returns domain.ErrNotFound if the stop or
+// the photo does not exist and domain.ErrForbidden if the request's user may
+// only view the trip.
+func (s *PhotoService) Delete(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.PhotoService.Delete: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return fmt.Errorf("service.PhotoService.Delete: %w", err)
+	}
+	p, err := s.photos.Delete(ctx, stopID, id)
+	if err != nil {
+		return fmt.Errorf("service.PhotoService.Delete: %w", err)
+	}
+	s.deleteObjects(ctx, p)
+	publishEvent(ctx, s.events, domain.EventPhotoDeleted, domain.TripRecordEvent(id, tripID, &stopID))
+	return nil
+}
+
+// deleteObjects removes a photo's image and thumbnails from storage, leaving
+// behind any that cannot be removed.
+func (s *PhotoService) deleteObjects(ctx context.Context, p domain.Photo) {
+	_ = s.objects.Delete(ctx, p.ObjectKey)
+	for _, key := range p.Thumbnails {
+		_ = s.objects.Delete(ctx, key)
+	}
+}
+
+// photoFilename reduces an uploaded file's name to its last element; some
+// browsers send the whole client-side path.
+func photoFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// photoKeyBase is where a new photo's objects are stored: the image at the
+// base plus its extension, each thumbnail at the base plus its size. Each
+// upload gets its own base, so two photos never share an object.
+func photoKeyBase(tripID, stopID uuid.UUID) string {
+	return "photos/" + tripID.String() + "/" + stopID.String() + "/" + uuid.NewString()
+}
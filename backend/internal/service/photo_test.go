@@ -0,0 +1,385 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil/exiftest"
+)
+
+// memPhotoRepo is an in-memory repo.PhotoRepo. createErr, when set, fails
+// every Create.
+type memPhotoRepo struct {
+	photos    []domain.Photo
+	createErr error
+}
+
+func (m *memPhotoRepo) Create(_ context.Context, p domain.Photo) (domain.Photo, error) {
+	if m.createErr != nil {
+		return domain.Photo{}, m.createErr
+	}
+	p.ID = uuid.New()
+	m.photos = append(m.photos, p)
+	return p, nil
+}
+func (m *memPhotoRepo) GetByID(_ context.Context, stopID, id uuid.UUID) (domain.Photo, error) {
+	for _, p := range m.photos {
+		if p.ID == id && p.StopID == stopID {
+			return p, nil
+		}
+	}
+	return domain.Photo{}, domain.ErrNotFound
+}
+func (m *memPhotoRepo) Get(_ context.Context, id uuid.UUID) (domain.Photo, error) {
+	for _, p := range m.photos {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return domain.Photo{}, domain.ErrNotFound
+}
+func (m *memPhotoRepo) ListByStop(_ context.Context, stopID uuid.UUID) ([]domain.Photo, error) {
+	out := []domain.Photo{}
+	for _, p := range m.photos {
+		if p.StopID == stopID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+func (m *memPhotoRepo) Delete(_ context.Context, stopID, id uuid.UUID) (domain.Photo, error) {
+	for i, p := range m.photos {
+		if p.ID == id && p.StopID == stopID {
+			m.photos = append(m.photos[:i], m.photos[i+1:]...)
+			return p, nil
+		}
+	}
+	return domain.Photo{}, domain.ErrNotFound
+}
+
+var _ repo.PhotoRepo = (*memPhotoRepo)(nil)
+
+// photoFixture is the world a PhotoService test runs in: one trip with one
+// stop and an empty object store. updated collects every stop written back;
+// updateErr, when set, fails those writes.
+type photoFixture struct {
+	svc       *service.PhotoService
+	photos    *memPhotoRepo
+	objects   *objectstore.Memory
+	tripID    uuid.UUID
+	stopID    uuid.UUID
+	stop      domain.Stop
+	updated   []domain.Stop
+	updateErr error
+}
+
+func newPhotoService() *photoFixture {
+	f := &photoFixture{tripID: uuid.New(), stopID: uuid.New(), photos: &memPhotoRepo{}, objects: objectstore.NewMemory()}
+	f.stop = domain.Stop{
+		ID: f.stopID, TripID: f.tripID, Name: "Madison Campground",
+		ArrivedAt: time.Date(2025, 7, 14, 20, 0, 0, 0, time.UTC), Metadata: domain.Metadata{"pets_allowed": true},
+	}
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+			if tripID != f.tripID || stopID != f.stopID {
+				return domain.Stop{}, domain.ErrNotFound
+			}
+			return f.stop, nil
+		},
+		update: func(_ context.Context, stop domain.Stop) (domain.Stop, error) {
+			if f.updateErr != nil {
+				return domain.Stop{}, f.updateErr
+			}
+			f.updated = append(f.updated, stop)
+			return stop, nil
+		},
+	}
+	f.svc = service.NewPhotoService(&mockTripRepo{}, stops, f.photos, f.objects, nil)
+	return f
+}
+
+// photoPNG is the signature and header of a PNG file, enough for content
+// sniffing.
+const photoPNG = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00"
+
+// encodePNG returns a whole w by h PNG image, one that decodes.
+func encodePNG(t *testing.T, w, h int) string {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{R: 200, G: 120, B: 40, A: 255}), image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.String()
+}
+
+func TestPhotoService_Upload(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+
+	got, err := f.svc.Upload(ctx, f.tripID, f.stopID, `C:\Users\me\lake.jpg`, "  Sunset  ", false, strings.NewReader(photoPNG))
+
+	require.NoError(t, err)
+	assert.Equal(t, f.stopID, got.StopID)
+	assert.Equal(t, "image/png", got.ContentType, "the type comes from the content, not the name")
+	assert.EqualValues(t, len(photoPNG), got.SizeBytes)
+	assert.Equal(t, "lake.jpg", got.Filename)
+	assert.Equal(t, "Sunset", got.Caption)
+	assert.True(t, strings.HasPrefix(got.ObjectKey, "photos/"+f.tripID.String()+"/"+f.stopID.String()+"/"), got.ObjectKey)
+	assert.True(t, strings.HasSuffix(got.ObjectKey, ".png"), got.ObjectKey)
+
+	photo, rc, err := f.svc.Open(ctx, f.tripID, f.stopID, got.ID)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, photoPNG, string(data))
+	assert.Equal(t, got.ID, photo.ID)
+}
+
+func TestPhotoService_Upload_Validation(t *testing.T) {
+	cases := map[string]struct {
+		data    string
+		caption string
+	}{
+		"empty file":   {data: ""},
+		"not an image": {data: "<gpx></gpx>"},
+		"long caption": {data: photoPNG, caption: strings.Repeat("x", domain.MaxPhotoCaptionLength+1)},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newPhotoService()
+
+			_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "x", tc.caption, false, strings.NewReader(tc.data))
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Zero(t, f.objects.Len(), "nothing is stored")
+		})
+	}
+}
+
+func TestPhotoService_Upload_UnknownStop(t *testing.T) {
+	f := newPhotoService()
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, uuid.New(), "x.png", "", false, strings.NewReader(photoPNG))
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Zero(t, f.objects.Len())
+}
+
+func TestPhotoService_Upload_RemovesImageWhenInsertFails(t *testing.T) {
+	f := newPhotoService()
+	f.photos.createErr = errors.New("connection reset")
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "x.png", "", false, strings.NewReader(photoPNG))
+
+	require.Error(t, err)
+	assert.Zero(t, f.objects.Len(), "an image nothing refers to is not left behind")
+}
+
+func TestPhotoService_Open_MissingImage(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+	p, err := f.svc.Upload(ctx, f.tripID, f.stopID, "x.png", "", false, strings.NewReader(photoPNG))
+	require.NoError(t, err)
+	require.NoError(t, f.objects.Delete(ctx, p.ObjectKey))
+
+	_, _, err = f.svc.Open(ctx, f.tripID, f.stopID, p.ID)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPhotoService_Delete(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+	p, err := f.svc.Upload(ctx, f.tripID, f.stopID, "x.png", "", false, strings.NewReader(photoPNG))
+	require.NoError(t, err)
+
+	require.NoError(t, f.svc.Delete(ctx, f.tripID, f.stopID, p.ID))
+
+	assert.Zero(t, f.objects.Len(), "the image goes with the photo")
+	photos, err := f.svc.ListByStop(ctx, f.tripID, f.stopID)
+	require.NoError(t, err)
+	assert.Empty(t, photos)
+	assert.ErrorIs(t, f.svc.Delete(ctx, f.tripID, f.stopID, p.ID), domain.ErrNotFound)
+}
+
+func TestPhotoService_Upload_Thumbnails(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+
+	got, err := f.svc.Upload(ctx, f.tripID, f.stopID, "lake.png", "", false, strings.NewReader(encodePNG(t, 1600, 800)))
+
+	require.NoError(t, err)
+	require.Len(t, got.Thumbnails, len(domain.ThumbnailSizes))
+	assert.Equal(t, 3, f.objects.Len(), "the image and a thumbnail in each size")
+	for _, size := range domain.ThumbnailSizes {
+		rc, err := f.svc.Thumbnail(ctx, got.ID, size)
+		require.NoError(t, err, size)
+		cfg, err := jpeg.DecodeConfig(rc)
+		rc.Close()
+		require.NoError(t, err, size)
+		assert.Equal(t, size.MaxEdge(), cfg.Width, size)
+		assert.Equal(t, size.MaxEdge()/2, cfg.Height, size)
+	}
+}
+
+func TestPhotoService_Upload_UndecodableImageHasNoThumbnails(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+	webp := "RIFF\x1a\x00\x00\x00WEBPVP8 \x0e\x00\x00\x00"
+
+	got, err := f.svc.Upload(ctx, f.tripID, f.stopID, "x.webp", "", false, strings.NewReader(webp))
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/webp", got.ContentType)
+	assert.Empty(t, got.Thumbnails)
+	assert.Equal(t, 1, f.objects.Len())
+	_, err = f.svc.Thumbnail(ctx, got.ID, domain.ThumbnailSmall)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPhotoService_Upload_RemovesThumbnailsWhenInsertFails(t *testing.T) {
+	f := newPhotoService()
+	f.photos.createErr = errors.New("connection reset")
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "x.png", "", false, strings.NewReader(encodePNG(t, 20, 20)))
+
+	require.Error(t, err)
+	assert.Zero(t, f.objects.Len())
+}
+
+func TestPhotoService_Thumbnail_Errors(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+	p, err := f.svc.Upload(ctx, f.tripID, f.stopID, "x.png", "", false, strings.NewReader(encodePNG(t, 20, 20)))
+	require.NoError(t, err)
+
+	_, err = f.svc.Thumbnail(ctx, p.ID, "huge")
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = f.svc.Thumbnail(ctx, uuid.New(), domain.ThumbnailSmall)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, f.objects.Delete(ctx, p.Thumbnails[domain.ThumbnailSmall]))
+	_, err = f.svc.Thumbnail(ctx, p.ID, domain.ThumbnailSmall)
+	assert.ErrorIs(t, err, domain.ErrNotFound, "a thumbnail gone from storage")
+}
+
+func TestPhotoService_Delete_RemovesThumbnails(t *testing.T) {
+	f := newPhotoService()
+	ctx := context.Background()
+	p, err := f.svc.Upload(ctx, f.tripID, f.stopID, "x.png", "", false, strings.NewReader(encodePNG(t, 20, 20)))
+	require.NoError(t, err)
+	require.NotEmpty(t, p.Thumbnails)
+
+	require.NoError(t, f.svc.Delete(ctx, f.tripID, f.stopID, p.ID))
+
+	assert.Zero(t, f.objects.Len())
+}
+
+// ---- EXIF ------------------------------------------------------------------
+
+// lakeEXIF is a photo taken at Madison Campground at 18:32 local time.
+func lakeEXIF(t *testing.T) []byte {
+	lat, lon := 44.6615, -110.4995
+	return exiftest.JPEG(t, exiftest.Tags{
+		Latitude: &lat, Longitude: &lon,
+		DateTimeOriginal: "2025:07:14 18:32:05", OffsetTimeOriginal: "-06:00",
+	})
+}
+
+func TestPhotoService_Upload_ReadsEXIF(t *testing.T) {
+	f := newPhotoService()
+
+	got, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "lake.jpg", "", false, bytes.NewReader(lakeEXIF(t)))
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", got.ContentType)
+	require.NotNil(t, got.Latitude)
+	assert.InDelta(t, 44.6615, *got.Latitude, 1e-6)
+	assert.InDelta(t, -110.4995, *got.Longitude, 1e-6)
+	require.NotNil(t, got.TakenAt)
+	assert.True(t, time.Date(2025, 7, 15, 0, 32, 5, 0, time.UTC).Equal(*got.TakenAt))
+	assert.Empty(t, f.updated, "the stop is left alone unless asked")
+}
+
+func TestPhotoService_Upload_ApplyEXIF(t *testing.T) {
+	f := newPhotoService()
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "lake.jpg", "", true, bytes.NewReader(lakeEXIF(t)))
+
+	require.NoError(t, err)
+	require.Len(t, f.updated, 1)
+	stop := f.updated[0]
+	require.True(t, stop.HasCoordinates())
+	assert.InDelta(t, 44.6615, *stop.Latitude, 1e-6)
+	assert.InDelta(t, -110.4995, *stop.Longitude, 1e-6)
+	assert.True(t, time.Date(2025, 7, 15, 0, 32, 5, 0, time.UTC).Equal(stop.ArrivedAt))
+	assert.Equal(t, "Madison Campground", stop.Name)
+	assert.Nil(t, stop.Metadata, "the stop's metadata is kept as it is")
+}
+
+func TestPhotoService_Upload_ApplyEXIF_PlannedStopTakesOnlyPosition(t *testing.T) {
+	f := newPhotoService()
+	f.stop.Planned, f.stop.ArrivedAt = true, time.Time{}
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "lake.jpg", "", true, bytes.NewReader(lakeEXIF(t)))
+
+	require.NoError(t, err)
+	require.Len(t, f.updated, 1)
+	assert.True(t, f.updated[0].Planned)
+	assert.True(t, f.updated[0].ArrivedAt.IsZero())
+	assert.True(t, f.updated[0].HasCoordinates())
+}
+
+func TestPhotoService_Upload_ApplyEXIF_NothingToApply(t *testing.T) {
+	f := newPhotoService()
+
+	got, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "x.png", "", true, strings.NewReader(encodePNG(t, 20, 20)))
+
+	require.NoError(t, err)
+	assert.Nil(t, got.TakenAt)
+	assert.Empty(t, f.updated)
+}
+
+func TestPhotoService_Upload_ApplyEXIF_AfterDeparture(t *testing.T) {
+	f := newPhotoService()
+	departed := time.Date(2025, 7, 14, 22, 0, 0, 0, time.UTC)
+	f.stop.DepartedAt = &departed
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "lake.jpg", "", true, bytes.NewReader(lakeEXIF(t)))
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+	assert.Empty(t, f.updated)
+	assert.Empty(t, f.photos.photos)
+	assert.Zero(t, f.objects.Len(), "nothing is stored")
+}
+
+func TestPhotoService_Upload_ApplyEXIF_RemovesPhotoWhenStopUpdateFails(t *testing.T) {
+	f := newPhotoService()
+	f.updateErr = errors.New("connection reset")
+
+	_, err := f.svc.Upload(context.Background(), f.tripID, f.stopID, "lake.jpg", "", true, bytes.NewReader(lakeEXIF(t)))
+
+	require.Error(t, err)
+	assert.Empty(t, f.photos.photos)
+	assert.Zero(t, f.objects.Len())
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/geocode"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// maxPlaceLookups caps how many stops one report looks up. Public geocoders
+// allow about one request a second, so a logbook with years of stops is
+// worked through over several requests rather than one very slow one.
+const maxPlaceLookups = 10
+
+// PlaceService works out which state, province, or territory each stop is
+// in from its coordinates and reports on the regions visited.
+//
+// Places are looked up lazily: each report first resolves a few stops that
+// are new, or whose coordinates have changed, since the last one.
+type PlaceService struct {
+	places   repo.PlaceRepo
+	geocoder geocode.Reverser
+}
+
+// NewPlaceService constructs a PlaceService. A nil geocoder turns lookups
+// off; places already stored are still reported.
+func NewPlaceService(places repo.PlaceRepo, geocoder geocode.Reverser) *PlaceService {
+	return &PlaceService{places: places, geocoder: geocoder}
+}
+
+// StatesVisited returns the regions stops were made in, limited to one
+// calendar year when year is non-nil. Stops whose place could not be
+// looked up yet are counted in UnresolvedStops; asking again resolves more.
+//
+// A failed lookup does not fail the report: the stop stays unresolved and
+// lookups stop until the next request. Returns domain.ErrValidation for a
+// year out of range.
+func (s *PlaceService) StatesVisited(ctx context.Context, year *int) (domain.StatesVisitedReport, error) {
+	if year != nil && (*year < 1 || *year > 9999) {
+		return domain.StatesVisitedReport{}, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+	}
+	y := 0 // every year, as repo.PlaceRepo counts it
+	if year != nil {
+		y = *year
+	}
+
+	if s.geocoder != nil {
+		if err := s.resolve(ctx, y); err != nil {
+			return domain.StatesVisitedReport{}, fmt.Errorf("service.PlaceService.StatesVisited: %w", err)
+		}
+	}
+
+	_, unresolved, err := s.places.Pending(ctx, y, 0)
+	if err != nil {
+		return domain.StatesVisitedReport{}, fmt.Errorf("service.PlaceService.StatesVisited: %w", err)
+	}
+	visits, err := s.places.Visits(ctx, y)
+	if err != nil {
+		return domain.StatesVisitedReport{}, fmt.Errorf("service.PlaceService.StatesVisited: %w", err)
+	}
+	return domain.StatesVisitedReport{Regions: visits, UnresolvedStops: unresolved}, nil
+}
+
+// resolve looks up and stores the places of up to maxPlaceLookups pending
+// stops. Only storage errors are returned.
+func (s *PlaceService) resolve(ctx context.Context, year int) error {
+	pending, _, err := s.places.Pending(ctx, year, maxPlaceLookups)
+	if err != nil {
+		return err
+	}
+	for _, p := range pending {
+		found, err := s.geocoder.Reverse(ctx, geo.Point{Lat: p.Latitude, Lon: p.Longitude})
+		if err != nil {
+			return nil // the geocoder is down or rate limiting; try next time
+		}
+		p.CountryCode, p.RegionCode, p.RegionName = found.CountryCode, found.RegionCode, found.RegionName
+		if _, err := s.places.Upsert(ctx, p); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return err // a stop deleted meanwhile needs no place
+		}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/geocode"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memPlaceRepo is an in-memory repo.PlaceRepo over a fixed list of stops.
+type memPlaceRepo struct {
+	stops  []domain.Stop // in arrival order
+	places map[uuid.UUID]domain.StopPlace
+}
+
+func (m *memPlaceRepo) inYear(s domain.Stop, year int) bool {
+	return year == 0 || s.ArrivedAt.UTC().Year() == year
+}
+
+func (m *memPlaceRepo) current(s domain.Stop) (domain.StopPlace, bool) {
+	p, ok := m.places[s.ID]
+	return p, ok && p.Latitude == *s.Latitude && p.Longitude == *s.Longitude
+}
+
+func (m *memPlaceRepo) Pending(_ context.Context, year, limit int) ([]domain.StopPlace, int, error) {
+	out := []domain.StopPlace{}
+	total := 0
+	for _, s := range m.stops {
+		if !s.HasCoordinates() || !m.inYear(s, year) {
+			continue
+		}
+		if _, ok := m.current(s); ok {
+			continue
+		}
+		total++
+		if len(out) < limit {
+			out = append(out, domain.StopPlace{StopID: s.ID, Latitude: *s.Latitude, Longitude: *s.Longitude})
+		}
+	}
+	return out, total, nil
+}
+func (m *memPlaceRepo) Upsert(_ context.Context, p domain.StopPlace) (domain.StopPlace, error) {
+	p.ResolvedAt = time.Now()
+	m.places[p.StopID] = p
+	return p, nil
+}
+func (m *memPlaceRepo) Visits(_ context.Context, year int) ([]domain.RegionVisit, error) {
+	byRegion := map[string]*domain.RegionVisit{}
+	trips := map[string]map[uuid.UUID]bool{}
+	for _, s := range m.stops {
+		if !s.HasCoordinates() || !m.inYear(s, year) {
+			continue
+		}
+		p, ok := m.current(s)
+		if !ok || p.CountryCode == "" {
+			continue
+		}
+		key := p.CountryCode + "/" + p.RegionCode
+		v := byRegion[key]
+		if v == nil {
+			v = &domain.RegionVisit{CountryCode: p.CountryCode, RegionCode: p.RegionCode, RegionName: p.RegionName, FirstVisited: s.ArrivedAt}
+			byRegion[key], trips[key] = v, map[uuid.UUID]bool{}
+		}
+		v.Stops++
+		trips[key][s.TripID] = true
+		v.Trips = len(trips[key])
+		v.LastVisited = s.ArrivedAt
+	}
+	out := []domain.RegionVisit{}
+	for _, v := range byRegion {
+		out = append(out, *v)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].CountryCode+out[i].RegionCode < out[j].CountryCode+out[j].RegionCode
+	})
+	return out, nil
+}
+
+var _ repo.PlaceRepo = (*memPlaceRepo)(nil)
+
+// fakeGeocoder places points by latitude: north of 49° is Alberta, south of
+// it Montana, and the equator is open sea.
+type fakeGeocoder struct {
+	calls int
+	err   error
+}
+
+func (f *fakeGeocoder) Reverse(_ context.Context, p geo.Point) (geocode.Place, error) {
+	f.calls++
+	switch {
+	case f.err != nil:
+		return geocode.Place{}, f.err
+	case p.Lat == 0:
+		return geocode.Place{}, nil
+	case p.Lat > 49:
+		return geocode.Place{CountryCode: "CA", RegionCode: "CA-AB", RegionName: "Alberta"}, nil
+	default:
+		return geocode.Place{CountryCode: "US", RegionCode: "US-MT", RegionName: "Montana"}, nil
+	}
+}
+
+// placeStop is a stop on trip arrived at on the given day of 2025.
+func placeStop(trip uuid.UUID, month time.Month, day int, lat, lon float64) domain.Stop {
+	return domain.Stop{
+		ID: uuid.New(), TripID: trip,
+		ArrivedAt: time.Date(2025, month, day, 18, 0, 0, 0, time.UTC),
+		Latitude:  &lat, Longitude: &lon,
+	}
+}
+
+func TestPlaceService_StatesVisited(t *testing.T) {
+	tripA, tripB := uuid.New(), uuid.New()
+	places := &memPlaceRepo{places: map[uuid.UUID]domain.StopPlace{}, stops: []domain.Stop{
+		placeStop(tripA, 6, 1, 48.7, -113.8), // Glacier
+		placeStop(tripA, 6, 4, 51.2, -115.6), // Banff
+		placeStop(tripA, 6, 8, 52.9, -118.1), // Jasper
+		{ID: uuid.New(), TripID: tripA, ArrivedAt: time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)},
+		placeStop(tripB, 8, 2, 45.7, -111.0), // Bozeman
+	}}
+	geocoder := &fakeGeocoder{}
+	svc := service.NewPlaceService(places, geocoder)
+
+	report, err := svc.StatesVisited(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Zero(t, report.UnresolvedStops)
+	assert.Equal(t, 4, geocoder.calls, "the stop without coordinates is not looked up")
+	require.Len(t, report.Regions, 2)
+	ab, mt := report.Regions[0], report.Regions[1]
+	assert.Equal(t, "CA-AB", ab.RegionCode)
+	assert.Equal(t, 2, ab.Stops)
+	assert.Equal(t, 1, ab.Trips)
+	assert.Equal(t, "Montana", mt.RegionName)
+	assert.Equal(t, 2, mt.Stops)
+	assert.Equal(t, 2, mt.Trips)
+	assert.True(t, mt.FirstVisited.Equal(places.stops[0].ArrivedAt))
+	assert.True(t, mt.LastVisited.Equal(places.stops[4].ArrivedAt))
+
+	_, err = svc.StatesVisited(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, geocoder.calls, "places are stored")
+}
+
+func TestPlaceService_StatesVisited_LooksUpMovedStopAgain(t *testing.T) {
+	trip := uuid.New()
+	places := &memPlaceRepo{places: map[uuid.UUID]domain.StopPlace{}, stops: []domain.Stop{
+		placeStop(trip, 6, 1, 48.7, -113.8),
+	}}
+	geocoder := &fakeGeocoder{}
+	svc := service.NewPlaceService(places, geocoder)
+	_, err := svc.StatesVisited(context.Background(), nil)
+	require.NoError(t, err)
+
+	lat := 51.2
+	places.stops[0].Latitude = &lat
+	report, err := svc.StatesVisited(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, geocoder.calls)
+	require.Len(t, report.Regions, 1)
+	assert.Equal(t, "CA-AB", report.Regions[0].RegionCode)
+}
+
+func TestPlaceService_StatesVisited_CapsLookups(t *testing.T) {
+	trip := uuid.New()
+	places := &memPlaceRepo{places: map[uuid.UUID]domain.StopPlace{}}
+	for i := 1; i <= 25; i++ {
+		places.stops = append(places.stops, placeStop(trip, 7, i, 45, -110))
+	}
+	geocoder := &fakeGeocoder{}
+	svc := service.NewPlaceService(places, geocoder)
+
+	report, err := svc.StatesVisited(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, geocoder.calls)
+	assert.Equal(t, 15, report.UnresolvedStops)
+	require.Len(t, report.Regions, 1)
+	assert.Equal(t, 10, report.Regions[0].Stops)
+}
+
+func TestPlaceService_StatesVisited_AtSeaIsResolved(t *testing.T) {
+	places := &memPlaceRepo{places: map[uuid.UUID]domain.StopPlace{}, stops: []domain.Stop{
+		placeStop(uuid.New(), 7, 1, 0, -30),
+	}}
+	svc := service.NewPlaceService(places, &fakeGeocoder{})
+
+	report, err := svc.StatesVisited(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Regions)
+	assert.Zero(t, report.UnresolvedStops)
+}
+
+func TestPlaceService_StatesVisited_GeocoderUnavailable(t *testing.T) {
+	newRepo := func() *memPlaceRepo {
+		return &memPlaceRepo{places: map[uuid.UUID]domain.StopPlace{}, stops: []domain.Stop{
+			placeStop(uuid.New(), 7, 1, 45, -110),
+			placeStop(uuid.New(), 7, 2, 45, -110),
+		}}
+	}
+
+	t.Run("failing", func(t *testing.T) {
+		geocoder := &fakeGeocoder{err: errors.New("429 Too Many Requests")}
+		report, err := service.NewPlaceService(newRepo(), geocoder).StatesVisited(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, geocoder.calls, "gives up for this request")
+		assert.Equal(t, 2, report.UnresolvedStops)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		report, err := service.NewPlaceService(newRepo(), nil).StatesVisited(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.UnresolvedStops)
+		assert.Empty(t, report.Regions)
+	})
+}
+
+func TestPlaceService_StatesVisited_Year(t *testing.T) {
+	trip := uuid.New()
+	old := placeStop(trip, 7, 1, 51.2, -115.6)
+	old.ArrivedAt = old.ArrivedAt.AddDate(-1, 0, 0)
+	places := &memPlaceRepo{places: map[uuid.UUID]domain.StopPlace{}, stops: []domain.Stop{
+		old, placeStop(trip, 7, 1, 45, -110),
+	}}
+	geocoder := &fakeGeocoder{}
+	svc := service.NewPlaceService(places, geocoder)
+
+	year := 2025
+	report, err := svc.StatesVisited(context.Background(), &year)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, geocoder.calls, "only that year's stops are looked up")
+	require.Len(t, report.Regions, 1)
+	assert.Equal(t, "US-MT", report.Regions[0].RegionCode)
+
+	for _, bad := range []int{0, 10000} {
+		_, err = svc.StatesVisited(context.Background(), &bad)
+		assert.True(t, errors.Is(err, domain.ErrValidation), "year %d: got %v", bad, err)
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// POIService manages points of interest and their tags.
+type POIService struct {
+	pois  repo.POIRepo
+	trips repo.TripRepo
+	tags  repo.TagRepo
+}
+
+// NewPOIService constructs a POIService.
+func NewPOIService(pois repo.POIRepo, trips repo.TripRepo, tags repo.TagRepo) *POIService {
+	return &POIService{pois: pois, trips: trips, tags: tags}
+}
+
+// Create validates and persists a point of interest tagged with tagNames.
+// Tags are upserted by slug, the same way stop tags are.
+// Returns domain.ErrNotFound if TripID names a trip that does not exist, and
+// domain.ErrForbidden if it names one the request's user may only view.
+func (s *POIService) Create(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error) {
+	p, err := s.validate(ctx, p, tagNames)
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Create: %w", err)
+	}
+
+	created, err := s.pois.Create(ctx, p)
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Create: %w", err)
+	}
+	if err := s.setTags(ctx, created.ID, tagNames); err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Create: %w", err)
+	}
+	return s.get(ctx, created.ID, "Create")
+}
+
+// GetByID returns a point of interest.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *POIService) GetByID(ctx context.Context, id uuid.UUID) (domain.PointOfInterest, error) {
+	return s.get(ctx, id, "GetByID")
+}
+
+// ListPaged returns one page of points matching f and the total number of matches.
+// The tag filter accepts a tag name or slug.
+func (s *POIService) ListPaged(ctx context.Context, f domain.POIFilter, p domain.PaginationParams) ([]domain.PointOfInterest, int64, error) {
+	if f.Category != "" && !f.Category.Valid() {
+		return nil, 0, fmt.Errorf("%w: unknown category %q", domain.ErrValidation, f.Category)
+	}
+	f.TagSlug = toSlug(f.TagSlug)
+	points, total, err := s.pois.ListPaged(ctx, f, p)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service.POIService.ListPaged: %w", err)
+	}
+	return points, total, nil
+}
+
+// Update validates and overwrites a point of interest, replacing its tags
+// with tagNames.
+// Returns domain.ErrNotFound if the point, or the trip TripID names, does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip the
+// point is linked to, before or after the change.
+func (s *POIService) Update(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error) {
+	p, err := s.validate(ctx, p, tagNames)
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Update: %w", err)
+	}
+	if err := s.requireEditor(ctx, p.ID); err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Update: %w", err)
+	}
+
+	if _, err := s.pois.Update(ctx, p); err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Update: %w", err)
+	}
+	if err := s.setTags(ctx, p.ID, tagNames); err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.Update: %w", err)
+	}
+	return s.get(ctx, p.ID, "Update")
+}
+
+// Delete removes a point of interest.
+// Returns domain.ErrNotFound if it does not exist, and domain.ErrForbidden if
+// it is linked to a trip the request's user may only view.
+func (s *POIService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.requireEditor(ctx, id); err != nil {
+		return fmt.Errorf("service.POIService.Delete: %w", err)
+	}
+	if err := s.pois.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.POIService.Delete: %w", err)
+	}
+	return nil
+}
+
+// requireEditor returns domain.ErrForbidden if the stored point is linked to
+// a trip the request's user may only view.
+func (s *POIService) requireEditor(ctx context.Context, id uuid.UUID) error {
+	p, err := s.pois.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if p.TripID == nil {
+		return nil
+	}
+	return requireTripRole(ctx, s.trips, *p.TripID, domain.TripRoleEditor)
+}
+
+// validate trims and checks p and its tag names, and confirms the trip it
+// names exists and the request's user may edit it.
+func (s *POIService) validate(ctx context.Context, p domain.PointOfInterest, tagNames []string) (domain.PointOfInterest, error) {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return p, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if !p.Category.Valid() {
+		return p, fmt.Errorf("%w: unknown category %q", domain.ErrValidation, p.Category)
+	}
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return p, fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+	}
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return p, fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrValidation)
+	}
+	for _, name := range tagNames {
+		if toSlug(name) == "" {
+			return p, fmt.Errorf("%w: tag %q contains no usable characters", domain.ErrValidation, name)
+		}
+	}
+	if p.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *p.TripID, domain.TripRoleEditor); err != nil {
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+// setTags upserts each tag name and links exactly those tags to the point.
+func (s *POIService) setTags(ctx context.Context, id uuid.UUID, tagNames []string) error {
+	ids := make([]uuid.UUID, 0, len(tagNames))
+	seen := make(map[uuid.UUID]bool, len(tagNames))
+	for _, name := range tagNames {
+		name = strings.TrimSpace(name)
+		tag, err := s.tags.Upsert(ctx, name, toSlug(name))
+		if err != nil {
+			return err
+		}
+		if !seen[tag.ID] {
+			seen[tag.ID] = true
+			ids = append(ids, tag.ID)
+		}
+	}
+	return s.pois.SetTags(ctx, id, ids)
+}
+
+// get reloads a point with its tags, wrapping errors for op.
+func (s *POIService) get(ctx context.Context, id uuid.UUID, op string) (domain.PointOfInterest, error) {
+	p, err := s.pois.GetByID(ctx, id)
+	if err != nil {
+		return domain.PointOfInterest{}, fmt.Errorf("service.POIService.%s: %w", op, err)
+	}
+	return p, nil
+}
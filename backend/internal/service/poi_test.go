@@ -0,0 +1,212 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memPOIRepo is an in-memory repo.POIRepo. Tag links are resolved against
+// the tags its companion mockTagRepo has upserted.
+type memPOIRepo struct {
+	points   map[uuid.UUID]domain.PointOfInterest
+	links    map[uuid.UUID][]uuid.UUID
+	tagsByID map[uuid.UUID]domain.Tag
+	filter   domain.POIFilter
+}
+
+func newMemPOIRepo() *memPOIRepo {
+	return &memPOIRepo{
+		points:   map[uuid.UUID]domain.PointOfInterest{},
+		links:    map[uuid.UUID][]uuid.UUID{},
+		tagsByID: map[uuid.UUID]domain.Tag{},
+	}
+}
+
+func (m *memPOIRepo) withTags(p domain.PointOfInterest) domain.PointOfInterest {
+	p.Tags = []domain.Tag{}
+	for _, id := range m.links[p.ID] {
+		p.Tags = append(p.Tags, m.tagsByID[id])
+	}
+	return p
+}
+func (m *memPOIRepo) Create(_ context.Context, p domain.PointOfInterest) (domain.PointOfInterest, error) {
+	p.ID = uuid.New()
+	m.points[p.ID] = p
+	return m.withTags(p), nil
+}
+func (m *memPOIRepo) GetByID(_ context.Context, id uuid.UUID) (domain.PointOfInterest, error) {
+	p, ok := m.points[id]
+	if !ok {
+		return domain.PointOfInterest{}, domain.ErrNotFound
+	}
+	return m.withTags(p), nil
+}
+func (m *memPOIRepo) ListPaged(_ context.Context, f domain.POIFilter, _ domain.PaginationParams) ([]domain.PointOfInterest, int64, error) {
+	m.filter = f
+	out := []domain.PointOfInterest{}
+	for _, p := range m.points {
+		out = append(out, m.withTags(p))
+	}
+	return out, int64(len(out)), nil
+}
+func (m *memPOIRepo) Update(_ context.Context, p domain.PointOfInterest) (domain.PointOfInterest, error) {
+	if _, ok := m.points[p.ID]; !ok {
+		return domain.PointOfInterest{}, domain.ErrNotFound
+	}
+	m.points[p.ID] = p
+	return m.withTags(p), nil
+}
+func (m *memPOIRepo) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := m.points[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(m.points, id)
+	delete(m.links, id)
+	return nil
+}
+func (m *memPOIRepo) SetTags(_ context.Context, id uuid.UUID, tagIDs []uuid.UUID) error {
+	m.links[id] = tagIDs
+	return nil
+}
+
+var _ repo.POIRepo = (*memPOIRepo)(nil)
+
+// newPOIService wires a POIService to in-memory points, a single known trip,
+// and a tag repo that upserts by slug.
+func newPOIService() (*service.POIService, *memPOIRepo, uuid.UUID) {
+	tripID := uuid.New()
+	pois := newMemPOIRepo()
+	bySlug := map[string]domain.Tag{}
+	tags := &mockTagRepo{
+		upsert: func(_ context.Context, name, slug string) (domain.Tag, error) {
+			if t, ok := bySlug[slug]; ok {
+				return t, nil
+			}
+			t := domain.Tag{ID: uuid.New(), Name: name, Slug: slug}
+			bySlug[slug] = t
+			pois.tagsByID[t.ID] = t
+			return t, nil
+		},
+	}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id}, nil
+		},
+	}
+	return service.NewPOIService(pois, trips, tags), pois, tripID
+}
+
+func moose(tripID *uuid.UUID) domain.PointOfInterest {
+	return domain.PointOfInterest{
+		TripID: tripID, Name: " Bull moose ", Category: domain.POIWildlife,
+		Latitude: 61.2181, Longitude: -149.9003,
+	}
+}
+
+func TestPOIService_Create_UpsertsTags(t *testing.T) {
+	svc, _, tripID := newPOIService()
+
+	got, err := svc.Create(context.Background(), moose(&tripID), []string{"Moose", " Alaska ", "moose"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bull moose", got.Name)
+	require.NotNil(t, got.TripID)
+	slugs := make([]string, len(got.Tags))
+	for i, tag := range got.Tags {
+		slugs[i] = tag.Slug
+	}
+	assert.Equal(t, []string{"moose", "alaska"}, slugs, "duplicate tag names link once")
+}
+
+func TestPOIService_Create_WithoutTrip(t *testing.T) {
+	svc, _, _ := newPOIService()
+
+	got, err := svc.Create(context.Background(), moose(nil), nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, got.TripID)
+	assert.Empty(t, got.Tags)
+}
+
+func TestPOIService_Create_UnknownTrip(t *testing.T) {
+	svc, pois, _ := newPOIService()
+	other := uuid.New()
+
+	_, err := svc.Create(context.Background(), moose(&other), nil)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+	assert.Empty(t, pois.points)
+}
+
+func TestPOIService_Create_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(p *domain.PointOfInterest)
+		tags   []string
+	}{
+		{"blank name", func(p *domain.PointOfInterest) { p.Name = "  " }, nil},
+		{"unknown category", func(p *domain.PointOfInterest) { p.Category = "ufo" }, nil},
+		{"latitude out of range", func(p *domain.PointOfInterest) { p.Latitude = 91 }, nil},
+		{"longitude out of range", func(p *domain.PointOfInterest) { p.Longitude = -181 }, nil},
+		{"unusable tag", func(*domain.PointOfInterest) {}, []string{"!!!"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, pois, _ := newPOIService()
+			p := moose(nil)
+			tc.mutate(&p)
+
+			_, err := svc.Create(context.Background(), p, tc.tags)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Empty(t, pois.points)
+		})
+	}
+}
+
+func TestPOIService_Update_ReplacesTags(t *testing.T) {
+	svc, _, _ := newPOIService()
+	created, err := svc.Create(context.Background(), moose(nil), []string{"moose", "alaska"})
+	require.NoError(t, err)
+
+	p := moose(nil)
+	p.ID = created.ID
+	got, err := svc.Update(context.Background(), p, []string{"wildlife"})
+
+	require.NoError(t, err)
+	require.Len(t, got.Tags, 1)
+	assert.Equal(t, "wildlife", got.Tags[0].Slug)
+}
+
+func TestPOIService_Update_NotFound(t *testing.T) {
+	svc, _, _ := newPOIService()
+	p := moose(nil)
+	p.ID = uuid.New()
+
+	_, err := svc.Update(context.Background(), p, nil)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestPOIService_ListPaged_NormalizesTagFilter(t *testing.T) {
+	svc, pois, _ := newPOIService()
+
+	_, _, err := svc.ListPaged(context.Background(), domain.POIFilter{TagSlug: "Rocky Mountains"}, domain.NewPaginationParams(nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "rocky-mountains", pois.filter.TagSlug)
+
+	_, _, err = svc.ListPaged(context.Background(), domain.POIFilter{Category: "ufo"}, domain.NewPaginationParams(nil, nil))
+	assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+}
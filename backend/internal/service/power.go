@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// PowerService records battery, solar, and generator readings and charts them
+// per trip.
+type PowerService struct {
+	readings repo.PowerRepo
+	trips    repo.TripRepo
+	events   EventPublisher
+}
+
+// NewPowerService constructs a PowerService. events may be nil, and then no
+// power reading events are published.
+func NewPowerService(readings repo.PowerRepo, trips repo.TripRepo, events EventPublisher) *PowerService {
+	return &PowerService{readings: readings, trips: trips, events: events}
+}
+
+// Create validates and persists a reading.
+// Returns domain.ErrNotFound if TripID names a trip that does not exist, and
+// domain.ErrForbidden if it names one the request's user may only view.
+func (s *PowerService) Create(ctx context.Context, reading domain.PowerReading) (domain.PowerReading, error) {
+	if reading.RecordedAt.IsZero() {
+		return domain.PowerReading{}, fmt.Errorf("%w: recorded_at is required", domain.ErrValidation)
+	}
+	if reading.StateOfCharge == nil && reading.SolarWh == nil && reading.GeneratorHours == nil {
+		return domain.PowerReading{}, fmt.Errorf("%w: at least one of state_of_charge, solar_wh, or generator_hours is required", domain.ErrValidation)
+	}
+	if soc := reading.StateOfCharge; soc != nil && (*soc < 0 || *soc > 100) {
+		return domain.PowerReading{}, fmt.Errorf("%w: state_of_charge must be between 0 and 100", domain.ErrValidation)
+	}
+	if reading.SolarWh != nil && *reading.SolarWh < 0 {
+		return domain.PowerReading{}, fmt.Errorf("%w: solar_wh must not be negative", domain.ErrValidation)
+	}
+	if reading.GeneratorHours != nil && *reading.GeneratorHours < 0 {
+		return domain.PowerReading{}, fmt.Errorf("%w: generator_hours must not be negative", domain.ErrValidation)
+	}
+	if reading.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *reading.TripID, domain.TripRoleEditor); err != nil {
+			return domain.PowerReading{}, fmt.Errorf("service.PowerService.Create: %w", err)
+		}
+	}
+
+	created, err := s.readings.Create(ctx, reading)
+	if err != nil {
+		return domain.PowerReading{}, fmt.Errorf("service.PowerService.Create: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventPowerReadingCreated, domain.RecordEventData{ID: created.ID, TripID: created.TripID})
+	return created, nil
+}
+
+// GetByID returns a single reading.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *PowerService) GetByID(ctx context.Context, id uuid.UUID) (domain.PowerReading, error) {
+	reading, err := s.readings.GetByID(ctx, id)
+	if err != nil {
+		return domain.PowerReading{}, fmt.Errorf("service.PowerService.GetByID: %w", err)
+	}
+	return reading, nil
+}
+
+// ListPaged returns one page of readings matching f, newest first, and the
+// total number of matches.
+func (s *PowerService) ListPaged(ctx context.Context, f domain.PowerFilter, p domain.PaginationParams) ([]domain.PowerReading, int64, error) {
+	readings, total, err := s.readings.ListPaged(ctx, f, p)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service.PowerService.ListPaged: %w", err)
+	}
+	return readings, total, nil
+}
+
+// Delete removes a reading.
+// Returns domain.ErrNotFound if it does not exist, and domain.ErrForbidden if
+// it is linked to a trip the request's user may only view.
+func (s *PowerService) Delete(ctx context.Context, id uuid.UUID) error {
+	reading, err := s.readings.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("service.PowerService.Delete: %w", err)
+	}
+	if reading.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *reading.TripID, domain.TripRoleEditor); err != nil {
+			return fmt.Errorf("service.PowerService.Delete: %w", err)
+		}
+	}
+	if err := s.readings.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.PowerService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventPowerReadingDeleted, domain.RecordEventData{ID: id, TripID: reading.TripID})
+	return nil
+}
+
+// TripPower returns a trip's readings combined per day, plus trip totals, in
+// a shape ready to chart. Returns domain.ErrNotFound if the trip does not exist.
+func (s *PowerService) TripPower(ctx context.Context, tripID uuid.UUID) (domain.TripPower, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return domain.TripPower{}, fmt.Errorf("service.PowerService.TripPower: %w", err)
+	}
+	readings, err := s.readings.ListByTripID(ctx, tripID)
+	if err != nil {
+		return domain.TripPower{}, fmt.Errorf("service.PowerService.TripPower: %w", err)
+	}
+
+	tp := domain.TripPower{TripID: tripID, Days: domain.PowerByDay(readings)}
+	for _, d := range tp.Days {
+		tp.TotalSolarWh += d.SolarWh
+		tp.TotalGeneratorHours += d.GeneratorHours
+		if d.MinStateOfCharge != nil && (tp.MinStateOfCharge == nil || *d.MinStateOfCharge < *tp.MinStateOfCharge) {
+			tp.MinStateOfCharge = d.MinStateOfCharge
+		}
+	}
+	return tp, nil
+}
@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memPowerRepo is an in-memory repo.PowerRepo.
+type memPowerRepo struct {
+	rows []domain.PowerReading
+}
+
+func (m *memPowerRepo) Create(_ context.Context, r domain.PowerReading) (domain.PowerReading, error) {
+	r.ID = uuid.New()
+	r.CreatedAt = time.Now()
+	m.rows = append(m.rows, r)
+	return r, nil
+}
+func (m *memPowerRepo) GetByID(_ context.Context, id uuid.UUID) (domain.PowerReading, error) {
+	for _, r := range m.rows {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return domain.PowerReading{}, domain.ErrNotFound
+}
+func (m *memPowerRepo) ListPaged(_ context.Context, _ domain.PowerFilter, _ domain.PaginationParams) ([]domain.PowerReading, int64, error) {
+	return m.rows, int64(len(m.rows)), nil
+}
+func (m *memPowerRepo) ListByTripID(_ context.Context, tripID uuid.UUID) ([]domain.PowerReading, error) {
+	out := []domain.PowerReading{}
+	for _, r := range m.rows {
+		if r.TripID != nil && *r.TripID == tripID {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].RecordedAt.Before(out[j].RecordedAt) })
+	return out, nil
+}
+func (m *memPowerRepo) Delete(_ context.Context, id uuid.UUID) error {
+	for i, r := range m.rows {
+		if r.ID == id {
+			m.rows = append(m.rows[:i], m.rows[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.PowerRepo = (*memPowerRepo)(nil)
+
+// newPowerService returns a PowerService over one existing trip.
+func newPowerService() (*service.PowerService, *memPowerRepo, uuid.UUID) {
+	tripID := uuid.New()
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: tripID}, nil
+		},
+	}
+	readings := &memPowerRepo{}
+	return service.NewPowerService(readings, trips, nil), readings, tripID
+}
+
+var powerT0 = time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+
+func num(v float64) *float64 { return &v }
+
+func TestPowerService_Create(t *testing.T) {
+	svc, _, tripID := newPowerService()
+
+	got, err := svc.Create(context.Background(), domain.PowerReading{
+		RecordedAt: powerT0, StateOfCharge: num(78.5), TripID: &tripID,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, got.StateOfCharge)
+	assert.InDelta(t, 78.5, *got.StateOfCharge, 0.001)
+	assert.Nil(t, got.SolarWh)
+}
+
+func TestPowerService_Create_Validation(t *testing.T) {
+	cases := map[string]domain.PowerReading{
+		"missing recorded_at":        {StateOfCharge: num(50)},
+		"no measurements":            {RecordedAt: powerT0},
+		"state of charge above 100":  {RecordedAt: powerT0, StateOfCharge: num(101)},
+		"state of charge below 0":    {RecordedAt: powerT0, StateOfCharge: num(-1)},
+		"negative solar yield":       {RecordedAt: powerT0, SolarWh: num(-5)},
+		"negative generator runtime": {RecordedAt: powerT0, GeneratorHours: num(-0.5)},
+	}
+
+	for name, reading := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, readings, _ := newPowerService()
+
+			_, err := svc.Create(context.Background(), reading)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Empty(t, readings.rows)
+		})
+	}
+}
+
+func TestPowerService_Create_UnknownTrip(t *testing.T) {
+	svc, _, _ := newPowerService()
+	other := uuid.New()
+
+	_, err := svc.Create(context.Background(), domain.PowerReading{
+		RecordedAt: powerT0, SolarWh: num(100), TripID: &other,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPowerService_TripPower(t *testing.T) {
+	svc, _, tripID := newPowerService()
+	ctx := context.Background()
+
+	for _, r := range []domain.PowerReading{
+		{RecordedAt: powerT0, StateOfCharge: num(90)},
+		{RecordedAt: powerT0.Add(12 * time.Hour), StateOfCharge: num(64), SolarWh: num(1500)},
+		{RecordedAt: powerT0.AddDate(0, 0, 2), StateOfCharge: num(55), SolarWh: num(400), GeneratorHours: num(2)},
+		{RecordedAt: powerT0.AddDate(0, 0, 2).Add(time.Hour), GeneratorHours: num(0.5)},
+	} {
+		r.TripID = &tripID
+		_, err := svc.Create(ctx, r)
+		require.NoError(t, err)
+	}
+	// Not on the trip; must not be charted.
+	_, err := svc.Create(ctx, domain.PowerReading{RecordedAt: powerT0, StateOfCharge: num(10)})
+	require.NoError(t, err)
+
+	tp, err := svc.TripPower(ctx, tripID)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 1900, tp.TotalSolarWh, 0.001)
+	assert.InDelta(t, 2.5, tp.TotalGeneratorHours, 0.001)
+	require.NotNil(t, tp.MinStateOfCharge)
+	assert.InDelta(t, 55, *tp.MinStateOfCharge, 0.001)
+
+	require.Len(t, tp.Days, 2, "the day without readings is omitted")
+	first, second := tp.Days[0], tp.Days[1]
+	assert.True(t, first.Date.Equal(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2, first.Readings)
+	require.NotNil(t, first.MinStateOfCharge)
+	assert.InDelta(t, 64, *first.MinStateOfCharge, 0.001)
+	assert.InDelta(t, 2.5, second.GeneratorHours, 0.001)
+}
+
+func TestPowerService_TripPower_NoReadings(t *testing.T) {
+	svc, _, tripID := newPowerService()
+
+	tp, err := svc.TripPower(context.Background(), tripID)
+
+	require.NoError(t, err)
+	assert.Empty(t, tp.Days)
+	assert.Nil(t, tp.MinStateOfCharge)
+}
+
+func TestPowerService_TripPower_UnknownTrip(t *testing.T) {
+	svc, _, _ := newPowerService()
+
+	_, err := svc.TripPower(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// PropaneService records propane fills and summarises consumption.
+type PropaneService struct {
+	fills  repo.PropaneRepo
+	trips  repo.TripRepo
+	events EventPublisher
+}
+
+// NewPropaneService constructs a PropaneService. events may be nil, and then
+// no propane fill events are published.
+func NewPropaneService(fills repo.PropaneRepo, trips repo.TripRepo, events EventPublisher) *PropaneService {
+	return &PropaneService{fills: fills, trips: trips, events: events}
+}
+
+// Create validates and persists a fill.
+// Returns domain.ErrNotFound if TripID names a trip that does not exist, and
+// domain.ErrForbidden if it names one the request's user may only view.
+func (s *PropaneService) Create(ctx context.Context, fill domain.PropaneFill) (domain.PropaneFill, error) {
+	if fill.FilledAt.IsZero() {
+		return domain.PropaneFill{}, fmt.Errorf("%w: filled_at is required", domain.ErrValidation)
+	}
+	if fill.Quantity <= 0 {
+		return domain.PropaneFill{}, fmt.Errorf("%w: quantity must be greater than zero", domain.ErrValidation)
+	}
+	if !fill.Unit.Valid() {
+		return domain.PropaneFill{}, fmt.Errorf("%w: unit must be %q or %q",
+			domain.ErrValidation, domain.PropaneGallons, domain.PropanePounds)
+	}
+	if fill.Price != nil && *fill.Price < 0 {
+		return domain.PropaneFill{}, fmt.Errorf("%w: price must not be negative", domain.ErrValidation)
+	}
+	fill.Location = strings.TrimSpace(fill.Location)
+	if fill.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *fill.TripID, domain.TripRoleEditor); err != nil {
+			return domain.PropaneFill{}, fmt.Errorf("service.PropaneService.Create: %w", err)
+		}
+	}
+
+	created, err := s.fills.Create(ctx, fill)
+	if err != nil {
+		return domain.PropaneFill{}, fmt.Errorf("service.PropaneService.Create: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventPropaneFillCreated, domain.RecordEventData{ID: created.ID, TripID: created.TripID})
+	return created, nil
+}
+
+// GetByID returns a single fill.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *PropaneService) GetByID(ctx context.Context, id uuid.UUID) (domain.PropaneFill, error) {
+	fill, err := s.fills.GetByID(ctx, id)
+	if err != nil {
+		return domain.PropaneFill{}, fmt.Errorf("service.PropaneService.GetByID: %w", err)
+	}
+	return fill, nil
+}
+
+// ListPaged returns one page of fills matching f, newest first, and the
+// total number of matches.
+func (s *PropaneService) ListPaged(ctx context.Context, f domain.PropaneFilter, p domain.PaginationParams) ([]domain.PropaneFill, int64, error) {
+	fills, total, err := s.fills.ListPaged(ctx, f, p)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service.PropaneService.ListPaged: %w", err)
+	}
+	return fills, total, nil
+}
+
+// Delete removes a fill.
+// Returns domain.ErrNotFound if it does not exist, and domain.ErrForbidden if
+// it is linked to a trip the request's user may only view.
+func (s *PropaneService) Delete(ctx context.Context, id uuid.UUID) error {
+	fill, err := s.fills.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("service.PropaneService.Delete: %w", err)
+	}
+	if fill.TripID != nil {
+		if err := requireTripRole(ctx, s.trips, *fill.TripID, domain.TripRoleEditor); err != nil {
+			return fmt.Errorf("service.PropaneService.Delete: %w", err)
+		}
+	}
+	if err := s.fills.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.PropaneService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventPropaneFillDeleted, domain.RecordEventData{ID: id, TripID: fill.TripID})
+	return nil
+}
+
+// Stats summarises the fills matching f: totals, average price, and the
+// consumption rate. Returns domain.ErrNotFound if f.TripID names a trip
+// that does not exist.
+func (s *PropaneService) Stats(ctx context.Context, f domain.PropaneFilter) (domain.PropaneStats, error) {
+	if f.TripID != nil {
+		if _, err := s.trips.GetByID(ctx, *f.TripID); err != nil {
+			return domain.PropaneStats{}, fmt.Errorf("service.PropaneService.Stats: %w", err)
+		}
+	}
+	fills, err := s.fills.List(ctx, f)
+	if err != nil {
+		return domain.PropaneStats{}, fmt.Errorf("service.PropaneService.Stats: %w", err)
+	}
+	return domain.ComputePropaneStats(fills), nil
+}
@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memPropaneRepo is an in-memory repo.PropaneRepo.
+type memPropaneRepo struct {
+	rows []domain.PropaneFill
+}
+
+func (m *memPropaneRepo) Create(_ context.Context, f domain.PropaneFill) (domain.PropaneFill, error) {
+	f.ID = uuid.New()
+	f.CreatedAt = time.Now()
+	m.rows = append(m.rows, f)
+	return f, nil
+}
+func (m *memPropaneRepo) GetByID(_ context.Context, id uuid.UUID) (domain.PropaneFill, error) {
+	for _, f := range m.rows {
+		if f.ID == id {
+			return f, nil
+		}
+	}
+	return domain.PropaneFill{}, domain.ErrNotFound
+}
+func (m *memPropaneRepo) ListPaged(ctx context.Context, f domain.PropaneFilter, _ domain.PaginationParams) ([]domain.PropaneFill, int64, error) {
+	out, _ := m.List(ctx, f)
+	return out, int64(len(out)), nil
+}
+func (m *memPropaneRepo) List(_ context.Context, f domain.PropaneFilter) ([]domain.PropaneFill, error) {
+	out := []domain.PropaneFill{}
+	for _, r := range m.rows {
+		if f.TripID != nil && (r.TripID == nil || *r.TripID != *f.TripID) {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].FilledAt.Before(out[j].FilledAt) })
+	return out, nil
+}
+func (m *memPropaneRepo) Delete(_ context.Context, id uuid.UUID) error {
+	for i, f := range m.rows {
+		if f.ID == id {
+			m.rows = append(m.rows[:i], m.rows[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.PropaneRepo = (*memPropaneRepo)(nil)
+
+// newPropaneService returns a PropaneService over one existing trip.
+func newPropaneService() (*service.PropaneService, *memPropaneRepo, uuid.UUID) {
+	tripID := uuid.New()
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: tripID}, nil
+		},
+	}
+	fills := &memPropaneRepo{}
+	return service.NewPropaneService(fills, trips, nil), fills, tripID
+}
+
+var propaneT0 = time.Date(2025, 6, 1, 16, 0, 0, 0, time.UTC)
+
+func TestPropaneService_Create(t *testing.T) {
+	svc, _, tripID := newPropaneService()
+
+	got, err := svc.Create(context.Background(), domain.PropaneFill{
+		FilledAt: propaneT0,
+		Quantity: 20,
+		Unit:     domain.PropanePounds,
+		Price:    num(18.50),
+		Location: "  Blue Ox Propane ",
+		TripID:   &tripID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Blue Ox Propane", got.Location, "location is trimmed")
+	assert.InDelta(t, 20/domain.PropanePoundsPerGallon, got.Gallons(), 0.001)
+}
+
+func TestPropaneService_Publishes(t *testing.T) {
+	tripID := uuid.New()
+	events := &recordingPublisher{}
+	svc := service.NewPropaneService(&memPropaneRepo{}, &mockTripRepo{}, events)
+	ctx := context.Background()
+
+	onTrip, err := svc.Create(ctx, domain.PropaneFill{FilledAt: propaneT0, Quantity: 5, Unit: domain.PropaneGallons, TripID: &tripID})
+	require.NoError(t, err)
+	atHome, err := svc.Create(ctx, domain.PropaneFill{FilledAt: propaneT0, Quantity: 5, Unit: domain.PropaneGallons})
+	require.NoError(t, err)
+	require.NoError(t, svc.Delete(ctx, onTrip.ID))
+
+	assert.Equal(t, []domain.WebhookEvent{
+		domain.EventPropaneFillCreated, domain.EventPropaneFillCreated, domain.EventPropaneFillDeleted,
+	}, events.events)
+	assert.Equal(t, []any{
+		domain.RecordEventData{ID: onTrip.ID, TripID: &tripID},
+		domain.RecordEventData{ID: atHome.ID},
+		domain.RecordEventData{ID: onTrip.ID, TripID: &tripID},
+	}, events.data, "a fill logged against no trip has no trip_id")
+}
+
+func TestPropaneService_Create_Validation(t *testing.T) {
+	valid := domain.PropaneFill{FilledAt: propaneT0, Quantity: 5, Unit: domain.PropaneGallons}
+	with := func(mutate func(*domain.PropaneFill)) domain.PropaneFill {
+		f := valid
+		mutate(&f)
+		return f
+	}
+	cases := map[string]domain.PropaneFill{
+		"missing filled_at": with(func(f *domain.PropaneFill) { f.FilledAt = time.Time{} }),
+		"zero quantity":     with(func(f *domain.PropaneFill) { f.Quantity = 0 }),
+		"unknown unit":      with(func(f *domain.PropaneFill) { f.Unit = "litre" }),
+		"negative price":    with(func(f *domain.PropaneFill) { f.Price = num(-1) }),
+	}
+
+	for name, fill := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, fills, _ := newPropaneService()
+
+			_, err := svc.Create(context.Background(), fill)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Empty(t, fills.rows)
+		})
+	}
+}
+
+func TestPropaneService_Create_UnknownTrip(t *testing.T) {
+	svc, _, _ := newPropaneService()
+	other := uuid.New()
+
+	_, err := svc.Create(context.Background(), domain.PropaneFill{
+		FilledAt: propaneT0, Quantity: 5, Unit: domain.PropaneGallons, TripID: &other,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPropaneService_Stats(t *testing.T) {
+	svc, _, _ := newPropaneService()
+	ctx := context.Background()
+
+	// 10 gal to start, then 8.48 lb (2 gal) and 6 gal over the next ten days.
+	// Only the last two count toward the rate: 8 gal / 10 days.
+	for _, f := range []domain.PropaneFill{
+		{FilledAt: propaneT0, Quantity: 10, Unit: domain.PropaneGallons, Price: num(30)},
+		{FilledAt: propaneT0.AddDate(0, 0, 4), Quantity: 8.48, Unit: domain.PropanePounds},
+		{FilledAt: propaneT0.AddDate(0, 0, 10), Quantity: 6, Unit: domain.PropaneGallons, Price: num(21)},
+	} {
+		_, err := svc.Create(ctx, f)
+		require.NoError(t, err)
+	}
+
+	stats, err := svc.Stats(ctx, domain.PropaneFilter{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Fills)
+	assert.InDelta(t, 18, stats.TotalGallons, 0.001)
+	assert.InDelta(t, 51, stats.TotalCost, 0.001)
+	require.NotNil(t, stats.AvgPricePerGallon)
+	assert.InDelta(t, 51.0/16, *stats.AvgPricePerGallon, 0.001, "unpriced fills are left out of the average")
+	require.NotNil(t, stats.GallonsPerDay)
+	assert.InDelta(t, 0.8, *stats.GallonsPerDay, 0.001)
+	require.NotNil(t, stats.FirstFillAt)
+	assert.True(t, stats.FirstFillAt.Equal(propaneT0))
+}
+
+func TestPropaneService_Stats_SingleFillHasNoRate(t *testing.T) {
+	svc, _, _ := newPropaneService()
+	ctx := context.Background()
+	_, err := svc.Create(ctx, domain.PropaneFill{FilledAt: propaneT0, Quantity: 5, Unit: domain.PropaneGallons})
+	require.NoError(t, err)
+
+	stats, err := svc.Stats(ctx, domain.PropaneFilter{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Fills)
+	assert.Nil(t, stats.GallonsPerDay)
+	assert.Nil(t, stats.AvgPricePerGallon)
+}
+
+func TestPropaneService_Stats_Empty(t *testing.T) {
+	svc, _, _ := newPropaneService()
+
+	stats, err := svc.Stats(context.Background(), domain.PropaneFilter{})
+
+	require.NoError(t, err)
+	assert.Zero(t, stats.Fills)
+	assert.Nil(t, stats.FirstFillAt)
+}
+
+func TestPropaneService_Stats_UnknownTrip(t *testing.T) {
+	svc, _, _ := newPropaneService()
+	other := uuid.New()
+
+	_, err := svc.Stats(context.Background(), domain.PropaneFilter{TripID: &other})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// ReportService builds reports that read across a trip's records.
+type ReportService struct {
+	trips    repo.TripRepo
+	expenses repo.ExpenseRepo
+}
+
+// NewReportService constructs a ReportService.
+func NewReportService(trips repo.TripRepo, expenses repo.ExpenseRepo) *ReportService {
+	return &ReportService{trips: trips, expenses: expenses}
+}
+
+// BudgetReport sums a trip's expenses by category and compares the total
+// with its budget. Each expense is rounded to whole cents before it is
+// added. Categories with no expenses are left out; the rest are ordered by
+// spending, largest first, ties by category name.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *ReportService) BudgetReport(ctx context.Context, tripID uuid.UUID) (domain.BudgetReport, error) {
+	trip, err := s.trips.GetByID(ctx, tripID)
+	if err != nil {
+		return domain.BudgetReport{}, fmt.Errorf("service.ReportService.BudgetReport: %w", err)
+	}
+	expenses, err := s.expenses.ListByTrip(ctx, tripID)
+	if err != nil {
+		return domain.BudgetReport{}, fmt.Errorf("service.ReportService.BudgetReport: %w", err)
+	}
+
+	byCategory := map[domain.ExpenseCategory]*domain.CategorySpend{}
+	report := domain.BudgetReport{TripID: tripID, BudgetCents: trip.BudgetCents, Categories: []domain.CategorySpend{}}
+	for _, e := range expenses {
+		cents := int64(math.Round(e.Amount * 100))
+		report.SpentCents += cents
+		c, ok := byCategory[e.Category]
+		if !ok {
+			c = &domain.CategorySpend{Category: e.Category}
+			byCategory[e.Category] = c
+		}
+		c.SpentCents += cents
+		c.Count++
+	}
+	for _, c := range byCategory {
+		report.Categories = append(report.Categories, *c)
+	}
+	sort.Slice(report.Categories, func(i, j int) bool {
+		a, b := report.Categories[i], report.Categories[j]
+		if a.SpentCents != b.SpentCents {
+			return a.SpentCents > b.SpentCents
+		}
+		return a.Category < b.Category
+	})
+
+	if trip.BudgetCents != nil {
+		remaining := *trip.BudgetCents - report.SpentCents
+		report.RemainingCents = &remaining
+	}
+	return report, nil
+}
@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// newReportService returns a ReportService over one trip, with the given
+// budget, and the repo its expenses are kept in.
+func newReportService(tripID uuid.UUID, budgetCents *int64) (*service.ReportService, *memExpenseRepo) {
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id, BudgetCents: budgetCents}, nil
+		},
+	}
+	expenses := &memExpenseRepo{}
+	return service.NewReportService(trips, expenses), expenses
+}
+
+func TestReportService_BudgetReport(t *testing.T) {
+	tripID := uuid.New()
+	budget := int64(20000)
+	svc, expenses := newReportService(tripID, &budget)
+	for _, e := range []domain.Expense{
+		{Category: domain.ExpenseFuel, Amount: 85.40},
+		{Category: domain.ExpenseCampground, Amount: 45},
+		{Category: domain.ExpenseFuel, Amount: 62.26},
+		{Category: domain.ExpenseToll, Amount: 8.75},
+	} {
+		e.TripID = tripID
+		_, _ = expenses.Create(context.Background(), e)
+	}
+	_, _ = expenses.Create(context.Background(), domain.Expense{TripID: uuid.New(), Category: domain.ExpenseFood, Amount: 30})
+
+	got, err := svc.BudgetReport(context.Background(), tripID)
+
+	require.NoError(t, err)
+	assert.Equal(t, tripID, got.TripID)
+	assert.Equal(t, int64(20141), got.SpentCents)
+	require.NotNil(t, got.RemainingCents)
+	assert.Equal(t, int64(-141), *got.RemainingCents)
+	assert.True(t, got.OverBudget())
+	assert.Equal(t, []domain.CategorySpend{
+		{Category: domain.ExpenseFuel, SpentCents: 14766, Count: 2},
+		{Category: domain.ExpenseCampground, SpentCents: 4500, Count: 1},
+		{Category: domain.ExpenseToll, SpentCents: 875, Count: 1},
+	}, got.Categories)
+}
+
+func TestReportService_BudgetReport_NoBudget(t *testing.T) {
+	tripID := uuid.New()
+	svc, _ := newReportService(tripID, nil)
+
+	got, err := svc.BudgetReport(context.Background(), tripID)
+
+	require.NoError(t, err)
+	assert.Zero(t, got.SpentCents)
+	assert.Nil(t, got.BudgetCents)
+	assert.Nil(t, got.RemainingCents)
+	assert.False(t, got.OverBudget())
+	assert.Empty(t, got.Categories)
+}
+
+func TestReportService_BudgetReport_UnknownTrip(t *testing.T) {
+	svc, _ := newReportService(uuid.New(), nil)
+
+	_, err := svc.BudgetReport(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// ReservationService manages campground reservations at stops and reports
+// the ones still ahead.
+type ReservationService struct {
+	trips        repo.TripRepo
+	stops        repo.StopRepo
+	reservations repo.ReservationRepo
+	events       EventPublisher
+	clock        domain.Clock
+}
+
+// NewReservationService constructs a ReservationService. Pass
+// domain.SystemClock in production; "upcoming" and the countdowns are read
+// from clock. events may be nil, and then no reservation events are
+// published.
+func NewReservationService(trips repo.TripRepo, stops repo.StopRepo, reservations repo.ReservationRepo, events EventPublisher, clock domain.Clock) *ReservationService {
+	return &ReservationService{trips: trips, stops: stops, reservations: reservations, events: events, clock: clock}
+}
+
+// Create validates and persists a reservation at a stop.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ReservationService) Create(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error) {
+	r, err := normalizeReservation(r)
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.Create: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, r.StopID); err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.Create: %w", err)
+	}
+
+	created, err := s.reservations.Create(ctx, r)
+	if err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.Create: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventReservationCreated, domain.TripRecordEvent(created.ID, tripID, &created.StopID))
+	return created, nil
+}
+
+// GetByID returns one of a stop's reservations.
+// Returns domain.ErrNotFound if the stop or the reservation does not exist.
+func (s *ReservationService) GetByID(ctx context.Context, tripID, stopID, id uuid.UUID) (domain.Reservation, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.GetByID: %w", err)
+	}
+	r, err := s.reservations.GetByID(ctx, stopID, id)
+	if err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.GetByID: %w", err)
+	}
+	return r, nil
+}
+
+// ListByStop returns a stop's reservations in check-in order.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+func (s *ReservationService) ListByStop(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.Reservation, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, fmt.Errorf("service.ReservationService.ListByStop: %w", err)
+	}
+	reservations, err := s.reservations.ListByStop(ctx, stopID)
+	if err != nil {
+		return nil, fmt.Errorf("service.ReservationService.ListByStop: %w", err)
+	}
+	return reservations, nil
+}
+
+// Update validates and overwrites a reservation.
+// Returns domain.ErrNotFound if the stop or the reservation does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ReservationService) Update(ctx context.Context, tripID uuid.UUID, r domain.Reservation) (domain.Reservation, error) {
+	r, err := normalizeReservation(r)
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.Update: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, r.StopID); err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.Update: %w", err)
+	}
+
+	updated, err := s.reservations.Update(ctx, r)
+	if err != nil {
+		return domain.Reservation{}, fmt.Errorf("service.ReservationService.Update: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventReservationUpdated, domain.TripRecordEvent(updated.ID, tripID, &updated.StopID))
+	return updated, nil
+}
+
+// Delete removes a reservation.
+// Returns domain.ErrNotFound if the stop or the reservation does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *ReservationService) Delete(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.ReservationService.Delete: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return fmt.Errorf("service.ReservationService.Delete: %w", err)
+	}
+	if err := s.reservations.Delete(ctx, stopID, id); err != nil {
+		return fmt.Errorf("service.ReservationService.Delete: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventReservationDeleted, domain.TripRecordEvent(id, tripID, &stopID))
+	return nil
+}
+
+// Upcoming returns every reservation that has not checked out yet, across
+// all trips, in check-in order. A reservation is flagged with a cancellation
+// reminder when its deadline falls within the next remindDays days.
+func (s *ReservationService) Upcoming(ctx context.Context, remindDays int) ([]domain.UpcomingReservation, error) {
+	if remindDays < 0 {
+		return nil, fmt.Errorf("%w: remind_days must not be negative", domain.ErrValidation)
+	}
+	now := s.clock.Now()
+	upcoming, err := s.reservations.ListUpcoming(ctx, now.UTC().Truncate(24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("service.ReservationService.Upcoming: %w", err)
+	}
+
+	window := time.Duration(remindDays) * 24 * time.Hour
+	for i, u := range upcoming {
+		upcoming[i] = domain.NewUpcomingReservation(u.Reservation, u.TripID, u.StopName, now, window)
+	}
+	return upcoming, nil
+}
+
+// normalizeReservation trims a reservation's text fields and enforces the
+// rules shared by create and update.
+func normalizeReservation(r domain.Reservation) (domain.Reservation, error) {
+	r.ConfirmationNumber = strings.TrimSpace(r.ConfirmationNumber)
+	r.SiteNumber = strings.TrimSpace(r.SiteNumber)
+	if r.CheckIn.IsZero() || r.CheckOut.IsZero() {
+		return r, fmt.Errorf("%w: check_in and check_out are required", domain.ErrValidation)
+	}
+	if !r.CheckOut.After(r.CheckIn) {
+		return r, fmt.Errorf("%w: check_out must be after check_in", domain.ErrValidation)
+	}
+	if r.Cost != nil && *r.Cost < 0 {
+		return r, fmt.Errorf("%w: cost must not be negative", domain.ErrValidation)
+	}
+	return r, nil
+}
@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memReservationRepo is an in-memory repo.ReservationRepo. Every
+// reservation's stop is named stopName and belongs to tripID.
+type memReservationRepo struct {
+	reservations []domain.Reservation
+	tripID       uuid.UUID
+	stopName     string
+	upcomingDate time.Time
+}
+
+func (m *memReservationRepo) Create(_ context.Context, r domain.Reservation) (domain.Reservation, error) {
+	r.ID = uuid.New()
+	m.reservations = append(m.reservations, r)
+	return r, nil
+}
+func (m *memReservationRepo) GetByID(_ context.Context, stopID, id uuid.UUID) (domain.Reservation, error) {
+	for _, r := range m.reservations {
+		if r.ID == id && r.StopID == stopID {
+			return r, nil
+		}
+	}
+	return domain.Reservation{}, domain.ErrNotFound
+}
+func (m *memReservationRepo) ListByStop(_ context.Context, stopID uuid.UUID) ([]domain.Reservation, error) {
+	out := []domain.Reservation{}
+	for _, r := range m.reservations {
+		if r.StopID == stopID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+func (m *memReservationRepo) Update(_ context.Context, r domain.Reservation) (domain.Reservation, error) {
+	for i := range m.reservations {
+		if m.reservations[i].ID == r.ID && m.reservations[i].StopID == r.StopID {
+			m.reservations[i] = r
+			return r, nil
+		}
+	}
+	return domain.Reservation{}, domain.ErrNotFound
+}
+func (m *memReservationRepo) Delete(_ context.Context, stopID, id uuid.UUID) error {
+	for i, r := range m.reservations {
+		if r.ID == id && r.StopID == stopID {
+			m.reservations = append(m.reservations[:i], m.reservations[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memReservationRepo) ListUpcoming(_ context.Context, date time.Time) ([]domain.UpcomingReservation, error) {
+	m.upcomingDate = date
+	out := []domain.UpcomingReservation{}
+	for _, r := range m.reservations {
+		if !r.CheckOut.Before(date) {
+			out = append(out, domain.UpcomingReservation{Reservation: r, TripID: m.tripID, StopName: m.stopName})
+		}
+	}
+	return out, nil
+}
+
+var _ repo.ReservationRepo = (*memReservationRepo)(nil)
+
+// reservationFixture is the world a ReservationService test runs in: one
+// trip with one stop and a fixed clock.
+type reservationFixture struct {
+	svc          *service.ReservationService
+	reservations *memReservationRepo
+	tripID       uuid.UUID
+	stopID       uuid.UUID
+}
+
+// reservationNow is mid-morning on 2025-06-20.
+var reservationNow = time.Date(2025, 6, 20, 10, 0, 0, 0, time.UTC)
+
+func newReservationService() *reservationFixture {
+	f := &reservationFixture{tripID: uuid.New(), stopID: uuid.New()}
+	f.reservations = &memReservationRepo{tripID: f.tripID, stopName: "Moab KOA"}
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+			if tripID != f.tripID || stopID != f.stopID {
+				return domain.Stop{}, domain.ErrNotFound
+			}
+			return domain.Stop{ID: stopID, TripID: tripID}, nil
+		},
+	}
+	f.svc = service.NewReservationService(&mockTripRepo{}, stops, f.reservations, nil, &fakeClock{now: reservationNow})
+	return f
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestReservationService_Create(t *testing.T) {
+	f := newReservationService()
+
+	got, err := f.svc.Create(context.Background(), f.tripID, domain.Reservation{
+		StopID:             f.stopID,
+		ConfirmationNumber: " KOA-48213 ",
+		CheckIn:            date(2025, 7, 1),
+		CheckOut:           date(2025, 7, 4),
+		Cost:               num(142.5),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "KOA-48213", got.ConfirmationNumber)
+	assert.Equal(t, 3, got.Nights())
+}
+
+func TestReservationService_Create_Validation(t *testing.T) {
+	cases := map[string]domain.Reservation{
+		"missing dates":          {},
+		"check_out before":       {CheckIn: date(2025, 7, 4), CheckOut: date(2025, 7, 1)},
+		"check_out same day":     {CheckIn: date(2025, 7, 1), CheckOut: date(2025, 7, 1)},
+		"negative cost":          {CheckIn: date(2025, 7, 1), CheckOut: date(2025, 7, 2), Cost: num(-1)},
+		"missing check_out only": {CheckIn: date(2025, 7, 1)},
+	}
+
+	for name, r := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newReservationService()
+			r.StopID = f.stopID
+
+			_, err := f.svc.Create(context.Background(), f.tripID, r)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Empty(t, f.reservations.reservations)
+		})
+	}
+}
+
+func TestReservationService_Create_StopOnOtherTrip(t *testing.T) {
+	f := newReservationService()
+
+	_, err := f.svc.Create(context.Background(), uuid.New(), domain.Reservation{
+		StopID: f.stopID, CheckIn: date(2025, 7, 1), CheckOut: date(2025, 7, 2),
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestReservationService_Upcoming(t *testing.T) {
+	f := newReservationService()
+	ctx := context.Background()
+	inFiveDays := reservationNow.Add(5 * 24 * time.Hour)
+	inTwentyDays := reservationNow.Add(20 * 24 * time.Hour)
+	yesterday := reservationNow.Add(-24 * time.Hour)
+	for _, r := range []domain.Reservation{
+		{CheckIn: date(2025, 6, 10), CheckOut: date(2025, 6, 12)},                                   // over
+		{CheckIn: date(2025, 6, 18), CheckOut: date(2025, 6, 20), CancellationDeadline: &yesterday}, // checking out today
+		{CheckIn: date(2025, 6, 28), CheckOut: date(2025, 7, 1), CancellationDeadline: &inFiveDays},
+		{CheckIn: date(2025, 7, 15), CheckOut: date(2025, 7, 18), CancellationDeadline: &inTwentyDays},
+	} {
+		r.StopID = f.stopID
+		_, err := f.svc.Create(ctx, f.tripID, r)
+		require.NoError(t, err)
+	}
+
+	got, err := f.svc.Upcoming(ctx, domain.DefaultReservationReminderDays)
+
+	require.NoError(t, err)
+	assert.Equal(t, date(2025, 6, 20), f.reservations.upcomingDate, "compared by UTC date")
+	require.Len(t, got, 3)
+	assert.Equal(t, f.tripID, got[0].TripID)
+	assert.Equal(t, "Moab KOA", got[0].StopName)
+
+	assert.Equal(t, -2, got[0].DaysUntilCheckIn, "stay already started")
+	assert.False(t, got[0].CancellationReminder, "deadline has passed")
+
+	assert.Equal(t, 8, got[1].DaysUntilCheckIn)
+	assert.True(t, got[1].CancellationReminder, "deadline within 7 days")
+
+	assert.False(t, got[2].CancellationReminder, "deadline beyond 7 days")
+}
+
+func TestReservationService_Upcoming_WiderWindow(t *testing.T) {
+	f := newReservationService()
+	inTwentyDays := reservationNow.Add(20 * 24 * time.Hour)
+	_, err := f.svc.Create(context.Background(), f.tripID, domain.Reservation{
+		StopID: f.stopID, CheckIn: date(2025, 7, 15), CheckOut: date(2025, 7, 18), CancellationDeadline: &inTwentyDays,
+	})
+	require.NoError(t, err)
+
+	got, err := f.svc.Upcoming(context.Background(), 30)
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.True(t, got[0].CancellationReminder)
+}
+
+func TestReservationService_Upcoming_NegativeWindow(t *testing.T) {
+	f := newReservationService()
+
+	_, err := f.svc.Upcoming(context.Background(), -1)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
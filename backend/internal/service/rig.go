@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// RigService manages the organization's rigs, the vehicles trips are taken in.
+type RigService struct {
+	rigs repo.RigRepo
+}
+
+// NewRigService constructs a RigService backed by the provided RigRepo.
+func NewRigService(rigs repo.RigRepo) *RigService {
+	return &RigService{rigs: rigs}
+}
+
+// Create validates and persists a rig.
+// Returns domain.ErrValidation if the input violates business rules.
+func (s *RigService) Create(ctx context.Context, rig domain.Rig) (domain.Rig, error) {
+	rig, err := validateRig(rig)
+	if err != nil {
+		return domain.Rig{}, err
+	}
+	created, err := s.rigs.Create(ctx, rig)
+	if err != nil {
+		return domain.Rig{}, fmt.Errorf("service.RigService.Create: %w", err)
+	}
+	return created, nil
+}
+
+// GetByID returns a rig by ID.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *RigService) GetByID(ctx context.Context, id uuid.UUID) (domain.Rig, error) {
+	rig, err := s.rigs.GetByID(ctx, id)
+	if err != nil {
+		return domain.Rig{}, fmt.Errorf("service.RigService.GetByID: %w", err)
+	}
+	return rig, nil
+}
+
+// List returns every rig ordered by name.
+func (s *RigService) List(ctx context.Context) ([]domain.Rig, error) {
+	rigs, err := s.rigs.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.RigService.List: %w", err)
+	}
+	return rigs, nil
+}
+
+// Update validates and overwrites a rig's fields.
+// Returns domain.ErrValidation for invalid input, domain.ErrNotFound if the
+// rig does not exist.
+func (s *RigService) Update(ctx context.Context, rig domain.Rig) (domain.Rig, error) {
+	rig, err := validateRig(rig)
+	if err != nil {
+		return domain.Rig{}, err
+	}
+	updated, err := s.rigs.Update(ctx, rig)
+	if err != nil {
+		return domain.Rig{}, fmt.Errorf("service.RigService.Update: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a rig. Trips taken in it are kept and no longer name a rig.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *RigService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.rigs.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.RigService.Delete: %w", err)
+	}
+	return nil
+}
+
+// validateRig trims rig's text fields and checks them.
+//   - Name must be non-empty.
+//   - LengthFeet and WeightLbs, if set, must be positive.
+func validateRig(rig domain.Rig) (domain.Rig, error) {
+	rig.Name = strings.TrimSpace(rig.Name)
+	rig.Make = strings.TrimSpace(rig.Make)
+	rig.Model = strings.TrimSpace(rig.Model)
+	if rig.Name == "" {
+		return rig, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if rig.LengthFeet != nil && *rig.LengthFeet <= 0 {
+		return rig, fmt.Errorf("%w: length_feet must be positive", domain.ErrValidation)
+	}
+	if rig.WeightLbs != nil && *rig.WeightLbs <= 0 {
+		return rig, fmt.Errorf("%w: weight_lbs must be positive", domain.ErrValidation)
+	}
+	return rig, nil
+}
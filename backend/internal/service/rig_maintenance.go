@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// RigMaintenanceService keeps each rig's service history: the oil changes,
+// tire rotations, and generator services done on it.
+type RigMaintenanceService struct {
+	rigs    repo.RigRepo
+	entries repo.RigMaintenanceRepo
+}
+
+// NewRigMaintenanceService constructs a RigMaintenanceService.
+func NewRigMaintenanceService(rigs repo.RigRepo, entries repo.RigMaintenanceRepo) *RigMaintenanceService {
+	return &RigMaintenanceService{rigs: rigs, entries: entries}
+}
+
+// Create validates and logs work done on a rig.
+// Returns domain.ErrValidation for invalid input and domain.ErrNotFound if
+// the rig does not exist.
+func (s *RigMaintenanceService) Create(ctx context.Context, m domain.RigMaintenance) (domain.RigMaintenance, error) {
+	m.Name = strings.TrimSpace(m.Name)
+	if m.Name == "" {
+		return domain.RigMaintenance{}, fmt.Errorf("%w: name is required", domain.ErrValidation)
+	}
+	if m.ServicedOn.IsZero() {
+		return domain.RigMaintenance{}, fmt.Errorf("%w: serviced_on is required", domain.ErrValidation)
+	}
+	if m.OdometerMiles != nil && *m.OdometerMiles < 0 {
+		return domain.RigMaintenance{}, fmt.Errorf("%w: odometer_miles must not be negative", domain.ErrValidation)
+	}
+	if m.EngineHours != nil && *m.EngineHours < 0 {
+		return domain.RigMaintenance{}, fmt.Errorf("%w: engine_hours must not be negative", domain.ErrValidation)
+	}
+
+	created, err := s.entries.Create(ctx, m)
+	if err != nil {
+		return domain.RigMaintenance{}, fmt.Errorf("service.RigMaintenanceService.Create: %w", err)
+	}
+	return created, nil
+}
+
+// List returns a rig's service history, most recent first.
+// Returns domain.ErrNotFound if the rig does not exist.
+func (s *RigMaintenanceService) List(ctx context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error) {
+	if _, err := s.rigs.GetByID(ctx, rigID); err != nil {
+		return nil, fmt.Errorf("service.RigMaintenanceService.List: %w", err)
+	}
+	entries, err := s.entries.ListByRig(ctx, rigID)
+	if err != nil {
+		return nil, fmt.Errorf("service.RigMaintenanceService.List: %w", err)
+	}
+	return entries, nil
+}
+
+// Delete removes an entry from a rig's history.
+// Returns domain.ErrNotFound if the rig has no such entry.
+func (s *RigMaintenanceService) Delete(ctx context.Context, rigID, id uuid.UUID) error {
+	if err := s.entries.Delete(ctx, rigID, id); err != nil {
+		return fmt.Errorf("service.RigMaintenanceService.Delete: %w", err)
+	}
+	return nil
+}
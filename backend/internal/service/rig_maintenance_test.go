@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memRigMaintenanceRepo is an in-memory repo.RigMaintenanceRepo whose rigs
+// are those of its companion memRigRepo.
+type memRigMaintenanceRepo struct {
+	rigs    *memRigRepo
+	entries []domain.RigMaintenance
+}
+
+func (m *memRigMaintenanceRepo) Create(_ context.Context, entry domain.RigMaintenance) (domain.RigMaintenance, error) {
+	if _, ok := m.rigs.rigs[entry.RigID]; !ok {
+		return domain.RigMaintenance{}, domain.ErrNotFound
+	}
+	entry.ID = uuid.New()
+	m.entries = append(m.entries, entry)
+	return entry, nil
+}
+func (m *memRigMaintenanceRepo) ListByRig(_ context.Context, rigID uuid.UUID) ([]domain.RigMaintenance, error) {
+	out := []domain.RigMaintenance{}
+	for _, e := range m.entries {
+		if e.RigID == rigID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+func (m *memRigMaintenanceRepo) Delete(_ context.Context, rigID, id uuid.UUID) error {
+	for i, e := range m.entries {
+		if e.ID == id && e.RigID == rigID {
+			m.entries = append(m.entries[:i], m.entries[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+var _ repo.RigMaintenanceRepo = (*memRigMaintenanceRepo)(nil)
+
+// newRigMaintenanceFixture returns a service over one rig, and that rig.
+func newRigMaintenanceFixture(t *testing.T) (*service.RigMaintenanceService, domain.Rig) {
+	t.Helper()
+	rigs := newMemRigRepo()
+	rig, err := rigs.Create(context.Background(), domain.Rig{Name: "Motorhome"})
+	require.NoError(t, err)
+	return service.NewRigMaintenanceService(rigs, &memRigMaintenanceRepo{rigs: rigs}), rig
+}
+
+func TestRigMaintenanceService_CreateAndList(t *testing.T) {
+	svc, rig := newRigMaintenanceFixture(t)
+	ctx := context.Background()
+	hours := 312.5
+
+	created, err := svc.Create(ctx, domain.RigMaintenance{RigID: rig.ID, Name: " Generator service ", ServicedOn: domain.NewDate(2025, 5, 12), EngineHours: &hours})
+	require.NoError(t, err)
+	assert.Equal(t, "Generator service", created.Name)
+
+	got, err := svc.List(ctx, rig.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, created.ID, got[0].ID)
+}
+
+func TestRigMaintenanceService_Create_Validation(t *testing.T) {
+	negative := -1.0
+	tests := map[string]domain.RigMaintenance{
+		"missing name":          {ServicedOn: domain.NewDate(2025, 5, 12)},
+		"missing serviced_on":   {Name: "Oil change"},
+		"negative odometer":     {Name: "Oil change", ServicedOn: domain.NewDate(2025, 5, 12), OdometerMiles: &negative},
+		"negative engine hours": {Name: "Oil change", ServicedOn: domain.NewDate(2025, 5, 12), EngineHours: &negative},
+	}
+	for name, entry := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc, rig := newRigMaintenanceFixture(t)
+			entry.RigID = rig.ID
+
+			_, err := svc.Create(context.Background(), entry)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestRigMaintenanceService_UnknownRig(t *testing.T) {
+	svc, _ := newRigMaintenanceFixture(t)
+	ctx := context.Background()
+
+	_, err := svc.List(ctx, uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = svc.Create(ctx, domain.RigMaintenance{RigID: uuid.New(), Name: "Oil change", ServicedOn: domain.NewDate(2025, 5, 12)})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memRigRepo is an in-memory repo.RigRepo.
+type memRigRepo struct {
+	rigs map[uuid.UUID]domain.Rig
+}
+
+func newMemRigRepo() *memRigRepo {
+	return &memRigRepo{rigs: map[uuid.UUID]domain.Rig{}}
+}
+
+func (m *memRigRepo) Create(_ context.Context, rig domain.Rig) (domain.Rig, error) {
+	rig.ID = uuid.New()
+	m.rigs[rig.ID] = rig
+	return rig, nil
+}
+func (m *memRigRepo) GetByID(_ context.Context, id uuid.UUID) (domain.Rig, error) {
+	rig, ok := m.rigs[id]
+	if !ok {
+		return domain.Rig{}, domain.ErrNotFound
+	}
+	return rig, nil
+}
+func (m *memRigRepo) List(_ context.Context) ([]domain.Rig, error) {
+	out := []domain.Rig{}
+	for _, rig := range m.rigs {
+		out = append(out, rig)
+	}
+	return out, nil
+}
+func (m *memRigRepo) Update(_ context.Context, rig domain.Rig) (domain.Rig, error) {
+	if _, ok := m.rigs[rig.ID]; !ok {
+		return domain.Rig{}, domain.ErrNotFound
+	}
+	m.rigs[rig.ID] = rig
+	return rig, nil
+}
+func (m *memRigRepo) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := m.rigs[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(m.rigs, id)
+	return nil
+}
+
+var _ repo.RigRepo = (*memRigRepo)(nil)
+
+func TestRigService_Create_TrimsFields(t *testing.T) {
+	svc := service.NewRigService(newMemRigRepo())
+
+	got, err := svc.Create(context.Background(), domain.Rig{Name: " Motorhome ", Make: " Winnebago "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Motorhome", got.Name)
+	assert.Equal(t, "Winnebago", got.Make)
+}
+
+func TestRigService_Create_Validation(t *testing.T) {
+	zero := 0.0
+	tests := map[string]domain.Rig{
+		"missing name": {Name: "  "},
+		"zero length":  {Name: "Trailer", LengthFeet: &zero},
+		"zero weight":  {Name: "Trailer", WeightLbs: &zero},
+	}
+	for name, rig := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := service.NewRigService(newMemRigRepo())
+
+			_, err := svc.Create(context.Background(), rig)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestRigService_Update_NotFound(t *testing.T) {
+	svc := service.NewRigService(newMemRigRepo())
+
+	_, err := svc.Update(context.Background(), domain.Rig{ID: uuid.New(), Name: "Trailer"})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTripService_Create_RigMustExist(t *testing.T) {
+	rigs := newMemRigRepo()
+	rig, err := rigs.Create(context.Background(), domain.Rig{Name: "Motorhome"})
+	require.NoError(t, err)
+	svc := service.NewTripService(echoRepo(), nil, rigs, nil)
+
+	trip := domain.Trip{Name: "Summer Tour", StartDate: domain.NewDate(2025, 6, 1), RigID: &rig.ID}
+	got, err := svc.Create(context.Background(), trip)
+	require.NoError(t, err)
+	assert.Equal(t, &rig.ID, got.RigID)
+
+	unknown := uuid.New()
+	trip.RigID = &unknown
+	_, err = svc.Create(context.Background(), trip)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
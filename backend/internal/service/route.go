@@ -0,0 +1,363 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/elevation"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/gpx"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/routing"
+)
+
+// trackToleranceMeters is how far the stored polyline may stray from an
+// uploaded track. Five meters keeps the road shape at any zoom a trip map
+// uses while cutting a one-point-per-second track down to a few percent.
+const trackToleranceMeters = 5
+
+// Elevation profiles are sampled every elevationSpacingMeters along a leg,
+// which shows the shape of a mountain pass, but at no more than
+// maxElevationSamples points so a long day's drive stays a few API calls.
+const (
+	elevationSpacingMeters = 200
+	maxElevationSamples    = 300
+)
+
+// metersPerMile converts routing API distances to the miles legs are kept in.
+const metersPerMile = 1609.344
+
+// RouteLegService keeps a trip's route legs in step with its stops and sums
+// them into trip stats.
+//
+// Legs are reconciled with the stop order whenever they are read rather than
+// on every stop write, so stops created by any path — the API, seeding, an
+// import — get their legs without each of those paths having to remember to.
+//
+// Uploaded GPX tracks are kept whole in tracks; the database holds only a
+// simplified polyline for drawing the map.
+//
+// Elevation profiles are looked up from elevations the first time they are
+// asked for and kept until the leg's polyline changes.
+//
+// The drive check looks up legs with no distance or driving time entered
+// from routes, and stores nothing.
+type RouteLegService struct {
+	trips      repo.TripRepo
+	stops      repo.StopRepo
+	legs       repo.RouteLegRepo
+	tracks     objectstore.Store
+	elevations elevation.Source
+	routes     routing.Router
+	events     EventPublisher
+}
+
+// NewRouteLegService constructs a RouteLegService. A nil elevations turns
+// off elevation lookups; profiles already stored are still served. A nil
+// routes turns off routing lookups, and a nil events publishing route leg
+// events.
+func NewRouteLegService(trips repo.TripRepo, stops repo.StopRepo, legs repo.RouteLegRepo, tracks objectstore.Store, elevations elevation.Source, routes routing.Router, events EventPublisher) *RouteLegService {
+	return &RouteLegService{trips: trips, stops: stops, legs: legs, tracks: tracks, elevations: elevations, routes: routes, events: events}
+}
+
+// ListByTrip returns a trip's legs in route order, first creating legs for
+// newly adjacent stops and dropping legs whose stops are no longer adjacent.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *RouteLegService) ListByTrip(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error) {
+	legs, _, err := s.sync(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.RouteLegService.ListByTrip: %w", err)
+	}
+	return legs, nil
+}
+
+// Update validates and saves a leg's distance, duration, and polyline.
+// Returns domain.ErrNotFound if the trip has no leg with that ID.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *RouteLegService) Update(ctx context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	leg.Polyline = strings.TrimSpace(leg.Polyline)
+	if leg.DistanceMiles != nil && *leg.DistanceMiles < 0 {
+		return domain.RouteLeg{}, fmt.Errorf("%w: distance_miles must not be negative", domain.ErrValidation)
+	}
+	if leg.DurationMinutes != nil && *leg.DurationMinutes < 0 {
+		return domain.RouteLeg{}, fmt.Errorf("%w: duration_minutes must not be negative", domain.ErrValidation)
+	}
+	if !domain.ValidPolyline(leg.Polyline) {
+		return domain.RouteLeg{}, fmt.Errorf("%w: polyline is not an encoded polyline", domain.ErrValidation)
+	}
+
+	if err := requireTripRole(ctx, s.trips, leg.TripID, domain.TripRoleEditor); err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.Update: %w", err)
+	}
+	updated, err := s.legs.Update(ctx, leg)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.Update: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventRouteLegUpdated, domain.TripRecordEvent(updated.ID, updated.TripID, nil))
+	return updated, nil
+}
+
+// UploadTrack stores a GPX track for a leg and derives the leg's polyline,
+// distance, and — when the track is timestamped — duration from it,
+// replacing any values entered by hand. A track uploaded again replaces the
+// previous one.
+//
+// Returns domain.ErrNotFound if the trip has no leg with that ID, and
+// domain.ErrValidation if the file is not GPX or has fewer than two points.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *RouteLegService) UploadTrack(ctx context.Context, tripID, legID uuid.UUID, r io.Reader) (domain.RouteLeg, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.UploadTrack: %w", err)
+	}
+	leg, err := s.legs.GetByID(ctx, tripID, legID)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.UploadTrack: %w", err)
+	}
+
+	// The file is read once for parsing and again for storage, and request
+	// bodies are already capped by the max-body-size middleware.
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.UploadTrack: read: %w", err)
+	}
+	track, err := gpx.Parse(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, gpx.ErrInvalid) {
+			return domain.RouteLeg{}, fmt.Errorf("%w: %v", domain.ErrValidation, err)
+		}
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.UploadTrack: %w", err)
+	}
+	if len(track.Points) < 2 {
+		return domain.RouteLeg{}, fmt.Errorf("%w: a track needs at least two points", domain.ErrValidation)
+	}
+
+	key := trackKey(tripID, legID)
+	if err := s.tracks.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.UploadTrack: %w", err)
+	}
+
+	pts := track.LatLons()
+	miles := math.Round(geo.PathMiles(pts)*10) / 10
+	leg.DistanceMiles = &miles
+	if d, ok := track.Duration(); ok {
+		minutes := int(math.Round(d.Minutes()))
+		leg.DurationMinutes = &minutes
+	}
+	leg.Polyline = geo.EncodePolyline(geo.Simplify(pts, trackToleranceMeters))
+	leg.TrackKey = key
+	leg.TrackPoints = len(track.Points)
+
+	updated, err := s.legs.AttachTrack(ctx, leg)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.UploadTrack: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventRouteLegUpdated, domain.TripRecordEvent(updated.ID, tripID, nil))
+	return updated, nil
+}
+
+// Track opens the GPX file uploaded for a leg. The caller closes it.
+// Returns domain.ErrNotFound if the trip has no leg with that ID or the leg
+// has no track.
+func (s *RouteLegService) Track(ctx context.Context, tripID, legID uuid.UUID) (io.ReadCloser, error) {
+	leg, err := s.legs.GetByID(ctx, tripID, legID)
+	if err != nil {
+		return nil, fmt.Errorf("service.RouteLegService.Track: %w", err)
+	}
+	if !leg.HasTrack() {
+		return nil, fmt.Errorf("service.RouteLegService.Track: %w", domain.ErrNotFound)
+	}
+	rc, err := s.tracks.Get(ctx, leg.TrackKey)
+	if errors.Is(err, objectstore.ErrNotFound) {
+		return nil, fmt.Errorf("service.RouteLegService.Track: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service.RouteLegService.Track: %w", err)
+	}
+	return rc, nil
+}
+
+// Elevation returns a leg with its elevation profile, looking the profile up
+// and storing it if the leg does not have one yet. The profile is sampled
+// along the leg's polyline.
+//
+// Returns domain.ErrNotFound if the trip has no leg with that ID, and
+// domain.ErrValidation if the leg has no polyline or elevation lookups are
+// not configured.
+func (s *RouteLegService) Elevation(ctx context.Context, tripID, legID uuid.UUID) (domain.RouteLeg, error) {
+	leg, err := s.legs.GetByID(ctx, tripID, legID)
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.Elevation: %w", err)
+	}
+	if leg.Elevation != nil {
+		return leg, nil
+	}
+	if leg.Polyline == "" {
+		return domain.RouteLeg{}, fmt.Errorf("%w: the leg has no polyline to follow; enter one or upload a GPX track", domain.ErrValidation)
+	}
+	if s.elevations == nil {
+		return domain.RouteLeg{}, fmt.Errorf("%w: elevation lookups are not configured", domain.ErrValidation)
+	}
+	path, err := geo.DecodePolyline(leg.Polyline)
+	if err != nil || len(path) < 2 {
+		return domain.RouteLeg{}, fmt.Errorf("%w: the leg's polyline does not decode to a route", domain.ErrValidation)
+	}
+
+	length := geo.PathMeters(path)
+	n := min(max(int(math.Ceil(length/elevationSpacingMeters))+1, 2), maxElevationSamples)
+	meters, err := s.elevations.Elevations(ctx, geo.Resample(path, n))
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.Elevation: %w", err)
+	}
+	leg.Elevation = &domain.ElevationProfile{SpacingMeters: length / float64(n-1), Meters: meters}
+
+	stored, err := s.legs.SetElevation(ctx, leg)
+	if errors.Is(err, domain.ErrNotFound) {
+		// The polyline was edited, or the leg deleted, while the lookup was
+		// in flight; the profile no longer matches anything stored.
+		return domain.RouteLeg{}, fmt.Errorf("%w: the leg's route changed while its elevation was looked up; try again", domain.ErrValidation)
+	}
+	if err != nil {
+		return domain.RouteLeg{}, fmt.Errorf("service.RouteLegService.Elevation: %w", err)
+	}
+	return stored, nil
+}
+
+// trackKey is where a leg's GPX file is stored. Keyed by leg, so uploading
+// again overwrites the previous file.
+func trackKey(tripID, legID uuid.UUID) string {
+	return "tracks/" + tripID.String() + "/" + legID.String() + ".gpx"
+}
+
+// CheckDrives checks each drive of the trip's planned itinerary — every leg
+// that ends at a planned stop, in route order — against rules.
+//
+// A leg's distance and driving time are the ones entered on it; whichever is
+// missing is looked up from the routing API when both stops have
+// coordinates. A failed lookup leaves it unknown, and the rules that needed
+// it unchecked. Sunset is worked out at the destination's coordinates.
+//
+// Returns domain.ErrNotFound if the trip does not exist, and
+// domain.ErrValidation if rules sets no limit or a negative one.
+func (s *RouteLegService) CheckDrives(ctx context.Context, tripID uuid.UUID, rules domain.DriveRules) ([]domain.DriveDay, error) {
+	if rules.MaxMiles < 0 || rules.MaxDriveTime < 0 {
+		return nil, fmt.Errorf("%w: limits must not be negative", domain.ErrValidation)
+	}
+	if rules.MaxMiles == 0 && rules.MaxDriveTime == 0 && !rules.ArriveBeforeDark {
+		return nil, fmt.Errorf("%w: set at least one of max_miles_per_day, max_drive_hours, or arrive_before_dark", domain.ErrValidation)
+	}
+
+	legs, stops, err := s.sync(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.RouteLegService.CheckDrives: %w", err)
+	}
+	byID := make(map[uuid.UUID]domain.Stop, len(stops))
+	for _, st := range stops {
+		byID[st.ID] = st
+	}
+
+	days := []domain.DriveDay{}
+	for _, l := range legs {
+		from, to := byID[l.FromStopID], byID[l.ToStopID]
+		if !to.Planned {
+			continue
+		}
+		day := domain.DriveDay{
+			LegID:           l.ID,
+			FromStopID:      l.FromStopID,
+			ToStopID:        l.ToStopID,
+			DistanceMiles:   l.DistanceMiles,
+			DurationMinutes: l.DurationMinutes,
+			Source:          domain.DriveEntered,
+		}
+		if day.DistanceMiles == nil || day.DurationMinutes == nil {
+			s.route(ctx, from, to, &day)
+		}
+
+		switch {
+		case from.DepartedAt != nil:
+			day.DepartAt = from.DepartedAt
+		case rules.DepartAt != nil:
+			depart := rules.DepartAt.AddDate(0, 0, len(days))
+			day.DepartAt = &depart
+		}
+		if day.DepartAt != nil && to.HasCoordinates() {
+			if sunset, ok := geo.Sunset(stopPoint(to), *day.DepartAt); ok {
+				day.Sunset = &sunset
+			}
+		}
+
+		day.Check(rules)
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// route fills in whichever of the day's distance and driving time is
+// missing from the routing API, leaving the day as it is when there is no
+// API, the stops have no coordinates, or the lookup fails.
+func (s *RouteLegService) route(ctx context.Context, from, to domain.Stop, day *domain.DriveDay) {
+	if day.DistanceMiles == nil && day.DurationMinutes == nil {
+		day.Source = domain.DriveUnknown
+	}
+	if s.routes == nil || !from.HasCoordinates() || !to.HasCoordinates() {
+		return
+	}
+	r, err := s.routes.Route(ctx, stopPoint(from), stopPoint(to))
+	if err != nil {
+		return
+	}
+	if day.DistanceMiles == nil {
+		miles := math.Round(r.Meters/metersPerMile*10) / 10
+		day.DistanceMiles = &miles
+	}
+	if day.DurationMinutes == nil {
+		minutes := int(math.Round(r.Duration.Minutes()))
+		day.DurationMinutes = &minutes
+	}
+	day.Source = domain.DriveRouted
+}
+
+// TripStats returns the trip's stop count and its legs' summed distance and
+// driving time. Returns domain.ErrNotFound if the trip does not exist.
+func (s *RouteLegService) TripStats(ctx context.Context, tripID uuid.UUID) (domain.TripStats, error) {
+	legs, stops, err := s.sync(ctx, tripID)
+	if err != nil {
+		return domain.TripStats{}, fmt.Errorf("service.RouteLegService.TripStats: %w", err)
+	}
+	return domain.SumRouteLegs(tripID, len(stops), legs), nil
+}
+
+// sync reconciles the trip's legs with its current stop order and returns
+// the legs along with the stops.
+func (s *RouteLegService) sync(ctx context.Context, tripID uuid.UUID) ([]domain.RouteLeg, []domain.Stop, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, nil, err
+	}
+	stops, err := s.stops.ListByTripID(ctx, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+	removed, err := s.legs.Sync(ctx, tripID, domain.ConsecutiveStopPairs(stops))
+	if err != nil {
+		return nil, nil, err
+	}
+	// Tracks of dropped legs are deleted on a best-effort basis: an orphaned
+	// file costs only disk space, while failing the read would hide the trip.
+	for _, key := range removed {
+		_ = s.tracks.Delete(ctx, key)
+	}
+	legs, err := s.legs.ListByTrip(ctx, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return legs, stops, nil
+}
@@ -0,0 +1,617 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/routing"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memRouteLegRepo is an in-memory repo.RouteLegRepo. Legs are kept in
+// insertion order, which Sync preserves as route order.
+type memRouteLegRepo struct {
+	legs []domain.RouteLeg
+}
+
+func (m *memRouteLegRepo) Sync(_ context.Context, tripID uuid.UUID, pairs []domain.StopPair) ([]string, error) {
+	existing := map[domain.StopPair]domain.RouteLeg{}
+	var other []domain.RouteLeg
+	for _, l := range m.legs {
+		if l.TripID == tripID {
+			existing[domain.StopPair{From: l.FromStopID, To: l.ToStopID}] = l
+		} else {
+			other = append(other, l)
+		}
+	}
+	for _, p := range pairs {
+		l, ok := existing[p]
+		if !ok {
+			l = domain.RouteLeg{ID: uuid.New(), TripID: tripID, FromStopID: p.From, ToStopID: p.To}
+		}
+		delete(existing, p)
+		other = append(other, l)
+	}
+	var removed []string
+	for _, l := range existing {
+		if l.TrackKey != "" {
+			removed = append(removed, l.TrackKey)
+		}
+	}
+	m.legs = other
+	return removed, nil
+}
+func (m *memRouteLegRepo) GetByID(_ context.Context, tripID, id uuid.UUID) (domain.RouteLeg, error) {
+	for _, l := range m.legs {
+		if l.ID == id && l.TripID == tripID {
+			return l, nil
+		}
+	}
+	return domain.RouteLeg{}, domain.ErrNotFound
+}
+func (m *memRouteLegRepo) ListByTrip(_ context.Context, tripID uuid.UUID) ([]domain.RouteLeg, error) {
+	out := []domain.RouteLeg{}
+	for _, l := range m.legs {
+		if l.TripID == tripID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+func (m *memRouteLegRepo) Update(_ context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	for i := range m.legs {
+		if m.legs[i].ID == leg.ID && m.legs[i].TripID == leg.TripID {
+			m.legs[i].DistanceMiles = leg.DistanceMiles
+			m.legs[i].DurationMinutes = leg.DurationMinutes
+			if m.legs[i].Polyline != leg.Polyline {
+				m.legs[i].Elevation = nil
+			}
+			m.legs[i].Polyline = leg.Polyline
+			return m.legs[i], nil
+		}
+	}
+	return domain.RouteLeg{}, domain.ErrNotFound
+}
+func (m *memRouteLegRepo) AttachTrack(_ context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	for i := range m.legs {
+		if m.legs[i].ID == leg.ID && m.legs[i].TripID == leg.TripID {
+			now := time.Now()
+			leg.TrackUploadedAt = &now
+			if m.legs[i].Polyline != leg.Polyline {
+				leg.Elevation = nil
+			}
+			m.legs[i] = leg
+			return leg, nil
+		}
+	}
+	return domain.RouteLeg{}, domain.ErrNotFound
+}
+
+func (m *memRouteLegRepo) SetElevation(_ context.Context, leg domain.RouteLeg) (domain.RouteLeg, error) {
+	for i := range m.legs {
+		if m.legs[i].ID == leg.ID && m.legs[i].TripID == leg.TripID && m.legs[i].Polyline == leg.Polyline {
+			p := *leg.Elevation
+			p.FetchedAt = time.Now()
+			m.legs[i].Elevation = &p
+			return m.legs[i], nil
+		}
+	}
+	return domain.RouteLeg{}, domain.ErrNotFound
+}
+
+var _ repo.RouteLegRepo = (*memRouteLegRepo)(nil)
+
+// fakeElevations reports each point's elevation as its latitude times 1000
+// meters, so a route heading north climbs.
+type fakeElevations struct {
+	calls  int
+	points int
+	err    error
+}
+
+func (f *fakeElevations) Elevations(_ context.Context, pts []geo.Point) ([]float64, error) {
+	f.calls++
+	f.points = len(pts)
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := make([]float64, len(pts))
+	for i, p := range pts {
+		out[i] = p.Lat * 1000
+	}
+	return out, nil
+}
+
+// fakeRouter answers every lookup with route, or err when set.
+type fakeRouter struct {
+	route routing.Route
+	err   error
+	calls int
+}
+
+func (f *fakeRouter) Route(_ context.Context, _, _ geo.Point) (routing.Route, error) {
+	f.calls++
+	return f.route, f.err
+}
+
+type routeFixture struct {
+	svc        *service.RouteLegService
+	legs       *memRouteLegRepo
+	tracks     *objectstore.Memory
+	elevations *fakeElevations
+	routes     *fakeRouter
+	tripID     uuid.UUID
+	stops      []domain.Stop
+}
+
+func newRouteFixture(stopCount int) *routeFixture {
+	f := &routeFixture{legs: &memRouteLegRepo{}, tracks: objectstore.NewMemory(), elevations: &fakeElevations{}, routes: &fakeRouter{}, tripID: uuid.New()}
+	for i := 0; i < stopCount; i++ {
+		f.stops = append(f.stops, domain.Stop{ID: uuid.New(), TripID: f.tripID})
+	}
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != f.tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: id}, nil
+		},
+	}
+	stops := &mockStopRepo{
+		listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
+			return f.stops, nil
+		},
+	}
+	f.svc = service.NewRouteLegService(trips, stops, f.legs, f.tracks, f.elevations, f.routes, nil)
+	return f
+}
+
+func TestRouteLegService_ListByTrip_CreatesLegBetweenConsecutiveStops(t *testing.T) {
+	f := newRouteFixture(3)
+
+	legs, err := f.svc.ListByTrip(context.Background(), f.tripID)
+
+	require.NoError(t, err)
+	require.Len(t, legs, 2)
+	assert.Equal(t, f.stops[0].ID, legs[0].FromStopID)
+	assert.Equal(t, f.stops[1].ID, legs[0].ToStopID)
+	assert.Equal(t, f.stops[1].ID, legs[1].FromStopID)
+	assert.Equal(t, f.stops[2].ID, legs[1].ToStopID)
+}
+
+func TestRouteLegService_ListByTrip_SingleStopHasNoLegs(t *testing.T) {
+	f := newRouteFixture(1)
+
+	legs, err := f.svc.ListByTrip(context.Background(), f.tripID)
+
+	require.NoError(t, err)
+	assert.Empty(t, legs)
+}
+
+func TestRouteLegService_ListByTrip_FollowsStopChanges(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(3)
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+
+	first := legs[0]
+	first.DistanceMiles = num(212.5)
+	_, err = f.svc.Update(ctx, first)
+	require.NoError(t, err)
+
+	// The last stop is replaced: the first leg keeps its edit, the second is
+	// rebuilt to the new stop.
+	f.stops = append(f.stops[:2], domain.Stop{ID: uuid.New(), TripID: f.tripID})
+
+	legs, err = f.svc.ListByTrip(ctx, f.tripID)
+
+	require.NoError(t, err)
+	require.Len(t, legs, 2)
+	assert.Equal(t, first.ID, legs[0].ID)
+	require.NotNil(t, legs[0].DistanceMiles)
+	assert.InDelta(t, 212.5, *legs[0].DistanceMiles, 0.001)
+	assert.Equal(t, f.stops[2].ID, legs[1].ToStopID)
+	assert.Nil(t, legs[1].DistanceMiles)
+}
+
+func TestRouteLegService_ListByTrip_UnknownTrip(t *testing.T) {
+	f := newRouteFixture(2)
+
+	_, err := f.svc.ListByTrip(context.Background(), uuid.New())
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+	assert.Empty(t, f.legs.legs)
+}
+
+func TestRouteLegService_Update_Validation(t *testing.T) {
+	minutes := func(n int) *int { return &n }
+	tests := []struct {
+		name   string
+		mutate func(l *domain.RouteLeg)
+	}{
+		{"negative distance", func(l *domain.RouteLeg) { l.DistanceMiles = num(-1) }},
+		{"negative duration", func(l *domain.RouteLeg) { l.DurationMinutes = minutes(-5) }},
+		{"polyline with spaces", func(l *domain.RouteLeg) { l.Polyline = "_p~iF ~ps|U" }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newRouteFixture(2)
+			legs, err := f.svc.ListByTrip(context.Background(), f.tripID)
+			require.NoError(t, err)
+			leg := legs[0]
+			tc.mutate(&leg)
+
+			_, err = f.svc.Update(context.Background(), leg)
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+		})
+	}
+}
+
+func TestRouteLegService_Update_UnknownLeg(t *testing.T) {
+	f := newRouteFixture(2)
+
+	_, err := f.svc.Update(context.Background(), domain.RouteLeg{ID: uuid.New(), TripID: f.tripID})
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestRouteLegService_TripStats_SumsLegs(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(4)
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+
+	hours := func(h int) *int { m := h * 60; return &m }
+	legs[0].DistanceMiles, legs[0].DurationMinutes = num(180), hours(3)
+	legs[1].DistanceMiles, legs[1].DurationMinutes = num(95.5), hours(2)
+	for _, l := range legs[:2] {
+		_, err := f.svc.Update(ctx, l)
+		require.NoError(t, err)
+	}
+
+	st, err := f.svc.TripStats(ctx, f.tripID)
+
+	require.NoError(t, err)
+	assert.Equal(t, f.tripID, st.TripID)
+	assert.Equal(t, 4, st.Stops)
+	assert.Equal(t, 3, st.Legs)
+	assert.Equal(t, 1, st.LegsMissingDistance)
+	assert.InDelta(t, 275.5, st.TotalDistanceMiles, 0.001)
+	assert.Equal(t, 300, st.TotalDurationMinutes)
+}
+
+// straightTrack is a GPX track of n points along a parallel, one minute
+// apart. Every point but the ends lies on a straight line.
+func straightTrack(n int) string {
+	var b strings.Builder
+	b.WriteString(`<gpx xmlns="http://www.topografix.com/GPX/1/1"><trk><trkseg>`)
+	start := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		b.WriteString(`<trkpt lat="45" lon="` + strconv.FormatFloat(-110+float64(i)*0.01, 'f', 4, 64) + `">`)
+		b.WriteString(`<time>` + start.Add(time.Duration(i)*time.Minute).Format(time.RFC3339) + `</time></trkpt>`)
+	}
+	b.WriteString(`</trkseg></trk></gpx>`)
+	return b.String()
+}
+
+func TestRouteLegService_UploadTrack(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+	doc := straightTrack(11)
+
+	got, err := f.svc.UploadTrack(ctx, f.tripID, legs[0].ID, strings.NewReader(doc))
+
+	require.NoError(t, err)
+	assert.True(t, got.HasTrack())
+	assert.Equal(t, 11, got.TrackPoints)
+	require.NotNil(t, got.DistanceMiles)
+	assert.InDelta(t, 4.9, *got.DistanceMiles, 0.05) // 0.1° of longitude at 45°N
+	require.NotNil(t, got.DurationMinutes)
+	assert.Equal(t, 10, *got.DurationMinutes)
+
+	pts, err := geo.DecodePolyline(got.Polyline)
+	require.NoError(t, err)
+	assert.Len(t, pts, 2, "a straight track simplifies to its end points")
+
+	rc, err := f.svc.Track(ctx, f.tripID, legs[0].ID)
+	require.NoError(t, err)
+	defer rc.Close()
+	stored, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, doc, string(stored))
+}
+
+func TestRouteLegService_UploadTrack_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not gpx":      `{}`,
+		"single point": straightTrack(1),
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newRouteFixture(2)
+			legs, err := f.svc.ListByTrip(ctx, f.tripID)
+			require.NoError(t, err)
+
+			_, err = f.svc.UploadTrack(ctx, f.tripID, legs[0].ID, strings.NewReader(doc))
+
+			assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+			assert.Zero(t, f.tracks.Len())
+		})
+	}
+}
+
+func TestRouteLegService_UploadTrack_UnknownLeg(t *testing.T) {
+	f := newRouteFixture(2)
+
+	_, err := f.svc.UploadTrack(context.Background(), f.tripID, uuid.New(), strings.NewReader(straightTrack(3)))
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestRouteLegService_Track_NoneUploaded(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+
+	_, err = f.svc.Track(ctx, f.tripID, legs[0].ID)
+
+	assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+}
+
+func TestRouteLegService_ListByTrip_DeletesTracksOfDroppedLegs(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+	_, err = f.svc.UploadTrack(ctx, f.tripID, legs[0].ID, strings.NewReader(straightTrack(3)))
+	require.NoError(t, err)
+	require.Equal(t, 1, f.tracks.Len())
+
+	f.stops = f.stops[:1]
+	_, err = f.svc.ListByTrip(ctx, f.tripID)
+
+	require.NoError(t, err)
+	assert.Zero(t, f.tracks.Len())
+}
+
+// withPolyline gives the fixture's first leg a route due north from 45°N to
+// 45.1°N, about 11 km, and returns it.
+func (f *routeFixture) withPolyline(t *testing.T) domain.RouteLeg {
+	t.Helper()
+	ctx := context.Background()
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+	leg := legs[0]
+	leg.Polyline = geo.EncodePolyline([]geo.Point{{Lat: 45, Lon: -110}, {Lat: 45.1, Lon: -110}})
+	leg, err = f.svc.Update(ctx, leg)
+	require.NoError(t, err)
+	return leg
+}
+
+func TestRouteLegService_Elevation_LooksUpOnce(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	leg := f.withPolyline(t)
+
+	got, err := f.svc.Elevation(ctx, f.tripID, leg.ID)
+
+	require.NoError(t, err)
+	require.NotNil(t, got.Elevation)
+	p := got.Elevation
+	assert.Len(t, p.Meters, 57, "one sample every 200 m or so")
+	assert.InDelta(t, 11119.0/56, p.SpacingMeters, 1)
+	assert.InDelta(t, 45000, p.Meters[0], 1e-6)
+	assert.InDelta(t, 45100, p.Meters[len(p.Meters)-1], 1e-6)
+	ascent, descent := p.Climb()
+	assert.InDelta(t, 100, ascent, 1e-6)
+	assert.Zero(t, descent)
+	assert.False(t, p.FetchedAt.IsZero())
+
+	_, err = f.svc.Elevation(ctx, f.tripID, leg.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.elevations.calls, "stored after the first lookup")
+}
+
+func TestRouteLegService_Elevation_CapsSamples(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+	legs[0].Polyline = geo.EncodePolyline([]geo.Point{{Lat: 39, Lon: -105}, {Lat: 41, Lon: -112}})
+	_, err = f.svc.Update(ctx, legs[0])
+	require.NoError(t, err)
+
+	got, err := f.svc.Elevation(ctx, f.tripID, legs[0].ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 300, f.elevations.points)
+	assert.Len(t, got.Elevation.Meters, 300)
+}
+
+func TestRouteLegService_Elevation_ClearedWhenPolylineChanges(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	leg := f.withPolyline(t)
+	_, err := f.svc.Elevation(ctx, f.tripID, leg.ID)
+	require.NoError(t, err)
+
+	leg.DistanceMiles = num(7)
+	kept, err := f.svc.Update(ctx, leg)
+	require.NoError(t, err)
+	assert.NotNil(t, kept.Elevation, "same polyline keeps the profile")
+
+	leg.Polyline = geo.EncodePolyline([]geo.Point{{Lat: 45, Lon: -110}, {Lat: 45, Lon: -110.1}})
+	cleared, err := f.svc.Update(ctx, leg)
+	require.NoError(t, err)
+	assert.Nil(t, cleared.Elevation)
+
+	got, err := f.svc.Elevation(ctx, f.tripID, leg.ID)
+	require.NoError(t, err)
+	assert.InDelta(t, 45000, got.Elevation.Meters[len(got.Elevation.Meters)-1], 1e-6, "sampled along the new route")
+	assert.Equal(t, 2, f.elevations.calls)
+}
+
+func TestRouteLegService_Elevation_Unavailable(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no polyline", func(t *testing.T) {
+		f := newRouteFixture(2)
+		legs, err := f.svc.ListByTrip(ctx, f.tripID)
+		require.NoError(t, err)
+
+		_, err = f.svc.Elevation(ctx, f.tripID, legs[0].ID)
+
+		assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+		assert.Zero(t, f.elevations.calls)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		f := newRouteFixture(2)
+		leg := f.withPolyline(t)
+		svc := service.NewRouteLegService(&mockTripRepo{}, &mockStopRepo{}, f.legs, f.tracks, nil, nil, nil)
+
+		_, err := svc.Elevation(ctx, f.tripID, leg.ID)
+
+		assert.True(t, errors.Is(err, domain.ErrValidation), "got %v", err)
+	})
+
+	t.Run("lookup fails", func(t *testing.T) {
+		f := newRouteFixture(2)
+		leg := f.withPolyline(t)
+		f.elevations.err = errors.New("upstream down")
+
+		_, err := f.svc.Elevation(ctx, f.tripID, leg.ID)
+
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, domain.ErrValidation))
+		stored, err := f.legs.GetByID(ctx, f.tripID, leg.ID)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Elevation)
+	})
+
+	t.Run("unknown leg", func(t *testing.T) {
+		f := newRouteFixture(2)
+
+		_, err := f.svc.Elevation(ctx, f.tripID, uuid.New())
+
+		assert.True(t, errors.Is(err, domain.ErrNotFound), "got %v", err)
+	})
+}
+
+func TestRouteLegService_CheckDrives(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(5)
+	at := func(lat, lon float64) (*float64, *float64) { return &lat, &lon }
+	// Reached: Golden, then Denver. Planned: Moab, Salt Lake City, and a
+	// stop with no coordinates.
+	f.stops[0].Latitude, f.stops[0].Longitude = at(39.7555, -105.2211)
+	f.stops[1].Latitude, f.stops[1].Longitude = at(39.7392, -104.9903)
+	f.stops[2].Latitude, f.stops[2].Longitude = at(38.5733, -109.5498)
+	f.stops[3].Latitude, f.stops[3].Longitude = at(40.7608, -111.8910)
+	for i := 2; i < 5; i++ {
+		f.stops[i].Planned = true
+	}
+	legs, err := f.svc.ListByTrip(ctx, f.tripID)
+	require.NoError(t, err)
+	minutes := 330
+	legs[1].DistanceMiles, legs[1].DurationMinutes = num(354), &minutes
+	_, err = f.svc.Update(ctx, legs[1])
+	require.NoError(t, err)
+	f.routes.route = routing.Route{Meters: 370 * 1609.344, Duration: 13 * time.Hour}
+
+	depart := time.Date(2025, 6, 21, 9, 0, 0, 0, time.FixedZone("MDT", -6*60*60))
+	days, err := f.svc.CheckDrives(ctx, f.tripID, domain.DriveRules{
+		MaxMiles:         300,
+		MaxDriveTime:     6 * time.Hour,
+		ArriveBeforeDark: true,
+		DepartAt:         &depart,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, days, 3, "only drives to planned stops")
+
+	denverMoab := days[0]
+	assert.Equal(t, legs[1].ID, denverMoab.LegID)
+	assert.Equal(t, domain.DriveEntered, denverMoab.Source)
+	assert.True(t, depart.Equal(*denverMoab.DepartAt))
+	assert.True(t, depart.Add(330*time.Minute).Equal(*denverMoab.ArriveAt))
+	require.NotNil(t, denverMoab.Sunset)
+	require.Len(t, denverMoab.Violations, 1)
+	assert.Equal(t, domain.RuleMaxMiles, denverMoab.Violations[0].Rule)
+	assert.Equal(t, "354 miles is over the 300-mile limit", denverMoab.Violations[0].Message)
+	assert.Empty(t, denverMoab.Unchecked)
+
+	moabSLC := days[1]
+	assert.Equal(t, domain.DriveRouted, moabSLC.Source)
+	assert.InDelta(t, 370, *moabSLC.DistanceMiles, 0.01)
+	assert.Equal(t, 780, *moabSLC.DurationMinutes)
+	assert.True(t, depart.AddDate(0, 0, 1).Equal(*moabSLC.DepartAt), "the next day at the same time")
+	rules := make([]domain.DriveRule, len(moabSLC.Violations))
+	for i, v := range moabSLC.Violations {
+		rules[i] = v.Rule
+	}
+	assert.Equal(t, []domain.DriveRule{domain.RuleMaxMiles, domain.RuleMaxDriveHours, domain.RuleArriveBeforeDark}, rules)
+	assert.Contains(t, moabSLC.Violations[2].Message, "after sunset")
+
+	unknown := days[2]
+	assert.Equal(t, domain.DriveUnknown, unknown.Source)
+	assert.Empty(t, unknown.Violations)
+	assert.Equal(t, []domain.DriveRule{domain.RuleMaxMiles, domain.RuleMaxDriveHours, domain.RuleArriveBeforeDark}, unknown.Unchecked)
+	assert.Equal(t, 1, f.routes.calls, "no lookup without coordinates")
+}
+
+func TestRouteLegService_CheckDrives_LoggedDepartureAndFailedLookup(t *testing.T) {
+	ctx := context.Background()
+	f := newRouteFixture(2)
+	lat, lon := 38.5733, -109.5498
+	f.stops[0].Latitude, f.stops[0].Longitude = &lat, &lon
+	f.stops[1].Latitude, f.stops[1].Longitude = &lat, &lon
+	departed := time.Date(2025, 6, 21, 14, 0, 0, 0, time.UTC)
+	f.stops[0].DepartedAt = &departed
+	f.stops[1].Planned = true
+	f.routes.err = routing.ErrNoRoute
+
+	days, err := f.svc.CheckDrives(ctx, f.tripID, domain.DriveRules{MaxMiles: 250, ArriveBeforeDark: true})
+
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, &departed, days[0].DepartAt, "the logged departure")
+	assert.Equal(t, domain.DriveUnknown, days[0].Source)
+	assert.Equal(t, []domain.DriveRule{domain.RuleMaxMiles, domain.RuleArriveBeforeDark}, days[0].Unchecked)
+}
+
+func TestRouteLegService_CheckDrives_Validation(t *testing.T) {
+	f := newRouteFixture(2)
+
+	for name, rules := range map[string]domain.DriveRules{
+		"no rules":         {},
+		"negative miles":   {MaxMiles: -1},
+		"negative driving": {MaxDriveTime: -time.Hour, ArriveBeforeDark: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := f.svc.CheckDrives(context.Background(), f.tripID, rules)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+
+	_, err := f.svc.CheckDrives(context.Background(), uuid.New(), domain.DriveRules{MaxMiles: 300})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+const (
+	// DefaultShareTTL is how long a share link lives when the caller does not
+	// ask for a specific lifetime.
+	DefaultShareTTL = 7 * 24 * time.Hour
+
+	// MaxShareTTL caps share link lifetime so a forgotten link cannot stay
+	// valid indefinitely.
+	MaxShareTTL = 90 * 24 * time.Hour
+
+	// shareAudience scopes share tokens so no other token signed with the same
+	// keys (e.g. a login token) can be presented as a share link, and vice versa.
+	shareAudience = "trip-share"
+)
+
+// TokenSigner signs and verifies JWTs. *auth.KeySet satisfies it.
+type TokenSigner interface {
+	Sign(claims jwt.Claims) (string, error)
+	Parse(tokenString string, claims jwt.Claims) error
+}
+
+// ShareService issues, lists, revokes, and resolves trip share links.
+//
+// A share link is a signed token embedding the share ID, the trip ID, and the
+// expiry, so expiry is enforced without a database lookup. The trip_shares
+// table is the revocation list: every token is also checked against its row,
+// which lets the owner kill a link before it expires.
+type ShareService struct {
+	trips  repo.TripRepo
+	stops  repo.StopRepo
+	shares repo.ShareRepo
+	signer TokenSigner
+	clock  domain.Clock
+}
+
+// NewShareService constructs a ShareService. Pass domain.SystemClock in
+// production; expiry and revocation times are read from clock.
+func NewShareService(trips repo.TripRepo, stops repo.StopRepo, shares repo.ShareRepo, signer TokenSigner, clock domain.Clock) *ShareService {
+	return &ShareService{trips: trips, stops: stops, shares: shares, signer: signer, clock: clock}
+}
+
+// Create issues a new share link for the trip, valid for ttl.
+// The returned Share carries the signed Token; it is not stored and cannot be
+// retrieved again. Returns domain.ErrNotFound if the trip does not exist,
+// domain.ErrForbidden if the request's user does not own it, and
+// domain.ErrValidation if ttl is not positive or exceeds MaxShareTTL.
+func (s *ShareService) Create(ctx context.Context, tripID uuid.UUID, ttl time.Duration) (domain.Share, error) {
+	if ttl <= 0 {
+		return domain.Share{}, fmt.Errorf("%w: expires_in_hours must be positive", domain.ErrValidation)
+	}
+	if ttl > MaxShareTTL {
+		return domain.Share{}, fmt.Errorf("%w: expires_in_hours must not exceed %d", domain.ErrValidation, int(MaxShareTTL.Hours()))
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return domain.Share{}, fmt.Errorf("service.ShareService.Create: %w", err)
+	}
+
+	// JWT expiry has second precision; truncate so the token and the row agree.
+	expiresAt := s.clock.Now().Add(ttl).Truncate(time.Second)
+	share, err := s.shares.Create(ctx, domain.Share{TripID: tripID, ExpiresAt: expiresAt})
+	if err != nil {
+		return domain.Share{}, fmt.Errorf("service.ShareService.Create: %w", err)
+	}
+
+	token, err := s.signer.Sign(jwt.RegisteredClaims{
+		ID:        share.ID.String(),
+		Subject:   tripID.String(),
+		Audience:  jwt.ClaimStrings{shareAudience},
+		IssuedAt:  jwt.NewNumericDate(share.CreatedAt),
+		ExpiresAt: jwt.NewNumericDate(share.ExpiresAt),
+	})
+	if err != nil {
+		return domain.Share{}, fmt.Errorf("service.ShareService.Create: sign: %w", err)
+	}
+	share.Token = token
+	return share, nil
+}
+
+// List returns the trip's active (unrevoked, unexpired) share links.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *ShareService) List(ctx context.Context, tripID uuid.UUID) ([]domain.Share, error) {
+	if _, err := s.trips.GetByID(ctx, tripID); err != nil {
+		return nil, fmt.Errorf("service.ShareService.List: %w", err)
+	}
+	shares, err := s.shares.ListActiveByTripID(ctx, tripID, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("service.ShareService.List: %w", err)
+	}
+	if shares == nil {
+		return []domain.Share{}, nil
+	}
+	return shares, nil
+}
+
+// Revoke invalidates a share link immediately.
+// Returns domain.ErrNotFound if the share does not exist, belongs to another
+// trip, or was already revoked, and domain.ErrForbidden if the request's
+// user does not own the trip.
+func (s *ShareService) Revoke(ctx context.Context, tripID, shareID uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleOwner); err != nil {
+		return fmt.Errorf("service.ShareService.Revoke: %w", err)
+	}
+	if err := s.shares.Revoke(ctx, tripID, shareID, s.clock.Now()); err != nil {
+		return fmt.Errorf("service.ShareService.Revoke: %w", err)
+	}
+	return nil
+}
+
+// Resolve verifies a share token and returns the read-only trip view it grants.
+//
+// Every failure — bad signature, expired token, unknown or revoked share —
+// is reported as domain.ErrNotFound so callers cannot probe which links exist.
+func (s *ShareService) Resolve(ctx context.Context, token string) (domain.SharedTrip, error) {
+	var claims jwt.RegisteredClaims
+	if err := s.signer.Parse(token, &claims); err != nil {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: %w", domain.ErrNotFound)
+	}
+	if !slices.Contains(claims.Audience, shareAudience) {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: wrong audience: %w", domain.ErrNotFound)
+	}
+	shareID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: %w", domain.ErrNotFound)
+	}
+
+	share, err := s.shares.GetByID(ctx, shareID)
+	if err != nil {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: %w", err)
+	}
+	if share.TripID.String() != claims.Subject || !share.Active(s.clock.Now()) {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: share inactive: %w", domain.ErrNotFound)
+	}
+
+	// The reader belongs to no organization; the share says which to read,
+	// and whose trip it is.
+	ctx = domain.WithOrganization(ctx, share.OrganizationID)
+	if share.OwnerID != nil {
+		ctx = domain.WithUser(ctx, *share.OwnerID)
+	}
+
+	trip, err := s.trips.GetByID(ctx, share.TripID)
+	if err != nil {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: %w", err)
+	}
+	stops, err := s.stops.ListByTripID(ctx, share.TripID)
+	if err != nil {
+		return domain.SharedTrip{}, fmt.Errorf("service.ShareService.Resolve: %w", err)
+	}
+	if stops == nil {
+		stops = []domain.Stop{}
+	}
+	return domain.SharedTrip{Trip: trip, Stops: stops, ExpiresAt: share.ExpiresAt}, nil
+}
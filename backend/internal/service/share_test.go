@@ -0,0 +1,237 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/auth"
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memShareRepo is an in-memory repo.ShareRepo. Shares are simple enough that
+// a working fake reads better than per-method function fields.
+type memShareRepo struct {
+	rows map[uuid.UUID]domain.Share
+}
+
+func newMemShareRepo() *memShareRepo {
+	return &memShareRepo{rows: map[uuid.UUID]domain.Share{}}
+}
+
+func (m *memShareRepo) Create(_ context.Context, s domain.Share) (domain.Share, error) {
+	s.ID = uuid.New()
+	s.CreatedAt = time.Now()
+	m.rows[s.ID] = s
+	return s, nil
+}
+func (m *memShareRepo) GetByID(_ context.Context, id uuid.UUID) (domain.Share, error) {
+	s, ok := m.rows[id]
+	if !ok {
+		return domain.Share{}, domain.ErrNotFound
+	}
+	return s, nil
+}
+func (m *memShareRepo) ListActiveByTripID(_ context.Context, tripID uuid.UUID, now time.Time) ([]domain.Share, error) {
+	var out []domain.Share
+	for _, s := range m.rows {
+		if s.TripID == tripID && s.Active(now) {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+func (m *memShareRepo) Revoke(_ context.Context, tripID, shareID uuid.UUID, now time.Time) error {
+	s, ok := m.rows[shareID]
+	if !ok || s.TripID != tripID || s.RevokedAt != nil {
+		return domain.ErrNotFound
+	}
+	s.RevokedAt = &now
+	m.rows[shareID] = s
+	return nil
+}
+
+var _ repo.ShareRepo = (*memShareRepo)(nil)
+
+func testKeySet(t *testing.T) *auth.KeySet {
+	t.Helper()
+	ks, err := auth.NewKeySet("k1", map[string][]byte{"k1": bytes.Repeat([]byte("s"), auth.MinKeyBytes)})
+	require.NoError(t, err)
+	return ks
+}
+
+// fakeClock is a settable domain.Clock. Tests advance it instead of sleeping.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+var _ domain.Clock = (*fakeClock)(nil)
+
+// newShareService returns a ShareService over one existing trip, running on a
+// fake clock. The clock starts at the current second because token signatures
+// are still checked against the wall clock by auth.KeySet.
+func newShareService(t *testing.T) (*service.ShareService, *memShareRepo, *auth.KeySet, uuid.UUID) {
+	svc, shares, ks, tripID, _ := newShareServiceWithClock(t)
+	return svc, shares, ks, tripID
+}
+
+func newShareServiceWithClock(t *testing.T) (*service.ShareService, *memShareRepo, *auth.KeySet, uuid.UUID, *fakeClock) {
+	t.Helper()
+	tripID := uuid.New()
+	trips := &mockTripRepo{
+		getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			if id != tripID {
+				return domain.Trip{}, domain.ErrNotFound
+			}
+			return domain.Trip{ID: tripID, Name: "Summer Tour"}, nil
+		},
+	}
+	stops := &mockStopRepo{
+		listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
+			return []domain.Stop{{Name: "Moab"}}, nil
+		},
+	}
+	shares := newMemShareRepo()
+	ks := testKeySet(t)
+	clock := &fakeClock{now: time.Now().Truncate(time.Second)}
+	return service.NewShareService(trips, stops, shares, ks, clock), shares, ks, tripID, clock
+}
+
+func TestShareService_CreateAndResolve(t *testing.T) {
+	svc, _, _, tripID, clock := newShareServiceWithClock(t)
+	ctx := context.Background()
+
+	share, err := svc.Create(ctx, tripID, time.Hour)
+	require.NoError(t, err)
+	require.NotEmpty(t, share.Token)
+	assert.Equal(t, clock.Now().Add(time.Hour), share.ExpiresAt)
+
+	shared, err := svc.Resolve(ctx, share.Token)
+	require.NoError(t, err)
+	assert.Equal(t, tripID, shared.Trip.ID)
+	assert.Len(t, shared.Stops, 1)
+	assert.True(t, shared.ExpiresAt.Equal(share.ExpiresAt))
+}
+
+func TestShareService_Create_Validation(t *testing.T) {
+	svc, _, _, tripID := newShareService(t)
+
+	for _, ttl := range []time.Duration{0, -time.Hour, service.MaxShareTTL + time.Hour} {
+		_, err := svc.Create(context.Background(), tripID, ttl)
+		assert.ErrorIs(t, err, domain.ErrValidation, "ttl %s", ttl)
+	}
+}
+
+func TestShareService_Create_TripNotFound(t *testing.T) {
+	svc, _, _, _ := newShareService(t)
+
+	_, err := svc.Create(context.Background(), uuid.New(), time.Hour)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// TestShareService_RevokedTokenRejected verifies the revocation list is
+// consulted even though the token itself is still within its expiry.
+func TestShareService_RevokedTokenRejected(t *testing.T) {
+	svc, _, _, tripID := newShareService(t)
+	ctx := context.Background()
+	share, err := svc.Create(ctx, tripID, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Revoke(ctx, tripID, share.ID))
+
+	_, err = svc.Resolve(ctx, share.Token)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	active, err := svc.List(ctx, tripID)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+// TestShareService_ExpiresOnClock verifies a share stops resolving and drops
+// out of List once the service clock passes its expiry.
+func TestShareService_ExpiresOnClock(t *testing.T) {
+	svc, _, _, tripID, clock := newShareServiceWithClock(t)
+	ctx := context.Background()
+	share, err := svc.Create(ctx, tripID, time.Hour)
+	require.NoError(t, err)
+
+	clock.Advance(time.Hour - time.Second)
+	_, err = svc.Resolve(ctx, share.Token)
+	require.NoError(t, err, "one second before expiry the link still works")
+
+	clock.Advance(time.Second)
+	_, err = svc.Resolve(ctx, share.Token)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	active, err := svc.List(ctx, tripID)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+// TestShareService_ExpiredShareRejected verifies a share whose row has expired
+// is rejected even if the token claims a later expiry.
+func TestShareService_ExpiredShareRejected(t *testing.T) {
+	svc, shares, _, tripID := newShareService(t)
+	ctx := context.Background()
+	share, err := svc.Create(ctx, tripID, time.Hour)
+	require.NoError(t, err)
+
+	row := shares.rows[share.ID]
+	row.ExpiresAt = time.Now().Add(-time.Minute)
+	shares.rows[share.ID] = row
+
+	_, err = svc.Resolve(ctx, share.Token)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestShareService_Resolve_RejectsBadTokens(t *testing.T) {
+	svc, _, ks, tripID := newShareService(t)
+	future := jwt.NewNumericDate(time.Now().Add(time.Hour))
+
+	// Correctly signed, but not a share token (no share audience).
+	loginLike, err := ks.Sign(jwt.RegisteredClaims{Subject: "user-1", ExpiresAt: future})
+	require.NoError(t, err)
+
+	// Share audience but a share ID that was never issued.
+	unknown, err := ks.Sign(jwt.RegisteredClaims{
+		ID: uuid.NewString(), Subject: tripID.String(),
+		Audience: jwt.ClaimStrings{"trip-share"}, ExpiresAt: future,
+	})
+	require.NoError(t, err)
+
+	// Signed with a key the service does not trust.
+	otherKeys, err := auth.NewKeySet("x", map[string][]byte{"x": bytes.Repeat([]byte("o"), auth.MinKeyBytes)})
+	require.NoError(t, err)
+	forged, err := otherKeys.Sign(jwt.RegisteredClaims{Audience: jwt.ClaimStrings{"trip-share"}, ExpiresAt: future})
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{
+		"garbage":        "not-a-token",
+		"wrong audience": loginLike,
+		"unknown share":  unknown,
+		"forged":         forged,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.Resolve(context.Background(), token)
+			assert.ErrorIs(t, err, domain.ErrNotFound)
+		})
+	}
+}
+
+func TestShareService_Revoke_WrongTrip(t *testing.T) {
+	svc, _, _, tripID := newShareService(t)
+	ctx := context.Background()
+	share, err := svc.Create(ctx, tripID, time.Hour)
+	require.NoError(t, err)
+
+	err = svc.Revoke(ctx, uuid.New(), share.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
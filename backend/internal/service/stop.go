@@ -3,56 +3,114 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 )
 
 // StopService implements business logic for Stop operations.
 // It holds trips, stops, and tags repos because creating a stop requires
 // verifying the parent trip exists, and tag operations are scoped to a stop.
+// Stop metadata is validated against the custom field definitions in fields,
+// and the answers a new stop gives to a note template against noteTemplates.
 type StopService struct {
-	trips repo.TripRepo
-	stops repo.StopRepo
-	tags  repo.TagRepo
+	trips         repo.TripRepo
+	stops         repo.StopRepo
+	tags          repo.TagRepo
+	fields        repo.CustomFieldRepo
+	noteTemplates repo.NoteTemplateRepo
+	events        EventPublisher
+	clock         domain.Clock
 }
 
 // NewStopService constructs a StopService backed by the provided repos.
-func NewStopService(trips repo.TripRepo, stops repo.StopRepo, tags repo.TagRepo) *StopService {
-	return &StopService{trips: trips, stops: stops, tags: tags}
+// Stops created, updated, and deleted are published to events, which may be
+// nil. clock dates the arrival at a planned stop when none is given.
+func NewStopService(trips repo.TripRepo, stops repo.StopRepo, tags repo.TagRepo, fields repo.CustomFieldRepo, noteTemplates repo.NoteTemplateRepo, events EventPublisher, clock domain.Clock) *StopService {
+	return &StopService{trips: trips, stops: stops, tags: tags, fields: fields, noteTemplates: noteTemplates, events: events, clock: clock}
 }
 
 // Create validates the stop, verifies the parent trip exists, then persists.
+// Answers to a note template are written into the notes ahead of any
+// given (see applyNoteTemplate).
 // Returns domain.ErrValidation if input violates business rules.
 // Returns domain.ErrNotFound if the parent trip does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
 func (s *StopService) Create(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
-	if _, err := s.trips.GetByID(ctx, stop.TripID); err != nil {
+	if err := requireTripRole(ctx, s.trips, stop.TripID, domain.TripRoleEditor); err != nil {
 		return domain.Stop{}, fmt.Errorf("service.StopService.Create: %w", err)
 	}
 	if err := validateStop(stop); err != nil {
 		return domain.Stop{}, err
 	}
+	if err := s.checkOdometer(ctx, stop); err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Create: %w", err)
+	}
+	metadata, err := checkMetadata(ctx, s.fields, domain.CustomFieldStop, stop.Metadata)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Create: %w", err)
+	}
+	stop.Metadata = metadata
+	stop, err = applyNoteTemplate(ctx, s.noteTemplates, stop)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Create: %w", err)
+	}
 	result, err := s.stops.Create(ctx, stop)
 	if err != nil {
 		return domain.Stop{}, fmt.Errorf("service.StopService.Create: %w", err)
 	}
+	s.publish(ctx, domain.EventStopCreated, domain.StopEvent(result))
 	return result, nil
 }
 
-// GetByID returns a single stop by ID, scoped to the given tripID.
+// GetByID returns a single stop by ID, scoped to the given tripID, with its
+// Daylight worked out.
 // Returns domain.ErrNotFound if no stop with that ID exists under that trip.
 func (s *StopService) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
 	result, err := s.stops.GetByID(ctx, tripID, stopID)
 	if err != nil {
 		return domain.Stop{}, fmt.Errorf("service.StopService.GetByID: %w", err)
 	}
+	result.Daylight = daylight(result)
 	return result, nil
 }
 
-// ListByTripID returns all stops for a trip ordered by arrived_at ascending.
+// daylight returns the sunrise and sunset at a stop on each day from its
+// arrival to its departure, or on the day of arrival while it has not been
+// left. A stop without coordinates or an arrival has none.
+func daylight(st domain.Stop) []domain.SunDay {
+	if !st.HasCoordinates() || st.ArrivedAt.IsZero() {
+		return nil
+	}
+	p := stopPoint(st)
+	last := geo.SolarDay(p, st.ArrivedAt)
+	if st.DepartedAt != nil {
+		last = geo.SolarDay(p, *st.DepartedAt)
+	}
+	var days []domain.SunDay
+	// A solar day is 24 hours long everywhere, so stepping by 24 hours
+	// lands on each following day in turn.
+	for at := st.ArrivedAt; !geo.SolarDay(p, at).After(last) && len(days) < domain.MaxSunDays; at = at.Add(24 * time.Hour) {
+		day := domain.SunDay{Date: domain.DateOf(geo.SolarDay(p, at))}
+		if rise, ok := geo.Sunrise(p, at); ok {
+			day.Sunrise = &rise
+		}
+		if set, ok := geo.Sunset(p, at); ok {
+			day.Sunset = &set
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+// ListByTripID returns all stops for a trip ordered by arrived_at ascending,
+// with planned stops last.
 // Always returns a non-nil slice so callers can safely range over it.
 func (s *StopService) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
 	stops, err := s.stops.ListByTripID(ctx, tripID)
@@ -65,10 +123,37 @@ func (s *StopService) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]dom
 	return stops, nil
 }
 
+// ListByTripIDs returns the stops of each trip in tripIDs, fetched in a
+// single query. Every requested trip has an entry, empty if it has no stops.
+func (s *StopService) ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]domain.Stop, error) {
+	byTrip := make(map[uuid.UUID][]domain.Stop, len(tripIDs))
+	for _, id := range tripIDs {
+		byTrip[id] = []domain.Stop{}
+	}
+	if len(tripIDs) == 0 {
+		return byTrip, nil
+	}
+
+	stops, err := s.stops.ListByTripIDs(ctx, tripIDs)
+	if err != nil {
+		return nil, fmt.Errorf("service.StopService.ListByTripIDs: %w", err)
+	}
+	for _, st := range stops {
+		byTrip[st.TripID] = append(byTrip[st.TripID], st)
+	}
+	return byTrip, nil
+}
+
 // ListByTripIDPaged returns one page of stops for a trip and the total count.
-// The caller controls page and limit via domain.PaginationParams.
-func (s *StopService) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error) {
-	stops, total, err := s.stops.ListByTripIDPaged(ctx, tripID, p)
+// The caller controls page and limit via domain.PaginationParams. Each entry
+// in fields is a "key:value" custom field filter; only stops matching all of
+// them are listed. Returns domain.ErrValidation for a bad filter.
+func (s *StopService) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, fields []string, p domain.PaginationParams) ([]domain.Stop, int64, error) {
+	filter, err := metadataFilter(ctx, s.fields, domain.CustomFieldStop, fields)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service.StopService.ListByTripIDPaged: %w", err)
+	}
+	stops, total, err := s.stops.ListByTripIDPaged(ctx, tripID, filter, p)
 	if err != nil {
 		return nil, 0, fmt.Errorf("service.StopService.ListByTripIDPaged: %w", err)
 	}
@@ -78,33 +163,337 @@ func (s *StopService) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p
 	return stops, total, nil
 }
 
+// Radius search bounds, in kilometers. The default is about 50 miles.
+const (
+	DefaultNearbyRadiusKm = 80
+	MaxNearbyRadiusKm     = 1000
+)
+
+// maxNearbyStops caps how many stops one radius search returns.
+const maxNearbyStops = 200
+
+// Nearby returns the stops, from every trip, within radiusKm of the given
+// point, nearest first. A nil radiusKm searches DefaultNearbyRadiusKm.
+// Returns domain.ErrValidation for coordinates out of range or a radius that
+// is not positive or exceeds MaxNearbyRadiusKm.
+func (s *StopService) Nearby(ctx context.Context, lat, lon float64, radiusKm *float64) ([]domain.NearbyStop, error) {
+	if lat < -90 || lat > 90 {
+		return nil, fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+	}
+	if lon < -180 || lon > 180 {
+		return nil, fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrValidation)
+	}
+	radius := float64(DefaultNearbyRadiusKm)
+	if radiusKm != nil {
+		radius = *radiusKm
+	}
+	if radius <= 0 || radius > MaxNearbyRadiusKm {
+		return nil, fmt.Errorf("%w: radius_km must be greater than 0 and at most %d", domain.ErrValidation, MaxNearbyRadiusKm)
+	}
+
+	nearby, err := s.stops.ListNearby(ctx, lat, lon, radius, maxNearbyStops)
+	if err != nil {
+		return nil, fmt.Errorf("service.StopService.Nearby: %w", err)
+	}
+	if nearby == nil {
+		nearby = []domain.NearbyStop{}
+	}
+	return nearby, nil
+}
+
+// MaxClusterZoom is the deepest map zoom Clusters accepts, as in Leaflet and
+// most tile servers.
+const MaxClusterZoom = 22
+
+// Clusters groups the stops, from every trip, inside box into markers for a
+// map at the given zoom: one per stop at close zoom, fewer and larger as the
+// map zooms out. Longitudes outside -180..180, as a map scrolled past the
+// antimeridian reports them, are wrapped; a box 360 degrees or wider covers
+// every longitude.
+// Returns domain.ErrValidation for a zoom outside 0..MaxClusterZoom or
+// latitudes out of range or out of order.
+func (s *StopService) Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+	if zoom < 0 || zoom > MaxClusterZoom {
+		return nil, fmt.Errorf("%w: zoom must be between 0 and %d", domain.ErrValidation, MaxClusterZoom)
+	}
+	if box.South < -90 || box.North > 90 {
+		return nil, fmt.Errorf("%w: bbox latitudes must be between -90 and 90", domain.ErrValidation)
+	}
+	if box.South > box.North {
+		return nil, fmt.Errorf("%w: bbox south must not be north of north", domain.ErrValidation)
+	}
+	if box.East-box.West >= 360 {
+		box.West, box.East = -180, 180
+	} else {
+		box.West, box.East = wrapLongitude(box.West), wrapLongitude(box.East)
+	}
+
+	clusters, err := s.stops.Clusters(ctx, box, zoom)
+	if err != nil {
+		return nil, fmt.Errorf("service.StopService.Clusters: %w", err)
+	}
+	if clusters == nil {
+		clusters = []domain.StopCluster{}
+	}
+	return clusters, nil
+}
+
+// wrapLongitude brings a longitude outside -180..180 back into that range.
+func wrapLongitude(lon float64) float64 {
+	if lon >= -180 && lon <= 180 {
+		return lon
+	}
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
+
+// Heatmap grid sizes, in degrees. The default is about 11 km north to south.
+const (
+	DefaultHeatmapCellDegrees = 0.1
+	MinHeatmapCellDegrees     = 0.001
+	MaxHeatmapCellDegrees     = 10
+)
+
+// Heatmap returns the nights spent at stops, from every trip, summed over a
+// grid cellDegrees wide (DefaultHeatmapCellDegrees when nil), for one
+// calendar year or, when year is nil, all of them.
+// Returns domain.ErrValidation for a year outside 1..9999 or a cell size
+// outside MinHeatmapCellDegrees..MaxHeatmapCellDegrees.
+func (s *StopService) Heatmap(ctx context.Context, year *int, cellDegrees *float64) (domain.Heatmap, error) {
+	if year != nil && (*year < 1 || *year > 9999) {
+		return domain.Heatmap{}, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+	}
+	cell := DefaultHeatmapCellDegrees
+	if cellDegrees != nil {
+		cell = *cellDegrees
+	}
+	if cell < MinHeatmapCellDegrees || cell > MaxHeatmapCellDegrees {
+		return domain.Heatmap{}, fmt.Errorf("%w: cell must be between %g and %g degrees", domain.ErrValidation, MinHeatmapCellDegrees, float64(MaxHeatmapCellDegrees))
+	}
+	y := 0 // every year, as repo.StopRepo counts it
+	if year != nil {
+		y = *year
+	}
+
+	cells, err := s.stops.Heatmap(ctx, y, cell)
+	if err != nil {
+		return domain.Heatmap{}, fmt.Errorf("service.StopService.Heatmap: %w", err)
+	}
+	if cells == nil {
+		cells = []domain.HeatmapCell{}
+	}
+	return domain.Heatmap{CellDegrees: cell, Cells: cells}, nil
+}
+
+// Occupancy accounts for every night of a calendar year (UTC) — the current
+// year when year is nil — as spent at a stop, in a gap during a trip, or off
+// trip, so nights missing from the log stand out. Nights are counted up to
+// last night: the current year stops there, and a later year has none.
+// Returns domain.ErrValidation for a year outside 1..9999.
+func (s *StopService) Occupancy(ctx context.Context, year *int) (domain.Occupancy, error) {
+	today := domain.DateOf(s.clock.Now().UTC())
+	y := today.Year
+	if year != nil {
+		y = *year
+	}
+	if y < 1 || y > 9999 {
+		return domain.Occupancy{}, fmt.Errorf("%w: year must be between 1 and 9999", domain.ErrValidation)
+	}
+	first := domain.NewDate(y, time.January, 1)
+	last := domain.NewDate(y, time.December, 31)
+	if !today.After(last) {
+		last = today.AddDays(-1)
+	}
+
+	trips, err := s.trips.List(ctx)
+	if err != nil {
+		return domain.Occupancy{}, fmt.Errorf("service.StopService.Occupancy: %w", err)
+	}
+	inYear := []domain.Trip{}
+	ids := []uuid.UUID{}
+	for _, t := range trips {
+		if t.StartDate.After(last) || (t.EndDate != nil && t.EndDate.Before(first)) {
+			continue
+		}
+		inYear = append(inYear, t)
+		ids = append(ids, t.ID)
+	}
+	var stops []domain.Stop
+	if len(ids) > 0 {
+		if stops, err = s.stops.ListByTripIDs(ctx, ids); err != nil {
+			return domain.Occupancy{}, fmt.Errorf("service.StopService.Occupancy: %w", err)
+		}
+	}
+	return domain.Occupancy{Year: y, Spans: domain.AccountForNights(first, last, inYear, stops)}, nil
+}
+
+// Gaps returns the runs of nights within a trip that no stop accounts for,
+// so the stops missing from its log can be filled in. An open-ended or
+// ongoing trip is checked up to last night.
+// Returns domain.ErrNotFound if the trip does not exist.
+func (s *StopService) Gaps(ctx context.Context, tripID uuid.UUID) ([]domain.TripGap, error) {
+	trip, err := s.trips.GetByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.StopService.Gaps: %w", err)
+	}
+	stops, err := s.stops.ListByTripID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("service.StopService.Gaps: %w", err)
+	}
+	lastNight := domain.DateOf(s.clock.Now().UTC()).AddDays(-1)
+	return domain.TripGaps(trip, lastNight, stops), nil
+}
+
 // Update validates and persists changes to an existing stop.
 // Returns domain.ErrValidation for invalid input, domain.ErrNotFound if the
-// stop does not exist under the given trip.
+// stop does not exist under the given trip, and domain.ErrForbidden if the
+// request's user may only view the trip.
 func (s *StopService) Update(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
 	if err := validateStop(stop); err != nil {
 		return domain.Stop{}, err
 	}
+	if err := requireTripRole(ctx, s.trips, stop.TripID, domain.TripRoleEditor); err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Update: %w", err)
+	}
+	if err := s.checkOdometer(ctx, stop); err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Update: %w", err)
+	}
+	metadata, err := checkMetadata(ctx, s.fields, domain.CustomFieldStop, stop.Metadata)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Update: %w", err)
+	}
+	stop.Metadata = metadata
 	result, err := s.stops.Update(ctx, stop)
 	if err != nil {
 		return domain.Stop{}, fmt.Errorf("service.StopService.Update: %w", err)
 	}
+	s.publish(ctx, domain.EventStopUpdated, domain.StopEvent(result))
+	return result, nil
+}
+
+// Arrive marks a planned stop as reached at the given time, or now when at is
+// nil. Its other fields, metadata, and tags are left as they are.
+// Returns domain.ErrValidation if the stop is not planned, domain.ErrNotFound
+// if the stop does not exist under the given trip, and domain.ErrForbidden if
+// the request's user may only view the trip.
+func (s *StopService) Arrive(ctx context.Context, tripID, stopID uuid.UUID, at *time.Time) (domain.Stop, error) {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Arrive: %w", err)
+	}
+	stop, err := s.stops.GetByID(ctx, tripID, stopID)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Arrive: %w", err)
+	}
+	if !stop.Planned {
+		return domain.Stop{}, fmt.Errorf("%w: the stop is not planned; it was reached at %s", domain.ErrValidation, stop.ArrivedAt.Format(time.RFC3339))
+	}
+
+	stop.Planned = false
+	stop.ArrivedAt = s.clock.Now().UTC()
+	if at != nil {
+		stop.ArrivedAt = *at
+	}
+	if err := validateStop(stop); err != nil {
+		return domain.Stop{}, err
+	}
+	tags := stop.Tags
+	stop.Metadata = nil // keep the stop's metadata as it is
+	result, err := s.stops.Update(ctx, stop)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Arrive: %w", err)
+	}
+	result.Tags = tags
+	s.publish(ctx, domain.EventStopUpdated, domain.StopEvent(result))
 	return result, nil
 }
 
 // Delete removes a stop by ID, scoped to the given tripID.
-// Returns domain.ErrNotFound if the stop does not exist under the given trip.
+// Returns domain.ErrNotFound if the stop does not exist under the given trip
+// and domain.ErrForbidden if the request's user may only view the trip.
 func (s *StopService) Delete(ctx context.Context, tripID, stopID uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.StopService.Delete: %w", err)
+	}
 	if err := s.stops.Delete(ctx, tripID, stopID); err != nil {
 		return fmt.Errorf("service.StopService.Delete: %w", err)
 	}
+	s.publish(ctx, domain.EventStopDeleted, domain.StopEventData{ID: stopID, TripID: tripID})
 	return nil
 }
 
-// AddTag upserts a tag by name and links it to the given stop.
+// checkOdometer makes sure the stop's odometer reading fits among those of
+// the trip's other stops: no lower than any reached before it and no higher
+// than any reached after. Stops reached at the same moment are not compared.
+func (s *StopService) checkOdometer(ctx context.Context, stop domain.Stop) error {
+	if stop.OdometerMiles == nil {
+		return nil
+	}
+	others, err := s.stops.ListByTripID(ctx, stop.TripID)
+	if err != nil {
+		return err
+	}
+	miles := *stop.OdometerMiles
+	for _, other := range others {
+		if other.ID == stop.ID || other.OdometerMiles == nil {
+			continue
+		}
+		switch {
+		case other.ArrivedAt.Before(stop.ArrivedAt) && *other.OdometerMiles > miles:
+			return fmt.Errorf("%w: odometer_miles %.1f is below the %.1f recorded at %s, which was reached earlier",
+				domain.ErrValidation, miles, *other.OdometerMiles, other.Name)
+		case other.ArrivedAt.After(stop.ArrivedAt) && *other.OdometerMiles < miles:
+			return fmt.Errorf("%w: odometer_miles %.1f is above the %.1f recorded at %s, which was reached later",
+				domain.ErrValidation, miles, *other.OdometerMiles, other.Name)
+		}
+	}
+	return nil
+}
+
+// publish announces a stop event when the service has somewhere to send it.
+func (s *StopService) publish(ctx context.Context, event domain.WebhookEvent, data domain.StopEventData) {
+	if s.events != nil {
+		s.events.Publish(ctx, event, data)
+	}
+}
+
+// History returns every recorded version of a stop, newest first.
+// Returns domain.ErrNotFound if the stop does not exist under the given trip
+// or is in the trash.
+func (s *StopService) History(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, fmt.Errorf("service.StopService.History: %w", err)
+	}
+	revisions, err := s.stops.ListRevisions(ctx, tripID, stopID)
+	if err != nil {
+		return nil, fmt.Errorf("service.StopService.History: %w", err)
+	}
+	return revisions, nil
+}
+
+// Revert puts a stop's editable fields back as they were at the given
+// revision, recording the result as a new revision. Tags are left as they
+// are. Returns domain.ErrNotFound if the stop or the revision does not exist.
+func (s *StopService) Revert(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.Stop, error) {
+	current, err := s.stops.GetByID(ctx, tripID, stopID)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Revert: %w", err)
+	}
+	rev, err := s.stops.GetRevision(ctx, tripID, stopID, revision)
+	if err != nil {
+		return domain.Stop{}, fmt.Errorf("service.StopService.Revert: %w", err)
+	}
+	return s.Update(ctx, rev.Apply(current))
+}
+
+// AddTag upserts a tag by name and links it to the given stop on tripID.
 // The name is normalized to a slug using the same rules as TagService.
-// Returns domain.ErrValidation if tagName is empty or normalizes to empty.
-func (s *StopService) AddTag(ctx context.Context, stopID uuid.UUID, tagName string) (domain.Tag, error) {
+// Returns domain.ErrValidation if tagName is empty or normalizes to empty,
+// domain.ErrNotFound if the stop does not exist under the given trip, and
+// domain.ErrForbidden if the request's user may only view the trip.
+func (s *StopService) AddTag(ctx context.Context, tripID, stopID uuid.UUID, tagName string) (domain.Tag, error) {
 	tagName = strings.TrimSpace(tagName)
 	if tagName == "" {
 		return domain.Tag{}, fmt.Errorf("%w: tag name is required", domain.ErrValidation)
@@ -113,6 +502,9 @@ func (s *StopService) AddTag(ctx context.Context, stopID uuid.UUID, tagName stri
 	if slug == "" {
 		return domain.Tag{}, fmt.Errorf("%w: tag name contains no usable characters", domain.ErrValidation)
 	}
+	if err := s.requireStop(ctx, tripID, stopID); err != nil {
+		return domain.Tag{}, fmt.Errorf("service.StopService.AddTag: %w", err)
+	}
 
 	tag, err := s.tags.Upsert(ctx, tagName, slug)
 	if err != nil {
@@ -124,15 +516,29 @@ func (s *StopService) AddTag(ctx context.Context, stopID uuid.UUID, tagName stri
 	return tag, nil
 }
 
-// RemoveTagFromStop unlinks a tag from a stop by slug.
-// Returns domain.ErrNotFound if the tag is not linked to the stop.
-func (s *StopService) RemoveTagFromStop(ctx context.Context, stopID uuid.UUID, slug string) error {
+// RemoveTagFromStop unlinks a tag from a stop on tripID by slug.
+// Returns domain.ErrNotFound if the tag is not linked to the stop and
+// domain.ErrForbidden if the request's user may only view the trip.
+func (s *StopService) RemoveTagFromStop(ctx context.Context, tripID, stopID uuid.UUID, slug string) error {
+	if err := s.requireStop(ctx, tripID, stopID); err != nil {
+		return fmt.Errorf("service.StopService.RemoveTagFromStop: %w", err)
+	}
 	if err := s.tags.RemoveFromStop(ctx, stopID, slug); err != nil {
 		return fmt.Errorf("service.StopService.RemoveTagFromStop: %w", err)
 	}
 	return nil
 }
 
+// requireStop confirms the request's user may edit the trip and that the
+// stop is on it.
+func (s *StopService) requireStop(ctx context.Context, tripID, stopID uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return err
+	}
+	_, err := s.stops.GetByID(ctx, tripID, stopID)
+	return err
+}
+
 // ListTagsByStop returns all tags linked to a stop, ordered by slug.
 // Always returns a non-nil slice so callers can safely range over it.
 func (s *StopService) ListTagsByStop(ctx context.Context, stopID uuid.UUID) ([]domain.Tag, error) {
@@ -148,13 +554,40 @@ func (s *StopService) ListTagsByStop(ctx context.Context, stopID uuid.UUID) ([]d
 
 // validateStop enforces business rules common to both Create and Update.
 //   - Name must be non-empty (whitespace-only names are rejected).
+//   - ArrivedAt is required, unless the stop is planned; then it must be
+//     unset, and so must DepartedAt.
 //   - DepartedAt, if set, must not be before ArrivedAt.
 func validateStop(stop domain.Stop) error {
 	if strings.TrimSpace(stop.Name) == "" {
 		return fmt.Errorf("%w: name is required", domain.ErrValidation)
 	}
+	if stop.Planned {
+		if !stop.ArrivedAt.IsZero() {
+			return fmt.Errorf("%w: a planned stop has no arrived_at", domain.ErrValidation)
+		}
+		if stop.DepartedAt != nil {
+			return fmt.Errorf("%w: a planned stop has no departed_at", domain.ErrValidation)
+		}
+		if stop.OdometerMiles != nil {
+			return fmt.Errorf("%w: a planned stop has no odometer_miles", domain.ErrValidation)
+		}
+	} else if stop.ArrivedAt.IsZero() {
+		return fmt.Errorf("%w: arrived_at is required unless the stop is planned", domain.ErrValidation)
+	}
 	if stop.DepartedAt != nil && stop.DepartedAt.Before(stop.ArrivedAt) {
 		return fmt.Errorf("%w: departed_at must not be before arrived_at", domain.ErrValidation)
 	}
+	if stop.OdometerMiles != nil && *stop.OdometerMiles < 0 {
+		return fmt.Errorf("%w: odometer_miles must not be negative", domain.ErrValidation)
+	}
+	if (stop.Latitude == nil) != (stop.Longitude == nil) {
+		return fmt.Errorf("%w: latitude and longitude must be given together", domain.ErrValidation)
+	}
+	if stop.Latitude != nil && (*stop.Latitude < -90 || *stop.Latitude > 90) {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrValidation)
+	}
+	if stop.Longitude != nil && (*stop.Longitude < -180 || *stop.Longitude > 180) {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrValidation)
+	}
 	return nil
 }
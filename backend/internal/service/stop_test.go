@@ -13,6 +13,7 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
 )
 
 // ---- mock repos ------------------------------------------------------------
@@ -22,58 +23,76 @@ type mockStopRepo struct {
 	create            func(ctx context.Context, stop domain.Stop) (domain.Stop, error)
 	getByID           func(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error)
 	listByTripID      func(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error)
-	listByTripIDPaged func(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	listByTripIDs     func(ctx context.Context, tripIDs []uuid.UUID) ([]domain.Stop, error)
+	listByTripIDPaged func(ctx context.Context, tripID uuid.UUID, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Stop, int64, error)
+	listNearby        func(ctx context.Context, lat, lon, radiusKm float64, limit int) ([]domain.NearbyStop, error)
+	clusters          func(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error)
+	heatmap           func(ctx context.Context, year int, cellDegrees float64) ([]domain.HeatmapCell, error)
 	update            func(ctx context.Context, stop domain.Stop) (domain.Stop, error)
 	delete            func(ctx context.Context, tripID, stopID uuid.UUID) error
+	listRevisions     func(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error)
+	getRevision       func(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.StopRevision, error)
 }
 
 func (m *mockStopRepo) Create(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
 	return m.create(ctx, stop)
 }
 func (m *mockStopRepo) GetByID(ctx context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+	if m.getByID == nil {
+		return domain.Stop{ID: stopID, TripID: tripID}, nil
+	}
 	return m.getByID(ctx, tripID, stopID)
 }
 func (m *mockStopRepo) ListByTripID(ctx context.Context, tripID uuid.UUID) ([]domain.Stop, error) {
 	return m.listByTripID(ctx, tripID)
 }
-func (m *mockStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, p domain.PaginationParams) ([]domain.Stop, int64, error) {
+func (m *mockStopRepo) ListByTripIDs(ctx context.Context, tripIDs []uuid.UUID) ([]domain.Stop, error) {
+	return m.listByTripIDs(ctx, tripIDs)
+}
+func (m *mockStopRepo) ListByTripIDPaged(ctx context.Context, tripID uuid.UUID, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Stop, int64, error) {
 	if m.listByTripIDPaged != nil {
-		return m.listByTripIDPaged(ctx, tripID, p)
+		return m.listByTripIDPaged(ctx, tripID, f, p)
 	}
 	return nil, 0, nil
 }
+func (m *mockStopRepo) ListNearby(ctx context.Context, lat, lon, radiusKm float64, limit int) ([]domain.NearbyStop, error) {
+	return m.listNearby(ctx, lat, lon, radiusKm, limit)
+}
+func (m *mockStopRepo) Clusters(ctx context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+	return m.clusters(ctx, box, zoom)
+}
+func (m *mockStopRepo) Heatmap(ctx context.Context, year int, cellDegrees float64) ([]domain.HeatmapCell, error) {
+	return m.heatmap(ctx, year, cellDegrees)
+}
 func (m *mockStopRepo) Update(ctx context.Context, stop domain.Stop) (domain.Stop, error) {
 	return m.update(ctx, stop)
 }
 func (m *mockStopRepo) Delete(ctx context.Context, tripID, stopID uuid.UUID) error {
 	return m.delete(ctx, tripID, stopID)
 }
+func (m *mockStopRepo) ListRevisions(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.StopRevision, error) {
+	return m.listRevisions(ctx, tripID, stopID)
+}
+func (m *mockStopRepo) GetRevision(ctx context.Context, tripID, stopID uuid.UUID, revision int) (domain.StopRevision, error) {
+	return m.getRevision(ctx, tripID, stopID, revision)
+}
 
 // compile-time check: mockStopRepo must satisfy repo.StopRepo.
 var _ repo.StopRepo = (*mockStopRepo)(nil)
 
 // ---- helpers ---------------------------------------------------------------
 
-func validStop(tripID uuid.UUID) domain.Stop {
-	return domain.Stop{
-		TripID:    tripID,
-		Name:      "Camp Grounds A",
-		Location:  "Yellowstone, WY",
-		ArrivedAt: time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
-	}
-}
-
 // newStopService constructs a StopService wired to the given mocks.
 // Pass nil for tagRepo when the test does not exercise tag operations.
 func newStopService(tripRepo repo.TripRepo, stopRepo repo.StopRepo) *service.StopService {
-	return service.NewStopService(tripRepo, stopRepo, nil)
+	return service.NewStopService(tripRepo, stopRepo, nil, nil, nil, nil, nil)
 }
 
 // ---- Create ----------------------------------------------------------------
 
 func TestStopService_Create_OK(t *testing.T) {
 	tripID := uuid.New()
-	input := validStop(tripID)
+	input := factory.Stop().WithTripID(tripID).Build()
 	stored := input
 	stored.ID = uuid.New()
 
@@ -106,7 +125,7 @@ func TestStopService_Create_TripNotFound(t *testing.T) {
 		&mockStopRepo{},
 	)
 
-	_, err := svc.Create(context.Background(), validStop(uuid.New()))
+	_, err := svc.Create(context.Background(), factory.Stop().WithTripID(uuid.New()).Build())
 
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
@@ -122,7 +141,7 @@ func TestStopService_Create_NameRequired(t *testing.T) {
 		&mockStopRepo{},
 	)
 
-	input := validStop(tripID)
+	input := factory.Stop().WithTripID(tripID).Build()
 	input.Name = "   "
 
 	_, err := svc.Create(context.Background(), input)
@@ -141,7 +160,7 @@ func TestStopService_Create_DepartedBeforeArrived(t *testing.T) {
 		&mockStopRepo{},
 	)
 
-	input := validStop(tripID)
+	input := factory.Stop().WithTripID(tripID).Build()
 	departed := input.ArrivedAt.Add(-1 * time.Hour) // depart before arriving — invalid
 	input.DepartedAt = &departed
 
@@ -150,6 +169,47 @@ func TestStopService_Create_DepartedBeforeArrived(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrValidation)
 }
 
+func TestStopService_Create_Coordinates(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon *float64
+		wantErr  bool
+	}{
+		{"both set", num(44.4280), num(-110.5885), false},
+		{"neither set", nil, nil, false},
+		{"latitude only", num(44.4280), nil, true},
+		{"longitude only", nil, num(-110.5885), true},
+		{"latitude out of range", num(91), num(0), true},
+		{"longitude out of range", num(0), num(-180.5), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newStopService(
+				&mockTripRepo{
+					getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+						return domain.Trip{ID: id}, nil
+					},
+				},
+				&mockStopRepo{
+					create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+						return s, nil
+					},
+				},
+			)
+			input := factory.Stop().WithTripID(uuid.New()).Build()
+			input.Latitude, input.Longitude = tc.lat, tc.lon
+
+			_, err := svc.Create(context.Background(), input)
+
+			if tc.wantErr {
+				assert.ErrorIs(t, err, domain.ErrValidation)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // ---- GetByID ---------------------------------------------------------------
 
 func TestStopService_GetByID_OK(t *testing.T) {
@@ -171,6 +231,43 @@ func TestStopService_GetByID_OK(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestStopService_GetByID_Daylight(t *testing.T) {
+	departed := time.Date(2025, 6, 4, 16, 0, 0, 0, time.UTC)
+	stop := factory.Stop().WithCoordinates(44.6621, -110.4997).WithDepartedAt(&departed).Build()
+	svc := newStopService(
+		&mockTripRepo{},
+		&mockStopRepo{
+			getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) { return stop, nil },
+		},
+	)
+
+	got, err := svc.GetByID(context.Background(), stop.TripID, stop.ID)
+
+	require.NoError(t, err)
+	require.Len(t, got.Daylight, 3, "arrived 2025-06-02, left 2025-06-04")
+	assert.Equal(t, domain.NewDate(2025, 6, 2), got.Daylight[0].Date)
+	assert.Equal(t, domain.NewDate(2025, 6, 4), got.Daylight[2].Date)
+	for _, day := range got.Daylight {
+		require.NotNil(t, day.Sunrise)
+		require.NotNil(t, day.Sunset)
+		assert.True(t, day.Sunrise.Before(*day.Sunset))
+	}
+}
+
+func TestStopService_GetByID_NoDaylightWithoutCoordinates(t *testing.T) {
+	svc := newStopService(
+		&mockTripRepo{},
+		&mockStopRepo{
+			getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) { return factory.Stop().Build(), nil },
+		},
+	)
+
+	got, err := svc.GetByID(context.Background(), uuid.New(), uuid.New())
+
+	require.NoError(t, err)
+	assert.Nil(t, got.Daylight)
+}
+
 func TestStopService_GetByID_NotFound(t *testing.T) {
 	svc := newStopService(
 		&mockTripRepo{},
@@ -227,11 +324,49 @@ func TestStopService_ListByTripID_ReturnsEmptySlice(t *testing.T) {
 	assert.Empty(t, got)
 }
 
+func TestStopService_ListByTripIDs_GroupsByTrip(t *testing.T) {
+	tripA, tripB, empty := uuid.New(), uuid.New(), uuid.New()
+	var calls int
+
+	svc := newStopService(
+		&mockTripRepo{},
+		&mockStopRepo{
+			listByTripIDs: func(_ context.Context, ids []uuid.UUID) ([]domain.Stop, error) {
+				calls++
+				assert.ElementsMatch(t, []uuid.UUID{tripA, tripB, empty}, ids)
+				return []domain.Stop{
+					{ID: uuid.New(), TripID: tripA},
+					{ID: uuid.New(), TripID: tripA},
+					{ID: uuid.New(), TripID: tripB},
+				}, nil
+			},
+		},
+	)
+
+	got, err := svc.ListByTripIDs(context.Background(), []uuid.UUID{tripA, tripB, empty})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Len(t, got[tripA], 2)
+	assert.Len(t, got[tripB], 1)
+	assert.NotNil(t, got[empty])
+	assert.Empty(t, got[empty])
+}
+
+func TestStopService_ListByTripIDs_NoTripsSkipsRepo(t *testing.T) {
+	svc := newStopService(&mockTripRepo{}, &mockStopRepo{})
+
+	got, err := svc.ListByTripIDs(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
 // ---- Update ----------------------------------------------------------------
 
 func TestStopService_Update_OK(t *testing.T) {
 	tripID := uuid.New()
-	input := validStop(tripID)
+	input := factory.Stop().WithTripID(tripID).Build()
 	input.ID = uuid.New()
 	input.Name = "Updated Name"
 
@@ -251,7 +386,7 @@ func TestStopService_Update_OK(t *testing.T) {
 }
 
 func TestStopService_Update_ValidationFails(t *testing.T) {
-	input := validStop(uuid.New())
+	input := factory.Stop().WithTripID(uuid.New()).Build()
 	input.ID = uuid.New()
 	input.Name = ""
 
@@ -262,6 +397,191 @@ func TestStopService_Update_ValidationFails(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrValidation)
 }
 
+func TestStopService_Odometer(t *testing.T) {
+	tripID := uuid.New()
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 12, 0, 0, 0, time.UTC) }
+	miles := func(m float64) *float64 { return &m }
+	earlier := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Moab", ArrivedAt: day(2), OdometerMiles: miles(42000)}
+	later := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Ouray", ArrivedAt: day(6), OdometerMiles: miles(42500)}
+	unread := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Green River", ArrivedAt: day(3)}
+
+	tests := []struct {
+		name    string
+		stop    domain.Stop
+		wantErr string
+	}{
+		{"between its neighbours", domain.Stop{ArrivedAt: day(4), OdometerMiles: miles(42200)}, ""},
+		{"equal to an earlier reading", domain.Stop{ArrivedAt: day(4), OdometerMiles: miles(42000)}, ""},
+		{"no reading", domain.Stop{ArrivedAt: day(1)}, ""},
+		{"below an earlier reading", domain.Stop{ArrivedAt: day(4), OdometerMiles: miles(41900)}, "below the 42000.0 recorded at Moab"},
+		{"above a later reading", domain.Stop{ArrivedAt: day(4), OdometerMiles: miles(42600)}, "above the 42500.0 recorded at Ouray"},
+		{"negative", domain.Stop{ArrivedAt: day(1), OdometerMiles: miles(-1)}, "must not be negative"},
+		{"on a planned stop", domain.Stop{Planned: true, OdometerMiles: miles(42600)}, "a planned stop has no odometer_miles"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.stop.TripID = tripID
+			tc.stop.Name = "Durango"
+			svc := newStopService(
+				&mockTripRepo{
+					getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+						return domain.Trip{ID: id}, nil
+					},
+				},
+				&mockStopRepo{
+					listByTripID: func(context.Context, uuid.UUID) ([]domain.Stop, error) {
+						return []domain.Stop{earlier, unread, later}, nil
+					},
+					create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+						return s, nil
+					},
+				},
+			)
+
+			_, err := svc.Create(context.Background(), tc.stop)
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, domain.ErrValidation)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestStopService_Update_OdometerIgnoresItsOwnReading(t *testing.T) {
+	tripID := uuid.New()
+	old := 42000.0
+	current := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Moab", ArrivedAt: time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC), OdometerMiles: &old}
+	corrected := 41950.0
+	input := current
+	input.OdometerMiles = &corrected
+
+	svc := newStopService(&mockTripRepo{}, &mockStopRepo{
+		listByTripID: func(context.Context, uuid.UUID) ([]domain.Stop, error) {
+			return []domain.Stop{current}, nil
+		},
+		update: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+			return s, nil
+		},
+	})
+
+	got, err := svc.Update(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 41950, *got.OdometerMiles, 0.001)
+}
+
+func TestStopService_Create_Planned(t *testing.T) {
+	tripID := uuid.New()
+	svc := newStopService(
+		&mockTripRepo{
+			getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+				return domain.Trip{ID: id}, nil
+			},
+		},
+		&mockStopRepo{
+			create: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+				return s, nil
+			},
+		},
+	)
+
+	departed := time.Date(2025, 6, 3, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		mutate  func(*domain.Stop)
+		wantErr bool
+	}{
+		{"planned", func(s *domain.Stop) { s.Planned, s.ArrivedAt = true, time.Time{} }, false},
+		{"planned with arrival", func(s *domain.Stop) { s.Planned = true }, true},
+		{"planned with departure", func(s *domain.Stop) { s.Planned, s.ArrivedAt, s.DepartedAt = true, time.Time{}, &departed }, true},
+		{"no arrival", func(s *domain.Stop) { s.ArrivedAt = time.Time{} }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := factory.Stop().WithTripID(tripID).Build()
+			tt.mutate(&input)
+
+			_, err := svc.Create(context.Background(), input)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrValidation)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// ---- Arrive ----------------------------------------------------------------
+
+func TestStopService_Arrive(t *testing.T) {
+	now := time.Date(2025, 6, 4, 16, 30, 0, 0, time.UTC)
+	planned := factory.Stop().WithTripID(uuid.New()).Build()
+	planned.ID = uuid.New()
+	planned.Planned = true
+	planned.ArrivedAt = time.Time{}
+	planned.Tags = []domain.Tag{{Name: "Lake", Slug: "lake"}}
+
+	newService := func(stored domain.Stop, updated *domain.Stop) *service.StopService {
+		return service.NewStopService(&mockTripRepo{}, &mockStopRepo{
+			getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) {
+				return stored, nil
+			},
+			update: func(_ context.Context, s domain.Stop) (domain.Stop, error) {
+				*updated = s
+				s.Tags = nil
+				return s, nil
+			},
+		}, nil, nil, nil, nil, &fakeClock{now: now})
+	}
+
+	t.Run("now", func(t *testing.T) {
+		var updated domain.Stop
+		got, err := newService(planned, &updated).Arrive(context.Background(), planned.TripID, planned.ID, nil)
+
+		require.NoError(t, err)
+		assert.False(t, updated.Planned)
+		assert.Equal(t, now, updated.ArrivedAt)
+		assert.Nil(t, updated.Metadata, "metadata is left as it is")
+		assert.Equal(t, planned.Tags, got.Tags)
+	})
+
+	t.Run("given time", func(t *testing.T) {
+		var updated domain.Stop
+		at := now.Add(-2 * time.Hour)
+		_, err := newService(planned, &updated).Arrive(context.Background(), planned.TripID, planned.ID, &at)
+
+		require.NoError(t, err)
+		assert.Equal(t, at, updated.ArrivedAt)
+	})
+
+	t.Run("not planned", func(t *testing.T) {
+		var updated domain.Stop
+		reached := planned
+		reached.Planned = false
+		reached.ArrivedAt = now.Add(-24 * time.Hour)
+		_, err := newService(reached, &updated).Arrive(context.Background(), reached.TripID, reached.ID, nil)
+
+		assert.ErrorIs(t, err, domain.ErrValidation)
+		assert.Zero(t, updated.ID, "nothing is written")
+	})
+}
+
+func TestStopService_Arrive_NotFound(t *testing.T) {
+	svc := newStopService(&mockTripRepo{}, &mockStopRepo{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) {
+			return domain.Stop{}, domain.ErrNotFound
+		},
+	})
+
+	_, err := svc.Arrive(context.Background(), uuid.New(), uuid.New(), nil)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
 // ---- Delete ----------------------------------------------------------------
 
 func TestStopService_Delete_OK(t *testing.T) {
@@ -294,6 +614,352 @@ func TestStopService_Delete_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
 
+// ---- Nearby ----------------------------------------------------------------
+
+func TestStopService_Nearby_DefaultRadius(t *testing.T) {
+	var gotRadius float64
+	var gotLimit int
+	svc := newStopService(
+		&mockTripRepo{},
+		&mockStopRepo{
+			listNearby: func(_ context.Context, _, _, radiusKm float64, limit int) ([]domain.NearbyStop, error) {
+				gotRadius, gotLimit = radiusKm, limit
+				return nil, nil
+			},
+		},
+	)
+
+	nearby, err := svc.Nearby(context.Background(), 44.43, -110.59, nil)
+
+	require.NoError(t, err)
+	assert.NotNil(t, nearby)
+	assert.Empty(t, nearby)
+	assert.Equal(t, float64(service.DefaultNearbyRadiusKm), gotRadius)
+	assert.Positive(t, gotLimit)
+}
+
+func TestStopService_Nearby_PassesSearch(t *testing.T) {
+	want := []domain.NearbyStop{{Stop: domain.Stop{Name: "Madison"}, TripName: "Yellowstone", DistanceKm: 3.2}}
+	svc := newStopService(
+		&mockTripRepo{},
+		&mockStopRepo{
+			listNearby: func(_ context.Context, lat, lon, radiusKm float64, _ int) ([]domain.NearbyStop, error) {
+				assert.Equal(t, 44.43, lat)
+				assert.Equal(t, -110.59, lon)
+				assert.Equal(t, 25.0, radiusKm)
+				return want, nil
+			},
+		},
+	)
+
+	nearby, err := svc.Nearby(context.Background(), 44.43, -110.59, num(25))
+
+	require.NoError(t, err)
+	assert.Equal(t, want, nearby)
+}
+
+func TestStopService_Nearby_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		radius   *float64
+	}{
+		{"latitude", 91, 0, nil},
+		{"longitude", 0, -181, nil},
+		{"zero radius", 0, 0, num(0)},
+		{"negative radius", 0, 0, num(-5)},
+		{"radius too large", 0, 0, num(service.MaxNearbyRadiusKm + 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newStopService(&mockTripRepo{}, &mockStopRepo{})
+
+			_, err := svc.Nearby(context.Background(), tt.lat, tt.lon, tt.radius)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+// ---- Clusters --------------------------------------------------------------
+
+func TestStopService_Clusters_WrapsLongitudes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   domain.BoundingBox
+		want domain.BoundingBox
+	}{
+		{"inside", domain.BoundingBox{West: -112, South: 40, East: -104, North: 45}, domain.BoundingBox{West: -112, South: 40, East: -104, North: 45}},
+		{"scrolled east", domain.BoundingBox{West: 170, South: -20, East: 190, North: -10}, domain.BoundingBox{West: 170, South: -20, East: -170, North: -10}},
+		{"scrolled west", domain.BoundingBox{West: -200, South: -20, East: -170, North: -10}, domain.BoundingBox{West: 160, South: -20, East: -170, North: -10}},
+		{"whole world", domain.BoundingBox{West: -400, South: -85, East: 400, North: 85}, domain.BoundingBox{West: -180, South: -85, East: 180, North: 85}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got domain.BoundingBox
+			svc := newStopService(&mockTripRepo{}, &mockStopRepo{
+				clusters: func(_ context.Context, box domain.BoundingBox, zoom int) ([]domain.StopCluster, error) {
+					got = box
+					assert.Equal(t, 5, zoom)
+					return nil, nil
+				},
+			})
+
+			clusters, err := svc.Clusters(context.Background(), tt.in, 5)
+
+			require.NoError(t, err)
+			assert.NotNil(t, clusters)
+			assert.InDelta(t, tt.want.West, got.West, 1e-9)
+			assert.InDelta(t, tt.want.East, got.East, 1e-9)
+			assert.Equal(t, tt.want.South, got.South)
+			assert.Equal(t, tt.want.North, got.North)
+		})
+	}
+}
+
+func TestStopService_Clusters_Validation(t *testing.T) {
+	ok := domain.BoundingBox{West: -112, South: 40, East: -104, North: 45}
+	tests := []struct {
+		name string
+		box  domain.BoundingBox
+		zoom int
+	}{
+		{"negative zoom", ok, -1},
+		{"zoom too deep", ok, service.MaxClusterZoom + 1},
+		{"south of the pole", domain.BoundingBox{West: -112, South: -91, East: -104, North: 45}, 5},
+		{"north of the pole", domain.BoundingBox{West: -112, South: 40, East: -104, North: 91}, 5},
+		{"upside down", domain.BoundingBox{West: -112, South: 45, East: -104, North: 40}, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newStopService(&mockTripRepo{}, &mockStopRepo{})
+
+			_, err := svc.Clusters(context.Background(), tt.box, tt.zoom)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+// ---- Heatmap ---------------------------------------------------------------
+
+func TestStopService_Heatmap_Defaults(t *testing.T) {
+	svc := newStopService(&mockTripRepo{}, &mockStopRepo{
+		heatmap: func(_ context.Context, year int, cellDegrees float64) ([]domain.HeatmapCell, error) {
+			assert.Zero(t, year, "every year")
+			assert.Equal(t, service.DefaultHeatmapCellDegrees, cellDegrees)
+			return nil, nil
+		},
+	})
+
+	got, err := svc.Heatmap(context.Background(), nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, service.DefaultHeatmapCellDegrees, got.CellDegrees)
+	assert.NotNil(t, got.Cells)
+	assert.Zero(t, got.MaxNights())
+}
+
+func TestStopService_Heatmap_YearAndCell(t *testing.T) {
+	year := 2024
+	cells := []domain.HeatmapCell{{Latitude: 44.5, Longitude: -110.8, Nights: 9}, {Latitude: 40.8, Longitude: -111.9, Nights: 2}}
+	svc := newStopService(&mockTripRepo{}, &mockStopRepo{
+		heatmap: func(_ context.Context, y int, cellDegrees float64) ([]domain.HeatmapCell, error) {
+			assert.Equal(t, 2024, y)
+			assert.Equal(t, 0.5, cellDegrees)
+			return cells, nil
+		},
+	})
+
+	got, err := svc.Heatmap(context.Background(), &year, num(0.5))
+
+	require.NoError(t, err)
+	assert.Equal(t, cells, got.Cells)
+	assert.Equal(t, 9, got.MaxNights())
+}
+
+func TestStopService_Heatmap_Validation(t *testing.T) {
+	zero, tooLate := 0, 10000
+	tests := []struct {
+		name string
+		year *int
+		cell *float64
+	}{
+		{"year zero", &zero, nil},
+		{"year too late", &tooLate, nil},
+		{"cell too small", nil, num(0.0001)},
+		{"cell too large", nil, num(11)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newStopService(&mockTripRepo{}, &mockStopRepo{})
+
+			_, err := svc.Heatmap(context.Background(), tt.year, tt.cell)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestStopService_Occupancy(t *testing.T) {
+	on := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2025, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+	june16 := domain.NewDate(2025, time.June, 16)
+	ended := domain.NewDate(2024, time.October, 1)
+	trip := domain.Trip{ID: uuid.New(), StartDate: domain.NewDate(2025, time.June, 10), EndDate: &june16}
+	open := domain.Trip{ID: uuid.New(), StartDate: domain.NewDate(2025, time.June, 18)}
+	old := domain.Trip{ID: uuid.New(), StartDate: domain.NewDate(2024, time.September, 1), EndDate: &ended}
+	madison := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Madison", ArrivedAt: on(time.June, 10, 18), DepartedAt: ptr(on(time.June, 12, 10))}
+	lunch := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Lunch", ArrivedAt: on(time.June, 13, 12), DepartedAt: ptr(on(time.June, 13, 13))}
+	planned := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Planned", Planned: true}
+	tetons := domain.Stop{ID: uuid.New(), TripID: trip.ID, Name: "Tetons", ArrivedAt: on(time.June, 14, 17), DepartedAt: ptr(on(time.June, 16, 9))}
+
+	svc := service.NewStopService(
+		&mockTripRepo{list: func(_ context.Context) ([]domain.Trip, error) {
+			return []domain.Trip{old, trip, open}, nil
+		}},
+		&mockStopRepo{listByTripIDs: func(_ context.Context, ids []uuid.UUID) ([]domain.Stop, error) {
+			assert.Equal(t, []uuid.UUID{trip.ID, open.ID}, ids, "only trips in the year")
+			return []domain.Stop{madison, lunch, planned, tetons}, nil
+		}},
+		nil, nil, nil, nil, &fakeClock{now: on(time.June, 20, 12)},
+	)
+
+	got, err := svc.Occupancy(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2025, got.Year)
+	span := func(first, last int, status domain.NightStatus, tripID *uuid.UUID, stop *domain.Stop) domain.NightSpan {
+		s := domain.NightSpan{First: domain.NewDate(2025, time.June, first), Last: domain.NewDate(2025, time.June, last), Status: status, TripID: tripID}
+		if stop != nil {
+			s.StopID, s.StopName = &stop.ID, stop.Name
+		}
+		return s
+	}
+	assert.Equal(t, []domain.NightSpan{
+		{First: domain.NewDate(2025, time.January, 1), Last: domain.NewDate(2025, time.June, 9), Status: domain.NightOffTrip},
+		span(10, 11, domain.NightAtStop, &trip.ID, &madison),
+		span(12, 13, domain.NightGap, &trip.ID, nil),
+		span(14, 15, domain.NightAtStop, &trip.ID, &tetons),
+		span(16, 17, domain.NightOffTrip, nil, nil),
+		span(18, 19, domain.NightGap, &open.ID, nil),
+	}, got.Spans, "counted up to last night")
+	assert.Equal(t, 4, got.Count(domain.NightAtStop))
+	assert.Equal(t, 4, got.Count(domain.NightGap))
+	assert.Equal(t, 162, got.Count(domain.NightOffTrip))
+}
+
+func TestStopService_Occupancy_Years(t *testing.T) {
+	past, future := 2023, 2026
+	svc := service.NewStopService(
+		&mockTripRepo{list: func(_ context.Context) ([]domain.Trip, error) { return nil, nil }},
+		&mockStopRepo{},
+		nil, nil, nil, nil, &fakeClock{now: time.Date(2025, time.June, 20, 12, 0, 0, 0, time.UTC)},
+	)
+
+	got, err := svc.Occupancy(context.Background(), &past)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.NightSpan{{
+		First: domain.NewDate(2023, time.January, 1), Last: domain.NewDate(2023, time.December, 31), Status: domain.NightOffTrip,
+	}}, got.Spans)
+	assert.Equal(t, 365, got.Count(domain.NightOffTrip))
+
+	got, err = svc.Occupancy(context.Background(), &future)
+	require.NoError(t, err)
+	assert.Equal(t, 2026, got.Year)
+	assert.Empty(t, got.Spans, "no nights yet")
+}
+
+func TestStopService_Occupancy_Validation(t *testing.T) {
+	for _, year := range []int{0, 10000} {
+		svc := service.NewStopService(&mockTripRepo{}, &mockStopRepo{}, nil, nil, nil, nil, &fakeClock{now: time.Now()})
+
+		_, err := svc.Occupancy(context.Background(), &year)
+
+		assert.ErrorIs(t, err, domain.ErrValidation, "year %d", year)
+	}
+}
+
+func TestStopService_Gaps(t *testing.T) {
+	on := func(day, hour int) time.Time { return time.Date(2025, time.June, day, hour, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	night := func(day int) domain.Date { return domain.NewDate(2025, time.June, day) }
+	tripID := uuid.New()
+	madison := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Madison", ArrivedAt: on(11, 18), DepartedAt: ptr(on(13, 10))}
+	planned := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Planned", Planned: true}
+	tetons := domain.Stop{ID: uuid.New(), TripID: tripID, Name: "Tetons", ArrivedAt: on(16, 17), DepartedAt: ptr(on(18, 9))}
+
+	newSvc := func(end *domain.Date) *service.StopService {
+		return service.NewStopService(
+			&mockTripRepo{getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+				return domain.Trip{ID: id, StartDate: night(10), EndDate: end}, nil
+			}},
+			&mockStopRepo{listByTripID: func(_ context.Context, id uuid.UUID) ([]domain.Stop, error) {
+				assert.Equal(t, tripID, id)
+				return []domain.Stop{madison, planned, tetons}, nil
+			}},
+			nil, nil, nil, nil, &fakeClock{now: on(21, 12)},
+		)
+	}
+
+	t.Run("ended", func(t *testing.T) {
+		end := night(19)
+		got, err := newSvc(&end).Gaps(context.Background(), tripID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TripGap{
+			{First: night(10), Last: night(10), Next: &madison},
+			{First: night(13), Last: night(15), Previous: &madison, Next: &tetons},
+			{First: night(18), Last: night(18), Previous: &tetons},
+		}, got)
+		assert.Equal(t, 3, got[1].Nights())
+	})
+
+	t.Run("open-ended checked up to last night", func(t *testing.T) {
+		got, err := newSvc(nil).Gaps(context.Background(), tripID)
+
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, night(18), got[2].First)
+		assert.Equal(t, night(20), got[2].Last)
+	})
+}
+
+func TestStopService_Gaps_NoGaps(t *testing.T) {
+	tripID := uuid.New()
+	end := domain.NewDate(2025, time.June, 12)
+	svc := service.NewStopService(
+		&mockTripRepo{getByID: func(_ context.Context, id uuid.UUID) (domain.Trip, error) {
+			return domain.Trip{ID: id, StartDate: domain.NewDate(2025, time.June, 10), EndDate: &end}, nil
+		}},
+		&mockStopRepo{listByTripID: func(_ context.Context, _ uuid.UUID) ([]domain.Stop, error) {
+			departed := time.Date(2025, time.June, 12, 9, 0, 0, 0, time.UTC)
+			return []domain.Stop{{ID: uuid.New(), TripID: tripID, ArrivedAt: time.Date(2025, time.June, 10, 16, 0, 0, 0, time.UTC), DepartedAt: &departed}}, nil
+		}},
+		nil, nil, nil, nil, &fakeClock{now: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	)
+
+	got, err := svc.Gaps(context.Background(), tripID)
+
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+	assert.Empty(t, got)
+}
+
+func TestStopService_Gaps_TripNotFound(t *testing.T) {
+	svc := newStopService(&mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) {
+			return domain.Trip{}, domain.ErrNotFound
+		},
+	}, &mockStopRepo{})
+
+	_, err := svc.Gaps(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
 // ---- error propagation helper check ----------------------------------------
 
 func TestStopService_Create_RepoError(t *testing.T) {
@@ -313,7 +979,7 @@ func TestStopService_Create_RepoError(t *testing.T) {
 		},
 	)
 
-	_, err := svc.Create(context.Background(), validStop(tripID))
+	_, err := svc.Create(context.Background(), factory.Stop().WithTripID(tripID).Build())
 
 	assert.ErrorIs(t, err, repoErr)
 }
@@ -337,9 +1003,13 @@ func TestStopService_AddTag_OK(t *testing.T) {
 				return nil
 			},
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	got, err := svc.AddTag(context.Background(), stopID, "Rocky Mountains")
+	got, err := svc.AddTag(context.Background(), uuid.New(), stopID, "Rocky Mountains")
 
 	require.NoError(t, err)
 	assert.Equal(t, "rocky-mountains", got.Slug)
@@ -358,18 +1028,22 @@ func TestStopService_AddTag_NormalizesName(t *testing.T) {
 			},
 			addToStop: func(_ context.Context, _, _ uuid.UUID) error { return nil },
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	_, err := svc.AddTag(context.Background(), uuid.New(), "WALMART")
+	_, err := svc.AddTag(context.Background(), uuid.New(), uuid.New(), "WALMART")
 
 	require.NoError(t, err)
 	assert.Equal(t, "walmart", capturedSlug)
 }
 
 func TestStopService_AddTag_EmptyName(t *testing.T) {
-	svc := service.NewStopService(&mockTripRepo{}, &mockStopRepo{}, &mockTagRepo{})
+	svc := service.NewStopService(&mockTripRepo{}, &mockStopRepo{}, &mockTagRepo{}, nil, nil, nil, nil)
 
-	_, err := svc.AddTag(context.Background(), uuid.New(), "   ")
+	_, err := svc.AddTag(context.Background(), uuid.New(), uuid.New(), "   ")
 
 	assert.ErrorIs(t, err, domain.ErrValidation)
 }
@@ -384,9 +1058,13 @@ func TestStopService_AddTag_UpsertError(t *testing.T) {
 				return domain.Tag{}, repoErr
 			},
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	_, err := svc.AddTag(context.Background(), uuid.New(), "camping")
+	_, err := svc.AddTag(context.Background(), uuid.New(), uuid.New(), "camping")
 
 	assert.ErrorIs(t, err, repoErr)
 }
@@ -402,9 +1080,13 @@ func TestStopService_AddTag_AddToStopError(t *testing.T) {
 			},
 			addToStop: func(_ context.Context, _, _ uuid.UUID) error { return repoErr },
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	_, err := svc.AddTag(context.Background(), uuid.New(), "camping")
+	_, err := svc.AddTag(context.Background(), uuid.New(), uuid.New(), "camping")
 
 	assert.ErrorIs(t, err, repoErr)
 }
@@ -421,9 +1103,13 @@ func TestStopService_RemoveTagFromStop_OK(t *testing.T) {
 				return nil
 			},
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	err := svc.RemoveTagFromStop(context.Background(), uuid.New(), "camping")
+	err := svc.RemoveTagFromStop(context.Background(), uuid.New(), uuid.New(), "camping")
 
 	require.NoError(t, err)
 }
@@ -437,9 +1123,13 @@ func TestStopService_RemoveTagFromStop_NotFound(t *testing.T) {
 				return domain.ErrNotFound
 			},
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	err := svc.RemoveTagFromStop(context.Background(), uuid.New(), "camping")
+	err := svc.RemoveTagFromStop(context.Background(), uuid.New(), uuid.New(), "camping")
 
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
@@ -462,6 +1152,10 @@ func TestStopService_ListTagsByStop_OK(t *testing.T) {
 				return expected, nil
 			},
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	got, err := svc.ListTagsByStop(context.Background(), stopID)
@@ -479,6 +1173,10 @@ func TestStopService_ListTagsByStop_ReturnsEmptySlice(t *testing.T) {
 				return nil, nil
 			},
 		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	got, err := svc.ListTagsByStop(context.Background(), uuid.New())
@@ -487,3 +1185,89 @@ func TestStopService_ListTagsByStop_ReturnsEmptySlice(t *testing.T) {
 	assert.NotNil(t, got)
 	assert.Empty(t, got)
 }
+
+// ---- History / Revert ------------------------------------------------------
+
+func TestStopService_History_OK(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	revisions := []domain.StopRevision{{StopID: stopID, Revision: 2}, {StopID: stopID, Revision: 1}}
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) { return domain.Stop{ID: stopID}, nil },
+		listRevisions: func(_ context.Context, gotTrip, gotStop uuid.UUID) ([]domain.StopRevision, error) {
+			assert.Equal(t, tripID, gotTrip)
+			assert.Equal(t, stopID, gotStop)
+			return revisions, nil
+		},
+	}
+
+	got, err := newStopService(&mockTripRepo{}, stops).History(context.Background(), tripID, stopID)
+
+	require.NoError(t, err)
+	assert.Equal(t, revisions, got)
+}
+
+func TestStopService_Revert_KeepsTags(t *testing.T) {
+	current := factory.Stop().WithID(uuid.New()).WithTripID(uuid.New()).WithName("Moab KOA").WithTags("hookups").Build()
+	arrived := current.ArrivedAt.Add(-time.Hour)
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) { return current, nil },
+		getRevision: func(_ context.Context, _, _ uuid.UUID, _ int) (domain.StopRevision, error) {
+			return domain.StopRevision{Revision: 1, Name: "Moab", ArrivedAt: arrived}, nil
+		},
+		update: func(_ context.Context, s domain.Stop) (domain.Stop, error) { return s, nil },
+	}
+
+	got, err := newStopService(&mockTripRepo{}, stops).Revert(context.Background(), current.TripID, current.ID, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Moab", got.Name)
+	assert.True(t, arrived.Equal(got.ArrivedAt))
+	assert.Equal(t, current.Tags, got.Tags)
+}
+
+func TestStopService_Revert_RevisionNotFound(t *testing.T) {
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, _, _ uuid.UUID) (domain.Stop, error) { return factory.Stop().Build(), nil },
+		getRevision: func(_ context.Context, _, _ uuid.UUID, _ int) (domain.StopRevision, error) {
+			return domain.StopRevision{}, domain.ErrNotFound
+		},
+	}
+
+	_, err := newStopService(&mockTripRepo{}, stops).Revert(context.Background(), uuid.New(), uuid.New(), 7)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// recordingPublisher is a service.EventPublisher that keeps what it is sent.
+type recordingPublisher struct {
+	events []domain.WebhookEvent
+	data   []any
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event domain.WebhookEvent, data any) {
+	p.events = append(p.events, event)
+	p.data = append(p.data, data)
+}
+
+// of returns the data of each recorded event of one kind, in order.
+func (p *recordingPublisher) of(event domain.WebhookEvent) []any {
+	var data []any
+	for i, e := range p.events {
+		if e == event {
+			data = append(data, p.data[i])
+		}
+	}
+	return data
+}
+
+func TestStopService_Delete_Publishes(t *testing.T) {
+	tripID, stopID := uuid.New(), uuid.New()
+	stops := &mockStopRepo{delete: func(_ context.Context, _, _ uuid.UUID) error { return nil }}
+	events := &recordingPublisher{}
+	svc := service.NewStopService(&mockTripRepo{}, stops, nil, nil, nil, events, nil)
+
+	require.NoError(t, svc.Delete(context.Background(), tripID, stopID))
+
+	assert.Equal(t, []domain.WebhookEvent{domain.EventStopDeleted}, events.events)
+	assert.Equal(t, []any{domain.StopEventData{ID: stopID, TripID: tripID}}, events.data)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
@@ -102,6 +103,67 @@ func (s *TagService) Delete(ctx context.Context, slug string) error {
 	return nil
 }
 
+// Merge folds the tag fromSlug into intoSlug: everything tagged fromSlug is
+// tagged intoSlug instead, and fromSlug is deleted. Use it to clean up
+// near-duplicates such as "walmart" and "wal-mart".
+// The tags under fromSlug move under intoSlug.
+// Returns domain.ErrValidation if the slugs are the same or intoSlug sits
+// beneath fromSlug; domain.ErrNotFound if either tag does not exist.
+func (s *TagService) Merge(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error) {
+	if fromSlug == intoSlug {
+		return domain.Tag{}, fmt.Errorf("%w: cannot merge a tag into itself", domain.ErrValidation)
+	}
+	below, err := s.tags.Subtree(ctx, fromSlug)
+	if err != nil {
+		return domain.Tag{}, fmt.Errorf("service.TagService.Merge: %w", err)
+	}
+	if slices.Contains(below, intoSlug) {
+		return domain.Tag{}, fmt.Errorf("%w: cannot merge a tag into one beneath it", domain.ErrValidation)
+	}
+
+	result, err := s.tags.Merge(ctx, fromSlug, intoSlug)
+	if err != nil {
+		return domain.Tag{}, fmt.Errorf("service.TagService.Merge: %w", err)
+	}
+	return result, nil
+}
+
+// SetParent puts the tag identified by slug under the tag parentSlug, or at
+// the top level when parentSlug is empty.
+// Returns domain.ErrValidation if parentSlug is the tag itself or a tag
+// beneath it; domain.ErrNotFound if either tag does not exist.
+func (s *TagService) SetParent(ctx context.Context, slug, parentSlug string) (domain.Tag, error) {
+	parentSlug = strings.ToLower(strings.TrimSpace(parentSlug))
+	if parentSlug != "" {
+		below, err := s.tags.Subtree(ctx, slug)
+		if err != nil {
+			return domain.Tag{}, fmt.Errorf("service.TagService.SetParent: %w", err)
+		}
+		if slices.Contains(below, parentSlug) {
+			return domain.Tag{}, fmt.Errorf("%w: a tag cannot sit under itself or a tag beneath it", domain.ErrValidation)
+		}
+	}
+
+	result, err := s.tags.SetParent(ctx, slug, parentSlug)
+	if err != nil {
+		return domain.Tag{}, fmt.Errorf("service.TagService.SetParent: %w", err)
+	}
+	return result, nil
+}
+
+// Stats returns every tag with the number of stops carrying it, directly and
+// counting the tags beneath it, the most used first.
+func (s *TagService) Stats(ctx context.Context) ([]domain.TagStats, error) {
+	stats, err := s.tags.Stats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.TagService.Stats: %w", err)
+	}
+	if stats == nil {
+		return []domain.TagStats{}, nil
+	}
+	return stats, nil
+}
+
 // toSlug converts a display name to a URL-safe, lowercase, hyphenated slug.
 // Examples:
 //
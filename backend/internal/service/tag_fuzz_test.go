@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// slugShape is every slug the service may ever hand to the repo: lowercase
+// ASCII letters and digits in hyphen-separated runs, no leading, trailing,
+// or doubled hyphens.
+var slugShape = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// FuzzTagService_UpsertByName_Slug checks the slug invariants through the
+// public API (toSlug itself is unexported):
+//   - the slug always has slugShape;
+//   - slugging is idempotent — a slug used as a name maps to itself;
+//   - a name containing any ASCII letter or digit is never rejected.
+//
+// Run with: go test ./internal/service -run '^$' -fuzz FuzzTagService_UpsertByName_Slug
+func FuzzTagService_UpsertByName_Slug(f *testing.F) {
+	for _, seed := range []string{
+		"Rocky Mountains", "WALMART", "Rocky  Mountains!", "  -- trailing --  ",
+		"Café", "東京", "🚐 boondocking 🚐", "a", "-", "", "\xff\xfe", "\u212a", // KELVIN SIGN lowercases to ASCII k
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		var got string
+		svc := service.NewTagService(&mockTagRepo{
+			upsert: func(_ context.Context, n, slug string) (domain.Tag, error) {
+				got = slug
+				return domain.Tag{Name: n, Slug: slug}, nil
+			},
+		})
+		ctx := context.Background()
+
+		_, err := svc.UpsertByName(ctx, name)
+		if err != nil {
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Fatalf("UpsertByName(%q): unexpected error %v", name, err)
+			}
+			if hasASCIIAlnum(name) {
+				t.Fatalf("UpsertByName(%q) rejected a name containing ASCII letters or digits: %v", name, err)
+			}
+			return
+		}
+
+		if !slugShape.MatchString(got) {
+			t.Fatalf("UpsertByName(%q) produced malformed slug %q", name, got)
+		}
+
+		first := got
+		if _, err := svc.UpsertByName(ctx, first); err != nil {
+			t.Fatalf("UpsertByName(%q) (a slug) failed: %v", first, err)
+		}
+		if got != first {
+			t.Fatalf("slug not idempotent: %q → %q → %q", name, first, got)
+		}
+	})
+}
+
+func hasASCIIAlnum(s string) bool {
+	return strings.ContainsFunc(strings.ToLower(s), func(r rune) bool {
+		return ('a' <= r && r <= 'z') || ('0' <= r && r <= '9')
+	})
+}
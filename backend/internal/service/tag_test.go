@@ -24,6 +24,10 @@ type mockTagRepo struct {
 	listByStop     func(ctx context.Context, stopID uuid.UUID) ([]domain.Tag, error)
 	updateName     func(ctx context.Context, slug, name string) (domain.Tag, error)
 	delete         func(ctx context.Context, slug string) error
+	merge          func(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error)
+	setParent      func(ctx context.Context, slug, parentSlug string) (domain.Tag, error)
+	subtree        func(ctx context.Context, slug string) ([]string, error)
+	stats          func(ctx context.Context) ([]domain.TagStats, error)
 }
 
 func (m *mockTagRepo) Upsert(ctx context.Context, name, slug string) (domain.Tag, error) {
@@ -60,6 +64,26 @@ func (m *mockTagRepo) Delete(ctx context.Context, slug string) error {
 	return nil
 }
 
+func (m *mockTagRepo) Merge(ctx context.Context, fromSlug, intoSlug string) (domain.Tag, error) {
+	return m.merge(ctx, fromSlug, intoSlug)
+}
+
+func (m *mockTagRepo) SetParent(ctx context.Context, slug, parentSlug string) (domain.Tag, error) {
+	return m.setParent(ctx, slug, parentSlug)
+}
+
+// Subtree defaults to a tag with nothing beneath it.
+func (m *mockTagRepo) Subtree(ctx context.Context, slug string) ([]string, error) {
+	if m.subtree != nil {
+		return m.subtree(ctx, slug)
+	}
+	return []string{slug}, nil
+}
+
+func (m *mockTagRepo) Stats(ctx context.Context) ([]domain.TagStats, error) {
+	return m.stats(ctx)
+}
+
 // compile-time check
 var _ repo.TagRepo = (*mockTagRepo)(nil)
 
@@ -241,3 +265,120 @@ func TestTagService_Delete_NotFound(t *testing.T) {
 
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
+
+// ---- Merge -----------------------------------------------------------------
+
+func TestTagService_Merge_OK(t *testing.T) {
+	into := domain.Tag{ID: uuid.New(), Name: "Walmart", Slug: "walmart"}
+	svc := service.NewTagService(&mockTagRepo{
+		merge: func(_ context.Context, fromSlug, intoSlug string) (domain.Tag, error) {
+			assert.Equal(t, "wal-mart", fromSlug)
+			assert.Equal(t, "walmart", intoSlug)
+			return into, nil
+		},
+	})
+
+	got, err := svc.Merge(context.Background(), "wal-mart", "walmart")
+
+	require.NoError(t, err)
+	assert.Equal(t, into, got)
+}
+
+func TestTagService_Merge_IntoItself(t *testing.T) {
+	svc := service.NewTagService(&mockTagRepo{})
+
+	_, err := svc.Merge(context.Background(), "walmart", "walmart")
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestTagService_Merge_IntoTagBeneath(t *testing.T) {
+	svc := service.NewTagService(&mockTagRepo{
+		subtree: func(_ context.Context, slug string) ([]string, error) {
+			assert.Equal(t, "public-lands", slug)
+			return []string{"public-lands", "national-park", "state-park"}, nil
+		},
+	})
+
+	_, err := svc.Merge(context.Background(), "public-lands", "state-park")
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+// ---- SetParent -------------------------------------------------------------
+
+func TestTagService_SetParent_OK(t *testing.T) {
+	svc := service.NewTagService(&mockTagRepo{
+		subtree: func(_ context.Context, slug string) ([]string, error) {
+			assert.Equal(t, "national-park", slug)
+			return []string{"national-park", "yellowstone"}, nil
+		},
+		setParent: func(_ context.Context, slug, parentSlug string) (domain.Tag, error) {
+			assert.Equal(t, "national-park", slug)
+			assert.Equal(t, "public-lands", parentSlug, "normalized")
+			return domain.Tag{Slug: slug, Parent: parentSlug}, nil
+		},
+	})
+
+	got, err := svc.SetParent(context.Background(), "national-park", " Public-Lands ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "public-lands", got.Parent)
+}
+
+func TestTagService_SetParent_Clear(t *testing.T) {
+	svc := service.NewTagService(&mockTagRepo{
+		subtree: func(_ context.Context, _ string) ([]string, error) {
+			t.Fatal("clearing a parent cannot make a cycle")
+			return nil, nil
+		},
+		setParent: func(_ context.Context, slug, parentSlug string) (domain.Tag, error) {
+			assert.Empty(t, parentSlug)
+			return domain.Tag{Slug: slug}, nil
+		},
+	})
+
+	_, err := svc.SetParent(context.Background(), "national-park", "")
+
+	require.NoError(t, err)
+}
+
+func TestTagService_SetParent_Cycle(t *testing.T) {
+	for _, parent := range []string{"public-lands", "yellowstone"} {
+		svc := service.NewTagService(&mockTagRepo{
+			subtree: func(_ context.Context, _ string) ([]string, error) {
+				return []string{"public-lands", "national-park", "yellowstone"}, nil
+			},
+		})
+
+		_, err := svc.SetParent(context.Background(), "public-lands", parent)
+
+		assert.ErrorIs(t, err, domain.ErrValidation, parent)
+	}
+}
+
+func TestTagService_SetParent_NotFound(t *testing.T) {
+	svc := service.NewTagService(&mockTagRepo{
+		subtree: func(_ context.Context, _ string) ([]string, error) {
+			return nil, domain.ErrNotFound
+		},
+	})
+
+	_, err := svc.SetParent(context.Background(), "no-such-slug", "public-lands")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// ---- Stats -----------------------------------------------------------------
+
+func TestTagService_Stats_Empty(t *testing.T) {
+	svc := service.NewTagService(&mockTagRepo{
+		stats: func(_ context.Context) ([]domain.TagStats, error) { return nil, nil },
+	})
+
+	got, err := svc.Stats(context.Background())
+
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// TankService records holding-tank levels and dump events at stops, and
+// reports tank status for the trip in progress.
+type TankService struct {
+	trips  repo.TripRepo
+	stops  repo.StopRepo
+	tanks  repo.TankRepo
+	events EventPublisher
+	clock  domain.Clock
+}
+
+// NewTankService constructs a TankService. Pass domain.SystemClock in
+// production; "current trip" and "days since last dump" are read from clock.
+// events may be nil, and then no tank level or dump events are published.
+func NewTankService(trips repo.TripRepo, stops repo.StopRepo, tanks repo.TankRepo, events EventPublisher, clock domain.Clock) *TankService {
+	return &TankService{trips: trips, stops: stops, tanks: tanks, events: events, clock: clock}
+}
+
+// CreateLevel validates and persists a tank reading at a stop.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *TankService) CreateLevel(ctx context.Context, tripID uuid.UUID, level domain.TankLevel) (domain.TankLevel, error) {
+	if level.RecordedAt.IsZero() {
+		return domain.TankLevel{}, fmt.Errorf("%w: recorded_at is required", domain.ErrValidation)
+	}
+	if level.FreshPct == nil && level.GreyPct == nil && level.BlackPct == nil {
+		return domain.TankLevel{}, fmt.Errorf("%w: at least one of fresh_pct, grey_pct, or black_pct is required", domain.ErrValidation)
+	}
+	for name, pct := range map[string]*int{"fresh_pct": level.FreshPct, "grey_pct": level.GreyPct, "black_pct": level.BlackPct} {
+		if pct != nil && (*pct < 0 || *pct > 100) {
+			return domain.TankLevel{}, fmt.Errorf("%w: %s must be between 0 and 100", domain.ErrValidation, name)
+		}
+	}
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.TankLevel{}, fmt.Errorf("service.TankService.CreateLevel: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, level.StopID); err != nil {
+		return domain.TankLevel{}, fmt.Errorf("service.TankService.CreateLevel: %w", err)
+	}
+
+	created, err := s.tanks.CreateLevel(ctx, level)
+	if err != nil {
+		return domain.TankLevel{}, fmt.Errorf("service.TankService.CreateLevel: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventTankLevelCreated, domain.TripRecordEvent(created.ID, tripID, &created.StopID))
+	return created, nil
+}
+
+// ListLevels returns a stop's tank readings, oldest first.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+func (s *TankService) ListLevels(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.TankLevel, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, fmt.Errorf("service.TankService.ListLevels: %w", err)
+	}
+	levels, err := s.tanks.ListLevelsByStop(ctx, stopID)
+	if err != nil {
+		return nil, fmt.Errorf("service.TankService.ListLevels: %w", err)
+	}
+	return levels, nil
+}
+
+// DeleteLevel removes a tank reading.
+// Returns domain.ErrNotFound if the stop or the reading does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *TankService) DeleteLevel(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.TankService.DeleteLevel: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return fmt.Errorf("service.TankService.DeleteLevel: %w", err)
+	}
+	if err := s.tanks.DeleteLevel(ctx, stopID, id); err != nil {
+		return fmt.Errorf("service.TankService.DeleteLevel: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventTankLevelDeleted, domain.TripRecordEvent(id, tripID, &stopID))
+	return nil
+}
+
+// CreateDump validates and persists a dump event at a stop.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *TankService) CreateDump(ctx context.Context, tripID uuid.UUID, dump domain.DumpEvent) (domain.DumpEvent, error) {
+	if dump.DumpedAt.IsZero() {
+		return domain.DumpEvent{}, fmt.Errorf("%w: dumped_at is required", domain.ErrValidation)
+	}
+	dump.Location = strings.TrimSpace(dump.Location)
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return domain.DumpEvent{}, fmt.Errorf("service.TankService.CreateDump: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, dump.StopID); err != nil {
+		return domain.DumpEvent{}, fmt.Errorf("service.TankService.CreateDump: %w", err)
+	}
+
+	created, err := s.tanks.CreateDump(ctx, dump)
+	if err != nil {
+		return domain.DumpEvent{}, fmt.Errorf("service.TankService.CreateDump: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventDumpCreated, domain.TripRecordEvent(created.ID, tripID, &created.StopID))
+	return created, nil
+}
+
+// ListDumps returns a stop's dump events, oldest first.
+// Returns domain.ErrNotFound if the stop does not exist on the trip.
+func (s *TankService) ListDumps(ctx context.Context, tripID, stopID uuid.UUID) ([]domain.DumpEvent, error) {
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return nil, fmt.Errorf("service.TankService.ListDumps: %w", err)
+	}
+	dumps, err := s.tanks.ListDumpsByStop(ctx, stopID)
+	if err != nil {
+		return nil, fmt.Errorf("service.TankService.ListDumps: %w", err)
+	}
+	return dumps, nil
+}
+
+// DeleteDump removes a dump event.
+// Returns domain.ErrNotFound if the stop or the event does not exist.
+// Returns domain.ErrForbidden if the request's user may only view the trip.
+func (s *TankService) DeleteDump(ctx context.Context, tripID, stopID, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.trips, tripID, domain.TripRoleEditor); err != nil {
+		return fmt.Errorf("service.TankService.DeleteDump: %w", err)
+	}
+	if _, err := s.stops.GetByID(ctx, tripID, stopID); err != nil {
+		return fmt.Errorf("service.TankService.DeleteDump: %w", err)
+	}
+	if err := s.tanks.DeleteDump(ctx, stopID, id); err != nil {
+		return fmt.Errorf("service.TankService.DeleteDump: %w", err)
+	}
+	publishEvent(ctx, s.events, domain.EventDumpDeleted, domain.TripRecordEvent(id, tripID, &stopID))
+	return nil
+}
+
+// CurrentTrip returns the trip in progress (see domain.TripInProgress) with
+// the time since the last dump. Returns domain.ErrNotFound if no trip is in
+// progress.
+func (s *TankService) CurrentTrip(ctx context.Context) (domain.CurrentTrip, error) {
+	now := s.clock.Now()
+
+	trips, err := s.trips.List(ctx)
+	if err != nil {
+		return domain.CurrentTrip{}, fmt.Errorf("service.TankService.CurrentTrip: %w", err)
+	}
+	trip, ok := domain.TripInProgress(trips, now)
+	if !ok {
+		return domain.CurrentTrip{}, fmt.Errorf("service.TankService.CurrentTrip: %w", domain.ErrNotFound)
+	}
+
+	last, err := s.tanks.LatestDump(ctx, now)
+	if err != nil {
+		return domain.CurrentTrip{}, fmt.Errorf("service.TankService.CurrentTrip: %w", err)
+	}
+	current := domain.CurrentTrip{Trip: trip, LastDump: last}
+	if last != nil {
+		days := int(now.Sub(last.DumpedAt).Hours() / 24)
+		current.DaysSinceLastDump = &days
+	}
+	return current, nil
+}
@@ -0,0 +1,255 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memTankRepo is an in-memory repo.TankRepo.
+type memTankRepo struct {
+	levels []domain.TankLevel
+	dumps  []domain.DumpEvent
+}
+
+func (m *memTankRepo) CreateLevel(_ context.Context, l domain.TankLevel) (domain.TankLevel, error) {
+	l.ID = uuid.New()
+	m.levels = append(m.levels, l)
+	return l, nil
+}
+func (m *memTankRepo) ListLevelsByStop(_ context.Context, stopID uuid.UUID) ([]domain.TankLevel, error) {
+	out := []domain.TankLevel{}
+	for _, l := range m.levels {
+		if l.StopID == stopID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+func (m *memTankRepo) DeleteLevel(_ context.Context, stopID, id uuid.UUID) error {
+	for i, l := range m.levels {
+		if l.ID == id && l.StopID == stopID {
+			m.levels = append(m.levels[:i], m.levels[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memTankRepo) CreateDump(_ context.Context, d domain.DumpEvent) (domain.DumpEvent, error) {
+	d.ID = uuid.New()
+	m.dumps = append(m.dumps, d)
+	return d, nil
+}
+func (m *memTankRepo) ListDumpsByStop(_ context.Context, stopID uuid.UUID) ([]domain.DumpEvent, error) {
+	out := []domain.DumpEvent{}
+	for _, d := range m.dumps {
+		if d.StopID == stopID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+func (m *memTankRepo) DeleteDump(_ context.Context, stopID, id uuid.UUID) error {
+	for i, d := range m.dumps {
+		if d.ID == id && d.StopID == stopID {
+			m.dumps = append(m.dumps[:i], m.dumps[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memTankRepo) LatestDump(_ context.Context, at time.Time) (*domain.DumpEvent, error) {
+	var latest *domain.DumpEvent
+	for i, d := range m.dumps {
+		if !d.DumpedAt.After(at) && (latest == nil || d.DumpedAt.After(latest.DumpedAt)) {
+			latest = &m.dumps[i]
+		}
+	}
+	return latest, nil
+}
+
+var _ repo.TankRepo = (*memTankRepo)(nil)
+
+// tankFixture is the world a TankService test runs in: one trip with one
+// stop, a set of trips for "current trip" lookups, and a fixed clock.
+type tankFixture struct {
+	svc    *service.TankService
+	tanks  *memTankRepo
+	trips  []domain.Trip
+	tripID uuid.UUID
+	stopID uuid.UUID
+	clock  *fakeClock
+}
+
+var tankNow = time.Date(2025, 6, 10, 15, 0, 0, 0, time.UTC)
+
+func newTankService() *tankFixture {
+	f := &tankFixture{
+		tanks:  &memTankRepo{},
+		tripID: uuid.New(),
+		stopID: uuid.New(),
+		clock:  &fakeClock{now: tankNow},
+	}
+	trips := &mockTripRepo{
+		list: func(_ context.Context) ([]domain.Trip, error) { return f.trips, nil },
+	}
+	stops := &mockStopRepo{
+		getByID: func(_ context.Context, tripID, stopID uuid.UUID) (domain.Stop, error) {
+			if tripID != f.tripID || stopID != f.stopID {
+				return domain.Stop{}, domain.ErrNotFound
+			}
+			return domain.Stop{ID: stopID, TripID: tripID}, nil
+		},
+	}
+	f.svc = service.NewTankService(trips, stops, f.tanks, nil, f.clock)
+	return f
+}
+
+func pct(v int) *int { return &v }
+
+func TestTankService_CreateLevel(t *testing.T) {
+	f := newTankService()
+
+	got, err := f.svc.CreateLevel(context.Background(), f.tripID, domain.TankLevel{
+		StopID: f.stopID, RecordedAt: tankNow, FreshPct: pct(66), BlackPct: pct(33),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, f.stopID, got.StopID)
+	assert.Nil(t, got.GreyPct)
+	assert.Len(t, f.tanks.levels, 1)
+}
+
+func TestTankService_CreateLevel_Validation(t *testing.T) {
+	cases := map[string]domain.TankLevel{
+		"missing recorded_at": {FreshPct: pct(50)},
+		"no levels":           {RecordedAt: tankNow},
+		"level above 100":     {RecordedAt: tankNow, GreyPct: pct(101)},
+		"level below 0":       {RecordedAt: tankNow, BlackPct: pct(-1)},
+	}
+
+	for name, level := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newTankService()
+			level.StopID = f.stopID
+
+			_, err := f.svc.CreateLevel(context.Background(), f.tripID, level)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+			assert.Empty(t, f.tanks.levels)
+		})
+	}
+}
+
+func TestTankService_CreateLevel_StopOnOtherTrip(t *testing.T) {
+	f := newTankService()
+
+	_, err := f.svc.CreateLevel(context.Background(), uuid.New(), domain.TankLevel{
+		StopID: f.stopID, RecordedAt: tankNow, FreshPct: pct(50),
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTankService_CreateDump(t *testing.T) {
+	f := newTankService()
+
+	got, err := f.svc.CreateDump(context.Background(), f.tripID, domain.DumpEvent{
+		StopID: f.stopID, DumpedAt: tankNow, Location: "  Love's, Green River ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Love's, Green River", got.Location, "location is trimmed")
+}
+
+func TestTankService_CreateDump_RequiresTime(t *testing.T) {
+	f := newTankService()
+
+	_, err := f.svc.CreateDump(context.Background(), f.tripID, domain.DumpEvent{StopID: f.stopID})
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestTankService_DeleteDump_UnknownStop(t *testing.T) {
+	f := newTankService()
+
+	err := f.svc.DeleteDump(context.Background(), f.tripID, uuid.New(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTankService_CurrentTrip(t *testing.T) {
+	f := newTankService()
+	ctx := context.Background()
+	day := func(d int) domain.Date { return domain.NewDate(2025, 6, d) }
+	ended := day(5)
+	f.trips = []domain.Trip{
+		{ID: uuid.New(), Name: "Future", StartDate: day(20)},
+		{ID: f.tripID, Name: "Utah", StartDate: day(7)},
+		{ID: uuid.New(), Name: "Colorado", StartDate: day(1), EndDate: &ended},
+	}
+	_, err := f.svc.CreateDump(ctx, f.tripID, domain.DumpEvent{StopID: f.stopID, DumpedAt: tankNow.Add(-76 * time.Hour)})
+	require.NoError(t, err)
+	// A dump logged ahead of time must not count yet.
+	_, err = f.svc.CreateDump(ctx, f.tripID, domain.DumpEvent{StopID: f.stopID, DumpedAt: tankNow.Add(time.Hour)})
+	require.NoError(t, err)
+
+	current, err := f.svc.CurrentTrip(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Utah", current.Trip.Name)
+	require.NotNil(t, current.DaysSinceLastDump)
+	assert.Equal(t, 3, *current.DaysSinceLastDump, "76 hours is 3 whole days")
+	require.NotNil(t, current.LastDump)
+	assert.True(t, current.LastDump.DumpedAt.Equal(tankNow.Add(-76*time.Hour)))
+}
+
+func TestTankService_CurrentTrip_NoDumps(t *testing.T) {
+	f := newTankService()
+	f.trips = []domain.Trip{{ID: f.tripID, StartDate: domain.DateOf(tankNow)}}
+
+	current, err := f.svc.CurrentTrip(context.Background())
+
+	require.NoError(t, err)
+	assert.Nil(t, current.LastDump)
+	assert.Nil(t, current.DaysSinceLastDump)
+}
+
+func TestTankService_CurrentTrip_NoneInProgress(t *testing.T) {
+	f := newTankService()
+	ended := domain.DateOf(tankNow).AddDays(-1)
+	f.trips = []domain.Trip{{ID: f.tripID, StartDate: ended.AddDays(-5), EndDate: &ended}}
+
+	_, err := f.svc.CurrentTrip(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTankService_CurrentTrip_UsesLocalCalendarDay(t *testing.T) {
+	f := newTankService()
+	// 8pm on June 9 in Mountain time is already June 10 in UTC.
+	f.clock.now = time.Date(2025, 6, 9, 20, 0, 0, 0, time.FixedZone("MDT", -6*60*60))
+	f.trips = []domain.Trip{{ID: f.tripID, StartDate: domain.NewDate(2025, 6, 10)}}
+
+	_, err := f.svc.CurrentTrip(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound, "a trip starting tomorrow has not started tonight")
+}
+
+func TestTankService_CurrentTrip_EndsToday(t *testing.T) {
+	f := newTankService()
+	today := domain.DateOf(tankNow)
+	f.trips = []domain.Trip{{ID: f.tripID, StartDate: today.AddDays(-3), EndDate: &today}}
+
+	_, err := f.svc.CurrentTrip(context.Background())
+
+	assert.NoError(t, err, "a trip is still in progress on its last day")
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// TrashService lists and restores deleted trips and stops, and purges them
+// once they have been in the trash for domain.TrashRetention.
+//
+// An item past the retention window is gone as far as callers can tell even
+// before the purge gets to it: it is neither listed nor restorable.
+type TrashService struct {
+	trash   repo.TrashRepo
+	objects objectstore.Store
+	clock   domain.Clock
+}
+
+// NewTrashService constructs a TrashService. Pass domain.SystemClock in
+// production; the retention window is measured from clock. objects holds the
+// GPX files of route legs and the stops' photos, removed when they are purged.
+func NewTrashService(trash repo.TrashRepo, objects objectstore.Store, clock domain.Clock) *TrashService {
+	return &TrashService{trash: trash, objects: objects, clock: clock}
+}
+
+// List returns the items still in the trash, most recently deleted first.
+func (s *TrashService) List(ctx context.Context) ([]domain.TrashItem, error) {
+	items, err := s.trash.List(ctx, s.cutoff())
+	if err != nil {
+		return nil, fmt.Errorf("service.TrashService.List: %w", err)
+	}
+	return items, nil
+}
+
+// Restore takes a trip or stop out of the trash. Returns domain.ErrNotFound
+// if it is not there and domain.ErrValidation for a stop whose trip is in
+// the trash too.
+func (s *TrashService) Restore(ctx context.Context, id uuid.UUID) (domain.TrashItem, error) {
+	item, err := s.trash.Restore(ctx, id, s.cutoff())
+	if err != nil {
+		return domain.TrashItem{}, fmt.Errorf("service.TrashService.Restore: %w", err)
+	}
+	return item, nil
+}
+
+// Purge deletes the items that have outlived the retention window, then the
+// track files of their route legs and their photos. A file that cannot be removed is left
+// behind rather than failing the purge, as route syncing does.
+func (s *TrashService) Purge(ctx context.Context) error {
+	removed, err := s.trash.Purge(ctx, s.cutoff())
+	if err != nil {
+		return fmt.Errorf("service.TrashService.Purge: %w", err)
+	}
+	for _, key := range removed {
+		_ = s.objects.Delete(ctx, key)
+	}
+	return nil
+}
+
+// cutoff returns the oldest deletion time still inside the retention window.
+func (s *TrashService) cutoff() time.Time {
+	return s.clock.Now().Add(-domain.TrashRetention)
+}
@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// fakeTrashRepo records the cutoffs it is called with.
+type fakeTrashRepo struct {
+	items   []domain.TrashItem
+	purged  []string
+	err     error
+	since   time.Time
+	before  time.Time
+	restore uuid.UUID
+}
+
+func (f *fakeTrashRepo) List(_ context.Context, since time.Time) ([]domain.TrashItem, error) {
+	f.since = since
+	return f.items, f.err
+}
+
+func (f *fakeTrashRepo) Restore(_ context.Context, id uuid.UUID, since time.Time) (domain.TrashItem, error) {
+	f.restore, f.since = id, since
+	if f.err != nil {
+		return domain.TrashItem{}, f.err
+	}
+	return domain.TrashItem{ID: id, Kind: domain.TrashTrip}, nil
+}
+
+func (f *fakeTrashRepo) Purge(_ context.Context, before time.Time) ([]string, error) {
+	f.before = before
+	return f.purged, f.err
+}
+
+var trashNow = time.Date(2025, 8, 31, 12, 0, 0, 0, time.UTC)
+
+func newTrashService(repo *fakeTrashRepo, tracks objectstore.Store) *service.TrashService {
+	return service.NewTrashService(repo, tracks, domain.ClockFunc(func() time.Time { return trashNow }))
+}
+
+func TestTrashService_ListAndRestore_UseRetentionWindow(t *testing.T) {
+	repo := &fakeTrashRepo{items: []domain.TrashItem{{ID: uuid.New()}}}
+	svc := newTrashService(repo, objectstore.NewMemory())
+	want := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	items, err := svc.List(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.True(t, repo.since.Equal(want), "since %v", repo.since)
+
+	id := uuid.New()
+	repo.since = time.Time{}
+	item, err := svc.Restore(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, id, item.ID)
+	assert.True(t, repo.since.Equal(want), "since %v", repo.since)
+}
+
+func TestTrashService_Restore_NotFound(t *testing.T) {
+	svc := newTrashService(&fakeTrashRepo{err: domain.ErrNotFound}, objectstore.NewMemory())
+
+	_, err := svc.Restore(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTrashService_Purge_DeletesTracks(t *testing.T) {
+	ctx := context.Background()
+	tracks := objectstore.NewMemory()
+	require.NoError(t, tracks.Put(ctx, "tracks/a.gpx", strings.NewReader("<gpx/>")))
+	require.NoError(t, tracks.Put(ctx, "tracks/b.gpx", strings.NewReader("<gpx/>")))
+	repo := &fakeTrashRepo{purged: []string{"tracks/a.gpx"}}
+
+	require.NoError(t, newTrashService(repo, tracks).Purge(ctx))
+
+	assert.True(t, repo.before.Equal(trashNow.Add(-domain.TrashRetention)))
+	_, err := tracks.Get(ctx, "tracks/a.gpx")
+	assert.ErrorIs(t, err, objectstore.ErrNotFound)
+	rc, err := tracks.Get(ctx, "tracks/b.gpx")
+	require.NoError(t, err, "tracks of items still in the trash stay")
+	rc.Close()
+}
+
+func TestTrashService_Purge_RepoError(t *testing.T) {
+	boom := errors.New("boom")
+	svc := newTrashService(&fakeTrashRepo{err: boom}, objectstore.NewMemory())
+
+	assert.ErrorIs(t, svc.Purge(context.Background()), boom)
+}
@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -16,12 +17,18 @@ import (
 
 // TripService implements business logic for Trip operations.
 type TripService struct {
-	repo repo.TripRepo
+	repo   repo.TripRepo
+	fields repo.CustomFieldRepo
+	rigs   repo.RigRepo
+	events EventPublisher
 }
 
 // NewTripService constructs a TripService backed by the provided TripRepo.
-func NewTripService(r repo.TripRepo) *TripService {
-	return &TripService{repo: r}
+// Trip metadata is validated against the custom field definitions in fields,
+// and the rig a trip names must be one of rigs. Trips created, updated, and
+// deleted are published to events, which may be nil.
+func NewTripService(r repo.TripRepo, fields repo.CustomFieldRepo, rigs repo.RigRepo, events EventPublisher) *TripService {
+	return &TripService{repo: r, fields: fields, rigs: rigs, events: events}
 }
 
 // Create validates and persists a new trip.
@@ -30,10 +37,19 @@ func (s *TripService) Create(ctx context.Context, trip domain.Trip) (domain.Trip
 	if err := validateTrip(trip); err != nil {
 		return domain.Trip{}, err
 	}
+	if err := s.checkRig(ctx, trip.RigID); err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Create: %w", err)
+	}
+	metadata, err := checkMetadata(ctx, s.fields, domain.CustomFieldTrip, trip.Metadata)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Create: %w", err)
+	}
+	trip.Metadata = metadata
 	result, err := s.repo.Create(ctx, trip)
 	if err != nil {
 		return domain.Trip{}, fmt.Errorf("service.TripService.Create: %w", err)
 	}
+	s.publish(ctx, domain.EventTripCreated, domain.TripEvent(result))
 	return result, nil
 }
 
@@ -61,9 +77,15 @@ func (s *TripService) List(ctx context.Context) ([]domain.Trip, error) {
 }
 
 // ListPaged returns one page of trips and the total count across all pages.
-// The caller controls page and limit via domain.PaginationParams.
-func (s *TripService) ListPaged(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error) {
-	trips, total, err := s.repo.ListPaged(ctx, p)
+// The caller controls page and limit via domain.PaginationParams. Each entry
+// in fields is a "key:value" custom field filter; only trips matching all of
+// them are listed. Returns domain.ErrValidation for a bad filter.
+func (s *TripService) ListPaged(ctx context.Context, fields []string, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+	filter, err := metadataFilter(ctx, s.fields, domain.CustomFieldTrip, fields)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service.TripService.ListPaged: %w", err)
+	}
+	trips, total, err := s.repo.ListPaged(ctx, filter, p)
 	if err != nil {
 		return nil, 0, fmt.Errorf("service.TripService.ListPaged: %w", err)
 	}
@@ -75,30 +97,129 @@ func (s *TripService) ListPaged(ctx context.Context, p domain.PaginationParams)
 
 // Update validates and persists changes to an existing trip.
 // Returns domain.ErrValidation for invalid input, domain.ErrNotFound if the
-// trip does not exist.
+// trip does not exist, and domain.ErrForbidden if the request's user may
+// only view it.
 func (s *TripService) Update(ctx context.Context, trip domain.Trip) (domain.Trip, error) {
 	if err := validateTrip(trip); err != nil {
 		return domain.Trip{}, err
 	}
+	if err := requireTripRole(ctx, s.repo, trip.ID, domain.TripRoleEditor); err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Update: %w", err)
+	}
+	if err := s.checkRig(ctx, trip.RigID); err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Update: %w", err)
+	}
+	metadata, err := checkMetadata(ctx, s.fields, domain.CustomFieldTrip, trip.Metadata)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Update: %w", err)
+	}
+	trip.Metadata = metadata
 	result, err := s.repo.Update(ctx, trip)
 	if err != nil {
 		return domain.Trip{}, fmt.Errorf("service.TripService.Update: %w", err)
 	}
+	s.publish(ctx, domain.EventTripUpdated, domain.TripEvent(result))
 	return result, nil
 }
 
 // Delete removes a trip by ID.
-// Returns domain.ErrNotFound if no trip with that ID exists.
+// Returns domain.ErrNotFound if no trip with that ID exists and
+// domain.ErrForbidden if the request's user does not own it.
 func (s *TripService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := requireTripRole(ctx, s.repo, id, domain.TripRoleOwner); err != nil {
+		return fmt.Errorf("service.TripService.Delete: %w", err)
+	}
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("service.TripService.Delete: %w", err)
 	}
+	s.publish(ctx, domain.EventTripDeleted, domain.TripEventData{ID: id})
+	return nil
+}
+
+// Clone copies a trip, and whichever of its parts c asks for, into a new
+// trip. A blank name becomes the source's name with " (copy)" after it, and
+// a start date moves every date and time of the copy by the same number of
+// days. Returns domain.ErrNotFound if the source does not exist and
+// domain.ErrValidation if tags or checklists are asked for without stops.
+func (s *TripService) Clone(ctx context.Context, sourceID uuid.UUID, c domain.TripClone) (domain.Trip, error) {
+	if (c.Parts.Tags || c.Parts.Checklists) && !c.Parts.Stops {
+		return domain.Trip{}, fmt.Errorf("%w: tags and checklists are copied with their stops, so stops must be included", domain.ErrValidation)
+	}
+	source, err := s.repo.GetByID(ctx, sourceID)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Clone: %w", err)
+	}
+	name := c.Name
+	if strings.TrimSpace(name) == "" {
+		name = source.Name + " (copy)"
+	}
+	shiftDays := 0
+	if c.StartDate != nil {
+		shiftDays = c.StartDate.DaysSince(source.StartDate)
+	}
+	result, err := s.repo.Clone(ctx, sourceID, name, shiftDays, c.Parts)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Clone: %w", err)
+	}
+	s.publish(ctx, domain.EventTripCreated, domain.TripEvent(result))
+	return result, nil
+}
+
+// publish announces a trip event when the service has somewhere to send it.
+func (s *TripService) publish(ctx context.Context, event domain.WebhookEvent, data domain.TripEventData) {
+	if s.events != nil {
+		s.events.Publish(ctx, event, data)
+	}
+}
+
+// History returns every recorded version of a trip, newest first.
+// Returns domain.ErrNotFound if the trip does not exist or is in the trash.
+func (s *TripService) History(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("service.TripService.History: %w", err)
+	}
+	revisions, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("service.TripService.History: %w", err)
+	}
+	return revisions, nil
+}
+
+// Revert puts a trip's editable fields back as they were at the given
+// revision. The revert is itself an update, so it is recorded as a new
+// revision and history is never rewritten.
+// Returns domain.ErrNotFound if the trip or the revision does not exist.
+func (s *TripService) Revert(ctx context.Context, id uuid.UUID, revision int) (domain.Trip, error) {
+	current, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Revert: %w", err)
+	}
+	rev, err := s.repo.GetRevision(ctx, id, revision)
+	if err != nil {
+		return domain.Trip{}, fmt.Errorf("service.TripService.Revert: %w", err)
+	}
+	return s.Update(ctx, rev.Apply(current))
+}
+
+// checkRig confirms the rig a trip names exists. A trip naming no rig is
+// always fine.
+func (s *TripService) checkRig(ctx context.Context, rigID *uuid.UUID) error {
+	if rigID == nil {
+		return nil
+	}
+	if _, err := s.rigs.GetByID(ctx, *rigID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: rig_id names no rig", domain.ErrValidation)
+		}
+		return err
+	}
 	return nil
 }
 
 // validateTrip enforces business rules common to both Create and Update.
 //   - Name must be non-empty (whitespace-only names are rejected).
 //   - EndDate, if set, must not be before StartDate.
+//   - BudgetCents, if set, must not be negative.
 func validateTrip(trip domain.Trip) error {
 	if strings.TrimSpace(trip.Name) == "" {
 		return fmt.Errorf("%w: name is required", domain.ErrValidation)
@@ -106,5 +227,8 @@ func validateTrip(trip domain.Trip) error {
 	if trip.EndDate != nil && trip.EndDate.Before(trip.StartDate) {
 		return fmt.Errorf("%w: end_date must not be before start_date", domain.ErrValidation)
 	}
+	if trip.BudgetCents != nil && *trip.BudgetCents < 0 {
+		return fmt.Errorf("%w: budget_cents must not be negative", domain.ErrValidation)
+	}
 	return nil
 }
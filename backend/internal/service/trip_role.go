@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// requireTripRole returns domain.ErrForbidden unless the request's user has
+// at least need on the trip: its owner, or for domain.TripRoleEditor an
+// editor too. Viewers may only read. It returns domain.ErrNotFound if the
+// user cannot see the trip, so it also stands in for checking it exists.
+//
+// Every service that changes a trip, or anything logged against one, calls
+// it before writing, whichever API the change came in through.
+func requireTripRole(ctx context.Context, trips repo.TripRepo, tripID uuid.UUID, need domain.TripRole) error {
+	role, err := trips.Role(ctx, tripID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case need == domain.TripRoleOwner && role != domain.TripRoleOwner:
+		return fmt.Errorf("%w: only the trip's owner may do this", domain.ErrForbidden)
+	case !role.CanEdit():
+		return fmt.Errorf("%w: viewers cannot change this trip", domain.ErrForbidden)
+	}
+	return nil
+}
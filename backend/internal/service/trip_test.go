@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +12,7 @@ import (
 	"github.com/pkordes/rv-logbook/backend/internal/domain"
 	"github.com/pkordes/rv-logbook/backend/internal/repo"
 	"github.com/pkordes/rv-logbook/backend/internal/service"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
 )
 
 // mockTripRepo is a hand-written test double for repo.TripRepo.
@@ -22,10 +22,28 @@ import (
 type mockTripRepo struct {
 	create    func(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 	getByID   func(ctx context.Context, id uuid.UUID) (domain.Trip, error)
+	role      func(ctx context.Context, id uuid.UUID) (domain.TripRole, error)
 	list      func(ctx context.Context) ([]domain.Trip, error)
-	listPaged func(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error)
+	listPaged func(ctx context.Context, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Trip, int64, error)
 	update    func(ctx context.Context, trip domain.Trip) (domain.Trip, error)
 	delete    func(ctx context.Context, id uuid.UUID) error
+	clone     func(ctx context.Context, sourceID uuid.UUID, name string, shiftDays int, parts domain.TripCloneParts) (domain.Trip, error)
+
+	listRevisions func(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error)
+	getRevision   func(ctx context.Context, id uuid.UUID, revision int) (domain.TripRevision, error)
+}
+
+// rolesTripRepo gives the request's user a fixed role on each trip in
+// roles; any other trip is not found. Changes to roles are seen by later
+// calls.
+func rolesTripRepo(roles map[uuid.UUID]domain.TripRole) *mockTripRepo {
+	return &mockTripRepo{role: func(_ context.Context, id uuid.UUID) (domain.TripRole, error) {
+		role, ok := roles[id]
+		if !ok {
+			return "", domain.ErrNotFound
+		}
+		return role, nil
+	}}
 }
 
 func (m *mockTripRepo) Create(ctx context.Context, trip domain.Trip) (domain.Trip, error) {
@@ -34,12 +52,26 @@ func (m *mockTripRepo) Create(ctx context.Context, trip domain.Trip) (domain.Tri
 func (m *mockTripRepo) GetByID(ctx context.Context, id uuid.UUID) (domain.Trip, error) {
 	return m.getByID(ctx, id)
 }
+
+// Role defaults to the owner of any trip GetByID finds, so tests that do not
+// care about roles need not stub it.
+func (m *mockTripRepo) Role(ctx context.Context, id uuid.UUID) (domain.TripRole, error) {
+	if m.role != nil {
+		return m.role(ctx, id)
+	}
+	if m.getByID != nil {
+		if _, err := m.getByID(ctx, id); err != nil {
+			return "", err
+		}
+	}
+	return domain.TripRoleOwner, nil
+}
 func (m *mockTripRepo) List(ctx context.Context) ([]domain.Trip, error) {
 	return m.list(ctx)
 }
-func (m *mockTripRepo) ListPaged(ctx context.Context, p domain.PaginationParams) ([]domain.Trip, int64, error) {
+func (m *mockTripRepo) ListPaged(ctx context.Context, f domain.MetadataFilter, p domain.PaginationParams) ([]domain.Trip, int64, error) {
 	if m.listPaged != nil {
-		return m.listPaged(ctx, p)
+		return m.listPaged(ctx, f, p)
 	}
 	return nil, 0, nil
 }
@@ -49,22 +81,21 @@ func (m *mockTripRepo) Update(ctx context.Context, trip domain.Trip) (domain.Tri
 func (m *mockTripRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.delete(ctx, id)
 }
+func (m *mockTripRepo) Clone(ctx context.Context, sourceID uuid.UUID, name string, shiftDays int, parts domain.TripCloneParts) (domain.Trip, error) {
+	return m.clone(ctx, sourceID, name, shiftDays, parts)
+}
+func (m *mockTripRepo) ListRevisions(ctx context.Context, id uuid.UUID) ([]domain.TripRevision, error) {
+	return m.listRevisions(ctx, id)
+}
+func (m *mockTripRepo) GetRevision(ctx context.Context, id uuid.UUID, revision int) (domain.TripRevision, error) {
+	return m.getRevision(ctx, id, revision)
+}
 
 // compile-time check: mockTripRepo must satisfy repo.TripRepo.
 var _ repo.TripRepo = (*mockTripRepo)(nil)
 
 // ---- helpers ---------------------------------------------------------------
 
-func validTrip() domain.Trip {
-	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
-	return domain.Trip{
-		Name:      "Summer Tour",
-		StartDate: start,
-		EndDate:   &end,
-	}
-}
-
 func echoRepo() *mockTripRepo {
 	// A repo that echoes whatever it receives back — useful for Create/Update tests
 	// that only care about validation logic, not what the DB returns.
@@ -77,18 +108,18 @@ func echoRepo() *mockTripRepo {
 // ---- Create tests ----------------------------------------------------------
 
 func TestTripService_Create_Valid(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	got, err := svc.Create(context.Background(), validTrip())
+	got, err := svc.Create(context.Background(), factory.Trip().Build())
 
 	require.NoError(t, err)
 	assert.Equal(t, "Summer Tour", got.Name)
 }
 
 func TestTripService_Create_MissingName(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
+	trip := factory.Trip().Build()
 	trip.Name = "   " // whitespace-only should be treated as empty
 
 	_, err := svc.Create(context.Background(), trip)
@@ -97,10 +128,10 @@ func TestTripService_Create_MissingName(t *testing.T) {
 }
 
 func TestTripService_Create_EndDateBeforeStartDate(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
-	bad := trip.StartDate.AddDate(0, 0, -1) // one day before start
+	trip := factory.Trip().Build()
+	bad := trip.StartDate.AddDays(-1) // one day before start
 	trip.EndDate = &bad
 
 	_, err := svc.Create(context.Background(), trip)
@@ -108,10 +139,22 @@ func TestTripService_Create_EndDateBeforeStartDate(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrValidation)
 }
 
+func TestTripService_Create_NegativeBudget(t *testing.T) {
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
+
+	trip := factory.Trip().Build()
+	budget := int64(-100)
+	trip.BudgetCents = &budget
+
+	_, err := svc.Create(context.Background(), trip)
+
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
 func TestTripService_Create_EndDateEqualToStartDate(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
+	trip := factory.Trip().Build()
 	same := trip.StartDate // same day — a one-day trip is valid
 	trip.EndDate = &same
 
@@ -122,9 +165,9 @@ func TestTripService_Create_EndDateEqualToStartDate(t *testing.T) {
 }
 
 func TestTripService_Create_NilEndDate(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
+	trip := factory.Trip().Build()
 	trip.EndDate = nil // trip still in progress — valid
 
 	_, err := svc.Create(context.Background(), trip)
@@ -139,9 +182,9 @@ func TestTripService_Create_RepoError(t *testing.T) {
 			return domain.Trip{}, repoErr
 		},
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
-	_, err := svc.Create(context.Background(), validTrip())
+	_, err := svc.Create(context.Background(), factory.Trip().Build())
 
 	// The service should propagate repo errors unchanged.
 	assert.ErrorIs(t, err, repoErr)
@@ -150,7 +193,7 @@ func TestTripService_Create_RepoError(t *testing.T) {
 // ---- GetByID tests ---------------------------------------------------------
 
 func TestTripService_GetByID_Found(t *testing.T) {
-	want := validTrip()
+	want := factory.Trip().Build()
 	want.ID = uuid.New()
 
 	r := &mockTripRepo{
@@ -158,7 +201,7 @@ func TestTripService_GetByID_Found(t *testing.T) {
 			return want, nil
 		},
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
 	got, err := svc.GetByID(context.Background(), want.ID)
 
@@ -172,7 +215,7 @@ func TestTripService_GetByID_NotFound(t *testing.T) {
 			return domain.Trip{}, domain.ErrNotFound
 		},
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
 	_, err := svc.GetByID(context.Background(), uuid.New())
 
@@ -182,11 +225,11 @@ func TestTripService_GetByID_NotFound(t *testing.T) {
 // ---- List tests ------------------------------------------------------------
 
 func TestTripService_List(t *testing.T) {
-	trips := []domain.Trip{validTrip(), validTrip()}
+	trips := []domain.Trip{factory.Trip().Build(), factory.Trip().Build()}
 	r := &mockTripRepo{
 		list: func(_ context.Context) ([]domain.Trip, error) { return trips, nil },
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
 	got, err := svc.List(context.Background())
 
@@ -198,7 +241,7 @@ func TestTripService_List_Empty(t *testing.T) {
 	r := &mockTripRepo{
 		list: func(_ context.Context) ([]domain.Trip, error) { return nil, nil },
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
 	got, err := svc.List(context.Background())
 
@@ -211,9 +254,9 @@ func TestTripService_List_Empty(t *testing.T) {
 // ---- Update tests ----------------------------------------------------------
 
 func TestTripService_Update_Valid(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
+	trip := factory.Trip().Build()
 	trip.ID = uuid.New()
 	trip.Name = "Renamed Trip"
 
@@ -224,9 +267,9 @@ func TestTripService_Update_Valid(t *testing.T) {
 }
 
 func TestTripService_Update_MissingName(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
+	trip := factory.Trip().Build()
 	trip.Name = ""
 
 	_, err := svc.Update(context.Background(), trip)
@@ -235,10 +278,10 @@ func TestTripService_Update_MissingName(t *testing.T) {
 }
 
 func TestTripService_Update_EndDateBeforeStartDate(t *testing.T) {
-	svc := service.NewTripService(echoRepo())
+	svc := service.NewTripService(echoRepo(), nil, nil, nil)
 
-	trip := validTrip()
-	bad := trip.StartDate.AddDate(0, 0, -1)
+	trip := factory.Trip().Build()
+	bad := trip.StartDate.AddDays(-1)
 	trip.EndDate = &bad
 
 	_, err := svc.Update(context.Background(), trip)
@@ -252,7 +295,7 @@ func TestTripService_Delete_OK(t *testing.T) {
 	r := &mockTripRepo{
 		delete: func(_ context.Context, _ uuid.UUID) error { return nil },
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
 	err := svc.Delete(context.Background(), uuid.New())
 
@@ -263,9 +306,152 @@ func TestTripService_Delete_NotFound(t *testing.T) {
 	r := &mockTripRepo{
 		delete: func(_ context.Context, _ uuid.UUID) error { return domain.ErrNotFound },
 	}
-	svc := service.NewTripService(r)
+	svc := service.NewTripService(r, nil, nil, nil)
 
 	err := svc.Delete(context.Background(), uuid.New())
 
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
+
+func TestTripService_Publishes(t *testing.T) {
+	id := uuid.New()
+	r := echoRepo()
+	r.delete = func(_ context.Context, _ uuid.UUID) error { return nil }
+	events := &recordingPublisher{}
+	svc := service.NewTripService(r, nil, nil, events)
+	ctx := context.Background()
+
+	trip := factory.Trip().Build()
+	trip.ID = id
+	_, err := svc.Create(ctx, trip)
+	require.NoError(t, err)
+	_, err = svc.Update(ctx, trip)
+	require.NoError(t, err)
+	require.NoError(t, svc.Delete(ctx, id))
+
+	assert.Equal(t, []domain.WebhookEvent{domain.EventTripCreated, domain.EventTripUpdated, domain.EventTripDeleted}, events.events)
+	assert.Equal(t, domain.TripEvent(trip), events.data[0])
+	assert.Equal(t, domain.TripEventData{ID: id}, events.data[2])
+}
+
+func TestTripService_FailedDelete_PublishesNothing(t *testing.T) {
+	r := &mockTripRepo{
+		delete: func(_ context.Context, _ uuid.UUID) error { return domain.ErrNotFound },
+	}
+	events := &recordingPublisher{}
+	svc := service.NewTripService(r, nil, nil, events)
+
+	_ = svc.Delete(context.Background(), uuid.New())
+
+	assert.Empty(t, events.events)
+}
+
+// ---- Clone tests -----------------------------------------------------------
+
+func TestTripService_Clone_ShiftsToStartDate(t *testing.T) {
+	source := factory.Trip().WithID(uuid.New()).WithStartDate(domain.NewDate(2025, 6, 1)).Build()
+	r := &mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) { return source, nil },
+		clone: func(_ context.Context, sourceID uuid.UUID, name string, shiftDays int, parts domain.TripCloneParts) (domain.Trip, error) {
+			assert.Equal(t, source.ID, sourceID)
+			assert.Equal(t, "Summer Tour 2026", name)
+			assert.Equal(t, 365, shiftDays)
+			assert.Equal(t, domain.TripCloneParts{Stops: true, Expenses: true}, parts)
+			return domain.Trip{ID: uuid.New(), Name: name}, nil
+		},
+	}
+	events := &recordingPublisher{}
+	svc := service.NewTripService(r, nil, nil, events)
+	start := domain.NewDate(2026, 6, 1)
+
+	got, err := svc.Clone(context.Background(), source.ID, domain.TripClone{
+		Name: "Summer Tour 2026", StartDate: &start, Parts: domain.TripCloneParts{Stops: true, Expenses: true},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Summer Tour 2026", got.Name)
+	assert.Equal(t, []domain.WebhookEvent{domain.EventTripCreated}, events.events)
+}
+
+func TestTripService_Clone_DefaultsNameAndKeepsDates(t *testing.T) {
+	r := &mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) { return factory.Trip().Build(), nil },
+		clone: func(_ context.Context, _ uuid.UUID, name string, shiftDays int, _ domain.TripCloneParts) (domain.Trip, error) {
+			assert.Equal(t, 0, shiftDays)
+			return domain.Trip{Name: name}, nil
+		},
+	}
+	svc := service.NewTripService(r, nil, nil, nil)
+
+	got, err := svc.Clone(context.Background(), uuid.New(), domain.TripClone{Name: "  "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Summer Tour (copy)", got.Name)
+}
+
+func TestTripService_Clone_TagsNeedStops(t *testing.T) {
+	svc := service.NewTripService(&mockTripRepo{}, nil, nil, nil)
+
+	for _, parts := range []domain.TripCloneParts{{Tags: true}, {Checklists: true, Expenses: true}} {
+		_, err := svc.Clone(context.Background(), uuid.New(), domain.TripClone{Parts: parts})
+
+		assert.ErrorIs(t, err, domain.ErrValidation, "%+v", parts)
+	}
+}
+
+func TestTripService_Clone_NotFound(t *testing.T) {
+	r := &mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) { return domain.Trip{}, domain.ErrNotFound },
+	}
+	svc := service.NewTripService(r, nil, nil, nil)
+
+	_, err := svc.Clone(context.Background(), uuid.New(), domain.TripClone{})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// ---- History / Revert tests ------------------------------------------------
+
+func TestTripService_History_NotFound(t *testing.T) {
+	r := &mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) { return domain.Trip{}, domain.ErrNotFound },
+	}
+	svc := service.NewTripService(r, nil, nil, nil)
+
+	_, err := svc.History(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestTripService_Revert_AppliesRevision(t *testing.T) {
+	current := factory.Trip().WithID(uuid.New()).WithName("Utah (renamed)").WithNotes("new").Build()
+	r := echoRepo()
+	r.getByID = func(_ context.Context, _ uuid.UUID) (domain.Trip, error) { return current, nil }
+	r.getRevision = func(_ context.Context, id uuid.UUID, revision int) (domain.TripRevision, error) {
+		assert.Equal(t, current.ID, id)
+		assert.Equal(t, 1, revision)
+		return domain.TripRevision{TripID: id, Revision: 1, Name: "Utah", StartDate: current.StartDate, Notes: "old"}, nil
+	}
+	svc := service.NewTripService(r, nil, nil, nil)
+
+	got, err := svc.Revert(context.Background(), current.ID, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, current.ID, got.ID)
+	assert.Equal(t, "Utah", got.Name)
+	assert.Equal(t, "old", got.Notes)
+}
+
+func TestTripService_Revert_RevisionNotFound(t *testing.T) {
+	r := &mockTripRepo{
+		getByID: func(_ context.Context, _ uuid.UUID) (domain.Trip, error) { return factory.Trip().Build(), nil },
+		getRevision: func(_ context.Context, _ uuid.UUID, _ int) (domain.TripRevision, error) {
+			return domain.TripRevision{}, domain.ErrNotFound
+		},
+	}
+	svc := service.NewTripService(r, nil, nil, nil)
+
+	_, err := svc.Revert(context.Background(), uuid.New(), 9)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// WebhookTimeout bounds each delivery, so a slow subscriber cannot pile up
+// open requests.
+const WebhookTimeout = 10 * time.Second
+
+// WebhookDeliveryLogSize is how many of a webhook's latest delivery attempts
+// the log returns.
+const WebhookDeliveryLogSize = 50
+
+// Headers sent with every delivery. WebhookSignatureHeader carries
+// "sha256=" and the hex HMAC-SHA256 of the body, keyed with the secret.
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// EventPublisher announces changes made by a service. *EventBus satisfies
+// it, and so does *WebhookService. Services accept a nil EventPublisher and
+// then publish nothing.
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.WebhookEvent, data any)
+}
+
+// WebhookService manages webhook subscriptions and delivers events to them.
+type WebhookService struct {
+	webhooks repo.WebhookRepo
+	client   *http.Client
+	clock    domain.Clock
+
+	wg sync.WaitGroup // deliveries started by Publish
+}
+
+var _ EventPublisher = (*WebhookService)(nil)
+
+// NewWebhookService constructs a WebhookService. A nil client uses one with
+// WebhookTimeout.
+func NewWebhookService(webhooks repo.WebhookRepo, client *http.Client, clock domain.Clock) *WebhookService {
+	if client == nil {
+		client = &http.Client{Timeout: WebhookTimeout}
+	}
+	return &WebhookService{webhooks: webhooks, client: client, clock: clock}
+}
+
+// Create validates a webhook, gives it a new secret, and persists it.
+func (s *WebhookService) Create(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	w, err := normalizeWebhook(w)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+	if w.Secret, err = newWebhookSecret(); err != nil {
+		return domain.Webhook{}, fmt.Errorf("service.WebhookService.Create: %w", err)
+	}
+
+	created, err := s.webhooks.Create(ctx, w)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("service.WebhookService.Create: %w", err)
+	}
+	return created, nil
+}
+
+// GetByID returns a webhook.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *WebhookService) GetByID(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	w, err := s.webhooks.GetByID(ctx, id)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("service.WebhookService.GetByID: %w", err)
+	}
+	return w, nil
+}
+
+// List returns every webhook in the order they were created.
+func (s *WebhookService) List(ctx context.Context) ([]domain.Webhook, error) {
+	webhooks, err := s.webhooks.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service.WebhookService.List: %w", err)
+	}
+	return webhooks, nil
+}
+
+// Update validates and overwrites a webhook. The secret only changes
+// through RotateSecret.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *WebhookService) Update(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	w, err := normalizeWebhook(w)
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+	updated, err := s.webhooks.Update(ctx, w)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("service.WebhookService.Update: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a webhook and its delivery log.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *WebhookService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.webhooks.Delete(ctx, id); err != nil {
+		return fmt.Errorf("service.WebhookService.Delete: %w", err)
+	}
+	return nil
+}
+
+// RotateSecret replaces a webhook's secret with a new one. Deliveries are
+// signed with the new secret from then on.
+// Returns domain.ErrNotFound if it does not exist.
+func (s *WebhookService) RotateSecret(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("service.WebhookService.RotateSecret: %w", err)
+	}
+	w, err := s.webhooks.SetSecret(ctx, id, secret)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("service.WebhookService.RotateSecret: %w", err)
+	}
+	return w, nil
+}
+
+// Test sends a ping event to a webhook, active or not, and returns the
+// logged attempt. A subscriber that fails is reported in the attempt, not
+// as an error.
+// Returns domain.ErrNotFound if the webhook does not exist.
+func (s *WebhookService) Test(ctx context.Context, id uuid.UUID) (domain.WebhookDelivery, error) {
+	w, err := s.webhooks.GetByID(ctx, id)
+	if err != nil {
+		return domain.WebhookDelivery{}, fmt.Errorf("service.WebhookService.Test: %w", err)
+	}
+	d, err := s.deliver(ctx, w, domain.EventPing, map[string]uuid.UUID{"webhook_id": w.ID})
+	if err != nil {
+		return domain.WebhookDelivery{}, fmt.Errorf("service.WebhookService.Test: %w", err)
+	}
+	return d, nil
+}
+
+// Deliveries returns a webhook's latest delivery attempts, newest first.
+// Returns domain.ErrNotFound if the webhook does not exist.
+func (s *WebhookService) Deliveries(ctx context.Context, id uuid.UUID) ([]domain.WebhookDelivery, error) {
+	if _, err := s.webhooks.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("service.WebhookService.Deliveries: %w", err)
+	}
+	deliveries, err := s.webhooks.ListDeliveries(ctx, id, WebhookDeliveryLogSize)
+	if err != nil {
+		return nil, fmt.Errorf("service.WebhookService.Deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Publish delivers event to every active webhook in the request's
+// organization that subscribes to it and, for an event about a trip, whose
+// owner can see that trip. It returns at once: the deliveries
+// run in the background, once each, and failures are only logged. Events no
+// webhook can subscribe to are ignored.
+//
+// It is the webhook relay: subscribe it to the EventBus the services
+// publish to.
+func (s *WebhookService) Publish(ctx context.Context, event domain.WebhookEvent, data any) {
+	if !event.Subscribable() {
+		return
+	}
+	// The deliveries outlive the request that caused them.
+	ctx = context.WithoutCancel(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		webhooks, err := s.webhooks.ListSubscribed(ctx, event, domain.EventTripID(data))
+		if err != nil {
+			slog.ErrorContext(ctx, "listing webhooks failed", "event", event, "error", err)
+			return
+		}
+		for _, w := range webhooks {
+			d, err := s.deliver(ctx, w, event, data)
+			if err != nil {
+				slog.ErrorContext(ctx, "recording webhook delivery failed", "webhook_id", w.ID, "event", event, "error", err)
+				continue
+			}
+			if !d.Succeeded() {
+				slog.WarnContext(ctx, "webhook delivery failed", "webhook_id", w.ID, "event", event, "status", d.StatusCode, "error", d.Error)
+			}
+		}
+	}()
+}
+
+// Wait blocks until every delivery started by Publish has finished. Each is
+// bounded by WebhookTimeout.
+func (s *WebhookService) Wait() {
+	s.wg.Wait()
+}
+
+// deliver POSTs one event to w and logs the attempt. The error is only for
+// failing to build or record the attempt; the subscriber's answer is in the
+// returned delivery.
+func (s *WebhookService) deliver(ctx context.Context, w domain.Webhook, event domain.WebhookEvent, data any) (domain.WebhookDelivery, error) {
+	payload := domain.WebhookPayload{ID: uuid.New(), Event: event, OccurredAt: s.clock.Now().UTC(), Data: data}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	d := domain.WebhookDelivery{ID: payload.ID, WebhookID: w.ID, Event: event, Payload: body}
+
+	ctx, cancel := context.WithTimeout(ctx, WebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "rv-logbook-webhooks")
+	req.Header.Set(WebhookEventHeader, string(event))
+	req.Header.Set(WebhookDeliveryHeader, payload.ID.String())
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(w.Secret, body))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	d.Duration = time.Since(start)
+	if err != nil {
+		d.Error = err.Error()
+	} else {
+		// Drain a little so the connection can be reused; the body is ignored.
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+		d.StatusCode = resp.StatusCode
+	}
+
+	// The attempt is recorded even when the caller has given up.
+	recorded, err := s.webhooks.CreateDelivery(context.WithoutCancel(ctx), d)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	return recorded, nil
+}
+
+// SignWebhook returns the X-Webhook-Signature value for body: "sha256="
+// followed by the hex HMAC-SHA256 of body keyed with secret. Subscribers
+// compute the same over the raw body to check a delivery came from here.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookSecret returns a random signing secret.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// normalizeWebhook trims a webhook's fields, drops repeated events, and
+// enforces the rules shared by create and update.
+func normalizeWebhook(w domain.Webhook) (domain.Webhook, error) {
+	w.URL = strings.TrimSpace(w.URL)
+	w.Description = strings.TrimSpace(w.Description)
+
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return w, fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrValidation)
+	}
+	if len(w.Events) == 0 {
+		return w, fmt.Errorf("%w: events must name at least one event", domain.ErrValidation)
+	}
+	events := make([]domain.WebhookEvent, 0, len(w.Events))
+	seen := map[domain.WebhookEvent]bool{}
+	for _, e := range w.Events {
+		if !e.Subscribable() {
+			return w, fmt.Errorf("%w: unknown event %q", domain.ErrValidation, e)
+		}
+		if !seen[e] {
+			seen[e] = true
+			events = append(events, e)
+		}
+	}
+	w.Events = events
+	return w, nil
+}
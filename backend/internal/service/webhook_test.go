@@ -0,0 +1,351 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/internal/service"
+)
+
+// memWebhookRepo is an in-memory repo.WebhookRepo. Deliveries are recorded
+// from background goroutines, so it is guarded by a mutex.
+type memWebhookRepo struct {
+	mu         sync.Mutex
+	webhooks   []domain.Webhook
+	deliveries []domain.WebhookDelivery
+	tripIDs    []*uuid.UUID // passed to ListSubscribed
+}
+
+func (m *memWebhookRepo) Create(_ context.Context, w domain.Webhook) (domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	w.ID = uuid.New()
+	m.webhooks = append(m.webhooks, w)
+	return w, nil
+}
+func (m *memWebhookRepo) GetByID(_ context.Context, id uuid.UUID) (domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, w := range m.webhooks {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return domain.Webhook{}, domain.ErrNotFound
+}
+func (m *memWebhookRepo) List(_ context.Context) ([]domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]domain.Webhook{}, m.webhooks...), nil
+}
+func (m *memWebhookRepo) ListSubscribed(_ context.Context, event domain.WebhookEvent, tripID *uuid.UUID) ([]domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tripIDs = append(m.tripIDs, tripID)
+	out := []domain.Webhook{}
+	for _, w := range m.webhooks {
+		if w.Subscribes(event) {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+func (m *memWebhookRepo) Update(_ context.Context, w domain.Webhook) (domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.webhooks {
+		if m.webhooks[i].ID == w.ID {
+			w.Secret = m.webhooks[i].Secret
+			m.webhooks[i] = w
+			return w, nil
+		}
+	}
+	return domain.Webhook{}, domain.ErrNotFound
+}
+func (m *memWebhookRepo) SetSecret(_ context.Context, id uuid.UUID, secret string) (domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.webhooks {
+		if m.webhooks[i].ID == id {
+			m.webhooks[i].Secret = secret
+			return m.webhooks[i], nil
+		}
+	}
+	return domain.Webhook{}, domain.ErrNotFound
+}
+func (m *memWebhookRepo) Delete(_ context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, w := range m.webhooks {
+		if w.ID == id {
+			m.webhooks = append(m.webhooks[:i], m.webhooks[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+func (m *memWebhookRepo) CreateDelivery(_ context.Context, d domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d.AttemptedAt = webhookNow
+	m.deliveries = append(m.deliveries, d)
+	return d, nil
+}
+func (m *memWebhookRepo) ListDeliveries(_ context.Context, webhookID uuid.UUID, limit int) ([]domain.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := []domain.WebhookDelivery{}
+	for i := len(m.deliveries) - 1; i >= 0 && len(out) < limit; i-- {
+		if m.deliveries[i].WebhookID == webhookID {
+			out = append(out, m.deliveries[i])
+		}
+	}
+	return out, nil
+}
+
+var _ repo.WebhookRepo = (*memWebhookRepo)(nil)
+
+var webhookNow = time.Date(2025, 7, 14, 16, 0, 0, 0, time.UTC)
+
+// delivered is one request taken by a webhookSubscriber.
+type delivered struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookSubscriber is an httptest.Server that answers every delivery with
+// status and keeps what it was sent.
+type webhookSubscriber struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []delivered
+}
+
+func newWebhookSubscriber(t *testing.T, status int) *webhookSubscriber {
+	t.Helper()
+	sub := &webhookSubscriber{}
+	sub.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		sub.mu.Lock()
+		sub.requests = append(sub.requests, delivered{header: r.Header.Clone(), body: body})
+		sub.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(sub.Close)
+	return sub
+}
+
+func (s *webhookSubscriber) received() []delivered {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]delivered{}, s.requests...)
+}
+
+func newWebhookService(webhooks *memWebhookRepo) *service.WebhookService {
+	return service.NewWebhookService(webhooks, nil, &fakeClock{now: webhookNow})
+}
+
+// ---- Create / Update -------------------------------------------------------
+
+func TestWebhookService_Create_Valid(t *testing.T) {
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+
+	got, err := svc.Create(context.Background(), domain.Webhook{
+		URL:    " https://ha.local/api/webhook/rv ",
+		Events: []domain.WebhookEvent{domain.EventStopCreated, domain.EventStopCreated, domain.EventStopDeleted},
+		Active: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://ha.local/api/webhook/rv", got.URL)
+	assert.Equal(t, []domain.WebhookEvent{domain.EventStopCreated, domain.EventStopDeleted}, got.Events, "repeats are dropped")
+	assert.True(t, strings.HasPrefix(got.Secret, "whsec_"))
+}
+
+func TestWebhookService_Create_Invalid(t *testing.T) {
+	valid := domain.Webhook{URL: "https://ha.local/hook", Events: []domain.WebhookEvent{domain.EventStopCreated}}
+	tests := []struct {
+		name   string
+		mutate func(*domain.Webhook)
+	}{
+		{"relative url", func(w *domain.Webhook) { w.URL = "/hook" }},
+		{"ftp url", func(w *domain.Webhook) { w.URL = "ftp://ha.local/hook" }},
+		{"no host", func(w *domain.Webhook) { w.URL = "https:///hook" }},
+		{"no events", func(w *domain.Webhook) { w.Events = nil }},
+		{"unknown event", func(w *domain.Webhook) { w.Events = []domain.WebhookEvent{"trip.archived"} }},
+		{"ping", func(w *domain.Webhook) { w.Events = []domain.WebhookEvent{domain.EventPing} }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := valid
+			tc.mutate(&w)
+
+			_, err := newWebhookService(&memWebhookRepo{}).Create(context.Background(), w)
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestWebhookService_Update_KeepsSecret(t *testing.T) {
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	created, err := svc.Create(context.Background(), domain.Webhook{URL: "https://ha.local/hook", Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: true})
+	require.NoError(t, err)
+
+	updated, err := svc.Update(context.Background(), domain.Webhook{ID: created.ID, URL: "https://ha.local/other", Events: []domain.WebhookEvent{domain.EventStopUpdated}})
+
+	require.NoError(t, err)
+	assert.Equal(t, created.Secret, updated.Secret)
+	assert.False(t, updated.Active)
+}
+
+func TestWebhookService_RotateSecret(t *testing.T) {
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	created, err := svc.Create(context.Background(), domain.Webhook{URL: "https://ha.local/hook", Events: []domain.WebhookEvent{domain.EventStopCreated}})
+	require.NoError(t, err)
+
+	rotated, err := svc.RotateSecret(context.Background(), created.ID)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, created.Secret, rotated.Secret)
+	assert.True(t, strings.HasPrefix(rotated.Secret, "whsec_"))
+
+	_, err = svc.RotateSecret(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// ---- Test / Deliveries -----------------------------------------------------
+
+func TestWebhookService_Test_SignsPing(t *testing.T) {
+	sub := newWebhookSubscriber(t, http.StatusNoContent)
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	// A test delivery goes out even to an inactive webhook.
+	w, err := svc.Create(context.Background(), domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopCreated}})
+	require.NoError(t, err)
+
+	d, err := svc.Test(context.Background(), w.ID)
+
+	require.NoError(t, err)
+	assert.True(t, d.Succeeded())
+	assert.Equal(t, http.StatusNoContent, d.StatusCode)
+	assert.Equal(t, domain.EventPing, d.Event)
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "ping", reqs[0].header.Get(service.WebhookEventHeader))
+	assert.Equal(t, d.ID.String(), reqs[0].header.Get(service.WebhookDeliveryHeader))
+	assert.Equal(t, service.SignWebhook(w.Secret, reqs[0].body), reqs[0].header.Get(service.WebhookSignatureHeader))
+
+	var payload domain.WebhookPayload
+	require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+	assert.Equal(t, d.ID, payload.ID)
+	assert.Equal(t, webhookNow, payload.OccurredAt)
+	assert.Equal(t, map[string]any{"webhook_id": w.ID.String()}, payload.Data)
+
+	log, err := svc.Deliveries(context.Background(), w.ID)
+	require.NoError(t, err)
+	require.Len(t, log, 1)
+	assert.Equal(t, d.ID, log[0].ID)
+}
+
+func TestWebhookService_Test_RecordsFailure(t *testing.T) {
+	sub := newWebhookSubscriber(t, http.StatusInternalServerError)
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	w, err := svc.Create(context.Background(), domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopCreated}})
+	require.NoError(t, err)
+
+	d, err := svc.Test(context.Background(), w.ID)
+
+	require.NoError(t, err, "a failing subscriber is reported in the delivery")
+	assert.False(t, d.Succeeded())
+	assert.Equal(t, http.StatusInternalServerError, d.StatusCode)
+}
+
+func TestWebhookService_Test_Unreachable(t *testing.T) {
+	sub := newWebhookSubscriber(t, http.StatusOK)
+	sub.Close()
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	w, err := svc.Create(context.Background(), domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopCreated}})
+	require.NoError(t, err)
+
+	d, err := svc.Test(context.Background(), w.ID)
+
+	require.NoError(t, err)
+	assert.False(t, d.Succeeded())
+	assert.Zero(t, d.StatusCode)
+	assert.NotEmpty(t, d.Error)
+}
+
+func TestWebhookService_Deliveries_NotFound(t *testing.T) {
+	_, err := newWebhookService(&memWebhookRepo{}).Deliveries(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// ---- Publish ---------------------------------------------------------------
+
+func TestWebhookService_Publish_OnlySubscribed(t *testing.T) {
+	sub := newWebhookSubscriber(t, http.StatusOK)
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	ctx := context.Background()
+	created, err := svc.Create(ctx, domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: true})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopDeleted}, Active: true})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: false})
+	require.NoError(t, err)
+
+	stop := domain.StopEvent(domain.Stop{ID: uuid.New(), TripID: uuid.New(), Name: "Peace Arch", ArrivedAt: webhookNow})
+	svc.Publish(ctx, domain.EventStopCreated, stop)
+	svc.Wait()
+
+	reqs := sub.received()
+	require.Len(t, reqs, 1, "only the active stop.created webhook is sent the event")
+	var payload struct {
+		Event domain.WebhookEvent  `json:"event"`
+		Data  domain.StopEventData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+	assert.Equal(t, domain.EventStopCreated, payload.Event)
+	assert.Equal(t, stop.ID, payload.Data.ID)
+	assert.Equal(t, "Peace Arch", payload.Data.Name)
+
+	require.Len(t, webhooks.deliveries, 1)
+	assert.Equal(t, created.ID, webhooks.deliveries[0].WebhookID)
+	assert.Equal(t, []*uuid.UUID{&stop.TripID}, webhooks.tripIDs, "webhooks are matched against the stop's trip")
+}
+
+func TestWebhookService_Publish_IgnoresUnsubscribableEvents(t *testing.T) {
+	sub := newWebhookSubscriber(t, http.StatusOK)
+	webhooks := &memWebhookRepo{}
+	svc := newWebhookService(webhooks)
+	ctx := context.Background()
+	_, err := svc.Create(ctx, domain.Webhook{URL: sub.URL, Events: []domain.WebhookEvent{domain.EventStopCreated}, Active: true})
+	require.NoError(t, err)
+
+	svc.Publish(ctx, domain.EventPing, nil)
+	svc.Wait()
+
+	assert.Empty(t, sub.received())
+	assert.Empty(t, webhooks.deliveries)
+}
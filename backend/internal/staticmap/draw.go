@@ -0,0 +1,40 @@
+package staticmap
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// drawLine draws a line of the given width from (x0, y0) to (x1, y1) by
+// stamping discs along it. Routes are short enough in pixels that this is
+// cheaper than it sounds.
+func drawLine(img *image.RGBA, x0, y0, x1, y1, width float64, c color.RGBA) {
+	r := width / 2
+	steps := int(math.Ceil(math.Hypot(x1-x0, y1-y0) * 2))
+	for i := 0; i <= steps; i++ {
+		t := 0.0
+		if steps > 0 {
+			t = float64(i) / float64(steps)
+		}
+		fillCircle(img, x0+(x1-x0)*t, y0+(y1-y0)*t, r, c)
+	}
+}
+
+// fillCircle paints every pixel whose center lies within r of (cx, cy).
+// Pixels outside the image are skipped.
+func fillCircle(img *image.RGBA, cx, cy, r float64, c color.RGBA) {
+	b := img.Bounds()
+	minX := max(b.Min.X, int(math.Floor(cx-r)))
+	maxX := min(b.Max.X-1, int(math.Ceil(cx+r)))
+	minY := max(b.Min.Y, int(math.Floor(cy-r)))
+	maxY := min(b.Max.Y-1, int(math.Ceil(cy+r)))
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			dx, dy := float64(x)+0.5-cx, float64(y)+0.5-cy
+			if dx*dx+dy*dy <= r*r {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
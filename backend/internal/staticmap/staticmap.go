@@ -0,0 +1,202 @@
+// Package staticmap renders a trip as a PNG: map tiles in the background, the
+// route drawn over them, and a marker at each stop. The image is meant for
+// embedding where an interactive map cannot go — reports, emails, previews.
+//
+// Tiles come from a Tiles provider. HTTPTiles fetches them from any
+// {z}/{x}/{y} slippy-map server; Cached keeps fetched tiles in an
+// objectstore.Store so each is downloaded once. With no provider the map is
+// drawn on a plain background.
+package staticmap
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+)
+
+// TileSize is the edge length, in pixels, of a Web Mercator tile.
+const TileSize = 256
+
+// MaxZoom is the closest zoom level a map is rendered at, however small the
+// area it covers.
+const MaxZoom = 15
+
+// singlePointZoom is used when everything on the map is one point, where
+// fitting would otherwise always pick MaxZoom.
+const singlePointZoom = 12
+
+// padding is kept clear, in pixels, between the drawn features and the edge
+// of the image so markers are not cut in half.
+const padding = 24
+
+// ErrEmpty is returned by Render when the map has nothing to draw.
+var ErrEmpty = errors.New("staticmap: nothing to draw")
+
+// Tiles supplies map tiles in the Web Mercator ("slippy map") scheme.
+type Tiles interface {
+	// Tile returns the TileSize×TileSize image at zoom z, column x, row y.
+	Tile(ctx context.Context, z, x, y int) (image.Image, error)
+}
+
+// Default colors used by the renderer.
+var (
+	Background = color.RGBA{0xe8, 0xe6, 0xdf, 0xff}
+	RouteColor = color.RGBA{0x25, 0x63, 0xeb, 0xff}
+	StartColor = color.RGBA{0x16, 0xa3, 0x4a, 0xff}
+	EndColor   = color.RGBA{0xdc, 0x26, 0x26, 0xff}
+	StopColor  = color.RGBA{0x1e, 0x40, 0xaf, 0xff}
+)
+
+// Marker is a dot drawn at one point.
+type Marker struct {
+	geo.Point
+	Color color.RGBA
+}
+
+// Map is everything drawn on one image.
+type Map struct {
+	Width, Height int
+
+	// Paths are drawn as lines, in order, beneath the markers.
+	Paths [][]geo.Point
+
+	// Markers are drawn last, in order, so later markers sit on top.
+	Markers []Marker
+}
+
+// Renderer draws Maps.
+type Renderer struct {
+	tiles Tiles
+}
+
+// NewRenderer returns a Renderer that draws on tiles from t. A nil t draws on
+// a plain background.
+func NewRenderer(t Tiles) *Renderer {
+	return &Renderer{tiles: t}
+}
+
+// Render draws m, zoomed and centered so every path and marker fits.
+//
+// A tile that cannot be fetched is left as plain background rather than
+// failing the whole image. Returns ErrEmpty if m has no points, and the
+// context's error if it is cancelled while tiles are being fetched.
+func (r *Renderer) Render(ctx context.Context, m Map) (*image.RGBA, error) {
+	var pts []geo.Point
+	for _, p := range m.Paths {
+		pts = append(pts, p...)
+	}
+	for _, mk := range m.Markers {
+		pts = append(pts, mk.Point)
+	}
+	if len(pts) == 0 {
+		return nil, ErrEmpty
+	}
+
+	z := fitZoom(pts, m.Width, m.Height)
+	minX, minY, maxX, maxY := bounds(pts, z)
+	// The top-left corner of the image, in world pixels at zoom z.
+	ox := math.Round((minX+maxX)/2 - float64(m.Width)/2)
+	oy := math.Round((minY+maxY)/2 - float64(m.Height)/2)
+
+	img := image.NewRGBA(image.Rect(0, 0, m.Width, m.Height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(Background), image.Point{}, draw.Src)
+
+	if r.tiles != nil {
+		if err := r.drawTiles(ctx, img, z, ox, oy); err != nil {
+			return nil, err
+		}
+	}
+
+	toPixel := func(p geo.Point) (float64, float64) {
+		x, y := project(p, z)
+		return x - ox, y - oy
+	}
+	for _, path := range m.Paths {
+		for i := 1; i < len(path); i++ {
+			x0, y0 := toPixel(path[i-1])
+			x1, y1 := toPixel(path[i])
+			drawLine(img, x0, y0, x1, y1, 2.5, RouteColor)
+		}
+	}
+	for _, mk := range m.Markers {
+		x, y := toPixel(mk.Point)
+		fillCircle(img, x, y, 8, color.RGBA{0xff, 0xff, 0xff, 0xff})
+		fillCircle(img, x, y, 6, mk.Color)
+	}
+	return img, nil
+}
+
+// drawTiles copies every tile that overlaps the image into place.
+func (r *Renderer) drawTiles(ctx context.Context, img *image.RGBA, z int, ox, oy float64) error {
+	n := 1 << z
+	b := img.Bounds()
+	firstX := int(math.Floor(ox / TileSize))
+	firstY := int(math.Floor(oy / TileSize))
+	lastX := int(math.Floor((ox + float64(b.Dx()) - 1) / TileSize))
+	lastY := int(math.Floor((oy + float64(b.Dy()) - 1) / TileSize))
+
+	for ty := firstY; ty <= lastY; ty++ {
+		if ty < 0 || ty >= n {
+			continue // above or below the world
+		}
+		for tx := firstX; tx <= lastX; tx++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			tile, err := r.tiles.Tile(ctx, z, ((tx%n)+n)%n, ty)
+			if err != nil {
+				continue
+			}
+			at := image.Pt(tx*TileSize-int(ox), ty*TileSize-int(oy))
+			draw.Draw(img, image.Rectangle{Min: at, Max: at.Add(image.Pt(TileSize, TileSize))}, tile, tile.Bounds().Min, draw.Src)
+		}
+	}
+	return nil
+}
+
+// fitZoom returns the closest zoom at which pts fit inside a width×height
+// image with padding to spare.
+func fitZoom(pts []geo.Point, width, height int) int {
+	minX, minY, maxX, maxY := bounds(pts, 0)
+	if maxX-minX == 0 && maxY-minY == 0 {
+		return singlePointZoom
+	}
+	for z := MaxZoom; z > 0; z-- {
+		scale := float64(int(1) << z)
+		if (maxX-minX)*scale <= float64(width-2*padding) && (maxY-minY)*scale <= float64(height-2*padding) {
+			return z
+		}
+	}
+	return 0
+}
+
+// bounds returns the pixel bounding box of pts at zoom z.
+func bounds(pts []geo.Point, z int) (minX, minY, maxX, maxY float64) {
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+	for _, p := range pts {
+		x, y := project(p, z)
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	return minX, minY, maxX, maxY
+}
+
+// maxLat is the latitude at which Web Mercator is cut off to make the world
+// square.
+const maxLat = 85.05112878
+
+// project converts p to world pixel coordinates at zoom z: (0, 0) is the
+// north-west corner of tile 0/0/0.
+func project(p geo.Point, z int) (float64, float64) {
+	lat := math.Max(-maxLat, math.Min(maxLat, p.Lat)) * math.Pi / 180
+	world := float64(TileSize) * float64(int(1)<<z)
+	x := (p.Lon + 180) / 360 * world
+	y := (1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2 * world
+	return x, y
+}
@@ -0,0 +1,189 @@
+package staticmap_test
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/geo"
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+	"github.com/pkordes/rv-logbook/backend/internal/staticmap"
+)
+
+// solidTiles returns every tile as one flat color, or fails when err is set.
+type solidTiles struct {
+	color color.RGBA
+	err   error
+	calls int
+}
+
+func (s *solidTiles) Tile(_ context.Context, _, _, _ int) (image.Image, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	img := image.NewRGBA(image.Rect(0, 0, staticmap.TileSize, staticmap.TileSize))
+	for i := range img.Pix {
+		img.Pix[i] = []uint8{s.color.R, s.color.G, s.color.B, s.color.A}[i%4]
+	}
+	return img, nil
+}
+
+var (
+	denver = geo.Point{Lat: 39.7392, Lon: -104.9903}
+	slc    = geo.Point{Lat: 40.7608, Lon: -111.8910}
+)
+
+func TestRender_Empty(t *testing.T) {
+	_, err := staticmap.NewRenderer(nil).Render(context.Background(), staticmap.Map{Width: 100, Height: 100})
+
+	assert.True(t, errors.Is(err, staticmap.ErrEmpty), "got %v", err)
+}
+
+func TestRender_SingleMarkerIsCentered(t *testing.T) {
+	img, err := staticmap.NewRenderer(nil).Render(context.Background(), staticmap.Map{
+		Width: 200, Height: 100,
+		Markers: []staticmap.Marker{{Point: denver, Color: staticmap.StartColor}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 200, 100), img.Bounds())
+	assert.Equal(t, staticmap.StartColor, img.RGBAAt(100, 50))
+	assert.Equal(t, staticmap.Background, img.RGBAAt(0, 0))
+}
+
+func TestRender_RouteFitsBetweenMarkers(t *testing.T) {
+	img, err := staticmap.NewRenderer(nil).Render(context.Background(), staticmap.Map{
+		Width: 400, Height: 300,
+		Paths: [][]geo.Point{{slc, denver}},
+		Markers: []staticmap.Marker{
+			{Point: slc, Color: staticmap.StartColor},
+			{Point: denver, Color: staticmap.EndColor},
+		},
+	})
+
+	require.NoError(t, err)
+	// Salt Lake City is west of Denver, so its marker is on the left half
+	// and the route crosses the middle of the image.
+	var start, end, route bool
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 400; x++ {
+			switch img.RGBAAt(x, y) {
+			case staticmap.StartColor:
+				start = start || x < 200
+			case staticmap.EndColor:
+				end = end || x > 200
+			case staticmap.RouteColor:
+				route = route || x == 200
+			}
+		}
+	}
+	assert.True(t, start, "start marker on the left")
+	assert.True(t, end, "end marker on the right")
+	assert.True(t, route, "route through the middle")
+}
+
+func TestRender_DrawsTiles(t *testing.T) {
+	water := color.RGBA{0xaa, 0xd3, 0xdf, 0xff}
+	tiles := &solidTiles{color: water}
+
+	img, err := staticmap.NewRenderer(tiles).Render(context.Background(), staticmap.Map{
+		Width: 300, Height: 300,
+		Markers: []staticmap.Marker{{Point: denver, Color: staticmap.StopColor}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, water, img.RGBAAt(0, 0))
+	assert.Equal(t, water, img.RGBAAt(299, 299))
+	assert.Equal(t, staticmap.StopColor, img.RGBAAt(150, 150))
+	assert.Positive(t, tiles.calls)
+}
+
+func TestRender_FailedTilesLeaveBackground(t *testing.T) {
+	tiles := &solidTiles{err: errors.New("offline")}
+
+	img, err := staticmap.NewRenderer(tiles).Render(context.Background(), staticmap.Map{
+		Width: 100, Height: 100,
+		Markers: []staticmap.Marker{{Point: denver, Color: staticmap.StopColor}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, staticmap.Background, img.RGBAAt(0, 0))
+}
+
+func TestRender_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := staticmap.NewRenderer(&solidTiles{}).Render(ctx, staticmap.Map{
+		Width: 100, Height: 100,
+		Markers: []staticmap.Marker{{Point: denver, Color: staticmap.StopColor}},
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestHTTPTiles(t *testing.T) {
+	var gotPath, gotAgent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAgent = r.URL.Path, r.UserAgent()
+		if r.URL.Path == "/missing/1/2/3.png" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		_ = png.Encode(w, image.NewRGBA(image.Rect(0, 0, staticmap.TileSize, staticmap.TileSize)))
+	}))
+	t.Cleanup(srv.Close)
+
+	img, err := staticmap.NewHTTPTiles(srv.URL+"/{z}/{x}/{y}.png", srv.Client()).Tile(context.Background(), 7, 24, 49)
+
+	require.NoError(t, err)
+	assert.Equal(t, staticmap.TileSize, img.Bounds().Dx())
+	assert.Equal(t, "/7/24/49.png", gotPath)
+	assert.Equal(t, staticmap.UserAgent, gotAgent)
+
+	_, err = staticmap.NewHTTPTiles(srv.URL+"/missing/{z}/{x}/{y}.png", srv.Client()).Tile(context.Background(), 1, 2, 3)
+	assert.Error(t, err)
+}
+
+func TestCached_FetchesOnce(t *testing.T) {
+	ctx := context.Background()
+	upstream := &solidTiles{color: color.RGBA{0x10, 0x20, 0x30, 0xff}}
+	store := objectstore.NewMemory()
+	cached := staticmap.NewCached(upstream, store)
+
+	first, err := cached.Tile(ctx, 3, 1, 2)
+	require.NoError(t, err)
+	second, err := cached.Tile(ctx, 3, 1, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, upstream.calls)
+	assert.Equal(t, 1, store.Len())
+	r, g, b, a := first.At(5, 5).RGBA()
+	r2, g2, b2, a2 := second.At(5, 5).RGBA()
+	assert.Equal(t, []uint32{r, g, b, a}, []uint32{r2, g2, b2, a2})
+}
+
+func TestCached_DoesNotCacheFailures(t *testing.T) {
+	ctx := context.Background()
+	upstream := &solidTiles{err: errors.New("offline")}
+	store := objectstore.NewMemory()
+	cached := staticmap.NewCached(upstream, store)
+
+	_, err := cached.Tile(ctx, 3, 1, 2)
+	require.Error(t, err)
+	_, err = cached.Tile(ctx, 3, 1, 2)
+	require.Error(t, err)
+
+	assert.Equal(t, 2, upstream.calls)
+	assert.Zero(t, store.Len())
+}
@@ -0,0 +1,127 @@
+package staticmap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	// Tile servers send PNG or JPEG.
+	_ "image/jpeg"
+
+	"github.com/pkordes/rv-logbook/backend/internal/objectstore"
+)
+
+// maxTileBytes caps how much of a tile response is read. Real tiles are a
+// few tens of kilobytes.
+const maxTileBytes = 1 << 20
+
+// UserAgent identifies the logbook to tile servers, several of which refuse
+// requests without one.
+const UserAgent = "rv-logbook (+https://github.com/pkordes/rv-logbook)"
+
+// HTTPTiles fetches tiles from a slippy-map tile server.
+type HTTPTiles struct {
+	url    string
+	client *http.Client
+}
+
+var _ Tiles = (*HTTPTiles)(nil)
+
+// NewHTTPTiles returns a provider for urlTemplate, which contains the
+// placeholders {z}, {x}, and {y} — for example
+// "https://tile.openstreetmap.org/{z}/{x}/{y}.png". A nil client uses one
+// with a ten-second timeout.
+func NewHTTPTiles(urlTemplate string, client *http.Client) *HTTPTiles {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &HTTPTiles{url: urlTemplate, client: client}
+}
+
+// Tile downloads and decodes one tile.
+func (t *HTTPTiles) Tile(ctx context.Context, z, x, y int) (image.Image, error) {
+	url := strings.NewReplacer(
+		"{z}", strconv.Itoa(z),
+		"{x}", strconv.Itoa(x),
+		"{y}", strconv.Itoa(y),
+	).Replace(t.url)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("staticmap.HTTPTiles.Tile: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("staticmap.HTTPTiles.Tile: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("staticmap.HTTPTiles.Tile: %d/%d/%d: unexpected status %s", z, x, y, resp.Status)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, maxTileBytes))
+	if err != nil {
+		return nil, fmt.Errorf("staticmap.HTTPTiles.Tile: %d/%d/%d: %w", z, x, y, err)
+	}
+	return img, nil
+}
+
+// Ping downloads the single tile of zoom level 0 to check that the server
+// answers.
+func (t *HTTPTiles) Ping(ctx context.Context) error {
+	if _, err := t.Tile(ctx, 0, 0, 0); err != nil {
+		return fmt.Errorf("staticmap.HTTPTiles.Ping: %w", err)
+	}
+	return nil
+}
+
+// Cached keeps tiles from another provider in an objectstore.Store under
+// "tiles/{z}/{x}/{y}.png", so each tile is fetched once. Tiles are never
+// expired; clear the tiles/ prefix after changing tile servers.
+type Cached struct {
+	tiles Tiles
+	store objectstore.Store
+}
+
+var _ Tiles = (*Cached)(nil)
+
+// NewCached returns a provider that serves tiles from store, falling back to
+// tiles for any it does not hold yet.
+func NewCached(tiles Tiles, store objectstore.Store) *Cached {
+	return &Cached{tiles: tiles, store: store}
+}
+
+// Tile returns the stored tile, fetching and storing it on a miss. Failed
+// fetches are not cached, and a failure to store a fetched tile does not
+// fail the call.
+func (c *Cached) Tile(ctx context.Context, z, x, y int) (image.Image, error) {
+	key := fmt.Sprintf("tiles/%d/%d/%d.png", z, x, y)
+
+	if rc, err := c.store.Get(ctx, key); err == nil {
+		img, err := png.Decode(rc)
+		rc.Close()
+		if err == nil {
+			return img, nil
+		}
+		// A damaged entry is refetched and overwritten below.
+	}
+
+	img, err := c.tiles.Tile(ctx, z, x, y)
+	if err != nil {
+		return nil, fmt.Errorf("staticmap.Cached.Tile: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err == nil {
+		_ = c.store.Put(ctx, key, &buf)
+	}
+	return img, nil
+}
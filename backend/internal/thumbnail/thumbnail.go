@@ -0,0 +1,107 @@
+// Package thumbnail makes the small JPEG previews of photos that lists and
+// galleries show instead of the originals.
+//
+// It uses only the standard library: JPEG, PNG, and GIF images can be read;
+// anything else, WebP included, is reported as ErrUnsupported. Images are
+// scaled down with a box filter, which averages every source pixel into the
+// thumbnail and so avoids the moiré of point sampling at large reductions.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+
+	_ "image/gif" // register the GIF decoder with image.Decode
+	_ "image/png" // register the PNG decoder with image.Decode
+)
+
+// MaxPixels is the largest image, in pixels, Decode will read. A small file
+// can declare an enormous canvas; this bounds the memory decoding takes.
+const MaxPixels = 64 << 20
+
+// jpegQuality is good enough for previews at a fraction of the size.
+const jpegQuality = 80
+
+// ErrUnsupported is returned by Decode for data that is not a JPEG, PNG, or
+// GIF image, or is larger than MaxPixels.
+var ErrUnsupported = errors.New("thumbnail: unsupported image")
+
+// Decode reads a JPEG, PNG, or GIF image; for an animated GIF, its first
+// frame.
+func Decode(data []byte) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d is too large", ErrUnsupported, cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	return img, nil
+}
+
+// Fit returns img scaled down so that neither side is longer than maxEdge,
+// keeping its proportions. An image that already fits keeps its size.
+// Transparent areas are flattened onto white, as JPEG has no alpha.
+func Fit(img image.Image, maxEdge int) *image.RGBA {
+	b := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Over)
+
+	w, h := b.Dx(), b.Dy()
+	if w <= maxEdge && h <= maxEdge {
+		return src
+	}
+	if w >= h {
+		w, h = maxEdge, max(1, h*maxEdge/w)
+	} else {
+		w, h = max(1, w*maxEdge/h), maxEdge
+	}
+	return boxScale(src, w, h)
+}
+
+// boxScale shrinks src to w by h, each destination pixel the average of the
+// source pixels it covers.
+func boxScale(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Rect.Dx(), src.Rect.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for dy := 0; dy < h; dy++ {
+		sy0, sy1 := dy*sh/h, max((dy+1)*sh/h, dy*sh/h+1)
+		for dx := 0; dx < w; dx++ {
+			sx0, sx1 := dx*sw/w, max((dx+1)*sw/w, dx*sw/w+1)
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := sx0; sx < sx1; sx++ {
+					p := row[sx*4 : sx*4+4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+			i := dy*dst.Stride + dx*4
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// Encode writes img as a JPEG.
+func Encode(w io.Writer, img image.Image) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+}
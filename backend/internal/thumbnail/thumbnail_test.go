@@ -0,0 +1,116 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/thumbnail"
+)
+
+func TestFit(t *testing.T) {
+	cases := map[string]struct {
+		w, h, maxEdge int
+		wantW, wantH  int
+	}{
+		"landscape":       {w: 1600, h: 900, maxEdge: 160, wantW: 160, wantH: 90},
+		"portrait":        {w: 900, h: 1600, maxEdge: 160, wantW: 90, wantH: 160},
+		"square":          {w: 500, h: 500, maxEdge: 160, wantW: 160, wantH: 160},
+		"already fits":    {w: 100, h: 40, maxEdge: 160, wantW: 100, wantH: 40},
+		"thin panorama":   {w: 4000, h: 10, maxEdge: 160, wantW: 160, wantH: 1},
+		"exact long edge": {w: 160, h: 80, maxEdge: 160, wantW: 160, wantH: 80},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := thumbnail.Fit(image.NewRGBA(image.Rect(0, 0, tc.w, tc.h)), tc.maxEdge)
+
+			assert.Equal(t, tc.wantW, got.Bounds().Dx())
+			assert.Equal(t, tc.wantH, got.Bounds().Dy())
+		})
+	}
+}
+
+func TestFit_AveragesPixels(t *testing.T) {
+	// Alternating black and white columns average to mid-grey.
+	img := image.NewGray(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x += 2 {
+		for y := 0; y < 2; y++ {
+			img.SetGray(x, y, color.Gray{Y: 255})
+		}
+	}
+
+	got := thumbnail.Fit(img, 2)
+
+	r, g, b, _ := got.At(0, 0).RGBA()
+	assert.Equal(t, []uint32{127, 127, 127}, []uint32{r >> 8, g >> 8, b >> 8})
+}
+
+func TestFit_FlattensTransparencyOntoWhite(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+
+	got := thumbnail.Fit(img, 160)
+
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, got.RGBAAt(1, 1))
+}
+
+func TestDecode(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 30, 20))))
+
+	img, err := thumbnail.Decode(buf.Bytes())
+
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 30, 20), img.Bounds())
+}
+
+func TestDecode_Unsupported(t *testing.T) {
+	cases := map[string][]byte{
+		"not an image": []byte("<gpx></gpx>"),
+		"webp":         []byte("RIFF\x1a\x00\x00\x00WEBPVP8 \x0e\x00\x00\x00"),
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := thumbnail.Decode(data)
+
+			assert.ErrorIs(t, err, thumbnail.ErrUnsupported)
+		})
+	}
+}
+
+func TestDecode_TooLarge(t *testing.T) {
+	_, err := thumbnail.Decode(pngHeader(65536, 65536))
+
+	assert.ErrorIs(t, err, thumbnail.ErrUnsupported)
+	assert.ErrorContains(t, err, "65536x65536 is too large")
+}
+
+// pngHeader returns the start of a PNG file declaring a w by h canvas: enough
+// for its size to be read, and no more.
+func pngHeader(w, h uint32) []byte {
+	chunk := []byte("IHDR")
+	chunk = binary.BigEndian.AppendUint32(chunk, w)
+	chunk = binary.BigEndian.AppendUint32(chunk, h)
+	chunk = append(chunk, 8, 6, 0, 0, 0)
+	data := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0d")
+	data = append(data, chunk...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(chunk))
+}
+
+func TestEncode(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, thumbnail.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 16, 9))))
+
+	cfg, err := jpeg.DecodeConfig(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 16, cfg.Width)
+	assert.Equal(t, 9, cfg.Height)
+}
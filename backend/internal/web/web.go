@@ -0,0 +1,108 @@
+// Package web serves the frontend single-page app from the binary, so a
+// self-hosted install is one binary and Postgres.
+//
+// The production build of frontend/ is copied into dist/ (make backend/web)
+// and embedded at compile time. The app shares the port with the API: files
+// in the build are served as they are, and any other page a browser navigates
+// to gets index.html so the client-side router can render it. Everything else
+// falls through to the API.
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// indexName is the app shell served for client-side routes.
+const indexName = "index.html"
+
+// apiPaths are the paths, and the trees under them, that belong to the API
+// even when a browser asks for HTML: the embeddable shared-trip page is
+// loaded in an iframe with "Accept: text/html" and must not get the app.
+var apiPaths = []string{"/shared", "/api", "/graphql", "/healthz", "/livez", "/readyz"}
+
+// assetsDir holds Vite's content-hashed bundles, whose names change whenever
+// their contents do.
+const assetsDir = "assets/"
+
+// dist holds the frontend build. README.md is always present so the embed
+// pattern matches even before the frontend has been built.
+//
+//go:embed all:dist
+var dist embed.FS
+
+// App returns the embedded frontend build, or false when the binary was built
+// without one.
+func App() (fs.FS, bool) {
+	app, _ := fs.Sub(dist, "dist") // cannot fail: "dist" is a literal embedded directory
+	if _, err := fs.Stat(app, indexName); err != nil {
+		return nil, false
+	}
+	return app, true
+}
+
+// Handler serves app in front of api:
+//
+//	GET a file in app                — the file, with cache headers
+//	GET anything else, Accept: html  — index.html (SPA fallback), except
+//	                                   under apiPaths
+//	everything else                  — api
+//
+// A browser navigating to /trips/{id} gets the app, while a client asking
+// for JSON at the same path gets the API. The app itself calls the API under
+// /api, which never reaches this handler.
+func Handler(app fs.FS, api http.Handler) http.Handler {
+	files := http.FileServerFS(app)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			api.ServeHTTP(w, r)
+			return
+		}
+
+		name := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+		if name != indexName && isFile(app, name) {
+			w.Header().Set("Cache-Control", cacheControl(name))
+			files.ServeHTTP(w, r)
+			return
+		}
+		if strings.Contains(r.Header.Get("Accept"), "text/html") && !isAPIPath(r.URL.Path) {
+			// The shell must never be cached: it names the current bundles.
+			w.Header().Set("Cache-Control", "no-cache")
+			http.ServeFileFS(w, r, app, indexName)
+			return
+		}
+		api.ServeHTTP(w, r)
+	})
+}
+
+// cacheControl returns the Cache-Control value for a file in the build.
+// Hashed bundles never change under the same name, so browsers may keep them
+// for a year; other files (favicon, robots.txt) are revalidated after an hour.
+func cacheControl(name string) string {
+	if strings.HasPrefix(name, assetsDir) {
+		return "public, max-age=31536000, immutable"
+	}
+	return "public, max-age=3600"
+}
+
+// isAPIPath reports whether p is one of apiPaths or under one of them.
+func isAPIPath(p string) bool {
+	for _, prefix := range apiPaths {
+		if p == prefix || strings.HasPrefix(p, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isFile reports whether name is a regular file in app.
+func isFile(app fs.FS, name string) bool {
+	if name == "" || name == "." {
+		return false
+	}
+	info, err := fs.Stat(app, name)
+	return err == nil && !info.IsDir()
+}
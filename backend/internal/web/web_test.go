@@ -0,0 +1,92 @@
+package web_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/internal/web"
+)
+
+var app = fstest.MapFS{
+	"index.html":           {Data: []byte("<!doctype html><div id=root></div>")},
+	"favicon.svg":          {Data: []byte("<svg/>")},
+	"assets/index-abc1.js": {Data: []byte("console.log(1)")},
+}
+
+// api stands in for the API router.
+var api = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"api":true}`)) //nolint:errcheck
+})
+
+func serve(method, path, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	web.Handler(app, api).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_ServesHashedAssetsImmutable(t *testing.T) {
+	rec := serve(http.MethodGet, "/assets/index-abc1.js", "*/*")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "console.log(1)", rec.Body.String())
+	assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+}
+
+func TestHandler_ServesOtherFilesBriefly(t *testing.T) {
+	rec := serve(http.MethodGet, "/favicon.svg", "")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=3600", rec.Header().Get("Cache-Control"))
+}
+
+// TestHandler_FallsBackToIndex verifies that a browser navigating to a
+// client-side route, including one the API also serves, gets the app shell.
+func TestHandler_FallsBackToIndex(t *testing.T) {
+	for _, path := range []string{"/", "/trips", "/trips/7b0c", "/sharedlinks", "/index.html"} {
+		t.Run(path, func(t *testing.T) {
+			rec := serve(http.MethodGet, path, "text/html,application/xhtml+xml")
+
+			if path == "/index.html" {
+				// http.ServeFileFS's canonical redirect to the directory.
+				assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+				return
+			}
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), "id=root")
+			assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+		})
+	}
+}
+
+func TestHandler_PassesAPIRequestsThrough(t *testing.T) {
+	tests := []struct {
+		name, method, path, accept string
+	}{
+		{"json client", http.MethodGet, "/trips", "application/json"},
+		{"no accept header", http.MethodGet, "/trips/7b0c", ""},
+		{"write from a browser", http.MethodPost, "/trips", "text/html"},
+		{"missing asset", http.MethodGet, "/assets/gone.js", "*/*"},
+		{"directory", http.MethodGet, "/assets", "*/*"},
+		{"embed iframe", http.MethodGet, "/shared/eyJhbGciOi/embed?format=html", "text/html,application/xhtml+xml"},
+		{"shared trip from a browser", http.MethodGet, "/shared/eyJhbGciOi", "text/html"},
+		{"api prefix from a browser", http.MethodGet, "/api/trips", "text/html"},
+		{"graphql from a browser", http.MethodGet, "/graphql", "text/html"},
+		{"health from a browser", http.MethodGet, "/healthz", "text/html"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := serve(tc.method, tc.path, tc.accept)
+
+			assert.Equal(t, `{"api":true}`, rec.Body.String())
+		})
+	}
+}
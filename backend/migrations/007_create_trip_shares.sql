@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- trip_shares is the server-side record of every share link issued for a trip.
+-- The link itself is a signed token carrying the share id and its expiry;
+-- this table is the revocation list — a token is only honoured while its row
+-- exists, is unrevoked, and has not expired.
+CREATE TABLE trip_shares (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id     UUID        NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    revoked_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX trip_shares_trip_id_idx ON trip_shares (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE trip_shares;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- odometer_readings is the mileage log: one row per odometer reading for a
+-- vehicle, at a point in time. Mileage figures (per trip, per vehicle) are
+-- derived from these rows rather than stored alongside trips.
+--
+-- vehicle is a free-text label ("Motorhome", "Tow car") until vehicles become
+-- a first-class resource. trip_id optionally ties a reading to the trip it was
+-- taken on; deleting the trip keeps the reading, since the odometer history of
+-- the vehicle is still true.
+CREATE TABLE odometer_readings (
+    id           UUID          PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle      TEXT          NOT NULL,
+    miles        NUMERIC(9, 1) NOT NULL CHECK (miles >= 0),
+    recorded_at  TIMESTAMPTZ   NOT NULL,
+    trip_id      UUID          REFERENCES trips(id) ON DELETE SET NULL,
+    notes        TEXT,
+    created_at   TIMESTAMPTZ   NOT NULL DEFAULT now()
+);
+
+CREATE INDEX odometer_readings_vehicle_recorded_at_idx ON odometer_readings (vehicle, recorded_at);
+CREATE INDEX odometer_readings_trip_id_idx ON odometer_readings (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE odometer_readings;
+-- +goose StatementEnd
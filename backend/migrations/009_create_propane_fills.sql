@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- propane_fills logs every propane purchase. Quantity is recorded in the unit
+-- on the receipt — US gallons at a metered pump, pounds when a cylinder is
+-- weighed — and converted to gallons only when computing stats.
+-- price is the total paid, not a unit price; it is optional because fills
+-- bundled into a campground fee have no separate price.
+CREATE TABLE propane_fills (
+    id          UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    filled_at   TIMESTAMPTZ    NOT NULL,
+    quantity    NUMERIC(8, 2)  NOT NULL CHECK (quantity > 0),
+    unit        TEXT           NOT NULL CHECK (unit IN ('gal', 'lb')),
+    price       NUMERIC(10, 2) CHECK (price >= 0),
+    location    TEXT,
+    trip_id     UUID           REFERENCES trips(id) ON DELETE SET NULL,
+    notes       TEXT,
+    created_at  TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX propane_fills_filled_at_idx ON propane_fills (filled_at);
+CREATE INDEX propane_fills_trip_id_idx ON propane_fills (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE propane_fills;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- power_readings logs the house electrical system: battery state of charge,
+-- solar yield, and generator run time. Each measurement is optional — a
+-- reading may carry only what the monitor showed at the time — but the CHECK
+-- below rejects a row that carries none of them.
+-- solar_wh and generator_hours are amounts since the previous reading (usually
+-- one day), not running totals, so a trip's totals are plain sums.
+CREATE TABLE power_readings (
+    id               UUID          PRIMARY KEY DEFAULT gen_random_uuid(),
+    recorded_at      TIMESTAMPTZ   NOT NULL,
+    state_of_charge  NUMERIC(4, 1) CHECK (state_of_charge BETWEEN 0 AND 100),
+    solar_wh         NUMERIC(8, 1) CHECK (solar_wh >= 0),
+    generator_hours  NUMERIC(5, 2) CHECK (generator_hours >= 0),
+    trip_id          UUID          REFERENCES trips(id) ON DELETE SET NULL,
+    notes            TEXT,
+    created_at       TIMESTAMPTZ   NOT NULL DEFAULT now(),
+    CHECK (state_of_charge IS NOT NULL OR solar_wh IS NOT NULL OR generator_hours IS NOT NULL)
+);
+
+CREATE INDEX power_readings_recorded_at_idx ON power_readings (recorded_at);
+CREATE INDEX power_readings_trip_id_idx ON power_readings (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE power_readings;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- tank_levels records holding-tank readings taken at a stop, as percentages
+-- of capacity. Each tank is optional — a panel may not show all three — but
+-- a row must carry at least one.
+-- Readings belong to the stop and are deleted with it.
+CREATE TABLE tank_levels (
+    id           UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    stop_id      UUID        NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    recorded_at  TIMESTAMPTZ NOT NULL,
+    fresh_pct    SMALLINT    CHECK (fresh_pct BETWEEN 0 AND 100),
+    grey_pct     SMALLINT    CHECK (grey_pct BETWEEN 0 AND 100),
+    black_pct    SMALLINT    CHECK (black_pct BETWEEN 0 AND 100),
+    notes        TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (fresh_pct IS NOT NULL OR grey_pct IS NOT NULL OR black_pct IS NOT NULL)
+);
+
+CREATE INDEX tank_levels_stop_id_idx ON tank_levels (stop_id, recorded_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE tank_levels;
+-- +goose StatementEnd
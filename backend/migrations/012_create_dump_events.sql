@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- dump_events logs each time the grey and black tanks were emptied. location
+-- names the dump station, which is often not the stop itself.
+-- Events belong to the stop and are deleted with it.
+CREATE TABLE dump_events (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    stop_id     UUID        NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    dumped_at   TIMESTAMPTZ NOT NULL,
+    location    TEXT,
+    notes       TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX dump_events_stop_id_idx ON dump_events (stop_id, dumped_at);
+-- "days since last dump" reads the newest event across all stops.
+CREATE INDEX dump_events_dumped_at_idx ON dump_events (dumped_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dump_events;
+-- +goose StatementEnd
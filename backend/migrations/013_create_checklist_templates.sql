@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- checklist_templates are reusable setup/teardown routines ("antenna down,
+-- steps up, hitch locked"). items is the ordered list of step labels.
+-- Starting a checklist at a stop copies the items, so editing or deleting a
+-- template never rewrites history.
+CREATE TABLE checklist_templates (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    name        TEXT        NOT NULL,
+    kind        TEXT        NOT NULL CHECK (kind IN ('departure', 'arrival')),
+    items       TEXT[]      NOT NULL CHECK (cardinality(items) > 0),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE checklist_templates;
+-- +goose StatementEnd
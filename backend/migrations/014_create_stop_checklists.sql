@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- stop_checklists are checklist runs at a stop. name and kind are copied
+-- from the template when the run starts; template_id only records where the
+-- run came from and is cleared if the template is deleted.
+CREATE TABLE stop_checklists (
+    id           UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    stop_id      UUID        NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    template_id  UUID        REFERENCES checklist_templates(id) ON DELETE SET NULL,
+    name         TEXT        NOT NULL,
+    kind         TEXT        NOT NULL CHECK (kind IN ('departure', 'arrival')),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX stop_checklists_stop_id_idx ON stop_checklists (stop_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE stop_checklists;
+-- +goose StatementEnd
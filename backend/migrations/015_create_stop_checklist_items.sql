@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- stop_checklist_items are the steps of one checklist run, in order.
+-- checked_at is NULL until the step is checked off.
+CREATE TABLE stop_checklist_items (
+    id            UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    checklist_id  UUID        NOT NULL REFERENCES stop_checklists(id) ON DELETE CASCADE,
+    position      INT         NOT NULL,
+    label         TEXT        NOT NULL,
+    checked_at    TIMESTAMPTZ,
+    UNIQUE (checklist_id, position)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE stop_checklist_items;
+-- +goose StatementEnd
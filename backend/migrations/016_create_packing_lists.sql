@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- packing_lists are either attached to a trip or, with trip_id NULL,
+-- templates kept from season to season. A new list can be copied from any
+-- existing one; the copy is independent of its source.
+CREATE TABLE packing_lists (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id     UUID        REFERENCES trips(id) ON DELETE CASCADE,
+    name        TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX packing_lists_trip_id_idx ON packing_lists (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE packing_lists;
+-- +goose StatementEnd
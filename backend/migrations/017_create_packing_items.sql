@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- packing_items are the entries of a packing list, in the order they were
+-- added. packed is reset to false when a list is copied.
+CREATE TABLE packing_items (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    list_id     UUID        NOT NULL REFERENCES packing_lists(id) ON DELETE CASCADE,
+    position    INT         NOT NULL,
+    name        TEXT        NOT NULL,
+    quantity    INT         NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    packed      BOOLEAN     NOT NULL DEFAULT false,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX packing_items_list_id_position_idx ON packing_items (list_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE packing_items;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- reservations are campground bookings for a stop. check_in and check_out are
+-- calendar dates as booked; check_out is the departure day, so nights are
+-- check_out - check_in. cost is the total booked and is optional because some
+-- sites are paid on arrival. cancellation_deadline is the last moment the
+-- booking can be cancelled without penalty.
+CREATE TABLE reservations (
+    id                     UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    stop_id                UUID           NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    confirmation_number    TEXT,
+    site_number            TEXT,
+    check_in               DATE           NOT NULL,
+    check_out              DATE           NOT NULL,
+    cost                   NUMERIC(10, 2) CHECK (cost >= 0),
+    cancellation_deadline  TIMESTAMPTZ,
+    notes                  TEXT,
+    created_at             TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    updated_at             TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    CHECK (check_out > check_in)
+);
+
+CREATE INDEX reservations_stop_id_idx ON reservations (stop_id);
+CREATE INDEX reservations_check_out_idx ON reservations (check_out);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reservations;
+-- +goose StatementEnd
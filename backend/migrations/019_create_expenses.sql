@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- expenses are money spent on a trip. stop_id and location record where the
+-- traveller was when the expense was logged: the stop they were at, and a copy
+-- of its location so the record survives the stop being deleted. Both are
+-- NULL for expenses logged on the road between stops.
+CREATE TABLE expenses (
+    id          UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id     UUID           NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    stop_id     UUID           REFERENCES stops(id) ON DELETE SET NULL,
+    category    TEXT           NOT NULL CHECK (category IN ('toll', 'parking', 'fuel', 'campground', 'food', 'other')),
+    amount      NUMERIC(10, 2) NOT NULL CHECK (amount > 0),
+    note        TEXT,
+    location    TEXT,
+    spent_at    TIMESTAMPTZ    NOT NULL,
+    created_at  TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX expenses_trip_id_spent_at_idx ON expenses (trip_id, spent_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE expenses;
+-- +goose StatementEnd
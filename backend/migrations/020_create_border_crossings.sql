@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- border_crossings log international border crossings on a trip. country is
+-- the ISO 3166-1 alpha-2 code of the country entered or left, and direction
+-- says which. documents lists what was shown at the port of entry (passport,
+-- NEXUS card, vehicle registration, pet records, ...).
+CREATE TABLE border_crossings (
+    id             UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id        UUID        NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    crossed_on     DATE        NOT NULL,
+    port_of_entry  TEXT        NOT NULL,
+    country        CHAR(2)     NOT NULL CHECK (country ~ '^[A-Z]{2}$'),
+    direction      TEXT        NOT NULL CHECK (direction IN ('entry', 'exit')),
+    documents      TEXT[]      NOT NULL DEFAULT '{}',
+    notes          TEXT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX border_crossings_trip_id_idx ON border_crossings (trip_id);
+CREATE INDEX border_crossings_crossed_on_idx ON border_crossings (crossed_on);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE border_crossings;
+-- +goose StatementEnd
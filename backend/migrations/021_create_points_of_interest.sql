@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- points_of_interest are places and sightings worth remembering — a moose by
+-- the road, a landmark, a good brewery — pinned by coordinates rather than
+-- tied to a stop. trip_id links one to the trip it was found on; it is
+-- optional, and deleting the trip keeps the point. seen_at is when it was
+-- seen, for sightings that have a time.
+CREATE TABLE points_of_interest (
+    id          UUID             PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id     UUID             REFERENCES trips(id) ON DELETE SET NULL,
+    name        TEXT             NOT NULL,
+    category    TEXT             NOT NULL CHECK (category IN ('wildlife', 'landmark', 'brewery', 'restaurant', 'viewpoint', 'other')),
+    latitude    DOUBLE PRECISION NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude   DOUBLE PRECISION NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    seen_at     TIMESTAMPTZ,
+    notes       TEXT,
+    created_at  TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ      NOT NULL DEFAULT now()
+);
+
+CREATE INDEX points_of_interest_trip_id_idx ON points_of_interest (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE points_of_interest;
+-- +goose StatementEnd
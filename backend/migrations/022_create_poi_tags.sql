@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- poi_tags links points of interest to the same tags stops use.
+CREATE TABLE poi_tags (
+    poi_id UUID NOT NULL REFERENCES points_of_interest(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id)               ON DELETE CASCADE,
+    PRIMARY KEY (poi_id, tag_id)
+);
+
+CREATE INDEX poi_tags_tag_id_idx ON poi_tags (tag_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE poi_tags;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- route_legs are the drives between consecutive stops of a trip, in
+-- arrived_at order. The application keeps one leg per adjacent pair of stops;
+-- distance, duration, and the encoded route polyline are filled in by hand
+-- (or by a routing import) and survive as long as the two stops stay
+-- adjacent.
+CREATE TABLE route_legs (
+    id                UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id           UUID           NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    from_stop_id      UUID           NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    to_stop_id        UUID           NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    distance_miles    NUMERIC(8, 1)  CHECK (distance_miles >= 0),
+    duration_minutes  INTEGER        CHECK (duration_minutes >= 0),
+    polyline          TEXT,
+    created_at        TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    updated_at        TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    UNIQUE (from_stop_id, to_stop_id),
+    CHECK (from_stop_id <> to_stop_id)
+);
+
+CREATE INDEX route_legs_trip_id_idx ON route_legs (trip_id);
+CREATE INDEX route_legs_to_stop_id_idx ON route_legs (to_stop_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE route_legs;
+-- +goose StatementEnd
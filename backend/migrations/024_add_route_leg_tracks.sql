@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- An uploaded GPX track for a route leg. The file itself lives in object
+-- storage under track_key; only its point count is kept here, alongside the
+-- simplified polyline written to route_legs.polyline for map rendering.
+ALTER TABLE route_legs
+    ADD COLUMN track_key          TEXT,
+    ADD COLUMN track_points       INTEGER CHECK (track_points > 0),
+    ADD COLUMN track_uploaded_at  TIMESTAMPTZ,
+    ADD CONSTRAINT route_legs_track_complete CHECK (
+        (track_key IS NULL) = (track_points IS NULL)
+        AND (track_key IS NULL) = (track_uploaded_at IS NULL)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE route_legs
+    DROP CONSTRAINT route_legs_track_complete,
+    DROP COLUMN track_uploaded_at,
+    DROP COLUMN track_points,
+    DROP COLUMN track_key;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optional coordinates for a stop, in decimal degrees, alongside the
+-- free-text location. Maps draw only stops that have them.
+ALTER TABLE stops
+    ADD COLUMN latitude   DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude  DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT stops_coordinates_paired CHECK ((latitude IS NULL) = (longitude IS NULL));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stops
+    DROP CONSTRAINT stops_coordinates_paired,
+    DROP COLUMN longitude,
+    DROP COLUMN latitude;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- location_pings are raw position reports from a tracking app (OwnTracks,
+-- Home Assistant). A device may resend a report it is unsure was delivered,
+-- so (device, recorded_at) is unique and repeats are dropped.
+CREATE TABLE location_pings (
+    id               UUID              PRIMARY KEY DEFAULT gen_random_uuid(),
+    device           TEXT              NOT NULL,
+    latitude         DOUBLE PRECISION  NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude        DOUBLE PRECISION  NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    accuracy_meters  DOUBLE PRECISION  CHECK (accuracy_meters >= 0),
+    recorded_at      TIMESTAMPTZ       NOT NULL,
+    created_at       TIMESTAMPTZ       NOT NULL DEFAULT now(),
+    UNIQUE (device, recorded_at)
+);
+
+-- location_dwells are the places a device stayed put long enough to count
+-- as a stop on the trip in progress. A dwell is open (departed_at NULL)
+-- while the device is still there; each device has at most one open dwell.
+-- status tracks the suggested stop: waiting for a decision, accepted (the
+-- stop was created, automatically or by hand), or dismissed.
+CREATE TABLE location_dwells (
+    id            UUID              PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id       UUID              NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    device        TEXT              NOT NULL,
+    latitude      DOUBLE PRECISION  NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude     DOUBLE PRECISION  NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    arrived_at    TIMESTAMPTZ       NOT NULL,
+    last_seen_at  TIMESTAMPTZ       NOT NULL,
+    departed_at   TIMESTAMPTZ,
+    status        TEXT              NOT NULL DEFAULT 'suggested'
+                                    CHECK (status IN ('suggested', 'accepted', 'dismissed')),
+    stop_id       UUID              REFERENCES stops(id) ON DELETE SET NULL,
+    created_at    TIMESTAMPTZ       NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ       NOT NULL DEFAULT now(),
+    CHECK (last_seen_at >= arrived_at),
+    CHECK (departed_at IS NULL OR departed_at >= last_seen_at)
+);
+
+CREATE UNIQUE INDEX location_dwells_open_device_idx ON location_dwells (device) WHERE departed_at IS NULL;
+CREATE INDEX location_dwells_device_arrived_at_idx ON location_dwells (device, arrived_at DESC);
+CREATE INDEX location_dwells_trip_id_idx ON location_dwells (trip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE location_dwells;
+DROP TABLE location_pings;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The elevation profile of a route leg: the height of the ground above sea
+-- level, in meters, at evenly spaced points along the leg's polyline. It is
+-- looked up from an elevation API on first request and cleared whenever the
+-- polyline changes.
+ALTER TABLE route_legs
+    ADD COLUMN elevation_meters          DOUBLE PRECISION[] CHECK (cardinality(elevation_meters) >= 2),
+    ADD COLUMN elevation_spacing_meters  DOUBLE PRECISION   CHECK (elevation_spacing_meters >= 0),
+    ADD COLUMN elevation_fetched_at      TIMESTAMPTZ,
+    ADD CONSTRAINT route_legs_elevation_complete CHECK (
+        (elevation_meters IS NULL) = (elevation_spacing_meters IS NULL)
+        AND (elevation_meters IS NULL) = (elevation_fetched_at IS NULL)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE route_legs
+    DROP CONSTRAINT route_legs_elevation_complete,
+    DROP COLUMN elevation_fetched_at,
+    DROP COLUMN elevation_spacing_meters,
+    DROP COLUMN elevation_meters;
+-- +goose StatementEnd
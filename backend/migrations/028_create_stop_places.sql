@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- stop_places records which country and state or province a stop is in, as
+-- looked up from its coordinates by a reverse geocoder. latitude and
+-- longitude are the coordinates that were looked up: a row whose coordinates
+-- no longer match its stop's is stale and is looked up again. country_code
+-- is NULL when the geocoder found no country there (out at sea).
+CREATE TABLE stop_places (
+    stop_id       UUID              PRIMARY KEY REFERENCES stops(id) ON DELETE CASCADE,
+    latitude      DOUBLE PRECISION  NOT NULL,
+    longitude     DOUBLE PRECISION  NOT NULL,
+    country_code  TEXT              CHECK (country_code ~ '^[A-Z]{2}$'),
+    region_code   TEXT,
+    region_name   TEXT,
+    resolved_at   TIMESTAMPTZ       NOT NULL DEFAULT now()
+);
+
+CREATE INDEX stop_places_region_idx ON stop_places (country_code, region_code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE stop_places;
+-- +goose StatementEnd
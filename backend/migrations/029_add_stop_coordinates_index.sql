@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Radius searches narrow stops to a latitude/longitude box before measuring
+-- distances. Stops without coordinates are never searched, so leave them out.
+CREATE INDEX stops_coordinates_idx ON stops (latitude, longitude) WHERE latitude IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX stops_coordinates_idx;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- PostGIS is optional. Where the database server has it, stops get a
+-- geography column and the spatial queries use it; where it does not, they
+-- fall back to haversine SQL on latitude and longitude (see
+-- internal/repo/spatial.go), and this migration changes nothing.
+--
+-- PostGIS lives in public so that every schema on the search path, including
+-- the throwaway ones integration tests migrate, shares the one installation.
+--
+-- coordinates is the stop's position as a geography point, derived from
+-- latitude and longitude so writes keep setting those two columns. Spatial
+-- queries use it and its GiST index; it is NULL when the stop has none. The
+-- index from 029 is superseded by the GiST one.
+--
+-- The statements are run through EXECUTE because the geography type does
+-- not exist on a server without PostGIS.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'postgis') THEN
+        CREATE EXTENSION IF NOT EXISTS postgis SCHEMA public;
+        EXECUTE $sql$
+            ALTER TABLE stops
+                ADD COLUMN coordinates geography(Point, 4326) GENERATED ALWAYS AS (
+                    CASE WHEN latitude IS NOT NULL
+                         THEN ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography
+                    END
+                ) STORED
+        $sql$;
+        EXECUTE 'CREATE INDEX stops_coordinates_gist_idx ON stops USING GIST (coordinates)';
+        DROP INDEX stops_coordinates_idx;
+    END IF;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The extension stays: other schemas in the database may still use it.
+CREATE INDEX IF NOT EXISTS stops_coordinates_idx ON stops (latitude, longitude) WHERE latitude IS NOT NULL;
+ALTER TABLE stops DROP COLUMN IF EXISTS coordinates;
+-- +goose StatementEnd
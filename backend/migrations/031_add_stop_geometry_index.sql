@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Map views select stops inside a latitude/longitude box. A geometry box
+-- matches that exactly, where a geography one has curved edges, so index the
+-- coordinates as geometry too. Without PostGIS there is no coordinates
+-- column, and the box is matched on latitude and longitude instead.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_attribute
+               WHERE attrelid = 'stops'::regclass AND attname = 'coordinates' AND NOT attisdropped) THEN
+        EXECUTE 'CREATE INDEX stops_coordinates_geometry_idx ON stops USING GIST ((coordinates::geometry))';
+    END IF;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS stops_coordinates_geometry_idx;
+-- +goose StatementEnd
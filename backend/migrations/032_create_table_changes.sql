@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- table_changes records when each table behind a cacheable read endpoint
+-- last had a row inserted, updated, or deleted. max(updated_at) cannot see
+-- deletes, and not every table has the column, so the triggers below keep
+-- this instead. Handlers send it as Last-Modified.
+CREATE TABLE table_changes (
+    table_name  TEXT         PRIMARY KEY,
+    changed_at  TIMESTAMPTZ  NOT NULL
+);
+
+INSERT INTO table_changes (table_name, changed_at)
+SELECT t, now()
+FROM unnest(ARRAY['trips', 'stops', 'tags', 'stop_tags', 'trip_shares', 'propane_fills', 'route_legs']) AS t;
+
+-- The triggers are deferred to commit so changed_at is stamped as late as
+-- possible: a reader that sees the new changed_at also sees the new rows.
+-- GREATEST keeps changed_at from going backwards when transactions that
+-- started in one order commit in another.
+CREATE FUNCTION note_table_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO table_changes (table_name, changed_at)
+    VALUES (TG_TABLE_NAME, clock_timestamp())
+    ON CONFLICT (table_name) DO UPDATE
+        SET changed_at = GREATEST(table_changes.changed_at, EXCLUDED.changed_at);
+    RETURN NULL;
+END;
+$$;
+
+CREATE CONSTRAINT TRIGGER trips_changed AFTER INSERT OR UPDATE OR DELETE ON trips
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+CREATE CONSTRAINT TRIGGER stops_changed AFTER INSERT OR UPDATE OR DELETE ON stops
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+CREATE CONSTRAINT TRIGGER tags_changed AFTER INSERT OR UPDATE OR DELETE ON tags
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+CREATE CONSTRAINT TRIGGER stop_tags_changed AFTER INSERT OR UPDATE OR DELETE ON stop_tags
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+CREATE CONSTRAINT TRIGGER trip_shares_changed AFTER INSERT OR UPDATE OR DELETE ON trip_shares
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+CREATE CONSTRAINT TRIGGER propane_fills_changed AFTER INSERT OR UPDATE OR DELETE ON propane_fills
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+CREATE CONSTRAINT TRIGGER route_legs_changed AFTER INSERT OR UPDATE OR DELETE ON route_legs
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER route_legs_changed ON route_legs;
+DROP TRIGGER propane_fills_changed ON propane_fills;
+DROP TRIGGER trip_shares_changed ON trip_shares;
+DROP TRIGGER stop_tags_changed ON stop_tags;
+DROP TRIGGER tags_changed ON tags;
+DROP TRIGGER stops_changed ON stops;
+DROP TRIGGER trips_changed ON trips;
+DROP FUNCTION note_table_change();
+DROP TABLE table_changes;
+-- +goose StatementEnd
@@ -0,0 +1,123 @@
+-- +goose Up
+-- +goose StatementBegin
+-- trip_summaries and tag_summaries hold per-trip and per-tag totals so the
+-- dashboard reads one row per trip and per tag instead of aggregating every
+-- stop. Only the triggers below write them.
+CREATE TABLE trip_summaries (
+    trip_id           UUID         PRIMARY KEY REFERENCES trips(id) ON DELETE CASCADE,
+    stop_count        INTEGER      NOT NULL DEFAULT 0,
+    nights            INTEGER      NOT NULL DEFAULT 0,
+    first_arrived_at  TIMESTAMPTZ,
+    last_arrived_at   TIMESTAMPTZ
+);
+
+CREATE TABLE tag_summaries (
+    tag_id      UUID     PRIMARY KEY REFERENCES tags(id) ON DELETE CASCADE,
+    stop_count  INTEGER  NOT NULL DEFAULT 0
+);
+
+-- A trip's summary is recomputed from its own stops, which this index finds
+-- without scanning the rest of the history.
+CREATE INDEX stops_trip_id_arrived_at_idx ON stops (trip_id, arrived_at);
+
+-- A stop's nights are the calendar days (UTC) from arrival to departure; a
+-- stop with no departure yet counts as one night. The heatmap counts the same.
+CREATE FUNCTION refresh_trip_summary(trip UUID) RETURNS void
+LANGUAGE sql AS $$
+    UPDATE trip_summaries ts
+    SET stop_count = agg.stop_count,
+        nights = agg.nights,
+        first_arrived_at = agg.first_arrived_at,
+        last_arrived_at = agg.last_arrived_at
+    FROM (
+        SELECT count(*) AS stop_count,
+               coalesce(sum(CASE WHEN departed_at IS NULL THEN 1
+                                 ELSE (departed_at AT TIME ZONE 'UTC')::date - (arrived_at AT TIME ZONE 'UTC')::date
+                            END), 0) AS nights,
+               min(arrived_at) AS first_arrived_at,
+               max(arrived_at) AS last_arrived_at
+        FROM stops
+        WHERE trip_id = trip
+    ) agg
+    WHERE ts.trip_id = trip;
+$$;
+
+CREATE FUNCTION note_trip_created() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO trip_summaries (trip_id) VALUES (NEW.id);
+    RETURN NULL;
+END;
+$$;
+
+-- Summaries are only ever updated here, never inserted, so a stop deleted
+-- along with its trip cannot recreate the trip's summary.
+CREATE FUNCTION note_stop_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_trip_summary(OLD.trip_id);
+    END IF;
+    IF TG_OP = 'INSERT' OR (TG_OP = 'UPDATE' AND NEW.trip_id <> OLD.trip_id) THEN
+        PERFORM refresh_trip_summary(NEW.trip_id);
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+CREATE FUNCTION note_tag_created() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO tag_summaries (tag_id) VALUES (NEW.id);
+    RETURN NULL;
+END;
+$$;
+
+-- Tag counts move by one per row, so no stop is read at all.
+CREATE FUNCTION note_stop_tag_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE tag_summaries SET stop_count = stop_count - 1 WHERE tag_id = OLD.tag_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE tag_summaries SET stop_count = stop_count + 1 WHERE tag_id = NEW.tag_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER trips_summarized AFTER INSERT ON trips
+    FOR EACH ROW EXECUTE FUNCTION note_trip_created();
+CREATE TRIGGER stops_summarized AFTER INSERT OR UPDATE OF trip_id, arrived_at, departed_at OR DELETE ON stops
+    FOR EACH ROW EXECUTE FUNCTION note_stop_change();
+CREATE TRIGGER tags_summarized AFTER INSERT ON tags
+    FOR EACH ROW EXECUTE FUNCTION note_tag_created();
+CREATE TRIGGER stop_tags_summarized AFTER INSERT OR UPDATE OR DELETE ON stop_tags
+    FOR EACH ROW EXECUTE FUNCTION note_stop_tag_change();
+
+INSERT INTO trip_summaries (trip_id) SELECT id FROM trips;
+SELECT refresh_trip_summary(id) FROM trips;
+
+INSERT INTO tag_summaries (tag_id, stop_count)
+SELECT t.id, count(st.stop_id)
+FROM tags t
+LEFT JOIN stop_tags st ON st.tag_id = t.id
+GROUP BY t.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER stop_tags_summarized ON stop_tags;
+DROP TRIGGER tags_summarized ON tags;
+DROP TRIGGER stops_summarized ON stops;
+DROP TRIGGER trips_summarized ON trips;
+DROP FUNCTION note_stop_tag_change();
+DROP FUNCTION note_tag_created();
+DROP FUNCTION note_stop_change();
+DROP FUNCTION note_trip_created();
+DROP FUNCTION refresh_trip_summary(UUID);
+DROP INDEX stops_trip_id_arrived_at_idx;
+DROP TABLE tag_summaries;
+DROP TABLE trip_summaries;
+-- +goose StatementEnd
@@ -0,0 +1,155 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleting a trip or stop now moves it to the trash: deleted_at is set and
+-- every read skips the row, along with the stops of a trashed trip. The rows
+-- that hang off it stay put, so restoring brings everything back. The purge
+-- job deletes for real once the retention window has passed.
+ALTER TABLE trips ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE stops ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- The trash and the purge job read only trashed rows.
+CREATE INDEX trips_deleted_at_idx ON trips (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX stops_deleted_at_idx ON stops (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Trip totals count only the stops not in the trash.
+CREATE OR REPLACE FUNCTION refresh_trip_summary(trip UUID) RETURNS void
+LANGUAGE sql AS $$
+    UPDATE trip_summaries ts
+    SET stop_count = agg.stop_count,
+        nights = agg.nights,
+        first_arrived_at = agg.first_arrived_at,
+        last_arrived_at = agg.last_arrived_at
+    FROM (
+        SELECT count(*) AS stop_count,
+               coalesce(sum(CASE WHEN departed_at IS NULL THEN 1
+                                 ELSE (departed_at AT TIME ZONE 'UTC')::date - (arrived_at AT TIME ZONE 'UTC')::date
+                            END), 0) AS nights,
+               min(arrived_at) AS first_arrived_at,
+               max(arrived_at) AS last_arrived_at
+        FROM stops
+        WHERE trip_id = trip AND deleted_at IS NULL
+    ) agg
+    WHERE ts.trip_id = trip;
+$$;
+
+DROP TRIGGER stops_summarized ON stops;
+CREATE TRIGGER stops_summarized AFTER INSERT OR UPDATE OF trip_id, arrived_at, departed_at, deleted_at OR DELETE ON stops
+    FOR EACH ROW EXECUTE FUNCTION note_stop_change();
+
+-- Tag counts cover live stops on live trips. A stop_tags row only moves the
+-- count while its stop is live; rows deleted along with a purged stop are
+-- already out of the count, since the stop left it when it was trashed.
+CREATE OR REPLACE FUNCTION note_stop_tag_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND EXISTS (
+        SELECT 1 FROM stops s JOIN trips t ON t.id = s.trip_id
+        WHERE s.id = OLD.stop_id AND s.deleted_at IS NULL AND t.deleted_at IS NULL
+    ) THEN
+        UPDATE tag_summaries SET stop_count = stop_count - 1 WHERE tag_id = OLD.tag_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND EXISTS (
+        SELECT 1 FROM stops s JOIN trips t ON t.id = s.trip_id
+        WHERE s.id = NEW.stop_id AND s.deleted_at IS NULL AND t.deleted_at IS NULL
+    ) THEN
+        UPDATE tag_summaries SET stop_count = stop_count + 1 WHERE tag_id = NEW.tag_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+-- Trashing or restoring a stop moves its tags' counts by one, unless its
+-- trip is in the trash and the stop is out of the counts either way.
+CREATE FUNCTION note_stop_trashed() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM trips WHERE id = NEW.trip_id AND deleted_at IS NULL) THEN
+        UPDATE tag_summaries ts
+        SET stop_count = ts.stop_count + CASE WHEN NEW.deleted_at IS NULL THEN 1 ELSE -1 END
+        FROM stop_tags st
+        WHERE st.stop_id = NEW.id AND ts.tag_id = st.tag_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+-- Trashing or restoring a trip moves each tag's count by the number of the
+-- trip's live stops that carry it.
+CREATE FUNCTION note_trip_trashed() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    UPDATE tag_summaries ts
+    SET stop_count = ts.stop_count + CASE WHEN NEW.deleted_at IS NULL THEN used.stops ELSE -used.stops END
+    FROM (
+        SELECT st.tag_id, count(*) AS stops
+        FROM stop_tags st
+        JOIN stops s ON s.id = st.stop_id
+        WHERE s.trip_id = NEW.id AND s.deleted_at IS NULL
+        GROUP BY st.tag_id
+    ) used
+    WHERE ts.tag_id = used.tag_id;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER stops_trashed AFTER UPDATE OF deleted_at ON stops
+    FOR EACH ROW WHEN ((OLD.deleted_at IS NULL) <> (NEW.deleted_at IS NULL))
+    EXECUTE FUNCTION note_stop_trashed();
+CREATE TRIGGER trips_trashed AFTER UPDATE OF deleted_at ON trips
+    FOR EACH ROW WHEN ((OLD.deleted_at IS NULL) <> (NEW.deleted_at IS NULL))
+    EXECUTE FUNCTION note_trip_trashed();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Rolling back empties the trash for good.
+DELETE FROM trips WHERE deleted_at IS NOT NULL;
+DELETE FROM stops WHERE deleted_at IS NOT NULL;
+
+DROP TRIGGER trips_trashed ON trips;
+DROP TRIGGER stops_trashed ON stops;
+DROP FUNCTION note_trip_trashed();
+DROP FUNCTION note_stop_trashed();
+
+CREATE OR REPLACE FUNCTION note_stop_tag_change() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE tag_summaries SET stop_count = stop_count - 1 WHERE tag_id = OLD.tag_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE tag_summaries SET stop_count = stop_count + 1 WHERE tag_id = NEW.tag_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER stops_summarized ON stops;
+CREATE TRIGGER stops_summarized AFTER INSERT OR UPDATE OF trip_id, arrived_at, departed_at OR DELETE ON stops
+    FOR EACH ROW EXECUTE FUNCTION note_stop_change();
+
+CREATE OR REPLACE FUNCTION refresh_trip_summary(trip UUID) RETURNS void
+LANGUAGE sql AS $$
+    UPDATE trip_summaries ts
+    SET stop_count = agg.stop_count,
+        nights = agg.nights,
+        first_arrived_at = agg.first_arrived_at,
+        last_arrived_at = agg.last_arrived_at
+    FROM (
+        SELECT count(*) AS stop_count,
+               coalesce(sum(CASE WHEN departed_at IS NULL THEN 1
+                                 ELSE (departed_at AT TIME ZONE 'UTC')::date - (arrived_at AT TIME ZONE 'UTC')::date
+                            END), 0) AS nights,
+               min(arrived_at) AS first_arrived_at,
+               max(arrived_at) AS last_arrived_at
+        FROM stops
+        WHERE trip_id = trip
+    ) agg
+    WHERE ts.trip_id = trip;
+$$;
+
+DROP INDEX stops_deleted_at_idx;
+DROP INDEX trips_deleted_at_idx;
+ALTER TABLE stops DROP COLUMN deleted_at;
+ALTER TABLE trips DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+-- trip_revisions and stop_revisions hold every version of a trip's and a
+-- stop's editable fields, written by the repos alongside each create and
+-- update. revision counts up from 1 per record; the record's own revision
+-- column is the number of its current version, bumped under the row lock
+-- of the update so concurrent edits cannot take the same number.
+ALTER TABLE trips ADD COLUMN revision INT NOT NULL DEFAULT 1;
+ALTER TABLE stops ADD COLUMN revision INT NOT NULL DEFAULT 1;
+
+CREATE TABLE trip_revisions (
+    trip_id     UUID        NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    revision    INT         NOT NULL,
+    name        TEXT        NOT NULL,
+    start_date  DATE        NOT NULL,
+    end_date    DATE,
+    notes       TEXT,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (trip_id, revision)
+);
+
+CREATE TABLE stop_revisions (
+    stop_id     UUID             NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    revision    INT              NOT NULL,
+    name        TEXT             NOT NULL,
+    location    TEXT,
+    latitude    DOUBLE PRECISION,
+    longitude   DOUBLE PRECISION,
+    arrived_at  TIMESTAMPTZ      NOT NULL,
+    departed_at TIMESTAMPTZ,
+    notes       TEXT,
+    recorded_at TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    PRIMARY KEY (stop_id, revision)
+);
+
+-- Existing records start their history at their current version.
+INSERT INTO trip_revisions (trip_id, revision, name, start_date, end_date, notes, recorded_at)
+SELECT id, 1, name, start_date, end_date, notes, updated_at FROM trips;
+
+INSERT INTO stop_revisions (stop_id, revision, name, location, latitude, longitude, arrived_at, departed_at, notes, recorded_at)
+SELECT id, 1, name, location, latitude, longitude, arrived_at, departed_at, notes, updated_at FROM stops;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE stop_revisions;
+DROP TABLE trip_revisions;
+ALTER TABLE stops DROP COLUMN revision;
+ALTER TABLE trips DROP COLUMN revision;
+-- +goose StatementEnd
@@ -0,0 +1,113 @@
+-- +goose Up
+-- +goose StatementBegin
+-- organizations are the households or groups that each keep a logbook of
+-- their own. Every trip, tag, and log entry belongs to one, and the repos
+-- scope every query to the organization the request acts for.
+CREATE TABLE organizations (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    name        TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- organization_members are the users who share an organization's logbook.
+-- user_id is the ID an authenticated user carries; owners manage the
+-- organization and its members.
+CREATE TABLE organization_members (
+    organization_id  UUID        NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id          TEXT        NOT NULL,
+    role             TEXT        NOT NULL CHECK (role IN ('owner', 'member')),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX organization_members_user_id_idx ON organization_members (user_id);
+
+-- The default organization owns everything logged so far, and acts for
+-- requests that do not name one.
+INSERT INTO organizations (id, name) VALUES ('00000000-0000-0000-0000-000000000001', 'Default');
+
+-- Every table whose rows can stand alone gets an organization; rows that
+-- hang off a trip or a stop belong to the trip's. An organization with trips
+-- cannot be deleted, but everything else it owns goes with it.
+ALTER TABLE trips
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id);
+ALTER TABLE tags
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE odometer_readings
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE propane_fills
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE power_readings
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE checklist_templates
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE packing_lists
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE points_of_interest
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE location_pings
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+-- Dwells hang off a trip, but are looked up by device before the trip is
+-- known.
+ALTER TABLE location_dwells
+    ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX trips_organization_id_idx ON trips (organization_id, start_date DESC);
+CREATE INDEX odometer_readings_organization_id_idx ON odometer_readings (organization_id);
+CREATE INDEX propane_fills_organization_id_idx ON propane_fills (organization_id);
+CREATE INDEX power_readings_organization_id_idx ON power_readings (organization_id);
+CREATE INDEX checklist_templates_organization_id_idx ON checklist_templates (organization_id);
+CREATE INDEX packing_lists_organization_id_idx ON packing_lists (organization_id);
+CREATE INDEX points_of_interest_organization_id_idx ON points_of_interest (organization_id);
+
+-- Tag slugs and device report times are unique within an organization:
+-- two households may both tag a stop "beach", or name a phone "phone".
+ALTER TABLE tags DROP CONSTRAINT tags_slug_key;
+ALTER TABLE tags ADD CONSTRAINT tags_organization_id_slug_key UNIQUE (organization_id, slug);
+ALTER TABLE location_pings DROP CONSTRAINT location_pings_device_recorded_at_key;
+ALTER TABLE location_pings ADD CONSTRAINT location_pings_organization_id_device_recorded_at_key UNIQUE (organization_id, device, recorded_at);
+DROP INDEX location_dwells_open_device_idx;
+CREATE UNIQUE INDEX location_dwells_open_device_idx ON location_dwells (organization_id, device) WHERE departed_at IS NULL;
+DROP INDEX location_dwells_device_arrived_at_idx;
+CREATE INDEX location_dwells_device_arrived_at_idx ON location_dwells (organization_id, device, arrived_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Rolling back keeps only the default organization's data.
+DELETE FROM trips WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM tags WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM odometer_readings WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM propane_fills WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM power_readings WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM checklist_templates WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM packing_lists WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM points_of_interest WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM location_pings WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+DELETE FROM location_dwells WHERE organization_id <> '00000000-0000-0000-0000-000000000001';
+
+DROP INDEX location_dwells_device_arrived_at_idx;
+CREATE INDEX location_dwells_device_arrived_at_idx ON location_dwells (device, arrived_at DESC);
+DROP INDEX location_dwells_open_device_idx;
+CREATE UNIQUE INDEX location_dwells_open_device_idx ON location_dwells (device) WHERE departed_at IS NULL;
+
+ALTER TABLE location_pings DROP CONSTRAINT location_pings_organization_id_device_recorded_at_key;
+ALTER TABLE location_pings ADD CONSTRAINT location_pings_device_recorded_at_key UNIQUE (device, recorded_at);
+ALTER TABLE tags DROP CONSTRAINT tags_organization_id_slug_key;
+ALTER TABLE tags ADD CONSTRAINT tags_slug_key UNIQUE (slug);
+
+ALTER TABLE location_dwells DROP COLUMN organization_id;
+ALTER TABLE location_pings DROP COLUMN organization_id;
+ALTER TABLE points_of_interest DROP COLUMN organization_id;
+ALTER TABLE packing_lists DROP COLUMN organization_id;
+ALTER TABLE checklist_templates DROP COLUMN organization_id;
+ALTER TABLE power_readings DROP COLUMN organization_id;
+ALTER TABLE propane_fills DROP COLUMN organization_id;
+ALTER TABLE odometer_readings DROP COLUMN organization_id;
+ALTER TABLE tags DROP COLUMN organization_id;
+ALTER TABLE trips DROP COLUMN organization_id;
+
+DROP TABLE organization_members;
+DROP TABLE organizations;
+-- +goose StatementEnd
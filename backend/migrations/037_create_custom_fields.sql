@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- custom_fields are the fields an organization defines for its trips or
+-- stops beyond the built-in ones. Their values live in the metadata column
+-- of the trip or stop, keyed by key, and are checked against type on write.
+CREATE TABLE custom_fields (
+    id               UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID        NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    entity           TEXT        NOT NULL CHECK (entity IN ('trip', 'stop')),
+    key              TEXT        NOT NULL CHECK (key ~ '^[a-z][a-z0-9_]{0,63}$'),
+    label            TEXT        NOT NULL,
+    type             TEXT        NOT NULL CHECK (type IN ('text', 'number', 'boolean', 'date')),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (organization_id, entity, key)
+);
+
+ALTER TABLE trips ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}' CHECK (jsonb_typeof(metadata) = 'object');
+ALTER TABLE stops ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}' CHECK (jsonb_typeof(metadata) = 'object');
+
+-- jsonb_path_ops answers the @> containment filters on the list endpoints.
+CREATE INDEX trips_metadata_idx ON trips USING GIN (metadata jsonb_path_ops);
+CREATE INDEX stops_metadata_idx ON stops USING GIN (metadata jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX stops_metadata_idx;
+DROP INDEX trips_metadata_idx;
+ALTER TABLE stops DROP COLUMN metadata;
+ALTER TABLE trips DROP COLUMN metadata;
+DROP TABLE custom_fields;
+-- +goose StatementEnd
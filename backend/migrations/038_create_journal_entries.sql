@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- journal_entries are the narrative of a trip, written a day at a time in
+-- markdown, apart from the trip's single notes field. A day may have several
+-- entries. stop_id optionally ties an entry to the stop it was written at and
+-- is cleared if that stop is deleted.
+CREATE TABLE journal_entries (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    trip_id     UUID        NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    stop_id     UUID        REFERENCES stops(id) ON DELETE SET NULL,
+    entry_date  DATE        NOT NULL,
+    body        TEXT        NOT NULL,
+    mood        TEXT        CHECK (mood IN ('great', 'good', 'okay', 'rough', 'awful')),
+    weather     TEXT        CHECK (weather IN ('sunny', 'partly_cloudy', 'cloudy', 'rain', 'storm', 'snow', 'fog', 'wind')),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX journal_entries_trip_id_entry_date_idx ON journal_entries (trip_id, entry_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE journal_entries;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- webhooks are an organization's subscriptions to its own events. Each event
+-- in events is POSTed to url as JSON, signed with secret (HMAC-SHA256), for as
+-- long as the subscription is active. The secret is kept in the clear: it is
+-- needed to sign every delivery.
+CREATE TABLE webhooks (
+    id               UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID        NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    url              TEXT        NOT NULL,
+    events           TEXT[]      NOT NULL CHECK (cardinality(events) > 0),
+    description      TEXT,
+    active           BOOLEAN     NOT NULL DEFAULT true,
+    secret           TEXT        NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhooks_organization_id_idx ON webhooks (organization_id);
+
+-- webhook_deliveries is the log of every attempt to deliver an event,
+-- including test deliveries. status_code is NULL when no response came back,
+-- and error then says why.
+CREATE TABLE webhook_deliveries (
+    id            UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id    UUID        NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event         TEXT        NOT NULL,
+    payload       JSONB       NOT NULL,
+    status_code   INTEGER,
+    error         TEXT,
+    duration_ms   INTEGER     NOT NULL CHECK (duration_ms >= 0),
+    attempted_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhook_deliveries_webhook_id_attempted_at_idx ON webhook_deliveries (webhook_id, attempted_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A planned stop is one not reached yet: its arrived_at is NULL until the
+-- rig gets there, and it cannot have departed before then. Ordering by
+-- arrived_at puts planned stops after the visited ones.
+ALTER TABLE stops ALTER COLUMN arrived_at DROP NOT NULL;
+ALTER TABLE stops ADD CONSTRAINT stops_planned_not_departed
+    CHECK (arrived_at IS NOT NULL OR departed_at IS NULL);
+ALTER TABLE stop_revisions ALTER COLUMN arrived_at DROP NOT NULL;
+
+-- Trip totals count only the stops that have been reached.
+CREATE OR REPLACE FUNCTION refresh_trip_summary(trip UUID) RETURNS void
+LANGUAGE sql AS $$
+    UPDATE trip_summaries ts
+    SET stop_count = agg.stop_count,
+        nights = agg.nights,
+        first_arrived_at = agg.first_arrived_at,
+        last_arrived_at = agg.last_arrived_at
+    FROM (
+        SELECT count(*) AS stop_count,
+               coalesce(sum(CASE WHEN departed_at IS NULL THEN 1
+                                 ELSE (departed_at AT TIME ZONE 'UTC')::date - (arrived_at AT TIME ZONE 'UTC')::date
+                            END), 0) AS nights,
+               min(arrived_at) AS first_arrived_at,
+               max(arrived_at) AS last_arrived_at
+        FROM stops
+        WHERE trip_id = trip AND deleted_at IS NULL AND arrived_at IS NOT NULL
+    ) agg
+    WHERE ts.trip_id = trip;
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Rolling back deletes the stops not reached yet, and the planned versions
+-- in the history of the ones that were.
+DELETE FROM stops WHERE arrived_at IS NULL;
+DELETE FROM stop_revisions WHERE arrived_at IS NULL;
+
+CREATE OR REPLACE FUNCTION refresh_trip_summary(trip UUID) RETURNS void
+LANGUAGE sql AS $$
+    UPDATE trip_summaries ts
+    SET stop_count = agg.stop_count,
+        nights = agg.nights,
+        first_arrived_at = agg.first_arrived_at,
+        last_arrived_at = agg.last_arrived_at
+    FROM (
+        SELECT count(*) AS stop_count,
+               coalesce(sum(CASE WHEN departed_at IS NULL THEN 1
+                                 ELSE (departed_at AT TIME ZONE 'UTC')::date - (arrived_at AT TIME ZONE 'UTC')::date
+                            END), 0) AS nights,
+               min(arrived_at) AS first_arrived_at,
+               max(arrived_at) AS last_arrived_at
+        FROM stops
+        WHERE trip_id = trip AND deleted_at IS NULL
+    ) agg
+    WHERE ts.trip_id = trip;
+$$;
+
+ALTER TABLE stop_revisions ALTER COLUMN arrived_at SET NOT NULL;
+ALTER TABLE stops DROP CONSTRAINT stops_planned_not_departed;
+ALTER TABLE stops ALTER COLUMN arrived_at SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A tag may sit under another ("national-park" under "public-lands"). Tag
+-- stats roll a tag's stops up from the tags beneath it, and filtering by a
+-- tag also matches them. Deleting a parent lifts its children to the top.
+-- The service keeps the hierarchy free of cycles.
+ALTER TABLE tags
+    ADD COLUMN parent_id UUID REFERENCES tags(id) ON DELETE SET NULL,
+    ADD CONSTRAINT tags_parent_not_self CHECK (parent_id <> id);
+
+CREATE INDEX tags_parent_id_idx ON tags (parent_id) WHERE parent_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX tags_parent_id_idx;
+ALTER TABLE tags
+    DROP CONSTRAINT tags_parent_not_self,
+    DROP COLUMN parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- users are the accounts that can log in. The accounts listed in AUTH_USERS
+-- are created, or their passwords reset, at startup. password_hash is a
+-- salted PBKDF2 hash; the password itself is never stored.
+CREATE TABLE users (
+    id             UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    username       TEXT        NOT NULL UNIQUE,
+    password_hash  TEXT        NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A trip belongs to the user who logged it, and only they see it. Trips
+-- logged before accounts existed, or while no one was logged in, have no
+-- owner and stay visible to everyone in their organization. A user with
+-- trips cannot be deleted.
+ALTER TABLE trips ADD COLUMN user_id UUID REFERENCES users(id);
+
+CREATE INDEX trips_user_id_idx ON trips (user_id) WHERE user_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX trips_user_id_idx;
+ALTER TABLE trips DROP COLUMN user_id;
+DROP TABLE users;
+-- +goose StatementEnd
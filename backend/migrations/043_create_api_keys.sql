@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- api_keys let automation clients authenticate with an X-API-Key header
+-- instead of logging in. A key acts as the user who created it and is gone
+-- with them. Only the SHA-256 of the key is stored; the key is shown once,
+-- when it is created. prefix is its first characters, to recognize it by.
+CREATE TABLE api_keys (
+    id          UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name        TEXT        NOT NULL,
+    prefix      TEXT        NOT NULL,
+    key_hash    TEXT        NOT NULL UNIQUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- On a group trip an expense can be claimed by the co-traveller who paid it
+-- and shared evenly among split_among; an empty list means the payer bore it
+-- alone. Co-travellers are names, not users, so anyone along for the trip
+-- can be included. Expenses with no payer are left out of settlements.
+ALTER TABLE expenses
+    ADD COLUMN paid_by     TEXT,
+    ADD COLUMN split_among TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT expenses_split_needs_payer CHECK (paid_by IS NOT NULL OR cardinality(split_among) = 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE expenses
+    DROP CONSTRAINT expenses_split_needs_payer,
+    DROP COLUMN split_among,
+    DROP COLUMN paid_by;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- maintenance_items are the services a vehicle needs every interval_miles,
+-- like an oil change every 5,000 miles. vehicle is the label its odometer
+-- readings are logged under; an item falls due when the latest reading
+-- reaches its last service's miles plus the interval.
+CREATE TABLE maintenance_items (
+    id               UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID           NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    vehicle          TEXT           NOT NULL,
+    name             TEXT           NOT NULL,
+    interval_miles   NUMERIC(9, 1)  NOT NULL CHECK (interval_miles > 0),
+    created_at       TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX maintenance_items_organization_id_vehicle_idx ON maintenance_items (organization_id, vehicle);
+
+-- maintenance_records is the service history: each time an item was done,
+-- and at what odometer reading. The record with the highest miles is the
+-- item's last service.
+CREATE TABLE maintenance_records (
+    id           UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    item_id      UUID           NOT NULL REFERENCES maintenance_items(id) ON DELETE CASCADE,
+    miles        NUMERIC(9, 1)  NOT NULL CHECK (miles >= 0),
+    serviced_on  DATE           NOT NULL,
+    notes        TEXT,
+    created_at   TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX maintenance_records_item_id_miles_idx ON maintenance_records (item_id, miles DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE maintenance_records;
+DROP TABLE maintenance_items;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- trip_members are the users a trip's owner has invited to collaborate on
+-- it. Editors may change the trip and what is logged against it; viewers
+-- only read it. The owner is trips.user_id and is never listed here.
+CREATE TABLE trip_members (
+    trip_id     UUID        NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+    user_id     UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role        TEXT        NOT NULL CHECK (role IN ('editor', 'viewer')),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (trip_id, user_id)
+);
+
+CREATE INDEX trip_members_user_id_idx ON trip_members (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE trip_members;
+-- +goose StatementEnd
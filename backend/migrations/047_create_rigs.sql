@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rigs are the vehicles an organization travels in. name is how the rig is
+-- known day to day; make, model, and its size are reference details, kept
+-- in feet and pounds as the owner's manual gives them.
+CREATE TABLE rigs (
+    id               UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID           NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name             TEXT           NOT NULL,
+    make             TEXT,
+    model            TEXT,
+    length_feet      NUMERIC(5, 1)  CHECK (length_feet > 0),
+    weight_lbs       NUMERIC(8, 1)  CHECK (weight_lbs > 0),
+    created_at       TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX rigs_organization_id_idx ON rigs (organization_id, name);
+
+-- A trip records the rig it was taken in. Deleting a rig leaves its trips
+-- in place with no rig.
+ALTER TABLE trips ADD COLUMN rig_id UUID REFERENCES rigs(id) ON DELETE SET NULL;
+
+CREATE INDEX trips_rig_id_idx ON trips (rig_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE trips DROP COLUMN rig_id;
+DROP TABLE rigs;
+-- +goose StatementEnd
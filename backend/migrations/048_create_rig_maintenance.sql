@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rig_maintenance is the log of work done on a rig: oil changes, tire
+-- rotations, generator service. odometer_miles and engine_hours are the
+-- readings when the work was done; generators and some engines are serviced
+-- by hours rather than miles, so either may be missing.
+CREATE TABLE rig_maintenance (
+    id              UUID           PRIMARY KEY DEFAULT gen_random_uuid(),
+    rig_id          UUID           NOT NULL REFERENCES rigs(id) ON DELETE CASCADE,
+    name            TEXT           NOT NULL,
+    serviced_on     DATE           NOT NULL,
+    odometer_miles  NUMERIC(9, 1)  CHECK (odometer_miles >= 0),
+    engine_hours    NUMERIC(8, 1)  CHECK (engine_hours >= 0),
+    notes           TEXT,
+    created_at      TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX rig_maintenance_rig_id_serviced_on_idx ON rig_maintenance (rig_id, serviced_on DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE rig_maintenance;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A stop can record the odometer on arrival. Readings must rise with arrival
+-- time within a trip; StopService checks that, since it spans rows.
+ALTER TABLE stops ADD COLUMN odometer_miles NUMERIC(9, 1) CHECK (odometer_miles >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stops DROP COLUMN odometer_miles;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A trip can set what it is expected to cost, in whole cents, for
+-- GET /trips/{tripId}/budget-report to compare its expenses against.
+ALTER TABLE trips ADD COLUMN budget_cents BIGINT CHECK (budget_cents >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE trips DROP COLUMN budget_cents;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- stop_photos are images attached to a stop. The bytes live in object
+-- storage under object_key; the row keeps what is needed to serve them back.
+-- filename is the name the file was uploaded under, if the client sent one.
+CREATE TABLE stop_photos (
+    id            UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    stop_id       UUID        NOT NULL REFERENCES stops(id) ON DELETE CASCADE,
+    object_key    TEXT        NOT NULL UNIQUE,
+    content_type  TEXT        NOT NULL,
+    size_bytes    BIGINT      NOT NULL CHECK (size_bytes >= 0),
+    filename      TEXT,
+    caption       TEXT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX stop_photos_stop_id_idx ON stop_photos (stop_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE stop_photos;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- thumbnails maps each thumbnail size ("small", "medium") to the object key
+-- of its JPEG. Photos the server could not decode, and those uploaded before
+-- thumbnails were made, have none.
+ALTER TABLE stop_photos ADD COLUMN thumbnails JSONB NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stop_photos DROP COLUMN thumbnails;
+-- +goose StatementEnd
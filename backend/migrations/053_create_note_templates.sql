@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- note_templates are the questions an organization asks about every stop it
+-- logs — site number, cell coverage, noise level. questions is an ordered
+-- array of {"key", "prompt", "choices"} objects. A stop created from a
+-- template keeps its answers in note_answers, keyed by question key, and
+-- has them written into its notes; editing or deleting the template never
+-- rewrites either.
+CREATE TABLE note_templates (
+    id               UUID        PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID        NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name             TEXT        NOT NULL,
+    questions        JSONB       NOT NULL CHECK (jsonb_typeof(questions) = 'array' AND jsonb_array_length(questions) > 0),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX note_templates_organization_id_idx ON note_templates (organization_id);
+
+ALTER TABLE stops
+    ADD COLUMN note_template_id UUID REFERENCES note_templates(id) ON DELETE SET NULL,
+    ADD COLUMN note_answers JSONB NOT NULL DEFAULT '{}' CHECK (jsonb_typeof(note_answers) = 'object');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stops DROP COLUMN note_answers, DROP COLUMN note_template_id;
+DROP TABLE note_templates;
+-- +goose StatementEnd
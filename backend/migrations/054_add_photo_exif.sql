@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Where and when a photo was taken, as read from its EXIF metadata when it
+-- was uploaded. latitude and longitude are set together or not at all; all
+-- three are NULL for photos with no EXIF and those uploaded before 054.
+ALTER TABLE stop_photos
+    ADD COLUMN taken_at  TIMESTAMPTZ,
+    ADD COLUMN latitude  DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT stop_photos_coordinates_paired CHECK ((latitude IS NULL) = (longitude IS NULL));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stop_photos
+    DROP CONSTRAINT stop_photos_coordinates_paired,
+    DROP COLUMN longitude,
+    DROP COLUMN latitude,
+    DROP COLUMN taken_at;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Answers from external geocoders, so the same point is never looked up
+-- twice while its answer is fresh. query is the provider's normalized form
+-- of the lookup, e.g. coordinates rounded to a campground's size. Geography
+-- is the same for everyone, so rows belong to no organization. An empty
+-- country_code is an answer too: nothing there, such as out at sea.
+CREATE TABLE geocode_cache (
+    provider     TEXT        NOT NULL,
+    query        TEXT        NOT NULL,
+    country_code TEXT        NOT NULL DEFAULT '',
+    region_code  TEXT        NOT NULL DEFAULT '',
+    region_name  TEXT        NOT NULL DEFAULT '',
+    fetched_at   TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (provider, query),
+    CHECK (expires_at > fetched_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE geocode_cache;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When each scheduled job last ran successfully, across every API replica.
+-- A replica runs a job only while holding its advisory lock, and only if
+-- this row says it is due, so the job runs once per interval fleet-wide.
+CREATE TABLE job_runs (
+    name        TEXT PRIMARY KEY,
+    last_run_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE job_runs;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Which trips a user sees depends on trip_members, so the cached views that
+-- filter by it must move their Last-Modified when a member is added or
+-- removed. See note_table_change in 032.
+INSERT INTO table_changes (table_name, changed_at)
+VALUES ('trip_members', now())
+ON CONFLICT (table_name) DO NOTHING;
+
+CREATE CONSTRAINT TRIGGER trip_members_changed AFTER INSERT OR UPDATE OR DELETE ON trip_members
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION note_table_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER trip_members_changed ON trip_members;
+DELETE FROM table_changes WHERE table_name = 'trip_members';
+-- +goose StatementEnd
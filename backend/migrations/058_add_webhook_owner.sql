@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A webhook belongs to the user who registered it, and hears only about the
+-- trips that user can see: events on another user's private trip are not
+-- delivered to it. Webhooks registered before accounts existed, or while no
+-- one was logged in, have no owner and hear only about unowned trips.
+ALTER TABLE webhooks ADD COLUMN user_id UUID REFERENCES users(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE webhooks DROP COLUMN user_id;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- tombstones remember the trips and stops purged from the trash, so a client
+-- syncing the export offline learns about deletions it missed. The trash
+-- purge writes them in the same statement as the delete, one for the trip and
+-- one for every stop that goes with it. organization_id and user_id are the
+-- trip's, so a tombstone is seen by whoever could see the trip; a member of a
+-- private trip no longer can, since the membership goes with the trip.
+CREATE TABLE tombstones (
+    entity_type      TEXT        NOT NULL CHECK (entity_type IN ('trip', 'stop')),
+    id               UUID        NOT NULL,
+    organization_id  UUID        NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id          UUID        REFERENCES users(id) ON DELETE CASCADE,
+    deleted_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (entity_type, id)
+);
+
+CREATE INDEX tombstones_organization_id_idx ON tombstones (organization_id, deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE tombstones;
+-- +goose StatementEnd
@@ -0,0 +1,191 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ContainerEnv controls the throwaway Postgres container started when
+// TEST_DATABASE_URL is not set. Unset, a container is started whenever a
+// container CLI is installed; "1" requires one, and "0" turns containers off
+// so integration tests skip.
+const ContainerEnv = "TEST_DB_CONTAINER"
+
+// containerCLIEnv selects the container CLI. Unset, docker is used if it is
+// installed and podman otherwise. Both accept the same run/port/rm arguments.
+const containerCLIEnv = "TEST_DB_CONTAINER_CLI"
+
+// defaultContainerImage matches the image used by docker-compose.yml.
+// Override with TEST_DB_IMAGE to test against another Postgres version; it
+// must ship PostGIS.
+const defaultContainerImage = "postgis/postgis:16-3.4-alpine"
+
+// containerReadyTimeout bounds how long SetupTestDatabase waits for Postgres
+// to accept connections, including a first-time image pull.
+const containerReadyTimeout = 2 * time.Minute
+
+// SetupTestDatabase makes sure TEST_DATABASE_URL points at a usable database
+// before a package's tests run. Call it at the top of TestMain.
+//
+//   - TEST_DATABASE_URL already set → nothing to do; teardown is a no-op.
+//   - TEST_DB_CONTAINER=0          → nothing to do; tests skip.
+//   - otherwise                    → start a disposable Postgres container,
+//     export its DSN as TEST_DATABASE_URL, and return a teardown that removes it.
+//
+// With no container CLI installed, tests skip as they did before containers,
+// unless TEST_DB_CONTAINER=1 asked for one, which is then an error. So is a
+// container that does not start, rather than a silent skip.
+//
+// The container is driven through the docker (or podman) CLI rather than a
+// client library such as testcontainers-go, to keep the module's dependency
+// graph free of the Docker SDK. It is started with --rm, so the runtime
+// cleans it up even if teardown never runs.
+func SetupTestDatabase() (teardown func(), err error) {
+	noop := func() {}
+	mode := os.Getenv(ContainerEnv)
+	if os.Getenv("TEST_DATABASE_URL") != "" || mode == "0" {
+		return noop, nil
+	}
+	if _, err := containerCLI(); err != nil {
+		if mode == "1" {
+			return noop, fmt.Errorf("testutil.SetupTestDatabase: %w", err)
+		}
+		return noop, nil
+	}
+
+	dsn, stop, err := StartPostgresContainer(context.Background())
+	if err != nil {
+		return noop, err
+	}
+	if err := os.Setenv("TEST_DATABASE_URL", dsn); err != nil {
+		stop()
+		return noop, fmt.Errorf("testutil.SetupTestDatabase: %w", err)
+	}
+	return func() {
+		_ = os.Unsetenv("TEST_DATABASE_URL")
+		stop()
+	}, nil
+}
+
+// MustSetupTestDatabase is SetupTestDatabase for TestMain, where there is no
+// *testing.T to fail. It panics on error.
+func MustSetupTestDatabase() (teardown func()) {
+	teardown, err := SetupTestDatabase()
+	if err != nil {
+		panic("testutil.MustSetupTestDatabase: " + err.Error())
+	}
+	return teardown
+}
+
+// StartPostgresContainer starts a disposable Postgres container bound to a
+// random loopback port, waits until it accepts connections, and returns its
+// DSN and a function that removes it.
+func StartPostgresContainer(ctx context.Context) (dsn string, stop func(), err error) {
+	image := os.Getenv("TEST_DB_IMAGE")
+	if image == "" {
+		image = defaultContainerImage
+	}
+
+	id, err := docker(ctx, "run", "--detach", "--rm",
+		"--env", "POSTGRES_USER=postgres",
+		"--env", "POSTGRES_PASSWORD=postgres",
+		"--env", "POSTGRES_DB=rvlogbook_test",
+		"--publish", "127.0.0.1::5432",
+		image,
+		// Durability is irrelevant for a throwaway database; skipping fsync
+		// makes migrations and tests noticeably faster.
+		"-c", "fsync=off", "-c", "synchronous_commit=off", "-c", "full_page_writes=off",
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("testutil.StartPostgresContainer: docker run: %w", err)
+	}
+	stop = func() {
+		_, _ = docker(context.Background(), "rm", "--force", "--volumes", id)
+	}
+
+	hostPort, err := docker(ctx, "port", id, "5432/tcp")
+	if err != nil {
+		stop()
+		return "", nil, fmt.Errorf("testutil.StartPostgresContainer: docker port: %w", err)
+	}
+	// `docker port` may print one line per address family; the first is ours.
+	hostPort = strings.SplitN(hostPort, "\n", 2)[0]
+	if _, _, err := net.SplitHostPort(hostPort); err != nil {
+		stop()
+		return "", nil, fmt.Errorf("testutil.StartPostgresContainer: unexpected port mapping %q", hostPort)
+	}
+
+	dsn = fmt.Sprintf("postgres://postgres:postgres@%s/rvlogbook_test?sslmode=disable", hostPort)
+	if err := waitForPostgres(ctx, dsn, containerReadyTimeout); err != nil {
+		stop()
+		return "", nil, fmt.Errorf("testutil.StartPostgresContainer: %w", err)
+	}
+	return dsn, stop, nil
+}
+
+// waitForPostgres polls dsn until a connection succeeds or timeout elapses.
+// The official image runs initdb against a socket-only server first, so a
+// successful TCP connection means the final server is up.
+func waitForPostgres(ctx context.Context, dsn string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastErr error
+	for {
+		conn, err := pgx.Connect(ctx, dsn)
+		if err == nil {
+			return conn.Close(ctx)
+		}
+		lastErr = err
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("postgres not ready after %s: %w", timeout, lastErr)
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// containerCLI returns the path of the container CLI: the one named by
+// containerCLIEnv, or else docker or podman, whichever is installed.
+func containerCLI() (string, error) {
+	if cli := os.Getenv(containerCLIEnv); cli != "" {
+		path, err := exec.LookPath(cli)
+		if err != nil {
+			return "", fmt.Errorf("%s=%s: %w", containerCLIEnv, cli, err)
+		}
+		return path, nil
+	}
+	for _, cli := range []string{"docker", "podman"} {
+		if path, err := exec.LookPath(cli); err == nil {
+			return path, nil
+		}
+	}
+	return "", errors.New("neither docker nor podman is installed")
+}
+
+// docker runs the container CLI (see containerCLI) and returns its trimmed
+// stdout.
+func docker(ctx context.Context, args ...string) (string, error) {
+	cli, err := containerCLI()
+	if err != nil {
+		return "", err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, cli, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
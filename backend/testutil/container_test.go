@@ -0,0 +1,46 @@
+package testutil_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// TestSetupTestDatabase_NoContainerCLI covers the cases that start no
+// container, with PATH emptied so neither docker nor podman can be found.
+func TestSetupTestDatabase_NoContainerCLI(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		mode    string
+		wantErr bool
+	}{
+		{"database given", "postgres://localhost/rvlogbook_test", "1", false},
+		{"containers off", "", "0", false},
+		{"default skips without a CLI", "", "", false},
+		{"required container without a CLI", "", "1", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("PATH", "")
+			t.Setenv("TEST_DB_CONTAINER_CLI", "")
+			t.Setenv("TEST_DATABASE_URL", tc.dsn)
+			t.Setenv(testutil.ContainerEnv, tc.mode)
+
+			teardown, err := testutil.SetupTestDatabase()
+
+			require.NotNil(t, teardown)
+			teardown()
+			if tc.wantErr {
+				assert.ErrorContains(t, err, "neither docker nor podman")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.dsn, os.Getenv("TEST_DATABASE_URL"))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package testutil
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/internal/middleware"
+	"github.com/pkordes/rv-logbook/backend/spec"
+)
+
+// ContractHandler wraps h so that every response it produces is validated
+// against the embedded OpenAPI spec. Any mismatch — an undeclared status
+// code, a missing required field, a wrong type — fails t with the offending
+// request and the validator's explanation.
+//
+// Requests are deliberately not validated: tests that send malformed input
+// must still reach the handler to assert how it is rejected. Only the
+// server's half of the contract is checked.
+func ContractHandler(t testing.TB, h http.Handler) http.Handler {
+	t.Helper()
+
+	validate, err := middleware.NewOpenAPIValidator(spec.OpenAPI, middleware.OpenAPIValidatorOptions{
+		ValidateResponses: true,
+		OnResponseError: func(r *http.Request, status int, err error) {
+			t.Errorf("response to %s %s (status %d) does not match openapi.yaml:\n%v", r.Method, r.URL, status, err)
+		},
+	})
+	if err != nil {
+		t.Fatalf("testutil.ContractHandler: %v", err)
+	}
+	return validate(h)
+}
@@ -1,6 +1,8 @@
 // Package testutil provides shared helpers for integration tests.
-// Helpers in this package skip automatically when required environment
-// variables are not set, so unit tests can run without a running database.
+// With TEST_DATABASE_URL unset, SetupTestDatabase starts a throwaway
+// Postgres container when docker or podman is installed (see container.go).
+// Without either, or with TEST_DB_CONTAINER=0, the helpers skip, so unit
+// tests can run without a database.
 package testutil
 
 import (
@@ -11,13 +13,17 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib" // registers "pgx" driver for database/sql
+	"github.com/pressly/goose/v3"
+
+	"github.com/pkordes/rv-logbook/backend/migrations"
 )
 
 // NewPool opens a *pgxpool.Pool connected to the database specified by the
 // TEST_DATABASE_URL environment variable.
 //
-// The test is skipped automatically if TEST_DATABASE_URL is not set, so
-// integration tests are opt-in and never break CI environments that lack a DB.
+// The test is skipped automatically if TEST_DATABASE_URL is not set, which
+// after SetupTestDatabase means no container could be started, so integration
+// tests never break environments without a database or a container runtime.
 // The pool is closed automatically when the test (and all its subtests) finish.
 func NewPool(t *testing.T) *pgxpool.Pool {
 	t.Helper()
@@ -84,7 +90,22 @@ func requireDSN(t *testing.T) string {
 	t.Helper()
 	dsn := os.Getenv("TEST_DATABASE_URL")
 	if dsn == "" {
-		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+		t.Skip("TEST_DATABASE_URL not set and no test container started (see " + ContainerEnv + "); skipping integration test")
 	}
 	return dsn
 }
+
+// MustMigrate applies every pending migration to the database at dsn and
+// panics on any error. Use this in TestMain, after SetupTestDatabase.
+func MustMigrate(dsn string) {
+	db := MustOpenSQLDB(dsn)
+	defer db.Close()
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS)
+	if err != nil {
+		panic("testutil.MustMigrate: create goose provider: " + err.Error())
+	}
+	if _, err := provider.Up(context.Background()); err != nil {
+		panic("testutil.MustMigrate: run migrations: " + err.Error())
+	}
+}
@@ -0,0 +1,180 @@
+// Package exiftest builds JPEG images carrying EXIF metadata, for tests of
+// code that reads where and when a photo was taken.
+package exiftest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"math"
+	"testing"
+	"time"
+)
+
+// Tags are the EXIF tags a JPEG is written with. Zero fields are left out.
+type Tags struct {
+	// Latitude and Longitude are written as GPS degrees, minutes, and
+	// seconds with their hemisphere references.
+	Latitude  *float64
+	Longitude *float64
+	// DateTimeOriginal ("2006:01:02 15:04:05") and OffsetTimeOriginal
+	// ("-07:00") are written as given.
+	DateTimeOriginal   string
+	OffsetTimeOriginal string
+	// GPSTime is written as the GPS date and time stamps, in UTC.
+	GPSTime time.Time
+	// LittleEndian writes the block in Intel rather than Motorola order.
+	LittleEndian bool
+}
+
+// JPEG returns an 8x8 JPEG image, one that decodes, whose EXIF block holds
+// tags.
+func JPEG(t testing.TB, tags Tags) []byte {
+	t.Helper()
+	var img bytes.Buffer
+	if err := jpeg.Encode(&img, image.NewGray(image.Rect(0, 0, 8, 8)), nil); err != nil {
+		t.Fatalf("exiftest: encode: %v", err)
+	}
+	block := append([]byte("Exif\x00\x00"), TIFF(tags)...)
+
+	var out bytes.Buffer
+	out.Write(img.Bytes()[:2]) // SOI
+	out.Write([]byte{0xFF, 0xE1})
+	_ = binary.Write(&out, binary.BigEndian, uint16(2+len(block)))
+	out.Write(block)
+	out.Write(img.Bytes()[2:])
+	return out.Bytes()
+}
+
+// entry is one field of a directory being written.
+type entry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+// TIFF returns the TIFF structure of an EXIF block holding tags: the main
+// directory, then the EXIF and GPS directories it points to.
+func TIFF(tags Tags) []byte {
+	var order binary.AppendByteOrder = binary.BigEndian
+	header := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	if tags.LittleEndian {
+		order = binary.LittleEndian
+		header = []byte("II\x2a\x00\x08\x00\x00\x00")
+	}
+
+	var exifDir, gpsDir []entry
+	if tags.DateTimeOriginal != "" {
+		exifDir = append(exifDir, asciiEntry(0x9003, tags.DateTimeOriginal))
+	}
+	if tags.OffsetTimeOriginal != "" {
+		exifDir = append(exifDir, asciiEntry(0x9011, tags.OffsetTimeOriginal))
+	}
+	if tags.Latitude != nil && tags.Longitude != nil {
+		gpsDir = append(gpsDir,
+			asciiEntry(0x0001, hemisphere(*tags.Latitude, "N", "S")),
+			rationalEntry(order, 0x0002, dms(*tags.Latitude)),
+			asciiEntry(0x0003, hemisphere(*tags.Longitude, "E", "W")),
+			rationalEntry(order, 0x0004, dms(*tags.Longitude)),
+		)
+	}
+	if !tags.GPSTime.IsZero() {
+		at := tags.GPSTime.UTC()
+		gpsDir = append(gpsDir,
+			rationalEntry(order, 0x0007, []float64{float64(at.Hour()), float64(at.Minute()), float64(at.Second())}),
+			asciiEntry(0x001d, at.Format("2006:01:02")),
+		)
+	}
+
+	// The main directory holds only the pointers, so its size is known
+	// before the offsets it points to.
+	var mainDir []entry
+	if len(exifDir) > 0 {
+		mainDir = append(mainDir, entry{tag: 0x8769, typ: 4, count: 1})
+	}
+	if len(gpsDir) > 0 {
+		mainDir = append(mainDir, entry{tag: 0x8825, typ: 4, count: 1})
+	}
+	next := uint32(len(header)) + dirSize(mainDir)
+	for i := range mainDir {
+		mainDir[i].value = order.AppendUint32(nil, next)
+		if mainDir[i].tag == 0x8769 {
+			next += dirSize(exifDir)
+		} else {
+			next += dirSize(gpsDir)
+		}
+	}
+
+	out := writeDir(header, order, mainDir)
+	if len(exifDir) > 0 {
+		out = writeDir(out, order, exifDir)
+	}
+	if len(gpsDir) > 0 {
+		out = writeDir(out, order, gpsDir)
+	}
+	return out
+}
+
+// dirSize is how many bytes writeDir takes for entries.
+func dirSize(entries []entry) uint32 {
+	size := uint32(2 + 12*len(entries) + 4)
+	for _, e := range entries {
+		if len(e.value) > 4 {
+			size += uint32(len(e.value))
+		}
+	}
+	return size
+}
+
+// writeDir appends a directory, with the values too long to fit in their
+// entries after it, to out.
+func writeDir(out []byte, order binary.AppendByteOrder, entries []entry) []byte {
+	valuesAt := uint32(len(out)) + uint32(2+12*len(entries)+4)
+	var values []byte
+	out = order.AppendUint16(out, uint16(len(entries)))
+	for _, e := range entries {
+		out = order.AppendUint16(out, e.tag)
+		out = order.AppendUint16(out, e.typ)
+		out = order.AppendUint32(out, e.count)
+		if len(e.value) <= 4 {
+			out = append(out, e.value...)
+			out = append(out, make([]byte, 4-len(e.value))...)
+			continue
+		}
+		out = order.AppendUint32(out, valuesAt+uint32(len(values)))
+		values = append(values, e.value...)
+	}
+	out = order.AppendUint32(out, 0) // no next directory
+	return append(out, values...)
+}
+
+func asciiEntry(tag uint16, s string) entry {
+	return entry{tag: tag, typ: 2, count: uint32(len(s) + 1), value: append([]byte(s), 0)}
+}
+
+// rationalEntry writes each value as a fraction over 10000.
+func rationalEntry(order binary.AppendByteOrder, tag uint16, values []float64) entry {
+	var b []byte
+	for _, v := range values {
+		b = order.AppendUint32(b, uint32(math.Round(v*10000)))
+		b = order.AppendUint32(b, 10000)
+	}
+	return entry{tag: tag, typ: 5, count: uint32(len(values)), value: b}
+}
+
+func hemisphere(deg float64, positive, negative string) string {
+	if deg < 0 {
+		return negative
+	}
+	return positive
+}
+
+// dms splits a coordinate into whole degrees, whole minutes, and seconds.
+func dms(deg float64) []float64 {
+	deg = math.Abs(deg)
+	d := math.Floor(deg)
+	m := math.Floor((deg - d) * 60)
+	return []float64{d, m, (deg - d - m/60) * 3600}
+}
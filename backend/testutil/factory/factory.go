@@ -0,0 +1,283 @@
+// Package factory provides fluent builders for test fixtures.
+//
+// Every builder starts from sensible defaults, so a test only spells out the
+// fields it actually cares about:
+//
+//	trip := factory.Trip().WithName("Utah").Build()              // in memory (service tests)
+//	trip := factory.Trip().Insert(t, tx)                         // persisted (repo tests)
+//	g := factory.Trip().
+//		WithStops(
+//			factory.Stop().WithName("Moab").WithTags("desert", "quiet"),
+//			factory.Stop().WithName("Zion"),
+//		).
+//		InsertGraph(t, tx)                                       // trip → stops → tags
+//
+// Insert methods write through the real repo implementations, so fixtures go
+// through the same SQL as production code. Pass the per-test transaction as
+// db to keep the rollback isolation described in internal/repo.
+package factory
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+)
+
+// DB is the minimal database handle the builders insert through.
+// It is satisfied by *pgxpool.Pool, *pgx.Conn, and pgx.Tx.
+type DB interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// ---- Trip ------------------------------------------------------------------
+
+// TripBuilder builds a domain.Trip and, optionally, its stops.
+type TripBuilder struct {
+	trip  domain.Trip
+	stops []*StopBuilder
+}
+
+// Trip returns a builder for a two-week trip starting 2025-06-01.
+func Trip() *TripBuilder {
+	end := domain.NewDate(2025, 6, 15)
+	return &TripBuilder{trip: domain.Trip{
+		Name:      "Summer Tour",
+		StartDate: domain.NewDate(2025, 6, 1),
+		EndDate:   &end,
+		Notes:     "Test notes",
+	}}
+}
+
+// WithID sets the trip ID returned by Build. Insert ignores it — the database
+// generates IDs.
+func (b *TripBuilder) WithID(id uuid.UUID) *TripBuilder { b.trip.ID = id; return b }
+
+// WithName sets the trip name.
+func (b *TripBuilder) WithName(name string) *TripBuilder { b.trip.Name = name; return b }
+
+// WithStartDate sets the start date.
+func (b *TripBuilder) WithStartDate(d domain.Date) *TripBuilder { b.trip.StartDate = d; return b }
+
+// WithEndDate sets the end date. Pass nil for a trip still in progress.
+func (b *TripBuilder) WithEndDate(d *domain.Date) *TripBuilder { b.trip.EndDate = d; return b }
+
+// WithNotes sets the free-text notes.
+func (b *TripBuilder) WithNotes(notes string) *TripBuilder { b.trip.Notes = notes; return b }
+
+// WithMetadata sets the trip's custom field values.
+func (b *TripBuilder) WithMetadata(m domain.Metadata) *TripBuilder { b.trip.Metadata = m; return b }
+
+// WithStops attaches stop builders that InsertGraph inserts under this trip.
+func (b *TripBuilder) WithStops(stops ...*StopBuilder) *TripBuilder {
+	b.stops = append(b.stops, stops...)
+	return b
+}
+
+// Build returns the trip without touching the database. A random ID is
+// assigned unless WithID was used, as service-layer mocks usually need one.
+func (b *TripBuilder) Build() domain.Trip {
+	t := b.trip
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return t
+}
+
+// Insert persists the trip (and any WithStops children) and returns the
+// stored trip. The test fails immediately on any database error.
+func (b *TripBuilder) Insert(t *testing.T, db DB) domain.Trip {
+	t.Helper()
+	return b.InsertGraph(t, db).Trip
+}
+
+// TripGraph is a persisted trip together with its persisted stops, in the
+// order they were attached. Each stop's Tags field is populated.
+type TripGraph struct {
+	Trip  domain.Trip
+	Stops []domain.Stop
+}
+
+// InsertGraph persists the trip, its stops, and each stop's tags.
+func (b *TripBuilder) InsertGraph(t *testing.T, db DB) TripGraph {
+	t.Helper()
+	trip, err := repo.NewTripRepo(db).Create(context.Background(), b.trip)
+	if err != nil {
+		t.Fatalf("factory.TripBuilder.Insert: %v", err)
+	}
+
+	g := TripGraph{Trip: trip, Stops: make([]domain.Stop, 0, len(b.stops))}
+	for _, sb := range b.stops {
+		g.Stops = append(g.Stops, sb.insertUnder(t, db, trip.ID))
+	}
+	return g
+}
+
+// ---- Stop ------------------------------------------------------------------
+
+// StopBuilder builds a domain.Stop and, optionally, its tags.
+type StopBuilder struct {
+	stop domain.Stop
+	tags []*TagBuilder
+}
+
+// Stop returns a builder for a stop arriving 2025-06-02 10:00 UTC, inside
+// the default Trip's date range.
+func Stop() *StopBuilder {
+	return &StopBuilder{stop: domain.Stop{
+		Name:      "Camp Grounds A",
+		Location:  "Yellowstone, WY",
+		ArrivedAt: time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
+		Notes:     "Great spot",
+		Tags:      []domain.Tag{},
+	}}
+}
+
+// WithID sets the stop ID returned by Build. Insert ignores it.
+func (b *StopBuilder) WithID(id uuid.UUID) *StopBuilder { b.stop.ID = id; return b }
+
+// WithTripID sets the parent trip. When inserting without it, Insert creates a
+// default parent trip first.
+func (b *StopBuilder) WithTripID(id uuid.UUID) *StopBuilder { b.stop.TripID = id; return b }
+
+// WithName sets the stop name.
+func (b *StopBuilder) WithName(name string) *StopBuilder { b.stop.Name = name; return b }
+
+// WithLocation sets the free-text location.
+func (b *StopBuilder) WithLocation(loc string) *StopBuilder { b.stop.Location = loc; return b }
+
+// WithCoordinates sets the stop's latitude and longitude.
+func (b *StopBuilder) WithCoordinates(lat, lon float64) *StopBuilder {
+	b.stop.Latitude, b.stop.Longitude = &lat, &lon
+	return b
+}
+
+// WithArrivedAt sets the arrival time.
+func (b *StopBuilder) WithArrivedAt(at time.Time) *StopBuilder { b.stop.ArrivedAt = at; return b }
+
+// WithDepartedAt sets the departure time. Pass nil for the current stop.
+func (b *StopBuilder) WithDepartedAt(at *time.Time) *StopBuilder { b.stop.DepartedAt = at; return b }
+
+// WithNotes sets the free-text notes.
+func (b *StopBuilder) WithNotes(notes string) *StopBuilder { b.stop.Notes = notes; return b }
+
+// WithMetadata sets the stop's custom field values.
+func (b *StopBuilder) WithMetadata(m domain.Metadata) *StopBuilder { b.stop.Metadata = m; return b }
+
+// WithTags attaches tags by display name. Use WithTagBuilders for full control.
+func (b *StopBuilder) WithTags(names ...string) *StopBuilder {
+	for _, n := range names {
+		b.tags = append(b.tags, Tag().WithName(n))
+	}
+	return b
+}
+
+// WithTagBuilders attaches tag builders.
+func (b *StopBuilder) WithTagBuilders(tags ...*TagBuilder) *StopBuilder {
+	b.tags = append(b.tags, tags...)
+	return b
+}
+
+// Build returns the stop without touching the database. Random trip and stop
+// IDs are assigned unless set explicitly; attached tags are built too.
+func (b *StopBuilder) Build() domain.Stop {
+	s := b.stop
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	if s.TripID == uuid.Nil {
+		s.TripID = uuid.New()
+	}
+	s.Tags = make([]domain.Tag, len(b.tags))
+	for i, tb := range b.tags {
+		s.Tags[i] = tb.Build()
+	}
+	return s
+}
+
+// Insert persists the stop and its tags. If no trip was set via WithTripID, a
+// default parent trip is inserted first.
+func (b *StopBuilder) Insert(t *testing.T, db DB) domain.Stop {
+	t.Helper()
+	tripID := b.stop.TripID
+	if tripID == uuid.Nil {
+		tripID = Trip().Insert(t, db).ID
+	}
+	return b.insertUnder(t, db, tripID)
+}
+
+func (b *StopBuilder) insertUnder(t *testing.T, db DB, tripID uuid.UUID) domain.Stop {
+	t.Helper()
+	ctx := context.Background()
+
+	in := b.stop
+	in.TripID = tripID
+	stop, err := repo.NewStopRepo(db).Create(ctx, in)
+	if err != nil {
+		t.Fatalf("factory.StopBuilder.Insert: %v", err)
+	}
+
+	tags := repo.NewTagRepo(db)
+	stop.Tags = make([]domain.Tag, 0, len(b.tags))
+	for _, tb := range b.tags {
+		tag := tb.Insert(t, db)
+		if err := tags.AddToStop(ctx, stop.ID, tag.ID); err != nil {
+			t.Fatalf("factory.StopBuilder.Insert: tag %q: %v", tag.Slug, err)
+		}
+		stop.Tags = append(stop.Tags, tag)
+	}
+	return stop
+}
+
+// ---- Tag -------------------------------------------------------------------
+
+// TagBuilder builds a domain.Tag.
+type TagBuilder struct {
+	tag domain.Tag
+}
+
+// Tag returns a builder for the tag "Mountains" (slug "mountains").
+func Tag() *TagBuilder {
+	return &TagBuilder{tag: domain.Tag{Name: "Mountains", Slug: "mountains"}}
+}
+
+// WithName sets the display name and derives the slug by lowercasing and
+// hyphenating whitespace. Names needing full normalisation (punctuation,
+// repeated separators) should set the slug explicitly with WithSlug.
+func (b *TagBuilder) WithName(name string) *TagBuilder {
+	b.tag.Name = name
+	b.tag.Slug = strings.Join(strings.Fields(strings.ToLower(name)), "-")
+	return b
+}
+
+// WithSlug overrides the slug.
+func (b *TagBuilder) WithSlug(slug string) *TagBuilder { b.tag.Slug = slug; return b }
+
+// Build returns the tag without touching the database, with a random ID.
+func (b *TagBuilder) Build() domain.Tag {
+	t := b.tag
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return t
+}
+
+// Insert upserts the tag by slug and returns the stored row. Inserting the
+// same slug twice returns the existing tag, matching production behaviour.
+func (b *TagBuilder) Insert(t *testing.T, db DB) domain.Tag {
+	t.Helper()
+	tag, err := repo.NewTagRepo(db).Upsert(context.Background(), b.tag.Name, b.tag.Slug)
+	if err != nil {
+		t.Fatalf("factory.TagBuilder.Insert: %v", err)
+	}
+	return tag
+}
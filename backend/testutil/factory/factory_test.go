@@ -0,0 +1,65 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+func TestTripBuilder_Build_Defaults(t *testing.T) {
+	trip := factory.Trip().Build()
+
+	assert.NotEqual(t, uuid.Nil, trip.ID)
+	assert.Equal(t, "Summer Tour", trip.Name)
+	assert.NotNil(t, trip.EndDate)
+	assert.False(t, trip.EndDate.Before(trip.StartDate), "default dates must pass service validation")
+}
+
+func TestTripBuilder_Build_Overrides(t *testing.T) {
+	id := uuid.New()
+
+	trip := factory.Trip().WithID(id).WithName("Utah").WithEndDate(nil).WithNotes("").Build()
+
+	assert.Equal(t, id, trip.ID)
+	assert.Equal(t, "Utah", trip.Name)
+	assert.Nil(t, trip.EndDate)
+	assert.Empty(t, trip.Notes)
+}
+
+// TestBuilders_BuildIsRepeatable verifies one builder can stamp out several
+// independent values — each Build gets its own random ID.
+func TestBuilders_BuildIsRepeatable(t *testing.T) {
+	b := factory.Stop()
+
+	assert.NotEqual(t, b.Build().ID, b.Build().ID)
+}
+
+func TestStopBuilder_Build_WithTags(t *testing.T) {
+	tripID := uuid.New()
+
+	stop := factory.Stop().WithTripID(tripID).WithTags("Good Cell", "quiet").Build()
+
+	assert.Equal(t, tripID, stop.TripID)
+	if assert.Len(t, stop.Tags, 2) {
+		assert.Equal(t, "good-cell", stop.Tags[0].Slug)
+		assert.Equal(t, "Good Cell", stop.Tags[0].Name)
+		assert.Equal(t, "quiet", stop.Tags[1].Slug)
+	}
+}
+
+func TestStopBuilder_Build_NoTagsIsEmptySlice(t *testing.T) {
+	stop := factory.Stop().Build()
+
+	assert.NotNil(t, stop.Tags)
+	assert.Empty(t, stop.Tags)
+}
+
+func TestTagBuilder_WithSlugOverridesDerived(t *testing.T) {
+	tag := factory.Tag().WithName("50 Amp!").WithSlug("50-amp").Build()
+
+	assert.Equal(t, "50 Amp!", tag.Name)
+	assert.Equal(t, "50-amp", tag.Slug)
+}
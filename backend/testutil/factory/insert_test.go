@@ -0,0 +1,73 @@
+//go:build integration
+
+package factory_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// TestMain migrates the test database (or, without TEST_DATABASE_URL, a
+// throwaway container) before the insert tests run.
+func TestMain(m *testing.M) {
+	teardown := testutil.MustSetupTestDatabase()
+	if dsn := os.Getenv("TEST_DATABASE_URL"); dsn != "" {
+		testutil.MustMigrate(dsn)
+	}
+	code := m.Run()
+	teardown()
+	os.Exit(code)
+}
+
+// TestTripBuilder_InsertGraph verifies a full trip → stop → tag hierarchy is
+// persisted and readable back through the repos.
+func TestTripBuilder_InsertGraph(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.NewPool(t).Begin(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+
+	g := factory.Trip().
+		WithName("Southwest").
+		WithStops(
+			factory.Stop().WithName("Moab").WithTags("desert", "quiet"),
+			factory.Stop().WithName("Zion").WithTags("desert"),
+		).
+		InsertGraph(t, tx)
+
+	require.Len(t, g.Stops, 2)
+	assert.Equal(t, g.Trip.ID, g.Stops[0].TripID)
+
+	stops, err := repo.NewStopRepo(tx).ListByTripID(ctx, g.Trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stops, 2)
+
+	moabTags, err := repo.NewTagRepo(tx).ListByStop(ctx, g.Stops[0].ID)
+	require.NoError(t, err)
+	assert.Len(t, moabTags, 2)
+
+	// The shared "desert" tag is upserted once, not duplicated.
+	assert.Equal(t, g.Stops[0].Tags[0].ID, g.Stops[1].Tags[0].ID)
+}
+
+// TestStopBuilder_InsertCreatesParentTrip verifies a stop inserted without a
+// trip gets a default parent rather than failing the foreign key.
+func TestStopBuilder_InsertCreatesParentTrip(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.NewPool(t).Begin(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback(ctx) })
+
+	stop := factory.Stop().Insert(t, tx)
+
+	_, err = repo.NewTripRepo(tx).GetByID(ctx, stop.TripID)
+	assert.NoError(t, err)
+}
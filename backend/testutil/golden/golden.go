@@ -0,0 +1,58 @@
+// Package golden compares test output byte-for-byte against checked-in
+// "golden" files, so format regressions (a reordered CSV column, a changed
+// timestamp layout, different escaping) fail loudly instead of slipping past
+// assertions that only spot-check a few fields.
+//
+// Golden files live in the calling package's testdata/ directory as
+// <name>.golden. After an intentional format change, regenerate them with
+//
+//	go test ./internal/handler -run TestGetExport_Golden -update
+//
+// and review the diff in git like any other change.
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// update is registered on the test binary's flag set by importing this
+// package, so `go test ... -update` works in any package that uses Assert.
+var update = flag.Bool("update", false, "rewrite golden files in testdata/ with the actual output")
+
+// Path returns the golden file path for name, relative to the package
+// directory the test runs in.
+func Path(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+// Assert fails t if got differs from the golden file for name. With -update
+// it writes got to the golden file instead and passes.
+func Assert(t testing.TB, name string, got []byte) {
+	t.Helper()
+	path := Path(name)
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("golden: %s does not exist; run the test with -update to create it", path)
+	}
+	if err != nil {
+		t.Fatalf("golden: %v", err)
+	}
+	// Compare as strings so testify prints a readable line diff.
+	assert.Equal(t, string(want), string(got), "output differs from %s (re-run with -update if the change is intended)", path)
+}
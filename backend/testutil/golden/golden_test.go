@@ -0,0 +1,68 @@
+package golden_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/testutil/golden"
+)
+
+// recordingT captures failures instead of failing the real test, so the
+// mismatch path of Assert can itself be tested.
+type recordingT struct {
+	testing.TB
+	failed bool
+	msgs   []string
+}
+
+func (r *recordingT) Helper() {}
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failed = true
+	r.msgs = append(r.msgs, fmt.Sprintf(format, args...))
+}
+func (r *recordingT) Fatalf(format string, args ...any) { r.Errorf(format, args...) }
+
+func writeGolden(t *testing.T, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll("testdata", 0o755))
+	require.NoError(t, os.WriteFile(golden.Path(name), []byte(content), 0o644))
+}
+
+func TestAssert_Match(t *testing.T) {
+	t.Chdir(t.TempDir())
+	writeGolden(t, "greeting", "hello\n")
+
+	rt := &recordingT{TB: t}
+	golden.Assert(rt, "greeting", []byte("hello\n"))
+
+	assert.False(t, rt.failed)
+}
+
+func TestAssert_Mismatch(t *testing.T) {
+	t.Chdir(t.TempDir())
+	writeGolden(t, "greeting", "hello\n")
+
+	rt := &recordingT{TB: t}
+	golden.Assert(rt, "greeting", []byte("hello\r\n"))
+
+	assert.True(t, rt.failed, "a single differing byte must fail")
+}
+
+func TestAssert_MissingFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	rt := &recordingT{TB: t}
+	golden.Assert(rt, "absent", []byte("x"))
+
+	require.True(t, rt.failed)
+	assert.Contains(t, rt.msgs[0], "-update")
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("testdata", "export.csv.golden"), golden.Path("export.csv"))
+}
@@ -0,0 +1,20 @@
+//go:build integration
+
+package testutil_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pkordes/rv-logbook/backend/testutil"
+)
+
+// TestMain provides a throwaway database when TEST_DATABASE_URL is unset.
+// Migrations are deliberately not applied here: the migration tests in this
+// package drive goose themselves, starting from a clean schema.
+func TestMain(m *testing.M) {
+	teardown := testutil.MustSetupTestDatabase()
+	code := m.Run()
+	teardown()
+	os.Exit(code)
+}
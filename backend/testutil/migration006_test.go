@@ -25,22 +25,14 @@ import (
 // paths in isolation by controlling exactly which version the database is at
 // before and after the migration runs.
 func TestMigration006_FixStopDatesMidnightToNoonEST(t *testing.T) {
-	db := testutil.NewSQLDB(t)
+	// A private, empty schema: start at version 0 without disturbing the
+	// schema other test packages share. It is dropped when the test ends.
+	db := testutil.NewSchemaSQLDB(t)
 	ctx := context.Background()
 
 	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS)
 	require.NoError(t, err)
 
-	// Start from a known-clean baseline regardless of shared test DB state.
-	_, err = provider.DownTo(ctx, 0)
-	require.NoError(t, err, "reset to version 0")
-	t.Cleanup(func() {
-		// Always roll back to 0 so we don't leave state for other tests.
-		if _, err := provider.DownTo(ctx, 0); err != nil {
-			t.Logf("cleanup: down-to-0 failed: %v", err)
-		}
-	})
-
 	// Apply schema migrations 001–005 to get the stops table in place.
 	_, err = provider.UpTo(ctx, 5)
 	require.NoError(t, err, "apply schema migrations 001-005")
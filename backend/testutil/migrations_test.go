@@ -25,7 +25,9 @@ import (
 //
 // The test is skipped automatically when TEST_DATABASE_URL is not set.
 func TestMigrations(t *testing.T) {
-	db := testutil.NewSQLDB(t)
+	// A private, empty schema: this test starts from version 0 and never
+	// touches the schema other test packages share.
+	db := testutil.NewSchemaSQLDB(t)
 
 	provider, err := goose.NewProvider(
 		goose.DialectPostgres,
@@ -36,21 +38,13 @@ func TestMigrations(t *testing.T) {
 
 	ctx := context.Background()
 
-	// --- Ensure a clean baseline before testing ---
-	// Another package's TestMain may have already applied migrations against this
-	// shared test DB. Reset to version 0 first so this test is self-contained and
-	// order-independent, whether run alone or as part of the full suite.
-	if _, err := provider.DownTo(ctx, 0); err != nil {
-		t.Fatalf("TestMigrations: initial reset: %v", err)
-	}
-
 	// --- Red → Green: apply all migrations ---
 	results, err := provider.Up(ctx)
 	require.NoError(t, err, "goose up")
 	assert.NotEmpty(t, results, "expected at least one migration to be applied")
 
 	// Verify all expected tables exist after applying migrations.
-	for _, table := range []string{"trips", "stops", "tags", "stop_tags"} {
+	for _, table := range []string{"trips", "stops", "tags", "stop_tags", "odometer_readings", "propane_fills", "power_readings", "tank_levels", "dump_events", "checklist_templates", "stop_checklists", "stop_checklist_items", "packing_lists", "packing_items", "reservations", "expenses", "border_crossings", "points_of_interest", "poi_tags", "route_legs", "location_pings", "location_dwells", "stop_places", "table_changes", "trip_summaries", "tag_summaries", "organizations", "organization_members", "custom_fields", "journal_entries", "webhooks", "webhook_deliveries"} {
 		assertTableExists(t, db, table)
 	}
 
@@ -59,20 +53,20 @@ func TestMigrations(t *testing.T) {
 	require.NoError(t, err, "goose down-to 0")
 
 	// Verify all tables have been removed after rolling back.
-	for _, table := range []string{"trips", "stops", "tags", "stop_tags"} {
+	for _, table := range []string{"trips", "stops", "tags", "stop_tags", "odometer_readings", "propane_fills", "power_readings", "tank_levels", "dump_events", "checklist_templates", "stop_checklists", "stop_checklist_items", "packing_lists", "packing_items", "reservations", "expenses", "border_crossings", "points_of_interest", "poi_tags", "route_legs", "location_pings", "location_dwells", "stop_places", "table_changes", "trip_summaries", "tag_summaries", "organizations", "organization_members", "custom_fields", "journal_entries", "webhooks", "webhook_deliveries"} {
 		assertTableNotExists(t, db, table)
 	}
 }
 
 // assertTableExists fails the test if the named table does not exist in the
-// public schema of the connected database.
+// current schema (first on search_path) of the connected database.
 func assertTableExists(t *testing.T, db *sql.DB, table string) {
 	t.Helper()
 	assertTablePresence(t, db, table, true)
 }
 
 // assertTableNotExists fails the test if the named table exists in the
-// public schema of the connected database.
+// current schema (first on search_path) of the connected database.
 func assertTableNotExists(t *testing.T, db *sql.DB, table string) {
 	t.Helper()
 	assertTablePresence(t, db, table, false)
@@ -85,7 +79,7 @@ func assertTablePresence(t *testing.T, db *sql.DB, table string, shouldExist boo
 	const q = `
 		SELECT EXISTS (
 			SELECT 1 FROM information_schema.tables
-			WHERE table_schema = 'public'
+			WHERE table_schema = current_schema()
 			AND   table_name   = $1
 		)`
 	var exists bool
@@ -0,0 +1,140 @@
+package testutil
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+
+	"github.com/pkordes/rv-logbook/backend/migrations"
+)
+
+// NewSchemaPool creates a uniquely named Postgres schema in the database at
+// TEST_DATABASE_URL, applies every migration inside it, and returns a pool
+// whose connections see only that schema (via search_path), plus public for
+// the PostGIS types and functions installed there. The schema and everything
+// in it are dropped when the test finishes.
+//
+// Use it instead of NewPool plus a rolled-back transaction when the code under
+// test commits — or begins its own transactions — so rollback isolation is
+// not available. Each call gets a private copy of the schema, so tests using
+// it may call t.Parallel(). The cost is one migration run per test (tens of
+// milliseconds); prefer the transaction pattern when it works.
+//
+// The test is skipped automatically if TEST_DATABASE_URL is not set.
+func NewSchemaPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := requireDSN(t)
+	schema := createSchema(t, dsn)
+
+	db := openSchemaSQLDB(t, dsn, schema)
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS)
+	if err != nil {
+		db.Close()
+		t.Fatalf("testutil.NewSchemaPool: create goose provider: %v", err)
+	}
+	if _, err := provider.Up(context.Background()); err != nil {
+		db.Close()
+		t.Fatalf("testutil.NewSchemaPool: migrate schema %s: %v", schema, err)
+	}
+	db.Close()
+
+	poolCfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("testutil.NewSchemaPool: parse dsn: %v", err)
+	}
+	poolCfg.ConnConfig.RuntimeParams["search_path"] = schemaSearchPath(schema)
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolCfg)
+	if err != nil {
+		t.Fatalf("testutil.NewSchemaPool: open pool: %v", err)
+	}
+	// Cleanups run last-in first-out: the pool closes before the schema is
+	// dropped, so no open connection holds locks on its tables.
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// NewSchemaSQLDB is NewSchemaPool without the migrations: it returns a *sql.DB
+// scoped to a fresh, empty schema. Use it for migration tests, which drive
+// goose up and down themselves and must not disturb the shared schema other
+// test packages run against.
+//
+// The test is skipped automatically if TEST_DATABASE_URL is not set.
+func NewSchemaSQLDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := requireDSN(t)
+	db := openSchemaSQLDB(t, dsn, createSchema(t, dsn))
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// openSchemaSQLDB opens a *sql.DB whose connections use schema as their
+// search_path, so unqualified names (including goose's version table)
+// resolve inside it.
+func openSchemaSQLDB(t *testing.T, dsn, schema string) *sql.DB {
+	t.Helper()
+
+	connCfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("testutil: parse dsn: %v", err)
+	}
+	connCfg.RuntimeParams["search_path"] = schemaSearchPath(schema)
+	return stdlib.OpenDB(*connCfg)
+}
+
+// schemaSearchPath puts schema first, so tables are created and found there,
+// then public, where the PostGIS extension is installed once per database.
+// Every migration creates its tables in the test schema, so none of the
+// shared schema's tables is ever reached through public.
+func schemaSearchPath(schema string) string {
+	return pgx.Identifier{schema}.Sanitize() + ", public"
+}
+
+// createSchema creates a schema with a random name and registers a cleanup
+// that drops it. Returns the (unquoted) schema name.
+func createSchema(t *testing.T, dsn string) string {
+	t.Helper()
+
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		t.Fatalf("testutil.createSchema: %v", err)
+	}
+	schema := "test_" + hex.EncodeToString(b[:])
+	ident := pgx.Identifier{schema}.Sanitize()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatalf("testutil.createSchema: connect: %v", err)
+	}
+	defer conn.Close(ctx)
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA "+ident); err != nil {
+		t.Fatalf("testutil.createSchema: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		conn, err := pgx.Connect(ctx, dsn)
+		if err != nil {
+			t.Errorf("testutil: drop schema %s: connect: %v", schema, err)
+			return
+		}
+		defer conn.Close(ctx)
+		if _, err := conn.Exec(ctx, "DROP SCHEMA "+ident+" CASCADE"); err != nil {
+			t.Errorf("testutil: drop schema %s: %v", schema, err)
+		}
+	})
+	return schema
+}
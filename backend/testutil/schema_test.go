@@ -0,0 +1,71 @@
+//go:build integration
+
+package testutil_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pkordes/rv-logbook/backend/internal/domain"
+	"github.com/pkordes/rv-logbook/backend/internal/repo"
+	"github.com/pkordes/rv-logbook/backend/testutil"
+	"github.com/pkordes/rv-logbook/backend/testutil/factory"
+)
+
+// TestNewSchemaPool_CommittedDataStaysPrivate verifies the isolation
+// guarantee: rows committed through one schema pool are invisible to another,
+// so tests that commit can run in parallel and assert exact counts.
+func TestNewSchemaPool_CommittedDataStaysPrivate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	a := testutil.NewSchemaPool(t)
+	b := testutil.NewSchemaPool(t)
+
+	// No transaction: these rows are committed.
+	factory.Trip().WithName("Only in A").Insert(t, a)
+	factory.Trip().WithName("Also only in A").Insert(t, a)
+
+	tripsA, totalA, err := repo.NewTripRepo(a).ListPaged(ctx, nil, domain.PaginationParams{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, tripsA, 2)
+	assert.Equal(t, int64(2), totalA, "a private schema makes totals exact")
+
+	_, totalB, err := repo.NewTripRepo(b).ListPaged(ctx, nil, domain.PaginationParams{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, totalB, "schema B must not see A's committed rows")
+}
+
+// TestNewSchemaPool_OwnTransactions verifies that code which begins and
+// commits its own transaction — the case rollback isolation cannot cover —
+// works against a schema pool.
+func TestNewSchemaPool_OwnTransactions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pool := testutil.NewSchemaPool(t)
+
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	created := factory.Trip().Insert(t, tx)
+	require.NoError(t, tx.Commit(ctx))
+
+	got, err := repo.NewTripRepo(pool).GetByID(ctx, created.ID)
+	require.NoError(t, err, "committed row must be visible on another connection")
+	assert.Equal(t, created.Name, got.Name)
+}
+
+// TestNewSchemaSQLDB_StartsEmpty verifies that the unmigrated variant hands
+// out a schema with no tables at all, not even goose's version table.
+func TestNewSchemaSQLDB_StartsEmpty(t *testing.T) {
+	t.Parallel()
+	db := testutil.NewSchemaSQLDB(t)
+
+	var n int
+	err := db.QueryRowContext(context.Background(),
+		`SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema()`).Scan(&n)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}